@@ -44,6 +44,9 @@ type ClientConfig struct {
 	CACertificate string `json:"ca_certificate"`
 	PrivateKey    string `json:"private_key"`
 	Certificate   string `json:"certificate"`
+
+	// WebSocketURL - Tunnel the connection over a websocket to this url (ws:// or wss://)
+	WebSocketURL string `json:"websocket_url,omitempty"`
 }
 
 // GetConfigDir - Returns the path to the config dir
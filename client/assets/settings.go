@@ -36,6 +36,7 @@ type ClientSettings struct {
 	SmallTermWidth    int    `json:"small_term_width"`
 	AlwaysOverflow    bool   `json:"always_overflow"`
 	VimMode           bool   `json:"vim_mode"`
+	MaskSecrets       bool   `json:"mask_secrets"`
 }
 
 // LoadSettings - Load the client settings from disk
@@ -61,6 +62,7 @@ func defaultSettings() *ClientSettings {
 		SmallTermWidth:    170,
 		AlwaysOverflow:    false,
 		VimMode:           false,
+		MaskSecrets:       true,
 	}
 }
 
@@ -59,6 +59,11 @@ func init() {
 
 	// Version
 	rootCmd.AddCommand(cmdVersion)
+
+	// FIDO2
+	cmdFIDO2Enroll.Flags().StringP("name", "n", "", "operator name")
+	cmdFIDO2Enroll.Flags().StringP("save", "s", "", "save credential to file ...")
+	rootCmd.AddCommand(cmdFIDO2Enroll)
 }
 
 var rootCmd = &cobra.Command{
@@ -96,8 +101,8 @@ func StartClientConsole() error {
 		fmt.Printf("Connection to server failed %s", err)
 		return nil
 	}
-	defer ln.Close()
-	return console.Start(rpc, command.BindCommands, func(con *console.SliverConsoleClient) {}, false)
+	server := console.NewServer(config, rpc, ln)
+	return console.Start(server, command.BindCommands, func(con *console.SliverConsoleClient) {}, false)
 }
 
 // Execute - Execute root command
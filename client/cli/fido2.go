@@ -0,0 +1,53 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bishopfox/sliver/client/transport"
+	"github.com/spf13/cobra"
+)
+
+var cmdFIDO2Enroll = &cobra.Command{
+	Use:   "fido2-enroll",
+	Short: "Create a FIDO2 credential for operator second factor verification",
+	Long:  `fido2-enroll --name <operator> --save <credential file>`,
+	Run: func(cmd *cobra.Command, args []string) {
+		name, _ := cmd.Flags().GetString("name")
+		save, _ := cmd.Flags().GetString("save")
+		if name == "" || save == "" {
+			fmt.Printf("Must specify --name and --save, see --help\n")
+			os.Exit(1)
+		}
+		fmt.Printf("Touch your security key ...\n")
+		credential, err := transport.FIDO2Enroll(name)
+		if err != nil {
+			fmt.Printf("[!] %s\n", err)
+			os.Exit(3)
+		}
+		err = os.WriteFile(save, credential, 0600)
+		if err != nil {
+			fmt.Printf("[!] %s\n", err)
+			os.Exit(3)
+		}
+		fmt.Printf("Saved credential to %s, your server admin needs it to enroll you (new-operator --second-factor fido2 --fido2-credential)\n", save)
+	},
+}
@@ -0,0 +1,52 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+)
+
+func init() {
+	help.RegisterOpsec(consts.AdcsStr, &help.OpsecInfo{
+		Risk:      help.OpsecMedium,
+		Artifacts: []string{"certificate requests in the CA's database (event 4886/4887 when auditing is enabled)"},
+		Network:   "ldap to a domain controller, remote registry (smb) and http(s) to each CA",
+	})
+}
+
+// isWindowsTarget - Check the active target is a Windows session or beacon
+func isWindowsTarget(con *console.SliverConsoleClient) bool {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return false
+	}
+	targetOS := ""
+	if session != nil {
+		targetOS = session.OS
+	} else {
+		targetOS = beacon.OS
+	}
+	if targetOS != "windows" {
+		con.PrintErrorf("Command only supported on Windows.\n")
+		return false
+	}
+	return true
+}
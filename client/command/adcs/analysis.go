@@ -0,0 +1,470 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// msPKI-Certificate-Name-Flag
+	enrolleeSuppliesSubject = 0x00000001
+	// msPKI-Enrollment-Flag
+	pendAllRequests = 0x00000002
+	// Policy module EditFlags
+	editfAttributeSubjectAltName2 = 0x00040000
+
+	// Certification authority rights
+	caManageCA           = 0x00000001
+	caManageCertificates = 0x00000002
+	caEnroll             = 0x00000200
+
+	// Directory object rights
+	adsControlAccess = 0x00000100
+	adsWriteProp     = 0x00000020
+	writeDAC         = 0x00040000
+	writeOwner       = 0x00080000
+	genericWrite     = 0x40000000
+	genericAll       = 0x10000000
+
+	aceAccessAllowed        = 0x00
+	aceAccessAllowedObject  = 0x05
+	aceObjectTypePresent    = 0x00000001
+	aceInheritedTypePresent = 0x00000002
+
+	enrollGUID     = "0e10c968-78fb-11d2-90d4-00c04f79dc55"
+	autoEnrollGUID = "a05b8cc2-17bc-4802-a710-e7c15ab866a2"
+
+	ekuClientAuth     = "1.3.6.1.5.5.7.3.2"
+	ekuPKINITClient   = "1.3.6.1.5.2.3.4"
+	ekuSmartCardLogon = "1.3.6.1.4.1.311.20.2.2"
+	ekuAnyPurpose     = "2.5.29.37.0"
+	ekuRequestAgent   = "1.3.6.1.4.1.311.20.2.1"
+)
+
+var (
+	// ErrInvalidSecurityDescriptor - The security descriptor is truncated or malformed
+	ErrInvalidSecurityDescriptor = errors.New("invalid security descriptor")
+
+	wellKnownSIDs = map[string]string{
+		"S-1-1-0":      "Everyone",
+		"S-1-5-7":      "Anonymous",
+		"S-1-5-11":     "Authenticated Users",
+		"S-1-5-18":     "SYSTEM",
+		"S-1-5-32-544": "BUILTIN\\Administrators",
+		"S-1-5-32-545": "BUILTIN\\Users",
+	}
+	domainRIDs = map[string]string{
+		"500": "Administrator",
+		"512": "Domain Admins",
+		"513": "Domain Users",
+		"514": "Domain Guests",
+		"515": "Domain Computers",
+		"516": "Domain Controllers",
+		"517": "Cert Publishers",
+		"518": "Schema Admins",
+		"519": "Enterprise Admins",
+	}
+	ekuNames = map[string]string{
+		ekuClientAuth:            "Client Authentication",
+		ekuPKINITClient:          "PKINIT Client Authentication",
+		ekuSmartCardLogon:        "Smart Card Logon",
+		ekuAnyPurpose:            "Any Purpose",
+		ekuRequestAgent:          "Certificate Request Agent",
+		"1.3.6.1.5.5.7.3.1":      "Server Authentication",
+		"1.3.6.1.5.5.7.3.4":      "Secure Email",
+		"1.3.6.1.4.1.311.10.3.4": "Encrypting File System",
+	}
+)
+
+// ace - An allow entry of a dacl, object is the zero guid if the entry applies
+// to the whole object
+type ace struct {
+	SID    string
+	Mask   uint32
+	Object string
+}
+
+type securityDescriptor struct {
+	Owner string
+	DACL  []ace
+}
+
+// CertificateAuthority - An enrollment service and the misconfigurations found
+type CertificateAuthority struct {
+	Name             string
+	Host             string
+	Templates        []string
+	EditFlags        int64
+	Managers         []string // Controlled principals with ManageCA or ManageCertificates
+	WebEnrollmentURL string
+	RegistryError    string
+	Vulns            []string
+}
+
+// CertificateTemplate - A certificate template and the misconfigurations found
+type CertificateTemplate struct {
+	Name                    string
+	DisplayName             string
+	CAs                     []string // The CAs that publish the template
+	EKUs                    []string
+	EnrolleeSuppliesSubject bool
+	ManagerApproval         bool
+	RASignatures            int64
+	Enrollers               []string // Controlled principals with enrollment rights
+	Writers                 []string // Controlled principals that can modify the template
+	Vulns                   []string
+}
+
+// parseSID - Parse a binary SID into its string form
+func parseSID(data []byte) (string, int, error) {
+	if len(data) < 8 {
+		return "", 0, ErrInvalidSecurityDescriptor
+	}
+	subAuthorities := int(data[1])
+	size := 8 + 4*subAuthorities
+	if len(data) < size {
+		return "", 0, ErrInvalidSecurityDescriptor
+	}
+	authority := uint64(0)
+	for _, b := range data[2:8] {
+		authority = authority<<8 | uint64(b)
+	}
+	sid := fmt.Sprintf("S-%d-%d", data[0], authority)
+	for index := 0; index < subAuthorities; index++ {
+		sid += fmt.Sprintf("-%d", binary.LittleEndian.Uint32(data[8+4*index:]))
+	}
+	return sid, size, nil
+}
+
+// parseGUID - Parse a binary GUID, the first three fields are little endian
+func parseGUID(data []byte) string {
+	return fmt.Sprintf("%08x-%04x-%04x-%x-%x",
+		binary.LittleEndian.Uint32(data[0:4]), binary.LittleEndian.Uint16(data[4:6]),
+		binary.LittleEndian.Uint16(data[6:8]), data[8:10], data[10:16])
+}
+
+// parseSecurityDescriptor - Parse the owner and allow entries of a self-relative
+// security descriptor, deny entries are ignored
+func parseSecurityDescriptor(data []byte) (*securityDescriptor, error) {
+	if len(data) < 20 {
+		return nil, ErrInvalidSecurityDescriptor
+	}
+	sd := &securityDescriptor{}
+	ownerOffset := binary.LittleEndian.Uint32(data[4:8])
+	if ownerOffset != 0 {
+		if uint32(len(data)) <= ownerOffset {
+			return nil, ErrInvalidSecurityDescriptor
+		}
+		owner, _, err := parseSID(data[ownerOffset:])
+		if err != nil {
+			return nil, err
+		}
+		sd.Owner = owner
+	}
+	daclOffset := binary.LittleEndian.Uint32(data[16:20])
+	if daclOffset == 0 {
+		return sd, nil
+	}
+	if uint32(len(data)) < daclOffset+8 {
+		return nil, ErrInvalidSecurityDescriptor
+	}
+	acl := data[daclOffset:]
+	aceCount := int(binary.LittleEndian.Uint16(acl[4:6]))
+	offset := 8
+	for index := 0; index < aceCount; index++ {
+		if len(acl) < offset+8 {
+			return nil, ErrInvalidSecurityDescriptor
+		}
+		aceType := acl[offset]
+		aceSize := int(binary.LittleEndian.Uint16(acl[offset+2 : offset+4]))
+		if aceSize < 8 || len(acl) < offset+aceSize {
+			return nil, ErrInvalidSecurityDescriptor
+		}
+		body := acl[offset+4 : offset+aceSize]
+		offset += aceSize
+
+		entry := ace{Mask: binary.LittleEndian.Uint32(body[0:4])}
+		sidData := body[4:]
+		switch aceType {
+		case aceAccessAllowed:
+		case aceAccessAllowedObject:
+			if len(body) < 8 {
+				return nil, ErrInvalidSecurityDescriptor
+			}
+			flags := binary.LittleEndian.Uint32(body[4:8])
+			sidData = body[8:]
+			if flags&aceObjectTypePresent != 0 {
+				if len(sidData) < 16 {
+					return nil, ErrInvalidSecurityDescriptor
+				}
+				entry.Object = parseGUID(sidData[:16])
+				sidData = sidData[16:]
+			}
+			if flags&aceInheritedTypePresent != 0 {
+				if len(sidData) < 16 {
+					return nil, ErrInvalidSecurityDescriptor
+				}
+				sidData = sidData[16:]
+			}
+		default:
+			continue
+		}
+		sid, _, err := parseSID(sidData)
+		if err != nil {
+			return nil, err
+		}
+		entry.SID = sid
+		sd.DACL = append(sd.DACL, entry)
+	}
+	return sd, nil
+}
+
+// controlledSIDs - Principals the implant's token is assumed to control: broad
+// groups of the domain and everything in the token
+func controlledSIDs(domainSID string, tokenSIDs []string) map[string]bool {
+	sids := map[string]bool{"S-1-1-0": true, "S-1-5-11": true, "S-1-5-32-545": true}
+	if domainSID != "" {
+		sids[domainSID+"-513"] = true
+		sids[domainSID+"-515"] = true
+	}
+	for _, sid := range tokenSIDs {
+		sids[sid] = true
+	}
+	return sids
+}
+
+// principalName - A readable name for well known and domain sids
+func principalName(sid string, domainSID string) string {
+	if name, ok := wellKnownSIDs[sid]; ok {
+		return name
+	}
+	if domainSID != "" && strings.HasPrefix(sid, domainSID+"-") {
+		if name, ok := domainRIDs[strings.TrimPrefix(sid, domainSID+"-")]; ok {
+			return name
+		}
+	}
+	return sid
+}
+
+func canEnroll(entry ace) bool {
+	if entry.Mask&genericAll != 0 {
+		return true
+	}
+	if entry.Mask&adsControlAccess == 0 {
+		return false
+	}
+	return entry.Object == "" || entry.Object == enrollGUID || entry.Object == autoEnrollGUID
+}
+
+func canWrite(entry ace) bool {
+	if entry.Mask&(genericAll|genericWrite|writeDAC|writeOwner) != 0 {
+		return true
+	}
+	return entry.Mask&adsWriteProp != 0 && entry.Object == ""
+}
+
+// Analyze - Find ESC1-4 and ESC6-8 misconfigurations in an enumeration, ESC5
+// (PKI object acls) is not checked
+func Analyze(enum *sliverpb.AdcsEnum) ([]*CertificateAuthority, []*CertificateTemplate) {
+	domainSID := ""
+	if 0 < len(enum.DomainSID) {
+		domainSID, _, _ = parseSID(enum.DomainSID)
+	}
+	controlled := controlledSIDs(domainSID, enum.TokenSIDs)
+	name := func(sid string) string { return principalName(sid, domainSID) }
+
+	cas := []*CertificateAuthority{}
+	published := map[string][]string{}
+	caEnrollable := map[string]bool{}
+	for _, entry := range enum.CAs {
+		ca := &CertificateAuthority{
+			Name:             attributeString(entry.Entry, "cn"),
+			Host:             attributeString(entry.Entry, "dNSHostName"),
+			Templates:        attributeStrings(entry.Entry, "certificateTemplates"),
+			EditFlags:        entry.EditFlags,
+			WebEnrollmentURL: entry.WebEnrollmentURL,
+			RegistryError:    entry.RegistryError,
+		}
+		for _, template := range ca.Templates {
+			published[template] = append(published[template], ca.Name)
+		}
+		// Without the CA's acl assume we can enroll, the default allows authenticated users
+		caEnrollable[ca.Name] = len(entry.Security) == 0
+		if sd, err := parseSecurityDescriptor(entry.Security); err == nil {
+			for _, allowed := range sd.DACL {
+				if !controlled[allowed.SID] {
+					continue
+				}
+				if allowed.Mask&(caManageCA|caManageCertificates) != 0 {
+					ca.Managers = appendUnique(ca.Managers, name(allowed.SID))
+				}
+				if allowed.Mask&caEnroll != 0 {
+					caEnrollable[ca.Name] = true
+				}
+			}
+		}
+		if 0 <= ca.EditFlags && ca.EditFlags&editfAttributeSubjectAltName2 != 0 {
+			ca.Vulns = append(ca.Vulns, "ESC6")
+		}
+		if 0 < len(ca.Managers) {
+			ca.Vulns = append(ca.Vulns, "ESC7")
+		}
+		if entry.WebEnrollment && strings.HasPrefix(entry.WebEnrollmentURL, "http://") {
+			ca.Vulns = append(ca.Vulns, "ESC8")
+		}
+		cas = append(cas, ca)
+	}
+
+	templates := []*CertificateTemplate{}
+	for _, entry := range enum.Templates {
+		template := &CertificateTemplate{
+			Name:                    attributeString(entry, "cn"),
+			DisplayName:             attributeString(entry, "displayName"),
+			EnrolleeSuppliesSubject: attributeInt(entry, "msPKI-Certificate-Name-Flag")&enrolleeSuppliesSubject != 0,
+			ManagerApproval:         attributeInt(entry, "msPKI-Enrollment-Flag")&pendAllRequests != 0,
+			RASignatures:            attributeInt(entry, "msPKI-RA-Signature"),
+		}
+		template.CAs = published[template.Name]
+		template.EKUs = attributeStrings(entry, "pKIExtendedKeyUsage")
+		if 2 <= attributeInt(entry, "msPKI-Template-Schema-Version") {
+			if policies := attributeStrings(entry, "msPKI-Certificate-Application-Policy"); 0 < len(policies) {
+				template.EKUs = policies
+			}
+		}
+		if sd, err := parseSecurityDescriptor(attributeValue(entry, "nTSecurityDescriptor")); err == nil {
+			if controlled[sd.Owner] {
+				template.Writers = appendUnique(template.Writers, name(sd.Owner))
+			}
+			for _, allowed := range sd.DACL {
+				if !controlled[allowed.SID] {
+					continue
+				}
+				if canEnroll(allowed) {
+					template.Enrollers = appendUnique(template.Enrollers, name(allowed.SID))
+				}
+				if canWrite(allowed) {
+					template.Writers = appendUnique(template.Writers, name(allowed.SID))
+				}
+			}
+		}
+
+		enrollable := false
+		for _, caName := range template.CAs {
+			enrollable = enrollable || caEnrollable[caName]
+		}
+		issuable := enrollable && 0 < len(template.Enrollers) && !template.ManagerApproval && template.RASignatures == 0
+		if issuable && template.EnrolleeSuppliesSubject && template.allowsAuthentication() {
+			template.Vulns = append(template.Vulns, "ESC1")
+		}
+		if issuable && (len(template.EKUs) == 0 || template.hasEKU(ekuAnyPurpose)) {
+			template.Vulns = append(template.Vulns, "ESC2")
+		}
+		if issuable && template.hasEKU(ekuRequestAgent) {
+			template.Vulns = append(template.Vulns, "ESC3")
+		}
+		if 0 < len(template.Writers) {
+			template.Vulns = append(template.Vulns, "ESC4")
+		}
+		templates = append(templates, template)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return cas, templates
+}
+
+func (t *CertificateTemplate) hasEKU(eku string) bool {
+	for _, templateEKU := range t.EKUs {
+		if templateEKU == eku {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsAuthentication - The template's certificates can be used for domain authentication
+func (t *CertificateTemplate) allowsAuthentication() bool {
+	return len(t.EKUs) == 0 || t.hasEKU(ekuClientAuth) || t.hasEKU(ekuPKINITClient) ||
+		t.hasEKU(ekuSmartCardLogon) || t.hasEKU(ekuAnyPurpose)
+}
+
+func ekuName(eku string) string {
+	if name, ok := ekuNames[eku]; ok {
+		return name
+	}
+	return eku
+}
+
+func attributeValue(entry *sliverpb.LDAPEntry, name string) []byte {
+	values := attributeValues(entry, name)
+	if len(values) == 0 {
+		return nil
+	}
+	return values[0]
+}
+
+func attributeValues(entry *sliverpb.LDAPEntry, name string) [][]byte {
+	if entry == nil {
+		return nil
+	}
+	for _, attr := range entry.Attributes {
+		if strings.EqualFold(attr.Name, name) {
+			return attr.Values
+		}
+	}
+	return nil
+}
+
+func attributeString(entry *sliverpb.LDAPEntry, name string) string {
+	return string(attributeValue(entry, name))
+}
+
+func attributeStrings(entry *sliverpb.LDAPEntry, name string) []string {
+	values := []string{}
+	for _, value := range attributeValues(entry, name) {
+		values = append(values, string(value))
+	}
+	return values
+}
+
+// attributeInt - Integer attributes are signed 32-bit decimal strings
+func attributeInt(entry *sliverpb.LDAPEntry, name string) int64 {
+	value, err := strconv.ParseInt(attributeString(entry, name), 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int64(uint32(value))
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
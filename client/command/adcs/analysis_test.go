@@ -0,0 +1,212 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const testDomainSID = "S-1-5-21-1004336348-1177238915-682003330"
+
+func appendUint32(data []byte, value uint32) []byte {
+	return append(data, byte(value), byte(value>>8), byte(value>>16), byte(value>>24))
+}
+
+func appendUint16(data []byte, value uint16) []byte {
+	return append(data, byte(value), byte(value>>8))
+}
+
+func sidBytes(sid string) []byte {
+	parts := strings.Split(sid, "-")[1:]
+	revision, _ := strconv.Atoi(parts[0])
+	authority, _ := strconv.Atoi(parts[1])
+	data := []byte{byte(revision), byte(len(parts) - 2), 0, 0, 0, 0, 0, byte(authority)}
+	for _, part := range parts[2:] {
+		subAuthority, _ := strconv.ParseUint(part, 10, 32)
+		data = appendUint32(data, uint32(subAuthority))
+	}
+	return data
+}
+
+func guidBytes(guid string) []byte {
+	hex := strings.ReplaceAll(guid, "-", "")
+	raw := make([]byte, 16)
+	for index := range raw {
+		value, _ := strconv.ParseUint(hex[2*index:2*index+2], 16, 8)
+		raw[index] = byte(value)
+	}
+	// The first three fields are little endian
+	return []byte{
+		raw[3], raw[2], raw[1], raw[0], raw[5], raw[4], raw[7], raw[6],
+		raw[8], raw[9], raw[10], raw[11], raw[12], raw[13], raw[14], raw[15],
+	}
+}
+
+func allowACE(sid string, mask uint32, object string) []byte {
+	body := appendUint32(nil, mask)
+	aceType := byte(aceAccessAllowed)
+	if object != "" {
+		aceType = aceAccessAllowedObject
+		body = appendUint32(body, aceObjectTypePresent)
+		body = append(body, guidBytes(object)...)
+	}
+	body = append(body, sidBytes(sid)...)
+	header := []byte{aceType, 0}
+	header = appendUint16(header, uint16(4+len(body)))
+	return append(header, body...)
+}
+
+func securityDescriptorBytes(owner string, aces ...[]byte) []byte {
+	ownerSID := sidBytes(owner)
+	acl := []byte{}
+	for _, entry := range aces {
+		acl = append(acl, entry...)
+	}
+	aclHeader := []byte{2, 0}
+	aclHeader = appendUint16(aclHeader, uint16(8+len(acl)))
+	aclHeader = appendUint16(aclHeader, uint16(len(aces)))
+	aclHeader = append(aclHeader, 0, 0)
+
+	sd := []byte{1, 0, 0x04, 0x80}
+	sd = appendUint32(sd, 20)                       // owner
+	sd = appendUint32(sd, 0)                        // group
+	sd = appendUint32(sd, 0)                        // sacl
+	sd = appendUint32(sd, uint32(20+len(ownerSID))) // dacl
+	sd = append(sd, ownerSID...)
+	sd = append(sd, aclHeader...)
+	return append(sd, acl...)
+}
+
+func entry(attrs map[string][]string) *sliverpb.LDAPEntry {
+	ldapEntry := &sliverpb.LDAPEntry{}
+	for name, values := range attrs {
+		attr := &sliverpb.LDAPAttribute{Name: name}
+		for _, value := range values {
+			attr.Values = append(attr.Values, []byte(value))
+		}
+		ldapEntry.Attributes = append(ldapEntry.Attributes, attr)
+	}
+	return ldapEntry
+}
+
+func TestParseSecurityDescriptor(t *testing.T) {
+	data := securityDescriptorBytes(testDomainSID+"-512",
+		allowACE(testDomainSID+"-513", adsControlAccess, enrollGUID),
+		allowACE("S-1-5-11", genericAll, ""),
+	)
+	sd, err := parseSecurityDescriptor(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sd.Owner != testDomainSID+"-512" {
+		t.Fatalf("unexpected owner %s", sd.Owner)
+	}
+	if len(sd.DACL) != 2 {
+		t.Fatalf("expected 2 aces, got %d", len(sd.DACL))
+	}
+	if sd.DACL[0].SID != testDomainSID+"-513" || sd.DACL[0].Object != enrollGUID || sd.DACL[0].Mask != adsControlAccess {
+		t.Fatalf("unexpected object ace %+v", sd.DACL[0])
+	}
+	if sd.DACL[1].SID != "S-1-5-11" || sd.DACL[1].Object != "" {
+		t.Fatalf("unexpected ace %+v", sd.DACL[1])
+	}
+	for length := 0; length < len(data); length++ {
+		if _, err := parseSecurityDescriptor(data[:length]); err == nil {
+			t.Fatalf("truncated security descriptor (%d bytes) parsed", length)
+		}
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	domainAdmins := testDomainSID + "-512"
+	domainUsers := testDomainSID + "-513"
+	template := func(name string, nameFlag string, enrollmentFlag string, ekus []string, sd []byte) *sliverpb.LDAPEntry {
+		template := entry(map[string][]string{
+			"cn":                            {name},
+			"msPKI-Certificate-Name-Flag":   {nameFlag},
+			"msPKI-Enrollment-Flag":         {enrollmentFlag},
+			"msPKI-RA-Signature":            {"0"},
+			"msPKI-Template-Schema-Version": {"2"},
+			"pKIExtendedKeyUsage":           ekus,
+		})
+		template.Attributes = append(template.Attributes, &sliverpb.LDAPAttribute{Name: "nTSecurityDescriptor", Values: [][]byte{sd}})
+		return template
+	}
+	usersEnroll := securityDescriptorBytes(domainAdmins, allowACE(domainUsers, adsControlAccess, enrollGUID))
+	adminsEnroll := securityDescriptorBytes(domainAdmins, allowACE(domainAdmins, adsControlAccess, enrollGUID))
+	usersWrite := securityDescriptorBytes(domainAdmins, allowACE("S-1-5-11", writeDAC, ""))
+
+	enum := &sliverpb.AdcsEnum{
+		DomainSID: sidBytes(testDomainSID),
+		CAs: []*sliverpb.AdcsCA{{
+			Entry: entry(map[string][]string{
+				"cn":                   {"corp-CA"},
+				"dNSHostName":          {"ca.corp.local"},
+				"certificateTemplates": {"ESC1", "ESC2", "ESC3", "Approval", "AdminsOnly", "Writable"},
+			}),
+			EditFlags:        editfAttributeSubjectAltName2 | 0x1,
+			Security:         securityDescriptorBytes(domainAdmins, allowACE("S-1-5-11", caEnroll, ""), allowACE(domainUsers, caManageCertificates, "")),
+			WebEnrollment:    true,
+			WebEnrollmentURL: "http://ca.corp.local/certsrv/",
+		}},
+		Templates: []*sliverpb.LDAPEntry{
+			template("ESC1", "1", "0", []string{ekuClientAuth}, usersEnroll),
+			template("ESC2", "0", "0", []string{}, usersEnroll),
+			template("ESC3", "0", "0", []string{ekuRequestAgent}, usersEnroll),
+			template("Approval", "1", "2", []string{ekuClientAuth}, usersEnroll),
+			template("AdminsOnly", "1", "0", []string{ekuClientAuth}, adminsEnroll),
+			template("Writable", "0", "0", []string{"1.3.6.1.5.5.7.3.1"}, usersWrite),
+			template("Unpublished", "1", "0", []string{ekuClientAuth}, usersEnroll),
+		},
+	}
+	cas, templates := Analyze(enum)
+	if len(cas) != 1 || strings.Join(cas[0].Vulns, ",") != "ESC6,ESC7,ESC8" {
+		t.Fatalf("unexpected ca vulns %v", cas[0].Vulns)
+	}
+	if len(cas[0].Managers) != 1 || cas[0].Managers[0] != "Domain Users" {
+		t.Fatalf("unexpected ca managers %v", cas[0].Managers)
+	}
+	expected := map[string]string{
+		"ESC1":        "ESC1",
+		"ESC2":        "ESC2",
+		"ESC3":        "ESC3",
+		"Approval":    "",
+		"AdminsOnly":  "",
+		"Writable":    "ESC4",
+		"Unpublished": "",
+	}
+	for _, template := range templates {
+		if vulns := strings.Join(template.Vulns, ","); vulns != expected[template.Name] {
+			t.Errorf("%s: expected '%s', got '%s'", template.Name, expected[template.Name], vulns)
+		}
+	}
+
+	// Templates are enrollable, and writable by their owner, through the token's groups
+	enum.TokenSIDs = []string{domainAdmins}
+	_, templates = Analyze(enum)
+	for _, template := range templates {
+		if template.Name == "AdminsOnly" && strings.Join(template.Vulns, ",") != "ESC1,ESC4" {
+			t.Errorf("AdminsOnly: expected 'ESC1,ESC4' with the token's groups, got '%v'", template.Vulns)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// AdcsEnumCmd - Enumerate the domain's certificate authorities and templates
+func AdcsEnumCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if !isWindowsTarget(con) {
+		return
+	}
+	vulnerable := ctx.Flags.Bool("vulnerable")
+
+	ctrl := make(chan bool)
+	con.SpinUntil("Enumerating certificate services ...", ctrl)
+	enum, err := con.Rpc.AdcsEnum(context.Background(), &sliverpb.AdcsEnumReq{
+		Server:  ctx.Flags.String("server"),
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if enum.Response != nil && enum.Response.Async {
+		con.AddBeaconCallback(enum.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, enum)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintAdcsEnum(enum, vulnerable, con)
+		})
+		con.PrintAsyncResponse(enum.Response)
+	} else {
+		PrintAdcsEnum(enum, vulnerable, con)
+	}
+}
+
+// PrintAdcsEnum - Print the CAs and the published templates and what they're vulnerable to
+func PrintAdcsEnum(enum *sliverpb.AdcsEnum, vulnerable bool, con *console.SliverConsoleClient) {
+	if enum.Response != nil && enum.Response.Err != "" {
+		con.PrintResponseErr(enum.Response)
+		return
+	}
+	if len(enum.CAs) == 0 {
+		con.PrintInfof("No enrollment services in %s\n", enum.ConfigurationNC)
+		return
+	}
+	cas, templates := Analyze(enum)
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"CA", "Host", "Templates", "Web Enrollment", "Managers", "Vulnerabilities"})
+	for _, ca := range cas {
+		tw.AppendRow(table.Row{
+			ca.Name,
+			ca.Host,
+			len(ca.Templates),
+			ca.WebEnrollmentURL,
+			strings.Join(ca.Managers, ", "),
+			vulns(ca.Vulns),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+	for _, ca := range cas {
+		if ca.RegistryError != "" {
+			con.PrintWarnf("Could not read the registry of %s, ESC6/ESC7 were not checked: %s\n", ca.Name, ca.RegistryError)
+		}
+	}
+	con.Println()
+
+	tw = table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Template", "CAs", "EKUs", "Enrollee Subject", "Approval", "Enrollment Rights", "Vulnerabilities"})
+	for _, template := range templates {
+		if len(template.CAs) == 0 || (vulnerable && len(template.Vulns) == 0) {
+			continue
+		}
+		ekus := []string{}
+		for _, eku := range template.EKUs {
+			ekus = append(ekus, ekuName(eku))
+		}
+		if len(ekus) == 0 {
+			ekus = append(ekus, "(none)")
+		}
+		approval := ""
+		if template.ManagerApproval {
+			approval = "manager"
+		}
+		if 0 < template.RASignatures {
+			approval = strings.TrimPrefix(fmt.Sprintf("%s, %d signatures", approval, template.RASignatures), ", ")
+		}
+		tw.AppendRow(table.Row{
+			template.Name,
+			strings.Join(template.CAs, "\n"),
+			strings.Join(ekus, "\n"),
+			template.EnrolleeSuppliesSubject,
+			approval,
+			strings.Join(template.Enrollers, "\n"),
+			vulns(template.Vulns),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+	for _, template := range templates {
+		if len(template.CAs) == 0 && 0 < len(template.Vulns) {
+			con.PrintInfof("%s is not published by a CA but is vulnerable to %s\n", template.Name, strings.Join(template.Vulns, ", "))
+		}
+	}
+}
+
+func vulns(found []string) string {
+	if len(found) == 0 {
+		return ""
+	}
+	return console.Bold + console.Red + strings.Join(found, ", ") + console.Normal
+}
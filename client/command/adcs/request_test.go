@@ -0,0 +1,91 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestCreateCSR(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM, err := createCSR(key, "administrator", "administrator@corp.local", "dc01.corp.local")
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(csrPEM)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		t.Fatal("csr is not pem encoded")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		t.Fatalf("invalid csr signature: %s", err)
+	}
+	if csr.Subject.CommonName != "administrator" {
+		t.Fatalf("unexpected subject %s", csr.Subject)
+	}
+	if len(csr.DNSNames) != 1 || csr.DNSNames[0] != "dc01.corp.local" {
+		t.Fatalf("unexpected dns names %v", csr.DNSNames)
+	}
+
+	// The CA copies the requested names into the certificate
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(1),
+		Subject:         pkix.Name{CommonName: "administrator"},
+		NotBefore:       time.Now(),
+		NotAfter:        time.Now().Add(time.Hour),
+		ExtraExtensions: csr.Extensions,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	upns := certificateUPNs(cert)
+	if len(upns) != 1 || upns[0] != "administrator@corp.local" {
+		t.Fatalf("unexpected upns %v", upns)
+	}
+}
+
+func TestSANAttributes(t *testing.T) {
+	if attributes := sanAttributes("", ""); attributes != "" {
+		t.Fatalf("expected no attributes, got %s", attributes)
+	}
+	if attributes := sanAttributes("administrator@corp.local", "dc01.corp.local"); attributes != "SAN:upn=administrator@corp.local&dns=dc01.corp.local" {
+		t.Fatalf("unexpected attributes %s", attributes)
+	}
+	if name := commonName("", "", `CORP\alice`); name != "alice" {
+		t.Fatalf("unexpected common name %s", name)
+	}
+}
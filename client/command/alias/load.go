@@ -29,6 +29,7 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/extensions"
 	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
 	consts "github.com/bishopfox/sliver/client/constants"
@@ -89,6 +90,7 @@ type AliasManifest struct {
 	Files        []*AliasFile `json:"files"`
 	IsReflective bool         `json:"is_reflective"`
 	IsAssembly   bool         `json:"is_assembly"`
+	Parser       string       `json:"parser"`
 
 	RootPath string `json:"-"`
 }
@@ -179,8 +181,9 @@ func LoadAlias(manifestPath string, con *console.SliverConsoleClient) (*AliasMan
 				f.String("a", "arch", "x84", "Assembly target architecture: x86, x64, x84 (x86+x64)")
 				f.Bool("i", "in-process", false, "Run in the current sliver process")
 				f.String("r", "runtime", "", "Runtime to use for running the assembly (only supported when used with --in-process)")
-				f.Bool("M", "amsi-bypass", false, "Bypass AMSI on Windows (only supported when used with --in-process)")
-				f.Bool("E", "etw-bypass", false, "Bypass ETW on Windows (only supported when used with --in-process)")
+				f.Bool("M", "amsi-bypass", false, "Bypass AMSI on Windows")
+				f.Bool("E", "etw-bypass", false, "Bypass ETW on Windows")
+				f.StringL("bypass-technique", "", "amsi and etw bypass technique (patch, hwbp), hwbp requires --in-process")
 
 			}
 			f.String("p", "process", "", "Path to process to host the shared object")
@@ -250,12 +253,15 @@ func runAliasCommand(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 	var goos string
 	var goarch string
+	var hostUUID string
 	if session != nil {
 		goos = session.OS
 		goarch = session.Arch
+		hostUUID = session.UUID
 	} else {
 		goos = beacon.OS
 		goarch = beacon.Arch
+		hostUUID = beacon.UUID
 	}
 
 	loadedAlias, ok := loadedAliases[ctx.Command.Name]
@@ -291,13 +297,11 @@ func runAliasCommand(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		if aliasManifest.IsAssembly {
 			inProcess := ctx.Flags.Bool("in-process")
 			runtime := ctx.Flags.String("runtime")
-			amsiBypass := ctx.Flags.Bool("amsi-bypass")
-			etwBypass := ctx.Flags.Bool("etw-bypass")
 			if !inProcess {
 				msgStr = " Arguments are limited to 256 characters when using the default fork/exec model for .NET assemblies.\nConsider using the --in-process flag to execute .NET assemblies in-process and work around this limitation.\n"
 			}
-			if !inProcess && (runtime != "" || etwBypass || amsiBypass) {
-				con.PrintErrorf("The --runtime, --etw-bypass, and --amsi-bypass flags can only be used with the --in-process flag\n")
+			if !inProcess && runtime != "" {
+				con.PrintErrorf("The --runtime flag can only be used with the --in-process flag\n")
 				return
 			}
 		} else if !aliasManifest.IsReflective {
@@ -361,6 +365,8 @@ func runAliasCommand(ctx *grumble.Context, con *console.SliverConsoleClient) {
 			Runtime:     ctx.Flags.String("runtime"),
 			AmsiBypass:  ctx.Flags.Bool("amsi-bypass"),
 			EtwBypass:   ctx.Flags.Bool("etw-bypass"),
+
+			BypassTechnique: strings.ToLower(ctx.Flags.String("bypass-technique")),
 		})
 		ctrl <- true
 		<-ctrl
@@ -377,10 +383,12 @@ func runAliasCommand(ctx *grumble.Context, con *console.SliverConsoleClient) {
 					return
 				}
 				PrintAssemblyOutput(ctx.Command.Name, executeAssemblyResp, outFilePath, con)
+				extensions.PostProcessOutput(aliasManifest.Parser, ctx.Command.Name, hostUUID, executeAssemblyResp.GetOutput(), con)
 			})
 			con.PrintAsyncResponse(executeAssemblyResp.Response)
 		} else {
 			PrintAssemblyOutput(ctx.Command.Name, executeAssemblyResp, outFilePath, con)
+			extensions.PostProcessOutput(aliasManifest.Parser, ctx.Command.Name, hostUUID, executeAssemblyResp.GetOutput(), con)
 		}
 
 	} else if aliasManifest.IsReflective {
@@ -414,10 +422,12 @@ func runAliasCommand(ctx *grumble.Context, con *console.SliverConsoleClient) {
 					return
 				}
 				PrintSpawnDLLOutput(ctx.Command.Name, spawnDllResp, outFilePath, con)
+				extensions.PostProcessOutput(aliasManifest.Parser, ctx.Command.Name, hostUUID, []byte(spawnDllResp.GetResult()), con)
 			})
 			con.PrintAsyncResponse(spawnDllResp.Response)
 		} else {
 			PrintSpawnDLLOutput(ctx.Command.Name, spawnDllResp, outFilePath, con)
+			extensions.PostProcessOutput(aliasManifest.Parser, ctx.Command.Name, hostUUID, []byte(spawnDllResp.GetResult()), con)
 		}
 
 	} else {
@@ -452,10 +462,12 @@ func runAliasCommand(ctx *grumble.Context, con *console.SliverConsoleClient) {
 					return
 				}
 				PrintSideloadOutput(ctx.Command.Name, sideloadResp, outFilePath, con)
+				extensions.PostProcessOutput(aliasManifest.Parser, ctx.Command.Name, hostUUID, []byte(sideloadResp.GetResult()), con)
 			})
 			con.PrintAsyncResponse(sideloadResp.Response)
 		} else {
 			PrintSideloadOutput(ctx.Command.Name, sideloadResp, outFilePath, con)
+			extensions.PostProcessOutput(aliasManifest.Parser, ctx.Command.Name, hostUUID, []byte(sideloadResp.GetResult()), con)
 		}
 	}
 }
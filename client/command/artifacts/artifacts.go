@@ -0,0 +1,154 @@
+package artifacts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// ArtifactsCmd - Display the saved shellcode artifacts
+func ArtifactsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	artifacts, err := con.Rpc.ShellcodeArtifacts(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(artifacts.Artifacts) == 0 {
+		con.PrintInfof("No shellcode artifacts\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Assembly",
+		"Arch",
+		"Arguments",
+		"Size",
+		"SHA256",
+		"Operator",
+		"Created",
+	})
+	for _, artifact := range artifacts.Artifacts {
+		assembly := artifact.FileName
+		if artifact.IsDLL {
+			assembly += " (dll)"
+		}
+		tw.AppendRow(table.Row{
+			artifact.Name,
+			assembly,
+			artifact.Arch,
+			artifact.Arguments,
+			util.ByteCountBinary(int64(artifact.Size)),
+			artifact.SHA256[:16],
+			artifact.Operator,
+			time.Unix(artifact.CreatedAt, 0).Format(time.RFC1123),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// ArtifactsConvertCmd - Convert a local .NET assembly to a shellcode artifact on the server
+func ArtifactsConvertCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	assemblyPath := ctx.Args.String("assembly")
+	assembly, err := os.ReadFile(assemblyPath)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	name := ctx.Flags.String("name")
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(assemblyPath), filepath.Ext(assemblyPath))
+	}
+	arch := strings.ToLower(ctx.Flags.String("arch"))
+	switch arch {
+	case "x64", "x86_64":
+		arch = "amd64"
+	case "x86", "i386":
+		arch = "386"
+	}
+	if arch != "amd64" && arch != "386" {
+		con.PrintErrorf("Shellcode artifacts are only supported for 386 and amd64\n")
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil("Converting assembly ...", ctrl)
+	artifact, err := con.Rpc.ShellcodeArtifactConvert(context.Background(), &clientpb.ShellcodeArtifactReq{
+		Name:      name,
+		FileName:  filepath.Base(assemblyPath),
+		Assembly:  assembly,
+		Arch:      arch,
+		Arguments: strings.Join(ctx.Args.StringList("arguments"), " "),
+		ClassName: ctx.Flags.String("class"),
+		Method:    ctx.Flags.String("method"),
+		AppDomain: ctx.Flags.String("app-domain"),
+		Runtime:   ctx.Flags.String("runtime"),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved shellcode artifact '%s' (%s, %s)\n", artifact.Name, artifact.Arch, util.ByteCountBinary(int64(artifact.Size)))
+}
+
+// ArtifactsSaveCmd - Write an artifact's shellcode to a local file
+func ArtifactsSaveCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	name := ctx.Args.String("name")
+	artifact, err := con.Rpc.ShellcodeArtifactContent(context.Background(), &clientpb.ShellcodeArtifact{Name: name})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	save := ctx.Args.String("file")
+	if save == "" {
+		save = artifact.Name + ".bin"
+	}
+	err = os.WriteFile(save, artifact.Data, 0600)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Wrote shellcode artifact '%s' to %s\n", artifact.Name, save)
+}
+
+// ArtifactsRmCmd - Remove a shellcode artifact
+func ArtifactsRmCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	name := ctx.Args.String("name")
+	_, err := con.Rpc.ShellcodeArtifactRm(context.Background(), &clientpb.ShellcodeArtifact{Name: name})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed shellcode artifact '%s'\n", name)
+}
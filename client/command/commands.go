@@ -37,14 +37,19 @@ import (
 	"os"
 
 	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/adcs"
 	"github.com/bishopfox/sliver/client/command/alias"
+	"github.com/bishopfox/sliver/client/command/artifacts"
 	"github.com/bishopfox/sliver/client/command/armory"
 	"github.com/bishopfox/sliver/client/command/backdoor"
 	"github.com/bishopfox/sliver/client/command/beacons"
+	"github.com/bishopfox/sliver/client/command/c2profiles"
+	"github.com/bishopfox/sliver/client/command/clipboard"
 	"github.com/bishopfox/sliver/client/command/builders"
 	"github.com/bishopfox/sliver/client/command/completers"
 	"github.com/bishopfox/sliver/client/command/cursed"
 	"github.com/bishopfox/sliver/client/command/dllhijack"
+	"github.com/bishopfox/sliver/client/command/engagement"
 	"github.com/bishopfox/sliver/client/command/environment"
 	"github.com/bishopfox/sliver/client/command/exec"
 	"github.com/bishopfox/sliver/client/command/extensions"
@@ -56,9 +61,13 @@ import (
 	"github.com/bishopfox/sliver/client/command/jobs"
 	"github.com/bishopfox/sliver/client/command/kill"
 	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/command/macos"
 	"github.com/bishopfox/sliver/client/command/monitor"
 	"github.com/bishopfox/sliver/client/command/network"
+	"github.com/bishopfox/sliver/client/command/ntlm"
 	"github.com/bishopfox/sliver/client/command/operators"
+	"github.com/bishopfox/sliver/client/command/persistence"
+	"github.com/bishopfox/sliver/client/command/playbooks"
 	"github.com/bishopfox/sliver/client/command/pivots"
 	"github.com/bishopfox/sliver/client/command/portfwd"
 	operator "github.com/bishopfox/sliver/client/command/prelude-operator"
@@ -69,7 +78,9 @@ import (
 	"github.com/bishopfox/sliver/client/command/registry"
 	"github.com/bishopfox/sliver/client/command/rportfwd"
 	"github.com/bishopfox/sliver/client/command/screenshot"
+	"github.com/bishopfox/sliver/client/command/servers"
 	"github.com/bishopfox/sliver/client/command/sessions"
+	"github.com/bishopfox/sliver/client/command/services"
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/command/shell"
 	sgn "github.com/bishopfox/sliver/client/command/shikata-ga-nai"
@@ -333,7 +344,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 
 	// [ Jobs ] -----------------------------------------------------------------
 
-	con.App.AddCommand(&grumble.Command{
+	jobsCmd := &grumble.Command{
 		Name:     consts.JobsStr,
 		Help:     "Job control",
 		LongHelp: help.GetHelpFor([]string{consts.JobsStr}),
@@ -350,7 +361,34 @@ func BindCommands(con *console.SliverConsoleClient) {
 			return nil
 		},
 		HelpGroup: consts.GenericHelpGroup,
+	}
+	jobsCmd.AddCommand(&grumble.Command{
+		Name:     consts.SettingsStr,
+		Help:     "Show or change a listener's settings without restarting it",
+		LongHelp: help.GetHelpFor([]string{consts.JobsStr, consts.SettingsStr}),
+		Args: func(a *grumble.Args) {
+			a.Int("id", "job id")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("T", "long-poll-timeout", "", "server-side long poll timeout (http/https)")
+			f.String("J", "long-poll-jitter", "", "server-side long poll jitter (http/https)")
+			f.StringL("c2profile", "", "http c2 profile name, see c2profiles (http/https)")
+			f.Bool("E", "enforce-otp", false, "enforce otp authentication")
+			f.Bool("D", "disable-otp", false, "disable otp authentication")
+			f.UintL("max-nop-padding", 0, "largest padding a nop response may carry, 0 for the default (dns)")
+			f.UintL("ttl", 0, "ttl of answers (dns)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			jobs.JobsSettingsCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
 	})
+	con.App.AddCommand(jobsCmd)
 
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.MtlsStr,
@@ -404,6 +442,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("L", "lhost", "", "interface to bind server to")
 			f.Int("l", "lport", generate.DefaultDNSLPort, "udp listen port")
 			f.Bool("D", "disable-otp", false, "disable otp authentication")
+			f.String("E", "txt-encoding", "", "txt response encoding (base64, base85, raw) for all domains, or domain=encoding,...")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 			f.Bool("p", "persistent", false, "make persistent across restarts")
@@ -417,6 +456,45 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.GenericHelpGroup,
 	})
 
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.IcmpStr,
+		Help:     "Start an ICMP listener",
+		LongHelp: help.GetHelpFor([]string{consts.IcmpStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("L", "lhost", "", "interface to bind server to")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.Bool("p", "persistent", false, "make persistent across restarts")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			jobs.ICMPListenerCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.UdpStr,
+		Help:     "Start a UDP listener",
+		LongHelp: help.GetHelpFor([]string{consts.UdpStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("L", "lhost", "", "interface to bind server to")
+			f.Int("l", "lport", generate.DefaultUDPLPort, "udp listen port")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.Bool("p", "persistent", false, "make persistent across restarts")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			jobs.UDPListenerCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.HttpStr,
 		Help:     "Start an HTTP listener",
@@ -429,6 +507,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Bool("D", "disable-otp", false, "disable otp authentication")
 			f.String("T", "long-poll-timeout", "1s", "server-side long poll timeout")
 			f.String("J", "long-poll-jitter", "2s", "server-side long poll jitter")
+			f.StringL("c2profile", "", "http c2 profile name (see c2profiles)")
+			f.StringL("front-domain", "", "domain implants front through (e.g. a cdn)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 			f.Bool("p", "persistent", false, "make persistent across restarts")
@@ -454,11 +534,14 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Bool("D", "disable-otp", false, "disable otp authentication")
 			f.String("T", "long-poll-timeout", "1s", "server-side long poll timeout")
 			f.String("J", "long-poll-jitter", "2s", "server-side long poll jitter")
+			f.StringL("c2profile", "", "http c2 profile name (see c2profiles)")
+			f.StringL("front-domain", "", "domain implants front through (e.g. a cdn)")
 
 			f.String("c", "cert", "", "PEM encoded certificate file")
 			f.String("k", "key", "", "PEM encoded private key file")
 			f.Bool("e", "lets-encrypt", false, "attempt to provision a let's encrypt certificate")
 			f.Bool("E", "disable-randomized-jarm", false, "disable randomized jarm fingerprints")
+			f.BoolL("http2", false, "negotiate http/2 with implants that support it")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 			f.Bool("p", "persistent", false, "make persistent across restarts")
@@ -486,6 +569,10 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.StringL("aes-encrypt-iv", "", "encrypt stage with AES encryption iv")
 			f.String("C", "compress", "none", "compress the stage before encrypting (zlib, gzip, deflate9, none)")
 			f.Bool("P", "prepend-size", false, "prepend the size of the stage to the payload (to use with MSF stagers)")
+			f.StringL("xor-key", "", "xor the stage with this key after compressing it")
+			f.StringL("stages", "", "profiles served to stagers by fingerprint, profile[:os=..+arch=..+version=..+av=..] (comma separated)")
+			f.StringL("artifact", "", "serve a shellcode artifact as the default stage instead of a profile")
+			f.IntL("chunk-size", 0, "largest chunk a stage is sent in to stagers that fingerprint, 0 for no limit")
 		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
@@ -496,6 +583,46 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.GenericHelpGroup,
 	})
 
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.ShellUpgradeStr,
+		Help:     "Upgrade plain reverse or bind tcp shells to implants",
+		LongHelp: help.GetHelpFor([]string{consts.ShellUpgradeStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("p", "profile", "", "implant profile names to upgrade shells with (comma separated, one per platform)")
+			f.String("L", "lhost", "", "interface to bind the listener to")
+			f.Int("l", "lport", 4444, "listener tcp port")
+			f.String("c", "connect", "", "connect to a bind shell at host:port instead of starting a listener")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			jobs.ShellUpgradeCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.WarmupStr,
+		Help:     "Send warmup traffic to engagement infrastructure",
+		LongHelp: help.GetHelpFor([]string{consts.WarmupStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("u", "url", "", "decoy site urls to request (comma separated)")
+			f.String("d", "dns", "", "dns names to look up (comma separated)")
+			f.String("r", "resolver", "", "resolver to send dns lookups to (host:port), defaults to the system resolver")
+			f.Uint("R", "rate", 20, "average number of requests per hour")
+			f.String("D", "duration", "", "stop after this long (e.g. 72h), runs until killed by default")
+			f.String("H", "hours", "", "only send traffic during these hours (server time, e.g. 8-18)")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			jobs.WarmupCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+
 	// [ Operators ] --------------------------------------------------------------
 
 	con.App.AddCommand(&grumble.Command{
@@ -514,6 +641,82 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.MultiplayerHelpGroup,
 	})
 
+	// [ Servers ] ----------------------------------------------------------------
+
+	serversCmd := &grumble.Command{
+		Name:     consts.ServersStr,
+		Help:     "List connected team servers",
+		LongHelp: help.GetHelpFor([]string{consts.ServersStr}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			servers.ServersCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.MultiplayerHelpGroup,
+	}
+	con.App.AddCommand(serversCmd)
+
+	serversCmd.AddCommand(&grumble.Command{
+		Name:     consts.ConnectStr,
+		Help:     "Connect to another team server",
+		LongHelp: help.GetHelpFor([]string{consts.ServersStr, consts.ConnectStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Bool("b", "background", false, "stay on the active server after connecting")
+		},
+		Args: func(a *grumble.Args) {
+			a.String("config", "path to an operator config, select an imported config if not set", grumble.Default(""))
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			servers.ServersConnectCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return completers.LocalPathCompleter(prefix, args, con)
+		},
+		HelpGroup: consts.MultiplayerHelpGroup,
+	})
+
+	serversCmd.AddCommand(&grumble.Command{
+		Name:     consts.UseStr,
+		Help:     "Switch the team server commands are sent to",
+		LongHelp: help.GetHelpFor([]string{consts.ServersStr, consts.UseStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "name of the server")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			servers.ServersUseCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return servers.ServerNameCompleter(prefix, args, con)
+		},
+		HelpGroup: consts.MultiplayerHelpGroup,
+	})
+
+	serversCmd.AddCommand(&grumble.Command{
+		Name:     consts.DisconnectStr,
+		Help:     "Disconnect from a team server",
+		LongHelp: help.GetHelpFor([]string{consts.ServersStr, consts.DisconnectStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "name of the server")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			servers.ServersDisconnectCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return servers.ServerNameCompleter(prefix, args, con)
+		},
+		HelpGroup: consts.MultiplayerHelpGroup,
+	})
+
 	// [ Reconfig ] ---------------------------------------------------------------
 
 	con.App.AddCommand(&grumble.Command{
@@ -524,6 +727,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("r", "reconnect-interval", "", "reconnect interval for implant")
 			f.String("i", "beacon-interval", "", "beacon callback interval")
 			f.String("j", "beacon-jitter", "", "beacon callback jitter (random up to)")
+			f.StringL("working-hours", "", "only connect during working hours, 'none' to remove them")
+			f.StringL("kill-date", "", "remove the implant after this date, 'none' to remove it")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -536,6 +741,67 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
+	proxyCmd := &grumble.Command{
+		Name:     consts.ProxyStr,
+		Help:     "Show or override the proxy used by the active beacon/session",
+		LongHelp: help.GetHelpFor([]string{consts.ProxyStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			reconfig.ProxyCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	}
+	proxyCmd.AddCommand(&grumble.Command{
+		Name:     consts.SetStr,
+		Help:     "Override the proxy used by http(s) c2, the implant reconnects right away",
+		LongHelp: help.GetHelpFor([]string{consts.ProxyStr, consts.SetStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("u", "url", "", "proxy url (e.g. http://10.0.0.1:8080)")
+			f.String("p", "pac", "", "url of a pac file to get the proxy from (windows only)")
+			f.Bool("d", "direct", false, "connect directly, don't use a proxy")
+			f.Bool("a", "auto", false, "use the system's proxy settings")
+			f.Bool("c", "clear", false, "clear the override, go back to the settings from generate time")
+			f.String("U", "username", "", "proxy username")
+			f.String("P", "password", "", "proxy password")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			reconfig.ProxySetCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+	con.App.AddCommand(proxyCmd)
+
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.RedirectStr,
+		Help:     "Point the active beacon/session at other c2s, e.g. a server its beacons were imported to",
+		LongHelp: help.GetHelpFor([]string{consts.RedirectStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Bool("c", "clear", false, "clear the redirect, go back to the c2s from generate time")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Args: func(a *grumble.Args) {
+			a.StringList("c2s", "c2 urls to redirect to", grumble.Default([]string{}))
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			reconfig.RedirectCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.RenameStr,
 		Help:     "Rename the active beacon/session",
@@ -615,7 +881,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.GenericHelpGroup,
 	})
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.KillStr,
 		Help:     "Kill a session",
 		LongHelp: help.GetHelpFor([]string{consts.KillStr}),
@@ -631,7 +897,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 		HelpGroup: consts.SliverHelpGroup,
-	})
+	}, con))
 
 	openSessionCmd := &grumble.Command{
 		Name:     consts.InteractiveStr,
@@ -642,10 +908,14 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("g", "wg", "", "wg connection strings")
 			f.String("b", "http", "", "http(s) connection strings")
 			f.String("n", "dns", "", "dns connection strings")
+			f.StringL("icmp", "", "icmp connection strings")
+			f.StringL("udp", "", "udp connection strings")
 			f.String("p", "named-pipe", "", "namedpipe connection strings")
 			f.String("i", "tcp-pivot", "", "tcppivot connection strings")
 
 			f.String("d", "delay", "0s", "delay opening the session (after checkin) for a given period of time")
+			f.StringL("duration", "", "close the session after a given period of time (e.g. 10m)")
+			f.StringL("interval", "", "check in at this interval for the --duration instead of opening a session (e.g. 1s)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -722,7 +992,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 	})
 	tasksCmd.AddCommand(&grumble.Command{
 		Name:     consts.CancelStr,
-		Help:     "Cancel a pending beacon task",
+		Help:     "Cancel a pending or running beacon task",
 		LongHelp: help.GetHelpFor([]string{consts.TasksStr, consts.CancelStr}),
 		Flags: func(f *grumble.Flags) {
 			f.Bool("O", "overflow", false, "overflow terminal width (display truncated rows)")
@@ -742,6 +1012,27 @@ func BindCommands(con *console.SliverConsoleClient) {
 		},
 		HelpGroup: consts.GenericHelpGroup,
 	})
+	tasksCmd.AddCommand(&grumble.Command{
+		Name:     consts.ReorderStr,
+		Help:     "Move a pending beacon task within the queue",
+		LongHelp: help.GetHelpFor([]string{consts.TasksStr, consts.ReorderStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("p", "position", 1, "position in the queue, 1 is sent first")
+			f.Bool("l", "last", false, "move the task to the end of the queue")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Args: func(a *grumble.Args) {
+			a.String("id", "beacon task ID", grumble.Default(""))
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			tasks.TasksReorderCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
 	con.App.AddCommand(tasksCmd)
 
 	// [ Use ] --------------------------------------------------------------
@@ -877,6 +1168,18 @@ func BindCommands(con *console.SliverConsoleClient) {
 		},
 		HelpGroup: consts.GenericHelpGroup,
 	})
+	settingsCmd.AddCommand(&grumble.Command{
+		Name:     "mask-secrets",
+		Help:     "Mask hashes, keys, and passwords in output (toggle to reveal)",
+		LongHelp: help.GetHelpFor([]string{consts.SettingsStr, "mask-secrets"}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			settings.SettingsMaskSecrets(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
 	settingsCmd.AddCommand(&grumble.Command{
 		Name:     "small-terminal",
 		Help:     "Set the small terminal width",
@@ -891,6 +1194,25 @@ func BindCommands(con *console.SliverConsoleClient) {
 	})
 	con.App.AddCommand(settingsCmd)
 
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.OpsecStr,
+		Help:     "List the OPSEC considerations of commands",
+		LongHelp: help.GetHelpFor([]string{consts.OpsecStr}),
+		Args: func(a *grumble.Args) {
+			a.String("command", "command name", grumble.Default(""))
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			settings.OpsecCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return help.OpsecCommands()
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+
 	// [ Info ] --------------------------------------------------------------
 
 	con.App.AddCommand(&grumble.Command{
@@ -931,6 +1253,42 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.PresenceStr,
+		Help:     "Report user idle time, locked workstation, and remote sessions",
+		LongHelp: help.GetHelpFor([]string{consts.PresenceStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("c", "checkin", "", "report presence with each beacon check-in (on/off)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			info.PresenceCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+
+	con.App.AddCommand(dryRun(&grumble.Command{
+		Name:     consts.SurveyStr,
+		Help:     "Survey the host's os, patches, software, admins, network and interesting paths",
+		LongHelp: help.GetHelpFor([]string{consts.SurveyStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Bool("s", "software", false, "list the installed software")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			info.SurveyCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	}, con))
+
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.GetPIDStr,
 		Help:     "Get session pid",
@@ -1045,7 +1403,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 
 	// [ Exec ] --------------------------------------------------------------
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.ExecuteStr,
 		Help:     "Execute a program on the remote system",
 		LongHelp: help.GetHelpFor([]string{consts.ExecuteStr}),
@@ -1059,6 +1417,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("E", "stderr", "", "remote path to redirect STDERR to")
 			f.String("n", "name", "", "name to assign loot (optional)")
 			f.Uint("P", "ppid", 0, "parent process id (optional, Windows only)")
+			f.Bool("b", "blockdlls", false, "block non-microsoft dlls in the new process (optional, Windows only)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -1073,9 +1432,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			return nil
 		},
 		HelpGroup: consts.SliverHelpGroup,
-	})
+	}, con))
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.ExecuteAssemblyStr,
 		Help:     "Loads and executes a .NET assembly in a child process (Windows Only)",
 		LongHelp: help.GetHelpFor([]string{consts.ExecuteAssemblyStr}),
@@ -1096,8 +1455,12 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("n", "name", "", "name to assign loot (optional)")
 			f.Uint("P", "ppid", 0, "parent process id (optional)")
 			f.String("A", "process-arguments", "", "arguments to pass to the hosting process")
-			f.Bool("M", "amsi-bypass", false, "Bypass AMSI on Windows (only supported when used with --in-process)")
-			f.Bool("E", "etw-bypass", false, "Bypass ETW on Windows (only supported when used with --in-process)")
+			f.Bool("M", "amsi-bypass", false, "Bypass AMSI on Windows")
+			f.Bool("E", "etw-bypass", false, "Bypass ETW on Windows")
+			f.StringL("bypass-technique", "", "amsi and etw bypass technique (patch, hwbp), hwbp requires --in-process")
+			f.Bool("b", "blockdlls", false, "block non-microsoft dlls in the hosting process")
+			f.StringL("injection", "", "process injection technique (crt, apc, hijack, stomp)")
+			f.StringL("stomp-module", "", "dll to stomp with --injection stomp (default xpsservices.dll)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -1108,9 +1471,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			return nil
 		},
 		HelpGroup: consts.SliverWinHelpGroup,
-	})
+	}, con))
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.ExecuteShellcodeStr,
 		Help:     "Executes the given shellcode in the sliver process",
 		LongHelp: help.GetHelpFor([]string{consts.ExecuteShellcodeStr}),
@@ -1121,9 +1484,10 @@ func BindCommands(con *console.SliverConsoleClient) {
 			return nil
 		},
 		Args: func(a *grumble.Args) {
-			a.String("filepath", "path the shellcode file")
+			a.String("filepath", "path the shellcode file", grumble.Default(""))
 		},
 		Flags: func(f *grumble.Flags) {
+			f.StringL("artifact", "", "execute a shellcode artifact saved on the server instead of a file")
 			f.Bool("r", "rwx-pages", false, "Use RWX permissions for memory pages")
 			f.Uint("p", "pid", 0, "Pid of process to inject into (0 means injection into ourselves)")
 			f.String("n", "process", `c:\windows\system32\notepad.exe`, "Process to inject into when running in interactive mode")
@@ -1131,13 +1495,15 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Bool("S", "shikata-ga-nai", false, "encode shellcode using shikata ga nai prior to execution")
 			f.String("A", "architecture", "amd64", "architecture of the shellcode: 386, amd64 (used with --shikata-ga-nai flag)")
 			f.Int("I", "iterations", 1, "number of encoding iterations (used with --shikata-ga-nai flag)")
+			f.StringL("injection", "", "process injection technique (crt, apc, hijack, stomp)")
+			f.StringL("stomp-module", "", "dll to stomp with --injection stomp (default xpsservices.dll)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 		HelpGroup: consts.SliverHelpGroup,
-	})
+	}, con))
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.SideloadStr,
 		Help:     "Load and execute a shared object (shared library/DLL) in a remote process",
 		LongHelp: help.GetHelpFor([]string{consts.SideloadStr}),
@@ -1151,6 +1517,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Bool("k", "keep-alive", false, "don't terminate host process once the execution completes")
 			f.Uint("P", "ppid", 0, "parent process id (optional)")
 			f.String("A", "process-arguments", "", "arguments to pass to the hosting process")
+			f.StringL("injection", "", "process injection technique (crt, apc, hijack, stomp)")
+			f.StringL("stomp-module", "", "dll to stomp with --injection stomp (default xpsservices.dll)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -1165,9 +1533,41 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
-	})
+	}, con))
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
+		Name:     consts.InlineExecuteStr,
+		Help:     "Execute an unmanaged PE (Windows) or ELF (Linux) from memory",
+		LongHelp: help.GetHelpFor([]string{consts.InlineExecuteStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Bool("i", "in-process", false, "run in the implant process (Windows only)")
+			f.String("p", "process", "", "path to the sacrificial process (windows, default notepad.exe), or argv[0] (linux)")
+			f.String("A", "process-arguments", "", "arguments to pass to the sacrificial process")
+			f.Uint("P", "ppid", 0, "parent process id (optional)")
+			f.Bool("w", "unicode", false, "command line is passed to the PE in UNICODE format (default is ANSI)")
+			f.Int("E", "exec-timeout", 0, "terminate the execution after this many seconds (0 to wait)")
+			f.Bool("s", "save", false, "save output to file")
+			f.Bool("X", "loot", false, "save output as loot")
+			f.String("n", "name", "", "name to assign loot (optional)")
+			f.StringL("injection", "", "process injection technique (crt, apc, hijack, stomp)")
+			f.StringL("stomp-module", "", "dll to stomp with --injection stomp (default xpsservices.dll)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Args: func(a *grumble.Args) {
+			a.String("filepath", "path to the executable")
+			a.StringList("args", "arguments for the executable", grumble.Default([]string{}))
+		},
+		HelpGroup: consts.SliverHelpGroup,
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			exec.InlineExecuteCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+	}, con))
+
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.SpawnDllStr,
 		Help:     "Load and execute a Reflective DLL in a remote process",
 		LongHelp: help.GetHelpFor([]string{consts.SpawnDllStr}),
@@ -1181,6 +1581,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Bool("k", "keep-alive", false, "don't terminate host process once the execution completes")
 			f.Uint("P", "ppid", 0, "parent process id (optional)")
 			f.String("A", "process-arguments", "", "arguments to pass to the hosting process")
+			f.StringL("injection", "", "process injection technique (crt, apc, hijack, stomp)")
+			f.StringL("stomp-module", "", "dll to stomp with --injection stomp (default xpsservices.dll)")
 		},
 		Args: func(a *grumble.Args) {
 			a.String("filepath", "path the DLL file")
@@ -1193,9 +1595,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
-	})
+	}, con))
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.MigrateStr,
 		Help:     "Migrate into a remote process",
 		LongHelp: help.GetHelpFor([]string{consts.MigrateStr}),
@@ -1209,10 +1611,15 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Bool("S", "disable-sgn", true, "disable shikata ga nai shellcode encoder")
 			f.Uint("p", "pid", 0, "process id to migrate into")
 			f.String("n", "process-name", "", "name of the process to migrate into")
+			f.StringL("injection", "", "process injection technique (crt, apc, hijack, stomp)")
+			f.StringL("stomp-module", "", "dll to stomp with --injection stomp (default xpsservices.dll)")
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
+		Completer: func(prefix string, args []string) []string {
+			return processes.MigrateCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.SliverWinHelpGroup,
-	})
+	}, con))
 
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.MsfStr,
@@ -1259,7 +1666,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.PsExecStr,
 		Help:     "Start a sliver service on a remote target",
 		LongHelp: help.GetHelpFor([]string{consts.PsExecStr}),
@@ -1281,7 +1688,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			a.String("hostname", "hostname")
 		},
 		HelpGroup: consts.SliverWinHelpGroup,
-	})
+	}, con))
 
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.SSHStr,
@@ -1311,12 +1718,59 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
-	// [ Generate ] --------------------------------------------------------------
-
-	generateCmd := &grumble.Command{
-		Name:     consts.GenerateStr,
-		Help:     "Generate an implant binary",
-		LongHelp: help.GetHelpFor([]string{consts.GenerateStr}),
+	con.App.AddCommand(dryRun(&grumble.Command{
+		Name:     consts.SSHHarvestStr,
+		Help:     "Enumerate ssh keys, known hosts and agent identities (Linux/macOS)",
+		LongHelp: help.GetHelpFor([]string{consts.SSHHarvestStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("u", "user", "", "only harvest this user (default: every readable user)")
+			f.Bool("X", "loot", false, "fetch the private key files and save them as loot")
+			f.Bool("A", "skip-agents", false, "don't connect to the agent sockets")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			exec.SSHHarvestCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	}, con))
+
+	con.App.AddCommand(dryRun(&grumble.Command{
+		Name:     consts.ValidateCredsStr,
+		Help:     "Test user/password loot against network services",
+		LongHelp: help.GetHelpFor([]string{consts.ValidateCredsStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("T", "target", "", "host to test the credentials against (i.e. a domain controller)")
+			f.String("p", "protocols", "smb", "comma separated protocols: smb, ldap, ldaps, winrm, winrms, kerberos")
+			f.String("d", "domain", "", "domain of users without one, required for kerberos")
+			f.String("c", "loot-ids", "", "comma separated credential loot ids (default: all user/password loot)")
+			f.String("S", "spray", "", "spray this password instead of testing the loot")
+			f.String("U", "users", "", "file of users to spray, one per line (default: the users in the loot)")
+			f.Int("D", "delay", 1000, "milliseconds between attempts")
+			f.Int("j", "jitter", 500, "random milliseconds added to the delay")
+			f.Uint("l", "lockout-threshold", 0, "failed attempts that lock an account out, attempts stop one short of it")
+			f.Int("w", "lockout-window", 30*60, "seconds of recorded failures counted against the threshold")
+
+			f.Int("t", "timeout", 10*60, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			loot.ValidateCredsCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	}, con))
+
+	// [ Generate ] --------------------------------------------------------------
+
+	generateCmd := &grumble.Command{
+		Name:     consts.GenerateStr,
+		Help:     "Generate an implant binary",
+		LongHelp: help.GetHelpFor([]string{consts.GenerateStr}),
 		Flags: func(f *grumble.Flags) {
 			f.String("o", "os", "windows", "operating system")
 			f.String("a", "arch", "amd64", "cpu architecture")
@@ -1325,6 +1779,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("O", "debug-file", "", "path to debug output")
 			f.Bool("e", "evasion", false, "enable evasion features (e.g. overwrite user space hooks)")
 			f.Bool("l", "skip-symbols", false, "skip symbol obfuscation")
+			f.BoolL("skip-literals", false, "skip literal obfuscation (faster builds, smaller binaries)")
+			f.BoolL("skip-tiny", false, "keep panic messages and runtime position info")
+			f.StringL("seed", "", "base64 obfuscation seed, reuse a build's seed to get the same obfuscated names")
 			f.String("I", "template", "sliver", "implant code template")
 			f.Bool("E", "external-builder", false, "use an external builder")
 			f.Bool("G", "disable-sgn", false, "disable shikata ga nai shellcode encoder")
@@ -1335,6 +1792,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("g", "wg", "", "wg connection strings")
 			f.String("b", "http", "", "http(s) connection strings")
 			f.String("n", "dns", "", "dns connection strings")
+			f.StringL("icmp", "", "icmp connection strings")
+			f.StringL("udp", "", "udp connection strings")
 			f.String("p", "named-pipe", "", "named-pipe connection strings")
 			f.String("i", "tcp-pivot", "", "tcp-pivot connection strings")
 
@@ -1348,15 +1807,55 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("P", "poll-timeout", generate.DefaultPollTimeout, "long poll request timeout")
 			f.Int("k", "max-errors", generate.DefaultMaxErrors, "max number of connection errors")
 
+			f.StringL("connect-backoff", generate.DefaultConnectBackoff, "registration backoff curve (constant, linear, exponential)")
+			f.IntL("connect-backoff-max", 0, "max registration backoff in seconds (0 = no limit)")
+			f.IntL("connect-jitter", 0, "random registration backoff jitter in seconds")
+			f.IntL("connect-attempts", 0, "max registration attempts before giving up (0 = no limit)")
+			f.IntL("connect-give-up", 0, "give up and self-delete after n hour(s) without registering (0 = never)")
+			f.StringL("connect-fallback", "", "protocol order used until registration (e.g. mtls,https,dns)")
+			f.StringL("failover", "", "failover chain protocol order, overrides the connection strategy (e.g. mtls,https,dns)")
+			f.IntL("failover-threshold", 3, "sequential failures before moving on to the next c2 in the failover chain")
+			f.IntL("failover-retry", 30*60, "seconds between retries of the preferred c2 when using a fallback c2")
+			f.StringL("dns-allow-resolvers", "", "dns c2 only uses these resolver ips/cidrs (comma separated)")
+			f.StringL("dns-deny-resolvers", "", "dns c2 never uses these resolver ips/cidrs (comma separated)")
+			f.IntL("indicator-threshold", 0, "fail the build if more tell-tale strings remain in the binary (0 = no limit)")
+			f.BoolL("watchdog", false, "pair the implant with a watchdog process that restarts it if killed (executables only)")
+			f.IntL("watchdog-delay", 30, "seconds the watchdog waits before restarting the implant")
+			f.BoolL("startup-snapshot", false, "include an environment snapshot (domain, interfaces, proxy, users, processes) in the registration")
+			f.StringL("injection", "", "default process injection technique (crt, apc, hijack, stomp)")
+			f.BoolL("amsi-bypass", false, "always bypass AMSI before loading the CLR or a BOF (windows only)")
+			f.BoolL("etw-bypass", false, "always bypass ETW before loading the CLR or a BOF (windows only)")
+			f.StringL("bypass-technique", "", "default amsi and etw bypass technique (patch, hwbp)")
+			f.StringL("syscalls", "", "make the nt syscalls for memory, threads and processes instead of calling kernel32 (direct, indirect), windows/amd64 only")
+			f.StringL("shellcode-loader", "", "convert shellcode with donut or as a dll with srdi (donut, srdi)")
+			f.StringL("srdi-function", "", "implant export srdi calls once the dll is loaded (StartW, VoidFunc, DllInstall, DllRegisterServer, DllUnregisterServer)")
+			f.BoolL("srdi-keep-header", false, "don't erase the dll's pe header once srdi loaded it")
+			f.BoolL("srdi-obfuscate-imports", false, "load the dll's imports in a random order")
+			f.IntL("srdi-import-delay", 0, "seconds to wait between each obfuscated import")
+			f.IntL("srdi-hash-key", 0, "rotation (1-31) of the loader's api and function hashes (0 = srdi's default 13)")
+			f.StringL("shellcode-prepend", "", "path to shellcode run before the implant's shellcode")
+			f.StringL("shellcode-append", "", "path to shellcode placed after the implant's shellcode")
+			f.StringL("proxy", "", "path to a dll whose exports are forwarded by the implant dll, for sideloading (windows dll format only)")
+			f.StringL("proxy-target", "", "path or module the proxied exports are forwarded to (default C:\\Windows\\System32\\<proxied dll>)")
+			f.BoolL("harness", false, "build a debug harness that runs the implant's handlers locally from a console, without a server")
+			f.StringL("c2profile", "", "http(s) c2 profile name (see c2profiles)")
+
 			f.String("w", "limit-datetime", "", "limit execution to before datetime")
 			f.Bool("x", "limit-domainjoined", false, "limit execution to domain joined machines")
 			f.String("y", "limit-username", "", "limit execution to specified username")
 			f.String("z", "limit-hostname", "", "limit execution to specified hostname")
 			f.String("F", "limit-fileexists", "", "limit execution to hosts with this file in the filesystem")
 			f.String("L", "limit-locale", "", "limit execution to hosts that match this locale")
-
-			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' (for dynamic libraries), 'service' (see `psexec` for more info) and 'shellcode' (windows only)")
+			f.StringL("key-domain", "", "key the c2s to the target's domain, the implant is inert on other hosts")
+			f.StringL("key-hostname", "", "only execute on hosts whose hostname matches this regex")
+			f.StringL("key-cidr", "", "key the c2s to an interface address in this cidr")
+			f.StringL("key-file", "", "key the c2s to the presence of this file (full path)")
+			f.StringL("working-hours", "", "only connect during working hours in the target's local time (e.g. 'Mon-Fri 08:00-18:00')")
+			f.StringL("kill-date", "", "remove the implant after this date (RFC3339 or YYYY-MM-DD)")
+
+			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' or 'dll' (for dynamic libraries), 'service' (windows service, see `psexec` for more info) and 'shellcode' (windows only)")
 			f.String("s", "save", "", "directory/file to the binary to")
+			f.StringL("batch", "", "generate an implant for each target in a yaml manifest")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -1378,6 +1877,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int64("M", "minutes", 0, "beacon interval minutes")
 			f.Int64("S", "seconds", 60, "beacon interval seconds")
 			f.Int64("J", "jitter", 30, "beacon interval jitter in seconds")
+			f.BoolL("quiet-checkin", false, "pad idle check-ins to a fixed size")
+			f.StringL("sleep-mask", "", "encrypt the beacon's memory while it sleeps (timer, ekko), windows only")
 
 			// Generate flags
 			f.String("o", "os", "windows", "operating system")
@@ -1387,6 +1888,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("O", "debug-file", "", "path to debug output")
 			f.Bool("e", "evasion", false, "enable evasion features  (e.g. overwrite user space hooks)")
 			f.Bool("l", "skip-symbols", false, "skip symbol obfuscation")
+			f.BoolL("skip-literals", false, "skip literal obfuscation (faster builds, smaller binaries)")
+			f.BoolL("skip-tiny", false, "keep panic messages and runtime position info")
+			f.StringL("seed", "", "base64 obfuscation seed, reuse a build's seed to get the same obfuscated names")
 			f.String("I", "template", "sliver", "implant code template")
 			f.Bool("E", "external-builder", false, "use an external builder")
 			f.Bool("G", "disable-sgn", false, "disable shikata ga nai shellcode encoder")
@@ -1397,6 +1901,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("g", "wg", "", "wg connection strings")
 			f.String("b", "http", "", "http(s) connection strings")
 			f.String("n", "dns", "", "dns connection strings")
+			f.StringL("icmp", "", "icmp connection strings")
+			f.StringL("udp", "", "udp connection strings")
 			f.String("p", "named-pipe", "", "named-pipe connection strings")
 			f.String("i", "tcp-pivot", "", "tcp-pivot connection strings")
 
@@ -1410,15 +1916,54 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("P", "poll-timeout", generate.DefaultPollTimeout, "long poll request timeout")
 			f.Int("k", "max-errors", generate.DefaultMaxErrors, "max number of connection errors")
 
+			f.StringL("connect-backoff", generate.DefaultConnectBackoff, "registration backoff curve (constant, linear, exponential)")
+			f.IntL("connect-backoff-max", 0, "max registration backoff in seconds (0 = no limit)")
+			f.IntL("connect-jitter", 0, "random registration backoff jitter in seconds")
+			f.IntL("connect-attempts", 0, "max registration attempts before giving up (0 = no limit)")
+			f.IntL("connect-give-up", 0, "give up and self-delete after n hour(s) without registering (0 = never)")
+			f.StringL("connect-fallback", "", "protocol order used until registration (e.g. mtls,https,dns)")
+			f.StringL("failover", "", "failover chain protocol order, overrides the connection strategy (e.g. mtls,https,dns)")
+			f.IntL("failover-threshold", 3, "sequential failures before moving on to the next c2 in the failover chain")
+			f.IntL("failover-retry", 30*60, "seconds between retries of the preferred c2 when using a fallback c2")
+			f.StringL("dns-allow-resolvers", "", "dns c2 only uses these resolver ips/cidrs (comma separated)")
+			f.StringL("dns-deny-resolvers", "", "dns c2 never uses these resolver ips/cidrs (comma separated)")
+			f.IntL("indicator-threshold", 0, "fail the build if more tell-tale strings remain in the binary (0 = no limit)")
+			f.BoolL("watchdog", false, "pair the implant with a watchdog process that restarts it if killed (executables only)")
+			f.IntL("watchdog-delay", 30, "seconds the watchdog waits before restarting the implant")
+			f.BoolL("startup-snapshot", false, "include an environment snapshot (domain, interfaces, proxy, users, processes) in the registration")
+			f.StringL("injection", "", "default process injection technique (crt, apc, hijack, stomp)")
+			f.BoolL("amsi-bypass", false, "always bypass AMSI before loading the CLR or a BOF (windows only)")
+			f.BoolL("etw-bypass", false, "always bypass ETW before loading the CLR or a BOF (windows only)")
+			f.StringL("bypass-technique", "", "default amsi and etw bypass technique (patch, hwbp)")
+			f.StringL("syscalls", "", "make the nt syscalls for memory, threads and processes instead of calling kernel32 (direct, indirect), windows/amd64 only")
+			f.StringL("shellcode-loader", "", "convert shellcode with donut or as a dll with srdi (donut, srdi)")
+			f.StringL("srdi-function", "", "implant export srdi calls once the dll is loaded (StartW, VoidFunc, DllInstall, DllRegisterServer, DllUnregisterServer)")
+			f.BoolL("srdi-keep-header", false, "don't erase the dll's pe header once srdi loaded it")
+			f.BoolL("srdi-obfuscate-imports", false, "load the dll's imports in a random order")
+			f.IntL("srdi-import-delay", 0, "seconds to wait between each obfuscated import")
+			f.IntL("srdi-hash-key", 0, "rotation (1-31) of the loader's api and function hashes (0 = srdi's default 13)")
+			f.StringL("shellcode-prepend", "", "path to shellcode run before the implant's shellcode")
+			f.StringL("shellcode-append", "", "path to shellcode placed after the implant's shellcode")
+			f.StringL("proxy", "", "path to a dll whose exports are forwarded by the implant dll, for sideloading (windows dll format only)")
+			f.StringL("proxy-target", "", "path or module the proxied exports are forwarded to (default C:\\Windows\\System32\\<proxied dll>)")
+			f.StringL("c2profile", "", "http(s) c2 profile name (see c2profiles)")
+
 			f.String("w", "limit-datetime", "", "limit execution to before datetime")
 			f.Bool("x", "limit-domainjoined", false, "limit execution to domain joined machines")
 			f.String("y", "limit-username", "", "limit execution to specified username")
 			f.String("z", "limit-hostname", "", "limit execution to specified hostname")
 			f.String("F", "limit-fileexists", "", "limit execution to hosts with this file in the filesystem")
 			f.String("L", "limit-locale", "", "limit execution to hosts that match this locale")
-
-			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' (for dynamic libraries), 'service' (see `psexec` for more info) and 'shellcode' (windows only)")
+			f.StringL("key-domain", "", "key the c2s to the target's domain, the implant is inert on other hosts")
+			f.StringL("key-hostname", "", "only execute on hosts whose hostname matches this regex")
+			f.StringL("key-cidr", "", "key the c2s to an interface address in this cidr")
+			f.StringL("key-file", "", "key the c2s to the presence of this file (full path)")
+			f.StringL("working-hours", "", "only connect during working hours in the target's local time (e.g. 'Mon-Fri 08:00-18:00')")
+			f.StringL("kill-date", "", "remove the implant after this date (RFC3339 or YYYY-MM-DD)")
+
+			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' or 'dll' (for dynamic libraries), 'service' (windows service, see `psexec` for more info) and 'shellcode' (windows only)")
 			f.String("s", "save", "", "directory/file to the binary to")
+			f.StringL("batch", "", "generate an implant for each target in a yaml manifest")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -1544,6 +2089,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("O", "debug-file", "", "path to debug output")
 			f.Bool("e", "evasion", false, "enable evasion features")
 			f.Bool("l", "skip-symbols", false, "skip symbol obfuscation")
+			f.BoolL("skip-literals", false, "skip literal obfuscation (faster builds, smaller binaries)")
+			f.BoolL("skip-tiny", false, "keep panic messages and runtime position info")
+			f.StringL("seed", "", "base64 obfuscation seed, reuse a build's seed to get the same obfuscated names")
 			f.Bool("G", "disable-sgn", false, "disable shikata ga nai shellcode encoder")
 
 			f.String("c", "canary", "", "canary domain(s)")
@@ -1553,6 +2101,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("g", "wg", "", "wg connection strings")
 			f.String("b", "http", "", "http(s) connection strings")
 			f.String("n", "dns", "", "dns connection strings")
+			f.StringL("icmp", "", "icmp connection strings")
+			f.StringL("udp", "", "udp connection strings")
 			f.String("p", "named-pipe", "", "named-pipe connection strings")
 			f.String("i", "tcp-pivot", "", "tcp-pivot connection strings")
 
@@ -1568,14 +2118,52 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("P", "poll-timeout", generate.DefaultPollTimeout, "long poll request timeout")
 			f.Int("k", "max-errors", generate.DefaultMaxErrors, "max number of connection errors")
 
+			f.StringL("connect-backoff", generate.DefaultConnectBackoff, "registration backoff curve (constant, linear, exponential)")
+			f.IntL("connect-backoff-max", 0, "max registration backoff in seconds (0 = no limit)")
+			f.IntL("connect-jitter", 0, "random registration backoff jitter in seconds")
+			f.IntL("connect-attempts", 0, "max registration attempts before giving up (0 = no limit)")
+			f.IntL("connect-give-up", 0, "give up and self-delete after n hour(s) without registering (0 = never)")
+			f.StringL("connect-fallback", "", "protocol order used until registration (e.g. mtls,https,dns)")
+			f.StringL("failover", "", "failover chain protocol order, overrides the connection strategy (e.g. mtls,https,dns)")
+			f.IntL("failover-threshold", 3, "sequential failures before moving on to the next c2 in the failover chain")
+			f.IntL("failover-retry", 30*60, "seconds between retries of the preferred c2 when using a fallback c2")
+			f.StringL("dns-allow-resolvers", "", "dns c2 only uses these resolver ips/cidrs (comma separated)")
+			f.StringL("dns-deny-resolvers", "", "dns c2 never uses these resolver ips/cidrs (comma separated)")
+			f.IntL("indicator-threshold", 0, "fail the build if more tell-tale strings remain in the binary (0 = no limit)")
+			f.BoolL("watchdog", false, "pair the implant with a watchdog process that restarts it if killed (executables only)")
+			f.IntL("watchdog-delay", 30, "seconds the watchdog waits before restarting the implant")
+			f.BoolL("startup-snapshot", false, "include an environment snapshot (domain, interfaces, proxy, users, processes) in the registration")
+			f.StringL("injection", "", "default process injection technique (crt, apc, hijack, stomp)")
+			f.BoolL("amsi-bypass", false, "always bypass AMSI before loading the CLR or a BOF (windows only)")
+			f.BoolL("etw-bypass", false, "always bypass ETW before loading the CLR or a BOF (windows only)")
+			f.StringL("bypass-technique", "", "default amsi and etw bypass technique (patch, hwbp)")
+			f.StringL("syscalls", "", "make the nt syscalls for memory, threads and processes instead of calling kernel32 (direct, indirect), windows/amd64 only")
+			f.StringL("shellcode-loader", "", "convert shellcode with donut or as a dll with srdi (donut, srdi)")
+			f.StringL("srdi-function", "", "implant export srdi calls once the dll is loaded (StartW, VoidFunc, DllInstall, DllRegisterServer, DllUnregisterServer)")
+			f.BoolL("srdi-keep-header", false, "don't erase the dll's pe header once srdi loaded it")
+			f.BoolL("srdi-obfuscate-imports", false, "load the dll's imports in a random order")
+			f.IntL("srdi-import-delay", 0, "seconds to wait between each obfuscated import")
+			f.IntL("srdi-hash-key", 0, "rotation (1-31) of the loader's api and function hashes (0 = srdi's default 13)")
+			f.StringL("shellcode-prepend", "", "path to shellcode run before the implant's shellcode")
+			f.StringL("shellcode-append", "", "path to shellcode placed after the implant's shellcode")
+			f.StringL("proxy", "", "path to a dll whose exports are forwarded by the implant dll, for sideloading (windows dll format only)")
+			f.StringL("proxy-target", "", "path or module the proxied exports are forwarded to (default C:\\Windows\\System32\\<proxied dll>)")
+			f.StringL("c2profile", "", "http(s) c2 profile name (see c2profiles)")
+
 			f.String("w", "limit-datetime", "", "limit execution to before datetime")
 			f.Bool("x", "limit-domainjoined", false, "limit execution to domain joined machines")
 			f.String("y", "limit-username", "", "limit execution to specified username")
 			f.String("z", "limit-hostname", "", "limit execution to specified hostname")
 			f.String("F", "limit-fileexists", "", "limit execution to hosts with this file in the filesystem")
 			f.String("L", "limit-locale", "", "limit execution to hosts that match this locale")
+			f.StringL("key-domain", "", "key the c2s to the target's domain, the implant is inert on other hosts")
+			f.StringL("key-hostname", "", "only execute on hosts whose hostname matches this regex")
+			f.StringL("key-cidr", "", "key the c2s to an interface address in this cidr")
+			f.StringL("key-file", "", "key the c2s to the presence of this file (full path)")
+			f.StringL("working-hours", "", "only connect during working hours in the target's local time (e.g. 'Mon-Fri 08:00-18:00')")
+			f.StringL("kill-date", "", "remove the implant after this date (RFC3339 or YYYY-MM-DD)")
 
-			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' (for dynamic libraries), 'service' (see `psexec` for more info) and 'shellcode' (windows only)")
+			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' or 'dll' (for dynamic libraries), 'service' (windows service, see `psexec` for more info) and 'shellcode' (windows only)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -1603,6 +2191,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int64("M", "minutes", 0, "beacon interval minutes")
 			f.Int64("S", "seconds", 60, "beacon interval seconds")
 			f.Int64("J", "jitter", 30, "beacon interval jitter in seconds")
+			f.BoolL("quiet-checkin", false, "pad idle check-ins to a fixed size")
+			f.StringL("sleep-mask", "", "encrypt the beacon's memory while it sleeps (timer, ekko), windows only")
 			f.Bool("G", "disable-sgn", false, "disable shikata ga nai shellcode encoder")
 
 			// Generate flags
@@ -1613,6 +2203,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("O", "debug-file", "", "path to debug output")
 			f.Bool("e", "evasion", false, "enable evasion features")
 			f.Bool("l", "skip-symbols", false, "skip symbol obfuscation")
+			f.BoolL("skip-literals", false, "skip literal obfuscation (faster builds, smaller binaries)")
+			f.BoolL("skip-tiny", false, "keep panic messages and runtime position info")
+			f.StringL("seed", "", "base64 obfuscation seed, reuse a build's seed to get the same obfuscated names")
 
 			f.String("c", "canary", "", "canary domain(s)")
 
@@ -1621,6 +2214,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("g", "wg", "", "wg connection strings")
 			f.String("b", "http", "", "http(s) connection strings")
 			f.String("n", "dns", "", "dns connection strings")
+			f.StringL("icmp", "", "icmp connection strings")
+			f.StringL("udp", "", "udp connection strings")
 			f.String("p", "named-pipe", "", "named-pipe connection strings")
 			f.String("i", "tcp-pivot", "", "tcp-pivot connection strings")
 			f.String("Z", "strategy", "", "specify a connection strategy (r = random, rd = random domain, s = sequential)")
@@ -1636,14 +2231,52 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("P", "poll-timeout", generate.DefaultPollTimeout, "long poll request timeout")
 			f.Int("k", "max-errors", generate.DefaultMaxErrors, "max number of connection errors")
 
+			f.StringL("connect-backoff", generate.DefaultConnectBackoff, "registration backoff curve (constant, linear, exponential)")
+			f.IntL("connect-backoff-max", 0, "max registration backoff in seconds (0 = no limit)")
+			f.IntL("connect-jitter", 0, "random registration backoff jitter in seconds")
+			f.IntL("connect-attempts", 0, "max registration attempts before giving up (0 = no limit)")
+			f.IntL("connect-give-up", 0, "give up and self-delete after n hour(s) without registering (0 = never)")
+			f.StringL("connect-fallback", "", "protocol order used until registration (e.g. mtls,https,dns)")
+			f.StringL("failover", "", "failover chain protocol order, overrides the connection strategy (e.g. mtls,https,dns)")
+			f.IntL("failover-threshold", 3, "sequential failures before moving on to the next c2 in the failover chain")
+			f.IntL("failover-retry", 30*60, "seconds between retries of the preferred c2 when using a fallback c2")
+			f.StringL("dns-allow-resolvers", "", "dns c2 only uses these resolver ips/cidrs (comma separated)")
+			f.StringL("dns-deny-resolvers", "", "dns c2 never uses these resolver ips/cidrs (comma separated)")
+			f.IntL("indicator-threshold", 0, "fail the build if more tell-tale strings remain in the binary (0 = no limit)")
+			f.BoolL("watchdog", false, "pair the implant with a watchdog process that restarts it if killed (executables only)")
+			f.IntL("watchdog-delay", 30, "seconds the watchdog waits before restarting the implant")
+			f.BoolL("startup-snapshot", false, "include an environment snapshot (domain, interfaces, proxy, users, processes) in the registration")
+			f.StringL("injection", "", "default process injection technique (crt, apc, hijack, stomp)")
+			f.BoolL("amsi-bypass", false, "always bypass AMSI before loading the CLR or a BOF (windows only)")
+			f.BoolL("etw-bypass", false, "always bypass ETW before loading the CLR or a BOF (windows only)")
+			f.StringL("bypass-technique", "", "default amsi and etw bypass technique (patch, hwbp)")
+			f.StringL("syscalls", "", "make the nt syscalls for memory, threads and processes instead of calling kernel32 (direct, indirect), windows/amd64 only")
+			f.StringL("shellcode-loader", "", "convert shellcode with donut or as a dll with srdi (donut, srdi)")
+			f.StringL("srdi-function", "", "implant export srdi calls once the dll is loaded (StartW, VoidFunc, DllInstall, DllRegisterServer, DllUnregisterServer)")
+			f.BoolL("srdi-keep-header", false, "don't erase the dll's pe header once srdi loaded it")
+			f.BoolL("srdi-obfuscate-imports", false, "load the dll's imports in a random order")
+			f.IntL("srdi-import-delay", 0, "seconds to wait between each obfuscated import")
+			f.IntL("srdi-hash-key", 0, "rotation (1-31) of the loader's api and function hashes (0 = srdi's default 13)")
+			f.StringL("shellcode-prepend", "", "path to shellcode run before the implant's shellcode")
+			f.StringL("shellcode-append", "", "path to shellcode placed after the implant's shellcode")
+			f.StringL("proxy", "", "path to a dll whose exports are forwarded by the implant dll, for sideloading (windows dll format only)")
+			f.StringL("proxy-target", "", "path or module the proxied exports are forwarded to (default C:\\Windows\\System32\\<proxied dll>)")
+			f.StringL("c2profile", "", "http(s) c2 profile name (see c2profiles)")
+
 			f.String("w", "limit-datetime", "", "limit execution to before datetime")
 			f.Bool("x", "limit-domainjoined", false, "limit execution to domain joined machines")
 			f.String("y", "limit-username", "", "limit execution to specified username")
 			f.String("z", "limit-hostname", "", "limit execution to specified hostname")
 			f.String("F", "limit-fileexists", "", "limit execution to hosts with this file in the filesystem")
 			f.String("L", "limit-locale", "", "limit execution to hosts that match this locale")
+			f.StringL("key-domain", "", "key the c2s to the target's domain, the implant is inert on other hosts")
+			f.StringL("key-hostname", "", "only execute on hosts whose hostname matches this regex")
+			f.StringL("key-cidr", "", "key the c2s to an interface address in this cidr")
+			f.StringL("key-file", "", "key the c2s to the presence of this file (full path)")
+			f.StringL("working-hours", "", "only connect during working hours in the target's local time (e.g. 'Mon-Fri 08:00-18:00')")
+			f.StringL("kill-date", "", "remove the implant after this date (RFC3339 or YYYY-MM-DD)")
 
-			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' (for dynamic libraries), 'service' (see `psexec` for more info) and 'shellcode' (windows only)")
+			f.String("f", "format", "exe", "Specifies the output formats, valid values are: 'exe', 'shared' or 'dll' (for dynamic libraries), 'service' (windows service, see `psexec` for more info) and 'shellcode' (windows only)")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -1784,10 +2417,13 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return filesystem.RemotePathCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.RmStr,
 		Help:     "Remove a file or directory",
 		LongHelp: help.GetHelpFor([]string{consts.RmStr}),
@@ -1806,8 +2442,11 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return filesystem.RemotePathCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.SliverHelpGroup,
-	})
+	}, con))
 
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.MkdirStr,
@@ -1844,6 +2483,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return filesystem.RemotePathCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
@@ -1885,6 +2527,47 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return filesystem.RemotePathCompleter(prefix, args, con)
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.SearchStr,
+		Help:     "Search for files by name, size, time, and contents",
+		LongHelp: help.GetHelpFor([]string{consts.SearchStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("n", "name", "", "glob matched against file names (e.g. *.kdbx)")
+			f.String("r", "regex", "", "regular expression matched against file names")
+			f.String("c", "content", "", "regular expression matched against file contents")
+			f.Bool("i", "ignore-case", false, "case insensitive name and content matching")
+			f.String("s", "min-size", "", "smallest file size (e.g. 10K)")
+			f.String("S", "max-size", "", "largest file size (e.g. 5M)")
+			f.String("N", "newer", "", "modified after a date or within a duration (e.g. 2023-01-31, 24h)")
+			f.String("O", "older", "", "modified before a date or longer ago than a duration")
+			f.Uint("d", "depth", 0, "max directory depth (default no limit)")
+			f.String("e", "exclude", "", "comma separated file and directory names to skip (globs)")
+			f.Uint("m", "max-results", 0, "max matches (default 1000)")
+			f.Uint("l", "lines", 0, "max matching lines shown per file (default 5)")
+			f.String("F", "max-file-size", "", "largest file whose contents are searched (default 10M)")
+			f.Bool("b", "binary", false, "also search the contents of binary files")
+			f.Bool("D", "dirs", false, "also match directory names")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Args: func(a *grumble.Args) {
+			a.String("path", "directory to search", grumble.Default("."))
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			filesystem.SearchCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return filesystem.RemotePathCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
@@ -1900,6 +2583,11 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("F", "file-type", "", "force a specific file type (binary/text) if looting")
 			f.String("n", "name", "", "name to assign the download if looting")
 			f.Bool("r", "recurse", false, "recursively download all files in a directory")
+
+			f.Bool("C", "chunked", false, "download in resumable chunks")
+			f.String("c", "chunk-size", "auto", "size of each chunk (e.g. 512K, 4M)")
+			f.String("z", "compress", "auto", "chunk compression (auto, gzip, none)")
+			f.String("w", "wait", "5m", "how long to wait for a lost session to reconnect")
 		},
 		Args: func(a *grumble.Args) {
 			a.String("remote-path", "path to the file or directory to download")
@@ -1911,6 +2599,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return filesystem.DownloadCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
@@ -1922,6 +2613,11 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 
 			f.Bool("i", "ioc", false, "track uploaded file as an ioc")
+
+			f.Bool("C", "chunked", false, "upload in resumable chunks")
+			f.String("c", "chunk-size", "auto", "size of each chunk (e.g. 512K, 4M)")
+			f.String("z", "compress", "auto", "chunk compression (auto, gzip, none)")
+			f.String("w", "wait", "5m", "how long to wait for a lost session to reconnect")
 		},
 		Args: func(a *grumble.Args) {
 			a.String("local-path", "local path to the file to upload")
@@ -2004,7 +2700,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.ProcdumpStr,
 		Help:     "Dump process memory",
 		LongHelp: help.GetHelpFor([]string{consts.ProcdumpStr}),
@@ -2024,9 +2720,30 @@ func BindCommands(con *console.SliverConsoleClient) {
 			return nil
 		},
 		HelpGroup: consts.SliverHelpGroup,
-	})
+	}, con))
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
+		Name:     consts.LsassDumpStr,
+		Help:     "Dump lsass memory straight into the loot store (Windows Only)",
+		LongHelp: help.GetHelpFor([]string{consts.LsassDumpStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("T", "technique", "duplicate", "how the lsass handle is acquired (duplicate, open)")
+			f.Bool("c", "clone", false, "dump a pss snapshot of lsass instead of the live process")
+			f.Int("p", "pid", 0, "lsass pid (optional, found automatically)")
+			f.String("N", "loot-name", "", "name to assign to the dump in the loot store (optional)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			processes.LsassDumpCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	}, con))
+
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.TerminateStr,
 		Help:     "Terminate a process on the remote system",
 		LongHelp: help.GetHelpFor([]string{consts.TerminateStr}),
@@ -2045,7 +2762,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 		HelpGroup: consts.SliverHelpGroup,
-	})
+	}, con))
 
 	// [ Privileges ] ---------------------------------------------
 
@@ -2089,6 +2806,9 @@ func BindCommands(con *console.SliverConsoleClient) {
 		Flags: func(f *grumble.Flags) {
 			f.Int("t", "timeout", 30, "command timeout in seconds")
 		},
+		Completer: func(prefix string, args []string) []string {
+			return processes.OwnerCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.SliverWinHelpGroup,
 	})
 
@@ -2108,7 +2828,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverWinHelpGroup,
 	})
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.GetSystemStr,
 		Help:     "Spawns a new sliver session as the NT AUTHORITY\\SYSTEM user (Windows Only)",
 		LongHelp: help.GetHelpFor([]string{consts.GetSystemStr}),
@@ -2123,7 +2843,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			return nil
 		},
 		HelpGroup: consts.SliverWinHelpGroup,
-	})
+	}, con))
 
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.MakeTokenStr,
@@ -2145,6 +2865,43 @@ func BindCommands(con *console.SliverConsoleClient) {
 		},
 	})
 
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.StealTokenStr,
+		Help:     "Impersonate the token of a process",
+		LongHelp: help.GetHelpFor([]string{consts.StealTokenStr}),
+		Args: func(a *grumble.Args) {
+			a.Uint("pid", "pid of the process to steal the token from")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", 30, "command timeout in seconds")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			privilege.StealTokenCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+	})
+
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.TokensStr,
+		Help:     "List, use, or remove the tokens made or stolen by the implant",
+		LongHelp: help.GetHelpFor([]string{consts.TokensStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Uint("u", "use", 0, "impersonate the token with this id")
+			f.Uint("r", "remove", 0, "close the token with this id")
+			f.Int("t", "timeout", 30, "command timeout in seconds")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			privilege.TokensCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+	})
+
 	con.App.AddCommand(&grumble.Command{
 		Name:     consts.ChmodStr,
 		Help:     "Change permissions on a file or directory",
@@ -2356,6 +3113,65 @@ func BindCommands(con *console.SliverConsoleClient) {
 	})
 	con.App.AddCommand(websitesCmd)
 
+	// [ C2 Profiles ] ---------------------------------------------
+
+	c2ProfilesCmd := &grumble.Command{
+		Name:     consts.C2ProfilesStr,
+		Help:     "Manage named HTTP(S) C2 profiles",
+		LongHelp: help.GetHelpFor([]string{consts.C2ProfilesStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			c2profiles.C2ProfilesCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	}
+	c2ProfilesCmd.AddCommand(&grumble.Command{
+		Name:     consts.ImportStr,
+		Help:     "Import a HTTP(S) C2 profile from a json file",
+		LongHelp: help.GetHelpFor([]string{consts.C2ProfilesStr, consts.ImportStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("n", "name", "", "profile name")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Args: func(a *grumble.Args) {
+			a.String("file", "path to the json profile")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			c2profiles.C2ProfileImportCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	c2ProfilesCmd.AddCommand(&grumble.Command{
+		Name:     consts.ExportStr,
+		Help:     "Export a HTTP(S) C2 profile to a json file",
+		LongHelp: help.GetHelpFor([]string{consts.C2ProfilesStr, consts.ExportStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("n", "name", "default", "profile name")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Args: func(a *grumble.Args) {
+			a.String("file", "path to write the json profile to")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			c2profiles.C2ProfileExportCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	con.App.AddCommand(c2ProfilesCmd)
+
 	// [ Screenshot ] ---------------------------------------------
 
 	con.App.AddCommand(&grumble.Command{
@@ -2363,9 +3179,15 @@ func BindCommands(con *console.SliverConsoleClient) {
 		Help:     "Take a screenshot",
 		LongHelp: help.GetHelpFor([]string{consts.ScreenshotStr}),
 		Flags: func(f *grumble.Flags) {
-			f.String("s", "save", "", "save to file (will overwrite if exists)")
+			f.String("s", "save", "", "save to file (will overwrite if exists), a directory with --watch")
 			f.Bool("X", "loot", false, "save output as loot")
 			f.String("n", "name", "", "name to assign loot (optional)")
+			f.Uint("d", "display", 0, "only capture this display (default all displays)")
+			f.Bool("S", "split", false, "save each display as a separate image")
+			f.Bool("l", "list", false, "list the displays instead of taking a screenshot")
+			f.String("f", "format", "png", "image format (png, jpeg)")
+			f.Int("q", "quality", 0, "jpeg quality 1 - 100 (default 75)")
+			f.String("w", "watch", "", "take a screenshot every interval (e.g. 60s) until ctrl-c")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -2378,9 +3200,70 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
+	clipboardCmd := &grumble.Command{
+		Name:     consts.ClipboardStr,
+		Help:     "Read the clipboard",
+		LongHelp: help.GetHelpFor([]string{consts.ClipboardStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Bool("X", "loot", false, "save output as loot")
+			f.String("n", "name", "", "name to assign loot (optional)")
+			f.Uint("m", "max-size", 0, "max bytes to capture (default 1 MiB)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			clipboard.ClipboardCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	}
+	clipboardCmd.AddCommand(&grumble.Command{
+		Name:     consts.GetStr,
+		Help:     "Read the current contents of the clipboard",
+		LongHelp: help.GetHelpFor([]string{consts.ClipboardStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Bool("X", "loot", false, "save output as loot")
+			f.String("n", "name", "", "name to assign loot (optional)")
+			f.Uint("m", "max-size", 0, "max bytes to capture (default 1 MiB)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			clipboard.ClipboardCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+	clipboardCmd.AddCommand(&grumble.Command{
+		Name:     consts.MonitorStr,
+		Help:     "Record changes to the clipboard",
+		LongHelp: help.GetHelpFor([]string{consts.ClipboardStr, consts.MonitorStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("d", "duration", "1m", "how long to monitor the clipboard (e.g. 30s, 10m)")
+			f.String("i", "interval", "1s", "time between polls of the clipboard")
+			f.Uint("m", "max-size", 0, "max bytes to capture per entry (default 1 MiB)")
+			f.BoolL("no-loot", false, "don't save captured entries as loot")
+			f.String("n", "name", "", "name to assign loot (optional)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			clipboard.ClipboardMonitorCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+	con.App.AddCommand(clipboardCmd)
+
 	// [ Backdoor ] ---------------------------------------------
 
-	con.App.AddCommand(&grumble.Command{
+	con.App.AddCommand(dryRun(&grumble.Command{
 		Name:     consts.BackdoorStr,
 		Help:     "Infect a remote file with a sliver shellcode",
 		LongHelp: help.GetHelpFor([]string{consts.BackdoorStr}),
@@ -2398,7 +3281,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			con.Println()
 			return nil
 		},
-	})
+	}, con))
 
 	// [ Beacons ] ---------------------------------------------
 
@@ -2546,6 +3429,91 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.GenericHelpGroup,
 	})
 
+	// [ macOS ] ---------------------------------------------
+
+	macosCmd := &grumble.Command{
+		Name:     consts.MacOSStr,
+		Help:     "macOS post-exploitation",
+		LongHelp: help.GetHelpFor([]string{consts.MacOSStr}),
+		Run: func(ctx *grumble.Context) error {
+			return nil
+		},
+		HelpGroup: consts.SliverMacHelpGroup,
+	}
+	macosCmd.AddCommand(&grumble.Command{
+		Name:     consts.TCCStr,
+		Help:     "Survey the TCC permissions granted on the target",
+		LongHelp: help.GetHelpFor([]string{consts.MacOSStr, consts.TCCStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("s", "service", "", "only show a service (e.g. Camera or kTCCServiceCamera)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			macos.TCCCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverMacHelpGroup,
+	})
+	macosCmd.AddCommand(&grumble.Command{
+		Name:     consts.KeychainStr,
+		Help:     "Enumerate or dump keychain items",
+		LongHelp: help.GetHelpFor([]string{consts.MacOSStr, consts.KeychainStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("k", "keychain", "", "keychain path (default: the user's keychain search list)")
+			f.String("s", "service", "", "only items whose service or server contains this value")
+			f.Bool("d", "dump", false, "dump the secrets of the matching items")
+			f.Bool("X", "loot", false, "save dumped secrets as loot")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			macos.KeychainCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverMacHelpGroup,
+	})
+	macosCmd.AddCommand(&grumble.Command{
+		Name:     consts.LaunchdStr,
+		Help:     "List launchd agents and daemons",
+		LongHelp: help.GetHelpFor([]string{consts.MacOSStr, consts.LaunchdStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Bool("s", "system", false, "include the jobs in /System/Library")
+			f.String("f", "filter", "", "filter jobs by label")
+			f.Bool("O", "overflow", false, "overflow terminal width (display truncated rows)")
+			f.Int("S", "skip-pages", 0, "skip the first n page(s)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			macos.LaunchdCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverMacHelpGroup,
+	})
+	macosCmd.AddCommand(&grumble.Command{
+		Name:     consts.ProfilesStr,
+		Help:     "List installed configuration profiles",
+		LongHelp: help.GetHelpFor([]string{consts.MacOSStr, consts.ProfilesStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			macos.ProfilesCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverMacHelpGroup,
+	})
+	con.App.AddCommand(macosCmd)
+
 	// [ Registry ] ---------------------------------------------
 
 	registryCmd := &grumble.Command{
@@ -2641,7 +3609,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 	registryCmd.AddCommand(&grumble.Command{
 		Name:     consts.RegistryListSubStr,
 		Help:     "List the sub keys under a registry key",
-		LongHelp: help.GetHelpFor([]string{consts.RegistryListSubStr}),
+		LongHelp: help.GetHelpFor([]string{consts.RegistryStr, consts.RegistryListSubStr}),
 		Args: func(a *grumble.Args) {
 			a.String("registry-path", "registry path")
 		},
@@ -2655,6 +3623,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 			f.String("H", "hive", "HKCU", "registry hive")
 			f.String("o", "hostname", "", "remote host to write values to")
+			f.Bool("r", "recursive", false, "list every key below the path")
+			f.Int("d", "depth", 0, "levels to recurse, 0 for all of them")
 		},
 	})
 
@@ -2677,29 +3647,259 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.String("o", "hostname", "", "remote host to write values to")
 		},
 	})
-	con.App.AddCommand(registryCmd)
-
-	// [ Reverse Port Forwarding ] --------------------------------------------------------------
-
-	rportfwdCmd := &grumble.Command{
-		Name:     consts.RportfwdStr,
-		Help:     "reverse port forwardings",
-		LongHelp: help.GetHelpFor([]string{consts.RportfwdStr}),
-		Flags: func(f *grumble.Flags) {
-			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+	registryCmd.AddCommand(&grumble.Command{
+		Name:     consts.RegistryExportStr,
+		Help:     "Export a registry key and everything below it to a .reg file",
+		LongHelp: help.GetHelpFor([]string{consts.RegistryStr, consts.RegistryExportStr}),
+		Args: func(a *grumble.Args) {
+			a.String("registry-path", "registry path, the whole hive if not set", grumble.Default(""))
 		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
-			rportfwd.RportFwdListenersCmd(ctx, con)
+			registry.RegExportCmd(ctx, con)
 			con.Println()
 			return nil
 		},
-		HelpGroup: consts.SliverHelpGroup,
-	}
-	rportfwdCmd.AddCommand(&grumble.Command{
-		Name:     consts.AddStr,
-		Help:     "Add and start reverse port forwarding",
-		LongHelp: help.GetHelpFor([]string{consts.RportfwdStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("H", "hive", "HKCU", "registry hive")
+			f.String("o", "hostname", "", "remote host to export from")
+			f.String("s", "save", "", "also save the .reg file to this local path")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	registryCmd.AddCommand(&grumble.Command{
+		Name:     consts.RegistrySearchStr,
+		Help:     "Search registry keys, value names and value data",
+		LongHelp: help.GetHelpFor([]string{consts.RegistryStr, consts.RegistrySearchStr}),
+		Args: func(a *grumble.Args) {
+			a.String("registry-path", "registry path to search below, the whole hive if not set", grumble.Default(""))
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			registry.RegSearchCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("H", "hive", "HKCU", "registry hive")
+			f.String("o", "hostname", "", "remote host to search")
+			f.String("k", "key", "", "regexp matched against key paths")
+			f.String("v", "value", "", "regexp matched against value names")
+			f.String("d", "data", "", "regexp matched against value data")
+			f.Int("m", "max", 1000, "max number of matching keys, 0 for no limit")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	con.App.AddCommand(registryCmd)
+
+	// [ Services ] ---------------------------------------------
+
+	servicesCmd := &grumble.Command{
+		Name:     consts.ServicesStr,
+		Help:     "List and manage windows services",
+		LongHelp: help.GetHelpFor([]string{consts.ServicesStr}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			services.ServicesCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("o", "hostname", "", "remote host to list services of")
+			f.String("f", "filter", "", "only list services with names, display names, binary paths or accounts containing this")
+			f.Bool("r", "running", false, "only list running services")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	}
+	servicesCmd.AddCommand(&grumble.Command{
+		Name:     consts.StartStr,
+		Help:     "Start an installed service",
+		LongHelp: help.GetHelpFor([]string{consts.ServicesStr, consts.StartStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "name of the service")
+			a.StringList("arguments", "arguments passed to the service", grumble.Default([]string{}))
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			services.ServicesStartCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("o", "hostname", "", "remote host of the service")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	servicesCmd.AddCommand(&grumble.Command{
+		Name:     consts.StopStr,
+		Help:     "Stop a running service",
+		LongHelp: help.GetHelpFor([]string{consts.ServicesStr, consts.StopStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "name of the service")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			services.ServicesStopCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("o", "hostname", "", "remote host of the service")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	servicesCmd.AddCommand(&grumble.Command{
+		Name:     consts.ServicesCreateStr,
+		Help:     "Create a service",
+		LongHelp: help.GetHelpFor([]string{consts.ServicesStr, consts.ServicesCreateStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "name of the service")
+			a.String("bin-path", "binary path of the service, including any arguments")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			services.ServicesCreateCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("o", "hostname", "", "remote host to create the service on")
+			f.String("n", "display-name", "", "display name, defaults to the service's name")
+			f.String("d", "description", "", "description of the service")
+			f.String("s", "start-type", "manual", "auto, delayed-auto, manual or disabled")
+			f.String("a", "account", "", "account the service runs as, defaults to LocalSystem")
+			f.Bool("p", "password", false, "prompt for the account's password")
+			f.Bool("S", "start", false, "start the service once it's created")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	servicesCmd.AddCommand(&grumble.Command{
+		Name:     consts.ServicesDeleteStr,
+		Help:     "Delete a service",
+		LongHelp: help.GetHelpFor([]string{consts.ServicesStr, consts.ServicesDeleteStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "name of the service")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			services.ServicesDeleteCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("o", "hostname", "", "remote host of the service")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	servicesCmd.AddCommand(&grumble.Command{
+		Name:     consts.ServicesConfigStr,
+		Help:     "Show or change the configuration of a service",
+		LongHelp: help.GetHelpFor([]string{consts.ServicesStr, consts.ServicesConfigStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "name of the service")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			services.ServicesConfigCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("o", "hostname", "", "remote host of the service")
+			f.String("b", "bin-path", "", "new binary path, including any arguments")
+			f.String("n", "display-name", "", "new display name")
+			f.String("d", "description", "", "new description")
+			f.String("s", "start-type", "", "new start type: auto, delayed-auto, manual or disabled")
+			f.String("a", "account", "", "new account the service runs as")
+			f.Bool("p", "password", false, "prompt for the account's password")
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	con.App.AddCommand(servicesCmd)
+
+	// [ AD CS ] ---------------------------------------------
+
+	adcsCmd := &grumble.Command{
+		Name:     consts.AdcsStr,
+		Help:     "Active Directory certificate services abuse",
+		LongHelp: help.GetHelpFor([]string{consts.AdcsStr}),
+		Run: func(ctx *grumble.Context) error {
+			return nil
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	}
+	adcsCmd.AddCommand(&grumble.Command{
+		Name:     consts.EnumStr,
+		Help:     "Enumerate certificate authorities and templates and find misconfigurations",
+		LongHelp: help.GetHelpFor([]string{consts.AdcsStr, consts.EnumStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("s", "server", "", "domain controller to query (default: the target's domain)")
+			f.Bool("v", "vulnerable", false, "only show vulnerable templates")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			adcs.AdcsEnumCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	adcsCmd.AddCommand(&grumble.Command{
+		Name:     consts.RequestStr,
+		Help:     "Request a certificate, optionally with alternate subject names",
+		LongHelp: help.GetHelpFor([]string{consts.AdcsStr, consts.RequestStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("c", "ca", "", "dns host name of the CA")
+			f.String("T", "template", "", "certificate template")
+			f.String("u", "upn", "", "user principal name to request as a subject alternative name")
+			f.String("d", "dns", "", "dns name to request as a subject alternative name")
+			f.String("S", "subject", "", "subject common name (default: derived from the upn, dns or target user)")
+			f.Bool("H", "https", false, "request over https")
+			f.String("s", "save", "", "also write the certificate and key to a local file")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			adcs.AdcsRequestCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverWinHelpGroup,
+	})
+	con.App.AddCommand(adcsCmd)
+
+	// [ Reverse Port Forwarding ] --------------------------------------------------------------
+
+	rportfwdCmd := &grumble.Command{
+		Name:     consts.RportfwdStr,
+		Help:     "reverse port forwardings",
+		LongHelp: help.GetHelpFor([]string{consts.RportfwdStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			rportfwd.RportFwdListenersCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	}
+	rportfwdCmd.AddCommand(&grumble.Command{
+		Name:     consts.AddStr,
+		Help:     "Add and start reverse port forwarding",
+		LongHelp: help.GetHelpFor([]string{consts.RportfwdStr}),
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
 			rportfwd.StartRportFwdListenerCmd(ctx, con)
@@ -2710,6 +3910,8 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 			f.String("r", "remote", "", "remote address <ip>:<port> connection is forwarded to")
 			f.String("b", "bind", "", "bind address <ip>:<port> implants listen on")
+			f.Bool("l", "local", false, "connections are forwarded to the remote address from this console instead of the server")
+			f.String("a", "allow", "", "only forward connections from these comma separated ips/cidrs")
 		},
 		HelpGroup: consts.SliverWinHelpGroup,
 	})
@@ -2732,6 +3934,60 @@ func BindCommands(con *console.SliverConsoleClient) {
 
 	con.App.AddCommand(rportfwdCmd)
 
+	// [ NTLM Listeners ] --------------------------------------------------------------
+
+	ntlmListenerCmd := &grumble.Command{
+		Name:     consts.NTLMListenerStr,
+		Help:     "smb listeners capturing ntlm authentication",
+		LongHelp: help.GetHelpFor([]string{consts.NTLMListenerStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			ntlm.NTLMListenersCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	}
+	ntlmListenerCmd.AddCommand(&grumble.Command{
+		Name:     consts.AddStr,
+		Help:     "Start an ntlm listener",
+		LongHelp: help.GetHelpFor([]string{consts.NTLMListenerStr}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			ntlm.StartNTLMListenerCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.String("b", "bind", "445", "bind address <ip>:<port> implants listen on")
+			f.String("r", "relay", "", "relay connections to <ip>:<port> instead of answering them")
+			f.Bool("l", "local", false, "connections are relayed from this console instead of the server")
+			f.String("a", "allow", "", "only accept connections from these comma separated ips/cidrs")
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+	ntlmListenerCmd.AddCommand(&grumble.Command{
+		Name:     consts.RmStr,
+		Help:     "Stop an ntlm listener",
+		LongHelp: help.GetHelpFor([]string{consts.NTLMListenerStr}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			ntlm.StopNTLMListenerCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+			f.Int("i", "id", 0, "id of the listener to stop")
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+	con.App.AddCommand(ntlmListenerCmd)
+
 	// [ Pivots ] --------------------------------------------------------------
 
 	pivotsCmd := &grumble.Command{
@@ -2756,8 +4012,11 @@ func BindCommands(con *console.SliverConsoleClient) {
 		Help:     "Start a named pipe pivot listener",
 		LongHelp: help.GetHelpFor([]string{consts.PivotsStr, consts.NamedPipeStr}),
 		Flags: func(f *grumble.Flags) {
-			f.String("b", "bind", "", "name of the named pipe to bind pivot listener")
+			f.String("b", "bind", "", "name of the named pipe to bind pivot listener (default: random name)")
 			f.Bool("a", "allow-all", false, "allow all users to connect")
+			f.Bool("r", "random-name", false, "pick a random pipe name even if --bind is set")
+			f.String("w", "wordlist", "", "file of pipe names to pick the random name from")
+			f.Bool("k", "key", false, "generate a key that peers must use to connect to this listener")
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 		Run: func(ctx *grumble.Context) error {
@@ -2776,6 +4035,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 		Flags: func(f *grumble.Flags) {
 			f.String("b", "bind", "", "remote interface to bind pivot listener")
 			f.Int("l", "lport", generate.DefaultTCPPivotPort, "tcp pivot listener port")
+			f.String("a", "allow", "", "only allow these comma separated implant builds to connect")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -2806,6 +4066,27 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.SliverHelpGroup,
 	})
 
+	pivotsCmd.AddCommand(&grumble.Command{
+		Name:     consts.AllowStr,
+		Help:     "Manage the implants allowed to connect to a pivot listener",
+		LongHelp: help.GetHelpFor([]string{consts.PivotsStr, consts.AllowStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("i", "id", 0, "id of the pivot listener")
+			f.String("a", "add", "", "allow these comma separated implant builds to connect")
+			f.String("r", "remove", "", "no longer allow these comma separated implant builds to connect")
+			f.Bool("c", "clear", false, "clear the allowlist, any implant can connect")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			pivots.PivotAllowCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.SliverHelpGroup,
+	})
+
 	pivotsCmd.AddCommand(&grumble.Command{
 		Name:     consts.DetailsStr,
 		Help:     "Get details of a pivot listener",
@@ -2825,11 +4106,11 @@ func BindCommands(con *console.SliverConsoleClient) {
 	})
 
 	pivotsCmd.AddCommand(&grumble.Command{
-		Name:     "graph",
-		Help:     "Get details of a pivot listener",
-		LongHelp: help.GetHelpFor([]string{consts.PivotsStr, "graph"}),
+		Name:     consts.GraphStr,
+		Help:     "Show the pivot graph of all sessions",
+		LongHelp: help.GetHelpFor([]string{consts.PivotsStr, consts.GraphStr}),
 		Flags: func(f *grumble.Flags) {
-			f.Int("i", "id", 0, "id of the pivot listener to stop")
+			f.Bool("j", "json", false, "print the graph as json")
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
@@ -2957,6 +4238,21 @@ func BindCommands(con *console.SliverConsoleClient) {
 	})
 	con.App.AddCommand(wgSocksCmd)
 
+	con.App.AddCommand(&grumble.Command{
+		Name:     consts.WgRotateKeysStr,
+		Help:     "Switch the WireGuard session to new keys",
+		LongHelp: help.GetHelpFor([]string{consts.WgRotateKeysStr}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			wireguard.WGRotateKeysCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+	})
+
 	// [ Portfwd ] --------------------------------------------------------------
 
 	portfwdCmd := &grumble.Command{
@@ -3176,12 +4472,18 @@ func BindCommands(con *console.SliverConsoleClient) {
 		Flags: func(f *grumble.Flags) {
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
+		Args: func(a *grumble.Args) {
+			a.String("id", "id of the loot (optional)", grumble.Default(""))
+		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
 			loot.LootRenameCmd(ctx, con)
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return loot.LootIDCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.GenericHelpGroup,
 	})
 	lootCmd.AddCommand(&grumble.Command{
@@ -3194,12 +4496,18 @@ func BindCommands(con *console.SliverConsoleClient) {
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
+		Args: func(a *grumble.Args) {
+			a.String("id", "id of the loot (optional)", grumble.Default(""))
+		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
 			loot.LootFetchCmd(ctx, con)
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return loot.LootIDCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.GenericHelpGroup,
 	})
 	lootCmd.AddCommand(&grumble.Command{
@@ -3211,12 +4519,18 @@ func BindCommands(con *console.SliverConsoleClient) {
 
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
+		Args: func(a *grumble.Args) {
+			a.String("id", "id of the loot (optional)", grumble.Default(""))
+		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
 			loot.LootRmCmd(ctx, con)
 			con.Println()
 			return nil
 		},
+		Completer: func(prefix string, args []string) []string {
+			return loot.LootIDCompleter(prefix, args, con)
+		},
 		HelpGroup: consts.GenericHelpGroup,
 	})
 	con.App.AddCommand(lootCmd)
@@ -3283,58 +4597,455 @@ func BindCommands(con *console.SliverConsoleClient) {
 		HelpGroup: consts.GenericHelpGroup,
 	})
 	hostsCmd.AddCommand(iocCmd)
-	con.App.AddCommand(hostsCmd)
-
-	// [ Reactions ] -----------------------------------------------------------------
-
-	reactionCmd := &grumble.Command{
-		Name:     consts.ReactionStr,
-		Help:     "Manage automatic reactions to events",
-		LongHelp: help.GetHelpFor([]string{consts.ReactionStr}),
+	hostsCmd.AddCommand(&grumble.Command{
+		Name:     consts.LocalGroupsStr,
+		Help:     "Show local group members surveyed from a given host",
+		LongHelp: help.GetHelpFor([]string{consts.HostsStr, consts.LocalGroupsStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
-			reaction.ReactionCmd(ctx, con)
+			hosts.HostsLocalGroupsCmd(ctx, con)
 			con.Println()
 			return nil
 		},
 		HelpGroup: consts.GenericHelpGroup,
-	}
-	reactionCmd.AddCommand(&grumble.Command{
-		Name:     consts.SetStr,
-		Help:     "Set a reaction to an event",
-		LongHelp: help.GetHelpFor([]string{consts.ReactionStr, consts.SetStr}),
+	})
+	hostsCmd.AddCommand(&grumble.Command{
+		Name:     consts.SurveyStr,
+		Help:     "Show the last survey of a given host",
+		LongHelp: help.GetHelpFor([]string{consts.HostsStr, consts.SurveyStr}),
 		Flags: func(f *grumble.Flags) {
-			f.String("e", "event", "", "specify the event type to react to")
+			f.Bool("s", "software", false, "list the installed software")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
-			reaction.ReactionSetCmd(ctx, con)
+			hosts.HostsSurveyCmd(ctx, con)
 			con.Println()
 			return nil
 		},
 		HelpGroup: consts.GenericHelpGroup,
 	})
-	reactionCmd.AddCommand(&grumble.Command{
-		Name:     consts.UnsetStr,
-		Help:     "Unset an existing reaction",
-		LongHelp: help.GetHelpFor([]string{consts.ReactionStr, consts.UnsetStr}),
+	hostsCmd.AddCommand(&grumble.Command{
+		Name:     consts.ServicesStr,
+		Help:     "Show the services found on a given host",
+		LongHelp: help.GetHelpFor([]string{consts.HostsStr, consts.ServicesStr}),
 		Flags: func(f *grumble.Flags) {
-			f.Int("i", "id", 0, "the id of the reaction to remove")
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 		Run: func(ctx *grumble.Context) error {
 			con.Println()
-			reaction.ReactionUnsetCmd(ctx, con)
+			hosts.HostsServicesCmd(ctx, con)
 			con.Println()
 			return nil
 		},
 		HelpGroup: consts.GenericHelpGroup,
 	})
-	reactionCmd.AddCommand(&grumble.Command{
-		Name:     consts.SaveStr,
-		Help:     "Save current reactions to disk",
-		LongHelp: help.GetHelpFor([]string{consts.ReactionStr, consts.SaveStr}),
-		Run: func(ctx *grumble.Context) error {
-			con.Println()
+	hostsCmd.AddCommand(&grumble.Command{
+		Name:     consts.TagStr,
+		Help:     "Add or remove tags from a host",
+		LongHelp: help.GetHelpFor([]string{consts.HostsStr, consts.TagStr}),
+		Args: func(a *grumble.Args) {
+			a.StringList("tags", "tags to add or remove")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("H", "host", "", "host id (default: the active target's host)")
+			f.Bool("r", "remove", false, "remove the tags")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			hosts.HostsTagCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	con.App.AddCommand(hostsCmd)
+
+	// [ Persistence ] -----------------------------------------------------------------
+	persistenceCmd := &grumble.Command{
+		Name:     consts.PersistenceStr,
+		Help:     "List persistence installed on hosts",
+		LongHelp: help.GetHelpFor([]string{consts.PersistenceStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			persistence.PersistenceCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	}
+	persistenceCmd.AddCommand(&grumble.Command{
+		Name:     consts.RmStr,
+		Help:     "Remove a persistence record once it has been cleaned up",
+		LongHelp: help.GetHelpFor([]string{consts.PersistenceStr, consts.RmStr}),
+		Args: func(a *grumble.Args) {
+			a.String("id", "persistence ID")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			persistence.PersistenceRmCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	con.App.AddCommand(persistenceCmd)
+
+	// [ Engagement ] -----------------------------------------------------------------
+	engagementCmd := &grumble.Command{
+		Name:     consts.EngagementStr,
+		Help:     "Show when the engagement ends",
+		LongHelp: help.GetHelpFor([]string{consts.EngagementStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			engagement.EngagementCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	}
+	engagementCmd.AddCommand(&grumble.Command{
+		Name:     consts.SetStr,
+		Help:     "Set when the engagement ends, implants are killed once it has passed",
+		LongHelp: help.GetHelpFor([]string{consts.EngagementStr}),
+		Args: func(a *grumble.Args) {
+			a.String("end", "end of the engagement (RFC3339 or YYYY-MM-DD)")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			engagement.EngagementSetCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	engagementCmd.AddCommand(&grumble.Command{
+		Name:     consts.UnsetStr,
+		Help:     "Remove the end of the engagement",
+		LongHelp: help.GetHelpFor([]string{consts.EngagementStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			engagement.EngagementUnsetCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	con.App.AddCommand(engagementCmd)
+
+	// [ Artifacts ] -----------------------------------------------------------------
+
+	artifactsCmd := &grumble.Command{
+		Name:     consts.ArtifactsStr,
+		Help:     "List shellcode artifacts converted from .NET assemblies",
+		LongHelp: help.GetHelpFor([]string{consts.ArtifactsStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			artifacts.ArtifactsCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	}
+	artifactsCmd.AddCommand(&grumble.Command{
+		Name:     consts.ConvertStr,
+		Help:     "Convert a local .NET assembly to shellcode and save it on the server",
+		LongHelp: help.GetHelpFor([]string{consts.ArtifactsStr}),
+		Args: func(a *grumble.Args) {
+			a.String("assembly", "path to the .NET assembly")
+			a.StringList("arguments", "arguments passed to the assembly", grumble.Default([]string{}))
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("n", "name", "", "artifact name (default is the assembly's file name)")
+			f.String("a", "arch", "amd64", "shellcode architecture (386, amd64)")
+			f.String("c", "class", "", "class name, required for dlls (e.g. Namespace.Class)")
+			f.String("m", "method", "", "method to call, required for dlls")
+			f.String("d", "app-domain", "", "app domain to load the assembly in (default is random)")
+			f.String("r", "runtime", "", "clr runtime version (default v4.0.30319)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			artifacts.ArtifactsConvertCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return completers.LocalPathCompleter(prefix, args, con)
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	artifactsCmd.AddCommand(&grumble.Command{
+		Name:     consts.SaveStr,
+		Help:     "Write an artifact's shellcode to a local file",
+		LongHelp: help.GetHelpFor([]string{consts.ArtifactsStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "artifact name")
+			a.String("file", "path to save the shellcode to (default is <name>.bin)", grumble.Default(""))
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			artifacts.ArtifactsSaveCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	artifactsCmd.AddCommand(&grumble.Command{
+		Name:     consts.RmStr,
+		Help:     "Remove a shellcode artifact",
+		LongHelp: help.GetHelpFor([]string{consts.ArtifactsStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "artifact name")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			artifacts.ArtifactsRmCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	con.App.AddCommand(artifactsCmd)
+
+	// [ Playbooks ] -----------------------------------------------------------------
+
+	playbooksCmd := &grumble.Command{
+		Name:     consts.PlaybooksStr,
+		Help:     "List playbooks, or show the steps of a playbook",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "playbook name", grumble.Default(""))
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	}
+	playbooksCmd.AddCommand(&grumble.Command{
+		Name:     consts.ImportStr,
+		Help:     "Save a playbook from a local json file",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr}),
+		Args: func(a *grumble.Args) {
+			a.String("file", "path to the playbook json file")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("n", "name", "", "save the playbook with this name instead of the file's")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksImportCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Completer: func(prefix string, args []string) []string {
+			return completers.LocalPathCompleter(prefix, args, con)
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	playbooksCmd.AddCommand(&grumble.Command{
+		Name:     consts.ExportStr,
+		Help:     "Write a playbook to a local json file",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "playbook name")
+			a.String("file", "path to save the playbook to")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksExportCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	playbooksCmd.AddCommand(&grumble.Command{
+		Name:     consts.RmStr,
+		Help:     "Remove a playbook",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "playbook name")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksRmCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	playbooksCmd.AddCommand(&grumble.Command{
+		Name:     consts.RunStr,
+		Help:     "Run a playbook against the active target or tagged hosts",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr, consts.RunStr}),
+		Args: func(a *grumble.Args) {
+			a.String("name", "playbook name")
+			a.StringList("variables", "variables as name=value")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("T", "tag", "", "run against every host with this tag")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksRunCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	playbooksCmd.AddCommand(&grumble.Command{
+		Name:     consts.RunsStr,
+		Help:     "List playbook runs, or show the steps of a run",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr, consts.RunStr}),
+		Args: func(a *grumble.Args) {
+			a.String("id", "run id", grumble.Default(""))
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksRunsCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	playbooksCmd.AddCommand(&grumble.Command{
+		Name:     consts.ApproveStr,
+		Help:     "Approve the step a playbook run is waiting on",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr, consts.RunStr}),
+		Args: func(a *grumble.Args) {
+			a.String("id", "run id")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksApproveCmd(ctx, con, false)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	playbooksCmd.AddCommand(&grumble.Command{
+		Name:     consts.RejectStr,
+		Help:     "Reject the step a playbook run is waiting on and stop the run",
+		LongHelp: help.GetHelpFor([]string{consts.PlaybooksStr, consts.RunStr}),
+		Args: func(a *grumble.Args) {
+			a.String("id", "run id")
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			playbooks.PlaybooksApproveCmd(ctx, con, true)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	con.App.AddCommand(playbooksCmd)
+
+	// [ Reactions ] -----------------------------------------------------------------
+
+	reactionCmd := &grumble.Command{
+		Name:     consts.ReactionStr,
+		Help:     "Manage automatic reactions to events",
+		LongHelp: help.GetHelpFor([]string{consts.ReactionStr}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			reaction.ReactionCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	}
+	reactionCmd.AddCommand(&grumble.Command{
+		Name:     consts.SetStr,
+		Help:     "Set a reaction to an event",
+		LongHelp: help.GetHelpFor([]string{consts.ReactionStr, consts.SetStr}),
+		Flags: func(f *grumble.Flags) {
+			f.String("e", "event", "", "specify the event type to react to")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			reaction.ReactionSetCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	reactionCmd.AddCommand(&grumble.Command{
+		Name:     consts.UnsetStr,
+		Help:     "Unset an existing reaction",
+		LongHelp: help.GetHelpFor([]string{consts.ReactionStr, consts.UnsetStr}),
+		Flags: func(f *grumble.Flags) {
+			f.Int("i", "id", 0, "the id of the reaction to remove")
+		},
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			reaction.ReactionUnsetCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		HelpGroup: consts.GenericHelpGroup,
+	})
+	reactionCmd.AddCommand(&grumble.Command{
+		Name:     consts.SaveStr,
+		Help:     "Save current reactions to disk",
+		LongHelp: help.GetHelpFor([]string{consts.ReactionStr, consts.SaveStr}),
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
 			reaction.ReactionSaveCmd(ctx, con)
 			con.Println()
 			return nil
@@ -3379,7 +5090,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 	}
-	con.App.AddCommand(dllhijackCmd)
+	con.App.AddCommand(dryRun(dllhijackCmd, con))
 
 	// [ Get Privs ] -----------------------------------------------------------------
 	getprivsCmd := &grumble.Command{
@@ -3399,6 +5110,74 @@ func BindCommands(con *console.SliverConsoleClient) {
 	}
 	con.App.AddCommand(getprivsCmd)
 
+	// [ Logons ] -----------------------------------------------------------------
+	logonsCmd := &grumble.Command{
+		Name:      consts.LogonsStr,
+		Help:      "List logon sessions and their tokens (Windows only)",
+		LongHelp:  help.GetHelpFor([]string{consts.LogonsStr}),
+		HelpGroup: consts.SliverWinHelpGroup,
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			privilege.LogonsCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.Bool("T", "tokens", false, "list the processes holding each logon session's token")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+	}
+	con.App.AddCommand(logonsCmd)
+
+	// [ Local Groups ] -----------------------------------------------------------
+	localGroupsCmd := &grumble.Command{
+		Name:      consts.LocalGroupsStr,
+		Help:      "Survey local administrators and other groups on remote hosts (Windows only)",
+		LongHelp:  help.GetHelpFor([]string{consts.LocalGroupsStr}),
+		HelpGroup: consts.SliverWinHelpGroup,
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			privilege.LocalGroupsCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("H", "hosts", "", "hosts to survey (comma separated), defaults to the local host")
+			f.String("f", "hosts-file", "", "local file with hosts to survey, one per line")
+			f.String("g", "groups", "", "group names or SIDs to survey (comma separated), defaults to well known groups")
+			f.String("u", "username", "", "username to survey as (netonly), defaults to the current token")
+			f.String("d", "domain", "", "domain of the username")
+			f.String("p", "password", "", "password of the username")
+
+			f.Int("t", "timeout", 300, "command timeout in seconds")
+		},
+	}
+	con.App.AddCommand(localGroupsCmd)
+
+	// [ Service Hijacks ] -----------------------------------------------------------
+	serviceHijacksCmd := &grumble.Command{
+		Name:      consts.ServiceHijacksStr,
+		Help:      "Survey services that can be hijacked for persistence, optionally exploit one (Windows only)",
+		LongHelp:  help.GetHelpFor([]string{consts.ServiceHijacksStr}),
+		HelpGroup: consts.SliverWinHelpGroup,
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			privilege.ServiceHijacksCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("e", "exploit", "", "hijack this service (requires an interactive session)")
+			f.String("T", "technique", "", "technique to hijack the service with, if more than one was found")
+			f.String("f", "file", "", "local service binary to install, e.g. generate --format service")
+			f.String("p", "path", "", "remote path to install the binary to (registry-dacl only)")
+
+			f.Int("t", "timeout", 300, "command timeout in seconds")
+		},
+	}
+	con.App.AddCommand(serviceHijacksCmd)
+
 	// [ Extensions ] -----------------------------------------------------------------
 	extensionCmd := &grumble.Command{
 		Name:      consts.ExtensionsStr,
@@ -3491,6 +5270,44 @@ func BindCommands(con *console.SliverConsoleClient) {
 
 	con.App.AddCommand(extensionCmd)
 
+	// [ BOFs ] -----------------------------------------------------------------
+	bofCmd := &grumble.Command{
+		Name:      consts.BOFStr,
+		Help:      "Run Beacon Object Files",
+		LongHelp:  help.GetHelpFor([]string{consts.BOFStr}),
+		HelpGroup: consts.SliverWinHelpGroup,
+	}
+	bofCmd.AddCommand(&grumble.Command{
+		Name:      consts.RunStr,
+		Help:      "Run a BOF with the COFF loader",
+		LongHelp:  help.GetHelpFor([]string{consts.BOFStr, consts.RunStr}),
+		HelpGroup: consts.SliverWinHelpGroup,
+		Run: func(ctx *grumble.Context) error {
+			con.Println()
+			extensions.BOFRunCmd(ctx, con)
+			con.Println()
+			return nil
+		},
+		Flags: func(f *grumble.Flags) {
+			f.String("f", "format", "", "argument types, one character per argument: b (binary file), i (int), s (short), z (string), Z (wide string)")
+			f.String("e", "entrypoint", "go", "BOF entrypoint")
+			f.String("p", "parser", "", "parse the output with this output parser")
+			f.Bool("M", "amsi-bypass", false, "bypass AMSI in the implant's process before running the BOF")
+			f.Bool("E", "etw-bypass", false, "bypass ETW in the implant's process before running the BOF")
+			f.StringL("bypass-technique", "", "amsi and etw bypass technique (patch, hwbp)")
+
+			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
+		},
+		Args: func(a *grumble.Args) {
+			a.String("file", "path to the BOF")
+			a.StringList("arguments", "BOF arguments", grumble.Default([]string{}))
+		},
+		Completer: func(prefix string, args []string) []string {
+			return completers.LocalPathCompleter(prefix, args, con)
+		},
+	})
+	con.App.AddCommand(bofCmd)
+
 	// [ Prelude's Operator ] ------------------------------------------------------------
 	operatorCmd := &grumble.Command{
 		Name:      consts.PreludeOperatorStr,
@@ -3706,4 +5523,7 @@ func BindCommands(con *console.SliverConsoleClient) {
 		},
 	}
 	con.App.AddCommand(buildersCmd)
+
+	// Annotated commands show their OPSEC notes in 'help <command>'
+	help.AppendOpsecHelp(con.App.Commands().All())
 }
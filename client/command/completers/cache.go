@@ -0,0 +1,115 @@
+package completers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const (
+	// SessionCacheTTL - Sessions are asked again once their completions expire
+	SessionCacheTTL = 30 * time.Second
+
+	// BeaconCacheTTL - Beacons are never tasked to complete a command, their
+	// completions come from the results of commands the operator ran
+	BeaconCacheTTL = 10 * time.Minute
+
+	// TargetTimeout - How long a session has to answer before we give up completing
+	TargetTimeout = 5 * time.Second
+)
+
+var targetCache = &completionCache{entries: map[string]*cacheEntry{}}
+
+type cacheEntry struct {
+	values  []string
+	expires time.Time
+}
+
+type completionCache struct {
+	entries map[string]*cacheEntry
+	mutex   sync.Mutex
+}
+
+// Target - The id and cache ttl of a session or beacon
+func Target(session *clientpb.Session, beacon *clientpb.Beacon) (string, time.Duration) {
+	if session != nil {
+		return session.ID, SessionCacheTTL
+	}
+	if beacon != nil {
+		return beacon.ID, BeaconCacheTTL
+	}
+	return "", 0
+}
+
+// Remember - Cache completions for a target
+func Remember(targetID string, key string, values []string, ttl time.Duration) {
+	if targetID == "" {
+		return
+	}
+	targetCache.mutex.Lock()
+	defer targetCache.mutex.Unlock()
+	now := time.Now()
+	for cacheKey, entry := range targetCache.entries {
+		if entry.expires.Before(now) {
+			delete(targetCache.entries, cacheKey)
+		}
+	}
+	targetCache.entries[targetID+":"+key] = &cacheEntry{values: values, expires: now.Add(ttl)}
+}
+
+// Recall - Cached completions for a target, if they haven't expired
+func Recall(targetID string, key string) ([]string, bool) {
+	targetCache.mutex.Lock()
+	defer targetCache.mutex.Unlock()
+	entry, ok := targetCache.entries[targetID+":"+key]
+	if !ok || entry.expires.Before(time.Now()) {
+		return nil, false
+	}
+	return entry.values, true
+}
+
+// Escape - The console splits lines like a shell, so suggestions need spaces
+// and backslashes escaped (e.g. NT\ AUTHORITY\\SYSTEM)
+func Escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, " ", `\ `, "\t", "\\\t").Replace(value)
+}
+
+// LastFlag - The flag the word being completed is a value for, if any
+func LastFlag(args []string) string {
+	if len(args) == 0 || !strings.HasPrefix(args[len(args)-1], "-") {
+		return ""
+	}
+	return strings.TrimLeft(args[len(args)-1], "-")
+}
+
+// Positional - The number of positional arguments before the word being completed,
+// flags are skipped but flag values can't be told apart from positional arguments
+func Positional(args []string) int {
+	count := 0
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") {
+			count++
+		}
+	}
+	return count
+}
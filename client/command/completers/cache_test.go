@@ -0,0 +1,66 @@
+package completers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	Remember("target", "ls:/tmp", []string{"foo", "bar/"}, time.Minute)
+	Remember("target", "ls:/etc", []string{"passwd"}, -time.Second)
+	if values, ok := Recall("target", "ls:/tmp"); !ok || len(values) != 2 {
+		t.Fatalf("expected cached values, got %v (%v)", values, ok)
+	}
+	if _, ok := Recall("other-target", "ls:/tmp"); ok {
+		t.Fatal("values cached for one target were recalled for another")
+	}
+	if _, ok := Recall("target", "ls:/etc"); ok {
+		t.Fatal("expired values were recalled")
+	}
+	Remember("", "ls:/tmp", []string{"foo"}, time.Minute)
+	if _, ok := Recall("", "ls:/tmp"); ok {
+		t.Fatal("values were cached without a target")
+	}
+}
+
+func TestEscape(t *testing.T) {
+	for value, expected := range map[string]string{
+		"passwd":              "passwd",
+		"Program Files/":      `Program\ Files/`,
+		`NT AUTHORITY\SYSTEM`: `NT\ AUTHORITY\\SYSTEM`,
+	} {
+		if escaped := Escape(value); escaped != expected {
+			t.Fatalf("expected %q to be escaped as %q, got %q", value, expected, escaped)
+		}
+	}
+}
+
+func TestArgs(t *testing.T) {
+	if flag := LastFlag([]string{"--pid"}); flag != "pid" {
+		t.Fatalf("expected pid flag, got %q", flag)
+	}
+	if flag := LastFlag([]string{"-p", "123"}); flag != "" {
+		t.Fatalf("expected no flag, got %q", flag)
+	}
+	if count := Positional([]string{"-r", "/tmp/foo"}); count != 1 {
+		t.Fatalf("expected 1 positional argument, got %d", count)
+	}
+}
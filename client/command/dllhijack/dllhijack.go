@@ -23,7 +23,9 @@ import (
 	"fmt"
 	"io/ioutil"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/desertbit/grumble"
 )
@@ -32,6 +34,14 @@ import (
 // dllhijack --ref-path c:\windows\system32\msasn1.dll --profile dll  TARGET_PATH
 // dllhijack --ref-path c:\windows\system32\msasn1.dll --ref-file /tmp/ref.dll --profile dll  TARGET_PATH
 
+func init() {
+	help.RegisterOpsec(consts.DLLHijackStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"dll planted on disk for the hijacked binary"},
+		Network:   "upload of the planted dll",
+	})
+}
+
 // DllHijackCmd -- implements the dllhijack command
 func DllHijackCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	var (
@@ -0,0 +1,57 @@
+package command
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/desertbit/grumble"
+)
+
+// dryRun - Add a --dry-run flag to a loud or destructive command, instead of
+// tasking the implant it prints the requests the command would have sent
+// (with the paths, process and injection options already resolved) and the
+// command's OPSEC notes
+func dryRun(cmd *grumble.Command, con *console.SliverConsoleClient) *grumble.Command {
+	flags := cmd.Flags
+	cmd.Flags = func(f *grumble.Flags) {
+		if flags != nil {
+			flags(f)
+		}
+		f.BoolL("dry-run", false, "show what would be sent to the implant without sending it")
+	}
+	run := cmd.Run
+	cmd.Run = func(ctx *grumble.Context) error {
+		if !ctx.Flags.Bool("dry-run") {
+			return run(ctx)
+		}
+		var err error
+		requests := con.DryRun(func() {
+			err = run(ctx)
+		})
+		con.PrintInfof("Dry run of %s, nothing was sent to the implant\n\n", cmd.Name)
+		con.PrintDryRun(requests)
+		if opsecHelp := help.GetOpsecHelpFor(cmd.Name); opsecHelp != "" {
+			con.Printf("\n%s\n", opsecHelp)
+		}
+		con.Println()
+		return err
+	}
+	return cmd
+}
@@ -0,0 +1,96 @@
+package engagement
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/client/command/generate"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+)
+
+// EngagementCmd - Show when the engagement ends
+func EngagementCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	engagement, err := con.Rpc.GetEngagement(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintEngagement(engagement, con)
+}
+
+// EngagementSetCmd - Set when the engagement ends
+func EngagementSetCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	rawEnd, err := generate.ParseKillDate(ctx.Args.String("end"))
+	if err != nil || rawEnd == "" {
+		con.PrintErrorf("end must be RFC3339 (2006-01-02T15:04:05Z) or a date (2006-01-02)\n")
+		return
+	}
+	end, _ := time.Parse(time.RFC3339, rawEnd)
+	if !end.After(time.Now()) {
+		confirm := false
+		prompt := &survey.Confirm{Message: "The end is in the past, kill every implant now?"}
+		survey.AskOne(prompt, &confirm)
+		if !confirm {
+			return
+		}
+	}
+	engagement, err := con.Rpc.SetEngagement(context.Background(), &clientpb.Engagement{End: end.Unix()})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintEngagement(engagement, con)
+}
+
+// EngagementUnsetCmd - Remove the end of the engagement
+func EngagementUnsetCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	confirm := false
+	prompt := &survey.Confirm{Message: "Remove the engagement end? Implants may run past the end of the engagement"}
+	survey.AskOne(prompt, &confirm)
+	if !confirm {
+		return
+	}
+	engagement, err := con.Rpc.SetEngagement(context.Background(), &clientpb.Engagement{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	PrintEngagement(engagement, con)
+}
+
+// PrintEngagement - Print when the engagement ends
+func PrintEngagement(engagement *clientpb.Engagement, con *console.SliverConsoleClient) {
+	if engagement.End == 0 {
+		con.PrintInfof("The engagement has no end\n")
+		return
+	}
+	end := time.Unix(engagement.End, 0)
+	if engagement.Ended {
+		con.PrintWarnf("The engagement ended %s (%s ago), implants can only be killed\n",
+			end.Format(time.RFC1123), time.Since(end).Round(time.Minute))
+	} else {
+		con.PrintInfof("The engagement ends %s (in %s)\n", end.Format(time.RFC1123), time.Until(end).Round(time.Minute))
+	}
+}
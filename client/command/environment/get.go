@@ -62,7 +62,7 @@ func EnvGetCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintGetEnvInfo - Print the results of the env get command
 func PrintGetEnvInfo(envInfo *sliverpb.EnvInfo, con *console.SliverConsoleClient) {
 	if envInfo.Response != nil && envInfo.Response.Err != "" {
-		con.PrintErrorf("%s\n", envInfo.Response.Err)
+		con.PrintResponseErr(envInfo.Response)
 		return
 	}
 	for _, envVar := range envInfo.Variables {
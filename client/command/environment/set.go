@@ -73,7 +73,7 @@ func EnvSetCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintSetEnvInfo - Print the set environment info
 func PrintSetEnvInfo(name string, value string, envInfo *sliverpb.SetEnv, con *console.SliverConsoleClient) {
 	if envInfo.Response != nil && envInfo.Response.Err != "" {
-		con.PrintErrorf("%s\n", envInfo.Response.Err)
+		con.PrintResponseErr(envInfo.Response)
 		return
 	}
 	con.PrintInfof("Set %s to %s\n", name, value)
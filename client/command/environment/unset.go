@@ -69,7 +69,7 @@ func EnvUnsetCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintUnsetEnvInfo - Print the set environment info
 func PrintUnsetEnvInfo(name string, envInfo *sliverpb.UnsetEnv, con *console.SliverConsoleClient) {
 	if envInfo.Response != nil && envInfo.Response.Err != "" {
-		con.PrintErrorf("%s\n", envInfo.Response.Err)
+		con.PrintResponseErr(envInfo.Response)
 		return
 	}
 	con.PrintInfof("Successfully unset %s\n", name)
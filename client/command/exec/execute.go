@@ -23,14 +23,24 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/command/loot"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 	"google.golang.org/protobuf/proto"
 )
 
+func init() {
+	help.RegisterOpsec(consts.ExecuteStr, &help.OpsecInfo{
+		Risk:      help.OpsecMedium,
+		Artifacts: []string{"child process of the implant", "output files when --stdout/--stderr are used"},
+		APIs:      []string{"process creation", "parent pid spoofing with --ppid (windows)", "process mitigation policy with --blockdlls (windows)"},
+	})
+}
+
 // ExecuteCmd - Run a command on the remote system
 func ExecuteCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -47,6 +57,7 @@ func ExecuteCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	saveLoot := ctx.Flags.Bool("loot")
 	saveOutput := ctx.Flags.Bool("save")
 	ppid := ctx.Flags.Uint("ppid")
+	blockDLLs := ctx.Flags.Bool("blockdlls")
 	hostName := getHostname(session, beacon)
 
 	// If the user wants to loot or save the output, we have to capture it regardless of if they specified -o
@@ -61,16 +72,17 @@ func ExecuteCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 
 	ctrl := make(chan bool)
 	con.SpinUntil(fmt.Sprintf("Executing %s %s ...", cmdPath, strings.Join(args, " ")), ctrl)
-	if token || ppid != 0 {
+	if token || ppid != 0 || blockDLLs {
 		exec, err = con.Rpc.ExecuteWindows(context.Background(), &sliverpb.ExecuteWindowsReq{
-			Request:  con.ActiveTarget.Request(ctx),
-			Path:     cmdPath,
-			Args:     args,
-			Output:   captureOutput,
-			Stderr:   stderr,
-			Stdout:   stdout,
-			UseToken: token,
-			PPid:     uint32(ppid),
+			Request:   con.ActiveTarget.Request(ctx),
+			Path:      cmdPath,
+			Args:      args,
+			Output:    captureOutput,
+			Stderr:    stderr,
+			Stdout:    stdout,
+			UseToken:  token,
+			PPid:      uint32(ppid),
+			BlockDLLs: blockDLLs,
 		})
 	} else {
 		exec, err = con.Rpc.Execute(context.Background(), &sliverpb.ExecuteReq{
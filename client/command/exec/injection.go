@@ -0,0 +1,65 @@
+package exec
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"strings"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+)
+
+// parseInjection - Parse the --injection and --stomp-module flags, a nil injection
+// means the implant's default technique is used
+func parseInjection(ctx *grumble.Context) (*sliverpb.Injection, error) {
+	technique := strings.ToLower(ctx.Flags.String("injection"))
+	stompModule := ctx.Flags.String("stomp-module")
+	if technique == "" && stompModule == "" {
+		return nil, nil
+	}
+	if stompModule != "" && technique != consts.InjectionModuleStomp {
+		return nil, fmt.Errorf("--stomp-module can only be used with --injection %s", consts.InjectionModuleStomp)
+	}
+	for _, name := range consts.InjectionTechniques {
+		if technique == name {
+			return &sliverpb.Injection{Technique: technique, StompModule: stompModule}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown injection technique '%s' (%s)", technique, strings.Join(consts.InjectionTechniques, ", "))
+}
+
+// parseBypassTechnique - Parse the --bypass-technique flag, empty means the implant's default.
+// Hardware breakpoints only work in the implant's process.
+func parseBypassTechnique(ctx *grumble.Context, inProcess bool) (string, error) {
+	technique := strings.ToLower(ctx.Flags.String("bypass-technique"))
+	if technique == "" {
+		return "", nil
+	}
+	if technique == consts.BypassHardwareBreakpoint && !inProcess {
+		return "", fmt.Errorf("--bypass-technique %s can only be used with the --in-process flag", technique)
+	}
+	for _, name := range consts.BypassTechniques {
+		if technique == name {
+			return technique, nil
+		}
+	}
+	return "", fmt.Errorf("unknown bypass technique '%s' (%s)", technique, strings.Join(consts.BypassTechniques, ", "))
+}
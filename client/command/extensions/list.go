@@ -42,7 +42,7 @@ func ExtensionsListCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	if extList.Response != nil && extList.Response.Err != "" {
-		con.PrintErrorf("%s\n", extList.Response.Err)
+		con.PrintResponseErr(extList.Response)
 		return
 	}
 	if len(extList.Names) > 0 {
@@ -65,6 +65,7 @@ type ExtensionManifest struct {
 	Entrypoint      string               `json:"entrypoint"`
 	DependsOn       string               `json:"depends_on"`
 	Init            string               `json:"init"`
+	Parser          string               `json:"parser"`
 
 	RootPath string `json:"-"`
 }
@@ -184,6 +185,9 @@ func ExtensionRegisterCommand(extCmd *ExtensionManifest, con *console.SliverCons
 		},
 		Flags: func(f *grumble.Flags) {
 			// f.Bool("s", "save", false, "Save output to disk")
+			f.Bool("M", "amsi-bypass", false, "bypass AMSI in the implant's process before calling the extension (windows only)")
+			f.Bool("E", "etw-bypass", false, "bypass ETW in the implant's process before calling the extension (windows only)")
+			f.StringL("bypass-technique", "", "amsi and etw bypass technique (patch, hwbp)")
 			f.Int("t", "timeout", defaultTimeout, "command timeout in seconds")
 		},
 		Args: func(a *grumble.Args) {
@@ -317,12 +321,15 @@ func runExtensionCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 	var goos string
 	var goarch string
+	var hostUUID string
 	if session != nil {
 		goos = session.OS
 		goarch = session.Arch
+		hostUUID = session.UUID
 	} else {
 		goos = beacon.OS
 		goarch = beacon.Arch
+		hostUUID = beacon.UUID
 	}
 
 	ext, ok := loadedExtensions[ctx.Command.Name]
@@ -375,6 +382,10 @@ func runExtensionCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		Export:  entryPoint,
 		Args:    extensionArgs,
 		Request: con.ActiveTarget.Request(ctx),
+
+		AmsiBypass:      ctx.Flags.Bool("amsi-bypass"),
+		EtwBypass:       ctx.Flags.Bool("etw-bypass"),
+		BypassTechnique: strings.ToLower(ctx.Flags.String("bypass-technique")),
 	})
 	ctrl <- true
 	<-ctrl
@@ -391,10 +402,12 @@ func runExtensionCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				return
 			}
 			PrintExtOutput(extName, ext.CommandName, callExtResp, con)
+			PostProcessOutput(ext.Parser, ext.CommandName, hostUUID, callExtResp.Output, con)
 		})
 		con.PrintAsyncResponse(callExtResp.Response)
 	} else {
 		PrintExtOutput(extName, ext.CommandName, callExtResp, con)
+		PostProcessOutput(ext.Parser, ext.CommandName, hostUUID, callExtResp.Output, con)
 	}
 }
 
@@ -409,7 +422,7 @@ func PrintExtOutput(extName string, commandName string, callExtension *sliverpb.
 		con.PrintInfof("Got output:\n%s\n", callExtension.Output)
 	}
 	if callExtension.Response != nil && callExtension.Response.Err != "" {
-		con.PrintErrorf("%s\n", callExtension.Response.Err)
+		con.PrintResponseErr(callExtension.Response)
 		return
 	}
 }
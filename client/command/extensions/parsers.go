@@ -0,0 +1,51 @@
+package extensions
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// PostProcessOutput - Send the output of an extension or alias to the server to be
+// parsed by the manifest's parser, the server adds any credentials it finds to the
+// loot store and any hosts/services to the hosts database
+func PostProcessOutput(parser string, cmdName string, hostUUID string, output []byte, con *console.SliverConsoleClient) {
+	if parser == "" || len(output) == 0 {
+		return
+	}
+	parsed, err := con.Rpc.ParseOutput(context.Background(), &clientpb.ParseOutputReq{
+		Parser:   parser,
+		Name:     cmdName,
+		HostUUID: hostUUID,
+		Output:   output,
+	})
+	if err != nil {
+		con.PrintErrorf("Failed to parse %s output: %s\n", cmdName, err)
+		return
+	}
+	services := 0
+	for _, host := range parsed.Hosts {
+		services += len(host.Services)
+	}
+	con.PrintInfof("Parsed %d credential(s), %d host(s), and %d service(s) from %s output\n",
+		len(parsed.Credentials), len(parsed.Hosts), services, cmdName)
+}
@@ -88,7 +88,7 @@ func PrintCat(download *sliverpb.Download, ctx *grumble.Context, con *console.Sl
 	userLootType := ctx.Flags.String("type")
 	userLootFileType := ctx.Flags.String("file-type")
 	if download.Response != nil && download.Response.Err != "" {
-		con.PrintErrorf("%s\n", download.Response.Err)
+		con.PrintResponseErr(download.Response)
 		return
 	}
 
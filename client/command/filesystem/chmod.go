@@ -77,7 +77,7 @@ func ChmodCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintChmod - Print the chmod response
 func PrintChmod(chmod *sliverpb.Chmod, con *console.SliverConsoleClient) {
 	if chmod.Response != nil && chmod.Response.Err != "" {
-		con.PrintErrorf("%s\n", chmod.Response.Err)
+		con.PrintResponseErr(chmod.Response)
 		return
 	}
 	con.PrintInfof("%s\n", chmod.Path)
@@ -85,7 +85,7 @@ func ChownCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintChown - Print the chown response
 func PrintChown(chown *sliverpb.Chown, con *console.SliverConsoleClient) {
 	if chown.Response != nil && chown.Response.Err != "" {
-		con.PrintErrorf("%s\n", chown.Response.Err)
+		con.PrintResponseErr(chown.Response)
 		return
 	}
 	con.PrintInfof("%s\n", chown.Path)
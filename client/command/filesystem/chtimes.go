@@ -100,7 +100,7 @@ func ChtimesCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintChtimes - Print the Chtimes response
 func PrintChtimes(chtimes *sliverpb.Chtimes, con *console.SliverConsoleClient) {
 	if chtimes.Response != nil && chtimes.Response.Err != "" {
-		con.PrintErrorf("%s\n", chtimes.Response.Err)
+		con.PrintResponseErr(chtimes.Response)
 		return
 	}
 	con.PrintInfof("%s\n", chtimes.Path)
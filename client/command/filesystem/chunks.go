@@ -0,0 +1,578 @@
+package filesystem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/spin"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util"
+	"github.com/bishopfox/sliver/util/encoders"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/AlecAivazis/survey.v1"
+)
+
+const (
+	// partialSuffix - Chunked downloads are written here until they're complete
+	partialSuffix = ".part"
+
+	// chunkRetries - Times we'll refetch a chunk that doesn't match its hash
+	chunkRetries = 3
+
+	beaconPollInterval   = 2 * time.Second
+	sessionPollInterval  = 5 * time.Second
+	maxChunkSize         = 64 * 1024 * 1024 // Largest chunk implants will accept
+	defaultDNSChunkSize  = 128 * 1024
+	defaultHTTPChunkSize = 1024 * 1024
+	defaultChunkSize     = 4 * 1024 * 1024
+)
+
+var (
+	// ErrChunksUnsupported - The implant is too old for chunked transfers
+	ErrChunksUnsupported = errors.New("implant does not support chunked transfers")
+
+	// ErrChunkHash - A chunk was corrupt more times than we're willing to retry
+	ErrChunkHash = errors.New("chunk hash mismatch")
+
+	// ErrZstdUnsupported - There's no zstd encoder vendored yet
+	ErrZstdUnsupported = errors.New("zstd compression is not supported yet, use gzip")
+)
+
+// chunkResult - Implant responses to chunk requests
+type chunkResult interface {
+	proto.Message
+	GetResponse() *commonpb.Response
+}
+
+// chunkedTransfer - Moves a file one ranged request at a time, so an interrupted
+// transfer can be resumed from the last chunk that was written
+type chunkedTransfer struct {
+	con       *console.SliverConsoleClient
+	session   *clientpb.Session
+	beacon    *clientpb.Beacon
+	req       *commonpb.Request
+	chunkSize int64
+	encoders  []string
+	wait      time.Duration
+
+	offset int64 // Updated atomically, read by the spinner
+	size   int64
+	status atomic.Value
+}
+
+func newChunkedTransfer(ctx *grumble.Context, con *console.SliverConsoleClient, session *clientpb.Session, beacon *clientpb.Beacon) (*chunkedTransfer, error) {
+	transport := ""
+	if session != nil {
+		transport = session.Transport
+	} else {
+		transport = beacon.Transport
+	}
+	chunkSize := transferChunkSize(transport)
+	if value := ctx.Flags.String("chunk-size"); value != "" && value != "auto" {
+		var err error
+		chunkSize, err = parseSize(value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if chunkSize <= 0 || maxChunkSize < chunkSize {
+		return nil, fmt.Errorf("chunk size must be between 1 and %s", util.ByteCountBinary(maxChunkSize))
+	}
+	encoders, err := transferEncoders(ctx.Flags.String("compress"), transport)
+	if err != nil {
+		return nil, err
+	}
+	wait, err := time.ParseDuration(ctx.Flags.String("wait"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid wait duration (%s)", err)
+	}
+	transfer := &chunkedTransfer{
+		con:       con,
+		session:   session,
+		beacon:    beacon,
+		req:       con.ActiveTarget.Request(ctx),
+		chunkSize: chunkSize,
+		encoders:  encoders,
+		wait:      wait,
+	}
+	transfer.status.Store("")
+	return transfer, nil
+}
+
+// transferChunkSize - Chunks small enough to make progress over slow transports
+func transferChunkSize(transport string) int64 {
+	switch transport {
+	case "dns":
+		return defaultDNSChunkSize
+	case "http(s)", "udp":
+		return defaultHTTPChunkSize
+	default:
+		return defaultChunkSize
+	}
+}
+
+// transferEncoders - Encoders we'll accept in order of preference, compression
+// isn't worth the cpu on transports that are already fast
+func transferEncoders(compress string, transport string) ([]string, error) {
+	switch compress {
+	case "", "auto":
+		if transport == "mtls" || transport == "wg" {
+			return []string{}, nil
+		}
+		return []string{"gzip"}, nil
+	case "gzip":
+		return []string{"gzip"}, nil
+	case "none":
+		return []string{}, nil
+	case "zstd":
+		return nil, ErrZstdUnsupported
+	default:
+		return nil, fmt.Errorf("unknown compression '%s' (auto, gzip, none)", compress)
+	}
+}
+
+// resumeOffset - Round what was already transferred down to a chunk boundary
+func resumeOffset(transferred int64, size int64, chunkSize int64) int64 {
+	if transferred <= 0 || size < transferred {
+		return 0
+	}
+	return transferred - transferred%chunkSize
+}
+
+// send - Send a chunk request, waits on the beacon to complete the task and
+// on the session to reconnect if it's lost. Errors in the implant's response
+// are returned along with the result.
+func (t *chunkedTransfer) send(call func(*commonpb.Request) (chunkResult, error)) (chunkResult, error) {
+	for {
+		result, err := call(t.req)
+		if err == nil && result.GetResponse() != nil && result.GetResponse().Async {
+			err = t.waitForTask(result.GetResponse().TaskID, result)
+		}
+		if err == nil {
+			if resp := result.GetResponse(); resp != nil && resp.Err != "" {
+				return result, errors.New(resp.Err)
+			}
+			return result, nil
+		}
+		if !t.reconnect() {
+			return nil, err
+		}
+	}
+}
+
+// waitForTask - Poll the server until the beacon has completed the task
+func (t *chunkedTransfer) waitForTask(taskID string, result proto.Message) error {
+	for {
+		task, err := t.con.Rpc.GetBeaconTaskContent(context.Background(), &clientpb.BeaconTask{ID: taskID})
+		if err != nil {
+			return err
+		}
+		switch task.State {
+		case "pending", "sent":
+			time.Sleep(beaconPollInterval)
+		case "completed":
+			return proto.Unmarshal(task.Response, result)
+		default:
+			return fmt.Errorf("task %s %s", taskID, task.State)
+		}
+	}
+}
+
+// reconnect - Wait for the implant to come back as a new session, the
+// implant's process is the same so the file offsets are still good
+func (t *chunkedTransfer) reconnect() bool {
+	if t.session == nil || t.wait <= 0 {
+		return false
+	}
+	t.status.Store("waiting for session to reconnect")
+	defer t.status.Store("")
+	deadline := time.Now().Add(t.wait)
+	for time.Now().Before(deadline) {
+		time.Sleep(sessionPollInterval)
+		sessions, err := t.con.Rpc.GetSessions(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			continue
+		}
+		for _, session := range sessions.Sessions {
+			if session.ID == t.session.ID || session.IsDead {
+				continue
+			}
+			if session.UUID == t.session.UUID && session.Name == t.session.Name && session.PID == t.session.PID {
+				if active := t.con.ActiveTarget.GetSession(); active != nil && active.ID == t.session.ID {
+					t.con.ActiveTarget.Set(session, nil)
+				}
+				t.session = session
+				t.req.SessionID = session.ID
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// progress - Spinner message
+func (t *chunkedTransfer) progress(message string) func() string {
+	return func() string {
+		size := atomic.LoadInt64(&t.size)
+		msg := message
+		if 0 < size {
+			msg = fmt.Sprintf("%s %s / %s", message,
+				util.ByteCountBinary(atomic.LoadInt64(&t.offset)),
+				util.ByteCountBinary(size),
+			)
+		}
+		if status := t.status.Load().(string); status != "" {
+			msg = fmt.Sprintf("%s (%s)", msg, status)
+		}
+		return msg
+	}
+}
+
+// run - Sessions block the console with a spinner, beacons transfer in the
+// background since each chunk waits on a check in
+func (t *chunkedTransfer) run(message string, transfer func() error) {
+	if t.beacon != nil {
+		t.con.PrintInfof("Transferring %s in the background\n", message)
+		go func() {
+			if err := transfer(); err != nil {
+				t.interrupted(err)
+			}
+		}()
+		return
+	}
+	ctrl := make(chan bool)
+	go spin.UntilFunc(t.con.App.Stdout(), t.progress(message), ctrl)
+	err := transfer()
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		t.interrupted(err)
+	}
+}
+
+func (t *chunkedTransfer) interrupted(err error) {
+	t.con.PrintErrorf("%s\n", err)
+	if 0 < atomic.LoadInt64(&t.offset) {
+		t.con.PrintWarnf("Transfer stopped at %s of %s, run the same command again to resume\n",
+			util.ByteCountBinary(atomic.LoadInt64(&t.offset)),
+			util.ByteCountBinary(atomic.LoadInt64(&t.size)),
+		)
+	}
+}
+
+// downloadRange - Fetch a range of the remote file, for hash only requests
+// the start and stop are used as is
+func (t *chunkedTransfer) downloadRange(remotePath string, start int64, stop int64, hashOnly bool) (*sliverpb.Download, error) {
+	result, err := t.send(func(req *commonpb.Request) (chunkResult, error) {
+		return t.con.Rpc.Download(context.Background(), &sliverpb.DownloadReq{
+			Request:  req,
+			Path:     remotePath,
+			Start:    start,
+			Stop:     stop,
+			Encoders: t.encoders,
+			HashOnly: hashOnly,
+		})
+	})
+	if result == nil {
+		return nil, err
+	}
+	download := result.(*sliverpb.Download)
+	if err == nil && download.Hash == "" {
+		return download, ErrChunksUnsupported
+	}
+	return download, err
+}
+
+// verifyChunk - Check that the chunk before offset is the same on both sides
+func (t *chunkedTransfer) verifyChunk(local *os.File, remotePath string, offset int64) bool {
+	if offset <= 0 {
+		return false
+	}
+	start := offset - t.chunkSize
+	if start < 0 {
+		start = 0
+	}
+	chunk := make([]byte, offset-start)
+	if _, err := local.ReadAt(chunk, start); err != nil {
+		return false
+	}
+	download, err := t.downloadRange(remotePath, start, offset, true)
+	if err != nil || download.Start != start || download.Stop != offset {
+		return false
+	}
+	return download.Hash == util.ChunkHash(chunk)
+}
+
+// chunkedDownload - Download a file into dst.part, resuming from what's
+// already in it, and move it to dst once it's complete
+func chunkedDownload(ctx *grumble.Context, con *console.SliverConsoleClient, session *clientpb.Session, beacon *clientpb.Beacon) {
+	transfer, err := newChunkedTransfer(ctx, con, session, beacon)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	remotePath := ctx.Args.String("remote-path")
+	saveLoot := ctx.Flags.Bool("loot")
+	if ctx.Flags.Bool("recurse") {
+		con.PrintErrorf("Chunked downloads do not support directories\n")
+		return
+	}
+	dst, err := chunkedDownloadPath(remotePath, ctx.Args.String("local-path"), saveLoot)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if _, err := os.Stat(dst); err == nil && !saveLoot {
+		overwrite := false
+		prompt := &survey.Confirm{Message: "Overwrite local file?"}
+		survey.AskOne(prompt, &overwrite, nil)
+		if !overwrite {
+			return
+		}
+	}
+	part, err := os.OpenFile(dst+partialSuffix, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		con.PrintErrorf("Failed to open local file %s: %s\n", dst+partialSuffix, err)
+		return
+	}
+
+	transfer.run(remotePath, func() error {
+		defer part.Close()
+		err := transfer.download(part, remotePath)
+		if err != nil {
+			return err
+		}
+		part.Close()
+		if saveLoot {
+			defer os.Remove(part.Name())
+			return transfer.lootDownload(ctx, part.Name(), remotePath)
+		}
+		if err := os.Rename(part.Name(), dst); err != nil {
+			return err
+		}
+		con.PrintInfof("Wrote %d bytes to %s\n", atomic.LoadInt64(&transfer.size), dst)
+		return nil
+	})
+}
+
+// chunkedDownloadPath - Where a chunked download is saved, loot is kept in a
+// temporary directory until it's sent to the server
+func chunkedDownloadPath(remotePath string, localPath string, saveLoot bool) (string, error) {
+	fileName := filepath.Base(prettifyDownloadName(remotePath))
+	if saveLoot {
+		return filepath.Join(os.TempDir(), fmt.Sprintf("sliver-loot-%s", fileName)), nil
+	}
+	dst, err := filepath.Abs(localPath)
+	if err != nil {
+		return "", err
+	}
+	if fi, err := os.Stat(dst); err == nil && fi.IsDir() {
+		dst = filepath.Join(dst, filepath.Base(remotePath))
+	}
+	return dst, nil
+}
+
+func (t *chunkedTransfer) download(part *os.File, remotePath string) error {
+	probe, err := t.downloadRange(remotePath, 0, 0, true)
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt64(&t.size, probe.Size)
+
+	fi, err := part.Stat()
+	if err != nil {
+		return err
+	}
+	offset := resumeOffset(fi.Size(), probe.Size, t.chunkSize)
+	if 0 < offset && !t.verifyChunk(part, remotePath, offset) {
+		offset = 0
+	}
+	if 0 < offset {
+		t.con.PrintInfof("Resuming download of %s at %s\n", remotePath, util.ByteCountBinary(offset))
+	}
+	if err := part.Truncate(offset); err != nil {
+		return err
+	}
+	atomic.StoreInt64(&t.offset, offset)
+
+	retries := 0
+	for offset < atomic.LoadInt64(&t.size) {
+		download, err := t.downloadRange(remotePath, offset, offset+t.chunkSize, false)
+		if err != nil {
+			return err
+		}
+		chunk := download.Data
+		if download.Encoder == "gzip" {
+			chunk, err = new(encoders.Gzip).Decode(chunk)
+		}
+		if err != nil || download.Start != offset || int64(len(chunk)) != download.Stop-download.Start || util.ChunkHash(chunk) != download.Hash {
+			retries++
+			if chunkRetries < retries {
+				return ErrChunkHash
+			}
+			continue
+		}
+		retries = 0
+		if _, err := part.WriteAt(chunk, offset); err != nil {
+			return err
+		}
+		// The file may grow or shrink while we download it
+		atomic.StoreInt64(&t.size, download.Size)
+		if download.Stop == offset {
+			break
+		}
+		offset = download.Stop
+		atomic.StoreInt64(&t.offset, offset)
+	}
+	return part.Truncate(offset)
+}
+
+func (t *chunkedTransfer) lootDownload(ctx *grumble.Context, localPath string, remotePath string) error {
+	data, err := os.ReadFile(localPath)
+	if err != nil {
+		return err
+	}
+	lootType, err := loot.ValidateLootType(ctx.Flags.String("type"))
+	if err != nil {
+		return err
+	}
+	download := &sliverpb.Download{Path: remotePath, Exists: true, ReadFiles: 1, Data: data}
+	fileType := loot.ValidateLootFileType(ctx.Flags.String("file-type"), data)
+	loot.LootDownload(download, ctx.Flags.String("name"), lootType, fileType, ctx, t.con)
+	return nil
+}
+
+// chunkedUpload - Upload a file one chunk at a time, resuming from what's
+// already on the remote system
+func chunkedUpload(ctx *grumble.Context, con *console.SliverConsoleClient, session *clientpb.Session, beacon *clientpb.Beacon, src string, dst string) {
+	transfer, err := newChunkedTransfer(ctx, con, session, beacon)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	local, err := os.Open(src)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	isIOC := ctx.Flags.Bool("ioc")
+	transfer.run(fmt.Sprintf("%s -> %s", src, dst), func() error {
+		defer local.Close()
+		upload, err := transfer.upload(local, dst, isIOC)
+		if err != nil {
+			return err
+		}
+		PrintUpload(upload, con)
+		return nil
+	})
+}
+
+func (t *chunkedTransfer) upload(local *os.File, dst string, isIOC bool) (*sliverpb.Upload, error) {
+	fi, err := local.Stat()
+	if err != nil {
+		return nil, err
+	}
+	atomic.StoreInt64(&t.size, fi.Size())
+
+	// The probe tells us how much of the file is already there, and which
+	// encoders the implant supports
+	probe, err := t.downloadRange(dst, 0, 0, true)
+	if probe == nil || (err != nil && probe.Exists) {
+		return nil, err
+	}
+	if len(probe.Encoders) == 0 {
+		return nil, ErrChunksUnsupported
+	}
+	encoder := ""
+	for _, accepted := range t.encoders {
+		for _, supported := range probe.Encoders {
+			if accepted == supported && encoder == "" {
+				encoder = accepted
+			}
+		}
+	}
+	offset := resumeOffset(probe.Size, fi.Size(), t.chunkSize)
+	if 0 < offset && !t.verifyChunk(local, dst, offset) {
+		offset = 0
+	}
+	if 0 < offset {
+		t.con.PrintInfof("Resuming upload to %s at %s\n", dst, util.ByteCountBinary(offset))
+	}
+	atomic.StoreInt64(&t.offset, offset)
+
+	fileHash := ""
+	if isIOC {
+		data, err := os.ReadFile(local.Name())
+		if err != nil {
+			return nil, err
+		}
+		fileHash = util.ChunkHash(data)
+	}
+
+	chunk := make([]byte, t.chunkSize)
+	for {
+		n, err := local.ReadAt(chunk, offset)
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		last := offset+int64(n) == fi.Size()
+		uploadReq := &sliverpb.UploadReq{
+			Path:    dst,
+			Chunked: true,
+			Offset:  offset,
+			Hash:    util.ChunkHash(chunk[:n]),
+			Data:    chunk[:n],
+		}
+		if encoder == "gzip" {
+			if data := new(encoders.Gzip).Encode(chunk[:n]); len(data) < n {
+				uploadReq.Data = data
+				uploadReq.Encoder = encoder
+			}
+		}
+		if last {
+			uploadReq.IsIOC = isIOC
+			uploadReq.FileHash = fileHash
+		}
+		result, err := t.send(func(req *commonpb.Request) (chunkResult, error) {
+			uploadReq.Request = req
+			return t.con.Rpc.Upload(context.Background(), uploadReq)
+		})
+		if err != nil {
+			return nil, err
+		}
+		offset += int64(n)
+		atomic.StoreInt64(&t.offset, offset)
+		if last || n == 0 {
+			return result.(*sliverpb.Upload), nil
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package filesystem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestResumeOffset(t *testing.T) {
+	for _, test := range []struct {
+		transferred, size, chunkSize, offset int64
+	}{
+		{0, 100, 10, 0},
+		{35, 100, 10, 30},
+		{40, 100, 10, 40},
+		{100, 100, 10, 100},
+		{120, 100, 10, 0}, // Larger than the source, not the same file
+		{-1, 100, 10, 0},
+	} {
+		if offset := resumeOffset(test.transferred, test.size, test.chunkSize); offset != test.offset {
+			t.Errorf("resumeOffset(%d, %d, %d) = %d, expected %d", test.transferred, test.size, test.chunkSize, offset, test.offset)
+		}
+	}
+}
+
+func TestTransferEncoders(t *testing.T) {
+	for _, test := range []struct {
+		compress, transport string
+		gzip                bool
+	}{
+		{"auto", "dns", true},
+		{"auto", "http(s)", true},
+		{"auto", "mtls", false},
+		{"", "wg", false},
+		{"gzip", "mtls", true},
+		{"none", "dns", false},
+	} {
+		encoders, err := transferEncoders(test.compress, test.transport)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (len(encoders) == 1 && encoders[0] == "gzip") != test.gzip {
+			t.Errorf("transferEncoders(%q, %q) = %v", test.compress, test.transport, encoders)
+		}
+	}
+	if _, err := transferEncoders("zstd", "dns"); err != ErrZstdUnsupported {
+		t.Errorf("expected zstd to be unsupported, got %v", err)
+	}
+	if _, err := transferEncoders("zip", "dns"); err == nil {
+		t.Error("expected an error for an unknown compression")
+	}
+}
@@ -0,0 +1,132 @@
+package filesystem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/completers"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// RemotePathCompleter - Completes a path on the active session or beacon, directory
+// listings are cached for a short time. Beacons only complete directories that
+// have already been listed with 'ls'.
+func RemotePathCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	dir, partial := splitRemotePath(prefix)
+	names, ok := remoteDir(dir, con)
+	if !ok {
+		return []string{}
+	}
+	results := []string{}
+	for _, name := range names {
+		if strings.HasPrefix(name, partial) {
+			// Only the rest of the name is inserted, the prefix was already unescaped
+			results = append(results, prefix+completers.Escape(name[len(partial):]))
+		}
+	}
+	return results
+}
+
+// DownloadCompleter - The remote path, then a local path to save it to
+func DownloadCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	if 0 < completers.Positional(args) {
+		return completers.LocalPathCompleter(prefix, args, con)
+	}
+	return RemotePathCompleter(prefix, args, con)
+}
+
+// splitRemotePath - Split a partial path into the directory to list (including
+// the trailing separator) and the partial name in that directory
+func splitRemotePath(prefix string) (string, string) {
+	index := strings.LastIndexAny(prefix, `/\`)
+	if index < 0 {
+		return "", prefix
+	}
+	return prefix[:index+1], prefix[index+1:]
+}
+
+// lsCacheKey - Listings are cached by the path that was listed, with or without
+// a trailing separator
+func lsCacheKey(path string) string {
+	if path == "" {
+		path = "."
+	}
+	path = strings.ReplaceAll(path, `\`, "/")
+	if trimmed := strings.TrimRight(path, "/"); trimmed != "" && !strings.HasSuffix(trimmed, ":") {
+		path = trimmed
+	}
+	return "ls:" + path
+}
+
+func remoteDir(dir string, con *console.SliverConsoleClient) ([]string, bool) {
+	session, beacon := con.ActiveTarget.Get()
+	targetID, ttl := completers.Target(session, beacon)
+	if targetID == "" {
+		return nil, false
+	}
+	if names, ok := completers.Recall(targetID, lsCacheKey(dir)); ok {
+		return names, true
+	}
+	if session == nil {
+		return nil, false
+	}
+	path := dir
+	if path == "" {
+		path = "."
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completers.TargetTimeout)
+	defer cancel()
+	ls, err := con.Rpc.Ls(ctx, &sliverpb.LsReq{
+		Request: &commonpb.Request{SessionID: session.ID, Timeout: int64(completers.TargetTimeout)},
+		Path:    path,
+	})
+	if err != nil {
+		return nil, false
+	}
+	return cacheLs(dir, ls, targetID, ttl)
+}
+
+// cacheLs - Cache a listing for completions, directories end with a separator
+func cacheLs(path string, ls *sliverpb.Ls, targetID string, ttl time.Duration) ([]string, bool) {
+	if (ls.Response != nil && ls.Response.Err != "") || !ls.Exists {
+		return nil, false
+	}
+	names := []string{}
+	for _, file := range ls.Files {
+		if file.IsDir {
+			names = append(names, file.Name+"/")
+		} else {
+			names = append(names, file.Name)
+		}
+	}
+	completers.Remember(targetID, lsCacheKey(path), names, ttl)
+	return names, true
+}
+
+// cacheLsResult - Cache the result of an 'ls' command
+func cacheLsResult(path string, ls *sliverpb.Ls, session *clientpb.Session, beacon *clientpb.Beacon) {
+	targetID, ttl := completers.Target(session, beacon)
+	cacheLs(path, ls, targetID, ttl)
+}
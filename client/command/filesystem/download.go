@@ -44,11 +44,15 @@ func DownloadCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	if session == nil && beacon == nil {
 		return
 	}
+	if ctx.Flags.Bool("chunked") {
+		chunkedDownload(ctx, con, session, beacon)
+		return
+	}
 	remotePath := ctx.Args.String("remote-path")
 	recurse := ctx.Flags.Bool("recurse")
 
 	ctrl := make(chan bool)
-	con.SpinUntil(fmt.Sprintf("Downloading %s ...", remotePath), ctrl)
+	con.SpinUntilTransfer(fmt.Sprintf("Downloading %s ...", remotePath), session, false, ctrl)
 	download, err := con.Rpc.Download(context.Background(), &sliverpb.DownloadReq{
 		Request: con.ActiveTarget.Request(ctx),
 		Path:    remotePath,
@@ -107,7 +111,7 @@ func prettifyDownloadName(path string) string {
 func HandleDownloadResponse(download *sliverpb.Download, ctx *grumble.Context, con *console.SliverConsoleClient) {
 	var err error
 	if download.Response != nil && download.Response.Err != "" {
-		con.PrintErrorf("%s\n", download.Response.Err)
+		con.PrintResponseErr(download.Response)
 		return
 	}
 
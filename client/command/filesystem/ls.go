@@ -60,10 +60,12 @@ func LsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
+			cacheLsResult(remotePath, ls, session, beacon)
 			PrintLs(ls, ctx.Flags, con)
 		})
 		con.PrintAsyncResponse(ls.Response)
 	} else {
+		cacheLsResult(remotePath, ls, session, beacon)
 		PrintLs(ls, ctx.Flags, con)
 	}
 }
@@ -71,7 +73,7 @@ func LsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintLs - Display an sliverpb.Ls object
 func PrintLs(ls *sliverpb.Ls, flags grumble.FlagMap, con *console.SliverConsoleClient) {
 	if ls.Response != nil && ls.Response.Err != "" {
-		con.PrintErrorf("%s\n", ls.Response.Err)
+		con.PrintResponseErr(ls.Response)
 		return
 	}
 
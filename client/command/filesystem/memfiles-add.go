@@ -60,7 +60,7 @@ func MemfilesAddCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintAddMemfile - Print the memfiles response
 func PrintAddMemfile(memfilesAdd *sliverpb.MemfilesAdd, con *console.SliverConsoleClient) {
 	if memfilesAdd.Response != nil && memfilesAdd.Response.Err != "" {
-		con.PrintErrorf("%s\n", memfilesAdd.Response.Err)
+		con.PrintResponseErr(memfilesAdd.Response)
 		return
 	}
 	con.PrintInfof("New memfile descriptor: %d\n", memfilesAdd.Fd)
@@ -66,7 +66,7 @@ func MemfilesListCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintMemfiles - Display an sliverpb.Ls object
 func PrintMemfiles(ls *sliverpb.Ls, con *console.SliverConsoleClient) {
 	if ls.Response != nil && ls.Response.Err != "" {
-		con.PrintErrorf("%s\n", ls.Response.Err)
+		con.PrintResponseErr(ls.Response)
 		return
 	}
 
@@ -70,7 +70,7 @@ func MemfilesRmCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintRmMemfile - Remove a memfile
 func PrintRmMemfile(memfilesList *sliverpb.MemfilesRm, con *console.SliverConsoleClient) {
 	if memfilesList.Response != nil && memfilesList.Response.Err != "" {
-		con.PrintErrorf("%s\n", memfilesList.Response.Err)
+		con.PrintResponseErr(memfilesList.Response)
 		return
 	}
 	con.PrintInfof("Removed memfile descriptor: %d\n", memfilesList.Fd)
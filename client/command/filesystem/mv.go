@@ -77,7 +77,7 @@ func MvCmd(ctx *grumble.Context, con *console.SliverConsoleClient) (err error) {
 // PrintMv - Print the renamed file
 func PrintMv(mv *sliverpb.Mv, con *console.SliverConsoleClient) {
 	if mv.Response != nil && mv.Response.Err != "" {
-		con.PrintErrorf("%s\n", mv.Response.Err)
+		con.PrintResponseErr(mv.Response)
 		return
 	}
 	con.PrintInfof("%s > %s\n", mv.Src, mv.Dst)
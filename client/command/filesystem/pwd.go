@@ -60,7 +60,7 @@ func PwdCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintPwd - Print the remote working directory
 func PrintPwd(pwd *sliverpb.Pwd, con *console.SliverConsoleClient) {
 	if pwd.Response != nil && pwd.Response.Err != "" {
-		con.PrintErrorf("%s\n", pwd.Response.Err)
+		con.PrintResponseErr(pwd.Response)
 		return
 	}
 	con.PrintInfof("%s\n", pwd.Path)
@@ -0,0 +1,260 @@
+package filesystem
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util"
+	"github.com/desertbit/grumble"
+	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// streamDrainTimeout - How long to wait for streamed matches that are still in
+// flight after the search's response arrives
+const streamDrainTimeout = 5 * time.Second
+
+func init() {
+	help.RegisterOpsec(consts.SearchStr, &help.OpsecInfo{
+		Risk:      help.OpsecLow,
+		Artifacts: []string{"access times of files whose contents are searched (if the file system records them)"},
+		APIs:      []string{"directory enumeration and file reads across the searched tree"},
+	})
+}
+
+// SearchCmd - Search the file system of the remote system
+func SearchCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	searchReq, err := parseSearchFlags(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	searchReq.Path = ctx.Args.String("path")
+	searchReq.Request = con.ActiveTarget.Request(ctx)
+
+	if beacon != nil {
+		search, err := con.Rpc.Search(context.Background(), searchReq)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		if search.Response != nil && search.Response.Async {
+			con.AddBeaconCallback(search.Response.TaskID, func(task *clientpb.BeaconTask) {
+				err = proto.Unmarshal(task.Response, search)
+				if err != nil {
+					con.PrintErrorf("Failed to decode response %s\n", err)
+					return
+				}
+				PrintSearch(search, con)
+			})
+			con.PrintAsyncResponse(search.Response)
+		} else {
+			PrintSearch(search, con)
+		}
+		return
+	}
+	streamSearch(session, searchReq, con)
+}
+
+// streamSearch - Print matches as the session streams them and the summary once
+// the search is done
+func streamSearch(session *clientpb.Session, searchReq *sliverpb.SearchReq, con *console.SliverConsoleClient) {
+	searchID, _ := uuid.NewV4()
+	searchReq.Stream = true
+	searchReq.SearchID = searchID.String()
+
+	listenerID, listener := con.CreateEventListener()
+	defer con.RemoveEventListener(listenerID)
+
+	type searchResult struct {
+		search *sliverpb.Search
+		err    error
+	}
+	done := make(chan searchResult, 1)
+	go func() {
+		search, err := con.Rpc.Search(context.Background(), searchReq)
+		done <- searchResult{search, err}
+	}()
+
+	printed := uint32(0)
+	printEvent := func(event *clientpb.Event) {
+		if event.EventType != consts.SearchMatchesEvent || event.Session == nil || event.Session.ID != session.ID {
+			return
+		}
+		matches := &sliverpb.SearchMatches{}
+		if proto.Unmarshal(event.Data, matches) != nil || matches.SearchID != searchReq.SearchID {
+			return
+		}
+		for _, match := range matches.Matches {
+			printSearchMatch(match, con)
+		}
+		printed += uint32(len(matches.Matches))
+	}
+
+	con.PrintInfof("Searching %s ...\n\n", searchReq.Path)
+	var result searchResult
+	for result.search == nil && result.err == nil {
+		select {
+		case event := <-listener:
+			printEvent(event)
+		case result = <-done:
+		}
+	}
+	if result.err != nil {
+		con.PrintErrorf("%s\n", result.err)
+		return
+	}
+	drain := time.After(streamDrainTimeout)
+	for printed < result.search.Streamed {
+		select {
+		case event := <-listener:
+			printEvent(event)
+		case <-drain:
+			con.PrintWarnf("%d streamed match(es) did not arrive\n", result.search.Streamed-printed)
+			printed = result.search.Streamed
+		}
+	}
+	PrintSearch(result.search, con)
+}
+
+// PrintSearch - Print the matches of a search and a summary
+func PrintSearch(search *sliverpb.Search, con *console.SliverConsoleClient) {
+	if search.Response != nil && search.Response.Err != "" {
+		con.PrintErrorf("%s\n", search.Response.Err)
+		return
+	}
+	for _, match := range search.Matches {
+		printSearchMatch(match, con)
+	}
+	total := uint32(len(search.Matches)) + search.Streamed
+	if 0 < total {
+		con.Println()
+	}
+	con.PrintInfof("%d match(es) in %d file(s) scanned under %s", total, search.Scanned, search.Path)
+	if 0 < search.Errors {
+		con.Printf(", %d could not be read", search.Errors)
+	}
+	con.Println()
+	if search.Truncated {
+		con.PrintWarnf("Search stopped early, raise --max-results or --timeout to see more\n")
+	}
+}
+
+func printSearchMatch(match *sliverpb.SearchMatch, con *console.SliverConsoleClient) {
+	modTime := time.Unix(match.ModTime, 0).Format("2006-01-02 15:04:05")
+	if match.IsDir {
+		con.Printf("%s%s%s/ %s\n", console.Bold, match.Path, console.Normal, modTime)
+		return
+	}
+	con.Printf("%s%s%s %s %s\n", console.Bold, match.Path, console.Normal, util.ByteCountBinary(match.Size), modTime)
+	for _, line := range match.Lines {
+		con.Printf("  %s%d%s: %s\n", console.Green, line.Number, console.Normal, line.Text)
+	}
+}
+
+func parseSearchFlags(ctx *grumble.Context) (*sliverpb.SearchReq, error) {
+	var err error
+	searchReq := &sliverpb.SearchReq{
+		Glob:         ctx.Flags.String("name"),
+		NameRegex:    ctx.Flags.String("regex"),
+		ContentRegex: ctx.Flags.String("content"),
+		IgnoreCase:   ctx.Flags.Bool("ignore-case"),
+		MaxDepth:     uint32(ctx.Flags.Uint("depth")),
+		MaxResults:   uint32(ctx.Flags.Uint("max-results")),
+		MaxLines:     uint32(ctx.Flags.Uint("lines")),
+		Binary:       ctx.Flags.Bool("binary"),
+		Dirs:         ctx.Flags.Bool("dirs"),
+	}
+	for _, exclude := range strings.Split(ctx.Flags.String("exclude"), ",") {
+		if exclude = strings.TrimSpace(exclude); exclude != "" {
+			searchReq.Exclude = append(searchReq.Exclude, exclude)
+		}
+	}
+	if searchReq.MinSize, err = parseSize(ctx.Flags.String("min-size")); err != nil {
+		return nil, err
+	}
+	if searchReq.MaxSize, err = parseSize(ctx.Flags.String("max-size")); err != nil {
+		return nil, err
+	}
+	if searchReq.MaxFileSize, err = parseSize(ctx.Flags.String("max-file-size")); err != nil {
+		return nil, err
+	}
+	if searchReq.ModifiedAfter, err = parseSearchTime(ctx.Flags.String("newer")); err != nil {
+		return nil, err
+	}
+	if searchReq.ModifiedBefore, err = parseSearchTime(ctx.Flags.String("older")); err != nil {
+		return nil, err
+	}
+	return searchReq, nil
+}
+
+// parseSize - Parse a size in bytes with an optional K, M, or G (1024 based) suffix
+func parseSize(value string) (int64, error) {
+	value = strings.TrimSuffix(strings.TrimSuffix(strings.ToUpper(strings.TrimSpace(value)), "B"), "I")
+	if value == "" {
+		return 0, nil
+	}
+	multiplier := int64(1)
+	switch value[len(value)-1] {
+	case 'K':
+		multiplier = 1024
+	case 'M':
+		multiplier = 1024 * 1024
+	case 'G':
+		multiplier = 1024 * 1024 * 1024
+	}
+	if 1 < multiplier {
+		value = value[:len(value)-1]
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("invalid size '%s'", value)
+	}
+	return size * multiplier, nil
+}
+
+// parseSearchTime - Parse a time as a duration before now (e.g. 24h), or a date
+func parseSearchTime(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-duration).Unix(), nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"} {
+		if timestamp, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return timestamp.Unix(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid time '%s', use a duration (e.g. 24h) or a date (e.g. 2023-01-31)", value)
+}
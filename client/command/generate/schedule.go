@@ -0,0 +1,87 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	workingHoursDay    = `(sun|mon|tue|wed|thu|fri|sat)[a-z]*`
+	workingHoursDays   = workingHoursDay + `(-` + workingHoursDay + `)?`
+	workingHoursRegexp = regexp.MustCompile(`^(?i)(` + workingHoursDays + `(,` + workingHoursDays + `)*\s+)?(\d{1,2}):(\d{2})-(\d{1,2}):(\d{2})$`)
+
+	// ErrInvalidWorkingHours - Working hours don't match '[days] HH:MM-HH:MM'
+	ErrInvalidWorkingHours = errors.New("working hours must be '[days] HH:MM-HH:MM', e.g. 'Mon-Fri 08:00-18:00'")
+)
+
+// ParseWorkingHours - Validate working hours, the implant parses them again in the
+// target's local time
+func ParseWorkingHours(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	match := workingHoursRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return "", ErrInvalidWorkingHours
+	}
+	clock := match[len(match)-4:]
+	start, err := minutesSinceMidnight(clock[0], clock[1])
+	if err != nil {
+		return "", err
+	}
+	end, err := minutesSinceMidnight(clock[2], clock[3])
+	if err != nil {
+		return "", err
+	}
+	if start == end {
+		return "", errors.New("working hours can't start and end at the same time")
+	}
+	return strings.Join(strings.Fields(value), " "), nil
+}
+
+func minutesSinceMidnight(hour string, minute string) (int, error) {
+	hours, _ := strconv.Atoi(hour)
+	minutes, _ := strconv.Atoi(minute)
+	if 24 < hours || 59 < minutes || (hours == 24 && minutes != 0) {
+		return 0, ErrInvalidWorkingHours
+	}
+	return hours*60 + minutes, nil
+}
+
+// ParseKillDate - Parse an RFC3339 kill date, or a date (UTC midnight)
+func ParseKillDate(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	killDate, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		killDate, err = time.Parse("2006-01-02", value)
+	}
+	if err != nil {
+		return "", errors.New("kill date must be RFC3339 (2006-01-02T15:04:05Z) or a date (2006-01-02)")
+	}
+	return killDate.UTC().Format(time.RFC3339), nil
+}
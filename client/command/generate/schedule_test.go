@@ -0,0 +1,61 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestParseWorkingHours(t *testing.T) {
+	valid := map[string]string{
+		"":                           "",
+		"Mon-Fri 08:00-18:00":        "Mon-Fri 08:00-18:00",
+		"  mon,WED,fri   9:30-17:00": "mon,WED,fri 9:30-17:00",
+		"22:00-06:00":                "22:00-06:00",
+		"Friday-Monday 00:00-24:00":  "Friday-Monday 00:00-24:00",
+	}
+	for value, expected := range valid {
+		workingHours, err := ParseWorkingHours(value)
+		if err != nil || workingHours != expected {
+			t.Errorf("ParseWorkingHours(%q) = %q, %v expected %q", value, workingHours, err, expected)
+		}
+	}
+	for _, value := range []string{"Mon-Fri", "Mo-Fr 08:00-18:00", "Mon, Fri 08:00-18:00", "08:00-08:00", "25:00-26:00", "08:60-09:00", "24:30-09:00"} {
+		if _, err := ParseWorkingHours(value); err == nil {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestParseKillDate(t *testing.T) {
+	valid := map[string]string{
+		"":                          "",
+		"2023-12-31":                "2023-12-31T00:00:00Z",
+		"2023-12-31T18:00:00-05:00": "2023-12-31T23:00:00Z",
+	}
+	for value, expected := range valid {
+		killDate, err := ParseKillDate(value)
+		if err != nil || killDate != expected {
+			t.Errorf("ParseKillDate(%q) = %q, %v expected %q", value, killDate, err, expected)
+		}
+	}
+	if _, err := ParseKillDate("tomorrow"); err == nil {
+		t.Error("expected an invalid kill date error")
+	}
+}
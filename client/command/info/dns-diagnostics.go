@@ -0,0 +1,101 @@
+package info
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// PrintDNSDiagnostics - Print the resolver report of a dns implant in diagnostic mode,
+// rewritten answers usually mean a split-horizon or filtering resolver
+func PrintDNSDiagnostics(diagnostics *sliverpb.DNSDiagnostics, con *console.SliverConsoleClient) {
+	if diagnostics == nil {
+		return
+	}
+	con.Println()
+	con.Printf(console.Bold+"DNS Diagnostics: %s%s (%s)\n", console.Normal, diagnostics.Parent,
+		con.FormatDateDelta(time.Unix(diagnostics.Timestamp, 0), true, false))
+	if len(diagnostics.Resolvers) == 0 {
+		con.PrintInfof("No resolvers reported\n")
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Resolver",
+		"State",
+		"Errors",
+		"Rewritten",
+		"TXT Errors",
+		"TXT Rewritten",
+		"Base58",
+		"EDNS0",
+		"TXT Encoding",
+		"Max Query",
+		"Max Answer",
+		"Avg RTT",
+	})
+	for _, resolver := range diagnostics.Resolvers {
+		state := "ok"
+		if resolver.Dropped {
+			state = console.Red + "dropped" + console.Normal
+		}
+		tw.AppendRow(table.Row{
+			resolver.Address,
+			state,
+			fmt.Sprintf("%d/%d", resolver.Errors, resolver.Queries),
+			dnsMismatch(resolver.Mismatches, resolver.Queries, resolver.Mismatch),
+			fmt.Sprintf("%d/%d", resolver.TXTErrors, resolver.TXTQueries),
+			dnsMismatch(resolver.TXTMismatches, resolver.TXTQueries, resolver.TXTMismatch),
+			presenceYesNo(resolver.Base58),
+			presenceYesNo(resolver.EDNS0),
+			resolver.TXTEncoding,
+			calibratedSize(resolver.MaxQuerySize),
+			calibratedSize(resolver.MaxAnswerSize),
+			(time.Duration(resolver.AvgRtt) * time.Millisecond).String(),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// dnsMismatch - Count of rewritten answers, with the first one as an example
+func dnsMismatch(mismatches uint32, queries uint32, example []byte) string {
+	if mismatches == 0 || len(example) == 0 {
+		return fmt.Sprintf("%d/%d", mismatches, queries)
+	}
+	if 16 < len(example) {
+		example = example[:16]
+	}
+	return fmt.Sprintf("%d/%d (%x)", mismatches, queries, example)
+}
+
+// calibratedSize - Characters a resolver was calibrated to, if it was calibrated
+func calibratedSize(size uint32) string {
+	if size == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", size)
+}
@@ -81,6 +81,8 @@ func InfoCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		con.Printf(console.Bold+"Reconnect Interval: %s%s\n", console.Normal, time.Duration(session.ReconnectInterval).String())
 		con.Printf(console.Bold+"     First Contact: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(session.FirstContact, 0), true, false))
 		con.Printf(console.Bold+"      Last Checkin: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(session.LastCheckin, 0), true, false))
+		PrintDNSDiagnostics(session.DNSDiagnostics, con)
+		PrintSnapshot(session.Snapshot, con)
 
 	} else if beacon != nil {
 
@@ -104,6 +106,9 @@ func InfoCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		con.Printf(console.Bold+"     First Contact: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(beacon.FirstContact, 0), true, false))
 		con.Printf(console.Bold+"      Last Checkin: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(beacon.LastCheckin, 0), true, false))
 		con.Printf(console.Bold+"      Next Checkin: %s%s\n", console.Normal, con.FormatDateDelta(time.Unix(beacon.NextCheckin, 0), true, true))
+		PrintPresenceSummary(beacon.Presence, con)
+		PrintDNSDiagnostics(beacon.DNSDiagnostics, con)
+		PrintSnapshot(beacon.Snapshot, con)
 
 	} else {
 		con.PrintErrorf("No target session, see `help %s`\n", consts.InfoStr)
@@ -197,7 +202,7 @@ func WhoamiCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 
 func PrintTokenOwner(cto *sliverpb.CurrentTokenOwner, con *console.SliverConsoleClient) {
 	if cto.Response != nil && cto.Response.Err != "" {
-		con.PrintErrorf("%s\n", cto.Response.Err)
+		con.PrintResponseErr(cto.Response)
 		return
 	}
 	con.PrintInfof("Current Token ID: %s", cto.Output)
@@ -0,0 +1,162 @@
+package info
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// PresenceCmd - Report whether a user is at the remote system
+func PresenceCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	req := &sliverpb.PresenceReq{Request: con.ActiveTarget.Request(ctx)}
+	switch ctx.Flags.String("checkin") {
+	case "":
+	case "on":
+		req.SetCheckin = true
+		req.Checkin = true
+	case "off":
+		req.SetCheckin = true
+	default:
+		con.PrintErrorf("Invalid --checkin value, must be 'on' or 'off'\n")
+		return
+	}
+	presence, err := con.Rpc.Presence(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if presence.Response != nil && presence.Response.Async {
+		con.AddBeaconCallback(presence.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, presence)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintPresence(presence, con)
+		})
+		con.PrintAsyncResponse(presence.Response)
+	} else {
+		PrintPresence(presence, con)
+	}
+}
+
+// PrintPresence - Print the results of the presence command
+func PrintPresence(presence *sliverpb.Presence, con *console.SliverConsoleClient) {
+	if presence.Response != nil && presence.Response.Err != "" {
+		con.PrintResponseErr(presence.Response)
+		return
+	}
+	con.Printf(console.Bold+"  Idle Time: %s%s\n", console.Normal, presenceIdleTime(presence.IdleTime))
+	con.Printf(console.Bold+"     Locked: %s%s\n", console.Normal, presenceYesNo(presence.Locked))
+	con.Printf(console.Bold+"Screensaver: %s%s\n", console.Normal, presenceYesNo(presence.ScreenSaver))
+	con.Printf(console.Bold+"    Checkin: %s%s\n", console.Normal, presenceYesNo(presence.Checkin))
+	if len(presence.Sessions) == 0 {
+		con.Println()
+		con.PrintInfof("No interactive sessions\n")
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"User",
+		"Station",
+		"State",
+		"Remote",
+		"Client",
+		"Idle",
+	})
+	for _, session := range presence.Sessions {
+		tw.AppendRow(table.Row{
+			session.ID,
+			presenceUser(session),
+			session.Station,
+			session.State,
+			presenceYesNo(session.Remote),
+			presenceClient(session),
+			presenceIdleTime(session.IdleTime),
+		})
+	}
+	con.Println()
+	con.Printf("%s\n", tw.Render())
+}
+
+// PrintPresenceSummary - One line summary of a beacon's last reported presence
+func PrintPresenceSummary(presence *sliverpb.Presence, con *console.SliverConsoleClient) {
+	if presence == nil || (presence.Response != nil && presence.Response.Err != "") {
+		return
+	}
+	remote := 0
+	for _, session := range presence.Sessions {
+		if session.Remote {
+			remote++
+		}
+	}
+	con.Printf(console.Bold+"          Presence: %sidle %s, locked %s, %d remote session(s) (%s)\n", console.Normal,
+		presenceIdleTime(presence.IdleTime), presenceYesNo(presence.Locked), remote,
+		con.FormatDateDelta(time.Unix(presence.Timestamp, 0), true, false),
+	)
+}
+
+func presenceUser(session *sliverpb.PresenceSession) string {
+	if session.Domain == "" {
+		return session.Username
+	}
+	return fmt.Sprintf("%s\\%s", session.Domain, session.Username)
+}
+
+func presenceClient(session *sliverpb.PresenceSession) string {
+	if session.ClientAddress == "" {
+		return session.ClientName
+	}
+	if session.ClientName == "" {
+		return session.ClientAddress
+	}
+	return fmt.Sprintf("%s (%s)", session.ClientName, session.ClientAddress)
+}
+
+func presenceIdleTime(seconds int64) string {
+	if seconds < 0 {
+		return "unknown"
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func presenceYesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
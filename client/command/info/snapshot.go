@@ -0,0 +1,68 @@
+package info
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/processes"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// PrintSnapshot - Print the environment snapshot an implant built with
+// --startup-snapshot registered with
+func PrintSnapshot(snapshot *sliverpb.EnvironmentSnapshot, con *console.SliverConsoleClient) {
+	if snapshot == nil {
+		return
+	}
+	con.Println()
+	con.Printf(console.Bold+"Environment Snapshot: %s%s\n", console.Normal,
+		con.FormatDateDelta(time.Unix(snapshot.Timestamp, 0), true, false))
+	con.Printf(console.Bold+"    Domain: %s%s\n", console.Normal, snapshot.Domain)
+	con.Printf(console.Bold+"      Role: %s%s\n", console.Normal, snapshot.DomainRole)
+	proxy := snapshot.Proxy
+	if proxy == "" {
+		proxy = "direct"
+	}
+	con.Printf(console.Bold+"     Proxy: %s%s\n", console.Normal, proxy)
+	con.Printf(console.Bold+"     Users: %s%s\n", console.Normal, strings.Join(console.SnapshotUsers(snapshot), ", "))
+	products := processes.KnownSecurityProducts(snapshot.Processes)
+	security := console.Red + strings.Join(products, ", ") + console.Normal
+	if len(snapshot.Processes) == 0 {
+		security = "unknown"
+	} else if len(products) == 0 {
+		security = "none identified"
+	}
+	con.Printf(console.Bold+"  Security: %s%s\n", console.Normal, security)
+	if len(snapshot.Interfaces) == 0 {
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Interface", "MAC", "Addresses"})
+	for _, iface := range snapshot.Interfaces {
+		tw.AppendRow(table.Row{iface.Name, iface.MAC, strings.Join(iface.IPAddresses, "\n")})
+	}
+	con.Printf("%s\n", tw.Render())
+}
@@ -0,0 +1,159 @@
+package info
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.SurveyStr, &help.OpsecInfo{
+		Risk:      help.OpsecLow,
+		Artifacts: []string{"registry reads of the servicing and uninstall keys (Windows)", "NetLocalGroupGetMembers on Administrators (Windows)", "dscl is run to read the admin group (macOS)"},
+		Network:   "none, the survey only reads local state",
+	})
+}
+
+// SurveyCmd - Survey the remote system, the survey is also saved to the host
+func SurveyCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	req := &sliverpb.SurveyReq{Request: con.ActiveTarget.Request(ctx)}
+	if session != nil {
+		req.ActiveC2 = session.ActiveC2
+	} else {
+		req.ActiveC2 = beacon.ActiveC2
+	}
+	showSoftware := ctx.Flags.Bool("software")
+	survey, err := con.Rpc.Survey(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if survey.Response != nil && survey.Response.Async {
+		con.AddBeaconCallback(survey.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, survey)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintSurvey(survey, showSoftware, con)
+		})
+		con.PrintAsyncResponse(survey.Response)
+	} else {
+		PrintSurvey(survey, showSoftware, con)
+	}
+}
+
+// PrintSurvey - Print a host survey as a report, the software list can be long
+// so it's only printed if requested
+func PrintSurvey(survey *sliverpb.Survey, showSoftware bool, con *console.SliverConsoleClient) {
+	if survey.Response != nil && survey.Response.Err != "" {
+		con.PrintResponseErr(survey.Response)
+		return
+	}
+	con.Printf(console.Bold+"Survey: %s%s\n", console.Normal,
+		con.FormatDateDelta(time.Unix(survey.Timestamp, 0), true, false))
+	con.Printf(console.Bold+"        OS: %s%s\n", console.Normal, survey.OS)
+	con.Printf(console.Bold+"    Kernel: %s%s (%s)\n", console.Normal, survey.Kernel, survey.Arch)
+	admins := strings.Join(survey.LocalAdmins, ", ")
+	if len(survey.LocalAdmins) == 0 {
+		admins = "none found"
+	}
+	con.Printf(console.Bold+"    Admins: %s%s\n", console.Normal, admins)
+	PrintSnapshot(survey.Environment, con)
+
+	if 0 < len(survey.Patches) {
+		con.Println()
+		con.Printf(console.Bold+"Patches (%d)%s\n", len(survey.Patches), console.Normal)
+		tw := table.NewWriter()
+		tw.SetStyle(settings.GetTableStyle(con))
+		tw.AppendHeader(table.Row{"ID", "Description", "Installed"})
+		for _, patch := range survey.Patches {
+			installed := ""
+			if patch.InstalledAt != 0 {
+				installed = time.Unix(patch.InstalledAt, 0).Format("2006-01-02")
+			}
+			tw.AppendRow(table.Row{patch.ID, patch.Description, installed})
+		}
+		con.Printf("%s\n", tw.Render())
+	}
+
+	if 0 < len(survey.Paths) {
+		con.Println()
+		con.Printf(console.Bold+"Interesting Paths (%d)%s\n", len(survey.Paths), console.Normal)
+		tw := table.NewWriter()
+		tw.SetStyle(settings.GetTableStyle(con))
+		tw.AppendHeader(table.Row{"Path", "Access", "Description"})
+		for _, path := range survey.Paths {
+			tw.AppendRow(table.Row{path.Path, surveyPathAccess(path), path.Description})
+		}
+		con.Printf("%s\n", tw.Render())
+	}
+
+	con.Println()
+	if !showSoftware {
+		con.PrintInfof("%d installed package(s), use --software to list them\n", len(survey.Software))
+	} else if 0 < len(survey.Software) {
+		con.Printf(console.Bold+"Software (%d)%s\n", len(survey.Software), console.Normal)
+		tw := table.NewWriter()
+		tw.SetStyle(settings.GetTableStyle(con))
+		tw.AppendHeader(table.Row{"Name", "Version", "Publisher"})
+		for _, pkg := range survey.Software {
+			tw.AppendRow(table.Row{pkg.Name, pkg.Version, pkg.Publisher})
+		}
+		con.Printf("%s\n", tw.Render())
+	}
+
+	for _, surveyErr := range survey.Errors {
+		con.PrintWarnf("%s\n", surveyErr)
+	}
+}
+
+func surveyPathAccess(path *sliverpb.SurveyPath) string {
+	access := ""
+	if path.Readable {
+		access += console.Green + "read" + console.Normal
+	}
+	if path.Writable {
+		if access != "" {
+			access += "/"
+		}
+		access += console.Red + "write" + console.Normal
+	}
+	if access == "" {
+		return fmt.Sprintf("%sexists%s", console.Bold, console.Normal)
+	}
+	return access
+}
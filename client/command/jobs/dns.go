@@ -20,6 +20,7 @@ package jobs
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/bishopfox/sliver/client/console"
@@ -40,6 +41,12 @@ func DNSListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	lhost := ctx.Flags.String("lhost")
 	lport := uint16(ctx.Flags.Int("lport"))
 
+	txtEncodings, err := parseTXTEncodings(domains, ctx.Flags.String("txt-encoding"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
 	con.PrintInfof("Starting DNS listener with parent domain(s) %v ...\n", domains)
 	dns, err := con.Rpc.StartDNSListener(context.Background(), &clientpb.DNSListenerReq{
 		Domains:    domains,
@@ -48,6 +55,8 @@ func DNSListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		Canaries:   !ctx.Flags.Bool("no-canaries"),
 		Persistent: ctx.Flags.Bool("persistent"),
 		EnforceOTP: !ctx.Flags.Bool("disable-otp"),
+
+		TXTEncodings: txtEncodings,
 	})
 	con.Println()
 	if err != nil {
@@ -56,3 +65,29 @@ func DNSListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		con.PrintInfof("Successfully started job #%d\n", dns.JobID)
 	}
 }
+
+// parseTXTEncodings - Either a single encoding used for every domain, or a list of
+// domain=encoding pairs, the server validates the encodings
+func parseTXTEncodings(domains []string, value string) (map[string]string, error) {
+	txtEncodings := map[string]string{}
+	if value == "" {
+		return txtEncodings, nil
+	}
+	if !strings.Contains(value, "=") {
+		for _, domain := range domains {
+			txtEncodings[domain] = value
+		}
+		return txtEncodings, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		zone, encoding, found := strings.Cut(pair, "=")
+		if !found || zone == "" {
+			return nil, fmt.Errorf("invalid txt encoding '%s', expected domain=encoding", pair)
+		}
+		if !strings.HasSuffix(zone, ".") {
+			zone += "."
+		}
+		txtEncodings[zone] = encoding
+	}
+	return txtEncodings, nil
+}
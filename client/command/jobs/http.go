@@ -55,6 +55,8 @@ func HTTPListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		EnforceOTP:      !disableOTP,
 		LongPollTimeout: int64(longPollTimeout),
 		LongPollJitter:  int64(longPollJitter),
+		C2Profile:       ctx.Flags.String("c2profile"),
+		FrontDomain:     ctx.Flags.String("front-domain"),
 	})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
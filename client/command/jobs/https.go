@@ -68,6 +68,9 @@ func HTTPSListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		EnforceOTP:      !disableOTP,
 		LongPollTimeout: int64(longPollTimeout),
 		LongPollJitter:  int64(longPollJitter),
+		C2Profile:       ctx.Flags.String("c2profile"),
+		FrontDomain:     ctx.Flags.String("front-domain"),
+		HTTP2:           ctx.Flags.Bool("http2"),
 		RandomizeJARM:   !ctx.Flags.Bool("disable-randomized-jarm"),
 	})
 	con.Println()
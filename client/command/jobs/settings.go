@@ -0,0 +1,118 @@
+package jobs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// JobsSettingsCmd - Show or change a listener's settings without restarting it
+func JobsSettingsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	jobID := uint32(ctx.Args.Int("id"))
+	current, err := con.Rpc.GetListenerSettings(context.Background(), &clientpb.ListenerSettingsReq{
+		JobID: jobID,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	changed := false
+	updated := proto.Clone(current).(*clientpb.ListenerSettings) // Only the flags the operator set change
+	if flag := ctx.Flags["long-poll-timeout"]; !flag.IsDefault {
+		timeout, err := time.ParseDuration(ctx.Flags.String("long-poll-timeout"))
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		updated.LongPollTimeout = int64(timeout)
+		changed = true
+	}
+	if flag := ctx.Flags["long-poll-jitter"]; !flag.IsDefault {
+		jitter, err := time.ParseDuration(ctx.Flags.String("long-poll-jitter"))
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		updated.LongPollJitter = int64(jitter)
+		changed = true
+	}
+	if flag := ctx.Flags["c2profile"]; !flag.IsDefault {
+		updated.C2Profile = ctx.Flags.String("c2profile")
+		changed = true
+	}
+	if ctx.Flags.Bool("enforce-otp") || ctx.Flags.Bool("disable-otp") {
+		if ctx.Flags.Bool("enforce-otp") && ctx.Flags.Bool("disable-otp") {
+			con.PrintErrorf("Cannot both enforce and disable otp\n")
+			return
+		}
+		updated.EnforceOTP = ctx.Flags.Bool("enforce-otp")
+		changed = true
+	}
+	if flag := ctx.Flags["max-nop-padding"]; !flag.IsDefault {
+		updated.MaxNOPPadding = uint32(ctx.Flags.Uint("max-nop-padding"))
+		changed = true
+	}
+	if flag := ctx.Flags["ttl"]; !flag.IsDefault {
+		updated.TTL = uint32(ctx.Flags.Uint("ttl"))
+		changed = true
+	}
+
+	if changed {
+		current, err = con.Rpc.UpdateListenerSettings(context.Background(), updated)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.PrintInfof("Updated job #%d settings\n\n", current.JobID)
+	}
+	PrintListenerSettings(current, con)
+}
+
+// PrintListenerSettings - Print the settings that apply to the listener's protocol
+func PrintListenerSettings(listenerSettings *clientpb.ListenerSettings, con *console.SliverConsoleClient) {
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendRow(table.Row{"Job", fmt.Sprintf("#%d (%s)", listenerSettings.JobID, listenerSettings.Name)})
+	switch listenerSettings.Name {
+	case "http", "https":
+		tw.AppendRow(table.Row{"Long Poll Timeout", time.Duration(listenerSettings.LongPollTimeout)})
+		tw.AppendRow(table.Row{"Long Poll Jitter", time.Duration(listenerSettings.LongPollJitter)})
+		c2Profile := listenerSettings.C2Profile
+		if c2Profile == "" {
+			c2Profile = "default"
+		}
+		tw.AppendRow(table.Row{"C2 Profile", c2Profile})
+	case "dns":
+		tw.AppendRow(table.Row{"Max NOP Padding", listenerSettings.MaxNOPPadding})
+		tw.AppendRow(table.Row{"TTL", listenerSettings.TTL})
+	}
+	tw.AppendRow(table.Row{"Enforce OTP", listenerSettings.EnforceOTP})
+	tw.AppendRow(table.Row{"Persisted", listenerSettings.Persisted})
+	con.Printf("%s\n", tw.Render())
+}
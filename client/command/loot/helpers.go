@@ -172,6 +172,16 @@ func SelectLoot(ctx *grumble.Context, rpc rpcpb.SliverRPCClient) (*clientpb.Loot
 		}
 	}
 
+	// Skip the menu if the operator gave us an id
+	if id := ctx.Args.String("id"); id != "" {
+		for _, loot := range allLoot.Loot {
+			if loot.LootID == id {
+				return loot, nil
+			}
+		}
+		return nil, errors.New("loot not found")
+	}
+
 	// Render selection table
 	buf := bytes.NewBufferString("")
 	table := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
@@ -209,3 +219,18 @@ func SelectLoot(ctx *grumble.Context, rpc rpcpb.SliverRPCClient) (*clientpb.Loot
 	}
 	return nil, errors.New("loot not found")
 }
+
+// LootIDCompleter - Completes the ids of loot in the server's loot store
+func LootIDCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	results := []string{}
+	allLoot, err := con.Rpc.LootAll(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return results
+	}
+	for _, loot := range allLoot.Loot {
+		if strings.HasPrefix(loot.LootID, prefix) {
+			results = append(results, loot.LootID)
+		}
+	}
+	return results
+}
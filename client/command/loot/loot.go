@@ -36,6 +36,7 @@ import (
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
 )
 
 // LootCmd - The loot root command
@@ -62,18 +63,39 @@ func LootCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		}
 	}
 	if filter == "" {
-		PrintAllLootTable(con.App.Stdout(), allLoot)
+		PrintAllLootTable(con.Stdout(), allLoot)
 	} else {
 		lootType, _ := lootTypeFromHumanStr(filter)
 		switch lootType {
 		case clientpb.LootType_LOOT_FILE:
-			PrintAllFileLootTable(con.App.Stdout(), allLoot)
+			PrintAllFileLootTable(con.Stdout(), allLoot)
 		case clientpb.LootType_LOOT_CREDENTIAL:
-			PrintAllCredentialLootTable(con.App.Stdout(), allLoot)
+			if con.MaskingSecrets() {
+				allLoot = maskCredentials(allLoot)
+			}
+			PrintAllCredentialLootTable(con.Stdout(), allLoot)
 		}
 	}
 }
 
+// maskCredentials - Copy of the loot with the passwords and api keys masked, the
+// table's columns aren't labeled so the console can't tell they're secrets
+func maskCredentials(allLoot *clientpb.AllLoot) *clientpb.AllLoot {
+	masked := proto.Clone(allLoot).(*clientpb.AllLoot)
+	for _, loot := range masked.Loot {
+		if loot.Credential == nil {
+			continue
+		}
+		if loot.Credential.Password != "" {
+			loot.Credential.Password = console.MaskedSecret
+		}
+		if loot.Credential.APIKey != "" {
+			loot.Credential.APIKey = console.MaskedSecret
+		}
+	}
+	return masked
+}
+
 // PrintLootFile - Display the contents of a piece of loot
 func PrintLootFile(stdout io.Writer, loot *clientpb.Loot) {
 	if loot.File == nil {
@@ -84,6 +106,9 @@ func PrintLootFile(stdout io.Writer, loot *clientpb.Loot) {
 	if loot.File.Name != "" {
 		fmt.Fprintf(stdout, "%sFile Name:%s %s\n\n", console.Bold, console.Normal, loot.File.Name)
 	}
+	if loot.OriginHostUUID != "" {
+		fmt.Fprintf(stdout, "%sOrigin Host:%s %s\n\n", console.Bold, console.Normal, loot.OriginHostUUID)
+	}
 	if loot.File.Data != nil && 0 < len(loot.File.Data) {
 		if loot.FileType == clientpb.FileType_TEXT || isText(loot.File.Data) {
 			fmt.Fprintf(stdout, "%s", string(loot.File.Data))
@@ -102,6 +127,7 @@ func PrintLootCredential(stdout io.Writer, loot *clientpb.Loot) {
 		if loot.Credential != nil {
 			fmt.Fprintf(stdout, "%s    User:%s %s\n", console.Bold, console.Normal, loot.Credential.User)
 			fmt.Fprintf(stdout, "%sPassword:%s %s\n", console.Bold, console.Normal, loot.Credential.Password)
+			printCredentialValidations(stdout, loot.Credential)
 		}
 		if loot.File != nil {
 			PrintLootFile(stdout, loot)
@@ -117,6 +143,12 @@ func PrintLootCredential(stdout io.Writer, loot *clientpb.Loot) {
 		if loot.File != nil {
 			PrintLootFile(stdout, loot)
 		}
+	case clientpb.CredentialType_HASH:
+		if loot.Credential != nil {
+			fmt.Fprintf(stdout, "%s     User:%s %s\n", console.Bold, console.Normal, loot.Credential.User)
+			fmt.Fprintf(stdout, "%sHash Type:%s %s\n", console.Bold, console.Normal, loot.Credential.HashType)
+			fmt.Fprintf(stdout, "%s     Hash:%s %s\n", console.Bold, console.Normal, loot.Credential.Hash)
+		}
 	default:
 		fmt.Fprintf(stdout, "%v\n", loot.Credential) // Well, let's give it our best
 	}
@@ -232,14 +264,15 @@ func PrintAllCredentialLootTable(stdout io.Writer, allLoot *clientpb.AllLoot) {
 	table := tabwriter.NewWriter(outputBuf, 0, 2, 2, ' ', 0)
 
 	// Column Headers
-	fmt.Fprintln(table, "Type\tName\tUser\tPassword\tAPI Key\tFile Name\tUUID\t")
-	fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+	fmt.Fprintln(table, "Type\tName\tUser\tPassword\tAPI Key\tFile Name\tStatus\tUUID\t")
+	fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
 		strings.Repeat("=", len("Type")),
 		strings.Repeat("=", len("Name")),
 		strings.Repeat("=", len("User")),
 		strings.Repeat("=", len("Password")),
 		strings.Repeat("=", len("API Key")),
 		strings.Repeat("=", len("File Name")),
+		strings.Repeat("=", len("Status")),
 		strings.Repeat("=", len("UUID")),
 	)
 	for _, loot := range allLoot.Loot {
@@ -258,13 +291,14 @@ func PrintAllCredentialLootTable(stdout io.Writer, allLoot *clientpb.AllLoot) {
 			password = loot.Credential.Password
 			apiKey = loot.Credential.APIKey
 		}
-		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t\n",
 			credentialTypeToString(loot.CredentialType),
 			loot.Name,
 			user,
 			password,
 			apiKey,
 			fileName,
+			latestValidation(loot.Credential),
 			loot.LootID,
 		)
 	}
@@ -291,6 +325,8 @@ func credentialTypeToString(value clientpb.CredentialType) string {
 		return "User/Password"
 	case clientpb.CredentialType_FILE:
 		return "File"
+	case clientpb.CredentialType_HASH:
+		return "Hash"
 	default:
 		return ""
 	}
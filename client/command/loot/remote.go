@@ -157,7 +157,7 @@ func SendLootMessage(loot *clientpb.Loot, con *console.SliverConsoleClient) {
 func LootDownload(download *sliverpb.Download, lootName string, lootType clientpb.LootType, fileType clientpb.FileType, ctx *grumble.Context, con *console.SliverConsoleClient) {
 	// Was the download successful?
 	if download.Response != nil && download.Response.Err != "" {
-		con.PrintErrorf("%s\n", download.Response.Err)
+		con.PrintResponseErr(download.Response)
 		return
 	}
 
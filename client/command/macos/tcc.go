@@ -0,0 +1,106 @@
+package macos
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+var tccAuthValues = map[int32]string{
+	0: "denied",
+	1: "unknown",
+	2: "allowed",
+	3: "limited",
+}
+
+// TCCCmd - Survey the TCC permissions granted on a macOS target
+func TCCCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if !isDarwinTarget(con) {
+		return
+	}
+	tcc, err := con.Rpc.TCC(context.Background(), &sliverpb.TCCReq{
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if tcc.Response != nil && tcc.Response.Async {
+		con.AddBeaconCallback(tcc.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, tcc)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintTCC(tcc, ctx.Flags.String("service"), con)
+		})
+		con.PrintAsyncResponse(tcc.Response)
+	} else {
+		PrintTCC(tcc, ctx.Flags.String("service"), con)
+	}
+}
+
+// PrintTCC - Print the TCC entries, optionally only those for a service
+func PrintTCC(tcc *sliverpb.TCC, service string, con *console.SliverConsoleClient) {
+	if tcc.Response != nil && tcc.Response.Err != "" {
+		con.PrintResponseErr(tcc.Response)
+		return
+	}
+	for _, err := range tcc.Errors {
+		con.PrintWarnf("%s\n", err)
+	}
+	if tcc.FullDiskAccess {
+		con.PrintInfof("Implant has full disk access (system TCC database is readable)\n\n")
+	} else {
+		con.PrintInfof("Implant does not have full disk access\n\n")
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Database", "Service", "Client", "Access", "Modified"})
+	for _, entry := range tcc.Entries {
+		if service != "" && entry.Service != service && entry.Service != "kTCCService"+service {
+			continue
+		}
+		access, ok := tccAuthValues[entry.AuthValue]
+		if !ok {
+			access = fmt.Sprintf("%d", entry.AuthValue)
+		}
+		if entry.AuthValue == 2 {
+			access = console.Green + access + console.Normal
+		}
+		tw.AppendRow(table.Row{
+			entry.Database,
+			entry.Service,
+			entry.Client,
+			access,
+			time.Unix(entry.LastModified, 0).Format(time.RFC1123),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
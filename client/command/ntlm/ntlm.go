@@ -0,0 +1,94 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// NTLMListenersCmd - List the ntlm listeners of the active session
+func NTLMListenersCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+	ntlmListeners, err := con.Rpc.GetNTLMListeners(context.Background(), &sliverpb.NTLMListenersReq{
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintWarnf("%s\n", err)
+		return
+	}
+	PrintNTLMListeners(ntlmListeners, con)
+}
+
+// PrintNTLMListeners - Print a table of ntlm listeners
+func PrintNTLMListeners(ntlmListeners *sliverpb.NTLMListeners, con *console.SliverConsoleClient) {
+	if ntlmListeners.Response != nil && ntlmListeners.Response.Err != "" {
+		con.PrintResponseErr(ntlmListeners.Response)
+		return
+	}
+	if len(ntlmListeners.Listeners) == 0 {
+		con.PrintInfof("No ntlm listeners\n")
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Bind Address",
+		"Relay Address",
+		"Allow",
+		"Connections",
+		"Captured",
+	})
+	session := con.ActiveTarget.GetSession()
+	for _, listener := range ntlmListeners.Listeners {
+		relayAddress := listener.RelayAddress
+		if relayAddress == "" {
+			relayAddress = "capture only"
+		} else if _, ok := core.LocalRportfwds.Get(session.GetID(), listener.RportfwdID); listener.Local && ok {
+			relayAddress += " (local)"
+		} else if listener.Local {
+			relayAddress += " (other console)"
+		}
+		allow := "any"
+		if 0 < len(listener.Allow) {
+			allow = strings.Join(listener.Allow, ", ")
+		}
+		tw.AppendRow(table.Row{
+			listener.ID,
+			listener.BindAddress,
+			relayAddress,
+			allow,
+			listener.Connections,
+			listener.Captured,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
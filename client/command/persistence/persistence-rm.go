@@ -0,0 +1,57 @@
+package persistence
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+)
+
+// PersistenceRmCmd - Remove a persistence record once it's been cleaned up, the
+// persistence itself is not undone
+func PersistenceRmCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	id := ctx.Args.String("id")
+	allPersistence, err := con.Rpc.PersistenceAll(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	var found *clientpb.Persistence
+	for _, persistence := range allPersistence.Persistence {
+		if id != "" && strings.HasPrefix(persistence.ID, id) {
+			found = persistence
+			break
+		}
+	}
+	if found == nil {
+		con.PrintErrorf("No persistence with id '%s'\n", id)
+		return
+	}
+	_, err = con.Rpc.PersistenceRm(context.Background(), found)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed persistence %s (%s on %s)\n", id, found.Technique, found.Hostname)
+}
@@ -0,0 +1,60 @@
+package persistence
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// PersistenceCmd - List the persistence installed on hosts
+func PersistenceCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	allPersistence, err := con.Rpc.PersistenceAll(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(allPersistence.Persistence) == 0 {
+		con.PrintInfof("No persistence recorded\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"ID", "Host", "Technique", "Target", "Path", "Operator", "Installed", "Restore"})
+	for _, persistence := range allPersistence.Persistence {
+		tw.AppendRow(table.Row{
+			strings.Split(persistence.ID, "-")[0],
+			persistence.Hostname,
+			persistence.Technique,
+			persistence.Target,
+			persistence.Path,
+			persistence.Operator,
+			time.Unix(persistence.CreatedAt, 0).Format(time.RFC1123),
+			persistence.Restore,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
@@ -0,0 +1,194 @@
+package pivots
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+)
+
+// PivotAllowCmd - Manage the implants allowed to connect to a pivot listener
+func PivotAllowCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session := con.ActiveTarget.GetSessionInteractive()
+	if session == nil {
+		return
+	}
+	pivotListeners, err := con.Rpc.PivotSessionListeners(context.Background(), &sliverpb.PivotListenersReq{
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if pivotListeners.Response != nil && pivotListeners.Response.Err != "" {
+		con.PrintResponseErr(pivotListeners.Response)
+		return
+	}
+	var listener *sliverpb.PivotListener
+	id := uint32(ctx.Flags.Int("id"))
+	if id == uint32(0) {
+		listener, err = SelectPivotListener(pivotListeners.Listeners, con)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+	}
+	for _, pivotListener := range pivotListeners.Listeners {
+		if pivotListener.ID == id {
+			listener = pivotListener
+		}
+	}
+	if listener == nil {
+		con.PrintErrorf("No pivot listener with id %d\n", id)
+		return
+	}
+
+	added, err := ImplantPeerKeys(ctx.Flags.String("add"), con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	removed, err := ImplantPeerKeys(ctx.Flags.String("remove"), con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if len(added) == 0 && len(removed) == 0 && !ctx.Flags.Bool("clear") {
+		printAllowedPeers(listener, con)
+		return
+	}
+
+	allowed := map[string][]byte{}
+	if !ctx.Flags.Bool("clear") {
+		for _, peerKey := range listener.AllowedPeerKeys {
+			allowed[string(peerKey)] = peerKey
+		}
+	}
+	for _, peerKey := range added {
+		allowed[string(peerKey)] = peerKey
+	}
+	for _, peerKey := range removed {
+		delete(allowed, string(peerKey))
+	}
+	if 0 < len(listener.AllowedPeerKeys) && len(allowed) == 0 && !ctx.Flags.Bool("clear") {
+		con.PrintErrorf("Removing every implant would allow any implant to connect, use --clear to do that\n")
+		return
+	}
+	allowedPeerKeys := [][]byte{}
+	for _, peerKey := range allowed {
+		allowedPeerKeys = append(allowedPeerKeys, peerKey)
+	}
+	listener, err = con.Rpc.PivotAllowPeers(context.Background(), &sliverpb.PivotAllowPeersReq{
+		ListenerID:      listener.ID,
+		AllowedPeerKeys: allowedPeerKeys,
+		Request:         con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if listener.Response != nil && listener.Response.Err != "" {
+		con.PrintResponseErr(listener.Response)
+		return
+	}
+	printAllowedPeers(listener, con)
+}
+
+func printAllowedPeers(listener *sliverpb.PivotListener, con *console.SliverConsoleClient) {
+	if len(listener.AllowedPeerKeys) == 0 {
+		con.PrintInfof("Any implant can connect to pivot listener %d\n", listener.ID)
+		return
+	}
+	con.PrintInfof("Implants allowed to connect to pivot listener %d:\n", listener.ID)
+	names := PeerKeyNames(con)
+	for _, name := range peerKeyNames(listener.AllowedPeerKeys, names) {
+		con.Printf("\t%s\n", name)
+	}
+}
+
+// ImplantPeerKeys - Resolve a comma separated list of implant build names to their public keys
+func ImplantPeerKeys(value string, con *console.SliverConsoleClient) ([][]byte, error) {
+	names := []string{}
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	builds, err := con.Rpc.ImplantBuilds(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+	peerKeys := [][]byte{}
+	for _, name := range names {
+		config, ok := builds.Configs[name]
+		if !ok {
+			return nil, fmt.Errorf("no implant build named '%s'", name)
+		}
+		peerKey, err := base64.RawStdEncoding.DecodeString(config.ECCPublicKey)
+		if err != nil || len(peerKey) == 0 {
+			return nil, fmt.Errorf("implant build '%s' has no public key", name)
+		}
+		peerKeys = append(peerKeys, peerKey)
+	}
+	return peerKeys, nil
+}
+
+// PeerKeyNames - Map implant public keys back to their build names
+func PeerKeyNames(con *console.SliverConsoleClient) map[string]string {
+	names := map[string]string{}
+	builds, err := con.Rpc.ImplantBuilds(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		return names
+	}
+	for name, config := range builds.Configs {
+		peerKey, err := base64.RawStdEncoding.DecodeString(config.ECCPublicKey)
+		if err == nil && 0 < len(peerKey) {
+			names[string(peerKey)] = name
+		}
+	}
+	return names
+}
+
+// peerKeyNames - Sorted names of the peer keys, unknown keys are shown as base64
+func peerKeyNames(peerKeys [][]byte, names map[string]string) []string {
+	result := []string{}
+	for _, peerKey := range peerKeys {
+		result = append(result, peerKeyName(peerKey, names))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func peerKeyName(peerKey []byte, names map[string]string) string {
+	if name, ok := names[string(peerKey)]; ok {
+		return name
+	}
+	return base64.RawStdEncoding.EncodeToString(peerKey)
+}
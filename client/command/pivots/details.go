@@ -20,7 +20,10 @@ package pivots
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
@@ -43,7 +46,7 @@ func PivotDetailsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 	if pivotListeners.Response != nil && pivotListeners.Response.Err != "" {
-		con.PrintErrorf("%s\n", pivotListeners.Response.Err)
+		con.PrintResponseErr(pivotListeners.Response)
 		return
 	}
 
@@ -75,6 +78,13 @@ func PrintPivotListenerDetails(listener *sliverpb.PivotListener, con *console.Sl
 	con.Printf("               ID: %d\n", listener.ID)
 	con.Printf("         Protocol: %s\n", PivotTypeToString(listener.Type))
 	con.Printf("     Bind Address: %s\n", listener.BindAddress)
+	if 0 < len(listener.Key) {
+		con.Printf("              Key: %s\n", hex.EncodeToString(listener.Key))
+	}
+	names := PeerKeyNames(con)
+	if 0 < len(listener.AllowedPeerKeys) {
+		con.Printf("    Allowed Peers: %s\n", strings.Join(peerKeyNames(listener.AllowedPeerKeys, names), ", "))
+	}
 	con.Printf(" Number of Pivots: %d\n", len(listener.Pivots))
 	con.Printf("\n")
 
@@ -93,4 +103,26 @@ func PrintPivotListenerDetails(listener *sliverpb.PivotListener, con *console.Sl
 		})
 	}
 	con.Printf("%s\n", tw.Render())
+
+	if 0 < len(listener.Rejected) {
+		tw := table.NewWriter()
+		tw.SetStyle(settings.GetTableStyle(con))
+		tw.SetTitle(console.Bold + "Rejected Peers" + console.Normal)
+		tw.AppendSeparator()
+		tw.AppendHeader(table.Row{
+			"Time",
+			"ID",
+			"Remote Address",
+			"Public Key",
+		})
+		for _, rejected := range listener.Rejected {
+			tw.AppendRow(table.Row{
+				time.Unix(rejected.Time, 0).Format(time.RFC1123),
+				rejected.PeerID,
+				rejected.RemoteAddress,
+				peerKeyName(rejected.PublicKey, names),
+			})
+		}
+		con.Printf("%s\n", tw.Render())
+	}
 }
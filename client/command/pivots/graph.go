@@ -22,28 +22,77 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 
 	"github.com/desertbit/grumble"
 )
 
-// PivotsGraphCmd - Display pivots for all sessions
+// PivotsGraphCmd - Display the pivot graph of all sessions
 func PivotsGraphCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
-
 	graph, err := con.Rpc.PivotGraph(context.Background(), &commonpb.Empty{})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
 
-	data, err := json.MarshalIndent(graph.Children, "", "  ")
-	if err != nil {
-		con.PrintErrorf("%s\n", err)
+	if ctx.Flags.Bool("json") {
+		data, err := json.MarshalIndent(graph.Children, "", "  ")
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.Printf("%s\n", string(data))
 		return
 	}
+	if len(graph.Children) == 0 {
+		con.PrintInfof("No sessions\n")
+		return
+	}
+	con.Printf("%s", renderPivotGraph(graph.Children))
+}
+
+// renderPivotGraph - Draw the graph as a tree, the top level implants are connected
+// to the server and each implant's pivots are drawn below it
+func renderPivotGraph(entries []*clientpb.PivotGraphEntry) string {
+	var tree strings.Builder
+	for _, entry := range entries {
+		tree.WriteString(pivotGraphNode(entry) + "\n")
+		tree.WriteString(renderPivotChildren(entry.Children, ""))
+	}
+	return tree.String()
+}
 
-	fmt.Printf("%s\n", string(data))
+func renderPivotChildren(entries []*clientpb.PivotGraphEntry, prefix string) string {
+	var tree strings.Builder
+	for index, entry := range entries {
+		branch, indent := "├── ", "│   "
+		if index == len(entries)-1 {
+			branch, indent = "└── ", "    "
+		}
+		tree.WriteString(prefix + branch + pivotGraphNode(entry) + "\n")
+		tree.WriteString(renderPivotChildren(entry.Children, prefix+indent))
+	}
+	return tree.String()
+}
 
+func pivotGraphNode(entry *clientpb.PivotGraphEntry) string {
+	node := console.Bold + entry.Name + console.Normal
+	if entry.Session != nil {
+		node += fmt.Sprintf(" (%s) %s", strings.Split(entry.Session.ID, "-")[0], entry.Session.RemoteAddress)
+	}
+	node += fmt.Sprintf(" via %s", entry.Transport)
+	if entry.Latency == 0 {
+		return node + console.Red + " no response" + console.Normal
+	}
+	latency := time.Duration(entry.Latency).Round(time.Millisecond)
+	hopLatency := time.Duration(entry.HopLatency).Round(time.Millisecond)
+	if latency == hopLatency {
+		return node + fmt.Sprintf(" %s", latency)
+	}
+	return node + fmt.Sprintf(" +%s (%s)", hopLatency, latency)
 }
@@ -0,0 +1,85 @@
+package pivots
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	insecureRand "math/rand"
+	"os"
+	"strings"
+)
+
+// defaultPipeNames - Pipe names that blend in with what's normally on a Windows host,
+// a random suffix is always appended so we don't collide with the real ones
+var defaultPipeNames = []string{
+	"mojo",
+	"chrome.sync",
+	"crashpad",
+	"msedge.nativeMessaging",
+	"MsFteWds",
+	"PSHost",
+	"dotnet-diagnostic",
+	"spoolss",
+	"TSVCPIPE",
+	"GoogleCrashServices",
+	"OneDriveSync",
+	"Teams-IPC",
+	"vmware-usbarbpipe",
+}
+
+// randomPipeName - Pick a random pipe name from the wordlist file, or the built-in names
+func randomPipeName(wordlist string) (string, error) {
+	names := defaultPipeNames
+	if wordlist != "" {
+		var err error
+		names, err = readPipeNames(wordlist)
+		if err != nil {
+			return "", err
+		}
+	}
+	name := names[insecureRand.Intn(len(names))]
+	return fmt.Sprintf("%s.%d.%d", name, 1000+insecureRand.Intn(9000), insecureRand.Uint32()), nil
+}
+
+// readPipeNames - One pipe name per line, blank lines and # comments are ignored
+func readPipeNames(wordlist string) ([]string, error) {
+	file, err := os.Open(wordlist)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	names := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		names = append(names, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, errors.New("wordlist does not contain any pipe names")
+	}
+	return names, nil
+}
@@ -43,7 +43,7 @@ func PivotsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 	if pivotListeners.Response != nil && pivotListeners.Response.Err != "" {
-		con.PrintErrorf("%s\n", pivotListeners.Response.Err)
+		con.PrintResponseErr(pivotListeners.Response)
 		return
 	}
 
@@ -20,7 +20,10 @@ package pivots
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -35,43 +38,84 @@ func StartTCPListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient)
 	}
 	bind := ctx.Flags.String("bind")
 	lport := uint16(ctx.Flags.Int("lport"))
+	allowedPeerKeys, err := ImplantPeerKeys(ctx.Flags.String("allow"), con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 	listener, err := con.Rpc.PivotStartListener(context.Background(), &sliverpb.PivotStartListenerReq{
-		Type:        sliverpb.PivotType_TCP,
-		BindAddress: fmt.Sprintf("%s:%d", bind, lport),
-		Request:     con.ActiveTarget.Request(ctx),
+		Type:            sliverpb.PivotType_TCP,
+		BindAddress:     fmt.Sprintf("%s:%d", bind, lport),
+		AllowedPeerKeys: allowedPeerKeys,
+		Request:         con.ActiveTarget.Request(ctx),
 	})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
 	if listener.Response != nil && listener.Response.Err != "" {
-		con.PrintErrorf("%s\n", listener.Response.Err)
+		con.PrintResponseErr(listener.Response)
 		return
 	}
 	con.PrintInfof("Started tcp pivot listener %s with id %d\n", listener.BindAddress, listener.ID)
+	if 0 < len(listener.AllowedPeerKeys) {
+		printAllowedPeers(listener, con)
+	}
 }
 
-// StartNamedPipeListenerCmd - Start a TCP pivot listener on the remote system
+// StartNamedPipeListenerCmd - Start a named pipe pivot listener on the remote system
 func StartNamedPipeListenerCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session := con.ActiveTarget.GetSessionInteractive()
 	if session == nil {
 		return
 	}
+	bind := ctx.Flags.String("bind")
+	wordlist := ctx.Flags.String("wordlist")
+	if bind == "" || ctx.Flags.Bool("random-name") || wordlist != "" {
+		var err error
+		bind, err = randomPipeName(wordlist)
+		if err != nil {
+			con.PrintErrorf("Failed to pick a pipe name: %s\n", err)
+			return
+		}
+	}
+	var key []byte
+	if ctx.Flags.Bool("key") {
+		key = make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			con.PrintErrorf("Failed to generate key: %s\n", err)
+			return
+		}
+	}
 	var options []bool
 	options = append(options, ctx.Flags.Bool("allow-all"))
 	listener, err := con.Rpc.PivotStartListener(context.Background(), &sliverpb.PivotStartListenerReq{
 		Type:        sliverpb.PivotType_NamedPipe,
-		BindAddress: ctx.Flags.String("bind"),
+		BindAddress: bind,
 		Request:     con.ActiveTarget.Request(ctx),
 		Options:     options,
+		Key:         key,
 	})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
 	if listener.Response != nil && listener.Response.Err != "" {
-		con.PrintErrorf("%s\n", listener.Response.Err)
+		con.PrintResponseErr(listener.Response)
 		return
 	}
 	con.PrintInfof("Started named pipe pivot listener %s with id %d\n", listener.BindAddress, listener.ID)
+	if 0 < len(listener.Key) {
+		con.PrintInfof("Generate implants with --named-pipe %s\n", NamedPipeConnectionString(session.Hostname, listener))
+	}
+}
+
+// NamedPipeConnectionString - The connection string implants need to connect to a listener
+func NamedPipeConnectionString(hostname string, listener *sliverpb.PivotListener) string {
+	pipeName := strings.TrimPrefix(listener.BindAddress, "\\\\.\\pipe\\")
+	connStr := fmt.Sprintf("%s/pipe/%s", hostname, strings.ReplaceAll(pipeName, "\\", "/"))
+	if 0 < len(listener.Key) {
+		connStr += "?key=" + hex.EncodeToString(listener.Key)
+	}
+	return connStr
 }
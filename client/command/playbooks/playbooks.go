@@ -0,0 +1,198 @@
+package playbooks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// PlaybooksCmd - Display the saved playbooks, or the steps of one playbook
+func PlaybooksCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	playbooks, err := con.Rpc.GetPlaybooks(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if name := ctx.Args.String("name"); name != "" {
+		for _, playbook := range playbooks.Playbooks {
+			if playbook.Name == name {
+				printPlaybook(playbook, con)
+				return
+			}
+		}
+		con.PrintErrorf("No playbook named '%s'\n", name)
+		return
+	}
+	if len(playbooks.Playbooks) == 0 {
+		con.PrintInfof("No playbooks\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Description",
+		"Variables",
+		"Steps",
+	})
+	for _, playbook := range playbooks.Playbooks {
+		variables := []string{}
+		for _, variable := range playbook.Variables {
+			variables = append(variables, variable.Name)
+		}
+		tw.AppendRow(table.Row{
+			playbook.Name,
+			playbook.Description,
+			strings.Join(variables, ", "),
+			len(playbook.Steps),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+func printPlaybook(playbook *clientpb.Playbook, con *console.SliverConsoleClient) {
+	con.Printf("%s%s%s %s\n", console.Bold, playbook.Name, console.Normal, playbook.Description)
+	if 0 < len(playbook.Variables) {
+		con.Println()
+		for _, variable := range playbook.Variables {
+			detail := []string{}
+			if variable.Required {
+				detail = append(detail, "required")
+			}
+			if variable.Default != "" {
+				detail = append(detail, fmt.Sprintf("default '%s'", variable.Default))
+			}
+			line := fmt.Sprintf("{{%s}}", variable.Name)
+			if 0 < len(detail) {
+				line += fmt.Sprintf(" (%s)", strings.Join(detail, ", "))
+			}
+			if variable.Description != "" {
+				line += " - " + variable.Description
+			}
+			con.Printf("  %s\n", line)
+		}
+	}
+	con.Println()
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"#",
+		"Step",
+		"Task",
+		"Args",
+		"When",
+		"Approval",
+	})
+	for index, step := range playbook.Steps {
+		names := []string{}
+		for name := range step.Args {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		args := []string{}
+		for _, name := range names {
+			args = append(args, fmt.Sprintf("%s=%s", name, step.Args[name]))
+		}
+		approval := ""
+		if step.Approval {
+			approval = "yes"
+		}
+		tw.AppendRow(table.Row{
+			index + 1,
+			step.Name,
+			step.Task,
+			strings.Join(args, " "),
+			step.When,
+			approval,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// PlaybooksImportCmd - Save a playbook from a local json file
+func PlaybooksImportCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	data, err := os.ReadFile(ctx.Args.String("file"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	playbook := &clientpb.Playbook{}
+	err = json.Unmarshal(data, playbook)
+	if err != nil {
+		con.PrintErrorf("Failed to parse playbook: %s\n", err)
+		return
+	}
+	if name := ctx.Flags.String("name"); name != "" {
+		playbook.Name = name
+	}
+	_, err = con.Rpc.SavePlaybook(context.Background(), playbook)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Saved playbook '%s' (%d steps)\n", playbook.Name, len(playbook.Steps))
+}
+
+// PlaybooksExportCmd - Write a playbook to a local json file
+func PlaybooksExportCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	name := ctx.Args.String("name")
+	playbooks, err := con.Rpc.GetPlaybooks(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	for _, playbook := range playbooks.Playbooks {
+		if playbook.Name != name {
+			continue
+		}
+		data, _ := json.MarshalIndent(playbook, "", "    ")
+		save := ctx.Args.String("file")
+		err = os.WriteFile(save, data, 0600)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.PrintInfof("Wrote playbook '%s' to %s\n", name, save)
+		return
+	}
+	con.PrintErrorf("No playbook named '%s'\n", name)
+}
+
+// PlaybooksRmCmd - Remove a saved playbook
+func PlaybooksRmCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	name := ctx.Args.String("name")
+	_, err := con.Rpc.RemovePlaybook(context.Background(), &clientpb.Playbook{Name: name})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Removed playbook '%s'\n", name)
+}
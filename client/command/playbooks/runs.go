@@ -0,0 +1,197 @@
+package playbooks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+// PlaybooksRunCmd - Run a playbook against the active target or every host with a tag
+func PlaybooksRunCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	runReq := &clientpb.PlaybookRunReq{
+		Name:      ctx.Args.String("name"),
+		Variables: map[string]string{},
+		Tag:       ctx.Flags.String("tag"),
+	}
+	for _, variable := range ctx.Args.StringList("variables") {
+		name, value, ok := strings.Cut(variable, "=")
+		if !ok {
+			con.PrintErrorf("Invalid variable '%s', variables are name=value\n", variable)
+			return
+		}
+		runReq.Variables[name] = value
+	}
+	if runReq.Tag == "" {
+		session, beacon := con.ActiveTarget.GetInteractive()
+		if session == nil && beacon == nil {
+			return
+		}
+		if session != nil {
+			runReq.SessionID = session.ID
+		} else {
+			runReq.BeaconID = beacon.ID
+		}
+	}
+	runs, err := con.Rpc.RunPlaybook(context.Background(), runReq)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	for _, run := range runs.Runs {
+		con.PrintInfof("Started playbook %s on %s (run %s)\n", run.Playbook, run.Hostname, shortID(run.ID))
+	}
+}
+
+// PlaybooksRunsCmd - Display running and recently finished playbook runs, or
+// the steps of one run
+func PlaybooksRunsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	runs, err := con.Rpc.GetPlaybookRuns(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if id := ctx.Args.String("id"); id != "" {
+		for _, run := range runs.Runs {
+			if strings.HasPrefix(run.ID, id) {
+				printRun(run, con)
+				return
+			}
+		}
+		con.PrintErrorf("No playbook run with id %s\n", id)
+		return
+	}
+	if len(runs.Runs) == 0 {
+		con.PrintInfof("No playbook runs\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"ID",
+		"Playbook",
+		"Host",
+		"Implant",
+		"Operator",
+		"State",
+		"Progress",
+		"Started",
+	})
+	for _, run := range runs.Runs {
+		finished := 0
+		for _, step := range run.Steps {
+			if step.CompletedAt != 0 {
+				finished++
+			}
+		}
+		tw.AppendRow(table.Row{
+			shortID(run.ID),
+			run.Playbook,
+			run.Hostname,
+			run.Name,
+			run.Operator,
+			runState(run.State),
+			fmt.Sprintf("%d/%d", finished, len(run.Steps)),
+			con.FormatDateDelta(time.Unix(run.StartedAt, 0), true, false),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+func printRun(run *clientpb.PlaybookRun, con *console.SliverConsoleClient) {
+	con.Printf("%sRun %s%s %s on %s (%s) started by %s - %s\n\n", console.Bold, shortID(run.ID), console.Normal,
+		run.Playbook, run.Hostname, run.Name, run.Operator, runState(run.State))
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"#",
+		"Step",
+		"State",
+		"Approved By",
+		"Result",
+	})
+	for index, step := range run.Steps {
+		result := step.Err
+		if result == "" {
+			result = strings.TrimSpace(step.Output)
+		}
+		tw.AppendRow(table.Row{
+			index + 1,
+			step.Name,
+			runState(step.State),
+			step.ApprovedBy,
+			result,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// PlaybooksApproveCmd - Approve or reject the step a run is waiting on
+func PlaybooksApproveCmd(ctx *grumble.Context, con *console.SliverConsoleClient, reject bool) {
+	run, err := con.Rpc.ApprovePlaybookStep(context.Background(), &clientpb.PlaybookApprovalReq{
+		RunID:  ctx.Args.String("id"),
+		Reject: reject,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	step := awaitingStep(run)
+	if reject {
+		con.PrintInfof("Rejected step %s of run %s, the run has stopped\n", step, shortID(run.ID))
+	} else {
+		con.PrintInfof("Approved step %s of run %s\n", step, shortID(run.ID))
+	}
+}
+
+// awaitingStep - Name of the step a run is (or was) waiting on
+func awaitingStep(run *clientpb.PlaybookRun) string {
+	for _, step := range run.Steps {
+		if step.State == "awaiting-approval" {
+			return step.Name
+		}
+	}
+	return ""
+}
+
+func runState(state string) string {
+	switch state {
+	case "completed":
+		return console.Green + state + console.Normal
+	case "failed", "rejected":
+		return console.Red + state + console.Normal
+	case "awaiting-approval":
+		return console.Orange + state + console.Normal
+	default:
+		return state
+	}
+}
+
+func shortID(id string) string {
+	return strings.Split(id, "-")[0]
+}
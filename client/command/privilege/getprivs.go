@@ -70,7 +70,7 @@ func PrintGetPrivs(privs *sliverpb.GetPrivs, pid int32, con *console.SliverConso
 	// Response is the Envelope (see RPC API), Err is part of it.
 	if privs.Response != nil && privs.Response.Err != "" {
 		con.PrintErrorf("NOTE: Information may be incomplete due to an error:\n")
-		con.PrintErrorf("%s\n", privs.Response.Err)
+		con.PrintResponseErr(privs.Response)
 	}
 	if privs.PrivInfo == nil {
 		return
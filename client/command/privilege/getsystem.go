@@ -21,13 +21,24 @@ package privilege
 import (
 	"context"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 	"google.golang.org/protobuf/proto"
 )
 
+func init() {
+	help.RegisterOpsec(consts.GetSystemStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"implant shellcode in a SYSTEM process (default spoolsv.exe)"},
+		Network:   "new c2 connection from the SYSTEM process",
+		APIs:      []string{"SeDebugPrivilege", "process injection (VirtualAllocEx, WriteProcessMemory, CreateRemoteThread)"},
+	})
+}
+
 // GetSystemCmd - Windows only, attempt to get SYSTEM on the remote system
 func GetSystemCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
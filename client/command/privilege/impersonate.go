@@ -21,13 +21,22 @@ package privilege
 import (
 	"context"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
 	"google.golang.org/protobuf/proto"
 )
 
+func init() {
+	help.RegisterOpsec(consts.ImpersonateStr, &help.OpsecInfo{
+		Risk: help.OpsecMedium,
+		APIs: []string{"OpenProcessToken and DuplicateTokenEx on another user's process", "ImpersonateLoggedOnUser"},
+	})
+}
+
 // ImpersonateCmd - Windows only, impersonate a user token
 func ImpersonateCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -36,6 +45,7 @@ func ImpersonateCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	username := ctx.Args.String("username")
+	targetID := targetID(session, beacon)
 	impersonate, err := con.Rpc.Impersonate(context.Background(), &sliverpb.ImpersonateReq{
 		Request:  con.ActiveTarget.Request(ctx),
 		Username: username,
@@ -52,10 +62,16 @@ func ImpersonateCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
+			if impersonate.Response.GetErr() == "" {
+				updateImpersonating(targetID, impersonate.Owner, con)
+			}
 			PrintImpersonate(impersonate, username, con)
 		})
 		con.PrintAsyncResponse(impersonate.Response)
 	} else {
+		if impersonate.Response.GetErr() == "" {
+			updateImpersonating(targetID, impersonate.Owner, con)
+		}
 		PrintImpersonate(impersonate, username, con)
 	}
 }
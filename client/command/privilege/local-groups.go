@@ -0,0 +1,149 @@
+package privilege
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	administratorsSID = "S-1-5-32-544"
+)
+
+// LocalGroupsCmd - Survey local group members on hosts in the network (Windows only)
+func LocalGroupsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetOS := getOS(session, beacon)
+	if targetOS != "windows" {
+		con.PrintErrorf("Command only supported on Windows.\n")
+		return
+	}
+
+	hosts := splitList(ctx.Flags.String("hosts"))
+	if hostsFile := ctx.Flags.String("hosts-file"); hostsFile != "" {
+		data, err := os.ReadFile(hostsFile)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			line = strings.TrimSpace(line)
+			if line != "" && !strings.HasPrefix(line, "#") {
+				hosts = append(hosts, line)
+			}
+		}
+	}
+	username := ctx.Flags.String("username")
+	domain := ctx.Flags.String("domain")
+	if domain == "" && strings.Contains(username, "\\") {
+		parts := strings.SplitN(username, "\\", 2)
+		domain, username = parts[0], parts[1]
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Surveying local groups on %d host(s) ...", len(hosts)), ctrl)
+	localGroups, err := con.Rpc.LocalGroups(context.Background(), &sliverpb.LocalGroupsReq{
+		Hosts:    hosts,
+		Groups:   splitList(ctx.Flags.String("groups")),
+		Username: username,
+		Domain:   domain,
+		Password: ctx.Flags.String("password"),
+		Request:  con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if localGroups.Response != nil && localGroups.Response.Async {
+		con.AddBeaconCallback(localGroups.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, localGroups)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintLocalGroups(localGroups, con)
+		})
+		con.PrintAsyncResponse(localGroups.Response)
+	} else {
+		PrintLocalGroups(localGroups, con)
+	}
+}
+
+// PrintLocalGroups - Print the results of the local-groups command, groups that
+// could not be surveyed are listed after the members
+func PrintLocalGroups(localGroups *sliverpb.LocalGroups, con *console.SliverConsoleClient) {
+	if localGroups.Response != nil && localGroups.Response.Err != "" {
+		con.PrintResponseErr(localGroups.Response)
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Host", "Group", "Member", "Type", "SID"})
+	failed := []*sliverpb.LocalGroup{}
+	members := 0
+	for _, group := range localGroups.Groups {
+		if group.Err != "" {
+			failed = append(failed, group)
+			continue
+		}
+		for _, member := range group.Members {
+			name := group.Name
+			if group.SID == administratorsSID {
+				name = console.Bold + name + console.Normal
+			}
+			tw.AppendRow(table.Row{group.Host, name, member.Name, member.Type, member.SID})
+			members++
+		}
+	}
+	if 0 < members {
+		con.Printf("%s\n", tw.Render())
+	} else {
+		con.PrintInfof("No local group members found\n")
+	}
+	for _, group := range failed {
+		con.PrintErrorf("%s: %s (%s)\n", group.Host, group.Name, group.Err)
+	}
+}
+
+func splitList(value string) []string {
+	values := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			values = append(values, item)
+		}
+	}
+	return values
+}
@@ -0,0 +1,156 @@
+package privilege
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// LogonsCmd - Enumerate the logon sessions on the remote system (Windows only)
+func LogonsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetOS := getOS(session, beacon)
+	if targetOS != "windows" {
+		con.PrintErrorf("Command only supported on Windows.\n")
+		return
+	}
+
+	showTokens := ctx.Flags.Bool("tokens")
+	logons, err := con.Rpc.LogonSessions(context.Background(), &sliverpb.LogonSessionsReq{
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if logons.Response != nil && logons.Response.Async {
+		con.AddBeaconCallback(logons.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, logons)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintLogonSessions(logons, showTokens, con)
+		})
+		con.PrintAsyncResponse(logons.Response)
+	} else {
+		PrintLogonSessions(logons, showTokens, con)
+	}
+}
+
+// PrintLogonSessions - Print the results of the logons command
+func PrintLogonSessions(logons *sliverpb.LogonSessions, showTokens bool, con *console.SliverConsoleClient) {
+	if logons.Response != nil && logons.Response.Err != "" {
+		con.PrintResponseErr(logons.Response)
+		return
+	}
+	if len(logons.Sessions) == 0 {
+		con.PrintInfof("No logon sessions\n")
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Logon ID",
+		"Session",
+		"Type",
+		"User",
+		"Auth Package",
+		"Logon Time",
+		"State",
+		"Idle",
+		"Creds",
+		"Tokens",
+	})
+	for _, logon := range logons.Sessions {
+		tw.AppendRow(table.Row{
+			fmt.Sprintf("0x%x", logon.LogonID),
+			logon.Session,
+			logon.LogonType,
+			logonUser(logon),
+			logon.AuthPackage,
+			logonTime(logon.LogonTime),
+			logon.SessionState,
+			logonIdleTime(logon.IdleTime),
+			logonCredentials(logon.HasCredentials),
+			len(logon.Tokens),
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+
+	if !showTokens {
+		return
+	}
+	for _, logon := range logons.Sessions {
+		if len(logon.Tokens) == 0 {
+			continue
+		}
+		con.Println()
+		con.Printf("%s (0x%x, %s)\n", logonUser(logon), logon.LogonID, logon.LogonType)
+		tokensTable := table.NewWriter()
+		tokensTable.SetStyle(settings.GetTableStyle(con))
+		tokensTable.AppendHeader(table.Row{"PID", "Executable", "Integrity"})
+		for _, token := range logon.Tokens {
+			tokensTable.AppendRow(table.Row{token.Pid, token.Executable, token.Integrity})
+		}
+		con.Printf("%s\n", tokensTable.Render())
+	}
+}
+
+func logonUser(logon *sliverpb.LogonSession) string {
+	if logon.Domain == "" {
+		return logon.Username
+	}
+	return fmt.Sprintf("%s\\%s", logon.Domain, logon.Username)
+}
+
+func logonTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).Format(time.RFC1123)
+}
+
+func logonIdleTime(seconds int64) string {
+	if seconds < 0 {
+		return ""
+	}
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func logonCredentials(hasCredentials bool) string {
+	if hasCredentials {
+		return "yes"
+	}
+	return "no"
+}
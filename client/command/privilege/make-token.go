@@ -21,7 +21,9 @@ package privilege
 import (
 	"context"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/desertbit/grumble"
@@ -38,6 +40,15 @@ var logonTypes = map[string]uint32{
 	"LOGON_NEW_CREDENTIALS":   9,
 }
 
+func init() {
+	help.RegisterOpsec(consts.MakeTokenStr, &help.OpsecInfo{
+		Risk:      help.OpsecMedium,
+		Artifacts: []string{"logon event (4624) for the new logon session"},
+		Network:   "authentication traffic when the token is used on the network",
+		APIs:      []string{"LogonUser"},
+	})
+}
+
 // MakeTokenCmd - Windows only, create a token using "valid" credentails
 func MakeTokenCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -60,6 +71,7 @@ func MakeTokenCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 
+	targetID := targetID(session, beacon)
 	ctrl := make(chan bool)
 	con.SpinUntil("Creating new logon session ...", ctrl)
 
@@ -84,10 +96,16 @@ func MakeTokenCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
+			if makeToken.Response.GetErr() == "" {
+				updateImpersonating(targetID, makeToken.Owner, con)
+			}
 			PrintMakeToken(makeToken, domain, username, con)
 		})
 		con.PrintAsyncResponse(makeToken.Response)
 	} else {
+		if makeToken.Response.GetErr() == "" {
+			updateImpersonating(targetID, makeToken.Owner, con)
+		}
 		PrintMakeToken(makeToken, domain, username, con)
 	}
 }
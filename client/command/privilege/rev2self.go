@@ -35,6 +35,7 @@ func RevToSelfCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 
+	targetID := targetID(session, beacon)
 	revert, err := con.Rpc.RevToSelf(context.Background(), &sliverpb.RevToSelfReq{
 		Request: con.ActiveTarget.Request(ctx),
 	})
@@ -50,10 +51,16 @@ func RevToSelfCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
+			if revert.Response.GetErr() == "" {
+				updateImpersonating(targetID, "", con)
+			}
 			PrintRev2Self(revert, con)
 		})
 		con.PrintAsyncResponse(revert.Response)
 	} else {
+		if revert.Response.GetErr() == "" {
+			updateImpersonating(targetID, "", con)
+		}
 		PrintRev2Self(revert, con)
 	}
 }
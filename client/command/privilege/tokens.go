@@ -0,0 +1,154 @@
+package privilege
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.TokensStr, &help.OpsecInfo{
+		Risk: help.OpsecLow,
+		APIs: []string{"ImpersonateLoggedOnUser with --use"},
+	})
+}
+
+// TokensCmd - Windows only, list, use, or remove the tokens the implant has made or stolen
+func TokensCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	use := ctx.Flags.Uint("use")
+	remove := ctx.Flags.Uint("remove")
+	if use != 0 && remove != 0 {
+		con.PrintErrorf("Use either --use or --remove, not both\n")
+		return
+	}
+	tokens, err := con.Rpc.Tokens(context.Background(), &sliverpb.TokensReq{
+		Request: con.ActiveTarget.Request(ctx),
+		Use:     uint32(use),
+		Remove:  uint32(remove),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	targetID := targetID(session, beacon)
+	if tokens.Response != nil && tokens.Response.Async {
+		con.AddBeaconCallback(tokens.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, tokens)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			if use != 0 || remove != 0 {
+				updateImpersonating(targetID, activeOwner(tokens), con)
+			}
+			PrintTokens(tokens, con)
+		})
+		con.PrintAsyncResponse(tokens.Response)
+	} else {
+		if use != 0 || remove != 0 {
+			updateImpersonating(targetID, activeOwner(tokens), con)
+		}
+		PrintTokens(tokens, con)
+	}
+}
+
+// PrintTokens - Print the implant's tokens, the active token is highlighted
+func PrintTokens(tokens *sliverpb.Tokens, con *console.SliverConsoleClient) {
+	if tokens.Response != nil && tokens.Response.GetErr() != "" {
+		con.PrintErrorf("%s\n", tokens.Response.GetErr())
+		return
+	}
+	if len(tokens.Tokens) == 0 {
+		con.PrintInfof("No tokens, use make-token or steal-token to add one\n")
+		return
+	}
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"ID", "Owner", "Source", "Pid", "Logon Type"})
+	for _, token := range tokens.Tokens {
+		id := fmt.Sprintf("%d", token.ID)
+		if token.Active {
+			id = fmt.Sprintf(console.Bold+console.Green+"%d*"+console.Normal, token.ID)
+		}
+		pid := ""
+		if token.Pid != 0 {
+			pid = fmt.Sprintf("%d", token.Pid)
+		}
+		tw.AppendRow(table.Row{id, token.Owner, token.Source, pid, logonTypeName(token.LogonType)})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// activeOwner - Owner of the token the implant is impersonating, if any
+func activeOwner(tokens *sliverpb.Tokens) string {
+	for _, token := range tokens.Tokens {
+		if token.Active {
+			return token.Owner
+		}
+	}
+	return ""
+}
+
+func logonTypeName(logonType uint32) string {
+	for name, value := range logonTypes {
+		if value == logonType {
+			return name
+		}
+	}
+	return ""
+}
+
+func targetID(session *clientpb.Session, beacon *clientpb.Beacon) string {
+	if session != nil {
+		return session.ID
+	}
+	return beacon.ID
+}
+
+// updateImpersonating - Update the impersonation shown in the prompt, beacon
+// results can arrive after the operator switched to another target
+func updateImpersonating(targetID string, owner string, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.Get()
+	if session != nil && session.ID == targetID {
+		session.Impersonating = owner
+	} else if beacon != nil && beacon.ID == targetID {
+		beacon.Impersonating = owner
+	} else {
+		return
+	}
+	con.App.SetPrompt(con.GetPrompt())
+}
@@ -0,0 +1,132 @@
+package processes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/client/command/completers"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	pidsCacheKey   = "ps:pids"
+	namesCacheKey  = "ps:names"
+	ownersCacheKey = "ps:owners"
+)
+
+// PidCompleter - Completes the process ids on the active session or beacon,
+// beacons only complete processes listed by 'ps'
+func PidCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	return processCompletions(prefix, pidsCacheKey, con)
+}
+
+// ProcessNameCompleter - Completes the process names on the active session or beacon
+func ProcessNameCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	return processCompletions(prefix, namesCacheKey, con)
+}
+
+// OwnerCompleter - Completes the owners of processes on the active session or beacon
+func OwnerCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	return processCompletions(prefix, ownersCacheKey, con)
+}
+
+// MigrateCompleter - Completes the value of the --pid or --process-name flags
+func MigrateCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	switch completers.LastFlag(args) {
+	case "p", "pid":
+		return PidCompleter(prefix, args, con)
+	case "n", "process-name":
+		return ProcessNameCompleter(prefix, args, con)
+	}
+	return []string{}
+}
+
+func processCompletions(prefix string, key string, con *console.SliverConsoleClient) []string {
+	session, beacon := con.ActiveTarget.Get()
+	targetID, ttl := completers.Target(session, beacon)
+	if targetID == "" {
+		return []string{}
+	}
+	values, ok := completers.Recall(targetID, key)
+	if !ok && session != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), completers.TargetTimeout)
+		defer cancel()
+		ps, err := con.Rpc.Ps(ctx, &sliverpb.PsReq{
+			Request: &commonpb.Request{SessionID: session.ID, Timeout: int64(completers.TargetTimeout)},
+		})
+		if err != nil || (ps.Response != nil && ps.Response.Err != "") {
+			return []string{}
+		}
+		cacheProcesses(ps, targetID, ttl)
+		values, _ = completers.Recall(targetID, key)
+	}
+	results := []string{}
+	for _, value := range values {
+		if strings.HasPrefix(value, prefix) {
+			// Only the rest of the value is inserted, the prefix was already unescaped
+			results = append(results, prefix+completers.Escape(value[len(prefix):]))
+		}
+	}
+	return results
+}
+
+// cacheProcesses - Cache the pids, names, and owners of a process listing
+func cacheProcesses(ps *sliverpb.Ps, targetID string, ttl time.Duration) {
+	pids := []string{}
+	names := map[string]bool{}
+	owners := map[string]bool{}
+	for _, process := range ps.Processes {
+		pids = append(pids, strconv.Itoa(int(process.Pid)))
+		if process.Executable != "" {
+			names[process.Executable] = true
+		}
+		if process.Owner != "" {
+			owners[process.Owner] = true
+		}
+	}
+	completers.Remember(targetID, pidsCacheKey, pids, ttl)
+	completers.Remember(targetID, namesCacheKey, sortedKeys(names), ttl)
+	completers.Remember(targetID, ownersCacheKey, sortedKeys(owners), ttl)
+}
+
+// cachePsResult - Cache the result of a 'ps' command
+func cachePsResult(ps *sliverpb.Ps, session *clientpb.Session, beacon *clientpb.Beacon) {
+	if ps.Response != nil && ps.Response.Err != "" {
+		return
+	}
+	targetID, ttl := completers.Target(session, beacon)
+	cacheProcesses(ps, targetID, ttl)
+}
+
+func sortedKeys(values map[string]bool) []string {
+	keys := []string{}
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
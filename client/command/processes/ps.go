@@ -88,6 +88,11 @@ var (
 	}
 )
 
+func init() {
+	// Registration events are printed by the console, which can't import us
+	console.SecurityProducts = KnownSecurityProducts
+}
+
 // PsCmd - List processes on the remote system
 func PsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -109,6 +114,7 @@ func PsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
+			cachePsResult(ps, session, beacon)
 			PrintPS(os, ps, false, ctx, con)
 			products := findKnownSecurityProducts(ps)
 			if 0 < len(products) {
@@ -118,6 +124,7 @@ func PsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		})
 		con.PrintAsyncResponse(ps.Response)
 	} else {
+		cachePsResult(ps, session, beacon)
 		PrintPS(os, ps, true, ctx, con)
 		products := findKnownSecurityProducts(ps)
 		if 0 < len(products) {
@@ -211,6 +218,19 @@ func findKnownSecurityProducts(ps *sliverpb.Ps) []string {
 	return products
 }
 
+// KnownSecurityProducts - The distinct security products among the executable names
+func KnownSecurityProducts(executables []string) []string {
+	products := []string{}
+	seen := map[string]bool{}
+	for _, executable := range executables {
+		if secTool, ok := knownSecurityTools[executable]; ok && !seen[secTool[1]] {
+			seen[secTool[1]] = true
+			products = append(products, secTool[1])
+		}
+	}
+	return products
+}
+
 // procRow - Stylizes the process information
 func procRow(tw table.Writer, proc *commonpb.Process, cmdLine bool, con *console.SliverConsoleClient) table.Row {
 	session, beacon := con.ActiveTarget.GetInteractive()
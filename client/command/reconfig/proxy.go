@@ -0,0 +1,142 @@
+package reconfig
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/desertbit/grumble"
+)
+
+// ProxyCmd - Show the proxy the active beacon/session connected through
+func ProxyCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	activeC2, proxyURL := "", ""
+	if session != nil {
+		activeC2, proxyURL = session.ActiveC2, session.ProxyURL
+	} else {
+		activeC2, proxyURL = beacon.ActiveC2, beacon.ProxyURL
+	}
+	if proxyURL == "" {
+		proxyURL = "(direct)"
+	}
+	con.Printf(console.Bold+"Active C2: %s%s\n", console.Normal, activeC2)
+	con.Printf(console.Bold+"Proxy URL: %s%s\n", console.Normal, proxyURL)
+}
+
+// ProxySetCmd - Override the proxy used by the active beacon/session's http(s) transports
+func ProxySetCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	req := &sliverpb.ProxySetReq{
+		PAC:      ctx.Flags.String("pac"),
+		Username: ctx.Flags.String("username"),
+		Password: ctx.Flags.String("password"),
+		Clear:    ctx.Flags.Bool("clear"),
+		Request:  con.ActiveTarget.Request(ctx),
+	}
+	options := 0
+	if proxyURL := ctx.Flags.String("url"); proxyURL != "" {
+		if _, err := url.Parse(proxyURL); err != nil {
+			con.PrintErrorf("Invalid proxy url: %s\n", err)
+			return
+		}
+		req.Proxy = proxyURL
+		options++
+	}
+	if req.PAC != "" {
+		if _, err := url.Parse(req.PAC); err != nil {
+			con.PrintErrorf("Invalid pac url: %s\n", err)
+			return
+		}
+		options++
+	}
+	if ctx.Flags.Bool("direct") {
+		req.Proxy = "direct"
+		options++
+	}
+	if ctx.Flags.Bool("auto") {
+		req.Proxy = "auto"
+		options++
+	}
+	if req.Clear {
+		options++
+	}
+	if options != 1 {
+		con.PrintErrorf("Specify one of --url, --pac, --direct, --auto, or --clear\n")
+		return
+	}
+	if req.PAC != "" && activeTargetOS(session, beacon) != "windows" {
+		con.PrintErrorf("PAC files are only supported by windows implants\n")
+		return
+	}
+
+	proxySet, err := con.Rpc.ProxySet(context.Background(), req)
+	if err != nil {
+		con.PrintWarnf("%s\n", err)
+		return
+	}
+	if proxySet.Response != nil && proxySet.Response.Async {
+		con.AddBeaconCallback(proxySet.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, proxySet)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			printProxySet(proxySet, con)
+		})
+		con.PrintAsyncResponse(proxySet.Response)
+	} else {
+		printProxySet(proxySet, con)
+	}
+}
+
+func printProxySet(proxySet *sliverpb.ProxySet, con *console.SliverConsoleClient) {
+	if proxySet.Response != nil && proxySet.Response.Err != "" {
+		con.PrintErrorf("%s\n", proxySet.Response.Err)
+		return
+	}
+	switch {
+	case proxySet.PAC != "":
+		con.PrintInfof("Proxy set to pac file %s, reconnecting ...\n", proxySet.PAC)
+	case proxySet.Proxy != "":
+		con.PrintInfof("Proxy set to %s, reconnecting ...\n", proxySet.Proxy)
+	default:
+		con.PrintInfof("Proxy override cleared, reconnecting ...\n")
+	}
+}
+
+func activeTargetOS(session *clientpb.Session, beacon *clientpb.Beacon) string {
+	if session != nil {
+		return session.OS
+	}
+	return beacon.OS
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/bishopfox/sliver/client/command/generate"
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -69,12 +70,26 @@ func ReconfigCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		}
 	}
 
-	reconfig, err := con.Rpc.Reconfigure(context.Background(), &sliverpb.ReconfigureReq{
+	reconfigReq := &sliverpb.ReconfigureReq{
 		ReconnectInterval: int64(reconnectInterval),
 		BeaconInterval:    int64(beaconInterval),
 		BeaconJitter:      int64(beaconJitter),
 		Request:           con.ActiveTarget.Request(ctx),
-	})
+	}
+	if workingHours := ctx.Flags.String("working-hours"); workingHours == "none" {
+		reconfigReq.ClearWorkingHours = true
+	} else if reconfigReq.WorkingHours, err = generate.ParseWorkingHours(workingHours); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if killDate := ctx.Flags.String("kill-date"); killDate == "none" {
+		reconfigReq.ClearKillDate = true
+	} else if reconfigReq.KillDate, err = generate.ParseKillDate(killDate); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	reconfig, err := con.Rpc.Reconfigure(context.Background(), reconfigReq)
 
 	if err != nil {
 		con.PrintWarnf("%s\n", err)
@@ -87,10 +102,26 @@ func ReconfigCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
+			if reconfig.Response != nil && reconfig.Response.Err != "" {
+				con.PrintResponseErr(reconfig.Response)
+				return
+			}
 			con.PrintInfof("Reconfigured beacon\n")
+			printSchedule(reconfig, con)
 		})
 		con.PrintAsyncResponse(reconfig.Response)
 	} else {
 		con.PrintInfof("Reconfiguration complete\n")
+		printSchedule(reconfig, con)
+	}
+}
+
+// printSchedule - Show the working hours and kill date the implant is using
+func printSchedule(reconfig *sliverpb.Reconfigure, con *console.SliverConsoleClient) {
+	if reconfig.WorkingHours != "" {
+		con.PrintInfof("Working hours: %s (local time)\n", reconfig.WorkingHours)
+	}
+	if reconfig.KillDate != "" {
+		con.PrintInfof("Kill date: %s\n", reconfig.KillDate)
 	}
 }
@@ -0,0 +1,104 @@
+package reconfig
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/desertbit/grumble"
+)
+
+// redirectSchemes - C2 protocols an implant can be redirected to
+var redirectSchemes = []string{"mtls", "wg", "http", "https", "dns", "icmp", "udp"}
+
+// RedirectCmd - Point the active beacon/session's transports at other C2s
+func RedirectCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+
+	req := &sliverpb.RedirectReq{
+		Clear:   ctx.Flags.Bool("clear"),
+		Request: con.ActiveTarget.Request(ctx),
+	}
+	for _, c2 := range ctx.Args.StringList("c2s") {
+		uri, err := url.Parse(c2)
+		if err != nil {
+			con.PrintErrorf("Invalid c2 url: %s\n", err)
+			return
+		}
+		if !validRedirectScheme(uri.Scheme) || uri.Host == "" {
+			con.PrintErrorf("Invalid c2 url %s, expected <%s>://<host>\n", c2, strings.Join(redirectSchemes, "|"))
+			return
+		}
+		req.C2S = append(req.C2S, uri.String())
+	}
+	if req.Clear == (0 < len(req.C2S)) {
+		con.PrintErrorf("Specify one or more c2 urls, or --clear\n")
+		return
+	}
+
+	redirect, err := con.Rpc.Redirect(context.Background(), req)
+	if err != nil {
+		con.PrintWarnf("%s\n", err)
+		return
+	}
+	if redirect.Response != nil && redirect.Response.Async {
+		con.AddBeaconCallback(redirect.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, redirect)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			printRedirect(redirect, con)
+		})
+		con.PrintAsyncResponse(redirect.Response)
+	} else {
+		printRedirect(redirect, con)
+	}
+}
+
+func printRedirect(redirect *sliverpb.Redirect, con *console.SliverConsoleClient) {
+	if redirect.Response != nil && redirect.Response.Err != "" {
+		con.PrintErrorf("%s\n", redirect.Response.Err)
+		return
+	}
+	if len(redirect.C2S) == 0 {
+		con.PrintInfof("Redirect cleared, reconnecting ...\n")
+		return
+	}
+	con.PrintInfof("Redirected to %s, reconnecting ...\n", strings.Join(redirect.C2S, ", "))
+}
+
+func validRedirectScheme(scheme string) bool {
+	for _, redirectScheme := range redirectSchemes {
+		if scheme == redirectScheme {
+			return true
+		}
+	}
+	return false
+}
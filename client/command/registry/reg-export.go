@@ -0,0 +1,262 @@
+package registry
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+// Windows REG_* value types
+const (
+	regNone     = 0
+	regSZ       = 1
+	regExpandSZ = 2
+	regBinary   = 3
+	regDWORD    = 4
+	regMultiSZ  = 7
+	regQWORD    = 11
+)
+
+var hiveNames = map[string]string{
+	"HKCR": "HKEY_CLASSES_ROOT",
+	"HKCU": "HKEY_CURRENT_USER",
+	"HKLM": "HKEY_LOCAL_MACHINE",
+	"HKPD": "HKEY_PERFORMANCE_DATA",
+	"HKU":  "HKEY_USERS",
+	"HKCC": "HKEY_CURRENT_CONFIG",
+}
+
+// RegExportCmd - Export a registry key and everything below it to a .reg file
+func RegExportCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetOS := getOS(session, beacon)
+	if targetOS != "windows" {
+		con.PrintErrorf("Registry operations can only target Windows\n")
+		return
+	}
+
+	hive := ctx.Flags.String("hive")
+	if err := checkHive(hive); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	hostname := ctx.Flags.String("hostname")
+	regPath := strings.Trim(strings.ReplaceAll(ctx.Args.String("registry-path"), "/", "\\"), "\\")
+	save := ctx.Flags.String("save")
+	targetName := hostname
+	if targetName == "" && session != nil {
+		targetName = session.Hostname
+	} else if targetName == "" {
+		targetName = beacon.Hostname
+	}
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Exporting %s ...", keyName(hive, regPath)), ctrl)
+	export, err := con.Rpc.RegistryExport(context.Background(), &sliverpb.RegistryExportReq{
+		Hive:     hive,
+		Path:     regPath,
+		Hostname: hostname,
+		Request:  con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	if export.Response != nil && export.Response.Async {
+		con.AddBeaconCallback(export.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, export)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			SaveRegExport(export, regPath, targetName, save, con)
+		})
+		con.PrintAsyncResponse(export.Response)
+	} else {
+		SaveRegExport(export, regPath, targetName, save, con)
+	}
+}
+
+// SaveRegExport - Add the export to the loot store as a .reg file, and save it
+// locally too if save is set
+func SaveRegExport(export *sliverpb.RegistryExport, regPath string, targetName string, save string, con *console.SliverConsoleClient) {
+	if export.Response != nil && export.Response.Err != "" {
+		con.PrintResponseErr(export.Response)
+		return
+	}
+	data := regFile(export)
+	fileName := regFileName(targetName, export.Hive, regPath)
+	lootName := fmt.Sprintf("Registry %s (%s)", keyName(export.Hive, regPath), targetName)
+	err := loot.AddLootFile(con.Rpc, lootName, fileName, data, false)
+	if err != nil {
+		con.PrintErrorf("Failed to save loot: %s\n", err)
+	} else {
+		con.PrintInfof("Saved %s to loot as %s\n", keyName(export.Hive, regPath), fileName)
+	}
+	if save != "" {
+		if info, err := os.Stat(save); err == nil && info.IsDir() {
+			save = filepath.Join(save, fileName)
+		}
+		err = os.WriteFile(save, data, 0o600)
+		if err != nil {
+			con.PrintErrorf("Failed to write %s: %s\n", save, err)
+		} else {
+			con.PrintInfof("Wrote %s\n", save)
+		}
+	}
+	PrintRegExport(export, con)
+}
+
+// PrintRegExport - Print a summary of the export
+func PrintRegExport(export *sliverpb.RegistryExport, con *console.SliverConsoleClient) {
+	if export.Response != nil && export.Response.Err != "" {
+		con.PrintResponseErr(export.Response)
+		return
+	}
+	values := 0
+	for _, key := range export.Keys {
+		values += len(key.Values)
+	}
+	con.PrintInfof("Exported %d keys and %d values\n", len(export.Keys), values)
+	printRegErrors(export.Errors, con)
+}
+
+func printRegErrors(errs []string, con *console.SliverConsoleClient) {
+	if len(errs) == 0 {
+		return
+	}
+	con.PrintWarnf("Skipped %d keys that could not be read:\n", len(errs))
+	for _, err := range errs {
+		con.Printf("  %s\n", err)
+	}
+}
+
+// keyName - The hive and path the way they're shown in the console
+func keyName(hive string, regPath string) string {
+	if regPath == "" {
+		return hive
+	}
+	return hive + "\\" + regPath
+}
+
+func regFileName(targetName string, hive string, regPath string) string {
+	name := strings.NewReplacer("\\", "_", "/", "_", " ", "_", ":", "_").Replace(keyName(hive, regPath))
+	if targetName != "" {
+		name = targetName + "_" + name
+	}
+	return name + ".reg"
+}
+
+// regFile - Format the export the way regedit does, utf-16 with a byte order
+// mark and crlf line endings so it can be imported as is
+func regFile(export *sliverpb.RegistryExport) []byte {
+	lines := []string{"Windows Registry Editor Version 5.00", ""}
+	hiveName := hiveNames[export.Hive]
+	for _, key := range export.Keys {
+		keyPath := hiveName
+		if key.Path != "" {
+			keyPath += "\\" + key.Path
+		}
+		lines = append(lines, fmt.Sprintf("[%s]", keyPath))
+		for _, value := range key.Values {
+			lines = append(lines, regValue(value))
+		}
+		lines = append(lines, "")
+	}
+	chars := utf16.Encode([]rune(strings.Join(lines, "\r\n") + "\r\n"))
+	data := make([]byte, 2+len(chars)*2)
+	data[0], data[1] = 0xff, 0xfe
+	for index, char := range chars {
+		binary.LittleEndian.PutUint16(data[2+index*2:], char)
+	}
+	return data
+}
+
+// regValue - A value line of a .reg file, strings and dwords are written as
+// text and everything else as hex(<type>)
+func regValue(value *sliverpb.RegistryValue) string {
+	name := "@"
+	if value.Name != "" {
+		name = regQuote(value.Name)
+	}
+	switch value.Type {
+	case regSZ:
+		if str, ok := regString(value.Data); ok {
+			return fmt.Sprintf("%s=%s", name, regQuote(str))
+		}
+	case regDWORD:
+		if len(value.Data) == 4 {
+			return fmt.Sprintf("%s=dword:%08x", name, binary.LittleEndian.Uint32(value.Data))
+		}
+	}
+	prefix := "hex"
+	if value.Type != regBinary {
+		prefix = fmt.Sprintf("hex(%x)", value.Type)
+	}
+	hexBytes := make([]string, len(value.Data))
+	for index, b := range value.Data {
+		hexBytes[index] = fmt.Sprintf("%02x", b)
+	}
+	return fmt.Sprintf("%s=%s:%s", name, prefix, strings.Join(hexBytes, ","))
+}
+
+// regString - Decode a null terminated utf-16 string, returns false if the
+// data can't be written as a quoted string
+func regString(data []byte) (string, bool) {
+	if len(data)%2 != 0 {
+		return "", false
+	}
+	str := utf16String(data)
+	str = strings.TrimSuffix(str, "\x00")
+	if strings.Contains(str, "\x00") {
+		return "", false
+	}
+	return str, true
+}
+
+func utf16String(data []byte) string {
+	chars := make([]uint16, len(data)/2)
+	for index := range chars {
+		chars[index] = binary.LittleEndian.Uint16(data[index*2:])
+	}
+	return string(utf16.Decode(chars))
+}
+
+func regQuote(str string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(str) + `"`
+}
@@ -0,0 +1,85 @@
+package registry
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func utf16Bytes(str string) []byte {
+	chars := utf16.Encode([]rune(str))
+	data := make([]byte, len(chars)*2)
+	for index, char := range chars {
+		binary.LittleEndian.PutUint16(data[index*2:], char)
+	}
+	return data
+}
+
+func TestRegFile(t *testing.T) {
+	export := &sliverpb.RegistryExport{
+		Hive: "HKLM",
+		Keys: []*sliverpb.RegistryKey{
+			{Path: `SOFTWARE\Test`, Values: []*sliverpb.RegistryValue{
+				{Name: "", Type: regSZ, Data: utf16Bytes("default\x00")},
+				{Name: `Path "quoted"`, Type: regSZ, Data: utf16Bytes(`C:\Temp` + "\x00")},
+				{Name: "Count", Type: regDWORD, Data: []byte{0x2a, 0, 0, 0}},
+				{Name: "Blob", Type: regBinary, Data: []byte{0xde, 0xad}},
+				{Name: "Big", Type: regQWORD, Data: []byte{1, 0, 0, 0, 0, 0, 0, 0}},
+			}},
+			{Path: `SOFTWARE\Test\Empty`},
+		},
+	}
+	data := regFile(export)
+	if data[0] != 0xff || data[1] != 0xfe {
+		t.Fatalf("missing utf-16 byte order mark")
+	}
+	expected := strings.Join([]string{
+		"Windows Registry Editor Version 5.00",
+		"",
+		`[HKEY_LOCAL_MACHINE\SOFTWARE\Test]`,
+		`@="default"`,
+		`"Path \"quoted\""="C:\\Temp"`,
+		`"Count"=dword:0000002a`,
+		`"Blob"=hex:de,ad`,
+		`"Big"=hex(b):01,00,00,00,00,00,00,00`,
+		"",
+		`[HKEY_LOCAL_MACHINE\SOFTWARE\Test\Empty]`,
+		"",
+	}, "\r\n") + "\r\n"
+	if text := utf16String(data[2:]); text != expected {
+		t.Fatalf("unexpected .reg file:\n%s\nexpected:\n%s", text, expected)
+	}
+}
+
+func TestRegValueFallback(t *testing.T) {
+	// Strings with embedded nulls and short dwords can't be written as text
+	value := regValue(&sliverpb.RegistryValue{Name: "a", Type: regSZ, Data: utf16Bytes("x\x00y\x00")})
+	if value != `"a"=hex(1):78,00,00,00,79,00,00,00` {
+		t.Fatalf("unexpected value %s", value)
+	}
+	value = regValue(&sliverpb.RegistryValue{Name: "b", Type: regDWORD, Data: []byte{1}})
+	if value != `"b"=hex(4):01` {
+		t.Fatalf("unexpected value %s", value)
+	}
+}
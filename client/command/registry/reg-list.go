@@ -45,10 +45,12 @@ func RegListSubKeysCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	hostname := ctx.Flags.String("hostname")
 
 	regList, err := con.Rpc.RegistryListSubKeys(context.Background(), &sliverpb.RegistrySubKeyListReq{
-		Hive:     hive,
-		Hostname: hostname,
-		Path:     regPath,
-		Request:  con.ActiveTarget.Request(ctx),
+		Hive:      hive,
+		Hostname:  hostname,
+		Path:      regPath,
+		Recursive: ctx.Flags.Bool("recursive"),
+		Depth:     uint32(ctx.Flags.Int("depth")),
+		Request:   con.ActiveTarget.Request(ctx),
 	})
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
@@ -73,7 +75,7 @@ func RegListSubKeysCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintListSubKeys - Print the list sub keys command result
 func PrintListSubKeys(regList *sliverpb.RegistrySubKeyList, hive string, regPath string, con *console.SliverConsoleClient) {
 	if regList.Response != nil && regList.Response.Err != "" {
-		con.PrintErrorf("%s\n", regList.Response.Err)
+		con.PrintResponseErr(regList.Response)
 		return
 	}
 	if 0 < len(regList.Subkeys) {
@@ -124,7 +126,7 @@ func RegListValuesCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintListValues - Print the registry list values
 func PrintListValues(regList *sliverpb.RegistryValuesList, hive string, regPath string, con *console.SliverConsoleClient) {
 	if regList.Response != nil && regList.Response.Err != "" {
-		con.PrintErrorf("%s\n", regList.Response.Err)
+		con.PrintResponseErr(regList.Response)
 		return
 	}
 	if 0 < len(regList.ValueNames) {
@@ -146,7 +146,7 @@ func RegReadCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 // PrintRegRead - Print the results of the registry read command
 func PrintRegRead(regRead *sliverpb.RegistryRead, con *console.SliverConsoleClient) {
 	if regRead.Response != nil && regRead.Response.Err != "" {
-		con.PrintErrorf("%s\n", regRead.Response.Err)
+		con.PrintResponseErr(regRead.Response)
 		return
 	}
 	con.Println(regRead.Value)
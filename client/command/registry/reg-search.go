@@ -0,0 +1,156 @@
+package registry
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+var regTypeNames = map[uint32]string{
+	regNone:     "REG_NONE",
+	regSZ:       "REG_SZ",
+	regExpandSZ: "REG_EXPAND_SZ",
+	regBinary:   "REG_BINARY",
+	regDWORD:    "REG_DWORD",
+	regMultiSZ:  "REG_MULTI_SZ",
+	regQWORD:    "REG_QWORD",
+}
+
+// RegSearchCmd - Search the keys, value names and value data below a registry key
+func RegSearchCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	targetOS := getOS(session, beacon)
+	if targetOS != "windows" {
+		con.PrintErrorf("Registry operations can only target Windows\n")
+		return
+	}
+
+	hive := ctx.Flags.String("hive")
+	if err := checkHive(hive); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	keyPattern := ctx.Flags.String("key")
+	valuePattern := ctx.Flags.String("value")
+	dataPattern := ctx.Flags.String("data")
+	if keyPattern == "" && valuePattern == "" && dataPattern == "" {
+		con.PrintErrorf("Provide a --key, --value or --data pattern\n")
+		return
+	}
+	regPath := strings.Trim(strings.ReplaceAll(ctx.Args.String("registry-path"), "/", "\\"), "\\")
+
+	ctrl := make(chan bool)
+	con.SpinUntil(fmt.Sprintf("Searching %s ...", keyName(hive, regPath)), ctrl)
+	search, err := con.Rpc.RegistrySearch(context.Background(), &sliverpb.RegistrySearchReq{
+		Hive:         hive,
+		Path:         regPath,
+		Hostname:     ctx.Flags.String("hostname"),
+		KeyPattern:   keyPattern,
+		ValuePattern: valuePattern,
+		DataPattern:  dataPattern,
+		MaxResults:   uint32(ctx.Flags.Int("max")),
+		Request:      con.ActiveTarget.Request(ctx),
+	})
+	ctrl <- true
+	<-ctrl
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+
+	if search.Response != nil && search.Response.Async {
+		con.AddBeaconCallback(search.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, search)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintRegSearch(search, hive, con)
+		})
+		con.PrintAsyncResponse(search.Response)
+	} else {
+		PrintRegSearch(search, hive, con)
+	}
+}
+
+// PrintRegSearch - Print the matching keys and values
+func PrintRegSearch(search *sliverpb.RegistrySearch, hive string, con *console.SliverConsoleClient) {
+	if search.Response != nil && search.Response.Err != "" {
+		con.PrintResponseErr(search.Response)
+		return
+	}
+	if len(search.Matches) == 0 {
+		con.PrintInfof("No matches\n")
+	}
+	for _, match := range search.Matches {
+		con.Printf("%s\n", keyName(hive, match.Path))
+		for _, value := range match.Values {
+			name := value.Name
+			if name == "" {
+				name = "(Default)"
+			}
+			con.Printf("    %s\t%s\t%s\n", name, regTypeName(value.Type), valueString(value))
+		}
+	}
+	if search.Truncated {
+		con.PrintWarnf("Stopped after %d matches, use --max to see more\n", len(search.Matches))
+	}
+	printRegErrors(search.Errors, con)
+}
+
+func regTypeName(valueType uint32) string {
+	if name, ok := regTypeNames[valueType]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%x", valueType)
+}
+
+// valueString - The value's data the way regedit shows it
+func valueString(value *sliverpb.RegistryValue) string {
+	switch value.Type {
+	case regSZ, regExpandSZ:
+		return strings.TrimRight(utf16String(value.Data), "\x00")
+	case regMultiSZ:
+		return strings.Join(strings.Split(strings.TrimRight(utf16String(value.Data), "\x00"), "\x00"), "\\0")
+	case regDWORD:
+		if len(value.Data) == 4 {
+			dword := binary.LittleEndian.Uint32(value.Data)
+			return fmt.Sprintf("0x%08x (%d)", dword, dword)
+		}
+	case regQWORD:
+		if len(value.Data) == 8 {
+			qword := binary.LittleEndian.Uint64(value.Data)
+			return fmt.Sprintf("0x%016x (%d)", qword, qword)
+		}
+	}
+	return hex.EncodeToString(value.Data)
+}
@@ -21,9 +21,12 @@ package rportfwd
 import (
 	"context"
 	"fmt"
+	"net"
 	"regexp"
+	"strings"
 
 	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 
 	"github.com/desertbit/grumble"
@@ -56,22 +59,62 @@ func StartRportFwdListenerCmd(ctx *grumble.Context, con *console.SliverConsoleCl
 	if portNumberOnlyRegexp.MatchString(forwardAddress) {
 		forwardAddress = fmt.Sprintf("127.0.0.1:%s", forwardAddress)
 	}
+	local := ctx.Flags.Bool("local")
+	if _, _, err := net.SplitHostPort(forwardAddress); local && err != nil {
+		con.PrintErrorf("Invalid remote address: %s\n", err)
+		return
+	}
+	allow, err := ParseAllowList(ctx.Flags.String("allow"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
 	rportfwdListener, err := con.Rpc.StartRportFwdListener(context.Background(), &sliverpb.RportFwdStartListenerReq{
 		Request:        con.ActiveTarget.Request(ctx),
 		BindAddress:    bindAddress,
 		ForwardAddress: forwardAddress,
+		Local:          local,
+		Allow:          allow,
 	})
 	if err != nil {
 		con.PrintWarnf("%s\n", err)
 		return
 	}
+	if local && rportfwdListener.Response.GetErr() == "" {
+		core.LocalRportfwds.Add(session.ID, rportfwdListener.ID, forwardAddress)
+	}
 	printStartedRportFwdListener(rportfwdListener, con)
 }
 
+// ParseAllowList - Parse a comma separated list of ips and/or cidrs
+func ParseAllowList(value string) ([]string, error) {
+	allow := []string{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if net.ParseIP(entry) == nil {
+			if _, _, err := net.ParseCIDR(entry); err != nil {
+				return nil, fmt.Errorf("invalid ip or cidr '%s'", entry)
+			}
+		}
+		allow = append(allow, entry)
+	}
+	return allow, nil
+}
+
 func printStartedRportFwdListener(rportfwdListener *sliverpb.RportFwdListener, con *console.SliverConsoleClient) {
 	if rportfwdListener.Response != nil && rportfwdListener.Response.Err != "" {
 		con.PrintErrorf("%s", rportfwdListener.Response.Err)
 		return
 	}
-	con.PrintInfof("Reverse port forwarding %s <- %s\n", rportfwdListener.ForwardAddress, rportfwdListener.BindAddress)
+	if rportfwdListener.Local {
+		con.PrintInfof("Reverse port forwarding %s (local) <- %s\n", rportfwdListener.ForwardAddress, rportfwdListener.BindAddress)
+	} else {
+		con.PrintInfof("Reverse port forwarding %s <- %s\n", rportfwdListener.ForwardAddress, rportfwdListener.BindAddress)
+	}
+	if 0 < len(rportfwdListener.Allow) {
+		con.PrintInfof("Only accepting connections from %s\n", strings.Join(rportfwdListener.Allow, ", "))
+	}
 }
@@ -22,6 +22,7 @@ import (
 	"context"
 
 	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 
 	"github.com/desertbit/grumble"
@@ -43,6 +44,9 @@ func StopRportFwdListenerCmd(ctx *grumble.Context, con *console.SliverConsoleCli
 		con.PrintWarnf("%s\n", err)
 		return
 	}
+	if rportfwdListener.Response.GetErr() == "" {
+		core.LocalRportfwds.Remove(session.ID, rportfwdListener.ID)
+	}
 	printStoppedRportFwdListener(rportfwdListener, con)
 }
 
@@ -20,10 +20,14 @@ package rportfwd
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/core"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util"
 	"github.com/desertbit/grumble"
 	"github.com/jedib0t/go-pretty/v6/table"
 )
@@ -47,7 +51,7 @@ func RportFwdListenersCmd(ctx *grumble.Context, con *console.SliverConsoleClient
 
 func PrintRportFwdListeners(rportfwdListeners *sliverpb.RportFwdListeners, flags grumble.FlagMap, con *console.SliverConsoleClient) {
 	if rportfwdListeners.Response != nil && rportfwdListeners.Response.Err != "" {
-		con.PrintErrorf("%s\n", rportfwdListeners.Response.Err)
+		con.PrintResponseErr(rportfwdListeners.Response)
 		return
 	}
 
@@ -62,12 +66,33 @@ func PrintRportFwdListeners(rportfwdListeners *sliverpb.RportFwdListeners, flags
 		"ID",
 		"Remote Address",
 		"Bind Address",
+		"Allow",
+		"Connections",
+		"Rejected",
+		"Sent",
+		"Received",
 	})
+	session := con.ActiveTarget.GetSession()
 	for _, p := range rportfwdListeners.Listeners {
+		remoteAddress := p.ForwardAddress
+		if _, ok := core.LocalRportfwds.Get(session.GetID(), p.ID); p.Local && ok {
+			remoteAddress += " (local)"
+		} else if p.Local {
+			remoteAddress += " (other console)"
+		}
+		allow := "any"
+		if 0 < len(p.Allow) {
+			allow = strings.Join(p.Allow, ", ")
+		}
 		tw.AppendRow(table.Row{
 			p.ID,
-			p.ForwardAddress,
+			remoteAddress,
 			p.BindAddress,
+			allow,
+			fmt.Sprintf("%d (%d active)", p.Connections, p.ActiveConnections),
+			p.RejectedConnections,
+			util.ByteCountBinary(int64(p.BytesSent)),
+			util.ByteCountBinary(int64(p.BytesReceived)),
 		})
 	}
 	con.Printf("%s\n", tw.Render())
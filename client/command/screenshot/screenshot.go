@@ -23,19 +23,33 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/command/loot"
+	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/util"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
 )
 
+func init() {
+	help.RegisterOpsec(consts.ScreenshotStr, &help.OpsecInfo{
+		Risk:    help.OpsecLow,
+		Network: "upload of the screenshot",
+		APIs:    []string{"screen capture apis"},
+	})
+}
+
 // ScreenshotCmd - Take a screenshot of the remote system
 func ScreenshotCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session, beacon := con.ActiveTarget.GetInteractive()
@@ -44,23 +58,53 @@ func ScreenshotCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	targetOS := getOS(session, beacon)
-	if targetOS != "windows" && targetOS != "linux" {
+	if targetOS != "windows" && targetOS != "linux" && targetOS != "darwin" {
 		con.PrintWarnf("Target platform may not support screenshots!\n")
 		return
 	}
 
-	screenshot, err := con.Rpc.Screenshot(context.Background(), &sliverpb.ScreenshotReq{
+	format := strings.ToLower(ctx.Flags.String("format"))
+	if format == "jpg" {
+		format = "jpeg"
+	}
+	if format != "png" && format != "jpeg" {
+		con.PrintErrorf("Unsupported format '%s', use png or jpeg\n", format)
+		return
+	}
+	quality := ctx.Flags.Int("quality")
+	if quality < 0 || 100 < quality {
+		con.PrintErrorf("Quality must be between 1 and 100\n")
+		return
+	}
+	screenshotReq := &sliverpb.ScreenshotReq{
+		Display: uint32(ctx.Flags.Uint("display")),
+		Split:   ctx.Flags.Bool("split"),
+		List:    ctx.Flags.Bool("list"),
+		Format:  format,
+		Quality: uint32(quality),
 		Request: con.ActiveTarget.Request(ctx),
-	})
+	}
+
+	if watch := ctx.Flags.String("watch"); watch != "" {
+		interval, err := time.ParseDuration(watch)
+		if err != nil || interval <= 0 {
+			con.PrintErrorf("Invalid watch interval '%s'\n", watch)
+			return
+		}
+		if beacon != nil {
+			con.PrintErrorf("Watch mode requires an interactive session\n")
+			return
+		}
+		watchScreenshots(ctx, screenshotReq, interval, session.Hostname, con)
+		return
+	}
+
+	screenshot, err := con.Rpc.Screenshot(context.Background(), screenshotReq)
 	if err != nil {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
 
-	saveLoot := ctx.Flags.Bool("loot")
-	lootName := ctx.Flags.String("name")
-	saveTo := ctx.Flags.String("save")
-
 	hostname := getHostname(session, beacon)
 	if screenshot.Response != nil && screenshot.Response.Async {
 		con.AddBeaconCallback(screenshot.Response.TaskID, func(task *clientpb.BeaconTask) {
@@ -69,76 +113,190 @@ func ScreenshotCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				con.PrintErrorf("Failed to decode response %s\n", err)
 				return
 			}
-			if saveLoot {
-				if len(screenshot.Data) > 0 {
-					LootScreenshot(screenshot, lootName, hostname, con)
-				} else {
-					con.PrintErrorf("Cannot loot screenshot because it contained no data")
-				}
-			}
-
-			if !saveLoot || saveTo != "" {
-				PrintScreenshot(screenshot, hostname, ctx, con)
-			}
+			handleScreenshot(screenshot, screenshotReq, hostname, ctx, con)
 		})
 		con.PrintAsyncResponse(screenshot.Response)
 	} else {
-		if saveLoot {
-			if len(screenshot.Data) > 0 {
-				LootScreenshot(screenshot, lootName, hostname, con)
-			} else {
-				con.PrintErrorf("Cannot loot screenshot because it contained no data")
-			}
-		}
-
-		if !saveLoot || saveTo != "" {
-			PrintScreenshot(screenshot, hostname, ctx, con)
-		}
+		handleScreenshot(screenshot, screenshotReq, hostname, ctx, con)
 	}
 }
 
-// PrintScreenshot - Handle the screenshot command response
-func PrintScreenshot(screenshot *sliverpb.Screenshot, hostname string, ctx *grumble.Context, con *console.SliverConsoleClient) {
-	timestamp := time.Now().Format("20060102150405")
+func handleScreenshot(screenshot *sliverpb.Screenshot, screenshotReq *sliverpb.ScreenshotReq, hostname string, ctx *grumble.Context, con *console.SliverConsoleClient) {
+	if screenshot.Response != nil && screenshot.Response.Err != "" {
+		con.PrintErrorf("%s\n", screenshot.Response.Err)
+		return
+	}
+	if screenshotReq.List {
+		PrintDisplays(screenshot, con)
+		return
+	}
 
+	saveLoot := ctx.Flags.Bool("loot")
 	saveTo := ctx.Flags.String("save")
-	var saveToFile *os.File
-	var err error
-	if saveTo == "" {
-		tmpFileName := filepath.Base(fmt.Sprintf("screenshot_%s_%s_*.png", filepath.Base(hostname), timestamp))
-		saveToFile, err = ioutil.TempFile("", tmpFileName)
+	if saveLoot {
+		if 0 < len(Images(screenshot)) {
+			LootScreenshot(screenshot, ctx.Flags.String("name"), hostname, con)
+		} else {
+			con.PrintErrorf("Cannot loot screenshot because it contained no data\n")
+		}
+	}
+	if !saveLoot || saveTo != "" {
+		PrintScreenshot(screenshot, hostname, ctx, con)
+	}
+}
+
+// watchScreenshots - Take a screenshot every interval until the operator hits ctrl-c,
+// --save is the directory the screenshots are written to
+func watchScreenshots(ctx *grumble.Context, screenshotReq *sliverpb.ScreenshotReq, interval time.Duration, hostname string, con *console.SliverConsoleClient) {
+	saveDir := ctx.Flags.String("save")
+	if saveDir != "" {
+		err := os.MkdirAll(saveDir, 0700)
 		if err != nil {
 			con.PrintErrorf("%s\n", err)
 			return
 		}
-	} else {
-		saveToFile, err = os.OpenFile(saveTo, os.O_WRONLY|os.O_CREATE, 0600)
+	}
+	saveLoot := ctx.Flags.Bool("loot")
+	lootName := ctx.Flags.String("name")
+
+	sigint := make(chan os.Signal, 1) // Catch keyboard interrupts
+	signal.Notify(sigint, os.Interrupt)
+	defer signal.Stop(sigint)
+
+	con.PrintInfof("Taking a screenshot every %s, press ctrl-c to stop ...\n", interval)
+	count := 0
+	for {
+		screenshot, err := con.Rpc.Screenshot(context.Background(), screenshotReq)
 		if err != nil {
-			con.PrintErrorf("Error creating file: %s\n", err)
+			con.PrintErrorf("%s\n", err)
+		} else if screenshot.Response != nil && screenshot.Response.Err != "" {
+			con.PrintErrorf("%s\n", screenshot.Response.Err)
+		} else {
+			count++
+			if saveLoot {
+				name := lootName
+				if name != "" {
+					name = fmt.Sprintf("%s_%d", lootName, count)
+				}
+				LootScreenshot(screenshot, name, hostname, con)
+			}
+			if !saveLoot || saveDir != "" {
+				saveTo := ""
+				if saveDir != "" {
+					saveTo = filepath.Join(saveDir, screenshotFileName(screenshot, hostname, time.Now()))
+				}
+				saveScreenshot(screenshot, hostname, saveTo, con)
+			}
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-sigint:
+			con.PrintInfof("Stopped after %d screenshot(s)\n", count)
 			return
 		}
 	}
-	defer saveToFile.Close()
-	var n int
-	n, err = saveToFile.Write(screenshot.Data)
-	if err != nil {
-		con.PrintErrorf("Error writting file: %s\n", err)
+}
+
+// PrintDisplays - Print the displays of a screenshot list response
+func PrintDisplays(screenshot *sliverpb.Screenshot, con *console.SliverConsoleClient) {
+	if len(screenshot.Displays) == 0 {
+		con.PrintInfof("No displays\n")
 		return
 	}
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Display", "X", "Y", "Width", "Height"})
+	for _, display := range screenshot.Displays {
+		tw.AppendRow(table.Row{display.Index, display.X, display.Y, display.Width, display.Height})
+	}
+	con.Printf("%s\n", tw.Render())
+}
 
-	con.PrintInfof("Screenshot written to %s (%s)\n", saveToFile.Name(), util.ByteCountBinary(int64(n)))
+// Image - A screenshot image and the suffix added to its file name
+type Image struct {
+	Suffix string
+	Data   []byte
 }
 
-func LootScreenshot(screenshot *sliverpb.Screenshot, lootName string, hostName string, con *console.SliverConsoleClient) {
-	timeNow := time.Now().UTC()
-	screenshotFileName := fmt.Sprintf("screenshot_%s_%s.png", hostName, timeNow.Format("20060102150405"))
+// Images - The combined screenshot, or one image per display when split
+func Images(screenshot *sliverpb.Screenshot) []Image {
+	images := []Image{}
+	if 0 < len(screenshot.Data) {
+		images = append(images, Image{Data: screenshot.Data})
+	}
+	for _, display := range screenshot.Displays {
+		if 0 < len(display.Data) {
+			images = append(images, Image{Suffix: fmt.Sprintf("_display%d", display.Index), Data: display.Data})
+		}
+	}
+	return images
+}
+
+// Extension - The file extension of the screenshot's format
+func Extension(screenshot *sliverpb.Screenshot) string {
+	if screenshot.Format == "jpeg" {
+		return "jpg"
+	}
+	return "png"
+}
+
+// PrintScreenshot - Handle the screenshot command response
+func PrintScreenshot(screenshot *sliverpb.Screenshot, hostname string, ctx *grumble.Context, con *console.SliverConsoleClient) {
+	saveScreenshot(screenshot, hostname, ctx.Flags.String("save"), con)
+}
 
-	if lootName == "" {
-		lootName = screenshotFileName
+// saveScreenshot - Write each image to saveTo, adding the image suffix before the
+// extension, or to temp files if saveTo is empty
+func saveScreenshot(screenshot *sliverpb.Screenshot, hostname string, saveTo string, con *console.SliverConsoleClient) {
+	timestamp := time.Now().Format("20060102150405")
+	for _, image := range Images(screenshot) {
+		var saveToFile *os.File
+		var err error
+		if saveTo == "" {
+			tmpFileName := filepath.Base(fmt.Sprintf("screenshot_%s_%s%s_*.%s", filepath.Base(hostname), timestamp, image.Suffix, Extension(screenshot)))
+			saveToFile, err = ioutil.TempFile("", tmpFileName)
+			if err != nil {
+				con.PrintErrorf("%s\n", err)
+				return
+			}
+		} else {
+			ext := filepath.Ext(saveTo)
+			saveToPath := strings.TrimSuffix(saveTo, ext) + image.Suffix + ext
+			saveToFile, err = os.OpenFile(saveToPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+			if err != nil {
+				con.PrintErrorf("Error creating file: %s\n", err)
+				return
+			}
+		}
+		var n int
+		n, err = saveToFile.Write(image.Data)
+		saveToFile.Close()
+		if err != nil {
+			con.PrintErrorf("Error writting file: %s\n", err)
+			return
+		}
+		con.PrintInfof("Screenshot written to %s (%s)\n", saveToFile.Name(), util.ByteCountBinary(int64(n)))
 	}
+}
+
+// LootScreenshot - Save each image of the screenshot as loot
+func LootScreenshot(screenshot *sliverpb.Screenshot, lootName string, hostName string, con *console.SliverConsoleClient) {
+	fileName := screenshotFileName(screenshot, hostName, time.Now().UTC())
+	ext := filepath.Ext(fileName)
+	for _, image := range Images(screenshot) {
+		imageFileName := strings.TrimSuffix(fileName, ext) + image.Suffix + ext
+		imageLootName := imageFileName
+		if lootName != "" {
+			imageLootName = lootName + image.Suffix
+		}
+		lootMessage := loot.CreateLootMessage(imageFileName, imageLootName, clientpb.LootType_LOOT_FILE, clientpb.FileType_BINARY, image.Data)
+		loot.SendLootMessage(lootMessage, con)
+	}
+}
 
-	lootMessage := loot.CreateLootMessage(screenshotFileName, lootName, clientpb.LootType_LOOT_FILE, clientpb.FileType_BINARY, screenshot.GetData())
-	loot.SendLootMessage(lootMessage, con)
+func screenshotFileName(screenshot *sliverpb.Screenshot, hostname string, timestamp time.Time) string {
+	return fmt.Sprintf("screenshot_%s_%s.%s", filepath.Base(hostname), timestamp.Format("20060102150405"), Extension(screenshot))
 }
 
 func getOS(session *clientpb.Session, beacon *clientpb.Beacon) string {
@@ -0,0 +1,192 @@
+package servers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/client/transport"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+)
+
+var (
+	// ErrNoConfigs - Every config is already connected, or there aren't any
+	ErrNoConfigs = errors.New("no other server configs, import one with 'sliver-client import'")
+)
+
+const (
+	countTimeout = 5 * time.Second
+)
+
+// ServersCmd - List the servers we're connected to
+func ServersCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	active := con.ActiveServer()
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{
+		"Name",
+		"Sessions",
+		"Beacons",
+		"Active",
+	})
+	for _, server := range con.Servers() {
+		sessions, beacons := countTargets(server)
+		name := server.Name
+		isActive := ""
+		if server == active {
+			name = console.Bold + console.Green + name + console.Normal
+			isActive = console.Bold + console.Green + "*" + console.Normal
+		}
+		tw.AppendRow(table.Row{name, sessions, beacons, isActive})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// ServersConnectCmd - Connect to another server, the config can be a path or
+// selected from the imported configs
+func ServersConnectCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	var config *assets.ClientConfig
+	var err error
+	configPath := ctx.Args.String("config")
+	if configPath != "" {
+		config, err = assets.ReadConfig(configPath)
+	} else {
+		config, err = selectConfig(con)
+	}
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if con.GetServer(console.ServerName(config)) != nil {
+		con.PrintErrorf("%s: %s\n", console.ErrServerConnected, console.ServerName(config))
+		return
+	}
+
+	con.PrintInfof("Connecting to %s:%d ...\n", config.LHost, config.LPort)
+	rpc, conn, err := transport.MTLSConnect(config)
+	if err != nil {
+		con.PrintErrorf("Connection to server failed %s\n", err)
+		return
+	}
+	server := console.NewServer(config, rpc, conn)
+	err = con.AddServer(server)
+	if err != nil {
+		conn.Close()
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if ctx.Flags.Bool("background") {
+		con.PrintInfof("Connected to %s\n", server.Name)
+		return
+	}
+	con.UseServer(server)
+	con.PrintInfof("Active server %s\n", server.Name)
+}
+
+// ServersUseCmd - Switch the server commands are sent to
+func ServersUseCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	server := con.GetServer(ctx.Args.String("name"))
+	if server == nil {
+		con.PrintErrorf("%s: %s\n", console.ErrServerNotFound, ctx.Args.String("name"))
+		return
+	}
+	con.UseServer(server)
+	con.PrintInfof("Active server %s\n", server.Name)
+}
+
+// ServersDisconnectCmd - Disconnect from a server
+func ServersDisconnectCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	server := con.GetServer(ctx.Args.String("name"))
+	if server == nil {
+		con.PrintErrorf("%s: %s\n", console.ErrServerNotFound, ctx.Args.String("name"))
+		return
+	}
+	err := con.RemoveServer(server)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Disconnected from %s\n", server.Name)
+}
+
+// ServerNameCompleter - Completer for connected server names
+func ServerNameCompleter(prefix string, args []string, con *console.SliverConsoleClient) []string {
+	results := []string{}
+	for _, server := range con.Servers() {
+		if strings.HasPrefix(server.Name, prefix) {
+			results = append(results, server.Name)
+		}
+	}
+	return results
+}
+
+// selectConfig - Select one of the imported configs we're not connected to yet
+func selectConfig(con *console.SliverConsoleClient) (*assets.ClientConfig, error) {
+	configs := map[string]*assets.ClientConfig{}
+	for key, config := range assets.GetConfigs() {
+		if con.GetServer(console.ServerName(config)) == nil {
+			configs[key] = config
+		}
+	}
+	if len(configs) == 0 {
+		return nil, ErrNoConfigs
+	}
+	keys := []string{}
+	for key := range configs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	answer := ""
+	prompt := &survey.Select{
+		Message: "Select a server:",
+		Options: keys,
+	}
+	err := survey.AskOne(prompt, &answer)
+	if err != nil {
+		return nil, err
+	}
+	return configs[answer], nil
+}
+
+// countTargets - Number of sessions and beacons on a server
+func countTargets(server *console.Server) (string, string) {
+	ctx, cancel := context.WithTimeout(context.Background(), countTimeout)
+	defer cancel()
+	sessions, err := server.Rpc.GetSessions(ctx, &commonpb.Empty{})
+	if err != nil {
+		return "?", "?"
+	}
+	beacons, err := server.Rpc.GetBeacons(ctx, &commonpb.Empty{})
+	if err != nil {
+		return fmt.Sprintf("%d", len(sessions.Sessions)), "?"
+	}
+	return fmt.Sprintf("%d", len(sessions.Sessions)), fmt.Sprintf("%d", len(beacons.Beacons))
+}
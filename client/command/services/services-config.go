@@ -0,0 +1,69 @@
+package services
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+)
+
+// ServicesConfigCmd - Show a service's configuration, or change it
+func ServicesConfigCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	if !isWindows(session, beacon) {
+		con.PrintErrorf("Service management can only target Windows\n")
+		return
+	}
+	startType := ctx.Flags.String("start-type")
+	if err := checkStartType(startType); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	password, err := accountPassword(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	req := &sliverpb.ServiceConfigReq{
+		ServiceInfo: serviceInfo(ctx),
+		BinPath:     ctx.Flags.String("bin-path"),
+		DisplayName: ctx.Flags.String("display-name"),
+		Description: ctx.Flags.String("description"),
+		StartType:   startType,
+		Account:     ctx.Flags.String("account"),
+		Password:    password,
+		Request:     con.ActiveTarget.Request(ctx),
+	}
+	detail, err := con.Rpc.ServiceConfig(context.Background(), req)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	message := ""
+	if req.BinPath != "" || req.DisplayName != "" || req.Description != "" || req.StartType != "" || req.Account != "" {
+		message = "Updated service %s"
+	}
+	printDetailResponse(detail, message, con)
+}
@@ -0,0 +1,139 @@
+package services
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+var startTypes = []string{"auto", "delayed-auto", "manual", "disabled"}
+
+// ServicesCreateCmd - Create a service, it's only started with --start
+func ServicesCreateCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	if !isWindows(session, beacon) {
+		con.PrintErrorf("Service management can only target Windows\n")
+		return
+	}
+	startType := ctx.Flags.String("start-type")
+	if err := checkStartType(startType); err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	password, err := accountPassword(ctx)
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	detail, err := con.Rpc.CreateService(context.Background(), &sliverpb.CreateServiceReq{
+		ServiceInfo: serviceInfo(ctx),
+		BinPath:     ctx.Args.String("bin-path"),
+		DisplayName: ctx.Flags.String("display-name"),
+		Description: ctx.Flags.String("description"),
+		StartType:   startType,
+		Account:     ctx.Flags.String("account"),
+		Password:    password,
+		Start:       ctx.Flags.Bool("start"),
+		Request:     con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if detail.Response != nil && detail.Response.Async {
+		con.AddBeaconCallback(detail.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, detail)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			printCreated(detail, ctx.Flags.String("hostname"), session, beacon, con)
+		})
+		con.PrintAsyncResponse(detail.Response)
+	} else {
+		printCreated(detail, ctx.Flags.String("hostname"), session, beacon, con)
+	}
+}
+
+// printCreated - Services that start on boot are recorded as persistence
+func printCreated(detail *sliverpb.ServiceDetail, hostname string, session *clientpb.Session, beacon *clientpb.Beacon, con *console.SliverConsoleClient) {
+	if detail.Response != nil && detail.Response.Err != "" {
+		con.PrintResponseErr(detail.Response)
+		return
+	}
+	con.PrintInfof("Created service %s\n\n", detail.Name)
+	PrintServiceDetail(detail, con)
+	if detail.StartType != "auto" && detail.StartType != "delayed-auto" {
+		return
+	}
+	persistence := &clientpb.Persistence{
+		Hostname:  hostname,
+		Technique: "service",
+		Target:    detail.Name,
+		Path:      detail.BinPath,
+		Restore:   fmt.Sprintf("services delete %s", detail.Name),
+	}
+	if hostname == "" && session != nil {
+		persistence.HostUUID, persistence.Hostname = session.UUID, session.Hostname
+	} else if hostname == "" {
+		persistence.HostUUID, persistence.Hostname = beacon.UUID, beacon.Hostname
+	}
+	persistence, err := con.Rpc.PersistenceAdd(context.Background(), persistence)
+	if err != nil {
+		con.PrintErrorf("Failed to record persistence: %s\n", err)
+		return
+	}
+	con.Println()
+	con.PrintInfof("Recorded as persistence %s\n", strings.Split(persistence.ID, "-")[0])
+}
+
+func checkStartType(startType string) error {
+	if startType == "" {
+		return nil
+	}
+	for _, valid := range startTypes {
+		if strings.ToLower(startType) == valid {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid start type '%s', must be one of %s", startType, strings.Join(startTypes, ", "))
+}
+
+// accountPassword - Prompt for the password of the service's account so it isn't
+// in the console's history
+func accountPassword(ctx *grumble.Context) (string, error) {
+	if !ctx.Flags.Bool("password") {
+		return "", nil
+	}
+	password := ""
+	err := survey.AskOne(&survey.Password{Message: "Account password:"}, &password)
+	return password, err
+}
@@ -0,0 +1,63 @@
+package services
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"google.golang.org/protobuf/proto"
+)
+
+// ServicesDeleteCmd - Mark a service for deletion, it's removed once it stops
+func ServicesDeleteCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	if !isWindows(session, beacon) {
+		con.PrintErrorf("Service management can only target Windows\n")
+		return
+	}
+	name := ctx.Args.String("name")
+	deleted, err := con.Rpc.RemoveService(context.Background(), &sliverpb.RemoveServiceReq{
+		ServiceInfo: serviceInfo(ctx),
+		Request:     con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if deleted.Response != nil && deleted.Response.Async {
+		con.AddBeaconCallback(deleted.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, deleted)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintServiceInfo(deleted, "Deleted service %s", name, con)
+		})
+		con.PrintAsyncResponse(deleted.Response)
+	} else {
+		PrintServiceInfo(deleted, "Deleted service %s", name, con)
+	}
+}
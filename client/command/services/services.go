@@ -0,0 +1,197 @@
+package services
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/command/settings"
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
+	"google.golang.org/protobuf/proto"
+)
+
+// ServicesCmd - List the services of the target's host, or a remote host
+func ServicesCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session, beacon := con.ActiveTarget.GetInteractive()
+	if session == nil && beacon == nil {
+		return
+	}
+	if !isWindows(session, beacon) {
+		con.PrintErrorf("Service management can only target Windows\n")
+		return
+	}
+	filter := strings.ToLower(ctx.Flags.String("filter"))
+	running := ctx.Flags.Bool("running")
+
+	services, err := con.Rpc.Services(context.Background(), &sliverpb.ServicesReq{
+		Hostname: ctx.Flags.String("hostname"),
+		Request:  con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if services.Response != nil && services.Response.Async {
+		con.AddBeaconCallback(services.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err = proto.Unmarshal(task.Response, services)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			PrintServices(services, filter, running, con)
+		})
+		con.PrintAsyncResponse(services.Response)
+	} else {
+		PrintServices(services, filter, running, con)
+	}
+}
+
+// PrintServices - Print the services matching the filter
+func PrintServices(services *sliverpb.Services, filter string, running bool, con *console.SliverConsoleClient) {
+	if services.Response != nil && services.Response.Err != "" {
+		con.PrintResponseErr(services.Response)
+		return
+	}
+	details := []*sliverpb.ServiceDetail{}
+	for _, detail := range services.Details {
+		if running && detail.Status != "running" {
+			continue
+		}
+		if filter != "" && !matches(detail, filter) {
+			continue
+		}
+		details = append(details, detail)
+	}
+	if len(details) == 0 {
+		con.PrintInfof("No services\n")
+		return
+	}
+	sort.Slice(details, func(i, j int) bool {
+		return strings.ToLower(details[i].Name) < strings.ToLower(details[j].Name)
+	})
+
+	tw := table.NewWriter()
+	tw.SetStyle(settings.GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Name", "Display Name", "Status", "Start Type", "PID", "Account", "Binary Path"})
+	for _, detail := range details {
+		pid := ""
+		if detail.PID != 0 {
+			pid = fmt.Sprintf("%d", detail.PID)
+		}
+		tw.AppendRow(table.Row{
+			detail.Name,
+			detail.DisplayName,
+			statusColor(detail.Status),
+			detail.StartType,
+			pid,
+			detail.Account,
+			detail.BinPath,
+		})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
+// PrintServiceDetail - Print a single service's configuration and status
+func PrintServiceDetail(detail *sliverpb.ServiceDetail, con *console.SliverConsoleClient) {
+	if detail.Response != nil && detail.Response.Err != "" {
+		con.PrintResponseErr(detail.Response)
+		return
+	}
+	con.Printf("%s        Name:%s %s\n", console.Bold, console.Normal, detail.Name)
+	con.Printf("%sDisplay Name:%s %s\n", console.Bold, console.Normal, detail.DisplayName)
+	if detail.Description != "" {
+		con.Printf("%s Description:%s %s\n", console.Bold, console.Normal, detail.Description)
+	}
+	con.Printf("%s      Status:%s %s\n", console.Bold, console.Normal, statusColor(detail.Status))
+	if detail.PID != 0 {
+		con.Printf("%s         PID:%s %d\n", console.Bold, console.Normal, detail.PID)
+	}
+	con.Printf("%s  Start Type:%s %s\n", console.Bold, console.Normal, detail.StartType)
+	con.Printf("%s     Account:%s %s\n", console.Bold, console.Normal, detail.Account)
+	con.Printf("%s Binary Path:%s %s\n", console.Bold, console.Normal, detail.BinPath)
+}
+
+func matches(detail *sliverpb.ServiceDetail, filter string) bool {
+	for _, field := range []string{detail.Name, detail.DisplayName, detail.BinPath, detail.Account} {
+		if strings.Contains(strings.ToLower(field), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+func statusColor(status string) string {
+	switch status {
+	case "running":
+		return console.Green + status + console.Normal
+	case "stopped":
+		return status
+	default:
+		return console.Orange + status + console.Normal
+	}
+}
+
+func isWindows(session *clientpb.Session, beacon *clientpb.Beacon) bool {
+	if session != nil {
+		return session.OS == "windows"
+	}
+	return beacon.OS == "windows"
+}
+
+// serviceInfo - The service named by the command's argument
+func serviceInfo(ctx *grumble.Context) *sliverpb.ServiceInfoReq {
+	return &sliverpb.ServiceInfoReq{
+		ServiceName: ctx.Args.String("name"),
+		Hostname:    ctx.Flags.String("hostname"),
+	}
+}
+
+// printDetailResponse - Print the service the request returned, waiting for beacons
+func printDetailResponse(detail *sliverpb.ServiceDetail, message string, con *console.SliverConsoleClient) {
+	printDetail := func() {
+		if detail.Response != nil && detail.Response.Err != "" {
+			con.PrintResponseErr(detail.Response)
+			return
+		}
+		if message != "" {
+			con.PrintInfof(message+"\n\n", detail.Name)
+		}
+		PrintServiceDetail(detail, con)
+	}
+	if detail.Response != nil && detail.Response.Async {
+		con.AddBeaconCallback(detail.Response.TaskID, func(task *clientpb.BeaconTask) {
+			err := proto.Unmarshal(task.Response, detail)
+			if err != nil {
+				con.PrintErrorf("Failed to decode response %s\n", err)
+				return
+			}
+			printDetail()
+		})
+		con.PrintAsyncResponse(detail.Response)
+	} else {
+		printDetail()
+	}
+}
@@ -20,6 +20,7 @@ package sessions
 
 import (
 	"context"
+	"errors"
 	"net/url"
 	"time"
 
@@ -42,6 +43,25 @@ func InteractiveCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		con.PrintErrorf("%s\n", err)
 		return
 	}
+	duration, interval, err := interactiveWindow(ctx.Flags.String("duration"), ctx.Flags.String("interval"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	if interval != 0 {
+		openSession, err := con.Rpc.OpenSession(context.Background(), &sliverpb.OpenSession{
+			Request:  con.ActiveTarget.Request(ctx),
+			Duration: int64(duration),
+			Interval: int64(interval),
+		})
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.PrintInfof("Beacon will check in every %s for %s, then revert to its interval\n", interval, duration)
+		con.PrintAsyncResponse(openSession.Response)
+		return
+	}
 
 	// Parse C2 Flags
 	c2s := []*clientpb.ImplantC2{}
@@ -74,6 +94,20 @@ func InteractiveCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 	c2s = append(c2s, dnsC2...)
 
+	icmpC2, err := generate.ParseICMPc2(ctx.Flags.String("icmp"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err.Error())
+		return
+	}
+	c2s = append(c2s, icmpC2...)
+
+	udpC2, err := generate.ParseUDPc2(ctx.Flags.String("udp"))
+	if err != nil {
+		con.PrintErrorf("%s\n", err.Error())
+		return
+	}
+	c2s = append(c2s, udpC2...)
+
 	namedPipeC2, err := generate.ParseNamedPipec2(ctx.Flags.String("named-pipe"))
 	if err != nil {
 		con.PrintErrorf("%s\n", err.Error())
@@ -127,6 +161,20 @@ func InteractiveCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 				return
 			}
 			c2s = append(c2s, dnsC2...)
+		case "icmp":
+			icmpC2, err = generate.ParseICMPc2(beacon.ActiveC2)
+			if err != nil {
+				con.PrintErrorf("%s\n", err.Error())
+				return
+			}
+			c2s = append(c2s, icmpC2...)
+		case "udp":
+			udpC2, err = generate.ParseUDPc2(beacon.ActiveC2)
+			if err != nil {
+				con.PrintErrorf("%s\n", err.Error())
+				return
+			}
+			c2s = append(c2s, udpC2...)
 		case "namedpipe":
 			namedPipeC2, err = generate.ParseNamedPipec2(beacon.ActiveC2)
 			if err != nil {
@@ -148,9 +196,10 @@ func InteractiveCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	openSession := &sliverpb.OpenSession{
-		Request: con.ActiveTarget.Request(ctx),
-		C2S:     []string{},
-		Delay:   int64(delay),
+		Request:  con.ActiveTarget.Request(ctx),
+		C2S:      []string{},
+		Delay:    int64(delay),
+		Duration: int64(duration),
 	}
 	for _, c2 := range c2s {
 		openSession.C2S = append(openSession.C2S, c2.URL)
@@ -166,3 +215,33 @@ func InteractiveCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		con.PrintErrorf("rpc response missing!\n")
 	}
 }
+
+// interactiveWindow - Parse the length of the interactive window, and the check in
+// interval to use instead of a session
+func interactiveWindow(durationFlag string, intervalFlag string) (time.Duration, time.Duration, error) {
+	duration := time.Duration(0)
+	if durationFlag != "" {
+		var err error
+		duration, err = time.ParseDuration(durationFlag)
+		if err != nil {
+			return 0, 0, err
+		}
+		if duration < 0 {
+			return 0, 0, errors.New("duration must be positive")
+		}
+	}
+	if intervalFlag == "" {
+		return duration, 0, nil
+	}
+	interval, err := time.ParseDuration(intervalFlag)
+	if err != nil {
+		return 0, 0, err
+	}
+	if interval <= 0 {
+		return 0, 0, errors.New("interval must be positive")
+	}
+	if duration == 0 {
+		return 0, 0, errors.New("--interval requires a --duration")
+	}
+	return duration, interval, nil
+}
@@ -19,12 +19,38 @@ package settings
 */
 
 import (
+	"strings"
+
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/desertbit/grumble"
+	"github.com/jedib0t/go-pretty/v6/table"
 )
 
+// OpsecCmd - List the OPSEC considerations of annotated commands
+func OpsecCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	cmdName := ctx.Args.String("command")
+	if cmdName != "" {
+		opsecHelp := help.GetOpsecHelpFor(cmdName)
+		if opsecHelp == "" {
+			con.PrintInfof("No OPSEC notes for '%s'\n", cmdName)
+			return
+		}
+		con.Printf("%s", opsecHelp)
+		return
+	}
+	tw := table.NewWriter()
+	tw.SetStyle(GetTableStyle(con))
+	tw.AppendHeader(table.Row{"Command", "Risk", "Artifacts", "Network"})
+	for _, name := range help.OpsecCommands() {
+		info := help.GetOpsec(name)
+		tw.AppendRow(table.Row{name, info.Risk, strings.Join(info.Artifacts, "\n"), info.Network})
+	}
+	con.Printf("%s\n", tw.Render())
+}
+
 // SettingsAutoAdultCmd - The client settings command
 func SettingsAutoAdultCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	var err error
@@ -39,11 +65,15 @@ func SettingsAutoAdultCmd(ctx *grumble.Context, con *console.SliverConsoleClient
 	con.PrintInfof("Auto Adult = %v\n", con.Settings.AutoAdult)
 }
 
-// IsUserAnAdult - This should be called for any dangerous (OPSEC-wise) functions
-func IsUserAnAdult(con *console.SliverConsoleClient) bool {
+// IsUserAnAdult - This should be called for any dangerous (OPSEC-wise) functions,
+// the command's OPSEC notes (if any) are shown before asking
+func IsUserAnAdult(con *console.SliverConsoleClient, cmdName string) bool {
 	if GetAutoAdult(con) {
 		return true
 	}
+	if opsecHelp := help.GetOpsecHelpFor(cmdName); opsecHelp != "" {
+		con.Printf("%s\n", opsecHelp)
+	}
 	confirm := false
 	prompt := &survey.Confirm{Message: "This action is bad OPSEC, are you an adult?"}
 	survey.AskOne(prompt, &confirm, nil)
@@ -48,6 +48,7 @@ func SettingsCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	tw.AppendRow(table.Row{"Small Term Width", con.Settings.SmallTermWidth, "Omit some table columns when terminal width is less than this value"})
 	tw.AppendRow(table.Row{"Always Overflow", con.Settings.AlwaysOverflow, "Disable table pagination"})
 	tw.AppendRow(table.Row{"Vim mode", con.Settings.VimMode, "Navigation mode, vim style"})
+	tw.AppendRow(table.Row{"Mask Secrets", con.Settings.MaskSecrets, "Mask hashes, keys, and passwords in output"})
 	con.Printf("%s\n", tw.Render())
 }
 
@@ -65,6 +66,20 @@ func SettingsAlwaysOverflow(ctx *grumble.Context, con *console.SliverConsoleClie
 	con.PrintInfof("Always overflow = %v\n", con.Settings.AlwaysOverflow)
 }
 
+// SettingsMaskSecrets - Toggle masking of secrets in output
+func SettingsMaskSecrets(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	var err error
+	if con.Settings == nil {
+		con.Settings, err = assets.LoadSettings()
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+	}
+	con.Settings.MaskSecrets = !con.Settings.MaskSecrets
+	con.PrintInfof("Mask secrets = %v\n", con.Settings.MaskSecrets)
+}
+
 // SettingsSmallTerm - Modify small terminal width value
 func SettingsSmallTerm(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	var err error
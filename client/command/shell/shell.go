@@ -25,8 +25,10 @@ import (
 	"log"
 	"os"
 
+	"github.com/bishopfox/sliver/client/command/help"
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/client/core"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"golang.org/x/crypto/ssh/terminal"
@@ -40,6 +42,15 @@ const (
 	linux   = "linux"
 )
 
+func init() {
+	help.RegisterOpsec(consts.ShellStr, &help.OpsecInfo{
+		Risk:      help.OpsecHigh,
+		Artifacts: []string{"shell process (e.g. /bin/bash, powershell.exe) with the implant as its parent"},
+		Network:   "interactive tunnel for the life of the shell",
+		APIs:      []string{"process creation with redirected stdio", "pty allocation on linux/darwin"},
+	})
+}
+
 // ShellCmd - Start an interactive shell on the remote system
 func ShellCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	session := con.ActiveTarget.GetSessionInteractive()
@@ -47,7 +58,7 @@ func ShellCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 
-	if !settings.IsUserAnAdult(con) {
+	if !settings.IsUserAnAdult(con, consts.ShellStr) {
 		return
 	}
 
@@ -97,7 +108,7 @@ func runInteractive(ctx *grumble.Context, shellPath string, noPty bool, con *con
 	}
 	//
 	if shell.Response != nil && shell.Response.Err != "" {
-		con.PrintErrorf("Error: %s\n", shell.Response.Err)
+		con.PrintResponseErr(shell.Response)
 		_, err = con.Rpc.CloseTunnel(context.Background(), &sliverpb.Tunnel{
 			TunnelID:  tunnel.ID,
 			SessionID: session.ID,
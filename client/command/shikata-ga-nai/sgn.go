@@ -62,7 +62,7 @@ func ShikataGaNaiCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 	if shellcodeResp.Response != nil && shellcodeResp.Response.Err != "" {
-		con.PrintErrorf("%s\n", shellcodeResp.Response.Err)
+		con.PrintResponseErr(shellcodeResp.Response)
 		return
 	}
 
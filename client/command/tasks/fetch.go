@@ -26,14 +26,18 @@ import (
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/client/command/clipboard"
 	"github.com/bishopfox/sliver/client/command/environment"
 	"github.com/bishopfox/sliver/client/command/exec"
 	"github.com/bishopfox/sliver/client/command/extensions"
 	"github.com/bishopfox/sliver/client/command/filesystem"
+	"github.com/bishopfox/sliver/client/command/info"
 	"github.com/bishopfox/sliver/client/command/network"
 	"github.com/bishopfox/sliver/client/command/privilege"
 	"github.com/bishopfox/sliver/client/command/processes"
 	"github.com/bishopfox/sliver/client/command/registry"
+	screenshotcmd "github.com/bishopfox/sliver/client/command/screenshot"
+	servicescmd "github.com/bishopfox/sliver/client/command/services"
 	"github.com/bishopfox/sliver/client/command/settings"
 	"github.com/bishopfox/sliver/client/console"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
@@ -127,6 +131,9 @@ func PrintTask(task *clientpb.BeaconTask, con *console.SliverConsoleClient) {
 	tw.AppendRow(table.Row{"State", emojiState(task.State) + " " + prettyState(strings.Title(task.State))})
 	tw.AppendRow(table.Row{"Description", task.Description})
 	tw.AppendRow(table.Row{"Created", time.Unix(task.CreatedAt, 0).Format(time.RFC1123)})
+	if 0 < task.Timeout {
+		tw.AppendRow(table.Row{"Timeout", time.Duration(task.Timeout).String()})
+	}
 	if !time.Unix(task.SentAt, 0).IsZero() {
 		tw.AppendRow(table.Row{"Sent", time.Unix(task.SentAt, 0).Format(time.RFC1123)})
 	}
@@ -160,6 +167,8 @@ func emojiState(state string) string {
 		return "❌"
 	case "canceled":
 		return "🚫"
+	case "timeout":
+		return "⌛"
 	default:
 		return "❓"
 	}
@@ -366,6 +375,14 @@ func renderTaskResponse(task *clientpb.BeaconTask, con *console.SliverConsoleCli
 		}
 		taskResponseDownload(download, con)
 
+	case sliverpb.MsgSearchReq:
+		search := &sliverpb.Search{}
+		err := proto.Unmarshal(task.Response, search)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		filesystem.PrintSearch(search, con)
 	case sliverpb.MsgLsReq:
 		ls := &sliverpb.Ls{}
 		err := proto.Unmarshal(task.Response, ls)
@@ -521,6 +538,33 @@ func renderTaskResponse(task *clientpb.BeaconTask, con *console.SliverConsoleCli
 		}
 		privilege.PrintGetPrivs(privs, beacon.PID, con)
 
+	case sliverpb.MsgPresenceReq:
+		presence := &sliverpb.Presence{}
+		err := proto.Unmarshal(task.Response, presence)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		info.PrintPresence(presence, con)
+
+	case sliverpb.MsgLogonSessionsReq:
+		logons := &sliverpb.LogonSessions{}
+		err := proto.Unmarshal(task.Response, logons)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		privilege.PrintLogonSessions(logons, true, con)
+
+	case sliverpb.MsgLocalGroupsReq:
+		localGroups := &sliverpb.LocalGroups{}
+		err := proto.Unmarshal(task.Response, localGroups)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		privilege.PrintLocalGroups(localGroups, con)
+
 	case sliverpb.MsgInvokeGetSystemReq:
 		getSystem := &sliverpb.GetSystem{}
 		err := proto.Unmarshal(task.Response, getSystem)
@@ -568,6 +612,24 @@ func renderTaskResponse(task *clientpb.BeaconTask, con *console.SliverConsoleCli
 		}
 		privilege.PrintMakeToken(makeToken, makeTokenReq.Domain, makeTokenReq.Username, con)
 
+	case sliverpb.MsgStealTokenReq:
+		stealToken := &sliverpb.StealToken{}
+		err := proto.Unmarshal(task.Response, stealToken)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		privilege.PrintStealToken(stealToken, con)
+
+	case sliverpb.MsgTokensReq:
+		tokens := &sliverpb.Tokens{}
+		err := proto.Unmarshal(task.Response, tokens)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		privilege.PrintTokens(tokens, con)
+
 	case sliverpb.MsgRunAsReq:
 		runAsReq := &sliverpb.RunAsReq{}
 		err := proto.Unmarshal(task.Response, runAsReq)
@@ -600,6 +662,33 @@ func renderTaskResponse(task *clientpb.BeaconTask, con *console.SliverConsoleCli
 		}
 		promptSaveToFile(dump.Data, con)
 
+	case sliverpb.MsgSurveyReq:
+		survey := &sliverpb.Survey{}
+		err := proto.Unmarshal(task.Response, survey)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		info.PrintSurvey(survey, false, con)
+
+	case sliverpb.MsgSSHHarvestReq:
+		harvest := &sliverpb.SSHHarvest{}
+		err := proto.Unmarshal(task.Response, harvest)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		exec.PrintSSHHarvest(harvest, "", false, con)
+
+	case sliverpb.MsgLsassDumpReq:
+		lsassDump := &sliverpb.LsassDump{}
+		err := proto.Unmarshal(task.Response, lsassDump)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		processes.PrintLsassDump(lsassDump, con)
+
 	case sliverpb.MsgPsReq:
 		ps := &sliverpb.Ps{}
 		err := proto.Unmarshal(task.Response, ps)
@@ -697,6 +786,52 @@ func renderTaskResponse(task *clientpb.BeaconTask, con *console.SliverConsoleCli
 		}
 		registry.PrintListSubKeys(regList, listValuesReq.Hive, listValuesReq.Path, con)
 
+	case sliverpb.MsgRegistryExportReq:
+		export := &sliverpb.RegistryExport{}
+		err := proto.Unmarshal(task.Response, export)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		registry.PrintRegExport(export, con)
+
+	case sliverpb.MsgRegistrySearchReq:
+		searchReq := &sliverpb.RegistrySearchReq{}
+		err := proto.Unmarshal(task.Request, searchReq)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		search := &sliverpb.RegistrySearch{}
+		err = proto.Unmarshal(task.Response, search)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		registry.PrintRegSearch(search, searchReq.Hive, con)
+
+	case sliverpb.MsgServicesReq:
+		services := &sliverpb.Services{}
+		err := proto.Unmarshal(task.Response, services)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		servicescmd.PrintServices(services, "", false, con)
+
+	case sliverpb.MsgServiceStartReq:
+		fallthrough
+	case sliverpb.MsgCreateServiceReq:
+		fallthrough
+	case sliverpb.MsgServiceConfigReq:
+		detail := &sliverpb.ServiceDetail{}
+		err := proto.Unmarshal(task.Response, detail)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		servicescmd.PrintServiceDetail(detail, con)
+
 	case sliverpb.MsgRegistryReadReq:
 		regRead := &sliverpb.RegistryRead{}
 		err := proto.Unmarshal(task.Response, regRead)
@@ -725,7 +860,31 @@ func renderTaskResponse(task *clientpb.BeaconTask, con *console.SliverConsoleCli
 			con.PrintErrorf("Failed to decode task response: %s\n", err)
 			return
 		}
-		promptSaveToFile(screenshot.Data, con)
+		if screenshot.Response != nil && screenshot.Response.Err != "" {
+			con.PrintErrorf("%s\n", screenshot.Response.Err)
+			return
+		}
+		images := screenshotcmd.Images(screenshot)
+		if len(images) == 0 {
+			screenshotcmd.PrintDisplays(screenshot, con)
+		}
+		for _, image := range images {
+			promptSaveToFile(image.Data, con)
+		}
+	case sliverpb.MsgClipboardReq:
+		clipboardReq := &sliverpb.ClipboardReq{}
+		err := proto.Unmarshal(reqEnvelope.Data, clipboardReq)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task request: %s\n", err)
+			return
+		}
+		clip := &sliverpb.Clipboard{}
+		err = proto.Unmarshal(task.Response, clip)
+		if err != nil {
+			con.PrintErrorf("Failed to decode task response: %s\n", err)
+			return
+		}
+		clipboard.PrintClipboard(clip, clipboardReq, con)
 
 	// ---------------------
 	// Default
@@ -8,7 +8,7 @@ import (
 	"github.com/desertbit/grumble"
 )
 
-// TasksCancelCmd - Cancel a beacon task before it's sent to the implant
+// TasksCancelCmd - Cancel a pending beacon task, or a sent task that's still running
 func TasksCancelCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	beacon := con.ActiveTarget.GetBeaconInteractive()
 	if beacon == nil {
@@ -26,12 +26,12 @@ func TasksCancelCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		}
 		tasks := []*clientpb.BeaconTask{}
 		for _, task := range beaconTasks.Tasks {
-			if task.State == "pending" {
+			if task.State == "pending" || task.State == "sent" {
 				tasks = append(tasks, task)
 			}
 		}
 		if len(tasks) == 0 {
-			con.PrintErrorf("No pending tasks for beacon\n")
+			con.PrintErrorf("No pending or sent tasks for beacon\n")
 			return
 		}
 
@@ -50,11 +50,16 @@ func TasksCancelCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	if task != nil {
+		sent := task.State == "sent"
 		task, err := con.Rpc.CancelBeaconTask(context.Background(), task)
 		if err != nil {
 			con.PrintErrorf("%s\n", err)
 			return
 		}
-		con.PrintInfof("Task %s canceled\n", task.ID)
+		if sent {
+			con.PrintInfof("Task %s will be canceled on the beacon's next check in\n", task.ID)
+		} else {
+			con.PrintInfof("Task %s canceled\n", task.ID)
+		}
 	}
 }
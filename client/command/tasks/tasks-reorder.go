@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/desertbit/grumble"
+)
+
+// TasksReorderCmd - Move a pending beacon task within the beacon's queue
+func TasksReorderCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	beacon := con.ActiveTarget.GetBeaconInteractive()
+	if beacon == nil {
+		return
+	}
+	beaconTasks, err := con.Rpc.GetBeaconTasks(context.Background(), &clientpb.Beacon{ID: beacon.ID})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	pending := pendingQueue(beaconTasks.Tasks)
+	if len(pending) == 0 {
+		con.PrintErrorf("No pending tasks for beacon\n")
+		return
+	}
+
+	var task *clientpb.BeaconTask
+	idArg := ctx.Args.String("id")
+	if idArg == "" {
+		task, err = SelectBeaconTask(pending)
+		if err != nil {
+			con.PrintErrorf("%s\n", err)
+			return
+		}
+		con.Printf(console.UpN+console.Clearln, 1)
+	} else {
+		for _, pendingTask := range pending {
+			if strings.HasPrefix(pendingTask.ID, idArg) {
+				task = pendingTask
+				break
+			}
+		}
+		if task == nil {
+			con.PrintErrorf("No pending task with id %s\n", idArg)
+			return
+		}
+	}
+
+	position := ctx.Flags.Int("position")
+	if ctx.Flags.Bool("last") {
+		position = len(pending)
+	}
+	if position < 1 {
+		position = 1
+	}
+	if len(pending) < position {
+		position = len(pending)
+	}
+
+	queue := []*clientpb.BeaconTask{}
+	for _, pendingTask := range pending {
+		if pendingTask.ID != task.ID {
+			queue = append(queue, pendingTask)
+		}
+	}
+	queue = append(queue[:position-1], append([]*clientpb.BeaconTask{task}, queue[position-1:]...)...)
+	taskIDs := []string{}
+	for _, queuedTask := range queue {
+		taskIDs = append(taskIDs, queuedTask.ID)
+	}
+
+	reordered, err := con.Rpc.ReorderBeaconTasks(context.Background(), &clientpb.BeaconTaskReorderReq{
+		BeaconID: beacon.ID,
+		TaskIDs:  taskIDs,
+	})
+	if err != nil {
+		con.PrintErrorf("%s\n", err)
+		return
+	}
+	con.PrintInfof("Task %s moved to position %d\n\n", task.ID, position)
+	for index, queuedTask := range reordered.Tasks {
+		con.Printf("%d. %s %s\n", index+1, strings.Split(queuedTask.ID, "-")[0], strings.TrimSuffix(queuedTask.Description, "Req"))
+	}
+}
+
+// pendingQueue - Pending tasks in the order the server will send them
+func pendingQueue(tasks []*clientpb.BeaconTask) []*clientpb.BeaconTask {
+	pending := []*clientpb.BeaconTask{}
+	for _, task := range tasks {
+		if task.State == "pending" {
+			pending = append(pending, task)
+		}
+	}
+	sort.SliceStable(pending, func(i, j int) bool {
+		if pending[i].Priority != pending[j].Priority {
+			return pending[i].Priority > pending[j].Priority
+		}
+		return pending[i].CreatedAt < pending[j].CreatedAt
+	})
+	return pending
+}
@@ -99,6 +99,8 @@ func prettyState(state string) string {
 		return console.Bold + console.Green + state + console.Normal
 	case "canceled":
 		return console.Bold + console.Gray + state + console.Normal
+	case "timeout":
+		return console.Bold + console.Red + state + console.Normal
 	default:
 		return state
 	}
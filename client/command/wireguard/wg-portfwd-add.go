@@ -62,7 +62,7 @@ func WGPortFwdAddCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	if portfwdAdd.Response != nil && portfwdAdd.Response.Err != "" {
-		con.PrintErrorf("Error: %s\n", portfwdAdd.Response.Err)
+		con.PrintResponseErr(portfwdAdd.Response)
 		return
 	}
 	con.PrintInfof("Port forwarding %s -> %s:%s\n", portfwdAdd.Forwarder.LocalAddr, remoteHost, remotePort)
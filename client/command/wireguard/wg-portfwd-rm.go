@@ -49,7 +49,7 @@ func WGPortFwdRmCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	if stopReq.Response != nil && stopReq.Response.Err != "" {
-		con.PrintErrorf("Error: %v\n", stopReq.Response.Err)
+		con.PrintResponseErr(stopReq.Response)
 		return
 	}
 
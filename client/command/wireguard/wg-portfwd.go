@@ -47,7 +47,7 @@ func WGPortFwdListCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 	if fwdList.Response != nil && fwdList.Response.Err != "" {
-		con.PrintErrorf("Error: %s\n", fwdList.Response.Err)
+		con.PrintResponseErr(fwdList.Response)
 		return
 	}
 
@@ -0,0 +1,52 @@
+package wireguard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/client/console"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/desertbit/grumble"
+)
+
+// WGRotateKeysCmd - Switch a WireGuard session to new keys
+func WGRotateKeysCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
+	session := con.ActiveTarget.GetSession()
+	if session == nil {
+		return
+	}
+	if session.Transport != "wg" {
+		con.PrintErrorf("This command is only supported for WireGuard implants\n")
+		return
+	}
+
+	rotate, err := con.Rpc.WGRotateKeys(context.Background(), &sliverpb.WGRotateKeysReq{
+		Request: con.ActiveTarget.Request(ctx),
+	})
+	if err != nil {
+		con.PrintErrorf("Error: %v\n", err)
+		return
+	}
+	if rotate.Response != nil && rotate.Response.Err != "" {
+		con.PrintResponseErr(rotate.Response)
+		return
+	}
+	con.PrintInfof("Rotated keys, the session switches to them in a few seconds\n")
+}
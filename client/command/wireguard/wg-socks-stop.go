@@ -50,7 +50,7 @@ func WGSocksStopCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 	}
 
 	if stopReq.Response != nil && stopReq.Response.Err != "" {
-		con.PrintErrorf("Error: %v\n", stopReq.Response.Err)
+		con.PrintResponseErr(stopReq.Response)
 		return
 	}
 
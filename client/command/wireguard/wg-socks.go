@@ -47,7 +47,7 @@ func WGSocksListCmd(ctx *grumble.Context, con *console.SliverConsoleClient) {
 		return
 	}
 	if socksList.Response != nil && socksList.Response.Err != "" {
-		con.PrintErrorf("Error: %s\n", socksList.Response.Err)
+		con.PrintResponseErr(socksList.Response)
 		return
 	}
 
@@ -40,6 +40,7 @@ import (
 	"github.com/bishopfox/sliver/client/version"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
 	"github.com/desertbit/go-shlex"
 	"github.com/desertbit/grumble"
@@ -97,13 +98,19 @@ type SliverConsoleClient struct {
 	BeaconTaskCallbacksMutex *sync.Mutex
 	IsServer                 bool
 	Settings                 *assets.ClientSettings
+
+	transfers *sync.Map // Last reported progress of large transfers with sessions
+
+	servers      []*Server
+	activeServer *Server
+	serversMutex *sync.Mutex
 }
 
 // BindCmds - Bind extra commands to the app object
 type BindCmds func(console *SliverConsoleClient)
 
-// Start - Console entrypoint
-func Start(rpc rpcpb.SliverRPCClient, bindCmds BindCmds, extraCmds BindCmds, isServer bool) error {
+// Start - Console entrypoint, more servers can be connected to later
+func Start(server *Server, bindCmds BindCmds, extraCmds BindCmds, isServer bool) error {
 	assets.Setup(false, false)
 	settings, _ := assets.LoadSettings()
 	con := &SliverConsoleClient{
@@ -117,7 +124,7 @@ func Start(rpc rpcpb.SliverRPCClient, bindCmds BindCmds, extraCmds BindCmds, isS
 			HelpSubCommands:       true,
 			VimMode:               settings.VimMode,
 		}),
-		Rpc: rpc,
+		Rpc: server.Rpc,
 		ActiveTarget: &ActiveTarget{
 			observers:  map[int]Observer{},
 			observerID: 0,
@@ -127,6 +134,8 @@ func Start(rpc rpcpb.SliverRPCClient, bindCmds BindCmds, extraCmds BindCmds, isS
 		BeaconTaskCallbacksMutex: &sync.Mutex{},
 		IsServer:                 isServer,
 		Settings:                 settings,
+		transfers:                &sync.Map{},
+		serversMutex:             &sync.Mutex{},
 	}
 	con.App.SetPrintASCIILogo(func(_ *grumble.App) {
 		con.PrintLogo()
@@ -139,8 +148,8 @@ func Start(rpc rpcpb.SliverRPCClient, bindCmds BindCmds, extraCmds BindCmds, isS
 		con.App.SetPrompt(con.GetPrompt())
 	})
 
-	go con.startEventLoop()
-	go core.TunnelLoop(rpc)
+	con.AddServer(server)
+	defer con.closeServers()
 
 	err := con.App.Run()
 	if err != nil {
@@ -149,8 +158,8 @@ func Start(rpc rpcpb.SliverRPCClient, bindCmds BindCmds, extraCmds BindCmds, isS
 	return err
 }
 
-func (con *SliverConsoleClient) startEventLoop() {
-	eventStream, err := con.Rpc.Events(context.Background(), &commonpb.Empty{})
+func (con *SliverConsoleClient) startEventLoop(server *Server) {
+	eventStream, err := server.Rpc.Events(context.Background(), &commonpb.Empty{})
 	if err != nil {
 		fmt.Printf(Warn+"%s\n", err)
 		return
@@ -160,6 +169,10 @@ func (con *SliverConsoleClient) startEventLoop() {
 		if err == io.EOF || event == nil {
 			return
 		}
+		if !con.isActiveServer(server) {
+			con.backgroundEvent(server, event)
+			continue
+		}
 
 		go con.triggerEventListeners(event)
 
@@ -198,12 +211,18 @@ func (con *SliverConsoleClient) startEventLoop() {
 			con.PrintEventErrorf("Job #%d stopped (%s/%s)", job.ID, job.Protocol, job.Name)
 			echoed = true
 
+		case consts.ListenerSettingsEvent:
+			job := event.Job
+			con.PrintEventInfof("Job #%d settings changed (%s/%s)", job.ID, job.Protocol, job.Name)
+			echoed = true
+
 		case consts.SessionOpenedEvent:
 			session := event.Session
 			currentTime := time.Now().Format(time.RFC1123)
 			shortID := strings.Split(session.ID, "-")[0]
-			con.PrintEventInfof("Session %s %s - %s (%s) - %s/%s - %v",
-				shortID, session.Name, session.RemoteAddress, session.Hostname, session.OS, session.Arch, currentTime)
+			con.PrintEventInfof("Session %s %s - %s (%s) - %s/%s - %v%s",
+				shortID, session.Name, session.RemoteAddress, session.Hostname, session.OS, session.Arch, currentTime,
+				snapshotCard(session.Snapshot))
 
 			// Prelude Operator
 			if prelude.ImplantMapper != nil {
@@ -249,8 +268,9 @@ func (con *SliverConsoleClient) startEventLoop() {
 			proto.Unmarshal(event.Data, beacon)
 			currentTime := time.Now().Format(time.RFC1123)
 			shortID := strings.Split(beacon.ID, "-")[0]
-			con.PrintEventInfof("Beacon %s %s - %s (%s) - %s/%s - %v",
-				shortID, beacon.Name, beacon.RemoteAddress, beacon.Hostname, beacon.OS, beacon.Arch, currentTime)
+			con.PrintEventInfof("Beacon %s %s - %s (%s) - %s/%s - %v%s",
+				shortID, beacon.Name, beacon.RemoteAddress, beacon.Hostname, beacon.OS, beacon.Arch, currentTime,
+				snapshotCard(beacon.Snapshot))
 
 			// Prelude Operator
 			if prelude.ImplantMapper != nil {
@@ -263,8 +283,78 @@ func (con *SliverConsoleClient) startEventLoop() {
 			}
 			echoed = true
 
+		case consts.BeaconAddressChangedEvent:
+			change := &clientpb.BeaconAddressChange{}
+			proto.Unmarshal(event.Data, change)
+			if change.Beacon == nil {
+				break
+			}
+			shortID := strings.Split(change.Beacon.ID, "-")[0]
+			con.PrintEventErrorf("Beacon %s %s source address changed %s -> %s (%s)",
+				shortID, change.Beacon.Name, addressInfo(change.PreviousAddress, change.PreviousASN, change.PreviousCountry),
+				addressInfo(change.RemoteAddress, change.ASN, change.Country), change.Reason)
+
 		case consts.BeaconTaskResultEvent:
-			con.triggerBeaconTaskCallback(event.Data)
+			con.triggerBeaconTaskCallback(server.Rpc, event.Data)
+			echoed = true
+
+		case consts.EngagementEndedEvent:
+			con.PrintEventErrorf(Bold+"The engagement ended %s, killing all implants"+Normal, string(event.Data))
+			echoed = true
+
+		case consts.EngagementUnreachableEvent:
+			beacon := &clientpb.Beacon{}
+			proto.Unmarshal(event.Data, beacon)
+			shortID := strings.Split(beacon.ID, "-")[0]
+			con.PrintEventErrorf(Bold+"WARNING: "+Normal+"Beacon %s %s (%s) missed its check in after the engagement ended, it may still be running",
+				shortID, beacon.Name, beacon.Hostname)
+			echoed = true
+
+		case consts.PlaybookEvent:
+			run := &clientpb.PlaybookRun{}
+			proto.Unmarshal(event.Data, run)
+			shortID := strings.Split(run.ID, "-")[0]
+			switch run.State {
+			case "awaiting-approval":
+				step := ""
+				for _, runStep := range run.Steps {
+					if runStep.State == run.State {
+						step = runStep.Name
+					}
+				}
+				con.PrintEventInfof("Playbook %s on %s is waiting for approval of step %s, see 'playbooks approve %s'",
+					run.Playbook, run.Hostname, step, shortID)
+			case "completed":
+				con.PrintEventSuccessf("Playbook %s on %s completed (run %s)", run.Playbook, run.Hostname, shortID)
+			default:
+				con.PrintEventErrorf("Playbook %s on %s %s (run %s)", run.Playbook, run.Hostname, run.State, shortID)
+			}
+			echoed = true
+
+		case consts.TransferProgressEvent:
+			progress := &sliverpb.TransferProgress{}
+			if event.Session != nil && proto.Unmarshal(event.Data, progress) == nil {
+				con.transfers.Store(transferKey(event.Session.ID, progress.ToImplant), progress)
+			}
+
+		case consts.RportFwdConnectionEvent:
+			rportfwd := &sliverpb.RPortfwd{}
+			if event.Session != nil && proto.Unmarshal(event.Data, rportfwd) == nil {
+				go core.LocalRportfwds.Connect(server.Rpc, event.Session.ID, rportfwd)
+			}
+
+		case consts.NTLMCaptureEvent:
+			capture := &sliverpb.NTLMCapture{}
+			if event.Session == nil || proto.Unmarshal(event.Data, capture) != nil {
+				break
+			}
+			shortID := strings.Split(event.Session.ID, "-")[0]
+			relayed := ""
+			if capture.RelayAddress != "" {
+				relayed = fmt.Sprintf(" (relayed to %s)", capture.RelayAddress)
+			}
+			con.PrintEventSuccessf("Session %s %s captured %s hash for %s\\%s from %s%s",
+				shortID, event.Session.Name, capture.HashType, capture.Domain, capture.User, capture.Source, relayed)
 			echoed = true
 
 		}
@@ -339,8 +429,23 @@ func (con *SliverConsoleClient) triggerReactions(event *clientpb.Event) {
 	}
 }
 
+// addressInfo - Format a source address along with its ASN and country, if known
+func addressInfo(address string, asn uint32, country string) string {
+	info := []string{}
+	if asn != 0 {
+		info = append(info, fmt.Sprintf("AS%d", asn))
+	}
+	if country != "" {
+		info = append(info, country)
+	}
+	if len(info) == 0 {
+		return address
+	}
+	return fmt.Sprintf("%s [%s]", address, strings.Join(info, " "))
+}
+
 // triggerBeaconTaskCallback - Triggers the callback for a beacon task
-func (con *SliverConsoleClient) triggerBeaconTaskCallback(data []byte) {
+func (con *SliverConsoleClient) triggerBeaconTaskCallback(rpc rpcpb.SliverRPCClient, data []byte) {
 	task := &clientpb.BeaconTask{}
 	err := proto.Unmarshal(data, task)
 	if err != nil {
@@ -349,7 +454,7 @@ func (con *SliverConsoleClient) triggerBeaconTaskCallback(data []byte) {
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	beacon, _ := con.Rpc.GetBeacon(ctx, &clientpb.Beacon{ID: task.BeaconID})
+	beacon, _ := rpc.GetBeacon(ctx, &clientpb.Beacon{ID: task.BeaconID})
 
 	// If the callback is not in our map then we don't do anything, the beacon task
 	// was either issued by another operator in multiplayer mode or the client process
@@ -361,7 +466,7 @@ func (con *SliverConsoleClient) triggerBeaconTaskCallback(data []byte) {
 			if beacon != nil {
 				con.PrintEventSuccessf("%s completed task %s", beacon.Name, strings.Split(task.ID, "-")[0])
 			}
-			task_content, err := con.Rpc.GetBeaconTaskContent(ctx, &clientpb.BeaconTask{
+			task_content, err := rpc.GetBeaconTaskContent(ctx, &clientpb.BeaconTask{
 				ID: task.ID,
 			})
 			con.Printf(Clearln + "\r")
@@ -387,15 +492,32 @@ func (con *SliverConsoleClient) GetPrompt() string {
 	if con.IsServer {
 		prompt = Bold + "[server] " + Normal + Underline + "sliver" + Normal
 	}
+	if server := con.ActiveServer(); server != nil && con.multipleServers() {
+		prompt = fmt.Sprintf(Bold+"[%s] "+Normal, server.Name) + prompt
+	}
 	if con.ActiveTarget.GetSession() != nil {
 		prompt += fmt.Sprintf(Bold+Red+" (%s)%s", con.ActiveTarget.GetSession().Name, Normal)
 	} else if con.ActiveTarget.GetBeacon() != nil {
 		prompt += fmt.Sprintf(Bold+Blue+" (%s)%s", con.ActiveTarget.GetBeacon().Name, Normal)
 	}
+	if impersonating := con.impersonating(); impersonating != "" {
+		prompt += fmt.Sprintf(Bold+Orange+" [%s]%s", impersonating, Normal)
+	}
 	prompt += " > "
 	return Clearln + prompt
 }
 
+// impersonating - Owner of the token the active target is impersonating
+func (con *SliverConsoleClient) impersonating() string {
+	if session := con.ActiveTarget.GetSession(); session != nil {
+		return session.Impersonating
+	}
+	if beacon := con.ActiveTarget.GetBeacon(); beacon != nil {
+		return beacon.Impersonating
+	}
+	return ""
+}
+
 func (con *SliverConsoleClient) PrintLogo() {
 	serverVer, err := con.Rpc.GetVersion(context.Background(), &commonpb.Empty{})
 	if err != nil {
@@ -527,46 +649,78 @@ func (con *SliverConsoleClient) PrintAsyncResponse(resp *commonpb.Response) {
 	con.PrintInfof("Tasked beacon %s (%s)\n", beacon.Name, strings.Split(resp.TaskID, "-")[0])
 }
 
+// PrintResponseErr - Print the error in an implant's response, including what kind
+// of failure it was if the implant reported an error code
+func (con *SliverConsoleClient) PrintResponseErr(resp *commonpb.Response) {
+	con.PrintErrorf("%s\n", sliverpb.ErrorMessage(resp))
+}
+
 func (con *SliverConsoleClient) Printf(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stdout(), format, args...)
+	return fmt.Fprintf(con.Stdout(), format, args...)
 }
 
 func (con *SliverConsoleClient) Println(args ...interface{}) (n int, err error) {
-	return fmt.Fprintln(con.App.Stdout(), args...)
+	return fmt.Fprintln(con.Stdout(), args...)
 }
 
 func (con *SliverConsoleClient) PrintInfof(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stdout(), Clearln+Info+format, args...)
+	return fmt.Fprintf(con.Stdout(), Clearln+Info+format, args...)
 }
 
 func (con *SliverConsoleClient) PrintSuccessf(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stdout(), Clearln+Success+format, args...)
+	return fmt.Fprintf(con.Stdout(), Clearln+Success+format, args...)
 }
 
 func (con *SliverConsoleClient) PrintWarnf(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stdout(), Clearln+"⚠️  "+Normal+format, args...)
+	return fmt.Fprintf(con.Stdout(), Clearln+"⚠️  "+Normal+format, args...)
 }
 
 func (con *SliverConsoleClient) PrintErrorf(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stderr(), Clearln+Warn+format, args...)
+	return fmt.Fprintf(con.Stderr(), Clearln+Warn+format, args...)
 }
 
 func (con *SliverConsoleClient) PrintEventInfof(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stdout(), Clearln+Info+format+"\n"+Clearln+"\r\n"+Clearln+"\r", args...)
+	return fmt.Fprintf(con.Stdout(), Clearln+Info+format+"\n"+Clearln+"\r\n"+Clearln+"\r", args...)
 }
 
 func (con *SliverConsoleClient) PrintEventErrorf(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stderr(), Clearln+Warn+format+"\n"+Clearln+"\r\n"+Clearln+"\r", args...)
+	return fmt.Fprintf(con.Stderr(), Clearln+Warn+format+"\n"+Clearln+"\r\n"+Clearln+"\r", args...)
 }
 
 func (con *SliverConsoleClient) PrintEventSuccessf(format string, args ...interface{}) (n int, err error) {
-	return fmt.Fprintf(con.App.Stdout(), Clearln+Success+format+"\n"+Clearln+"\r\n"+Clearln+"\r", args...)
+	return fmt.Fprintf(con.Stdout(), Clearln+Success+format+"\n"+Clearln+"\r\n"+Clearln+"\r", args...)
 }
 
 func (con *SliverConsoleClient) SpinUntil(message string, ctrl chan bool) {
 	go spin.Until(con.App.Stdout(), message, ctrl)
 }
 
+// SpinUntilTransfer - Spin until ctrl channel signals, the percent complete is shown
+// if the session reports the progress of the transfer (i.e. over DNS)
+func (con *SliverConsoleClient) SpinUntilTransfer(message string, session *clientpb.Session, toImplant bool, ctrl chan bool) {
+	if session == nil {
+		con.SpinUntil(message, ctrl)
+		return
+	}
+	key := transferKey(session.ID, toImplant)
+	con.transfers.Delete(key)
+	go spin.UntilFunc(con.App.Stdout(), func() string {
+		value, ok := con.transfers.Load(key)
+		if !ok {
+			return message
+		}
+		progress := value.(*sliverpb.TransferProgress)
+		if progress.Total == 0 {
+			return message
+		}
+		return fmt.Sprintf("%s %d%%", message, uint64(progress.Transferred)*100/uint64(progress.Total))
+	}, ctrl)
+}
+
+func transferKey(sessionID string, toImplant bool) string {
+	return fmt.Sprintf("%s/%v", sessionID, toImplant)
+}
+
 // FormatDateDelta - Generate formatted date string of the time delta between then and now
 func (con *SliverConsoleClient) FormatDateDelta(t time.Time, includeDate bool, color bool) string {
 	nextTime := t.Format(time.UnixDate)
@@ -671,6 +825,11 @@ func (s *ActiveTarget) Request(ctx *grumble.Context) *commonpb.Request {
 	if s.beacon != nil {
 		req.Async = true
 		req.BeaconID = s.beacon.ID
+		// Beacon tasks use the server's default timeout for the type of task
+		// unless the operator sets one
+		if flag, ok := ctx.Flags["timeout"]; ok && flag.IsDefault {
+			req.Timeout = 0
+		}
 	}
 	return req
 }
@@ -0,0 +1,174 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrDryRun - Returned instead of sending a request to an implant during a dry run
+var ErrDryRun = errors.New("dry run, the request was not sent")
+
+// DryRunRequest - A request a dry run stopped from being sent to an implant
+type DryRunRequest struct {
+	Method  string
+	Request proto.Message
+}
+
+// DryRun - Run a command without sending any of its requests to the implant,
+// requests that don't go to an implant (e.g. listing profiles) are still sent
+// to the server. Commands stop at the first implant request, since anything
+// after it depends on the implant's response.
+func (con *SliverConsoleClient) DryRun(run func()) []*DryRunRequest {
+	rpc := con.Rpc
+	conn := &dryRunConn{rpc: rpc}
+	con.Rpc = rpcpb.NewSliverRPCClient(conn)
+	defer func() {
+		con.Rpc = rpc
+	}()
+	run()
+	return conn.requests
+}
+
+// PrintDryRun - Print the requests a dry run stopped from being sent
+func (con *SliverConsoleClient) PrintDryRun(requests []*DryRunRequest) {
+	if len(requests) == 0 {
+		con.PrintInfof("The command didn't make any requests to the implant\n")
+		return
+	}
+	for _, req := range requests {
+		con.Printf(Bold+"%s"+Normal+"\n", req.Method)
+		for _, line := range DryRunFields(req.Request.ProtoReflect(), "  ") {
+			con.Println(line)
+		}
+	}
+}
+
+// DryRunFields - The populated fields of a request, one per line, large byte
+// fields (e.g. assemblies and shellcode) are shown as their size and hash
+func DryRunFields(msg protoreflect.Message, indent string) []string {
+	lines := []string{}
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		name := string(field.Name())
+		switch {
+		case field.IsList():
+			list := value.List()
+			lines = append(lines, fmt.Sprintf("%s%s:", indent, name))
+			for index := 0; index < list.Len(); index++ {
+				if field.Kind() == protoreflect.MessageKind {
+					lines = append(lines, fmt.Sprintf("%s  - %d:", indent, index))
+					lines = append(lines, DryRunFields(list.Get(index).Message(), indent+"    ")...)
+				} else {
+					lines = append(lines, fmt.Sprintf("%s  - %s", indent, dryRunValue(field, list.Get(index))))
+				}
+			}
+		case field.IsMap():
+			lines = append(lines, fmt.Sprintf("%s%s:", indent, name))
+			value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				lines = append(lines, fmt.Sprintf("%s  %s: %s", indent, key.String(), dryRunValue(field.MapValue(), value)))
+				return true
+			})
+		case field.Kind() == protoreflect.MessageKind:
+			if _, ok := value.Message().Interface().(*commonpb.Request); ok {
+				return true // The target is the active session or beacon
+			}
+			lines = append(lines, fmt.Sprintf("%s%s:", indent, name))
+			lines = append(lines, DryRunFields(value.Message(), indent+"  ")...)
+		default:
+			lines = append(lines, fmt.Sprintf("%s%s: %s", indent, name, dryRunValue(field, value)))
+		}
+		return true
+	})
+	return lines
+}
+
+func dryRunValue(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+		data := value.Bytes()
+		if len(data) <= 32 {
+			return fmt.Sprintf("%x", data)
+		}
+		digest := sha256.Sum256(data)
+		return fmt.Sprintf("<%d bytes, sha256 %x>", len(data), digest)
+	case protoreflect.StringKind:
+		return fmt.Sprintf("%q", value.String())
+	case protoreflect.EnumKind:
+		if enum := field.Enum().Values().ByNumber(value.Enum()); enum != nil {
+			return string(enum.Name())
+		}
+	case protoreflect.MessageKind:
+		return strings.TrimSpace(strings.Join(DryRunFields(value.Message(), ""), ", "))
+	}
+	return value.String()
+}
+
+// dryRunConn - Records implant requests instead of sending them, everything
+// else is passed on to the server
+type dryRunConn struct {
+	rpc      rpcpb.SliverRPCClient
+	requests []*DryRunRequest
+}
+
+func (c *dryRunConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	req, ok := args.(proto.Message)
+	if !ok {
+		return ErrDryRun
+	}
+	name := method[strings.LastIndex(method, "/")+1:]
+	if !isImplantRequest(req) {
+		// Call the real client's method of the same name
+		results := reflect.ValueOf(c.rpc).MethodByName(name).Call([]reflect.Value{
+			reflect.ValueOf(ctx), reflect.ValueOf(args),
+		})
+		if err, _ := results[1].Interface().(error); err != nil {
+			return err
+		}
+		proto.Merge(reply.(proto.Message), results[0].Interface().(proto.Message))
+		return nil
+	}
+	c.requests = append(c.requests, &DryRunRequest{Method: name, Request: req})
+	return ErrDryRun
+}
+
+func (c *dryRunConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, ErrDryRun
+}
+
+// isImplantRequest - Requests for a session or beacon have a commonpb.Request
+func isImplantRequest(req proto.Message) bool {
+	msg := req.ProtoReflect()
+	field := msg.Descriptor().Fields().ByName("Request")
+	if field == nil || field.Kind() != protoreflect.MessageKind || !msg.Has(field) {
+		return false
+	}
+	target, ok := msg.Get(field).Message().Interface().(*commonpb.Request)
+	return ok && (target.SessionID != "" || target.BeaconID != "")
+}
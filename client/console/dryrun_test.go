@@ -0,0 +1,77 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/grpc"
+)
+
+// versionRPC - Only answers GetVersion, implant requests should never reach it
+type versionRPC struct {
+	rpcpb.SliverRPCClient
+}
+
+func (versionRPC) GetVersion(context.Context, *commonpb.Empty, ...grpc.CallOption) (*clientpb.Version, error) {
+	return &clientpb.Version{Major: 1, Commit: "abc"}, nil
+}
+
+func TestDryRun(t *testing.T) {
+	con := &SliverConsoleClient{Rpc: versionRPC{}}
+	var version *clientpb.Version
+	var versionErr, assemblyErr error
+	requests := con.DryRun(func() {
+		version, versionErr = con.Rpc.GetVersion(context.Background(), &commonpb.Empty{})
+		_, assemblyErr = con.Rpc.ExecuteAssembly(context.Background(), &sliverpb.ExecuteAssemblyReq{
+			Request:   &commonpb.Request{SessionID: "session"},
+			Process:   `c:\windows\system32\notepad.exe`,
+			Assembly:  make([]byte, 1024),
+			Injection: &sliverpb.Injection{Technique: "apc"},
+		})
+	})
+	if _, ok := con.Rpc.(versionRPC); !ok {
+		t.Fatal("rpc client was not restored")
+	}
+	if versionErr != nil || version.Commit != "abc" {
+		t.Fatalf("server request was not passed through: %v (%v)", version, versionErr)
+	}
+	if assemblyErr != ErrDryRun {
+		t.Fatalf("expected dry run error, got %v", assemblyErr)
+	}
+	if len(requests) != 1 || requests[0].Method != "ExecuteAssembly" {
+		t.Fatalf("expected one ExecuteAssembly request, got %v", requests)
+	}
+
+	fields := strings.Join(DryRunFields(requests[0].Request.ProtoReflect(), ""), "\n")
+	for _, expected := range []string{`Process: "c:\\windows\\system32\\notepad.exe"`, "Assembly: <1024 bytes, sha256 ", "Injection:\n  Technique: \"apc\""} {
+		if !strings.Contains(fields, expected) {
+			t.Fatalf("%q not in:\n%s", expected, fields)
+		}
+	}
+	if strings.Contains(fields, "SessionID") {
+		t.Fatalf("target should not be shown:\n%s", fields)
+	}
+}
@@ -0,0 +1,116 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"io"
+	"regexp"
+)
+
+// MaskedSecret - Shown in place of secrets while masking is enabled
+const MaskedSecret = "********"
+
+type secretPattern struct {
+	pattern *regexp.Regexp
+	replace string
+}
+
+// secretPatterns - Secrets that commonly end up in output, harvested hashes and keys,
+// or passwords echoed back in command lines. Only the secret itself is replaced so
+// the output still makes sense.
+var secretPatterns = []secretPattern{
+	// PEM encoded private keys
+	{
+		regexp.MustCompile(`(-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----)[\s\S]*?(-----END [A-Z0-9 ]*PRIVATE KEY-----)`),
+		"${1}\n" + MaskedSecret + "\n${2}",
+	},
+	// LM:NT pairs i.e. pwdump/secretsdump format
+	{
+		regexp.MustCompile(`(?i)\b[0-9a-f]{32}:[0-9a-f]{32}\b`),
+		MaskedSecret + ":" + MaskedSecret,
+	},
+	// NetNTLMv2 challenge responses, user::domain:challenge:proof:blob
+	{
+		regexp.MustCompile(`(?i)(::[^:\s]*:[0-9a-f]{16}:)[0-9a-f]{32}:[0-9a-f]+`),
+		"${1}" + MaskedSecret,
+	},
+	// crypt(3) and kerberoast/asreproast hashes, the hash type is kept
+	{
+		regexp.MustCompile(`(\$(?:1|2[abxy]?|5|6|y|argon2id?|krb5tgs|krb5asrep|krb5pa)\$)[^\s'"]+`),
+		"${1}" + MaskedSecret,
+	},
+	// Labeled hashes, e.g. "NTLM: <hash>"
+	{
+		regexp.MustCompile(`(?i)((?:ntlm|nt hash|nthash|lm hash|lmhash)\s*[=:]\s*)[0-9a-f]{32}\b`),
+		"${1}" + MaskedSecret,
+	},
+	// Passwords in command lines, e.g. --password <pass>, -pass=<pass>, /p:<pass>
+	{
+		regexp.MustCompile(`(?i)((?:\s|^)(?:--?pass(?:word)?|/p(?:assword)?:)[ =]?)("[^"]*"|'[^']*'|[^\s"']+)`),
+		"${1}" + MaskedSecret,
+	},
+	// Labeled secrets, e.g. "Password: <pass>", allowing for color codes around the label
+	{
+		regexp.MustCompile(`(?i)((?:password|passwd|api[ _-]?key|secret)(?:\x1b\[[0-9;]*m)?\s*[=:](?:\x1b\[[0-9;]*m)?[ \t]*)([^\s\x1b]+)`),
+		"${1}" + MaskedSecret,
+	},
+}
+
+// MaskSecrets - Replace anything that looks like a secret in the output
+func MaskSecrets(output string) string {
+	for _, secret := range secretPatterns {
+		output = secret.pattern.ReplaceAllString(output, secret.replace)
+	}
+	return output
+}
+
+// maskWriter - Masks secrets in every write, fmt writes each formatted
+// message in one go so a secret is never split across writes
+type maskWriter struct {
+	io.Writer
+}
+
+func (w *maskWriter) Write(data []byte) (int, error) {
+	_, err := w.Writer.Write([]byte(MaskSecrets(string(data))))
+	if err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// MaskingSecrets - Is masking of secrets in output enabled, see 'settings mask-secrets'
+func (con *SliverConsoleClient) MaskingSecrets() bool {
+	return con.Settings != nil && con.Settings.MaskSecrets
+}
+
+// Stdout - The console's stdout, secrets are masked if enabled
+func (con *SliverConsoleClient) Stdout() io.Writer {
+	if con.MaskingSecrets() {
+		return &maskWriter{con.App.Stdout()}
+	}
+	return con.App.Stdout()
+}
+
+// Stderr - The console's stderr, secrets are masked if enabled
+func (con *SliverConsoleClient) Stderr() io.Writer {
+	if con.MaskingSecrets() {
+		return &maskWriter{con.App.Stderr()}
+	}
+	return con.App.Stderr()
+}
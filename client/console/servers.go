@@ -0,0 +1,238 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bishopfox/sliver/client/assets"
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrServerConnected - There's already a connection to the server
+	ErrServerConnected = errors.New("already connected to server")
+	// ErrServerNotFound - No connection to a server by that name
+	ErrServerNotFound = errors.New("server not found")
+	// ErrActiveServer - The active server can't be disconnected
+	ErrActiveServer = errors.New("cannot disconnect from the active server")
+)
+
+// Server - A connection to a team server, commands are always sent to the
+// active server
+type Server struct {
+	Name   string
+	Config *assets.ClientConfig // nil for the server console
+	Rpc    rpcpb.SliverRPCClient
+
+	conn *grpc.ClientConn
+
+	// The active target when we last switched away from this server
+	session *clientpb.Session
+	beacon  *clientpb.Beacon
+}
+
+// NewServer - A server connection made with a client config
+func NewServer(config *assets.ClientConfig, rpc rpcpb.SliverRPCClient, conn *grpc.ClientConn) *Server {
+	return &Server{
+		Name:   ServerName(config),
+		Config: config,
+		Rpc:    rpc,
+		conn:   conn,
+	}
+}
+
+// ServerName - The name a server is referred to by in the console
+func ServerName(config *assets.ClientConfig) string {
+	return fmt.Sprintf("%s@%s:%d", config.Operator, config.LHost, config.LPort)
+}
+
+// Servers - All connected servers, in the order they were connected
+func (con *SliverConsoleClient) Servers() []*Server {
+	con.serversMutex.Lock()
+	defer con.serversMutex.Unlock()
+	return append([]*Server{}, con.servers...)
+}
+
+// ActiveServer - The server commands are sent to
+func (con *SliverConsoleClient) ActiveServer() *Server {
+	con.serversMutex.Lock()
+	defer con.serversMutex.Unlock()
+	return con.activeServer
+}
+
+// GetServer - Get a connected server by name
+func (con *SliverConsoleClient) GetServer(name string) *Server {
+	con.serversMutex.Lock()
+	defer con.serversMutex.Unlock()
+	for _, server := range con.servers {
+		if server.Name == name {
+			return server
+		}
+	}
+	return nil
+}
+
+// AddServer - Add a server connection and start processing its events, the
+// first server added becomes the active server
+func (con *SliverConsoleClient) AddServer(server *Server) error {
+	con.serversMutex.Lock()
+	for _, connected := range con.servers {
+		if connected.Name == server.Name {
+			con.serversMutex.Unlock()
+			return ErrServerConnected
+		}
+	}
+	con.servers = append(con.servers, server)
+	first := con.activeServer == nil
+	con.serversMutex.Unlock()
+
+	go con.startEventLoop(server)
+	go core.TunnelLoop(server.Rpc)
+	if first {
+		con.UseServer(server)
+	}
+	return nil
+}
+
+// UseServer - Switch the active server, the active target is saved so it's
+// restored when switching back
+func (con *SliverConsoleClient) UseServer(server *Server) {
+	con.serversMutex.Lock()
+	if con.activeServer != nil {
+		con.activeServer.session, con.activeServer.beacon = con.ActiveTarget.Get()
+	}
+	con.activeServer = server
+	con.Rpc = server.Rpc
+	con.serversMutex.Unlock()
+
+	core.GetTunnels().UseStream(server.Rpc)
+	con.ActiveTarget.Set(server.session, server.beacon)
+}
+
+// RemoveServer - Disconnect from a server that isn't the active server
+func (con *SliverConsoleClient) RemoveServer(server *Server) error {
+	con.serversMutex.Lock()
+	defer con.serversMutex.Unlock()
+	if server == con.activeServer {
+		return ErrActiveServer
+	}
+	for index, connected := range con.servers {
+		if connected == server {
+			con.servers = append(con.servers[:index], con.servers[index+1:]...)
+			if server.conn != nil {
+				server.conn.Close()
+			}
+			return nil
+		}
+	}
+	return ErrServerNotFound
+}
+
+// isActiveServer - Events from other servers are only announced
+func (con *SliverConsoleClient) isActiveServer(server *Server) bool {
+	con.serversMutex.Lock()
+	defer con.serversMutex.Unlock()
+	return server == con.activeServer
+}
+
+// backgroundEvent - Announce the important events from a server that isn't the
+// active server, reactions and event listeners only ever see the active server's
+// events since they run commands against it
+func (con *SliverConsoleClient) backgroundEvent(server *Server, event *clientpb.Event) {
+	switch event.EventType {
+
+	case consts.CanaryEvent, consts.WatchtowerEvent:
+		con.PrintEventErrorf("[%s] "+Bold+"WARNING: %s%s has been burned", server.Name, Normal, event.Session.Name)
+
+	case consts.JobStoppedEvent:
+		job := event.Job
+		con.PrintEventErrorf("[%s] Job #%d stopped (%s/%s)", server.Name, job.ID, job.Protocol, job.Name)
+
+	case consts.SessionOpenedEvent:
+		session := event.Session
+		shortID := strings.Split(session.ID, "-")[0]
+		con.PrintEventInfof("[%s] Session %s %s - %s (%s) - %s/%s",
+			server.Name, shortID, session.Name, session.RemoteAddress, session.Hostname, session.OS, session.Arch)
+
+	case consts.SessionClosedEvent:
+		session := event.Session
+		shortID := strings.Split(session.ID, "-")[0]
+		con.PrintEventErrorf("[%s] Lost session %s %s - %s (%s) - %s/%s",
+			server.Name, shortID, session.Name, session.RemoteAddress, session.Hostname, session.OS, session.Arch)
+		core.GetTunnels().CloseForSession(session.ID)
+		core.CloseCursedProcesses(session.ID)
+		con.serversMutex.Lock()
+		if server.session != nil && server.session.ID == session.ID {
+			server.session = nil
+		}
+		con.serversMutex.Unlock()
+
+	case consts.BeaconRegisteredEvent:
+		beacon := &clientpb.Beacon{}
+		proto.Unmarshal(event.Data, beacon)
+		shortID := strings.Split(beacon.ID, "-")[0]
+		con.PrintEventInfof("[%s] Beacon %s %s - %s (%s) - %s/%s",
+			server.Name, shortID, beacon.Name, beacon.RemoteAddress, beacon.Hostname, beacon.OS, beacon.Arch)
+
+	case consts.BeaconTaskResultEvent:
+		// Tasks issued before switching servers still get their results
+		con.triggerBeaconTaskCallback(server.Rpc, event.Data)
+		return
+
+	case consts.TransferProgressEvent:
+		progress := &sliverpb.TransferProgress{}
+		if event.Session != nil && proto.Unmarshal(event.Data, progress) == nil {
+			con.transfers.Store(transferKey(event.Session.ID, progress.ToImplant), progress)
+		}
+		return
+
+	default:
+		return
+	}
+	con.Printf(Clearln + con.GetPrompt())
+	bufio.NewWriter(con.App.Stdout()).Flush()
+}
+
+// multipleServers - Only show which server is active when it's ambiguous
+func (con *SliverConsoleClient) multipleServers() bool {
+	con.serversMutex.Lock()
+	defer con.serversMutex.Unlock()
+	return 1 < len(con.servers)
+}
+
+// closeServers - Close all of the server connections when the console exits
+func (con *SliverConsoleClient) closeServers() {
+	con.serversMutex.Lock()
+	defer con.serversMutex.Unlock()
+	for _, server := range con.servers {
+		if server.conn != nil {
+			server.conn.Close()
+		}
+	}
+}
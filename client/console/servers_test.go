@@ -0,0 +1,105 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bishopfox/sliver/client/assets"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"google.golang.org/grpc"
+)
+
+// offlineRPC - The event and tunnel streams fail immediately, nothing else is called
+type offlineRPC struct {
+	rpcpb.SliverRPCClient
+}
+
+func (offlineRPC) Events(context.Context, *commonpb.Empty, ...grpc.CallOption) (rpcpb.SliverRPC_EventsClient, error) {
+	return nil, errors.New("offline")
+}
+
+func (offlineRPC) TunnelData(context.Context, ...grpc.CallOption) (rpcpb.SliverRPC_TunnelDataClient, error) {
+	return nil, errors.New("offline")
+}
+
+func TestServers(t *testing.T) {
+	con := &SliverConsoleClient{
+		ActiveTarget: &ActiveTarget{observers: map[int]Observer{}},
+		serversMutex: &sync.Mutex{},
+	}
+	first := NewServer(&assets.ClientConfig{Operator: "alice", LHost: "10.0.0.1", LPort: 31337}, &offlineRPC{}, nil)
+	second := NewServer(&assets.ClientConfig{Operator: "alice", LHost: "10.0.0.2", LPort: 31337}, &offlineRPC{}, nil)
+	if first.Name != "alice@10.0.0.1:31337" {
+		t.Fatalf("unexpected server name %q", first.Name)
+	}
+
+	if err := con.AddServer(first); err != nil {
+		t.Fatal(err)
+	}
+	if con.ActiveServer() != first || con.Rpc != first.Rpc {
+		t.Fatal("first server is not the active server")
+	}
+	if strings.Contains(con.GetPrompt(), first.Name) {
+		t.Fatal("prompt shows the server with only one server connected")
+	}
+	if err := con.AddServer(second); err != nil {
+		t.Fatal(err)
+	}
+	if err := con.AddServer(NewServer(first.Config, &offlineRPC{}, nil)); err != ErrServerConnected {
+		t.Fatalf("expected already connected error, got %v", err)
+	}
+	if con.ActiveServer() != first {
+		t.Fatal("adding a server changed the active server")
+	}
+	if !strings.Contains(con.GetPrompt(), first.Name) {
+		t.Fatal("prompt does not show the active server")
+	}
+
+	// The active target is restored when switching back
+	session := &clientpb.Session{ID: "session-on-first", Name: "FIRST"}
+	con.ActiveTarget.Set(session, nil)
+	con.UseServer(second)
+	if con.Rpc != second.Rpc || con.ActiveTarget.GetSession() != nil {
+		t.Fatal("switching servers kept the other server's session")
+	}
+	con.UseServer(first)
+	if con.ActiveTarget.GetSession() != session {
+		t.Fatal("switching back did not restore the session")
+	}
+
+	if err := con.RemoveServer(first); err != ErrActiveServer {
+		t.Fatalf("expected active server error, got %v", err)
+	}
+	if err := con.RemoveServer(second); err != nil {
+		t.Fatal(err)
+	}
+	if con.GetServer(second.Name) != nil || len(con.Servers()) != 1 {
+		t.Fatal("server was not removed")
+	}
+	if err := con.RemoveServer(second); err != ErrServerNotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+}
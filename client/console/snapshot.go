@@ -0,0 +1,115 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// SecurityProducts - Names the security products among a snapshot's executables,
+// set by the processes command which has the list of known products
+var SecurityProducts = func(executables []string) []string { return nil }
+
+// snapshotCard - The triage lines printed under a registration event, empty if
+// the implant wasn't built with a startup snapshot
+func snapshotCard(snapshot *sliverpb.EnvironmentSnapshot) string {
+	if snapshot == nil {
+		return ""
+	}
+	lines := []string{}
+	if snapshot.Domain != "" || snapshot.DomainRole != "" {
+		domain := snapshot.Domain
+		if domain == "" {
+			domain = "none"
+		}
+		if snapshot.DomainRole != "" {
+			domain += fmt.Sprintf(" (%s)", snapshot.DomainRole)
+		}
+		lines = append(lines, Bold+"Domain: "+Normal+domain)
+	}
+	if users := SnapshotUsers(snapshot); 0 < len(users) {
+		lines = append(lines, Bold+"Users: "+Normal+strings.Join(users, ", "))
+	}
+	if addresses := SnapshotAddresses(snapshot); 0 < len(addresses) {
+		lines = append(lines, Bold+"Addresses: "+Normal+strings.Join(addresses, ", "))
+	}
+	if snapshot.Proxy != "" {
+		lines = append(lines, Bold+"Proxy: "+Normal+snapshot.Proxy)
+	}
+	if 0 < len(snapshot.Processes) {
+		products := SecurityProducts(snapshot.Processes)
+		if len(products) == 0 {
+			lines = append(lines, Bold+"Security: "+Normal+"none identified")
+		} else {
+			lines = append(lines, Bold+"Security: "+Normal+Red+strings.Join(products, ", ")+Normal)
+		}
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	return "\n" + Clearln + "\t" + strings.Join(lines, "\n"+Clearln+"\t")
+}
+
+// SnapshotUsers - The distinct logged on users, remote sessions note where they're from
+func SnapshotUsers(snapshot *sliverpb.EnvironmentSnapshot) []string {
+	users := []string{}
+	seen := map[string]bool{}
+	for _, session := range snapshot.Sessions {
+		user := session.Username
+		if session.Domain != "" {
+			user = session.Domain + "\\" + user
+		}
+		if session.Remote {
+			from := session.ClientAddress
+			if from == "" {
+				from = session.ClientName
+			}
+			if from != "" {
+				user += fmt.Sprintf(" (remote from %s)", from)
+			} else {
+				user += " (remote)"
+			}
+		}
+		if !seen[user] {
+			seen[user] = true
+			users = append(users, user)
+		}
+	}
+	return users
+}
+
+// SnapshotAddresses - The addresses of the snapshot's interfaces, link-local
+// addresses are left out
+func SnapshotAddresses(snapshot *sliverpb.EnvironmentSnapshot) []string {
+	addresses := []string{}
+	for _, iface := range snapshot.Interfaces {
+		for _, address := range iface.IPAddresses {
+			ip, _, err := net.ParseCIDR(address)
+			if err == nil && (ip.IsLinkLocalUnicast() || ip.IsLoopback()) {
+				continue
+			}
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
@@ -0,0 +1,75 @@
+package console
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestSnapshotCard(t *testing.T) {
+	if snapshotCard(nil) != "" {
+		t.Fatal("card printed without a snapshot")
+	}
+	defer func(securityProducts func([]string) []string) {
+		SecurityProducts = securityProducts
+	}(SecurityProducts)
+	SecurityProducts = func(executables []string) []string {
+		for _, executable := range executables {
+			if executable == "MsMpEng.exe" {
+				return []string{"Windows Defender"}
+			}
+		}
+		return nil
+	}
+	snapshot := &sliverpb.EnvironmentSnapshot{
+		Domain:     "corp.example.com",
+		DomainRole: "member workstation",
+		Interfaces: []*sliverpb.NetInterface{
+			{Name: "Ethernet", IPAddresses: []string{"fe80::1/64", "10.0.0.5/24"}},
+		},
+		Sessions: []*sliverpb.PresenceSession{
+			{Username: "alice", Domain: "CORP"},
+			{Username: "alice", Domain: "CORP"},
+			{Username: "bob", Domain: "CORP", Remote: true, ClientAddress: "10.0.0.9"},
+		},
+		Processes: []string{"explorer.exe", "MsMpEng.exe"},
+	}
+	card := snapshotCard(snapshot)
+	for _, expected := range []string{
+		"corp.example.com (member workstation)",
+		`CORP\alice, CORP\bob (remote from 10.0.0.9)`,
+		"10.0.0.5/24",
+		"Windows Defender",
+	} {
+		if !strings.Contains(card, expected) {
+			t.Fatalf("card is missing %q:\n%s", expected, card)
+		}
+	}
+	if strings.Contains(card, "fe80::1") || strings.Contains(card, "Proxy") {
+		t.Fatalf("card has a link-local address or empty proxy:\n%s", card)
+	}
+
+	snapshot.Processes = []string{"explorer.exe"}
+	if !strings.Contains(snapshotCard(snapshot), "none identified") {
+		t.Fatal("card should say no security products were identified")
+	}
+}
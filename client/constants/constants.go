@@ -29,6 +29,80 @@ const (
 	LastUpdateCheckFileName = "last_update_check"
 )
 
+// Process injection techniques, how implants execute tasks in remote processes
+const (
+	InjectionCreateRemoteThread = "crt"
+	InjectionAPC                = "apc"
+	InjectionThreadHijack       = "hijack"
+	InjectionModuleStomp        = "stomp"
+)
+
+// InjectionTechniques - Every technique an implant can be told to use
+var InjectionTechniques = []string{
+	InjectionCreateRemoteThread,
+	InjectionAPC,
+	InjectionThreadHijack,
+	InjectionModuleStomp,
+}
+
+// Sleep masks, how windows beacons hide their memory between check-ins
+const (
+	SleepMaskTimer = "timer"
+	SleepMaskEkko  = "ekko"
+)
+
+// SleepMasks - Every sleep mask a beacon can be built with
+var SleepMasks = []string{
+	SleepMaskTimer,
+	SleepMaskEkko,
+}
+
+// AMSI and ETW bypass techniques, how implants disable them before loading the CLR or a BOF
+const (
+	BypassPatch              = "patch"
+	BypassHardwareBreakpoint = "hwbp"
+)
+
+// BypassTechniques - Every bypass technique an implant can be told to use
+var BypassTechniques = []string{
+	BypassPatch,
+	BypassHardwareBreakpoint,
+}
+
+// Syscall backends, how windows implants make the nt syscalls behind their memory,
+// thread and process primitives instead of calling kernel32
+const (
+	SyscallDirect   = "direct"
+	SyscallIndirect = "indirect"
+)
+
+// SyscallBackends - Every syscall backend an implant can be built with
+var SyscallBackends = []string{
+	SyscallDirect,
+	SyscallIndirect,
+}
+
+// Shellcode loaders, how implants are converted to shellcode
+const (
+	ShellcodeDonut = "donut"
+	ShellcodeSRDI  = "srdi"
+)
+
+// ShellcodeLoaders - Every loader shellcode implants can be converted with
+var ShellcodeLoaders = []string{
+	ShellcodeDonut,
+	ShellcodeSRDI,
+}
+
+// SRDIExports - The implant exports sRDI can call once the dll is loaded
+var SRDIExports = []string{
+	"StartW",
+	"VoidFunc",
+	"DllInstall",
+	"DllRegisterServer",
+	"DllUnregisterServer",
+}
+
 // Events
 const (
 	// UpdateStr - "update"
@@ -89,6 +163,9 @@ const (
 	// BeaconTaskResult - Beacon task completed with a result
 	BeaconTaskResultEvent = "beacon-taskresult"
 
+	// BeaconAddressChangedEvent - Beacon checked in from a suspicious new source address
+	BeaconAddressChangedEvent = "beacon-address-changed"
+
 	// ExternalBuildEvent
 	ExternalBuildEvent          = "external-build"
 	AcknowledgeBuildEvent       = "external-acknowledge"
@@ -97,6 +174,30 @@ const (
 
 	// WireGuardNewPeer - New Wireguard peer added
 	WireGuardNewPeer = "wireguard-newpeer"
+
+	// TransferProgressEvent - Progress of a large envelope over a slow transport
+	TransferProgressEvent = "transfer-progress"
+
+	// SearchMatchesEvent - Matches of a running file system search
+	SearchMatchesEvent = "search-matches"
+
+	// NTLMCaptureEvent - An ntlm listener captured an authentication
+	NTLMCaptureEvent = "ntlm-capture"
+
+	// RportFwdConnectionEvent - A reverse port forward to an operator's console accepted a connection
+	RportFwdConnectionEvent = "rportfwd-connection"
+
+	// ListenerSettingsEvent - A listener's runtime settings were changed
+	ListenerSettingsEvent = "listener-settings"
+
+	// EngagementEndedEvent - The engagement's end has passed, all implants are being killed
+	EngagementEndedEvent = "engagement-ended"
+
+	// EngagementUnreachableEvent - A beacon didn't check in to pick up its kill task
+	EngagementUnreachableEvent = "engagement-unreachable"
+
+	// PlaybookEvent - A playbook run is waiting for approval or has finished
+	PlaybookEvent = "playbook"
 )
 
 // Commands
@@ -105,15 +206,20 @@ const (
 	NewOperatorStr     = "new-operator"
 	KickOperatorStr    = "kick-operator"
 	MultiplayerModeStr = "multiplayer"
+	ServersStr         = "servers"
+	DisconnectStr      = "disconnect"
 
 	SessionsStr     = "sessions"
 	BackgroundStr   = "background"
 	InfoStr         = "info"
 	UseStr          = "use"
 	ReconfigStr     = "reconfig"
+	ProxyStr        = "proxy"
+	RedirectStr     = "redirect"
 	PruneStr        = "prune"
 	TasksStr        = "tasks"
 	CancelStr       = "cancel"
+	ReorderStr      = "reorder"
 	GenerateStr     = "generate"
 	RegenerateStr   = "regenerate"
 	CompilerInfoStr = "info"
@@ -123,6 +229,7 @@ const (
 	BeaconsStr      = "beacons"
 	WatchStr        = "watch"
 	SettingsStr     = "settings"
+	OpsecStr        = "opsec"
 	SearchStr       = "search"
 
 	// Generic
@@ -133,12 +240,15 @@ const (
 	StartStr   = "start"
 	StopStr    = "stop"
 	SetStr     = "set"
+	GetStr     = "get"
 	UnsetStr   = "unset"
 	SaveStr    = "save"
 	ReloadStr  = "reload"
 	LoadStr    = "load"
 	TablesStr  = "tables"
 	DetailsStr = "details"
+	ImportStr  = "import"
+	ExportStr  = "export"
 
 	LootStr       = "loot"
 	LootLocalStr  = "local"
@@ -155,16 +265,22 @@ const (
 	MtlsStr        = "mtls"
 	WGStr          = "wg"
 	DnsStr         = "dns"
+	IcmpStr        = "icmp"
+	UdpStr         = "udp"
 	HttpStr        = "http"
 	HttpsStr       = "https"
 	NamedPipeStr   = "named-pipe"
 	TCPListenerStr = "tcp"
+	AllowStr       = "allow"
+	GraphStr       = "graph"
 
 	MsfStr       = "msf"
 	MsfInjectStr = "msf-inject"
 
 	PsStr        = "ps"
 	PingStr      = "ping"
+	PresenceStr  = "presence"
+	SurveyStr    = "survey"
 	KillStr      = "kill"
 	TerminateStr = "terminate"
 
@@ -194,6 +310,7 @@ const (
 	MemfilesStr = "memfiles"
 
 	ProcdumpStr         = "procdump"
+	LsassDumpStr        = "lsass-dump"
 	ImpersonateStr      = "impersonate"
 	RunAsStr            = "runas"
 	ElevateStr          = "elevate"
@@ -203,23 +320,34 @@ const (
 	ExecuteShellcodeStr = "execute-shellcode"
 	MigrateStr          = "migrate"
 	SideloadStr         = "sideload"
+	InlineExecuteStr    = "inline-execute"
+	ValidateCredsStr    = "validate-creds"
 	SpawnDllStr         = "spawndll"
 	ExtensionsStr       = "extensions"
+	BOFStr              = "bof"
+	RunStr              = "run"
 	InstallStr          = "install"
 	ListStr             = "list"
 	ArmoryStr           = "armory"
 	AliasesStr          = "aliases"
 	StageListenerStr    = "stage-listener"
+	ShellUpgradeStr     = "shell-upgrade"
+	WarmupStr           = "warmup"
 
 	WebsitesStr       = "websites"
 	RmWebContentStr   = "rm-content"
 	AddWebContentStr  = "add-content"
 	WebContentTypeStr = "content-type"
 
+	C2ProfilesStr = "c2profiles"
+
 	ScreenshotStr         = "screenshot"
+	ClipboardStr          = "clipboard"
 	PsExecStr             = "psexec"
 	BackdoorStr           = "backdoor"
 	MakeTokenStr          = "make-token"
+	StealTokenStr         = "steal-token"
+	TokensStr             = "tokens"
 	EnvStr                = "env"
 	RegistryStr           = "registry"
 	RegistryReadStr       = "read"
@@ -228,28 +356,59 @@ const (
 	RegistryListValuesStr = "list-values"
 	RegistryCreateKeyStr  = "create"
 	RegistryDeleteKeyStr  = "delete"
+	RegistryExportStr     = "export"
+	RegistrySearchStr     = "search"
+	ServicesCreateStr     = "create"
+	ServicesDeleteStr     = "delete"
+	ServicesConfigStr     = "config"
+	MacOSStr              = "macos"
+	TCCStr                = "tcc"
+	KeychainStr           = "keychain"
+	LaunchdStr            = "launchd"
+	AdcsStr               = "adcs"
+	EnumStr               = "enum"
+	RequestStr            = "request"
 	PivotsStr             = "pivots"
 	WgConfigStr           = "wg-config"
 	WgSocksStr            = "wg-socks"
 	WgPortFwdStr          = "wg-portfwd"
+	WgRotateKeysStr       = "wg-rotate-keys"
 	MonitorStr            = "monitor"
 	SSHStr                = "ssh"
+	SSHHarvestStr         = "ssh-harvest"
 	DLLHijackStr          = "dllhijack"
 	InteractiveStr        = "interactive"
 	CloseStr              = "close"
 
-	PortfwdStr  = "portfwd"
-	Socks5Str   = "socks5"
-	RportfwdStr = "rportfwd"
+	PortfwdStr      = "portfwd"
+	Socks5Str       = "socks5"
+	RportfwdStr     = "rportfwd"
+	NTLMListenerStr = "ntlm-listener"
 
 	ReactionStr = "reaction"
 
-	HostsStr = "hosts"
-	IOCStr   = "ioc"
+	HostsStr       = "hosts"
+	IOCStr         = "ioc"
+	LocalGroupsStr = "local-groups"
+	ServicesStr    = "services"
+	TagStr         = "tag"
+
+	PersistenceStr    = "persistence"
+	ServiceHijacksStr = "service-hijacks"
+	EngagementStr     = "engagement"
+
+	ArtifactsStr = "artifacts"
+	ConvertStr   = "convert"
+
+	PlaybooksStr = "playbooks"
+	RunsStr      = "runs"
+	ApproveStr   = "approve"
+	RejectStr    = "reject"
 
 	LicensesStr = "licenses"
 
 	GetPrivsStr        = "getprivs"
+	LogonsStr          = "logons"
 	PreludeOperatorStr = "prelude-operator"
 	ConnectStr         = "connect"
 
@@ -270,6 +429,7 @@ const (
 	GenericHelpGroup     = "Generic:"
 	SliverHelpGroup      = "Sliver:"
 	SliverWinHelpGroup   = "Sliver - Windows:"
+	SliverMacHelpGroup   = "Sliver - macOS:"
 	MultiplayerHelpGroup = "Multiplayer:"
 	AliasHelpGroup       = "Sliver - 3rd Party macros:"
 	ExtensionHelpGroup   = "Sliver - 3rd Party extensions:"
@@ -21,6 +21,8 @@ package core
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
+	"math"
 
 	"golang.org/x/text/encoding/unicode"
 )
@@ -85,3 +87,70 @@ func (b *BOFArgsBuffer) GetBuffer() ([]byte, error) {
 	}
 	return outBuffer.Bytes(), nil
 }
+
+// PackBOFArgs - Pack arguments the same way as Cobalt Strike's bof_pack(), each
+// character of the format is the type of the matching argument:
+// b (binary data), i (4-byte int), s (2-byte short), z (string), Z (wide string)
+func PackBOFArgs(format string, args ...interface{}) ([]byte, error) {
+	if len(format) != len(args) {
+		return nil, fmt.Errorf("format '%s' has %d argument(s), got %d", format, len(format), len(args))
+	}
+	buffer := BOFArgsBuffer{Buffer: new(bytes.Buffer)}
+	for index, argType := range format {
+		var err error
+		switch argType {
+		case 'b':
+			data, ok := args[index].([]byte)
+			if !ok {
+				return nil, fmt.Errorf("argument %d is not binary data", index+1)
+			}
+			err = buffer.AddData(data)
+		case 'i':
+			value, ok := bofInt(args[index])
+			if !ok || value < math.MinInt32 || math.MaxUint32 < value {
+				return nil, fmt.Errorf("argument %d is not an int", index+1)
+			}
+			err = buffer.AddInt(uint32(value))
+		case 's':
+			value, ok := bofInt(args[index])
+			if !ok || value < math.MinInt16 || math.MaxUint16 < value {
+				return nil, fmt.Errorf("argument %d is not a short", index+1)
+			}
+			err = buffer.AddShort(uint16(value))
+		case 'z', 'Z':
+			value, ok := args[index].(string)
+			if !ok {
+				return nil, fmt.Errorf("argument %d is not a string", index+1)
+			}
+			if argType == 'z' {
+				err = buffer.AddString(value)
+			} else {
+				err = buffer.AddWString(value)
+			}
+		default:
+			return nil, fmt.Errorf("invalid format character '%c'", argType)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return buffer.GetBuffer()
+}
+
+func bofInt(arg interface{}) (int64, bool) {
+	switch value := arg.(type) {
+	case int:
+		return int64(value), true
+	case int16:
+		return int64(value), true
+	case int32:
+		return int64(value), true
+	case int64:
+		return value, true
+	case uint16:
+		return int64(value), true
+	case uint32:
+		return int64(value), true
+	}
+	return 0, false
+}
@@ -0,0 +1,115 @@
+package core
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	// LocalRportfwds - Reverse port forwards whose connections are dialed by this console
+	LocalRportfwds = localRportfwds{
+		forwards: map[string]string{},
+		mutex:    &sync.RWMutex{},
+	}
+)
+
+type localRportfwds struct {
+	forwards map[string]string // session/listener id -> local target
+	mutex    *sync.RWMutex
+}
+
+func localRportfwdKey(sessionID string, listenerID uint32) string {
+	return fmt.Sprintf("%s/%d", sessionID, listenerID)
+}
+
+// Add - Forward connections accepted by an implant's listener to a local target
+func (r *localRportfwds) Add(sessionID string, listenerID uint32, target string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.forwards[localRportfwdKey(sessionID, listenerID)] = target
+}
+
+// Remove - Stop forwarding connections of an implant's listener
+func (r *localRportfwds) Remove(sessionID string, listenerID uint32) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.forwards, localRportfwdKey(sessionID, listenerID))
+}
+
+// Get - Get the local target of an implant's listener, if this console added it
+func (r *localRportfwds) Get(sessionID string, listenerID uint32) (string, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	target, ok := r.forwards[localRportfwdKey(sessionID, listenerID)]
+	return target, ok
+}
+
+// Connect - Dial the local target of a connection accepted by an implant's listener and bind
+// it to the connection's tunnel. Connections to listeners added by other consoles are ignored,
+// the target address always comes from this console and never from the implant.
+func (r *localRportfwds) Connect(rpc rpcpb.SliverRPCClient, sessionID string, rportfwd *sliverpb.RPortfwd) {
+	target, ok := r.Get(sessionID, rportfwd.ListenerID)
+	if !ok {
+		return
+	}
+	log.Printf("[rportfwd] Dialing %s for tunnel %d", target, rportfwd.TunnelID)
+	conn, err := net.DialTimeout("tcp", target, 30*time.Second)
+	if err != nil {
+		log.Printf("[rportfwd] Failed to dial %s: %s", target, err)
+		rpc.CloseTunnel(context.Background(), &sliverpb.Tunnel{
+			TunnelID:  rportfwd.TunnelID,
+			SessionID: sessionID,
+		})
+		return
+	}
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		tcpConn.SetKeepAlive(true)
+		tcpConn.SetKeepAlivePeriod(30 * time.Second)
+	}
+	tunnel := GetTunnels().Start(rportfwd.TunnelID, sessionID)
+
+	// Cleanup
+	defer func() {
+		conn.Close()
+		GetTunnels().Close(tunnel.ID)
+		rpc.CloseTunnel(context.Background(), &sliverpb.Tunnel{
+			TunnelID:  tunnel.ID,
+			SessionID: sessionID,
+		})
+	}()
+
+	errs := make(chan error, 1)
+	go toImplantLoop(conn, tunnel, errs)
+	go fromImplantLoop(conn, tunnel, errs)
+
+	// Block until error, then cleanup
+	err = <-errs
+	if err != nil {
+		log.Printf("[rportfwd] Closing tunnel %d with error %s", tunnel.ID, err)
+	}
+}
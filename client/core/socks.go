@@ -31,6 +31,8 @@ import (
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/util/leaky"
+	"github.com/things-go/go-socks5"
+	"github.com/things-go/go-socks5/statute"
 )
 
 var (
@@ -41,6 +43,9 @@ var (
 	}
 	SocksConnPool = sync.Map{}
 	SocksProxyID  = (uint64)(0)
+
+	socksTunnels      = sync.Map{} // map[uint64]*socksTunnel
+	socksAssociations = sync.Map{} // map[uint64]*socksAssociation
 )
 
 // PortfwdMeta - Metadata about a portfwd listener
@@ -139,6 +144,10 @@ func (f *socksProxy) Start(tcpProxy *TcpProxy) error {
 					SocksConnPool.Delete(socksData.TunnelID)
 					continue
 				}
+				if socksData.UDP {
+					socksDatagram(conn, socksData)
+					continue
+				}
 				log.Printf("[socks] agent to Server To (Client to User) Data Sequence %d , Data Size %d \n", FromImplantSequence, len(socksData.Data))
 				//fmt.Printf("recv data len %d \n", len(p.Data))
 				_, err := conn.Write(socksData.Data)
@@ -209,11 +218,48 @@ const leakyBufSize = 4108 // data.len(2) + hmacsha1(10) + data(4096)
 
 var leakyBuf = leaky.NewLeakyBuf(2048, leakyBufSize)
 
-func connect(conn net.Conn, stream rpcpb.SliverRPC_SocksProxyClient, frame *sliverpb.SocksData) {
+// socksTunnel - Frames sent to the implant for one connection, TCP data and UDP
+// datagrams share the tunnel's sequence
+type socksTunnel struct {
+	stream   rpcpb.SliverRPC_SocksProxyClient
+	frame    *sliverpb.SocksData
+	sequence uint64
+	mutex    sync.Mutex
+}
 
+func (t *socksTunnel) send(data []byte, udp bool, closeConn bool) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	err := t.stream.Send(&sliverpb.SocksData{
+		Data:      data,
+		UDP:       udp,
+		CloseConn: closeConn,
+		Username:  t.frame.Username,
+		Password:  t.frame.Password,
+		Sequence:  t.sequence,
+		TunnelID:  t.frame.TunnelID,
+		Request:   t.frame.Request,
+	})
+	if err != nil {
+		return err
+	}
+	t.sequence++
+	return nil
+}
+
+func connect(conn net.Conn, stream rpcpb.SliverRPC_SocksProxyClient, frame *sliverpb.SocksData) {
+	tunnel := &socksTunnel{stream: stream, frame: frame}
 	SocksConnPool.Store(frame.TunnelID, conn)
+	socksTunnels.Store(frame.TunnelID, tunnel)
 
 	defer func() {
+		socksTunnels.Delete(frame.TunnelID)
+		if association, ok := socksAssociations.LoadAndDelete(frame.TunnelID); ok {
+			association.(*socksAssociation).relay.Close()
+			// Let the implant know the association is over
+			tunnel.send(nil, false, true)
+		}
+
 		// It's neccessary to close and remove connection once we done with it
 		c, ok := SocksConnPool.LoadAndDelete(frame.TunnelID)
 		if !ok {
@@ -230,7 +276,6 @@ func connect(conn net.Conn, stream rpcpb.SliverRPC_SocksProxyClient, frame *sliv
 
 	buff := leakyBuf.Get()
 	defer leakyBuf.Put(buff)
-	var ToImplantSequence uint64 = 0
 	for {
 		n, err := conn.Read(buff)
 
@@ -241,17 +286,101 @@ func connect(conn net.Conn, stream rpcpb.SliverRPC_SocksProxyClient, frame *sliv
 			return
 		}
 		if n > 0 {
-			frame.Data = buff[:n]
-			frame.Sequence = ToImplantSequence
-			log.Printf("[socks] (User to Client) to Server to agent  Data Sequence %d , Data Size %d \n", ToImplantSequence, len(frame.Data))
-			err := stream.Send(frame)
+			log.Printf("[socks] (User to Client) to Server to agent Data Size %d \n", n)
+			err := tunnel.send(buff[:n], false, false)
 			if err != nil {
 				log.Printf("[socks] (User to Client) failed to send data, %s ", err)
 				return
 			}
-			ToImplantSequence++
-
 		}
 
 	}
 }
+
+// socksAssociation - The local end of a UDP ASSOCIATE, the user's datagrams are
+// encapsulated in the socks tunnel of the association's control connection
+type socksAssociation struct {
+	relay *net.UDPConn
+	user  *net.UDPAddr
+	mutex sync.Mutex
+}
+
+// socksDatagram - An empty datagram from the implant starts an association,
+// anything else is a datagram for the user
+func socksDatagram(conn net.Conn, socksData *sliverpb.SocksData) {
+	if len(socksData.Data) == 0 {
+		associate(conn, socksData.TunnelID)
+		return
+	}
+	value, ok := socksAssociations.Load(socksData.TunnelID)
+	if !ok {
+		return
+	}
+	association := value.(*socksAssociation)
+	association.mutex.Lock()
+	user := association.user
+	association.mutex.Unlock()
+	if user == nil {
+		return
+	}
+	_, err := association.relay.WriteToUDP(socksData.Data, user)
+	if err != nil {
+		log.Printf("[socks] failed to write datagram to %s: %s", user, err)
+	}
+}
+
+func associate(conn net.Conn, tunnelID uint64) {
+	value, ok := socksTunnels.Load(tunnelID)
+	if !ok {
+		return
+	}
+	tunnel := value.(*socksTunnel)
+
+	// The relay listens on the same address the user connected to
+	var bindIP net.IP
+	if localAddr, ok := conn.LocalAddr().(*net.TCPAddr); ok {
+		bindIP = localAddr.IP
+	}
+	relay, err := net.ListenUDP("udp", &net.UDPAddr{IP: bindIP})
+	if err != nil {
+		log.Printf("[socks] failed to start udp relay: %s", err)
+		socks5.SendReply(conn, statute.RepServerFailure, nil)
+		conn.Close()
+		return
+	}
+	association := &socksAssociation{relay: relay}
+	socksAssociations.Store(tunnelID, association)
+	err = socks5.SendReply(conn, statute.RepSuccess, relay.LocalAddr())
+	if err != nil {
+		conn.Close()
+		return
+	}
+	log.Printf("[socks] udp associate %s<--><-->%q\n", relay.LocalAddr(), conn.RemoteAddr())
+
+	go func() {
+		defer relay.Close()
+		var userIP net.IP
+		if remoteAddr, ok := conn.RemoteAddr().(*net.TCPAddr); ok {
+			userIP = remoteAddr.IP
+		}
+		buf := make([]byte, 65535)
+		for {
+			n, user, err := relay.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			// Only the host that requested the association may use it (RFC 1928)
+			if userIP != nil && !userIP.Equal(user.IP) {
+				continue
+			}
+			association.mutex.Lock()
+			association.user = user
+			association.mutex.Unlock()
+			err = tunnel.send(buf[:n], true, false)
+			if err != nil {
+				log.Printf("[socks] (User to Client) failed to send datagram, %s ", err)
+				return
+			}
+		}
+	}()
+}
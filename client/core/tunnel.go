@@ -28,7 +28,7 @@ import (
 
 // TunnelLoop - Parses incoming tunnel messages and distributes them
 //              to session/tunnel objects
-// 				Expected to be called only once during initialization
+// 				Expected to be called once for each server connection
 func TunnelLoop(rpc rpcpb.SliverRPCClient) error {
 	log.Println("Starting tunnel data loop ...")
 	defer log.Printf("Warning: TunnelLoop exited")
@@ -41,7 +41,8 @@ func TunnelLoop(rpc rpcpb.SliverRPCClient) error {
 		return err
 	}
 
-	GetTunnels().SetStream(stream)
+	GetTunnels().SetStream(rpc, stream)
+	defer GetTunnels().RemoveStream(rpc)
 
 	for {
 		log.Printf("Waiting for TunnelData ...")
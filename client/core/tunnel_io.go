@@ -24,6 +24,8 @@ import (
 	"io"
 	"log"
 	"sync"
+
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
 )
 
 // TunnelIO - Duplex data tunnel, compatible with both io.ReadWriter
@@ -36,6 +38,7 @@ type TunnelIO struct {
 
 	isOpen bool
 	mutex  *sync.RWMutex
+	stream rpcpb.SliverRPC_TunnelDataClient // The stream of the server the tunnel is on
 }
 
 // NewTunnelIO - Single entry point for creating instance of new TunnelIO
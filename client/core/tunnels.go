@@ -46,6 +46,7 @@ func GetTunnels() *tunnels {
 			tunnels:     &map[uint64]*TunnelIO{},
 			mutex:       &sync.RWMutex{},
 			streamMutex: &sync.Mutex{},
+			streams:     map[rpcpb.SliverRPCClient]rpcpb.SliverRPC_TunnelDataClient{},
 		}
 	}
 
@@ -58,16 +59,50 @@ type tunnels struct {
 	tunnels     *map[uint64]*TunnelIO
 	mutex       *sync.RWMutex
 	streamMutex *sync.Mutex
-	stream      rpcpb.SliverRPC_TunnelDataClient
+
+	// Each server connection has its own stream, new tunnels
+	// are started on the active server's stream
+	streams map[rpcpb.SliverRPCClient]rpcpb.SliverRPC_TunnelDataClient
+	active  rpcpb.SliverRPCClient
 }
 
-func (t *tunnels) SetStream(stream rpcpb.SliverRPC_TunnelDataClient) {
+// SetStream - Set the tunnel data stream for a server connection
+func (t *tunnels) SetStream(rpc rpcpb.SliverRPCClient, stream rpcpb.SliverRPC_TunnelDataClient) {
 	t.streamMutex.Lock()
 	defer t.streamMutex.Unlock()
 
 	log.Printf("Set stream")
 
-	t.stream = stream
+	t.streams[rpc] = stream
+	if t.active == nil {
+		t.active = rpc
+	}
+}
+
+// UseStream - Start new tunnels on a server connection's stream
+func (t *tunnels) UseStream(rpc rpcpb.SliverRPCClient) {
+	t.streamMutex.Lock()
+	defer t.streamMutex.Unlock()
+
+	t.active = rpc
+}
+
+// RemoveStream - The server connection's stream is gone, as are its tunnels
+func (t *tunnels) RemoveStream(rpc rpcpb.SliverRPCClient) {
+	t.streamMutex.Lock()
+	stream := t.streams[rpc]
+	delete(t.streams, rpc)
+	t.streamMutex.Unlock()
+
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	for tunnelID, tunnel := range *t.tunnels {
+		if tunnel.stream == stream {
+			go func(tunnelID uint64) {
+				GetTunnels().Close(tunnelID)
+			}(tunnelID)
+		}
+	}
 }
 
 // Get - Get a tunnel
@@ -82,17 +117,17 @@ func (t *tunnels) Get(tunnelID uint64) *TunnelIO {
 
 // send - safe way to send a message to the stream
 // protobuf stream allow only one writer at a time, so just in case there is a mutex for it
-func (t *tunnels) send(tunnelData *sliverpb.TunnelData) error {
+func (t *tunnels) send(stream rpcpb.SliverRPC_TunnelDataClient, tunnelData *sliverpb.TunnelData) error {
 	t.streamMutex.Lock()
 	defer t.streamMutex.Unlock()
 
-	if t.stream == nil {
+	if stream == nil {
 		return errors.New("uninitizlied stream")
 	}
 
 	log.Printf("Private send to stream, tunnelId: %d", tunnelData.TunnelID)
 
-	return t.stream.Send(tunnelData)
+	return stream.Send(tunnelData)
 }
 
 // Start - Add a tunnel to the core mapper
@@ -101,6 +136,9 @@ func (t *tunnels) Start(tunnelID uint64, sessionID string) *TunnelIO {
 	defer t.mutex.Unlock()
 
 	tunnel := NewTunnelIO(tunnelID, sessionID)
+	t.streamMutex.Lock()
+	tunnel.stream = t.streams[t.active]
+	t.streamMutex.Unlock()
 
 	(*t.tunnels)[tunnelID] = tunnel
 
@@ -111,7 +149,7 @@ func (t *tunnels) Start(tunnelID uint64, sessionID string) *TunnelIO {
 		for data := range tunnel.Send {
 			log.Printf("Send %d bytes on tunnel %d", len(data), tunnel.ID)
 
-			err := t.send(&sliverpb.TunnelData{
+			err := t.send(tunnel.stream, &sliverpb.TunnelData{
 				TunnelID:  tunnel.ID,
 				SessionID: tunnel.SessionID,
 				Data:      data,
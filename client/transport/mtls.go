@@ -24,11 +24,13 @@ import (
 	"crypto/x509"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"time"
 
 	"github.com/bishopfox/sliver/client/assets"
 	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/util/websocket"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 )
@@ -45,17 +47,22 @@ const (
 )
 
 type TokenAuth struct {
-	token string
+	token        string
+	secondFactor string
 }
 
 // Return value is mapped to request headers.
-func (t TokenAuth) GetRequestMetadata(ctx context.Context, in ...string) (map[string]string, error) {
-	return map[string]string{
+func (t *TokenAuth) GetRequestMetadata(ctx context.Context, in ...string) (map[string]string, error) {
+	metadata := map[string]string{
 		"Authorization": "Bearer " + t.token,
-	}, nil
+	}
+	if t.secondFactor != "" {
+		metadata[secondFactorMetadataKey] = t.secondFactor
+	}
+	return metadata, nil
 }
 
-func (TokenAuth) RequireTransportSecurity() bool {
+func (*TokenAuth) RequireTransportSecurity() bool {
 	return true
 }
 
@@ -66,20 +73,33 @@ func MTLSConnect(config *assets.ClientConfig) (rpcpb.SliverRPCClient, *grpc.Clie
 		return nil, nil, err
 	}
 	transportCreds := credentials.NewTLS(tlsConfig)
-	callCreds := credentials.PerRPCCredentials(TokenAuth{token: config.Token})
+	auth := &TokenAuth{token: config.Token}
+	callCreds := credentials.PerRPCCredentials(auth)
 	options := []grpc.DialOption{
 		grpc.WithTransportCredentials(transportCreds),
 		grpc.WithPerRPCCredentials(callCreds),
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(ClientMaxReceiveMessageSize)),
 	}
+	if config.WebSocketURL != "" {
+		// The mTLS connection is tunneled over the websocket
+		options = append(options, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return websocket.Dial(ctx, config.WebSocketURL)
+		}))
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 	defer cancel()
 	connection, err := grpc.DialContext(ctx, fmt.Sprintf("%s:%d", config.LHost, config.LPort), options...)
 	if err != nil {
 		return nil, nil, err
 	}
-	return rpcpb.NewSliverRPCClient(connection), connection, nil
+	rpc := rpcpb.NewSliverRPCClient(connection)
+	err = verifySecondFactor(rpc, auth)
+	if err != nil {
+		connection.Close()
+		return nil, nil, err
+	}
+	return rpc, connection, nil
 }
 
 func getTLSConfig(caCertificate string, certificate string, privateKey string) (*tls.Config, error) {
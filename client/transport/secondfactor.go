@@ -0,0 +1,193 @@
+package transport
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	FIDO2 assertions and credentials are made with libfido2's command line tools
+	(fido2-token, fido2-assert, fido2-cred) so we don't need cgo or hid drivers:
+	https://developers.yubico.com/libfido2/Manuals/
+
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// FIDO2DeviceEnv - Set to use a specific FIDO2 device instead of the first one
+	FIDO2DeviceEnv = "SLIVER_FIDO2_DEVICE"
+
+	// FIDO2RelyingParty - Must match the server's relying party id
+	FIDO2RelyingParty = "sliver"
+
+	secondFactorMetadataKey = "second-factor"
+)
+
+// verifySecondFactor - Verify the operator's second factor if the server requires one
+func verifySecondFactor(rpc rpcpb.SliverRPCClient, auth *TokenAuth) error {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	challenge, err := rpc.GetSecondFactorChallenge(ctx, &commonpb.Empty{})
+	cancel()
+	if status.Code(err) == codes.Unimplemented {
+		return nil // Older servers don't support second factors
+	}
+	if err != nil {
+		return err
+	}
+	req := &clientpb.SecondFactorReq{}
+	switch challenge.Type {
+	case "":
+		return nil
+	case "totp":
+		prompt := &survey.Password{Message: "TOTP code:"}
+		err = survey.AskOne(prompt, &req.Code)
+	case "fido2":
+		fmt.Println("Touch your security key ...")
+		req.Challenge = challenge.Challenge
+		req.AuthenticatorData, req.Signature, err = fido2Assert(challenge)
+	default:
+		return fmt.Errorf("unsupported second factor '%s'", challenge.Type)
+	}
+	if err != nil {
+		return err
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+	secondFactor, err := rpc.VerifySecondFactor(ctx, req)
+	if err != nil {
+		return err
+	}
+	auth.secondFactor = secondFactor.Token
+	return nil
+}
+
+// fido2Assert - Sign the challenge with the enrolled credential, returns the
+// authenticator data and signature
+func fido2Assert(challenge *clientpb.SecondFactorChallenge) ([]byte, []byte, error) {
+	device, err := fido2Device()
+	if err != nil {
+		return nil, nil, err
+	}
+	input := strings.Join([]string{
+		base64.StdEncoding.EncodeToString(challenge.Challenge),
+		challenge.RelyingParty,
+		base64.StdEncoding.EncodeToString(challenge.CredentialID),
+	}, "\n") + "\n"
+	output, err := fido2Tool("fido2-assert", input, "-G", "-t", "up=true", device)
+	if err != nil {
+		return nil, nil, err
+	}
+	// client data hash, relying party id, authenticator data, signature
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) < 4 {
+		return nil, nil, errors.New("unexpected fido2-assert output")
+	}
+	authData, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[2]))
+	if err != nil {
+		return nil, nil, err
+	}
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[3]))
+	if err != nil {
+		return nil, nil, err
+	}
+	return authData, signature, nil
+}
+
+// FIDO2Enroll - Create a new credential on the security key for an operator,
+// returns the credential the server admin needs to enroll the operator
+func FIDO2Enroll(operatorName string) ([]byte, error) {
+	device, err := fido2Device()
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := make([]byte, 32)
+	userID := make([]byte, 32)
+	if _, err := rand.Read(clientDataHash); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(userID); err != nil {
+		return nil, err
+	}
+	input := strings.Join([]string{
+		base64.StdEncoding.EncodeToString(clientDataHash),
+		FIDO2RelyingParty,
+		operatorName,
+		base64.StdEncoding.EncodeToString(userID),
+	}, "\n") + "\n"
+	attestation, err := fido2Tool("fido2-cred", input, "-M", device)
+	if err != nil {
+		return nil, err
+	}
+	// Verifying the attestation outputs the credential id and public key
+	credential, err := fido2Tool("fido2-cred", attestation, "-V")
+	if err != nil {
+		return nil, err
+	}
+	return []byte(credential), nil
+}
+
+// fido2Device - The device from the environment, or the first device found
+func fido2Device() (string, error) {
+	if device := os.Getenv(FIDO2DeviceEnv); device != "" {
+		return device, nil
+	}
+	output, err := fido2Tool("fido2-token", "", "-L")
+	if err != nil {
+		return "", err
+	}
+	// Each line is '<device path>: vendor=..., product=... (...)'
+	for _, line := range strings.Split(output, "\n") {
+		if index := strings.Index(line, ": "); 0 < index {
+			return line[:index], nil
+		}
+	}
+	return "", fmt.Errorf("no fido2 devices found, set %s to select one", FIDO2DeviceEnv)
+}
+
+func fido2Tool(name string, input string, args ...string) (string, error) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return "", fmt.Errorf("%s not found, install libfido2's tools (%s)", name, err)
+	}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command(path, args...)
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err = cmd.Run()
+	if err != nil {
+		return "", fmt.Errorf("%s failed: %s %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	return stdout.String(), nil
+}
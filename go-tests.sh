@@ -156,3 +156,11 @@ else
     cat ~/.sliver/logs/sliver.log
     exit 1
 fi
+
+# server / e2e
+if SLIVER_E2E=1 go test -tags=server,$TAGS ./server/e2e -timeout 2h ; then
+    :
+else
+    cat ~/.sliver/logs/sliver.log
+    exit 1
+fi
@@ -0,0 +1,111 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	certfnshPath = "/certsrv/certfnsh.asp"
+	certnewPath  = "/certsrv/certnew.cer"
+)
+
+var (
+	issuedRequestID  = regexp.MustCompile(`certnew\.cer\?ReqID=(\d+)`)
+	pendingRequestID = regexp.MustCompile(`(?i)Your Request Id is (\d+)`)
+	dispositionMsg   = regexp.MustCompile(`(?is)The disposition message is "([^"]*)"`)
+
+	caAttributes = []string{
+		"cn", "name", "dNSHostName", "certificateTemplates", "cACertificateDN", "flags",
+	}
+	templateAttributes = []string{
+		"cn", "name", "displayName", "flags", "pKIExtendedKeyUsage", "msPKI-Certificate-Application-Policy",
+		"msPKI-Certificate-Name-Flag", "msPKI-Enrollment-Flag", "msPKI-RA-Signature",
+		"msPKI-Template-Schema-Version", "nTSecurityDescriptor",
+	}
+)
+
+// attributeValue - The first value of an entry's attribute
+func attributeValue(entry *sliverpb.LDAPEntry, name string) []byte {
+	for _, attr := range entry.Attributes {
+		if strings.EqualFold(attr.Name, name) && 0 < len(attr.Values) {
+			return attr.Values[0]
+		}
+	}
+	return nil
+}
+
+// requestForm - The certfnsh.asp form of a certificate request, attributes
+// are appended to the template on their own lines
+func requestForm(csr []byte, template string, attributes string) url.Values {
+	certAttrib := "CertificateTemplate:" + template
+	if attributes != "" {
+		certAttrib += "\n" + attributes
+	}
+	return url.Values{
+		"Mode":             {"newreq"},
+		"CertRequest":      {string(csr)},
+		"CertAttrib":       {certAttrib},
+		"TargetStoreFlags": {"0"},
+		"SaveCert":         {"yes"},
+		"ThumbPrint":       {""},
+	}
+}
+
+// parseCertfnsh - Parse the request id, whether the certificate was issued, and
+// the disposition message from a certfnsh.asp response
+func parseCertfnsh(body string) (int64, bool, string) {
+	if match := issuedRequestID.FindStringSubmatch(body); match != nil {
+		requestID, _ := strconv.ParseInt(match[1], 10, 64)
+		return requestID, true, "issued"
+	}
+	disposition := "unexpected response from certfnsh.asp"
+	if match := dispositionMsg.FindStringSubmatch(body); match != nil {
+		disposition = strings.Join(strings.Fields(match[1]), " ")
+	}
+	if match := pendingRequestID.FindStringSubmatch(body); match != nil {
+		requestID, _ := strconv.ParseInt(match[1], 10, 64)
+		if dispositionMsg.FindStringSubmatch(body) == nil {
+			disposition = "pending"
+		}
+		return requestID, false, disposition
+	}
+	return 0, false, disposition
+}
+
+// acceptsWindowsAuth - Web enrollment can be relayed to if it offers ntlm or negotiate
+func acceptsWindowsAuth(statusCode int, header http.Header) bool {
+	if statusCode != http.StatusUnauthorized {
+		return false
+	}
+	for _, value := range header.Values("WWW-Authenticate") {
+		fields := strings.Fields(value)
+		if 0 < len(fields) && (strings.EqualFold(fields[0], "ntlm") || strings.EqualFold(fields[0], "negotiate")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseCertfnsh(t *testing.T) {
+	issued := `<Script Language="JavaScript">
+	function handleGetCert() {
+		location="certnew.cer?ReqID=42&amp;"+getEncoding();
+	}`
+	requestID, ok, disposition := parseCertfnsh(issued)
+	if requestID != 42 || !ok || disposition != "issued" {
+		t.Fatalf("unexpected result for an issued request %d %v %s", requestID, ok, disposition)
+	}
+
+	pending := `<P>Your certificate request has been received. However, you must wait for an administrator to issue the certificate you requested.</P>
+	<P>Your Request Id is 43.</P>`
+	requestID, ok, disposition = parseCertfnsh(pending)
+	if requestID != 43 || ok || disposition != "pending" {
+		t.Fatalf("unexpected result for a pending request %d %v %s", requestID, ok, disposition)
+	}
+
+	denied := `<P>Your certificate request was denied.</P>
+	<P>Your Request Id is 44. The disposition message is "Denied by Policy Module  0x80094012,
+	The permissions on the certificate template do not allow the current user to enroll for this type of certificate. ".</P>`
+	requestID, ok, disposition = parseCertfnsh(denied)
+	if requestID != 44 || ok || disposition != "Denied by Policy Module 0x80094012, The permissions on the certificate template do not allow the current user to enroll for this type of certificate." {
+		t.Fatalf("unexpected result for a denied request %d %v %s", requestID, ok, disposition)
+	}
+
+	if _, ok, _ = parseCertfnsh("<html></html>"); ok {
+		t.Fatal("unexpected response parsed as issued")
+	}
+}
+
+func TestAcceptsWindowsAuth(t *testing.T) {
+	header := http.Header{}
+	header.Add("WWW-Authenticate", "Negotiate")
+	header.Add("WWW-Authenticate", "NTLM")
+	if !acceptsWindowsAuth(http.StatusUnauthorized, header) {
+		t.Fatal("negotiate was not accepted")
+	}
+	if acceptsWindowsAuth(http.StatusOK, header) {
+		t.Fatal("unauthenticated endpoint accepted")
+	}
+	basic := http.Header{}
+	basic.Add("WWW-Authenticate", `Basic realm="ca"`)
+	basic.Add("WWW-Authenticate", "")
+	if acceptsWindowsAuth(http.StatusUnauthorized, basic) {
+		t.Fatal("basic auth accepted")
+	}
+}
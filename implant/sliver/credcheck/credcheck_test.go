@@ -0,0 +1,167 @@
+package credcheck
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestNTLMv2(t *testing.T) {
+	// [MS-NLMP] 4.2.4 NTLMv2 Authentication
+	targetInfo := []byte{2, 0, 12, 0}
+	targetInfo = append(targetInfo, utf16le("Domain")...)
+	targetInfo = append(targetInfo, 1, 0, 12, 0)
+	targetInfo = append(targetInfo, utf16le("Server")...)
+	targetInfo = append(targetInfo, 0, 0, 0, 0)
+	serverChallenge, _ := hex.DecodeString("0123456789abcdef")
+	clientChallenge, _ := hex.DecodeString("aaaaaaaaaaaaaaaa")
+	challenge := &ntlmChallenge{ServerChallenge: serverChallenge, TargetInfo: targetInfo}
+
+	ntResponse, lmResponse := ntlmv2Responses(&account{Name: "User", Domain: "Domain"}, "Password", challenge, clientChallenge, make([]byte, 8))
+	if proof := hex.EncodeToString(ntResponse[:16]); proof != "68cd0ab851e51c96aabc927bebef6a1c" {
+		t.Fatalf("wrong NTProofStr %s", proof)
+	}
+	if lm := hex.EncodeToString(lmResponse); lm != "86c35097ac9cec102554764a57cccc19aaaaaaaaaaaaaaaa" {
+		t.Fatalf("wrong LMv2 response %s", lm)
+	}
+}
+
+func TestParseNTLMChallenge(t *testing.T) {
+	msg := make([]byte, 48)
+	copy(msg, ntlmSignature)
+	msg[8] = 2
+	copy(msg[24:], "chalenge")
+	info := []byte{7, 0, 8, 0, 1, 2, 3, 4, 5, 6, 7, 8, 0, 0, 0, 0}
+	msg[40] = byte(len(info))
+	msg[44] = 48
+	msg = append(msg, info...)
+
+	// Embedded in a SPNEGO NegTokenResp
+	challenge, err := parseNTLMChallenge(spnegoResponse(msg))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(challenge.ServerChallenge) != "chalenge" {
+		t.Fatalf("wrong server challenge %q", challenge.ServerChallenge)
+	}
+	if !bytes.Equal(challenge.timestamp(), info[4:12]) {
+		t.Fatalf("server timestamp not used %x", challenge.timestamp())
+	}
+	if _, err = parseNTLMChallenge(msg[:40]); err != ErrInvalidChallenge {
+		t.Fatalf("truncated challenge parsed %v", err)
+	}
+}
+
+func TestParseBindResponse(t *testing.T) {
+	// Active Directory uses 4 byte lengths
+	diagnostic := "80090308: LdapErr: DSID-0C09044E, comment: AcceptSecurityContext error, data 775, v4563"
+	op := append([]byte{0x0a, 1, 49, 0x04, 0, 0x04, byte(len(diagnostic))}, diagnostic...)
+	resp := append([]byte{0x61, 0x84, 0, 0, 0, byte(len(op))}, op...)
+	resp = append([]byte{0x02, 1, 1}, resp...)
+	resp = append([]byte{0x30, 0x84, 0, 0, 0, byte(len(resp))}, resp...)
+
+	read, err := readBER(bytes.NewReader(resp))
+	if err != nil || !bytes.Equal(read, resp) {
+		t.Fatalf("failed to read response %v", err)
+	}
+	code, message, err := parseBindResponse(resp)
+	if err != nil || code != ldapInvalidCredentials || message != diagnostic {
+		t.Fatalf("unexpected bind response %d %q %v", code, message, err)
+	}
+	if status, _ := ldapStatus(code, message); status != Locked {
+		t.Fatalf("expected locked status, got %s", status)
+	}
+	if status, _ := ldapStatus(ldapInvalidCredentials, "AcceptSecurityContext error, data 52e, v4563"); status != Invalid {
+		t.Fatalf("expected invalid status, got %s", status)
+	}
+	if status, _ := ldapStatus(ldapSuccess, ""); status != Valid {
+		t.Fatalf("expected valid status, got %s", status)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	sleep = func(time.Duration) {}
+	attempts := map[string]int{}
+	checkers["test"] = func(_ string, user *account, password string) (string, error) {
+		attempts[user.Name]++
+		switch {
+		case user.Name == "locked":
+			return Locked, nil
+		case password == "right":
+			return Valid, nil
+		}
+		return Invalid, nil
+	}
+	defer delete(checkers, "test")
+
+	resp, err := Validate(&sliverpb.ValidateCredsReq{
+		Target:    "dc",
+		Protocols: []string{"test"},
+		Credentials: []*sliverpb.CredentialAttempt{
+			{User: "alice", Password: "wrong"},
+			{User: "alice", Password: "wrong"},
+			{User: "alice", Password: "right"},
+			{User: `CORP\bob`, Password: "wrong"},
+			{User: `corp\BOB`, Password: "right"},
+			{User: "locked", Password: "right"},
+			{User: "locked", Password: "right"},
+		},
+		LockoutThreshold: 3,
+		Failures:         map[string]uint32{`CORP\bob`: 1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	statuses := []string{}
+	for _, result := range resp.Results {
+		statuses = append(statuses, result.Status)
+	}
+	expected := []string{Invalid, Invalid, Skipped, Invalid, Skipped, Locked, Skipped}
+	for index := range expected {
+		if len(statuses) != len(expected) || statuses[index] != expected[index] {
+			t.Fatalf("expected %v got %v", expected, statuses)
+		}
+	}
+	if attempts["locked"] != 1 || resp.Aborted != "" {
+		t.Fatalf("locked account retried or run aborted %d %q", attempts["locked"], resp.Aborted)
+	}
+
+	// Locking out an account we've failed to login as aborts the run
+	resp, _ = Validate(&sliverpb.ValidateCredsReq{
+		Target:    "dc",
+		Protocols: []string{"test"},
+		Credentials: []*sliverpb.CredentialAttempt{
+			{User: "locked", Password: "right"},
+			{User: "alice", Password: "right"},
+		},
+		Failures: map[string]uint32{"locked": 2},
+	})
+	if resp.Aborted == "" || len(resp.Results) != 1 {
+		t.Fatalf("run wasn't aborted %v", resp.Results)
+	}
+
+	if _, err = Validate(&sliverpb.ValidateCredsReq{Target: "dc", Protocols: []string{"telnet"}}); err == nil {
+		t.Fatal("unknown protocol accepted")
+	}
+}
@@ -0,0 +1,90 @@
+package credcheck
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/jcmturner/gokrb5.v7/client"
+	"gopkg.in/jcmturner/gokrb5.v7/config"
+	"gopkg.in/jcmturner/gokrb5.v7/iana/errorcode"
+)
+
+// krb5.conf for a single realm, udp_preference_limit = 1 makes gokrb5 use tcp
+const krb5Conf = `[libdefaults]
+  default_realm = %[1]s
+  dns_lookup_kdc = false
+  dns_lookup_realm = false
+  udp_preference_limit = 1
+[realms]
+  %[1]s = {
+    kdc = %[2]s:88
+  }
+`
+
+var (
+	// gokrb5 wraps the KDC's errors in strings, i.e. "KRB Error: (24) KDC_ERR_PREAUTH_FAILED ..."
+	krbErrorCode = regexp.MustCompile(`KRB Error: \((\d+)\)`)
+
+	// ErrNoRealm - Kerberos needs the user's realm
+	ErrNoRealm = errors.New("no domain for kerberos")
+)
+
+// kerberosStatus - The credential status of a failed AS exchange
+func kerberosStatus(err error) (string, error) {
+	match := krbErrorCode.FindStringSubmatch(err.Error())
+	if match == nil {
+		return Error, err
+	}
+	code, _ := strconv.Atoi(match[1])
+	switch int32(code) {
+	case errorcode.KDC_ERR_PREAUTH_FAILED, errorcode.KDC_ERR_C_PRINCIPAL_UNKNOWN:
+		return Invalid, nil
+	case errorcode.KDC_ERR_CLIENT_REVOKED:
+		return Locked, errors.New("credentials revoked, the account is locked out or disabled")
+	case errorcode.KDC_ERR_KEY_EXPIRED:
+		return Expired, nil
+	case errorcode.KDC_ERR_POLICY:
+		return Restricted, nil
+	}
+	return Error, err
+}
+
+// checkKerberos - Request a TGT with pre-authentication, the target is the KDC
+func checkKerberos(target string, user *account, password string) (string, error) {
+	if user.Domain == "" {
+		return Error, ErrNoRealm
+	}
+	realm := strings.ToUpper(user.Domain)
+	conf, err := config.NewConfigFromString(fmt.Sprintf(krb5Conf, realm, target))
+	if err != nil {
+		return Error, err
+	}
+	krbClient := client.NewClientWithPassword(user.Name, realm, password, conf, client.DisablePAFXFAST(true))
+	defer krbClient.Destroy()
+	err = krbClient.Login()
+	if err != nil {
+		return kerberosStatus(err)
+	}
+	return Valid, nil
+}
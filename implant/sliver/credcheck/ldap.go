@@ -0,0 +1,230 @@
+package credcheck
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/tls"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"time"
+)
+
+const (
+	ldapPort                 = "389"
+	ldapsPort                = "636"
+	ldapBindResponse         = 1
+	ldapSuccess              = 0
+	ldapInvalidCredentials   = 49
+	ldapMaxResponseSize      = 64 * 1024
+	ldapProtocolVersion      = 3
+	ldapSimpleAuthentication = 0
+)
+
+var (
+	// Active Directory puts the reason a bind failed in the diagnostic message
+	// i.e. "80090308: LdapErr: DSID-0C09044E, comment: AcceptSecurityContext error, data 52e, v4563"
+	adBindError = regexp.MustCompile(`(?i)\bdata ([0-9a-f]+)`)
+
+	// ErrEmptyPassword - An LDAP bind without a password is an anonymous bind
+	ErrEmptyPassword = errors.New("empty password")
+	// ErrInvalidLDAPResponse - Not a bind response
+	ErrInvalidLDAPResponse = errors.New("invalid ldap response")
+)
+
+// bindName - AD accepts user principal names and down-level logon names
+func (a *account) bindName() string {
+	if a.Domain == "" {
+		return a.Name
+	}
+	return a.Name + "@" + a.Domain
+}
+
+// ldapBindRequest - An LDAPMessage with a simple BindRequest [RFC 4511]
+func ldapBindRequest(messageID int, name string, password string) []byte {
+	id, _ := asn1.Marshal(messageID)
+	version, _ := asn1.Marshal(ldapProtocolVersion)
+	return asn1Wrap(asn1.ClassUniversal, asn1.TagSequence, id, asn1Wrap(asn1.ClassApplication, 0,
+		version,
+		asn1Wrap(asn1.ClassUniversal, asn1.TagOctetString, []byte(name)),
+		rawPrimitive(asn1.ClassContextSpecific, ldapSimpleAuthentication, []byte(password)),
+	))
+}
+
+func rawPrimitive(class int, tag int, content []byte) []byte {
+	data, _ := asn1.Marshal(asn1.RawValue{Class: class, Tag: tag, Bytes: content})
+	return data
+}
+
+// berElement - Split the first BER element off the data, Active Directory uses
+// non-minimal lengths so we can't use encoding/asn1 to parse responses
+func berElement(data []byte) (byte, []byte, []byte, error) {
+	if len(data) < 2 {
+		return 0, nil, nil, ErrInvalidLDAPResponse
+	}
+	identifier := data[0]
+	length := int(data[1])
+	data = data[2:]
+	if length&0x80 != 0 {
+		size := length & 0x7f
+		if 4 < size || len(data) < size {
+			return 0, nil, nil, ErrInvalidLDAPResponse
+		}
+		length = 0
+		for _, b := range data[:size] {
+			length = length<<8 | int(b)
+		}
+		data = data[size:]
+	}
+	if length < 0 || len(data) < length {
+		return 0, nil, nil, ErrInvalidLDAPResponse
+	}
+	return identifier, data[:length], data[length:], nil
+}
+
+// parseBindResponse - Returns the result code and diagnostic message
+func parseBindResponse(data []byte) (int, string, error) {
+	identifier, message, _, err := berElement(data)
+	if err != nil || identifier != 0x30 {
+		return 0, "", ErrInvalidLDAPResponse
+	}
+	_, _, rest, err := berElement(message) // Message ID
+	if err != nil {
+		return 0, "", err
+	}
+	identifier, op, _, err := berElement(rest)
+	if err != nil || identifier != 0x60|ldapBindResponse {
+		return 0, "", ErrInvalidLDAPResponse
+	}
+	identifier, resultCode, rest, err := berElement(op)
+	if err != nil || identifier != asn1.TagEnum || len(resultCode) == 0 {
+		return 0, "", ErrInvalidLDAPResponse
+	}
+	code := 0
+	for _, b := range resultCode {
+		code = code<<8 | int(b)
+	}
+	_, _, rest, err = berElement(rest) // Matched DN
+	if err != nil {
+		return code, "", nil
+	}
+	_, diagnostic, _, err := berElement(rest)
+	if err != nil {
+		return code, "", nil
+	}
+	return code, string(diagnostic), nil
+}
+
+// ldapStatus - The credential status of a bind result
+func ldapStatus(code int, diagnostic string) (string, error) {
+	switch code {
+	case ldapSuccess:
+		return Valid, nil
+	case ldapInvalidCredentials:
+		match := adBindError.FindStringSubmatch(diagnostic)
+		if match == nil {
+			return Invalid, nil
+		}
+		switch match[1] {
+		case "775":
+			return Locked, nil
+		case "533", "701":
+			return Disabled, nil
+		case "532", "773":
+			return Expired, nil
+		case "530", "531":
+			return Restricted, nil
+		}
+		return Invalid, nil
+	}
+	return Error, fmt.Errorf("ldap result %d %s", code, diagnostic)
+}
+
+func checkLDAP(target string, user *account, password string) (string, error) {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(target, ldapPort), dialTimeout)
+	if err != nil {
+		return Error, err
+	}
+	return ldapBind(conn, user, password)
+}
+
+func checkLDAPS(target string, user *account, password string) (string, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(target, ldapsPort), &tls.Config{
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return Error, err
+	}
+	return ldapBind(conn, user, password)
+}
+
+func ldapBind(conn net.Conn, user *account, password string) (string, error) {
+	defer conn.Close()
+	if password == "" {
+		return Error, ErrEmptyPassword
+	}
+	conn.SetDeadline(time.Now().Add(dialTimeout))
+	if _, err := conn.Write(ldapBindRequest(1, user.bindName(), password)); err != nil {
+		return Error, err
+	}
+	resp, err := readBER(conn)
+	if err != nil {
+		return Error, err
+	}
+	code, diagnostic, err := parseBindResponse(resp)
+	if err != nil {
+		return Error, err
+	}
+	return ldapStatus(code, diagnostic)
+}
+
+// readBER - Read one BER encoded element
+func readBER(reader io.Reader) ([]byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return nil, err
+	}
+	length := int(header[1])
+	if header[1]&0x80 != 0 {
+		lengthBytes := make([]byte, header[1]&0x7f)
+		if 4 < len(lengthBytes) {
+			return nil, ErrInvalidLDAPResponse
+		}
+		if _, err := io.ReadFull(reader, lengthBytes); err != nil {
+			return nil, err
+		}
+		header = append(header, lengthBytes...)
+		length = 0
+		for _, b := range lengthBytes {
+			length = length<<8 | int(b)
+		}
+	}
+	if length < 0 || ldapMaxResponseSize < length {
+		return nil, ErrInvalidLDAPResponse
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return nil, err
+	}
+	return append(header, data...), nil
+}
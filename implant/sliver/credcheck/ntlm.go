@@ -0,0 +1,206 @@
+package credcheck
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/asn1"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"golang.org/x/crypto/md4"
+)
+
+// NTLM messages are described in [MS-NLMP], we only need enough of it to
+// authenticate once with NTLMv2
+const (
+	ntlmNegotiateUnicode      = 0x00000001
+	ntlmRequestTarget         = 0x00000004
+	ntlmNegotiateNTLM         = 0x00000200
+	ntlmNegotiateAlwaysSign   = 0x00008000
+	ntlmNegotiateExtendedSec  = 0x00080000
+	ntlmNegotiateTargetInfo   = 0x00800000
+	ntlmNegotiate128          = 0x20000000
+	ntlmNegotiate56           = 0x80000000
+	ntlmNegotiateFlags        = ntlmNegotiateUnicode | ntlmRequestTarget | ntlmNegotiateNTLM | ntlmNegotiateAlwaysSign | ntlmNegotiateExtendedSec | ntlmNegotiateTargetInfo | ntlmNegotiate128 | ntlmNegotiate56
+	ntlmAvEOL                 = 0
+	ntlmAvTimestamp           = 7
+	ntlmChallengeMinSize      = 48
+	ntlmAuthenticateFixedSize = 64
+)
+
+var (
+	ntlmSignature = []byte("NTLMSSP\x00")
+	spnegoOID     = asn1.ObjectIdentifier{1, 3, 6, 1, 5, 5, 2}
+	ntlmsspOID    = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 2, 2, 10}
+
+	// ErrInvalidChallenge - The server's challenge message could not be parsed
+	ErrInvalidChallenge = errors.New("invalid ntlm challenge")
+)
+
+type ntlmChallenge struct {
+	Flags           uint32
+	ServerChallenge []byte
+	TargetInfo      []byte
+}
+
+func ntlmNegotiateMessage() []byte {
+	msg := make([]byte, 32)
+	copy(msg, ntlmSignature)
+	binary.LittleEndian.PutUint32(msg[8:], 1)
+	binary.LittleEndian.PutUint32(msg[12:], ntlmNegotiateFlags)
+	return msg
+}
+
+// parseNTLMChallenge - Parse a challenge message, the message may be embedded in
+// a larger security blob (i.e. a SPNEGO token)
+func parseNTLMChallenge(blob []byte) (*ntlmChallenge, error) {
+	index := bytes.Index(blob, ntlmSignature)
+	if index < 0 {
+		return nil, ErrInvalidChallenge
+	}
+	msg := blob[index:]
+	if len(msg) < ntlmChallengeMinSize || binary.LittleEndian.Uint32(msg[8:]) != 2 {
+		return nil, ErrInvalidChallenge
+	}
+	challenge := &ntlmChallenge{
+		Flags:           binary.LittleEndian.Uint32(msg[20:]),
+		ServerChallenge: msg[24:32],
+	}
+	infoLen := int(binary.LittleEndian.Uint16(msg[40:]))
+	infoOffset := int(binary.LittleEndian.Uint32(msg[44:]))
+	if len(msg) < infoOffset+infoLen {
+		return nil, ErrInvalidChallenge
+	}
+	challenge.TargetInfo = msg[infoOffset : infoOffset+infoLen]
+	return challenge, nil
+}
+
+// timestamp - The server's MsvAvTimestamp, or the current time as a FILETIME
+func (c *ntlmChallenge) timestamp() []byte {
+	info := c.TargetInfo
+	for 4 <= len(info) {
+		avID := binary.LittleEndian.Uint16(info)
+		avLen := int(binary.LittleEndian.Uint16(info[2:]))
+		if avID == ntlmAvEOL || len(info) < 4+avLen {
+			break
+		}
+		if avID == ntlmAvTimestamp && avLen == 8 {
+			return info[4:12]
+		}
+		info = info[4+avLen:]
+	}
+	filetime := make([]byte, 8)
+	binary.LittleEndian.PutUint64(filetime, uint64(time.Now().UnixNano()/100+116444736000000000))
+	return filetime
+}
+
+func utf16le(value string) []byte {
+	encoded := utf16.Encode([]rune(value))
+	buf := make([]byte, 2*len(encoded))
+	for index, char := range encoded {
+		binary.LittleEndian.PutUint16(buf[2*index:], char)
+	}
+	return buf
+}
+
+func hmacMD5(key []byte, data ...[]byte) []byte {
+	mac := hmac.New(md5.New, key)
+	for _, chunk := range data {
+		mac.Write(chunk)
+	}
+	return mac.Sum(nil)
+}
+
+func ntHash(password string) []byte {
+	hash := md4.New()
+	hash.Write(utf16le(password))
+	return hash.Sum(nil)
+}
+
+// ntlmv2Responses - The NT and LM challenge responses for the given client challenge
+func ntlmv2Responses(user *account, password string, challenge *ntlmChallenge, clientChallenge []byte, timestamp []byte) ([]byte, []byte) {
+	responseKey := hmacMD5(ntHash(password), utf16le(strings.ToUpper(user.Name)+user.Domain))
+	temp := []byte{1, 1, 0, 0, 0, 0, 0, 0}
+	temp = append(temp, timestamp...)
+	temp = append(temp, clientChallenge...)
+	temp = append(temp, 0, 0, 0, 0)
+	temp = append(temp, challenge.TargetInfo...)
+	temp = append(temp, 0, 0, 0, 0)
+	ntProof := hmacMD5(responseKey, challenge.ServerChallenge, temp)
+	lmResponse := append(hmacMD5(responseKey, challenge.ServerChallenge, clientChallenge), clientChallenge...)
+	return append(ntProof, temp...), lmResponse
+}
+
+// ntlmAuthenticateMessage - Answer the server's challenge
+func ntlmAuthenticateMessage(user *account, password string, challenge *ntlmChallenge) ([]byte, error) {
+	clientChallenge := make([]byte, 8)
+	if _, err := rand.Read(clientChallenge); err != nil {
+		return nil, err
+	}
+	ntResponse, lmResponse := ntlmv2Responses(user, password, challenge, clientChallenge, challenge.timestamp())
+
+	msg := make([]byte, ntlmAuthenticateFixedSize)
+	copy(msg, ntlmSignature)
+	binary.LittleEndian.PutUint32(msg[8:], 3)
+	fields := [][]byte{lmResponse, ntResponse, utf16le(user.Domain), utf16le(user.Name), {}, {}}
+	for index, field := range fields {
+		header := msg[12+8*index:]
+		binary.LittleEndian.PutUint16(header, uint16(len(field)))
+		binary.LittleEndian.PutUint16(header[2:], uint16(len(field)))
+		binary.LittleEndian.PutUint32(header[4:], uint32(len(msg)))
+		msg = append(msg, field...)
+	}
+	binary.LittleEndian.PutUint32(msg[60:], challenge.Flags&ntlmNegotiateFlags)
+	return msg, nil
+}
+
+func asn1Wrap(class int, tag int, content ...[]byte) []byte {
+	data, _ := asn1.Marshal(asn1.RawValue{
+		Class:      class,
+		Tag:        tag,
+		IsCompound: class != asn1.ClassUniversal || tag == asn1.TagSequence,
+		Bytes:      bytes.Join(content, nil),
+	})
+	return data
+}
+
+// spnegoInit - Wrap the negotiate message in a SPNEGO NegTokenInit [RFC 4178]
+func spnegoInit(token []byte) []byte {
+	spnego, _ := asn1.Marshal(spnegoOID)
+	ntlmssp, _ := asn1.Marshal(ntlmsspOID)
+	negTokenInit := asn1Wrap(asn1.ClassUniversal, asn1.TagSequence,
+		asn1Wrap(asn1.ClassContextSpecific, 0, asn1Wrap(asn1.ClassUniversal, asn1.TagSequence, ntlmssp)),
+		asn1Wrap(asn1.ClassContextSpecific, 2, asn1Wrap(asn1.ClassUniversal, asn1.TagOctetString, token)),
+	)
+	return asn1Wrap(asn1.ClassApplication, 0, spnego, asn1Wrap(asn1.ClassContextSpecific, 0, negTokenInit))
+}
+
+// spnegoResponse - Wrap the authenticate message in a SPNEGO NegTokenResp
+func spnegoResponse(token []byte) []byte {
+	return asn1Wrap(asn1.ClassContextSpecific, 1, asn1Wrap(asn1.ClassUniversal, asn1.TagSequence,
+		asn1Wrap(asn1.ClassContextSpecific, 2, asn1Wrap(asn1.ClassUniversal, asn1.TagOctetString, token)),
+	))
+}
@@ -0,0 +1,208 @@
+package credcheck
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// Just enough SMB2 [MS-SMB2] to negotiate a dialect and set up a session
+const (
+	smb2HeaderSize        = 64
+	smb2Negotiate         = 0
+	smb2SessionSetup      = 1
+	smb2SigningEnabled    = 1
+	smb2SessionGuest      = 1
+	smb2SessionNull       = 2
+	smbPort               = "445"
+	statusSuccess         = 0x00000000
+	statusMoreProcessing  = 0xC0000016
+	statusLogonFailure    = 0xC000006D
+	statusAccountRestrict = 0xC000006E
+	statusLogonHours      = 0xC000006F
+	statusWorkstation     = 0xC0000070
+	statusPasswordExpired = 0xC0000071
+	statusAccountDisabled = 0xC0000072
+	statusAccountExpired  = 0xC0000193
+	statusMustChange      = 0xC0000224
+	statusLockedOut       = 0xC0000234
+)
+
+var (
+	smb2Dialects = []uint16{0x0202, 0x0210, 0x0300, 0x0302}
+
+	// ErrInvalidSMBResponse - The server didn't respond with SMB2
+	ErrInvalidSMBResponse = errors.New("invalid smb2 response")
+)
+
+// ntStatus - The credential status of an NTSTATUS from the session setup
+func ntStatus(status uint32) (string, error) {
+	switch status {
+	case statusSuccess:
+		return Valid, nil
+	case statusLogonFailure:
+		return Invalid, nil
+	case statusLockedOut:
+		return Locked, nil
+	case statusAccountDisabled, statusAccountExpired:
+		return Disabled, nil
+	case statusPasswordExpired, statusMustChange:
+		return Expired, nil
+	case statusAccountRestrict, statusLogonHours, statusWorkstation:
+		return Restricted, nil
+	}
+	return Error, fmt.Errorf("ntstatus 0x%08x", status)
+}
+
+type smbConn struct {
+	conn      net.Conn
+	messageID uint64
+	sessionID uint64
+}
+
+func (s *smbConn) send(command uint16, body []byte) error {
+	packet := make([]byte, 4+smb2HeaderSize, 4+smb2HeaderSize+len(body))
+	binary.BigEndian.PutUint32(packet, uint32(smb2HeaderSize+len(body))) // Direct TCP transport header
+	header := packet[4:]
+	copy(header, "\xfeSMB")
+	binary.LittleEndian.PutUint16(header[4:], smb2HeaderSize)
+	binary.LittleEndian.PutUint16(header[6:], 1) // Credit charge
+	binary.LittleEndian.PutUint16(header[12:], command)
+	binary.LittleEndian.PutUint16(header[14:], 31) // Credits requested
+	binary.LittleEndian.PutUint64(header[24:], s.messageID)
+	binary.LittleEndian.PutUint64(header[40:], s.sessionID)
+	s.messageID++
+	_, err := s.conn.Write(append(packet, body...))
+	return err
+}
+
+// recv - Read a response, returns its status and body
+func (s *smbConn) recv() (uint32, []byte, error) {
+	transport := make([]byte, 4)
+	if _, err := io.ReadFull(s.conn, transport); err != nil {
+		return 0, nil, err
+	}
+	size := binary.BigEndian.Uint32(transport) & 0xffffff
+	if size < smb2HeaderSize {
+		return 0, nil, ErrInvalidSMBResponse
+	}
+	packet := make([]byte, size)
+	if _, err := io.ReadFull(s.conn, packet); err != nil {
+		return 0, nil, err
+	}
+	if string(packet[:4]) != "\xfeSMB" {
+		return 0, nil, ErrInvalidSMBResponse
+	}
+	s.sessionID = binary.LittleEndian.Uint64(packet[40:])
+	return binary.LittleEndian.Uint32(packet[8:]), packet, nil
+}
+
+func (s *smbConn) negotiate() error {
+	body := make([]byte, 36+2*len(smb2Dialects))
+	binary.LittleEndian.PutUint16(body, 36)
+	binary.LittleEndian.PutUint16(body[2:], uint16(len(smb2Dialects)))
+	binary.LittleEndian.PutUint16(body[4:], smb2SigningEnabled)
+	rand.Read(body[12:28]) // Client GUID
+	for index, dialect := range smb2Dialects {
+		binary.LittleEndian.PutUint16(body[36+2*index:], dialect)
+	}
+	if err := s.send(smb2Negotiate, body); err != nil {
+		return err
+	}
+	status, _, err := s.recv()
+	if err != nil {
+		return err
+	}
+	if status != statusSuccess {
+		return fmt.Errorf("negotiate failed with ntstatus 0x%08x", status)
+	}
+	return nil
+}
+
+// sessionSetup - Send a security token, returns the status, session flags and the
+// server's security token
+func (s *smbConn) sessionSetup(token []byte) (uint32, uint16, []byte, error) {
+	body := make([]byte, 24, 24+len(token))
+	binary.LittleEndian.PutUint16(body, 25)
+	body[3] = smb2SigningEnabled
+	binary.LittleEndian.PutUint16(body[12:], smb2HeaderSize+24)
+	binary.LittleEndian.PutUint16(body[14:], uint16(len(token)))
+	if err := s.send(smb2SessionSetup, append(body, token...)); err != nil {
+		return 0, 0, nil, err
+	}
+	status, packet, err := s.recv()
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if len(packet) < smb2HeaderSize+8 {
+		return status, 0, nil, nil
+	}
+	resp := packet[smb2HeaderSize:]
+	flags := binary.LittleEndian.Uint16(resp[2:])
+	offset := int(binary.LittleEndian.Uint16(resp[4:]))
+	length := int(binary.LittleEndian.Uint16(resp[6:]))
+	if offset < smb2HeaderSize || len(packet) < offset+length {
+		return status, flags, nil, nil
+	}
+	return status, flags, packet[offset : offset+length], nil
+}
+
+// checkSMB - NTLM authentication in an SMB2 session setup
+func checkSMB(target string, user *account, password string) (string, error) {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(target, smbPort), dialTimeout)
+	if err != nil {
+		return Error, err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(3 * dialTimeout))
+	smb := &smbConn{conn: conn}
+	if err := smb.negotiate(); err != nil {
+		return Error, err
+	}
+
+	status, _, token, err := smb.sessionSetup(spnegoInit(ntlmNegotiateMessage()))
+	if err != nil {
+		return Error, err
+	}
+	if status != statusMoreProcessing {
+		return Error, fmt.Errorf("unexpected ntstatus 0x%08x", status)
+	}
+	challenge, err := parseNTLMChallenge(token)
+	if err != nil {
+		return Error, err
+	}
+	authenticate, err := ntlmAuthenticateMessage(user, password, challenge)
+	if err != nil {
+		return Error, err
+	}
+	status, flags, _, err := smb.sessionSetup(spnegoResponse(authenticate))
+	if err != nil {
+		return Error, err
+	}
+	if status == statusSuccess && flags&(smb2SessionGuest|smb2SessionNull) != 0 {
+		return Invalid, errors.New("logged in as guest")
+	}
+	return ntStatus(status)
+}
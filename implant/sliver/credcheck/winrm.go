@@ -0,0 +1,123 @@
+package credcheck
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	winrmPort  = "5985"
+	winrmsPort = "5986"
+	winrmPath  = "/wsman"
+)
+
+func checkWinRM(target string, user *account, password string) (string, error) {
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(target, winrmPort), dialTimeout)
+	if err != nil {
+		return Error, err
+	}
+	return winrmAuth(conn, "http://"+net.JoinHostPort(target, winrmPort)+winrmPath, user, password)
+}
+
+func checkWinRMS(target string, user *account, password string) (string, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+	conn, err := tls.DialWithDialer(dialer, "tcp", net.JoinHostPort(target, winrmsPort), &tls.Config{
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		return Error, err
+	}
+	return winrmAuth(conn, "https://"+net.JoinHostPort(target, winrmsPort)+winrmPath, user, password)
+}
+
+// winrmAuth - NTLM over HTTP needs both legs on the same connection, so we don't
+// use an http.Client. WinRM only answers 401 to the authenticate message if the
+// credentials are invalid or the user isn't allowed to use WinRM.
+func winrmAuth(conn net.Conn, url string, user *account, password string) (string, error) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(3 * dialTimeout))
+	reader := bufio.NewReader(conn)
+
+	resp, err := winrmPost(conn, reader, url, ntlmNegotiateMessage())
+	if err != nil {
+		return Error, err
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		return Error, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	var challenge *ntlmChallenge
+	for _, header := range resp.Header.Values("WWW-Authenticate") {
+		fields := strings.Fields(header)
+		if len(fields) != 2 || !(strings.EqualFold(fields[0], "negotiate") || strings.EqualFold(fields[0], "ntlm")) {
+			continue
+		}
+		token, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			continue
+		}
+		challenge, err = parseNTLMChallenge(token)
+		if err == nil {
+			break
+		}
+	}
+	if challenge == nil {
+		return Error, ErrInvalidChallenge
+	}
+	authenticate, err := ntlmAuthenticateMessage(user, password, challenge)
+	if err != nil {
+		return Error, err
+	}
+	resp, err = winrmPost(conn, reader, url, authenticate)
+	if err != nil {
+		return Error, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		return Invalid, nil
+	}
+	return Valid, nil
+}
+
+func winrmPost(conn net.Conn, reader *bufio.Reader, url string, token []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Negotiate "+base64.StdEncoding.EncodeToString(token))
+	req.Header.Set("Content-Type", "application/soap+xml;charset=UTF-8")
+	req.ContentLength = 0
+	if err = req.Write(conn); err != nil {
+		return nil, err
+	}
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, err
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return resp, nil
+}
@@ -23,6 +23,8 @@ import (
 	"encoding/base64"
 	"errors"
 
+	"golang.org/x/crypto/chacha20poly1305"
+
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
@@ -108,6 +110,13 @@ func MinisignVerify(message []byte, signature string) bool {
 	return valid
 }
 
+// ImplantStorageKey - Derive a key from the implant's private key, used to encrypt
+// state the implant persists to disk so it can only be read by the same build
+func ImplantStorageKey(purpose string) [chacha20poly1305.KeySize]byte {
+	keyPair := GetECCKeyPair()
+	return deriveKeyFrom(append(keyPair.Private[:], []byte(purpose)...))
+}
+
 // GetServerECCPublicKey - Get the decoded server public key
 func GetServerECCPublicKey() *[32]byte {
 	publicRaw, err := base64.RawStdEncoding.DecodeString(eccServerPublicKey)
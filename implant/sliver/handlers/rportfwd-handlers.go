@@ -39,6 +39,10 @@ var (
 		pb.MsgRportFwdListenersReq:     rportFwdListenersHandler,
 		pb.MsgRportFwdStartListenerReq: rportFwdStartListenerHandler,
 		pb.MsgRportFwdStopListenerReq:  rportFwdStopListenerHandler,
+
+		pb.MsgNTLMListenersReq:     ntlmListenersHandler,
+		pb.MsgNTLMStartListenerReq: ntlmStartListenerHandler,
+		pb.MsgNTLMStopListenerReq:  ntlmStopListenerHandler,
 	}
 )
 
@@ -58,6 +62,14 @@ func rportFwdListenersHandler(envelope *pb.Envelope, connection *transports.Conn
 			ID:             uint32(portfwd.ID),
 			BindAddress:    portfwd.BindAddr,
 			ForwardAddress: portfwd.RemoteAddr,
+			Local:          portfwd.Local,
+			Allow:          portfwd.Allow,
+
+			Connections:         portfwd.Connections,
+			ActiveConnections:   portfwd.ActiveConnections,
+			RejectedConnections: portfwd.RejectedConnections,
+			BytesSent:           portfwd.BytesSent,
+			BytesReceived:       portfwd.BytesReceived,
 		})
 	}
 	data, _ := proto.Marshal(&pb.RportFwdListeners{
@@ -75,7 +87,7 @@ func rportFwdStartListenerHandler(envelope *pb.Envelope, connection *transports.
 	resp := &pb.RportFwdListener{Response: &commonpb.Response{}}
 	err := proto.Unmarshal(envelope.Data, req)
 	if err != nil {
-		resp.Response.Err = err.Error()
+		resp.Response = pb.ErrorResponse(err)
 		data, _ := proto.Marshal(resp)
 		connection.Send <- &pb.Envelope{
 			ID:   envelope.ID,
@@ -88,6 +100,7 @@ func rportFwdStartListenerHandler(envelope *pb.Envelope, connection *transports.
 	for _, r := range rportfwds {
 		if r.BindAddr == req.BindAddress {
 			resp.Response.Err = "Already listening on " + r.BindAddr + "\n"
+			resp.Response.ErrCode = commonpb.ErrorCode_ALREADY_EXISTS
 			data, _ := proto.Marshal(resp)
 			connection.Send <- &pb.Envelope{
 				ID:   envelope.ID,
@@ -96,6 +109,16 @@ func rportFwdStartListenerHandler(envelope *pb.Envelope, connection *transports.
 			return
 		}
 	}
+	allowNets, err := rportfwd.ParseAllowList(req.Allow)
+	if err != nil {
+		resp.Response = pb.ErrorResponse(err)
+		data, _ := proto.Marshal(resp)
+		connection.Send <- &pb.Envelope{
+			ID:   envelope.ID,
+			Data: data,
+		}
+		return
+	}
 	tcpProxy := &tcpproxy.Proxy{}
 	channelProxy := &rportfwd.ChannelProxy{
 		Conn:            connection,
@@ -103,6 +126,9 @@ func rportFwdStartListenerHandler(envelope *pb.Envelope, connection *transports.
 		BindAddr:        req.BindAddress,
 		KeepAlivePeriod: 1000 * time.Second,
 		DialTimeout:     30 * time.Second,
+		Local:           req.Local,
+		Allow:           req.Allow,
+		AllowNets:       allowNets,
 	}
 	tcpProxy.AddRoute(req.BindAddress, channelProxy)
 	rportfwd := rportfwd.Portfwds.Add(tcpProxy, channelProxy)
@@ -119,6 +145,8 @@ func rportFwdStartListenerHandler(envelope *pb.Envelope, connection *transports.
 	resp.ForwardAddress = req.ForwardAddress
 	resp.BindPort = req.ForwardPort
 	resp.ForwardPort = req.ForwardPort
+	resp.Local = req.Local
+	resp.Allow = req.Allow
 	resp.ID = uint32(rportfwd.ID)
 
 	data, _ := proto.Marshal(resp)
@@ -133,7 +161,7 @@ func rportFwdStopListenerHandler(envelope *pb.Envelope, connection *transports.C
 	resp := &pb.RportFwdListener{Response: &commonpb.Response{}}
 	err := proto.Unmarshal(envelope.Data, req)
 	if err != nil {
-		resp.Response.Err = err.Error()
+		resp.Response = pb.ErrorResponse(err)
 		data, _ := proto.Marshal(resp)
 		connection.Send <- &pb.Envelope{
 			ID:   envelope.ID,
@@ -147,8 +175,10 @@ func rportFwdStopListenerHandler(envelope *pb.Envelope, connection *transports.C
 		resp.ID = uint32(rportfwd.ID)
 		resp.BindAddress = rportfwd.ChannelProxy.BindAddr
 		resp.ForwardAddress = rportfwd.ChannelProxy.RemoteAddr
+		resp.Local = rportfwd.ChannelProxy.Local
 	} else {
 		resp.Response.Err = "Invalid ID\n"
+		resp.Response.ErrCode = commonpb.ErrorCode_INVALID_ARGUMENT
 	}
 
 	data, _ := proto.Marshal(resp)
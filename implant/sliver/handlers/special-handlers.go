@@ -27,6 +27,10 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 
+	// {{if .Config.Watchdog}}
+	"github.com/bishopfox/sliver/implant/sliver/watchdog"
+	// {{end}}
+
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
@@ -52,6 +56,9 @@ func killHandler(data []byte, _ *transports.Connection) error {
 	if err != nil {
 		return err
 	}
+	// {{if .Config.Watchdog}}
+	watchdog.Stop() // Otherwise it restarts us
+	// {{end}}
 	// {{if .Config.Debug}}
 	log.Println("Let's exit!")
 	// {{end}}
@@ -26,6 +26,10 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 
+	// {{if .Config.Watchdog}}
+	"github.com/bishopfox/sliver/implant/sliver/watchdog"
+	// {{end}}
+
 	// {{if or .Config.IsSharedLib .Config.IsShellcode}}
 
 	"syscall"
@@ -56,6 +60,9 @@ func killHandler(data []byte, _ *transports.Connection) error {
 	if err != nil {
 		return err
 	}
+	// {{if .Config.Watchdog}}
+	watchdog.Stop() // Otherwise it restarts us
+	// {{end}}
 	// {{if or .Config.IsSharedLib .Config.IsShellcode}}
 	// Windows only: ExitThread() instead of os.Exit() for DLL/shellcode slivers
 	// so that the parent process is not killed
@@ -0,0 +1,175 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/ntlm"
+	"github.com/bishopfox/sliver/implant/sliver/rportfwd"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	// contextHandlers - Handlers that can be aborted when their task times out,
+	// these take precedence over the system handler for the same message type
+	contextHandlers = map[uint32]ContextHandler{
+		sliverpb.MsgExecuteReq: executeContextHandler,
+		sliverpb.MsgSearchReq:  searchContextHandler,
+	}
+
+	// streamSend - Running tasks send partial results on this channel, nil if the
+	// transport can't take them before the task is done (i.e. beacons)
+	streamSend  chan<- *sliverpb.Envelope
+	streamMutex = &sync.RWMutex{}
+
+	// runHandler - Runs a handler on the current goroutine, overridden on
+	// platforms that need to set up the thread first (e.g. token impersonation)
+	runHandler = func(handler RPCHandler, data []byte, resp RPCResponse) {
+		handler(data, resp)
+	}
+
+	// liveHandlers - Handlers that haven't returned, including those whose task
+	// has already timed out (e.g. a long clipboard monitor)
+	liveHandlers int32
+)
+
+// RunTask - Run the handler for a task, if the task times out or is canceled before
+// the handler has responded we respond with an error and drop any late response
+func RunTask(handler RPCHandler, envelope *sliverpb.Envelope, resp RPCResponse) {
+	timeout := time.Duration(envelope.Timeout)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if 0 < timeout {
+		var timeoutCancel context.CancelFunc
+		ctx, timeoutCancel = context.WithTimeout(ctx, timeout)
+		defer timeoutCancel()
+	}
+	if ctxHandler, ok := contextHandlers[envelope.Type]; ok {
+		handler = func(data []byte, resp RPCResponse) {
+			ctxHandler(ctx, data, resp)
+		}
+	}
+	runningTasks.add(envelope.ID, cancel)
+	defer runningTasks.remove(envelope.ID)
+
+	once := &sync.Once{}
+	respond := func(data []byte, err error) {
+		once.Do(func() {
+			resp(data, err)
+		})
+	}
+	done := make(chan struct{})
+	atomic.AddInt32(&liveHandlers, 1)
+	go func() {
+		defer atomic.AddInt32(&liveHandlers, -1)
+		defer close(done)
+		runHandler(handler, envelope.Data, respond)
+	}()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		if ctx.Err() == context.Canceled {
+			// {{if .Config.Debug}}
+			log.Printf("[task] %d canceled", envelope.ID)
+			// {{end}}
+			respond(sliverpb.CanceledResponse(), ctx.Err())
+			return
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[task] %d timed out after %s", envelope.ID, timeout)
+		// {{end}}
+		respond(sliverpb.TimeoutResponse(timeout), ctx.Err())
+	}
+}
+
+// Background - Whether anything started by a task is still running: handlers that
+// outlived their task, reverse port forwards and ntlm listeners
+func Background() bool {
+	return 0 < atomic.LoadInt32(&liveHandlers) || 0 < len(rportfwd.Portfwds.List()) || 0 < len(ntlm.Listeners.List())
+}
+
+// SetStream - Set the channel running tasks send partial results on, nil to
+// have tasks respond with everything at once
+func SetStream(send chan<- *sliverpb.Envelope) {
+	streamMutex.Lock()
+	defer streamMutex.Unlock()
+	streamSend = send
+}
+
+// stream - Send a partial result, returns false if there's no stream or the
+// task is done before the envelope could be sent
+func stream(ctx context.Context, envelope *sliverpb.Envelope) bool {
+	streamMutex.RLock()
+	send := streamSend
+	streamMutex.RUnlock()
+	if send == nil {
+		return false
+	}
+	select {
+	case send <- envelope:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// CancelTask - Cancel a running task, does nothing if the task has already
+// responded or was never started
+func CancelTask(id int64) {
+	// {{if .Config.Debug}}
+	log.Printf("[task] cancel %d", id)
+	// {{end}}
+	runningTasks.cancel(id)
+}
+
+// taskRegistry - The cancel functions of running tasks by envelope id
+type taskRegistry struct {
+	mutex   sync.Mutex
+	cancels map[int64]context.CancelFunc
+}
+
+var runningTasks = &taskRegistry{cancels: map[int64]context.CancelFunc{}}
+
+func (r *taskRegistry) add(id int64, cancel context.CancelFunc) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cancels[id] = cancel
+}
+
+func (r *taskRegistry) remove(id int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.cancels, id)
+}
+
+func (r *taskRegistry) cancel(id int64) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if cancel, ok := r.cancels[id]; ok {
+		cancel()
+	}
+}
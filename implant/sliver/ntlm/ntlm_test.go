@@ -0,0 +1,186 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newAuthenticate(user string, domain string, workstation string, lm []byte, nt []byte) []byte {
+	fields := [][]byte{lm, nt, encodeUTF16(domain), encodeUTF16(user), encodeUTF16(workstation), {}}
+	message := make([]byte, 72)
+	copy(message, signature)
+	binary.LittleEndian.PutUint32(message[8:], authenticateMessage)
+	binary.LittleEndian.PutUint32(message[60:], negotiateUnicode)
+	for index, field := range fields {
+		putPayload(message[12+index*8:], len(field), len(message))
+		message = append(message, field...)
+	}
+	return message
+}
+
+func newNegotiate() []byte {
+	message := make([]byte, 32)
+	copy(message, signature)
+	binary.LittleEndian.PutUint32(message[8:], negotiateMessage)
+	return message
+}
+
+func TestHash(t *testing.T) {
+	serverChallenge := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	ntProof := bytes.Repeat([]byte{0xaa}, 16)
+	blob := bytes.Repeat([]byte{0xbb}, 28)
+	auth, err := ParseAuthenticate(newAuthenticate("alice", "CORP", "WS01", make([]byte, 24), append(ntProof, blob...)))
+	if err != nil {
+		t.Fatalf("failed to parse authenticate message: %s", err)
+	}
+	if auth.User != "alice" || auth.Domain != "CORP" || auth.Workstation != "WS01" {
+		t.Fatalf("unexpected authenticate fields %+v", auth)
+	}
+	hashType, hash, err := Hash(auth, serverChallenge)
+	expected := fmt.Sprintf("alice::CORP:0102030405060708:%x:%x", ntProof, blob)
+	if err != nil || hashType != NetNTLMv2 || hash != expected {
+		t.Fatalf("unexpected hash %s %s (%v)", hashType, hash, err)
+	}
+
+	lm := bytes.Repeat([]byte{0x11}, 24)
+	nt := bytes.Repeat([]byte{0x22}, 24)
+	auth, _ = ParseAuthenticate(newAuthenticate("bob", "CORP", "WS01", lm, nt))
+	hashType, hash, err = Hash(auth, serverChallenge)
+	expected = fmt.Sprintf("bob::CORP:%x:%x:0102030405060708", lm, nt)
+	if err != nil || hashType != NetNTLMv1 || hash != expected {
+		t.Fatalf("unexpected hash %s %s (%v)", hashType, hash, err)
+	}
+
+	auth, _ = ParseAuthenticate(newAuthenticate("", "", "WS01", []byte{0}, nil))
+	if _, _, err = Hash(auth, serverChallenge); err != errAnonymous {
+		t.Fatalf("expected anonymous error, got %v", err)
+	}
+	if _, err = ParseAuthenticate(newAuthenticate("alice", "CORP", "WS01", nil, nil)[:40]); err != errTruncated {
+		t.Fatalf("expected truncated error, got %v", err)
+	}
+}
+
+func TestServeSMB(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	captures := make(chan string, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- ServeSMB(server, workgroup, "HOST", func(auth *Authenticate, serverChallenge []byte) {
+			_, hash, _ := Hash(auth, serverChallenge)
+			captures <- hash
+		})
+	}()
+
+	request := func(command uint16, messageID uint64, body []byte) (uint32, []byte) {
+		header := smb2Header(command, 0, messageID, 0)
+		binary.LittleEndian.PutUint32(header[16:], 0)
+		if err := writePacket(client, append(header, body...)); err != nil {
+			t.Fatal(err)
+		}
+		packet, err := readPacket(client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if binary.LittleEndian.Uint64(packet[24:]) != messageID {
+			t.Fatalf("unexpected message id in response")
+		}
+		return binary.LittleEndian.Uint32(packet[8:]), packet
+	}
+
+	negotiate := make([]byte, 36)
+	binary.LittleEndian.PutUint16(negotiate, 36)
+	binary.LittleEndian.PutUint16(negotiate[2:], 2)
+	negotiate = append(negotiate, 0x02, 0x02, 0x10, 0x02)
+	status, packet := request(smb2Negotiate, 0, negotiate)
+	if status != 0 || binary.LittleEndian.Uint16(packet[smb2HeaderSize+4:]) != 0x0210 {
+		t.Fatalf("unexpected negotiate response %x", status)
+	}
+	if !bytes.Contains(securityBuffer(packet, smb2HeaderSize+56), ntlmsspOID) {
+		t.Fatal("negotiate response doesn't offer ntlm")
+	}
+
+	sessionSetup := func(token []byte) []byte {
+		body := make([]byte, 24)
+		binary.LittleEndian.PutUint16(body, 25)
+		binary.LittleEndian.PutUint16(body[12:], smb2HeaderSize+24)
+		binary.LittleEndian.PutUint16(body[14:], uint16(len(token)))
+		return append(body, token...)
+	}
+	status, packet = request(smb2SessionSetup, 1, sessionSetup(newNegotiate()))
+	if status != statusMoreProcessingRequired {
+		t.Fatalf("unexpected session setup status %x", status)
+	}
+	token := securityBuffer(packet, smb2HeaderSize+4)
+	serverChallenge, err := ParseChallenge(token[bytes.Index(token, signature):])
+	if err != nil {
+		t.Fatalf("failed to parse challenge: %s", err)
+	}
+
+	nt := append(bytes.Repeat([]byte{0xaa}, 16), bytes.Repeat([]byte{0xbb}, 28)...)
+	status, _ = request(smb2SessionSetup, 2, sessionSetup(newAuthenticate("alice", "CORP", "WS01", make([]byte, 24), nt)))
+	if status != statusAccessDenied {
+		t.Fatalf("unexpected session setup status %x", status)
+	}
+	select {
+	case hash := <-captures:
+		if !strings.HasPrefix(hash, fmt.Sprintf("alice::CORP:%x:", serverChallenge)) {
+			t.Fatalf("unexpected hash %s", hash)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("authentication wasn't captured")
+	}
+	if err := <-done; err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+}
+
+func TestSniffer(t *testing.T) {
+	hashes := []string{}
+	s := &sniffer{captured: func(auth *Authenticate, serverChallenge []byte) {
+		_, hash, _ := Hash(auth, serverChallenge)
+		hashes = append(hashes, hash)
+	}}
+	serverChallenge := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	nt := append(bytes.Repeat([]byte{0xaa}, 16), bytes.Repeat([]byte{0xbb}, 28)...)
+	auth := newAuthenticate("alice", "CORP", "WS01", make([]byte, 24), nt)
+
+	// Authentication without a challenge can't be cracked
+	s.client(append([]byte("smb"), auth...))
+	s.client(append([]byte("smb"), newNegotiate()...))
+	challenge := NewChallenge(serverChallenge, workgroup, "HOST", time.Now())
+	s.server(append([]byte("smb"), challenge[:5]...))
+	s.server(challenge[5:])
+	for _, chunk := range [][]byte{[]byte("smb"), auth[:4], auth[4:30], auth[30:]} {
+		s.client(chunk)
+	}
+	if len(hashes) != 1 || !strings.HasPrefix(hashes[0], "alice::CORP:0102030405060708:") {
+		t.Fatalf("unexpected hashes %v", hashes)
+	}
+	if len(signature) <= len(s.fromClient) {
+		t.Fatal("sniffer kept a handled message")
+	}
+}
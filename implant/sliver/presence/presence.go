@@ -0,0 +1,68 @@
+package presence
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var checkin int32
+
+// SetCheckin - Enable or disable reporting presence with each beacon check-in
+func SetCheckin(enabled bool) {
+	if enabled {
+		atomic.StoreInt32(&checkin, 1)
+	} else {
+		atomic.StoreInt32(&checkin, 0)
+	}
+}
+
+// Checkin - Whether presence is reported with each beacon check-in
+func Checkin() bool {
+	return atomic.LoadInt32(&checkin) == 1
+}
+
+// Report - Collect the signals of whether a user is at the system: input idle
+// time, locked workstation, screensaver, and the interactive/remote sessions
+func Report() (*sliverpb.Presence, error) {
+	report := &sliverpb.Presence{
+		IdleTime:  -1,
+		Checkin:   Checkin(),
+		Timestamp: time.Now().Unix(),
+	}
+	err := collect(report)
+	return report, err
+}
+
+// consoleIdleTime - The least idle local session, -1 if none of them are known
+func consoleIdleTime(sessions []*sliverpb.PresenceSession) int64 {
+	idleTime := int64(-1)
+	for _, session := range sessions {
+		if session.Remote || session.IdleTime < 0 {
+			continue
+		}
+		if idleTime < 0 || session.IdleTime < idleTime {
+			idleTime = session.IdleTime
+		}
+	}
+	return idleTime
+}
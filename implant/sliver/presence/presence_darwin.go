@@ -0,0 +1,85 @@
+package presence
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+	------------------------------------------------------------------------
+
+	The owner of /dev/console is the user logged in at the console, and each
+	terminal is owned by the user it was allocated to so we use the last access
+	time of the terminals as the idle time of those sessions. Input idle time
+	and lock state need IOKit and CoreGraphics, which need cgo.
+
+*/
+
+import (
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func collect(report *sliverpb.Presence) error {
+	now := time.Now()
+	console, err := os.Stat("/dev/console")
+	if err != nil {
+		return err
+	}
+	if stat, ok := console.Sys().(*syscall.Stat_t); ok && stat.Uid != 0 {
+		report.Sessions = append(report.Sessions, &sliverpb.PresenceSession{
+			Username: username(stat.Uid),
+			Station:  "console",
+			State:    "Active",
+			IdleTime: -1,
+		})
+	}
+	ttys, _ := filepath.Glob("/dev/ttys[0-9]*")
+	for _, tty := range ttys {
+		info, err := os.Stat(tty)
+		if err != nil {
+			continue
+		}
+		stat, ok := info.Sys().(*syscall.Stat_t)
+		if !ok || stat.Uid == 0 {
+			continue // Unallocated terminals are owned by root
+		}
+		idle := now.Sub(time.Unix(stat.Atimespec.Unix())) / time.Second
+		if idle < 0 {
+			idle = 0
+		}
+		report.Sessions = append(report.Sessions, &sliverpb.PresenceSession{
+			Username: username(stat.Uid),
+			Station:  filepath.Base(tty),
+			State:    "Active",
+			IdleTime: int64(idle),
+		})
+	}
+	report.IdleTime = consoleIdleTime(report.Sessions)
+	return nil
+}
+
+func username(uid uint32) string {
+	owner, err := user.LookupId(strconv.Itoa(int(uid)))
+	if err != nil {
+		return strconv.Itoa(int(uid))
+	}
+	return owner.Username
+}
@@ -0,0 +1,134 @@
+package presence
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+	------------------------------------------------------------------------
+
+	Like 'w' we read the sessions from utmp and use the last access time of
+	each session's terminal as its idle time, there's no input idle time or
+	lock state we can read without talking to the display server.
+
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	utmpRecordSize  = 384
+	utmpUserProcess = 7
+)
+
+var utmpPaths = []string{"/var/run/utmp", "/run/utmp"}
+
+// utmpRecord - The fields of a glibc utmp record we read
+type utmpRecord struct {
+	Type    int16
+	Pid     int32
+	Line    string
+	User    string
+	Host    string
+	Session int32
+}
+
+func collect(report *sliverpb.Presence) error {
+	var data []byte
+	var err error
+	for _, utmpPath := range utmpPaths {
+		data, err = os.ReadFile(utmpPath)
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, record := range parseUtmp(data) {
+		if record.Type != utmpUserProcess {
+			continue
+		}
+		// X sessions are recorded with the display as the host
+		remote := record.Host != "" && !strings.HasPrefix(record.Host, ":")
+		session := &sliverpb.PresenceSession{
+			ID:       uint32(record.Session),
+			Username: record.User,
+			Station:  record.Line,
+			State:    "Active",
+			Remote:   remote,
+			IdleTime: ttyIdleTime(record.Line, now),
+		}
+		if remote {
+			session.ClientAddress = record.Host
+		}
+		report.Sessions = append(report.Sessions, session)
+	}
+	report.IdleTime = consoleIdleTime(report.Sessions)
+	return nil
+}
+
+func parseUtmp(data []byte) []utmpRecord {
+	records := []utmpRecord{}
+	for offset := 0; offset+utmpRecordSize <= len(data); offset += utmpRecordSize {
+		record := data[offset : offset+utmpRecordSize]
+		records = append(records, utmpRecord{
+			Type:    int16(binary.LittleEndian.Uint16(record[0:2])),
+			Pid:     int32(binary.LittleEndian.Uint32(record[4:8])),
+			Line:    cString(record[8:40]),
+			User:    cString(record[44:76]),
+			Host:    cString(record[76:332]),
+			Session: int32(binary.LittleEndian.Uint32(record[336:340])),
+		})
+	}
+	return records
+}
+
+func cString(buf []byte) string {
+	if index := bytes.IndexByte(buf, 0); 0 <= index {
+		buf = buf[:index]
+	}
+	return string(buf)
+}
+
+// ttyIdleTime - Seconds since the terminal was last read from, -1 if unknown
+func ttyIdleTime(line string, now time.Time) int64 {
+	if line == "" || strings.Contains(line, "..") {
+		return -1
+	}
+	info, err := os.Stat(filepath.Join("/dev", line))
+	if err != nil {
+		return -1
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return -1
+	}
+	idle := now.Sub(time.Unix(stat.Atim.Unix())) / time.Second
+	if idle < 0 {
+		return 0
+	}
+	return int64(idle)
+}
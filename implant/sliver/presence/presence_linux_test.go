@@ -0,0 +1,71 @@
+package presence
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func utmpEntry(recordType int16, line string, user string, host string, session int32) []byte {
+	record := make([]byte, utmpRecordSize)
+	binary.LittleEndian.PutUint16(record[0:2], uint16(recordType))
+	binary.LittleEndian.PutUint32(record[4:8], 1234)
+	copy(record[8:40], line)
+	copy(record[44:76], user)
+	copy(record[76:332], host)
+	binary.LittleEndian.PutUint32(record[336:340], uint32(session))
+	return record
+}
+
+func TestParseUtmp(t *testing.T) {
+	data := utmpEntry(2, "~", "reboot", "5.15.0", 0) // BOOT_TIME
+	data = append(data, utmpEntry(utmpUserProcess, "pts/0", "alice", "10.0.0.1", 7)...)
+	data = append(data, utmpEntry(utmpUserProcess, "tty1", "bob", "", 8)...)
+	data = append(data, 1, 2, 3) // Partial records are ignored
+
+	records := parseUtmp(data)
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+	alice := records[1]
+	if alice.Type != utmpUserProcess || alice.Line != "pts/0" || alice.User != "alice" || alice.Host != "10.0.0.1" || alice.Session != 7 {
+		t.Fatalf("Unexpected record %+v", alice)
+	}
+	if records[2].Host != "" || records[2].Pid != 1234 {
+		t.Fatalf("Unexpected record %+v", records[2])
+	}
+}
+
+func TestConsoleIdleTime(t *testing.T) {
+	sessions := []*sliverpb.PresenceSession{
+		{IdleTime: 5, Remote: true},
+		{IdleTime: -1},
+		{IdleTime: 300},
+		{IdleTime: 60},
+	}
+	if idle := consoleIdleTime(sessions); idle != 60 {
+		t.Fatalf("Expected the least idle local session, got %d", idle)
+	}
+	if idle := consoleIdleTime(sessions[:2]); idle != -1 {
+		t.Fatalf("Expected unknown idle time, got %d", idle)
+	}
+}
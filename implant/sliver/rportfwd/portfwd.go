@@ -25,11 +25,14 @@ import (
 
 	"context"
 	"encoding/binary"
+	"errors"
 	"io"
 	"math/rand"
 	"net"
 	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bishopfox/sliver/implant/sliver/tcpproxy"
@@ -53,6 +56,14 @@ type PortfwdMeta struct {
 	SessionID  string
 	BindAddr   string
 	RemoteAddr string
+	Local      bool
+	Allow      []string
+
+	Connections         uint64
+	ActiveConnections   uint64
+	RejectedConnections uint64
+	BytesSent           uint64
+	BytesReceived       uint64
 }
 
 // Portfwd - Tracks portfwd<->tcpproxy
@@ -68,6 +79,14 @@ func (p *Portfwd) GetMetadata() *PortfwdMeta {
 		ID:         p.ID,
 		BindAddr:   p.ChannelProxy.BindAddr,
 		RemoteAddr: p.ChannelProxy.RemoteAddr,
+		Local:      p.ChannelProxy.Local,
+		Allow:      p.ChannelProxy.Allow,
+
+		Connections:         atomic.LoadUint64(&p.ChannelProxy.stats.connections),
+		ActiveConnections:   atomic.LoadUint64(&p.ChannelProxy.stats.active),
+		RejectedConnections: atomic.LoadUint64(&p.ChannelProxy.stats.rejected),
+		BytesSent:           atomic.LoadUint64(&p.ChannelProxy.stats.bytesSent),
+		BytesReceived:       atomic.LoadUint64(&p.ChannelProxy.stats.bytesReceived),
 	}
 }
 
@@ -85,6 +104,7 @@ func (f *portfwds) Add(tcpProxy *tcpproxy.Proxy, channelProxy *ChannelProxy) *Po
 		TCPProxy:     tcpProxy,
 		ChannelProxy: channelProxy,
 	}
+	channelProxy.ID = portfwd.ID
 	f.forwards[portfwd.ID] = portfwd
 	return portfwd
 }
@@ -117,6 +137,9 @@ func (f *portfwds) List() []*PortfwdMeta {
 //
 // Implements the Target interface from tcpproxy pkg
 type ChannelProxy struct {
+	stats portfwdStats // Must be first to be 64-bit aligned on 32-bit platforms
+
+	ID   int
 	Conn *transports.Connection
 	//Session  *clientpb.Session
 
@@ -124,6 +147,33 @@ type ChannelProxy struct {
 	RemoteAddr      string
 	KeepAlivePeriod time.Duration
 	DialTimeout     time.Duration
+
+	// Local - The remote address is dialed by the operator's console instead of the server
+	Local bool
+	// Allow - Only connections from these ips/cidrs are forwarded, all are allowed if empty
+	Allow     []string
+	AllowNets []*net.IPNet
+}
+
+// portfwdStats - Connection statistics of a reverse port forward, updated atomically
+type portfwdStats struct {
+	connections   uint64
+	active        uint64
+	rejected      uint64
+	bytesSent     uint64
+	bytesReceived uint64
+}
+
+// statsWriter - Counts the bytes written back to a forwarded connection
+type statsWriter struct {
+	io.WriteCloser
+	count *uint64
+}
+
+func (w statsWriter) Write(data []byte) (int, error) {
+	n, err := w.WriteCloser.Write(data)
+	atomic.AddUint64(w.count, uint64(n))
+	return n, err
 }
 
 // HandleConn - Handle a TCP connection
@@ -131,6 +181,16 @@ func (p *ChannelProxy) HandleConn(src net.Conn) {
 	// {{if .Config.Debug}}
 	log.Printf("[tcpproxy] Handling new connection")
 	// {{end}}
+	if !p.allowed(src.RemoteAddr()) {
+		// {{if .Config.Debug}}
+		log.Printf("[rportfwd] Rejected connection from %s", src.RemoteAddr())
+		// {{end}}
+		atomic.AddUint64(&p.stats.rejected, 1)
+		src.Close()
+		return
+	}
+	atomic.AddUint64(&p.stats.connections, 1)
+	atomic.AddUint64(&p.stats.active, 1)
 	ctx := context.Background()
 	var cancelContext context.CancelFunc
 	if p.DialTimeout >= 0 {
@@ -156,7 +216,7 @@ func (p *ChannelProxy) HandleConn(src net.Conn) {
 
 	tunnel := transports.NewTunnel(
 		tId,
-		src,
+		statsWriter{WriteCloser: src, count: &p.stats.bytesReceived},
 		src,
 	)
 	p.Conn.AddTunnel(tunnel)
@@ -170,16 +230,20 @@ func (p *ChannelProxy) HandleConn(src net.Conn) {
 		}
 		src.Close()
 		cancelContext()
+		atomic.AddUint64(&p.stats.active, ^uint64(0))
 	}
 
 	go func() {
 		tWriter := tunnelWriter{
-			tun:      tunnel,
-			conn:     p.Conn,
-			host:     p.Host(),
-			port:     p.Port(),
-			protocol: sliverpb.PortFwdProtoTCP,
-			tunnelID: tId,
+			tun:        tunnel,
+			conn:       p.Conn,
+			host:       p.Host(),
+			port:       p.Port(),
+			protocol:   sliverpb.PortFwdProtoTCP,
+			tunnelID:   tId,
+			local:      p.Local,
+			listenerID: uint32(p.ID),
+			sent:       &p.stats.bytesSent,
 		}
 		// portfwd only uses one reader, hence the tunnel.Readers[0]
 		n, err := io.Copy(tWriter, tunnel.Readers[0])
@@ -231,6 +295,23 @@ func (p *ChannelProxy) Host() string {
 	return host
 }
 
+// allowed - Check a connection's source against the allow list
+func (p *ChannelProxy) allowed(addr net.Addr) bool {
+	if len(p.AllowNets) == 0 {
+		return true
+	}
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	for _, allowNet := range p.AllowNets {
+		if allowNet.Contains(tcpAddr.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *ChannelProxy) dialTimeout() time.Duration {
 	if p.DialTimeout > 0 {
 		return p.DialTimeout
@@ -238,6 +319,36 @@ func (p *ChannelProxy) dialTimeout() time.Duration {
 	return 30 * time.Second
 }
 
+// ParseAllowList - Parse a list of ips and/or cidrs, single ips only match themselves
+func ParseAllowList(allow []string) ([]*net.IPNet, error) {
+	allowNets := []*net.IPNet{}
+	for _, value := range allow {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, errors.New("{{if .Config.Debug}}invalid ip address{{end}}")
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip = ip.To4()
+				bits = 8 * net.IPv4len
+			}
+			allowNets = append(allowNets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, allowNet, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, err
+		}
+		allowNets = append(allowNets, allowNet)
+	}
+	return allowNets, nil
+}
+
 func nextPortfwdID() int {
 	portfwdID++
 	return portfwdID
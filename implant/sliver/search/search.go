@@ -0,0 +1,337 @@
+package search
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	defaultMaxResults  = 1000
+	defaultMaxFileSize = 10 * 1024 * 1024
+	defaultMaxLines    = 5
+
+	maxLineLength = 256
+	binaryProbe   = 8 * 1024
+
+	// Matches are streamed in batches, whichever is reached first
+	batchSize     = 32
+	batchInterval = 2 * time.Second
+)
+
+var (
+	// ErrBadGlob - The glob is not a valid pattern
+	ErrBadGlob = errors.New("invalid glob pattern")
+
+	errStop = errors.New("stop")
+)
+
+// StreamFunc - Sends a batch of matches while the search is running, returns false if
+// the matches could not be sent, they are returned with the results instead
+type StreamFunc func([]*sliverpb.SearchMatch) bool
+
+type filters struct {
+	glob         string
+	nameRegex    *regexp.Regexp
+	contentRegex *regexp.Regexp
+	ignoreCase   bool
+	exclude      []string
+}
+
+// Search - Walk the tree at req.Path and return the matching files, matches are passed to
+// stream in batches if it isn't nil. The search stops early when ctx is done.
+func Search(ctx context.Context, req *sliverpb.SearchReq, stream StreamFunc) (*sliverpb.Search, error) {
+	search, err := compile(req)
+	if err != nil {
+		return nil, err
+	}
+	root := req.Path
+	if root == "" {
+		root = "."
+	}
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(root); err != nil {
+		return nil, err
+	}
+	maxResults := req.MaxResults
+	if maxResults == 0 {
+		maxResults = defaultMaxResults
+	}
+
+	result := &sliverpb.Search{Path: root}
+	pending := []*sliverpb.SearchMatch{}
+	lastFlush := time.Now()
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		if stream != nil && stream(pending) {
+			result.Streamed += uint32(len(pending))
+		} else {
+			result.Matches = append(result.Matches, pending...)
+		}
+		pending = []*sliverpb.SearchMatch{}
+		lastFlush = time.Now()
+	}
+
+	err = filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if ctx.Err() != nil {
+			return errStop
+		}
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[search] %s", err)
+			// {{end}}
+			result.Errors++
+			return nil
+		}
+		if path != root && search.excluded(entry.Name()) {
+			if entry.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		var walkErr error
+		if entry.IsDir() {
+			if path == root {
+				return nil
+			}
+			if 0 < req.MaxDepth && depth(root, path) >= int(req.MaxDepth) {
+				walkErr = filepath.SkipDir
+			}
+			if !req.Dirs || search.contentRegex != nil {
+				return walkErr
+			}
+		} else if !entry.Type().IsRegular() {
+			return nil
+		} else {
+			result.Scanned++
+		}
+
+		match, err := search.match(req, path, entry)
+		if err != nil {
+			result.Errors++
+			return walkErr
+		}
+		if match == nil {
+			return walkErr
+		}
+		pending = append(pending, match)
+		if uint32(len(result.Matches)+len(pending))+result.Streamed >= maxResults {
+			result.Truncated = true
+			return errStop
+		}
+		if stream != nil && (batchSize <= len(pending) || batchInterval < time.Since(lastFlush)) {
+			flush()
+		}
+		return walkErr
+	})
+	flush()
+	if err == errStop {
+		result.Truncated = true
+		err = nil
+	}
+	return result, err
+}
+
+func compile(req *sliverpb.SearchReq) (*filters, error) {
+	var err error
+	search := &filters{
+		glob:       req.Glob,
+		ignoreCase: req.IgnoreCase,
+		exclude:    req.Exclude,
+	}
+	prefix := ""
+	if req.IgnoreCase {
+		prefix = "(?i)"
+		search.glob = strings.ToLower(search.glob)
+	}
+	if _, err := filepath.Match(search.glob, ""); err != nil {
+		return nil, ErrBadGlob
+	}
+	if req.NameRegex != "" {
+		search.nameRegex, err = regexp.Compile(prefix + req.NameRegex)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.ContentRegex != "" {
+		search.contentRegex, err = regexp.Compile(prefix + req.ContentRegex)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return search, nil
+}
+
+func (f *filters) excluded(name string) bool {
+	for _, pattern := range f.exclude {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filters) matchName(name string) bool {
+	if f.glob != "" {
+		if f.ignoreCase {
+			name = strings.ToLower(name)
+		}
+		if matched, _ := filepath.Match(f.glob, name); !matched {
+			return false
+		}
+	}
+	return f.nameRegex == nil || f.nameRegex.MatchString(name)
+}
+
+// match - Returns nil if the entry doesn't match the request
+func (f *filters) match(req *sliverpb.SearchReq, path string, entry fs.DirEntry) (*sliverpb.SearchMatch, error) {
+	if !f.matchName(entry.Name()) {
+		return nil, nil
+	}
+	info, err := entry.Info()
+	if err != nil {
+		return nil, err
+	}
+	if !entry.IsDir() {
+		if info.Size() < req.MinSize || (0 < req.MaxSize && req.MaxSize < info.Size()) {
+			return nil, nil
+		}
+	}
+	modTime := info.ModTime().Unix()
+	if (0 < req.ModifiedAfter && modTime < req.ModifiedAfter) || (0 < req.ModifiedBefore && req.ModifiedBefore < modTime) {
+		return nil, nil
+	}
+	match := &sliverpb.SearchMatch{
+		Path:    path,
+		IsDir:   entry.IsDir(),
+		Size:    info.Size(),
+		ModTime: modTime,
+		Mode:    info.Mode().String(),
+	}
+	if f.contentRegex == nil {
+		return match, nil
+	}
+
+	maxFileSize := req.MaxFileSize
+	if maxFileSize == 0 {
+		maxFileSize = defaultMaxFileSize
+	}
+	if maxFileSize < info.Size() {
+		return nil, nil
+	}
+	maxLines := req.MaxLines
+	if maxLines == 0 {
+		maxLines = defaultMaxLines
+	}
+	match.Lines, err = grep(path, f.contentRegex, maxFileSize, int(maxLines), req.Binary)
+	if err != nil || len(match.Lines) == 0 {
+		return nil, err
+	}
+	return match, nil
+}
+
+// grep - Returns up to maxLines lines of the file that match the regex, binary
+// files (files with a NUL byte near the start) are skipped unless binary is set
+func grep(path string, regex *regexp.Regexp, maxFileSize int64, maxLines int, binary bool) ([]*sliverpb.SearchLine, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxFileSize))
+	if err != nil {
+		return nil, err
+	}
+	if !binary && bytes.IndexByte(data[:min(len(data), binaryProbe)], 0) != -1 {
+		return nil, nil
+	}
+
+	lines := []*sliverpb.SearchLine{}
+	number := 1
+	offset := 0 // start of line number
+	for _, loc := range regex.FindAllIndex(data, -1) {
+		if loc[0] < offset {
+			continue // another match on a line that we already have
+		}
+		number += bytes.Count(data[offset:loc[0]], []byte{'\n'})
+		start := bytes.LastIndexByte(data[:loc[0]], '\n') + 1
+		end := bytes.IndexByte(data[loc[0]:], '\n')
+		if end == -1 {
+			end = len(data)
+		} else {
+			end += loc[0]
+		}
+		lines = append(lines, &sliverpb.SearchLine{
+			Number: uint32(number),
+			Text:   lineText(data[start:end]),
+		})
+		if maxLines <= len(lines) || end == len(data) {
+			break
+		}
+		offset = end + 1
+		number++
+	}
+	return lines, nil
+}
+
+// lineText - The line as valid utf-8, long lines are truncated
+func lineText(line []byte) string {
+	line = bytes.TrimRight(line, "\r")
+	if maxLineLength < len(line) {
+		line = line[:maxLineLength]
+	}
+	return strings.ToValidUTF8(string(line), "?")
+}
+
+// depth - Number of directories between root and path, entries in root are at depth 1
+func depth(root string, path string) int {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		return 0
+	}
+	return strings.Count(rel, string(filepath.Separator)) + 1
+}
+
+func min(a int, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
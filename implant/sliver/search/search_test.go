@@ -0,0 +1,174 @@
+package search
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func testTree(t *testing.T) string {
+	root := t.TempDir()
+	files := map[string]string{
+		"notes.txt":                "nothing here\npassword=hunter2\nmore\nPASSWORD=other\n",
+		"a/config.ini":             "[db]\r\npassword = secret\r\n",
+		"a/b/deep.txt":             "password in a deep file",
+		"a/b/binary.bin":           "pass\x00word password",
+		"node_modules/skip.txt":    "password",
+		"a/empty.txt":              "",
+		"a/b/c/deeper/Report.TXT":  "quarterly numbers",
+		"a/b/c/deeper/report2.txt": "more numbers",
+	}
+	for name, contents := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func matchedNames(result *sliverpb.Search) map[string]*sliverpb.SearchMatch {
+	names := map[string]*sliverpb.SearchMatch{}
+	for _, match := range result.Matches {
+		names[filepath.Base(match.Path)] = match
+	}
+	return names
+}
+
+func TestSearchNames(t *testing.T) {
+	root := testTree(t)
+	result, err := Search(context.Background(), &sliverpb.SearchReq{Path: root, Glob: "*.txt", Exclude: []string{"node_modules"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := matchedNames(result)
+	for _, name := range []string{"notes.txt", "deep.txt", "empty.txt", "report2.txt"} {
+		if names[name] == nil {
+			t.Errorf("expected %s to match", name)
+		}
+	}
+	if names["skip.txt"] != nil || names["Report.TXT"] != nil || names["config.ini"] != nil {
+		t.Errorf("unexpected matches %v", names)
+	}
+
+	result, _ = Search(context.Background(), &sliverpb.SearchReq{Path: root, Glob: "report*.txt", IgnoreCase: true}, nil)
+	if len(result.Matches) != 2 {
+		t.Errorf("expected 2 case insensitive matches, got %d", len(result.Matches))
+	}
+	result, _ = Search(context.Background(), &sliverpb.SearchReq{Path: root, NameRegex: `\.txt$`, MaxDepth: 2}, nil)
+	names = matchedNames(result)
+	if names["notes.txt"] == nil || names["empty.txt"] == nil || names["deep.txt"] != nil {
+		t.Errorf("max depth not applied %v", names)
+	}
+	result, _ = Search(context.Background(), &sliverpb.SearchReq{Path: root, Glob: "*.txt", MinSize: 1, MaxSize: 20}, nil)
+	names = matchedNames(result)
+	if names["empty.txt"] != nil || names["notes.txt"] != nil || names["report2.txt"] == nil {
+		t.Errorf("size filters not applied %v", names)
+	}
+	result, _ = Search(context.Background(), &sliverpb.SearchReq{Path: root, Glob: "deeper", Dirs: true}, nil)
+	if len(result.Matches) != 1 || !result.Matches[0].IsDir {
+		t.Errorf("expected the directory to match, got %v", result.Matches)
+	}
+
+	if _, err := Search(context.Background(), &sliverpb.SearchReq{Path: root, Glob: "["}, nil); err != ErrBadGlob {
+		t.Errorf("expected bad glob error, got %v", err)
+	}
+	if _, err := Search(context.Background(), &sliverpb.SearchReq{Path: filepath.Join(root, "missing")}, nil); err == nil {
+		t.Error("expected an error for a missing path")
+	}
+}
+
+func TestSearchContents(t *testing.T) {
+	root := testTree(t)
+	result, err := Search(context.Background(), &sliverpb.SearchReq{Path: root, ContentRegex: "password", IgnoreCase: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := matchedNames(result)
+	notes := names["notes.txt"]
+	if notes == nil || len(notes.Lines) != 2 {
+		t.Fatalf("expected two lines from notes.txt, got %v", notes)
+	}
+	if notes.Lines[0].Number != 2 || notes.Lines[0].Text != "password=hunter2" || notes.Lines[1].Number != 4 {
+		t.Errorf("wrong lines %v", notes.Lines)
+	}
+	config := names["config.ini"]
+	if config == nil || config.Lines[0].Number != 2 || config.Lines[0].Text != "password = secret" {
+		t.Errorf("wrong lines from crlf file %v", config)
+	}
+	if names["binary.bin"] != nil || names["Report.TXT"] != nil {
+		t.Errorf("unexpected content matches %v", names)
+	}
+	if names["skip.txt"] == nil {
+		t.Error("expected a match without exclusions")
+	}
+
+	result, _ = Search(context.Background(), &sliverpb.SearchReq{Path: root, ContentRegex: "password", Binary: true, MaxLines: 1}, nil)
+	names = matchedNames(result)
+	if names["binary.bin"] == nil || len(names["notes.txt"].Lines) != 1 {
+		t.Errorf("binary or max lines not applied %v", names)
+	}
+	result, _ = Search(context.Background(), &sliverpb.SearchReq{Path: root, ContentRegex: "password", MaxFileSize: 30}, nil)
+	if names = matchedNames(result); names["notes.txt"] != nil || names["deep.txt"] == nil {
+		t.Errorf("max file size not applied %v", names)
+	}
+}
+
+func TestSearchStream(t *testing.T) {
+	root := t.TempDir()
+	for i := 0; i < batchSize*2+5; i++ {
+		os.WriteFile(filepath.Join(root, fmt.Sprintf("%d.log", i)), []byte{}, 0600)
+	}
+	batches := 0
+	streamed := 0
+	result, err := Search(context.Background(), &sliverpb.SearchReq{Path: root, Glob: "*.log"}, func(matches []*sliverpb.SearchMatch) bool {
+		batches++
+		streamed += len(matches)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batches != 3 || streamed != batchSize*2+5 || int(result.Streamed) != streamed || len(result.Matches) != 0 {
+		t.Errorf("expected 3 batches, got %d (%d streamed, %d returned)", batches, result.Streamed, len(result.Matches))
+	}
+
+	result, _ = Search(context.Background(), &sliverpb.SearchReq{Path: root, Glob: "*.log", MaxResults: 10}, func(matches []*sliverpb.SearchMatch) bool {
+		return false
+	})
+	if len(result.Matches) != 10 || result.Streamed != 0 || !result.Truncated {
+		t.Errorf("expected 10 returned matches, got %d (%d streamed, truncated %v)", len(result.Matches), result.Streamed, result.Truncated)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, _ = Search(ctx, &sliverpb.SearchReq{Path: root, Glob: "*.log"}, nil)
+	if len(result.Matches) != 0 || !result.Truncated {
+		t.Errorf("expected canceled search to stop, got %d matches", len(result.Matches))
+	}
+}
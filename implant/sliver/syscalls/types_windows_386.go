@@ -0,0 +1,54 @@
+package syscalls
+
+// FLOATING_SAVE_AREA - x87 registers of an x86 thread context
+type FLOATING_SAVE_AREA struct {
+	ControlWord   uint32
+	StatusWord    uint32
+	TagWord       uint32
+	ErrorOffset   uint32
+	ErrorSelector uint32
+	DataOffset    uint32
+	DataSelector  uint32
+	RegisterArea  [80]byte
+	Cr0NpxState   uint32
+}
+
+// CONTEXT - x86 thread context
+type CONTEXT struct {
+	ContextFlags uint32
+
+	Dr0 uint32
+	Dr1 uint32
+	Dr2 uint32
+	Dr3 uint32
+	Dr6 uint32
+	Dr7 uint32
+
+	FloatSave FLOATING_SAVE_AREA
+
+	SegGs uint32
+	SegFs uint32
+	SegEs uint32
+	SegDs uint32
+
+	Edi uint32
+	Esi uint32
+	Ebx uint32
+	Edx uint32
+	Ecx uint32
+	Eax uint32
+
+	Ebp    uint32
+	Eip    uint32
+	SegCs  uint32
+	EFlags uint32
+	Esp    uint32
+	SegSs  uint32
+
+	ExtendedRegisters [512]byte
+}
+
+// NewContext - A zeroed CONTEXT with the given flags
+func NewContext(flags uint32) *CONTEXT {
+	return &CONTEXT{ContextFlags: flags}
+}
@@ -0,0 +1,76 @@
+package syscalls
+
+import "unsafe"
+
+const (
+	CONTEXT_AMD64                 = 0x00100000
+	CONTEXT_AMD64_CONTROL         = CONTEXT_AMD64 | 0x1  // SS:SP, CS:IP, FLAGS, BP
+	CONTEXT_AMD64_INTEGER         = CONTEXT_AMD64 | 0x2  // AX, BX, CX, DX, SI, DI, R8-R15
+	CONTEXT_AMD64_DEBUG_REGISTERS = CONTEXT_AMD64 | 0x10 // DR0-DR3, DR6, DR7
+)
+
+// CONTEXT - x64 thread context, must be 16 byte aligned (see NewContext)
+type CONTEXT struct {
+	P1Home uint64
+	P2Home uint64
+	P3Home uint64
+	P4Home uint64
+	P5Home uint64
+	P6Home uint64
+
+	ContextFlags uint32
+	MxCsr        uint32
+
+	SegCs  uint16
+	SegDs  uint16
+	SegEs  uint16
+	SegFs  uint16
+	SegGs  uint16
+	SegSs  uint16
+	EFlags uint32
+
+	Dr0 uint64
+	Dr1 uint64
+	Dr2 uint64
+	Dr3 uint64
+	Dr6 uint64
+	Dr7 uint64
+
+	Rax uint64
+	Rcx uint64
+	Rdx uint64
+	Rbx uint64
+	Rsp uint64
+	Rbp uint64
+	Rsi uint64
+	Rdi uint64
+	R8  uint64
+	R9  uint64
+	R10 uint64
+	R11 uint64
+	R12 uint64
+	R13 uint64
+	R14 uint64
+	R15 uint64
+
+	Rip uint64
+
+	FltSave        [512]byte
+	VectorRegister [26 * 16]byte
+	VectorControl  uint64
+
+	DebugControl         uint64
+	LastBranchToRip      uint64
+	LastBranchFromRip    uint64
+	LastExceptionToRip   uint64
+	LastExceptionFromRip uint64
+}
+
+// NewContext - A zeroed CONTEXT with the given flags at a 16 byte aligned address
+func NewContext(flags uint32) *CONTEXT {
+	buf := make([]byte, unsafe.Sizeof(CONTEXT{})+16)
+	offset := (16 - uintptr(unsafe.Pointer(&buf[0]))%16) % 16
+	ctx := (*CONTEXT)(unsafe.Pointer(&buf[offset]))
+	ctx.ContextFlags = flags
+	return ctx
+}
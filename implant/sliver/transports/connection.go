@@ -35,8 +35,9 @@ type Connection struct {
 	tunnels map[uint64]*Tunnel
 	mutex   *sync.RWMutex
 
-	uri      *url.URL
-	proxyURL *url.URL
+	uri            *url.URL
+	proxyURL       *url.URL
+	dnsDiagnostics *pb.DNSDiagnostics
 
 	Start Start
 	Stop  Stop
@@ -58,6 +59,11 @@ func (c *Connection) ProxyURL() string {
 	return c.proxyURL.String()
 }
 
+// DNSDiagnostics - Get the resolver report of a dns connection in diagnostic mode
+func (c *Connection) DNSDiagnostics() *pb.DNSDiagnostics {
+	return c.dnsDiagnostics
+}
+
 // Cleanup - Execute cleanup once
 func (c *Connection) Cleanup() {
 	c.once.Do(func() {
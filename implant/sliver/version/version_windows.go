@@ -1,4 +1,4 @@
-//go:build 386 || amd64
+//go:build 386 || amd64 || arm64
 
 package version
 
@@ -79,6 +79,8 @@ func getOSVersion() string {
 	var arch string
 	if runtime.GOARCH == "amd64" {
 		arch = "x86_64"
+	} else if runtime.GOARCH == "arm64" {
+		arch = "arm64"
 	} else {
 		var is64Bit bool
 		pHandle, _ := windows.GetCurrentProcess()
@@ -0,0 +1,39 @@
+//go:build !windows
+
+package watchdog
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"syscall"
+	"time"
+)
+
+// waitExit - The implant isn't our child, so poll until it's gone
+func waitExit(pid int) {
+	for syscall.Kill(pid, 0) != syscall.ESRCH {
+		time.Sleep(time.Second)
+	}
+}
+
+// sysProcAttr - Detach from the parent's session so the pair doesn't share a
+// process group that can be killed in one go
+func sysProcAttr() *syscall.SysProcAttr {
+	return &syscall.SysProcAttr{Setsid: true}
+}
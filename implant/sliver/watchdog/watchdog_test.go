@@ -0,0 +1,75 @@
+package watchdog
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRole(t *testing.T) {
+	tests := []struct {
+		value string
+		role  string
+		pid   int
+	}{
+		{"watchdog:1234", roleWatchdog, 1234},
+		{"implant:42", roleImplant, 42},
+		{"", "", 0},
+		{"watchdog", "", 0},
+		{"watchdog:", "", 0},
+		{"watchdog:-1", "", 0},
+		{"implant:abc", "", 0},
+		{"other:1234", "", 0},
+	}
+	for _, test := range tests {
+		role, pid := parseRole(test.value)
+		if role != test.role || pid != test.pid {
+			t.Errorf("parseRole(%q) = %q, %d expected %q, %d", test.value, role, pid, test.role, test.pid)
+		}
+	}
+}
+
+func TestParseDelay(t *testing.T) {
+	if delay := parseDelay("{{.Config.WatchdogDelay}}"); delay != defaultDelay {
+		t.Errorf("unrendered delay should be the default, got %s", delay)
+	}
+	if delay := parseDelay("-1"); delay != defaultDelay {
+		t.Errorf("negative delay should be the default, got %s", delay)
+	}
+	if delay := parseDelay("0"); delay != 0 {
+		t.Errorf("expected no delay, got %s", delay)
+	}
+	if delay := parseDelay("120000000000"); delay != 2*time.Minute {
+		t.Errorf("expected 2m delay, got %s", delay)
+	}
+}
+
+func TestRecentRestarts(t *testing.T) {
+	now := time.Now()
+	restarts := []time.Time{
+		now.Add(-restartWindow - time.Second),
+		now.Add(-restartWindow + time.Second),
+		now.Add(-time.Second),
+	}
+	recent := recentRestarts(restarts, now)
+	if len(recent) != 2 || !recent[0].Equal(restarts[1]) {
+		t.Errorf("expected the last 2 restarts, got %v", recent)
+	}
+}
@@ -8,6 +8,7 @@ package clientpb
 
 import (
 	commonpb "github.com/bishopfox/sliver/protobuf/commonpb"
+	sliverpb "github.com/bishopfox/sliver/protobuf/sliverpb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -179,6 +180,7 @@ const (
 	CredentialType_USER_PASSWORD CredentialType = 1
 	CredentialType_API_KEY       CredentialType = 2
 	CredentialType_FILE          CredentialType = 3
+	CredentialType_HASH          CredentialType = 4
 )
 
 // Enum value maps for CredentialType.
@@ -188,12 +190,14 @@ var (
 		1: "USER_PASSWORD",
 		2: "API_KEY",
 		3: "FILE",
+		4: "HASH",
 	}
 	CredentialType_value = map[string]int32{
 		"NO_CREDENTIAL": 0,
 		"USER_PASSWORD": 1,
 		"API_KEY":       2,
 		"FILE":          3,
+		"HASH":          4,
 	}
 )
 
@@ -453,9 +457,12 @@ type Session struct {
 	Burned            bool     `protobuf:"varint,22,opt,name=Burned,proto3" json:"Burned,omitempty"`
 	Extensions        []string `protobuf:"bytes,23,rep,name=Extensions,proto3" json:"Extensions,omitempty"`
 	// string ConfigID = 24;
-	PeerID       int64  `protobuf:"varint,25,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
-	Locale       string `protobuf:"bytes,26,opt,name=Locale,proto3" json:"Locale,omitempty"`
-	FirstContact int64  `protobuf:"varint,27,opt,name=FirstContact,proto3" json:"FirstContact,omitempty"`
+	PeerID         int64                         `protobuf:"varint,25,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	Locale         string                        `protobuf:"bytes,26,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	FirstContact   int64                         `protobuf:"varint,27,opt,name=FirstContact,proto3" json:"FirstContact,omitempty"`
+	DNSDiagnostics *sliverpb.DNSDiagnostics      `protobuf:"bytes,28,opt,name=DNSDiagnostics,proto3" json:"DNSDiagnostics,omitempty"`
+	Impersonating  string                        `protobuf:"bytes,29,opt,name=Impersonating,proto3" json:"Impersonating,omitempty"` // Owner of the token the implant is impersonating
+	Snapshot       *sliverpb.EnvironmentSnapshot `protobuf:"bytes,30,opt,name=Snapshot,proto3" json:"Snapshot,omitempty"`           // Reported on registration
 }
 
 func (x *Session) Reset() {
@@ -665,39 +672,64 @@ func (x *Session) GetFirstContact() int64 {
 	return 0
 }
 
+func (x *Session) GetDNSDiagnostics() *sliverpb.DNSDiagnostics {
+	if x != nil {
+		return x.DNSDiagnostics
+	}
+	return nil
+}
+
+func (x *Session) GetImpersonating() string {
+	if x != nil {
+		return x.Impersonating
+	}
+	return ""
+}
+
+func (x *Session) GetSnapshot() *sliverpb.EnvironmentSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
 type Beacon struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID                  string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name                string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Hostname            string `protobuf:"bytes,3,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	UUID                string `protobuf:"bytes,4,opt,name=UUID,proto3" json:"UUID,omitempty"`
-	Username            string `protobuf:"bytes,5,opt,name=Username,proto3" json:"Username,omitempty"`
-	UID                 string `protobuf:"bytes,6,opt,name=UID,proto3" json:"UID,omitempty"`
-	GID                 string `protobuf:"bytes,7,opt,name=GID,proto3" json:"GID,omitempty"`
-	OS                  string `protobuf:"bytes,8,opt,name=OS,proto3" json:"OS,omitempty"`
-	Arch                string `protobuf:"bytes,9,opt,name=Arch,proto3" json:"Arch,omitempty"`
-	Transport           string `protobuf:"bytes,10,opt,name=Transport,proto3" json:"Transport,omitempty"`
-	RemoteAddress       string `protobuf:"bytes,11,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
-	PID                 int32  `protobuf:"varint,12,opt,name=PID,proto3" json:"PID,omitempty"`
-	Filename            string `protobuf:"bytes,13,opt,name=Filename,proto3" json:"Filename,omitempty"` // Argv[0]
-	LastCheckin         int64  `protobuf:"varint,14,opt,name=LastCheckin,proto3" json:"LastCheckin,omitempty"`
-	ActiveC2            string `protobuf:"bytes,15,opt,name=ActiveC2,proto3" json:"ActiveC2,omitempty"`
-	Version             string `protobuf:"bytes,16,opt,name=Version,proto3" json:"Version,omitempty"`
-	Evasion             bool   `protobuf:"varint,17,opt,name=Evasion,proto3" json:"Evasion,omitempty"`
-	IsDead              bool   `protobuf:"varint,18,opt,name=IsDead,proto3" json:"IsDead,omitempty"`
-	ProxyURL            string `protobuf:"bytes,20,opt,name=ProxyURL,proto3" json:"ProxyURL,omitempty"`
-	ReconnectInterval   int64  `protobuf:"varint,21,opt,name=ReconnectInterval,proto3" json:"ReconnectInterval,omitempty"`
-	Interval            int64  `protobuf:"varint,22,opt,name=Interval,proto3" json:"Interval,omitempty"`
-	Jitter              int64  `protobuf:"varint,23,opt,name=Jitter,proto3" json:"Jitter,omitempty"`
-	Burned              bool   `protobuf:"varint,24,opt,name=Burned,proto3" json:"Burned,omitempty"`
-	NextCheckin         int64  `protobuf:"varint,25,opt,name=NextCheckin,proto3" json:"NextCheckin,omitempty"`
-	TasksCount          int64  `protobuf:"varint,26,opt,name=TasksCount,proto3" json:"TasksCount,omitempty"`
-	TasksCountCompleted int64  `protobuf:"varint,27,opt,name=TasksCountCompleted,proto3" json:"TasksCountCompleted,omitempty"`
-	Locale              string `protobuf:"bytes,28,opt,name=Locale,proto3" json:"Locale,omitempty"`
-	FirstContact        int64  `protobuf:"varint,29,opt,name=FirstContact,proto3" json:"FirstContact,omitempty"`
+	ID                  string                        `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                string                        `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Hostname            string                        `protobuf:"bytes,3,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	UUID                string                        `protobuf:"bytes,4,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Username            string                        `protobuf:"bytes,5,opt,name=Username,proto3" json:"Username,omitempty"`
+	UID                 string                        `protobuf:"bytes,6,opt,name=UID,proto3" json:"UID,omitempty"`
+	GID                 string                        `protobuf:"bytes,7,opt,name=GID,proto3" json:"GID,omitempty"`
+	OS                  string                        `protobuf:"bytes,8,opt,name=OS,proto3" json:"OS,omitempty"`
+	Arch                string                        `protobuf:"bytes,9,opt,name=Arch,proto3" json:"Arch,omitempty"`
+	Transport           string                        `protobuf:"bytes,10,opt,name=Transport,proto3" json:"Transport,omitempty"`
+	RemoteAddress       string                        `protobuf:"bytes,11,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	PID                 int32                         `protobuf:"varint,12,opt,name=PID,proto3" json:"PID,omitempty"`
+	Filename            string                        `protobuf:"bytes,13,opt,name=Filename,proto3" json:"Filename,omitempty"` // Argv[0]
+	LastCheckin         int64                         `protobuf:"varint,14,opt,name=LastCheckin,proto3" json:"LastCheckin,omitempty"`
+	ActiveC2            string                        `protobuf:"bytes,15,opt,name=ActiveC2,proto3" json:"ActiveC2,omitempty"`
+	Version             string                        `protobuf:"bytes,16,opt,name=Version,proto3" json:"Version,omitempty"`
+	Evasion             bool                          `protobuf:"varint,17,opt,name=Evasion,proto3" json:"Evasion,omitempty"`
+	IsDead              bool                          `protobuf:"varint,18,opt,name=IsDead,proto3" json:"IsDead,omitempty"`
+	ProxyURL            string                        `protobuf:"bytes,20,opt,name=ProxyURL,proto3" json:"ProxyURL,omitempty"`
+	ReconnectInterval   int64                         `protobuf:"varint,21,opt,name=ReconnectInterval,proto3" json:"ReconnectInterval,omitempty"`
+	Interval            int64                         `protobuf:"varint,22,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	Jitter              int64                         `protobuf:"varint,23,opt,name=Jitter,proto3" json:"Jitter,omitempty"`
+	Burned              bool                          `protobuf:"varint,24,opt,name=Burned,proto3" json:"Burned,omitempty"`
+	NextCheckin         int64                         `protobuf:"varint,25,opt,name=NextCheckin,proto3" json:"NextCheckin,omitempty"`
+	TasksCount          int64                         `protobuf:"varint,26,opt,name=TasksCount,proto3" json:"TasksCount,omitempty"`
+	TasksCountCompleted int64                         `protobuf:"varint,27,opt,name=TasksCountCompleted,proto3" json:"TasksCountCompleted,omitempty"`
+	Locale              string                        `protobuf:"bytes,28,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	FirstContact        int64                         `protobuf:"varint,29,opt,name=FirstContact,proto3" json:"FirstContact,omitempty"`
+	Presence            *sliverpb.Presence            `protobuf:"bytes,30,opt,name=Presence,proto3" json:"Presence,omitempty"` // Last presence reported with a check-in
+	DNSDiagnostics      *sliverpb.DNSDiagnostics      `protobuf:"bytes,31,opt,name=DNSDiagnostics,proto3" json:"DNSDiagnostics,omitempty"`
+	Impersonating       string                        `protobuf:"bytes,32,opt,name=Impersonating,proto3" json:"Impersonating,omitempty"` // Owner of the token the implant is impersonating
+	Snapshot            *sliverpb.EnvironmentSnapshot `protobuf:"bytes,33,opt,name=Snapshot,proto3" json:"Snapshot,omitempty"`           // Reported on registration
 }
 
 func (x *Beacon) Reset() {
@@ -928,6 +960,34 @@ func (x *Beacon) GetFirstContact() int64 {
 	return 0
 }
 
+func (x *Beacon) GetPresence() *sliverpb.Presence {
+	if x != nil {
+		return x.Presence
+	}
+	return nil
+}
+
+func (x *Beacon) GetDNSDiagnostics() *sliverpb.DNSDiagnostics {
+	if x != nil {
+		return x.DNSDiagnostics
+	}
+	return nil
+}
+
+func (x *Beacon) GetImpersonating() string {
+	if x != nil {
+		return x.Impersonating
+	}
+	return ""
+}
+
+func (x *Beacon) GetSnapshot() *sliverpb.EnvironmentSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
 type Beacons struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -975,6 +1035,109 @@ func (x *Beacons) GetBeacons() []*Beacon {
 	return nil
 }
 
+type BeaconAddressChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Beacon          *Beacon `protobuf:"bytes,1,opt,name=Beacon,proto3" json:"Beacon,omitempty"`
+	PreviousAddress string  `protobuf:"bytes,2,opt,name=PreviousAddress,proto3" json:"PreviousAddress,omitempty"`
+	PreviousASN     uint32  `protobuf:"varint,3,opt,name=PreviousASN,proto3" json:"PreviousASN,omitempty"`
+	PreviousCountry string  `protobuf:"bytes,4,opt,name=PreviousCountry,proto3" json:"PreviousCountry,omitempty"`
+	RemoteAddress   string  `protobuf:"bytes,5,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	ASN             uint32  `protobuf:"varint,6,opt,name=ASN,proto3" json:"ASN,omitempty"`
+	Country         string  `protobuf:"bytes,7,opt,name=Country,proto3" json:"Country,omitempty"`
+	Reason          string  `protobuf:"bytes,8,opt,name=Reason,proto3" json:"Reason,omitempty"`
+}
+
+func (x *BeaconAddressChange) Reset() {
+	*x = BeaconAddressChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconAddressChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconAddressChange) ProtoMessage() {}
+
+func (x *BeaconAddressChange) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconAddressChange.ProtoReflect.Descriptor instead.
+func (*BeaconAddressChange) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BeaconAddressChange) GetBeacon() *Beacon {
+	if x != nil {
+		return x.Beacon
+	}
+	return nil
+}
+
+func (x *BeaconAddressChange) GetPreviousAddress() string {
+	if x != nil {
+		return x.PreviousAddress
+	}
+	return ""
+}
+
+func (x *BeaconAddressChange) GetPreviousASN() uint32 {
+	if x != nil {
+		return x.PreviousASN
+	}
+	return 0
+}
+
+func (x *BeaconAddressChange) GetPreviousCountry() string {
+	if x != nil {
+		return x.PreviousCountry
+	}
+	return ""
+}
+
+func (x *BeaconAddressChange) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *BeaconAddressChange) GetASN() uint32 {
+	if x != nil {
+		return x.ASN
+	}
+	return 0
+}
+
+func (x *BeaconAddressChange) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *BeaconAddressChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type BeaconTask struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -989,12 +1152,14 @@ type BeaconTask struct {
 	Request     []byte `protobuf:"bytes,7,opt,name=Request,proto3" json:"Request,omitempty"`
 	Response    []byte `protobuf:"bytes,8,opt,name=Response,proto3" json:"Response,omitempty"`
 	Description string `protobuf:"bytes,9,opt,name=Description,proto3" json:"Description,omitempty"`
+	Timeout     int64  `protobuf:"varint,10,opt,name=Timeout,proto3" json:"Timeout,omitempty"`
+	Priority    int32  `protobuf:"varint,11,opt,name=Priority,proto3" json:"Priority,omitempty"` // Pending tasks with a higher priority are sent first
 }
 
 func (x *BeaconTask) Reset() {
 	*x = BeaconTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[4]
+		mi := &file_clientpb_client_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1007,7 +1172,7 @@ func (x *BeaconTask) String() string {
 func (*BeaconTask) ProtoMessage() {}
 
 func (x *BeaconTask) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[4]
+	mi := &file_clientpb_client_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1020,7 +1185,7 @@ func (x *BeaconTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeaconTask.ProtoReflect.Descriptor instead.
 func (*BeaconTask) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{4}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BeaconTask) GetID() string {
@@ -1086,6 +1251,76 @@ func (x *BeaconTask) GetDescription() string {
 	return ""
 }
 
+func (x *BeaconTask) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *BeaconTask) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+// BeaconTaskReorderReq - Send these pending tasks first, in this order
+type BeaconTaskReorderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BeaconID string   `protobuf:"bytes,1,opt,name=BeaconID,proto3" json:"BeaconID,omitempty"`
+	TaskIDs  []string `protobuf:"bytes,2,rep,name=TaskIDs,proto3" json:"TaskIDs,omitempty"`
+}
+
+func (x *BeaconTaskReorderReq) Reset() {
+	*x = BeaconTaskReorderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BeaconTaskReorderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeaconTaskReorderReq) ProtoMessage() {}
+
+func (x *BeaconTaskReorderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeaconTaskReorderReq.ProtoReflect.Descriptor instead.
+func (*BeaconTaskReorderReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BeaconTaskReorderReq) GetBeaconID() string {
+	if x != nil {
+		return x.BeaconID
+	}
+	return ""
+}
+
+func (x *BeaconTaskReorderReq) GetTaskIDs() []string {
+	if x != nil {
+		return x.TaskIDs
+	}
+	return nil
+}
+
 type BeaconTasks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1098,7 +1333,7 @@ type BeaconTasks struct {
 func (x *BeaconTasks) Reset() {
 	*x = BeaconTasks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[5]
+		mi := &file_clientpb_client_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1111,7 +1346,7 @@ func (x *BeaconTasks) String() string {
 func (*BeaconTasks) ProtoMessage() {}
 
 func (x *BeaconTasks) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[5]
+	mi := &file_clientpb_client_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,7 +1359,7 @@ func (x *BeaconTasks) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeaconTasks.ProtoReflect.Descriptor instead.
 func (*BeaconTasks) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{5}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BeaconTasks) GetBeaconID() string {
@@ -1154,7 +1389,7 @@ type ImplantC2 struct {
 func (x *ImplantC2) Reset() {
 	*x = ImplantC2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[6]
+		mi := &file_clientpb_client_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1167,7 +1402,7 @@ func (x *ImplantC2) String() string {
 func (*ImplantC2) ProtoMessage() {}
 
 func (x *ImplantC2) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[6]
+	mi := &file_clientpb_client_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,7 +1415,7 @@ func (x *ImplantC2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplantC2.ProtoReflect.Descriptor instead.
 func (*ImplantC2) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{6}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ImplantC2) GetPriority() uint32 {
@@ -1220,6 +1455,8 @@ type ImplantConfig struct {
 	Evasion                 bool   `protobuf:"varint,9,opt,name=Evasion,proto3" json:"Evasion,omitempty"`
 	ObfuscateSymbols        bool   `protobuf:"varint,10,opt,name=ObfuscateSymbols,proto3" json:"ObfuscateSymbols,omitempty"`
 	TemplateName            string `protobuf:"bytes,11,opt,name=TemplateName,proto3" json:"TemplateName,omitempty"`
+	QuietCheckin            bool   `protobuf:"varint,12,opt,name=QuietCheckin,proto3" json:"QuietCheckin,omitempty"` // Beacons only
+	SleepMask               string `protobuf:"bytes,13,opt,name=SleepMask,proto3" json:"SleepMask,omitempty"`        // Windows beacons only, see consts.SleepMasks
 	MtlsCACert              string `protobuf:"bytes,20,opt,name=MtlsCACert,proto3" json:"MtlsCACert,omitempty"`
 	MtlsCert                string `protobuf:"bytes,21,opt,name=MtlsCert,proto3" json:"MtlsCert,omitempty"`
 	MtlsKey                 string `protobuf:"bytes,22,opt,name=MtlsKey,proto3" json:"MtlsKey,omitempty"`
@@ -1236,29 +1473,94 @@ type ImplantConfig struct {
 	ReconnectInterval       int64  `protobuf:"varint,40,opt,name=ReconnectInterval,proto3" json:"ReconnectInterval,omitempty"`
 	MaxConnectionErrors     uint32 `protobuf:"varint,41,opt,name=MaxConnectionErrors,proto3" json:"MaxConnectionErrors,omitempty"`
 	PollTimeout             int64  `protobuf:"varint,42,opt,name=PollTimeout,proto3" json:"PollTimeout,omitempty"`
+	// registration retry policy
+	ConnectBackoff     string `protobuf:"bytes,43,opt,name=ConnectBackoff,proto3" json:"ConnectBackoff,omitempty"`
+	ConnectBackoffMax  int64  `protobuf:"varint,44,opt,name=ConnectBackoffMax,proto3" json:"ConnectBackoffMax,omitempty"`
+	ConnectJitter      int64  `protobuf:"varint,45,opt,name=ConnectJitter,proto3" json:"ConnectJitter,omitempty"`
+	ConnectMaxAttempts uint32 `protobuf:"varint,46,opt,name=ConnectMaxAttempts,proto3" json:"ConnectMaxAttempts,omitempty"`
+	ConnectGiveUp      int64  `protobuf:"varint,47,opt,name=ConnectGiveUp,proto3" json:"ConnectGiveUp,omitempty"`
 	// c2
-	C2                 []*ImplantC2 `protobuf:"bytes,50,rep,name=C2,proto3" json:"C2,omitempty"`
-	CanaryDomains      []string     `protobuf:"bytes,51,rep,name=CanaryDomains,proto3" json:"CanaryDomains,omitempty"`
-	ConnectionStrategy string       `protobuf:"bytes,52,opt,name=ConnectionStrategy,proto3" json:"ConnectionStrategy,omitempty"`
-	LimitDomainJoined  bool         `protobuf:"varint,60,opt,name=LimitDomainJoined,proto3" json:"LimitDomainJoined,omitempty"`
-	LimitDatetime      string       `protobuf:"bytes,61,opt,name=LimitDatetime,proto3" json:"LimitDatetime,omitempty"`
-	LimitHostname      string       `protobuf:"bytes,62,opt,name=LimitHostname,proto3" json:"LimitHostname,omitempty"`
-	LimitUsername      string       `protobuf:"bytes,63,opt,name=LimitUsername,proto3" json:"LimitUsername,omitempty"`
-	LimitFileExists    string       `protobuf:"bytes,64,opt,name=LimitFileExists,proto3" json:"LimitFileExists,omitempty"`
-	LimitLocale        string       `protobuf:"bytes,65,opt,name=LimitLocale,proto3" json:"LimitLocale,omitempty"`
-	Format             OutputFormat `protobuf:"varint,100,opt,name=Format,proto3,enum=clientpb.OutputFormat" json:"Format,omitempty"`
-	IsSharedLib        bool         `protobuf:"varint,101,opt,name=IsSharedLib,proto3" json:"IsSharedLib,omitempty"`
-	FileName           string       `protobuf:"bytes,102,opt,name=FileName,proto3" json:"FileName,omitempty"`
-	IsService          bool         `protobuf:"varint,103,opt,name=IsService,proto3" json:"IsService,omitempty"`
-	IsShellcode        bool         `protobuf:"varint,104,opt,name=IsShellcode,proto3" json:"IsShellcode,omitempty"`
-	RunAtLoad          bool         `protobuf:"varint,105,opt,name=RunAtLoad,proto3" json:"RunAtLoad,omitempty"`
-	DebugFile          string       `protobuf:"bytes,106,opt,name=DebugFile,proto3" json:"DebugFile,omitempty"`
+	C2                   []*ImplantC2 `protobuf:"bytes,50,rep,name=C2,proto3" json:"C2,omitempty"`
+	CanaryDomains        []string     `protobuf:"bytes,51,rep,name=CanaryDomains,proto3" json:"CanaryDomains,omitempty"`
+	ConnectionStrategy   string       `protobuf:"bytes,52,opt,name=ConnectionStrategy,proto3" json:"ConnectionStrategy,omitempty"`
+	ConnectFallbackOrder string       `protobuf:"bytes,53,opt,name=ConnectFallbackOrder,proto3" json:"ConnectFallbackOrder,omitempty"`
+	HTTPC2Profile        string       `protobuf:"bytes,54,opt,name=HTTPC2Profile,proto3" json:"HTTPC2Profile,omitempty"` // Named http c2 profile, empty for the default
+	FailoverOrder        string       `protobuf:"bytes,55,opt,name=FailoverOrder,proto3" json:"FailoverOrder,omitempty"` // Protocols in failover order, e.g. mtls,https,dns
+	FailoverThreshold    uint32       `protobuf:"varint,56,opt,name=FailoverThreshold,proto3" json:"FailoverThreshold,omitempty"`
+	FailoverRetry        int64        `protobuf:"varint,57,opt,name=FailoverRetry,proto3" json:"FailoverRetry,omitempty"`
+	DNSResolverAllow     string       `protobuf:"bytes,58,opt,name=DNSResolverAllow,proto3" json:"DNSResolverAllow,omitempty"` // Resolver IPs/CIDRs the dns c2 may use, empty for any
+	DNSResolverDeny      string       `protobuf:"bytes,59,opt,name=DNSResolverDeny,proto3" json:"DNSResolverDeny,omitempty"`   // Resolver IPs/CIDRs the dns c2 must never use
+	LimitDomainJoined    bool         `protobuf:"varint,60,opt,name=LimitDomainJoined,proto3" json:"LimitDomainJoined,omitempty"`
+	LimitDatetime        string       `protobuf:"bytes,61,opt,name=LimitDatetime,proto3" json:"LimitDatetime,omitempty"`
+	LimitHostname        string       `protobuf:"bytes,62,opt,name=LimitHostname,proto3" json:"LimitHostname,omitempty"`
+	LimitUsername        string       `protobuf:"bytes,63,opt,name=LimitUsername,proto3" json:"LimitUsername,omitempty"`
+	LimitFileExists      string       `protobuf:"bytes,64,opt,name=LimitFileExists,proto3" json:"LimitFileExists,omitempty"`
+	LimitLocale          string       `protobuf:"bytes,65,opt,name=LimitLocale,proto3" json:"LimitLocale,omitempty"`
+	WorkingHours         string       `protobuf:"bytes,66,opt,name=WorkingHours,proto3" json:"WorkingHours,omitempty"` // e.g. "Mon-Fri 08:00-18:00", target's local time
+	KillDate             string       `protobuf:"bytes,67,opt,name=KillDate,proto3" json:"KillDate,omitempty"`         // RFC3339
+	// Environment keying, the c2s are encrypted with a key derived from the
+	// target's domain, network and a file name so the binary is inert elsewhere
+	KeyDomain   string       `protobuf:"bytes,68,opt,name=KeyDomain,proto3" json:"KeyDomain,omitempty"`
+	KeyHostname string       `protobuf:"bytes,69,opt,name=KeyHostname,proto3" json:"KeyHostname,omitempty"` // Regex the hostname must match, not key material
+	KeyCIDR     string       `protobuf:"bytes,70,opt,name=KeyCIDR,proto3" json:"KeyCIDR,omitempty"`
+	KeyFile     string       `protobuf:"bytes,71,opt,name=KeyFile,proto3" json:"KeyFile,omitempty"`
+	Format      OutputFormat `protobuf:"varint,100,opt,name=Format,proto3,enum=clientpb.OutputFormat" json:"Format,omitempty"`
+	IsSharedLib bool         `protobuf:"varint,101,opt,name=IsSharedLib,proto3" json:"IsSharedLib,omitempty"`
+	FileName    string       `protobuf:"bytes,102,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	IsService   bool         `protobuf:"varint,103,opt,name=IsService,proto3" json:"IsService,omitempty"`
+	IsShellcode bool         `protobuf:"varint,104,opt,name=IsShellcode,proto3" json:"IsShellcode,omitempty"`
+	RunAtLoad   bool         `protobuf:"varint,105,opt,name=RunAtLoad,proto3" json:"RunAtLoad,omitempty"`
+	DebugFile   string       `protobuf:"bytes,106,opt,name=DebugFile,proto3" json:"DebugFile,omitempty"`
+	// batch generation, the delivery target the build was made for
+	BatchName   string `protobuf:"bytes,107,opt,name=BatchName,proto3" json:"BatchName,omitempty"`
+	BatchTarget string `protobuf:"bytes,108,opt,name=BatchTarget,proto3" json:"BatchTarget,omitempty"`
+	// Residual indicator strings allowed in the binary, 0 for no limit
+	IndicatorThreshold uint32 `protobuf:"varint,109,opt,name=IndicatorThreshold,proto3" json:"IndicatorThreshold,omitempty"`
+	// Paired watchdog process that restarts the implant if it's killed (executables only)
+	Watchdog      bool  `protobuf:"varint,110,opt,name=Watchdog,proto3" json:"Watchdog,omitempty"`
+	WatchdogDelay int64 `protobuf:"varint,111,opt,name=WatchdogDelay,proto3" json:"WatchdogDelay,omitempty"`
+	// Default process injection technique of tasks that don't choose one
+	InjectionTechnique string `protobuf:"bytes,112,opt,name=InjectionTechnique,proto3" json:"InjectionTechnique,omitempty"`
+	// Debug session wired to a console on stdin over an in-process loopback
+	// connection instead of a c2, handlers can be run without a server
+	IsHarness bool `protobuf:"varint,113,opt,name=IsHarness,proto3" json:"IsHarness,omitempty"`
+	// Include an environment snapshot (domain, interfaces, proxy, logged on
+	// users, processes) in the registration so new implants arrive triaged
+	StartupSnapshot bool `protobuf:"varint,114,opt,name=StartupSnapshot,proto3" json:"StartupSnapshot,omitempty"`
+	// Disable AMSI and ETW before loading the CLR or a BOF, even if the task
+	// doesn't ask for it (Windows only), see consts.BypassTechniques
+	AmsiBypass      bool   `protobuf:"varint,115,opt,name=AmsiBypass,proto3" json:"AmsiBypass,omitempty"`
+	EtwBypass       bool   `protobuf:"varint,116,opt,name=EtwBypass,proto3" json:"EtwBypass,omitempty"`
+	BypassTechnique string `protobuf:"bytes,117,opt,name=BypassTechnique,proto3" json:"BypassTechnique,omitempty"`
+	// Make the nt syscalls behind memory allocation, thread creation and
+	// process open directly (Windows amd64 only), see consts.SyscallBackends
+	SyscallBackend string `protobuf:"bytes,118,opt,name=SyscallBackend,proto3" json:"SyscallBackend,omitempty"`
+	// Shellcode is made with donut from an exe by default, or with sRDI from a
+	// dll, see consts.ShellcodeLoaders
+	ShellcodeLoader      string `protobuf:"bytes,119,opt,name=ShellcodeLoader,proto3" json:"ShellcodeLoader,omitempty"`
+	SRDIFunction         string `protobuf:"bytes,120,opt,name=SRDIFunction,proto3" json:"SRDIFunction,omitempty"`
+	SRDIKeepHeader       bool   `protobuf:"varint,121,opt,name=SRDIKeepHeader,proto3" json:"SRDIKeepHeader,omitempty"`
+	SRDIObfuscateImports bool   `protobuf:"varint,122,opt,name=SRDIObfuscateImports,proto3" json:"SRDIObfuscateImports,omitempty"`
+	SRDIImportDelay      uint32 `protobuf:"varint,123,opt,name=SRDIImportDelay,proto3" json:"SRDIImportDelay,omitempty"`
+	SRDIHashKey          uint32 `protobuf:"varint,124,opt,name=SRDIHashKey,proto3" json:"SRDIHashKey,omitempty"`
+	// Shellcode run before the implant's shellcode
+	ShellcodePrepend []byte `protobuf:"bytes,125,opt,name=ShellcodePrepend,proto3" json:"ShellcodePrepend,omitempty"`
+	ShellcodeAppend  []byte `protobuf:"bytes,126,opt,name=ShellcodeAppend,proto3" json:"ShellcodeAppend,omitempty"`
+	// Windows dlls forward the exports of ProxyDLL to ProxyTarget, so the implant
+	// can be sideloaded in place of the proxied dll
+	ProxyDLL    []byte `protobuf:"bytes,127,opt,name=ProxyDLL,proto3" json:"ProxyDLL,omitempty"`
+	ProxyTarget string `protobuf:"bytes,128,opt,name=ProxyTarget,proto3" json:"ProxyTarget,omitempty"`
+	// Garble's literal and tiny modes are on by default, the seed determines the
+	// obfuscated names so a build can be reproduced
+	ObfuscateSkipLiterals bool   `protobuf:"varint,129,opt,name=ObfuscateSkipLiterals,proto3" json:"ObfuscateSkipLiterals,omitempty"`
+	ObfuscateSkipTiny     bool   `protobuf:"varint,130,opt,name=ObfuscateSkipTiny,proto3" json:"ObfuscateSkipTiny,omitempty"`
+	ObfuscationSeed       string `protobuf:"bytes,131,opt,name=ObfuscationSeed,proto3" json:"ObfuscationSeed,omitempty"`
 }
 
 func (x *ImplantConfig) Reset() {
 	*x = ImplantConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[7]
+		mi := &file_clientpb_client_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1271,7 +1573,7 @@ func (x *ImplantConfig) String() string {
 func (*ImplantConfig) ProtoMessage() {}
 
 func (x *ImplantConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[7]
+	mi := &file_clientpb_client_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +1586,7 @@ func (x *ImplantConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplantConfig.ProtoReflect.Descriptor instead.
 func (*ImplantConfig) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{7}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ImplantConfig) GetID() string {
@@ -1364,6 +1666,20 @@ func (x *ImplantConfig) GetTemplateName() string {
 	return ""
 }
 
+func (x *ImplantConfig) GetQuietCheckin() bool {
+	if x != nil {
+		return x.QuietCheckin
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetSleepMask() string {
+	if x != nil {
+		return x.SleepMask
+	}
+	return ""
+}
+
 func (x *ImplantConfig) GetMtlsCACert() string {
 	if x != nil {
 		return x.MtlsCACert
@@ -1476,6 +1792,41 @@ func (x *ImplantConfig) GetPollTimeout() int64 {
 	return 0
 }
 
+func (x *ImplantConfig) GetConnectBackoff() string {
+	if x != nil {
+		return x.ConnectBackoff
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetConnectBackoffMax() int64 {
+	if x != nil {
+		return x.ConnectBackoffMax
+	}
+	return 0
+}
+
+func (x *ImplantConfig) GetConnectJitter() int64 {
+	if x != nil {
+		return x.ConnectJitter
+	}
+	return 0
+}
+
+func (x *ImplantConfig) GetConnectMaxAttempts() uint32 {
+	if x != nil {
+		return x.ConnectMaxAttempts
+	}
+	return 0
+}
+
+func (x *ImplantConfig) GetConnectGiveUp() int64 {
+	if x != nil {
+		return x.ConnectGiveUp
+	}
+	return 0
+}
+
 func (x *ImplantConfig) GetC2() []*ImplantC2 {
 	if x != nil {
 		return x.C2
@@ -1497,77 +1848,168 @@ func (x *ImplantConfig) GetConnectionStrategy() string {
 	return ""
 }
 
-func (x *ImplantConfig) GetLimitDomainJoined() bool {
+func (x *ImplantConfig) GetConnectFallbackOrder() string {
 	if x != nil {
-		return x.LimitDomainJoined
+		return x.ConnectFallbackOrder
 	}
-	return false
+	return ""
 }
 
-func (x *ImplantConfig) GetLimitDatetime() string {
+func (x *ImplantConfig) GetHTTPC2Profile() string {
 	if x != nil {
-		return x.LimitDatetime
+		return x.HTTPC2Profile
 	}
 	return ""
 }
 
-func (x *ImplantConfig) GetLimitHostname() string {
+func (x *ImplantConfig) GetFailoverOrder() string {
 	if x != nil {
-		return x.LimitHostname
+		return x.FailoverOrder
 	}
 	return ""
 }
 
-func (x *ImplantConfig) GetLimitUsername() string {
+func (x *ImplantConfig) GetFailoverThreshold() uint32 {
 	if x != nil {
-		return x.LimitUsername
+		return x.FailoverThreshold
 	}
-	return ""
+	return 0
 }
 
-func (x *ImplantConfig) GetLimitFileExists() string {
+func (x *ImplantConfig) GetFailoverRetry() int64 {
 	if x != nil {
-		return x.LimitFileExists
+		return x.FailoverRetry
 	}
-	return ""
+	return 0
 }
 
-func (x *ImplantConfig) GetLimitLocale() string {
+func (x *ImplantConfig) GetDNSResolverAllow() string {
 	if x != nil {
-		return x.LimitLocale
+		return x.DNSResolverAllow
 	}
 	return ""
 }
 
-func (x *ImplantConfig) GetFormat() OutputFormat {
+func (x *ImplantConfig) GetDNSResolverDeny() string {
 	if x != nil {
-		return x.Format
+		return x.DNSResolverDeny
 	}
-	return OutputFormat_SHARED_LIB
+	return ""
 }
 
-func (x *ImplantConfig) GetIsSharedLib() bool {
+func (x *ImplantConfig) GetLimitDomainJoined() bool {
 	if x != nil {
-		return x.IsSharedLib
+		return x.LimitDomainJoined
 	}
 	return false
 }
 
-func (x *ImplantConfig) GetFileName() string {
+func (x *ImplantConfig) GetLimitDatetime() string {
 	if x != nil {
-		return x.FileName
+		return x.LimitDatetime
 	}
 	return ""
 }
 
-func (x *ImplantConfig) GetIsService() bool {
+func (x *ImplantConfig) GetLimitHostname() string {
 	if x != nil {
-		return x.IsService
+		return x.LimitHostname
 	}
-	return false
+	return ""
 }
 
-func (x *ImplantConfig) GetIsShellcode() bool {
+func (x *ImplantConfig) GetLimitUsername() string {
+	if x != nil {
+		return x.LimitUsername
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetLimitFileExists() string {
+	if x != nil {
+		return x.LimitFileExists
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetLimitLocale() string {
+	if x != nil {
+		return x.LimitLocale
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetWorkingHours() string {
+	if x != nil {
+		return x.WorkingHours
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetKillDate() string {
+	if x != nil {
+		return x.KillDate
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetKeyDomain() string {
+	if x != nil {
+		return x.KeyDomain
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetKeyHostname() string {
+	if x != nil {
+		return x.KeyHostname
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetKeyCIDR() string {
+	if x != nil {
+		return x.KeyCIDR
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetFormat() OutputFormat {
+	if x != nil {
+		return x.Format
+	}
+	return OutputFormat_SHARED_LIB
+}
+
+func (x *ImplantConfig) GetIsSharedLib() bool {
+	if x != nil {
+		return x.IsSharedLib
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetIsService() bool {
+	if x != nil {
+		return x.IsService
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetIsShellcode() bool {
 	if x != nil {
 		return x.IsShellcode
 	}
@@ -1588,6 +2030,181 @@ func (x *ImplantConfig) GetDebugFile() string {
 	return ""
 }
 
+func (x *ImplantConfig) GetBatchName() string {
+	if x != nil {
+		return x.BatchName
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetBatchTarget() string {
+	if x != nil {
+		return x.BatchTarget
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetIndicatorThreshold() uint32 {
+	if x != nil {
+		return x.IndicatorThreshold
+	}
+	return 0
+}
+
+func (x *ImplantConfig) GetWatchdog() bool {
+	if x != nil {
+		return x.Watchdog
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetWatchdogDelay() int64 {
+	if x != nil {
+		return x.WatchdogDelay
+	}
+	return 0
+}
+
+func (x *ImplantConfig) GetInjectionTechnique() string {
+	if x != nil {
+		return x.InjectionTechnique
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetIsHarness() bool {
+	if x != nil {
+		return x.IsHarness
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetStartupSnapshot() bool {
+	if x != nil {
+		return x.StartupSnapshot
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetAmsiBypass() bool {
+	if x != nil {
+		return x.AmsiBypass
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetEtwBypass() bool {
+	if x != nil {
+		return x.EtwBypass
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetBypassTechnique() string {
+	if x != nil {
+		return x.BypassTechnique
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetSyscallBackend() string {
+	if x != nil {
+		return x.SyscallBackend
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetShellcodeLoader() string {
+	if x != nil {
+		return x.ShellcodeLoader
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetSRDIFunction() string {
+	if x != nil {
+		return x.SRDIFunction
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetSRDIKeepHeader() bool {
+	if x != nil {
+		return x.SRDIKeepHeader
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetSRDIObfuscateImports() bool {
+	if x != nil {
+		return x.SRDIObfuscateImports
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetSRDIImportDelay() uint32 {
+	if x != nil {
+		return x.SRDIImportDelay
+	}
+	return 0
+}
+
+func (x *ImplantConfig) GetSRDIHashKey() uint32 {
+	if x != nil {
+		return x.SRDIHashKey
+	}
+	return 0
+}
+
+func (x *ImplantConfig) GetShellcodePrepend() []byte {
+	if x != nil {
+		return x.ShellcodePrepend
+	}
+	return nil
+}
+
+func (x *ImplantConfig) GetShellcodeAppend() []byte {
+	if x != nil {
+		return x.ShellcodeAppend
+	}
+	return nil
+}
+
+func (x *ImplantConfig) GetProxyDLL() []byte {
+	if x != nil {
+		return x.ProxyDLL
+	}
+	return nil
+}
+
+func (x *ImplantConfig) GetProxyTarget() string {
+	if x != nil {
+		return x.ProxyTarget
+	}
+	return ""
+}
+
+func (x *ImplantConfig) GetObfuscateSkipLiterals() bool {
+	if x != nil {
+		return x.ObfuscateSkipLiterals
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetObfuscateSkipTiny() bool {
+	if x != nil {
+		return x.ObfuscateSkipTiny
+	}
+	return false
+}
+
+func (x *ImplantConfig) GetObfuscationSeed() string {
+	if x != nil {
+		return x.ObfuscationSeed
+	}
+	return ""
+}
+
 type ExternalImplantConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1600,7 +2217,7 @@ type ExternalImplantConfig struct {
 func (x *ExternalImplantConfig) Reset() {
 	*x = ExternalImplantConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[8]
+		mi := &file_clientpb_client_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1613,7 +2230,7 @@ func (x *ExternalImplantConfig) String() string {
 func (*ExternalImplantConfig) ProtoMessage() {}
 
 func (x *ExternalImplantConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[8]
+	mi := &file_clientpb_client_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1626,7 +2243,7 @@ func (x *ExternalImplantConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalImplantConfig.ProtoReflect.Descriptor instead.
 func (*ExternalImplantConfig) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{8}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ExternalImplantConfig) GetConfig() *ImplantConfig {
@@ -1656,7 +2273,7 @@ type ExternalImplantBinary struct {
 func (x *ExternalImplantBinary) Reset() {
 	*x = ExternalImplantBinary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[9]
+		mi := &file_clientpb_client_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +2286,7 @@ func (x *ExternalImplantBinary) String() string {
 func (*ExternalImplantBinary) ProtoMessage() {}
 
 func (x *ExternalImplantBinary) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[9]
+	mi := &file_clientpb_client_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +2299,7 @@ func (x *ExternalImplantBinary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExternalImplantBinary.ProtoReflect.Descriptor instead.
 func (*ExternalImplantBinary) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{9}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ExternalImplantBinary) GetName() string {
@@ -1718,7 +2335,7 @@ type ImplantBuilds struct {
 func (x *ImplantBuilds) Reset() {
 	*x = ImplantBuilds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[10]
+		mi := &file_clientpb_client_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1731,7 +2348,7 @@ func (x *ImplantBuilds) String() string {
 func (*ImplantBuilds) ProtoMessage() {}
 
 func (x *ImplantBuilds) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[10]
+	mi := &file_clientpb_client_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,7 +2361,7 @@ func (x *ImplantBuilds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplantBuilds.ProtoReflect.Descriptor instead.
 func (*ImplantBuilds) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{10}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ImplantBuilds) GetConfigs() map[string]*ImplantConfig {
@@ -1767,7 +2384,7 @@ type CompilerTarget struct {
 func (x *CompilerTarget) Reset() {
 	*x = CompilerTarget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[11]
+		mi := &file_clientpb_client_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1780,7 +2397,7 @@ func (x *CompilerTarget) String() string {
 func (*CompilerTarget) ProtoMessage() {}
 
 func (x *CompilerTarget) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[11]
+	mi := &file_clientpb_client_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1793,7 +2410,7 @@ func (x *CompilerTarget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompilerTarget.ProtoReflect.Descriptor instead.
 func (*CompilerTarget) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{11}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CompilerTarget) GetGOOS() string {
@@ -1831,7 +2448,7 @@ type CrossCompiler struct {
 func (x *CrossCompiler) Reset() {
 	*x = CrossCompiler{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[12]
+		mi := &file_clientpb_client_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1844,7 +2461,7 @@ func (x *CrossCompiler) String() string {
 func (*CrossCompiler) ProtoMessage() {}
 
 func (x *CrossCompiler) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[12]
+	mi := &file_clientpb_client_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1857,7 +2474,7 @@ func (x *CrossCompiler) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CrossCompiler.ProtoReflect.Descriptor instead.
 func (*CrossCompiler) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{12}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CrossCompiler) GetTargetGOOS() string {
@@ -1903,7 +2520,7 @@ type Compiler struct {
 func (x *Compiler) Reset() {
 	*x = Compiler{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[13]
+		mi := &file_clientpb_client_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1916,7 +2533,7 @@ func (x *Compiler) String() string {
 func (*Compiler) ProtoMessage() {}
 
 func (x *Compiler) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[13]
+	mi := &file_clientpb_client_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,7 +2546,7 @@ func (x *Compiler) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Compiler.ProtoReflect.Descriptor instead.
 func (*Compiler) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{13}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Compiler) GetGOOS() string {
@@ -1978,7 +2595,7 @@ type DeleteReq struct {
 func (x *DeleteReq) Reset() {
 	*x = DeleteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[14]
+		mi := &file_clientpb_client_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1991,7 +2608,7 @@ func (x *DeleteReq) String() string {
 func (*DeleteReq) ProtoMessage() {}
 
 func (x *DeleteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[14]
+	mi := &file_clientpb_client_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2004,7 +2621,7 @@ func (x *DeleteReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteReq.ProtoReflect.Descriptor instead.
 func (*DeleteReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{14}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteReq) GetName() string {
@@ -2031,7 +2648,7 @@ type DNSCanary struct {
 func (x *DNSCanary) Reset() {
 	*x = DNSCanary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[15]
+		mi := &file_clientpb_client_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2044,7 +2661,7 @@ func (x *DNSCanary) String() string {
 func (*DNSCanary) ProtoMessage() {}
 
 func (x *DNSCanary) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[15]
+	mi := &file_clientpb_client_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2674,7 @@ func (x *DNSCanary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSCanary.ProtoReflect.Descriptor instead.
 func (*DNSCanary) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{15}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DNSCanary) GetImplantName() string {
@@ -2113,7 +2730,7 @@ type Canaries struct {
 func (x *Canaries) Reset() {
 	*x = Canaries{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[16]
+		mi := &file_clientpb_client_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2126,7 +2743,7 @@ func (x *Canaries) String() string {
 func (*Canaries) ProtoMessage() {}
 
 func (x *Canaries) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[16]
+	mi := &file_clientpb_client_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,7 +2756,7 @@ func (x *Canaries) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Canaries.ProtoReflect.Descriptor instead.
 func (*Canaries) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{16}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Canaries) GetCanaries() []*DNSCanary {
@@ -2161,7 +2778,7 @@ type UniqueWGIP struct {
 func (x *UniqueWGIP) Reset() {
 	*x = UniqueWGIP{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[17]
+		mi := &file_clientpb_client_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2174,7 +2791,7 @@ func (x *UniqueWGIP) String() string {
 func (*UniqueWGIP) ProtoMessage() {}
 
 func (x *UniqueWGIP) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[17]
+	mi := &file_clientpb_client_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2187,7 +2804,7 @@ func (x *UniqueWGIP) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniqueWGIP.ProtoReflect.Descriptor instead.
 func (*UniqueWGIP) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{17}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UniqueWGIP) GetIP() string {
@@ -2209,7 +2826,7 @@ type ImplantProfile struct {
 func (x *ImplantProfile) Reset() {
 	*x = ImplantProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[18]
+		mi := &file_clientpb_client_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2222,7 +2839,7 @@ func (x *ImplantProfile) String() string {
 func (*ImplantProfile) ProtoMessage() {}
 
 func (x *ImplantProfile) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[18]
+	mi := &file_clientpb_client_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2235,7 +2852,7 @@ func (x *ImplantProfile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplantProfile.ProtoReflect.Descriptor instead.
 func (*ImplantProfile) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{18}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ImplantProfile) GetName() string {
@@ -2263,7 +2880,7 @@ type ImplantProfiles struct {
 func (x *ImplantProfiles) Reset() {
 	*x = ImplantProfiles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[19]
+		mi := &file_clientpb_client_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2276,7 +2893,7 @@ func (x *ImplantProfiles) String() string {
 func (*ImplantProfiles) ProtoMessage() {}
 
 func (x *ImplantProfiles) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[19]
+	mi := &file_clientpb_client_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2289,7 +2906,7 @@ func (x *ImplantProfiles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImplantProfiles.ProtoReflect.Descriptor instead.
 func (*ImplantProfiles) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{19}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ImplantProfiles) GetProfiles() []*ImplantProfile {
@@ -2310,7 +2927,7 @@ type RegenerateReq struct {
 func (x *RegenerateReq) Reset() {
 	*x = RegenerateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[20]
+		mi := &file_clientpb_client_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2323,7 +2940,7 @@ func (x *RegenerateReq) String() string {
 func (*RegenerateReq) ProtoMessage() {}
 
 func (x *RegenerateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[20]
+	mi := &file_clientpb_client_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,7 +2953,7 @@ func (x *RegenerateReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegenerateReq.ProtoReflect.Descriptor instead.
 func (*RegenerateReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{20}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *RegenerateReq) GetImplantName() string {
@@ -2362,7 +2979,7 @@ type Job struct {
 func (x *Job) Reset() {
 	*x = Job{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[21]
+		mi := &file_clientpb_client_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2375,7 +2992,7 @@ func (x *Job) String() string {
 func (*Job) ProtoMessage() {}
 
 func (x *Job) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[21]
+	mi := &file_clientpb_client_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2388,7 +3005,7 @@ func (x *Job) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Job.ProtoReflect.Descriptor instead.
 func (*Job) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{21}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Job) GetID() uint32 {
@@ -2445,7 +3062,7 @@ type Jobs struct {
 func (x *Jobs) Reset() {
 	*x = Jobs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[22]
+		mi := &file_clientpb_client_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2458,7 +3075,7 @@ func (x *Jobs) String() string {
 func (*Jobs) ProtoMessage() {}
 
 func (x *Jobs) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[22]
+	mi := &file_clientpb_client_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2471,7 +3088,7 @@ func (x *Jobs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Jobs.ProtoReflect.Descriptor instead.
 func (*Jobs) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{22}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *Jobs) GetActive() []*Job {
@@ -2492,7 +3109,7 @@ type KillJobReq struct {
 func (x *KillJobReq) Reset() {
 	*x = KillJobReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[23]
+		mi := &file_clientpb_client_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2505,7 +3122,7 @@ func (x *KillJobReq) String() string {
 func (*KillJobReq) ProtoMessage() {}
 
 func (x *KillJobReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[23]
+	mi := &file_clientpb_client_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2518,7 +3135,7 @@ func (x *KillJobReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KillJobReq.ProtoReflect.Descriptor instead.
 func (*KillJobReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{23}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *KillJobReq) GetID() uint32 {
@@ -2540,7 +3157,7 @@ type KillJob struct {
 func (x *KillJob) Reset() {
 	*x = KillJob{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[24]
+		mi := &file_clientpb_client_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2553,7 +3170,7 @@ func (x *KillJob) String() string {
 func (*KillJob) ProtoMessage() {}
 
 func (x *KillJob) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[24]
+	mi := &file_clientpb_client_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2566,7 +3183,7 @@ func (x *KillJob) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KillJob.ProtoReflect.Descriptor instead.
 func (*KillJob) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{24}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *KillJob) GetID() uint32 {
@@ -2583,6 +3200,166 @@ func (x *KillJob) GetSuccess() bool {
 	return false
 }
 
+// Listener settings that can be changed while the job is running, persistent
+// jobs keep their settings in the database across restarts
+type ListenerSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID           uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`                        // Job name, http, https, or dns
+	LongPollTimeout int64  `protobuf:"varint,3,opt,name=LongPollTimeout,proto3" json:"LongPollTimeout,omitempty"` // http(s) only
+	LongPollJitter  int64  `protobuf:"varint,4,opt,name=LongPollJitter,proto3" json:"LongPollJitter,omitempty"`   // http(s) only
+	C2Profile       string `protobuf:"bytes,5,opt,name=C2Profile,proto3" json:"C2Profile,omitempty"`              // http(s) only, empty for the default
+	EnforceOTP      bool   `protobuf:"varint,6,opt,name=EnforceOTP,proto3" json:"EnforceOTP,omitempty"`
+	MaxNOPPadding   uint32 `protobuf:"varint,7,opt,name=MaxNOPPadding,proto3" json:"MaxNOPPadding,omitempty"` // dns only, largest padding a nop response may carry
+	TTL             uint32 `protobuf:"varint,8,opt,name=TTL,proto3" json:"TTL,omitempty"`                     // dns only
+	Persisted       bool   `protobuf:"varint,9,opt,name=Persisted,proto3" json:"Persisted,omitempty"`
+}
+
+func (x *ListenerSettings) Reset() {
+	*x = ListenerSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenerSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerSettings) ProtoMessage() {}
+
+func (x *ListenerSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerSettings.ProtoReflect.Descriptor instead.
+func (*ListenerSettings) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListenerSettings) GetJobID() uint32 {
+	if x != nil {
+		return x.JobID
+	}
+	return 0
+}
+
+func (x *ListenerSettings) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListenerSettings) GetLongPollTimeout() int64 {
+	if x != nil {
+		return x.LongPollTimeout
+	}
+	return 0
+}
+
+func (x *ListenerSettings) GetLongPollJitter() int64 {
+	if x != nil {
+		return x.LongPollJitter
+	}
+	return 0
+}
+
+func (x *ListenerSettings) GetC2Profile() string {
+	if x != nil {
+		return x.C2Profile
+	}
+	return ""
+}
+
+func (x *ListenerSettings) GetEnforceOTP() bool {
+	if x != nil {
+		return x.EnforceOTP
+	}
+	return false
+}
+
+func (x *ListenerSettings) GetMaxNOPPadding() uint32 {
+	if x != nil {
+		return x.MaxNOPPadding
+	}
+	return 0
+}
+
+func (x *ListenerSettings) GetTTL() uint32 {
+	if x != nil {
+		return x.TTL
+	}
+	return 0
+}
+
+func (x *ListenerSettings) GetPersisted() bool {
+	if x != nil {
+		return x.Persisted
+	}
+	return false
+}
+
+type ListenerSettingsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+}
+
+func (x *ListenerSettingsReq) Reset() {
+	*x = ListenerSettingsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListenerSettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListenerSettingsReq) ProtoMessage() {}
+
+func (x *ListenerSettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListenerSettingsReq.ProtoReflect.Descriptor instead.
+func (*ListenerSettingsReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ListenerSettingsReq) GetJobID() uint32 {
+	if x != nil {
+		return x.JobID
+	}
+	return 0
+}
+
 // [ Listeners ] ----------------------------------------
 type MTLSListenerReq struct {
 	state         protoimpl.MessageState
@@ -2597,7 +3374,7 @@ type MTLSListenerReq struct {
 func (x *MTLSListenerReq) Reset() {
 	*x = MTLSListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[25]
+		mi := &file_clientpb_client_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2610,7 +3387,7 @@ func (x *MTLSListenerReq) String() string {
 func (*MTLSListenerReq) ProtoMessage() {}
 
 func (x *MTLSListenerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[25]
+	mi := &file_clientpb_client_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,7 +3400,7 @@ func (x *MTLSListenerReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MTLSListenerReq.ProtoReflect.Descriptor instead.
 func (*MTLSListenerReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{25}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *MTLSListenerReq) GetHost() string {
@@ -2658,7 +3435,7 @@ type MTLSListener struct {
 func (x *MTLSListener) Reset() {
 	*x = MTLSListener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[26]
+		mi := &file_clientpb_client_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2671,7 +3448,7 @@ func (x *MTLSListener) String() string {
 func (*MTLSListener) ProtoMessage() {}
 
 func (x *MTLSListener) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[26]
+	mi := &file_clientpb_client_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +3461,7 @@ func (x *MTLSListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MTLSListener.ProtoReflect.Descriptor instead.
 func (*MTLSListener) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{26}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MTLSListener) GetJobID() uint32 {
@@ -2710,7 +3487,7 @@ type WGListenerReq struct {
 func (x *WGListenerReq) Reset() {
 	*x = WGListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[27]
+		mi := &file_clientpb_client_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2723,7 +3500,7 @@ func (x *WGListenerReq) String() string {
 func (*WGListenerReq) ProtoMessage() {}
 
 func (x *WGListenerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[27]
+	mi := &file_clientpb_client_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2736,7 +3513,7 @@ func (x *WGListenerReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WGListenerReq.ProtoReflect.Descriptor instead.
 func (*WGListenerReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{27}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *WGListenerReq) GetHost() string {
@@ -2792,7 +3569,7 @@ type WGListener struct {
 func (x *WGListener) Reset() {
 	*x = WGListener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[28]
+		mi := &file_clientpb_client_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2805,7 +3582,7 @@ func (x *WGListener) String() string {
 func (*WGListener) ProtoMessage() {}
 
 func (x *WGListener) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[28]
+	mi := &file_clientpb_client_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,7 +3595,7 @@ func (x *WGListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WGListener.ProtoReflect.Descriptor instead.
 func (*WGListener) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{28}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *WGListener) GetJobID() uint32 {
@@ -2833,18 +3610,19 @@ type DNSListenerReq struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domains    []string `protobuf:"bytes,1,rep,name=Domains,proto3" json:"Domains,omitempty"`
-	Canaries   bool     `protobuf:"varint,2,opt,name=Canaries,proto3" json:"Canaries,omitempty"`
-	Host       string   `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
-	Port       uint32   `protobuf:"varint,4,opt,name=Port,proto3" json:"Port,omitempty"`
-	Persistent bool     `protobuf:"varint,5,opt,name=Persistent,proto3" json:"Persistent,omitempty"`
-	EnforceOTP bool     `protobuf:"varint,6,opt,name=EnforceOTP,proto3" json:"EnforceOTP,omitempty"`
+	Domains      []string          `protobuf:"bytes,1,rep,name=Domains,proto3" json:"Domains,omitempty"`
+	Canaries     bool              `protobuf:"varint,2,opt,name=Canaries,proto3" json:"Canaries,omitempty"`
+	Host         string            `protobuf:"bytes,3,opt,name=Host,proto3" json:"Host,omitempty"`
+	Port         uint32            `protobuf:"varint,4,opt,name=Port,proto3" json:"Port,omitempty"`
+	Persistent   bool              `protobuf:"varint,5,opt,name=Persistent,proto3" json:"Persistent,omitempty"`
+	EnforceOTP   bool              `protobuf:"varint,6,opt,name=EnforceOTP,proto3" json:"EnforceOTP,omitempty"`
+	TXTEncodings map[string]string `protobuf:"bytes,7,rep,name=TXTEncodings,proto3" json:"TXTEncodings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Parent domain -> most efficient TXT encoding allowed
 }
 
 func (x *DNSListenerReq) Reset() {
 	*x = DNSListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[29]
+		mi := &file_clientpb_client_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2857,7 +3635,7 @@ func (x *DNSListenerReq) String() string {
 func (*DNSListenerReq) ProtoMessage() {}
 
 func (x *DNSListenerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[29]
+	mi := &file_clientpb_client_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2870,7 +3648,7 @@ func (x *DNSListenerReq) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSListenerReq.ProtoReflect.Descriptor instead.
 func (*DNSListenerReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{29}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *DNSListenerReq) GetDomains() []string {
@@ -2915,6 +3693,13 @@ func (x *DNSListenerReq) GetEnforceOTP() bool {
 	return false
 }
 
+func (x *DNSListenerReq) GetTXTEncodings() map[string]string {
+	if x != nil {
+		return x.TXTEncodings
+	}
+	return nil
+}
+
 type DNSListener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2926,7 +3711,7 @@ type DNSListener struct {
 func (x *DNSListener) Reset() {
 	*x = DNSListener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[30]
+		mi := &file_clientpb_client_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2939,7 +3724,7 @@ func (x *DNSListener) String() string {
 func (*DNSListener) ProtoMessage() {}
 
 func (x *DNSListener) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[30]
+	mi := &file_clientpb_client_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2952,7 +3737,7 @@ func (x *DNSListener) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DNSListener.ProtoReflect.Descriptor instead.
 func (*DNSListener) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{30}
+	return file_clientpb_client_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DNSListener) GetJobID() uint32 {
@@ -2962,43 +3747,32 @@ func (x *DNSListener) GetJobID() uint32 {
 	return 0
 }
 
-type HTTPListenerReq struct {
+type ICMPListenerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domain          string `protobuf:"bytes,1,opt,name=Domain,proto3" json:"Domain,omitempty"`
-	Host            string `protobuf:"bytes,2,opt,name=Host,proto3" json:"Host,omitempty"`
-	Port            uint32 `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
-	Secure          bool   `protobuf:"varint,4,opt,name=Secure,proto3" json:"Secure,omitempty"` // Enable HTTPS
-	Website         string `protobuf:"bytes,5,opt,name=Website,proto3" json:"Website,omitempty"`
-	Cert            []byte `protobuf:"bytes,6,opt,name=Cert,proto3" json:"Cert,omitempty"`
-	Key             []byte `protobuf:"bytes,7,opt,name=Key,proto3" json:"Key,omitempty"`
-	ACME            bool   `protobuf:"varint,8,opt,name=ACME,proto3" json:"ACME,omitempty"`
-	Persistent      bool   `protobuf:"varint,9,opt,name=Persistent,proto3" json:"Persistent,omitempty"`
-	EnforceOTP      bool   `protobuf:"varint,10,opt,name=EnforceOTP,proto3" json:"EnforceOTP,omitempty"`
-	LongPollTimeout int64  `protobuf:"varint,11,opt,name=LongPollTimeout,proto3" json:"LongPollTimeout,omitempty"`
-	LongPollJitter  int64  `protobuf:"varint,12,opt,name=LongPollJitter,proto3" json:"LongPollJitter,omitempty"`
-	RandomizeJARM   bool   `protobuf:"varint,13,opt,name=RandomizeJARM,proto3" json:"RandomizeJARM,omitempty"` // Only valid with Secure = true
+	Host       string `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"` // Address to receive echo requests on, empty for all
+	Persistent bool   `protobuf:"varint,2,opt,name=Persistent,proto3" json:"Persistent,omitempty"`
 }
 
-func (x *HTTPListenerReq) Reset() {
-	*x = HTTPListenerReq{}
+func (x *ICMPListenerReq) Reset() {
+	*x = ICMPListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[31]
+		mi := &file_clientpb_client_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HTTPListenerReq) String() string {
+func (x *ICMPListenerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HTTPListenerReq) ProtoMessage() {}
+func (*ICMPListenerReq) ProtoMessage() {}
 
-func (x *HTTPListenerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[31]
+func (x *ICMPListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,129 +3783,50 @@ func (x *HTTPListenerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HTTPListenerReq.ProtoReflect.Descriptor instead.
-func (*HTTPListenerReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{31}
-}
-
-func (x *HTTPListenerReq) GetDomain() string {
-	if x != nil {
-		return x.Domain
-	}
-	return ""
+// Deprecated: Use ICMPListenerReq.ProtoReflect.Descriptor instead.
+func (*ICMPListenerReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *HTTPListenerReq) GetHost() string {
+func (x *ICMPListenerReq) GetHost() string {
 	if x != nil {
 		return x.Host
 	}
 	return ""
 }
 
-func (x *HTTPListenerReq) GetPort() uint32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *HTTPListenerReq) GetSecure() bool {
+func (x *ICMPListenerReq) GetPersistent() bool {
 	if x != nil {
-		return x.Secure
+		return x.Persistent
 	}
 	return false
 }
 
-func (x *HTTPListenerReq) GetWebsite() string {
-	if x != nil {
-		return x.Website
-	}
-	return ""
-}
-
-func (x *HTTPListenerReq) GetCert() []byte {
-	if x != nil {
-		return x.Cert
-	}
-	return nil
-}
-
-func (x *HTTPListenerReq) GetKey() []byte {
-	if x != nil {
-		return x.Key
-	}
-	return nil
-}
-
-func (x *HTTPListenerReq) GetACME() bool {
-	if x != nil {
-		return x.ACME
-	}
-	return false
-}
-
-func (x *HTTPListenerReq) GetPersistent() bool {
-	if x != nil {
-		return x.Persistent
-	}
-	return false
-}
-
-func (x *HTTPListenerReq) GetEnforceOTP() bool {
-	if x != nil {
-		return x.EnforceOTP
-	}
-	return false
-}
-
-func (x *HTTPListenerReq) GetLongPollTimeout() int64 {
-	if x != nil {
-		return x.LongPollTimeout
-	}
-	return 0
-}
-
-func (x *HTTPListenerReq) GetLongPollJitter() int64 {
-	if x != nil {
-		return x.LongPollJitter
-	}
-	return 0
-}
-
-func (x *HTTPListenerReq) GetRandomizeJARM() bool {
-	if x != nil {
-		return x.RandomizeJARM
-	}
-	return false
-}
-
-// Named Pipes Messages for pivoting
-type NamedPipesReq struct {
+type ICMPListener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PipeName string            `protobuf:"bytes,16,opt,name=PipeName,proto3" json:"PipeName,omitempty"`
-	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
 }
 
-func (x *NamedPipesReq) Reset() {
-	*x = NamedPipesReq{}
+func (x *ICMPListener) Reset() {
+	*x = ICMPListener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[32]
+		mi := &file_clientpb_client_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NamedPipesReq) String() string {
+func (x *ICMPListener) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NamedPipesReq) ProtoMessage() {}
+func (*ICMPListener) ProtoMessage() {}
 
-func (x *NamedPipesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[32]
+func (x *ICMPListener) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3142,52 +3837,45 @@ func (x *NamedPipesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NamedPipesReq.ProtoReflect.Descriptor instead.
-func (*NamedPipesReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *NamedPipesReq) GetPipeName() string {
-	if x != nil {
-		return x.PipeName
-	}
-	return ""
+// Deprecated: Use ICMPListener.ProtoReflect.Descriptor instead.
+func (*ICMPListener) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *NamedPipesReq) GetRequest() *commonpb.Request {
+func (x *ICMPListener) GetJobID() uint32 {
 	if x != nil {
-		return x.Request
+		return x.JobID
 	}
-	return nil
+	return 0
 }
 
-type NamedPipes struct {
+type UDPListenerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success  bool               `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
-	Err      string             `protobuf:"bytes,2,opt,name=Err,proto3" json:"Err,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Host       string `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	Port       uint32 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	Persistent bool   `protobuf:"varint,3,opt,name=Persistent,proto3" json:"Persistent,omitempty"`
 }
 
-func (x *NamedPipes) Reset() {
-	*x = NamedPipes{}
+func (x *UDPListenerReq) Reset() {
+	*x = UDPListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[33]
+		mi := &file_clientpb_client_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NamedPipes) String() string {
+func (x *UDPListenerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NamedPipes) ProtoMessage() {}
+func (*UDPListenerReq) ProtoMessage() {}
 
-func (x *NamedPipes) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[33]
+func (x *UDPListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3198,59 +3886,57 @@ func (x *NamedPipes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NamedPipes.ProtoReflect.Descriptor instead.
-func (*NamedPipes) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UDPListenerReq.ProtoReflect.Descriptor instead.
+func (*UDPListenerReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *NamedPipes) GetSuccess() bool {
+func (x *UDPListenerReq) GetHost() string {
 	if x != nil {
-		return x.Success
+		return x.Host
 	}
-	return false
+	return ""
 }
 
-func (x *NamedPipes) GetErr() string {
+func (x *UDPListenerReq) GetPort() uint32 {
 	if x != nil {
-		return x.Err
+		return x.Port
 	}
-	return ""
+	return 0
 }
 
-func (x *NamedPipes) GetResponse() *commonpb.Response {
+func (x *UDPListenerReq) GetPersistent() bool {
 	if x != nil {
-		return x.Response
+		return x.Persistent
 	}
-	return nil
+	return false
 }
 
-// TCP Messages for pivoting
-type TCPPivotReq struct {
+type UDPListener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string            `protobuf:"bytes,16,opt,name=Address,proto3" json:"Address,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
 }
 
-func (x *TCPPivotReq) Reset() {
-	*x = TCPPivotReq{}
+func (x *UDPListener) Reset() {
+	*x = UDPListener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[34]
+		mi := &file_clientpb_client_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TCPPivotReq) String() string {
+func (x *UDPListener) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TCPPivotReq) ProtoMessage() {}
+func (*UDPListener) ProtoMessage() {}
 
-func (x *TCPPivotReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[34]
+func (x *UDPListener) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3261,52 +3947,58 @@ func (x *TCPPivotReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TCPPivotReq.ProtoReflect.Descriptor instead.
-func (*TCPPivotReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{34}
-}
-
-func (x *TCPPivotReq) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use UDPListener.ProtoReflect.Descriptor instead.
+func (*UDPListener) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *TCPPivotReq) GetRequest() *commonpb.Request {
+func (x *UDPListener) GetJobID() uint32 {
 	if x != nil {
-		return x.Request
+		return x.JobID
 	}
-	return nil
+	return 0
 }
 
-type TCPPivot struct {
+type HTTPListenerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success  bool               `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
-	Err      string             `protobuf:"bytes,2,opt,name=Err,proto3" json:"Err,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Domain          string `protobuf:"bytes,1,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	Host            string `protobuf:"bytes,2,opt,name=Host,proto3" json:"Host,omitempty"`
+	Port            uint32 `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	Secure          bool   `protobuf:"varint,4,opt,name=Secure,proto3" json:"Secure,omitempty"` // Enable HTTPS
+	Website         string `protobuf:"bytes,5,opt,name=Website,proto3" json:"Website,omitempty"`
+	Cert            []byte `protobuf:"bytes,6,opt,name=Cert,proto3" json:"Cert,omitempty"`
+	Key             []byte `protobuf:"bytes,7,opt,name=Key,proto3" json:"Key,omitempty"`
+	ACME            bool   `protobuf:"varint,8,opt,name=ACME,proto3" json:"ACME,omitempty"`
+	Persistent      bool   `protobuf:"varint,9,opt,name=Persistent,proto3" json:"Persistent,omitempty"`
+	EnforceOTP      bool   `protobuf:"varint,10,opt,name=EnforceOTP,proto3" json:"EnforceOTP,omitempty"`
+	LongPollTimeout int64  `protobuf:"varint,11,opt,name=LongPollTimeout,proto3" json:"LongPollTimeout,omitempty"`
+	LongPollJitter  int64  `protobuf:"varint,12,opt,name=LongPollJitter,proto3" json:"LongPollJitter,omitempty"`
+	RandomizeJARM   bool   `protobuf:"varint,13,opt,name=RandomizeJARM,proto3" json:"RandomizeJARM,omitempty"` // Only valid with Secure = true
+	C2Profile       string `protobuf:"bytes,14,opt,name=C2Profile,proto3" json:"C2Profile,omitempty"`          // Named http c2 profile, empty for the default
+	FrontDomain     string `protobuf:"bytes,15,opt,name=FrontDomain,proto3" json:"FrontDomain,omitempty"`      // Domain implants front through, e.g. a CDN
+	HTTP2           bool   `protobuf:"varint,16,opt,name=HTTP2,proto3" json:"HTTP2,omitempty"`                 // Negotiate HTTP/2, only valid with Secure = true
 }
 
-func (x *TCPPivot) Reset() {
-	*x = TCPPivot{}
+func (x *HTTPListenerReq) Reset() {
+	*x = HTTPListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[35]
+		mi := &file_clientpb_client_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TCPPivot) String() string {
+func (x *HTTPListenerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TCPPivot) ProtoMessage() {}
+func (*HTTPListenerReq) ProtoMessage() {}
 
-func (x *TCPPivot) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[35]
+func (x *HTTPListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3317,105 +4009,150 @@ func (x *TCPPivot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TCPPivot.ProtoReflect.Descriptor instead.
-func (*TCPPivot) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use HTTPListenerReq.ProtoReflect.Descriptor instead.
+func (*HTTPListenerReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TCPPivot) GetSuccess() bool {
+func (x *HTTPListenerReq) GetDomain() string {
 	if x != nil {
-		return x.Success
+		return x.Domain
 	}
-	return false
+	return ""
 }
 
-func (x *TCPPivot) GetErr() string {
+func (x *HTTPListenerReq) GetHost() string {
 	if x != nil {
-		return x.Err
+		return x.Host
 	}
 	return ""
 }
 
-func (x *TCPPivot) GetResponse() *commonpb.Response {
+func (x *HTTPListenerReq) GetPort() uint32 {
 	if x != nil {
-		return x.Response
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-type HTTPListener struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+func (x *HTTPListenerReq) GetSecure() bool {
+	if x != nil {
+		return x.Secure
+	}
+	return false
 }
 
-func (x *HTTPListener) Reset() {
-	*x = HTTPListener{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *HTTPListenerReq) GetWebsite() string {
+	if x != nil {
+		return x.Website
 	}
+	return ""
 }
 
-func (x *HTTPListener) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *HTTPListenerReq) GetCert() []byte {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
 }
 
-func (*HTTPListener) ProtoMessage() {}
-
-func (x *HTTPListener) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[36]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *HTTPListenerReq) GetKey() []byte {
+	if x != nil {
+		return x.Key
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use HTTPListener.ProtoReflect.Descriptor instead.
-func (*HTTPListener) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{36}
+func (x *HTTPListenerReq) GetACME() bool {
+	if x != nil {
+		return x.ACME
+	}
+	return false
 }
 
-func (x *HTTPListener) GetJobID() uint32 {
+func (x *HTTPListenerReq) GetPersistent() bool {
 	if x != nil {
-		return x.JobID
+		return x.Persistent
+	}
+	return false
+}
+
+func (x *HTTPListenerReq) GetEnforceOTP() bool {
+	if x != nil {
+		return x.EnforceOTP
+	}
+	return false
+}
+
+func (x *HTTPListenerReq) GetLongPollTimeout() int64 {
+	if x != nil {
+		return x.LongPollTimeout
 	}
 	return 0
 }
 
-// [ commands ] ----------------------------------------
-type Sessions struct {
+func (x *HTTPListenerReq) GetLongPollJitter() int64 {
+	if x != nil {
+		return x.LongPollJitter
+	}
+	return 0
+}
+
+func (x *HTTPListenerReq) GetRandomizeJARM() bool {
+	if x != nil {
+		return x.RandomizeJARM
+	}
+	return false
+}
+
+func (x *HTTPListenerReq) GetC2Profile() string {
+	if x != nil {
+		return x.C2Profile
+	}
+	return ""
+}
+
+func (x *HTTPListenerReq) GetFrontDomain() string {
+	if x != nil {
+		return x.FrontDomain
+	}
+	return ""
+}
+
+func (x *HTTPListenerReq) GetHTTP2() bool {
+	if x != nil {
+		return x.HTTP2
+	}
+	return false
+}
+
+// HTTP C2 profiles, the config is the profile's json
+type HTTPC2Profile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sessions []*Session `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+	Name   string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Config []byte `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
 }
 
-func (x *Sessions) Reset() {
-	*x = Sessions{}
+func (x *HTTPC2Profile) Reset() {
+	*x = HTTPC2Profile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[37]
+		mi := &file_clientpb_client_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Sessions) String() string {
+func (x *HTTPC2Profile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Sessions) ProtoMessage() {}
+func (*HTTPC2Profile) ProtoMessage() {}
 
-func (x *Sessions) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[37]
+func (x *HTTPC2Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3426,45 +4163,50 @@ func (x *Sessions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Sessions.ProtoReflect.Descriptor instead.
-func (*Sessions) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use HTTPC2Profile.ProtoReflect.Descriptor instead.
+func (*HTTPC2Profile) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *Sessions) GetSessions() []*Session {
+func (x *HTTPC2Profile) GetName() string {
 	if x != nil {
-		return x.Sessions
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HTTPC2Profile) GetConfig() []byte {
+	if x != nil {
+		return x.Config
 	}
 	return nil
 }
 
-type RenameReq struct {
+type HTTPC2Profiles struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	BeaconID  string `protobuf:"bytes,2,opt,name=BeaconID,proto3" json:"BeaconID,omitempty"`
-	Name      string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Profiles []*HTTPC2Profile `protobuf:"bytes,1,rep,name=Profiles,proto3" json:"Profiles,omitempty"`
 }
 
-func (x *RenameReq) Reset() {
-	*x = RenameReq{}
+func (x *HTTPC2Profiles) Reset() {
+	*x = HTTPC2Profiles{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[38]
+		mi := &file_clientpb_client_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameReq) String() string {
+func (x *HTTPC2Profiles) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameReq) ProtoMessage() {}
+func (*HTTPC2Profiles) ProtoMessage() {}
 
-func (x *RenameReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[38]
+func (x *HTTPC2Profiles) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3475,57 +4217,46 @@ func (x *RenameReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameReq.ProtoReflect.Descriptor instead.
-func (*RenameReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *RenameReq) GetSessionID() string {
-	if x != nil {
-		return x.SessionID
-	}
-	return ""
-}
-
-func (x *RenameReq) GetBeaconID() string {
-	if x != nil {
-		return x.BeaconID
-	}
-	return ""
+// Deprecated: Use HTTPC2Profiles.ProtoReflect.Descriptor instead.
+func (*HTTPC2Profiles) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RenameReq) GetName() string {
+func (x *HTTPC2Profiles) GetProfiles() []*HTTPC2Profile {
 	if x != nil {
-		return x.Name
+		return x.Profiles
 	}
-	return ""
+	return nil
 }
 
-type GenerateReq struct {
+// Upload chunks are content addressed by their sha256, so files pushed to
+// many hosts only need to be sent to the server once
+type UploadChunk struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config *ImplantConfig `protobuf:"bytes,1,opt,name=Config,proto3" json:"Config,omitempty"`
+	Hash string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"` // gzip
 }
 
-func (x *GenerateReq) Reset() {
-	*x = GenerateReq{}
+func (x *UploadChunk) Reset() {
+	*x = UploadChunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[39]
+		mi := &file_clientpb_client_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateReq) String() string {
+func (x *UploadChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateReq) ProtoMessage() {}
+func (*UploadChunk) ProtoMessage() {}
 
-func (x *GenerateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[39]
+func (x *UploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3536,43 +4267,50 @@ func (x *GenerateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateReq.ProtoReflect.Descriptor instead.
-func (*GenerateReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UploadChunk.ProtoReflect.Descriptor instead.
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GenerateReq) GetConfig() *ImplantConfig {
+func (x *UploadChunk) GetHash() string {
 	if x != nil {
-		return x.Config
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *UploadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
 	}
 	return nil
 }
 
-type Generate struct {
+type UploadChunks struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	File *commonpb.File `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
+	Hashes []string `protobuf:"bytes,1,rep,name=Hashes,proto3" json:"Hashes,omitempty"`
 }
 
-func (x *Generate) Reset() {
-	*x = Generate{}
+func (x *UploadChunks) Reset() {
+	*x = UploadChunks{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[40]
+		mi := &file_clientpb_client_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Generate) String() string {
+func (x *UploadChunks) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Generate) ProtoMessage() {}
+func (*UploadChunks) ProtoMessage() {}
 
-func (x *Generate) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[40]
+func (x *UploadChunks) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3583,48 +4321,45 @@ func (x *Generate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Generate.ProtoReflect.Descriptor instead.
-func (*Generate) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use UploadChunks.ProtoReflect.Descriptor instead.
+func (*UploadChunks) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Generate) GetFile() *commonpb.File {
+func (x *UploadChunks) GetHashes() []string {
 	if x != nil {
-		return x.File
+		return x.Hashes
 	}
 	return nil
 }
 
-type MSFReq struct {
+// Named Pipes Messages for pivoting
+type NamedPipesReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload    string            `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
-	LHost      string            `protobuf:"bytes,2,opt,name=LHost,proto3" json:"LHost,omitempty"`
-	LPort      uint32            `protobuf:"varint,3,opt,name=LPort,proto3" json:"LPort,omitempty"`
-	Encoder    string            `protobuf:"bytes,4,opt,name=Encoder,proto3" json:"Encoder,omitempty"`
-	Iterations int32             `protobuf:"varint,5,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
-	Request    *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	PipeName string            `protobuf:"bytes,16,opt,name=PipeName,proto3" json:"PipeName,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *MSFReq) Reset() {
-	*x = MSFReq{}
+func (x *NamedPipesReq) Reset() {
+	*x = NamedPipesReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[41]
+		mi := &file_clientpb_client_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MSFReq) String() string {
+func (x *NamedPipesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MSFReq) ProtoMessage() {}
+func (*NamedPipesReq) ProtoMessage() {}
 
-func (x *MSFReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[41]
+func (x *NamedPipesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3635,84 +4370,52 @@ func (x *MSFReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MSFReq.ProtoReflect.Descriptor instead.
-func (*MSFReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *MSFReq) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
-}
-
-func (x *MSFReq) GetLHost() string {
-	if x != nil {
-		return x.LHost
-	}
-	return ""
-}
-
-func (x *MSFReq) GetLPort() uint32 {
-	if x != nil {
-		return x.LPort
-	}
-	return 0
+// Deprecated: Use NamedPipesReq.ProtoReflect.Descriptor instead.
+func (*NamedPipesReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *MSFReq) GetEncoder() string {
+func (x *NamedPipesReq) GetPipeName() string {
 	if x != nil {
-		return x.Encoder
+		return x.PipeName
 	}
 	return ""
 }
 
-func (x *MSFReq) GetIterations() int32 {
-	if x != nil {
-		return x.Iterations
-	}
-	return 0
-}
-
-func (x *MSFReq) GetRequest() *commonpb.Request {
+func (x *NamedPipesReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type MSFRemoteReq struct {
+type NamedPipes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Payload    string            `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
-	LHost      string            `protobuf:"bytes,2,opt,name=LHost,proto3" json:"LHost,omitempty"`
-	LPort      uint32            `protobuf:"varint,3,opt,name=LPort,proto3" json:"LPort,omitempty"`
-	Encoder    string            `protobuf:"bytes,4,opt,name=Encoder,proto3" json:"Encoder,omitempty"`
-	Iterations int32             `protobuf:"varint,5,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
-	PID        uint32            `protobuf:"varint,8,opt,name=PID,proto3" json:"PID,omitempty"`
-	Request    *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Success  bool               `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Err      string             `protobuf:"bytes,2,opt,name=Err,proto3" json:"Err,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *MSFRemoteReq) Reset() {
-	*x = MSFRemoteReq{}
+func (x *NamedPipes) Reset() {
+	*x = NamedPipes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[42]
+		mi := &file_clientpb_client_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MSFRemoteReq) String() string {
+func (x *NamedPipes) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MSFRemoteReq) ProtoMessage() {}
+func (*NamedPipes) ProtoMessage() {}
 
-func (x *MSFRemoteReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[42]
+func (x *NamedPipes) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3723,91 +4426,115 @@ func (x *MSFRemoteReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MSFRemoteReq.ProtoReflect.Descriptor instead.
-func (*MSFRemoteReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use NamedPipes.ProtoReflect.Descriptor instead.
+func (*NamedPipes) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *MSFRemoteReq) GetPayload() string {
+func (x *NamedPipes) GetSuccess() bool {
 	if x != nil {
-		return x.Payload
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *MSFRemoteReq) GetLHost() string {
+func (x *NamedPipes) GetErr() string {
 	if x != nil {
-		return x.LHost
+		return x.Err
 	}
 	return ""
 }
 
-func (x *MSFRemoteReq) GetLPort() uint32 {
+func (x *NamedPipes) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.LPort
+		return x.Response
 	}
-	return 0
+	return nil
 }
 
-func (x *MSFRemoteReq) GetEncoder() string {
-	if x != nil {
-		return x.Encoder
+// TCP Messages for pivoting
+type TCPPivotReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string            `protobuf:"bytes,16,opt,name=Address,proto3" json:"Address,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *TCPPivotReq) Reset() {
+	*x = TCPPivotReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MSFRemoteReq) GetIterations() int32 {
-	if x != nil {
-		return x.Iterations
+func (x *TCPPivotReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TCPPivotReq) ProtoMessage() {}
+
+func (x *TCPPivotReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MSFRemoteReq) GetPID() uint32 {
+// Deprecated: Use TCPPivotReq.ProtoReflect.Descriptor instead.
+func (*TCPPivotReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TCPPivotReq) GetAddress() string {
 	if x != nil {
-		return x.PID
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
-func (x *MSFRemoteReq) GetRequest() *commonpb.Request {
+func (x *TCPPivotReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type StagerListenerReq struct {
+type TCPPivot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Protocol StageProtocol `protobuf:"varint,1,opt,name=Protocol,proto3,enum=clientpb.StageProtocol" json:"Protocol,omitempty"`
-	Host     string        `protobuf:"bytes,2,opt,name=Host,proto3" json:"Host,omitempty"`
-	Port     uint32        `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
-	Data     []byte        `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
-	Cert     []byte        `protobuf:"bytes,5,opt,name=Cert,proto3" json:"Cert,omitempty"`
-	Key      []byte        `protobuf:"bytes,6,opt,name=Key,proto3" json:"Key,omitempty"`
-	ACME     bool          `protobuf:"varint,7,opt,name=ACME,proto3" json:"ACME,omitempty"`
+	Success  bool               `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Err      string             `protobuf:"bytes,2,opt,name=Err,proto3" json:"Err,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
 }
 
-func (x *StagerListenerReq) Reset() {
-	*x = StagerListenerReq{}
+func (x *TCPPivot) Reset() {
+	*x = TCPPivot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[43]
+		mi := &file_clientpb_client_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StagerListenerReq) String() string {
+func (x *TCPPivot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StagerListenerReq) ProtoMessage() {}
+func (*TCPPivot) ProtoMessage() {}
 
-func (x *StagerListenerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[43]
+func (x *TCPPivot) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3818,85 +4545,105 @@ func (x *StagerListenerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StagerListenerReq.ProtoReflect.Descriptor instead.
-func (*StagerListenerReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use TCPPivot.ProtoReflect.Descriptor instead.
+func (*TCPPivot) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *StagerListenerReq) GetProtocol() StageProtocol {
+func (x *TCPPivot) GetSuccess() bool {
 	if x != nil {
-		return x.Protocol
+		return x.Success
 	}
-	return StageProtocol_TCP
+	return false
 }
 
-func (x *StagerListenerReq) GetHost() string {
+func (x *TCPPivot) GetErr() string {
 	if x != nil {
-		return x.Host
+		return x.Err
 	}
 	return ""
 }
 
-func (x *StagerListenerReq) GetPort() uint32 {
+func (x *TCPPivot) GetResponse() *commonpb.Response {
 	if x != nil {
-		return x.Port
+		return x.Response
 	}
-	return 0
+	return nil
 }
 
-func (x *StagerListenerReq) GetData() []byte {
-	if x != nil {
-		return x.Data
-	}
-	return nil
+type HTTPListener struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
 }
 
-func (x *StagerListenerReq) GetCert() []byte {
-	if x != nil {
-		return x.Cert
+func (x *HTTPListener) Reset() {
+	*x = HTTPListener{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *StagerListenerReq) GetKey() []byte {
-	if x != nil {
-		return x.Key
+func (x *HTTPListener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPListener) ProtoMessage() {}
+
+func (x *HTTPListener) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *StagerListenerReq) GetACME() bool {
+// Deprecated: Use HTTPListener.ProtoReflect.Descriptor instead.
+func (*HTTPListener) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *HTTPListener) GetJobID() uint32 {
 	if x != nil {
-		return x.ACME
+		return x.JobID
 	}
-	return false
+	return 0
 }
 
-type StagerListener struct {
+// [ commands ] ----------------------------------------
+type Sessions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	Sessions []*Session `protobuf:"bytes,1,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
 }
 
-func (x *StagerListener) Reset() {
-	*x = StagerListener{}
+func (x *Sessions) Reset() {
+	*x = Sessions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[44]
+		mi := &file_clientpb_client_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StagerListener) String() string {
+func (x *Sessions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StagerListener) ProtoMessage() {}
+func (*Sessions) ProtoMessage() {}
 
-func (x *StagerListener) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[44]
+func (x *Sessions) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3907,45 +4654,45 @@ func (x *StagerListener) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StagerListener.ProtoReflect.Descriptor instead.
-func (*StagerListener) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use Sessions.ProtoReflect.Descriptor instead.
+func (*Sessions) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *StagerListener) GetJobID() uint32 {
+func (x *Sessions) GetSessions() []*Session {
 	if x != nil {
-		return x.JobID
+		return x.Sessions
 	}
-	return 0
+	return nil
 }
 
-type ShellcodeRDIReq struct {
+type RenameReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data         []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
-	FunctionName string `protobuf:"bytes,2,opt,name=FunctionName,proto3" json:"FunctionName,omitempty"`
-	Arguments    string `protobuf:"bytes,3,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
+	SessionID string `protobuf:"bytes,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	BeaconID  string `protobuf:"bytes,2,opt,name=BeaconID,proto3" json:"BeaconID,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
 }
 
-func (x *ShellcodeRDIReq) Reset() {
-	*x = ShellcodeRDIReq{}
+func (x *RenameReq) Reset() {
+	*x = RenameReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[45]
+		mi := &file_clientpb_client_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShellcodeRDIReq) String() string {
+func (x *RenameReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShellcodeRDIReq) ProtoMessage() {}
+func (*RenameReq) ProtoMessage() {}
 
-func (x *ShellcodeRDIReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[45]
+func (x *RenameReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3956,57 +4703,57 @@ func (x *ShellcodeRDIReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShellcodeRDIReq.ProtoReflect.Descriptor instead.
-func (*ShellcodeRDIReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use RenameReq.ProtoReflect.Descriptor instead.
+func (*RenameReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ShellcodeRDIReq) GetData() []byte {
+func (x *RenameReq) GetSessionID() string {
 	if x != nil {
-		return x.Data
+		return x.SessionID
 	}
-	return nil
+	return ""
 }
 
-func (x *ShellcodeRDIReq) GetFunctionName() string {
+func (x *RenameReq) GetBeaconID() string {
 	if x != nil {
-		return x.FunctionName
+		return x.BeaconID
 	}
 	return ""
 }
 
-func (x *ShellcodeRDIReq) GetArguments() string {
+func (x *RenameReq) GetName() string {
 	if x != nil {
-		return x.Arguments
+		return x.Name
 	}
 	return ""
 }
 
-type ShellcodeRDI struct {
+type GenerateReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Config *ImplantConfig `protobuf:"bytes,1,opt,name=Config,proto3" json:"Config,omitempty"`
 }
 
-func (x *ShellcodeRDI) Reset() {
-	*x = ShellcodeRDI{}
+func (x *GenerateReq) Reset() {
+	*x = GenerateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[46]
+		mi := &file_clientpb_client_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShellcodeRDI) String() string {
+func (x *GenerateReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShellcodeRDI) ProtoMessage() {}
+func (*GenerateReq) ProtoMessage() {}
 
-func (x *ShellcodeRDI) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[46]
+func (x *GenerateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4017,49 +4764,45 @@ func (x *ShellcodeRDI) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShellcodeRDI.ProtoReflect.Descriptor instead.
-func (*ShellcodeRDI) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GenerateReq.ProtoReflect.Descriptor instead.
+func (*GenerateReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ShellcodeRDI) GetData() []byte {
+func (x *GenerateReq) GetConfig() *ImplantConfig {
 	if x != nil {
-		return x.Data
+		return x.Config
 	}
 	return nil
 }
 
-type MsfStagerReq struct {
+type Generate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Arch     string        `protobuf:"bytes,1,opt,name=Arch,proto3" json:"Arch,omitempty"`
-	Format   string        `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`
-	Port     uint32        `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
-	Host     string        `protobuf:"bytes,4,opt,name=Host,proto3" json:"Host,omitempty"`
-	OS       string        `protobuf:"bytes,5,opt,name=OS,proto3" json:"OS,omitempty"` // reserved for future usage
-	Protocol StageProtocol `protobuf:"varint,6,opt,name=Protocol,proto3,enum=clientpb.StageProtocol" json:"Protocol,omitempty"`
-	BadChars []string      `protobuf:"bytes,7,rep,name=BadChars,proto3" json:"BadChars,omitempty"`
+	File            *commonpb.File   `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
+	Indicators      *IndicatorReport `protobuf:"bytes,2,opt,name=Indicators,proto3" json:"Indicators,omitempty"`
+	ObfuscationSeed string           `protobuf:"bytes,3,opt,name=ObfuscationSeed,proto3" json:"ObfuscationSeed,omitempty"`
 }
 
-func (x *MsfStagerReq) Reset() {
-	*x = MsfStagerReq{}
+func (x *Generate) Reset() {
+	*x = Generate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[47]
+		mi := &file_clientpb_client_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsfStagerReq) String() string {
+func (x *Generate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsfStagerReq) ProtoMessage() {}
+func (*Generate) ProtoMessage() {}
 
-func (x *MsfStagerReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[47]
+func (x *Generate) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4070,85 +4813,61 @@ func (x *MsfStagerReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsfStagerReq.ProtoReflect.Descriptor instead.
-func (*MsfStagerReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *MsfStagerReq) GetArch() string {
-	if x != nil {
-		return x.Arch
-	}
-	return ""
-}
-
-func (x *MsfStagerReq) GetFormat() string {
-	if x != nil {
-		return x.Format
-	}
-	return ""
+// Deprecated: Use Generate.ProtoReflect.Descriptor instead.
+func (*Generate) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *MsfStagerReq) GetPort() uint32 {
+func (x *Generate) GetFile() *commonpb.File {
 	if x != nil {
-		return x.Port
+		return x.File
 	}
-	return 0
+	return nil
 }
 
-func (x *MsfStagerReq) GetHost() string {
+func (x *Generate) GetIndicators() *IndicatorReport {
 	if x != nil {
-		return x.Host
+		return x.Indicators
 	}
-	return ""
+	return nil
 }
 
-func (x *MsfStagerReq) GetOS() string {
+func (x *Generate) GetObfuscationSeed() string {
 	if x != nil {
-		return x.OS
+		return x.ObfuscationSeed
 	}
 	return ""
 }
 
-func (x *MsfStagerReq) GetProtocol() StageProtocol {
-	if x != nil {
-		return x.Protocol
-	}
-	return StageProtocol_TCP
-}
-
-func (x *MsfStagerReq) GetBadChars() []string {
-	if x != nil {
-		return x.BadChars
-	}
-	return nil
-}
-
-type MsfStager struct {
+// Indicator - A tell-tale string found in an implant binary
+type Indicator struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	File *commonpb.File `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
+	Category string `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category,omitempty"`
+	Value    string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Count    uint32 `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	Stripped bool   `protobuf:"varint,4,opt,name=Stripped,proto3" json:"Stripped,omitempty"`
 }
 
-func (x *MsfStager) Reset() {
-	*x = MsfStager{}
+func (x *Indicator) Reset() {
+	*x = Indicator{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[48]
+		mi := &file_clientpb_client_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsfStager) String() string {
+func (x *Indicator) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsfStager) ProtoMessage() {}
+func (*Indicator) ProtoMessage() {}
 
-func (x *MsfStager) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[48]
+func (x *Indicator) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4159,48 +4878,68 @@ func (x *MsfStager) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsfStager.ProtoReflect.Descriptor instead.
-func (*MsfStager) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use Indicator.ProtoReflect.Descriptor instead.
+func (*Indicator) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *MsfStager) GetFile() *commonpb.File {
+func (x *Indicator) GetCategory() string {
 	if x != nil {
-		return x.File
+		return x.Category
 	}
-	return nil
+	return ""
 }
 
-// GetSystemReq - Client request to the server which is translated into
-//
-//	InvokeSystemReq when sending to the implant.
-type GetSystemReq struct {
+func (x *Indicator) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Indicator) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Indicator) GetStripped() bool {
+	if x != nil {
+		return x.Stripped
+	}
+	return false
+}
+
+// IndicatorReport - The indicators found in a build, Residual is the number of
+// occurrences that were not stripped and ship in the binary
+type IndicatorReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HostingProcess string            `protobuf:"bytes,1,opt,name=HostingProcess,proto3" json:"HostingProcess,omitempty"`
-	Config         *ImplantConfig    `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
-	Request        *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Indicators []*Indicator `protobuf:"bytes,1,rep,name=Indicators,proto3" json:"Indicators,omitempty"`
+	Residual   uint32       `protobuf:"varint,2,opt,name=Residual,proto3" json:"Residual,omitempty"`
+	Threshold  uint32       `protobuf:"varint,3,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
 }
 
-func (x *GetSystemReq) Reset() {
-	*x = GetSystemReq{}
+func (x *IndicatorReport) Reset() {
+	*x = IndicatorReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[49]
+		mi := &file_clientpb_client_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetSystemReq) String() string {
+func (x *IndicatorReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSystemReq) ProtoMessage() {}
+func (*IndicatorReport) ProtoMessage() {}
 
-func (x *GetSystemReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[49]
+func (x *IndicatorReport) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4211,63 +4950,62 @@ func (x *GetSystemReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSystemReq.ProtoReflect.Descriptor instead.
-func (*GetSystemReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use IndicatorReport.ProtoReflect.Descriptor instead.
+func (*IndicatorReport) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetSystemReq) GetHostingProcess() string {
+func (x *IndicatorReport) GetIndicators() []*Indicator {
 	if x != nil {
-		return x.HostingProcess
+		return x.Indicators
 	}
-	return ""
+	return nil
 }
 
-func (x *GetSystemReq) GetConfig() *ImplantConfig {
+func (x *IndicatorReport) GetResidual() uint32 {
 	if x != nil {
-		return x.Config
+		return x.Residual
 	}
-	return nil
+	return 0
 }
 
-func (x *GetSystemReq) GetRequest() *commonpb.Request {
+func (x *IndicatorReport) GetThreshold() uint32 {
 	if x != nil {
-		return x.Request
+		return x.Threshold
 	}
-	return nil
+	return 0
 }
 
-// MigrateReq - Client request to the server which is translated into
-//
-//	InvokeMigrateReq when sending to the implant.
-type MigrateReq struct {
+type MSFReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pid     uint32            `protobuf:"varint,1,opt,name=Pid,proto3" json:"Pid,omitempty"`
-	Config  *ImplantConfig    `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
-	Encoder ShellcodeEncoder  `protobuf:"varint,3,opt,name=Encoder,proto3,enum=clientpb.ShellcodeEncoder" json:"Encoder,omitempty"`
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Payload    string            `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	LHost      string            `protobuf:"bytes,2,opt,name=LHost,proto3" json:"LHost,omitempty"`
+	LPort      uint32            `protobuf:"varint,3,opt,name=LPort,proto3" json:"LPort,omitempty"`
+	Encoder    string            `protobuf:"bytes,4,opt,name=Encoder,proto3" json:"Encoder,omitempty"`
+	Iterations int32             `protobuf:"varint,5,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
+	Request    *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *MigrateReq) Reset() {
-	*x = MigrateReq{}
+func (x *MSFReq) Reset() {
+	*x = MSFReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[50]
+		mi := &file_clientpb_client_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MigrateReq) String() string {
+func (x *MSFReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MigrateReq) ProtoMessage() {}
+func (*MSFReq) ProtoMessage() {}
 
-func (x *MigrateReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[50]
+func (x *MSFReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4278,65 +5016,84 @@ func (x *MigrateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MigrateReq.ProtoReflect.Descriptor instead.
-func (*MigrateReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use MSFReq.ProtoReflect.Descriptor instead.
+func (*MSFReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *MigrateReq) GetPid() uint32 {
+func (x *MSFReq) GetPayload() string {
 	if x != nil {
-		return x.Pid
+		return x.Payload
 	}
-	return 0
+	return ""
 }
 
-func (x *MigrateReq) GetConfig() *ImplantConfig {
+func (x *MSFReq) GetLHost() string {
 	if x != nil {
-		return x.Config
+		return x.LHost
 	}
-	return nil
+	return ""
 }
 
-func (x *MigrateReq) GetEncoder() ShellcodeEncoder {
+func (x *MSFReq) GetLPort() uint32 {
+	if x != nil {
+		return x.LPort
+	}
+	return 0
+}
+
+func (x *MSFReq) GetEncoder() string {
 	if x != nil {
 		return x.Encoder
 	}
-	return ShellcodeEncoder_NONE
+	return ""
 }
 
-func (x *MigrateReq) GetRequest() *commonpb.Request {
+func (x *MSFReq) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *MSFReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-// [ Tunnels ] ----------------------------------------
-type CreateTunnelReq struct {
+type MSFRemoteReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Payload    string            `protobuf:"bytes,1,opt,name=Payload,proto3" json:"Payload,omitempty"`
+	LHost      string            `protobuf:"bytes,2,opt,name=LHost,proto3" json:"LHost,omitempty"`
+	LPort      uint32            `protobuf:"varint,3,opt,name=LPort,proto3" json:"LPort,omitempty"`
+	Encoder    string            `protobuf:"bytes,4,opt,name=Encoder,proto3" json:"Encoder,omitempty"`
+	Iterations int32             `protobuf:"varint,5,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
+	PID        uint32            `protobuf:"varint,8,opt,name=PID,proto3" json:"PID,omitempty"`
+	Request    *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *CreateTunnelReq) Reset() {
-	*x = CreateTunnelReq{}
+func (x *MSFRemoteReq) Reset() {
+	*x = MSFRemoteReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[51]
+		mi := &file_clientpb_client_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateTunnelReq) String() string {
+func (x *MSFRemoteReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTunnelReq) ProtoMessage() {}
+func (*MSFRemoteReq) ProtoMessage() {}
 
-func (x *CreateTunnelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[51]
+func (x *MSFRemoteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4347,99 +5104,95 @@ func (x *CreateTunnelReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTunnelReq.ProtoReflect.Descriptor instead.
-func (*CreateTunnelReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use MSFRemoteReq.ProtoReflect.Descriptor instead.
+func (*MSFRemoteReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateTunnelReq) GetRequest() *commonpb.Request {
+func (x *MSFRemoteReq) GetPayload() string {
 	if x != nil {
-		return x.Request
+		return x.Payload
 	}
-	return nil
+	return ""
 }
 
-type CreateTunnel struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *MSFRemoteReq) GetLHost() string {
+	if x != nil {
+		return x.LHost
+	}
+	return ""
+}
 
-	SessionID uint32 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
-	TunnelID  uint64 `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
-}
-
-func (x *CreateTunnel) Reset() {
-	*x = CreateTunnel{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[52]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MSFRemoteReq) GetLPort() uint32 {
+	if x != nil {
+		return x.LPort
 	}
+	return 0
 }
 
-func (x *CreateTunnel) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateTunnel) ProtoMessage() {}
-
-func (x *CreateTunnel) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[52]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MSFRemoteReq) GetEncoder() string {
+	if x != nil {
+		return x.Encoder
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateTunnel.ProtoReflect.Descriptor instead.
-func (*CreateTunnel) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{52}
+func (x *MSFRemoteReq) GetIterations() int32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
 }
 
-func (x *CreateTunnel) GetSessionID() uint32 {
+func (x *MSFRemoteReq) GetPID() uint32 {
 	if x != nil {
-		return x.SessionID
+		return x.PID
 	}
 	return 0
 }
 
-func (x *CreateTunnel) GetTunnelID() uint64 {
+func (x *MSFRemoteReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.TunnelID
+		return x.Request
 	}
-	return 0
+	return nil
 }
 
-type CloseTunnelReq struct {
+type StagerListenerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
-	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Protocol StageProtocol `protobuf:"varint,1,opt,name=Protocol,proto3,enum=clientpb.StageProtocol" json:"Protocol,omitempty"`
+	Host     string        `protobuf:"bytes,2,opt,name=Host,proto3" json:"Host,omitempty"`
+	Port     uint32        `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	Data     []byte        `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+	Cert     []byte        `protobuf:"bytes,5,opt,name=Cert,proto3" json:"Cert,omitempty"`
+	Key      []byte        `protobuf:"bytes,6,opt,name=Key,proto3" json:"Key,omitempty"`
+	ACME     bool          `protobuf:"varint,7,opt,name=ACME,proto3" json:"ACME,omitempty"`
+	// Stagers that send a fingerprint are served the first stage that matches
+	// it, Data is served to the others
+	Stages    []*Stage `protobuf:"bytes,8,rep,name=Stages,proto3" json:"Stages,omitempty"`
+	ChunkSize uint32   `protobuf:"varint,9,opt,name=ChunkSize,proto3" json:"ChunkSize,omitempty"` // Largest chunk a stage is sent in, 0 for no limit
 }
 
-func (x *CloseTunnelReq) Reset() {
-	*x = CloseTunnelReq{}
+func (x *StagerListenerReq) Reset() {
+	*x = StagerListenerReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[53]
+		mi := &file_clientpb_client_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseTunnelReq) String() string {
+func (x *StagerListenerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseTunnelReq) ProtoMessage() {}
+func (*StagerListenerReq) ProtoMessage() {}
 
-func (x *CloseTunnelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[53]
+func (x *StagerListenerReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4450,54 +5203,105 @@ func (x *CloseTunnelReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseTunnelReq.ProtoReflect.Descriptor instead.
-func (*CloseTunnelReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use StagerListenerReq.ProtoReflect.Descriptor instead.
+func (*StagerListenerReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CloseTunnelReq) GetTunnelID() uint64 {
+func (x *StagerListenerReq) GetProtocol() StageProtocol {
 	if x != nil {
-		return x.TunnelID
+		return x.Protocol
+	}
+	return StageProtocol_TCP
+}
+
+func (x *StagerListenerReq) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *StagerListenerReq) GetPort() uint32 {
+	if x != nil {
+		return x.Port
 	}
 	return 0
 }
 
-func (x *CloseTunnelReq) GetRequest() *commonpb.Request {
+func (x *StagerListenerReq) GetData() []byte {
 	if x != nil {
-		return x.Request
+		return x.Data
 	}
 	return nil
 }
 
-// [ Pivots ] ----------------------------------------
-type PivotGraphEntry struct {
+func (x *StagerListenerReq) GetCert() []byte {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *StagerListenerReq) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *StagerListenerReq) GetACME() bool {
+	if x != nil {
+		return x.ACME
+	}
+	return false
+}
+
+func (x *StagerListenerReq) GetStages() []*Stage {
+	if x != nil {
+		return x.Stages
+	}
+	return nil
+}
+
+func (x *StagerListenerReq) GetChunkSize() uint32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+// A payload served to the stagers whose host matches, empty fields match any host
+type Stage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerID   int64              `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
-	Session  *Session           `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
-	Name     string             `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
-	Children []*PivotGraphEntry `protobuf:"bytes,4,rep,name=Children,proto3" json:"Children,omitempty"`
+	Name    string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Data    []byte   `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+	GOOS    string   `protobuf:"bytes,3,opt,name=GOOS,proto3" json:"GOOS,omitempty"`
+	GOARCH  string   `protobuf:"bytes,4,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"`
+	Version string   `protobuf:"bytes,5,opt,name=Version,proto3" json:"Version,omitempty"` // Prefix of the os version, e.g. 10.0 or 6.1
+	AV      []string `protobuf:"bytes,6,rep,name=AV,proto3" json:"AV,omitempty"`           // Matches if the host reports one of these AV products
 }
 
-func (x *PivotGraphEntry) Reset() {
-	*x = PivotGraphEntry{}
+func (x *Stage) Reset() {
+	*x = Stage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[54]
+		mi := &file_clientpb_client_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PivotGraphEntry) String() string {
+func (x *Stage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotGraphEntry) ProtoMessage() {}
+func (*Stage) ProtoMessage() {}
 
-func (x *PivotGraphEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[54]
+func (x *Stage) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4508,64 +5312,78 @@ func (x *PivotGraphEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotGraphEntry.ProtoReflect.Descriptor instead.
-func (*PivotGraphEntry) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use Stage.ProtoReflect.Descriptor instead.
+func (*Stage) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *PivotGraphEntry) GetPeerID() int64 {
+func (x *Stage) GetName() string {
 	if x != nil {
-		return x.PeerID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *PivotGraphEntry) GetSession() *Session {
+func (x *Stage) GetData() []byte {
 	if x != nil {
-		return x.Session
+		return x.Data
 	}
 	return nil
 }
 
-func (x *PivotGraphEntry) GetName() string {
+func (x *Stage) GetGOOS() string {
 	if x != nil {
-		return x.Name
+		return x.GOOS
 	}
 	return ""
 }
 
-func (x *PivotGraphEntry) GetChildren() []*PivotGraphEntry {
+func (x *Stage) GetGOARCH() string {
 	if x != nil {
-		return x.Children
+		return x.GOARCH
+	}
+	return ""
+}
+
+func (x *Stage) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Stage) GetAV() []string {
+	if x != nil {
+		return x.AV
 	}
 	return nil
 }
 
-type PivotGraph struct {
+type StagerListener struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Children []*PivotGraphEntry `protobuf:"bytes,1,rep,name=Children,proto3" json:"Children,omitempty"`
+	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
 }
 
-func (x *PivotGraph) Reset() {
-	*x = PivotGraph{}
+func (x *StagerListener) Reset() {
+	*x = StagerListener{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[55]
+		mi := &file_clientpb_client_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PivotGraph) String() string {
+func (x *StagerListener) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PivotGraph) ProtoMessage() {}
+func (*StagerListener) ProtoMessage() {}
 
-func (x *PivotGraph) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[55]
+func (x *StagerListener) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4576,46 +5394,46 @@ func (x *PivotGraph) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PivotGraph.ProtoReflect.Descriptor instead.
-func (*PivotGraph) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use StagerListener.ProtoReflect.Descriptor instead.
+func (*StagerListener) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *PivotGraph) GetChildren() []*PivotGraphEntry {
+func (x *StagerListener) GetJobID() uint32 {
 	if x != nil {
-		return x.Children
+		return x.JobID
 	}
-	return nil
+	return 0
 }
 
-// [ Events ] ----------------------------------------
-type Client struct {
+// An executable implant for the platform of an upgraded shell
+type ShellUpgradeImplant struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID       uint32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name     string    `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Operator *Operator `protobuf:"bytes,3,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	GOOS   string `protobuf:"bytes,1,opt,name=GOOS,proto3" json:"GOOS,omitempty"`
+	GOARCH string `protobuf:"bytes,2,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"`
+	Data   []byte `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 }
 
-func (x *Client) Reset() {
-	*x = Client{}
+func (x *ShellUpgradeImplant) Reset() {
+	*x = ShellUpgradeImplant{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[56]
+		mi := &file_clientpb_client_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Client) String() string {
+func (x *ShellUpgradeImplant) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Client) ProtoMessage() {}
+func (*ShellUpgradeImplant) ProtoMessage() {}
 
-func (x *Client) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[56]
+func (x *ShellUpgradeImplant) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4626,62 +5444,60 @@ func (x *Client) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Client.ProtoReflect.Descriptor instead.
-func (*Client) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ShellUpgradeImplant.ProtoReflect.Descriptor instead.
+func (*ShellUpgradeImplant) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *Client) GetID() uint32 {
+func (x *ShellUpgradeImplant) GetGOOS() string {
 	if x != nil {
-		return x.ID
+		return x.GOOS
 	}
-	return 0
+	return ""
 }
 
-func (x *Client) GetName() string {
+func (x *ShellUpgradeImplant) GetGOARCH() string {
 	if x != nil {
-		return x.Name
+		return x.GOARCH
 	}
 	return ""
 }
 
-func (x *Client) GetOperator() *Operator {
+func (x *ShellUpgradeImplant) GetData() []byte {
 	if x != nil {
-		return x.Operator
+		return x.Data
 	}
 	return nil
 }
 
-type Event struct {
+type ShellUpgradeReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EventType string   `protobuf:"bytes,1,opt,name=EventType,proto3" json:"EventType,omitempty"`
-	Session   *Session `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
-	Job       *Job     `protobuf:"bytes,3,opt,name=Job,proto3" json:"Job,omitempty"`
-	Client    *Client  `protobuf:"bytes,4,opt,name=Client,proto3" json:"Client,omitempty"`
-	Data      []byte   `protobuf:"bytes,5,opt,name=Data,proto3" json:"Data,omitempty"`
-	Err       string   `protobuf:"bytes,6,opt,name=Err,proto3" json:"Err,omitempty"` // Can't trigger normal gRPC error
+	Host     string                 `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	Port     uint32                 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	Implants []*ShellUpgradeImplant `protobuf:"bytes,3,rep,name=Implants,proto3" json:"Implants,omitempty"`
+	Connect  string                 `protobuf:"bytes,4,opt,name=Connect,proto3" json:"Connect,omitempty"` // Address of a bind shell, connect to it instead of listening
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
+func (x *ShellUpgradeReq) Reset() {
+	*x = ShellUpgradeReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[57]
+		mi := &file_clientpb_client_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Event) String() string {
+func (x *ShellUpgradeReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*ShellUpgradeReq) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[57]
+func (x *ShellUpgradeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4692,78 +5508,70 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ShellUpgradeReq.ProtoReflect.Descriptor instead.
+func (*ShellUpgradeReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *Event) GetEventType() string {
+func (x *ShellUpgradeReq) GetHost() string {
 	if x != nil {
-		return x.EventType
+		return x.Host
 	}
 	return ""
 }
 
-func (x *Event) GetSession() *Session {
-	if x != nil {
-		return x.Session
-	}
-	return nil
-}
-
-func (x *Event) GetJob() *Job {
+func (x *ShellUpgradeReq) GetPort() uint32 {
 	if x != nil {
-		return x.Job
-	}
-	return nil
-}
-
-func (x *Event) GetClient() *Client {
-	if x != nil {
-		return x.Client
+		return x.Port
 	}
-	return nil
+	return 0
 }
 
-func (x *Event) GetData() []byte {
+func (x *ShellUpgradeReq) GetImplants() []*ShellUpgradeImplant {
 	if x != nil {
-		return x.Data
+		return x.Implants
 	}
 	return nil
 }
 
-func (x *Event) GetErr() string {
+func (x *ShellUpgradeReq) GetConnect() string {
 	if x != nil {
-		return x.Err
+		return x.Connect
 	}
 	return ""
 }
 
-type Operators struct {
+type WarmupReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Operators []*Operator `protobuf:"bytes,1,rep,name=Operators,proto3" json:"Operators,omitempty"`
+	URLs            []string `protobuf:"bytes,1,rep,name=URLs,proto3" json:"URLs,omitempty"`         // Decoy site pages to request
+	DNSNames        []string `protobuf:"bytes,2,rep,name=DNSNames,proto3" json:"DNSNames,omitempty"` // Names to look up
+	Resolver        string   `protobuf:"bytes,3,opt,name=Resolver,proto3" json:"Resolver,omitempty"` // host:port of a resolver, empty for the system resolver
+	RequestsPerHour uint32   `protobuf:"varint,4,opt,name=RequestsPerHour,proto3" json:"RequestsPerHour,omitempty"`
+	Duration        int64    `protobuf:"varint,5,opt,name=Duration,proto3" json:"Duration,omitempty"`       // Stop after this long, 0 runs until the job is killed
+	ActiveFrom      uint32   `protobuf:"varint,6,opt,name=ActiveFrom,proto3" json:"ActiveFrom,omitempty"`   // Only send traffic from this hour (0-23, server time) ...
+	ActiveUntil     uint32   `protobuf:"varint,7,opt,name=ActiveUntil,proto3" json:"ActiveUntil,omitempty"` // ... until this hour, equal hours are always active
 }
 
-func (x *Operators) Reset() {
-	*x = Operators{}
+func (x *WarmupReq) Reset() {
+	*x = WarmupReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[58]
+		mi := &file_clientpb_client_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Operators) String() string {
+func (x *WarmupReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operators) ProtoMessage() {}
+func (*WarmupReq) ProtoMessage() {}
 
-func (x *Operators) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[58]
+func (x *WarmupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4774,102 +5582,85 @@ func (x *Operators) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operators.ProtoReflect.Descriptor instead.
-func (*Operators) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use WarmupReq.ProtoReflect.Descriptor instead.
+func (*WarmupReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *Operators) GetOperators() []*Operator {
+func (x *WarmupReq) GetURLs() []string {
 	if x != nil {
-		return x.Operators
+		return x.URLs
 	}
 	return nil
 }
 
-type Operator struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Online bool   `protobuf:"varint,1,opt,name=Online,proto3" json:"Online,omitempty"`
-	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-}
-
-func (x *Operator) Reset() {
-	*x = Operator{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[59]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *WarmupReq) GetDNSNames() []string {
+	if x != nil {
+		return x.DNSNames
 	}
+	return nil
 }
 
-func (x *Operator) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *WarmupReq) GetResolver() string {
+	if x != nil {
+		return x.Resolver
+	}
+	return ""
 }
 
-func (*Operator) ProtoMessage() {}
-
-func (x *Operator) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[59]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WarmupReq) GetRequestsPerHour() uint32 {
+	if x != nil {
+		return x.RequestsPerHour
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Operator.ProtoReflect.Descriptor instead.
-func (*Operator) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{59}
+func (x *WarmupReq) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
 }
 
-func (x *Operator) GetOnline() bool {
+func (x *WarmupReq) GetActiveFrom() uint32 {
 	if x != nil {
-		return x.Online
+		return x.ActiveFrom
 	}
-	return false
+	return 0
 }
 
-func (x *Operator) GetName() string {
+func (x *WarmupReq) GetActiveUntil() uint32 {
 	if x != nil {
-		return x.Name
+		return x.ActiveUntil
 	}
-	return ""
+	return 0
 }
 
-// [ Websites ] ----------------------------------------
-type WebContent struct {
+type Warmup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path        string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	ContentType string `protobuf:"bytes,2,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
-	Size        uint64 `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`
-	Content     []byte `protobuf:"bytes,9,opt,name=Content,proto3" json:"Content,omitempty"`
+	JobID uint32 `protobuf:"varint,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
 }
 
-func (x *WebContent) Reset() {
-	*x = WebContent{}
+func (x *Warmup) Reset() {
+	*x = Warmup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[60]
+		mi := &file_clientpb_client_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WebContent) String() string {
+func (x *Warmup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebContent) ProtoMessage() {}
+func (*Warmup) ProtoMessage() {}
 
-func (x *WebContent) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[60]
+func (x *Warmup) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4880,65 +5671,45 @@ func (x *WebContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebContent.ProtoReflect.Descriptor instead.
-func (*WebContent) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{60}
-}
-
-func (x *WebContent) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *WebContent) GetContentType() string {
-	if x != nil {
-		return x.ContentType
-	}
-	return ""
+// Deprecated: Use Warmup.ProtoReflect.Descriptor instead.
+func (*Warmup) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *WebContent) GetSize() uint64 {
+func (x *Warmup) GetJobID() uint32 {
 	if x != nil {
-		return x.Size
+		return x.JobID
 	}
 	return 0
 }
 
-func (x *WebContent) GetContent() []byte {
-	if x != nil {
-		return x.Content
-	}
-	return nil
-}
-
-type WebsiteAddContent struct {
+type ShellcodeRDIReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Contents map[string]*WebContent `protobuf:"bytes,2,rep,name=Contents,proto3" json:"Contents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Data         []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	FunctionName string `protobuf:"bytes,2,opt,name=FunctionName,proto3" json:"FunctionName,omitempty"`
+	Arguments    string `protobuf:"bytes,3,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
 }
 
-func (x *WebsiteAddContent) Reset() {
-	*x = WebsiteAddContent{}
+func (x *ShellcodeRDIReq) Reset() {
+	*x = ShellcodeRDIReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[61]
+		mi := &file_clientpb_client_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WebsiteAddContent) String() string {
+func (x *ShellcodeRDIReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsiteAddContent) ProtoMessage() {}
+func (*ShellcodeRDIReq) ProtoMessage() {}
 
-func (x *WebsiteAddContent) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[61]
+func (x *ShellcodeRDIReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4949,51 +5720,57 @@ func (x *WebsiteAddContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsiteAddContent.ProtoReflect.Descriptor instead.
-func (*WebsiteAddContent) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ShellcodeRDIReq.ProtoReflect.Descriptor instead.
+func (*ShellcodeRDIReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *WebsiteAddContent) GetName() string {
+func (x *ShellcodeRDIReq) GetData() []byte {
 	if x != nil {
-		return x.Name
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ShellcodeRDIReq) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
 	}
 	return ""
 }
 
-func (x *WebsiteAddContent) GetContents() map[string]*WebContent {
+func (x *ShellcodeRDIReq) GetArguments() string {
 	if x != nil {
-		return x.Contents
+		return x.Arguments
 	}
-	return nil
+	return ""
 }
 
-type WebsiteRemoveContent struct {
+type ShellcodeRDI struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name  string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Paths []string `protobuf:"bytes,2,rep,name=Paths,proto3" json:"Paths,omitempty"`
+	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
 }
 
-func (x *WebsiteRemoveContent) Reset() {
-	*x = WebsiteRemoveContent{}
+func (x *ShellcodeRDI) Reset() {
+	*x = ShellcodeRDI{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[62]
+		mi := &file_clientpb_client_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WebsiteRemoveContent) String() string {
+func (x *ShellcodeRDI) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsiteRemoveContent) ProtoMessage() {}
+func (*ShellcodeRDI) ProtoMessage() {}
 
-func (x *WebsiteRemoveContent) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[62]
+func (x *ShellcodeRDI) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5004,51 +5781,52 @@ func (x *WebsiteRemoveContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsiteRemoveContent.ProtoReflect.Descriptor instead.
-func (*WebsiteRemoveContent) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *WebsiteRemoveContent) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ShellcodeRDI.ProtoReflect.Descriptor instead.
+func (*ShellcodeRDI) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *WebsiteRemoveContent) GetPaths() []string {
+func (x *ShellcodeRDI) GetData() []byte {
 	if x != nil {
-		return x.Paths
+		return x.Data
 	}
 	return nil
 }
 
-type Website struct {
+// ShellcodeArtifactReq - Convert a .NET assembly to shellcode and save it
+type ShellcodeArtifactReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Contents map[string]*WebContent `protobuf:"bytes,2,rep,name=Contents,proto3" json:"Contents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Name      string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	FileName  string `protobuf:"bytes,2,opt,name=FileName,proto3" json:"FileName,omitempty"`
+	Assembly  []byte `protobuf:"bytes,3,opt,name=Assembly,proto3" json:"Assembly,omitempty"`
+	Arch      string `protobuf:"bytes,4,opt,name=Arch,proto3" json:"Arch,omitempty"`
+	Arguments string `protobuf:"bytes,5,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
+	ClassName string `protobuf:"bytes,6,opt,name=ClassName,proto3" json:"ClassName,omitempty"`
+	Method    string `protobuf:"bytes,7,opt,name=Method,proto3" json:"Method,omitempty"`
+	AppDomain string `protobuf:"bytes,8,opt,name=AppDomain,proto3" json:"AppDomain,omitempty"`
+	Runtime   string `protobuf:"bytes,9,opt,name=Runtime,proto3" json:"Runtime,omitempty"`
 }
 
-func (x *Website) Reset() {
-	*x = Website{}
+func (x *ShellcodeArtifactReq) Reset() {
+	*x = ShellcodeArtifactReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[63]
+		mi := &file_clientpb_client_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Website) String() string {
+func (x *ShellcodeArtifactReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Website) ProtoMessage() {}
+func (*ShellcodeArtifactReq) ProtoMessage() {}
 
-func (x *Website) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[63]
+func (x *ShellcodeArtifactReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5059,172 +5837,110 @@ func (x *Website) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Website.ProtoReflect.Descriptor instead.
-func (*Website) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ShellcodeArtifactReq.ProtoReflect.Descriptor instead.
+func (*ShellcodeArtifactReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *Website) GetName() string {
+func (x *ShellcodeArtifactReq) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Website) GetContents() map[string]*WebContent {
+func (x *ShellcodeArtifactReq) GetFileName() string {
 	if x != nil {
-		return x.Contents
+		return x.FileName
 	}
-	return nil
+	return ""
 }
 
-type Websites struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Websites []*Website `protobuf:"bytes,1,rep,name=Websites,proto3" json:"Websites,omitempty"`
+func (x *ShellcodeArtifactReq) GetAssembly() []byte {
+	if x != nil {
+		return x.Assembly
+	}
+	return nil
 }
 
-func (x *Websites) Reset() {
-	*x = Websites{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[64]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ShellcodeArtifactReq) GetArch() string {
+	if x != nil {
+		return x.Arch
 	}
+	return ""
 }
 
-func (x *Websites) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Websites) ProtoMessage() {}
-
-func (x *Websites) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[64]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Websites.ProtoReflect.Descriptor instead.
-func (*Websites) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{64}
-}
-
-func (x *Websites) GetWebsites() []*Website {
+func (x *ShellcodeArtifactReq) GetArguments() string {
 	if x != nil {
-		return x.Websites
-	}
-	return nil
-}
-
-type WGClientConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ServerPubKey     string `protobuf:"bytes,1,opt,name=ServerPubKey,proto3" json:"ServerPubKey,omitempty"`
-	ClientPrivateKey string `protobuf:"bytes,2,opt,name=ClientPrivateKey,proto3" json:"ClientPrivateKey,omitempty"`
-	ClientPubKey     string `protobuf:"bytes,3,opt,name=ClientPubKey,proto3" json:"ClientPubKey,omitempty"`
-	ClientIP         string `protobuf:"bytes,4,opt,name=ClientIP,proto3" json:"ClientIP,omitempty"`
-}
-
-func (x *WGClientConfig) Reset() {
-	*x = WGClientConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[65]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *WGClientConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WGClientConfig) ProtoMessage() {}
-
-func (x *WGClientConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[65]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Arguments
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WGClientConfig.ProtoReflect.Descriptor instead.
-func (*WGClientConfig) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{65}
+	return ""
 }
 
-func (x *WGClientConfig) GetServerPubKey() string {
+func (x *ShellcodeArtifactReq) GetClassName() string {
 	if x != nil {
-		return x.ServerPubKey
+		return x.ClassName
 	}
 	return ""
 }
 
-func (x *WGClientConfig) GetClientPrivateKey() string {
+func (x *ShellcodeArtifactReq) GetMethod() string {
 	if x != nil {
-		return x.ClientPrivateKey
+		return x.Method
 	}
 	return ""
 }
 
-func (x *WGClientConfig) GetClientPubKey() string {
+func (x *ShellcodeArtifactReq) GetAppDomain() string {
 	if x != nil {
-		return x.ClientPubKey
+		return x.AppDomain
 	}
 	return ""
 }
 
-func (x *WGClientConfig) GetClientIP() string {
+func (x *ShellcodeArtifactReq) GetRuntime() string {
 	if x != nil {
-		return x.ClientIP
+		return x.Runtime
 	}
 	return ""
 }
 
-type Credential struct {
+type ShellcodeArtifact struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// USER_PASSWORD
-	User     string `protobuf:"bytes,2,opt,name=User,proto3" json:"User,omitempty"`
-	Password string `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
-	// API_KEY
-	APIKey string `protobuf:"bytes,4,opt,name=APIKey,proto3" json:"APIKey,omitempty"`
-}
-
-func (x *Credential) Reset() {
-	*x = Credential{}
+	ID        string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	FileName  string `protobuf:"bytes,3,opt,name=FileName,proto3" json:"FileName,omitempty"` // The assembly the shellcode was made from
+	Arch      string `protobuf:"bytes,4,opt,name=Arch,proto3" json:"Arch,omitempty"`
+	IsDLL     bool   `protobuf:"varint,5,opt,name=IsDLL,proto3" json:"IsDLL,omitempty"`
+	Arguments string `protobuf:"bytes,6,opt,name=Arguments,proto3" json:"Arguments,omitempty"`
+	Runtime   string `protobuf:"bytes,7,opt,name=Runtime,proto3" json:"Runtime,omitempty"`
+	Size      uint64 `protobuf:"varint,8,opt,name=Size,proto3" json:"Size,omitempty"`
+	SHA256    string `protobuf:"bytes,9,opt,name=SHA256,proto3" json:"SHA256,omitempty"`
+	CreatedAt int64  `protobuf:"varint,10,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	Operator  string `protobuf:"bytes,11,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	Data      []byte `protobuf:"bytes,12,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (x *ShellcodeArtifact) Reset() {
+	*x = ShellcodeArtifact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[66]
+		mi := &file_clientpb_client_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Credential) String() string {
+func (x *ShellcodeArtifact) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Credential) ProtoMessage() {}
+func (*ShellcodeArtifact) ProtoMessage() {}
 
-func (x *Credential) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[66]
+func (x *ShellcodeArtifact) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5235,137 +5951,105 @@ func (x *Credential) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Credential.ProtoReflect.Descriptor instead.
-func (*Credential) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use ShellcodeArtifact.ProtoReflect.Descriptor instead.
+func (*ShellcodeArtifact) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Credential) GetUser() string {
+func (x *ShellcodeArtifact) GetID() string {
 	if x != nil {
-		return x.User
+		return x.ID
 	}
 	return ""
 }
 
-func (x *Credential) GetPassword() string {
+func (x *ShellcodeArtifact) GetName() string {
 	if x != nil {
-		return x.Password
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Credential) GetAPIKey() string {
+func (x *ShellcodeArtifact) GetFileName() string {
 	if x != nil {
-		return x.APIKey
+		return x.FileName
 	}
 	return ""
 }
 
-type Loot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Type           LootType       `protobuf:"varint,1,opt,name=Type,proto3,enum=clientpb.LootType" json:"Type,omitempty"`
-	LootID         string         `protobuf:"bytes,2,opt,name=LootID,proto3" json:"LootID,omitempty"`
-	Name           string         `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
-	CredentialType CredentialType `protobuf:"varint,4,opt,name=CredentialType,proto3,enum=clientpb.CredentialType" json:"CredentialType,omitempty"`
-	Credential     *Credential    `protobuf:"bytes,5,opt,name=Credential,proto3" json:"Credential,omitempty"`
-	FileType       FileType       `protobuf:"varint,6,opt,name=FileType,proto3,enum=clientpb.FileType" json:"FileType,omitempty"`
-	File           *commonpb.File `protobuf:"bytes,9,opt,name=File,proto3" json:"File,omitempty"`
-}
-
-func (x *Loot) Reset() {
-	*x = Loot{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[67]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ShellcodeArtifact) GetArch() string {
+	if x != nil {
+		return x.Arch
 	}
+	return ""
 }
 
-func (x *Loot) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Loot) ProtoMessage() {}
-
-func (x *Loot) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[67]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ShellcodeArtifact) GetIsDLL() bool {
+	if x != nil {
+		return x.IsDLL
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Loot.ProtoReflect.Descriptor instead.
-func (*Loot) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{67}
+	return false
 }
 
-func (x *Loot) GetType() LootType {
+func (x *ShellcodeArtifact) GetArguments() string {
 	if x != nil {
-		return x.Type
+		return x.Arguments
 	}
-	return LootType_LOOT_FILE
+	return ""
 }
 
-func (x *Loot) GetLootID() string {
+func (x *ShellcodeArtifact) GetRuntime() string {
 	if x != nil {
-		return x.LootID
+		return x.Runtime
 	}
 	return ""
 }
 
-func (x *Loot) GetName() string {
+func (x *ShellcodeArtifact) GetSize() uint64 {
 	if x != nil {
-		return x.Name
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *Loot) GetCredentialType() CredentialType {
+func (x *ShellcodeArtifact) GetSHA256() string {
 	if x != nil {
-		return x.CredentialType
+		return x.SHA256
 	}
-	return CredentialType_NO_CREDENTIAL
+	return ""
 }
 
-func (x *Loot) GetCredential() *Credential {
+func (x *ShellcodeArtifact) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Credential
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *Loot) GetFileType() FileType {
+func (x *ShellcodeArtifact) GetOperator() string {
 	if x != nil {
-		return x.FileType
+		return x.Operator
 	}
-	return FileType_NO_FILE
+	return ""
 }
 
-func (x *Loot) GetFile() *commonpb.File {
+func (x *ShellcodeArtifact) GetData() []byte {
 	if x != nil {
-		return x.File
+		return x.Data
 	}
 	return nil
 }
 
-type AllLoot struct {
+type ShellcodeArtifacts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Loot []*Loot `protobuf:"bytes,1,rep,name=Loot,proto3" json:"Loot,omitempty"`
+	Artifacts []*ShellcodeArtifact `protobuf:"bytes,1,rep,name=Artifacts,proto3" json:"Artifacts,omitempty"`
 }
 
-func (x *AllLoot) Reset() {
-	*x = AllLoot{}
+func (x *ShellcodeArtifacts) Reset() {
+	*x = ShellcodeArtifacts{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientpb_client_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5373,13 +6057,13 @@ func (x *AllLoot) Reset() {
 	}
 }
 
-func (x *AllLoot) String() string {
+func (x *ShellcodeArtifacts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllLoot) ProtoMessage() {}
+func (*ShellcodeArtifacts) ProtoMessage() {}
 
-func (x *AllLoot) ProtoReflect() protoreflect.Message {
+func (x *ShellcodeArtifacts) ProtoReflect() protoreflect.Message {
 	mi := &file_clientpb_client_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5391,31 +6075,34 @@ func (x *AllLoot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllLoot.ProtoReflect.Descriptor instead.
-func (*AllLoot) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShellcodeArtifacts.ProtoReflect.Descriptor instead.
+func (*ShellcodeArtifacts) Descriptor() ([]byte, []int) {
 	return file_clientpb_client_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *AllLoot) GetLoot() []*Loot {
+func (x *ShellcodeArtifacts) GetArtifacts() []*ShellcodeArtifact {
 	if x != nil {
-		return x.Loot
+		return x.Artifacts
 	}
 	return nil
 }
 
-// [ Hosts ] ----------------------------------------
-type IOC struct {
+type MsfStagerReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path     string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
-	FileHash string `protobuf:"bytes,2,opt,name=FileHash,proto3" json:"FileHash,omitempty"`
-	ID       string `protobuf:"bytes,3,opt,name=ID,proto3" json:"ID,omitempty"`
+	Arch     string        `protobuf:"bytes,1,opt,name=Arch,proto3" json:"Arch,omitempty"`
+	Format   string        `protobuf:"bytes,2,opt,name=Format,proto3" json:"Format,omitempty"`
+	Port     uint32        `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	Host     string        `protobuf:"bytes,4,opt,name=Host,proto3" json:"Host,omitempty"`
+	OS       string        `protobuf:"bytes,5,opt,name=OS,proto3" json:"OS,omitempty"` // reserved for future usage
+	Protocol StageProtocol `protobuf:"varint,6,opt,name=Protocol,proto3,enum=clientpb.StageProtocol" json:"Protocol,omitempty"`
+	BadChars []string      `protobuf:"bytes,7,rep,name=BadChars,proto3" json:"BadChars,omitempty"`
 }
 
-func (x *IOC) Reset() {
-	*x = IOC{}
+func (x *MsfStagerReq) Reset() {
+	*x = MsfStagerReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientpb_client_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5423,13 +6110,13 @@ func (x *IOC) Reset() {
 	}
 }
 
-func (x *IOC) String() string {
+func (x *MsfStagerReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IOC) ProtoMessage() {}
+func (*MsfStagerReq) ProtoMessage() {}
 
-func (x *IOC) ProtoReflect() protoreflect.Message {
+func (x *MsfStagerReq) ProtoReflect() protoreflect.Message {
 	mi := &file_clientpb_client_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5441,110 +6128,85 @@ func (x *IOC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IOC.ProtoReflect.Descriptor instead.
-func (*IOC) Descriptor() ([]byte, []int) {
+// Deprecated: Use MsfStagerReq.ProtoReflect.Descriptor instead.
+func (*MsfStagerReq) Descriptor() ([]byte, []int) {
 	return file_clientpb_client_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *IOC) GetPath() string {
+func (x *MsfStagerReq) GetArch() string {
 	if x != nil {
-		return x.Path
+		return x.Arch
 	}
 	return ""
 }
 
-func (x *IOC) GetFileHash() string {
+func (x *MsfStagerReq) GetFormat() string {
 	if x != nil {
-		return x.FileHash
+		return x.Format
 	}
 	return ""
 }
 
-func (x *IOC) GetID() string {
+func (x *MsfStagerReq) GetPort() uint32 {
 	if x != nil {
-		return x.ID
+		return x.Port
 	}
-	return ""
-}
-
-type ExtensionData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Output string `protobuf:"bytes,1,opt,name=Output,proto3" json:"Output,omitempty"`
+	return 0
 }
 
-func (x *ExtensionData) Reset() {
-	*x = ExtensionData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[70]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MsfStagerReq) GetHost() string {
+	if x != nil {
+		return x.Host
 	}
+	return ""
 }
 
-func (x *ExtensionData) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MsfStagerReq) GetOS() string {
+	if x != nil {
+		return x.OS
+	}
+	return ""
 }
 
-func (*ExtensionData) ProtoMessage() {}
-
-func (x *ExtensionData) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[70]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MsfStagerReq) GetProtocol() StageProtocol {
+	if x != nil {
+		return x.Protocol
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ExtensionData.ProtoReflect.Descriptor instead.
-func (*ExtensionData) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{70}
+	return StageProtocol_TCP
 }
 
-func (x *ExtensionData) GetOutput() string {
+func (x *MsfStagerReq) GetBadChars() []string {
 	if x != nil {
-		return x.Output
+		return x.BadChars
 	}
-	return ""
+	return nil
 }
 
-type Host struct {
+type MsfStager struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hostname      string                    `protobuf:"bytes,1,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
-	HostUUID      string                    `protobuf:"bytes,2,opt,name=HostUUID,proto3" json:"HostUUID,omitempty"`
-	OSVersion     string                    `protobuf:"bytes,3,opt,name=OSVersion,proto3" json:"OSVersion,omitempty"`
-	IOCs          []*IOC                    `protobuf:"bytes,4,rep,name=IOCs,proto3" json:"IOCs,omitempty"`
-	ExtensionData map[string]*ExtensionData `protobuf:"bytes,5,rep,name=ExtensionData,proto3" json:"ExtensionData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Locale        string                    `protobuf:"bytes,6,opt,name=Locale,proto3" json:"Locale,omitempty"`
-	FirstContact  int64                     `protobuf:"varint,7,opt,name=FirstContact,proto3" json:"FirstContact,omitempty"`
+	File *commonpb.File `protobuf:"bytes,1,opt,name=File,proto3" json:"File,omitempty"`
 }
 
-func (x *Host) Reset() {
-	*x = Host{}
+func (x *MsfStager) Reset() {
+	*x = MsfStager{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[71]
+		mi := &file_clientpb_client_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Host) String() string {
+func (x *MsfStager) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Host) ProtoMessage() {}
+func (*MsfStager) ProtoMessage() {}
 
-func (x *Host) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[71]
+func (x *MsfStager) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5555,85 +6217,48 @@ func (x *Host) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Host.ProtoReflect.Descriptor instead.
-func (*Host) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{71}
-}
-
-func (x *Host) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *Host) GetHostUUID() string {
-	if x != nil {
-		return x.HostUUID
-	}
-	return ""
-}
-
-func (x *Host) GetOSVersion() string {
-	if x != nil {
-		return x.OSVersion
-	}
-	return ""
-}
-
-func (x *Host) GetIOCs() []*IOC {
-	if x != nil {
-		return x.IOCs
-	}
-	return nil
+// Deprecated: Use MsfStager.ProtoReflect.Descriptor instead.
+func (*MsfStager) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *Host) GetExtensionData() map[string]*ExtensionData {
+func (x *MsfStager) GetFile() *commonpb.File {
 	if x != nil {
-		return x.ExtensionData
+		return x.File
 	}
 	return nil
 }
 
-func (x *Host) GetLocale() string {
-	if x != nil {
-		return x.Locale
-	}
-	return ""
-}
-
-func (x *Host) GetFirstContact() int64 {
-	if x != nil {
-		return x.FirstContact
-	}
-	return 0
-}
-
-type AllHosts struct {
+// GetSystemReq - Client request to the server which is translated into
+//
+//	InvokeSystemReq when sending to the implant.
+type GetSystemReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hosts []*Host `protobuf:"bytes,1,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+	HostingProcess string            `protobuf:"bytes,1,opt,name=HostingProcess,proto3" json:"HostingProcess,omitempty"`
+	Config         *ImplantConfig    `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+	Request        *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *AllHosts) Reset() {
-	*x = AllHosts{}
+func (x *GetSystemReq) Reset() {
+	*x = GetSystemReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[72]
+		mi := &file_clientpb_client_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllHosts) String() string {
+func (x *GetSystemReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllHosts) ProtoMessage() {}
+func (*GetSystemReq) ProtoMessage() {}
 
-func (x *AllHosts) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[72]
+func (x *GetSystemReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5644,49 +6269,64 @@ func (x *AllHosts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllHosts.ProtoReflect.Descriptor instead.
-func (*AllHosts) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use GetSystemReq.ProtoReflect.Descriptor instead.
+func (*GetSystemReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *AllHosts) GetHosts() []*Host {
+func (x *GetSystemReq) GetHostingProcess() string {
 	if x != nil {
-		return x.Hosts
+		return x.HostingProcess
+	}
+	return ""
+}
+
+func (x *GetSystemReq) GetConfig() *ImplantConfig {
+	if x != nil {
+		return x.Config
 	}
 	return nil
 }
 
-// [ Dll Hijack ] ----------------------------------------
-type DllHijackReq struct {
+func (x *GetSystemReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// MigrateReq - Client request to the server which is translated into
+//
+//	InvokeMigrateReq when sending to the implant.
+type MigrateReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReferenceDLLPath string            `protobuf:"bytes,1,opt,name=ReferenceDLLPath,proto3" json:"ReferenceDLLPath,omitempty"`
-	TargetLocation   string            `protobuf:"bytes,2,opt,name=TargetLocation,proto3" json:"TargetLocation,omitempty"`
-	ReferenceDLL     []byte            `protobuf:"bytes,3,opt,name=ReferenceDLL,proto3" json:"ReferenceDLL,omitempty"`
-	TargetDLL        []byte            `protobuf:"bytes,4,opt,name=TargetDLL,proto3" json:"TargetDLL,omitempty"`
-	ProfileName      string            `protobuf:"bytes,5,opt,name=ProfileName,proto3" json:"ProfileName,omitempty"`
-	Request          *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	Pid       uint32              `protobuf:"varint,1,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	Config    *ImplantConfig      `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+	Encoder   ShellcodeEncoder    `protobuf:"varint,3,opt,name=Encoder,proto3,enum=clientpb.ShellcodeEncoder" json:"Encoder,omitempty"`
+	Injection *sliverpb.Injection `protobuf:"bytes,4,opt,name=Injection,proto3" json:"Injection,omitempty"`
+	Request   *commonpb.Request   `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *DllHijackReq) Reset() {
-	*x = DllHijackReq{}
+func (x *MigrateReq) Reset() {
+	*x = MigrateReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[73]
+		mi := &file_clientpb_client_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DllHijackReq) String() string {
+func (x *MigrateReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DllHijackReq) ProtoMessage() {}
+func (*MigrateReq) ProtoMessage() {}
 
-func (x *DllHijackReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[73]
+func (x *MigrateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5697,78 +6337,72 @@ func (x *DllHijackReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DllHijackReq.ProtoReflect.Descriptor instead.
-func (*DllHijackReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{73}
-}
-
-func (x *DllHijackReq) GetReferenceDLLPath() string {
-	if x != nil {
-		return x.ReferenceDLLPath
-	}
-	return ""
+// Deprecated: Use MigrateReq.ProtoReflect.Descriptor instead.
+func (*MigrateReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *DllHijackReq) GetTargetLocation() string {
+func (x *MigrateReq) GetPid() uint32 {
 	if x != nil {
-		return x.TargetLocation
+		return x.Pid
 	}
-	return ""
+	return 0
 }
 
-func (x *DllHijackReq) GetReferenceDLL() []byte {
+func (x *MigrateReq) GetConfig() *ImplantConfig {
 	if x != nil {
-		return x.ReferenceDLL
+		return x.Config
 	}
 	return nil
 }
 
-func (x *DllHijackReq) GetTargetDLL() []byte {
+func (x *MigrateReq) GetEncoder() ShellcodeEncoder {
 	if x != nil {
-		return x.TargetDLL
+		return x.Encoder
 	}
-	return nil
+	return ShellcodeEncoder_NONE
 }
 
-func (x *DllHijackReq) GetProfileName() string {
+func (x *MigrateReq) GetInjection() *sliverpb.Injection {
 	if x != nil {
-		return x.ProfileName
+		return x.Injection
 	}
-	return ""
+	return nil
 }
 
-func (x *DllHijackReq) GetRequest() *commonpb.Request {
+func (x *MigrateReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type DllHijack struct {
+// [ Tunnels ] ----------------------------------------
+type CreateTunnelReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	Request *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
 }
 
-func (x *DllHijack) Reset() {
-	*x = DllHijack{}
+func (x *CreateTunnelReq) Reset() {
+	*x = CreateTunnelReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[74]
+		mi := &file_clientpb_client_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DllHijack) String() string {
+func (x *CreateTunnelReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DllHijack) ProtoMessage() {}
+func (*CreateTunnelReq) ProtoMessage() {}
 
-func (x *DllHijack) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[74]
+func (x *CreateTunnelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5779,48 +6413,44 @@ func (x *DllHijack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DllHijack.ProtoReflect.Descriptor instead.
-func (*DllHijack) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use CreateTunnelReq.ProtoReflect.Descriptor instead.
+func (*CreateTunnelReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *DllHijack) GetResponse() *commonpb.Response {
+func (x *CreateTunnelReq) GetRequest() *commonpb.Request {
 	if x != nil {
-		return x.Response
+		return x.Request
 	}
 	return nil
 }
 
-type ShellcodeEncodeReq struct {
+type CreateTunnel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Encoder      ShellcodeEncoder  `protobuf:"varint,1,opt,name=Encoder,proto3,enum=clientpb.ShellcodeEncoder" json:"Encoder,omitempty"`
-	Architecture string            `protobuf:"bytes,2,opt,name=Architecture,proto3" json:"Architecture,omitempty"`
-	Iterations   uint32            `protobuf:"varint,3,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
-	BadChars     []byte            `protobuf:"bytes,4,opt,name=BadChars,proto3" json:"BadChars,omitempty"`
-	Data         []byte            `protobuf:"bytes,8,opt,name=Data,proto3" json:"Data,omitempty"`
-	Request      *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+	SessionID uint32 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	TunnelID  uint64 `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
 }
 
-func (x *ShellcodeEncodeReq) Reset() {
-	*x = ShellcodeEncodeReq{}
+func (x *CreateTunnel) Reset() {
+	*x = CreateTunnel{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[75]
+		mi := &file_clientpb_client_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShellcodeEncodeReq) String() string {
+func (x *CreateTunnel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShellcodeEncodeReq) ProtoMessage() {}
+func (*CreateTunnel) ProtoMessage() {}
 
-func (x *ShellcodeEncodeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_clientpb_client_proto_msgTypes[75]
+func (x *CreateTunnel) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5831,64 +6461,97 @@ func (x *ShellcodeEncodeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShellcodeEncodeReq.ProtoReflect.Descriptor instead.
-func (*ShellcodeEncodeReq) Descriptor() ([]byte, []int) {
-	return file_clientpb_client_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use CreateTunnel.ProtoReflect.Descriptor instead.
+func (*CreateTunnel) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ShellcodeEncodeReq) GetEncoder() ShellcodeEncoder {
+func (x *CreateTunnel) GetSessionID() uint32 {
 	if x != nil {
-		return x.Encoder
+		return x.SessionID
 	}
-	return ShellcodeEncoder_NONE
+	return 0
 }
 
-func (x *ShellcodeEncodeReq) GetArchitecture() string {
+func (x *CreateTunnel) GetTunnelID() uint64 {
 	if x != nil {
-		return x.Architecture
+		return x.TunnelID
 	}
-	return ""
+	return 0
 }
 
-func (x *ShellcodeEncodeReq) GetIterations() uint32 {
-	if x != nil {
-		return x.Iterations
+type CloseTunnelReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TunnelID uint64            `protobuf:"varint,8,opt,name=TunnelID,proto3" json:"TunnelID,omitempty"`
+	Request  *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *CloseTunnelReq) Reset() {
+	*x = CloseTunnelReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ShellcodeEncodeReq) GetBadChars() []byte {
-	if x != nil {
-		return x.BadChars
+func (x *CloseTunnelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseTunnelReq) ProtoMessage() {}
+
+func (x *CloseTunnelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ShellcodeEncodeReq) GetData() []byte {
+// Deprecated: Use CloseTunnelReq.ProtoReflect.Descriptor instead.
+func (*CloseTunnelReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CloseTunnelReq) GetTunnelID() uint64 {
 	if x != nil {
-		return x.Data
+		return x.TunnelID
 	}
-	return nil
+	return 0
 }
 
-func (x *ShellcodeEncodeReq) GetRequest() *commonpb.Request {
+func (x *CloseTunnelReq) GetRequest() *commonpb.Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
-type ShellcodeEncode struct {
+// [ Pivots ] ----------------------------------------
+type PivotGraphEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data     []byte             `protobuf:"bytes,8,opt,name=Data,proto3" json:"Data,omitempty"`
-	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+	PeerID     int64              `protobuf:"varint,1,opt,name=PeerID,proto3" json:"PeerID,omitempty"`
+	Session    *Session           `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
+	Name       string             `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Children   []*PivotGraphEntry `protobuf:"bytes,4,rep,name=Children,proto3" json:"Children,omitempty"`
+	Transport  string             `protobuf:"bytes,5,opt,name=Transport,proto3" json:"Transport,omitempty"`    // How the implant connects to its parent (or the server)
+	Latency    int64              `protobuf:"varint,6,opt,name=Latency,proto3" json:"Latency,omitempty"`       // Round trip from the server, 0 if the implant didn't respond
+	HopLatency int64              `protobuf:"varint,7,opt,name=HopLatency,proto3" json:"HopLatency,omitempty"` // Latency minus the parent's latency
 }
 
-func (x *ShellcodeEncode) Reset() {
-	*x = ShellcodeEncode{}
+func (x *PivotGraphEntry) Reset() {
+	*x = PivotGraphEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientpb_client_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5896,13 +6559,13 @@ func (x *ShellcodeEncode) Reset() {
 	}
 }
 
-func (x *ShellcodeEncode) String() string {
+func (x *PivotGraphEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShellcodeEncode) ProtoMessage() {}
+func (*PivotGraphEntry) ProtoMessage() {}
 
-func (x *ShellcodeEncode) ProtoReflect() protoreflect.Message {
+func (x *PivotGraphEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_clientpb_client_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5914,35 +6577,70 @@ func (x *ShellcodeEncode) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShellcodeEncode.ProtoReflect.Descriptor instead.
-func (*ShellcodeEncode) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotGraphEntry.ProtoReflect.Descriptor instead.
+func (*PivotGraphEntry) Descriptor() ([]byte, []int) {
 	return file_clientpb_client_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ShellcodeEncode) GetData() []byte {
+func (x *PivotGraphEntry) GetPeerID() int64 {
 	if x != nil {
-		return x.Data
+		return x.PeerID
+	}
+	return 0
+}
+
+func (x *PivotGraphEntry) GetSession() *Session {
+	if x != nil {
+		return x.Session
 	}
 	return nil
 }
 
-func (x *ShellcodeEncode) GetResponse() *commonpb.Response {
+func (x *PivotGraphEntry) GetName() string {
 	if x != nil {
-		return x.Response
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PivotGraphEntry) GetChildren() []*PivotGraphEntry {
+	if x != nil {
+		return x.Children
 	}
 	return nil
 }
 
-type ShellcodeEncoderMap struct {
+func (x *PivotGraphEntry) GetTransport() string {
+	if x != nil {
+		return x.Transport
+	}
+	return ""
+}
+
+func (x *PivotGraphEntry) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *PivotGraphEntry) GetHopLatency() int64 {
+	if x != nil {
+		return x.HopLatency
+	}
+	return 0
+}
+
+type PivotGraph struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Encoders map[string]ShellcodeEncoder `protobuf:"bytes,1,rep,name=Encoders,proto3" json:"Encoders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=clientpb.ShellcodeEncoder"`
+	Children []*PivotGraphEntry `protobuf:"bytes,1,rep,name=Children,proto3" json:"Children,omitempty"`
 }
 
-func (x *ShellcodeEncoderMap) Reset() {
-	*x = ShellcodeEncoderMap{}
+func (x *PivotGraph) Reset() {
+	*x = PivotGraph{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientpb_client_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5950,13 +6648,13 @@ func (x *ShellcodeEncoderMap) Reset() {
 	}
 }
 
-func (x *ShellcodeEncoderMap) String() string {
+func (x *PivotGraph) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShellcodeEncoderMap) ProtoMessage() {}
+func (*PivotGraph) ProtoMessage() {}
 
-func (x *ShellcodeEncoderMap) ProtoReflect() protoreflect.Message {
+func (x *PivotGraph) ProtoReflect() protoreflect.Message {
 	mi := &file_clientpb_client_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5968,30 +6666,31 @@ func (x *ShellcodeEncoderMap) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShellcodeEncoderMap.ProtoReflect.Descriptor instead.
-func (*ShellcodeEncoderMap) Descriptor() ([]byte, []int) {
+// Deprecated: Use PivotGraph.ProtoReflect.Descriptor instead.
+func (*PivotGraph) Descriptor() ([]byte, []int) {
 	return file_clientpb_client_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ShellcodeEncoderMap) GetEncoders() map[string]ShellcodeEncoder {
+func (x *PivotGraph) GetChildren() []*PivotGraphEntry {
 	if x != nil {
-		return x.Encoders
+		return x.Children
 	}
 	return nil
 }
 
-// [ Builder ] ----------------------------------------
-type ExternalGenerateReq struct {
+// [ Events ] ----------------------------------------
+type Client struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Config      *ImplantConfig `protobuf:"bytes,1,opt,name=Config,proto3" json:"Config,omitempty"`
-	BuilderName string         `protobuf:"bytes,2,opt,name=BuilderName,proto3" json:"BuilderName,omitempty"`
+	ID       uint32    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name     string    `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Operator *Operator `protobuf:"bytes,3,opt,name=Operator,proto3" json:"Operator,omitempty"`
 }
 
-func (x *ExternalGenerateReq) Reset() {
-	*x = ExternalGenerateReq{}
+func (x *Client) Reset() {
+	*x = Client{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientpb_client_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5999,13 +6698,13 @@ func (x *ExternalGenerateReq) Reset() {
 	}
 }
 
-func (x *ExternalGenerateReq) String() string {
+func (x *Client) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalGenerateReq) ProtoMessage() {}
+func (*Client) ProtoMessage() {}
 
-func (x *ExternalGenerateReq) ProtoReflect() protoreflect.Message {
+func (x *Client) ProtoReflect() protoreflect.Message {
 	mi := &file_clientpb_client_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6017,35 +6716,47 @@ func (x *ExternalGenerateReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalGenerateReq.ProtoReflect.Descriptor instead.
-func (*ExternalGenerateReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use Client.ProtoReflect.Descriptor instead.
+func (*Client) Descriptor() ([]byte, []int) {
 	return file_clientpb_client_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ExternalGenerateReq) GetConfig() *ImplantConfig {
+func (x *Client) GetID() uint32 {
 	if x != nil {
-		return x.Config
+		return x.ID
 	}
-	return nil
+	return 0
 }
 
-func (x *ExternalGenerateReq) GetBuilderName() string {
+func (x *Client) GetName() string {
 	if x != nil {
-		return x.BuilderName
+		return x.Name
 	}
 	return ""
 }
 
-type Builders struct {
+func (x *Client) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+type Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Builders []*Builder `protobuf:"bytes,1,rep,name=Builders,proto3" json:"Builders,omitempty"`
+	EventType string   `protobuf:"bytes,1,opt,name=EventType,proto3" json:"EventType,omitempty"`
+	Session   *Session `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
+	Job       *Job     `protobuf:"bytes,3,opt,name=Job,proto3" json:"Job,omitempty"`
+	Client    *Client  `protobuf:"bytes,4,opt,name=Client,proto3" json:"Client,omitempty"`
+	Data      []byte   `protobuf:"bytes,5,opt,name=Data,proto3" json:"Data,omitempty"`
+	Err       string   `protobuf:"bytes,6,opt,name=Err,proto3" json:"Err,omitempty"` // Can't trigger normal gRPC error
 }
 
-func (x *Builders) Reset() {
-	*x = Builders{}
+func (x *Event) Reset() {
+	*x = Event{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_clientpb_client_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6053,13 +6764,13 @@ func (x *Builders) Reset() {
 	}
 }
 
-func (x *Builders) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Builders) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *Builders) ProtoReflect() protoreflect.Message {
+func (x *Event) ProtoReflect() protoreflect.Message {
 	mi := &file_clientpb_client_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6071,48 +6782,77 @@ func (x *Builders) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Builders.ProtoReflect.Descriptor instead.
-func (*Builders) Descriptor() ([]byte, []int) {
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
 	return file_clientpb_client_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *Builders) GetBuilders() []*Builder {
+func (x *Event) GetEventType() string {
 	if x != nil {
-		return x.Builders
+		return x.EventType
 	}
-	return nil
+	return ""
 }
 
-type Builder struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Event) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
 
-	Name           string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	OperatorName   string            `protobuf:"bytes,2,opt,name=OperatorName,proto3" json:"OperatorName,omitempty"`
-	GOOS           string            `protobuf:"bytes,3,opt,name=GOOS,proto3" json:"GOOS,omitempty"`     // The builder's OS
-	GOARCH         string            `protobuf:"bytes,4,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"` // The builder's Arch
-	Templates      []string          `protobuf:"bytes,5,rep,name=Templates,proto3" json:"Templates,omitempty"`
-	Targets        []*CompilerTarget `protobuf:"bytes,6,rep,name=Targets,proto3" json:"Targets,omitempty"`
-	CrossCompilers []*CrossCompiler  `protobuf:"bytes,7,rep,name=CrossCompilers,proto3" json:"CrossCompilers,omitempty"`
+func (x *Event) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
 }
 
-func (x *Builder) Reset() {
-	*x = Builder{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_clientpb_client_proto_msgTypes[80]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Event) GetClient() *Client {
+	if x != nil {
+		return x.Client
 	}
+	return nil
 }
 
-func (x *Builder) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Event) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
 }
 
-func (*Builder) ProtoMessage() {}
+func (x *Event) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
 
-func (x *Builder) ProtoReflect() protoreflect.Message {
+type Operators struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operators []*Operator `protobuf:"bytes,1,rep,name=Operators,proto3" json:"Operators,omitempty"`
+}
+
+func (x *Operators) Reset() {
+	*x = Operators{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operators) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operators) ProtoMessage() {}
+
+func (x *Operators) ProtoReflect() protoreflect.Message {
 	mi := &file_clientpb_client_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6124,1053 +6864,5178 @@ func (x *Builder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Builder.ProtoReflect.Descriptor instead.
-func (*Builder) Descriptor() ([]byte, []int) {
+// Deprecated: Use Operators.ProtoReflect.Descriptor instead.
+func (*Operators) Descriptor() ([]byte, []int) {
 	return file_clientpb_client_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *Builder) GetName() string {
+func (x *Operators) GetOperators() []*Operator {
+	if x != nil {
+		return x.Operators
+	}
+	return nil
+}
+
+type Operator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Online bool   `protobuf:"varint,1,opt,name=Online,proto3" json:"Online,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *Operator) Reset() {
+	*x = Operator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operator) ProtoMessage() {}
+
+func (x *Operator) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operator.ProtoReflect.Descriptor instead.
+func (*Operator) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Operator) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *Operator) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *Builder) GetOperatorName() string {
+type SecondFactorChallenge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"` // Empty if the operator is not enrolled
+	Challenge    []byte `protobuf:"bytes,2,opt,name=Challenge,proto3" json:"Challenge,omitempty"`
+	RelyingParty string `protobuf:"bytes,3,opt,name=RelyingParty,proto3" json:"RelyingParty,omitempty"`
+	CredentialID []byte `protobuf:"bytes,4,opt,name=CredentialID,proto3" json:"CredentialID,omitempty"`
+}
+
+func (x *SecondFactorChallenge) Reset() {
+	*x = SecondFactorChallenge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecondFactorChallenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecondFactorChallenge) ProtoMessage() {}
+
+func (x *SecondFactorChallenge) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecondFactorChallenge.ProtoReflect.Descriptor instead.
+func (*SecondFactorChallenge) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SecondFactorChallenge) GetType() string {
 	if x != nil {
-		return x.OperatorName
+		return x.Type
 	}
 	return ""
 }
 
-func (x *Builder) GetGOOS() string {
+func (x *SecondFactorChallenge) GetChallenge() []byte {
 	if x != nil {
-		return x.GOOS
+		return x.Challenge
+	}
+	return nil
+}
+
+func (x *SecondFactorChallenge) GetRelyingParty() string {
+	if x != nil {
+		return x.RelyingParty
 	}
 	return ""
 }
 
-func (x *Builder) GetGOARCH() string {
+func (x *SecondFactorChallenge) GetCredentialID() []byte {
 	if x != nil {
-		return x.GOARCH
+		return x.CredentialID
+	}
+	return nil
+}
+
+type SecondFactorReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code              string `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`           // TOTP
+	Challenge         []byte `protobuf:"bytes,2,opt,name=Challenge,proto3" json:"Challenge,omitempty"` // FIDO2
+	AuthenticatorData []byte `protobuf:"bytes,3,opt,name=AuthenticatorData,proto3" json:"AuthenticatorData,omitempty"`
+	Signature         []byte `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (x *SecondFactorReq) Reset() {
+	*x = SecondFactorReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SecondFactorReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecondFactorReq) ProtoMessage() {}
+
+func (x *SecondFactorReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecondFactorReq.ProtoReflect.Descriptor instead.
+func (*SecondFactorReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SecondFactorReq) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-func (x *Builder) GetTemplates() []string {
+func (x *SecondFactorReq) GetChallenge() []byte {
 	if x != nil {
-		return x.Templates
+		return x.Challenge
 	}
 	return nil
 }
 
-func (x *Builder) GetTargets() []*CompilerTarget {
+func (x *SecondFactorReq) GetAuthenticatorData() []byte {
 	if x != nil {
-		return x.Targets
+		return x.AuthenticatorData
 	}
 	return nil
 }
 
-func (x *Builder) GetCrossCompilers() []*CrossCompiler {
+func (x *SecondFactorReq) GetSignature() []byte {
 	if x != nil {
-		return x.CrossCompilers
+		return x.Signature
 	}
 	return nil
 }
 
-var File_clientpb_client_proto protoreflect.FileDescriptor
+type SecondFactor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_clientpb_client_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x4d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x69,
-	0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4d, 0x69, 0x6e, 0x6f, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x44, 0x69, 0x72, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x44,
-	0x69, 0x72, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x22, 0x93, 0x05, 0x0a, 0x07, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x49, 0x44, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x63, 0x68,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50,
-	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e,
-	0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x12, 0x18, 0x0a, 0x07,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x61, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x76, 0x61, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x44, 0x65, 0x61, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x49, 0x73, 0x44, 0x65, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x13, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e,
-	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55,
-	0x52, 0x4c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55,
-	0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x1a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x69,
-	0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x82,
-	0x06, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49,
-	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x47,
-	0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x49, 0x44, 0x12, 0x0e, 0x0a,
-	0x02, 0x4f, 0x53, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a,
-	0x04, 0x41, 0x72, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63,
-	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43,
-	0x32, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43,
-	0x32, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x45,
-	0x76, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x76,
-	0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x44, 0x65, 0x61, 0x64, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x44, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x0a,
-	0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x42,
-	0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x42, 0x75, 0x72,
-	0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b,
-	0x69, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
-	0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18,
-	0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x22, 0x35, 0x0a, 0x07, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x2a,
-	0x0a, 0x07, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x52, 0x07, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x22, 0xfe, 0x01, 0x0a, 0x0a, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x6e,
-	0x74, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x65, 0x6e, 0x74, 0x41,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x55, 0x0a, 0x0b, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x65,
-	0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x05, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x22, 0x53, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x32, 0x12,
-	0x1a, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x55,
-	0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c, 0x12, 0x18, 0x0a,
-	0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa6, 0x0c, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6c,
-	0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x22, 0x0a,
-	0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x4a, 0x69, 0x74, 0x74, 0x65,
-	0x72, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x61, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x76, 0x61, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x53, 0x79,
-	0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x4f, 0x62, 0x66,
-	0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x12, 0x22, 0x0a,
-	0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x41, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x18, 0x15, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x4d, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x4d, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x45, 0x43, 0x43, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x12, 0x45, 0x43, 0x43, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x43, 0x43, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x45,
-	0x43, 0x43, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x45,
-	0x43, 0x43, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x45, 0x43, 0x43, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
-	0x79, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x43, 0x43, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x15, 0x45, 0x43, 0x43, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x4d, 0x69, 0x6e, 0x69, 0x73,
-	0x69, 0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x4d, 0x69, 0x6e, 0x69, 0x73, 0x69,
-	0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x12, 0x2a, 0x0a, 0x10, 0x57, 0x47, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72,
-	0x69, 0x76, 0x4b, 0x65, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x57, 0x47, 0x49,
-	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x0a,
-	0x0e, 0x57, 0x47, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
-	0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x57, 0x47, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
-	0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x57, 0x47, 0x50, 0x65, 0x65, 0x72, 0x54,
-	0x75, 0x6e, 0x49, 0x50, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x57, 0x47, 0x50, 0x65,
-	0x65, 0x72, 0x54, 0x75, 0x6e, 0x49, 0x50, 0x12, 0x2c, 0x0a, 0x11, 0x57, 0x47, 0x4b, 0x65, 0x79,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x21, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x11, 0x57, 0x47, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x57, 0x47, 0x54, 0x63, 0x70, 0x43, 0x6f,
-	0x6d, 0x6d, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x57,
-	0x47, 0x54, 0x63, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x2c, 0x0a,
-	0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
-	0x61, 0x6c, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x4d,
-	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x73, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x2a, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
-	0x23, 0x0a, 0x02, 0x43, 0x32, 0x18, 0x32, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x32,
-	0x52, 0x02, 0x43, 0x32, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x33, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x61, 0x6e,
-	0x61, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
-	0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18,
-	0x3c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x24,
-	0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x40, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78,
-	0x69, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f, 0x63,
-	0x61, 0x6c, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
-	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x4c, 0x69, 0x62, 0x18, 0x65, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x53, 0x68, 0x65, 0x6c, 0x6c,
-	0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x4c, 0x6f, 0x61,
-	0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x4c, 0x6f,
-	0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x18,
-	0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65,
-	0x22, 0x66, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c,
-	0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x54,
-	0x50, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f,
-	0x54, 0x50, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x79, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65,
-	0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12,
-	0x22, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x46,
-	0x69, 0x6c, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x3e, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x53, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x0e, 0x43, 0x6f,
-	0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x47, 0x4f, 0x4f, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53,
-	0x12, 0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x2e, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x6f,
-	0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x47, 0x4f, 0x4f, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x16,
-	0x0a, 0x06, 0x43, 0x43, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x43, 0x43, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x58, 0x58, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x58, 0x58, 0x50, 0x61, 0x74, 0x68,
-	0x22, 0xf5, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f,
-	0x53, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x32, 0x0a, 0x07, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a,
-	0x0e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x0e,
-	0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x48,
-	0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x54, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x52, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x09, 0x44, 0x4e,
-	0x53, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6d,
-	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12,
-	0x26, 0x0a, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x61, 0x74, 0x65, 0x73,
-	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a,
-	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x3b, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x2f, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53,
-	0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x22, 0x1c, 0x0a, 0x0a, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x57, 0x47, 0x49, 0x50, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x50, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x22, 0x55,
-	0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x47, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x31,
-	0x0a, 0x0d, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12,
-	0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x95, 0x01, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12,
-	0x18, 0x0a, 0x07, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x07, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x2d, 0x0a, 0x04, 0x4a, 0x6f, 0x62,
-	0x73, 0x12, 0x25, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62,
-	0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x1c, 0x0a, 0x0a, 0x4b, 0x69, 0x6c, 0x6c,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x07, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f,
-	0x62, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49,
-	0x44, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x59, 0x0a, 0x0f, 0x4d,
-	0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12,
-	0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x73,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x9d, 0x01, 0x0a,
-	0x0d, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12,
-	0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f,
-	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x75, 0x6e, 0x49, 0x50, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x75, 0x6e, 0x49, 0x50, 0x12, 0x14, 0x0a, 0x05,
-	0x4e, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4e, 0x50, 0x6f,
-	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
-	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a,
-	0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f,
-	0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
-	0x22, 0xae, 0x01, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x54, 0x50, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x54,
-	0x50, 0x22, 0x23, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0xf5, 0x02, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f,
-	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61,
-	0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65,
-	0x63, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x65, 0x63, 0x75,
-	0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x43, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x43, 0x65, 0x72, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b,
-	0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x43, 0x4d, 0x45, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x04, 0x41, 0x43, 0x4d, 0x45, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x73,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x4f, 0x54, 0x50, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x45, 0x6e, 0x66, 0x6f,
-	0x72, 0x63, 0x65, 0x4f, 0x54, 0x50, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0f, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
-	0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x4a, 0x69, 0x74, 0x74,
-	0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x6c, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x4a, 0x41, 0x52, 0x4d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x4a, 0x41, 0x52, 0x4d, 0x22, 0x58,
-	0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12,
-	0x1a, 0x0a, 0x08, 0x50, 0x69, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x50, 0x69, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65,
-	0x64, 0x50, 0x69, 0x70, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x45,
-	0x72, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x54, 0x0a, 0x0b, 0x54, 0x43, 0x50, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x52, 0x65,
-	0x71, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x08, 0x54, 0x43, 0x50, 0x50,
-	0x69, 0x76, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10,
-	0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x45, 0x72, 0x72,
-	0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x24, 0x0a, 0x0c, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x39, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x59, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1c,
-	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x0b,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x06, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2e, 0x0a, 0x08,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0xb5, 0x01, 0x0a,
-	0x06, 0x4d, 0x53, 0x46, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x4c, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x49, 0x74, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xcd, 0x01, 0x0a, 0x0c, 0x4d, 0x53, 0x46, 0x52, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x4c, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x4c, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0xbe, 0x01, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x08, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48,
-	0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x43,
-	0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x43, 0x4d, 0x45, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x41, 0x43, 0x4d, 0x45, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x67, 0x0a,
-	0x0f, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x52, 0x65, 0x71,
-	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x46, 0x75, 0x6e, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x0c, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
-	0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xc3, 0x01, 0x0a, 0x0c, 0x4d,
-	0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x41,
-	0x72, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12,
-	0x16, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x48,
-	0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12,
-	0x33, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
-	0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73,
-	0x22, 0x2f, 0x0a, 0x09, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x12, 0x22, 0x0a,
-	0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x46, 0x69, 0x6c,
-	0x65, 0x22, 0x94, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x48, 0x6f, 0x73, 0x74,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x4d, 0x69, 0x67,
-	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a,
-	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71,
-	0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x0a,
-	0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x08, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
-	0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x0e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a,
-	0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x50,
-	0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16,
-	0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64,
-	0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x43,
-	0x0a, 0x0a, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x35, 0x0a, 0x08,
-	0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x47,
-	0x72, 0x61, 0x70, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64,
-	0x72, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x2e, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0xc3, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x53, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a,
-	0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x28, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x45, 0x72, 0x72, 0x22, 0x3d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0x36, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x74,
-	0x0a, 0x0a, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x16, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
-	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45,
-	0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73,
-	0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x43, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x51, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x73,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x07, 0x57,
-	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x51, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x39, 0x0a, 0x08, 0x57, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x08, 0x57, 0x65, 0x62,
-	0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x57, 0x47, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x10,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x22, 0x54, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x22, 0xa6,
-	0x02, 0x0a, 0x04, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0e, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x43,
-	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x2d, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x4c, 0x6f,
-	0x6f, 0x74, 0x12, 0x22, 0x0a, 0x04, 0x4c, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74,
-	0x52, 0x04, 0x4c, 0x6f, 0x6f, 0x74, 0x22, 0x45, 0x0a, 0x03, 0x49, 0x4f, 0x43, 0x12, 0x12, 0x0a,
-	0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x0a,
-	0x02, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x27, 0x0a,
-	0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
-	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0xdf, 0x02, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48,
-	0x6f, 0x73, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48,
-	0x6f, 0x73, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x53, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x53, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x04, 0x49, 0x4f, 0x43, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49,
-	0x4f, 0x43, 0x52, 0x04, 0x49, 0x4f, 0x43, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x69, 0x72,
-	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x1a, 0x59, 0x0a,
-	0x12, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x30, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x48,
-	0x6f, 0x73, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48,
-	0x6f, 0x73, 0x74, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x0c, 0x44,
-	0x6c, 0x6c, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x2a, 0x0a, 0x10, 0x52,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x4c, 0x4c, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x44, 0x4c, 0x4c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x4c, 0x4c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x44, 0x4c, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x4c, 0x4c,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x44, 0x4c,
-	0x4c, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x3b, 0x0a, 0x09, 0x44, 0x6c, 0x6c, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a,
-	0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xeb, 0x01,
-	0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b,
-	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x0f, 0x53,
-	0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x47, 0x0a, 0x08, 0x45, 0x6e,
-	0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64,
-	0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x2e, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x73, 0x1a, 0x57, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x68, 0x0a, 0x13,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49,
-	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
-	0x73, 0x22, 0x80, 0x02, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41,
-	0x52, 0x43, 0x48, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43,
-	0x48, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
-	0x32, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x54, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70,
-	0x69, 0x6c, 0x65, 0x72, 0x52, 0x0e, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69,
-	0x6c, 0x65, 0x72, 0x73, 0x2a, 0x5b, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x4c,
-	0x49, 0x42, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x48, 0x45, 0x4c, 0x4c, 0x43, 0x4f, 0x44,
-	0x45, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03,
-	0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x10,
-	0x04, 0x2a, 0x2d, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48,
-	0x54, 0x54, 0x50, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x02,
-	0x2a, 0x2e, 0x0a, 0x08, 0x4c, 0x6f, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09,
-	0x4c, 0x4f, 0x4f, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c,
-	0x4f, 0x4f, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01,
-	0x2a, 0x4d, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54,
-	0x49, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x41,
-	0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x50, 0x49, 0x5f,
-	0x4b, 0x45, 0x59, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x03, 0x2a,
-	0x2d, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4e,
-	0x4f, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41,
-	0x52, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x58, 0x54, 0x10, 0x02, 0x2a, 0x30,
-	0x0a, 0x10, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e,
-	0x53, 0x48, 0x49, 0x4b, 0x41, 0x54, 0x41, 0x5f, 0x47, 0x41, 0x5f, 0x4e, 0x41, 0x49, 0x10, 0x01,
-	0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62,
-	0x69, 0x73, 0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78, 0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Token string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
 }
 
-var (
-	file_clientpb_client_proto_rawDescOnce sync.Once
-	file_clientpb_client_proto_rawDescData = file_clientpb_client_proto_rawDesc
-)
+func (x *SecondFactor) Reset() {
+	*x = SecondFactor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_clientpb_client_proto_rawDescGZIP() []byte {
-	file_clientpb_client_proto_rawDescOnce.Do(func() {
-		file_clientpb_client_proto_rawDescData = protoimpl.X.CompressGZIP(file_clientpb_client_proto_rawDescData)
-	})
-	return file_clientpb_client_proto_rawDescData
+func (x *SecondFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_clientpb_client_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_clientpb_client_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
-var file_clientpb_client_proto_goTypes = []interface{}{
-	(OutputFormat)(0),             // 0: clientpb.OutputFormat
-	(StageProtocol)(0),            // 1: clientpb.StageProtocol
-	(LootType)(0),                 // 2: clientpb.LootType
-	(CredentialType)(0),           // 3: clientpb.CredentialType
-	(FileType)(0),                 // 4: clientpb.FileType
-	(ShellcodeEncoder)(0),         // 5: clientpb.ShellcodeEncoder
-	(*Version)(nil),               // 6: clientpb.Version
-	(*Session)(nil),               // 7: clientpb.Session
-	(*Beacon)(nil),                // 8: clientpb.Beacon
-	(*Beacons)(nil),               // 9: clientpb.Beacons
-	(*BeaconTask)(nil),            // 10: clientpb.BeaconTask
-	(*BeaconTasks)(nil),           // 11: clientpb.BeaconTasks
-	(*ImplantC2)(nil),             // 12: clientpb.ImplantC2
-	(*ImplantConfig)(nil),         // 13: clientpb.ImplantConfig
-	(*ExternalImplantConfig)(nil), // 14: clientpb.ExternalImplantConfig
-	(*ExternalImplantBinary)(nil), // 15: clientpb.ExternalImplantBinary
-	(*ImplantBuilds)(nil),         // 16: clientpb.ImplantBuilds
-	(*CompilerTarget)(nil),        // 17: clientpb.CompilerTarget
-	(*CrossCompiler)(nil),         // 18: clientpb.CrossCompiler
-	(*Compiler)(nil),              // 19: clientpb.Compiler
-	(*DeleteReq)(nil),             // 20: clientpb.DeleteReq
-	(*DNSCanary)(nil),             // 21: clientpb.DNSCanary
-	(*Canaries)(nil),              // 22: clientpb.Canaries
-	(*UniqueWGIP)(nil),            // 23: clientpb.UniqueWGIP
-	(*ImplantProfile)(nil),        // 24: clientpb.ImplantProfile
-	(*ImplantProfiles)(nil),       // 25: clientpb.ImplantProfiles
-	(*RegenerateReq)(nil),         // 26: clientpb.RegenerateReq
-	(*Job)(nil),                   // 27: clientpb.Job
-	(*Jobs)(nil),                  // 28: clientpb.Jobs
-	(*KillJobReq)(nil),            // 29: clientpb.KillJobReq
-	(*KillJob)(nil),               // 30: clientpb.KillJob
-	(*MTLSListenerReq)(nil),       // 31: clientpb.MTLSListenerReq
-	(*MTLSListener)(nil),          // 32: clientpb.MTLSListener
-	(*WGListenerReq)(nil),         // 33: clientpb.WGListenerReq
-	(*WGListener)(nil),            // 34: clientpb.WGListener
-	(*DNSListenerReq)(nil),        // 35: clientpb.DNSListenerReq
-	(*DNSListener)(nil),           // 36: clientpb.DNSListener
-	(*HTTPListenerReq)(nil),       // 37: clientpb.HTTPListenerReq
-	(*NamedPipesReq)(nil),         // 38: clientpb.NamedPipesReq
-	(*NamedPipes)(nil),            // 39: clientpb.NamedPipes
-	(*TCPPivotReq)(nil),           // 40: clientpb.TCPPivotReq
-	(*TCPPivot)(nil),              // 41: clientpb.TCPPivot
-	(*HTTPListener)(nil),          // 42: clientpb.HTTPListener
-	(*Sessions)(nil),              // 43: clientpb.Sessions
-	(*RenameReq)(nil),             // 44: clientpb.RenameReq
-	(*GenerateReq)(nil),           // 45: clientpb.GenerateReq
-	(*Generate)(nil),              // 46: clientpb.Generate
-	(*MSFReq)(nil),                // 47: clientpb.MSFReq
-	(*MSFRemoteReq)(nil),          // 48: clientpb.MSFRemoteReq
-	(*StagerListenerReq)(nil),     // 49: clientpb.StagerListenerReq
-	(*StagerListener)(nil),        // 50: clientpb.StagerListener
-	(*ShellcodeRDIReq)(nil),       // 51: clientpb.ShellcodeRDIReq
-	(*ShellcodeRDI)(nil),          // 52: clientpb.ShellcodeRDI
-	(*MsfStagerReq)(nil),          // 53: clientpb.MsfStagerReq
-	(*MsfStager)(nil),             // 54: clientpb.MsfStager
-	(*GetSystemReq)(nil),          // 55: clientpb.GetSystemReq
-	(*MigrateReq)(nil),            // 56: clientpb.MigrateReq
-	(*CreateTunnelReq)(nil),       // 57: clientpb.CreateTunnelReq
-	(*CreateTunnel)(nil),          // 58: clientpb.CreateTunnel
-	(*CloseTunnelReq)(nil),        // 59: clientpb.CloseTunnelReq
-	(*PivotGraphEntry)(nil),       // 60: clientpb.PivotGraphEntry
-	(*PivotGraph)(nil),            // 61: clientpb.PivotGraph
-	(*Client)(nil),                // 62: clientpb.Client
-	(*Event)(nil),                 // 63: clientpb.Event
-	(*Operators)(nil),             // 64: clientpb.Operators
-	(*Operator)(nil),              // 65: clientpb.Operator
-	(*WebContent)(nil),            // 66: clientpb.WebContent
-	(*WebsiteAddContent)(nil),     // 67: clientpb.WebsiteAddContent
-	(*WebsiteRemoveContent)(nil),  // 68: clientpb.WebsiteRemoveContent
-	(*Website)(nil),               // 69: clientpb.Website
-	(*Websites)(nil),              // 70: clientpb.Websites
-	(*WGClientConfig)(nil),        // 71: clientpb.WGClientConfig
-	(*Credential)(nil),            // 72: clientpb.Credential
-	(*Loot)(nil),                  // 73: clientpb.Loot
-	(*AllLoot)(nil),               // 74: clientpb.AllLoot
-	(*IOC)(nil),                   // 75: clientpb.IOC
-	(*ExtensionData)(nil),         // 76: clientpb.ExtensionData
-	(*Host)(nil),                  // 77: clientpb.Host
-	(*AllHosts)(nil),              // 78: clientpb.AllHosts
-	(*DllHijackReq)(nil),          // 79: clientpb.DllHijackReq
-	(*DllHijack)(nil),             // 80: clientpb.DllHijack
-	(*ShellcodeEncodeReq)(nil),    // 81: clientpb.ShellcodeEncodeReq
-	(*ShellcodeEncode)(nil),       // 82: clientpb.ShellcodeEncode
-	(*ShellcodeEncoderMap)(nil),   // 83: clientpb.ShellcodeEncoderMap
-	(*ExternalGenerateReq)(nil),   // 84: clientpb.ExternalGenerateReq
-	(*Builders)(nil),              // 85: clientpb.Builders
-	(*Builder)(nil),               // 86: clientpb.Builder
-	nil,                           // 87: clientpb.ImplantBuilds.ConfigsEntry
-	nil,                           // 88: clientpb.WebsiteAddContent.ContentsEntry
-	nil,                           // 89: clientpb.Website.ContentsEntry
-	nil,                           // 90: clientpb.Host.ExtensionDataEntry
-	nil,                           // 91: clientpb.ShellcodeEncoderMap.EncodersEntry
-	(*commonpb.File)(nil),         // 92: commonpb.File
-	(*commonpb.Request)(nil),      // 93: commonpb.Request
-	(*commonpb.Response)(nil),     // 94: commonpb.Response
+func (*SecondFactor) ProtoMessage() {}
+
+func (x *SecondFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_clientpb_client_proto_depIdxs = []int32{
-	8,  // 0: clientpb.Beacons.Beacons:type_name -> clientpb.Beacon
-	10, // 1: clientpb.BeaconTasks.Tasks:type_name -> clientpb.BeaconTask
-	12, // 2: clientpb.ImplantConfig.C2:type_name -> clientpb.ImplantC2
-	0,  // 3: clientpb.ImplantConfig.Format:type_name -> clientpb.OutputFormat
-	13, // 4: clientpb.ExternalImplantConfig.Config:type_name -> clientpb.ImplantConfig
-	92, // 5: clientpb.ExternalImplantBinary.File:type_name -> commonpb.File
-	87, // 6: clientpb.ImplantBuilds.Configs:type_name -> clientpb.ImplantBuilds.ConfigsEntry
-	0,  // 7: clientpb.CompilerTarget.Format:type_name -> clientpb.OutputFormat
-	17, // 8: clientpb.Compiler.Targets:type_name -> clientpb.CompilerTarget
-	18, // 9: clientpb.Compiler.CrossCompilers:type_name -> clientpb.CrossCompiler
-	17, // 10: clientpb.Compiler.UnsupportedTargets:type_name -> clientpb.CompilerTarget
-	21, // 11: clientpb.Canaries.Canaries:type_name -> clientpb.DNSCanary
-	13, // 12: clientpb.ImplantProfile.Config:type_name -> clientpb.ImplantConfig
-	24, // 13: clientpb.ImplantProfiles.Profiles:type_name -> clientpb.ImplantProfile
-	27, // 14: clientpb.Jobs.Active:type_name -> clientpb.Job
-	93, // 15: clientpb.NamedPipesReq.Request:type_name -> commonpb.Request
-	94, // 16: clientpb.NamedPipes.Response:type_name -> commonpb.Response
-	93, // 17: clientpb.TCPPivotReq.Request:type_name -> commonpb.Request
-	94, // 18: clientpb.TCPPivot.Response:type_name -> commonpb.Response
-	7,  // 19: clientpb.Sessions.Sessions:type_name -> clientpb.Session
-	13, // 20: clientpb.GenerateReq.Config:type_name -> clientpb.ImplantConfig
-	92, // 21: clientpb.Generate.File:type_name -> commonpb.File
-	93, // 22: clientpb.MSFReq.Request:type_name -> commonpb.Request
-	93, // 23: clientpb.MSFRemoteReq.Request:type_name -> commonpb.Request
-	1,  // 24: clientpb.StagerListenerReq.Protocol:type_name -> clientpb.StageProtocol
-	1,  // 25: clientpb.MsfStagerReq.Protocol:type_name -> clientpb.StageProtocol
-	92, // 26: clientpb.MsfStager.File:type_name -> commonpb.File
-	13, // 27: clientpb.GetSystemReq.Config:type_name -> clientpb.ImplantConfig
-	93, // 28: clientpb.GetSystemReq.Request:type_name -> commonpb.Request
-	13, // 29: clientpb.MigrateReq.Config:type_name -> clientpb.ImplantConfig
-	5,  // 30: clientpb.MigrateReq.Encoder:type_name -> clientpb.ShellcodeEncoder
-	93, // 31: clientpb.MigrateReq.Request:type_name -> commonpb.Request
-	93, // 32: clientpb.CreateTunnelReq.Request:type_name -> commonpb.Request
-	93, // 33: clientpb.CloseTunnelReq.Request:type_name -> commonpb.Request
-	7,  // 34: clientpb.PivotGraphEntry.Session:type_name -> clientpb.Session
-	60, // 35: clientpb.PivotGraphEntry.Children:type_name -> clientpb.PivotGraphEntry
-	60, // 36: clientpb.PivotGraph.Children:type_name -> clientpb.PivotGraphEntry
-	65, // 37: clientpb.Client.Operator:type_name -> clientpb.Operator
-	7,  // 38: clientpb.Event.Session:type_name -> clientpb.Session
-	27, // 39: clientpb.Event.Job:type_name -> clientpb.Job
-	62, // 40: clientpb.Event.Client:type_name -> clientpb.Client
-	65, // 41: clientpb.Operators.Operators:type_name -> clientpb.Operator
-	88, // 42: clientpb.WebsiteAddContent.Contents:type_name -> clientpb.WebsiteAddContent.ContentsEntry
-	89, // 43: clientpb.Website.Contents:type_name -> clientpb.Website.ContentsEntry
-	69, // 44: clientpb.Websites.Websites:type_name -> clientpb.Website
-	2,  // 45: clientpb.Loot.Type:type_name -> clientpb.LootType
-	3,  // 46: clientpb.Loot.CredentialType:type_name -> clientpb.CredentialType
-	72, // 47: clientpb.Loot.Credential:type_name -> clientpb.Credential
-	4,  // 48: clientpb.Loot.FileType:type_name -> clientpb.FileType
-	92, // 49: clientpb.Loot.File:type_name -> commonpb.File
-	73, // 50: clientpb.AllLoot.Loot:type_name -> clientpb.Loot
-	75, // 51: clientpb.Host.IOCs:type_name -> clientpb.IOC
-	90, // 52: clientpb.Host.ExtensionData:type_name -> clientpb.Host.ExtensionDataEntry
-	77, // 53: clientpb.AllHosts.Hosts:type_name -> clientpb.Host
-	93, // 54: clientpb.DllHijackReq.Request:type_name -> commonpb.Request
-	94, // 55: clientpb.DllHijack.Response:type_name -> commonpb.Response
-	5,  // 56: clientpb.ShellcodeEncodeReq.Encoder:type_name -> clientpb.ShellcodeEncoder
-	93, // 57: clientpb.ShellcodeEncodeReq.Request:type_name -> commonpb.Request
-	94, // 58: clientpb.ShellcodeEncode.Response:type_name -> commonpb.Response
-	91, // 59: clientpb.ShellcodeEncoderMap.Encoders:type_name -> clientpb.ShellcodeEncoderMap.EncodersEntry
-	13, // 60: clientpb.ExternalGenerateReq.Config:type_name -> clientpb.ImplantConfig
-	86, // 61: clientpb.Builders.Builders:type_name -> clientpb.Builder
-	17, // 62: clientpb.Builder.Targets:type_name -> clientpb.CompilerTarget
-	18, // 63: clientpb.Builder.CrossCompilers:type_name -> clientpb.CrossCompiler
-	13, // 64: clientpb.ImplantBuilds.ConfigsEntry.value:type_name -> clientpb.ImplantConfig
-	66, // 65: clientpb.WebsiteAddContent.ContentsEntry.value:type_name -> clientpb.WebContent
-	66, // 66: clientpb.Website.ContentsEntry.value:type_name -> clientpb.WebContent
-	76, // 67: clientpb.Host.ExtensionDataEntry.value:type_name -> clientpb.ExtensionData
-	5,  // 68: clientpb.ShellcodeEncoderMap.EncodersEntry.value:type_name -> clientpb.ShellcodeEncoder
-	69, // [69:69] is the sub-list for method output_type
-	69, // [69:69] is the sub-list for method input_type
-	69, // [69:69] is the sub-list for extension type_name
-	69, // [69:69] is the sub-list for extension extendee
-	0,  // [0:69] is the sub-list for field type_name
+
+// Deprecated: Use SecondFactor.ProtoReflect.Descriptor instead.
+func (*SecondFactor) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{84}
 }
 
-func init() { file_clientpb_client_proto_init() }
-func file_clientpb_client_proto_init() {
-	if File_clientpb_client_proto != nil {
-		return
+func (x *SecondFactor) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_clientpb_client_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Version); i {
+	return ""
+}
+
+// [ Websites ] ----------------------------------------
+type WebContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path        string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	ContentType string `protobuf:"bytes,2,opt,name=ContentType,proto3" json:"ContentType,omitempty"`
+	Size        uint64 `protobuf:"varint,3,opt,name=Size,proto3" json:"Size,omitempty"`
+	Content     []byte `protobuf:"bytes,9,opt,name=Content,proto3" json:"Content,omitempty"`
+}
+
+func (x *WebContent) Reset() {
+	*x = WebContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebContent) ProtoMessage() {}
+
+func (x *WebContent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebContent.ProtoReflect.Descriptor instead.
+func (*WebContent) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *WebContent) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WebContent) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *WebContent) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *WebContent) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type WebsiteAddContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Contents map[string]*WebContent `protobuf:"bytes,2,rep,name=Contents,proto3" json:"Contents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WebsiteAddContent) Reset() {
+	*x = WebsiteAddContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebsiteAddContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteAddContent) ProtoMessage() {}
+
+func (x *WebsiteAddContent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteAddContent.ProtoReflect.Descriptor instead.
+func (*WebsiteAddContent) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *WebsiteAddContent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebsiteAddContent) GetContents() map[string]*WebContent {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+type WebsiteRemoveContent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Paths []string `protobuf:"bytes,2,rep,name=Paths,proto3" json:"Paths,omitempty"`
+}
+
+func (x *WebsiteRemoveContent) Reset() {
+	*x = WebsiteRemoveContent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebsiteRemoveContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebsiteRemoveContent) ProtoMessage() {}
+
+func (x *WebsiteRemoveContent) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebsiteRemoveContent.ProtoReflect.Descriptor instead.
+func (*WebsiteRemoveContent) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *WebsiteRemoveContent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WebsiteRemoveContent) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type Website struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Contents map[string]*WebContent `protobuf:"bytes,2,rep,name=Contents,proto3" json:"Contents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Website) Reset() {
+	*x = Website{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Website) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Website) ProtoMessage() {}
+
+func (x *Website) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Website.ProtoReflect.Descriptor instead.
+func (*Website) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Website) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Website) GetContents() map[string]*WebContent {
+	if x != nil {
+		return x.Contents
+	}
+	return nil
+}
+
+type Websites struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Websites []*Website `protobuf:"bytes,1,rep,name=Websites,proto3" json:"Websites,omitempty"`
+}
+
+func (x *Websites) Reset() {
+	*x = Websites{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Websites) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Websites) ProtoMessage() {}
+
+func (x *Websites) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Websites.ProtoReflect.Descriptor instead.
+func (*Websites) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *Websites) GetWebsites() []*Website {
+	if x != nil {
+		return x.Websites
+	}
+	return nil
+}
+
+type WGClientConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerPubKey     string `protobuf:"bytes,1,opt,name=ServerPubKey,proto3" json:"ServerPubKey,omitempty"`
+	ClientPrivateKey string `protobuf:"bytes,2,opt,name=ClientPrivateKey,proto3" json:"ClientPrivateKey,omitempty"`
+	ClientPubKey     string `protobuf:"bytes,3,opt,name=ClientPubKey,proto3" json:"ClientPubKey,omitempty"`
+	ClientIP         string `protobuf:"bytes,4,opt,name=ClientIP,proto3" json:"ClientIP,omitempty"`
+}
+
+func (x *WGClientConfig) Reset() {
+	*x = WGClientConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WGClientConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WGClientConfig) ProtoMessage() {}
+
+func (x *WGClientConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WGClientConfig.ProtoReflect.Descriptor instead.
+func (*WGClientConfig) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *WGClientConfig) GetServerPubKey() string {
+	if x != nil {
+		return x.ServerPubKey
+	}
+	return ""
+}
+
+func (x *WGClientConfig) GetClientPrivateKey() string {
+	if x != nil {
+		return x.ClientPrivateKey
+	}
+	return ""
+}
+
+func (x *WGClientConfig) GetClientPubKey() string {
+	if x != nil {
+		return x.ClientPubKey
+	}
+	return ""
+}
+
+func (x *WGClientConfig) GetClientIP() string {
+	if x != nil {
+		return x.ClientIP
+	}
+	return ""
+}
+
+type Credential struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// USER_PASSWORD
+	User     string `protobuf:"bytes,2,opt,name=User,proto3" json:"User,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
+	// API_KEY
+	APIKey      string                  `protobuf:"bytes,4,opt,name=APIKey,proto3" json:"APIKey,omitempty"`
+	Validations []*CredentialValidation `protobuf:"bytes,5,rep,name=Validations,proto3" json:"Validations,omitempty"`
+	// HASH, in hashcat's format
+	Hash     string `protobuf:"bytes,6,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	HashType string `protobuf:"bytes,7,opt,name=HashType,proto3" json:"HashType,omitempty"`
+}
+
+func (x *Credential) Reset() {
+	*x = Credential{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Credential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credential) ProtoMessage() {}
+
+func (x *Credential) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Credential.ProtoReflect.Descriptor instead.
+func (*Credential) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *Credential) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *Credential) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Credential) GetAPIKey() string {
+	if x != nil {
+		return x.APIKey
+	}
+	return ""
+}
+
+func (x *Credential) GetValidations() []*CredentialValidation {
+	if x != nil {
+		return x.Validations
+	}
+	return nil
+}
+
+func (x *Credential) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Credential) GetHashType() string {
+	if x != nil {
+		return x.HashType
+	}
+	return ""
+}
+
+type CredentialValidation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol    string `protobuf:"bytes,1,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Target      string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	Status      string `protobuf:"bytes,3,opt,name=Status,proto3" json:"Status,omitempty"`
+	Error       string `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	ValidatedAt int64  `protobuf:"varint,5,opt,name=ValidatedAt,proto3" json:"ValidatedAt,omitempty"`
+}
+
+func (x *CredentialValidation) Reset() {
+	*x = CredentialValidation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CredentialValidation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialValidation) ProtoMessage() {}
+
+func (x *CredentialValidation) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialValidation.ProtoReflect.Descriptor instead.
+func (*CredentialValidation) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CredentialValidation) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *CredentialValidation) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CredentialValidation) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CredentialValidation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CredentialValidation) GetValidatedAt() int64 {
+	if x != nil {
+		return x.ValidatedAt
+	}
+	return 0
+}
+
+type Loot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type           LootType       `protobuf:"varint,1,opt,name=Type,proto3,enum=clientpb.LootType" json:"Type,omitempty"`
+	LootID         string         `protobuf:"bytes,2,opt,name=LootID,proto3" json:"LootID,omitempty"`
+	Name           string         `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	CredentialType CredentialType `protobuf:"varint,4,opt,name=CredentialType,proto3,enum=clientpb.CredentialType" json:"CredentialType,omitempty"`
+	Credential     *Credential    `protobuf:"bytes,5,opt,name=Credential,proto3" json:"Credential,omitempty"`
+	FileType       FileType       `protobuf:"varint,6,opt,name=FileType,proto3,enum=clientpb.FileType" json:"FileType,omitempty"`
+	File           *commonpb.File `protobuf:"bytes,9,opt,name=File,proto3" json:"File,omitempty"`
+	OriginHostUUID string         `protobuf:"bytes,10,opt,name=OriginHostUUID,proto3" json:"OriginHostUUID,omitempty"` // Host the loot was taken from, if it's known
+}
+
+func (x *Loot) Reset() {
+	*x = Loot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loot) ProtoMessage() {}
+
+func (x *Loot) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loot.ProtoReflect.Descriptor instead.
+func (*Loot) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Loot) GetType() LootType {
+	if x != nil {
+		return x.Type
+	}
+	return LootType_LOOT_FILE
+}
+
+func (x *Loot) GetLootID() string {
+	if x != nil {
+		return x.LootID
+	}
+	return ""
+}
+
+func (x *Loot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Loot) GetCredentialType() CredentialType {
+	if x != nil {
+		return x.CredentialType
+	}
+	return CredentialType_NO_CREDENTIAL
+}
+
+func (x *Loot) GetCredential() *Credential {
+	if x != nil {
+		return x.Credential
+	}
+	return nil
+}
+
+func (x *Loot) GetFileType() FileType {
+	if x != nil {
+		return x.FileType
+	}
+	return FileType_NO_FILE
+}
+
+func (x *Loot) GetFile() *commonpb.File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *Loot) GetOriginHostUUID() string {
+	if x != nil {
+		return x.OriginHostUUID
+	}
+	return ""
+}
+
+type AllLoot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Loot []*Loot `protobuf:"bytes,1,rep,name=Loot,proto3" json:"Loot,omitempty"`
+}
+
+func (x *AllLoot) Reset() {
+	*x = AllLoot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllLoot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllLoot) ProtoMessage() {}
+
+func (x *AllLoot) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllLoot.ProtoReflect.Descriptor instead.
+func (*AllLoot) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AllLoot) GetLoot() []*Loot {
+	if x != nil {
+		return x.Loot
+	}
+	return nil
+}
+
+// [ Hosts ] ----------------------------------------
+type IOC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string `protobuf:"bytes,1,opt,name=Path,proto3" json:"Path,omitempty"`
+	FileHash string `protobuf:"bytes,2,opt,name=FileHash,proto3" json:"FileHash,omitempty"`
+	ID       string `protobuf:"bytes,3,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *IOC) Reset() {
+	*x = IOC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IOC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IOC) ProtoMessage() {}
+
+func (x *IOC) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IOC.ProtoReflect.Descriptor instead.
+func (*IOC) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *IOC) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *IOC) GetFileHash() string {
+	if x != nil {
+		return x.FileHash
+	}
+	return ""
+}
+
+func (x *IOC) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type ExtensionData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output string `protobuf:"bytes,1,opt,name=Output,proto3" json:"Output,omitempty"`
+}
+
+func (x *ExtensionData) Reset() {
+	*x = ExtensionData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExtensionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtensionData) ProtoMessage() {}
+
+func (x *ExtensionData) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtensionData.ProtoReflect.Descriptor instead.
+func (*ExtensionData) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ExtensionData) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type Host struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname          string                    `protobuf:"bytes,1,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	HostUUID          string                    `protobuf:"bytes,2,opt,name=HostUUID,proto3" json:"HostUUID,omitempty"`
+	OSVersion         string                    `protobuf:"bytes,3,opt,name=OSVersion,proto3" json:"OSVersion,omitempty"`
+	IOCs              []*IOC                    `protobuf:"bytes,4,rep,name=IOCs,proto3" json:"IOCs,omitempty"`
+	ExtensionData     map[string]*ExtensionData `protobuf:"bytes,5,rep,name=ExtensionData,proto3" json:"ExtensionData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Locale            string                    `protobuf:"bytes,6,opt,name=Locale,proto3" json:"Locale,omitempty"`
+	FirstContact      int64                     `protobuf:"varint,7,opt,name=FirstContact,proto3" json:"FirstContact,omitempty"`
+	LocalGroupMembers []*HostLocalGroupMember   `protobuf:"bytes,8,rep,name=LocalGroupMembers,proto3" json:"LocalGroupMembers,omitempty"`
+	// Hosts found by parsing tool output have an address instead of an implant
+	Address    string         `protobuf:"bytes,9,opt,name=Address,proto3" json:"Address,omitempty"`
+	Services   []*HostService `protobuf:"bytes,10,rep,name=Services,proto3" json:"Services,omitempty"`
+	Tags       []string       `protobuf:"bytes,11,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	SurveyedAt int64          `protobuf:"varint,12,opt,name=SurveyedAt,proto3" json:"SurveyedAt,omitempty"` // Time of the stored survey, 0 if never surveyed
+}
+
+func (x *Host) Reset() {
+	*x = Host{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Host) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Host) ProtoMessage() {}
+
+func (x *Host) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Host.ProtoReflect.Descriptor instead.
+func (*Host) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Host) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Host) GetHostUUID() string {
+	if x != nil {
+		return x.HostUUID
+	}
+	return ""
+}
+
+func (x *Host) GetOSVersion() string {
+	if x != nil {
+		return x.OSVersion
+	}
+	return ""
+}
+
+func (x *Host) GetIOCs() []*IOC {
+	if x != nil {
+		return x.IOCs
+	}
+	return nil
+}
+
+func (x *Host) GetExtensionData() map[string]*ExtensionData {
+	if x != nil {
+		return x.ExtensionData
+	}
+	return nil
+}
+
+func (x *Host) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Host) GetFirstContact() int64 {
+	if x != nil {
+		return x.FirstContact
+	}
+	return 0
+}
+
+func (x *Host) GetLocalGroupMembers() []*HostLocalGroupMember {
+	if x != nil {
+		return x.LocalGroupMembers
+	}
+	return nil
+}
+
+func (x *Host) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Host) GetServices() []*HostService {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *Host) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Host) GetSurveyedAt() int64 {
+	if x != nil {
+		return x.SurveyedAt
+	}
+	return 0
+}
+
+// HostTagsReq - Add or remove tags, playbooks can run against every host with a tag
+type HostTagsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HostUUID string   `protobuf:"bytes,1,opt,name=HostUUID,proto3" json:"HostUUID,omitempty"`
+	Tags     []string `protobuf:"bytes,2,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Remove   bool     `protobuf:"varint,3,opt,name=Remove,proto3" json:"Remove,omitempty"`
+}
+
+func (x *HostTagsReq) Reset() {
+	*x = HostTagsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostTagsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostTagsReq) ProtoMessage() {}
+
+func (x *HostTagsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostTagsReq.ProtoReflect.Descriptor instead.
+func (*HostTagsReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *HostTagsReq) GetHostUUID() string {
+	if x != nil {
+		return x.HostUUID
+	}
+	return ""
+}
+
+func (x *HostTagsReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *HostTagsReq) GetRemove() bool {
+	if x != nil {
+		return x.Remove
+	}
+	return false
+}
+
+// HostService - A network service found on a host
+type HostService struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     uint32 `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	Protocol string `protobuf:"bytes,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Version  string `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *HostService) Reset() {
+	*x = HostService{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostService) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostService) ProtoMessage() {}
+
+func (x *HostService) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostService.ProtoReflect.Descriptor instead.
+func (*HostService) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *HostService) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HostService) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *HostService) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HostService) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// HostLocalGroupMember - A member of a local group on a remote host, surveyed from
+// the host it's stored on
+type HostLocalGroupMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RemoteHost string `protobuf:"bytes,1,opt,name=RemoteHost,proto3" json:"RemoteHost,omitempty"`
+	Group      string `protobuf:"bytes,2,opt,name=Group,proto3" json:"Group,omitempty"`
+	GroupSID   string `protobuf:"bytes,3,opt,name=GroupSID,proto3" json:"GroupSID,omitempty"`
+	Member     string `protobuf:"bytes,4,opt,name=Member,proto3" json:"Member,omitempty"`
+	MemberSID  string `protobuf:"bytes,5,opt,name=MemberSID,proto3" json:"MemberSID,omitempty"`
+	MemberType string `protobuf:"bytes,6,opt,name=MemberType,proto3" json:"MemberType,omitempty"`
+	CreatedAt  int64  `protobuf:"varint,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *HostLocalGroupMember) Reset() {
+	*x = HostLocalGroupMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostLocalGroupMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostLocalGroupMember) ProtoMessage() {}
+
+func (x *HostLocalGroupMember) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostLocalGroupMember.ProtoReflect.Descriptor instead.
+func (*HostLocalGroupMember) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *HostLocalGroupMember) GetRemoteHost() string {
+	if x != nil {
+		return x.RemoteHost
+	}
+	return ""
+}
+
+func (x *HostLocalGroupMember) GetGroup() string {
+	if x != nil {
+		return x.Group
+	}
+	return ""
+}
+
+func (x *HostLocalGroupMember) GetGroupSID() string {
+	if x != nil {
+		return x.GroupSID
+	}
+	return ""
+}
+
+func (x *HostLocalGroupMember) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+func (x *HostLocalGroupMember) GetMemberSID() string {
+	if x != nil {
+		return x.MemberSID
+	}
+	return ""
+}
+
+func (x *HostLocalGroupMember) GetMemberType() string {
+	if x != nil {
+		return x.MemberType
+	}
+	return ""
+}
+
+func (x *HostLocalGroupMember) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AllHosts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hosts []*Host `protobuf:"bytes,1,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+}
+
+func (x *AllHosts) Reset() {
+	*x = AllHosts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllHosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllHosts) ProtoMessage() {}
+
+func (x *AllHosts) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllHosts.ProtoReflect.Descriptor instead.
+func (*AllHosts) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AllHosts) GetHosts() []*Host {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// [ Persistence ] ----------------------------------------
+// Persistence - Something an operator changed on a host to keep access, with
+// what's needed to undo it
+type Persistence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID        string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	HostUUID  string `protobuf:"bytes,2,opt,name=HostUUID,proto3" json:"HostUUID,omitempty"`
+	Hostname  string `protobuf:"bytes,3,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	Technique string `protobuf:"bytes,4,opt,name=Technique,proto3" json:"Technique,omitempty"`
+	Target    string `protobuf:"bytes,5,opt,name=Target,proto3" json:"Target,omitempty"`   // e.g. the hijacked service
+	Path      string `protobuf:"bytes,6,opt,name=Path,proto3" json:"Path,omitempty"`       // Where the implant was installed
+	Restore   string `protobuf:"bytes,7,opt,name=Restore,proto3" json:"Restore,omitempty"` // How to undo it, e.g. the original image path
+	Operator  string `protobuf:"bytes,8,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	CreatedAt int64  `protobuf:"varint,9,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+}
+
+func (x *Persistence) Reset() {
+	*x = Persistence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Persistence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Persistence) ProtoMessage() {}
+
+func (x *Persistence) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Persistence.ProtoReflect.Descriptor instead.
+func (*Persistence) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Persistence) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Persistence) GetHostUUID() string {
+	if x != nil {
+		return x.HostUUID
+	}
+	return ""
+}
+
+func (x *Persistence) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Persistence) GetTechnique() string {
+	if x != nil {
+		return x.Technique
+	}
+	return ""
+}
+
+func (x *Persistence) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Persistence) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Persistence) GetRestore() string {
+	if x != nil {
+		return x.Restore
+	}
+	return ""
+}
+
+func (x *Persistence) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Persistence) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type AllPersistence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Persistence []*Persistence `protobuf:"bytes,1,rep,name=Persistence,proto3" json:"Persistence,omitempty"`
+}
+
+func (x *AllPersistence) Reset() {
+	*x = AllPersistence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllPersistence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllPersistence) ProtoMessage() {}
+
+func (x *AllPersistence) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllPersistence.ProtoReflect.Descriptor instead.
+func (*AllPersistence) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *AllPersistence) GetPersistence() []*Persistence {
+	if x != nil {
+		return x.Persistence
+	}
+	return nil
+}
+
+// Engagement - Once the end has passed implants can't be tasked and are killed
+type Engagement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	End   int64 `protobuf:"varint,1,opt,name=End,proto3" json:"End,omitempty"` // Unix, 0 for no end
+	Ended bool  `protobuf:"varint,2,opt,name=Ended,proto3" json:"Ended,omitempty"`
+}
+
+func (x *Engagement) Reset() {
+	*x = Engagement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Engagement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Engagement) ProtoMessage() {}
+
+func (x *Engagement) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Engagement.ProtoReflect.Descriptor instead.
+func (*Engagement) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Engagement) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Engagement) GetEnded() bool {
+	if x != nil {
+		return x.Ended
+	}
+	return false
+}
+
+// [ Dll Hijack ] ----------------------------------------
+type DllHijackReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReferenceDLLPath string            `protobuf:"bytes,1,opt,name=ReferenceDLLPath,proto3" json:"ReferenceDLLPath,omitempty"`
+	TargetLocation   string            `protobuf:"bytes,2,opt,name=TargetLocation,proto3" json:"TargetLocation,omitempty"`
+	ReferenceDLL     []byte            `protobuf:"bytes,3,opt,name=ReferenceDLL,proto3" json:"ReferenceDLL,omitempty"`
+	TargetDLL        []byte            `protobuf:"bytes,4,opt,name=TargetDLL,proto3" json:"TargetDLL,omitempty"`
+	ProfileName      string            `protobuf:"bytes,5,opt,name=ProfileName,proto3" json:"ProfileName,omitempty"`
+	Request          *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *DllHijackReq) Reset() {
+	*x = DllHijackReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DllHijackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DllHijackReq) ProtoMessage() {}
+
+func (x *DllHijackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DllHijackReq.ProtoReflect.Descriptor instead.
+func (*DllHijackReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *DllHijackReq) GetReferenceDLLPath() string {
+	if x != nil {
+		return x.ReferenceDLLPath
+	}
+	return ""
+}
+
+func (x *DllHijackReq) GetTargetLocation() string {
+	if x != nil {
+		return x.TargetLocation
+	}
+	return ""
+}
+
+func (x *DllHijackReq) GetReferenceDLL() []byte {
+	if x != nil {
+		return x.ReferenceDLL
+	}
+	return nil
+}
+
+func (x *DllHijackReq) GetTargetDLL() []byte {
+	if x != nil {
+		return x.TargetDLL
+	}
+	return nil
+}
+
+func (x *DllHijackReq) GetProfileName() string {
+	if x != nil {
+		return x.ProfileName
+	}
+	return ""
+}
+
+func (x *DllHijackReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type DllHijack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *DllHijack) Reset() {
+	*x = DllHijack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DllHijack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DllHijack) ProtoMessage() {}
+
+func (x *DllHijack) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DllHijack.ProtoReflect.Descriptor instead.
+func (*DllHijack) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *DllHijack) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ShellcodeEncodeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encoder      ShellcodeEncoder  `protobuf:"varint,1,opt,name=Encoder,proto3,enum=clientpb.ShellcodeEncoder" json:"Encoder,omitempty"`
+	Architecture string            `protobuf:"bytes,2,opt,name=Architecture,proto3" json:"Architecture,omitempty"`
+	Iterations   uint32            `protobuf:"varint,3,opt,name=Iterations,proto3" json:"Iterations,omitempty"`
+	BadChars     []byte            `protobuf:"bytes,4,opt,name=BadChars,proto3" json:"BadChars,omitempty"`
+	Data         []byte            `protobuf:"bytes,8,opt,name=Data,proto3" json:"Data,omitempty"`
+	Request      *commonpb.Request `protobuf:"bytes,9,opt,name=Request,proto3" json:"Request,omitempty"`
+}
+
+func (x *ShellcodeEncodeReq) Reset() {
+	*x = ShellcodeEncodeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShellcodeEncodeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShellcodeEncodeReq) ProtoMessage() {}
+
+func (x *ShellcodeEncodeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShellcodeEncodeReq.ProtoReflect.Descriptor instead.
+func (*ShellcodeEncodeReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *ShellcodeEncodeReq) GetEncoder() ShellcodeEncoder {
+	if x != nil {
+		return x.Encoder
+	}
+	return ShellcodeEncoder_NONE
+}
+
+func (x *ShellcodeEncodeReq) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *ShellcodeEncodeReq) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *ShellcodeEncodeReq) GetBadChars() []byte {
+	if x != nil {
+		return x.BadChars
+	}
+	return nil
+}
+
+func (x *ShellcodeEncodeReq) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ShellcodeEncodeReq) GetRequest() *commonpb.Request {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+type ShellcodeEncode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data     []byte             `protobuf:"bytes,8,opt,name=Data,proto3" json:"Data,omitempty"`
+	Response *commonpb.Response `protobuf:"bytes,9,opt,name=Response,proto3" json:"Response,omitempty"`
+}
+
+func (x *ShellcodeEncode) Reset() {
+	*x = ShellcodeEncode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShellcodeEncode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShellcodeEncode) ProtoMessage() {}
+
+func (x *ShellcodeEncode) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShellcodeEncode.ProtoReflect.Descriptor instead.
+func (*ShellcodeEncode) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ShellcodeEncode) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ShellcodeEncode) GetResponse() *commonpb.Response {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type ShellcodeEncoderMap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Encoders map[string]ShellcodeEncoder `protobuf:"bytes,1,rep,name=Encoders,proto3" json:"Encoders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=clientpb.ShellcodeEncoder"`
+}
+
+func (x *ShellcodeEncoderMap) Reset() {
+	*x = ShellcodeEncoderMap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShellcodeEncoderMap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShellcodeEncoderMap) ProtoMessage() {}
+
+func (x *ShellcodeEncoderMap) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShellcodeEncoderMap.ProtoReflect.Descriptor instead.
+func (*ShellcodeEncoderMap) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *ShellcodeEncoderMap) GetEncoders() map[string]ShellcodeEncoder {
+	if x != nil {
+		return x.Encoders
+	}
+	return nil
+}
+
+// [ Builder ] ----------------------------------------
+type ExternalGenerateReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Config      *ImplantConfig `protobuf:"bytes,1,opt,name=Config,proto3" json:"Config,omitempty"`
+	BuilderName string         `protobuf:"bytes,2,opt,name=BuilderName,proto3" json:"BuilderName,omitempty"`
+}
+
+func (x *ExternalGenerateReq) Reset() {
+	*x = ExternalGenerateReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalGenerateReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalGenerateReq) ProtoMessage() {}
+
+func (x *ExternalGenerateReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalGenerateReq.ProtoReflect.Descriptor instead.
+func (*ExternalGenerateReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ExternalGenerateReq) GetConfig() *ImplantConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ExternalGenerateReq) GetBuilderName() string {
+	if x != nil {
+		return x.BuilderName
+	}
+	return ""
+}
+
+type Builders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Builders []*Builder `protobuf:"bytes,1,rep,name=Builders,proto3" json:"Builders,omitempty"`
+}
+
+func (x *Builders) Reset() {
+	*x = Builders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Builders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Builders) ProtoMessage() {}
+
+func (x *Builders) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Builders.ProtoReflect.Descriptor instead.
+func (*Builders) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Builders) GetBuilders() []*Builder {
+	if x != nil {
+		return x.Builders
+	}
+	return nil
+}
+
+type Builder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	OperatorName   string            `protobuf:"bytes,2,opt,name=OperatorName,proto3" json:"OperatorName,omitempty"`
+	GOOS           string            `protobuf:"bytes,3,opt,name=GOOS,proto3" json:"GOOS,omitempty"`     // The builder's OS
+	GOARCH         string            `protobuf:"bytes,4,opt,name=GOARCH,proto3" json:"GOARCH,omitempty"` // The builder's Arch
+	Templates      []string          `protobuf:"bytes,5,rep,name=Templates,proto3" json:"Templates,omitempty"`
+	Targets        []*CompilerTarget `protobuf:"bytes,6,rep,name=Targets,proto3" json:"Targets,omitempty"`
+	CrossCompilers []*CrossCompiler  `protobuf:"bytes,7,rep,name=CrossCompilers,proto3" json:"CrossCompilers,omitempty"`
+}
+
+func (x *Builder) Reset() {
+	*x = Builder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Builder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Builder) ProtoMessage() {}
+
+func (x *Builder) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Builder.ProtoReflect.Descriptor instead.
+func (*Builder) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *Builder) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Builder) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *Builder) GetGOOS() string {
+	if x != nil {
+		return x.GOOS
+	}
+	return ""
+}
+
+func (x *Builder) GetGOARCH() string {
+	if x != nil {
+		return x.GOARCH
+	}
+	return ""
+}
+
+func (x *Builder) GetTemplates() []string {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *Builder) GetTargets() []*CompilerTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *Builder) GetCrossCompilers() []*CrossCompiler {
+	if x != nil {
+		return x.CrossCompilers
+	}
+	return nil
+}
+
+// [ Output Parsers ] ----------------------------------------
+type ParseOutputReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Parser   string `protobuf:"bytes,1,opt,name=Parser,proto3" json:"Parser,omitempty"`
+	Name     string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"` // Extension or alias the output came from
+	HostUUID string `protobuf:"bytes,3,opt,name=HostUUID,proto3" json:"HostUUID,omitempty"`
+	Output   []byte `protobuf:"bytes,4,opt,name=Output,proto3" json:"Output,omitempty"`
+}
+
+func (x *ParseOutputReq) Reset() {
+	*x = ParseOutputReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParseOutputReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParseOutputReq) ProtoMessage() {}
+
+func (x *ParseOutputReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParseOutputReq.ProtoReflect.Descriptor instead.
+func (*ParseOutputReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ParseOutputReq) GetParser() string {
+	if x != nil {
+		return x.Parser
+	}
+	return ""
+}
+
+func (x *ParseOutputReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ParseOutputReq) GetHostUUID() string {
+	if x != nil {
+		return x.HostUUID
+	}
+	return ""
+}
+
+func (x *ParseOutputReq) GetOutput() []byte {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+// ParsedOutput - Hosts without a hostname or address are the host the output came from
+type ParsedOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Credentials []*Credential `protobuf:"bytes,1,rep,name=Credentials,proto3" json:"Credentials,omitempty"`
+	Hosts       []*Host       `protobuf:"bytes,2,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+}
+
+func (x *ParsedOutput) Reset() {
+	*x = ParsedOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParsedOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParsedOutput) ProtoMessage() {}
+
+func (x *ParsedOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParsedOutput.ProtoReflect.Descriptor instead.
+func (*ParsedOutput) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ParsedOutput) GetCredentials() []*Credential {
+	if x != nil {
+		return x.Credentials
+	}
+	return nil
+}
+
+func (x *ParsedOutput) GetHosts() []*Host {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// [ Playbooks ] ----------------------------------------
+type PlaybookVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Default     string `protobuf:"bytes,3,opt,name=Default,proto3" json:"Default,omitempty"`
+	Required    bool   `protobuf:"varint,4,opt,name=Required,proto3" json:"Required,omitempty"`
+}
+
+func (x *PlaybookVariable) Reset() {
+	*x = PlaybookVariable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybookVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybookVariable) ProtoMessage() {}
+
+func (x *PlaybookVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybookVariable.ProtoReflect.Descriptor instead.
+func (*PlaybookVariable) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *PlaybookVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaybookVariable) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PlaybookVariable) GetDefault() string {
+	if x != nil {
+		return x.Default
+	}
+	return ""
+}
+
+func (x *PlaybookVariable) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// PlaybookStep - A typed task template, Args and When can use {{variable}}s,
+// the variables of earlier steps are {{step.field}}
+type PlaybookStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name            string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Task            string            `protobuf:"bytes,2,opt,name=Task,proto3" json:"Task,omitempty"`
+	Args            map[string]string `protobuf:"bytes,3,rep,name=Args,proto3" json:"Args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	When            string            `protobuf:"bytes,4,opt,name=When,proto3" json:"When,omitempty"`
+	Approval        bool              `protobuf:"varint,5,opt,name=Approval,proto3" json:"Approval,omitempty"` // An operator has to approve the step before it runs
+	ContinueOnError bool              `protobuf:"varint,6,opt,name=ContinueOnError,proto3" json:"ContinueOnError,omitempty"`
+	Timeout         int64             `protobuf:"varint,7,opt,name=Timeout,proto3" json:"Timeout,omitempty"` // Seconds
+}
+
+func (x *PlaybookStep) Reset() {
+	*x = PlaybookStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybookStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybookStep) ProtoMessage() {}
+
+func (x *PlaybookStep) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybookStep.ProtoReflect.Descriptor instead.
+func (*PlaybookStep) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PlaybookStep) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaybookStep) GetTask() string {
+	if x != nil {
+		return x.Task
+	}
+	return ""
+}
+
+func (x *PlaybookStep) GetArgs() map[string]string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *PlaybookStep) GetWhen() string {
+	if x != nil {
+		return x.When
+	}
+	return ""
+}
+
+func (x *PlaybookStep) GetApproval() bool {
+	if x != nil {
+		return x.Approval
+	}
+	return false
+}
+
+func (x *PlaybookStep) GetContinueOnError() bool {
+	if x != nil {
+		return x.ContinueOnError
+	}
+	return false
+}
+
+func (x *PlaybookStep) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type Playbook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string              `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description string              `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	Variables   []*PlaybookVariable `protobuf:"bytes,3,rep,name=Variables,proto3" json:"Variables,omitempty"`
+	Steps       []*PlaybookStep     `protobuf:"bytes,4,rep,name=Steps,proto3" json:"Steps,omitempty"`
+}
+
+func (x *Playbook) Reset() {
+	*x = Playbook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Playbook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playbook) ProtoMessage() {}
+
+func (x *Playbook) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playbook.ProtoReflect.Descriptor instead.
+func (*Playbook) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *Playbook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Playbook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Playbook) GetVariables() []*PlaybookVariable {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Playbook) GetSteps() []*PlaybookStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+type Playbooks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Playbooks []*Playbook `protobuf:"bytes,1,rep,name=Playbooks,proto3" json:"Playbooks,omitempty"`
+}
+
+func (x *Playbooks) Reset() {
+	*x = Playbooks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Playbooks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playbooks) ProtoMessage() {}
+
+func (x *Playbooks) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playbooks.ProtoReflect.Descriptor instead.
+func (*Playbooks) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Playbooks) GetPlaybooks() []*Playbook {
+	if x != nil {
+		return x.Playbooks
+	}
+	return nil
+}
+
+// PlaybookRunReq - Run against a session, a beacon, or every host with a tag
+type PlaybookRunReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string            `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Variables map[string]string `protobuf:"bytes,2,rep,name=Variables,proto3" json:"Variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SessionID string            `protobuf:"bytes,3,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	BeaconID  string            `protobuf:"bytes,4,opt,name=BeaconID,proto3" json:"BeaconID,omitempty"`
+	Tag       string            `protobuf:"bytes,5,opt,name=Tag,proto3" json:"Tag,omitempty"`
+}
+
+func (x *PlaybookRunReq) Reset() {
+	*x = PlaybookRunReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybookRunReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybookRunReq) ProtoMessage() {}
+
+func (x *PlaybookRunReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybookRunReq.ProtoReflect.Descriptor instead.
+func (*PlaybookRunReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *PlaybookRunReq) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaybookRunReq) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *PlaybookRunReq) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PlaybookRunReq) GetBeaconID() string {
+	if x != nil {
+		return x.BeaconID
+	}
+	return ""
+}
+
+func (x *PlaybookRunReq) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type PlaybookStepResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	State       string `protobuf:"bytes,2,opt,name=State,proto3" json:"State,omitempty"`
+	Err         string `protobuf:"bytes,3,opt,name=Err,proto3" json:"Err,omitempty"`
+	Output      string `protobuf:"bytes,4,opt,name=Output,proto3" json:"Output,omitempty"`
+	StartedAt   int64  `protobuf:"varint,5,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	CompletedAt int64  `protobuf:"varint,6,opt,name=CompletedAt,proto3" json:"CompletedAt,omitempty"`
+	ApprovedBy  string `protobuf:"bytes,7,opt,name=ApprovedBy,proto3" json:"ApprovedBy,omitempty"`
+}
+
+func (x *PlaybookStepResult) Reset() {
+	*x = PlaybookStepResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybookStepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybookStepResult) ProtoMessage() {}
+
+func (x *PlaybookStepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybookStepResult.ProtoReflect.Descriptor instead.
+func (*PlaybookStepResult) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PlaybookStepResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaybookStepResult) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PlaybookStepResult) GetErr() string {
+	if x != nil {
+		return x.Err
+	}
+	return ""
+}
+
+func (x *PlaybookStepResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *PlaybookStepResult) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *PlaybookStepResult) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+func (x *PlaybookStepResult) GetApprovedBy() string {
+	if x != nil {
+		return x.ApprovedBy
+	}
+	return ""
+}
+
+type PlaybookRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID          string                `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Playbook    string                `protobuf:"bytes,2,opt,name=Playbook,proto3" json:"Playbook,omitempty"`
+	Operator    string                `protobuf:"bytes,3,opt,name=Operator,proto3" json:"Operator,omitempty"`
+	SessionID   string                `protobuf:"bytes,4,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	BeaconID    string                `protobuf:"bytes,5,opt,name=BeaconID,proto3" json:"BeaconID,omitempty"`
+	Name        string                `protobuf:"bytes,6,opt,name=Name,proto3" json:"Name,omitempty"`
+	Hostname    string                `protobuf:"bytes,7,opt,name=Hostname,proto3" json:"Hostname,omitempty"`
+	State       string                `protobuf:"bytes,8,opt,name=State,proto3" json:"State,omitempty"`
+	Variables   map[string]string     `protobuf:"bytes,9,rep,name=Variables,proto3" json:"Variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Steps       []*PlaybookStepResult `protobuf:"bytes,10,rep,name=Steps,proto3" json:"Steps,omitempty"`
+	StartedAt   int64                 `protobuf:"varint,11,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	CompletedAt int64                 `protobuf:"varint,12,opt,name=CompletedAt,proto3" json:"CompletedAt,omitempty"`
+}
+
+func (x *PlaybookRun) Reset() {
+	*x = PlaybookRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybookRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybookRun) ProtoMessage() {}
+
+func (x *PlaybookRun) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybookRun.ProtoReflect.Descriptor instead.
+func (*PlaybookRun) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *PlaybookRun) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetPlaybook() string {
+	if x != nil {
+		return x.Playbook
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetBeaconID() string {
+	if x != nil {
+		return x.BeaconID
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *PlaybookRun) GetVariables() map[string]string {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *PlaybookRun) GetSteps() []*PlaybookStepResult {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *PlaybookRun) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *PlaybookRun) GetCompletedAt() int64 {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return 0
+}
+
+type PlaybookRuns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Runs []*PlaybookRun `protobuf:"bytes,1,rep,name=Runs,proto3" json:"Runs,omitempty"`
+}
+
+func (x *PlaybookRuns) Reset() {
+	*x = PlaybookRuns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybookRuns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybookRuns) ProtoMessage() {}
+
+func (x *PlaybookRuns) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybookRuns.ProtoReflect.Descriptor instead.
+func (*PlaybookRuns) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *PlaybookRuns) GetRuns() []*PlaybookRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type PlaybookApprovalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RunID  string `protobuf:"bytes,1,opt,name=RunID,proto3" json:"RunID,omitempty"`
+	Reject bool   `protobuf:"varint,2,opt,name=Reject,proto3" json:"Reject,omitempty"`
+}
+
+func (x *PlaybookApprovalReq) Reset() {
+	*x = PlaybookApprovalReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_clientpb_client_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybookApprovalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybookApprovalReq) ProtoMessage() {}
+
+func (x *PlaybookApprovalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_clientpb_client_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybookApprovalReq.ProtoReflect.Descriptor instead.
+func (*PlaybookApprovalReq) Descriptor() ([]byte, []int) {
+	return file_clientpb_client_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *PlaybookApprovalReq) GetRunID() string {
+	if x != nil {
+		return x.RunID
+	}
+	return ""
+}
+
+func (x *PlaybookApprovalReq) GetReject() bool {
+	if x != nil {
+		return x.Reject
+	}
+	return false
+}
+
+var File_clientpb_client_proto protoreflect.FileDescriptor
+
+var file_clientpb_client_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x1a, 0x15, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xbd, 0x01, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x4d,
+	0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x4d, 0x61, 0x6a, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x4d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x50, 0x61, 0x74, 0x63, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x69, 0x72, 0x74, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x44, 0x69, 0x72, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x4f,
+	0x53, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x41,
+	0x72, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x22,
+	0xb6, 0x06, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55,
+	0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55,
+	0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x10, 0x0a,
+	0x03, 0x47, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x47, 0x49, 0x44, 0x12,
+	0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12,
+	0x12, 0x0a, 0x04, 0x41, 0x72, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41,
+	0x72, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x43, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x43, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x45, 0x76, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x45, 0x76, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x49, 0x73, 0x44, 0x65, 0x61,
+	0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x49, 0x73, 0x44, 0x65, 0x61, 0x64, 0x12,
+	0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x52, 0x65, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x55, 0x52, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x75, 0x72,
+	0x6e, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x42, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x17, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x40, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0e, 0x44, 0x4e, 0x53, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a,
+	0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xd5, 0x07, 0x0a, 0x06, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x55, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x47, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x47, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x4f, 0x53, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x63, 0x68, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x50, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x43, 0x32, 0x12, 0x18, 0x0a, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76, 0x61, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x76, 0x61, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x49, 0x73, 0x44, 0x65, 0x61, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x49, 0x73, 0x44, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x55, 0x52, 0x4c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x55, 0x52, 0x4c, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x18, 0x19, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x30, 0x0a, 0x13, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x69, 0x72,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x2e, 0x0a,
+	0x08, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x08, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x40, 0x0a,
+	0x0e, 0x44, 0x4e, 0x53, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x44, 0x4e, 0x53, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52,
+	0x0e, 0x44, 0x4e, 0x53, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x24, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x22, 0x35, 0x0a, 0x07, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x52, 0x07,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x22, 0x9f, 0x02, 0x0a, 0x13, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x28, 0x0a, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x52, 0x06, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x41,
+	0x53, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x41, 0x53, 0x4e, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x24, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x41, 0x53, 0x4e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x41, 0x53, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb4, 0x02, 0x0a, 0x0a, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x6e, 0x74,
+	0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x53, 0x65, 0x6e, 0x74, 0x41, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x22, 0x4c, 0x0a, 0x14, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x44, 0x73, 0x22, 0x55,
+	0x0a, 0x0b, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x05, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x22, 0x53, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x43, 0x32, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x55, 0x52, 0x4c,
+	0x12, 0x18, 0x0a, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xee, 0x19, 0x0a, 0x0d, 0x49,
+	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x49, 0x73, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x49, 0x73, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x22, 0x0a, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x4a, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52,
+	0x43, 0x48, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x76,
+	0x61, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x76, 0x61,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
+	0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73,
+	0x12, 0x22, 0x0a, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x51, 0x75, 0x69, 0x65, 0x74, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x69, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x51, 0x75, 0x69, 0x65,
+	0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6c, 0x65, 0x65,
+	0x70, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x6c, 0x65,
+	0x65, 0x70, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x41,
+	0x43, 0x65, 0x72, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x74, 0x6c, 0x73,
+	0x43, 0x41, 0x43, 0x65, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65,
+	0x72, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4d, 0x74, 0x6c, 0x73, 0x43, 0x65,
+	0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12,
+	0x45, 0x43, 0x43, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x45, 0x43, 0x43, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c,
+	0x45, 0x43, 0x43, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x45, 0x43, 0x43, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x24, 0x0a, 0x0d, 0x45, 0x43, 0x43, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x45, 0x43, 0x43, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x15, 0x45, 0x43, 0x43, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x45, 0x43, 0x43, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x17,
+	0x4d, 0x69, 0x6e, 0x69, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x4d,
+	0x69, 0x6e, 0x69, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x57, 0x47, 0x49, 0x6d, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x4b, 0x65, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x57, 0x47, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x4b,
+	0x65, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x57, 0x47, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x57, 0x47, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x57, 0x47,
+	0x50, 0x65, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x49, 0x50, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x57, 0x47, 0x50, 0x65, 0x65, 0x72, 0x54, 0x75, 0x6e, 0x49, 0x50, 0x12, 0x2c, 0x0a, 0x11,
+	0x57, 0x47, 0x4b, 0x65, 0x79, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x57, 0x47, 0x4b, 0x65, 0x79, 0x45, 0x78,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x57, 0x47,
+	0x54, 0x63, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x57, 0x47, 0x54, 0x63, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x73, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x30, 0x0a, 0x13, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x4d,
+	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x2c, 0x0a, 0x11,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x61,
+	0x78, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x61, 0x78, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x2d, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x78, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x24, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x47, 0x69, 0x76, 0x65, 0x55,
+	0x70, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x47, 0x69, 0x76, 0x65, 0x55, 0x70, 0x12, 0x23, 0x0a, 0x02, 0x43, 0x32, 0x18, 0x32, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x32, 0x52, 0x02, 0x43, 0x32, 0x12, 0x24, 0x0a, 0x0d, 0x43,
+	0x61, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x33, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x79, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x73, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x32, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x35, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x36, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x48, 0x54,
+	0x54, 0x50, 0x43, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x46,
+	0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x37, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x11, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x46, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x24, 0x0a, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x18, 0x39, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x18, 0x3a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x12, 0x28, 0x0a, 0x0f, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72,
+	0x44, 0x65, 0x6e, 0x79, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x44, 0x4e, 0x53, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x79, 0x12, 0x2c, 0x0a, 0x11, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64,
+	0x18, 0x3c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x3e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x40,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x41, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x42, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x57,
+	0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4b,
+	0x69, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x18, 0x43, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4b,
+	0x69, 0x6c, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x18, 0x44, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4b, 0x65, 0x79, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x4b, 0x65, 0x79, 0x48, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x45, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4b, 0x65, 0x79, 0x48,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x43, 0x49,
+	0x44, 0x52, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x43, 0x49, 0x44,
+	0x52, 0x12, 0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x47, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x49,
+	0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x18, 0x65, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x49, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x12, 0x1a, 0x0a,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x53, 0x68, 0x65,
+	0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73,
+	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x75, 0x6e,
+	0x41, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x75,
+	0x6e, 0x41, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x46, 0x69, 0x6c, 0x65, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x6d, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f,
+	0x67, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x57, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f,
+	0x67, 0x12, 0x24, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x64, 0x6f, 0x67, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x64,
+	0x6f, 0x67, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x70, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x12, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x63, 0x68, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x48, 0x61, 0x72,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x71, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x48, 0x61,
+	0x72, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x72, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x75, 0x70, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x41, 0x6d, 0x73, 0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x73, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x41, 0x6d, 0x73, 0x69, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x45, 0x74, 0x77, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x74, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x45, 0x74, 0x77, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x28, 0x0a,
+	0x0f, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x18, 0x75, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x54, 0x65,
+	0x63, 0x68, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x63, 0x61,
+	0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x76, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x53, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
+	0x6f, 0x64, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x52, 0x44,
+	0x49, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x53, 0x52, 0x44, 0x49, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
+	0x0e, 0x53, 0x52, 0x44, 0x49, 0x4b, 0x65, 0x65, 0x70, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x79, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x53, 0x52, 0x44, 0x49, 0x4b, 0x65, 0x65, 0x70, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x53, 0x52, 0x44, 0x49, 0x4f, 0x62, 0x66,
+	0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x7a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x14, 0x53, 0x52, 0x44, 0x49, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61,
+	0x74, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x52, 0x44,
+	0x49, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x7b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x53, 0x52, 0x44, 0x49, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x53, 0x52, 0x44, 0x49, 0x48, 0x61, 0x73, 0x68, 0x4b,
+	0x65, 0x79, 0x18, 0x7c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x53, 0x52, 0x44, 0x49, 0x48, 0x61,
+	0x73, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f,
+	0x64, 0x65, 0x50, 0x72, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x10, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x50, 0x72, 0x65, 0x70, 0x65, 0x6e,
+	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x70,
+	0x70, 0x65, 0x6e, 0x64, 0x18, 0x7e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x53, 0x68, 0x65, 0x6c,
+	0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x44, 0x4c, 0x4c, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x44, 0x4c, 0x4c, 0x12, 0x21, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x80, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x35, 0x0a, 0x15, 0x4f, 0x62,
+	0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x4c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x73, 0x18, 0x81, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x4f, 0x62, 0x66, 0x75,
+	0x73, 0x63, 0x61, 0x74, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x73, 0x12, 0x2d, 0x0a, 0x11, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x53, 0x6b,
+	0x69, 0x70, 0x54, 0x69, 0x6e, 0x79, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x4f,
+	0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x54, 0x69, 0x6e, 0x79,
+	0x12, 0x29, 0x0a, 0x0f, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x65, 0x64, 0x18, 0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4f, 0x62, 0x66, 0x75,
+	0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x22, 0x66, 0x0a, 0x15, 0x45,
+	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x54, 0x50, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x54, 0x50, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x79, 0x0a, 0x15, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x04, 0x46, 0x69,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0xa4,
+	0x01, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73,
+	0x12, 0x3e, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x1a, 0x53, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x47,
+	0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f, 0x41,
+	0x52, 0x43, 0x48, 0x12, 0x2e, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x43, 0x72, 0x6f, 0x73, 0x73, 0x43, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47,
+	0x4f, 0x4f, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47,
+	0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x43, 0x50,
+	0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43, 0x43, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x58, 0x58, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x43, 0x58, 0x58, 0x50, 0x61, 0x74, 0x68, 0x22, 0xf5, 0x01, 0x0a, 0x08,
+	0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06,
+	0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f,
+	0x41, 0x52, 0x43, 0x48, 0x12, 0x32, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x0e, 0x43, 0x72, 0x6f, 0x73, 0x73,
+	0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x55, 0x6e, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52,
+	0x12, 0x55, 0x6e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x22, 0x1f, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x09, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x6e, 0x61,
+	0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4c, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3b,
+	0x0a, 0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x43, 0x61, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x0a, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x57, 0x47, 0x49, 0x50, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x50, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x50, 0x22, 0x55, 0x0a, 0x0e, 0x49, 0x6d, 0x70,
+	0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x47, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x31, 0x0a, 0x0d, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6d,
+	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x95, 0x01, 0x0a,
+	0x03, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x22, 0x2d, 0x0a, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x25, 0x0a, 0x06,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x06, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x22, 0x1c, 0x0a, 0x0a, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49,
+	0x44, 0x22, 0x33, 0x0a, 0x07, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x4a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x6c, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x32, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x32, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65,
+	0x4f, 0x54, 0x50, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x4f, 0x54, 0x50, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x61, 0x78, 0x4e, 0x4f, 0x50, 0x50,
+	0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4d, 0x61,
+	0x78, 0x4e, 0x4f, 0x50, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x54,
+	0x54, 0x4c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x54, 0x54, 0x4c, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x2b, 0x0a, 0x13, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x59, 0x0a, 0x0f, 0x4d, 0x54, 0x4c, 0x53,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x9d, 0x01, 0x0a, 0x0d, 0x57, 0x47,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48,
+	0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x75, 0x6e, 0x49, 0x50, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x75, 0x6e, 0x49, 0x50, 0x12, 0x14, 0x0a, 0x05, 0x4e, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4e, 0x50, 0x6f, 0x72, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x4b, 0x65, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x0a, 0x57, 0x47, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0xbf, 0x02,
+	0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x43, 0x61,
+	0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x54, 0x50, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x54, 0x50, 0x12, 0x4e,
+	0x0a, 0x0c, 0x54, 0x58, 0x54, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x2e, 0x54,
+	0x58, 0x54, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0c, 0x54, 0x58, 0x54, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x3f,
+	0x0a, 0x11, 0x54, 0x58, 0x54, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x23, 0x0a, 0x0b, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x0f, 0x49, 0x43, 0x4d, 0x50, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x24, 0x0a, 0x0c, 0x49,
+	0x43, 0x4d, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x22, 0x58, 0x0a, 0x0e, 0x55, 0x44, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x23, 0x0a, 0x0b, 0x55,
+	0x44, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f,
+	0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44,
+	0x22, 0xcb, 0x03, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x4b, 0x65,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x41, 0x43, 0x4d, 0x45, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x41, 0x43, 0x4d, 0x45,
+	0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x54, 0x50, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x4f, 0x54, 0x50,
+	0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x4c, 0x6f, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4c, 0x6f,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x4c, 0x6f, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x6c, 0x4a, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x69, 0x7a, 0x65, 0x4a,
+	0x41, 0x52, 0x4d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x69, 0x7a, 0x65, 0x4a, 0x41, 0x52, 0x4d, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x32, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x32, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x46, 0x72, 0x6f, 0x6e, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x46, 0x72, 0x6f,
+	0x6e, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50,
+	0x32, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x48, 0x54, 0x54, 0x50, 0x32, 0x22, 0x3b,
+	0x0a, 0x0d, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x45, 0x0a, 0x0e, 0x48,
+	0x54, 0x54, 0x50, 0x43, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43,
+	0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x22, 0x35, 0x0a, 0x0b, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x26, 0x0a, 0x0c, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x22, 0x58, 0x0a, 0x0d, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x69, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x69, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x69, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x0a, 0x4e,
+	0x61, 0x6d, 0x65, 0x64, 0x50, 0x69, 0x70, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x54, 0x0a, 0x0b, 0x54, 0x43, 0x50, 0x50, 0x69, 0x76, 0x6f,
+	0x74, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x66, 0x0a, 0x08, 0x54,
+	0x43, 0x50, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x45, 0x72, 0x72, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x24, 0x0a, 0x0c, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x39, 0x0a, 0x08, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x59, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x3e, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2f,
+	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x93, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x04,
+	0x46, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x39, 0x0a, 0x0a, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x0a, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x4f,
+	0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x65, 0x65, 0x64, 0x22, 0x6f, 0x0a, 0x09, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74,
+	0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x53, 0x74,
+	0x72, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x80, 0x01, 0x0a, 0x0f, 0x49, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x49, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x0a, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x52, 0x65, 0x73, 0x69, 0x64, 0x75, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xb5, 0x01, 0x0a, 0x06, 0x4d, 0x53,
+	0x46, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x4c, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0xcd, 0x01, 0x0a, 0x0c, 0x4d, 0x53, 0x46, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x48, 0x6f,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x4c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x03, 0x50, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x85, 0x02, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x33, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x4b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x41, 0x43, 0x4d, 0x45, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x41, 0x43,
+	0x4d, 0x45, 0x12, 0x27, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x52, 0x06, 0x53, 0x74, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43,
+	0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x47,
+	0x4f, 0x4f, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x41, 0x56, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x41,
+	0x56, 0x22, 0x26, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x55, 0x0a, 0x13, 0x53, 0x68, 0x65,
+	0x6c, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x47, 0x4f, 0x4f, 0x53, 0x12, 0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x08,
+	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x49,
+	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x22, 0xdf, 0x01, 0x0a, 0x09, 0x57, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x55, 0x52, 0x4c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x55,
+	0x52, 0x4c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x44, 0x4e, 0x53, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65, 0x72, 0x48, 0x6f, 0x75, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x50, 0x65,
+	0x72, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x22, 0x1e, 0x0a, 0x06, 0x57, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x4a, 0x6f,
+	0x62, 0x49, 0x44, 0x22, 0x67, 0x0a, 0x0f, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x44, 0x49, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x75,
+	0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x0c,
+	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x12, 0x12, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x22, 0x82, 0x02, 0x0a, 0x14, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x41, 0x73, 0x73,
+	0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72,
+	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x41, 0x70, 0x70, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x41, 0x70, 0x70, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75,
+	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xaf, 0x02, 0x0a, 0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
+	0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x41,
+	0x72, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12,
+	0x14, 0x0a, 0x05, 0x49, 0x73, 0x44, 0x4c, 0x4c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x49, 0x73, 0x44, 0x4c, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x48, 0x41, 0x32, 0x35, 0x36, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x4f, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x39, 0x0a,
+	0x09, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c,
+	0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x09, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x66,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x63,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x63, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x4f, 0x53, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x4f, 0x53, 0x12, 0x33, 0x0a,
+	0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73, 0x22, 0x2f,
+	0x0a, 0x09, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x46,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22,
+	0x94, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x12, 0x26, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe5, 0x01, 0x0a, 0x0a, 0x4d, 0x69, 0x67, 0x72, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x31,
+	0x0a, 0x09, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6a,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x49, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c,
+	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x08,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x22, 0x5d, 0x0a, 0x0e,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x1e,
+	0x0a, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x2b,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf9, 0x01, 0x0a, 0x0f,
+	0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x50, 0x65, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2b, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x43, 0x68, 0x69, 0x6c,
+	0x64, 0x72, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12,
+	0x1c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x48, 0x6f, 0x70, 0x4c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x48, 0x6f, 0x70,
+	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x43, 0x0a, 0x0a, 0x50, 0x69, 0x76, 0x6f, 0x74,
+	0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x35, 0x0a, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x22, 0x5c, 0x0a, 0x06,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x02, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x08, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xc3, 0x01, 0x0a, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62,
+	0x12, 0x28, 0x0a, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10,
+	0x0a, 0x03, 0x45, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x45, 0x72, 0x72,
+	0x22, 0x3d, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a,
+	0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x22,
+	0x36, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f,
+	0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4f, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x79, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x6c, 0x79, 0x69,
+	0x6e, 0x67, 0x50, 0x61, 0x72, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x43,
+	0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x44, 0x22, 0x8f, 0x01, 0x0a, 0x0f,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67,
+	0x65, 0x12, 0x2c, 0x0a, 0x11, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x41, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x24, 0x0a,
+	0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x74, 0x0a, 0x0a, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x11, 0x57, 0x65,
+	0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x51, 0x0a, 0x0d, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a,
+	0x14, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22,
+	0xad, 0x01, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3b, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x51, 0x0a, 0x0d,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x39, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65,
+	0x52, 0x08, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x22, 0xa0, 0x01, 0x0a, 0x0e, 0x57,
+	0x47, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x22, 0x0a,
+	0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a,
+	0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x22, 0xc6, 0x01,
+	0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x41, 0x50, 0x49, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x41, 0x50,
+	0x49, 0x4b, 0x65, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x61, 0x73, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61,
+	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x61,
+	0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0xce, 0x02, 0x0a, 0x04, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x26, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x40, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0a, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08,
+	0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x48, 0x6f, 0x73, 0x74,
+	0x55, 0x55, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x07, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x6f, 0x74, 0x12,
+	0x22, 0x0a, 0x04, 0x4c, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x52, 0x04, 0x4c,
+	0x6f, 0x6f, 0x74, 0x22, 0x45, 0x0a, 0x03, 0x49, 0x4f, 0x43, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x27, 0x0a, 0x0d, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x22, 0xae, 0x04, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74,
+	0x55, 0x55, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4f, 0x53, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x04, 0x49, 0x4f, 0x43, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x4f, 0x43, 0x52,
+	0x04, 0x49, 0x4f, 0x43, 0x73, 0x12, 0x47, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x69, 0x72, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x46, 0x69,
+	0x72, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x48, 0x6f, 0x73, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x11, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x31, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x08, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x75, 0x72,
+	0x76, 0x65, 0x79, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x53,
+	0x75, 0x72, 0x76, 0x65, 0x79, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x59, 0x0a, 0x12, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x55, 0x0a, 0x0b, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x55, 0x55, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x55, 0x55, 0x49, 0x44, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x22, 0x6b, 0x0a, 0x0b, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x14, 0x48, 0x6f, 0x73,
+	0x74, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x48, 0x6f, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x53, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x53, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x30, 0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x48, 0x6f,
+	0x73, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73,
+	0x74, 0x55, 0x55, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73,
+	0x74, 0x55, 0x55, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x65, 0x63, 0x68, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x49, 0x0a, 0x0e, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x37, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x0b, 0x50,
+	0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x45, 0x6e,
+	0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6e,
+	0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x45, 0x6e, 0x64, 0x65, 0x64,
+	0x22, 0xf3, 0x01, 0x0a, 0x0c, 0x44, 0x6c, 0x6c, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x4c,
+	0x4c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x4c, 0x4c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a,
+	0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x44, 0x4c, 0x4c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x4c, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x44, 0x4c, 0x4c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x44, 0x4c, 0x4c, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x44, 0x6c, 0x6c, 0x48, 0x69, 0x6a,
+	0x61, 0x63, 0x6b, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64,
+	0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x12, 0x34, 0x0a, 0x07, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x07, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x42, 0x61, 0x64, 0x43, 0x68, 0x61, 0x72, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x55, 0x0a, 0x0f, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x13, 0x53, 0x68, 0x65,
+	0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70,
+	0x12, 0x47, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68,
+	0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61,
+	0x70, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x57, 0x0a, 0x0d, 0x45, 0x6e, 0x63,
+	0x6f, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0x68, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x12, 0x2f, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x39, 0x0a, 0x08,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x08, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22, 0x80, 0x02, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x47,
+	0x4f, 0x4f, 0x53, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x47, 0x4f, 0x4f, 0x53, 0x12,
+	0x16, 0x0a, 0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x47, 0x4f, 0x41, 0x52, 0x43, 0x48, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x52, 0x07, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x6f,
+	0x73, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x52, 0x0e, 0x43, 0x72, 0x6f, 0x73,
+	0x73, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x73, 0x22, 0x70, 0x0a, 0x0e, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x61,
+	0x72, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73, 0x74,
+	0x55, 0x55, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x6c, 0x0a, 0x0c,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x0b,
+	0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0b, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48,
+	0x6f, 0x73, 0x74, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x7e, 0x0a, 0x10, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x99, 0x02, 0x0a, 0x0c, 0x50,
+	0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x61, 0x73, 0x6b, 0x12, 0x34, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x2e, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x68, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x57, 0x68, 0x65, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x4f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x37, 0x0a,
+	0x09, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa8, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x62,
+	0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09, 0x56, 0x61, 0x72,
+	0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x09, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x53, 0x74, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x53, 0x74, 0x65, 0x70,
+	0x73, 0x22, 0x3d, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x30,
+	0x0a, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x09, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x73,
+	0x22, 0xf5, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x75, 0x6e,
+	0x52, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x09, 0x56, 0x61, 0x72, 0x69, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x75,
+	0x6e, 0x52, 0x65, 0x71, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x54, 0x61, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc8, 0x01, 0x0a, 0x12, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x72, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x45, 0x72, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x42,
+	0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x42, 0x79, 0x22, 0xcb, 0x03, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b,
+	0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x12,
+	0x1a, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x09, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f,
+	0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x2e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x05, 0x53, 0x74, 0x65, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f,
+	0x6f, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x53, 0x74,
+	0x65, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x1a, 0x3c, 0x0a, 0x0e, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x39, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x75, 0x6e,
+	0x73, 0x12, 0x29, 0x0a, 0x04, 0x52, 0x75, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62,
+	0x6f, 0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x04, 0x52, 0x75, 0x6e, 0x73, 0x22, 0x43, 0x0a, 0x13,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x52, 0x75, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x6a,
+	0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x2a, 0x5b, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x48, 0x41, 0x52, 0x45, 0x44, 0x5f, 0x4c, 0x49, 0x42, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x48, 0x45, 0x4c, 0x4c, 0x43, 0x4f, 0x44, 0x45, 0x10, 0x01,
+	0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x54, 0x48, 0x49, 0x52, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x59, 0x10, 0x04, 0x2a, 0x2d,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x54, 0x54, 0x50, 0x53, 0x10, 0x02, 0x2a, 0x2e, 0x0a,
+	0x08, 0x4c, 0x6f, 0x6f, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x4c, 0x4f, 0x4f,
+	0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x4f, 0x4f, 0x54,
+	0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x2a, 0x57, 0x0a,
+	0x0e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c,
+	0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57,
+	0x4f, 0x52, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x50, 0x49, 0x5f, 0x4b, 0x45, 0x59,
+	0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
+	0x48, 0x41, 0x53, 0x48, 0x10, 0x04, 0x2a, 0x2d, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x4f, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x42, 0x49, 0x4e, 0x41, 0x52, 0x59, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x54,
+	0x45, 0x58, 0x54, 0x10, 0x02, 0x2a, 0x30, 0x0a, 0x10, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f,
+	0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x48, 0x49, 0x4b, 0x41, 0x54, 0x41, 0x5f, 0x47,
+	0x41, 0x5f, 0x4e, 0x41, 0x49, 0x10, 0x01, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x73, 0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78, 0x2f,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_clientpb_client_proto_rawDescOnce sync.Once
+	file_clientpb_client_proto_rawDescData = file_clientpb_client_proto_rawDesc
+)
+
+func file_clientpb_client_proto_rawDescGZIP() []byte {
+	file_clientpb_client_proto_rawDescOnce.Do(func() {
+		file_clientpb_client_proto_rawDescData = protoimpl.X.CompressGZIP(file_clientpb_client_proto_rawDescData)
+	})
+	return file_clientpb_client_proto_rawDescData
+}
+
+var file_clientpb_client_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_clientpb_client_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
+var file_clientpb_client_proto_goTypes = []interface{}{
+	(OutputFormat)(0),                    // 0: clientpb.OutputFormat
+	(StageProtocol)(0),                   // 1: clientpb.StageProtocol
+	(LootType)(0),                        // 2: clientpb.LootType
+	(CredentialType)(0),                  // 3: clientpb.CredentialType
+	(FileType)(0),                        // 4: clientpb.FileType
+	(ShellcodeEncoder)(0),                // 5: clientpb.ShellcodeEncoder
+	(*Version)(nil),                      // 6: clientpb.Version
+	(*Session)(nil),                      // 7: clientpb.Session
+	(*Beacon)(nil),                       // 8: clientpb.Beacon
+	(*Beacons)(nil),                      // 9: clientpb.Beacons
+	(*BeaconAddressChange)(nil),          // 10: clientpb.BeaconAddressChange
+	(*BeaconTask)(nil),                   // 11: clientpb.BeaconTask
+	(*BeaconTaskReorderReq)(nil),         // 12: clientpb.BeaconTaskReorderReq
+	(*BeaconTasks)(nil),                  // 13: clientpb.BeaconTasks
+	(*ImplantC2)(nil),                    // 14: clientpb.ImplantC2
+	(*ImplantConfig)(nil),                // 15: clientpb.ImplantConfig
+	(*ExternalImplantConfig)(nil),        // 16: clientpb.ExternalImplantConfig
+	(*ExternalImplantBinary)(nil),        // 17: clientpb.ExternalImplantBinary
+	(*ImplantBuilds)(nil),                // 18: clientpb.ImplantBuilds
+	(*CompilerTarget)(nil),               // 19: clientpb.CompilerTarget
+	(*CrossCompiler)(nil),                // 20: clientpb.CrossCompiler
+	(*Compiler)(nil),                     // 21: clientpb.Compiler
+	(*DeleteReq)(nil),                    // 22: clientpb.DeleteReq
+	(*DNSCanary)(nil),                    // 23: clientpb.DNSCanary
+	(*Canaries)(nil),                     // 24: clientpb.Canaries
+	(*UniqueWGIP)(nil),                   // 25: clientpb.UniqueWGIP
+	(*ImplantProfile)(nil),               // 26: clientpb.ImplantProfile
+	(*ImplantProfiles)(nil),              // 27: clientpb.ImplantProfiles
+	(*RegenerateReq)(nil),                // 28: clientpb.RegenerateReq
+	(*Job)(nil),                          // 29: clientpb.Job
+	(*Jobs)(nil),                         // 30: clientpb.Jobs
+	(*KillJobReq)(nil),                   // 31: clientpb.KillJobReq
+	(*KillJob)(nil),                      // 32: clientpb.KillJob
+	(*ListenerSettings)(nil),             // 33: clientpb.ListenerSettings
+	(*ListenerSettingsReq)(nil),          // 34: clientpb.ListenerSettingsReq
+	(*MTLSListenerReq)(nil),              // 35: clientpb.MTLSListenerReq
+	(*MTLSListener)(nil),                 // 36: clientpb.MTLSListener
+	(*WGListenerReq)(nil),                // 37: clientpb.WGListenerReq
+	(*WGListener)(nil),                   // 38: clientpb.WGListener
+	(*DNSListenerReq)(nil),               // 39: clientpb.DNSListenerReq
+	(*DNSListener)(nil),                  // 40: clientpb.DNSListener
+	(*ICMPListenerReq)(nil),              // 41: clientpb.ICMPListenerReq
+	(*ICMPListener)(nil),                 // 42: clientpb.ICMPListener
+	(*UDPListenerReq)(nil),               // 43: clientpb.UDPListenerReq
+	(*UDPListener)(nil),                  // 44: clientpb.UDPListener
+	(*HTTPListenerReq)(nil),              // 45: clientpb.HTTPListenerReq
+	(*HTTPC2Profile)(nil),                // 46: clientpb.HTTPC2Profile
+	(*HTTPC2Profiles)(nil),               // 47: clientpb.HTTPC2Profiles
+	(*UploadChunk)(nil),                  // 48: clientpb.UploadChunk
+	(*UploadChunks)(nil),                 // 49: clientpb.UploadChunks
+	(*NamedPipesReq)(nil),                // 50: clientpb.NamedPipesReq
+	(*NamedPipes)(nil),                   // 51: clientpb.NamedPipes
+	(*TCPPivotReq)(nil),                  // 52: clientpb.TCPPivotReq
+	(*TCPPivot)(nil),                     // 53: clientpb.TCPPivot
+	(*HTTPListener)(nil),                 // 54: clientpb.HTTPListener
+	(*Sessions)(nil),                     // 55: clientpb.Sessions
+	(*RenameReq)(nil),                    // 56: clientpb.RenameReq
+	(*GenerateReq)(nil),                  // 57: clientpb.GenerateReq
+	(*Generate)(nil),                     // 58: clientpb.Generate
+	(*Indicator)(nil),                    // 59: clientpb.Indicator
+	(*IndicatorReport)(nil),              // 60: clientpb.IndicatorReport
+	(*MSFReq)(nil),                       // 61: clientpb.MSFReq
+	(*MSFRemoteReq)(nil),                 // 62: clientpb.MSFRemoteReq
+	(*StagerListenerReq)(nil),            // 63: clientpb.StagerListenerReq
+	(*Stage)(nil),                        // 64: clientpb.Stage
+	(*StagerListener)(nil),               // 65: clientpb.StagerListener
+	(*ShellUpgradeImplant)(nil),          // 66: clientpb.ShellUpgradeImplant
+	(*ShellUpgradeReq)(nil),              // 67: clientpb.ShellUpgradeReq
+	(*WarmupReq)(nil),                    // 68: clientpb.WarmupReq
+	(*Warmup)(nil),                       // 69: clientpb.Warmup
+	(*ShellcodeRDIReq)(nil),              // 70: clientpb.ShellcodeRDIReq
+	(*ShellcodeRDI)(nil),                 // 71: clientpb.ShellcodeRDI
+	(*ShellcodeArtifactReq)(nil),         // 72: clientpb.ShellcodeArtifactReq
+	(*ShellcodeArtifact)(nil),            // 73: clientpb.ShellcodeArtifact
+	(*ShellcodeArtifacts)(nil),           // 74: clientpb.ShellcodeArtifacts
+	(*MsfStagerReq)(nil),                 // 75: clientpb.MsfStagerReq
+	(*MsfStager)(nil),                    // 76: clientpb.MsfStager
+	(*GetSystemReq)(nil),                 // 77: clientpb.GetSystemReq
+	(*MigrateReq)(nil),                   // 78: clientpb.MigrateReq
+	(*CreateTunnelReq)(nil),              // 79: clientpb.CreateTunnelReq
+	(*CreateTunnel)(nil),                 // 80: clientpb.CreateTunnel
+	(*CloseTunnelReq)(nil),               // 81: clientpb.CloseTunnelReq
+	(*PivotGraphEntry)(nil),              // 82: clientpb.PivotGraphEntry
+	(*PivotGraph)(nil),                   // 83: clientpb.PivotGraph
+	(*Client)(nil),                       // 84: clientpb.Client
+	(*Event)(nil),                        // 85: clientpb.Event
+	(*Operators)(nil),                    // 86: clientpb.Operators
+	(*Operator)(nil),                     // 87: clientpb.Operator
+	(*SecondFactorChallenge)(nil),        // 88: clientpb.SecondFactorChallenge
+	(*SecondFactorReq)(nil),              // 89: clientpb.SecondFactorReq
+	(*SecondFactor)(nil),                 // 90: clientpb.SecondFactor
+	(*WebContent)(nil),                   // 91: clientpb.WebContent
+	(*WebsiteAddContent)(nil),            // 92: clientpb.WebsiteAddContent
+	(*WebsiteRemoveContent)(nil),         // 93: clientpb.WebsiteRemoveContent
+	(*Website)(nil),                      // 94: clientpb.Website
+	(*Websites)(nil),                     // 95: clientpb.Websites
+	(*WGClientConfig)(nil),               // 96: clientpb.WGClientConfig
+	(*Credential)(nil),                   // 97: clientpb.Credential
+	(*CredentialValidation)(nil),         // 98: clientpb.CredentialValidation
+	(*Loot)(nil),                         // 99: clientpb.Loot
+	(*AllLoot)(nil),                      // 100: clientpb.AllLoot
+	(*IOC)(nil),                          // 101: clientpb.IOC
+	(*ExtensionData)(nil),                // 102: clientpb.ExtensionData
+	(*Host)(nil),                         // 103: clientpb.Host
+	(*HostTagsReq)(nil),                  // 104: clientpb.HostTagsReq
+	(*HostService)(nil),                  // 105: clientpb.HostService
+	(*HostLocalGroupMember)(nil),         // 106: clientpb.HostLocalGroupMember
+	(*AllHosts)(nil),                     // 107: clientpb.AllHosts
+	(*Persistence)(nil),                  // 108: clientpb.Persistence
+	(*AllPersistence)(nil),               // 109: clientpb.AllPersistence
+	(*Engagement)(nil),                   // 110: clientpb.Engagement
+	(*DllHijackReq)(nil),                 // 111: clientpb.DllHijackReq
+	(*DllHijack)(nil),                    // 112: clientpb.DllHijack
+	(*ShellcodeEncodeReq)(nil),           // 113: clientpb.ShellcodeEncodeReq
+	(*ShellcodeEncode)(nil),              // 114: clientpb.ShellcodeEncode
+	(*ShellcodeEncoderMap)(nil),          // 115: clientpb.ShellcodeEncoderMap
+	(*ExternalGenerateReq)(nil),          // 116: clientpb.ExternalGenerateReq
+	(*Builders)(nil),                     // 117: clientpb.Builders
+	(*Builder)(nil),                      // 118: clientpb.Builder
+	(*ParseOutputReq)(nil),               // 119: clientpb.ParseOutputReq
+	(*ParsedOutput)(nil),                 // 120: clientpb.ParsedOutput
+	(*PlaybookVariable)(nil),             // 121: clientpb.PlaybookVariable
+	(*PlaybookStep)(nil),                 // 122: clientpb.PlaybookStep
+	(*Playbook)(nil),                     // 123: clientpb.Playbook
+	(*Playbooks)(nil),                    // 124: clientpb.Playbooks
+	(*PlaybookRunReq)(nil),               // 125: clientpb.PlaybookRunReq
+	(*PlaybookStepResult)(nil),           // 126: clientpb.PlaybookStepResult
+	(*PlaybookRun)(nil),                  // 127: clientpb.PlaybookRun
+	(*PlaybookRuns)(nil),                 // 128: clientpb.PlaybookRuns
+	(*PlaybookApprovalReq)(nil),          // 129: clientpb.PlaybookApprovalReq
+	nil,                                  // 130: clientpb.ImplantBuilds.ConfigsEntry
+	nil,                                  // 131: clientpb.DNSListenerReq.TXTEncodingsEntry
+	nil,                                  // 132: clientpb.WebsiteAddContent.ContentsEntry
+	nil,                                  // 133: clientpb.Website.ContentsEntry
+	nil,                                  // 134: clientpb.Host.ExtensionDataEntry
+	nil,                                  // 135: clientpb.ShellcodeEncoderMap.EncodersEntry
+	nil,                                  // 136: clientpb.PlaybookStep.ArgsEntry
+	nil,                                  // 137: clientpb.PlaybookRunReq.VariablesEntry
+	nil,                                  // 138: clientpb.PlaybookRun.VariablesEntry
+	(*sliverpb.DNSDiagnostics)(nil),      // 139: sliverpb.DNSDiagnostics
+	(*sliverpb.EnvironmentSnapshot)(nil), // 140: sliverpb.EnvironmentSnapshot
+	(*sliverpb.Presence)(nil),            // 141: sliverpb.Presence
+	(*commonpb.File)(nil),                // 142: commonpb.File
+	(*commonpb.Request)(nil),             // 143: commonpb.Request
+	(*commonpb.Response)(nil),            // 144: commonpb.Response
+	(*sliverpb.Injection)(nil),           // 145: sliverpb.Injection
+}
+var file_clientpb_client_proto_depIdxs = []int32{
+	139, // 0: clientpb.Session.DNSDiagnostics:type_name -> sliverpb.DNSDiagnostics
+	140, // 1: clientpb.Session.Snapshot:type_name -> sliverpb.EnvironmentSnapshot
+	141, // 2: clientpb.Beacon.Presence:type_name -> sliverpb.Presence
+	139, // 3: clientpb.Beacon.DNSDiagnostics:type_name -> sliverpb.DNSDiagnostics
+	140, // 4: clientpb.Beacon.Snapshot:type_name -> sliverpb.EnvironmentSnapshot
+	8,   // 5: clientpb.Beacons.Beacons:type_name -> clientpb.Beacon
+	8,   // 6: clientpb.BeaconAddressChange.Beacon:type_name -> clientpb.Beacon
+	11,  // 7: clientpb.BeaconTasks.Tasks:type_name -> clientpb.BeaconTask
+	14,  // 8: clientpb.ImplantConfig.C2:type_name -> clientpb.ImplantC2
+	0,   // 9: clientpb.ImplantConfig.Format:type_name -> clientpb.OutputFormat
+	15,  // 10: clientpb.ExternalImplantConfig.Config:type_name -> clientpb.ImplantConfig
+	142, // 11: clientpb.ExternalImplantBinary.File:type_name -> commonpb.File
+	130, // 12: clientpb.ImplantBuilds.Configs:type_name -> clientpb.ImplantBuilds.ConfigsEntry
+	0,   // 13: clientpb.CompilerTarget.Format:type_name -> clientpb.OutputFormat
+	19,  // 14: clientpb.Compiler.Targets:type_name -> clientpb.CompilerTarget
+	20,  // 15: clientpb.Compiler.CrossCompilers:type_name -> clientpb.CrossCompiler
+	19,  // 16: clientpb.Compiler.UnsupportedTargets:type_name -> clientpb.CompilerTarget
+	23,  // 17: clientpb.Canaries.Canaries:type_name -> clientpb.DNSCanary
+	15,  // 18: clientpb.ImplantProfile.Config:type_name -> clientpb.ImplantConfig
+	26,  // 19: clientpb.ImplantProfiles.Profiles:type_name -> clientpb.ImplantProfile
+	29,  // 20: clientpb.Jobs.Active:type_name -> clientpb.Job
+	131, // 21: clientpb.DNSListenerReq.TXTEncodings:type_name -> clientpb.DNSListenerReq.TXTEncodingsEntry
+	46,  // 22: clientpb.HTTPC2Profiles.Profiles:type_name -> clientpb.HTTPC2Profile
+	143, // 23: clientpb.NamedPipesReq.Request:type_name -> commonpb.Request
+	144, // 24: clientpb.NamedPipes.Response:type_name -> commonpb.Response
+	143, // 25: clientpb.TCPPivotReq.Request:type_name -> commonpb.Request
+	144, // 26: clientpb.TCPPivot.Response:type_name -> commonpb.Response
+	7,   // 27: clientpb.Sessions.Sessions:type_name -> clientpb.Session
+	15,  // 28: clientpb.GenerateReq.Config:type_name -> clientpb.ImplantConfig
+	142, // 29: clientpb.Generate.File:type_name -> commonpb.File
+	60,  // 30: clientpb.Generate.Indicators:type_name -> clientpb.IndicatorReport
+	59,  // 31: clientpb.IndicatorReport.Indicators:type_name -> clientpb.Indicator
+	143, // 32: clientpb.MSFReq.Request:type_name -> commonpb.Request
+	143, // 33: clientpb.MSFRemoteReq.Request:type_name -> commonpb.Request
+	1,   // 34: clientpb.StagerListenerReq.Protocol:type_name -> clientpb.StageProtocol
+	64,  // 35: clientpb.StagerListenerReq.Stages:type_name -> clientpb.Stage
+	66,  // 36: clientpb.ShellUpgradeReq.Implants:type_name -> clientpb.ShellUpgradeImplant
+	73,  // 37: clientpb.ShellcodeArtifacts.Artifacts:type_name -> clientpb.ShellcodeArtifact
+	1,   // 38: clientpb.MsfStagerReq.Protocol:type_name -> clientpb.StageProtocol
+	142, // 39: clientpb.MsfStager.File:type_name -> commonpb.File
+	15,  // 40: clientpb.GetSystemReq.Config:type_name -> clientpb.ImplantConfig
+	143, // 41: clientpb.GetSystemReq.Request:type_name -> commonpb.Request
+	15,  // 42: clientpb.MigrateReq.Config:type_name -> clientpb.ImplantConfig
+	5,   // 43: clientpb.MigrateReq.Encoder:type_name -> clientpb.ShellcodeEncoder
+	145, // 44: clientpb.MigrateReq.Injection:type_name -> sliverpb.Injection
+	143, // 45: clientpb.MigrateReq.Request:type_name -> commonpb.Request
+	143, // 46: clientpb.CreateTunnelReq.Request:type_name -> commonpb.Request
+	143, // 47: clientpb.CloseTunnelReq.Request:type_name -> commonpb.Request
+	7,   // 48: clientpb.PivotGraphEntry.Session:type_name -> clientpb.Session
+	82,  // 49: clientpb.PivotGraphEntry.Children:type_name -> clientpb.PivotGraphEntry
+	82,  // 50: clientpb.PivotGraph.Children:type_name -> clientpb.PivotGraphEntry
+	87,  // 51: clientpb.Client.Operator:type_name -> clientpb.Operator
+	7,   // 52: clientpb.Event.Session:type_name -> clientpb.Session
+	29,  // 53: clientpb.Event.Job:type_name -> clientpb.Job
+	84,  // 54: clientpb.Event.Client:type_name -> clientpb.Client
+	87,  // 55: clientpb.Operators.Operators:type_name -> clientpb.Operator
+	132, // 56: clientpb.WebsiteAddContent.Contents:type_name -> clientpb.WebsiteAddContent.ContentsEntry
+	133, // 57: clientpb.Website.Contents:type_name -> clientpb.Website.ContentsEntry
+	94,  // 58: clientpb.Websites.Websites:type_name -> clientpb.Website
+	98,  // 59: clientpb.Credential.Validations:type_name -> clientpb.CredentialValidation
+	2,   // 60: clientpb.Loot.Type:type_name -> clientpb.LootType
+	3,   // 61: clientpb.Loot.CredentialType:type_name -> clientpb.CredentialType
+	97,  // 62: clientpb.Loot.Credential:type_name -> clientpb.Credential
+	4,   // 63: clientpb.Loot.FileType:type_name -> clientpb.FileType
+	142, // 64: clientpb.Loot.File:type_name -> commonpb.File
+	99,  // 65: clientpb.AllLoot.Loot:type_name -> clientpb.Loot
+	101, // 66: clientpb.Host.IOCs:type_name -> clientpb.IOC
+	134, // 67: clientpb.Host.ExtensionData:type_name -> clientpb.Host.ExtensionDataEntry
+	106, // 68: clientpb.Host.LocalGroupMembers:type_name -> clientpb.HostLocalGroupMember
+	105, // 69: clientpb.Host.Services:type_name -> clientpb.HostService
+	103, // 70: clientpb.AllHosts.Hosts:type_name -> clientpb.Host
+	108, // 71: clientpb.AllPersistence.Persistence:type_name -> clientpb.Persistence
+	143, // 72: clientpb.DllHijackReq.Request:type_name -> commonpb.Request
+	144, // 73: clientpb.DllHijack.Response:type_name -> commonpb.Response
+	5,   // 74: clientpb.ShellcodeEncodeReq.Encoder:type_name -> clientpb.ShellcodeEncoder
+	143, // 75: clientpb.ShellcodeEncodeReq.Request:type_name -> commonpb.Request
+	144, // 76: clientpb.ShellcodeEncode.Response:type_name -> commonpb.Response
+	135, // 77: clientpb.ShellcodeEncoderMap.Encoders:type_name -> clientpb.ShellcodeEncoderMap.EncodersEntry
+	15,  // 78: clientpb.ExternalGenerateReq.Config:type_name -> clientpb.ImplantConfig
+	118, // 79: clientpb.Builders.Builders:type_name -> clientpb.Builder
+	19,  // 80: clientpb.Builder.Targets:type_name -> clientpb.CompilerTarget
+	20,  // 81: clientpb.Builder.CrossCompilers:type_name -> clientpb.CrossCompiler
+	97,  // 82: clientpb.ParsedOutput.Credentials:type_name -> clientpb.Credential
+	103, // 83: clientpb.ParsedOutput.Hosts:type_name -> clientpb.Host
+	136, // 84: clientpb.PlaybookStep.Args:type_name -> clientpb.PlaybookStep.ArgsEntry
+	121, // 85: clientpb.Playbook.Variables:type_name -> clientpb.PlaybookVariable
+	122, // 86: clientpb.Playbook.Steps:type_name -> clientpb.PlaybookStep
+	123, // 87: clientpb.Playbooks.Playbooks:type_name -> clientpb.Playbook
+	137, // 88: clientpb.PlaybookRunReq.Variables:type_name -> clientpb.PlaybookRunReq.VariablesEntry
+	138, // 89: clientpb.PlaybookRun.Variables:type_name -> clientpb.PlaybookRun.VariablesEntry
+	126, // 90: clientpb.PlaybookRun.Steps:type_name -> clientpb.PlaybookStepResult
+	127, // 91: clientpb.PlaybookRuns.Runs:type_name -> clientpb.PlaybookRun
+	15,  // 92: clientpb.ImplantBuilds.ConfigsEntry.value:type_name -> clientpb.ImplantConfig
+	91,  // 93: clientpb.WebsiteAddContent.ContentsEntry.value:type_name -> clientpb.WebContent
+	91,  // 94: clientpb.Website.ContentsEntry.value:type_name -> clientpb.WebContent
+	102, // 95: clientpb.Host.ExtensionDataEntry.value:type_name -> clientpb.ExtensionData
+	5,   // 96: clientpb.ShellcodeEncoderMap.EncodersEntry.value:type_name -> clientpb.ShellcodeEncoder
+	97,  // [97:97] is the sub-list for method output_type
+	97,  // [97:97] is the sub-list for method input_type
+	97,  // [97:97] is the sub-list for extension type_name
+	97,  // [97:97] is the sub-list for extension extendee
+	0,   // [0:97] is the sub-list for field type_name
+}
+
+func init() { file_clientpb_client_proto_init() }
+func file_clientpb_client_proto_init() {
+	if File_clientpb_client_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_clientpb_client_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Beacon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Beacons); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconAddressChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconTaskReorderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconTasks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImplantC2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImplantConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalImplantConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalImplantBinary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImplantBuilds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompilerTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrossCompiler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compiler); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSCanary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Canaries); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UniqueWGIP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImplantProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImplantProfiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegenerateReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Job); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Jobs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillJobReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenerSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListenerSettingsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MTLSListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MTLSListener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WGListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WGListener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DNSListener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ICMPListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ICMPListener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UDPListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UDPListener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPListenerReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPC2Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPC2Profiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_clientpb_client_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadChunks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7181,8 +12046,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Session); i {
+		file_clientpb_client_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamedPipesReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7193,8 +12058,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Beacon); i {
+		file_clientpb_client_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NamedPipes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7205,8 +12070,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Beacons); i {
+		file_clientpb_client_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCPPivotReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7217,8 +12082,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconTask); i {
+		file_clientpb_client_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TCPPivot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7229,8 +12094,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BeaconTasks); i {
+		file_clientpb_client_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPListener); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7241,8 +12106,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImplantC2); i {
+		file_clientpb_client_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sessions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7253,8 +12118,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImplantConfig); i {
+		file_clientpb_client_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7265,8 +12130,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalImplantConfig); i {
+		file_clientpb_client_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7277,8 +12142,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalImplantBinary); i {
+		file_clientpb_client_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Generate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7289,8 +12154,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImplantBuilds); i {
+		file_clientpb_client_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Indicator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7301,8 +12166,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CompilerTarget); i {
+		file_clientpb_client_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IndicatorReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7313,8 +12178,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CrossCompiler); i {
+		file_clientpb_client_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSFReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7325,8 +12190,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Compiler); i {
+		file_clientpb_client_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MSFRemoteReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7337,8 +12202,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteReq); i {
+		file_clientpb_client_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagerListenerReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7349,8 +12214,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNSCanary); i {
+		file_clientpb_client_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7361,8 +12226,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Canaries); i {
+		file_clientpb_client_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StagerListener); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7373,8 +12238,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UniqueWGIP); i {
+		file_clientpb_client_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellUpgradeImplant); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7385,8 +12250,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImplantProfile); i {
+		file_clientpb_client_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellUpgradeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7397,8 +12262,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImplantProfiles); i {
+		file_clientpb_client_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WarmupReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7409,8 +12274,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RegenerateReq); i {
+		file_clientpb_client_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Warmup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7421,8 +12286,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Job); i {
+		file_clientpb_client_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeRDIReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7433,8 +12298,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Jobs); i {
+		file_clientpb_client_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeRDI); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7445,8 +12310,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KillJobReq); i {
+		file_clientpb_client_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeArtifactReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7457,8 +12322,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KillJob); i {
+		file_clientpb_client_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeArtifact); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7469,8 +12334,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MTLSListenerReq); i {
+		file_clientpb_client_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeArtifacts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7481,8 +12346,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MTLSListener); i {
+		file_clientpb_client_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsfStagerReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7493,8 +12358,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WGListenerReq); i {
+		file_clientpb_client_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsfStager); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7505,8 +12370,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WGListener); i {
+		file_clientpb_client_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7517,8 +12382,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNSListenerReq); i {
+		file_clientpb_client_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MigrateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7529,8 +12394,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DNSListener); i {
+		file_clientpb_client_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTunnelReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7541,8 +12406,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HTTPListenerReq); i {
+		file_clientpb_client_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateTunnel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7553,8 +12418,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NamedPipesReq); i {
+		file_clientpb_client_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseTunnelReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7565,8 +12430,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NamedPipes); i {
+		file_clientpb_client_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PivotGraphEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7577,8 +12442,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TCPPivotReq); i {
+		file_clientpb_client_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PivotGraph); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7589,8 +12454,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TCPPivot); i {
+		file_clientpb_client_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Client); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7601,8 +12466,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HTTPListener); i {
+		file_clientpb_client_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7613,8 +12478,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Sessions); i {
+		file_clientpb_client_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operators); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7625,8 +12490,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameReq); i {
+		file_clientpb_client_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Operator); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7637,8 +12502,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateReq); i {
+		file_clientpb_client_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecondFactorChallenge); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7649,8 +12514,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Generate); i {
+		file_clientpb_client_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecondFactorReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7661,8 +12526,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MSFReq); i {
+		file_clientpb_client_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecondFactor); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7673,8 +12538,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MSFRemoteReq); i {
+		file_clientpb_client_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7685,8 +12550,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StagerListenerReq); i {
+		file_clientpb_client_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebsiteAddContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7697,8 +12562,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StagerListener); i {
+		file_clientpb_client_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebsiteRemoveContent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7709,8 +12574,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShellcodeRDIReq); i {
+		file_clientpb_client_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Website); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7721,8 +12586,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShellcodeRDI); i {
+		file_clientpb_client_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Websites); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7733,8 +12598,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsfStagerReq); i {
+		file_clientpb_client_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WGClientConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7745,8 +12610,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsfStager); i {
+		file_clientpb_client_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Credential); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7757,8 +12622,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSystemReq); i {
+		file_clientpb_client_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CredentialValidation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7769,8 +12634,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MigrateReq); i {
+		file_clientpb_client_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Loot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7781,8 +12646,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTunnelReq); i {
+		file_clientpb_client_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllLoot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7793,8 +12658,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateTunnel); i {
+		file_clientpb_client_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IOC); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7805,8 +12670,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseTunnelReq); i {
+		file_clientpb_client_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExtensionData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7817,8 +12682,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PivotGraphEntry); i {
+		file_clientpb_client_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Host); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7829,8 +12694,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PivotGraph); i {
+		file_clientpb_client_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostTagsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7841,8 +12706,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Client); i {
+		file_clientpb_client_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostService); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7853,8 +12718,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Event); i {
+		file_clientpb_client_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostLocalGroupMember); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7865,8 +12730,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operators); i {
+		file_clientpb_client_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllHosts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7877,8 +12742,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operator); i {
+		file_clientpb_client_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Persistence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7889,8 +12754,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WebContent); i {
+		file_clientpb_client_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllPersistence); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7901,8 +12766,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WebsiteAddContent); i {
+		file_clientpb_client_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Engagement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7913,8 +12778,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WebsiteRemoveContent); i {
+		file_clientpb_client_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DllHijackReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7925,8 +12790,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Website); i {
+		file_clientpb_client_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DllHijack); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7937,8 +12802,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Websites); i {
+		file_clientpb_client_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeEncodeReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7949,8 +12814,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WGClientConfig); i {
+		file_clientpb_client_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeEncode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7961,8 +12826,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Credential); i {
+		file_clientpb_client_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellcodeEncoderMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7973,8 +12838,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Loot); i {
+		file_clientpb_client_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalGenerateReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7985,8 +12850,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllLoot); i {
+		file_clientpb_client_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Builders); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7997,8 +12862,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IOC); i {
+		file_clientpb_client_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Builder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8009,8 +12874,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExtensionData); i {
+		file_clientpb_client_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParseOutputReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8021,8 +12886,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Host); i {
+		file_clientpb_client_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParsedOutput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8033,8 +12898,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllHosts); i {
+		file_clientpb_client_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybookVariable); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8045,8 +12910,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DllHijackReq); i {
+		file_clientpb_client_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybookStep); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8057,8 +12922,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DllHijack); i {
+		file_clientpb_client_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Playbook); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8069,8 +12934,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShellcodeEncodeReq); i {
+		file_clientpb_client_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Playbooks); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8081,8 +12946,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShellcodeEncode); i {
+		file_clientpb_client_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybookRunReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8093,8 +12958,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShellcodeEncoderMap); i {
+		file_clientpb_client_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybookStepResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8105,8 +12970,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExternalGenerateReq); i {
+		file_clientpb_client_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybookRun); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8117,8 +12982,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Builders); i {
+		file_clientpb_client_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybookRuns); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8129,8 +12994,8 @@ func file_clientpb_client_proto_init() {
 				return nil
 			}
 		}
-		file_clientpb_client_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Builder); i {
+		file_clientpb_client_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybookApprovalReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8148,7 +13013,7 @@ func file_clientpb_client_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_clientpb_client_proto_rawDesc,
 			NumEnums:      6,
-			NumMessages:   86,
+			NumMessages:   133,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
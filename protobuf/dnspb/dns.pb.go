@@ -32,20 +32,22 @@ const (
 	DNSMessageType_DATA_TO_IMPLANT   DNSMessageType = 7
 	DNSMessageType_DATA_FROM_IMPLANT DNSMessageType = 8
 	DNSMessageType_CLEAR             DNSMessageType = 9
+	DNSMessageType_RESUME            DNSMessageType = 10
 )
 
 // Enum value maps for DNSMessageType.
 var (
 	DNSMessageType_name = map[int32]string{
-		0: "NOP",
-		1: "TOTP",
-		2: "INIT",
-		3: "POLL",
-		4: "CLOSE",
-		6: "MANIFEST",
-		7: "DATA_TO_IMPLANT",
-		8: "DATA_FROM_IMPLANT",
-		9: "CLEAR",
+		0:  "NOP",
+		1:  "TOTP",
+		2:  "INIT",
+		3:  "POLL",
+		4:  "CLOSE",
+		6:  "MANIFEST",
+		7:  "DATA_TO_IMPLANT",
+		8:  "DATA_FROM_IMPLANT",
+		9:  "CLEAR",
+		10: "RESUME",
 	}
 	DNSMessageType_value = map[string]int32{
 		"NOP":               0,
@@ -57,6 +59,7 @@ var (
 		"DATA_TO_IMPLANT":   7,
 		"DATA_FROM_IMPLANT": 8,
 		"CLEAR":             9,
+		"RESUME":            10,
 	}
 )
 
@@ -87,21 +90,83 @@ func (DNSMessageType) EnumDescriptor() ([]byte, []int) {
 	return file_dnspb_dns_proto_rawDescGZIP(), []int{0}
 }
 
+// Encoding of data in TXT responses, in order of efficiency
+type TXTEncoding int32
+
+const (
+	TXTEncoding_BASE64 TXTEncoding = 0
+	TXTEncoding_BASE85 TXTEncoding = 1
+	TXTEncoding_RAW    TXTEncoding = 2
+)
+
+// Enum value maps for TXTEncoding.
+var (
+	TXTEncoding_name = map[int32]string{
+		0: "BASE64",
+		1: "BASE85",
+		2: "RAW",
+	}
+	TXTEncoding_value = map[string]int32{
+		"BASE64": 0,
+		"BASE85": 1,
+		"RAW":    2,
+	}
+)
+
+func (x TXTEncoding) Enum() *TXTEncoding {
+	p := new(TXTEncoding)
+	*p = x
+	return p
+}
+
+func (x TXTEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TXTEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_dnspb_dns_proto_enumTypes[1].Descriptor()
+}
+
+func (TXTEncoding) Type() protoreflect.EnumType {
+	return &file_dnspb_dns_proto_enumTypes[1]
+}
+
+func (x TXTEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TXTEncoding.Descriptor instead.
+func (TXTEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_dnspb_dns_proto_rawDescGZIP(), []int{1}
+}
+
 // NOTE: DNS is very space sensitive so certain fields are re-purposed
 // depending on the DNSMessageType as noted below:
 //
 // [Type TOTP]: ID field is used for the TOTP code
+// [Type RESUME]: Data field is the dns session ID encrypted with the session key
+// [Type NOP]: Start field offsets the pattern the response is padded with
+// [Type NOP, POLL]: Data field is random padding, the server ignores it
+// [Type NOP, DATA_TO_IMPLANT]: Encoding field requests a TXT encoding, the server
+// responds with base64 if the zone doesn't allow it
+// [Type INIT, RESUME]: Encoding and Stop fields propose the densest TXT encoding
+// and largest chunk size the implant can use, the encrypted
+// response includes what the server agreed to
+// [All types]: Padding field makes the encoded query one of a few fixed lengths,
+// the server ignores it
 type DNSMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type  DNSMessageType `protobuf:"varint,1,opt,name=Type,proto3,enum=dnspb.DNSMessageType" json:"Type,omitempty"`
-	ID    uint32         `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`       // 8 bit message id + 24 bit dns session ID
-	Start uint32         `protobuf:"varint,3,opt,name=Start,proto3" json:"Start,omitempty"` // Bytes start at
-	Stop  uint32         `protobuf:"varint,4,opt,name=Stop,proto3" json:"Stop,omitempty"`   // Bytes stop at
-	Size  uint32         `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`   // Total size
-	Data  []byte         `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`    // Actual data
+	Type     DNSMessageType `protobuf:"varint,1,opt,name=Type,proto3,enum=dnspb.DNSMessageType" json:"Type,omitempty"`
+	ID       uint32         `protobuf:"varint,2,opt,name=ID,proto3" json:"ID,omitempty"`       // 8 bit message id + 24 bit dns session ID
+	Start    uint32         `protobuf:"varint,3,opt,name=Start,proto3" json:"Start,omitempty"` // Bytes start at
+	Stop     uint32         `protobuf:"varint,4,opt,name=Stop,proto3" json:"Stop,omitempty"`   // Bytes stop at
+	Size     uint32         `protobuf:"varint,5,opt,name=Size,proto3" json:"Size,omitempty"`   // Total size
+	Data     []byte         `protobuf:"bytes,6,opt,name=Data,proto3" json:"Data,omitempty"`    // Actual data
+	Encoding TXTEncoding    `protobuf:"varint,7,opt,name=Encoding,proto3,enum=dnspb.TXTEncoding" json:"Encoding,omitempty"`
+	Padding  []byte         `protobuf:"bytes,8,opt,name=Padding,proto3" json:"Padding,omitempty"`
 }
 
 func (x *DNSMessage) Reset() {
@@ -178,11 +243,25 @@ func (x *DNSMessage) GetData() []byte {
 	return nil
 }
 
+func (x *DNSMessage) GetEncoding() TXTEncoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return TXTEncoding_BASE64
+}
+
+func (x *DNSMessage) GetPadding() []byte {
+	if x != nil {
+		return x.Padding
+	}
+	return nil
+}
+
 var File_dnspb_dns_proto protoreflect.FileDescriptor
 
 var file_dnspb_dns_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x64, 0x6e, 0x73, 0x70, 0x62, 0x2f, 0x64, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x05, 0x64, 0x6e, 0x73, 0x70, 0x62, 0x22, 0x99, 0x01, 0x0a, 0x0a, 0x44, 0x4e, 0x53,
+	0x6f, 0x12, 0x05, 0x64, 0x6e, 0x73, 0x70, 0x62, 0x22, 0xe3, 0x01, 0x0a, 0x0a, 0x44, 0x4e, 0x53,
 	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x64, 0x6e, 0x73, 0x70, 0x62, 0x2e, 0x44, 0x4e,
 	0x53, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x54, 0x79,
@@ -192,19 +271,27 @@ var file_dnspb_dns_proto_rawDesc = []byte{
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x12, 0x0a, 0x04,
 	0x53, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65,
 	0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x2a, 0x87, 0x01, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x4f, 0x50, 0x10, 0x00,
-	0x12, 0x08, 0x0a, 0x04, 0x54, 0x4f, 0x54, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e,
-	0x49, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x09,
-	0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x4e,
-	0x49, 0x46, 0x45, 0x53, 0x54, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x41, 0x54, 0x41, 0x5f,
-	0x54, 0x4f, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x41, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11,
-	0x44, 0x41, 0x54, 0x41, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x41, 0x4e,
-	0x54, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x09, 0x42, 0x2c,
-	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x73,
-	0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78, 0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x6e, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x64, 0x6e, 0x73, 0x70, 0x62, 0x2e, 0x54,
+	0x58, 0x54, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x50, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x2a, 0x93,
+	0x01, 0x0a, 0x0e, 0x44, 0x4e, 0x53, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x4f,
+	0x54, 0x50, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x49, 0x54, 0x10, 0x02, 0x12, 0x08,
+	0x0a, 0x04, 0x50, 0x4f, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x10,
+	0x06, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x54, 0x4f, 0x5f, 0x49, 0x4d, 0x50,
+	0x4c, 0x41, 0x4e, 0x54, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x46,
+	0x52, 0x4f, 0x4d, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x41, 0x4e, 0x54, 0x10, 0x08, 0x12, 0x09, 0x0a,
+	0x05, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x53, 0x55,
+	0x4d, 0x45, 0x10, 0x0a, 0x2a, 0x2e, 0x0a, 0x0b, 0x54, 0x58, 0x54, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x69, 0x6e, 0x67, 0x12, 0x0a, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x42, 0x41, 0x53, 0x45, 0x38, 0x35, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x52,
+	0x41, 0x57, 0x10, 0x02, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x73, 0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78, 0x2f, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x6e, 0x73,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -219,19 +306,21 @@ func file_dnspb_dns_proto_rawDescGZIP() []byte {
 	return file_dnspb_dns_proto_rawDescData
 }
 
-var file_dnspb_dns_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_dnspb_dns_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
 var file_dnspb_dns_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_dnspb_dns_proto_goTypes = []interface{}{
 	(DNSMessageType)(0), // 0: dnspb.DNSMessageType
-	(*DNSMessage)(nil),  // 1: dnspb.DNSMessage
+	(TXTEncoding)(0),    // 1: dnspb.TXTEncoding
+	(*DNSMessage)(nil),  // 2: dnspb.DNSMessage
 }
 var file_dnspb_dns_proto_depIdxs = []int32{
 	0, // 0: dnspb.DNSMessage.Type:type_name -> dnspb.DNSMessageType
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 1: dnspb.DNSMessage.Encoding:type_name -> dnspb.TXTEncoding
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_dnspb_dns_proto_init() }
@@ -258,7 +347,7 @@ func file_dnspb_dns_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dnspb_dns_proto_rawDesc,
-			NumEnums:      1,
+			NumEnums:      2,
 			NumMessages:   1,
 			NumExtensions: 0,
 			NumServices:   0,
@@ -31,376 +31,555 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2f, 0x73,
 	0x6c, 0x69, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x63, 0x6c, 0x69,
 	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0xd0, 0x40, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x50, 0x43,
+	0x74, 0x6f, 0x32, 0xc3, 0x60, 0x0a, 0x09, 0x53, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x52, 0x50, 0x43,
 	0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0f,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
 	0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69,
 	0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
 	0x72, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
 	0x70, 0x74, 0x79, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c,
-	0x12, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c,
-	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x75, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x13,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x47, 0x65,
-	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x52,
-	0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x10, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x15,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x4c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6c, 0x6c,
+	0x65, 0x6e, 0x67, 0x65, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x47, 0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x19, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12,
+	0x2a, 0x0a, 0x04, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x52,
+	0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x74, 0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x53,
+	0x65, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x15,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x0a, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x73, 0x12,
+	0x2f, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x10,
 	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x42, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63,
-	0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54,
-	0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3e, 0x0a, 0x10, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x14, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54,
-	0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
-	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x0c, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f,
-	0x0a, 0x0b, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f,
+	0x12, 0x2d, 0x0a, 0x08, 0x52, 0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x12, 0x10, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x1a, 0x0f,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x2a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x4b,
-	0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12,
-	0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0f, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57,
-	0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x10, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x2e,
+	0x39, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x42, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x3e,
+	0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4b,
+	0x0a, 0x12, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x0c, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x0f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2f, 0x0a, 0x0b, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12,
+	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x2a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0e, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x32, 0x0a,
+	0x07, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x11,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x4a, 0x6f,
+	0x62, 0x12, 0x50, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x50, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x1a, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x54,
+	0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4d, 0x54, 0x4c, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x40, 0x0a,
+	0x0f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
+	0x43, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44,
+	0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e,
 	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x44, 0x4e, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x47,
-	0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x54, 0x54, 0x50, 0x53, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c,
-	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
-	0x4f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x43, 0x50, 0x53, 0x74, 0x61, 0x67, 0x65,
-	0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x12, 0x50, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x54, 0x54, 0x50, 0x53, 0x74, 0x61,
+	0x65, 0x6e, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x46, 0x0a,
+	0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54,
+	0x54, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x43,
+	0x4d, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x43, 0x4d, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x49, 0x43, 0x4d, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x43, 0x0a,
+	0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x55, 0x44, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x44, 0x50,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x44, 0x50, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x12, 0x3e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x3d, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x43, 0x32, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x4f, 0x0a, 0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x43, 0x50, 0x53, 0x74, 0x61,
 	0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x63, 0x6c,
 	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73,
 	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
 	0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x4c, 0x6f, 0x6f, 0x74, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x29, 0x0a,
-	0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x52, 0x6d, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0a, 0x4c, 0x6f, 0x6f, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x4c, 0x6f, 0x6f, 0x74, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x4c, 0x6f, 0x6f, 0x74, 0x41, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x50, 0x0a, 0x19, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
+	0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x17, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x54, 0x54,
+	0x50, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
+	0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65,
+	0x72, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x57,
+	0x61, 0x72, 0x6d, 0x75, 0x70, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x57, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x12, 0x29, 0x0a, 0x07,
+	0x4c, 0x6f, 0x6f, 0x74, 0x41, 0x64, 0x64, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x4c, 0x6f, 0x6f, 0x74, 0x52,
+	0x6d, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f,
+	0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x2c, 0x0a, 0x0a, 0x4c, 0x6f, 0x6f, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74,
+	0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74,
+	0x12, 0x2d, 0x0a, 0x0b, 0x4c, 0x6f, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a,
+	0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x12,
+	0x2d, 0x0a, 0x07, 0x4c, 0x6f, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2e,
+	0x0a, 0x09, 0x4c, 0x6f, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x66, 0x12, 0x0e, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2c,
+	0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x04,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x48, 0x6f, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x48, 0x6f, 0x73, 0x74, 0x52, 0x6d, 0x12, 0x0e,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x2b, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x4f, 0x43, 0x52, 0x6d, 0x12, 0x0d, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x4f, 0x43, 0x1a, 0x0f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0b,
+	0x50, 0x61, 0x72, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x18, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x31, 0x0a,
+	0x08, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x0a, 0x48, 0x6f, 0x73, 0x74, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x0e,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
+	0x12, 0x34, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x73,
 	0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c,
-	0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x4c, 0x6f, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x4f,
-	0x66, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x6f,
-	0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c,
-	0x4c, 0x6f, 0x6f, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x73,
-	0x74, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x48, 0x6f,
-	0x73, 0x74, 0x52, 0x6d, 0x12, 0x0e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x48, 0x6f, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x4f, 0x43,
-	0x52, 0x6d, 0x12, 0x0d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x4f,
-	0x43, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x35, 0x0a, 0x08, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x15,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a,
-	0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x53, 0x61, 0x76, 0x65, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d,
-	0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x20,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c,
-	0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70,
-	0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x37, 0x0a, 0x0f, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x11, 0x2e,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72,
-	0x1a, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x72,
-	0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75,
-	0x69, 0x6c, 0x64, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x3a,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42,
-	0x75, 0x69, 0x6c, 0x64, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x43, 0x61,
-	0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x16, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57, 0x47, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x57, 0x47, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x39, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x49, 0x50, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x57, 0x47, 0x49, 0x50, 0x12, 0x3d, 0x0a, 0x0f, 0x49,
-	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x0f,
+	0x79, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x35,
+	0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b,
+	0x12, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x6f, 0x6f, 0x6b, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x6f, 0x6f, 0x6b, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f,
+	0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x75,
+	0x6e, 0x73, 0x12, 0x4b, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x6f, 0x6f, 0x6b, 0x52, 0x75, 0x6e, 0x12,
+	0x3e, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x37, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x6d,
+	0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3b, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x6c, 0x6c, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x41, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x36, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3b, 0x0a,
+	0x0d, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x67, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x45, 0x6e, 0x67, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x52, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x19, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x53, 0x61, 0x76, 0x65, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x12, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x69, 0x6e,
+	0x61, 0x72, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x5c, 0x0a, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x61,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x17, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x1a, 0x1f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x37, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x0f, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x2f, 0x0a, 0x08, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x39, 0x0a, 0x0a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x17,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x49,
+	0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x17, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x12, 0x3a, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x13, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x43, 0x61, 0x6e, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x0f,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61,
-	0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x48, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65,
-	0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e,
-	0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x61, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x57,
+	0x47, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x50, 0x12, 0x0f, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x57,
+	0x47, 0x49, 0x50, 0x12, 0x3d, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x3c, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x49, 0x6d, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x48, 0x0a, 0x12, 0x53, 0x61, 0x76, 0x65, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c, 0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x1a, 0x18, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x6c,
+	0x61, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x4d, 0x73,
+	0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x13,
 	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61,
-	0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x4d, 0x73, 0x66, 0x53, 0x74, 0x61, 0x67, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0c, 0x53,
-	0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x12, 0x19, 0x2e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
-	0x52, 0x44, 0x49, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x12, 0x32,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x0f, 0x2e,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12,
-	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c,
-	0x65, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45,
-	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
-	0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x45, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x2f, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74,
-	0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57,
-	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x73, 0x69,
-	0x74, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x57, 0x65, 0x62, 0x73,
-	0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x1a, 0x0f, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a,
-	0x11, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a,
-	0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69,
-	0x74, 0x65, 0x12, 0x46, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x14, 0x57, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65,
-	0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x0e, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a,
-	0x02, 0x50, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
-	0x50, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12,
-	0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x08,
-	0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x70, 0x62, 0x2e, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a,
-	0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x66, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x14,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
-	0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x4c, 0x73, 0x12, 0x0f, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x12, 0x24, 0x0a, 0x02,
-	0x43, 0x64, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x64,
-	0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50,
-	0x77, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x50, 0x77, 0x64, 0x12, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x77, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x77, 0x64, 0x12, 0x23, 0x0a, 0x02, 0x4d, 0x76,
-	0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x76, 0x52, 0x65,
-	0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x76, 0x12,
-	0x23, 0x0a, 0x02, 0x52, 0x6d, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x52, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x52, 0x6d, 0x12, 0x2c, 0x0a, 0x05, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x12, 0x12, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x52, 0x65,
-	0x71, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x64,
-	0x69, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x15,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x67, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x44, 0x49, 0x12, 0x19, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53,
+	0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x52, 0x65, 0x71, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
+	0x6f, 0x64, 0x65, 0x52, 0x44, 0x49, 0x12, 0x32, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x10, 0x53, 0x68,
+	0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x12, 0x1c,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
+	0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64,
+	0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x45, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x0f,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1d, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x63, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x4d, 0x61, 0x70, 0x12, 0x57,
+	0x0a, 0x18, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x43, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1c,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63,
+	0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x54, 0x0a, 0x18,
+	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61,
+	0x63, 0x74, 0x12, 0x43, 0x0a, 0x13, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x52, 0x6d, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x63, 0x6f, 0x64, 0x65, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2f, 0x0a, 0x08, 0x57, 0x65, 0x62, 0x73, 0x69,
+	0x74, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x73,
+	0x69, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x57, 0x65, 0x62,
+	0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x11, 0x2e, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x1a, 0x0f, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43,
+	0x0a, 0x11, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73,
+	0x69, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x14, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x70, 0x62, 0x2e, 0x57, 0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x49, 0x0a, 0x14, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x12, 0x1e, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x57,
+	0x65, 0x62, 0x73, 0x69, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x0e,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x1a, 0x0e,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x23,
+	0x0a, 0x02, 0x50, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x50, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x50, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a,
+	0x08, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x66, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x66, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x32, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12,
+	0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x02, 0x4c, 0x73, 0x12, 0x0f,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x24,
+	0x0a, 0x02, 0x43, 0x64, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x43, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x50, 0x77, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x50, 0x77, 0x64, 0x12, 0x10, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x77, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x77, 0x64, 0x12, 0x23, 0x0a, 0x02,
+	0x4d, 0x76, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x76,
+	0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d,
+	0x76, 0x12, 0x23, 0x0a, 0x02, 0x52, 0x6d, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x52, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x52, 0x6d, 0x12, 0x2c, 0x0a, 0x05, 0x4d, 0x6b, 0x64, 0x69, 0x72, 0x12,
+	0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x6b, 0x64, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d,
+	0x6b, 0x64, 0x69, 0x72, 0x12, 0x35, 0x0a, 0x08, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64,
+	0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x48, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x1a, 0x16,
+	0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73, 0x12, 0x39, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x15, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x2c, 0x0a, 0x05, 0x43, 0x68, 0x6d, 0x6f, 0x64, 0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x6d, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0f,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x6d, 0x6f, 0x64, 0x12,
+	0x2c, 0x0a, 0x05, 0x43, 0x68, 0x6f, 0x77, 0x6e, 0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x6f, 0x77, 0x6e, 0x12, 0x32, 0x0a,
+	0x07, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x4d, 0x65,
+	0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x64, 0x64, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d,
+	0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x64, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x4d, 0x65,
+	0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d, 0x12, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d, 0x52, 0x65,
+	0x71, 0x1a, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d, 0x12, 0x3e, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71,
+	0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x38, 0x0a, 0x09, 0x4c, 0x73, 0x61, 0x73, 0x73,
+	0x44, 0x75, 0x6d, 0x70, 0x12, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x4c, 0x73, 0x61, 0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x73, 0x61, 0x73, 0x73, 0x44, 0x75, 0x6d,
+	0x70, 0x12, 0x2c, 0x0a, 0x05, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0f,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x12,
+	0x3e, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x18,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12,
+	0x38, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x16, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c,
+	0x66, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x13,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x29, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x11, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0e,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x27,
+	0x0a, 0x03, 0x4d, 0x73, 0x66, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x4d, 0x53, 0x46, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x09, 0x4d, 0x73, 0x66, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x4d, 0x53, 0x46, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4a, 0x0a, 0x0f,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12,
+	0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x4d, 0x69, 0x67, 0x72,
+	0x61, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d,
+	0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x12, 0x40, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x15,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f,
 	0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x43, 0x68,
-	0x6d, 0x6f, 0x64, 0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43,
-	0x68, 0x6d, 0x6f, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x6d, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x43, 0x68, 0x6f, 0x77,
-	0x6e, 0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x6f,
-	0x77, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x43, 0x68, 0x6f, 0x77, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x43, 0x68, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x4d, 0x65,
-	0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x4c, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41,
-	0x64, 0x64, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65,
-	0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73,
-	0x41, 0x64, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52,
-	0x6d, 0x12, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x6d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x6d,
-	0x12, 0x3e, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x12,
-	0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65,
-	0x73, 0x73, 0x44, 0x75, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x44, 0x75, 0x6d, 0x70,
-	0x12, 0x2c, 0x0a, 0x05, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x75, 0x6e, 0x41, 0x73, 0x12, 0x3e,
-	0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x18, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f,
-	0x6e, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x49, 0x6d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x12, 0x38,
-	0x0a, 0x09, 0x52, 0x65, 0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x16, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66,
-	0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52,
-	0x65, 0x76, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x38, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53,
-	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x12, 0x29, 0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x11, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x27, 0x0a,
-	0x03, 0x4d, 0x73, 0x66, 0x12, 0x10, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
-	0x4d, 0x53, 0x46, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x33, 0x0a, 0x09, 0x4d, 0x73, 0x66, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d,
-	0x53, 0x46, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x4a, 0x0a, 0x0f, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x1c,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x41, 0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x19, 0x2e, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41,
-	0x73, 0x73, 0x65, 0x6d, 0x62, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x4d, 0x69, 0x67, 0x72, 0x61,
-	0x74, 0x65, 0x12, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4d, 0x69,
-	0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
-	0x72, 0x70, 0x62, 0x2e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12,
-	0x40, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77,
-	0x73, 0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11,
-	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x12, 0x35, 0x0a, 0x08, 0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x15, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
-	0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3b, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x77,
-	0x6e, 0x44, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
-	0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61,
-	0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x12, 0x3b, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73,
-	0x68, 0x6f, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
-	0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68,
-	0x6f, 0x74, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x12, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x11, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x6f,
-	0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x15, 0x50, 0x69,
-	0x76, 0x6f, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50,
-	0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x1a, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x50, 0x69,
-	0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12,
-	0x40, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x3e, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x42, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15,
+	0x2e, 0x53, 0x69, 0x64, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x44, 0x0a, 0x0d, 0x49, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12,
+	0x3b, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x12, 0x1b, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x70, 0x61,
+	0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x53, 0x70, 0x61, 0x77, 0x6e, 0x44, 0x6c, 0x6c, 0x12, 0x3b, 0x0a, 0x0a,
+	0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x17, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
+	0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x6c, 0x69,
+	0x70, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x43, 0x6c, 0x69, 0x70, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x13,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x69, 0x70, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x12, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x11, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74,
+	0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x15, 0x50,
+	0x69, 0x76, 0x6f, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76,
+	0x6f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x48, 0x0a, 0x0f, 0x50,
+	0x69, 0x76, 0x6f, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1c,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72,
+	0x61, 0x70, 0x68, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x14, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e,
+	0x50, 0x69, 0x76, 0x6f, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x40, 0x0a, 0x0c, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x19, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x0b,
+	0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x0d,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x35, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x15, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x44, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x12, 0x44, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x17,
 	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x38, 0x0a, 0x09, 0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x61,
-	0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x38, 0x0a, 0x09, 0x4d, 0x61, 0x6b, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x3b, 0x0a, 0x0a, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x6c,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x65, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2f,
+	0x0a, 0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12,
 	0x2d, 0x0a, 0x06, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x76, 0x12, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76,
 	0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c,
 	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x45, 0x6e, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2f,
@@ -443,34 +622,106 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 	0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61,
 	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
 	0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x53, 0x53, 0x48, 0x43,
-	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x53, 0x53, 0x48, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a,
-	0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x48, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x44,
-	0x4c, 0x4c, 0x12, 0x16, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6c,
-	0x6c, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6c, 0x6c, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x12,
-	0x35, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x12, 0x15, 0x2e, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52,
-	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x12, 0x57, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
-	0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
-	0x22, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74,
-	0x46, 0x77, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52,
-	0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
-	0x53, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x70, 0x6f, 0x72,
-	0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64,
-	0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
-	0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74,
-	0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x4f,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x47,
+	0x0a, 0x0e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x03, 0x54, 0x43, 0x43, 0x12, 0x10,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x43, 0x43, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x43, 0x43, 0x12,
+	0x35, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x15, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4b, 0x65, 0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4b, 0x65,
+	0x79, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68,
+	0x64, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x61, 0x75,
+	0x6e, 0x63, 0x68, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x64, 0x12, 0x47, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x52, 0x75, 0x6e, 0x53, 0x53, 0x48, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x53, 0x53, 0x48, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x48, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x0a, 0x53, 0x53, 0x48, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73,
+	0x74, 0x12, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x48,
+	0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x53, 0x48, 0x48, 0x61, 0x72, 0x76, 0x65, 0x73, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x44, 0x4c, 0x4c, 0x12, 0x16, 0x2e,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x44, 0x6c, 0x6c, 0x48, 0x69, 0x6a, 0x61,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x44, 0x6c, 0x6c, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x12, 0x35, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69, 0x76,
+	0x73, 0x12, 0x44, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f,
+	0x67, 0x6f, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x67, 0x6f, 0x6e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x47, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x6a, 0x61,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x48, 0x69, 0x6a, 0x61, 0x63, 0x6b, 0x73,
+	0x12, 0x35, 0x0a, 0x08, 0x41, 0x64, 0x63, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x15, 0x2e, 0x73,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x63, 0x73, 0x45, 0x6e, 0x75, 0x6d,
+	0x52, 0x65, 0x71, 0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x63, 0x73, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x3e, 0x0a, 0x0b, 0x41, 0x64, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x41, 0x64, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71,
+	0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x63, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x64, 0x73, 0x12, 0x35, 0x0a,
+	0x08, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x1a, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x13,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x72, 0x76, 0x65, 0x79,
+	0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
+	0x75, 0x72, 0x76, 0x65, 0x79, 0x12, 0x57, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x22,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x77, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x1a, 0x1a, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70,
+	0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x53,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x73, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x77, 0x64, 0x53,
+	0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x1a,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x52, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x77, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4e, 0x54, 0x4c, 0x4d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x1a,
+	0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x54, 0x4c, 0x4d, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e, 0x54, 0x4c, 0x4d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4e, 0x54, 0x4c, 0x4d,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x4e, 0x54, 0x4c, 0x4d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x4e, 0x54, 0x4c, 0x4d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x12, 0x49, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x70, 0x4e, 0x54, 0x4c, 0x4d, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x4e, 0x54, 0x4c, 0x4d, 0x53, 0x74, 0x6f, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x1a, 0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4e,
+	0x54, 0x4c, 0x4d, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12, 0x3b, 0x0a, 0x0b, 0x4f,
 	0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x2e, 0x73, 0x6c, 0x69,
 	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
 	0x6e, 0x1a, 0x15, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x4f, 0x70, 0x65,
@@ -519,517 +770,739 @@ var file_rpcpb_services_proto_rawDesc = []byte{
 	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73,
 	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x73, 0x6c, 0x69,
 	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x12, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65,
-	0x6c, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12, 0x14, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64,
-	0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50,
-	0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x73, 0x65,
-	0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x53, 0x6f, 0x63, 0x6b, 0x73,
-	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x13, 0x2e, 0x73, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x61, 0x74, 0x61, 0x28,
-	0x01, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54,
-	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62,
-	0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0c, 0x57, 0x47, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x4b, 0x65, 0x79, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x57, 0x47, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x16, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x57, 0x47, 0x52, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x12, 0x12, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x68, 0x65, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e,
+	0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x32, 0x0a, 0x07, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64,
+	0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74,
+	0x66, 0x77, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
+	0x62, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x66, 0x77, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x0a, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x0f, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x53, 0x6f,
+	0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x13, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x13, 0x2e,
+	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x28, 0x01, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70,
-	0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x54, 0x75, 0x6e,
-	0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x14, 0x2e,
-	0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x44,
-	0x61, 0x74, 0x61, 0x28, 0x01, 0x30, 0x01, 0x12, 0x2c, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x73, 0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78, 0x2f, 0x73, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x0b, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x10, 0x2e, 0x73, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x1a, 0x0f, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0a,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x2e, 0x73, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0x14, 0x2e, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x44, 0x61, 0x74, 0x61, 0x28, 0x01, 0x30, 0x01, 0x12, 0x2c, 0x0a, 0x06, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62,
+	0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x69, 0x73, 0x68, 0x6f, 0x70, 0x66, 0x6f, 0x78,
+	0x2f, 0x73, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x72, 0x70, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_rpcpb_services_proto_goTypes = []interface{}{
 	(*commonpb.Empty)(nil),                    // 0: commonpb.Empty
-	(*sliverpb.KillReq)(nil),                  // 1: sliverpb.KillReq
-	(*sliverpb.ReconfigureReq)(nil),           // 2: sliverpb.ReconfigureReq
-	(*clientpb.RenameReq)(nil),                // 3: clientpb.RenameReq
-	(*clientpb.Beacon)(nil),                   // 4: clientpb.Beacon
-	(*clientpb.BeaconTask)(nil),               // 5: clientpb.BeaconTask
-	(*clientpb.KillJobReq)(nil),               // 6: clientpb.KillJobReq
-	(*clientpb.MTLSListenerReq)(nil),          // 7: clientpb.MTLSListenerReq
-	(*clientpb.WGListenerReq)(nil),            // 8: clientpb.WGListenerReq
-	(*clientpb.DNSListenerReq)(nil),           // 9: clientpb.DNSListenerReq
-	(*clientpb.HTTPListenerReq)(nil),          // 10: clientpb.HTTPListenerReq
-	(*clientpb.StagerListenerReq)(nil),        // 11: clientpb.StagerListenerReq
-	(*clientpb.Loot)(nil),                     // 12: clientpb.Loot
-	(*clientpb.Host)(nil),                     // 13: clientpb.Host
-	(*clientpb.IOC)(nil),                      // 14: clientpb.IOC
-	(*clientpb.GenerateReq)(nil),              // 15: clientpb.GenerateReq
-	(*clientpb.ExternalGenerateReq)(nil),      // 16: clientpb.ExternalGenerateReq
-	(*clientpb.ExternalImplantBinary)(nil),    // 17: clientpb.ExternalImplantBinary
-	(*clientpb.ImplantConfig)(nil),            // 18: clientpb.ImplantConfig
-	(*clientpb.Builder)(nil),                  // 19: clientpb.Builder
-	(*clientpb.Event)(nil),                    // 20: clientpb.Event
-	(*clientpb.RegenerateReq)(nil),            // 21: clientpb.RegenerateReq
-	(*clientpb.DeleteReq)(nil),                // 22: clientpb.DeleteReq
-	(*clientpb.ImplantProfile)(nil),           // 23: clientpb.ImplantProfile
-	(*clientpb.MsfStagerReq)(nil),             // 24: clientpb.MsfStagerReq
-	(*clientpb.ShellcodeRDIReq)(nil),          // 25: clientpb.ShellcodeRDIReq
-	(*clientpb.ShellcodeEncodeReq)(nil),       // 26: clientpb.ShellcodeEncodeReq
-	(*clientpb.Website)(nil),                  // 27: clientpb.Website
-	(*clientpb.WebsiteAddContent)(nil),        // 28: clientpb.WebsiteAddContent
-	(*clientpb.WebsiteRemoveContent)(nil),     // 29: clientpb.WebsiteRemoveContent
-	(*sliverpb.Ping)(nil),                     // 30: sliverpb.Ping
-	(*sliverpb.PsReq)(nil),                    // 31: sliverpb.PsReq
-	(*sliverpb.TerminateReq)(nil),             // 32: sliverpb.TerminateReq
-	(*sliverpb.IfconfigReq)(nil),              // 33: sliverpb.IfconfigReq
-	(*sliverpb.NetstatReq)(nil),               // 34: sliverpb.NetstatReq
-	(*sliverpb.LsReq)(nil),                    // 35: sliverpb.LsReq
-	(*sliverpb.CdReq)(nil),                    // 36: sliverpb.CdReq
-	(*sliverpb.PwdReq)(nil),                   // 37: sliverpb.PwdReq
-	(*sliverpb.MvReq)(nil),                    // 38: sliverpb.MvReq
-	(*sliverpb.RmReq)(nil),                    // 39: sliverpb.RmReq
-	(*sliverpb.MkdirReq)(nil),                 // 40: sliverpb.MkdirReq
-	(*sliverpb.DownloadReq)(nil),              // 41: sliverpb.DownloadReq
-	(*sliverpb.UploadReq)(nil),                // 42: sliverpb.UploadReq
-	(*sliverpb.ChmodReq)(nil),                 // 43: sliverpb.ChmodReq
-	(*sliverpb.ChownReq)(nil),                 // 44: sliverpb.ChownReq
-	(*sliverpb.ChtimesReq)(nil),               // 45: sliverpb.ChtimesReq
-	(*sliverpb.MemfilesListReq)(nil),          // 46: sliverpb.MemfilesListReq
-	(*sliverpb.MemfilesAddReq)(nil),           // 47: sliverpb.MemfilesAddReq
-	(*sliverpb.MemfilesRmReq)(nil),            // 48: sliverpb.MemfilesRmReq
-	(*sliverpb.ProcessDumpReq)(nil),           // 49: sliverpb.ProcessDumpReq
-	(*sliverpb.RunAsReq)(nil),                 // 50: sliverpb.RunAsReq
-	(*sliverpb.ImpersonateReq)(nil),           // 51: sliverpb.ImpersonateReq
-	(*sliverpb.RevToSelfReq)(nil),             // 52: sliverpb.RevToSelfReq
-	(*clientpb.GetSystemReq)(nil),             // 53: clientpb.GetSystemReq
-	(*sliverpb.TaskReq)(nil),                  // 54: sliverpb.TaskReq
-	(*clientpb.MSFReq)(nil),                   // 55: clientpb.MSFReq
-	(*clientpb.MSFRemoteReq)(nil),             // 56: clientpb.MSFRemoteReq
-	(*sliverpb.ExecuteAssemblyReq)(nil),       // 57: sliverpb.ExecuteAssemblyReq
-	(*clientpb.MigrateReq)(nil),               // 58: clientpb.MigrateReq
-	(*sliverpb.ExecuteReq)(nil),               // 59: sliverpb.ExecuteReq
-	(*sliverpb.ExecuteWindowsReq)(nil),        // 60: sliverpb.ExecuteWindowsReq
-	(*sliverpb.SideloadReq)(nil),              // 61: sliverpb.SideloadReq
-	(*sliverpb.InvokeSpawnDllReq)(nil),        // 62: sliverpb.InvokeSpawnDllReq
-	(*sliverpb.ScreenshotReq)(nil),            // 63: sliverpb.ScreenshotReq
-	(*sliverpb.CurrentTokenOwnerReq)(nil),     // 64: sliverpb.CurrentTokenOwnerReq
-	(*sliverpb.PivotStartListenerReq)(nil),    // 65: sliverpb.PivotStartListenerReq
-	(*sliverpb.PivotStopListenerReq)(nil),     // 66: sliverpb.PivotStopListenerReq
-	(*sliverpb.PivotListenersReq)(nil),        // 67: sliverpb.PivotListenersReq
-	(*sliverpb.StartServiceReq)(nil),          // 68: sliverpb.StartServiceReq
-	(*sliverpb.StopServiceReq)(nil),           // 69: sliverpb.StopServiceReq
-	(*sliverpb.RemoveServiceReq)(nil),         // 70: sliverpb.RemoveServiceReq
-	(*sliverpb.MakeTokenReq)(nil),             // 71: sliverpb.MakeTokenReq
-	(*sliverpb.EnvReq)(nil),                   // 72: sliverpb.EnvReq
-	(*sliverpb.SetEnvReq)(nil),                // 73: sliverpb.SetEnvReq
-	(*sliverpb.UnsetEnvReq)(nil),              // 74: sliverpb.UnsetEnvReq
-	(*sliverpb.BackdoorReq)(nil),              // 75: sliverpb.BackdoorReq
-	(*sliverpb.RegistryReadReq)(nil),          // 76: sliverpb.RegistryReadReq
-	(*sliverpb.RegistryWriteReq)(nil),         // 77: sliverpb.RegistryWriteReq
-	(*sliverpb.RegistryCreateKeyReq)(nil),     // 78: sliverpb.RegistryCreateKeyReq
-	(*sliverpb.RegistryDeleteKeyReq)(nil),     // 79: sliverpb.RegistryDeleteKeyReq
-	(*sliverpb.RegistrySubKeyListReq)(nil),    // 80: sliverpb.RegistrySubKeyListReq
-	(*sliverpb.RegistryListValuesReq)(nil),    // 81: sliverpb.RegistryListValuesReq
-	(*sliverpb.SSHCommandReq)(nil),            // 82: sliverpb.SSHCommandReq
-	(*clientpb.DllHijackReq)(nil),             // 83: clientpb.DllHijackReq
-	(*sliverpb.GetPrivsReq)(nil),              // 84: sliverpb.GetPrivsReq
-	(*sliverpb.RportFwdStartListenerReq)(nil), // 85: sliverpb.RportFwdStartListenerReq
-	(*sliverpb.RportFwdListenersReq)(nil),     // 86: sliverpb.RportFwdListenersReq
-	(*sliverpb.RportFwdStopListenerReq)(nil),  // 87: sliverpb.RportFwdStopListenerReq
-	(*sliverpb.OpenSession)(nil),              // 88: sliverpb.OpenSession
-	(*sliverpb.CloseSession)(nil),             // 89: sliverpb.CloseSession
-	(*sliverpb.RegisterExtensionReq)(nil),     // 90: sliverpb.RegisterExtensionReq
-	(*sliverpb.CallExtensionReq)(nil),         // 91: sliverpb.CallExtensionReq
-	(*sliverpb.ListExtensionsReq)(nil),        // 92: sliverpb.ListExtensionsReq
-	(*sliverpb.WGPortForwardStartReq)(nil),    // 93: sliverpb.WGPortForwardStartReq
-	(*sliverpb.WGPortForwardStopReq)(nil),     // 94: sliverpb.WGPortForwardStopReq
-	(*sliverpb.WGSocksStartReq)(nil),          // 95: sliverpb.WGSocksStartReq
-	(*sliverpb.WGSocksStopReq)(nil),           // 96: sliverpb.WGSocksStopReq
-	(*sliverpb.WGTCPForwardersReq)(nil),       // 97: sliverpb.WGTCPForwardersReq
-	(*sliverpb.WGSocksServersReq)(nil),        // 98: sliverpb.WGSocksServersReq
-	(*sliverpb.ShellReq)(nil),                 // 99: sliverpb.ShellReq
-	(*sliverpb.PortfwdReq)(nil),               // 100: sliverpb.PortfwdReq
-	(*sliverpb.Socks)(nil),                    // 101: sliverpb.Socks
-	(*sliverpb.SocksData)(nil),                // 102: sliverpb.SocksData
-	(*sliverpb.Tunnel)(nil),                   // 103: sliverpb.Tunnel
-	(*sliverpb.TunnelData)(nil),               // 104: sliverpb.TunnelData
-	(*clientpb.Version)(nil),                  // 105: clientpb.Version
-	(*clientpb.Operators)(nil),                // 106: clientpb.Operators
-	(*sliverpb.Reconfigure)(nil),              // 107: sliverpb.Reconfigure
-	(*clientpb.Sessions)(nil),                 // 108: clientpb.Sessions
-	(*clientpb.Beacons)(nil),                  // 109: clientpb.Beacons
-	(*clientpb.BeaconTasks)(nil),              // 110: clientpb.BeaconTasks
-	(*commonpb.Response)(nil),                 // 111: commonpb.Response
-	(*clientpb.Jobs)(nil),                     // 112: clientpb.Jobs
-	(*clientpb.KillJob)(nil),                  // 113: clientpb.KillJob
-	(*clientpb.MTLSListener)(nil),             // 114: clientpb.MTLSListener
-	(*clientpb.WGListener)(nil),               // 115: clientpb.WGListener
-	(*clientpb.DNSListener)(nil),              // 116: clientpb.DNSListener
-	(*clientpb.HTTPListener)(nil),             // 117: clientpb.HTTPListener
-	(*clientpb.StagerListener)(nil),           // 118: clientpb.StagerListener
-	(*clientpb.AllLoot)(nil),                  // 119: clientpb.AllLoot
-	(*clientpb.AllHosts)(nil),                 // 120: clientpb.AllHosts
-	(*clientpb.Generate)(nil),                 // 121: clientpb.Generate
-	(*clientpb.ExternalImplantConfig)(nil),    // 122: clientpb.ExternalImplantConfig
-	(*clientpb.Builders)(nil),                 // 123: clientpb.Builders
-	(*clientpb.ImplantBuilds)(nil),            // 124: clientpb.ImplantBuilds
-	(*clientpb.Canaries)(nil),                 // 125: clientpb.Canaries
-	(*clientpb.WGClientConfig)(nil),           // 126: clientpb.WGClientConfig
-	(*clientpb.UniqueWGIP)(nil),               // 127: clientpb.UniqueWGIP
-	(*clientpb.ImplantProfiles)(nil),          // 128: clientpb.ImplantProfiles
-	(*clientpb.MsfStager)(nil),                // 129: clientpb.MsfStager
-	(*clientpb.ShellcodeRDI)(nil),             // 130: clientpb.ShellcodeRDI
-	(*clientpb.Compiler)(nil),                 // 131: clientpb.Compiler
-	(*clientpb.ShellcodeEncode)(nil),          // 132: clientpb.ShellcodeEncode
-	(*clientpb.ShellcodeEncoderMap)(nil),      // 133: clientpb.ShellcodeEncoderMap
-	(*clientpb.Websites)(nil),                 // 134: clientpb.Websites
-	(*sliverpb.Ps)(nil),                       // 135: sliverpb.Ps
-	(*sliverpb.Terminate)(nil),                // 136: sliverpb.Terminate
-	(*sliverpb.Ifconfig)(nil),                 // 137: sliverpb.Ifconfig
-	(*sliverpb.Netstat)(nil),                  // 138: sliverpb.Netstat
-	(*sliverpb.Ls)(nil),                       // 139: sliverpb.Ls
-	(*sliverpb.Pwd)(nil),                      // 140: sliverpb.Pwd
-	(*sliverpb.Mv)(nil),                       // 141: sliverpb.Mv
-	(*sliverpb.Rm)(nil),                       // 142: sliverpb.Rm
-	(*sliverpb.Mkdir)(nil),                    // 143: sliverpb.Mkdir
-	(*sliverpb.Download)(nil),                 // 144: sliverpb.Download
-	(*sliverpb.Upload)(nil),                   // 145: sliverpb.Upload
-	(*sliverpb.Chmod)(nil),                    // 146: sliverpb.Chmod
-	(*sliverpb.Chown)(nil),                    // 147: sliverpb.Chown
-	(*sliverpb.Chtimes)(nil),                  // 148: sliverpb.Chtimes
-	(*sliverpb.MemfilesAdd)(nil),              // 149: sliverpb.MemfilesAdd
-	(*sliverpb.MemfilesRm)(nil),               // 150: sliverpb.MemfilesRm
-	(*sliverpb.ProcessDump)(nil),              // 151: sliverpb.ProcessDump
-	(*sliverpb.RunAs)(nil),                    // 152: sliverpb.RunAs
-	(*sliverpb.Impersonate)(nil),              // 153: sliverpb.Impersonate
-	(*sliverpb.RevToSelf)(nil),                // 154: sliverpb.RevToSelf
-	(*sliverpb.GetSystem)(nil),                // 155: sliverpb.GetSystem
-	(*sliverpb.Task)(nil),                     // 156: sliverpb.Task
-	(*sliverpb.ExecuteAssembly)(nil),          // 157: sliverpb.ExecuteAssembly
-	(*sliverpb.Migrate)(nil),                  // 158: sliverpb.Migrate
-	(*sliverpb.Execute)(nil),                  // 159: sliverpb.Execute
-	(*sliverpb.Sideload)(nil),                 // 160: sliverpb.Sideload
-	(*sliverpb.SpawnDll)(nil),                 // 161: sliverpb.SpawnDll
-	(*sliverpb.Screenshot)(nil),               // 162: sliverpb.Screenshot
-	(*sliverpb.CurrentTokenOwner)(nil),        // 163: sliverpb.CurrentTokenOwner
-	(*sliverpb.PivotListener)(nil),            // 164: sliverpb.PivotListener
-	(*sliverpb.PivotListeners)(nil),           // 165: sliverpb.PivotListeners
-	(*clientpb.PivotGraph)(nil),               // 166: clientpb.PivotGraph
-	(*sliverpb.ServiceInfo)(nil),              // 167: sliverpb.ServiceInfo
-	(*sliverpb.MakeToken)(nil),                // 168: sliverpb.MakeToken
-	(*sliverpb.EnvInfo)(nil),                  // 169: sliverpb.EnvInfo
-	(*sliverpb.SetEnv)(nil),                   // 170: sliverpb.SetEnv
-	(*sliverpb.UnsetEnv)(nil),                 // 171: sliverpb.UnsetEnv
-	(*sliverpb.Backdoor)(nil),                 // 172: sliverpb.Backdoor
-	(*sliverpb.RegistryRead)(nil),             // 173: sliverpb.RegistryRead
-	(*sliverpb.RegistryWrite)(nil),            // 174: sliverpb.RegistryWrite
-	(*sliverpb.RegistryCreateKey)(nil),        // 175: sliverpb.RegistryCreateKey
-	(*sliverpb.RegistryDeleteKey)(nil),        // 176: sliverpb.RegistryDeleteKey
-	(*sliverpb.RegistrySubKeyList)(nil),       // 177: sliverpb.RegistrySubKeyList
-	(*sliverpb.RegistryValuesList)(nil),       // 178: sliverpb.RegistryValuesList
-	(*sliverpb.SSHCommand)(nil),               // 179: sliverpb.SSHCommand
-	(*clientpb.DllHijack)(nil),                // 180: clientpb.DllHijack
-	(*sliverpb.GetPrivs)(nil),                 // 181: sliverpb.GetPrivs
-	(*sliverpb.RportFwdListener)(nil),         // 182: sliverpb.RportFwdListener
-	(*sliverpb.RportFwdListeners)(nil),        // 183: sliverpb.RportFwdListeners
-	(*sliverpb.RegisterExtension)(nil),        // 184: sliverpb.RegisterExtension
-	(*sliverpb.CallExtension)(nil),            // 185: sliverpb.CallExtension
-	(*sliverpb.ListExtensions)(nil),           // 186: sliverpb.ListExtensions
-	(*sliverpb.WGPortForward)(nil),            // 187: sliverpb.WGPortForward
-	(*sliverpb.WGSocks)(nil),                  // 188: sliverpb.WGSocks
-	(*sliverpb.WGTCPForwarders)(nil),          // 189: sliverpb.WGTCPForwarders
-	(*sliverpb.WGSocksServers)(nil),           // 190: sliverpb.WGSocksServers
-	(*sliverpb.Shell)(nil),                    // 191: sliverpb.Shell
-	(*sliverpb.Portfwd)(nil),                  // 192: sliverpb.Portfwd
+	(*clientpb.SecondFactorReq)(nil),          // 1: clientpb.SecondFactorReq
+	(*sliverpb.KillReq)(nil),                  // 2: sliverpb.KillReq
+	(*sliverpb.ReconfigureReq)(nil),           // 3: sliverpb.ReconfigureReq
+	(*sliverpb.ProxySetReq)(nil),              // 4: sliverpb.ProxySetReq
+	(*sliverpb.RedirectReq)(nil),              // 5: sliverpb.RedirectReq
+	(*clientpb.RenameReq)(nil),                // 6: clientpb.RenameReq
+	(*clientpb.Beacon)(nil),                   // 7: clientpb.Beacon
+	(*clientpb.BeaconTask)(nil),               // 8: clientpb.BeaconTask
+	(*clientpb.BeaconTaskReorderReq)(nil),     // 9: clientpb.BeaconTaskReorderReq
+	(*clientpb.KillJobReq)(nil),               // 10: clientpb.KillJobReq
+	(*clientpb.ListenerSettingsReq)(nil),      // 11: clientpb.ListenerSettingsReq
+	(*clientpb.ListenerSettings)(nil),         // 12: clientpb.ListenerSettings
+	(*clientpb.MTLSListenerReq)(nil),          // 13: clientpb.MTLSListenerReq
+	(*clientpb.WGListenerReq)(nil),            // 14: clientpb.WGListenerReq
+	(*clientpb.DNSListenerReq)(nil),           // 15: clientpb.DNSListenerReq
+	(*clientpb.HTTPListenerReq)(nil),          // 16: clientpb.HTTPListenerReq
+	(*clientpb.ICMPListenerReq)(nil),          // 17: clientpb.ICMPListenerReq
+	(*clientpb.UDPListenerReq)(nil),           // 18: clientpb.UDPListenerReq
+	(*clientpb.HTTPC2Profile)(nil),            // 19: clientpb.HTTPC2Profile
+	(*clientpb.StagerListenerReq)(nil),        // 20: clientpb.StagerListenerReq
+	(*clientpb.ShellUpgradeReq)(nil),          // 21: clientpb.ShellUpgradeReq
+	(*clientpb.WarmupReq)(nil),                // 22: clientpb.WarmupReq
+	(*clientpb.Loot)(nil),                     // 23: clientpb.Loot
+	(*clientpb.Host)(nil),                     // 24: clientpb.Host
+	(*clientpb.IOC)(nil),                      // 25: clientpb.IOC
+	(*clientpb.ParseOutputReq)(nil),           // 26: clientpb.ParseOutputReq
+	(*clientpb.HostTagsReq)(nil),              // 27: clientpb.HostTagsReq
+	(*clientpb.Playbook)(nil),                 // 28: clientpb.Playbook
+	(*clientpb.PlaybookRunReq)(nil),           // 29: clientpb.PlaybookRunReq
+	(*clientpb.PlaybookApprovalReq)(nil),      // 30: clientpb.PlaybookApprovalReq
+	(*clientpb.Persistence)(nil),              // 31: clientpb.Persistence
+	(*clientpb.Engagement)(nil),               // 32: clientpb.Engagement
+	(*clientpb.GenerateReq)(nil),              // 33: clientpb.GenerateReq
+	(*clientpb.ExternalGenerateReq)(nil),      // 34: clientpb.ExternalGenerateReq
+	(*clientpb.ExternalImplantBinary)(nil),    // 35: clientpb.ExternalImplantBinary
+	(*clientpb.ImplantConfig)(nil),            // 36: clientpb.ImplantConfig
+	(*clientpb.Builder)(nil),                  // 37: clientpb.Builder
+	(*clientpb.Event)(nil),                    // 38: clientpb.Event
+	(*clientpb.RegenerateReq)(nil),            // 39: clientpb.RegenerateReq
+	(*clientpb.DeleteReq)(nil),                // 40: clientpb.DeleteReq
+	(*clientpb.ImplantProfile)(nil),           // 41: clientpb.ImplantProfile
+	(*clientpb.MsfStagerReq)(nil),             // 42: clientpb.MsfStagerReq
+	(*clientpb.ShellcodeRDIReq)(nil),          // 43: clientpb.ShellcodeRDIReq
+	(*clientpb.ShellcodeEncodeReq)(nil),       // 44: clientpb.ShellcodeEncodeReq
+	(*clientpb.ShellcodeArtifactReq)(nil),     // 45: clientpb.ShellcodeArtifactReq
+	(*clientpb.ShellcodeArtifact)(nil),        // 46: clientpb.ShellcodeArtifact
+	(*clientpb.Website)(nil),                  // 47: clientpb.Website
+	(*clientpb.WebsiteAddContent)(nil),        // 48: clientpb.WebsiteAddContent
+	(*clientpb.WebsiteRemoveContent)(nil),     // 49: clientpb.WebsiteRemoveContent
+	(*sliverpb.Ping)(nil),                     // 50: sliverpb.Ping
+	(*sliverpb.PsReq)(nil),                    // 51: sliverpb.PsReq
+	(*sliverpb.TerminateReq)(nil),             // 52: sliverpb.TerminateReq
+	(*sliverpb.IfconfigReq)(nil),              // 53: sliverpb.IfconfigReq
+	(*sliverpb.NetstatReq)(nil),               // 54: sliverpb.NetstatReq
+	(*sliverpb.LsReq)(nil),                    // 55: sliverpb.LsReq
+	(*sliverpb.SearchReq)(nil),                // 56: sliverpb.SearchReq
+	(*sliverpb.CdReq)(nil),                    // 57: sliverpb.CdReq
+	(*sliverpb.PwdReq)(nil),                   // 58: sliverpb.PwdReq
+	(*sliverpb.MvReq)(nil),                    // 59: sliverpb.MvReq
+	(*sliverpb.RmReq)(nil),                    // 60: sliverpb.RmReq
+	(*sliverpb.MkdirReq)(nil),                 // 61: sliverpb.MkdirReq
+	(*sliverpb.DownloadReq)(nil),              // 62: sliverpb.DownloadReq
+	(*sliverpb.UploadReq)(nil),                // 63: sliverpb.UploadReq
+	(*clientpb.UploadChunks)(nil),             // 64: clientpb.UploadChunks
+	(*clientpb.UploadChunk)(nil),              // 65: clientpb.UploadChunk
+	(*sliverpb.ChmodReq)(nil),                 // 66: sliverpb.ChmodReq
+	(*sliverpb.ChownReq)(nil),                 // 67: sliverpb.ChownReq
+	(*sliverpb.ChtimesReq)(nil),               // 68: sliverpb.ChtimesReq
+	(*sliverpb.MemfilesListReq)(nil),          // 69: sliverpb.MemfilesListReq
+	(*sliverpb.MemfilesAddReq)(nil),           // 70: sliverpb.MemfilesAddReq
+	(*sliverpb.MemfilesRmReq)(nil),            // 71: sliverpb.MemfilesRmReq
+	(*sliverpb.ProcessDumpReq)(nil),           // 72: sliverpb.ProcessDumpReq
+	(*sliverpb.LsassDumpReq)(nil),             // 73: sliverpb.LsassDumpReq
+	(*sliverpb.RunAsReq)(nil),                 // 74: sliverpb.RunAsReq
+	(*sliverpb.ImpersonateReq)(nil),           // 75: sliverpb.ImpersonateReq
+	(*sliverpb.RevToSelfReq)(nil),             // 76: sliverpb.RevToSelfReq
+	(*clientpb.GetSystemReq)(nil),             // 77: clientpb.GetSystemReq
+	(*sliverpb.TaskReq)(nil),                  // 78: sliverpb.TaskReq
+	(*clientpb.MSFReq)(nil),                   // 79: clientpb.MSFReq
+	(*clientpb.MSFRemoteReq)(nil),             // 80: clientpb.MSFRemoteReq
+	(*sliverpb.ExecuteAssemblyReq)(nil),       // 81: sliverpb.ExecuteAssemblyReq
+	(*clientpb.MigrateReq)(nil),               // 82: clientpb.MigrateReq
+	(*sliverpb.ExecuteReq)(nil),               // 83: sliverpb.ExecuteReq
+	(*sliverpb.ExecuteWindowsReq)(nil),        // 84: sliverpb.ExecuteWindowsReq
+	(*sliverpb.SideloadReq)(nil),              // 85: sliverpb.SideloadReq
+	(*sliverpb.InlineExecuteReq)(nil),         // 86: sliverpb.InlineExecuteReq
+	(*sliverpb.InvokeSpawnDllReq)(nil),        // 87: sliverpb.InvokeSpawnDllReq
+	(*sliverpb.ScreenshotReq)(nil),            // 88: sliverpb.ScreenshotReq
+	(*sliverpb.ClipboardReq)(nil),             // 89: sliverpb.ClipboardReq
+	(*sliverpb.CurrentTokenOwnerReq)(nil),     // 90: sliverpb.CurrentTokenOwnerReq
+	(*sliverpb.PivotStartListenerReq)(nil),    // 91: sliverpb.PivotStartListenerReq
+	(*sliverpb.PivotStopListenerReq)(nil),     // 92: sliverpb.PivotStopListenerReq
+	(*sliverpb.PivotListenersReq)(nil),        // 93: sliverpb.PivotListenersReq
+	(*sliverpb.PivotAllowPeersReq)(nil),       // 94: sliverpb.PivotAllowPeersReq
+	(*sliverpb.StartServiceReq)(nil),          // 95: sliverpb.StartServiceReq
+	(*sliverpb.StopServiceReq)(nil),           // 96: sliverpb.StopServiceReq
+	(*sliverpb.RemoveServiceReq)(nil),         // 97: sliverpb.RemoveServiceReq
+	(*sliverpb.ServicesReq)(nil),              // 98: sliverpb.ServicesReq
+	(*sliverpb.ServiceStartReq)(nil),          // 99: sliverpb.ServiceStartReq
+	(*sliverpb.CreateServiceReq)(nil),         // 100: sliverpb.CreateServiceReq
+	(*sliverpb.ServiceConfigReq)(nil),         // 101: sliverpb.ServiceConfigReq
+	(*sliverpb.MakeTokenReq)(nil),             // 102: sliverpb.MakeTokenReq
+	(*sliverpb.StealTokenReq)(nil),            // 103: sliverpb.StealTokenReq
+	(*sliverpb.TokensReq)(nil),                // 104: sliverpb.TokensReq
+	(*sliverpb.EnvReq)(nil),                   // 105: sliverpb.EnvReq
+	(*sliverpb.SetEnvReq)(nil),                // 106: sliverpb.SetEnvReq
+	(*sliverpb.UnsetEnvReq)(nil),              // 107: sliverpb.UnsetEnvReq
+	(*sliverpb.BackdoorReq)(nil),              // 108: sliverpb.BackdoorReq
+	(*sliverpb.RegistryReadReq)(nil),          // 109: sliverpb.RegistryReadReq
+	(*sliverpb.RegistryWriteReq)(nil),         // 110: sliverpb.RegistryWriteReq
+	(*sliverpb.RegistryCreateKeyReq)(nil),     // 111: sliverpb.RegistryCreateKeyReq
+	(*sliverpb.RegistryDeleteKeyReq)(nil),     // 112: sliverpb.RegistryDeleteKeyReq
+	(*sliverpb.RegistrySubKeyListReq)(nil),    // 113: sliverpb.RegistrySubKeyListReq
+	(*sliverpb.RegistryListValuesReq)(nil),    // 114: sliverpb.RegistryListValuesReq
+	(*sliverpb.RegistryExportReq)(nil),        // 115: sliverpb.RegistryExportReq
+	(*sliverpb.RegistrySearchReq)(nil),        // 116: sliverpb.RegistrySearchReq
+	(*sliverpb.TCCReq)(nil),                   // 117: sliverpb.TCCReq
+	(*sliverpb.KeychainReq)(nil),              // 118: sliverpb.KeychainReq
+	(*sliverpb.LaunchdReq)(nil),               // 119: sliverpb.LaunchdReq
+	(*sliverpb.ConfigProfilesReq)(nil),        // 120: sliverpb.ConfigProfilesReq
+	(*sliverpb.SSHCommandReq)(nil),            // 121: sliverpb.SSHCommandReq
+	(*sliverpb.SSHHarvestReq)(nil),            // 122: sliverpb.SSHHarvestReq
+	(*clientpb.DllHijackReq)(nil),             // 123: clientpb.DllHijackReq
+	(*sliverpb.GetPrivsReq)(nil),              // 124: sliverpb.GetPrivsReq
+	(*sliverpb.LogonSessionsReq)(nil),         // 125: sliverpb.LogonSessionsReq
+	(*sliverpb.LocalGroupsReq)(nil),           // 126: sliverpb.LocalGroupsReq
+	(*sliverpb.ServiceHijacksReq)(nil),        // 127: sliverpb.ServiceHijacksReq
+	(*sliverpb.AdcsEnumReq)(nil),              // 128: sliverpb.AdcsEnumReq
+	(*sliverpb.AdcsRequestReq)(nil),           // 129: sliverpb.AdcsRequestReq
+	(*sliverpb.ValidateCredsReq)(nil),         // 130: sliverpb.ValidateCredsReq
+	(*sliverpb.PresenceReq)(nil),              // 131: sliverpb.PresenceReq
+	(*sliverpb.SurveyReq)(nil),                // 132: sliverpb.SurveyReq
+	(*sliverpb.RportFwdStartListenerReq)(nil), // 133: sliverpb.RportFwdStartListenerReq
+	(*sliverpb.RportFwdListenersReq)(nil),     // 134: sliverpb.RportFwdListenersReq
+	(*sliverpb.RportFwdStopListenerReq)(nil),  // 135: sliverpb.RportFwdStopListenerReq
+	(*sliverpb.NTLMListenersReq)(nil),         // 136: sliverpb.NTLMListenersReq
+	(*sliverpb.NTLMStartListenerReq)(nil),     // 137: sliverpb.NTLMStartListenerReq
+	(*sliverpb.NTLMStopListenerReq)(nil),      // 138: sliverpb.NTLMStopListenerReq
+	(*sliverpb.OpenSession)(nil),              // 139: sliverpb.OpenSession
+	(*sliverpb.CloseSession)(nil),             // 140: sliverpb.CloseSession
+	(*sliverpb.RegisterExtensionReq)(nil),     // 141: sliverpb.RegisterExtensionReq
+	(*sliverpb.CallExtensionReq)(nil),         // 142: sliverpb.CallExtensionReq
+	(*sliverpb.ListExtensionsReq)(nil),        // 143: sliverpb.ListExtensionsReq
+	(*sliverpb.WGPortForwardStartReq)(nil),    // 144: sliverpb.WGPortForwardStartReq
+	(*sliverpb.WGPortForwardStopReq)(nil),     // 145: sliverpb.WGPortForwardStopReq
+	(*sliverpb.WGSocksStartReq)(nil),          // 146: sliverpb.WGSocksStartReq
+	(*sliverpb.WGSocksStopReq)(nil),           // 147: sliverpb.WGSocksStopReq
+	(*sliverpb.WGTCPForwardersReq)(nil),       // 148: sliverpb.WGTCPForwardersReq
+	(*sliverpb.WGSocksServersReq)(nil),        // 149: sliverpb.WGSocksServersReq
+	(*sliverpb.WGRotateKeysReq)(nil),          // 150: sliverpb.WGRotateKeysReq
+	(*sliverpb.ShellReq)(nil),                 // 151: sliverpb.ShellReq
+	(*sliverpb.PortfwdReq)(nil),               // 152: sliverpb.PortfwdReq
+	(*sliverpb.Socks)(nil),                    // 153: sliverpb.Socks
+	(*sliverpb.SocksData)(nil),                // 154: sliverpb.SocksData
+	(*sliverpb.Tunnel)(nil),                   // 155: sliverpb.Tunnel
+	(*sliverpb.TunnelData)(nil),               // 156: sliverpb.TunnelData
+	(*clientpb.Version)(nil),                  // 157: clientpb.Version
+	(*clientpb.Operators)(nil),                // 158: clientpb.Operators
+	(*clientpb.SecondFactorChallenge)(nil),    // 159: clientpb.SecondFactorChallenge
+	(*clientpb.SecondFactor)(nil),             // 160: clientpb.SecondFactor
+	(*sliverpb.Reconfigure)(nil),              // 161: sliverpb.Reconfigure
+	(*sliverpb.ProxySet)(nil),                 // 162: sliverpb.ProxySet
+	(*sliverpb.Redirect)(nil),                 // 163: sliverpb.Redirect
+	(*clientpb.Sessions)(nil),                 // 164: clientpb.Sessions
+	(*clientpb.Beacons)(nil),                  // 165: clientpb.Beacons
+	(*clientpb.BeaconTasks)(nil),              // 166: clientpb.BeaconTasks
+	(*commonpb.Response)(nil),                 // 167: commonpb.Response
+	(*clientpb.Jobs)(nil),                     // 168: clientpb.Jobs
+	(*clientpb.KillJob)(nil),                  // 169: clientpb.KillJob
+	(*clientpb.MTLSListener)(nil),             // 170: clientpb.MTLSListener
+	(*clientpb.WGListener)(nil),               // 171: clientpb.WGListener
+	(*clientpb.DNSListener)(nil),              // 172: clientpb.DNSListener
+	(*clientpb.HTTPListener)(nil),             // 173: clientpb.HTTPListener
+	(*clientpb.ICMPListener)(nil),             // 174: clientpb.ICMPListener
+	(*clientpb.UDPListener)(nil),              // 175: clientpb.UDPListener
+	(*clientpb.HTTPC2Profiles)(nil),           // 176: clientpb.HTTPC2Profiles
+	(*clientpb.StagerListener)(nil),           // 177: clientpb.StagerListener
+	(*clientpb.Warmup)(nil),                   // 178: clientpb.Warmup
+	(*clientpb.AllLoot)(nil),                  // 179: clientpb.AllLoot
+	(*clientpb.AllHosts)(nil),                 // 180: clientpb.AllHosts
+	(*clientpb.ParsedOutput)(nil),             // 181: clientpb.ParsedOutput
+	(*sliverpb.Survey)(nil),                   // 182: sliverpb.Survey
+	(*clientpb.Playbooks)(nil),                // 183: clientpb.Playbooks
+	(*clientpb.PlaybookRuns)(nil),             // 184: clientpb.PlaybookRuns
+	(*clientpb.PlaybookRun)(nil),              // 185: clientpb.PlaybookRun
+	(*clientpb.AllPersistence)(nil),           // 186: clientpb.AllPersistence
+	(*clientpb.Generate)(nil),                 // 187: clientpb.Generate
+	(*clientpb.ExternalImplantConfig)(nil),    // 188: clientpb.ExternalImplantConfig
+	(*clientpb.Builders)(nil),                 // 189: clientpb.Builders
+	(*clientpb.ImplantBuilds)(nil),            // 190: clientpb.ImplantBuilds
+	(*clientpb.Canaries)(nil),                 // 191: clientpb.Canaries
+	(*clientpb.WGClientConfig)(nil),           // 192: clientpb.WGClientConfig
+	(*clientpb.UniqueWGIP)(nil),               // 193: clientpb.UniqueWGIP
+	(*clientpb.ImplantProfiles)(nil),          // 194: clientpb.ImplantProfiles
+	(*clientpb.MsfStager)(nil),                // 195: clientpb.MsfStager
+	(*clientpb.ShellcodeRDI)(nil),             // 196: clientpb.ShellcodeRDI
+	(*clientpb.Compiler)(nil),                 // 197: clientpb.Compiler
+	(*clientpb.ShellcodeEncode)(nil),          // 198: clientpb.ShellcodeEncode
+	(*clientpb.ShellcodeEncoderMap)(nil),      // 199: clientpb.ShellcodeEncoderMap
+	(*clientpb.ShellcodeArtifacts)(nil),       // 200: clientpb.ShellcodeArtifacts
+	(*clientpb.Websites)(nil),                 // 201: clientpb.Websites
+	(*sliverpb.Ps)(nil),                       // 202: sliverpb.Ps
+	(*sliverpb.Terminate)(nil),                // 203: sliverpb.Terminate
+	(*sliverpb.Ifconfig)(nil),                 // 204: sliverpb.Ifconfig
+	(*sliverpb.Netstat)(nil),                  // 205: sliverpb.Netstat
+	(*sliverpb.Ls)(nil),                       // 206: sliverpb.Ls
+	(*sliverpb.Search)(nil),                   // 207: sliverpb.Search
+	(*sliverpb.Pwd)(nil),                      // 208: sliverpb.Pwd
+	(*sliverpb.Mv)(nil),                       // 209: sliverpb.Mv
+	(*sliverpb.Rm)(nil),                       // 210: sliverpb.Rm
+	(*sliverpb.Mkdir)(nil),                    // 211: sliverpb.Mkdir
+	(*sliverpb.Download)(nil),                 // 212: sliverpb.Download
+	(*sliverpb.Upload)(nil),                   // 213: sliverpb.Upload
+	(*sliverpb.Chmod)(nil),                    // 214: sliverpb.Chmod
+	(*sliverpb.Chown)(nil),                    // 215: sliverpb.Chown
+	(*sliverpb.Chtimes)(nil),                  // 216: sliverpb.Chtimes
+	(*sliverpb.MemfilesAdd)(nil),              // 217: sliverpb.MemfilesAdd
+	(*sliverpb.MemfilesRm)(nil),               // 218: sliverpb.MemfilesRm
+	(*sliverpb.ProcessDump)(nil),              // 219: sliverpb.ProcessDump
+	(*sliverpb.LsassDump)(nil),                // 220: sliverpb.LsassDump
+	(*sliverpb.RunAs)(nil),                    // 221: sliverpb.RunAs
+	(*sliverpb.Impersonate)(nil),              // 222: sliverpb.Impersonate
+	(*sliverpb.RevToSelf)(nil),                // 223: sliverpb.RevToSelf
+	(*sliverpb.GetSystem)(nil),                // 224: sliverpb.GetSystem
+	(*sliverpb.Task)(nil),                     // 225: sliverpb.Task
+	(*sliverpb.ExecuteAssembly)(nil),          // 226: sliverpb.ExecuteAssembly
+	(*sliverpb.Migrate)(nil),                  // 227: sliverpb.Migrate
+	(*sliverpb.Execute)(nil),                  // 228: sliverpb.Execute
+	(*sliverpb.Sideload)(nil),                 // 229: sliverpb.Sideload
+	(*sliverpb.InlineExecute)(nil),            // 230: sliverpb.InlineExecute
+	(*sliverpb.SpawnDll)(nil),                 // 231: sliverpb.SpawnDll
+	(*sliverpb.Screenshot)(nil),               // 232: sliverpb.Screenshot
+	(*sliverpb.Clipboard)(nil),                // 233: sliverpb.Clipboard
+	(*sliverpb.CurrentTokenOwner)(nil),        // 234: sliverpb.CurrentTokenOwner
+	(*sliverpb.PivotListener)(nil),            // 235: sliverpb.PivotListener
+	(*sliverpb.PivotListeners)(nil),           // 236: sliverpb.PivotListeners
+	(*clientpb.PivotGraph)(nil),               // 237: clientpb.PivotGraph
+	(*sliverpb.ServiceInfo)(nil),              // 238: sliverpb.ServiceInfo
+	(*sliverpb.Services)(nil),                 // 239: sliverpb.Services
+	(*sliverpb.ServiceDetail)(nil),            // 240: sliverpb.ServiceDetail
+	(*sliverpb.MakeToken)(nil),                // 241: sliverpb.MakeToken
+	(*sliverpb.StealToken)(nil),               // 242: sliverpb.StealToken
+	(*sliverpb.Tokens)(nil),                   // 243: sliverpb.Tokens
+	(*sliverpb.EnvInfo)(nil),                  // 244: sliverpb.EnvInfo
+	(*sliverpb.SetEnv)(nil),                   // 245: sliverpb.SetEnv
+	(*sliverpb.UnsetEnv)(nil),                 // 246: sliverpb.UnsetEnv
+	(*sliverpb.Backdoor)(nil),                 // 247: sliverpb.Backdoor
+	(*sliverpb.RegistryRead)(nil),             // 248: sliverpb.RegistryRead
+	(*sliverpb.RegistryWrite)(nil),            // 249: sliverpb.RegistryWrite
+	(*sliverpb.RegistryCreateKey)(nil),        // 250: sliverpb.RegistryCreateKey
+	(*sliverpb.RegistryDeleteKey)(nil),        // 251: sliverpb.RegistryDeleteKey
+	(*sliverpb.RegistrySubKeyList)(nil),       // 252: sliverpb.RegistrySubKeyList
+	(*sliverpb.RegistryValuesList)(nil),       // 253: sliverpb.RegistryValuesList
+	(*sliverpb.RegistryExport)(nil),           // 254: sliverpb.RegistryExport
+	(*sliverpb.RegistrySearch)(nil),           // 255: sliverpb.RegistrySearch
+	(*sliverpb.TCC)(nil),                      // 256: sliverpb.TCC
+	(*sliverpb.Keychain)(nil),                 // 257: sliverpb.Keychain
+	(*sliverpb.Launchd)(nil),                  // 258: sliverpb.Launchd
+	(*sliverpb.ConfigProfiles)(nil),           // 259: sliverpb.ConfigProfiles
+	(*sliverpb.SSHCommand)(nil),               // 260: sliverpb.SSHCommand
+	(*sliverpb.SSHHarvest)(nil),               // 261: sliverpb.SSHHarvest
+	(*clientpb.DllHijack)(nil),                // 262: clientpb.DllHijack
+	(*sliverpb.GetPrivs)(nil),                 // 263: sliverpb.GetPrivs
+	(*sliverpb.LogonSessions)(nil),            // 264: sliverpb.LogonSessions
+	(*sliverpb.LocalGroups)(nil),              // 265: sliverpb.LocalGroups
+	(*sliverpb.ServiceHijacks)(nil),           // 266: sliverpb.ServiceHijacks
+	(*sliverpb.AdcsEnum)(nil),                 // 267: sliverpb.AdcsEnum
+	(*sliverpb.AdcsRequest)(nil),              // 268: sliverpb.AdcsRequest
+	(*sliverpb.ValidateCreds)(nil),            // 269: sliverpb.ValidateCreds
+	(*sliverpb.Presence)(nil),                 // 270: sliverpb.Presence
+	(*sliverpb.RportFwdListener)(nil),         // 271: sliverpb.RportFwdListener
+	(*sliverpb.RportFwdListeners)(nil),        // 272: sliverpb.RportFwdListeners
+	(*sliverpb.NTLMListeners)(nil),            // 273: sliverpb.NTLMListeners
+	(*sliverpb.NTLMListener)(nil),             // 274: sliverpb.NTLMListener
+	(*sliverpb.RegisterExtension)(nil),        // 275: sliverpb.RegisterExtension
+	(*sliverpb.CallExtension)(nil),            // 276: sliverpb.CallExtension
+	(*sliverpb.ListExtensions)(nil),           // 277: sliverpb.ListExtensions
+	(*sliverpb.WGPortForward)(nil),            // 278: sliverpb.WGPortForward
+	(*sliverpb.WGSocks)(nil),                  // 279: sliverpb.WGSocks
+	(*sliverpb.WGTCPForwarders)(nil),          // 280: sliverpb.WGTCPForwarders
+	(*sliverpb.WGSocksServers)(nil),           // 281: sliverpb.WGSocksServers
+	(*sliverpb.WGRotateKeys)(nil),             // 282: sliverpb.WGRotateKeys
+	(*sliverpb.Shell)(nil),                    // 283: sliverpb.Shell
+	(*sliverpb.Portfwd)(nil),                  // 284: sliverpb.Portfwd
 }
 var file_rpcpb_services_proto_depIdxs = []int32{
 	0,   // 0: rpcpb.SliverRPC.GetVersion:input_type -> commonpb.Empty
 	0,   // 1: rpcpb.SliverRPC.GetOperators:input_type -> commonpb.Empty
-	1,   // 2: rpcpb.SliverRPC.Kill:input_type -> sliverpb.KillReq
-	2,   // 3: rpcpb.SliverRPC.Reconfigure:input_type -> sliverpb.ReconfigureReq
-	3,   // 4: rpcpb.SliverRPC.Rename:input_type -> clientpb.RenameReq
-	0,   // 5: rpcpb.SliverRPC.GetSessions:input_type -> commonpb.Empty
-	0,   // 6: rpcpb.SliverRPC.GetBeacons:input_type -> commonpb.Empty
-	4,   // 7: rpcpb.SliverRPC.GetBeacon:input_type -> clientpb.Beacon
-	4,   // 8: rpcpb.SliverRPC.RmBeacon:input_type -> clientpb.Beacon
-	4,   // 9: rpcpb.SliverRPC.GetBeaconTasks:input_type -> clientpb.Beacon
-	5,   // 10: rpcpb.SliverRPC.GetBeaconTaskContent:input_type -> clientpb.BeaconTask
-	5,   // 11: rpcpb.SliverRPC.CancelBeaconTask:input_type -> clientpb.BeaconTask
-	0,   // 12: rpcpb.SliverRPC.MonitorStart:input_type -> commonpb.Empty
-	0,   // 13: rpcpb.SliverRPC.MonitorStop:input_type -> commonpb.Empty
-	0,   // 14: rpcpb.SliverRPC.GetJobs:input_type -> commonpb.Empty
-	6,   // 15: rpcpb.SliverRPC.KillJob:input_type -> clientpb.KillJobReq
-	7,   // 16: rpcpb.SliverRPC.StartMTLSListener:input_type -> clientpb.MTLSListenerReq
-	8,   // 17: rpcpb.SliverRPC.StartWGListener:input_type -> clientpb.WGListenerReq
-	9,   // 18: rpcpb.SliverRPC.StartDNSListener:input_type -> clientpb.DNSListenerReq
-	10,  // 19: rpcpb.SliverRPC.StartHTTPSListener:input_type -> clientpb.HTTPListenerReq
-	10,  // 20: rpcpb.SliverRPC.StartHTTPListener:input_type -> clientpb.HTTPListenerReq
-	11,  // 21: rpcpb.SliverRPC.StartTCPStagerListener:input_type -> clientpb.StagerListenerReq
-	11,  // 22: rpcpb.SliverRPC.StartHTTPStagerListener:input_type -> clientpb.StagerListenerReq
-	12,  // 23: rpcpb.SliverRPC.LootAdd:input_type -> clientpb.Loot
-	12,  // 24: rpcpb.SliverRPC.LootRm:input_type -> clientpb.Loot
-	12,  // 25: rpcpb.SliverRPC.LootUpdate:input_type -> clientpb.Loot
-	12,  // 26: rpcpb.SliverRPC.LootContent:input_type -> clientpb.Loot
-	0,   // 27: rpcpb.SliverRPC.LootAll:input_type -> commonpb.Empty
-	12,  // 28: rpcpb.SliverRPC.LootAllOf:input_type -> clientpb.Loot
-	0,   // 29: rpcpb.SliverRPC.Hosts:input_type -> commonpb.Empty
-	13,  // 30: rpcpb.SliverRPC.Host:input_type -> clientpb.Host
-	13,  // 31: rpcpb.SliverRPC.HostRm:input_type -> clientpb.Host
-	14,  // 32: rpcpb.SliverRPC.HostIOCRm:input_type -> clientpb.IOC
-	15,  // 33: rpcpb.SliverRPC.Generate:input_type -> clientpb.GenerateReq
-	16,  // 34: rpcpb.SliverRPC.GenerateExternal:input_type -> clientpb.ExternalGenerateReq
-	17,  // 35: rpcpb.SliverRPC.GenerateExternalSaveBuild:input_type -> clientpb.ExternalImplantBinary
-	18,  // 36: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:input_type -> clientpb.ImplantConfig
-	19,  // 37: rpcpb.SliverRPC.BuilderRegister:input_type -> clientpb.Builder
-	20,  // 38: rpcpb.SliverRPC.BuilderTrigger:input_type -> clientpb.Event
-	0,   // 39: rpcpb.SliverRPC.Builders:input_type -> commonpb.Empty
-	21,  // 40: rpcpb.SliverRPC.Regenerate:input_type -> clientpb.RegenerateReq
-	0,   // 41: rpcpb.SliverRPC.ImplantBuilds:input_type -> commonpb.Empty
-	22,  // 42: rpcpb.SliverRPC.DeleteImplantBuild:input_type -> clientpb.DeleteReq
-	0,   // 43: rpcpb.SliverRPC.Canaries:input_type -> commonpb.Empty
-	0,   // 44: rpcpb.SliverRPC.GenerateWGClientConfig:input_type -> commonpb.Empty
-	0,   // 45: rpcpb.SliverRPC.GenerateUniqueIP:input_type -> commonpb.Empty
-	0,   // 46: rpcpb.SliverRPC.ImplantProfiles:input_type -> commonpb.Empty
-	22,  // 47: rpcpb.SliverRPC.DeleteImplantProfile:input_type -> clientpb.DeleteReq
-	23,  // 48: rpcpb.SliverRPC.SaveImplantProfile:input_type -> clientpb.ImplantProfile
-	24,  // 49: rpcpb.SliverRPC.MsfStage:input_type -> clientpb.MsfStagerReq
-	25,  // 50: rpcpb.SliverRPC.ShellcodeRDI:input_type -> clientpb.ShellcodeRDIReq
-	0,   // 51: rpcpb.SliverRPC.GetCompiler:input_type -> commonpb.Empty
-	26,  // 52: rpcpb.SliverRPC.ShellcodeEncoder:input_type -> clientpb.ShellcodeEncodeReq
-	0,   // 53: rpcpb.SliverRPC.ShellcodeEncoderMap:input_type -> commonpb.Empty
-	0,   // 54: rpcpb.SliverRPC.Websites:input_type -> commonpb.Empty
-	27,  // 55: rpcpb.SliverRPC.Website:input_type -> clientpb.Website
-	27,  // 56: rpcpb.SliverRPC.WebsiteRemove:input_type -> clientpb.Website
-	28,  // 57: rpcpb.SliverRPC.WebsiteAddContent:input_type -> clientpb.WebsiteAddContent
-	28,  // 58: rpcpb.SliverRPC.WebsiteUpdateContent:input_type -> clientpb.WebsiteAddContent
-	29,  // 59: rpcpb.SliverRPC.WebsiteRemoveContent:input_type -> clientpb.WebsiteRemoveContent
-	30,  // 60: rpcpb.SliverRPC.Ping:input_type -> sliverpb.Ping
-	31,  // 61: rpcpb.SliverRPC.Ps:input_type -> sliverpb.PsReq
-	32,  // 62: rpcpb.SliverRPC.Terminate:input_type -> sliverpb.TerminateReq
-	33,  // 63: rpcpb.SliverRPC.Ifconfig:input_type -> sliverpb.IfconfigReq
-	34,  // 64: rpcpb.SliverRPC.Netstat:input_type -> sliverpb.NetstatReq
-	35,  // 65: rpcpb.SliverRPC.Ls:input_type -> sliverpb.LsReq
-	36,  // 66: rpcpb.SliverRPC.Cd:input_type -> sliverpb.CdReq
-	37,  // 67: rpcpb.SliverRPC.Pwd:input_type -> sliverpb.PwdReq
-	38,  // 68: rpcpb.SliverRPC.Mv:input_type -> sliverpb.MvReq
-	39,  // 69: rpcpb.SliverRPC.Rm:input_type -> sliverpb.RmReq
-	40,  // 70: rpcpb.SliverRPC.Mkdir:input_type -> sliverpb.MkdirReq
-	41,  // 71: rpcpb.SliverRPC.Download:input_type -> sliverpb.DownloadReq
-	42,  // 72: rpcpb.SliverRPC.Upload:input_type -> sliverpb.UploadReq
-	43,  // 73: rpcpb.SliverRPC.Chmod:input_type -> sliverpb.ChmodReq
-	44,  // 74: rpcpb.SliverRPC.Chown:input_type -> sliverpb.ChownReq
-	45,  // 75: rpcpb.SliverRPC.Chtimes:input_type -> sliverpb.ChtimesReq
-	46,  // 76: rpcpb.SliverRPC.MemfilesList:input_type -> sliverpb.MemfilesListReq
-	47,  // 77: rpcpb.SliverRPC.MemfilesAdd:input_type -> sliverpb.MemfilesAddReq
-	48,  // 78: rpcpb.SliverRPC.MemfilesRm:input_type -> sliverpb.MemfilesRmReq
-	49,  // 79: rpcpb.SliverRPC.ProcessDump:input_type -> sliverpb.ProcessDumpReq
-	50,  // 80: rpcpb.SliverRPC.RunAs:input_type -> sliverpb.RunAsReq
-	51,  // 81: rpcpb.SliverRPC.Impersonate:input_type -> sliverpb.ImpersonateReq
-	52,  // 82: rpcpb.SliverRPC.RevToSelf:input_type -> sliverpb.RevToSelfReq
-	53,  // 83: rpcpb.SliverRPC.GetSystem:input_type -> clientpb.GetSystemReq
-	54,  // 84: rpcpb.SliverRPC.Task:input_type -> sliverpb.TaskReq
-	55,  // 85: rpcpb.SliverRPC.Msf:input_type -> clientpb.MSFReq
-	56,  // 86: rpcpb.SliverRPC.MsfRemote:input_type -> clientpb.MSFRemoteReq
-	57,  // 87: rpcpb.SliverRPC.ExecuteAssembly:input_type -> sliverpb.ExecuteAssemblyReq
-	58,  // 88: rpcpb.SliverRPC.Migrate:input_type -> clientpb.MigrateReq
-	59,  // 89: rpcpb.SliverRPC.Execute:input_type -> sliverpb.ExecuteReq
-	60,  // 90: rpcpb.SliverRPC.ExecuteWindows:input_type -> sliverpb.ExecuteWindowsReq
-	61,  // 91: rpcpb.SliverRPC.Sideload:input_type -> sliverpb.SideloadReq
-	62,  // 92: rpcpb.SliverRPC.SpawnDll:input_type -> sliverpb.InvokeSpawnDllReq
-	63,  // 93: rpcpb.SliverRPC.Screenshot:input_type -> sliverpb.ScreenshotReq
-	64,  // 94: rpcpb.SliverRPC.CurrentTokenOwner:input_type -> sliverpb.CurrentTokenOwnerReq
-	65,  // 95: rpcpb.SliverRPC.PivotStartListener:input_type -> sliverpb.PivotStartListenerReq
-	66,  // 96: rpcpb.SliverRPC.PivotStopListener:input_type -> sliverpb.PivotStopListenerReq
-	67,  // 97: rpcpb.SliverRPC.PivotSessionListeners:input_type -> sliverpb.PivotListenersReq
-	0,   // 98: rpcpb.SliverRPC.PivotGraph:input_type -> commonpb.Empty
-	68,  // 99: rpcpb.SliverRPC.StartService:input_type -> sliverpb.StartServiceReq
-	69,  // 100: rpcpb.SliverRPC.StopService:input_type -> sliverpb.StopServiceReq
-	70,  // 101: rpcpb.SliverRPC.RemoveService:input_type -> sliverpb.RemoveServiceReq
-	71,  // 102: rpcpb.SliverRPC.MakeToken:input_type -> sliverpb.MakeTokenReq
-	72,  // 103: rpcpb.SliverRPC.GetEnv:input_type -> sliverpb.EnvReq
-	73,  // 104: rpcpb.SliverRPC.SetEnv:input_type -> sliverpb.SetEnvReq
-	74,  // 105: rpcpb.SliverRPC.UnsetEnv:input_type -> sliverpb.UnsetEnvReq
-	75,  // 106: rpcpb.SliverRPC.Backdoor:input_type -> sliverpb.BackdoorReq
-	76,  // 107: rpcpb.SliverRPC.RegistryRead:input_type -> sliverpb.RegistryReadReq
-	77,  // 108: rpcpb.SliverRPC.RegistryWrite:input_type -> sliverpb.RegistryWriteReq
-	78,  // 109: rpcpb.SliverRPC.RegistryCreateKey:input_type -> sliverpb.RegistryCreateKeyReq
-	79,  // 110: rpcpb.SliverRPC.RegistryDeleteKey:input_type -> sliverpb.RegistryDeleteKeyReq
-	80,  // 111: rpcpb.SliverRPC.RegistryListSubKeys:input_type -> sliverpb.RegistrySubKeyListReq
-	81,  // 112: rpcpb.SliverRPC.RegistryListValues:input_type -> sliverpb.RegistryListValuesReq
-	82,  // 113: rpcpb.SliverRPC.RunSSHCommand:input_type -> sliverpb.SSHCommandReq
-	83,  // 114: rpcpb.SliverRPC.HijackDLL:input_type -> clientpb.DllHijackReq
-	84,  // 115: rpcpb.SliverRPC.GetPrivs:input_type -> sliverpb.GetPrivsReq
-	85,  // 116: rpcpb.SliverRPC.StartRportFwdListener:input_type -> sliverpb.RportFwdStartListenerReq
-	86,  // 117: rpcpb.SliverRPC.GetRportFwdListeners:input_type -> sliverpb.RportFwdListenersReq
-	87,  // 118: rpcpb.SliverRPC.StopRportFwdListener:input_type -> sliverpb.RportFwdStopListenerReq
-	88,  // 119: rpcpb.SliverRPC.OpenSession:input_type -> sliverpb.OpenSession
-	89,  // 120: rpcpb.SliverRPC.CloseSession:input_type -> sliverpb.CloseSession
-	90,  // 121: rpcpb.SliverRPC.RegisterExtension:input_type -> sliverpb.RegisterExtensionReq
-	91,  // 122: rpcpb.SliverRPC.CallExtension:input_type -> sliverpb.CallExtensionReq
-	92,  // 123: rpcpb.SliverRPC.ListExtensions:input_type -> sliverpb.ListExtensionsReq
-	93,  // 124: rpcpb.SliverRPC.WGStartPortForward:input_type -> sliverpb.WGPortForwardStartReq
-	94,  // 125: rpcpb.SliverRPC.WGStopPortForward:input_type -> sliverpb.WGPortForwardStopReq
-	95,  // 126: rpcpb.SliverRPC.WGStartSocks:input_type -> sliverpb.WGSocksStartReq
-	96,  // 127: rpcpb.SliverRPC.WGStopSocks:input_type -> sliverpb.WGSocksStopReq
-	97,  // 128: rpcpb.SliverRPC.WGListForwarders:input_type -> sliverpb.WGTCPForwardersReq
-	98,  // 129: rpcpb.SliverRPC.WGListSocksServers:input_type -> sliverpb.WGSocksServersReq
-	99,  // 130: rpcpb.SliverRPC.Shell:input_type -> sliverpb.ShellReq
-	100, // 131: rpcpb.SliverRPC.Portfwd:input_type -> sliverpb.PortfwdReq
-	101, // 132: rpcpb.SliverRPC.CreateSocks:input_type -> sliverpb.Socks
-	101, // 133: rpcpb.SliverRPC.CloseSocks:input_type -> sliverpb.Socks
-	102, // 134: rpcpb.SliverRPC.SocksProxy:input_type -> sliverpb.SocksData
-	103, // 135: rpcpb.SliverRPC.CreateTunnel:input_type -> sliverpb.Tunnel
-	103, // 136: rpcpb.SliverRPC.CloseTunnel:input_type -> sliverpb.Tunnel
-	104, // 137: rpcpb.SliverRPC.TunnelData:input_type -> sliverpb.TunnelData
-	0,   // 138: rpcpb.SliverRPC.Events:input_type -> commonpb.Empty
-	105, // 139: rpcpb.SliverRPC.GetVersion:output_type -> clientpb.Version
-	106, // 140: rpcpb.SliverRPC.GetOperators:output_type -> clientpb.Operators
-	0,   // 141: rpcpb.SliverRPC.Kill:output_type -> commonpb.Empty
-	107, // 142: rpcpb.SliverRPC.Reconfigure:output_type -> sliverpb.Reconfigure
-	0,   // 143: rpcpb.SliverRPC.Rename:output_type -> commonpb.Empty
-	108, // 144: rpcpb.SliverRPC.GetSessions:output_type -> clientpb.Sessions
-	109, // 145: rpcpb.SliverRPC.GetBeacons:output_type -> clientpb.Beacons
-	4,   // 146: rpcpb.SliverRPC.GetBeacon:output_type -> clientpb.Beacon
-	0,   // 147: rpcpb.SliverRPC.RmBeacon:output_type -> commonpb.Empty
-	110, // 148: rpcpb.SliverRPC.GetBeaconTasks:output_type -> clientpb.BeaconTasks
-	5,   // 149: rpcpb.SliverRPC.GetBeaconTaskContent:output_type -> clientpb.BeaconTask
-	5,   // 150: rpcpb.SliverRPC.CancelBeaconTask:output_type -> clientpb.BeaconTask
-	111, // 151: rpcpb.SliverRPC.MonitorStart:output_type -> commonpb.Response
-	0,   // 152: rpcpb.SliverRPC.MonitorStop:output_type -> commonpb.Empty
-	112, // 153: rpcpb.SliverRPC.GetJobs:output_type -> clientpb.Jobs
-	113, // 154: rpcpb.SliverRPC.KillJob:output_type -> clientpb.KillJob
-	114, // 155: rpcpb.SliverRPC.StartMTLSListener:output_type -> clientpb.MTLSListener
-	115, // 156: rpcpb.SliverRPC.StartWGListener:output_type -> clientpb.WGListener
-	116, // 157: rpcpb.SliverRPC.StartDNSListener:output_type -> clientpb.DNSListener
-	117, // 158: rpcpb.SliverRPC.StartHTTPSListener:output_type -> clientpb.HTTPListener
-	117, // 159: rpcpb.SliverRPC.StartHTTPListener:output_type -> clientpb.HTTPListener
-	118, // 160: rpcpb.SliverRPC.StartTCPStagerListener:output_type -> clientpb.StagerListener
-	118, // 161: rpcpb.SliverRPC.StartHTTPStagerListener:output_type -> clientpb.StagerListener
-	12,  // 162: rpcpb.SliverRPC.LootAdd:output_type -> clientpb.Loot
-	0,   // 163: rpcpb.SliverRPC.LootRm:output_type -> commonpb.Empty
-	12,  // 164: rpcpb.SliverRPC.LootUpdate:output_type -> clientpb.Loot
-	12,  // 165: rpcpb.SliverRPC.LootContent:output_type -> clientpb.Loot
-	119, // 166: rpcpb.SliverRPC.LootAll:output_type -> clientpb.AllLoot
-	119, // 167: rpcpb.SliverRPC.LootAllOf:output_type -> clientpb.AllLoot
-	120, // 168: rpcpb.SliverRPC.Hosts:output_type -> clientpb.AllHosts
-	13,  // 169: rpcpb.SliverRPC.Host:output_type -> clientpb.Host
-	0,   // 170: rpcpb.SliverRPC.HostRm:output_type -> commonpb.Empty
-	0,   // 171: rpcpb.SliverRPC.HostIOCRm:output_type -> commonpb.Empty
-	121, // 172: rpcpb.SliverRPC.Generate:output_type -> clientpb.Generate
-	122, // 173: rpcpb.SliverRPC.GenerateExternal:output_type -> clientpb.ExternalImplantConfig
-	0,   // 174: rpcpb.SliverRPC.GenerateExternalSaveBuild:output_type -> commonpb.Empty
-	122, // 175: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:output_type -> clientpb.ExternalImplantConfig
-	20,  // 176: rpcpb.SliverRPC.BuilderRegister:output_type -> clientpb.Event
-	0,   // 177: rpcpb.SliverRPC.BuilderTrigger:output_type -> commonpb.Empty
-	123, // 178: rpcpb.SliverRPC.Builders:output_type -> clientpb.Builders
-	121, // 179: rpcpb.SliverRPC.Regenerate:output_type -> clientpb.Generate
-	124, // 180: rpcpb.SliverRPC.ImplantBuilds:output_type -> clientpb.ImplantBuilds
-	0,   // 181: rpcpb.SliverRPC.DeleteImplantBuild:output_type -> commonpb.Empty
-	125, // 182: rpcpb.SliverRPC.Canaries:output_type -> clientpb.Canaries
-	126, // 183: rpcpb.SliverRPC.GenerateWGClientConfig:output_type -> clientpb.WGClientConfig
-	127, // 184: rpcpb.SliverRPC.GenerateUniqueIP:output_type -> clientpb.UniqueWGIP
-	128, // 185: rpcpb.SliverRPC.ImplantProfiles:output_type -> clientpb.ImplantProfiles
-	0,   // 186: rpcpb.SliverRPC.DeleteImplantProfile:output_type -> commonpb.Empty
-	23,  // 187: rpcpb.SliverRPC.SaveImplantProfile:output_type -> clientpb.ImplantProfile
-	129, // 188: rpcpb.SliverRPC.MsfStage:output_type -> clientpb.MsfStager
-	130, // 189: rpcpb.SliverRPC.ShellcodeRDI:output_type -> clientpb.ShellcodeRDI
-	131, // 190: rpcpb.SliverRPC.GetCompiler:output_type -> clientpb.Compiler
-	132, // 191: rpcpb.SliverRPC.ShellcodeEncoder:output_type -> clientpb.ShellcodeEncode
-	133, // 192: rpcpb.SliverRPC.ShellcodeEncoderMap:output_type -> clientpb.ShellcodeEncoderMap
-	134, // 193: rpcpb.SliverRPC.Websites:output_type -> clientpb.Websites
-	27,  // 194: rpcpb.SliverRPC.Website:output_type -> clientpb.Website
-	0,   // 195: rpcpb.SliverRPC.WebsiteRemove:output_type -> commonpb.Empty
-	27,  // 196: rpcpb.SliverRPC.WebsiteAddContent:output_type -> clientpb.Website
-	27,  // 197: rpcpb.SliverRPC.WebsiteUpdateContent:output_type -> clientpb.Website
-	27,  // 198: rpcpb.SliverRPC.WebsiteRemoveContent:output_type -> clientpb.Website
-	30,  // 199: rpcpb.SliverRPC.Ping:output_type -> sliverpb.Ping
-	135, // 200: rpcpb.SliverRPC.Ps:output_type -> sliverpb.Ps
-	136, // 201: rpcpb.SliverRPC.Terminate:output_type -> sliverpb.Terminate
-	137, // 202: rpcpb.SliverRPC.Ifconfig:output_type -> sliverpb.Ifconfig
-	138, // 203: rpcpb.SliverRPC.Netstat:output_type -> sliverpb.Netstat
-	139, // 204: rpcpb.SliverRPC.Ls:output_type -> sliverpb.Ls
-	140, // 205: rpcpb.SliverRPC.Cd:output_type -> sliverpb.Pwd
-	140, // 206: rpcpb.SliverRPC.Pwd:output_type -> sliverpb.Pwd
-	141, // 207: rpcpb.SliverRPC.Mv:output_type -> sliverpb.Mv
-	142, // 208: rpcpb.SliverRPC.Rm:output_type -> sliverpb.Rm
-	143, // 209: rpcpb.SliverRPC.Mkdir:output_type -> sliverpb.Mkdir
-	144, // 210: rpcpb.SliverRPC.Download:output_type -> sliverpb.Download
-	145, // 211: rpcpb.SliverRPC.Upload:output_type -> sliverpb.Upload
-	146, // 212: rpcpb.SliverRPC.Chmod:output_type -> sliverpb.Chmod
-	147, // 213: rpcpb.SliverRPC.Chown:output_type -> sliverpb.Chown
-	148, // 214: rpcpb.SliverRPC.Chtimes:output_type -> sliverpb.Chtimes
-	139, // 215: rpcpb.SliverRPC.MemfilesList:output_type -> sliverpb.Ls
-	149, // 216: rpcpb.SliverRPC.MemfilesAdd:output_type -> sliverpb.MemfilesAdd
-	150, // 217: rpcpb.SliverRPC.MemfilesRm:output_type -> sliverpb.MemfilesRm
-	151, // 218: rpcpb.SliverRPC.ProcessDump:output_type -> sliverpb.ProcessDump
-	152, // 219: rpcpb.SliverRPC.RunAs:output_type -> sliverpb.RunAs
-	153, // 220: rpcpb.SliverRPC.Impersonate:output_type -> sliverpb.Impersonate
-	154, // 221: rpcpb.SliverRPC.RevToSelf:output_type -> sliverpb.RevToSelf
-	155, // 222: rpcpb.SliverRPC.GetSystem:output_type -> sliverpb.GetSystem
-	156, // 223: rpcpb.SliverRPC.Task:output_type -> sliverpb.Task
-	156, // 224: rpcpb.SliverRPC.Msf:output_type -> sliverpb.Task
-	156, // 225: rpcpb.SliverRPC.MsfRemote:output_type -> sliverpb.Task
-	157, // 226: rpcpb.SliverRPC.ExecuteAssembly:output_type -> sliverpb.ExecuteAssembly
-	158, // 227: rpcpb.SliverRPC.Migrate:output_type -> sliverpb.Migrate
-	159, // 228: rpcpb.SliverRPC.Execute:output_type -> sliverpb.Execute
-	159, // 229: rpcpb.SliverRPC.ExecuteWindows:output_type -> sliverpb.Execute
-	160, // 230: rpcpb.SliverRPC.Sideload:output_type -> sliverpb.Sideload
-	161, // 231: rpcpb.SliverRPC.SpawnDll:output_type -> sliverpb.SpawnDll
-	162, // 232: rpcpb.SliverRPC.Screenshot:output_type -> sliverpb.Screenshot
-	163, // 233: rpcpb.SliverRPC.CurrentTokenOwner:output_type -> sliverpb.CurrentTokenOwner
-	164, // 234: rpcpb.SliverRPC.PivotStartListener:output_type -> sliverpb.PivotListener
-	0,   // 235: rpcpb.SliverRPC.PivotStopListener:output_type -> commonpb.Empty
-	165, // 236: rpcpb.SliverRPC.PivotSessionListeners:output_type -> sliverpb.PivotListeners
-	166, // 237: rpcpb.SliverRPC.PivotGraph:output_type -> clientpb.PivotGraph
-	167, // 238: rpcpb.SliverRPC.StartService:output_type -> sliverpb.ServiceInfo
-	167, // 239: rpcpb.SliverRPC.StopService:output_type -> sliverpb.ServiceInfo
-	167, // 240: rpcpb.SliverRPC.RemoveService:output_type -> sliverpb.ServiceInfo
-	168, // 241: rpcpb.SliverRPC.MakeToken:output_type -> sliverpb.MakeToken
-	169, // 242: rpcpb.SliverRPC.GetEnv:output_type -> sliverpb.EnvInfo
-	170, // 243: rpcpb.SliverRPC.SetEnv:output_type -> sliverpb.SetEnv
-	171, // 244: rpcpb.SliverRPC.UnsetEnv:output_type -> sliverpb.UnsetEnv
-	172, // 245: rpcpb.SliverRPC.Backdoor:output_type -> sliverpb.Backdoor
-	173, // 246: rpcpb.SliverRPC.RegistryRead:output_type -> sliverpb.RegistryRead
-	174, // 247: rpcpb.SliverRPC.RegistryWrite:output_type -> sliverpb.RegistryWrite
-	175, // 248: rpcpb.SliverRPC.RegistryCreateKey:output_type -> sliverpb.RegistryCreateKey
-	176, // 249: rpcpb.SliverRPC.RegistryDeleteKey:output_type -> sliverpb.RegistryDeleteKey
-	177, // 250: rpcpb.SliverRPC.RegistryListSubKeys:output_type -> sliverpb.RegistrySubKeyList
-	178, // 251: rpcpb.SliverRPC.RegistryListValues:output_type -> sliverpb.RegistryValuesList
-	179, // 252: rpcpb.SliverRPC.RunSSHCommand:output_type -> sliverpb.SSHCommand
-	180, // 253: rpcpb.SliverRPC.HijackDLL:output_type -> clientpb.DllHijack
-	181, // 254: rpcpb.SliverRPC.GetPrivs:output_type -> sliverpb.GetPrivs
-	182, // 255: rpcpb.SliverRPC.StartRportFwdListener:output_type -> sliverpb.RportFwdListener
-	183, // 256: rpcpb.SliverRPC.GetRportFwdListeners:output_type -> sliverpb.RportFwdListeners
-	182, // 257: rpcpb.SliverRPC.StopRportFwdListener:output_type -> sliverpb.RportFwdListener
-	88,  // 258: rpcpb.SliverRPC.OpenSession:output_type -> sliverpb.OpenSession
-	0,   // 259: rpcpb.SliverRPC.CloseSession:output_type -> commonpb.Empty
-	184, // 260: rpcpb.SliverRPC.RegisterExtension:output_type -> sliverpb.RegisterExtension
-	185, // 261: rpcpb.SliverRPC.CallExtension:output_type -> sliverpb.CallExtension
-	186, // 262: rpcpb.SliverRPC.ListExtensions:output_type -> sliverpb.ListExtensions
-	187, // 263: rpcpb.SliverRPC.WGStartPortForward:output_type -> sliverpb.WGPortForward
-	187, // 264: rpcpb.SliverRPC.WGStopPortForward:output_type -> sliverpb.WGPortForward
-	188, // 265: rpcpb.SliverRPC.WGStartSocks:output_type -> sliverpb.WGSocks
-	188, // 266: rpcpb.SliverRPC.WGStopSocks:output_type -> sliverpb.WGSocks
-	189, // 267: rpcpb.SliverRPC.WGListForwarders:output_type -> sliverpb.WGTCPForwarders
-	190, // 268: rpcpb.SliverRPC.WGListSocksServers:output_type -> sliverpb.WGSocksServers
-	191, // 269: rpcpb.SliverRPC.Shell:output_type -> sliverpb.Shell
-	192, // 270: rpcpb.SliverRPC.Portfwd:output_type -> sliverpb.Portfwd
-	101, // 271: rpcpb.SliverRPC.CreateSocks:output_type -> sliverpb.Socks
-	0,   // 272: rpcpb.SliverRPC.CloseSocks:output_type -> commonpb.Empty
-	102, // 273: rpcpb.SliverRPC.SocksProxy:output_type -> sliverpb.SocksData
-	103, // 274: rpcpb.SliverRPC.CreateTunnel:output_type -> sliverpb.Tunnel
-	0,   // 275: rpcpb.SliverRPC.CloseTunnel:output_type -> commonpb.Empty
-	104, // 276: rpcpb.SliverRPC.TunnelData:output_type -> sliverpb.TunnelData
-	20,  // 277: rpcpb.SliverRPC.Events:output_type -> clientpb.Event
-	139, // [139:278] is the sub-list for method output_type
-	0,   // [0:139] is the sub-list for method input_type
+	0,   // 2: rpcpb.SliverRPC.GetSecondFactorChallenge:input_type -> commonpb.Empty
+	1,   // 3: rpcpb.SliverRPC.VerifySecondFactor:input_type -> clientpb.SecondFactorReq
+	2,   // 4: rpcpb.SliverRPC.Kill:input_type -> sliverpb.KillReq
+	3,   // 5: rpcpb.SliverRPC.Reconfigure:input_type -> sliverpb.ReconfigureReq
+	4,   // 6: rpcpb.SliverRPC.ProxySet:input_type -> sliverpb.ProxySetReq
+	5,   // 7: rpcpb.SliverRPC.Redirect:input_type -> sliverpb.RedirectReq
+	6,   // 8: rpcpb.SliverRPC.Rename:input_type -> clientpb.RenameReq
+	0,   // 9: rpcpb.SliverRPC.GetSessions:input_type -> commonpb.Empty
+	0,   // 10: rpcpb.SliverRPC.GetBeacons:input_type -> commonpb.Empty
+	7,   // 11: rpcpb.SliverRPC.GetBeacon:input_type -> clientpb.Beacon
+	7,   // 12: rpcpb.SliverRPC.RmBeacon:input_type -> clientpb.Beacon
+	7,   // 13: rpcpb.SliverRPC.GetBeaconTasks:input_type -> clientpb.Beacon
+	8,   // 14: rpcpb.SliverRPC.GetBeaconTaskContent:input_type -> clientpb.BeaconTask
+	8,   // 15: rpcpb.SliverRPC.CancelBeaconTask:input_type -> clientpb.BeaconTask
+	9,   // 16: rpcpb.SliverRPC.ReorderBeaconTasks:input_type -> clientpb.BeaconTaskReorderReq
+	0,   // 17: rpcpb.SliverRPC.MonitorStart:input_type -> commonpb.Empty
+	0,   // 18: rpcpb.SliverRPC.MonitorStop:input_type -> commonpb.Empty
+	0,   // 19: rpcpb.SliverRPC.GetJobs:input_type -> commonpb.Empty
+	10,  // 20: rpcpb.SliverRPC.KillJob:input_type -> clientpb.KillJobReq
+	11,  // 21: rpcpb.SliverRPC.GetListenerSettings:input_type -> clientpb.ListenerSettingsReq
+	12,  // 22: rpcpb.SliverRPC.UpdateListenerSettings:input_type -> clientpb.ListenerSettings
+	13,  // 23: rpcpb.SliverRPC.StartMTLSListener:input_type -> clientpb.MTLSListenerReq
+	14,  // 24: rpcpb.SliverRPC.StartWGListener:input_type -> clientpb.WGListenerReq
+	15,  // 25: rpcpb.SliverRPC.StartDNSListener:input_type -> clientpb.DNSListenerReq
+	16,  // 26: rpcpb.SliverRPC.StartHTTPSListener:input_type -> clientpb.HTTPListenerReq
+	16,  // 27: rpcpb.SliverRPC.StartHTTPListener:input_type -> clientpb.HTTPListenerReq
+	17,  // 28: rpcpb.SliverRPC.StartICMPListener:input_type -> clientpb.ICMPListenerReq
+	18,  // 29: rpcpb.SliverRPC.StartUDPListener:input_type -> clientpb.UDPListenerReq
+	0,   // 30: rpcpb.SliverRPC.GetHTTPC2Profiles:input_type -> commonpb.Empty
+	19,  // 31: rpcpb.SliverRPC.SaveHTTPC2Profile:input_type -> clientpb.HTTPC2Profile
+	20,  // 32: rpcpb.SliverRPC.StartTCPStagerListener:input_type -> clientpb.StagerListenerReq
+	21,  // 33: rpcpb.SliverRPC.StartShellUpgradeListener:input_type -> clientpb.ShellUpgradeReq
+	20,  // 34: rpcpb.SliverRPC.StartHTTPStagerListener:input_type -> clientpb.StagerListenerReq
+	22,  // 35: rpcpb.SliverRPC.StartWarmup:input_type -> clientpb.WarmupReq
+	23,  // 36: rpcpb.SliverRPC.LootAdd:input_type -> clientpb.Loot
+	23,  // 37: rpcpb.SliverRPC.LootRm:input_type -> clientpb.Loot
+	23,  // 38: rpcpb.SliverRPC.LootUpdate:input_type -> clientpb.Loot
+	23,  // 39: rpcpb.SliverRPC.LootContent:input_type -> clientpb.Loot
+	0,   // 40: rpcpb.SliverRPC.LootAll:input_type -> commonpb.Empty
+	23,  // 41: rpcpb.SliverRPC.LootAllOf:input_type -> clientpb.Loot
+	0,   // 42: rpcpb.SliverRPC.Hosts:input_type -> commonpb.Empty
+	24,  // 43: rpcpb.SliverRPC.Host:input_type -> clientpb.Host
+	24,  // 44: rpcpb.SliverRPC.HostRm:input_type -> clientpb.Host
+	25,  // 45: rpcpb.SliverRPC.HostIOCRm:input_type -> clientpb.IOC
+	26,  // 46: rpcpb.SliverRPC.ParseOutput:input_type -> clientpb.ParseOutputReq
+	27,  // 47: rpcpb.SliverRPC.HostTags:input_type -> clientpb.HostTagsReq
+	24,  // 48: rpcpb.SliverRPC.HostSurvey:input_type -> clientpb.Host
+	0,   // 49: rpcpb.SliverRPC.GetPlaybooks:input_type -> commonpb.Empty
+	28,  // 50: rpcpb.SliverRPC.SavePlaybook:input_type -> clientpb.Playbook
+	28,  // 51: rpcpb.SliverRPC.RemovePlaybook:input_type -> clientpb.Playbook
+	29,  // 52: rpcpb.SliverRPC.RunPlaybook:input_type -> clientpb.PlaybookRunReq
+	0,   // 53: rpcpb.SliverRPC.GetPlaybookRuns:input_type -> commonpb.Empty
+	30,  // 54: rpcpb.SliverRPC.ApprovePlaybookStep:input_type -> clientpb.PlaybookApprovalReq
+	31,  // 55: rpcpb.SliverRPC.PersistenceAdd:input_type -> clientpb.Persistence
+	31,  // 56: rpcpb.SliverRPC.PersistenceRm:input_type -> clientpb.Persistence
+	0,   // 57: rpcpb.SliverRPC.PersistenceAll:input_type -> commonpb.Empty
+	0,   // 58: rpcpb.SliverRPC.GetEngagement:input_type -> commonpb.Empty
+	32,  // 59: rpcpb.SliverRPC.SetEngagement:input_type -> clientpb.Engagement
+	33,  // 60: rpcpb.SliverRPC.Generate:input_type -> clientpb.GenerateReq
+	34,  // 61: rpcpb.SliverRPC.GenerateExternal:input_type -> clientpb.ExternalGenerateReq
+	35,  // 62: rpcpb.SliverRPC.GenerateExternalSaveBuild:input_type -> clientpb.ExternalImplantBinary
+	36,  // 63: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:input_type -> clientpb.ImplantConfig
+	37,  // 64: rpcpb.SliverRPC.BuilderRegister:input_type -> clientpb.Builder
+	38,  // 65: rpcpb.SliverRPC.BuilderTrigger:input_type -> clientpb.Event
+	0,   // 66: rpcpb.SliverRPC.Builders:input_type -> commonpb.Empty
+	39,  // 67: rpcpb.SliverRPC.Regenerate:input_type -> clientpb.RegenerateReq
+	0,   // 68: rpcpb.SliverRPC.ImplantBuilds:input_type -> commonpb.Empty
+	40,  // 69: rpcpb.SliverRPC.DeleteImplantBuild:input_type -> clientpb.DeleteReq
+	0,   // 70: rpcpb.SliverRPC.Canaries:input_type -> commonpb.Empty
+	0,   // 71: rpcpb.SliverRPC.GenerateWGClientConfig:input_type -> commonpb.Empty
+	0,   // 72: rpcpb.SliverRPC.GenerateUniqueIP:input_type -> commonpb.Empty
+	0,   // 73: rpcpb.SliverRPC.ImplantProfiles:input_type -> commonpb.Empty
+	40,  // 74: rpcpb.SliverRPC.DeleteImplantProfile:input_type -> clientpb.DeleteReq
+	41,  // 75: rpcpb.SliverRPC.SaveImplantProfile:input_type -> clientpb.ImplantProfile
+	42,  // 76: rpcpb.SliverRPC.MsfStage:input_type -> clientpb.MsfStagerReq
+	43,  // 77: rpcpb.SliverRPC.ShellcodeRDI:input_type -> clientpb.ShellcodeRDIReq
+	0,   // 78: rpcpb.SliverRPC.GetCompiler:input_type -> commonpb.Empty
+	44,  // 79: rpcpb.SliverRPC.ShellcodeEncoder:input_type -> clientpb.ShellcodeEncodeReq
+	0,   // 80: rpcpb.SliverRPC.ShellcodeEncoderMap:input_type -> commonpb.Empty
+	45,  // 81: rpcpb.SliverRPC.ShellcodeArtifactConvert:input_type -> clientpb.ShellcodeArtifactReq
+	0,   // 82: rpcpb.SliverRPC.ShellcodeArtifacts:input_type -> commonpb.Empty
+	46,  // 83: rpcpb.SliverRPC.ShellcodeArtifactContent:input_type -> clientpb.ShellcodeArtifact
+	46,  // 84: rpcpb.SliverRPC.ShellcodeArtifactRm:input_type -> clientpb.ShellcodeArtifact
+	0,   // 85: rpcpb.SliverRPC.Websites:input_type -> commonpb.Empty
+	47,  // 86: rpcpb.SliverRPC.Website:input_type -> clientpb.Website
+	47,  // 87: rpcpb.SliverRPC.WebsiteRemove:input_type -> clientpb.Website
+	48,  // 88: rpcpb.SliverRPC.WebsiteAddContent:input_type -> clientpb.WebsiteAddContent
+	48,  // 89: rpcpb.SliverRPC.WebsiteUpdateContent:input_type -> clientpb.WebsiteAddContent
+	49,  // 90: rpcpb.SliverRPC.WebsiteRemoveContent:input_type -> clientpb.WebsiteRemoveContent
+	50,  // 91: rpcpb.SliverRPC.Ping:input_type -> sliverpb.Ping
+	51,  // 92: rpcpb.SliverRPC.Ps:input_type -> sliverpb.PsReq
+	52,  // 93: rpcpb.SliverRPC.Terminate:input_type -> sliverpb.TerminateReq
+	53,  // 94: rpcpb.SliverRPC.Ifconfig:input_type -> sliverpb.IfconfigReq
+	54,  // 95: rpcpb.SliverRPC.Netstat:input_type -> sliverpb.NetstatReq
+	55,  // 96: rpcpb.SliverRPC.Ls:input_type -> sliverpb.LsReq
+	56,  // 97: rpcpb.SliverRPC.Search:input_type -> sliverpb.SearchReq
+	57,  // 98: rpcpb.SliverRPC.Cd:input_type -> sliverpb.CdReq
+	58,  // 99: rpcpb.SliverRPC.Pwd:input_type -> sliverpb.PwdReq
+	59,  // 100: rpcpb.SliverRPC.Mv:input_type -> sliverpb.MvReq
+	60,  // 101: rpcpb.SliverRPC.Rm:input_type -> sliverpb.RmReq
+	61,  // 102: rpcpb.SliverRPC.Mkdir:input_type -> sliverpb.MkdirReq
+	62,  // 103: rpcpb.SliverRPC.Download:input_type -> sliverpb.DownloadReq
+	63,  // 104: rpcpb.SliverRPC.Upload:input_type -> sliverpb.UploadReq
+	64,  // 105: rpcpb.SliverRPC.GetMissingUploadChunks:input_type -> clientpb.UploadChunks
+	65,  // 106: rpcpb.SliverRPC.SaveUploadChunk:input_type -> clientpb.UploadChunk
+	66,  // 107: rpcpb.SliverRPC.Chmod:input_type -> sliverpb.ChmodReq
+	67,  // 108: rpcpb.SliverRPC.Chown:input_type -> sliverpb.ChownReq
+	68,  // 109: rpcpb.SliverRPC.Chtimes:input_type -> sliverpb.ChtimesReq
+	69,  // 110: rpcpb.SliverRPC.MemfilesList:input_type -> sliverpb.MemfilesListReq
+	70,  // 111: rpcpb.SliverRPC.MemfilesAdd:input_type -> sliverpb.MemfilesAddReq
+	71,  // 112: rpcpb.SliverRPC.MemfilesRm:input_type -> sliverpb.MemfilesRmReq
+	72,  // 113: rpcpb.SliverRPC.ProcessDump:input_type -> sliverpb.ProcessDumpReq
+	73,  // 114: rpcpb.SliverRPC.LsassDump:input_type -> sliverpb.LsassDumpReq
+	74,  // 115: rpcpb.SliverRPC.RunAs:input_type -> sliverpb.RunAsReq
+	75,  // 116: rpcpb.SliverRPC.Impersonate:input_type -> sliverpb.ImpersonateReq
+	76,  // 117: rpcpb.SliverRPC.RevToSelf:input_type -> sliverpb.RevToSelfReq
+	77,  // 118: rpcpb.SliverRPC.GetSystem:input_type -> clientpb.GetSystemReq
+	78,  // 119: rpcpb.SliverRPC.Task:input_type -> sliverpb.TaskReq
+	79,  // 120: rpcpb.SliverRPC.Msf:input_type -> clientpb.MSFReq
+	80,  // 121: rpcpb.SliverRPC.MsfRemote:input_type -> clientpb.MSFRemoteReq
+	81,  // 122: rpcpb.SliverRPC.ExecuteAssembly:input_type -> sliverpb.ExecuteAssemblyReq
+	82,  // 123: rpcpb.SliverRPC.Migrate:input_type -> clientpb.MigrateReq
+	83,  // 124: rpcpb.SliverRPC.Execute:input_type -> sliverpb.ExecuteReq
+	84,  // 125: rpcpb.SliverRPC.ExecuteWindows:input_type -> sliverpb.ExecuteWindowsReq
+	85,  // 126: rpcpb.SliverRPC.Sideload:input_type -> sliverpb.SideloadReq
+	86,  // 127: rpcpb.SliverRPC.InlineExecute:input_type -> sliverpb.InlineExecuteReq
+	87,  // 128: rpcpb.SliverRPC.SpawnDll:input_type -> sliverpb.InvokeSpawnDllReq
+	88,  // 129: rpcpb.SliverRPC.Screenshot:input_type -> sliverpb.ScreenshotReq
+	89,  // 130: rpcpb.SliverRPC.Clipboard:input_type -> sliverpb.ClipboardReq
+	90,  // 131: rpcpb.SliverRPC.CurrentTokenOwner:input_type -> sliverpb.CurrentTokenOwnerReq
+	91,  // 132: rpcpb.SliverRPC.PivotStartListener:input_type -> sliverpb.PivotStartListenerReq
+	92,  // 133: rpcpb.SliverRPC.PivotStopListener:input_type -> sliverpb.PivotStopListenerReq
+	93,  // 134: rpcpb.SliverRPC.PivotSessionListeners:input_type -> sliverpb.PivotListenersReq
+	94,  // 135: rpcpb.SliverRPC.PivotAllowPeers:input_type -> sliverpb.PivotAllowPeersReq
+	0,   // 136: rpcpb.SliverRPC.PivotGraph:input_type -> commonpb.Empty
+	95,  // 137: rpcpb.SliverRPC.StartService:input_type -> sliverpb.StartServiceReq
+	96,  // 138: rpcpb.SliverRPC.StopService:input_type -> sliverpb.StopServiceReq
+	97,  // 139: rpcpb.SliverRPC.RemoveService:input_type -> sliverpb.RemoveServiceReq
+	98,  // 140: rpcpb.SliverRPC.Services:input_type -> sliverpb.ServicesReq
+	99,  // 141: rpcpb.SliverRPC.ServiceStart:input_type -> sliverpb.ServiceStartReq
+	100, // 142: rpcpb.SliverRPC.CreateService:input_type -> sliverpb.CreateServiceReq
+	101, // 143: rpcpb.SliverRPC.ServiceConfig:input_type -> sliverpb.ServiceConfigReq
+	102, // 144: rpcpb.SliverRPC.MakeToken:input_type -> sliverpb.MakeTokenReq
+	103, // 145: rpcpb.SliverRPC.StealToken:input_type -> sliverpb.StealTokenReq
+	104, // 146: rpcpb.SliverRPC.Tokens:input_type -> sliverpb.TokensReq
+	105, // 147: rpcpb.SliverRPC.GetEnv:input_type -> sliverpb.EnvReq
+	106, // 148: rpcpb.SliverRPC.SetEnv:input_type -> sliverpb.SetEnvReq
+	107, // 149: rpcpb.SliverRPC.UnsetEnv:input_type -> sliverpb.UnsetEnvReq
+	108, // 150: rpcpb.SliverRPC.Backdoor:input_type -> sliverpb.BackdoorReq
+	109, // 151: rpcpb.SliverRPC.RegistryRead:input_type -> sliverpb.RegistryReadReq
+	110, // 152: rpcpb.SliverRPC.RegistryWrite:input_type -> sliverpb.RegistryWriteReq
+	111, // 153: rpcpb.SliverRPC.RegistryCreateKey:input_type -> sliverpb.RegistryCreateKeyReq
+	112, // 154: rpcpb.SliverRPC.RegistryDeleteKey:input_type -> sliverpb.RegistryDeleteKeyReq
+	113, // 155: rpcpb.SliverRPC.RegistryListSubKeys:input_type -> sliverpb.RegistrySubKeyListReq
+	114, // 156: rpcpb.SliverRPC.RegistryListValues:input_type -> sliverpb.RegistryListValuesReq
+	115, // 157: rpcpb.SliverRPC.RegistryExport:input_type -> sliverpb.RegistryExportReq
+	116, // 158: rpcpb.SliverRPC.RegistrySearch:input_type -> sliverpb.RegistrySearchReq
+	117, // 159: rpcpb.SliverRPC.TCC:input_type -> sliverpb.TCCReq
+	118, // 160: rpcpb.SliverRPC.Keychain:input_type -> sliverpb.KeychainReq
+	119, // 161: rpcpb.SliverRPC.Launchd:input_type -> sliverpb.LaunchdReq
+	120, // 162: rpcpb.SliverRPC.ConfigProfiles:input_type -> sliverpb.ConfigProfilesReq
+	121, // 163: rpcpb.SliverRPC.RunSSHCommand:input_type -> sliverpb.SSHCommandReq
+	122, // 164: rpcpb.SliverRPC.SSHHarvest:input_type -> sliverpb.SSHHarvestReq
+	123, // 165: rpcpb.SliverRPC.HijackDLL:input_type -> clientpb.DllHijackReq
+	124, // 166: rpcpb.SliverRPC.GetPrivs:input_type -> sliverpb.GetPrivsReq
+	125, // 167: rpcpb.SliverRPC.LogonSessions:input_type -> sliverpb.LogonSessionsReq
+	126, // 168: rpcpb.SliverRPC.LocalGroups:input_type -> sliverpb.LocalGroupsReq
+	127, // 169: rpcpb.SliverRPC.ServiceHijacks:input_type -> sliverpb.ServiceHijacksReq
+	128, // 170: rpcpb.SliverRPC.AdcsEnum:input_type -> sliverpb.AdcsEnumReq
+	129, // 171: rpcpb.SliverRPC.AdcsRequest:input_type -> sliverpb.AdcsRequestReq
+	130, // 172: rpcpb.SliverRPC.ValidateCreds:input_type -> sliverpb.ValidateCredsReq
+	131, // 173: rpcpb.SliverRPC.Presence:input_type -> sliverpb.PresenceReq
+	132, // 174: rpcpb.SliverRPC.Survey:input_type -> sliverpb.SurveyReq
+	133, // 175: rpcpb.SliverRPC.StartRportFwdListener:input_type -> sliverpb.RportFwdStartListenerReq
+	134, // 176: rpcpb.SliverRPC.GetRportFwdListeners:input_type -> sliverpb.RportFwdListenersReq
+	135, // 177: rpcpb.SliverRPC.StopRportFwdListener:input_type -> sliverpb.RportFwdStopListenerReq
+	136, // 178: rpcpb.SliverRPC.GetNTLMListeners:input_type -> sliverpb.NTLMListenersReq
+	137, // 179: rpcpb.SliverRPC.StartNTLMListener:input_type -> sliverpb.NTLMStartListenerReq
+	138, // 180: rpcpb.SliverRPC.StopNTLMListener:input_type -> sliverpb.NTLMStopListenerReq
+	139, // 181: rpcpb.SliverRPC.OpenSession:input_type -> sliverpb.OpenSession
+	140, // 182: rpcpb.SliverRPC.CloseSession:input_type -> sliverpb.CloseSession
+	141, // 183: rpcpb.SliverRPC.RegisterExtension:input_type -> sliverpb.RegisterExtensionReq
+	142, // 184: rpcpb.SliverRPC.CallExtension:input_type -> sliverpb.CallExtensionReq
+	143, // 185: rpcpb.SliverRPC.ListExtensions:input_type -> sliverpb.ListExtensionsReq
+	144, // 186: rpcpb.SliverRPC.WGStartPortForward:input_type -> sliverpb.WGPortForwardStartReq
+	145, // 187: rpcpb.SliverRPC.WGStopPortForward:input_type -> sliverpb.WGPortForwardStopReq
+	146, // 188: rpcpb.SliverRPC.WGStartSocks:input_type -> sliverpb.WGSocksStartReq
+	147, // 189: rpcpb.SliverRPC.WGStopSocks:input_type -> sliverpb.WGSocksStopReq
+	148, // 190: rpcpb.SliverRPC.WGListForwarders:input_type -> sliverpb.WGTCPForwardersReq
+	149, // 191: rpcpb.SliverRPC.WGListSocksServers:input_type -> sliverpb.WGSocksServersReq
+	150, // 192: rpcpb.SliverRPC.WGRotateKeys:input_type -> sliverpb.WGRotateKeysReq
+	151, // 193: rpcpb.SliverRPC.Shell:input_type -> sliverpb.ShellReq
+	152, // 194: rpcpb.SliverRPC.Portfwd:input_type -> sliverpb.PortfwdReq
+	153, // 195: rpcpb.SliverRPC.CreateSocks:input_type -> sliverpb.Socks
+	153, // 196: rpcpb.SliverRPC.CloseSocks:input_type -> sliverpb.Socks
+	154, // 197: rpcpb.SliverRPC.SocksProxy:input_type -> sliverpb.SocksData
+	155, // 198: rpcpb.SliverRPC.CreateTunnel:input_type -> sliverpb.Tunnel
+	155, // 199: rpcpb.SliverRPC.CloseTunnel:input_type -> sliverpb.Tunnel
+	156, // 200: rpcpb.SliverRPC.TunnelData:input_type -> sliverpb.TunnelData
+	0,   // 201: rpcpb.SliverRPC.Events:input_type -> commonpb.Empty
+	157, // 202: rpcpb.SliverRPC.GetVersion:output_type -> clientpb.Version
+	158, // 203: rpcpb.SliverRPC.GetOperators:output_type -> clientpb.Operators
+	159, // 204: rpcpb.SliverRPC.GetSecondFactorChallenge:output_type -> clientpb.SecondFactorChallenge
+	160, // 205: rpcpb.SliverRPC.VerifySecondFactor:output_type -> clientpb.SecondFactor
+	0,   // 206: rpcpb.SliverRPC.Kill:output_type -> commonpb.Empty
+	161, // 207: rpcpb.SliverRPC.Reconfigure:output_type -> sliverpb.Reconfigure
+	162, // 208: rpcpb.SliverRPC.ProxySet:output_type -> sliverpb.ProxySet
+	163, // 209: rpcpb.SliverRPC.Redirect:output_type -> sliverpb.Redirect
+	0,   // 210: rpcpb.SliverRPC.Rename:output_type -> commonpb.Empty
+	164, // 211: rpcpb.SliverRPC.GetSessions:output_type -> clientpb.Sessions
+	165, // 212: rpcpb.SliverRPC.GetBeacons:output_type -> clientpb.Beacons
+	7,   // 213: rpcpb.SliverRPC.GetBeacon:output_type -> clientpb.Beacon
+	0,   // 214: rpcpb.SliverRPC.RmBeacon:output_type -> commonpb.Empty
+	166, // 215: rpcpb.SliverRPC.GetBeaconTasks:output_type -> clientpb.BeaconTasks
+	8,   // 216: rpcpb.SliverRPC.GetBeaconTaskContent:output_type -> clientpb.BeaconTask
+	8,   // 217: rpcpb.SliverRPC.CancelBeaconTask:output_type -> clientpb.BeaconTask
+	166, // 218: rpcpb.SliverRPC.ReorderBeaconTasks:output_type -> clientpb.BeaconTasks
+	167, // 219: rpcpb.SliverRPC.MonitorStart:output_type -> commonpb.Response
+	0,   // 220: rpcpb.SliverRPC.MonitorStop:output_type -> commonpb.Empty
+	168, // 221: rpcpb.SliverRPC.GetJobs:output_type -> clientpb.Jobs
+	169, // 222: rpcpb.SliverRPC.KillJob:output_type -> clientpb.KillJob
+	12,  // 223: rpcpb.SliverRPC.GetListenerSettings:output_type -> clientpb.ListenerSettings
+	12,  // 224: rpcpb.SliverRPC.UpdateListenerSettings:output_type -> clientpb.ListenerSettings
+	170, // 225: rpcpb.SliverRPC.StartMTLSListener:output_type -> clientpb.MTLSListener
+	171, // 226: rpcpb.SliverRPC.StartWGListener:output_type -> clientpb.WGListener
+	172, // 227: rpcpb.SliverRPC.StartDNSListener:output_type -> clientpb.DNSListener
+	173, // 228: rpcpb.SliverRPC.StartHTTPSListener:output_type -> clientpb.HTTPListener
+	173, // 229: rpcpb.SliverRPC.StartHTTPListener:output_type -> clientpb.HTTPListener
+	174, // 230: rpcpb.SliverRPC.StartICMPListener:output_type -> clientpb.ICMPListener
+	175, // 231: rpcpb.SliverRPC.StartUDPListener:output_type -> clientpb.UDPListener
+	176, // 232: rpcpb.SliverRPC.GetHTTPC2Profiles:output_type -> clientpb.HTTPC2Profiles
+	0,   // 233: rpcpb.SliverRPC.SaveHTTPC2Profile:output_type -> commonpb.Empty
+	177, // 234: rpcpb.SliverRPC.StartTCPStagerListener:output_type -> clientpb.StagerListener
+	177, // 235: rpcpb.SliverRPC.StartShellUpgradeListener:output_type -> clientpb.StagerListener
+	177, // 236: rpcpb.SliverRPC.StartHTTPStagerListener:output_type -> clientpb.StagerListener
+	178, // 237: rpcpb.SliverRPC.StartWarmup:output_type -> clientpb.Warmup
+	23,  // 238: rpcpb.SliverRPC.LootAdd:output_type -> clientpb.Loot
+	0,   // 239: rpcpb.SliverRPC.LootRm:output_type -> commonpb.Empty
+	23,  // 240: rpcpb.SliverRPC.LootUpdate:output_type -> clientpb.Loot
+	23,  // 241: rpcpb.SliverRPC.LootContent:output_type -> clientpb.Loot
+	179, // 242: rpcpb.SliverRPC.LootAll:output_type -> clientpb.AllLoot
+	179, // 243: rpcpb.SliverRPC.LootAllOf:output_type -> clientpb.AllLoot
+	180, // 244: rpcpb.SliverRPC.Hosts:output_type -> clientpb.AllHosts
+	24,  // 245: rpcpb.SliverRPC.Host:output_type -> clientpb.Host
+	0,   // 246: rpcpb.SliverRPC.HostRm:output_type -> commonpb.Empty
+	0,   // 247: rpcpb.SliverRPC.HostIOCRm:output_type -> commonpb.Empty
+	181, // 248: rpcpb.SliverRPC.ParseOutput:output_type -> clientpb.ParsedOutput
+	24,  // 249: rpcpb.SliverRPC.HostTags:output_type -> clientpb.Host
+	182, // 250: rpcpb.SliverRPC.HostSurvey:output_type -> sliverpb.Survey
+	183, // 251: rpcpb.SliverRPC.GetPlaybooks:output_type -> clientpb.Playbooks
+	28,  // 252: rpcpb.SliverRPC.SavePlaybook:output_type -> clientpb.Playbook
+	0,   // 253: rpcpb.SliverRPC.RemovePlaybook:output_type -> commonpb.Empty
+	184, // 254: rpcpb.SliverRPC.RunPlaybook:output_type -> clientpb.PlaybookRuns
+	184, // 255: rpcpb.SliverRPC.GetPlaybookRuns:output_type -> clientpb.PlaybookRuns
+	185, // 256: rpcpb.SliverRPC.ApprovePlaybookStep:output_type -> clientpb.PlaybookRun
+	31,  // 257: rpcpb.SliverRPC.PersistenceAdd:output_type -> clientpb.Persistence
+	0,   // 258: rpcpb.SliverRPC.PersistenceRm:output_type -> commonpb.Empty
+	186, // 259: rpcpb.SliverRPC.PersistenceAll:output_type -> clientpb.AllPersistence
+	32,  // 260: rpcpb.SliverRPC.GetEngagement:output_type -> clientpb.Engagement
+	32,  // 261: rpcpb.SliverRPC.SetEngagement:output_type -> clientpb.Engagement
+	187, // 262: rpcpb.SliverRPC.Generate:output_type -> clientpb.Generate
+	188, // 263: rpcpb.SliverRPC.GenerateExternal:output_type -> clientpb.ExternalImplantConfig
+	0,   // 264: rpcpb.SliverRPC.GenerateExternalSaveBuild:output_type -> commonpb.Empty
+	188, // 265: rpcpb.SliverRPC.GenerateExternalGetImplantConfig:output_type -> clientpb.ExternalImplantConfig
+	38,  // 266: rpcpb.SliverRPC.BuilderRegister:output_type -> clientpb.Event
+	0,   // 267: rpcpb.SliverRPC.BuilderTrigger:output_type -> commonpb.Empty
+	189, // 268: rpcpb.SliverRPC.Builders:output_type -> clientpb.Builders
+	187, // 269: rpcpb.SliverRPC.Regenerate:output_type -> clientpb.Generate
+	190, // 270: rpcpb.SliverRPC.ImplantBuilds:output_type -> clientpb.ImplantBuilds
+	0,   // 271: rpcpb.SliverRPC.DeleteImplantBuild:output_type -> commonpb.Empty
+	191, // 272: rpcpb.SliverRPC.Canaries:output_type -> clientpb.Canaries
+	192, // 273: rpcpb.SliverRPC.GenerateWGClientConfig:output_type -> clientpb.WGClientConfig
+	193, // 274: rpcpb.SliverRPC.GenerateUniqueIP:output_type -> clientpb.UniqueWGIP
+	194, // 275: rpcpb.SliverRPC.ImplantProfiles:output_type -> clientpb.ImplantProfiles
+	0,   // 276: rpcpb.SliverRPC.DeleteImplantProfile:output_type -> commonpb.Empty
+	41,  // 277: rpcpb.SliverRPC.SaveImplantProfile:output_type -> clientpb.ImplantProfile
+	195, // 278: rpcpb.SliverRPC.MsfStage:output_type -> clientpb.MsfStager
+	196, // 279: rpcpb.SliverRPC.ShellcodeRDI:output_type -> clientpb.ShellcodeRDI
+	197, // 280: rpcpb.SliverRPC.GetCompiler:output_type -> clientpb.Compiler
+	198, // 281: rpcpb.SliverRPC.ShellcodeEncoder:output_type -> clientpb.ShellcodeEncode
+	199, // 282: rpcpb.SliverRPC.ShellcodeEncoderMap:output_type -> clientpb.ShellcodeEncoderMap
+	46,  // 283: rpcpb.SliverRPC.ShellcodeArtifactConvert:output_type -> clientpb.ShellcodeArtifact
+	200, // 284: rpcpb.SliverRPC.ShellcodeArtifacts:output_type -> clientpb.ShellcodeArtifacts
+	46,  // 285: rpcpb.SliverRPC.ShellcodeArtifactContent:output_type -> clientpb.ShellcodeArtifact
+	0,   // 286: rpcpb.SliverRPC.ShellcodeArtifactRm:output_type -> commonpb.Empty
+	201, // 287: rpcpb.SliverRPC.Websites:output_type -> clientpb.Websites
+	47,  // 288: rpcpb.SliverRPC.Website:output_type -> clientpb.Website
+	0,   // 289: rpcpb.SliverRPC.WebsiteRemove:output_type -> commonpb.Empty
+	47,  // 290: rpcpb.SliverRPC.WebsiteAddContent:output_type -> clientpb.Website
+	47,  // 291: rpcpb.SliverRPC.WebsiteUpdateContent:output_type -> clientpb.Website
+	47,  // 292: rpcpb.SliverRPC.WebsiteRemoveContent:output_type -> clientpb.Website
+	50,  // 293: rpcpb.SliverRPC.Ping:output_type -> sliverpb.Ping
+	202, // 294: rpcpb.SliverRPC.Ps:output_type -> sliverpb.Ps
+	203, // 295: rpcpb.SliverRPC.Terminate:output_type -> sliverpb.Terminate
+	204, // 296: rpcpb.SliverRPC.Ifconfig:output_type -> sliverpb.Ifconfig
+	205, // 297: rpcpb.SliverRPC.Netstat:output_type -> sliverpb.Netstat
+	206, // 298: rpcpb.SliverRPC.Ls:output_type -> sliverpb.Ls
+	207, // 299: rpcpb.SliverRPC.Search:output_type -> sliverpb.Search
+	208, // 300: rpcpb.SliverRPC.Cd:output_type -> sliverpb.Pwd
+	208, // 301: rpcpb.SliverRPC.Pwd:output_type -> sliverpb.Pwd
+	209, // 302: rpcpb.SliverRPC.Mv:output_type -> sliverpb.Mv
+	210, // 303: rpcpb.SliverRPC.Rm:output_type -> sliverpb.Rm
+	211, // 304: rpcpb.SliverRPC.Mkdir:output_type -> sliverpb.Mkdir
+	212, // 305: rpcpb.SliverRPC.Download:output_type -> sliverpb.Download
+	213, // 306: rpcpb.SliverRPC.Upload:output_type -> sliverpb.Upload
+	64,  // 307: rpcpb.SliverRPC.GetMissingUploadChunks:output_type -> clientpb.UploadChunks
+	0,   // 308: rpcpb.SliverRPC.SaveUploadChunk:output_type -> commonpb.Empty
+	214, // 309: rpcpb.SliverRPC.Chmod:output_type -> sliverpb.Chmod
+	215, // 310: rpcpb.SliverRPC.Chown:output_type -> sliverpb.Chown
+	216, // 311: rpcpb.SliverRPC.Chtimes:output_type -> sliverpb.Chtimes
+	206, // 312: rpcpb.SliverRPC.MemfilesList:output_type -> sliverpb.Ls
+	217, // 313: rpcpb.SliverRPC.MemfilesAdd:output_type -> sliverpb.MemfilesAdd
+	218, // 314: rpcpb.SliverRPC.MemfilesRm:output_type -> sliverpb.MemfilesRm
+	219, // 315: rpcpb.SliverRPC.ProcessDump:output_type -> sliverpb.ProcessDump
+	220, // 316: rpcpb.SliverRPC.LsassDump:output_type -> sliverpb.LsassDump
+	221, // 317: rpcpb.SliverRPC.RunAs:output_type -> sliverpb.RunAs
+	222, // 318: rpcpb.SliverRPC.Impersonate:output_type -> sliverpb.Impersonate
+	223, // 319: rpcpb.SliverRPC.RevToSelf:output_type -> sliverpb.RevToSelf
+	224, // 320: rpcpb.SliverRPC.GetSystem:output_type -> sliverpb.GetSystem
+	225, // 321: rpcpb.SliverRPC.Task:output_type -> sliverpb.Task
+	225, // 322: rpcpb.SliverRPC.Msf:output_type -> sliverpb.Task
+	225, // 323: rpcpb.SliverRPC.MsfRemote:output_type -> sliverpb.Task
+	226, // 324: rpcpb.SliverRPC.ExecuteAssembly:output_type -> sliverpb.ExecuteAssembly
+	227, // 325: rpcpb.SliverRPC.Migrate:output_type -> sliverpb.Migrate
+	228, // 326: rpcpb.SliverRPC.Execute:output_type -> sliverpb.Execute
+	228, // 327: rpcpb.SliverRPC.ExecuteWindows:output_type -> sliverpb.Execute
+	229, // 328: rpcpb.SliverRPC.Sideload:output_type -> sliverpb.Sideload
+	230, // 329: rpcpb.SliverRPC.InlineExecute:output_type -> sliverpb.InlineExecute
+	231, // 330: rpcpb.SliverRPC.SpawnDll:output_type -> sliverpb.SpawnDll
+	232, // 331: rpcpb.SliverRPC.Screenshot:output_type -> sliverpb.Screenshot
+	233, // 332: rpcpb.SliverRPC.Clipboard:output_type -> sliverpb.Clipboard
+	234, // 333: rpcpb.SliverRPC.CurrentTokenOwner:output_type -> sliverpb.CurrentTokenOwner
+	235, // 334: rpcpb.SliverRPC.PivotStartListener:output_type -> sliverpb.PivotListener
+	0,   // 335: rpcpb.SliverRPC.PivotStopListener:output_type -> commonpb.Empty
+	236, // 336: rpcpb.SliverRPC.PivotSessionListeners:output_type -> sliverpb.PivotListeners
+	235, // 337: rpcpb.SliverRPC.PivotAllowPeers:output_type -> sliverpb.PivotListener
+	237, // 338: rpcpb.SliverRPC.PivotGraph:output_type -> clientpb.PivotGraph
+	238, // 339: rpcpb.SliverRPC.StartService:output_type -> sliverpb.ServiceInfo
+	238, // 340: rpcpb.SliverRPC.StopService:output_type -> sliverpb.ServiceInfo
+	238, // 341: rpcpb.SliverRPC.RemoveService:output_type -> sliverpb.ServiceInfo
+	239, // 342: rpcpb.SliverRPC.Services:output_type -> sliverpb.Services
+	240, // 343: rpcpb.SliverRPC.ServiceStart:output_type -> sliverpb.ServiceDetail
+	240, // 344: rpcpb.SliverRPC.CreateService:output_type -> sliverpb.ServiceDetail
+	240, // 345: rpcpb.SliverRPC.ServiceConfig:output_type -> sliverpb.ServiceDetail
+	241, // 346: rpcpb.SliverRPC.MakeToken:output_type -> sliverpb.MakeToken
+	242, // 347: rpcpb.SliverRPC.StealToken:output_type -> sliverpb.StealToken
+	243, // 348: rpcpb.SliverRPC.Tokens:output_type -> sliverpb.Tokens
+	244, // 349: rpcpb.SliverRPC.GetEnv:output_type -> sliverpb.EnvInfo
+	245, // 350: rpcpb.SliverRPC.SetEnv:output_type -> sliverpb.SetEnv
+	246, // 351: rpcpb.SliverRPC.UnsetEnv:output_type -> sliverpb.UnsetEnv
+	247, // 352: rpcpb.SliverRPC.Backdoor:output_type -> sliverpb.Backdoor
+	248, // 353: rpcpb.SliverRPC.RegistryRead:output_type -> sliverpb.RegistryRead
+	249, // 354: rpcpb.SliverRPC.RegistryWrite:output_type -> sliverpb.RegistryWrite
+	250, // 355: rpcpb.SliverRPC.RegistryCreateKey:output_type -> sliverpb.RegistryCreateKey
+	251, // 356: rpcpb.SliverRPC.RegistryDeleteKey:output_type -> sliverpb.RegistryDeleteKey
+	252, // 357: rpcpb.SliverRPC.RegistryListSubKeys:output_type -> sliverpb.RegistrySubKeyList
+	253, // 358: rpcpb.SliverRPC.RegistryListValues:output_type -> sliverpb.RegistryValuesList
+	254, // 359: rpcpb.SliverRPC.RegistryExport:output_type -> sliverpb.RegistryExport
+	255, // 360: rpcpb.SliverRPC.RegistrySearch:output_type -> sliverpb.RegistrySearch
+	256, // 361: rpcpb.SliverRPC.TCC:output_type -> sliverpb.TCC
+	257, // 362: rpcpb.SliverRPC.Keychain:output_type -> sliverpb.Keychain
+	258, // 363: rpcpb.SliverRPC.Launchd:output_type -> sliverpb.Launchd
+	259, // 364: rpcpb.SliverRPC.ConfigProfiles:output_type -> sliverpb.ConfigProfiles
+	260, // 365: rpcpb.SliverRPC.RunSSHCommand:output_type -> sliverpb.SSHCommand
+	261, // 366: rpcpb.SliverRPC.SSHHarvest:output_type -> sliverpb.SSHHarvest
+	262, // 367: rpcpb.SliverRPC.HijackDLL:output_type -> clientpb.DllHijack
+	263, // 368: rpcpb.SliverRPC.GetPrivs:output_type -> sliverpb.GetPrivs
+	264, // 369: rpcpb.SliverRPC.LogonSessions:output_type -> sliverpb.LogonSessions
+	265, // 370: rpcpb.SliverRPC.LocalGroups:output_type -> sliverpb.LocalGroups
+	266, // 371: rpcpb.SliverRPC.ServiceHijacks:output_type -> sliverpb.ServiceHijacks
+	267, // 372: rpcpb.SliverRPC.AdcsEnum:output_type -> sliverpb.AdcsEnum
+	268, // 373: rpcpb.SliverRPC.AdcsRequest:output_type -> sliverpb.AdcsRequest
+	269, // 374: rpcpb.SliverRPC.ValidateCreds:output_type -> sliverpb.ValidateCreds
+	270, // 375: rpcpb.SliverRPC.Presence:output_type -> sliverpb.Presence
+	182, // 376: rpcpb.SliverRPC.Survey:output_type -> sliverpb.Survey
+	271, // 377: rpcpb.SliverRPC.StartRportFwdListener:output_type -> sliverpb.RportFwdListener
+	272, // 378: rpcpb.SliverRPC.GetRportFwdListeners:output_type -> sliverpb.RportFwdListeners
+	271, // 379: rpcpb.SliverRPC.StopRportFwdListener:output_type -> sliverpb.RportFwdListener
+	273, // 380: rpcpb.SliverRPC.GetNTLMListeners:output_type -> sliverpb.NTLMListeners
+	274, // 381: rpcpb.SliverRPC.StartNTLMListener:output_type -> sliverpb.NTLMListener
+	274, // 382: rpcpb.SliverRPC.StopNTLMListener:output_type -> sliverpb.NTLMListener
+	139, // 383: rpcpb.SliverRPC.OpenSession:output_type -> sliverpb.OpenSession
+	0,   // 384: rpcpb.SliverRPC.CloseSession:output_type -> commonpb.Empty
+	275, // 385: rpcpb.SliverRPC.RegisterExtension:output_type -> sliverpb.RegisterExtension
+	276, // 386: rpcpb.SliverRPC.CallExtension:output_type -> sliverpb.CallExtension
+	277, // 387: rpcpb.SliverRPC.ListExtensions:output_type -> sliverpb.ListExtensions
+	278, // 388: rpcpb.SliverRPC.WGStartPortForward:output_type -> sliverpb.WGPortForward
+	278, // 389: rpcpb.SliverRPC.WGStopPortForward:output_type -> sliverpb.WGPortForward
+	279, // 390: rpcpb.SliverRPC.WGStartSocks:output_type -> sliverpb.WGSocks
+	279, // 391: rpcpb.SliverRPC.WGStopSocks:output_type -> sliverpb.WGSocks
+	280, // 392: rpcpb.SliverRPC.WGListForwarders:output_type -> sliverpb.WGTCPForwarders
+	281, // 393: rpcpb.SliverRPC.WGListSocksServers:output_type -> sliverpb.WGSocksServers
+	282, // 394: rpcpb.SliverRPC.WGRotateKeys:output_type -> sliverpb.WGRotateKeys
+	283, // 395: rpcpb.SliverRPC.Shell:output_type -> sliverpb.Shell
+	284, // 396: rpcpb.SliverRPC.Portfwd:output_type -> sliverpb.Portfwd
+	153, // 397: rpcpb.SliverRPC.CreateSocks:output_type -> sliverpb.Socks
+	0,   // 398: rpcpb.SliverRPC.CloseSocks:output_type -> commonpb.Empty
+	154, // 399: rpcpb.SliverRPC.SocksProxy:output_type -> sliverpb.SocksData
+	155, // 400: rpcpb.SliverRPC.CreateTunnel:output_type -> sliverpb.Tunnel
+	0,   // 401: rpcpb.SliverRPC.CloseTunnel:output_type -> commonpb.Empty
+	156, // 402: rpcpb.SliverRPC.TunnelData:output_type -> sliverpb.TunnelData
+	38,  // 403: rpcpb.SliverRPC.Events:output_type -> clientpb.Event
+	202, // [202:404] is the sub-list for method output_type
+	0,   // [0:202] is the sub-list for method input_type
 	0,   // [0:0] is the sub-list for extension type_name
 	0,   // [0:0] is the sub-list for extension extendee
 	0,   // [0:0] is the sub-list for field type_name
@@ -29,9 +29,13 @@ type SliverRPCClient interface {
 	GetVersion(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Version, error)
 	// *** Operator Commands ***
 	GetOperators(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Operators, error)
+	GetSecondFactorChallenge(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.SecondFactorChallenge, error)
+	VerifySecondFactor(ctx context.Context, in *clientpb.SecondFactorReq, opts ...grpc.CallOption) (*clientpb.SecondFactor, error)
 	// *** Generic ***
 	Kill(ctx context.Context, in *sliverpb.KillReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	Reconfigure(ctx context.Context, in *sliverpb.ReconfigureReq, opts ...grpc.CallOption) (*sliverpb.Reconfigure, error)
+	ProxySet(ctx context.Context, in *sliverpb.ProxySetReq, opts ...grpc.CallOption) (*sliverpb.ProxySet, error)
+	Redirect(ctx context.Context, in *sliverpb.RedirectReq, opts ...grpc.CallOption) (*sliverpb.Redirect, error)
 	Rename(ctx context.Context, in *clientpb.RenameReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	// *** Sessions ***
 	GetSessions(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Sessions, error)
@@ -42,21 +46,32 @@ type SliverRPCClient interface {
 	GetBeaconTasks(ctx context.Context, in *clientpb.Beacon, opts ...grpc.CallOption) (*clientpb.BeaconTasks, error)
 	GetBeaconTaskContent(ctx context.Context, in *clientpb.BeaconTask, opts ...grpc.CallOption) (*clientpb.BeaconTask, error)
 	CancelBeaconTask(ctx context.Context, in *clientpb.BeaconTask, opts ...grpc.CallOption) (*clientpb.BeaconTask, error)
+	ReorderBeaconTasks(ctx context.Context, in *clientpb.BeaconTaskReorderReq, opts ...grpc.CallOption) (*clientpb.BeaconTasks, error)
 	// ***Threat monitoring ***
 	MonitorStart(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*commonpb.Response, error)
 	MonitorStop(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	// *** Jobs ***
 	GetJobs(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Jobs, error)
 	KillJob(ctx context.Context, in *clientpb.KillJobReq, opts ...grpc.CallOption) (*clientpb.KillJob, error)
+	GetListenerSettings(ctx context.Context, in *clientpb.ListenerSettingsReq, opts ...grpc.CallOption) (*clientpb.ListenerSettings, error)
+	UpdateListenerSettings(ctx context.Context, in *clientpb.ListenerSettings, opts ...grpc.CallOption) (*clientpb.ListenerSettings, error)
 	// *** Listeners ***
 	StartMTLSListener(ctx context.Context, in *clientpb.MTLSListenerReq, opts ...grpc.CallOption) (*clientpb.MTLSListener, error)
 	StartWGListener(ctx context.Context, in *clientpb.WGListenerReq, opts ...grpc.CallOption) (*clientpb.WGListener, error)
 	StartDNSListener(ctx context.Context, in *clientpb.DNSListenerReq, opts ...grpc.CallOption) (*clientpb.DNSListener, error)
 	StartHTTPSListener(ctx context.Context, in *clientpb.HTTPListenerReq, opts ...grpc.CallOption) (*clientpb.HTTPListener, error)
 	StartHTTPListener(ctx context.Context, in *clientpb.HTTPListenerReq, opts ...grpc.CallOption) (*clientpb.HTTPListener, error)
+	StartICMPListener(ctx context.Context, in *clientpb.ICMPListenerReq, opts ...grpc.CallOption) (*clientpb.ICMPListener, error)
+	StartUDPListener(ctx context.Context, in *clientpb.UDPListenerReq, opts ...grpc.CallOption) (*clientpb.UDPListener, error)
+	// *** HTTP C2 Profiles ***
+	GetHTTPC2Profiles(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.HTTPC2Profiles, error)
+	SaveHTTPC2Profile(ctx context.Context, in *clientpb.HTTPC2Profile, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	// *** Stager Listener ***
 	StartTCPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error)
+	StartShellUpgradeListener(ctx context.Context, in *clientpb.ShellUpgradeReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error)
 	StartHTTPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error)
+	// *** Infrastructure Warmup ***
+	StartWarmup(ctx context.Context, in *clientpb.WarmupReq, opts ...grpc.CallOption) (*clientpb.Warmup, error)
 	// *** Loot ***
 	LootAdd(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error)
 	LootRm(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*commonpb.Empty, error)
@@ -69,6 +84,23 @@ type SliverRPCClient interface {
 	Host(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*clientpb.Host, error)
 	HostRm(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	HostIOCRm(ctx context.Context, in *clientpb.IOC, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	ParseOutput(ctx context.Context, in *clientpb.ParseOutputReq, opts ...grpc.CallOption) (*clientpb.ParsedOutput, error)
+	HostTags(ctx context.Context, in *clientpb.HostTagsReq, opts ...grpc.CallOption) (*clientpb.Host, error)
+	HostSurvey(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*sliverpb.Survey, error)
+	// *** Playbooks ***
+	GetPlaybooks(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Playbooks, error)
+	SavePlaybook(ctx context.Context, in *clientpb.Playbook, opts ...grpc.CallOption) (*clientpb.Playbook, error)
+	RemovePlaybook(ctx context.Context, in *clientpb.Playbook, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	RunPlaybook(ctx context.Context, in *clientpb.PlaybookRunReq, opts ...grpc.CallOption) (*clientpb.PlaybookRuns, error)
+	GetPlaybookRuns(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.PlaybookRuns, error)
+	ApprovePlaybookStep(ctx context.Context, in *clientpb.PlaybookApprovalReq, opts ...grpc.CallOption) (*clientpb.PlaybookRun, error)
+	// *** Persistence ***
+	PersistenceAdd(ctx context.Context, in *clientpb.Persistence, opts ...grpc.CallOption) (*clientpb.Persistence, error)
+	PersistenceRm(ctx context.Context, in *clientpb.Persistence, opts ...grpc.CallOption) (*commonpb.Empty, error)
+	PersistenceAll(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllPersistence, error)
+	// *** Engagement ***
+	GetEngagement(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Engagement, error)
+	SetEngagement(ctx context.Context, in *clientpb.Engagement, opts ...grpc.CallOption) (*clientpb.Engagement, error)
 	// *** Implants ***
 	Generate(ctx context.Context, in *clientpb.GenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error)
 	GenerateExternal(ctx context.Context, in *clientpb.ExternalGenerateReq, opts ...grpc.CallOption) (*clientpb.ExternalImplantConfig, error)
@@ -91,6 +123,10 @@ type SliverRPCClient interface {
 	GetCompiler(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Compiler, error)
 	ShellcodeEncoder(ctx context.Context, in *clientpb.ShellcodeEncodeReq, opts ...grpc.CallOption) (*clientpb.ShellcodeEncode, error)
 	ShellcodeEncoderMap(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ShellcodeEncoderMap, error)
+	ShellcodeArtifactConvert(ctx context.Context, in *clientpb.ShellcodeArtifactReq, opts ...grpc.CallOption) (*clientpb.ShellcodeArtifact, error)
+	ShellcodeArtifacts(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ShellcodeArtifacts, error)
+	ShellcodeArtifactContent(ctx context.Context, in *clientpb.ShellcodeArtifact, opts ...grpc.CallOption) (*clientpb.ShellcodeArtifact, error)
+	ShellcodeArtifactRm(ctx context.Context, in *clientpb.ShellcodeArtifact, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	// *** Websites ***
 	Websites(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Websites, error)
 	Website(ctx context.Context, in *clientpb.Website, opts ...grpc.CallOption) (*clientpb.Website, error)
@@ -105,6 +141,7 @@ type SliverRPCClient interface {
 	Ifconfig(ctx context.Context, in *sliverpb.IfconfigReq, opts ...grpc.CallOption) (*sliverpb.Ifconfig, error)
 	Netstat(ctx context.Context, in *sliverpb.NetstatReq, opts ...grpc.CallOption) (*sliverpb.Netstat, error)
 	Ls(ctx context.Context, in *sliverpb.LsReq, opts ...grpc.CallOption) (*sliverpb.Ls, error)
+	Search(ctx context.Context, in *sliverpb.SearchReq, opts ...grpc.CallOption) (*sliverpb.Search, error)
 	Cd(ctx context.Context, in *sliverpb.CdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error)
 	Pwd(ctx context.Context, in *sliverpb.PwdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error)
 	Mv(ctx context.Context, in *sliverpb.MvReq, opts ...grpc.CallOption) (*sliverpb.Mv, error)
@@ -112,6 +149,8 @@ type SliverRPCClient interface {
 	Mkdir(ctx context.Context, in *sliverpb.MkdirReq, opts ...grpc.CallOption) (*sliverpb.Mkdir, error)
 	Download(ctx context.Context, in *sliverpb.DownloadReq, opts ...grpc.CallOption) (*sliverpb.Download, error)
 	Upload(ctx context.Context, in *sliverpb.UploadReq, opts ...grpc.CallOption) (*sliverpb.Upload, error)
+	GetMissingUploadChunks(ctx context.Context, in *clientpb.UploadChunks, opts ...grpc.CallOption) (*clientpb.UploadChunks, error)
+	SaveUploadChunk(ctx context.Context, in *clientpb.UploadChunk, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	Chmod(ctx context.Context, in *sliverpb.ChmodReq, opts ...grpc.CallOption) (*sliverpb.Chmod, error)
 	Chown(ctx context.Context, in *sliverpb.ChownReq, opts ...grpc.CallOption) (*sliverpb.Chown, error)
 	Chtimes(ctx context.Context, in *sliverpb.ChtimesReq, opts ...grpc.CallOption) (*sliverpb.Chtimes, error)
@@ -119,6 +158,7 @@ type SliverRPCClient interface {
 	MemfilesAdd(ctx context.Context, in *sliverpb.MemfilesAddReq, opts ...grpc.CallOption) (*sliverpb.MemfilesAdd, error)
 	MemfilesRm(ctx context.Context, in *sliverpb.MemfilesRmReq, opts ...grpc.CallOption) (*sliverpb.MemfilesRm, error)
 	ProcessDump(ctx context.Context, in *sliverpb.ProcessDumpReq, opts ...grpc.CallOption) (*sliverpb.ProcessDump, error)
+	LsassDump(ctx context.Context, in *sliverpb.LsassDumpReq, opts ...grpc.CallOption) (*sliverpb.LsassDump, error)
 	RunAs(ctx context.Context, in *sliverpb.RunAsReq, opts ...grpc.CallOption) (*sliverpb.RunAs, error)
 	Impersonate(ctx context.Context, in *sliverpb.ImpersonateReq, opts ...grpc.CallOption) (*sliverpb.Impersonate, error)
 	RevToSelf(ctx context.Context, in *sliverpb.RevToSelfReq, opts ...grpc.CallOption) (*sliverpb.RevToSelf, error)
@@ -131,18 +171,27 @@ type SliverRPCClient interface {
 	Execute(ctx context.Context, in *sliverpb.ExecuteReq, opts ...grpc.CallOption) (*sliverpb.Execute, error)
 	ExecuteWindows(ctx context.Context, in *sliverpb.ExecuteWindowsReq, opts ...grpc.CallOption) (*sliverpb.Execute, error)
 	Sideload(ctx context.Context, in *sliverpb.SideloadReq, opts ...grpc.CallOption) (*sliverpb.Sideload, error)
+	InlineExecute(ctx context.Context, in *sliverpb.InlineExecuteReq, opts ...grpc.CallOption) (*sliverpb.InlineExecute, error)
 	SpawnDll(ctx context.Context, in *sliverpb.InvokeSpawnDllReq, opts ...grpc.CallOption) (*sliverpb.SpawnDll, error)
 	Screenshot(ctx context.Context, in *sliverpb.ScreenshotReq, opts ...grpc.CallOption) (*sliverpb.Screenshot, error)
+	Clipboard(ctx context.Context, in *sliverpb.ClipboardReq, opts ...grpc.CallOption) (*sliverpb.Clipboard, error)
 	CurrentTokenOwner(ctx context.Context, in *sliverpb.CurrentTokenOwnerReq, opts ...grpc.CallOption) (*sliverpb.CurrentTokenOwner, error)
 	// *** Pivots ***
 	PivotStartListener(ctx context.Context, in *sliverpb.PivotStartListenerReq, opts ...grpc.CallOption) (*sliverpb.PivotListener, error)
 	PivotStopListener(ctx context.Context, in *sliverpb.PivotStopListenerReq, opts ...grpc.CallOption) (*commonpb.Empty, error)
 	PivotSessionListeners(ctx context.Context, in *sliverpb.PivotListenersReq, opts ...grpc.CallOption) (*sliverpb.PivotListeners, error)
+	PivotAllowPeers(ctx context.Context, in *sliverpb.PivotAllowPeersReq, opts ...grpc.CallOption) (*sliverpb.PivotListener, error)
 	PivotGraph(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.PivotGraph, error)
 	StartService(ctx context.Context, in *sliverpb.StartServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error)
 	StopService(ctx context.Context, in *sliverpb.StopServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error)
 	RemoveService(ctx context.Context, in *sliverpb.RemoveServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceInfo, error)
+	Services(ctx context.Context, in *sliverpb.ServicesReq, opts ...grpc.CallOption) (*sliverpb.Services, error)
+	ServiceStart(ctx context.Context, in *sliverpb.ServiceStartReq, opts ...grpc.CallOption) (*sliverpb.ServiceDetail, error)
+	CreateService(ctx context.Context, in *sliverpb.CreateServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceDetail, error)
+	ServiceConfig(ctx context.Context, in *sliverpb.ServiceConfigReq, opts ...grpc.CallOption) (*sliverpb.ServiceDetail, error)
 	MakeToken(ctx context.Context, in *sliverpb.MakeTokenReq, opts ...grpc.CallOption) (*sliverpb.MakeToken, error)
+	StealToken(ctx context.Context, in *sliverpb.StealTokenReq, opts ...grpc.CallOption) (*sliverpb.StealToken, error)
+	Tokens(ctx context.Context, in *sliverpb.TokensReq, opts ...grpc.CallOption) (*sliverpb.Tokens, error)
 	GetEnv(ctx context.Context, in *sliverpb.EnvReq, opts ...grpc.CallOption) (*sliverpb.EnvInfo, error)
 	SetEnv(ctx context.Context, in *sliverpb.SetEnvReq, opts ...grpc.CallOption) (*sliverpb.SetEnv, error)
 	UnsetEnv(ctx context.Context, in *sliverpb.UnsetEnvReq, opts ...grpc.CallOption) (*sliverpb.UnsetEnv, error)
@@ -153,12 +202,30 @@ type SliverRPCClient interface {
 	RegistryDeleteKey(ctx context.Context, in *sliverpb.RegistryDeleteKeyReq, opts ...grpc.CallOption) (*sliverpb.RegistryDeleteKey, error)
 	RegistryListSubKeys(ctx context.Context, in *sliverpb.RegistrySubKeyListReq, opts ...grpc.CallOption) (*sliverpb.RegistrySubKeyList, error)
 	RegistryListValues(ctx context.Context, in *sliverpb.RegistryListValuesReq, opts ...grpc.CallOption) (*sliverpb.RegistryValuesList, error)
+	RegistryExport(ctx context.Context, in *sliverpb.RegistryExportReq, opts ...grpc.CallOption) (*sliverpb.RegistryExport, error)
+	RegistrySearch(ctx context.Context, in *sliverpb.RegistrySearchReq, opts ...grpc.CallOption) (*sliverpb.RegistrySearch, error)
+	TCC(ctx context.Context, in *sliverpb.TCCReq, opts ...grpc.CallOption) (*sliverpb.TCC, error)
+	Keychain(ctx context.Context, in *sliverpb.KeychainReq, opts ...grpc.CallOption) (*sliverpb.Keychain, error)
+	Launchd(ctx context.Context, in *sliverpb.LaunchdReq, opts ...grpc.CallOption) (*sliverpb.Launchd, error)
+	ConfigProfiles(ctx context.Context, in *sliverpb.ConfigProfilesReq, opts ...grpc.CallOption) (*sliverpb.ConfigProfiles, error)
 	RunSSHCommand(ctx context.Context, in *sliverpb.SSHCommandReq, opts ...grpc.CallOption) (*sliverpb.SSHCommand, error)
+	SSHHarvest(ctx context.Context, in *sliverpb.SSHHarvestReq, opts ...grpc.CallOption) (*sliverpb.SSHHarvest, error)
 	HijackDLL(ctx context.Context, in *clientpb.DllHijackReq, opts ...grpc.CallOption) (*clientpb.DllHijack, error)
 	GetPrivs(ctx context.Context, in *sliverpb.GetPrivsReq, opts ...grpc.CallOption) (*sliverpb.GetPrivs, error)
+	LogonSessions(ctx context.Context, in *sliverpb.LogonSessionsReq, opts ...grpc.CallOption) (*sliverpb.LogonSessions, error)
+	LocalGroups(ctx context.Context, in *sliverpb.LocalGroupsReq, opts ...grpc.CallOption) (*sliverpb.LocalGroups, error)
+	ServiceHijacks(ctx context.Context, in *sliverpb.ServiceHijacksReq, opts ...grpc.CallOption) (*sliverpb.ServiceHijacks, error)
+	AdcsEnum(ctx context.Context, in *sliverpb.AdcsEnumReq, opts ...grpc.CallOption) (*sliverpb.AdcsEnum, error)
+	AdcsRequest(ctx context.Context, in *sliverpb.AdcsRequestReq, opts ...grpc.CallOption) (*sliverpb.AdcsRequest, error)
+	ValidateCreds(ctx context.Context, in *sliverpb.ValidateCredsReq, opts ...grpc.CallOption) (*sliverpb.ValidateCreds, error)
+	Presence(ctx context.Context, in *sliverpb.PresenceReq, opts ...grpc.CallOption) (*sliverpb.Presence, error)
+	Survey(ctx context.Context, in *sliverpb.SurveyReq, opts ...grpc.CallOption) (*sliverpb.Survey, error)
 	StartRportFwdListener(ctx context.Context, in *sliverpb.RportFwdStartListenerReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListener, error)
 	GetRportFwdListeners(ctx context.Context, in *sliverpb.RportFwdListenersReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListeners, error)
 	StopRportFwdListener(ctx context.Context, in *sliverpb.RportFwdStopListenerReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListener, error)
+	GetNTLMListeners(ctx context.Context, in *sliverpb.NTLMListenersReq, opts ...grpc.CallOption) (*sliverpb.NTLMListeners, error)
+	StartNTLMListener(ctx context.Context, in *sliverpb.NTLMStartListenerReq, opts ...grpc.CallOption) (*sliverpb.NTLMListener, error)
+	StopNTLMListener(ctx context.Context, in *sliverpb.NTLMStopListenerReq, opts ...grpc.CallOption) (*sliverpb.NTLMListener, error)
 	// Beacon only commands
 	OpenSession(ctx context.Context, in *sliverpb.OpenSession, opts ...grpc.CallOption) (*sliverpb.OpenSession, error)
 	CloseSession(ctx context.Context, in *sliverpb.CloseSession, opts ...grpc.CallOption) (*commonpb.Empty, error)
@@ -173,6 +240,7 @@ type SliverRPCClient interface {
 	WGStopSocks(ctx context.Context, in *sliverpb.WGSocksStopReq, opts ...grpc.CallOption) (*sliverpb.WGSocks, error)
 	WGListForwarders(ctx context.Context, in *sliverpb.WGTCPForwardersReq, opts ...grpc.CallOption) (*sliverpb.WGTCPForwarders, error)
 	WGListSocksServers(ctx context.Context, in *sliverpb.WGSocksServersReq, opts ...grpc.CallOption) (*sliverpb.WGSocksServers, error)
+	WGRotateKeys(ctx context.Context, in *sliverpb.WGRotateKeysReq, opts ...grpc.CallOption) (*sliverpb.WGRotateKeys, error)
 	// *** Realtime Commands ***
 	Shell(ctx context.Context, in *sliverpb.ShellReq, opts ...grpc.CallOption) (*sliverpb.Shell, error)
 	Portfwd(ctx context.Context, in *sliverpb.PortfwdReq, opts ...grpc.CallOption) (*sliverpb.Portfwd, error)
@@ -214,6 +282,24 @@ func (c *sliverRPCClient) GetOperators(ctx context.Context, in *commonpb.Empty,
 	return out, nil
 }
 
+func (c *sliverRPCClient) GetSecondFactorChallenge(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.SecondFactorChallenge, error) {
+	out := new(clientpb.SecondFactorChallenge)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetSecondFactorChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) VerifySecondFactor(ctx context.Context, in *clientpb.SecondFactorReq, opts ...grpc.CallOption) (*clientpb.SecondFactor, error) {
+	out := new(clientpb.SecondFactor)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/VerifySecondFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Kill(ctx context.Context, in *sliverpb.KillReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Kill", in, out, opts...)
@@ -232,6 +318,24 @@ func (c *sliverRPCClient) Reconfigure(ctx context.Context, in *sliverpb.Reconfig
 	return out, nil
 }
 
+func (c *sliverRPCClient) ProxySet(ctx context.Context, in *sliverpb.ProxySetReq, opts ...grpc.CallOption) (*sliverpb.ProxySet, error) {
+	out := new(sliverpb.ProxySet)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ProxySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Redirect(ctx context.Context, in *sliverpb.RedirectReq, opts ...grpc.CallOption) (*sliverpb.Redirect, error) {
+	out := new(sliverpb.Redirect)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Redirect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Rename(ctx context.Context, in *clientpb.RenameReq, opts ...grpc.CallOption) (*commonpb.Empty, error) {
 	out := new(commonpb.Empty)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Rename", in, out, opts...)
@@ -304,6 +408,15 @@ func (c *sliverRPCClient) CancelBeaconTask(ctx context.Context, in *clientpb.Bea
 	return out, nil
 }
 
+func (c *sliverRPCClient) ReorderBeaconTasks(ctx context.Context, in *clientpb.BeaconTaskReorderReq, opts ...grpc.CallOption) (*clientpb.BeaconTasks, error) {
+	out := new(clientpb.BeaconTasks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ReorderBeaconTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) MonitorStart(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*commonpb.Response, error) {
 	out := new(commonpb.Response)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MonitorStart", in, out, opts...)
@@ -340,6 +453,24 @@ func (c *sliverRPCClient) KillJob(ctx context.Context, in *clientpb.KillJobReq,
 	return out, nil
 }
 
+func (c *sliverRPCClient) GetListenerSettings(ctx context.Context, in *clientpb.ListenerSettingsReq, opts ...grpc.CallOption) (*clientpb.ListenerSettings, error) {
+	out := new(clientpb.ListenerSettings)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetListenerSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) UpdateListenerSettings(ctx context.Context, in *clientpb.ListenerSettings, opts ...grpc.CallOption) (*clientpb.ListenerSettings, error) {
+	out := new(clientpb.ListenerSettings)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/UpdateListenerSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) StartMTLSListener(ctx context.Context, in *clientpb.MTLSListenerReq, opts ...grpc.CallOption) (*clientpb.MTLSListener, error) {
 	out := new(clientpb.MTLSListener)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartMTLSListener", in, out, opts...)
@@ -385,6 +516,42 @@ func (c *sliverRPCClient) StartHTTPListener(ctx context.Context, in *clientpb.HT
 	return out, nil
 }
 
+func (c *sliverRPCClient) StartICMPListener(ctx context.Context, in *clientpb.ICMPListenerReq, opts ...grpc.CallOption) (*clientpb.ICMPListener, error) {
+	out := new(clientpb.ICMPListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartICMPListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) StartUDPListener(ctx context.Context, in *clientpb.UDPListenerReq, opts ...grpc.CallOption) (*clientpb.UDPListener, error) {
+	out := new(clientpb.UDPListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartUDPListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetHTTPC2Profiles(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.HTTPC2Profiles, error) {
+	out := new(clientpb.HTTPC2Profiles)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetHTTPC2Profiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) SaveHTTPC2Profile(ctx context.Context, in *clientpb.HTTPC2Profile, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SaveHTTPC2Profile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) StartTCPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error) {
 	out := new(clientpb.StagerListener)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartTCPStagerListener", in, out, opts...)
@@ -394,6 +561,15 @@ func (c *sliverRPCClient) StartTCPStagerListener(ctx context.Context, in *client
 	return out, nil
 }
 
+func (c *sliverRPCClient) StartShellUpgradeListener(ctx context.Context, in *clientpb.ShellUpgradeReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error) {
+	out := new(clientpb.StagerListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartShellUpgradeListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) StartHTTPStagerListener(ctx context.Context, in *clientpb.StagerListenerReq, opts ...grpc.CallOption) (*clientpb.StagerListener, error) {
 	out := new(clientpb.StagerListener)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartHTTPStagerListener", in, out, opts...)
@@ -403,6 +579,15 @@ func (c *sliverRPCClient) StartHTTPStagerListener(ctx context.Context, in *clien
 	return out, nil
 }
 
+func (c *sliverRPCClient) StartWarmup(ctx context.Context, in *clientpb.WarmupReq, opts ...grpc.CallOption) (*clientpb.Warmup, error) {
+	out := new(clientpb.Warmup)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartWarmup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) LootAdd(ctx context.Context, in *clientpb.Loot, opts ...grpc.CallOption) (*clientpb.Loot, error) {
 	out := new(clientpb.Loot)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LootAdd", in, out, opts...)
@@ -493,6 +678,132 @@ func (c *sliverRPCClient) HostIOCRm(ctx context.Context, in *clientpb.IOC, opts
 	return out, nil
 }
 
+func (c *sliverRPCClient) ParseOutput(ctx context.Context, in *clientpb.ParseOutputReq, opts ...grpc.CallOption) (*clientpb.ParsedOutput, error) {
+	out := new(clientpb.ParsedOutput)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ParseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) HostTags(ctx context.Context, in *clientpb.HostTagsReq, opts ...grpc.CallOption) (*clientpb.Host, error) {
+	out := new(clientpb.Host)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) HostSurvey(ctx context.Context, in *clientpb.Host, opts ...grpc.CallOption) (*sliverpb.Survey, error) {
+	out := new(sliverpb.Survey)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HostSurvey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetPlaybooks(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Playbooks, error) {
+	out := new(clientpb.Playbooks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetPlaybooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) SavePlaybook(ctx context.Context, in *clientpb.Playbook, opts ...grpc.CallOption) (*clientpb.Playbook, error) {
+	out := new(clientpb.Playbook)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SavePlaybook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RemovePlaybook(ctx context.Context, in *clientpb.Playbook, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RemovePlaybook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RunPlaybook(ctx context.Context, in *clientpb.PlaybookRunReq, opts ...grpc.CallOption) (*clientpb.PlaybookRuns, error) {
+	out := new(clientpb.PlaybookRuns)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RunPlaybook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetPlaybookRuns(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.PlaybookRuns, error) {
+	out := new(clientpb.PlaybookRuns)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetPlaybookRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ApprovePlaybookStep(ctx context.Context, in *clientpb.PlaybookApprovalReq, opts ...grpc.CallOption) (*clientpb.PlaybookRun, error) {
+	out := new(clientpb.PlaybookRun)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ApprovePlaybookStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) PersistenceAdd(ctx context.Context, in *clientpb.Persistence, opts ...grpc.CallOption) (*clientpb.Persistence, error) {
+	out := new(clientpb.Persistence)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PersistenceAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) PersistenceRm(ctx context.Context, in *clientpb.Persistence, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PersistenceRm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) PersistenceAll(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.AllPersistence, error) {
+	out := new(clientpb.AllPersistence)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PersistenceAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetEngagement(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Engagement, error) {
+	out := new(clientpb.Engagement)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetEngagement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) SetEngagement(ctx context.Context, in *clientpb.Engagement, opts ...grpc.CallOption) (*clientpb.Engagement, error) {
+	out := new(clientpb.Engagement)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SetEngagement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Generate(ctx context.Context, in *clientpb.GenerateReq, opts ...grpc.CallOption) (*clientpb.Generate, error) {
 	out := new(clientpb.Generate)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Generate", in, out, opts...)
@@ -705,6 +1016,42 @@ func (c *sliverRPCClient) ShellcodeEncoderMap(ctx context.Context, in *commonpb.
 	return out, nil
 }
 
+func (c *sliverRPCClient) ShellcodeArtifactConvert(ctx context.Context, in *clientpb.ShellcodeArtifactReq, opts ...grpc.CallOption) (*clientpb.ShellcodeArtifact, error) {
+	out := new(clientpb.ShellcodeArtifact)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeArtifactConvert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ShellcodeArtifacts(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.ShellcodeArtifacts, error) {
+	out := new(clientpb.ShellcodeArtifacts)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ShellcodeArtifactContent(ctx context.Context, in *clientpb.ShellcodeArtifact, opts ...grpc.CallOption) (*clientpb.ShellcodeArtifact, error) {
+	out := new(clientpb.ShellcodeArtifact)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeArtifactContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ShellcodeArtifactRm(ctx context.Context, in *clientpb.ShellcodeArtifact, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ShellcodeArtifactRm", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Websites(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.Websites, error) {
 	out := new(clientpb.Websites)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Websites", in, out, opts...)
@@ -813,6 +1160,15 @@ func (c *sliverRPCClient) Ls(ctx context.Context, in *sliverpb.LsReq, opts ...gr
 	return out, nil
 }
 
+func (c *sliverRPCClient) Search(ctx context.Context, in *sliverpb.SearchReq, opts ...grpc.CallOption) (*sliverpb.Search, error) {
+	out := new(sliverpb.Search)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Search", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Cd(ctx context.Context, in *sliverpb.CdReq, opts ...grpc.CallOption) (*sliverpb.Pwd, error) {
 	out := new(sliverpb.Pwd)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Cd", in, out, opts...)
@@ -876,6 +1232,24 @@ func (c *sliverRPCClient) Upload(ctx context.Context, in *sliverpb.UploadReq, op
 	return out, nil
 }
 
+func (c *sliverRPCClient) GetMissingUploadChunks(ctx context.Context, in *clientpb.UploadChunks, opts ...grpc.CallOption) (*clientpb.UploadChunks, error) {
+	out := new(clientpb.UploadChunks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetMissingUploadChunks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) SaveUploadChunk(ctx context.Context, in *clientpb.UploadChunk, opts ...grpc.CallOption) (*commonpb.Empty, error) {
+	out := new(commonpb.Empty)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SaveUploadChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Chmod(ctx context.Context, in *sliverpb.ChmodReq, opts ...grpc.CallOption) (*sliverpb.Chmod, error) {
 	out := new(sliverpb.Chmod)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Chmod", in, out, opts...)
@@ -939,6 +1313,15 @@ func (c *sliverRPCClient) ProcessDump(ctx context.Context, in *sliverpb.ProcessD
 	return out, nil
 }
 
+func (c *sliverRPCClient) LsassDump(ctx context.Context, in *sliverpb.LsassDumpReq, opts ...grpc.CallOption) (*sliverpb.LsassDump, error) {
+	out := new(sliverpb.LsassDump)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LsassDump", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) RunAs(ctx context.Context, in *sliverpb.RunAsReq, opts ...grpc.CallOption) (*sliverpb.RunAs, error) {
 	out := new(sliverpb.RunAs)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RunAs", in, out, opts...)
@@ -1047,6 +1430,15 @@ func (c *sliverRPCClient) Sideload(ctx context.Context, in *sliverpb.SideloadReq
 	return out, nil
 }
 
+func (c *sliverRPCClient) InlineExecute(ctx context.Context, in *sliverpb.InlineExecuteReq, opts ...grpc.CallOption) (*sliverpb.InlineExecute, error) {
+	out := new(sliverpb.InlineExecute)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/InlineExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) SpawnDll(ctx context.Context, in *sliverpb.InvokeSpawnDllReq, opts ...grpc.CallOption) (*sliverpb.SpawnDll, error) {
 	out := new(sliverpb.SpawnDll)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SpawnDll", in, out, opts...)
@@ -1065,6 +1457,15 @@ func (c *sliverRPCClient) Screenshot(ctx context.Context, in *sliverpb.Screensho
 	return out, nil
 }
 
+func (c *sliverRPCClient) Clipboard(ctx context.Context, in *sliverpb.ClipboardReq, opts ...grpc.CallOption) (*sliverpb.Clipboard, error) {
+	out := new(sliverpb.Clipboard)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Clipboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) CurrentTokenOwner(ctx context.Context, in *sliverpb.CurrentTokenOwnerReq, opts ...grpc.CallOption) (*sliverpb.CurrentTokenOwner, error) {
 	out := new(sliverpb.CurrentTokenOwner)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CurrentTokenOwner", in, out, opts...)
@@ -1101,6 +1502,15 @@ func (c *sliverRPCClient) PivotSessionListeners(ctx context.Context, in *sliverp
 	return out, nil
 }
 
+func (c *sliverRPCClient) PivotAllowPeers(ctx context.Context, in *sliverpb.PivotAllowPeersReq, opts ...grpc.CallOption) (*sliverpb.PivotListener, error) {
+	out := new(sliverpb.PivotListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotAllowPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) PivotGraph(ctx context.Context, in *commonpb.Empty, opts ...grpc.CallOption) (*clientpb.PivotGraph, error) {
 	out := new(clientpb.PivotGraph)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/PivotGraph", in, out, opts...)
@@ -1137,52 +1547,106 @@ func (c *sliverRPCClient) RemoveService(ctx context.Context, in *sliverpb.Remove
 	return out, nil
 }
 
-func (c *sliverRPCClient) MakeToken(ctx context.Context, in *sliverpb.MakeTokenReq, opts ...grpc.CallOption) (*sliverpb.MakeToken, error) {
-	out := new(sliverpb.MakeToken)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MakeToken", in, out, opts...)
+func (c *sliverRPCClient) Services(ctx context.Context, in *sliverpb.ServicesReq, opts ...grpc.CallOption) (*sliverpb.Services, error) {
+	out := new(sliverpb.Services)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Services", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) GetEnv(ctx context.Context, in *sliverpb.EnvReq, opts ...grpc.CallOption) (*sliverpb.EnvInfo, error) {
-	out := new(sliverpb.EnvInfo)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetEnv", in, out, opts...)
+func (c *sliverRPCClient) ServiceStart(ctx context.Context, in *sliverpb.ServiceStartReq, opts ...grpc.CallOption) (*sliverpb.ServiceDetail, error) {
+	out := new(sliverpb.ServiceDetail)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ServiceStart", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) SetEnv(ctx context.Context, in *sliverpb.SetEnvReq, opts ...grpc.CallOption) (*sliverpb.SetEnv, error) {
-	out := new(sliverpb.SetEnv)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SetEnv", in, out, opts...)
+func (c *sliverRPCClient) CreateService(ctx context.Context, in *sliverpb.CreateServiceReq, opts ...grpc.CallOption) (*sliverpb.ServiceDetail, error) {
+	out := new(sliverpb.ServiceDetail)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/CreateService", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) UnsetEnv(ctx context.Context, in *sliverpb.UnsetEnvReq, opts ...grpc.CallOption) (*sliverpb.UnsetEnv, error) {
-	out := new(sliverpb.UnsetEnv)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/UnsetEnv", in, out, opts...)
+func (c *sliverRPCClient) ServiceConfig(ctx context.Context, in *sliverpb.ServiceConfigReq, opts ...grpc.CallOption) (*sliverpb.ServiceDetail, error) {
+	out := new(sliverpb.ServiceDetail)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ServiceConfig", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) Backdoor(ctx context.Context, in *sliverpb.BackdoorReq, opts ...grpc.CallOption) (*sliverpb.Backdoor, error) {
-	out := new(sliverpb.Backdoor)
-	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Backdoor", in, out, opts...)
+func (c *sliverRPCClient) MakeToken(ctx context.Context, in *sliverpb.MakeTokenReq, opts ...grpc.CallOption) (*sliverpb.MakeToken, error) {
+	out := new(sliverpb.MakeToken)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/MakeToken", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *sliverRPCClient) RegistryRead(ctx context.Context, in *sliverpb.RegistryReadReq, opts ...grpc.CallOption) (*sliverpb.RegistryRead, error) {
+func (c *sliverRPCClient) StealToken(ctx context.Context, in *sliverpb.StealTokenReq, opts ...grpc.CallOption) (*sliverpb.StealToken, error) {
+	out := new(sliverpb.StealToken)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StealToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Tokens(ctx context.Context, in *sliverpb.TokensReq, opts ...grpc.CallOption) (*sliverpb.Tokens, error) {
+	out := new(sliverpb.Tokens)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Tokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) GetEnv(ctx context.Context, in *sliverpb.EnvReq, opts ...grpc.CallOption) (*sliverpb.EnvInfo, error) {
+	out := new(sliverpb.EnvInfo)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) SetEnv(ctx context.Context, in *sliverpb.SetEnvReq, opts ...grpc.CallOption) (*sliverpb.SetEnv, error) {
+	out := new(sliverpb.SetEnv)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SetEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) UnsetEnv(ctx context.Context, in *sliverpb.UnsetEnvReq, opts ...grpc.CallOption) (*sliverpb.UnsetEnv, error) {
+	out := new(sliverpb.UnsetEnv)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/UnsetEnv", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Backdoor(ctx context.Context, in *sliverpb.BackdoorReq, opts ...grpc.CallOption) (*sliverpb.Backdoor, error) {
+	out := new(sliverpb.Backdoor)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Backdoor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistryRead(ctx context.Context, in *sliverpb.RegistryReadReq, opts ...grpc.CallOption) (*sliverpb.RegistryRead, error) {
 	out := new(sliverpb.RegistryRead)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryRead", in, out, opts...)
 	if err != nil {
@@ -1236,6 +1700,60 @@ func (c *sliverRPCClient) RegistryListValues(ctx context.Context, in *sliverpb.R
 	return out, nil
 }
 
+func (c *sliverRPCClient) RegistryExport(ctx context.Context, in *sliverpb.RegistryExportReq, opts ...grpc.CallOption) (*sliverpb.RegistryExport, error) {
+	out := new(sliverpb.RegistryExport)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistryExport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) RegistrySearch(ctx context.Context, in *sliverpb.RegistrySearchReq, opts ...grpc.CallOption) (*sliverpb.RegistrySearch, error) {
+	out := new(sliverpb.RegistrySearch)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RegistrySearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) TCC(ctx context.Context, in *sliverpb.TCCReq, opts ...grpc.CallOption) (*sliverpb.TCC, error) {
+	out := new(sliverpb.TCC)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/TCC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Keychain(ctx context.Context, in *sliverpb.KeychainReq, opts ...grpc.CallOption) (*sliverpb.Keychain, error) {
+	out := new(sliverpb.Keychain)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Keychain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Launchd(ctx context.Context, in *sliverpb.LaunchdReq, opts ...grpc.CallOption) (*sliverpb.Launchd, error) {
+	out := new(sliverpb.Launchd)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Launchd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ConfigProfiles(ctx context.Context, in *sliverpb.ConfigProfilesReq, opts ...grpc.CallOption) (*sliverpb.ConfigProfiles, error) {
+	out := new(sliverpb.ConfigProfiles)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ConfigProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) RunSSHCommand(ctx context.Context, in *sliverpb.SSHCommandReq, opts ...grpc.CallOption) (*sliverpb.SSHCommand, error) {
 	out := new(sliverpb.SSHCommand)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/RunSSHCommand", in, out, opts...)
@@ -1245,6 +1763,15 @@ func (c *sliverRPCClient) RunSSHCommand(ctx context.Context, in *sliverpb.SSHCom
 	return out, nil
 }
 
+func (c *sliverRPCClient) SSHHarvest(ctx context.Context, in *sliverpb.SSHHarvestReq, opts ...grpc.CallOption) (*sliverpb.SSHHarvest, error) {
+	out := new(sliverpb.SSHHarvest)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/SSHHarvest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) HijackDLL(ctx context.Context, in *clientpb.DllHijackReq, opts ...grpc.CallOption) (*clientpb.DllHijack, error) {
 	out := new(clientpb.DllHijack)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/HijackDLL", in, out, opts...)
@@ -1263,6 +1790,78 @@ func (c *sliverRPCClient) GetPrivs(ctx context.Context, in *sliverpb.GetPrivsReq
 	return out, nil
 }
 
+func (c *sliverRPCClient) LogonSessions(ctx context.Context, in *sliverpb.LogonSessionsReq, opts ...grpc.CallOption) (*sliverpb.LogonSessions, error) {
+	out := new(sliverpb.LogonSessions)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LogonSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) LocalGroups(ctx context.Context, in *sliverpb.LocalGroupsReq, opts ...grpc.CallOption) (*sliverpb.LocalGroups, error) {
+	out := new(sliverpb.LocalGroups)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/LocalGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ServiceHijacks(ctx context.Context, in *sliverpb.ServiceHijacksReq, opts ...grpc.CallOption) (*sliverpb.ServiceHijacks, error) {
+	out := new(sliverpb.ServiceHijacks)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ServiceHijacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) AdcsEnum(ctx context.Context, in *sliverpb.AdcsEnumReq, opts ...grpc.CallOption) (*sliverpb.AdcsEnum, error) {
+	out := new(sliverpb.AdcsEnum)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/AdcsEnum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) AdcsRequest(ctx context.Context, in *sliverpb.AdcsRequestReq, opts ...grpc.CallOption) (*sliverpb.AdcsRequest, error) {
+	out := new(sliverpb.AdcsRequest)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/AdcsRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) ValidateCreds(ctx context.Context, in *sliverpb.ValidateCredsReq, opts ...grpc.CallOption) (*sliverpb.ValidateCreds, error) {
+	out := new(sliverpb.ValidateCreds)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/ValidateCreds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Presence(ctx context.Context, in *sliverpb.PresenceReq, opts ...grpc.CallOption) (*sliverpb.Presence, error) {
+	out := new(sliverpb.Presence)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Presence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) Survey(ctx context.Context, in *sliverpb.SurveyReq, opts ...grpc.CallOption) (*sliverpb.Survey, error) {
+	out := new(sliverpb.Survey)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Survey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) StartRportFwdListener(ctx context.Context, in *sliverpb.RportFwdStartListenerReq, opts ...grpc.CallOption) (*sliverpb.RportFwdListener, error) {
 	out := new(sliverpb.RportFwdListener)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartRportFwdListener", in, out, opts...)
@@ -1290,6 +1889,33 @@ func (c *sliverRPCClient) StopRportFwdListener(ctx context.Context, in *sliverpb
 	return out, nil
 }
 
+func (c *sliverRPCClient) GetNTLMListeners(ctx context.Context, in *sliverpb.NTLMListenersReq, opts ...grpc.CallOption) (*sliverpb.NTLMListeners, error) {
+	out := new(sliverpb.NTLMListeners)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/GetNTLMListeners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) StartNTLMListener(ctx context.Context, in *sliverpb.NTLMStartListenerReq, opts ...grpc.CallOption) (*sliverpb.NTLMListener, error) {
+	out := new(sliverpb.NTLMListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StartNTLMListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sliverRPCClient) StopNTLMListener(ctx context.Context, in *sliverpb.NTLMStopListenerReq, opts ...grpc.CallOption) (*sliverpb.NTLMListener, error) {
+	out := new(sliverpb.NTLMListener)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/StopNTLMListener", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) OpenSession(ctx context.Context, in *sliverpb.OpenSession, opts ...grpc.CallOption) (*sliverpb.OpenSession, error) {
 	out := new(sliverpb.OpenSession)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/OpenSession", in, out, opts...)
@@ -1389,6 +2015,15 @@ func (c *sliverRPCClient) WGListSocksServers(ctx context.Context, in *sliverpb.W
 	return out, nil
 }
 
+func (c *sliverRPCClient) WGRotateKeys(ctx context.Context, in *sliverpb.WGRotateKeysReq, opts ...grpc.CallOption) (*sliverpb.WGRotateKeys, error) {
+	out := new(sliverpb.WGRotateKeys)
+	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/WGRotateKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sliverRPCClient) Shell(ctx context.Context, in *sliverpb.ShellReq, opts ...grpc.CallOption) (*sliverpb.Shell, error) {
 	out := new(sliverpb.Shell)
 	err := c.cc.Invoke(ctx, "/rpcpb.SliverRPC/Shell", in, out, opts...)
@@ -1545,9 +2180,13 @@ type SliverRPCServer interface {
 	GetVersion(context.Context, *commonpb.Empty) (*clientpb.Version, error)
 	// *** Operator Commands ***
 	GetOperators(context.Context, *commonpb.Empty) (*clientpb.Operators, error)
+	GetSecondFactorChallenge(context.Context, *commonpb.Empty) (*clientpb.SecondFactorChallenge, error)
+	VerifySecondFactor(context.Context, *clientpb.SecondFactorReq) (*clientpb.SecondFactor, error)
 	// *** Generic ***
 	Kill(context.Context, *sliverpb.KillReq) (*commonpb.Empty, error)
 	Reconfigure(context.Context, *sliverpb.ReconfigureReq) (*sliverpb.Reconfigure, error)
+	ProxySet(context.Context, *sliverpb.ProxySetReq) (*sliverpb.ProxySet, error)
+	Redirect(context.Context, *sliverpb.RedirectReq) (*sliverpb.Redirect, error)
 	Rename(context.Context, *clientpb.RenameReq) (*commonpb.Empty, error)
 	// *** Sessions ***
 	GetSessions(context.Context, *commonpb.Empty) (*clientpb.Sessions, error)
@@ -1558,21 +2197,32 @@ type SliverRPCServer interface {
 	GetBeaconTasks(context.Context, *clientpb.Beacon) (*clientpb.BeaconTasks, error)
 	GetBeaconTaskContent(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error)
 	CancelBeaconTask(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error)
+	ReorderBeaconTasks(context.Context, *clientpb.BeaconTaskReorderReq) (*clientpb.BeaconTasks, error)
 	// ***Threat monitoring ***
 	MonitorStart(context.Context, *commonpb.Empty) (*commonpb.Response, error)
 	MonitorStop(context.Context, *commonpb.Empty) (*commonpb.Empty, error)
 	// *** Jobs ***
 	GetJobs(context.Context, *commonpb.Empty) (*clientpb.Jobs, error)
 	KillJob(context.Context, *clientpb.KillJobReq) (*clientpb.KillJob, error)
+	GetListenerSettings(context.Context, *clientpb.ListenerSettingsReq) (*clientpb.ListenerSettings, error)
+	UpdateListenerSettings(context.Context, *clientpb.ListenerSettings) (*clientpb.ListenerSettings, error)
 	// *** Listeners ***
 	StartMTLSListener(context.Context, *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error)
 	StartWGListener(context.Context, *clientpb.WGListenerReq) (*clientpb.WGListener, error)
 	StartDNSListener(context.Context, *clientpb.DNSListenerReq) (*clientpb.DNSListener, error)
 	StartHTTPSListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error)
 	StartHTTPListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error)
+	StartICMPListener(context.Context, *clientpb.ICMPListenerReq) (*clientpb.ICMPListener, error)
+	StartUDPListener(context.Context, *clientpb.UDPListenerReq) (*clientpb.UDPListener, error)
+	// *** HTTP C2 Profiles ***
+	GetHTTPC2Profiles(context.Context, *commonpb.Empty) (*clientpb.HTTPC2Profiles, error)
+	SaveHTTPC2Profile(context.Context, *clientpb.HTTPC2Profile) (*commonpb.Empty, error)
 	// *** Stager Listener ***
 	StartTCPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error)
+	StartShellUpgradeListener(context.Context, *clientpb.ShellUpgradeReq) (*clientpb.StagerListener, error)
 	StartHTTPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error)
+	// *** Infrastructure Warmup ***
+	StartWarmup(context.Context, *clientpb.WarmupReq) (*clientpb.Warmup, error)
 	// *** Loot ***
 	LootAdd(context.Context, *clientpb.Loot) (*clientpb.Loot, error)
 	LootRm(context.Context, *clientpb.Loot) (*commonpb.Empty, error)
@@ -1585,6 +2235,23 @@ type SliverRPCServer interface {
 	Host(context.Context, *clientpb.Host) (*clientpb.Host, error)
 	HostRm(context.Context, *clientpb.Host) (*commonpb.Empty, error)
 	HostIOCRm(context.Context, *clientpb.IOC) (*commonpb.Empty, error)
+	ParseOutput(context.Context, *clientpb.ParseOutputReq) (*clientpb.ParsedOutput, error)
+	HostTags(context.Context, *clientpb.HostTagsReq) (*clientpb.Host, error)
+	HostSurvey(context.Context, *clientpb.Host) (*sliverpb.Survey, error)
+	// *** Playbooks ***
+	GetPlaybooks(context.Context, *commonpb.Empty) (*clientpb.Playbooks, error)
+	SavePlaybook(context.Context, *clientpb.Playbook) (*clientpb.Playbook, error)
+	RemovePlaybook(context.Context, *clientpb.Playbook) (*commonpb.Empty, error)
+	RunPlaybook(context.Context, *clientpb.PlaybookRunReq) (*clientpb.PlaybookRuns, error)
+	GetPlaybookRuns(context.Context, *commonpb.Empty) (*clientpb.PlaybookRuns, error)
+	ApprovePlaybookStep(context.Context, *clientpb.PlaybookApprovalReq) (*clientpb.PlaybookRun, error)
+	// *** Persistence ***
+	PersistenceAdd(context.Context, *clientpb.Persistence) (*clientpb.Persistence, error)
+	PersistenceRm(context.Context, *clientpb.Persistence) (*commonpb.Empty, error)
+	PersistenceAll(context.Context, *commonpb.Empty) (*clientpb.AllPersistence, error)
+	// *** Engagement ***
+	GetEngagement(context.Context, *commonpb.Empty) (*clientpb.Engagement, error)
+	SetEngagement(context.Context, *clientpb.Engagement) (*clientpb.Engagement, error)
 	// *** Implants ***
 	Generate(context.Context, *clientpb.GenerateReq) (*clientpb.Generate, error)
 	GenerateExternal(context.Context, *clientpb.ExternalGenerateReq) (*clientpb.ExternalImplantConfig, error)
@@ -1607,6 +2274,10 @@ type SliverRPCServer interface {
 	GetCompiler(context.Context, *commonpb.Empty) (*clientpb.Compiler, error)
 	ShellcodeEncoder(context.Context, *clientpb.ShellcodeEncodeReq) (*clientpb.ShellcodeEncode, error)
 	ShellcodeEncoderMap(context.Context, *commonpb.Empty) (*clientpb.ShellcodeEncoderMap, error)
+	ShellcodeArtifactConvert(context.Context, *clientpb.ShellcodeArtifactReq) (*clientpb.ShellcodeArtifact, error)
+	ShellcodeArtifacts(context.Context, *commonpb.Empty) (*clientpb.ShellcodeArtifacts, error)
+	ShellcodeArtifactContent(context.Context, *clientpb.ShellcodeArtifact) (*clientpb.ShellcodeArtifact, error)
+	ShellcodeArtifactRm(context.Context, *clientpb.ShellcodeArtifact) (*commonpb.Empty, error)
 	// *** Websites ***
 	Websites(context.Context, *commonpb.Empty) (*clientpb.Websites, error)
 	Website(context.Context, *clientpb.Website) (*clientpb.Website, error)
@@ -1621,6 +2292,7 @@ type SliverRPCServer interface {
 	Ifconfig(context.Context, *sliverpb.IfconfigReq) (*sliverpb.Ifconfig, error)
 	Netstat(context.Context, *sliverpb.NetstatReq) (*sliverpb.Netstat, error)
 	Ls(context.Context, *sliverpb.LsReq) (*sliverpb.Ls, error)
+	Search(context.Context, *sliverpb.SearchReq) (*sliverpb.Search, error)
 	Cd(context.Context, *sliverpb.CdReq) (*sliverpb.Pwd, error)
 	Pwd(context.Context, *sliverpb.PwdReq) (*sliverpb.Pwd, error)
 	Mv(context.Context, *sliverpb.MvReq) (*sliverpb.Mv, error)
@@ -1628,6 +2300,8 @@ type SliverRPCServer interface {
 	Mkdir(context.Context, *sliverpb.MkdirReq) (*sliverpb.Mkdir, error)
 	Download(context.Context, *sliverpb.DownloadReq) (*sliverpb.Download, error)
 	Upload(context.Context, *sliverpb.UploadReq) (*sliverpb.Upload, error)
+	GetMissingUploadChunks(context.Context, *clientpb.UploadChunks) (*clientpb.UploadChunks, error)
+	SaveUploadChunk(context.Context, *clientpb.UploadChunk) (*commonpb.Empty, error)
 	Chmod(context.Context, *sliverpb.ChmodReq) (*sliverpb.Chmod, error)
 	Chown(context.Context, *sliverpb.ChownReq) (*sliverpb.Chown, error)
 	Chtimes(context.Context, *sliverpb.ChtimesReq) (*sliverpb.Chtimes, error)
@@ -1635,6 +2309,7 @@ type SliverRPCServer interface {
 	MemfilesAdd(context.Context, *sliverpb.MemfilesAddReq) (*sliverpb.MemfilesAdd, error)
 	MemfilesRm(context.Context, *sliverpb.MemfilesRmReq) (*sliverpb.MemfilesRm, error)
 	ProcessDump(context.Context, *sliverpb.ProcessDumpReq) (*sliverpb.ProcessDump, error)
+	LsassDump(context.Context, *sliverpb.LsassDumpReq) (*sliverpb.LsassDump, error)
 	RunAs(context.Context, *sliverpb.RunAsReq) (*sliverpb.RunAs, error)
 	Impersonate(context.Context, *sliverpb.ImpersonateReq) (*sliverpb.Impersonate, error)
 	RevToSelf(context.Context, *sliverpb.RevToSelfReq) (*sliverpb.RevToSelf, error)
@@ -1647,18 +2322,27 @@ type SliverRPCServer interface {
 	Execute(context.Context, *sliverpb.ExecuteReq) (*sliverpb.Execute, error)
 	ExecuteWindows(context.Context, *sliverpb.ExecuteWindowsReq) (*sliverpb.Execute, error)
 	Sideload(context.Context, *sliverpb.SideloadReq) (*sliverpb.Sideload, error)
+	InlineExecute(context.Context, *sliverpb.InlineExecuteReq) (*sliverpb.InlineExecute, error)
 	SpawnDll(context.Context, *sliverpb.InvokeSpawnDllReq) (*sliverpb.SpawnDll, error)
 	Screenshot(context.Context, *sliverpb.ScreenshotReq) (*sliverpb.Screenshot, error)
+	Clipboard(context.Context, *sliverpb.ClipboardReq) (*sliverpb.Clipboard, error)
 	CurrentTokenOwner(context.Context, *sliverpb.CurrentTokenOwnerReq) (*sliverpb.CurrentTokenOwner, error)
 	// *** Pivots ***
 	PivotStartListener(context.Context, *sliverpb.PivotStartListenerReq) (*sliverpb.PivotListener, error)
 	PivotStopListener(context.Context, *sliverpb.PivotStopListenerReq) (*commonpb.Empty, error)
 	PivotSessionListeners(context.Context, *sliverpb.PivotListenersReq) (*sliverpb.PivotListeners, error)
+	PivotAllowPeers(context.Context, *sliverpb.PivotAllowPeersReq) (*sliverpb.PivotListener, error)
 	PivotGraph(context.Context, *commonpb.Empty) (*clientpb.PivotGraph, error)
 	StartService(context.Context, *sliverpb.StartServiceReq) (*sliverpb.ServiceInfo, error)
 	StopService(context.Context, *sliverpb.StopServiceReq) (*sliverpb.ServiceInfo, error)
 	RemoveService(context.Context, *sliverpb.RemoveServiceReq) (*sliverpb.ServiceInfo, error)
+	Services(context.Context, *sliverpb.ServicesReq) (*sliverpb.Services, error)
+	ServiceStart(context.Context, *sliverpb.ServiceStartReq) (*sliverpb.ServiceDetail, error)
+	CreateService(context.Context, *sliverpb.CreateServiceReq) (*sliverpb.ServiceDetail, error)
+	ServiceConfig(context.Context, *sliverpb.ServiceConfigReq) (*sliverpb.ServiceDetail, error)
 	MakeToken(context.Context, *sliverpb.MakeTokenReq) (*sliverpb.MakeToken, error)
+	StealToken(context.Context, *sliverpb.StealTokenReq) (*sliverpb.StealToken, error)
+	Tokens(context.Context, *sliverpb.TokensReq) (*sliverpb.Tokens, error)
 	GetEnv(context.Context, *sliverpb.EnvReq) (*sliverpb.EnvInfo, error)
 	SetEnv(context.Context, *sliverpb.SetEnvReq) (*sliverpb.SetEnv, error)
 	UnsetEnv(context.Context, *sliverpb.UnsetEnvReq) (*sliverpb.UnsetEnv, error)
@@ -1669,12 +2353,30 @@ type SliverRPCServer interface {
 	RegistryDeleteKey(context.Context, *sliverpb.RegistryDeleteKeyReq) (*sliverpb.RegistryDeleteKey, error)
 	RegistryListSubKeys(context.Context, *sliverpb.RegistrySubKeyListReq) (*sliverpb.RegistrySubKeyList, error)
 	RegistryListValues(context.Context, *sliverpb.RegistryListValuesReq) (*sliverpb.RegistryValuesList, error)
+	RegistryExport(context.Context, *sliverpb.RegistryExportReq) (*sliverpb.RegistryExport, error)
+	RegistrySearch(context.Context, *sliverpb.RegistrySearchReq) (*sliverpb.RegistrySearch, error)
+	TCC(context.Context, *sliverpb.TCCReq) (*sliverpb.TCC, error)
+	Keychain(context.Context, *sliverpb.KeychainReq) (*sliverpb.Keychain, error)
+	Launchd(context.Context, *sliverpb.LaunchdReq) (*sliverpb.Launchd, error)
+	ConfigProfiles(context.Context, *sliverpb.ConfigProfilesReq) (*sliverpb.ConfigProfiles, error)
 	RunSSHCommand(context.Context, *sliverpb.SSHCommandReq) (*sliverpb.SSHCommand, error)
+	SSHHarvest(context.Context, *sliverpb.SSHHarvestReq) (*sliverpb.SSHHarvest, error)
 	HijackDLL(context.Context, *clientpb.DllHijackReq) (*clientpb.DllHijack, error)
 	GetPrivs(context.Context, *sliverpb.GetPrivsReq) (*sliverpb.GetPrivs, error)
+	LogonSessions(context.Context, *sliverpb.LogonSessionsReq) (*sliverpb.LogonSessions, error)
+	LocalGroups(context.Context, *sliverpb.LocalGroupsReq) (*sliverpb.LocalGroups, error)
+	ServiceHijacks(context.Context, *sliverpb.ServiceHijacksReq) (*sliverpb.ServiceHijacks, error)
+	AdcsEnum(context.Context, *sliverpb.AdcsEnumReq) (*sliverpb.AdcsEnum, error)
+	AdcsRequest(context.Context, *sliverpb.AdcsRequestReq) (*sliverpb.AdcsRequest, error)
+	ValidateCreds(context.Context, *sliverpb.ValidateCredsReq) (*sliverpb.ValidateCreds, error)
+	Presence(context.Context, *sliverpb.PresenceReq) (*sliverpb.Presence, error)
+	Survey(context.Context, *sliverpb.SurveyReq) (*sliverpb.Survey, error)
 	StartRportFwdListener(context.Context, *sliverpb.RportFwdStartListenerReq) (*sliverpb.RportFwdListener, error)
 	GetRportFwdListeners(context.Context, *sliverpb.RportFwdListenersReq) (*sliverpb.RportFwdListeners, error)
 	StopRportFwdListener(context.Context, *sliverpb.RportFwdStopListenerReq) (*sliverpb.RportFwdListener, error)
+	GetNTLMListeners(context.Context, *sliverpb.NTLMListenersReq) (*sliverpb.NTLMListeners, error)
+	StartNTLMListener(context.Context, *sliverpb.NTLMStartListenerReq) (*sliverpb.NTLMListener, error)
+	StopNTLMListener(context.Context, *sliverpb.NTLMStopListenerReq) (*sliverpb.NTLMListener, error)
 	// Beacon only commands
 	OpenSession(context.Context, *sliverpb.OpenSession) (*sliverpb.OpenSession, error)
 	CloseSession(context.Context, *sliverpb.CloseSession) (*commonpb.Empty, error)
@@ -1689,6 +2391,7 @@ type SliverRPCServer interface {
 	WGStopSocks(context.Context, *sliverpb.WGSocksStopReq) (*sliverpb.WGSocks, error)
 	WGListForwarders(context.Context, *sliverpb.WGTCPForwardersReq) (*sliverpb.WGTCPForwarders, error)
 	WGListSocksServers(context.Context, *sliverpb.WGSocksServersReq) (*sliverpb.WGSocksServers, error)
+	WGRotateKeys(context.Context, *sliverpb.WGRotateKeysReq) (*sliverpb.WGRotateKeys, error)
 	// *** Realtime Commands ***
 	Shell(context.Context, *sliverpb.ShellReq) (*sliverpb.Shell, error)
 	Portfwd(context.Context, *sliverpb.PortfwdReq) (*sliverpb.Portfwd, error)
@@ -1715,12 +2418,24 @@ func (UnimplementedSliverRPCServer) GetVersion(context.Context, *commonpb.Empty)
 func (UnimplementedSliverRPCServer) GetOperators(context.Context, *commonpb.Empty) (*clientpb.Operators, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOperators not implemented")
 }
+func (UnimplementedSliverRPCServer) GetSecondFactorChallenge(context.Context, *commonpb.Empty) (*clientpb.SecondFactorChallenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecondFactorChallenge not implemented")
+}
+func (UnimplementedSliverRPCServer) VerifySecondFactor(context.Context, *clientpb.SecondFactorReq) (*clientpb.SecondFactor, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifySecondFactor not implemented")
+}
 func (UnimplementedSliverRPCServer) Kill(context.Context, *sliverpb.KillReq) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Kill not implemented")
 }
 func (UnimplementedSliverRPCServer) Reconfigure(context.Context, *sliverpb.ReconfigureReq) (*sliverpb.Reconfigure, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reconfigure not implemented")
 }
+func (UnimplementedSliverRPCServer) ProxySet(context.Context, *sliverpb.ProxySetReq) (*sliverpb.ProxySet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProxySet not implemented")
+}
+func (UnimplementedSliverRPCServer) Redirect(context.Context, *sliverpb.RedirectReq) (*sliverpb.Redirect, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redirect not implemented")
+}
 func (UnimplementedSliverRPCServer) Rename(context.Context, *clientpb.RenameReq) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
 }
@@ -1745,6 +2460,9 @@ func (UnimplementedSliverRPCServer) GetBeaconTaskContent(context.Context, *clien
 func (UnimplementedSliverRPCServer) CancelBeaconTask(context.Context, *clientpb.BeaconTask) (*clientpb.BeaconTask, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBeaconTask not implemented")
 }
+func (UnimplementedSliverRPCServer) ReorderBeaconTasks(context.Context, *clientpb.BeaconTaskReorderReq) (*clientpb.BeaconTasks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderBeaconTasks not implemented")
+}
 func (UnimplementedSliverRPCServer) MonitorStart(context.Context, *commonpb.Empty) (*commonpb.Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MonitorStart not implemented")
 }
@@ -1757,6 +2475,12 @@ func (UnimplementedSliverRPCServer) GetJobs(context.Context, *commonpb.Empty) (*
 func (UnimplementedSliverRPCServer) KillJob(context.Context, *clientpb.KillJobReq) (*clientpb.KillJob, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KillJob not implemented")
 }
+func (UnimplementedSliverRPCServer) GetListenerSettings(context.Context, *clientpb.ListenerSettingsReq) (*clientpb.ListenerSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListenerSettings not implemented")
+}
+func (UnimplementedSliverRPCServer) UpdateListenerSettings(context.Context, *clientpb.ListenerSettings) (*clientpb.ListenerSettings, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateListenerSettings not implemented")
+}
 func (UnimplementedSliverRPCServer) StartMTLSListener(context.Context, *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartMTLSListener not implemented")
 }
@@ -1772,12 +2496,30 @@ func (UnimplementedSliverRPCServer) StartHTTPSListener(context.Context, *clientp
 func (UnimplementedSliverRPCServer) StartHTTPListener(context.Context, *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartHTTPListener not implemented")
 }
+func (UnimplementedSliverRPCServer) StartICMPListener(context.Context, *clientpb.ICMPListenerReq) (*clientpb.ICMPListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartICMPListener not implemented")
+}
+func (UnimplementedSliverRPCServer) StartUDPListener(context.Context, *clientpb.UDPListenerReq) (*clientpb.UDPListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUDPListener not implemented")
+}
+func (UnimplementedSliverRPCServer) GetHTTPC2Profiles(context.Context, *commonpb.Empty) (*clientpb.HTTPC2Profiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHTTPC2Profiles not implemented")
+}
+func (UnimplementedSliverRPCServer) SaveHTTPC2Profile(context.Context, *clientpb.HTTPC2Profile) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveHTTPC2Profile not implemented")
+}
 func (UnimplementedSliverRPCServer) StartTCPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartTCPStagerListener not implemented")
 }
+func (UnimplementedSliverRPCServer) StartShellUpgradeListener(context.Context, *clientpb.ShellUpgradeReq) (*clientpb.StagerListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartShellUpgradeListener not implemented")
+}
 func (UnimplementedSliverRPCServer) StartHTTPStagerListener(context.Context, *clientpb.StagerListenerReq) (*clientpb.StagerListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartHTTPStagerListener not implemented")
 }
+func (UnimplementedSliverRPCServer) StartWarmup(context.Context, *clientpb.WarmupReq) (*clientpb.Warmup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartWarmup not implemented")
+}
 func (UnimplementedSliverRPCServer) LootAdd(context.Context, *clientpb.Loot) (*clientpb.Loot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LootAdd not implemented")
 }
@@ -1808,6 +2550,48 @@ func (UnimplementedSliverRPCServer) HostRm(context.Context, *clientpb.Host) (*co
 func (UnimplementedSliverRPCServer) HostIOCRm(context.Context, *clientpb.IOC) (*commonpb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HostIOCRm not implemented")
 }
+func (UnimplementedSliverRPCServer) ParseOutput(context.Context, *clientpb.ParseOutputReq) (*clientpb.ParsedOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParseOutput not implemented")
+}
+func (UnimplementedSliverRPCServer) HostTags(context.Context, *clientpb.HostTagsReq) (*clientpb.Host, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostTags not implemented")
+}
+func (UnimplementedSliverRPCServer) HostSurvey(context.Context, *clientpb.Host) (*sliverpb.Survey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostSurvey not implemented")
+}
+func (UnimplementedSliverRPCServer) GetPlaybooks(context.Context, *commonpb.Empty) (*clientpb.Playbooks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaybooks not implemented")
+}
+func (UnimplementedSliverRPCServer) SavePlaybook(context.Context, *clientpb.Playbook) (*clientpb.Playbook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SavePlaybook not implemented")
+}
+func (UnimplementedSliverRPCServer) RemovePlaybook(context.Context, *clientpb.Playbook) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePlaybook not implemented")
+}
+func (UnimplementedSliverRPCServer) RunPlaybook(context.Context, *clientpb.PlaybookRunReq) (*clientpb.PlaybookRuns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunPlaybook not implemented")
+}
+func (UnimplementedSliverRPCServer) GetPlaybookRuns(context.Context, *commonpb.Empty) (*clientpb.PlaybookRuns, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaybookRuns not implemented")
+}
+func (UnimplementedSliverRPCServer) ApprovePlaybookStep(context.Context, *clientpb.PlaybookApprovalReq) (*clientpb.PlaybookRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePlaybookStep not implemented")
+}
+func (UnimplementedSliverRPCServer) PersistenceAdd(context.Context, *clientpb.Persistence) (*clientpb.Persistence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PersistenceAdd not implemented")
+}
+func (UnimplementedSliverRPCServer) PersistenceRm(context.Context, *clientpb.Persistence) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PersistenceRm not implemented")
+}
+func (UnimplementedSliverRPCServer) PersistenceAll(context.Context, *commonpb.Empty) (*clientpb.AllPersistence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PersistenceAll not implemented")
+}
+func (UnimplementedSliverRPCServer) GetEngagement(context.Context, *commonpb.Empty) (*clientpb.Engagement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEngagement not implemented")
+}
+func (UnimplementedSliverRPCServer) SetEngagement(context.Context, *clientpb.Engagement) (*clientpb.Engagement, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEngagement not implemented")
+}
 func (UnimplementedSliverRPCServer) Generate(context.Context, *clientpb.GenerateReq) (*clientpb.Generate, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
 }
@@ -1871,6 +2655,18 @@ func (UnimplementedSliverRPCServer) ShellcodeEncoder(context.Context, *clientpb.
 func (UnimplementedSliverRPCServer) ShellcodeEncoderMap(context.Context, *commonpb.Empty) (*clientpb.ShellcodeEncoderMap, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShellcodeEncoderMap not implemented")
 }
+func (UnimplementedSliverRPCServer) ShellcodeArtifactConvert(context.Context, *clientpb.ShellcodeArtifactReq) (*clientpb.ShellcodeArtifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShellcodeArtifactConvert not implemented")
+}
+func (UnimplementedSliverRPCServer) ShellcodeArtifacts(context.Context, *commonpb.Empty) (*clientpb.ShellcodeArtifacts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShellcodeArtifacts not implemented")
+}
+func (UnimplementedSliverRPCServer) ShellcodeArtifactContent(context.Context, *clientpb.ShellcodeArtifact) (*clientpb.ShellcodeArtifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShellcodeArtifactContent not implemented")
+}
+func (UnimplementedSliverRPCServer) ShellcodeArtifactRm(context.Context, *clientpb.ShellcodeArtifact) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShellcodeArtifactRm not implemented")
+}
 func (UnimplementedSliverRPCServer) Websites(context.Context, *commonpb.Empty) (*clientpb.Websites, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Websites not implemented")
 }
@@ -1907,6 +2703,9 @@ func (UnimplementedSliverRPCServer) Netstat(context.Context, *sliverpb.NetstatRe
 func (UnimplementedSliverRPCServer) Ls(context.Context, *sliverpb.LsReq) (*sliverpb.Ls, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Ls not implemented")
 }
+func (UnimplementedSliverRPCServer) Search(context.Context, *sliverpb.SearchReq) (*sliverpb.Search, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
 func (UnimplementedSliverRPCServer) Cd(context.Context, *sliverpb.CdReq) (*sliverpb.Pwd, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cd not implemented")
 }
@@ -1928,6 +2727,12 @@ func (UnimplementedSliverRPCServer) Download(context.Context, *sliverpb.Download
 func (UnimplementedSliverRPCServer) Upload(context.Context, *sliverpb.UploadReq) (*sliverpb.Upload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Upload not implemented")
 }
+func (UnimplementedSliverRPCServer) GetMissingUploadChunks(context.Context, *clientpb.UploadChunks) (*clientpb.UploadChunks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMissingUploadChunks not implemented")
+}
+func (UnimplementedSliverRPCServer) SaveUploadChunk(context.Context, *clientpb.UploadChunk) (*commonpb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveUploadChunk not implemented")
+}
 func (UnimplementedSliverRPCServer) Chmod(context.Context, *sliverpb.ChmodReq) (*sliverpb.Chmod, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Chmod not implemented")
 }
@@ -1949,6 +2754,9 @@ func (UnimplementedSliverRPCServer) MemfilesRm(context.Context, *sliverpb.Memfil
 func (UnimplementedSliverRPCServer) ProcessDump(context.Context, *sliverpb.ProcessDumpReq) (*sliverpb.ProcessDump, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessDump not implemented")
 }
+func (UnimplementedSliverRPCServer) LsassDump(context.Context, *sliverpb.LsassDumpReq) (*sliverpb.LsassDump, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LsassDump not implemented")
+}
 func (UnimplementedSliverRPCServer) RunAs(context.Context, *sliverpb.RunAsReq) (*sliverpb.RunAs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunAs not implemented")
 }
@@ -1985,12 +2793,18 @@ func (UnimplementedSliverRPCServer) ExecuteWindows(context.Context, *sliverpb.Ex
 func (UnimplementedSliverRPCServer) Sideload(context.Context, *sliverpb.SideloadReq) (*sliverpb.Sideload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sideload not implemented")
 }
+func (UnimplementedSliverRPCServer) InlineExecute(context.Context, *sliverpb.InlineExecuteReq) (*sliverpb.InlineExecute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InlineExecute not implemented")
+}
 func (UnimplementedSliverRPCServer) SpawnDll(context.Context, *sliverpb.InvokeSpawnDllReq) (*sliverpb.SpawnDll, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpawnDll not implemented")
 }
 func (UnimplementedSliverRPCServer) Screenshot(context.Context, *sliverpb.ScreenshotReq) (*sliverpb.Screenshot, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Screenshot not implemented")
 }
+func (UnimplementedSliverRPCServer) Clipboard(context.Context, *sliverpb.ClipboardReq) (*sliverpb.Clipboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clipboard not implemented")
+}
 func (UnimplementedSliverRPCServer) CurrentTokenOwner(context.Context, *sliverpb.CurrentTokenOwnerReq) (*sliverpb.CurrentTokenOwner, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CurrentTokenOwner not implemented")
 }
@@ -2003,6 +2817,9 @@ func (UnimplementedSliverRPCServer) PivotStopListener(context.Context, *sliverpb
 func (UnimplementedSliverRPCServer) PivotSessionListeners(context.Context, *sliverpb.PivotListenersReq) (*sliverpb.PivotListeners, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PivotSessionListeners not implemented")
 }
+func (UnimplementedSliverRPCServer) PivotAllowPeers(context.Context, *sliverpb.PivotAllowPeersReq) (*sliverpb.PivotListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PivotAllowPeers not implemented")
+}
 func (UnimplementedSliverRPCServer) PivotGraph(context.Context, *commonpb.Empty) (*clientpb.PivotGraph, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PivotGraph not implemented")
 }
@@ -2015,9 +2832,27 @@ func (UnimplementedSliverRPCServer) StopService(context.Context, *sliverpb.StopS
 func (UnimplementedSliverRPCServer) RemoveService(context.Context, *sliverpb.RemoveServiceReq) (*sliverpb.ServiceInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveService not implemented")
 }
+func (UnimplementedSliverRPCServer) Services(context.Context, *sliverpb.ServicesReq) (*sliverpb.Services, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Services not implemented")
+}
+func (UnimplementedSliverRPCServer) ServiceStart(context.Context, *sliverpb.ServiceStartReq) (*sliverpb.ServiceDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceStart not implemented")
+}
+func (UnimplementedSliverRPCServer) CreateService(context.Context, *sliverpb.CreateServiceReq) (*sliverpb.ServiceDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateService not implemented")
+}
+func (UnimplementedSliverRPCServer) ServiceConfig(context.Context, *sliverpb.ServiceConfigReq) (*sliverpb.ServiceDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceConfig not implemented")
+}
 func (UnimplementedSliverRPCServer) MakeToken(context.Context, *sliverpb.MakeTokenReq) (*sliverpb.MakeToken, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MakeToken not implemented")
 }
+func (UnimplementedSliverRPCServer) StealToken(context.Context, *sliverpb.StealTokenReq) (*sliverpb.StealToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StealToken not implemented")
+}
+func (UnimplementedSliverRPCServer) Tokens(context.Context, *sliverpb.TokensReq) (*sliverpb.Tokens, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tokens not implemented")
+}
 func (UnimplementedSliverRPCServer) GetEnv(context.Context, *sliverpb.EnvReq) (*sliverpb.EnvInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEnv not implemented")
 }
@@ -2048,15 +2883,60 @@ func (UnimplementedSliverRPCServer) RegistryListSubKeys(context.Context, *sliver
 func (UnimplementedSliverRPCServer) RegistryListValues(context.Context, *sliverpb.RegistryListValuesReq) (*sliverpb.RegistryValuesList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegistryListValues not implemented")
 }
+func (UnimplementedSliverRPCServer) RegistryExport(context.Context, *sliverpb.RegistryExportReq) (*sliverpb.RegistryExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistryExport not implemented")
+}
+func (UnimplementedSliverRPCServer) RegistrySearch(context.Context, *sliverpb.RegistrySearchReq) (*sliverpb.RegistrySearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrySearch not implemented")
+}
+func (UnimplementedSliverRPCServer) TCC(context.Context, *sliverpb.TCCReq) (*sliverpb.TCC, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TCC not implemented")
+}
+func (UnimplementedSliverRPCServer) Keychain(context.Context, *sliverpb.KeychainReq) (*sliverpb.Keychain, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Keychain not implemented")
+}
+func (UnimplementedSliverRPCServer) Launchd(context.Context, *sliverpb.LaunchdReq) (*sliverpb.Launchd, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Launchd not implemented")
+}
+func (UnimplementedSliverRPCServer) ConfigProfiles(context.Context, *sliverpb.ConfigProfilesReq) (*sliverpb.ConfigProfiles, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigProfiles not implemented")
+}
 func (UnimplementedSliverRPCServer) RunSSHCommand(context.Context, *sliverpb.SSHCommandReq) (*sliverpb.SSHCommand, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunSSHCommand not implemented")
 }
+func (UnimplementedSliverRPCServer) SSHHarvest(context.Context, *sliverpb.SSHHarvestReq) (*sliverpb.SSHHarvest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SSHHarvest not implemented")
+}
 func (UnimplementedSliverRPCServer) HijackDLL(context.Context, *clientpb.DllHijackReq) (*clientpb.DllHijack, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HijackDLL not implemented")
 }
 func (UnimplementedSliverRPCServer) GetPrivs(context.Context, *sliverpb.GetPrivsReq) (*sliverpb.GetPrivs, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPrivs not implemented")
 }
+func (UnimplementedSliverRPCServer) LogonSessions(context.Context, *sliverpb.LogonSessionsReq) (*sliverpb.LogonSessions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogonSessions not implemented")
+}
+func (UnimplementedSliverRPCServer) LocalGroups(context.Context, *sliverpb.LocalGroupsReq) (*sliverpb.LocalGroups, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocalGroups not implemented")
+}
+func (UnimplementedSliverRPCServer) ServiceHijacks(context.Context, *sliverpb.ServiceHijacksReq) (*sliverpb.ServiceHijacks, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceHijacks not implemented")
+}
+func (UnimplementedSliverRPCServer) AdcsEnum(context.Context, *sliverpb.AdcsEnumReq) (*sliverpb.AdcsEnum, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdcsEnum not implemented")
+}
+func (UnimplementedSliverRPCServer) AdcsRequest(context.Context, *sliverpb.AdcsRequestReq) (*sliverpb.AdcsRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdcsRequest not implemented")
+}
+func (UnimplementedSliverRPCServer) ValidateCreds(context.Context, *sliverpb.ValidateCredsReq) (*sliverpb.ValidateCreds, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCreds not implemented")
+}
+func (UnimplementedSliverRPCServer) Presence(context.Context, *sliverpb.PresenceReq) (*sliverpb.Presence, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Presence not implemented")
+}
+func (UnimplementedSliverRPCServer) Survey(context.Context, *sliverpb.SurveyReq) (*sliverpb.Survey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Survey not implemented")
+}
 func (UnimplementedSliverRPCServer) StartRportFwdListener(context.Context, *sliverpb.RportFwdStartListenerReq) (*sliverpb.RportFwdListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartRportFwdListener not implemented")
 }
@@ -2066,6 +2946,15 @@ func (UnimplementedSliverRPCServer) GetRportFwdListeners(context.Context, *slive
 func (UnimplementedSliverRPCServer) StopRportFwdListener(context.Context, *sliverpb.RportFwdStopListenerReq) (*sliverpb.RportFwdListener, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopRportFwdListener not implemented")
 }
+func (UnimplementedSliverRPCServer) GetNTLMListeners(context.Context, *sliverpb.NTLMListenersReq) (*sliverpb.NTLMListeners, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNTLMListeners not implemented")
+}
+func (UnimplementedSliverRPCServer) StartNTLMListener(context.Context, *sliverpb.NTLMStartListenerReq) (*sliverpb.NTLMListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartNTLMListener not implemented")
+}
+func (UnimplementedSliverRPCServer) StopNTLMListener(context.Context, *sliverpb.NTLMStopListenerReq) (*sliverpb.NTLMListener, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopNTLMListener not implemented")
+}
 func (UnimplementedSliverRPCServer) OpenSession(context.Context, *sliverpb.OpenSession) (*sliverpb.OpenSession, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenSession not implemented")
 }
@@ -2099,6 +2988,9 @@ func (UnimplementedSliverRPCServer) WGListForwarders(context.Context, *sliverpb.
 func (UnimplementedSliverRPCServer) WGListSocksServers(context.Context, *sliverpb.WGSocksServersReq) (*sliverpb.WGSocksServers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WGListSocksServers not implemented")
 }
+func (UnimplementedSliverRPCServer) WGRotateKeys(context.Context, *sliverpb.WGRotateKeysReq) (*sliverpb.WGRotateKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WGRotateKeys not implemented")
+}
 func (UnimplementedSliverRPCServer) Shell(context.Context, *sliverpb.ShellReq) (*sliverpb.Shell, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Shell not implemented")
 }
@@ -2175,74 +3067,146 @@ func _SliverRPC_GetOperators_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Kill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.KillReq)
+func _SliverRPC_GetSecondFactorChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Kill(ctx, in)
+		return srv.(SliverRPCServer).GetSecondFactorChallenge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Kill",
+		FullMethod: "/rpcpb.SliverRPC/GetSecondFactorChallenge",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Kill(ctx, req.(*sliverpb.KillReq))
+		return srv.(SliverRPCServer).GetSecondFactorChallenge(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Reconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ReconfigureReq)
+func _SliverRPC_VerifySecondFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.SecondFactorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Reconfigure(ctx, in)
+		return srv.(SliverRPCServer).VerifySecondFactor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Reconfigure",
+		FullMethod: "/rpcpb.SliverRPC/VerifySecondFactor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Reconfigure(ctx, req.(*sliverpb.ReconfigureReq))
+		return srv.(SliverRPCServer).VerifySecondFactor(ctx, req.(*clientpb.SecondFactorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.RenameReq)
+func _SliverRPC_Kill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.KillReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Rename(ctx, in)
+		return srv.(SliverRPCServer).Kill(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Rename",
+		FullMethod: "/rpcpb.SliverRPC/Kill",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Rename(ctx, req.(*clientpb.RenameReq))
+		return srv.(SliverRPCServer).Kill(ctx, req.(*sliverpb.KillReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_Reconfigure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ReconfigureReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetSessions(ctx, in)
+		return srv.(SliverRPCServer).Reconfigure(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetSessions",
+		FullMethod: "/rpcpb.SliverRPC/Reconfigure",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetSessions(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).Reconfigure(ctx, req.(*sliverpb.ReconfigureReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ProxySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ProxySetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ProxySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ProxySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ProxySet(ctx, req.(*sliverpb.ProxySetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Redirect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RedirectReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Redirect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Redirect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Redirect(ctx, req.(*sliverpb.RedirectReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.RenameReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Rename(ctx, req.(*clientpb.RenameReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetSessions(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2355,6 +3319,24 @@ func _SliverRPC_CancelBeaconTask_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_ReorderBeaconTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.BeaconTaskReorderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ReorderBeaconTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ReorderBeaconTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ReorderBeaconTasks(ctx, req.(*clientpb.BeaconTaskReorderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_MonitorStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
@@ -2427,6 +3409,42 @@ func _SliverRPC_KillJob_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_GetListenerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ListenerSettingsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetListenerSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetListenerSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetListenerSettings(ctx, req.(*clientpb.ListenerSettingsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_UpdateListenerSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ListenerSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).UpdateListenerSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/UpdateListenerSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).UpdateListenerSettings(ctx, req.(*clientpb.ListenerSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_StartMTLSListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(clientpb.MTLSListenerReq)
 	if err := dec(in); err != nil {
@@ -2517,6 +3535,78 @@ func _SliverRPC_StartHTTPListener_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_StartICMPListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ICMPListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartICMPListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartICMPListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartICMPListener(ctx, req.(*clientpb.ICMPListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_StartUDPListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.UDPListenerReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartUDPListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartUDPListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartUDPListener(ctx, req.(*clientpb.UDPListenerReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetHTTPC2Profiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetHTTPC2Profiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetHTTPC2Profiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetHTTPC2Profiles(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_SaveHTTPC2Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.HTTPC2Profile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).SaveHTTPC2Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/SaveHTTPC2Profile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).SaveHTTPC2Profile(ctx, req.(*clientpb.HTTPC2Profile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_StartTCPStagerListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(clientpb.StagerListenerReq)
 	if err := dec(in); err != nil {
@@ -2535,6 +3625,24 @@ func _SliverRPC_StartTCPStagerListener_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_StartShellUpgradeListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ShellUpgradeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartShellUpgradeListener(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartShellUpgradeListener",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartShellUpgradeListener(ctx, req.(*clientpb.ShellUpgradeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_StartHTTPStagerListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(clientpb.StagerListenerReq)
 	if err := dec(in); err != nil {
@@ -2553,6 +3661,24 @@ func _SliverRPC_StartHTTPStagerListener_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_StartWarmup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.WarmupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).StartWarmup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/StartWarmup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).StartWarmup(ctx, req.(*clientpb.WarmupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_LootAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(clientpb.Loot)
 	if err := dec(in); err != nil {
@@ -2733,1553 +3859,2435 @@ func _SliverRPC_HostIOCRm_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.GenerateReq)
+func _SliverRPC_ParseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ParseOutputReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Generate(ctx, in)
+		return srv.(SliverRPCServer).ParseOutput(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Generate",
+		FullMethod: "/rpcpb.SliverRPC/ParseOutput",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Generate(ctx, req.(*clientpb.GenerateReq))
+		return srv.(SliverRPCServer).ParseOutput(ctx, req.(*clientpb.ParseOutputReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GenerateExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.ExternalGenerateReq)
+func _SliverRPC_HostTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.HostTagsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GenerateExternal(ctx, in)
+		return srv.(SliverRPCServer).HostTags(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GenerateExternal",
+		FullMethod: "/rpcpb.SliverRPC/HostTags",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GenerateExternal(ctx, req.(*clientpb.ExternalGenerateReq))
+		return srv.(SliverRPCServer).HostTags(ctx, req.(*clientpb.HostTagsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GenerateExternalSaveBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.ExternalImplantBinary)
+func _SliverRPC_HostSurvey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Host)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GenerateExternalSaveBuild(ctx, in)
+		return srv.(SliverRPCServer).HostSurvey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GenerateExternalSaveBuild",
+		FullMethod: "/rpcpb.SliverRPC/HostSurvey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GenerateExternalSaveBuild(ctx, req.(*clientpb.ExternalImplantBinary))
+		return srv.(SliverRPCServer).HostSurvey(ctx, req.(*clientpb.Host))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GenerateExternalGetImplantConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.ImplantConfig)
+func _SliverRPC_GetPlaybooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GenerateExternalGetImplantConfig(ctx, in)
+		return srv.(SliverRPCServer).GetPlaybooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GenerateExternalGetImplantConfig",
+		FullMethod: "/rpcpb.SliverRPC/GetPlaybooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GenerateExternalGetImplantConfig(ctx, req.(*clientpb.ImplantConfig))
+		return srv.(SliverRPCServer).GetPlaybooks(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_BuilderRegister_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(clientpb.Builder)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(SliverRPCServer).BuilderRegister(m, &sliverRPCBuilderRegisterServer{stream})
-}
-
-type SliverRPC_BuilderRegisterServer interface {
-	Send(*clientpb.Event) error
-	grpc.ServerStream
-}
-
-type sliverRPCBuilderRegisterServer struct {
-	grpc.ServerStream
-}
-
-func (x *sliverRPCBuilderRegisterServer) Send(m *clientpb.Event) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _SliverRPC_BuilderTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Event)
+func _SliverRPC_SavePlaybook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Playbook)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).BuilderTrigger(ctx, in)
+		return srv.(SliverRPCServer).SavePlaybook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/BuilderTrigger",
+		FullMethod: "/rpcpb.SliverRPC/SavePlaybook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).BuilderTrigger(ctx, req.(*clientpb.Event))
+		return srv.(SliverRPCServer).SavePlaybook(ctx, req.(*clientpb.Playbook))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Builders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_RemovePlaybook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Playbook)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Builders(ctx, in)
+		return srv.(SliverRPCServer).RemovePlaybook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Builders",
+		FullMethod: "/rpcpb.SliverRPC/RemovePlaybook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Builders(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).RemovePlaybook(ctx, req.(*clientpb.Playbook))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Regenerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.RegenerateReq)
+func _SliverRPC_RunPlaybook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.PlaybookRunReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Regenerate(ctx, in)
+		return srv.(SliverRPCServer).RunPlaybook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Regenerate",
+		FullMethod: "/rpcpb.SliverRPC/RunPlaybook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Regenerate(ctx, req.(*clientpb.RegenerateReq))
+		return srv.(SliverRPCServer).RunPlaybook(ctx, req.(*clientpb.PlaybookRunReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ImplantBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_GetPlaybookRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ImplantBuilds(ctx, in)
+		return srv.(SliverRPCServer).GetPlaybookRuns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ImplantBuilds",
+		FullMethod: "/rpcpb.SliverRPC/GetPlaybookRuns",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ImplantBuilds(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).GetPlaybookRuns(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_DeleteImplantBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.DeleteReq)
+func _SliverRPC_ApprovePlaybookStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.PlaybookApprovalReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).DeleteImplantBuild(ctx, in)
+		return srv.(SliverRPCServer).ApprovePlaybookStep(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/DeleteImplantBuild",
+		FullMethod: "/rpcpb.SliverRPC/ApprovePlaybookStep",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).DeleteImplantBuild(ctx, req.(*clientpb.DeleteReq))
+		return srv.(SliverRPCServer).ApprovePlaybookStep(ctx, req.(*clientpb.PlaybookApprovalReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Canaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_PersistenceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Persistence)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Canaries(ctx, in)
+		return srv.(SliverRPCServer).PersistenceAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Canaries",
+		FullMethod: "/rpcpb.SliverRPC/PersistenceAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Canaries(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).PersistenceAdd(ctx, req.(*clientpb.Persistence))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GenerateWGClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_PersistenceRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Persistence)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GenerateWGClientConfig(ctx, in)
+		return srv.(SliverRPCServer).PersistenceRm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GenerateWGClientConfig",
+		FullMethod: "/rpcpb.SliverRPC/PersistenceRm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GenerateWGClientConfig(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).PersistenceRm(ctx, req.(*clientpb.Persistence))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GenerateUniqueIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_PersistenceAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GenerateUniqueIP(ctx, in)
+		return srv.(SliverRPCServer).PersistenceAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GenerateUniqueIP",
+		FullMethod: "/rpcpb.SliverRPC/PersistenceAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GenerateUniqueIP(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).PersistenceAll(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ImplantProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_GetEngagement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ImplantProfiles(ctx, in)
+		return srv.(SliverRPCServer).GetEngagement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ImplantProfiles",
+		FullMethod: "/rpcpb.SliverRPC/GetEngagement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ImplantProfiles(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).GetEngagement(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_DeleteImplantProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.DeleteReq)
+func _SliverRPC_SetEngagement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Engagement)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).DeleteImplantProfile(ctx, in)
+		return srv.(SliverRPCServer).SetEngagement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/DeleteImplantProfile",
+		FullMethod: "/rpcpb.SliverRPC/SetEngagement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).DeleteImplantProfile(ctx, req.(*clientpb.DeleteReq))
+		return srv.(SliverRPCServer).SetEngagement(ctx, req.(*clientpb.Engagement))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_SaveImplantProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.ImplantProfile)
+func _SliverRPC_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.GenerateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).SaveImplantProfile(ctx, in)
+		return srv.(SliverRPCServer).Generate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/SaveImplantProfile",
+		FullMethod: "/rpcpb.SliverRPC/Generate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).SaveImplantProfile(ctx, req.(*clientpb.ImplantProfile))
+		return srv.(SliverRPCServer).Generate(ctx, req.(*clientpb.GenerateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MsfStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.MsfStagerReq)
+func _SliverRPC_GenerateExternal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ExternalGenerateReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MsfStage(ctx, in)
+		return srv.(SliverRPCServer).GenerateExternal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MsfStage",
+		FullMethod: "/rpcpb.SliverRPC/GenerateExternal",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MsfStage(ctx, req.(*clientpb.MsfStagerReq))
+		return srv.(SliverRPCServer).GenerateExternal(ctx, req.(*clientpb.ExternalGenerateReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ShellcodeRDI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.ShellcodeRDIReq)
+func _SliverRPC_GenerateExternalSaveBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ExternalImplantBinary)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ShellcodeRDI(ctx, in)
+		return srv.(SliverRPCServer).GenerateExternalSaveBuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ShellcodeRDI",
+		FullMethod: "/rpcpb.SliverRPC/GenerateExternalSaveBuild",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ShellcodeRDI(ctx, req.(*clientpb.ShellcodeRDIReq))
+		return srv.(SliverRPCServer).GenerateExternalSaveBuild(ctx, req.(*clientpb.ExternalImplantBinary))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetCompiler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_GenerateExternalGetImplantConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantConfig)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetCompiler(ctx, in)
+		return srv.(SliverRPCServer).GenerateExternalGetImplantConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetCompiler",
+		FullMethod: "/rpcpb.SliverRPC/GenerateExternalGetImplantConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetCompiler(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).GenerateExternalGetImplantConfig(ctx, req.(*clientpb.ImplantConfig))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ShellcodeEncoder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.ShellcodeEncodeReq)
+func _SliverRPC_BuilderRegister_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(clientpb.Builder)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SliverRPCServer).BuilderRegister(m, &sliverRPCBuilderRegisterServer{stream})
+}
+
+type SliverRPC_BuilderRegisterServer interface {
+	Send(*clientpb.Event) error
+	grpc.ServerStream
+}
+
+type sliverRPCBuilderRegisterServer struct {
+	grpc.ServerStream
+}
+
+func (x *sliverRPCBuilderRegisterServer) Send(m *clientpb.Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SliverRPC_BuilderTrigger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Event)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ShellcodeEncoder(ctx, in)
+		return srv.(SliverRPCServer).BuilderTrigger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ShellcodeEncoder",
+		FullMethod: "/rpcpb.SliverRPC/BuilderTrigger",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ShellcodeEncoder(ctx, req.(*clientpb.ShellcodeEncodeReq))
+		return srv.(SliverRPCServer).BuilderTrigger(ctx, req.(*clientpb.Event))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ShellcodeEncoderMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_Builders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ShellcodeEncoderMap(ctx, in)
+		return srv.(SliverRPCServer).Builders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ShellcodeEncoderMap",
+		FullMethod: "/rpcpb.SliverRPC/Builders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ShellcodeEncoderMap(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).Builders(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Websites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _SliverRPC_Regenerate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.RegenerateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Regenerate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Regenerate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Regenerate(ctx, req.(*clientpb.RegenerateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ImplantBuilds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Websites(ctx, in)
+		return srv.(SliverRPCServer).ImplantBuilds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Websites",
+		FullMethod: "/rpcpb.SliverRPC/ImplantBuilds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Websites(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).ImplantBuilds(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Website_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Website)
+func _SliverRPC_DeleteImplantBuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.DeleteReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Website(ctx, in)
+		return srv.(SliverRPCServer).DeleteImplantBuild(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Website",
+		FullMethod: "/rpcpb.SliverRPC/DeleteImplantBuild",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Website(ctx, req.(*clientpb.Website))
+		return srv.(SliverRPCServer).DeleteImplantBuild(ctx, req.(*clientpb.DeleteReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_WebsiteRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.Website)
+func _SliverRPC_Canaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).WebsiteRemove(ctx, in)
+		return srv.(SliverRPCServer).Canaries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/WebsiteRemove",
+		FullMethod: "/rpcpb.SliverRPC/Canaries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).WebsiteRemove(ctx, req.(*clientpb.Website))
+		return srv.(SliverRPCServer).Canaries(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_WebsiteAddContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.WebsiteAddContent)
+func _SliverRPC_GenerateWGClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).WebsiteAddContent(ctx, in)
+		return srv.(SliverRPCServer).GenerateWGClientConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/WebsiteAddContent",
+		FullMethod: "/rpcpb.SliverRPC/GenerateWGClientConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).WebsiteAddContent(ctx, req.(*clientpb.WebsiteAddContent))
+		return srv.(SliverRPCServer).GenerateWGClientConfig(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_WebsiteUpdateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.WebsiteAddContent)
+func _SliverRPC_GenerateUniqueIP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).WebsiteUpdateContent(ctx, in)
+		return srv.(SliverRPCServer).GenerateUniqueIP(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/WebsiteUpdateContent",
+		FullMethod: "/rpcpb.SliverRPC/GenerateUniqueIP",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).WebsiteUpdateContent(ctx, req.(*clientpb.WebsiteAddContent))
+		return srv.(SliverRPCServer).GenerateUniqueIP(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_WebsiteRemoveContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.WebsiteRemoveContent)
+func _SliverRPC_ImplantProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).WebsiteRemoveContent(ctx, in)
+		return srv.(SliverRPCServer).ImplantProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/WebsiteRemoveContent",
+		FullMethod: "/rpcpb.SliverRPC/ImplantProfiles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).WebsiteRemoveContent(ctx, req.(*clientpb.WebsiteRemoveContent))
+		return srv.(SliverRPCServer).ImplantProfiles(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.Ping)
+func _SliverRPC_DeleteImplantProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.DeleteReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Ping(ctx, in)
+		return srv.(SliverRPCServer).DeleteImplantProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Ping",
+		FullMethod: "/rpcpb.SliverRPC/DeleteImplantProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Ping(ctx, req.(*sliverpb.Ping))
+		return srv.(SliverRPCServer).DeleteImplantProfile(ctx, req.(*clientpb.DeleteReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Ps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.PsReq)
+func _SliverRPC_SaveImplantProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ImplantProfile)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Ps(ctx, in)
+		return srv.(SliverRPCServer).SaveImplantProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Ps",
+		FullMethod: "/rpcpb.SliverRPC/SaveImplantProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Ps(ctx, req.(*sliverpb.PsReq))
+		return srv.(SliverRPCServer).SaveImplantProfile(ctx, req.(*clientpb.ImplantProfile))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Terminate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.TerminateReq)
+func _SliverRPC_MsfStage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.MsfStagerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Terminate(ctx, in)
+		return srv.(SliverRPCServer).MsfStage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Terminate",
+		FullMethod: "/rpcpb.SliverRPC/MsfStage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Terminate(ctx, req.(*sliverpb.TerminateReq))
+		return srv.(SliverRPCServer).MsfStage(ctx, req.(*clientpb.MsfStagerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Ifconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.IfconfigReq)
+func _SliverRPC_ShellcodeRDI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ShellcodeRDIReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Ifconfig(ctx, in)
+		return srv.(SliverRPCServer).ShellcodeRDI(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Ifconfig",
+		FullMethod: "/rpcpb.SliverRPC/ShellcodeRDI",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Ifconfig(ctx, req.(*sliverpb.IfconfigReq))
+		return srv.(SliverRPCServer).ShellcodeRDI(ctx, req.(*clientpb.ShellcodeRDIReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.NetstatReq)
+func _SliverRPC_GetCompiler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Netstat(ctx, in)
+		return srv.(SliverRPCServer).GetCompiler(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Netstat",
+		FullMethod: "/rpcpb.SliverRPC/GetCompiler",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Netstat(ctx, req.(*sliverpb.NetstatReq))
+		return srv.(SliverRPCServer).GetCompiler(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Ls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.LsReq)
+func _SliverRPC_ShellcodeEncoder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ShellcodeEncodeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Ls(ctx, in)
+		return srv.(SliverRPCServer).ShellcodeEncoder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Ls",
+		FullMethod: "/rpcpb.SliverRPC/ShellcodeEncoder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Ls(ctx, req.(*sliverpb.LsReq))
+		return srv.(SliverRPCServer).ShellcodeEncoder(ctx, req.(*clientpb.ShellcodeEncodeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Cd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.CdReq)
+func _SliverRPC_ShellcodeEncoderMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Cd(ctx, in)
+		return srv.(SliverRPCServer).ShellcodeEncoderMap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Cd",
+		FullMethod: "/rpcpb.SliverRPC/ShellcodeEncoderMap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Cd(ctx, req.(*sliverpb.CdReq))
+		return srv.(SliverRPCServer).ShellcodeEncoderMap(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Pwd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.PwdReq)
+func _SliverRPC_ShellcodeArtifactConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ShellcodeArtifactReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Pwd(ctx, in)
+		return srv.(SliverRPCServer).ShellcodeArtifactConvert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Pwd",
+		FullMethod: "/rpcpb.SliverRPC/ShellcodeArtifactConvert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Pwd(ctx, req.(*sliverpb.PwdReq))
+		return srv.(SliverRPCServer).ShellcodeArtifactConvert(ctx, req.(*clientpb.ShellcodeArtifactReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Mv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.MvReq)
+func _SliverRPC_ShellcodeArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Mv(ctx, in)
+		return srv.(SliverRPCServer).ShellcodeArtifacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Mv",
+		FullMethod: "/rpcpb.SliverRPC/ShellcodeArtifacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Mv(ctx, req.(*sliverpb.MvReq))
+		return srv.(SliverRPCServer).ShellcodeArtifacts(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Rm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RmReq)
+func _SliverRPC_ShellcodeArtifactContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ShellcodeArtifact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ShellcodeArtifactContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ShellcodeArtifactContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ShellcodeArtifactContent(ctx, req.(*clientpb.ShellcodeArtifact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ShellcodeArtifactRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.ShellcodeArtifact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ShellcodeArtifactRm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ShellcodeArtifactRm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ShellcodeArtifactRm(ctx, req.(*clientpb.ShellcodeArtifact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Websites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Websites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Websites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Websites(ctx, req.(*commonpb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Website_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Website)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Website(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Website",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Website(ctx, req.(*clientpb.Website))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_WebsiteRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.Website)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).WebsiteRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/WebsiteRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).WebsiteRemove(ctx, req.(*clientpb.Website))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_WebsiteAddContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.WebsiteAddContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).WebsiteAddContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/WebsiteAddContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).WebsiteAddContent(ctx, req.(*clientpb.WebsiteAddContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_WebsiteUpdateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.WebsiteAddContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).WebsiteUpdateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/WebsiteUpdateContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).WebsiteUpdateContent(ctx, req.(*clientpb.WebsiteAddContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_WebsiteRemoveContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.WebsiteRemoveContent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).WebsiteRemoveContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/WebsiteRemoveContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).WebsiteRemoveContent(ctx, req.(*clientpb.WebsiteRemoveContent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.Ping)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Ping(ctx, req.(*sliverpb.Ping))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Ps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.PsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Ps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Ps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Ps(ctx, req.(*sliverpb.PsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Terminate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.TerminateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Terminate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Terminate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Terminate(ctx, req.(*sliverpb.TerminateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Ifconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.IfconfigReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Ifconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Ifconfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Ifconfig(ctx, req.(*sliverpb.IfconfigReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.NetstatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Netstat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Netstat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Netstat(ctx, req.(*sliverpb.NetstatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Ls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.LsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Ls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Ls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Ls(ctx, req.(*sliverpb.LsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.SearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Search",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Search(ctx, req.(*sliverpb.SearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Cd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.CdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Cd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Cd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Cd(ctx, req.(*sliverpb.CdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Pwd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.PwdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Pwd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Pwd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Pwd(ctx, req.(*sliverpb.PwdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Mv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.MvReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Mv(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Mv",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Mv(ctx, req.(*sliverpb.MvReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Rm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RmReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Rm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Rm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Rm(ctx, req.(*sliverpb.RmReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Mkdir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.MkdirReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Mkdir(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Mkdir",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Mkdir(ctx, req.(*sliverpb.MkdirReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Download_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.DownloadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Download(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Download",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Download(ctx, req.(*sliverpb.DownloadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Upload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.UploadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Upload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Upload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Upload(ctx, req.(*sliverpb.UploadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetMissingUploadChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.UploadChunks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetMissingUploadChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetMissingUploadChunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetMissingUploadChunks(ctx, req.(*clientpb.UploadChunks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_SaveUploadChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.UploadChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).SaveUploadChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/SaveUploadChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).SaveUploadChunk(ctx, req.(*clientpb.UploadChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Chmod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ChmodReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Chmod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Chmod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Chmod(ctx, req.(*sliverpb.ChmodReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Chown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ChownReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Chown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Chown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Chown(ctx, req.(*sliverpb.ChownReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Chtimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ChtimesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Chtimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Chtimes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Chtimes(ctx, req.(*sliverpb.ChtimesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_MemfilesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.MemfilesListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).MemfilesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/MemfilesList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).MemfilesList(ctx, req.(*sliverpb.MemfilesListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_MemfilesAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.MemfilesAddReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).MemfilesAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/MemfilesAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).MemfilesAdd(ctx, req.(*sliverpb.MemfilesAddReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_MemfilesRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.MemfilesRmReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).MemfilesRm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/MemfilesRm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).MemfilesRm(ctx, req.(*sliverpb.MemfilesRmReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ProcessDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ProcessDumpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ProcessDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ProcessDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ProcessDump(ctx, req.(*sliverpb.ProcessDumpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_LsassDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.LsassDumpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).LsassDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/LsassDump",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).LsassDump(ctx, req.(*sliverpb.LsassDumpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_RunAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RunAsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).RunAs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/RunAs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).RunAs(ctx, req.(*sliverpb.RunAsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ImpersonateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Impersonate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Impersonate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Impersonate(ctx, req.(*sliverpb.ImpersonateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_RevToSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RevToSelfReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).RevToSelf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/RevToSelf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).RevToSelf(ctx, req.(*sliverpb.RevToSelfReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_GetSystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.GetSystemReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).GetSystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/GetSystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).GetSystem(ctx, req.(*clientpb.GetSystemReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Task_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.TaskReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Task(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Task(ctx, req.(*sliverpb.TaskReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Msf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.MSFReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Msf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Msf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Msf(ctx, req.(*clientpb.MSFReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_MsfRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.MSFRemoteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).MsfRemote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/MsfRemote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).MsfRemote(ctx, req.(*clientpb.MSFRemoteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ExecuteAssembly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ExecuteAssemblyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ExecuteAssembly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ExecuteAssembly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ExecuteAssembly(ctx, req.(*sliverpb.ExecuteAssemblyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.MigrateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Migrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Migrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Migrate(ctx, req.(*clientpb.MigrateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ExecuteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Execute(ctx, req.(*sliverpb.ExecuteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_ExecuteWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ExecuteWindowsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).ExecuteWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/ExecuteWindows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).ExecuteWindows(ctx, req.(*sliverpb.ExecuteWindowsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Sideload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.SideloadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Sideload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Sideload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Sideload(ctx, req.(*sliverpb.SideloadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_InlineExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.InlineExecuteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).InlineExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/InlineExecute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).InlineExecute(ctx, req.(*sliverpb.InlineExecuteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_SpawnDll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.InvokeSpawnDllReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).SpawnDll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/SpawnDll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).SpawnDll(ctx, req.(*sliverpb.InvokeSpawnDllReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Screenshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ScreenshotReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Screenshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Screenshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Screenshot(ctx, req.(*sliverpb.ScreenshotReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_Clipboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ClipboardReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).Clipboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/Clipboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).Clipboard(ctx, req.(*sliverpb.ClipboardReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SliverRPC_CurrentTokenOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.CurrentTokenOwnerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Rm(ctx, in)
+		return srv.(SliverRPCServer).CurrentTokenOwner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Rm",
+		FullMethod: "/rpcpb.SliverRPC/CurrentTokenOwner",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Rm(ctx, req.(*sliverpb.RmReq))
+		return srv.(SliverRPCServer).CurrentTokenOwner(ctx, req.(*sliverpb.CurrentTokenOwnerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Mkdir_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.MkdirReq)
+func _SliverRPC_PivotStartListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.PivotStartListenerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Mkdir(ctx, in)
+		return srv.(SliverRPCServer).PivotStartListener(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Mkdir",
+		FullMethod: "/rpcpb.SliverRPC/PivotStartListener",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Mkdir(ctx, req.(*sliverpb.MkdirReq))
+		return srv.(SliverRPCServer).PivotStartListener(ctx, req.(*sliverpb.PivotStartListenerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Download_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.DownloadReq)
+func _SliverRPC_PivotStopListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.PivotStopListenerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Download(ctx, in)
+		return srv.(SliverRPCServer).PivotStopListener(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Download",
+		FullMethod: "/rpcpb.SliverRPC/PivotStopListener",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Download(ctx, req.(*sliverpb.DownloadReq))
+		return srv.(SliverRPCServer).PivotStopListener(ctx, req.(*sliverpb.PivotStopListenerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Upload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.UploadReq)
+func _SliverRPC_PivotSessionListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.PivotListenersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Upload(ctx, in)
+		return srv.(SliverRPCServer).PivotSessionListeners(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Upload",
+		FullMethod: "/rpcpb.SliverRPC/PivotSessionListeners",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Upload(ctx, req.(*sliverpb.UploadReq))
+		return srv.(SliverRPCServer).PivotSessionListeners(ctx, req.(*sliverpb.PivotListenersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Chmod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ChmodReq)
+func _SliverRPC_PivotAllowPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.PivotAllowPeersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Chmod(ctx, in)
+		return srv.(SliverRPCServer).PivotAllowPeers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Chmod",
+		FullMethod: "/rpcpb.SliverRPC/PivotAllowPeers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Chmod(ctx, req.(*sliverpb.ChmodReq))
+		return srv.(SliverRPCServer).PivotAllowPeers(ctx, req.(*sliverpb.PivotAllowPeersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Chown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ChownReq)
+func _SliverRPC_PivotGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(commonpb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Chown(ctx, in)
+		return srv.(SliverRPCServer).PivotGraph(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Chown",
+		FullMethod: "/rpcpb.SliverRPC/PivotGraph",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Chown(ctx, req.(*sliverpb.ChownReq))
+		return srv.(SliverRPCServer).PivotGraph(ctx, req.(*commonpb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Chtimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ChtimesReq)
+func _SliverRPC_StartService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.StartServiceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Chtimes(ctx, in)
+		return srv.(SliverRPCServer).StartService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Chtimes",
+		FullMethod: "/rpcpb.SliverRPC/StartService",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Chtimes(ctx, req.(*sliverpb.ChtimesReq))
+		return srv.(SliverRPCServer).StartService(ctx, req.(*sliverpb.StartServiceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MemfilesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.MemfilesListReq)
+func _SliverRPC_StopService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.StopServiceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MemfilesList(ctx, in)
+		return srv.(SliverRPCServer).StopService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MemfilesList",
+		FullMethod: "/rpcpb.SliverRPC/StopService",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MemfilesList(ctx, req.(*sliverpb.MemfilesListReq))
+		return srv.(SliverRPCServer).StopService(ctx, req.(*sliverpb.StopServiceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MemfilesAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.MemfilesAddReq)
+func _SliverRPC_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RemoveServiceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MemfilesAdd(ctx, in)
+		return srv.(SliverRPCServer).RemoveService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MemfilesAdd",
+		FullMethod: "/rpcpb.SliverRPC/RemoveService",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MemfilesAdd(ctx, req.(*sliverpb.MemfilesAddReq))
+		return srv.(SliverRPCServer).RemoveService(ctx, req.(*sliverpb.RemoveServiceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MemfilesRm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.MemfilesRmReq)
+func _SliverRPC_Services_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ServicesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MemfilesRm(ctx, in)
+		return srv.(SliverRPCServer).Services(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MemfilesRm",
+		FullMethod: "/rpcpb.SliverRPC/Services",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MemfilesRm(ctx, req.(*sliverpb.MemfilesRmReq))
+		return srv.(SliverRPCServer).Services(ctx, req.(*sliverpb.ServicesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ProcessDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ProcessDumpReq)
+func _SliverRPC_ServiceStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ServiceStartReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ProcessDump(ctx, in)
+		return srv.(SliverRPCServer).ServiceStart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ProcessDump",
+		FullMethod: "/rpcpb.SliverRPC/ServiceStart",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ProcessDump(ctx, req.(*sliverpb.ProcessDumpReq))
+		return srv.(SliverRPCServer).ServiceStart(ctx, req.(*sliverpb.ServiceStartReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RunAs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RunAsReq)
+func _SliverRPC_CreateService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.CreateServiceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RunAs(ctx, in)
+		return srv.(SliverRPCServer).CreateService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RunAs",
+		FullMethod: "/rpcpb.SliverRPC/CreateService",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RunAs(ctx, req.(*sliverpb.RunAsReq))
+		return srv.(SliverRPCServer).CreateService(ctx, req.(*sliverpb.CreateServiceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Impersonate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ImpersonateReq)
+func _SliverRPC_ServiceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ServiceConfigReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Impersonate(ctx, in)
+		return srv.(SliverRPCServer).ServiceConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Impersonate",
+		FullMethod: "/rpcpb.SliverRPC/ServiceConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Impersonate(ctx, req.(*sliverpb.ImpersonateReq))
+		return srv.(SliverRPCServer).ServiceConfig(ctx, req.(*sliverpb.ServiceConfigReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RevToSelf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RevToSelfReq)
+func _SliverRPC_MakeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.MakeTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RevToSelf(ctx, in)
+		return srv.(SliverRPCServer).MakeToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RevToSelf",
+		FullMethod: "/rpcpb.SliverRPC/MakeToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RevToSelf(ctx, req.(*sliverpb.RevToSelfReq))
+		return srv.(SliverRPCServer).MakeToken(ctx, req.(*sliverpb.MakeTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetSystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.GetSystemReq)
+func _SliverRPC_StealToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.StealTokenReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetSystem(ctx, in)
+		return srv.(SliverRPCServer).StealToken(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetSystem",
+		FullMethod: "/rpcpb.SliverRPC/StealToken",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetSystem(ctx, req.(*clientpb.GetSystemReq))
+		return srv.(SliverRPCServer).StealToken(ctx, req.(*sliverpb.StealTokenReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Task_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.TaskReq)
+func _SliverRPC_Tokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.TokensReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Task(ctx, in)
+		return srv.(SliverRPCServer).Tokens(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Task",
+		FullMethod: "/rpcpb.SliverRPC/Tokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Task(ctx, req.(*sliverpb.TaskReq))
+		return srv.(SliverRPCServer).Tokens(ctx, req.(*sliverpb.TokensReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Msf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.MSFReq)
+func _SliverRPC_GetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.EnvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Msf(ctx, in)
+		return srv.(SliverRPCServer).GetEnv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Msf",
+		FullMethod: "/rpcpb.SliverRPC/GetEnv",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Msf(ctx, req.(*clientpb.MSFReq))
+		return srv.(SliverRPCServer).GetEnv(ctx, req.(*sliverpb.EnvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MsfRemote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.MSFRemoteReq)
+func _SliverRPC_SetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.SetEnvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MsfRemote(ctx, in)
+		return srv.(SliverRPCServer).SetEnv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MsfRemote",
+		FullMethod: "/rpcpb.SliverRPC/SetEnv",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MsfRemote(ctx, req.(*clientpb.MSFRemoteReq))
+		return srv.(SliverRPCServer).SetEnv(ctx, req.(*sliverpb.SetEnvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ExecuteAssembly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ExecuteAssemblyReq)
+func _SliverRPC_UnsetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.UnsetEnvReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ExecuteAssembly(ctx, in)
+		return srv.(SliverRPCServer).UnsetEnv(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ExecuteAssembly",
+		FullMethod: "/rpcpb.SliverRPC/UnsetEnv",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ExecuteAssembly(ctx, req.(*sliverpb.ExecuteAssemblyReq))
+		return srv.(SliverRPCServer).UnsetEnv(ctx, req.(*sliverpb.UnsetEnvReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Migrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.MigrateReq)
+func _SliverRPC_Backdoor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.BackdoorReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Migrate(ctx, in)
+		return srv.(SliverRPCServer).Backdoor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Migrate",
+		FullMethod: "/rpcpb.SliverRPC/Backdoor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Migrate(ctx, req.(*clientpb.MigrateReq))
+		return srv.(SliverRPCServer).Backdoor(ctx, req.(*sliverpb.BackdoorReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ExecuteReq)
+func _SliverRPC_RegistryRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistryReadReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Execute(ctx, in)
+		return srv.(SliverRPCServer).RegistryRead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Execute",
+		FullMethod: "/rpcpb.SliverRPC/RegistryRead",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Execute(ctx, req.(*sliverpb.ExecuteReq))
+		return srv.(SliverRPCServer).RegistryRead(ctx, req.(*sliverpb.RegistryReadReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_ExecuteWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ExecuteWindowsReq)
+func _SliverRPC_RegistryWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistryWriteReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).ExecuteWindows(ctx, in)
+		return srv.(SliverRPCServer).RegistryWrite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/ExecuteWindows",
+		FullMethod: "/rpcpb.SliverRPC/RegistryWrite",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).ExecuteWindows(ctx, req.(*sliverpb.ExecuteWindowsReq))
+		return srv.(SliverRPCServer).RegistryWrite(ctx, req.(*sliverpb.RegistryWriteReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Sideload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.SideloadReq)
+func _SliverRPC_RegistryCreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistryCreateKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Sideload(ctx, in)
+		return srv.(SliverRPCServer).RegistryCreateKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Sideload",
+		FullMethod: "/rpcpb.SliverRPC/RegistryCreateKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Sideload(ctx, req.(*sliverpb.SideloadReq))
+		return srv.(SliverRPCServer).RegistryCreateKey(ctx, req.(*sliverpb.RegistryCreateKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_SpawnDll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.InvokeSpawnDllReq)
+func _SliverRPC_RegistryDeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistryDeleteKeyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).SpawnDll(ctx, in)
+		return srv.(SliverRPCServer).RegistryDeleteKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/SpawnDll",
+		FullMethod: "/rpcpb.SliverRPC/RegistryDeleteKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).SpawnDll(ctx, req.(*sliverpb.InvokeSpawnDllReq))
+		return srv.(SliverRPCServer).RegistryDeleteKey(ctx, req.(*sliverpb.RegistryDeleteKeyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Screenshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.ScreenshotReq)
+func _SliverRPC_RegistryListSubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistrySubKeyListReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Screenshot(ctx, in)
+		return srv.(SliverRPCServer).RegistryListSubKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Screenshot",
+		FullMethod: "/rpcpb.SliverRPC/RegistryListSubKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Screenshot(ctx, req.(*sliverpb.ScreenshotReq))
+		return srv.(SliverRPCServer).RegistryListSubKeys(ctx, req.(*sliverpb.RegistrySubKeyListReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_CurrentTokenOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.CurrentTokenOwnerReq)
+func _SliverRPC_RegistryListValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistryListValuesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).CurrentTokenOwner(ctx, in)
+		return srv.(SliverRPCServer).RegistryListValues(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/CurrentTokenOwner",
+		FullMethod: "/rpcpb.SliverRPC/RegistryListValues",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).CurrentTokenOwner(ctx, req.(*sliverpb.CurrentTokenOwnerReq))
+		return srv.(SliverRPCServer).RegistryListValues(ctx, req.(*sliverpb.RegistryListValuesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_PivotStartListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.PivotStartListenerReq)
+func _SliverRPC_RegistryExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistryExportReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).PivotStartListener(ctx, in)
+		return srv.(SliverRPCServer).RegistryExport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/PivotStartListener",
+		FullMethod: "/rpcpb.SliverRPC/RegistryExport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).PivotStartListener(ctx, req.(*sliverpb.PivotStartListenerReq))
+		return srv.(SliverRPCServer).RegistryExport(ctx, req.(*sliverpb.RegistryExportReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_PivotStopListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.PivotStopListenerReq)
+func _SliverRPC_RegistrySearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RegistrySearchReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).PivotStopListener(ctx, in)
+		return srv.(SliverRPCServer).RegistrySearch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/PivotStopListener",
+		FullMethod: "/rpcpb.SliverRPC/RegistrySearch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).PivotStopListener(ctx, req.(*sliverpb.PivotStopListenerReq))
+		return srv.(SliverRPCServer).RegistrySearch(ctx, req.(*sliverpb.RegistrySearchReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_PivotSessionListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.PivotListenersReq)
+func _SliverRPC_TCC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.TCCReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).PivotSessionListeners(ctx, in)
+		return srv.(SliverRPCServer).TCC(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/PivotSessionListeners",
+		FullMethod: "/rpcpb.SliverRPC/TCC",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).PivotSessionListeners(ctx, req.(*sliverpb.PivotListenersReq))
+		return srv.(SliverRPCServer).TCC(ctx, req.(*sliverpb.TCCReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_PivotGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(commonpb.Empty)
+func _SliverRPC_Keychain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.KeychainReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).PivotGraph(ctx, in)
+		return srv.(SliverRPCServer).Keychain(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/PivotGraph",
+		FullMethod: "/rpcpb.SliverRPC/Keychain",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).PivotGraph(ctx, req.(*commonpb.Empty))
+		return srv.(SliverRPCServer).Keychain(ctx, req.(*sliverpb.KeychainReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.StartServiceReq)
+func _SliverRPC_Launchd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.LaunchdReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartService(ctx, in)
+		return srv.(SliverRPCServer).Launchd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartService",
+		FullMethod: "/rpcpb.SliverRPC/Launchd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartService(ctx, req.(*sliverpb.StartServiceReq))
+		return srv.(SliverRPCServer).Launchd(ctx, req.(*sliverpb.LaunchdReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StopService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.StopServiceReq)
+func _SliverRPC_ConfigProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ConfigProfilesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StopService(ctx, in)
+		return srv.(SliverRPCServer).ConfigProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StopService",
+		FullMethod: "/rpcpb.SliverRPC/ConfigProfiles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StopService(ctx, req.(*sliverpb.StopServiceReq))
+		return srv.(SliverRPCServer).ConfigProfiles(ctx, req.(*sliverpb.ConfigProfilesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RemoveService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RemoveServiceReq)
+func _SliverRPC_RunSSHCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.SSHCommandReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RemoveService(ctx, in)
+		return srv.(SliverRPCServer).RunSSHCommand(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RemoveService",
+		FullMethod: "/rpcpb.SliverRPC/RunSSHCommand",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RemoveService(ctx, req.(*sliverpb.RemoveServiceReq))
+		return srv.(SliverRPCServer).RunSSHCommand(ctx, req.(*sliverpb.SSHCommandReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_MakeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.MakeTokenReq)
+func _SliverRPC_SSHHarvest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.SSHHarvestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).MakeToken(ctx, in)
+		return srv.(SliverRPCServer).SSHHarvest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/MakeToken",
+		FullMethod: "/rpcpb.SliverRPC/SSHHarvest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).MakeToken(ctx, req.(*sliverpb.MakeTokenReq))
+		return srv.(SliverRPCServer).SSHHarvest(ctx, req.(*sliverpb.SSHHarvestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.EnvReq)
+func _SliverRPC_HijackDLL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(clientpb.DllHijackReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetEnv(ctx, in)
+		return srv.(SliverRPCServer).HijackDLL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetEnv",
+		FullMethod: "/rpcpb.SliverRPC/HijackDLL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetEnv(ctx, req.(*sliverpb.EnvReq))
+		return srv.(SliverRPCServer).HijackDLL(ctx, req.(*clientpb.DllHijackReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_SetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.SetEnvReq)
+func _SliverRPC_GetPrivs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.GetPrivsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).SetEnv(ctx, in)
+		return srv.(SliverRPCServer).GetPrivs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/SetEnv",
+		FullMethod: "/rpcpb.SliverRPC/GetPrivs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).SetEnv(ctx, req.(*sliverpb.SetEnvReq))
+		return srv.(SliverRPCServer).GetPrivs(ctx, req.(*sliverpb.GetPrivsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_UnsetEnv_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.UnsetEnvReq)
+func _SliverRPC_LogonSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.LogonSessionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).UnsetEnv(ctx, in)
+		return srv.(SliverRPCServer).LogonSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/UnsetEnv",
+		FullMethod: "/rpcpb.SliverRPC/LogonSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).UnsetEnv(ctx, req.(*sliverpb.UnsetEnvReq))
+		return srv.(SliverRPCServer).LogonSessions(ctx, req.(*sliverpb.LogonSessionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_Backdoor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.BackdoorReq)
+func _SliverRPC_LocalGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.LocalGroupsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).Backdoor(ctx, in)
+		return srv.(SliverRPCServer).LocalGroups(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/Backdoor",
+		FullMethod: "/rpcpb.SliverRPC/LocalGroups",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).Backdoor(ctx, req.(*sliverpb.BackdoorReq))
+		return srv.(SliverRPCServer).LocalGroups(ctx, req.(*sliverpb.LocalGroupsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RegistryRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RegistryReadReq)
+func _SliverRPC_ServiceHijacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ServiceHijacksReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RegistryRead(ctx, in)
+		return srv.(SliverRPCServer).ServiceHijacks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RegistryRead",
+		FullMethod: "/rpcpb.SliverRPC/ServiceHijacks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RegistryRead(ctx, req.(*sliverpb.RegistryReadReq))
+		return srv.(SliverRPCServer).ServiceHijacks(ctx, req.(*sliverpb.ServiceHijacksReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RegistryWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RegistryWriteReq)
+func _SliverRPC_AdcsEnum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.AdcsEnumReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RegistryWrite(ctx, in)
+		return srv.(SliverRPCServer).AdcsEnum(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RegistryWrite",
+		FullMethod: "/rpcpb.SliverRPC/AdcsEnum",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RegistryWrite(ctx, req.(*sliverpb.RegistryWriteReq))
+		return srv.(SliverRPCServer).AdcsEnum(ctx, req.(*sliverpb.AdcsEnumReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RegistryCreateKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RegistryCreateKeyReq)
+func _SliverRPC_AdcsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.AdcsRequestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RegistryCreateKey(ctx, in)
+		return srv.(SliverRPCServer).AdcsRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RegistryCreateKey",
+		FullMethod: "/rpcpb.SliverRPC/AdcsRequest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RegistryCreateKey(ctx, req.(*sliverpb.RegistryCreateKeyReq))
+		return srv.(SliverRPCServer).AdcsRequest(ctx, req.(*sliverpb.AdcsRequestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RegistryDeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RegistryDeleteKeyReq)
+func _SliverRPC_ValidateCreds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.ValidateCredsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RegistryDeleteKey(ctx, in)
+		return srv.(SliverRPCServer).ValidateCreds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RegistryDeleteKey",
+		FullMethod: "/rpcpb.SliverRPC/ValidateCreds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RegistryDeleteKey(ctx, req.(*sliverpb.RegistryDeleteKeyReq))
+		return srv.(SliverRPCServer).ValidateCreds(ctx, req.(*sliverpb.ValidateCredsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RegistryListSubKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RegistrySubKeyListReq)
+func _SliverRPC_Presence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.PresenceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RegistryListSubKeys(ctx, in)
+		return srv.(SliverRPCServer).Presence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RegistryListSubKeys",
+		FullMethod: "/rpcpb.SliverRPC/Presence",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RegistryListSubKeys(ctx, req.(*sliverpb.RegistrySubKeyListReq))
+		return srv.(SliverRPCServer).Presence(ctx, req.(*sliverpb.PresenceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RegistryListValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RegistryListValuesReq)
+func _SliverRPC_Survey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.SurveyReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RegistryListValues(ctx, in)
+		return srv.(SliverRPCServer).Survey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RegistryListValues",
+		FullMethod: "/rpcpb.SliverRPC/Survey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RegistryListValues(ctx, req.(*sliverpb.RegistryListValuesReq))
+		return srv.(SliverRPCServer).Survey(ctx, req.(*sliverpb.SurveyReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_RunSSHCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.SSHCommandReq)
+func _SliverRPC_StartRportFwdListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RportFwdStartListenerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).RunSSHCommand(ctx, in)
+		return srv.(SliverRPCServer).StartRportFwdListener(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/RunSSHCommand",
+		FullMethod: "/rpcpb.SliverRPC/StartRportFwdListener",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).RunSSHCommand(ctx, req.(*sliverpb.SSHCommandReq))
+		return srv.(SliverRPCServer).StartRportFwdListener(ctx, req.(*sliverpb.RportFwdStartListenerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_HijackDLL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(clientpb.DllHijackReq)
+func _SliverRPC_GetRportFwdListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RportFwdListenersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).HijackDLL(ctx, in)
+		return srv.(SliverRPCServer).GetRportFwdListeners(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/HijackDLL",
+		FullMethod: "/rpcpb.SliverRPC/GetRportFwdListeners",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).HijackDLL(ctx, req.(*clientpb.DllHijackReq))
+		return srv.(SliverRPCServer).GetRportFwdListeners(ctx, req.(*sliverpb.RportFwdListenersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetPrivs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.GetPrivsReq)
+func _SliverRPC_StopRportFwdListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.RportFwdStopListenerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetPrivs(ctx, in)
+		return srv.(SliverRPCServer).StopRportFwdListener(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetPrivs",
+		FullMethod: "/rpcpb.SliverRPC/StopRportFwdListener",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetPrivs(ctx, req.(*sliverpb.GetPrivsReq))
+		return srv.(SliverRPCServer).StopRportFwdListener(ctx, req.(*sliverpb.RportFwdStopListenerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StartRportFwdListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RportFwdStartListenerReq)
+func _SliverRPC_GetNTLMListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.NTLMListenersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StartRportFwdListener(ctx, in)
+		return srv.(SliverRPCServer).GetNTLMListeners(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StartRportFwdListener",
+		FullMethod: "/rpcpb.SliverRPC/GetNTLMListeners",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StartRportFwdListener(ctx, req.(*sliverpb.RportFwdStartListenerReq))
+		return srv.(SliverRPCServer).GetNTLMListeners(ctx, req.(*sliverpb.NTLMListenersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_GetRportFwdListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RportFwdListenersReq)
+func _SliverRPC_StartNTLMListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.NTLMStartListenerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).GetRportFwdListeners(ctx, in)
+		return srv.(SliverRPCServer).StartNTLMListener(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/GetRportFwdListeners",
+		FullMethod: "/rpcpb.SliverRPC/StartNTLMListener",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).GetRportFwdListeners(ctx, req.(*sliverpb.RportFwdListenersReq))
+		return srv.(SliverRPCServer).StartNTLMListener(ctx, req.(*sliverpb.NTLMStartListenerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SliverRPC_StopRportFwdListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sliverpb.RportFwdStopListenerReq)
+func _SliverRPC_StopNTLMListener_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.NTLMStopListenerReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SliverRPCServer).StopRportFwdListener(ctx, in)
+		return srv.(SliverRPCServer).StopNTLMListener(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.SliverRPC/StopRportFwdListener",
+		FullMethod: "/rpcpb.SliverRPC/StopNTLMListener",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SliverRPCServer).StopRportFwdListener(ctx, req.(*sliverpb.RportFwdStopListenerReq))
+		return srv.(SliverRPCServer).StopNTLMListener(ctx, req.(*sliverpb.NTLMStopListenerReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4482,6 +6490,24 @@ func _SliverRPC_WGListSocksServers_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SliverRPC_WGRotateKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sliverpb.WGRotateKeysReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SliverRPCServer).WGRotateKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.SliverRPC/WGRotateKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SliverRPCServer).WGRotateKeys(ctx, req.(*sliverpb.WGRotateKeysReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SliverRPC_Shell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(sliverpb.ShellReq)
 	if err := dec(in); err != nil {
@@ -4678,6 +6704,14 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOperators",
 			Handler:    _SliverRPC_GetOperators_Handler,
 		},
+		{
+			MethodName: "GetSecondFactorChallenge",
+			Handler:    _SliverRPC_GetSecondFactorChallenge_Handler,
+		},
+		{
+			MethodName: "VerifySecondFactor",
+			Handler:    _SliverRPC_VerifySecondFactor_Handler,
+		},
 		{
 			MethodName: "Kill",
 			Handler:    _SliverRPC_Kill_Handler,
@@ -4686,6 +6720,14 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reconfigure",
 			Handler:    _SliverRPC_Reconfigure_Handler,
 		},
+		{
+			MethodName: "ProxySet",
+			Handler:    _SliverRPC_ProxySet_Handler,
+		},
+		{
+			MethodName: "Redirect",
+			Handler:    _SliverRPC_Redirect_Handler,
+		},
 		{
 			MethodName: "Rename",
 			Handler:    _SliverRPC_Rename_Handler,
@@ -4718,6 +6760,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelBeaconTask",
 			Handler:    _SliverRPC_CancelBeaconTask_Handler,
 		},
+		{
+			MethodName: "ReorderBeaconTasks",
+			Handler:    _SliverRPC_ReorderBeaconTasks_Handler,
+		},
 		{
 			MethodName: "MonitorStart",
 			Handler:    _SliverRPC_MonitorStart_Handler,
@@ -4734,6 +6780,14 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KillJob",
 			Handler:    _SliverRPC_KillJob_Handler,
 		},
+		{
+			MethodName: "GetListenerSettings",
+			Handler:    _SliverRPC_GetListenerSettings_Handler,
+		},
+		{
+			MethodName: "UpdateListenerSettings",
+			Handler:    _SliverRPC_UpdateListenerSettings_Handler,
+		},
 		{
 			MethodName: "StartMTLSListener",
 			Handler:    _SliverRPC_StartMTLSListener_Handler,
@@ -4754,14 +6808,38 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartHTTPListener",
 			Handler:    _SliverRPC_StartHTTPListener_Handler,
 		},
+		{
+			MethodName: "StartICMPListener",
+			Handler:    _SliverRPC_StartICMPListener_Handler,
+		},
+		{
+			MethodName: "StartUDPListener",
+			Handler:    _SliverRPC_StartUDPListener_Handler,
+		},
+		{
+			MethodName: "GetHTTPC2Profiles",
+			Handler:    _SliverRPC_GetHTTPC2Profiles_Handler,
+		},
+		{
+			MethodName: "SaveHTTPC2Profile",
+			Handler:    _SliverRPC_SaveHTTPC2Profile_Handler,
+		},
 		{
 			MethodName: "StartTCPStagerListener",
 			Handler:    _SliverRPC_StartTCPStagerListener_Handler,
 		},
+		{
+			MethodName: "StartShellUpgradeListener",
+			Handler:    _SliverRPC_StartShellUpgradeListener_Handler,
+		},
 		{
 			MethodName: "StartHTTPStagerListener",
 			Handler:    _SliverRPC_StartHTTPStagerListener_Handler,
 		},
+		{
+			MethodName: "StartWarmup",
+			Handler:    _SliverRPC_StartWarmup_Handler,
+		},
 		{
 			MethodName: "LootAdd",
 			Handler:    _SliverRPC_LootAdd_Handler,
@@ -4802,6 +6880,62 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HostIOCRm",
 			Handler:    _SliverRPC_HostIOCRm_Handler,
 		},
+		{
+			MethodName: "ParseOutput",
+			Handler:    _SliverRPC_ParseOutput_Handler,
+		},
+		{
+			MethodName: "HostTags",
+			Handler:    _SliverRPC_HostTags_Handler,
+		},
+		{
+			MethodName: "HostSurvey",
+			Handler:    _SliverRPC_HostSurvey_Handler,
+		},
+		{
+			MethodName: "GetPlaybooks",
+			Handler:    _SliverRPC_GetPlaybooks_Handler,
+		},
+		{
+			MethodName: "SavePlaybook",
+			Handler:    _SliverRPC_SavePlaybook_Handler,
+		},
+		{
+			MethodName: "RemovePlaybook",
+			Handler:    _SliverRPC_RemovePlaybook_Handler,
+		},
+		{
+			MethodName: "RunPlaybook",
+			Handler:    _SliverRPC_RunPlaybook_Handler,
+		},
+		{
+			MethodName: "GetPlaybookRuns",
+			Handler:    _SliverRPC_GetPlaybookRuns_Handler,
+		},
+		{
+			MethodName: "ApprovePlaybookStep",
+			Handler:    _SliverRPC_ApprovePlaybookStep_Handler,
+		},
+		{
+			MethodName: "PersistenceAdd",
+			Handler:    _SliverRPC_PersistenceAdd_Handler,
+		},
+		{
+			MethodName: "PersistenceRm",
+			Handler:    _SliverRPC_PersistenceRm_Handler,
+		},
+		{
+			MethodName: "PersistenceAll",
+			Handler:    _SliverRPC_PersistenceAll_Handler,
+		},
+		{
+			MethodName: "GetEngagement",
+			Handler:    _SliverRPC_GetEngagement_Handler,
+		},
+		{
+			MethodName: "SetEngagement",
+			Handler:    _SliverRPC_SetEngagement_Handler,
+		},
 		{
 			MethodName: "Generate",
 			Handler:    _SliverRPC_Generate_Handler,
@@ -4882,6 +7016,22 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShellcodeEncoderMap",
 			Handler:    _SliverRPC_ShellcodeEncoderMap_Handler,
 		},
+		{
+			MethodName: "ShellcodeArtifactConvert",
+			Handler:    _SliverRPC_ShellcodeArtifactConvert_Handler,
+		},
+		{
+			MethodName: "ShellcodeArtifacts",
+			Handler:    _SliverRPC_ShellcodeArtifacts_Handler,
+		},
+		{
+			MethodName: "ShellcodeArtifactContent",
+			Handler:    _SliverRPC_ShellcodeArtifactContent_Handler,
+		},
+		{
+			MethodName: "ShellcodeArtifactRm",
+			Handler:    _SliverRPC_ShellcodeArtifactRm_Handler,
+		},
 		{
 			MethodName: "Websites",
 			Handler:    _SliverRPC_Websites_Handler,
@@ -4930,6 +7080,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Ls",
 			Handler:    _SliverRPC_Ls_Handler,
 		},
+		{
+			MethodName: "Search",
+			Handler:    _SliverRPC_Search_Handler,
+		},
 		{
 			MethodName: "Cd",
 			Handler:    _SliverRPC_Cd_Handler,
@@ -4958,6 +7112,14 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Upload",
 			Handler:    _SliverRPC_Upload_Handler,
 		},
+		{
+			MethodName: "GetMissingUploadChunks",
+			Handler:    _SliverRPC_GetMissingUploadChunks_Handler,
+		},
+		{
+			MethodName: "SaveUploadChunk",
+			Handler:    _SliverRPC_SaveUploadChunk_Handler,
+		},
 		{
 			MethodName: "Chmod",
 			Handler:    _SliverRPC_Chmod_Handler,
@@ -4986,6 +7148,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessDump",
 			Handler:    _SliverRPC_ProcessDump_Handler,
 		},
+		{
+			MethodName: "LsassDump",
+			Handler:    _SliverRPC_LsassDump_Handler,
+		},
 		{
 			MethodName: "RunAs",
 			Handler:    _SliverRPC_RunAs_Handler,
@@ -5034,6 +7200,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Sideload",
 			Handler:    _SliverRPC_Sideload_Handler,
 		},
+		{
+			MethodName: "InlineExecute",
+			Handler:    _SliverRPC_InlineExecute_Handler,
+		},
 		{
 			MethodName: "SpawnDll",
 			Handler:    _SliverRPC_SpawnDll_Handler,
@@ -5042,6 +7212,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Screenshot",
 			Handler:    _SliverRPC_Screenshot_Handler,
 		},
+		{
+			MethodName: "Clipboard",
+			Handler:    _SliverRPC_Clipboard_Handler,
+		},
 		{
 			MethodName: "CurrentTokenOwner",
 			Handler:    _SliverRPC_CurrentTokenOwner_Handler,
@@ -5058,6 +7232,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PivotSessionListeners",
 			Handler:    _SliverRPC_PivotSessionListeners_Handler,
 		},
+		{
+			MethodName: "PivotAllowPeers",
+			Handler:    _SliverRPC_PivotAllowPeers_Handler,
+		},
 		{
 			MethodName: "PivotGraph",
 			Handler:    _SliverRPC_PivotGraph_Handler,
@@ -5074,10 +7252,34 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveService",
 			Handler:    _SliverRPC_RemoveService_Handler,
 		},
+		{
+			MethodName: "Services",
+			Handler:    _SliverRPC_Services_Handler,
+		},
+		{
+			MethodName: "ServiceStart",
+			Handler:    _SliverRPC_ServiceStart_Handler,
+		},
+		{
+			MethodName: "CreateService",
+			Handler:    _SliverRPC_CreateService_Handler,
+		},
+		{
+			MethodName: "ServiceConfig",
+			Handler:    _SliverRPC_ServiceConfig_Handler,
+		},
 		{
 			MethodName: "MakeToken",
 			Handler:    _SliverRPC_MakeToken_Handler,
 		},
+		{
+			MethodName: "StealToken",
+			Handler:    _SliverRPC_StealToken_Handler,
+		},
+		{
+			MethodName: "Tokens",
+			Handler:    _SliverRPC_Tokens_Handler,
+		},
 		{
 			MethodName: "GetEnv",
 			Handler:    _SliverRPC_GetEnv_Handler,
@@ -5118,10 +7320,38 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegistryListValues",
 			Handler:    _SliverRPC_RegistryListValues_Handler,
 		},
+		{
+			MethodName: "RegistryExport",
+			Handler:    _SliverRPC_RegistryExport_Handler,
+		},
+		{
+			MethodName: "RegistrySearch",
+			Handler:    _SliverRPC_RegistrySearch_Handler,
+		},
+		{
+			MethodName: "TCC",
+			Handler:    _SliverRPC_TCC_Handler,
+		},
+		{
+			MethodName: "Keychain",
+			Handler:    _SliverRPC_Keychain_Handler,
+		},
+		{
+			MethodName: "Launchd",
+			Handler:    _SliverRPC_Launchd_Handler,
+		},
+		{
+			MethodName: "ConfigProfiles",
+			Handler:    _SliverRPC_ConfigProfiles_Handler,
+		},
 		{
 			MethodName: "RunSSHCommand",
 			Handler:    _SliverRPC_RunSSHCommand_Handler,
 		},
+		{
+			MethodName: "SSHHarvest",
+			Handler:    _SliverRPC_SSHHarvest_Handler,
+		},
 		{
 			MethodName: "HijackDLL",
 			Handler:    _SliverRPC_HijackDLL_Handler,
@@ -5130,6 +7360,38 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPrivs",
 			Handler:    _SliverRPC_GetPrivs_Handler,
 		},
+		{
+			MethodName: "LogonSessions",
+			Handler:    _SliverRPC_LogonSessions_Handler,
+		},
+		{
+			MethodName: "LocalGroups",
+			Handler:    _SliverRPC_LocalGroups_Handler,
+		},
+		{
+			MethodName: "ServiceHijacks",
+			Handler:    _SliverRPC_ServiceHijacks_Handler,
+		},
+		{
+			MethodName: "AdcsEnum",
+			Handler:    _SliverRPC_AdcsEnum_Handler,
+		},
+		{
+			MethodName: "AdcsRequest",
+			Handler:    _SliverRPC_AdcsRequest_Handler,
+		},
+		{
+			MethodName: "ValidateCreds",
+			Handler:    _SliverRPC_ValidateCreds_Handler,
+		},
+		{
+			MethodName: "Presence",
+			Handler:    _SliverRPC_Presence_Handler,
+		},
+		{
+			MethodName: "Survey",
+			Handler:    _SliverRPC_Survey_Handler,
+		},
 		{
 			MethodName: "StartRportFwdListener",
 			Handler:    _SliverRPC_StartRportFwdListener_Handler,
@@ -5142,6 +7404,18 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopRportFwdListener",
 			Handler:    _SliverRPC_StopRportFwdListener_Handler,
 		},
+		{
+			MethodName: "GetNTLMListeners",
+			Handler:    _SliverRPC_GetNTLMListeners_Handler,
+		},
+		{
+			MethodName: "StartNTLMListener",
+			Handler:    _SliverRPC_StartNTLMListener_Handler,
+		},
+		{
+			MethodName: "StopNTLMListener",
+			Handler:    _SliverRPC_StopNTLMListener_Handler,
+		},
 		{
 			MethodName: "OpenSession",
 			Handler:    _SliverRPC_OpenSession_Handler,
@@ -5186,6 +7460,10 @@ var SliverRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WGListSocksServers",
 			Handler:    _SliverRPC_WGListSocksServers_Handler,
 		},
+		{
+			MethodName: "WGRotateKeys",
+			Handler:    _SliverRPC_WGRotateKeys_Handler,
+		},
 		{
 			MethodName: "Shell",
 			Handler:    _SliverRPC_Shell_Handler,
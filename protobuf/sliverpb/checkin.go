@@ -0,0 +1,37 @@
+package sliverpb
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// QuietCheckinSize - Size of quiet check-ins and the server's no-task response to them
+const QuietCheckinSize = 64
+
+// MarshalQuietCheckin - Marshal a check-in padded to QuietCheckinSize, so every idle
+// check-in is the same size, messages that are already too large are not padded
+func MarshalQuietCheckin(checkin *BeaconTasks) ([]byte, error) {
+	checkin.Padding = nil
+	size := proto.Size(checkin) + 2 // Padding field tag and length
+	if size < QuietCheckinSize {
+		checkin.Padding = make([]byte, QuietCheckinSize-size)
+	}
+	return proto.Marshal(checkin)
+}
@@ -0,0 +1,60 @@
+package sliverpb
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarshalQuietCheckin(t *testing.T) {
+	checkins := []*BeaconTasks{
+		{},
+		{ID: "8b5aa5c0-4c2b-4ee1-9c5f-6a79a1a5f2c4", NextCheckin: 1},
+		{ID: "8b5aa5c0-4c2b-4ee1-9c5f-6a79a1a5f2c4", NextCheckin: 90},
+		{ID: "8b5aa5c0-4c2b-4ee1-9c5f-6a79a1a5f2c4", NextCheckin: 86400 * 365},
+		{ID: "8b5aa5c0-4c2b-4ee1-9c5f-6a79a1a5f2c4", NextCheckin: 86400 * 365, MessageID: 1<<63 - 1},
+	}
+	for _, checkin := range checkins {
+		data, err := MarshalQuietCheckin(checkin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(data) != QuietCheckinSize {
+			t.Fatalf("expected %d bytes got %d (%v)", QuietCheckinSize, len(data), checkin)
+		}
+		parsed := &BeaconTasks{}
+		if err := proto.Unmarshal(data, parsed); err != nil {
+			t.Fatal(err)
+		}
+		if parsed.ID != checkin.ID || parsed.NextCheckin != checkin.NextCheckin || parsed.MessageID != checkin.MessageID || len(parsed.Padding) == 0 {
+			t.Fatalf("round trip mismatch %v != %v", parsed, checkin)
+		}
+	}
+
+	large := &BeaconTasks{Tasks: []*Envelope{{Data: make([]byte, QuietCheckinSize)}}}
+	data, err := MarshalQuietCheckin(large)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(large.Padding) != 0 || len(data) != proto.Size(large) {
+		t.Fatalf("large message was padded to %d bytes", len(data))
+	}
+}
@@ -326,6 +326,105 @@ const (
 	MsgMemfilesRmReq
 	// MsgChown - Replies with file path
 	MsgMemfilesRm
+
+	// MsgLogonSessionsReq - Enumerate logon sessions (Windows)
+	MsgLogonSessionsReq
+
+	// MsgPresenceReq - User presence signals, e.g. input idle time
+	MsgPresenceReq
+
+	// MsgTransferProgress - Progress of a large envelope over a slow transport
+	MsgTransferProgress
+
+	// MsgLocalGroupsReq - Survey local group members on remote hosts (Windows)
+	MsgLocalGroupsReq
+
+	// MsgServiceHijacksReq - Survey services that can be hijacked (Windows)
+	MsgServiceHijacksReq
+
+	// MsgWGRotateKeysReq - Switch a wg implant to new session keys
+	MsgWGRotateKeysReq
+
+	// MsgPivotAllowPeersReq - Replace the allowlist of a pivot listener
+	MsgPivotAllowPeersReq
+
+	// MsgProxySetReq - Override the proxy used by http(s) transports
+	MsgProxySetReq
+
+	// MsgTCCReq - Survey the macOS TCC permission databases
+	MsgTCCReq
+	// MsgKeychainReq - Enumerate or dump macOS keychain items
+	MsgKeychainReq
+	// MsgLaunchdReq - List macOS launchd jobs
+	MsgLaunchdReq
+	// MsgConfigProfilesReq - List installed macOS configuration profiles
+	MsgConfigProfilesReq
+	// MsgClipboardReq - Read the clipboard
+	MsgClipboardReq
+
+	// MsgAdcsEnumReq - Enumerate AD CS certificate authorities and templates
+	MsgAdcsEnumReq
+	// MsgAdcsRequestReq - Request a certificate from an AD CS web enrollment endpoint
+	MsgAdcsRequestReq
+
+	// MsgInlineExecuteReq - Run an unmanaged PE/ELF from memory and capture its output
+	MsgInlineExecuteReq
+	// MsgInlineExecute - Output and exit code of the inline execution
+	MsgInlineExecute
+
+	// MsgValidateCredsReq - Test credentials against network services
+	MsgValidateCredsReq
+	// MsgValidateCreds - Results of the credential tests
+	MsgValidateCreds
+
+	// MsgStealTokenReq - Impersonate the token of a process (Windows)
+	MsgStealTokenReq
+	// MsgStealToken - Owner of the stolen token
+	MsgStealToken
+	// MsgTokensReq - List, use, or remove the implant's tokens (Windows)
+	MsgTokensReq
+	// MsgTokens - The implant's tokens
+	MsgTokens
+
+	// MsgSearchReq - Search the file system for names and contents
+	MsgSearchReq
+	// MsgSearchMatches - Matches sent while a search is running
+	MsgSearchMatches
+
+	// MsgRedirectReq - Point the implant's transports at other C2s
+	MsgRedirectReq
+
+	// MsgRegistryExportReq - Read every key and value under a registry key
+	MsgRegistryExportReq
+	// MsgRegistrySearchReq - Search registry keys, values and data
+	MsgRegistrySearchReq
+
+	// MsgNTLMStartListenerReq - Start an ntlm capture listener on the implant
+	MsgNTLMStartListenerReq
+	// MsgNTLMStopListenerReq - Stop an ntlm capture listener
+	MsgNTLMStopListenerReq
+	// MsgNTLMListenersReq - List the ntlm capture listeners
+	MsgNTLMListenersReq
+	// MsgNTLMCaptures - Authentications captured by ntlm listeners
+	MsgNTLMCaptures
+
+	// MsgServicesReq - List the services of a host
+	MsgServicesReq
+	// MsgServiceStartReq - Start an installed service
+	MsgServiceStartReq
+	// MsgCreateServiceReq - Create a service without starting it
+	MsgCreateServiceReq
+	// MsgServiceConfigReq - Read or change a service's configuration
+	MsgServiceConfigReq
+
+	// MsgLsassDumpReq - Minidump lsass into an encrypted in-memory buffer
+	MsgLsassDumpReq
+
+	// MsgSSHHarvestReq - Enumerate ssh keys, known hosts and agents
+	MsgSSHHarvestReq
+
+	// MsgSurveyReq - Survey the host's os, patches, software and configuration
+	MsgSurveyReq
 )
 
 // Constants to replace enums
@@ -492,6 +591,48 @@ func MsgNumber(request proto.Message) uint32 {
 		return MsgWGListForwardersReq
 	case *WGSocksServersReq:
 		return MsgWGListSocksReq
+	case *WGRotateKeysReq:
+		return MsgWGRotateKeysReq
+	case *PivotAllowPeersReq:
+		return MsgPivotAllowPeersReq
+	case *ProxySetReq:
+		return MsgProxySetReq
+	case *TCCReq:
+		return MsgTCCReq
+	case *KeychainReq:
+		return MsgKeychainReq
+	case *LaunchdReq:
+		return MsgLaunchdReq
+	case *ConfigProfilesReq:
+		return MsgConfigProfilesReq
+	case *ClipboardReq:
+		return MsgClipboardReq
+	case *AdcsEnumReq:
+		return MsgAdcsEnumReq
+	case *AdcsRequestReq:
+		return MsgAdcsRequestReq
+	case *InlineExecuteReq:
+		return MsgInlineExecuteReq
+	case *InlineExecute:
+		return MsgInlineExecute
+	case *ValidateCredsReq:
+		return MsgValidateCredsReq
+	case *ValidateCreds:
+		return MsgValidateCreds
+	case *StealTokenReq:
+		return MsgStealTokenReq
+	case *StealToken:
+		return MsgStealToken
+	case *TokensReq:
+		return MsgTokensReq
+	case *Tokens:
+		return MsgTokens
+	case *SearchReq:
+		return MsgSearchReq
+	case *SearchMatches:
+		return MsgSearchMatches
+	case *RedirectReq:
+		return MsgRedirectReq
 
 	case *PortfwdReq:
 		return MsgPortfwdReq
@@ -512,6 +653,35 @@ func MsgNumber(request proto.Message) uint32 {
 		return MsgRegistrySubKeysListReq
 	case *RegistryListValuesReq:
 		return MsgRegistryListValuesReq
+	case *RegistryExportReq:
+		return MsgRegistryExportReq
+	case *RegistrySearchReq:
+		return MsgRegistrySearchReq
+	case *NTLMStartListenerReq:
+		return MsgNTLMStartListenerReq
+	case *NTLMStopListenerReq:
+		return MsgNTLMStopListenerReq
+	case *NTLMListenersReq:
+		return MsgNTLMListenersReq
+	case *NTLMCaptures:
+		return MsgNTLMCaptures
+	case *ServicesReq:
+		return MsgServicesReq
+	case *ServiceStartReq:
+		return MsgServiceStartReq
+	case *CreateServiceReq:
+		return MsgCreateServiceReq
+	case *ServiceConfigReq:
+		return MsgServiceConfigReq
+
+	case *LsassDumpReq:
+		return MsgLsassDumpReq
+
+	case *SSHHarvestReq:
+		return MsgSSHHarvestReq
+
+	case *SurveyReq:
+		return MsgSurveyReq
 
 	case *RegisterExtensionReq:
 		return MsgRegisterExtensionReq
@@ -577,6 +747,18 @@ func MsgNumber(request proto.Message) uint32 {
 	case *MemfilesRm:
 		return MsgMemfilesRm
 
+	case *LogonSessionsReq:
+		return MsgLogonSessionsReq
+
+	case *PresenceReq:
+		return MsgPresenceReq
+	case *TransferProgress:
+		return MsgTransferProgress
+	case *LocalGroupsReq:
+		return MsgLocalGroupsReq
+	case *ServiceHijacksReq:
+		return MsgServiceHijacksReq
+
 	}
 	return uint32(0)
 }
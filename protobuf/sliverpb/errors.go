@@ -0,0 +1,152 @@
+package sliverpb
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrUnsupportedOS - Returned by handlers that are not available on the target's OS
+	ErrUnsupportedOS = errors.New("unsupported on this OS")
+	// ErrPayloadTooLarge - Returned when a message cannot be sent over the transport
+	ErrPayloadTooLarge = errors.New("payload too large for transport")
+
+	errorSummaries = map[commonpb.ErrorCode]string{
+		commonpb.ErrorCode_ACCESS_DENIED:     "access denied on target",
+		commonpb.ErrorCode_NOT_FOUND:         "not found on target",
+		commonpb.ErrorCode_ALREADY_EXISTS:    "already exists on target",
+		commonpb.ErrorCode_UNSUPPORTED_OS:    "unsupported on this OS",
+		commonpb.ErrorCode_PAYLOAD_TOO_LARGE: "payload too large for transport",
+		commonpb.ErrorCode_TIMEOUT:           "timed out on target",
+		commonpb.ErrorCode_INVALID_ARGUMENT:  "invalid argument",
+		commonpb.ErrorCode_CANCELED:          "canceled by the operator",
+	}
+)
+
+// ErrorResponse - Create a Response for an error, with the error code and detail
+// derived from the error
+func ErrorResponse(err error) *commonpb.Response {
+	if err == nil {
+		return &commonpb.Response{}
+	}
+	return &commonpb.Response{
+		Err:       err.Error(),
+		ErrCode:   ErrorCodeOf(err),
+		ErrDetail: errorDetail(err),
+	}
+}
+
+// TimeoutResponse - The response to a task that timed out, every response message
+// has its commonpb.Response in field 9 so this decodes as any of them
+func TimeoutResponse(timeout time.Duration) []byte {
+	data, _ := proto.Marshal(&Task{
+		Response: &commonpb.Response{
+			Err:     fmt.Sprintf("%s after %s", errorSummaries[commonpb.ErrorCode_TIMEOUT], timeout),
+			ErrCode: commonpb.ErrorCode_TIMEOUT,
+		},
+	})
+	return data
+}
+
+// CanceledResponse - The response to a task the operator canceled while it was
+// running, decodes as any response message just like TimeoutResponse
+func CanceledResponse() []byte {
+	data, _ := proto.Marshal(&Task{
+		Response: &commonpb.Response{
+			Err:     errorSummaries[commonpb.ErrorCode_CANCELED],
+			ErrCode: commonpb.ErrorCode_CANCELED,
+		},
+	})
+	return data
+}
+
+// ErrorCodeOf - Classify an error, returns UNKNOWN if the type of failure cannot
+// be determined and NONE if there is no error
+func ErrorCodeOf(err error) commonpb.ErrorCode {
+	switch {
+	case err == nil:
+		return commonpb.ErrorCode_NONE
+	case errors.Is(err, ErrUnsupportedOS):
+		return commonpb.ErrorCode_UNSUPPORTED_OS
+	case errors.Is(err, ErrPayloadTooLarge), errors.Is(err, syscall.EFBIG), errors.Is(err, syscall.E2BIG):
+		return commonpb.ErrorCode_PAYLOAD_TOO_LARGE
+	case errors.Is(err, fs.ErrPermission):
+		return commonpb.ErrorCode_ACCESS_DENIED
+	case errors.Is(err, fs.ErrNotExist), errors.Is(err, exec.ErrNotFound):
+		return commonpb.ErrorCode_NOT_FOUND
+	case errors.Is(err, fs.ErrExist):
+		return commonpb.ErrorCode_ALREADY_EXISTS
+	case errors.Is(err, os.ErrDeadlineExceeded), errors.Is(err, context.DeadlineExceeded):
+		return commonpb.ErrorCode_TIMEOUT
+	case errors.Is(err, context.Canceled):
+		return commonpb.ErrorCode_CANCELED
+	case errors.Is(err, fs.ErrInvalid):
+		return commonpb.ErrorCode_INVALID_ARGUMENT
+	}
+	return commonpb.ErrorCode_UNKNOWN
+}
+
+// errorDetail - The path or syscall the error is about, if any
+func errorDetail(err error) string {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Path
+	}
+	var linkErr *os.LinkError
+	if errors.As(err, &linkErr) {
+		return fmt.Sprintf("%s -> %s", linkErr.Old, linkErr.New)
+	}
+	var execErr *exec.Error
+	if errors.As(err, &execErr) {
+		return execErr.Name
+	}
+	var syscallErr *os.SyscallError
+	if errors.As(err, &syscallErr) {
+		return syscallErr.Syscall
+	}
+	return ""
+}
+
+// ErrorMessage - Render a Response's error as a message an operator can act on,
+// responses from older implants without an error code are returned as is
+func ErrorMessage(resp *commonpb.Response) string {
+	if resp == nil || resp.Err == "" {
+		return ""
+	}
+	summary, ok := errorSummaries[resp.ErrCode]
+	if !ok || strings.HasPrefix(resp.Err, summary) {
+		return resp.Err
+	}
+	if resp.ErrDetail != "" && !strings.Contains(resp.Err, resp.ErrDetail) {
+		return fmt.Sprintf("%s (%s): %s", summary, resp.ErrDetail, resp.Err)
+	}
+	return fmt.Sprintf("%s: %s", summary, resp.Err)
+}
@@ -0,0 +1,122 @@
+package sliverpb
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestErrorResponse(t *testing.T) {
+	missing := filepath.Join(t.TempDir(), "missing")
+	_, err := os.ReadFile(missing)
+	resp := ErrorResponse(err)
+	if resp.ErrCode != commonpb.ErrorCode_NOT_FOUND {
+		t.Fatalf("expected NOT_FOUND got %s", resp.ErrCode)
+	}
+	if resp.ErrDetail != missing {
+		t.Fatalf("expected detail %q got %q", missing, resp.ErrDetail)
+	}
+	if resp.Err != err.Error() {
+		t.Fatalf("expected err %q got %q", err.Error(), resp.Err)
+	}
+
+	resp = ErrorResponse(fmt.Errorf("screenshot: %w", ErrUnsupportedOS))
+	if resp.ErrCode != commonpb.ErrorCode_UNSUPPORTED_OS {
+		t.Fatalf("expected UNSUPPORTED_OS got %s", resp.ErrCode)
+	}
+	resp = ErrorResponse(&os.PathError{Op: "open", Path: "/etc/shadow", Err: os.ErrPermission})
+	if resp.ErrCode != commonpb.ErrorCode_ACCESS_DENIED {
+		t.Fatalf("expected ACCESS_DENIED got %s", resp.ErrCode)
+	}
+	resp = ErrorResponse(errors.New("something else"))
+	if resp.ErrCode != commonpb.ErrorCode_UNKNOWN || resp.ErrDetail != "" {
+		t.Fatalf("expected UNKNOWN without detail got %s %q", resp.ErrCode, resp.ErrDetail)
+	}
+	resp = ErrorResponse(nil)
+	if resp.Err != "" || resp.ErrCode != commonpb.ErrorCode_NONE {
+		t.Fatalf("expected empty response got %v", resp)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	tests := []struct {
+		resp     *commonpb.Response
+		expected string
+	}{
+		{nil, ""},
+		{&commonpb.Response{}, ""},
+		{&commonpb.Response{Err: "old implant"}, "old implant"},
+		{
+			&commonpb.Response{Err: "open /etc/shadow: permission denied", ErrCode: commonpb.ErrorCode_ACCESS_DENIED, ErrDetail: "/etc/shadow"},
+			"access denied on target: open /etc/shadow: permission denied",
+		},
+		{
+			&commonpb.Response{Err: "Access is denied.", ErrCode: commonpb.ErrorCode_ACCESS_DENIED, ErrDetail: "RegOpenKeyEx"},
+			"access denied on target (RegOpenKeyEx): Access is denied.",
+		},
+		{
+			&commonpb.Response{Err: ErrUnsupportedOS.Error(), ErrCode: commonpb.ErrorCode_UNSUPPORTED_OS},
+			"unsupported on this OS",
+		},
+	}
+	for _, test := range tests {
+		msg := ErrorMessage(test.resp)
+		if msg != test.expected {
+			t.Errorf("expected %q got %q", test.expected, msg)
+		}
+	}
+}
+
+func TestTimeoutResponse(t *testing.T) {
+	data := TimeoutResponse(5 * time.Minute)
+	execute := &Execute{}
+	err := proto.Unmarshal(data, execute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if execute.Response.GetErrCode() != commonpb.ErrorCode_TIMEOUT {
+		t.Fatalf("expected TIMEOUT got %s", execute.Response.GetErrCode())
+	}
+	if msg := ErrorMessage(execute.Response); msg != "timed out on target after 5m0s" {
+		t.Fatalf("unexpected error message %q", msg)
+	}
+}
+
+func TestCanceledResponse(t *testing.T) {
+	execute := &Execute{}
+	err := proto.Unmarshal(CanceledResponse(), execute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if execute.Response.GetErrCode() != commonpb.ErrorCode_CANCELED {
+		t.Fatalf("expected CANCELED got %s", execute.Response.GetErrCode())
+	}
+	if code := ErrorCodeOf(context.Canceled); code != commonpb.ErrorCode_CANCELED {
+		t.Fatalf("expected CANCELED got %s", code)
+	}
+}
@@ -0,0 +1,157 @@
+package asn
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+var (
+	asnLog = log.NamedLogger("asn", "database")
+
+	// ErrInvalidRecord - A line of the database could not be parsed
+	ErrInvalidRecord = errors.New("invalid asn database record")
+
+	database     *Database
+	databaseOnce sync.Once
+)
+
+// Record - An address range announced by an autonomous system
+type Record struct {
+	Start       netip.Addr
+	End         netip.Addr
+	ASN         uint32
+	Country     string
+	Description string
+}
+
+// Database - IP to ASN database, loaded from the tab separated format used
+// by iptoasn.com (range_start, range_end, AS_number, country_code, AS_description)
+type Database struct {
+	records []*Record
+}
+
+// Parse - Parse a database, records do not need to be sorted
+func Parse(reader io.Reader) (*Database, error) {
+	db := &Database{records: []*Record{}}
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 4 {
+			return nil, ErrInvalidRecord
+		}
+		start, err := netip.ParseAddr(fields[0])
+		if err != nil {
+			return nil, ErrInvalidRecord
+		}
+		end, err := netip.ParseAddr(fields[1])
+		if err != nil || end.Less(start) {
+			return nil, ErrInvalidRecord
+		}
+		asn, err := strconv.ParseUint(fields[2], 10, 32)
+		if err != nil {
+			return nil, ErrInvalidRecord
+		}
+		if asn == 0 {
+			continue // Not routed
+		}
+		record := &Record{
+			Start:   start.Unmap(),
+			End:     end.Unmap(),
+			ASN:     uint32(asn),
+			Country: strings.ToUpper(fields[3]),
+		}
+		if 4 < len(fields) {
+			record.Description = fields[4]
+		}
+		db.records = append(db.records, record)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(db.records, func(i, j int) bool {
+		return db.records[i].Start.Less(db.records[j].Start)
+	})
+	return db, nil
+}
+
+// Lookup - Find the record for an address, returns nil if the address is not
+// in the database
+func (db *Database) Lookup(addr netip.Addr) *Record {
+	addr = addr.Unmap()
+	index := sort.Search(len(db.records), func(i int) bool {
+		return addr.Less(db.records[i].Start)
+	})
+	if index == 0 {
+		return nil
+	}
+	record := db.records[index-1]
+	if record.End.Less(addr) {
+		return nil
+	}
+	return record
+}
+
+// Lookup - Find the record for an address in the database configured in the server
+// config, returns nil if no database is configured or the address is not found
+func Lookup(addr netip.Addr) *Record {
+	databaseOnce.Do(func() {
+		serverConfig := configs.GetServerConfig()
+		if serverConfig.CheckinAlerts == nil || serverConfig.CheckinAlerts.ASNDatabase == "" {
+			return
+		}
+		dbPath := serverConfig.CheckinAlerts.ASNDatabase
+		if !filepath.IsAbs(dbPath) {
+			dbPath = filepath.Join(assets.GetRootAppDir(), dbPath)
+		}
+		dbFile, err := os.Open(dbPath)
+		if err != nil {
+			asnLog.Errorf("Failed to open asn database %s", err)
+			return
+		}
+		defer dbFile.Close()
+		database, err = Parse(dbFile)
+		if err != nil {
+			asnLog.Errorf("Failed to parse asn database %s", err)
+			return
+		}
+		asnLog.Infof("Loaded %d asn records from %s", len(database.records), dbPath)
+	})
+	if database == nil {
+		return nil
+	}
+	return database.Lookup(addr)
+}
@@ -0,0 +1,68 @@
+package asn
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+const testDatabase = `1.0.0.0	1.0.0.255	13335	US	CLOUDFLARENET
+8.8.8.0	8.8.8.255	15169	US	GOOGLE
+1.0.4.0	1.0.7.255	38803	AU	GTELECOM-AUSTRALIA
+1.0.8.0	1.0.15.255	0	None	Not routed
+2001:4860::	2001:4860:ffff:ffff:ffff:ffff:ffff:ffff	15169	US	GOOGLE
+`
+
+func TestLookup(t *testing.T) {
+	db, err := Parse(strings.NewReader(testDatabase))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for addr, expected := range map[string]uint32{
+		"1.0.0.1":              13335,
+		"1.0.0.255":            13335,
+		"1.0.5.1":              38803,
+		"8.8.8.8":              15169,
+		"::ffff:8.8.4.4":       0,
+		"::ffff:8.8.8.8":       15169,
+		"2001:4860:4860::8888": 15169,
+		"1.0.9.1":              0,
+		"1.0.1.1":              0,
+		"0.0.0.1":              0,
+		"255.255.255.255":      0,
+		"::1":                  0,
+	} {
+		record := db.Lookup(netip.MustParseAddr(addr))
+		if expected == 0 && record != nil {
+			t.Fatalf("Expected no record for %s, got AS%d", addr, record.ASN)
+		}
+		if expected != 0 && (record == nil || record.ASN != expected) {
+			t.Fatalf("Expected AS%d for %s, got %v", expected, addr, record)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("1.0.0.0\tfoo\t13335\tUS\n"))
+	if err != ErrInvalidRecord {
+		t.Fatalf("Expected %s, got %v", ErrInvalidRecord, err)
+	}
+}
@@ -267,6 +267,14 @@ func SetupGoPath(goPathSrc string) error {
 	os.MkdirAll(sliverpbDir, 0700)
 	os.WriteFile(filepath.Join(sliverpbDir, "sliver.pb.go"), sliverpbGoSrc, 0600)
 	os.WriteFile(filepath.Join(sliverpbDir, "constants.go"), sliverpbConstSrc, 0600)
+	for _, name := range []string{"errors.go", "checkin.go"} {
+		sliverpbHelperSrc, err := protobufs.FS.ReadFile("sliverpb/" + name)
+		if err != nil {
+			setupLog.Infof("Static asset not found: %s", name)
+			return err
+		}
+		os.WriteFile(filepath.Join(sliverpbDir, name), sliverpbHelperSrc, 0600)
+	}
 
 	// Common PB
 	commonpbSrc, err := protobufs.FS.ReadFile("commonpb/common.pb.go")
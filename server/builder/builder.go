@@ -165,7 +165,7 @@ func handleBuildEvent(externalBuilder *clientpb.Builder, event *clientpb.Event,
 	_, extModel := generate.ImplantConfigFromProtobuf(extConfig.Config)
 
 	builderLog.Infof("Building %s for %s/%s (format: %s)", extConfig.Config.Name, extConfig.Config.GOOS, extConfig.Config.GOARCH, extConfig.Config.Format)
-	builderLog.Infof("    [c2] mtls:%t wg:%t http/s:%t dns:%t", extModel.MTLSc2Enabled, extModel.WGc2Enabled, extModel.HTTPc2Enabled, extModel.DNSc2Enabled)
+	builderLog.Infof("    [c2] mtls:%t wg:%t http/s:%t dns:%t icmp:%t udp:%t", extModel.MTLSc2Enabled, extModel.WGc2Enabled, extModel.HTTPc2Enabled, extModel.DNSc2Enabled, extModel.ICMPc2Enabled, extModel.UDPc2Enabled)
 	builderLog.Infof("[pivots] tcp:%t named-pipe:%t", extModel.TCPPivotc2Enabled, extModel.NamePipec2Enabled)
 
 	rpc.BuilderTrigger(context.Background(), &clientpb.Event{
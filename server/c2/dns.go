@@ -31,12 +31,14 @@ package c2
 		2. DNS server responds with the "DNS Session ID" which is just some random value
 		3. Requests with valid DNS session IDs enable the server to respond with CRC32 responses
 		4. Implant establishes encrypted session
+		5. A restarted implant may resume the encrypted session by proving it still has the key
 
 */
 
 import (
 	"bytes"
 	secureRand "crypto/rand"
+	"encoding/ascii85"
 	"encoding/base64"
 	"encoding/binary"
 	"errors"
@@ -49,6 +51,7 @@ import (
 	"unicode"
 
 	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/dnspb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
@@ -68,6 +71,13 @@ const (
 	messageIDBitMask = 0xff000000 // Bitwise mask to get the message ID
 
 	defaultMaxTXTLength = 254
+	// Largest EDNS0 udp payload we'll send, regardless of what the resolver advertises
+	maxEDNS0Size = 4096
+	// NOP responses are padded to at most this many bytes for edns0 probes
+	defaultMaxNOPPadding = 4096
+
+	bytesPerAAAA = 15                   // Each AAAA record has a 1 byte index
+	maxAAAAData  = 256*bytesPerAAAA - 2 // Max index is 255, -2 for the length
 )
 
 var (
@@ -75,6 +85,14 @@ var (
 	implantBase64         = encoders.Base64{} // Implant's version of base64 with custom alphabet
 	ErrInvalidMsg         = errors.New("invalid dns message")
 	ErrNoOutgoingMessages = errors.New("no outgoing messages")
+	ErrAnswerTooLong      = errors.New("too much data to encode in answer")
+
+	// TXTEncodings - Names of the TXT encodings a zone can be configured with
+	TXTEncodings = map[string]dnspb.TXTEncoding{
+		"base64": dnspb.TXTEncoding_BASE64,
+		"base85": dnspb.TXTEncoding_BASE85,
+		"raw":    dnspb.TXTEncoding_RAW,
+	}
 )
 
 // StartDNSListener - Start a DNS listener
@@ -87,6 +105,8 @@ func StartDNSListener(bindIface string, lport uint16, domains []string, canaries
 		TTL:          0,
 		MaxTXTLength: defaultMaxTXTLength,
 		EnforceOTP:   enforceOTP,
+
+		MaxNOPPadding: defaultMaxNOPPadding,
 	}
 	dnsLog.Infof("Starting DNS listener for %v (canaries: %v) ...", domains, canaries)
 	dns.HandleFunc(".", func(writer dns.ResponseWriter, req *dns.Msg) {
@@ -97,6 +117,29 @@ func StartDNSListener(bindIface string, lport uint16, domains []string, canaries
 	return server
 }
 
+// parseTXTEncodings - Map each parent domain to the TXT encoding configured for it,
+// domains without an encoding use base64
+func parseTXTEncodings(domains []string, txtEncodings map[string]string) (map[string]dnspb.TXTEncoding, error) {
+	encodings := map[string]dnspb.TXTEncoding{}
+	for zone, name := range txtEncodings {
+		encoding, ok := TXTEncodings[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("invalid txt encoding '%s' for %s", name, zone)
+		}
+		found := false
+		for _, domain := range domains {
+			if strings.EqualFold(dns.Fqdn(zone), dns.Fqdn(domain)) {
+				encodings[domain] = encoding
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("txt encoding for %s which is not a parent domain", zone)
+		}
+	}
+	return encodings, nil
+}
+
 // DNSSession - Holds DNS session information
 type DNSSession struct {
 	ID         uint32
@@ -110,6 +153,19 @@ type DNSSession struct {
 	incomingEnvelopes map[uint32]*PendingEnvelope
 	incomingMutex     *sync.Mutex
 	msgCount          uint32
+
+	// TXT encoding and chunk size agreed on during init or resume, a zero chunk
+	// size means the implant did not negotiate
+	txtEncoding    dnspb.TXTEncoding
+	chunkSize      uint32
+	negotiateMutex sync.RWMutex
+}
+
+// Negotiated - The TXT encoding and chunk size agreed on with the implant
+func (s *DNSSession) Negotiated() (dnspb.TXTEncoding, uint32) {
+	s.negotiateMutex.RLock()
+	defer s.negotiateMutex.RUnlock()
+	return s.txtEncoding, s.chunkSize
 }
 
 func (s *DNSSession) msgID(id uint32) uint32 {
@@ -184,6 +240,25 @@ func (s *DNSSession) ClearOutgoingEnvelope(msgID uint32) {
 	delete(s.outgoingBuffers, msgID)
 }
 
+// Resume - Drop any partially received or unread messages and the session of the
+// implant process that was running before the dns session was resumed, the new
+// process will register itself again
+func (s *DNSSession) Resume() {
+	s.incomingMutex.Lock()
+	s.incomingEnvelopes = map[uint32]*PendingEnvelope{}
+	s.incomingMutex.Unlock()
+
+	s.outgoingMutex.Lock()
+	s.outgoingMsgIDs = []uint32{}
+	s.outgoingBuffers = map[uint32][]byte{}
+	s.outgoingMutex.Unlock()
+
+	if s.ImplanConn != nil {
+		s.ImplanConn.Cleanup()
+		s.ImplanConn.Cleanup = func() {}
+	}
+}
+
 // IncomingPendingEnvelope - Get a pending message linked list, creates one if it doesn't exist
 func (s *DNSSession) IncomingPendingEnvelope(msgID uint32, size uint32) *PendingEnvelope {
 	s.incomingMutex.Lock()
@@ -312,6 +387,16 @@ type SliverDNSServer struct {
 	TTL          uint32
 	MaxTXTLength int
 	EnforceOTP   bool
+
+	// Largest padding a nop response may carry
+	MaxNOPPadding uint32
+
+	// Guards the settings operators can change while the listener is running
+	settingsMutex sync.RWMutex
+
+	// Most efficient TXT encoding each parent domain may use, implants request an
+	// encoding per query since not every resolver passes every encoding through
+	TXTEncodings map[string]dnspb.TXTEncoding
 }
 
 // Shutdown - Shutdown the DNS server
@@ -319,6 +404,30 @@ func (s *SliverDNSServer) Shutdown() error {
 	return s.server.Shutdown()
 }
 
+// settings - The listener's current runtime settings
+func (s *SliverDNSServer) settings() *clientpb.ListenerSettings {
+	s.settingsMutex.RLock()
+	defer s.settingsMutex.RUnlock()
+	return &clientpb.ListenerSettings{
+		EnforceOTP:    s.EnforceOTP,
+		MaxNOPPadding: s.MaxNOPPadding,
+		TTL:           s.TTL,
+	}
+}
+
+// applySettings - Change the listener's settings
+func (s *SliverDNSServer) applySettings(settings *clientpb.ListenerSettings) error {
+	s.settingsMutex.Lock()
+	defer s.settingsMutex.Unlock()
+	s.EnforceOTP = settings.EnforceOTP
+	s.TTL = settings.TTL
+	s.MaxNOPPadding = settings.MaxNOPPadding
+	if s.MaxNOPPadding == 0 {
+		s.MaxNOPPadding = defaultMaxNOPPadding
+	}
+	return nil
+}
+
 // ListenAndServe - Listen for DNS requests and respond
 func (s *SliverDNSServer) ListenAndServe() error {
 	return s.server.ListenAndServe()
@@ -349,12 +458,47 @@ func (s *SliverDNSServer) HandleDNSRequest(domains []string, canaries bool, writ
 		resp = s.handleCanary(req)
 	}
 	if resp != nil {
+		// AAAA responses contain many records for the same name, without compression
+		// they would not fit in a single UDP packet
+		resp.Compress = true
+		truncateResponse(req, resp)
 		writer.WriteMsg(resp)
 	} else {
 		dnsLog.Infof("Invalid query, no DNS response")
 	}
 }
 
+// truncateResponse - Truncate the response if it doesn't fit, miekg/dns estimates the
+// size of TXT records from the escaped presentation format of their strings, which
+// overestimates raw and base85 records, so the packed size is checked first
+func truncateResponse(req *dns.Msg, resp *dns.Msg) {
+	size := responseSize(req, resp)
+	if wire, err := resp.Pack(); err == nil && len(wire) <= size {
+		return
+	}
+	resp.Truncate(size)
+}
+
+// responseSize - The largest response the resolver can accept, 512 bytes unless the
+// query has an EDNS0 OPT record, in which case the OPT record is added to the response
+func responseSize(req *dns.Msg, resp *dns.Msg) int {
+	opt := req.IsEdns0()
+	if opt == nil {
+		return dns.MinMsgSize
+	}
+	size := opt.UDPSize()
+	if size < dns.MinMsgSize {
+		size = dns.MinMsgSize
+	}
+	if maxEDNS0Size < size {
+		size = maxEDNS0Size
+	}
+	if resp.IsEdns0() == nil {
+		resp.SetEdns0(size, false)
+	}
+	return int(size)
+}
+
 // Returns true if the requested domain is a c2 subdomain, and the domain it matched with
 func (s *SliverDNSServer) isC2SubDomain(domains []string, reqDomain string) (bool, string) {
 	for _, parentDomain := range domains {
@@ -405,6 +549,8 @@ func (s *SliverDNSServer) handleC2(domain string, req *dns.Msg) *dns.Msg {
 		return s.handleDataToImplant(domain, msg, checksum, req)
 	case dnspb.DNSMessageType_CLEAR:
 		return s.handleClear(domain, msg, checksum, req)
+	case dnspb.DNSMessageType_RESUME:
+		return s.handleResume(domain, msg, checksum, req)
 	}
 	return nil
 }
@@ -422,6 +568,7 @@ func (s *SliverDNSServer) decodeSubdata(subdomain string) (*dnspb.DNSMessage, ui
 			msg := &dnspb.DNSMessage{}
 			err = proto.Unmarshal(data, msg)
 			if err == nil {
+				msg.Padding = nil // Only there to make query lengths uniform
 				return msg, crc32.ChecksumIEEE(data), nil
 			}
 		}
@@ -461,7 +608,10 @@ func (s *SliverDNSServer) refusedErrorResp(req *dns.Msg) *dns.Msg {
 func (s *SliverDNSServer) handleTOTP(domain string, msg *dnspb.DNSMessage, req *dns.Msg) *dns.Msg {
 	dnsLog.Debugf("[dns] totp request: %v", msg)
 	totpCode := fmt.Sprintf("%08d", msg.ID)
-	if s.EnforceOTP {
+	s.settingsMutex.RLock()
+	enforceOTP := s.EnforceOTP
+	s.settingsMutex.RUnlock()
+	if enforceOTP {
 		valid, err := cryptography.ValidateTOTP(totpCode)
 		if err != nil || !valid {
 			dnsLog.Warnf("totp request invalid (%v)", err)
@@ -490,14 +640,7 @@ func (s *SliverDNSServer) handleTOTP(domain string, msg *dnspb.DNSMessage, req *
 	respBuf := make([]byte, 4)
 	binary.LittleEndian.PutUint32(respBuf, dnsSessionID)
 	for _, q := range req.Question {
-		switch q.Qtype {
-		case dns.TypeA:
-			a := &dns.A{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: s.TTL},
-				A:   respBuf,
-			}
-			resp.Answer = append(resp.Answer, a)
-		}
+		resp.Answer = append(resp.Answer, s.recordAnswers(domain, q, respBuf)...)
 	}
 	return resp
 }
@@ -529,7 +672,7 @@ func (s *SliverDNSServer) handleDNSSessionInit(domain string, msg *dnspb.DNSMess
 	}
 	var senderPublicKey [32]byte
 	copy(senderPublicKey[:], publicKey)
-	serverKeyPair := cryptography.ECCServerKeyPair()
+	serverKeyPair := cryptography.ECCServerKeyPairFor(implantConfig.ECCServerPublicKey)
 	sessionInit, err := cryptography.ECCDecrypt(&senderPublicKey, serverKeyPair.Private, msg.Data[32:])
 	if err != nil {
 		dnsLog.Errorf("[session init] error decrypting session init data: %s", err)
@@ -550,9 +693,7 @@ func (s *SliverDNSServer) handleDNSSessionInit(domain string, msg *dnspb.DNSMess
 		dnsLog.Debugf("[dns] closing implant conn send loop")
 	}()
 	dnsSession.CipherCtx = cryptography.NewCipherContext(sessionKey)
-	buf := make([]byte, 4)
-	binary.LittleEndian.PutUint32(buf, dnsSession.ID)
-	respData, err := dnsSession.CipherCtx.Encrypt(buf)
+	respData, err := dnsSession.CipherCtx.Encrypt(s.negotiate(domain, dnsSession, msg))
 	if err != nil {
 		dnsLog.Errorf("[session init] failed to encrypt msg with session key: %s", err)
 		return s.refusedErrorResp(req)
@@ -562,23 +703,40 @@ func (s *SliverDNSServer) handleDNSSessionInit(domain string, msg *dnspb.DNSMess
 	resp.SetReply(req)
 	resp.Authoritative = true
 	for _, q := range req.Question {
-		switch q.Qtype {
-		case dns.TypeTXT:
-			respTxt := string(implantBase64.Encode(respData))
-			txts := []string{}
-			for start, stop := 0, 0; stop < len(respTxt); start = stop {
-				stop += s.MaxTXTLength
-				if len(respTxt) < stop {
-					stop = len(respTxt)
-				}
-				txts = append(txts, respTxt[start:stop])
-			}
-			txt := &dns.TXT{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: s.TTL},
-				Txt: txts,
-			}
-			resp.Answer = append(resp.Answer, txt)
-		}
+		resp.Answer = append(resp.Answer, s.recordAnswers(domain, q, respData)...)
+	}
+	return resp
+}
+
+// handleResume - A restarted implant is resuming an existing session, the session ID
+// encrypted with the session key proves it's the same implant
+func (s *SliverDNSServer) handleResume(domain string, msg *dnspb.DNSMessage, checksum uint32, req *dns.Msg) *dns.Msg {
+	dnsLog.Debugf("[resume] with dns session id %d", msg.ID&sessionIDBitMask)
+	loadSession, _ := s.sessions.Load(msg.ID & sessionIDBitMask)
+	dnsSession := loadSession.(*DNSSession)
+	if dnsSession.CipherCtx == nil {
+		dnsLog.Warnf("[resume] session is not initialized")
+		return s.refusedErrorResp(req)
+	}
+	data, err := dnsSession.CipherCtx.Decrypt(msg.Data)
+	if err != nil || len(data) < 4 || binary.LittleEndian.Uint32(data)&sessionIDBitMask != dnsSession.ID {
+		dnsLog.Errorf("[resume] invalid resume message: %v", err)
+		return s.refusedErrorResp(req)
+	}
+	dnsLog.Infof("[resume] resuming dns session %d", dnsSession.ID)
+	dnsSession.Resume()
+
+	respData, err := dnsSession.CipherCtx.Encrypt(s.negotiate(domain, dnsSession, msg))
+	if err != nil {
+		dnsLog.Errorf("[resume] failed to encrypt msg with session key: %s", err)
+		return s.refusedErrorResp(req)
+	}
+
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.Authoritative = true
+	for _, q := range req.Question {
+		resp.Answer = append(resp.Answer, s.recordAnswers(domain, q, respData)...)
 	}
 	return resp
 }
@@ -607,23 +765,7 @@ func (s *SliverDNSServer) handlePoll(domain string, msg *dnspb.DNSMessage, check
 	resp.SetReply(req)
 	resp.Authoritative = true
 	for _, q := range req.Question {
-		switch q.Qtype {
-		case dns.TypeTXT:
-			respTxt := string(implantBase64.Encode(respData))
-			txts := []string{}
-			for start, stop := 0, 0; stop < len(respTxt); start = stop {
-				stop += s.MaxTXTLength
-				if len(respTxt) < stop {
-					stop = len(respTxt)
-				}
-				txts = append(txts, respTxt[start:stop])
-			}
-			txt := &dns.TXT{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: s.TTL},
-				Txt: txts,
-			}
-			resp.Answer = append(resp.Answer, txt)
-		}
+		resp.Answer = append(resp.Answer, s.recordAnswers(domain, q, respData)...)
 	}
 	return resp
 }
@@ -641,19 +783,12 @@ func (s *SliverDNSServer) handleDataFromImplant(domain string, msg *dnspb.DNSMes
 
 	resp := new(dns.Msg)
 	resp.SetReply(req)
+	resp.Authoritative = true
+	// resp.RecursionAvailable = complete
+	respBuf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(respBuf, checksum)
 	for _, q := range req.Question {
-		switch q.Qtype {
-		case dns.TypeA:
-			resp.Authoritative = true
-			// resp.RecursionAvailable = complete
-			respBuf := make([]byte, 4)
-			binary.LittleEndian.PutUint32(respBuf, checksum)
-			a := &dns.A{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: s.TTL},
-				A:   respBuf,
-			}
-			resp.Answer = append(resp.Answer, a)
-		}
+		resp.Answer = append(resp.Answer, s.recordAnswers(domain, q, respBuf)...)
 	}
 	return resp
 }
@@ -663,6 +798,11 @@ func (s *SliverDNSServer) handleDataToImplant(domain string, msg *dnspb.DNSMessa
 	loadSession, _ := s.sessions.Load(msg.ID & sessionIDBitMask)
 	dnsSession := loadSession.(*DNSSession)
 
+	txtEncoding, chunkSize := dnsSession.Negotiated()
+	if 0 < chunkSize && (msg.Stop < msg.Start || chunkSize < msg.Stop-msg.Start) {
+		dnsLog.Errorf("[to implant] chunk exceeds the negotiated size (%d)", chunkSize)
+		return s.refusedErrorResp(req)
+	}
 	data, err := dnsSession.OutgoingRead(msg.ID, msg.Start, msg.Stop)
 	if err != nil {
 		dnsLog.Errorf("[to implant] read failed: %s", err)
@@ -678,28 +818,58 @@ func (s *SliverDNSServer) handleDataToImplant(domain string, msg *dnspb.DNSMessa
 	resp := new(dns.Msg)
 	resp.SetReply(req)
 	resp.Authoritative = true
+	encoding := s.txtEncoding(domain, msg.Encoding)
+	if 0 < chunkSize && txtEncoding < encoding {
+		encoding = dnspb.TXTEncoding_BASE64
+	}
 	for _, q := range req.Question {
-		switch q.Qtype {
-		case dns.TypeTXT:
-			respTxt := string(implantBase64.Encode(respData))
-			txts := []string{}
-			for start, stop := 0, 0; stop < len(respTxt); start = stop {
-				stop += s.MaxTXTLength
-				if len(respTxt) < stop {
-					stop = len(respTxt)
-				}
-				txts = append(txts, respTxt[start:stop])
-			}
-			txt := &dns.TXT{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: s.TTL},
-				Txt: txts,
-			}
-			resp.Answer = append(resp.Answer, txt)
-		}
+		resp.Answer = append(resp.Answer, s.encodedRecordAnswers(domain, q, respData, encoding)...)
 	}
 	return resp
 }
 
+// negotiate - Agree on the TXT encoding and chunk size the implant proposed in its
+// init or resume message, and build the response: the dns session ID followed by
+// the encoding and chunk size if the implant proposed any. Older implants only
+// read the session ID.
+func (s *SliverDNSServer) negotiate(domain string, dnsSession *DNSSession, msg *dnspb.DNSMessage) []byte {
+	buf := make([]byte, 4, 9)
+	binary.LittleEndian.PutUint32(buf, dnsSession.ID)
+	if msg.Stop == 0 {
+		return buf
+	}
+	encoding := s.txtEncoding(domain, msg.Encoding)
+	chunkSize := msg.Stop
+	if maxSize := uint32(maxChunkSize(encoding, s.MaxTXTLength)); maxSize < chunkSize {
+		chunkSize = maxSize
+	}
+	dnsLog.Infof("[dns] session %d negotiated %s txt encoding with %d byte chunks", dnsSession.ID, encoding, chunkSize)
+	dnsSession.negotiateMutex.Lock()
+	dnsSession.txtEncoding = encoding
+	dnsSession.chunkSize = chunkSize
+	dnsSession.negotiateMutex.Unlock()
+
+	buf = append(buf, byte(encoding), 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(buf[5:], chunkSize)
+	return buf
+}
+
+// maxChunkSize - The most data a DATA_TO_IMPLANT response can carry in a TXT record
+// of the largest response we send: -12 header, -259 worst case question, -12 answer
+// header, -11 OPT record, a length byte per character string, -9 metadata
+func maxChunkSize(encoding dnspb.TXTEncoding, maxTXTLength int) int {
+	rdata := maxEDNS0Size - 12 - 259 - 12 - 11
+	chars := rdata - (rdata+maxTXTLength)/(maxTXTLength+1)
+	switch encoding {
+	case dnspb.TXTEncoding_BASE85:
+		chars = chars * 4 / 5
+	case dnspb.TXTEncoding_RAW:
+	default:
+		chars = chars * 3 / 4
+	}
+	return chars - 9
+}
+
 func (s *SliverDNSServer) handleClear(domain string, msg *dnspb.DNSMessage, checksum uint32, req *dns.Msg) *dns.Msg {
 	dnsLog.Debugf("[clear] dns session id %d", msg.ID&sessionIDBitMask)
 	loadSession, _ := s.sessions.Load(msg.ID & sessionIDBitMask)
@@ -713,29 +883,7 @@ func (s *SliverDNSServer) handleClear(domain string, msg *dnspb.DNSMessage, chec
 	resp.SetReply(req)
 	resp.Authoritative = true
 	for _, q := range req.Question {
-		switch q.Qtype {
-		case dns.TypeA:
-			a := &dns.A{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: s.TTL},
-				A:   respBuf,
-			}
-			resp.Answer = append(resp.Answer, a)
-		case dns.TypeTXT:
-			respTxt := string(implantBase64.Encode(respBuf))
-			txts := []string{}
-			for start, stop := 0, 0; stop < len(respTxt); start = stop {
-				stop += s.MaxTXTLength
-				if len(respTxt) < stop {
-					stop = len(respTxt)
-				}
-				txts = append(txts, respTxt[start:stop])
-			}
-			txt := &dns.TXT{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: s.TTL},
-				Txt: txts,
-			}
-			resp.Answer = append(resp.Answer, txt)
-		}
+		resp.Answer = append(resp.Answer, s.recordAnswers(domain, q, respBuf)...)
 	}
 	return resp
 }
@@ -747,19 +895,157 @@ func (s *SliverDNSServer) handleNOP(domain string, msg *dnspb.DNSMessage, checks
 	resp.Authoritative = true
 	respBuf := make([]byte, 4)
 	binary.LittleEndian.PutUint32(respBuf, checksum)
-	for _, q := range req.Question {
-		switch q.Qtype {
-		case dns.TypeA:
-			a := &dns.A{
-				Hdr: dns.RR_Header{Name: q.Name, Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: s.TTL},
-				A:   respBuf,
-			}
-			resp.Answer = append(resp.Answer, a)
+	// Implants probe how large a response the path supports by asking for padding,
+	// A records can only carry the checksum
+	s.settingsMutex.RLock()
+	maxPadding := s.MaxNOPPadding
+	s.settingsMutex.RUnlock()
+	if 0 < msg.Size && msg.Size <= maxPadding && req.Question[0].Qtype != dns.TypeA {
+		for index := uint32(0); index < msg.Size; index++ {
+			respBuf = append(respBuf, byte(msg.Start+index))
 		}
 	}
+	encoding := s.txtEncoding(domain, msg.Encoding)
+	for _, q := range req.Question {
+		resp.Answer = append(resp.Answer, s.encodedRecordAnswers(domain, q, respBuf, encoding)...)
+	}
 	return resp
 }
 
+// txtEncoding - The requested encoding if the zone allows it, otherwise base64
+func (s *SliverDNSServer) txtEncoding(domain string, requested dnspb.TXTEncoding) dnspb.TXTEncoding {
+	if requested <= s.TXTEncodings[domain] {
+		return requested
+	}
+	return dnspb.TXTEncoding_BASE64
+}
+
+// recordAnswers - Encode response data as answers for the record type of the question,
+// an A record can only carry a 4 byte value, TXT, AAAA, and CNAME records can carry
+// arbitrary data.
+func (s *SliverDNSServer) recordAnswers(domain string, q dns.Question, data []byte) []dns.RR {
+	return s.encodedRecordAnswers(domain, q, data, dnspb.TXTEncoding_BASE64)
+}
+
+// encodedRecordAnswers - Same as recordAnswers but TXT answers use the given encoding
+func (s *SliverDNSServer) encodedRecordAnswers(domain string, q dns.Question, data []byte, encoding dnspb.TXTEncoding) []dns.RR {
+	s.settingsMutex.RLock()
+	ttl := s.TTL
+	s.settingsMutex.RUnlock()
+	hdr := dns.RR_Header{Name: q.Name, Rrtype: q.Qtype, Class: dns.ClassINET, Ttl: ttl}
+	switch q.Qtype {
+	case dns.TypeA:
+		if len(data) != 4 {
+			return nil
+		}
+		return []dns.RR{&dns.A{Hdr: hdr, A: data}}
+	case dns.TypeTXT:
+		return []dns.RR{&dns.TXT{Hdr: hdr, Txt: txtStrings(data, encoding, s.MaxTXTLength)}}
+	case dns.TypeAAAA:
+		records, err := aaaaRecords(data)
+		if err != nil {
+			dnsLog.Errorf("[dns] failed to encode aaaa records: %s", err)
+			return nil
+		}
+		answers := []dns.RR{}
+		for _, record := range records {
+			answers = append(answers, &dns.AAAA{Hdr: hdr, AAAA: record})
+		}
+		return answers
+	case dns.TypeCNAME:
+		target, err := cnameTarget(domain, data)
+		if err != nil {
+			dnsLog.Errorf("[dns] failed to encode cname record: %s", err)
+			return nil
+		}
+		return []dns.RR{&dns.CNAME{Hdr: hdr, Target: target}}
+	}
+	return nil
+}
+
+// txtStrings - Encode data as TXT character strings of at most maxLength bytes, the
+// strings are in the presentation format miekg/dns expects so any byte that isn't
+// printable (or is a quote or backslash) is escaped
+func txtStrings(data []byte, encoding dnspb.TXTEncoding, maxLength int) []string {
+	var encoded []byte
+	switch encoding {
+	case dnspb.TXTEncoding_BASE85:
+		encoded = make([]byte, ascii85.MaxEncodedLen(len(data)))
+		encoded = encoded[:ascii85.Encode(encoded, data)]
+	case dnspb.TXTEncoding_RAW:
+		encoded = data
+	default:
+		encoded = implantBase64.Encode(data)
+	}
+	txts := []string{}
+	for start, stop := 0, 0; stop < len(encoded); start = stop {
+		stop += maxLength
+		if len(encoded) < stop {
+			stop = len(encoded)
+		}
+		txts = append(txts, escapeTXT(encoded[start:stop]))
+	}
+	return txts
+}
+
+func escapeTXT(data []byte) string {
+	escaped := &strings.Builder{}
+	for _, b := range data {
+		switch {
+		case b == '"' || b == '\\':
+			escaped.WriteByte('\\')
+			escaped.WriteByte(b)
+		case b < ' ' || '~' < b:
+			fmt.Fprintf(escaped, "\\%03d", b)
+		default:
+			escaped.WriteByte(b)
+		}
+	}
+	return escaped.String()
+}
+
+// aaaaRecords - Resolvers may reorder records, so the first byte of each AAAA record is
+// its index followed by 15 bytes of data, the data is prefixed with its uint16 length
+func aaaaRecords(data []byte) ([][]byte, error) {
+	if maxAAAAData < len(data) {
+		return nil, ErrAnswerTooLong
+	}
+	buf := make([]byte, 2, 2+len(data))
+	binary.LittleEndian.PutUint16(buf, uint16(len(data)))
+	buf = append(buf, data...)
+	records := [][]byte{}
+	for index := 0; index*bytesPerAAAA < len(buf); index++ {
+		record := make([]byte, 16)
+		record[0] = byte(index)
+		stop := (index + 1) * bytesPerAAAA
+		if len(buf) < stop {
+			stop = len(buf)
+		}
+		copy(record[1:], buf[index*bytesPerAAAA:stop])
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// cnameTarget - Encode data as base32 labels under the parent domain
+func cnameTarget(domain string, data []byte) (string, error) {
+	encoded := string(encoders.Base32{}.Encode(data))
+	labels := []string{}
+	for start := 0; start < len(encoded); start += 63 {
+		stop := start + 63
+		if len(encoded) < stop {
+			stop = len(encoded)
+		}
+		labels = append(labels, encoded[start:stop])
+	}
+	labels = append(labels, strings.TrimPrefix(dns.Fqdn(domain), "."))
+	target := strings.Join(labels, ".")
+	if 254 < len(target) {
+		return "", ErrAnswerTooLong
+	}
+	return target, nil
+}
+
 // ---------------------------
 // Canary Record Handler
 // ---------------------------
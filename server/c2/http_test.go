@@ -20,16 +20,20 @@ package c2
 
 import (
 	"bytes"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	insecureRand "math/rand"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	implantCrypto "github.com/bishopfox/sliver/implant/sliver/cryptography"
 	implantEncoders "github.com/bishopfox/sliver/implant/sliver/encoders"
 	implantTransports "github.com/bishopfox/sliver/implant/sliver/transports/httpclient"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/cryptography"
@@ -119,3 +123,111 @@ func TestGetNonceFromURL(t *testing.T) {
 		}
 	}
 }
+
+func TestImplantRemoteAddr(t *testing.T) {
+	server := &SliverHTTPC2{ServerConf: &HTTPServerConfig{}}
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "198.51.100.1:443"
+	req.Header.Set("X-Forwarded-For", "203.0.113.7, 198.51.100.1")
+	if addr := server.implantRemoteAddr(req); addr != req.RemoteAddr {
+		t.Fatalf("Non-fronted listener parsed '%s' from a forwarded address list", addr)
+	}
+
+	server.ServerConf.FrontDomain = "cdn.example.com"
+	if addr := server.implantRemoteAddr(req); addr != "tcp(198.51.100.1:443)->203.0.113.7" {
+		t.Fatalf("Fronted listener did not use the first forwarded address, got '%s'", addr)
+	}
+	req.Header.Set("CF-Connecting-IP", "203.0.113.8")
+	if addr := server.implantRemoteAddr(req); addr != "tcp(198.51.100.1:443)->203.0.113.8" {
+		t.Fatalf("Fronted listener did not use the cdn's client address header, got '%s'", addr)
+	}
+	req.Header.Del("CF-Connecting-IP")
+	req.Header.Set("X-Forwarded-For", "not an ip")
+	if addr := server.implantRemoteAddr(req); addr != req.RemoteAddr {
+		t.Fatalf("Fronted listener parsed '%s' from an invalid address", addr)
+	}
+}
+
+func TestHTTP2Listener(t *testing.T) {
+	server, err := StartHTTPListener(&HTTPServerConfig{
+		Addr:          "127.0.0.1:0",
+		Secure:        true,
+		RandomizeJARM: true,
+		HTTP2:         true,
+	})
+	if err != nil {
+		t.Fatalf("Listener failed to start %s", err)
+	}
+	ln, err := tls.Listen("tcp", server.HTTPServer.Addr, server.HTTPServer.TLSConfig)
+	if err != nil {
+		t.Fatalf("Failed to listen %s", err)
+	}
+	go server.HTTPServer.Serve(ln)
+	defer server.HTTPServer.Close()
+
+	for _, forceHTTP2 := range []bool{true, false} {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig:   &tls.Config{InsecureSkipVerify: true},
+			ForceAttemptHTTP2: forceHTTP2,
+		}}
+		resp, err := client.Get(fmt.Sprintf("https://%s/", ln.Addr()))
+		if err != nil {
+			t.Fatalf("Request failed (http2 = %v) %s", forceHTTP2, err)
+		}
+		resp.Body.Close()
+		expected := 1
+		if forceHTTP2 {
+			expected = 2
+		}
+		if resp.ProtoMajor != expected {
+			t.Fatalf("Expected HTTP/%d, got %s", expected, resp.Proto)
+		}
+	}
+}
+
+func TestApplySettings(t *testing.T) {
+	server, err := StartHTTPListener(&HTTPServerConfig{Addr: "127.0.0.1:0"})
+	if err != nil {
+		t.Fatalf("Listener failed to start %s", err)
+	}
+	if settings := server.settings(); settings.LongPollTimeout != int64(DefaultLongPollTimeout) {
+		t.Fatalf("Expected the default long poll timeout, got %d", settings.LongPollTimeout)
+	}
+
+	err = server.applySettings(&clientpb.ListenerSettings{LongPollTimeout: int64(5 * time.Second), EnforceOTP: true})
+	if err != nil {
+		t.Fatalf("Failed to apply settings %s", err)
+	}
+	if timeout := server.getServerPollTimeout(); timeout != 5*time.Second {
+		t.Fatalf("Long poll timeout was not applied, got %s", timeout)
+	}
+	req := httptest.NewRequest(http.MethodGet, "/foo.js", nil)
+	if server.filterOTP(req, nil) {
+		t.Fatal("Request without an otp code passed the filter after enforcing otp")
+	}
+	if err := server.applySettings(&clientpb.ListenerSettings{LongPollJitter: -1}); err != ErrInvalidListenerSettings {
+		t.Fatalf("Expected invalid settings error, got %v", err)
+	}
+
+	profile := &configs.HTTPC2Config{}
+	data, _ := json.Marshal(configs.GetHTTPC2Config())
+	json.Unmarshal(data, profile)
+	profile.ImplantConfig.PollFileExt = "settingstest"
+	data, _ = json.Marshal(profile)
+	if err := configs.SaveHTTPC2Profile("settings-test", data); err != nil {
+		t.Fatalf("Failed to save profile %s", err)
+	}
+	err = server.applySettings(&clientpb.ListenerSettings{C2Profile: "settings-test"})
+	if err != nil {
+		t.Fatalf("Failed to apply settings %s", err)
+	}
+	if ext := server.LoadC2Config().ImplantConfig.PollFileExt; ext != "settingstest" {
+		t.Fatalf("C2 profile was not reloaded, poll file ext is '%s'", ext)
+	}
+	if err := server.applySettings(&clientpb.ListenerSettings{C2Profile: "does-not-exist"}); err == nil {
+		t.Fatal("Applied a c2 profile that does not exist")
+	}
+	if settings := server.settings(); settings.C2Profile != "settings-test" || settings.EnforceOTP {
+		t.Fatalf("Unexpected settings after a failed update %v", settings)
+	}
+}
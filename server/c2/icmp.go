@@ -0,0 +1,126 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/binary"
+	"net"
+
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	icmpEchoReply   = 0
+	icmpEchoRequest = 8
+	icmpHeaderSize  = 8
+)
+
+var (
+	icmpLog = log.NamedLogger("c2", "icmp")
+)
+
+// SliverICMPC2 - Answers the implant's echo requests, the kernel also answers
+// them but the implant ignores those since they just echo its own frames back
+type SliverICMPC2 struct {
+	*datagramC2
+	conn net.PacketConn
+}
+
+// StartICMPListener - Listen for echo requests on bindIface, needs a raw socket
+func StartICMPListener(bindIface string) (*SliverICMPC2, error) {
+	if bindIface == "" {
+		bindIface = "0.0.0.0"
+	}
+	icmpLog.Infof("Starting ICMP listener on %s", bindIface)
+	conn, err := net.ListenPacket("ip4:icmp", bindIface)
+	if err != nil {
+		return nil, err
+	}
+	server := &SliverICMPC2{
+		datagramC2: newDatagramC2("icmp", icmpLog),
+		conn:       conn,
+	}
+	go server.serve()
+	return server, nil
+}
+
+// Close - Stop the listener and drop all of its sessions
+func (s *SliverICMPC2) Close() {
+	s.closeSessions()
+	s.conn.Close()
+}
+
+func (s *SliverICMPC2) serve() {
+	buf := make([]byte, 4096)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-s.done:
+			default:
+				icmpLog.Errorf("Read error %s", err)
+			}
+			return
+		}
+		icmpType, id, seq, payload, ok := parseICMPEcho(buf[:n])
+		if !ok || icmpType != icmpEchoRequest {
+			continue
+		}
+		frameType, session, frameSeq, data, err := parseDatagramFrame(payload)
+		if err != nil {
+			continue // Regular ping
+		}
+		reply := s.handleFrame(addr, frameType, session, frameSeq, data)
+		if reply != nil {
+			s.conn.WriteTo(icmpEchoPacket(icmpEchoReply, id, seq, reply), addr)
+		}
+	}
+}
+
+func icmpEchoPacket(icmpType uint8, id uint16, seq uint16, data []byte) []byte {
+	packet := make([]byte, icmpHeaderSize+len(data))
+	packet[0] = icmpType
+	binary.BigEndian.PutUint16(packet[4:], id)
+	binary.BigEndian.PutUint16(packet[6:], seq)
+	copy(packet[icmpHeaderSize:], data)
+	binary.BigEndian.PutUint16(packet[2:], icmpChecksum(packet))
+	return packet
+}
+
+func parseICMPEcho(packet []byte) (uint8, uint16, uint16, []byte, bool) {
+	if len(packet) < icmpHeaderSize {
+		return 0, 0, 0, nil, false
+	}
+	return packet[0], binary.BigEndian.Uint16(packet[4:]), binary.BigEndian.Uint16(packet[6:]), packet[icmpHeaderSize:], true
+}
+
+// icmpChecksum - RFC 1071 internet checksum
+func icmpChecksum(data []byte) uint16 {
+	var sum uint32
+	for i := 0; i+1 < len(data); i += 2 {
+		sum += uint32(data[i])<<8 | uint32(data[i+1])
+	}
+	if len(data)%2 == 1 {
+		sum += uint32(data[len(data)-1]) << 8
+	}
+	for sum>>16 != 0 {
+		sum = sum&0xffff + sum>>16
+	}
+	return ^uint16(sum)
+}
@@ -0,0 +1,52 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestICMPFrames(t *testing.T) {
+	data := []byte("hello world") // Odd length
+	frame := datagramFrame(datagramFrameData, 0xdeadbeef, 42, data)
+	packet := icmpEchoPacket(icmpEchoReply, 1234, 42, frame)
+	if icmpChecksum(packet) != 0 {
+		t.Fatalf("packet checksum does not verify: %x", icmpChecksum(packet))
+	}
+
+	icmpType, id, _, payload, ok := parseICMPEcho(packet)
+	if !ok || icmpType != icmpEchoReply || id != 1234 {
+		t.Fatalf("failed to parse echo packet: %d %d %v", icmpType, id, ok)
+	}
+	frameType, session, seq, parsed, err := parseDatagramFrame(payload)
+	if err != nil {
+		t.Fatalf("failed to parse frame: %s", err)
+	}
+	if frameType != datagramFrameData || session != 0xdeadbeef || seq != 42 || !bytes.Equal(parsed, data) {
+		t.Fatalf("frame mismatch: %d %x %d %q", frameType, session, seq, parsed)
+	}
+
+	if _, _, _, _, err := parseDatagramFrame([]byte("not a sliver frame")); err != ErrInvalidFrame {
+		t.Fatalf("expected invalid frame error, got %v", err)
+	}
+	if _, _, _, _, ok := parseICMPEcho(packet[:4]); ok {
+		t.Fatal("parsed a truncated echo packet")
+	}
+}
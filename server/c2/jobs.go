@@ -30,6 +30,7 @@ import (
 	"time"
 
 	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
@@ -126,8 +127,13 @@ func StartWGListenerJob(listenPort uint16, nListenPort uint16, keyExchangeListen
 }
 
 // StartDNSListenerJob - Start a DNS listener as a job
-func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canaries bool, enforceOTP bool) (*core.Job, error) {
+func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canaries bool, enforceOTP bool, txtEncodings map[string]string) (*core.Job, error) {
+	encodings, err := parseTXTEncodings(domains, txtEncodings)
+	if err != nil {
+		return nil, err
+	}
 	server := StartDNSListener(bindIface, lport, domains, canaries, enforceOTP)
+	server.TXTEncodings = encodings
 	description := fmt.Sprintf("%s (canaries %v)", strings.Join(domains, " "), canaries)
 	job := &core.Job{
 		ID:          core.NextJobID(),
@@ -143,6 +149,7 @@ func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canar
 		<-job.JobCtrl
 		jobLog.Infof("Stopping DNS listener (%d) ...", job.ID)
 		server.Shutdown()
+		tunableListeners.Delete(job.ID)
 		core.Jobs.Remove(job)
 		core.EventBroker.Publish(core.Event{
 			Job:       job,
@@ -150,6 +157,7 @@ func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canar
 		})
 	}()
 
+	tunableListeners.Store(job.ID, server)
 	core.Jobs.Add(job)
 
 	// There is no way to call DNS' ListenAndServe() without blocking
@@ -168,6 +176,60 @@ func StartDNSListenerJob(bindIface string, lport uint16, domains []string, canar
 	return job, nil
 }
 
+// StartICMPListenerJob - Start an ICMP listener as a job
+func StartICMPListenerJob(bindIface string) (*core.Job, error) {
+	server, err := StartICMPListener(bindIface)
+	if err != nil {
+		return nil, err // If we fail to bind don't setup the Job
+	}
+	if bindIface == "" {
+		bindIface = "0.0.0.0"
+	}
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        "icmp",
+		Description: fmt.Sprintf("icmp listener %s", bindIface),
+		Protocol:    "icmp",
+		JobCtrl:     make(chan bool),
+	}
+
+	go func() {
+		<-job.JobCtrl
+		jobLog.Infof("Stopping ICMP listener (%d) ...", job.ID)
+		server.Close()
+		core.Jobs.Remove(job)
+	}()
+	core.Jobs.Add(job)
+
+	return job, nil
+}
+
+// StartUDPListenerJob - Start a UDP listener as a job
+func StartUDPListenerJob(bindIface string, lport uint16) (*core.Job, error) {
+	server, err := StartUDPListener(bindIface, lport)
+	if err != nil {
+		return nil, err // If we fail to bind don't setup the Job
+	}
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        "udp",
+		Description: fmt.Sprintf("udp listener %s:%d", bindIface, lport),
+		Protocol:    "udp",
+		Port:        lport,
+		JobCtrl:     make(chan bool),
+	}
+
+	go func() {
+		<-job.JobCtrl
+		jobLog.Infof("Stopping UDP listener (%d) ...", job.ID)
+		server.Close()
+		core.Jobs.Remove(job)
+	}()
+	core.Jobs.Add(job)
+
+	return job, nil
+}
+
 // StartHTTPListenerJob - Start a HTTP listener as a job
 func StartHTTPListenerJob(conf *HTTPServerConfig) (*core.Job, error) {
 	server, err := StartHTTPListener(conf)
@@ -188,10 +250,16 @@ func StartHTTPListenerJob(conf *HTTPServerConfig) (*core.Job, error) {
 		JobCtrl:     make(chan bool),
 		Domains:     []string{conf.Domain},
 	}
+	if conf.FrontDomain != "" {
+		job.Description = fmt.Sprintf("%s for domain %s fronted via %s", name, conf.Domain, conf.FrontDomain)
+		job.Domains = append(job.Domains, conf.FrontDomain)
+	}
+	tunableListeners.Store(job.ID, server)
 	core.Jobs.Add(job)
 
 	cleanup := func(err error) {
 		server.Cleanup()
+		tunableListeners.Delete(job.ID)
 		core.Jobs.Remove(job)
 		core.EventBroker.Publish(core.Event{
 			Job:       job,
@@ -228,8 +296,8 @@ func StartHTTPListenerJob(conf *HTTPServerConfig) (*core.Job, error) {
 }
 
 // StartTCPStagerListenerJob - Start a TCP staging payload listener
-func StartTCPStagerListenerJob(host string, port uint16, shellcode []byte) (*core.Job, error) {
-	ln, err := StartTCPListener(host, port, shellcode)
+func StartTCPStagerListenerJob(host string, port uint16, stager *Stager) (*core.Job, error) {
+	ln, err := StartTCPListener(host, port, stager)
 	if err != nil {
 		return nil, err // If we fail to bind don't setup the Job
 	}
@@ -261,8 +329,46 @@ func StartTCPStagerListenerJob(host string, port uint16, shellcode []byte) (*cor
 	return job, nil
 }
 
+// StartShellUpgradeListenerJob - Start a listener that upgrades plain reverse tcp shells
+func StartShellUpgradeListenerJob(host string, port uint16, implants []*clientpb.ShellUpgradeImplant) (*core.Job, error) {
+	ln, err := StartShellUpgradeListener(host, port, implants)
+	if err != nil {
+		return nil, err // If we fail to bind don't setup the Job
+	}
+
+	platforms := []string{}
+	for _, implant := range implants {
+		platforms = append(platforms, fmt.Sprintf("%s/%s", implant.GOOS, implant.GOARCH))
+	}
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        "shell-upgrade",
+		Description: fmt.Sprintf("Shell upgrade listener (%s)", strings.Join(platforms, ", ")),
+		Protocol:    "tcp",
+		Port:        port,
+		JobCtrl:     make(chan bool),
+	}
+
+	go func() {
+		<-job.JobCtrl
+		jobLog.Infof("Stopping shell upgrade listener (%d) ...", job.ID)
+		ln.Close()
+
+		core.Jobs.Remove(job)
+
+		core.EventBroker.Publish(core.Event{
+			Job:       job,
+			EventType: consts.JobStoppedEvent,
+		})
+	}()
+
+	core.Jobs.Add(job)
+
+	return job, nil
+}
+
 // StartHTTPStagerListenerJob - Start an HTTP(S) stager payload listener
-func StartHTTPStagerListenerJob(conf *HTTPServerConfig, data []byte) (*core.Job, error) {
+func StartHTTPStagerListenerJob(conf *HTTPServerConfig, stager *Stager) (*core.Job, error) {
 	server, err := StartHTTPListener(conf)
 	if err != nil {
 		return nil, err
@@ -271,7 +377,7 @@ func StartHTTPStagerListenerJob(conf *HTTPServerConfig, data []byte) (*core.Job,
 	if conf.Secure {
 		name = "https"
 	}
-	server.SliverStage = data
+	server.SliverStage = stager
 	job := &core.Job{
 		ID:          core.NextJobID(),
 		Name:        name,
@@ -342,7 +448,24 @@ func StartPersistentJobs(cfg *configs.ServerConfig) error {
 	}
 
 	for _, j := range cfg.Jobs.DNS {
-		job, err := StartDNSListenerJob(j.Host, j.Port, j.Domains, j.Canaries, j.EnforceOTP)
+		job, err := StartDNSListenerJob(j.Host, j.Port, j.Domains, j.Canaries, j.EnforceOTP, j.TXTEncodings)
+		if err != nil {
+			return err
+		}
+		job.PersistentID = j.JobID
+		loadListenerSettings(job)
+	}
+
+	for _, j := range cfg.Jobs.ICMP {
+		job, err := StartICMPListenerJob(j.Host)
+		if err != nil {
+			return err
+		}
+		job.PersistentID = j.JobID
+	}
+
+	for _, j := range cfg.Jobs.UDP {
+		job, err := StartUDPListenerJob(j.Host, j.Port)
 		if err != nil {
 			return err
 		}
@@ -363,12 +486,16 @@ func StartPersistentJobs(cfg *configs.ServerConfig) error {
 			LongPollTimeout: time.Duration(j.LongPollTimeout),
 			LongPollJitter:  time.Duration(j.LongPollJitter),
 			RandomizeJARM:   j.RandomizeJARM,
+			C2Profile:       j.C2Profile,
+			FrontDomain:     j.FrontDomain,
+			HTTP2:           j.HTTP2,
 		}
 		job, err := StartHTTPListenerJob(cfg)
 		if err != nil {
 			return err
 		}
 		job.PersistentID = j.JobID
+		loadListenerSettings(job)
 	}
 
 	return nil
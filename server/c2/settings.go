@@ -0,0 +1,122 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"sync"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrNoListenerSettings - The job isn't a listener with runtime settings
+	ErrNoListenerSettings = errors.New("job does not have listener settings")
+	// ErrInvalidListenerSettings - A setting is out of range
+	ErrInvalidListenerSettings = errors.New("invalid listener settings")
+
+	tunableListeners = &sync.Map{} // Job ID -> tunableListener
+)
+
+// tunableListener - A listener that reads its timing, padding, gating, and profile
+// settings on every request, so they can be changed without restarting the job
+type tunableListener interface {
+	settings() *clientpb.ListenerSettings
+	applySettings(*clientpb.ListenerSettings) error
+}
+
+// GetListenerSettings - Get the current settings of a running listener
+func GetListenerSettings(jobID int) (*clientpb.ListenerSettings, error) {
+	job, listener, err := tunableListenerByJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+	settings := listener.settings()
+	settings.JobID = uint32(job.ID)
+	settings.Name = job.Name
+	settings.Persisted = job.PersistentID != ""
+	return settings, nil
+}
+
+// UpdateListenerSettings - Apply new settings to a running listener, persistent
+// listeners save them to the database so they're restored when the job restarts
+func UpdateListenerSettings(settings *clientpb.ListenerSettings) (*clientpb.ListenerSettings, error) {
+	job, listener, err := tunableListenerByJobID(int(settings.JobID))
+	if err != nil {
+		return nil, err
+	}
+	err = listener.applySettings(settings)
+	if err != nil {
+		return nil, err
+	}
+	if job.PersistentID != "" {
+		err = db.SaveListenerSettings(job.PersistentID, listener.settings())
+		if err != nil {
+			jobLog.Errorf("Failed to save job %d settings: %s", job.ID, err)
+			return nil, err
+		}
+	}
+	updated, err := GetListenerSettings(job.ID)
+	if err != nil {
+		return nil, err
+	}
+	data, _ := proto.Marshal(updated)
+	core.EventBroker.Publish(core.Event{
+		Job:       job,
+		EventType: consts.ListenerSettingsEvent,
+		Data:      data,
+	})
+	return updated, nil
+}
+
+// loadListenerSettings - Apply the saved settings of a persistent listener
+func loadListenerSettings(job *core.Job) {
+	_, listener, err := tunableListenerByJobID(job.ID)
+	if err != nil {
+		return
+	}
+	saved, err := db.ListenerSettingsByPersistentID(job.PersistentID)
+	if err == db.ErrRecordNotFound {
+		return
+	}
+	if err != nil {
+		jobLog.Errorf("Failed to load job %d settings: %s", job.ID, err)
+		return
+	}
+	err = listener.applySettings(saved.ToProtobuf())
+	if err != nil {
+		jobLog.Warnf("Failed to apply saved job %d settings: %s", job.ID, err)
+	}
+}
+
+func tunableListenerByJobID(jobID int) (*core.Job, tunableListener, error) {
+	job := core.Jobs.Get(jobID)
+	if job == nil {
+		return nil, nil, ErrNoListenerSettings
+	}
+	listener, ok := tunableListeners.Load(jobID)
+	if !ok {
+		return nil, nil, ErrNoListenerSettings
+	}
+	return job, listener.(tunableListener), nil
+}
@@ -0,0 +1,288 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	Staging protocol, stagers that don't speak it are served the default stage as before.
+
+	TCP: the stager sends 'STG1', the length of its fingerprint (uint32 little endian)
+	and the fingerprint, one key=value per line:
+
+		os=windows
+		arch=amd64
+		version=10.0.19045
+		av=defender,crowdstrike
+		chunk=4096
+
+	The server answers with the size of the stage and the chunk size (uint32 little
+	endian each), then sends the stage one chunk at a time, waiting for the stager to
+	send a byte after each chunk. The chunk size is the smaller of the listener's and
+	the stager's chunk size, 0 sends the stage at once.
+
+	HTTP(S): the fingerprint is in the query string (?os=windows&arch=amd64&...) and a
+	Range header requests a chunk of the stage, the total size is in the Content-Range
+	of the response.
+*/
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+const (
+	stagerMagic = "STG1"
+
+	// Legacy stagers don't send anything, so we only wait this long for a fingerprint
+	fingerprintTimeout = 3 * time.Second
+	maxFingerprintSize = 4096
+	chunkAckTimeout    = 30 * time.Second
+)
+
+var (
+	// ErrInvalidFingerprint - The stager's fingerprint is too large
+	ErrInvalidFingerprint = errors.New("invalid stager fingerprint")
+)
+
+// Stager - The stages of a stager listener
+type Stager struct {
+	Default   []byte // Served when no stage matches or the stager doesn't send a fingerprint
+	Stages    []*clientpb.Stage
+	ChunkSize uint32
+}
+
+// StageFingerprint - The host a stager is running on, as reported by the stager
+type StageFingerprint struct {
+	GOOS      string
+	GOARCH    string
+	Version   string
+	AV        []string
+	ChunkSize uint32
+}
+
+// IsStaged - Does the listener speak the staging protocol, or just serve the default stage
+func (s *Stager) IsStaged() bool {
+	return 0 < len(s.Stages) || 0 < s.ChunkSize
+}
+
+// Select - The first stage that matches the fingerprint, or the default stage. Returns
+// the stage's name (empty for the default stage) and data.
+func (s *Stager) Select(fingerprint *StageFingerprint) (string, []byte) {
+	if fingerprint != nil {
+		for _, stage := range s.Stages {
+			if stageMatches(stage, fingerprint) {
+				return stage.Name, stage.Data
+			}
+		}
+	}
+	return "", s.Default
+}
+
+// chunkSize - The chunk size both the listener and the stager can handle, 0 for no limit
+func (s *Stager) chunkSize(fingerprint *StageFingerprint) uint32 {
+	if fingerprint == nil || fingerprint.ChunkSize == 0 {
+		return s.ChunkSize
+	}
+	if s.ChunkSize == 0 || fingerprint.ChunkSize < s.ChunkSize {
+		return fingerprint.ChunkSize
+	}
+	return s.ChunkSize
+}
+
+func stageMatches(stage *clientpb.Stage, fingerprint *StageFingerprint) bool {
+	if stage.GOOS != "" && !strings.EqualFold(stage.GOOS, fingerprint.GOOS) {
+		return false
+	}
+	if stage.GOARCH != "" && normalizeArch(stage.GOARCH) != fingerprint.GOARCH {
+		return false
+	}
+	if stage.Version != "" && !strings.HasPrefix(fingerprint.Version, stage.Version) {
+		return false
+	}
+	if 0 < len(stage.AV) {
+		for _, av := range stage.AV {
+			for _, hostAV := range fingerprint.AV {
+				if strings.EqualFold(av, hostAV) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// normalizeArch - Stagers report the arch the way their platform names it
+func normalizeArch(arch string) string {
+	switch strings.ToLower(arch) {
+	case "x64", "x86_64", "amd64":
+		return "amd64"
+	case "x86", "i386", "i686", "386":
+		return "386"
+	case "aarch64", "arm64":
+		return "arm64"
+	}
+	return strings.ToLower(arch)
+}
+
+// ParseStageFingerprint - Parse a fingerprint's key=value lines, unknown keys are ignored
+func ParseStageFingerprint(data []byte) *StageFingerprint {
+	values := url.Values{}
+	for _, line := range strings.Split(string(data), "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if found {
+			values.Set(strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value))
+		}
+	}
+	return stageFingerprintFromValues(values)
+}
+
+// stageFingerprintFromValues - The fingerprint in a query string, nil if there isn't one
+func stageFingerprintFromValues(values url.Values) *StageFingerprint {
+	fingerprint := &StageFingerprint{
+		GOOS:    strings.ToLower(values.Get("os")),
+		GOARCH:  normalizeArch(values.Get("arch")),
+		Version: values.Get("version"),
+	}
+	for _, av := range strings.Split(values.Get("av"), ",") {
+		if av = strings.TrimSpace(av); av != "" {
+			fingerprint.AV = append(fingerprint.AV, av)
+		}
+	}
+	if chunkSize, err := strconv.ParseUint(values.Get("chunk"), 10, 32); err == nil {
+		fingerprint.ChunkSize = uint32(chunkSize)
+	}
+	if fingerprint.GOOS == "" && fingerprint.GOARCH == "" && fingerprint.Version == "" && len(fingerprint.AV) == 0 && fingerprint.ChunkSize == 0 {
+		return nil
+	}
+	return fingerprint
+}
+
+// readStageFingerprint - Read the stager's fingerprint, returns nil if the stager
+// doesn't send one before the timeout, but some bytes might have been read
+func readStageFingerprint(conn net.Conn, reader *bufio.Reader) (*StageFingerprint, error) {
+	conn.SetReadDeadline(time.Now().Add(fingerprintTimeout))
+	defer conn.SetReadDeadline(time.Time{})
+	magic, err := reader.Peek(len(stagerMagic))
+	if err != nil || string(magic) != stagerMagic {
+		return nil, nil
+	}
+	reader.Discard(len(stagerMagic))
+	var size uint32
+	err = binary.Read(reader, binary.LittleEndian, &size)
+	if err != nil {
+		return nil, err
+	}
+	if maxFingerprintSize < size {
+		return nil, ErrInvalidFingerprint
+	}
+	data := make([]byte, size)
+	_, err = io.ReadFull(reader, data)
+	if err != nil {
+		return nil, err
+	}
+	fingerprint := ParseStageFingerprint(data)
+	if fingerprint == nil {
+		fingerprint = &StageFingerprint{}
+	}
+	return fingerprint, nil
+}
+
+// writeStage - Send the stage's size, chunk size and the stage in chunks, each chunk
+// waits for the stager's ack before the next one is sent
+func writeStage(conn net.Conn, reader *bufio.Reader, data []byte, chunkSize uint32) error {
+	header := make([]byte, 8)
+	binary.LittleEndian.PutUint32(header, uint32(len(data)))
+	binary.LittleEndian.PutUint32(header[4:], chunkSize)
+	_, err := conn.Write(header)
+	if err != nil {
+		return err
+	}
+	if chunkSize == 0 {
+		_, err = conn.Write(data)
+		return err
+	}
+	for offset := 0; offset < len(data); offset += int(chunkSize) {
+		end := offset + int(chunkSize)
+		if len(data) < end {
+			end = len(data)
+		}
+		_, err = conn.Write(data[offset:end])
+		if err != nil {
+			return err
+		}
+		conn.SetReadDeadline(time.Now().Add(chunkAckTimeout))
+		_, err = reader.ReadByte()
+		if err != nil {
+			return err
+		}
+	}
+	conn.SetReadDeadline(time.Time{})
+	return nil
+}
+
+// stageRange - The range of the stage to serve for a Range header (only the
+// 'bytes=start-end' form), capped to the chunk size. ok is false if the range
+// isn't satisfiable.
+func stageRange(header string, size int, chunkSize uint32) (int, int, bool) {
+	start, end := 0, size-1
+	if spec, found := strings.CutPrefix(header, "bytes="); found {
+		first, last, _ := strings.Cut(strings.TrimSpace(spec), "-")
+		if first == "" {
+			// Suffix range, the last N bytes
+			count, err := strconv.Atoi(last)
+			if err != nil || count <= 0 {
+				return 0, 0, false
+			}
+			if size < count {
+				count = size
+			}
+			start = size - count
+		} else {
+			var err error
+			start, err = strconv.Atoi(first)
+			if err != nil || start < 0 {
+				return 0, 0, false
+			}
+			if last != "" {
+				end, err = strconv.Atoi(last)
+				if err != nil || end < start {
+					return 0, 0, false
+				}
+				if size <= end {
+					end = size - 1
+				}
+			}
+		}
+	}
+	if size <= start {
+		return 0, 0, false
+	}
+	if 0 < chunkSize && int(chunkSize) < end-start+1 {
+		end = start + int(chunkSize) - 1
+	}
+	return start, end, true
+}
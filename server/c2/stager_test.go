@@ -0,0 +1,142 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+func TestStagerSelect(t *testing.T) {
+	stager := &Stager{
+		Default: []byte("default"),
+		Stages: []*clientpb.Stage{
+			{Name: "edr", Data: []byte("edr"), AV: []string{"CrowdStrike"}},
+			{Name: "win7", Data: []byte("win7"), GOOS: "windows", Version: "6.1"},
+			{Name: "win64", Data: []byte("win64"), GOOS: "windows", GOARCH: "amd64"},
+		},
+	}
+	tests := []struct {
+		fingerprint string
+		stage       string
+	}{
+		{"os=windows\narch=x64\nversion=10.0.19045\nav=defender,crowdstrike\n", "edr"},
+		{"os=Windows\narch=x86\nversion=6.1.7601\n", "win7"},
+		{"os=windows\narch=x86_64\nversion=10.0.19045\nav=defender\n", "win64"},
+		{"os=windows\narch=x86\nversion=10.0.19045\n", ""},
+		{"os=linux\narch=amd64\n", ""},
+	}
+	for _, test := range tests {
+		name, data := stager.Select(ParseStageFingerprint([]byte(test.fingerprint)))
+		if name != test.stage {
+			t.Errorf("fingerprint %q selected stage '%s', expected '%s'", test.fingerprint, name, test.stage)
+		}
+		if name == "" && !bytes.Equal(data, stager.Default) {
+			t.Errorf("fingerprint %q selected %q instead of the default stage", test.fingerprint, data)
+		}
+	}
+	if name, _ := stager.Select(nil); name != "" {
+		t.Errorf("stager without a fingerprint selected stage '%s'", name)
+	}
+}
+
+func TestStagerChunkSize(t *testing.T) {
+	stager := &Stager{ChunkSize: 4096}
+	if size := stager.chunkSize(&StageFingerprint{ChunkSize: 1024}); size != 1024 {
+		t.Errorf("expected the stager's smaller chunk size, got %d", size)
+	}
+	if size := stager.chunkSize(&StageFingerprint{ChunkSize: 8192}); size != 4096 {
+		t.Errorf("expected the listener's smaller chunk size, got %d", size)
+	}
+	if size := stager.chunkSize(nil); size != 4096 {
+		t.Errorf("expected the listener's chunk size, got %d", size)
+	}
+	if size := (&Stager{}).chunkSize(&StageFingerprint{ChunkSize: 512}); size != 512 {
+		t.Errorf("expected the stager's chunk size, got %d", size)
+	}
+}
+
+func TestStageRange(t *testing.T) {
+	tests := []struct {
+		header     string
+		chunkSize  uint32
+		start, end int
+		ok         bool
+	}{
+		{"", 0, 0, 99, true},
+		{"", 10, 0, 9, true},
+		{"bytes=10-", 0, 10, 99, true},
+		{"bytes=10-", 20, 10, 29, true},
+		{"bytes=90-200", 0, 90, 99, true},
+		{"bytes=-10", 0, 90, 99, true},
+		{"bytes=100-", 0, 0, 0, false},
+		{"bytes=20-10", 0, 0, 0, false},
+	}
+	for _, test := range tests {
+		start, end, ok := stageRange(test.header, 100, test.chunkSize)
+		if ok != test.ok || (ok && (start != test.start || end != test.end)) {
+			t.Errorf("range %q (chunk %d) = %d-%d %v, expected %d-%d %v", test.header, test.chunkSize,
+				start, end, ok, test.start, test.end, test.ok)
+		}
+	}
+}
+
+func TestTCPStagerChunks(t *testing.T) {
+	stage := bytes.Repeat([]byte("0123456789"), 100)
+	stager := &Stager{
+		Default:   []byte("default"),
+		Stages:    []*clientpb.Stage{{Name: "linux", Data: stage, GOOS: "linux"}},
+		ChunkSize: 256,
+	}
+	stub, conn := shellConns(t)
+	defer stub.Close()
+	go handleConnection(conn, stager)
+
+	fingerprint := []byte("os=linux\narch=amd64\nchunk=300\n")
+	size := make([]byte, 4)
+	binary.LittleEndian.PutUint32(size, uint32(len(fingerprint)))
+	stub.Write(append(append([]byte(stagerMagic), size...), fingerprint...))
+
+	header := make([]byte, 8)
+	if _, err := io.ReadFull(stub, header); err != nil {
+		t.Fatal(err)
+	}
+	if binary.LittleEndian.Uint32(header) != uint32(len(stage)) || binary.LittleEndian.Uint32(header[4:]) != 256 {
+		t.Fatalf("unexpected header %v", header)
+	}
+	received := []byte{}
+	for len(received) < len(stage) {
+		chunk := make([]byte, 256)
+		if len(stage)-len(received) < len(chunk) {
+			chunk = chunk[:len(stage)-len(received)]
+		}
+		if _, err := io.ReadFull(stub, chunk); err != nil {
+			t.Fatal(err)
+		}
+		received = append(received, chunk...)
+		stub.Write([]byte{1})
+	}
+	if !bytes.Equal(received, stage) {
+		t.Fatal("received stage doesn't match")
+	}
+}
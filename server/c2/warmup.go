@@ -0,0 +1,213 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	insecureRand "math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+)
+
+const (
+	defaultWarmupRequestsPerHour = 20
+	warmupRequestTimeout         = 30 * time.Second
+	maxWarmupBodySize            = 2 * 1024 * 1024
+)
+
+var (
+	// ErrNoWarmupTargets - Nothing to send traffic to
+	ErrNoWarmupTargets = errors.New("no urls or dns names to warm up")
+	// ErrInvalidWarmupHours - Active hours are 0-23
+	ErrInvalidWarmupHours = errors.New("invalid active hours, must be 0-23")
+
+	warmupOS = []string{"windows", "windows", "windows", "darwin", "linux"}
+)
+
+// WarmupConfig - Benign looking traffic sent to new infrastructure, so it has an
+// activity history before implants start calling back
+type WarmupConfig struct {
+	URLs            []string
+	DNSNames        []string
+	Resolver        string // host:port, empty for the system resolver
+	RequestsPerHour uint32
+	Duration        time.Duration // 0 runs until the job is killed
+	ActiveFrom      int           // Hours of the day (server time) traffic is sent in,
+	ActiveUntil     int           // equal hours are always active
+}
+
+type warmup struct {
+	conf     *WarmupConfig
+	client   *http.Client
+	resolver *net.Resolver
+}
+
+func newWarmup(conf *WarmupConfig) (*warmup, error) {
+	if len(conf.URLs) == 0 && len(conf.DNSNames) == 0 {
+		return nil, ErrNoWarmupTargets
+	}
+	for _, rawURL := range conf.URLs {
+		target, err := url.Parse(rawURL)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return nil, fmt.Errorf("invalid warmup url '%s'", rawURL)
+		}
+	}
+	if conf.ActiveFrom < 0 || 23 < conf.ActiveFrom || conf.ActiveUntil < 0 || 23 < conf.ActiveUntil {
+		return nil, ErrInvalidWarmupHours
+	}
+	if conf.RequestsPerHour == 0 {
+		conf.RequestsPerHour = defaultWarmupRequestsPerHour
+	}
+	resolver := net.DefaultResolver
+	if conf.Resolver != "" {
+		if _, _, err := net.SplitHostPort(conf.Resolver); err != nil {
+			conf.Resolver = net.JoinHostPort(conf.Resolver, "53")
+		}
+		resolver = &net.Resolver{
+			PreferGo: true,
+			Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, conf.Resolver)
+			},
+		}
+	}
+	return &warmup{
+		conf:     conf,
+		client:   &http.Client{Timeout: warmupRequestTimeout},
+		resolver: resolver,
+	}, nil
+}
+
+// active - Traffic is only sent during the active hours, e.g. business hours
+func (w *warmup) active(now time.Time) bool {
+	from, until, hour := w.conf.ActiveFrom, w.conf.ActiveUntil, now.Hour()
+	if from == until {
+		return true
+	}
+	if from < until {
+		return from <= hour && hour < until
+	}
+	return from <= hour || hour < until // The window wraps around midnight
+}
+
+// next - Time until the next request, exponentially distributed so requests
+// arrive at random like they would from real visitors
+func (w *warmup) next() time.Duration {
+	mean := float64(time.Hour) / float64(w.conf.RequestsPerHour)
+	return time.Duration(insecureRand.ExpFloat64() * mean)
+}
+
+// send - Request a random url or look up a random dns name
+func (w *warmup) send(ctx context.Context) error {
+	index := insecureRand.Intn(len(w.conf.URLs) + len(w.conf.DNSNames))
+	if index < len(w.conf.URLs) {
+		return w.get(ctx, w.conf.URLs[index])
+	}
+	name := w.conf.DNSNames[index-len(w.conf.URLs)]
+	ctx, cancel := context.WithTimeout(ctx, warmupRequestTimeout)
+	defer cancel()
+	_, err := w.resolver.LookupHost(ctx, name)
+	jobLog.Debugf("[warmup] lookup %s (err: %v)", name, err)
+	return err
+}
+
+func (w *warmup) get(ctx context.Context, target string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	goos := warmupOS[insecureRand.Intn(len(warmupOS))]
+	req.Header.Set("User-Agent", configs.GetHTTPC2Config().GenerateUserAgent(goos, "amd64"))
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		jobLog.Debugf("[warmup] GET %s (err: %v)", target, err)
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxWarmupBodySize)) // Read the page like a browser would
+	jobLog.Debugf("[warmup] GET %s (%d)", target, resp.StatusCode)
+	return nil
+}
+
+// StartWarmupJob - Start sending warmup traffic in the background
+func StartWarmupJob(conf *WarmupConfig) (*core.Job, error) {
+	w, err := newWarmup(conf)
+	if err != nil {
+		return nil, err
+	}
+	description := fmt.Sprintf("%d requests/hour", conf.RequestsPerHour)
+	if conf.ActiveFrom != conf.ActiveUntil {
+		description += fmt.Sprintf(" from %02d:00 to %02d:00", conf.ActiveFrom, conf.ActiveUntil)
+	}
+	if 0 < conf.Duration {
+		description += fmt.Sprintf(" for %s", conf.Duration)
+	}
+	domains := []string{}
+	for _, rawURL := range conf.URLs {
+		target, _ := url.Parse(rawURL)
+		domains = append(domains, target.Hostname())
+	}
+	domains = append(domains, conf.DNSNames...)
+	job := &core.Job{
+		ID:          core.NextJobID(),
+		Name:        "warmup",
+		Description: description,
+		Protocol:    "http/dns",
+		JobCtrl:     make(chan bool),
+		Domains:     domains,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var deadline <-chan time.Time
+	if 0 < conf.Duration {
+		deadline = time.After(conf.Duration)
+	}
+	go func() {
+		defer cancel()
+		defer core.Jobs.Remove(job)
+		timer := time.NewTimer(w.next())
+		defer timer.Stop()
+		for {
+			select {
+			case <-job.JobCtrl:
+				jobLog.Infof("Stopping warmup (%d) ...", job.ID)
+				return
+			case <-deadline:
+				jobLog.Infof("Warmup (%d) finished after %s", job.ID, conf.Duration)
+				return
+			case now := <-timer.C:
+				if w.active(now) {
+					go w.send(ctx)
+				}
+				timer.Reset(w.next())
+			}
+		}
+	}()
+	core.Jobs.Add(job)
+	return job, nil
+}
@@ -0,0 +1,93 @@
+package c2
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWarmupActiveHours(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2023, 1, 1, hour, 30, 0, 0, time.Local)
+	}
+	w := &warmup{conf: &WarmupConfig{ActiveFrom: 8, ActiveUntil: 18}}
+	if !w.active(at(8)) || !w.active(at(17)) || w.active(at(18)) || w.active(at(3)) {
+		t.Fatal("business hours window is wrong")
+	}
+	w.conf.ActiveFrom, w.conf.ActiveUntil = 22, 6
+	if !w.active(at(23)) || !w.active(at(2)) || w.active(at(6)) || w.active(at(12)) {
+		t.Fatal("window wrapping around midnight is wrong")
+	}
+	w.conf.ActiveFrom, w.conf.ActiveUntil = 0, 0
+	if !w.active(at(12)) {
+		t.Fatal("equal hours should always be active")
+	}
+}
+
+func TestWarmupConfig(t *testing.T) {
+	if _, err := newWarmup(&WarmupConfig{}); err != ErrNoWarmupTargets {
+		t.Fatalf("expected no targets error, got %v", err)
+	}
+	if _, err := newWarmup(&WarmupConfig{URLs: []string{"ftp://example.com"}}); err == nil {
+		t.Fatal("non-http url was accepted")
+	}
+	if _, err := newWarmup(&WarmupConfig{DNSNames: []string{"example.com"}, ActiveUntil: 24}); err != ErrInvalidWarmupHours {
+		t.Fatalf("expected invalid hours error, got %v", err)
+	}
+	w, err := newWarmup(&WarmupConfig{DNSNames: []string{"example.com"}, Resolver: "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.conf.RequestsPerHour != defaultWarmupRequestsPerHour || w.conf.Resolver != "127.0.0.1:53" {
+		t.Fatalf("defaults were not applied: %+v", w.conf)
+	}
+	total := time.Duration(0)
+	for i := 0; i < 1000; i++ {
+		total += w.next()
+	}
+	mean := total / 1000
+	if mean < time.Hour/defaultWarmupRequestsPerHour/2 || time.Hour/defaultWarmupRequestsPerHour*2 < mean {
+		t.Fatalf("mean interval %s is far from the configured rate", mean)
+	}
+}
+
+func TestWarmupSend(t *testing.T) {
+	userAgents := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		userAgents <- req.UserAgent()
+		w.Write([]byte("<html></html>"))
+	}))
+	defer server.Close()
+
+	w, err := newWarmup(&WarmupConfig{URLs: []string{server.URL + "/index.html"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.send(context.Background()); err != nil {
+		t.Fatalf("warmup request failed: %s", err)
+	}
+	if userAgent := <-userAgents; !strings.HasPrefix(userAgent, "Mozilla/") {
+		t.Fatalf("expected a browser user agent, got %q", userAgent)
+	}
+}
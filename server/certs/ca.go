@@ -70,6 +70,15 @@ func GenerateCertificateAuthority(caType string, commonName string) (*x509.Certi
 	return cert, key
 }
 
+// RotateCertificateAuthority - Replace the CA for a given type with a new one, any
+// certificates signed by the old CA are removed and will no longer be trusted
+func RotateCertificateAuthority(caType string, commonName string) error {
+	certsLog.Infof("Rotating certificate authority for '%s'", caType)
+	cert, key := GenerateECCCertificate(caType, commonName, true, false)
+	SaveCertificateAuthority(caType, cert, key)
+	return RemoveCertificates(caType)
+}
+
 // GetCertificateAuthority - Get the current CA certificate
 func GetCertificateAuthority(caType string) (*x509.Certificate, *ecdsa.PrivateKey, error) {
 	certPEM, keyPEM, err := GetCertificateAuthorityPEM(caType)
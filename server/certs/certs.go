@@ -128,6 +128,25 @@ func RemoveCertificate(caType string, keyType string, commonName string) error {
 	return err
 }
 
+// ListCertificates - List all of the certificates issued by a given CA, or every
+// certificate in the cert store if caType is blank
+func ListCertificates(caType string) ([]*models.Certificate, error) {
+	certModels := []*models.Certificate{}
+	err := db.Session().Where(&models.Certificate{CAType: caType}).Find(&certModels).Error
+	return certModels, err
+}
+
+// RemoveCertificates - Remove all of the certificates issued by a given CA
+func RemoveCertificates(caType string) error {
+	if caType == "" {
+		return errors.New("must specify a ca type")
+	}
+	err := db.Session().Where(&models.Certificate{
+		CAType: caType,
+	}).Delete(&models.Certificate{}).Error
+	return err
+}
+
 // --------------------------------
 //  Generic Certificate Functions
 // --------------------------------
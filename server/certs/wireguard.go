@@ -19,6 +19,7 @@ package certs
 */
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -115,6 +116,42 @@ func GenerateWGKeys(isPeer bool, wgPeerTunIP string) (string, string, error) {
 	return privKey, pubKey, nil
 }
 
+// GenerateWGPeerKeys - Generate wg keys for an existing peer, they're not saved
+// until the peer switches to them (see ReplaceWGPeer)
+func GenerateWGPeerKeys() (string, string, error) {
+	return genWGKeys()
+}
+
+// ReplaceWGPeer - Replace the keys of the peer with the tun ip, running wg listeners
+// drop the old keys and route the tun ip to the new public key
+func ReplaceWGPeer(wgPeerTunIP string, privKey string, pubKey string) error {
+	dbSession := db.Session()
+	oldPeers := []*models.WGPeer{}
+	err := dbSession.Where(&models.WGPeer{TunIP: wgPeerTunIP}).Find(&oldPeers).Error
+	if err != nil {
+		return err
+	}
+	err = dbSession.Create(&models.WGPeer{
+		PrivKey: privKey,
+		PubKey:  pubKey,
+		TunIP:   wgPeerTunIP,
+	}).Error
+	if err != nil {
+		return err
+	}
+	config := bytes.NewBuffer(nil)
+	for _, oldPeer := range oldPeers {
+		fmt.Fprintf(config, "public_key=%s\nremove=true\n", oldPeer.PubKey)
+		dbSession.Delete(oldPeer)
+	}
+	fmt.Fprintf(config, "public_key=%s\nallowed_ip=%s/32\n", pubKey, wgPeerTunIP)
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.WireGuardNewPeer,
+		Data:      config.Bytes(),
+	})
+	return nil
+}
+
 func genWGKeys() (string, string, error) {
 	wgKeysLog.Infof("Generating wg keys")
 
@@ -0,0 +1,119 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/bishopfox/sliver/server/migration"
+	"github.com/spf13/cobra"
+)
+
+var cmdExportBeacons = &cobra.Command{
+	Use:   "export-beacons",
+	Short: "Export beacons, their keys and queued tasks to move them to another server",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		save, err := cmd.Flags().GetString(saveFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", saveFlagStr, err)
+			os.Exit(1)
+		}
+		if save == "" {
+			save, _ = os.Getwd()
+		}
+		beaconIDs, err := cmd.Flags().GetStringSlice(beaconFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", beaconFlagStr, err)
+			os.Exit(1)
+		}
+		implantNames, err := cmd.Flags().GetStringSlice(implantFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", implantFlagStr, err)
+			os.Exit(1)
+		}
+
+		bundle, err := migration.Export(beaconIDs, implantNames)
+		if err != nil {
+			fmt.Printf("Export failed %s\n", err)
+			os.Exit(1)
+		}
+
+		saveTo, _ := filepath.Abs(save)
+		fi, err := os.Stat(saveTo)
+		if !os.IsNotExist(err) && !fi.IsDir() {
+			fmt.Printf("File already exists: %s\n", saveTo)
+			os.Exit(1)
+		}
+		if !os.IsNotExist(err) && fi.IsDir() {
+			saveTo = filepath.Join(saveTo, fmt.Sprintf("beacons_%s.json", bundle.ExportedAt.Format("20060102150405")))
+		}
+		data, _ := json.Marshal(bundle)
+		err = os.WriteFile(saveTo, data, 0600)
+		if err != nil {
+			fmt.Printf("Write failed: %s (%s)\n", saveTo, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Exported %d beacon(s) and %d implant build(s) to %s\n", len(bundle.Beacons), len(bundle.Builds), saveTo)
+		fmt.Printf("The file contains the server's keys, keep it safe\n")
+	},
+}
+
+var cmdImportBeacons = &cobra.Command{
+	Use:   "import-beacons",
+	Short: "Import beacons exported from another server",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		load, err := cmd.Flags().GetString(loadFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", loadFlagStr, err)
+			os.Exit(1)
+		}
+		fi, err := os.Stat(load)
+		if os.IsNotExist(err) || fi.IsDir() {
+			fmt.Printf("Cannot load file %s\n", load)
+			os.Exit(1)
+		}
+		data, err := os.ReadFile(load)
+		if err != nil {
+			fmt.Printf("Cannot read file %s\n", err)
+			os.Exit(1)
+		}
+		bundle := &migration.Bundle{}
+		err = json.Unmarshal(data, bundle)
+		if err != nil {
+			fmt.Printf("Failed to parse file %s\n", err)
+			os.Exit(1)
+		}
+
+		imported, err := migration.Import(bundle)
+		if err != nil {
+			fmt.Printf("Import failed %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Imported %d beacon(s), %d task(s), %d implant build(s), and %d host(s)\n",
+			imported.Beacons, imported.Tasks, imported.Builds, imported.Hosts)
+		if skipped := len(bundle.Beacons) - imported.Beacons; 0 < skipped {
+			fmt.Printf("Skipped %d beacon(s) the server already has\n", skipped)
+		}
+	},
+}
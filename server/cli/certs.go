@@ -19,13 +19,19 @@ package cli
 */
 
 import (
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/spf13/cobra"
 )
 
@@ -36,6 +42,11 @@ var (
 		"mtls":     certs.MtlsImplantCA,
 		"https":    certs.HTTPSCA,
 	}
+
+	// caCommonNames - Common names used when (re)generating each type of CA
+	caCommonNames = map[string]string{
+		certs.OperatorCA: "operators",
+	}
 )
 
 // CA - Exported CA format
@@ -153,3 +164,144 @@ var cmdExportCA = &cobra.Command{
 		}
 	},
 }
+
+// CertificateInfo - Exported certificate format
+type CertificateInfo struct {
+	CommonName string    `json:"common_name"`
+	CAType     string    `json:"ca_type"`
+	KeyType    string    `json:"key_type"`
+	CreatedAt  time.Time `json:"created_at"`
+	NotAfter   time.Time `json:"not_after"`
+}
+
+var cmdCerts = &cobra.Command{
+	Use:   "certs",
+	Short: "Manage certificates",
+	Long:  ``,
+}
+
+var cmdListCerts = &cobra.Command{
+	Use:   "list",
+	Short: "List certificates issued by the server",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		caType, err := cmd.Flags().GetString(caTypeFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", caTypeFlagStr, err)
+			os.Exit(1)
+		}
+		ca := ""
+		if caType != "" {
+			var ok bool
+			ca, ok = CATypes[caType]
+			if !ok {
+				CAs := strings.Join(validCATypes(), ", ")
+				fmt.Printf("Invalid ca type '%s' must be one of %s\n", caType, CAs)
+				os.Exit(1)
+			}
+		}
+		jsonOutput, err := cmd.Flags().GetBool(jsonFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", jsonFlagStr, err)
+			os.Exit(1)
+		}
+
+		certModels, err := certs.ListCertificates(ca)
+		if err != nil {
+			fmt.Printf("Failed to list certificates %s\n", err)
+			os.Exit(1)
+		}
+		certInfos := []*CertificateInfo{}
+		for _, certModel := range certModels {
+			certInfos = append(certInfos, &CertificateInfo{
+				CommonName: certModel.CommonName,
+				CAType:     certModel.CAType,
+				KeyType:    certModel.KeyType,
+				CreatedAt:  certModel.CreatedAt,
+				NotAfter:   certNotAfter(certModel),
+			})
+		}
+
+		if jsonOutput {
+			data, _ := json.MarshalIndent(certInfos, "", "    ")
+			fmt.Printf("%s\n", data)
+			return
+		}
+		table := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+		fmt.Fprintf(table, "Common Name\tCA\tKey\tCreated\tExpires\t\n")
+		for _, info := range certInfos {
+			expires := ""
+			if !info.NotAfter.IsZero() {
+				expires = info.NotAfter.Format(time.RFC1123)
+			}
+			fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t\n",
+				info.CommonName, info.CAType, info.KeyType, info.CreatedAt.Format(time.RFC1123), expires)
+		}
+		table.Flush()
+	},
+}
+
+var cmdRotateCA = &cobra.Command{
+	Use:   "rotate",
+	Short: "Replace a certificate authority and remove the certificates it issued",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		caType, err := cmd.Flags().GetString(caTypeFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", caTypeFlagStr, err)
+			os.Exit(1)
+		}
+		ca, ok := CATypes[caType]
+		if !ok {
+			CAs := strings.Join(validCATypes(), ", ")
+			fmt.Printf("Invalid ca type '%s' must be one of %s\n", caType, CAs)
+			os.Exit(1)
+		}
+		force, err := cmd.Flags().GetBool(forceFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", forceFlagStr, err)
+			os.Exit(1)
+		}
+		if !force {
+			fmt.Printf("Rotating the %s CA invalidates every certificate it has issued, ", caType)
+			switch ca {
+			case certs.OperatorCA:
+				fmt.Printf("all operator configs will need to be regenerated.\n")
+			case certs.MtlsImplantCA:
+				fmt.Printf("existing mtls implants will no longer be able to connect.\n")
+			default:
+				fmt.Printf("listeners will need to be restarted.\n")
+			}
+			fmt.Printf("Re-run with --%s to continue\n", forceFlagStr)
+			os.Exit(1)
+		}
+
+		certs.SetupCAs()
+		err = certs.RotateCertificateAuthority(ca, caCommonNames[ca])
+		if err != nil {
+			fmt.Printf("Failed to rotate CA %s\n", err)
+			os.Exit(1)
+		}
+		if ca == certs.OperatorCA {
+			// Operator tokens are useless without a client certificate
+			err = db.Session().Where("1 = 1").Delete(&models.Operator{}).Error
+			if err != nil {
+				fmt.Printf("Failed to remove operator auth tokens %s\n", err)
+				os.Exit(1)
+			}
+		}
+		fmt.Printf("Rotated %s CA\n", caType)
+	},
+}
+
+func certNotAfter(certModel *models.Certificate) time.Time {
+	block, _ := pem.Decode([]byte(certModel.CertificatePEM))
+	if block == nil {
+		return time.Time{}
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}
+	}
+	return cert.NotAfter
+}
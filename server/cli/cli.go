@@ -33,6 +33,8 @@ import (
 	"github.com/bishopfox/sliver/server/console"
 	"github.com/bishopfox/sliver/server/cryptography"
 	"github.com/bishopfox/sliver/server/daemon"
+	"github.com/bishopfox/sliver/server/engagement"
+	"github.com/bishopfox/sliver/server/handlers"
 	"github.com/spf13/cobra"
 )
 
@@ -47,10 +49,21 @@ const (
 	lportFlagStr = "lport"
 	saveFlagStr  = "save"
 
+	secondFactorFlagStr    = "second-factor"
+	fido2CredentialFlagStr = "fido2-credential"
+	websocketURLFlagStr    = "websocket-url"
+
 	// Cert flags
 	caTypeFlagStr = "type"
 	loadFlagStr   = "load"
 
+	// Output flags
+	jsonFlagStr = "json"
+
+	// Beacon export flags
+	beaconFlagStr  = "beacon"
+	implantFlagStr = "implant"
+
 	// console log file name
 	logFileName = "console.log"
 )
@@ -77,6 +90,13 @@ func init() {
 	operatorCmd.Flags().StringP(lhostFlagStr, "l", "", "multiplayer listener host")
 	operatorCmd.Flags().Uint16P(lportFlagStr, "p", uint16(31337), "multiplayer listener port")
 	operatorCmd.Flags().StringP(saveFlagStr, "s", "", "save file to ...")
+	operatorCmd.Flags().String(secondFactorFlagStr, "", "require a second factor when connecting (totp or fido2)")
+	operatorCmd.Flags().String(fido2CredentialFlagStr, "", "fido2 credential file from 'sliver-client fido2-enroll'")
+	operatorCmd.Flags().String(websocketURLFlagStr, "", "tunnel the connection over a websocket to this url (ws:// or wss://)")
+	operatorListCmd.Flags().BoolP(jsonFlagStr, "j", false, "output as json")
+	operatorCmd.AddCommand(operatorListCmd)
+	operatorRevokeCmd.Flags().StringP(nameFlagStr, "n", "", "operator name")
+	operatorCmd.AddCommand(operatorRevokeCmd)
 	rootCmd.AddCommand(operatorCmd)
 
 	// Certs
@@ -88,6 +108,36 @@ func init() {
 	cmdImportCA.Flags().StringP(caTypeFlagStr, "t", "", fmt.Sprintf("ca type (%s)", strings.Join(validCATypes(), ", ")))
 	rootCmd.AddCommand(cmdImportCA)
 
+	// Beacons
+	cmdExportBeacons.Flags().StringP(saveFlagStr, "s", "", "save beacons to file ...")
+	cmdExportBeacons.Flags().StringSliceP(beaconFlagStr, "b", []string{}, "only export these beacon ids (default all)")
+	cmdExportBeacons.Flags().StringSliceP(implantFlagStr, "i", []string{}, "also export these implant builds, e.g. of renamed beacons")
+	rootCmd.AddCommand(cmdExportBeacons)
+
+	cmdImportBeacons.Flags().StringP(loadFlagStr, "l", "", "load beacons from file ...")
+	rootCmd.AddCommand(cmdImportBeacons)
+
+	cmdListCerts.Flags().StringP(caTypeFlagStr, "t", "", fmt.Sprintf("only list certs issued by ca type (%s)", strings.Join(validCATypes(), ", ")))
+	cmdListCerts.Flags().BoolP(jsonFlagStr, "j", false, "output as json")
+	cmdCerts.AddCommand(cmdListCerts)
+	cmdRotateCA.Flags().StringP(caTypeFlagStr, "t", "", fmt.Sprintf("ca type (%s)", strings.Join(validCATypes(), ", ")))
+	cmdRotateCA.Flags().BoolP(forceFlagStr, "f", false, "do not refuse to invalidate issued certificates")
+	cmdCerts.AddCommand(cmdRotateCA)
+	rootCmd.AddCommand(cmdCerts)
+
+	// Jobs
+	jobsCmd.Flags().BoolP(jsonFlagStr, "j", false, "output as json")
+	rootCmd.AddCommand(jobsCmd)
+
+	// Database
+	dbCmd.AddCommand(dbMigrateCmd)
+	dbCmd.AddCommand(dbVacuumCmd)
+	rootCmd.AddCommand(dbCmd)
+
+	// Config
+	configCmd.AddCommand(configValidateCmd)
+	rootCmd.AddCommand(configCmd)
+
 	// Daemon
 	daemonCmd.Flags().StringP(lhostFlagStr, "l", daemon.BlankHost, "multiplayer listener host")
 	daemonCmd.Flags().Uint16P(lportFlagStr, "p", daemon.BlankPort, "multiplayer listener port")
@@ -129,6 +179,8 @@ var rootCmd = &cobra.Command{
 		cryptography.MinisignServerPrivateKey()
 
 		serverConfig := configs.GetServerConfig()
+		handlers.StartBeaconTaskTimeouts()
+		engagement.Start()
 		c2.StartPersistentJobs(serverConfig)
 		console.StartPersistentJobs(serverConfig)
 		if serverConfig.DaemonMode {
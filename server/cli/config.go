@@ -0,0 +1,63 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Server configuration",
+	Long:  ``,
+}
+
+var configValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the server configuration files for errors",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		checks := []struct {
+			name  string
+			path  string
+			check func() error
+		}{
+			{"server", configs.GetServerConfigPath(), configs.CheckServerConfigErrors},
+			{"database", configs.GetDatabaseConfigPath(), configs.CheckDatabaseConfigErrors},
+			{"http c2", configs.GetHTTPC2ConfigPath(), configs.CheckHTTPC2ConfigErrors},
+		}
+		failed := false
+		for _, config := range checks {
+			err := config.check()
+			if err != nil {
+				fmt.Printf("[!] %s config (%s): %s\n", config.name, config.path, err)
+				failed = true
+				continue
+			}
+			fmt.Printf("[*] %s config (%s): ok\n", config.name, config.path)
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
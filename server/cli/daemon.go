@@ -12,6 +12,8 @@ import (
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/cryptography"
 	"github.com/bishopfox/sliver/server/daemon"
+	"github.com/bishopfox/sliver/server/engagement"
+	"github.com/bishopfox/sliver/server/handlers"
 	"github.com/spf13/cobra"
 )
 
@@ -56,6 +58,8 @@ var daemonCmd = &cobra.Command{
 		cryptography.MinisignServerPrivateKey()
 
 		serverConfig := configs.GetServerConfig()
+		handlers.StartBeaconTaskTimeouts()
+		engagement.Start()
 		c2.StartPersistentJobs(serverConfig)
 
 		daemon.Start(lhost, uint16(lport))
@@ -0,0 +1,61 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/spf13/cobra"
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database maintenance",
+	Long:  ``,
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Create or update the database schema",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := db.Migrate()
+		if err != nil {
+			fmt.Printf("Migration failed %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Database schema is up to date\n")
+	},
+}
+
+var dbVacuumCmd = &cobra.Command{
+	Use:   "vacuum",
+	Short: "Reclaim unused space in the database",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := db.Vacuum()
+		if err != nil {
+			fmt.Printf("Vacuum failed %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Database vacuumed\n")
+	},
+}
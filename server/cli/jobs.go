@@ -0,0 +1,115 @@
+package cli
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/spf13/cobra"
+)
+
+// PersistentJob - Exported persistent job format
+type PersistentJob struct {
+	JobID   string `json:"job_id"`
+	Name    string `json:"name"`
+	Host    string `json:"host"`
+	Port    uint16 `json:"port"`
+	Details string `json:"details"`
+}
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "List persistent jobs",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		jsonOutput, err := cmd.Flags().GetBool(jsonFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", jsonFlagStr, err)
+			os.Exit(1)
+		}
+
+		jobs := persistentJobs(configs.GetServerConfig())
+		if jsonOutput {
+			data, _ := json.MarshalIndent(jobs, "", "    ")
+			fmt.Printf("%s\n", data)
+			return
+		}
+		table := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+		fmt.Fprintf(table, "Job ID\tName\tHost\tPort\tDetails\t\n")
+		for _, job := range jobs {
+			fmt.Fprintf(table, "%s\t%s\t%s\t%d\t%s\t\n", job.JobID, job.Name, job.Host, job.Port, job.Details)
+		}
+		table.Flush()
+	},
+}
+
+func persistentJobs(serverConfig *configs.ServerConfig) []*PersistentJob {
+	jobs := []*PersistentJob{}
+	if serverConfig.Jobs == nil {
+		return jobs
+	}
+	for _, job := range serverConfig.Jobs.Multiplayer {
+		jobs = append(jobs, &PersistentJob{JobID: job.JobID, Name: "grpc", Host: job.Host, Port: job.Port})
+	}
+	for _, job := range serverConfig.Jobs.MTLS {
+		jobs = append(jobs, &PersistentJob{JobID: job.JobID, Name: "mtls", Host: job.Host, Port: job.Port})
+	}
+	for _, job := range serverConfig.Jobs.WG {
+		jobs = append(jobs, &PersistentJob{
+			JobID:   job.JobID,
+			Name:    "wg",
+			Port:    job.Port,
+			Details: fmt.Sprintf("nport %d, key port %d", job.NPort, job.KeyPort),
+		})
+	}
+	for _, job := range serverConfig.Jobs.DNS {
+		jobs = append(jobs, &PersistentJob{
+			JobID:   job.JobID,
+			Name:    "dns",
+			Host:    job.Host,
+			Port:    job.Port,
+			Details: strings.Join(job.Domains, ", "),
+		})
+	}
+	for _, job := range serverConfig.Jobs.HTTP {
+		name := "http"
+		if job.Secure {
+			name = "https"
+		}
+		jobs = append(jobs, &PersistentJob{
+			JobID:   job.JobID,
+			Name:    name,
+			Host:    job.Host,
+			Port:    job.Port,
+			Details: job.Domain,
+		})
+	}
+	for _, job := range serverConfig.Jobs.ICMP {
+		jobs = append(jobs, &PersistentJob{JobID: job.JobID, Name: "icmp", Host: job.Host})
+	}
+	for _, job := range serverConfig.Jobs.UDP {
+		jobs = append(jobs, &PersistentJob{JobID: job.JobID, Name: "udp", Host: job.Host, Port: job.Port})
+	}
+	return jobs
+}
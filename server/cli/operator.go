@@ -19,12 +19,19 @@ package cli
 */
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"text/tabwriter"
+	"time"
 
 	"github.com/bishopfox/sliver/server/certs"
 	"github.com/bishopfox/sliver/server/console"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/secondfactor"
 	"github.com/spf13/cobra"
 )
 
@@ -69,12 +76,37 @@ var operatorCmd = &cobra.Command{
 			save, _ = os.Getwd()
 		}
 
+		factorType, err := cmd.Flags().GetString(secondFactorFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s", secondFactorFlagStr, err)
+			os.Exit(1)
+		}
+		fido2Credential, err := cmd.Flags().GetString(fido2CredentialFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s", fido2CredentialFlagStr, err)
+			os.Exit(1)
+		}
+
+		websocketURL, err := cmd.Flags().GetString(websocketURLFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s", websocketURLFlagStr, err)
+			os.Exit(1)
+		}
+
 		certs.SetupCAs()
-		configJSON, err := console.NewOperatorConfig(name, lhost, lport)
+		configJSON, err := console.NewOperatorConfig(name, lhost, lport, websocketURL)
 		if err != nil {
 			fmt.Printf("Failed: %s\n", err)
 			os.Exit(1)
 		}
+		msg, err := console.EnrollOperatorSecondFactor(name, factorType, fido2Credential)
+		if err != nil {
+			fmt.Printf("Failed to enroll second factor: %s\n", err)
+			os.Exit(1)
+		}
+		if msg != "" {
+			fmt.Println(msg)
+		}
 
 		saveTo, _ := filepath.Abs(save)
 		fi, err := os.Stat(saveTo)
@@ -93,3 +125,102 @@ var operatorCmd = &cobra.Command{
 		}
 	},
 }
+
+// OperatorInfo - Exported operator format
+type OperatorInfo struct {
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	HasCert   bool      `json:"has_certificate"`
+}
+
+var operatorListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List operators",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		jsonOutput, err := cmd.Flags().GetBool(jsonFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", jsonFlagStr, err)
+			os.Exit(1)
+		}
+
+		operators := []*models.Operator{}
+		err = db.Session().Order("created_at").Find(&operators).Error
+		if err != nil {
+			fmt.Printf("Failed to list operators %s\n", err)
+			os.Exit(1)
+		}
+		// An operator may have more than one token, only report the first one
+		infos := map[string]*OperatorInfo{}
+		for _, operator := range operators {
+			if _, ok := infos[operator.Name]; ok {
+				continue
+			}
+			_, _, err := certs.OperatorClientGetCertificate(operator.Name)
+			infos[operator.Name] = &OperatorInfo{
+				Name:      operator.Name,
+				CreatedAt: operator.CreatedAt,
+				HasCert:   err == nil,
+			}
+		}
+		operatorInfos := []*OperatorInfo{}
+		for _, info := range infos {
+			operatorInfos = append(operatorInfos, info)
+		}
+		sort.Slice(operatorInfos, func(i, j int) bool {
+			return operatorInfos[i].Name < operatorInfos[j].Name
+		})
+
+		if jsonOutput {
+			data, _ := json.MarshalIndent(operatorInfos, "", "    ")
+			fmt.Printf("%s\n", data)
+			return
+		}
+		table := tabwriter.NewWriter(os.Stdout, 0, 2, 2, ' ', 0)
+		fmt.Fprintf(table, "Name\tCreated\tCertificate\t\n")
+		for _, info := range operatorInfos {
+			fmt.Fprintf(table, "%s\t%s\t%v\t\n", info.Name, info.CreatedAt.Format(time.RFC1123), info.HasCert)
+		}
+		table.Flush()
+	},
+}
+
+var operatorRevokeCmd = &cobra.Command{
+	Use:   "revoke",
+	Short: "Revoke an operator's auth tokens and client certificate",
+	Long:  ``,
+	Run: func(cmd *cobra.Command, args []string) {
+		name, err := cmd.Flags().GetString(nameFlagStr)
+		if err != nil {
+			fmt.Printf("Failed to parse --%s flag %s\n", nameFlagStr, err)
+			os.Exit(1)
+		}
+		if name == "" {
+			fmt.Printf("Must specify --%s\n", nameFlagStr)
+			os.Exit(1)
+		}
+
+		result := db.Session().Where(&models.Operator{
+			Name: name,
+		}).Delete(&models.Operator{})
+		if result.Error != nil {
+			fmt.Printf("Failed to remove auth token(s) %s\n", result.Error)
+			os.Exit(1)
+		}
+		if result.RowsAffected == 0 {
+			fmt.Printf("No operator named '%s'\n", name)
+			os.Exit(1)
+		}
+		err = secondfactor.Remove(name)
+		if err != nil {
+			fmt.Printf("Failed to remove second factor %s\n", err)
+			os.Exit(1)
+		}
+		err = certs.OperatorClientRemoveCertificate(name)
+		if err != nil {
+			fmt.Printf("Failed to remove client certificate(s) %s\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Revoked operator %s, restart the server if it is running\n", name)
+	},
+}
@@ -26,6 +26,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/bishopfox/sliver/server/assets"
 	"github.com/bishopfox/sliver/server/log"
@@ -45,6 +46,12 @@ const (
 var (
 	// ErrInvalidDialect - An invalid dialect was specified
 	ErrInvalidDialect = errors.New("invalid SQL Dialect")
+	// ErrMissingDatabaseHost - A network database was specified without a host
+	ErrMissingDatabaseHost = errors.New("database host and port must be specified")
+	// ErrMissingDatabaseName - A network database was specified without a database name
+	ErrMissingDatabaseName = errors.New("database name must be specified")
+	// ErrInvalidDatabaseLogLevel - An unknown gorm log level was specified
+	ErrInvalidDatabaseLogLevel = errors.New("database log level must be one of silent, err, warn, or info")
 
 	databaseConfigLog = log.NamedLogger("config", "database")
 )
@@ -177,3 +184,43 @@ func getDefaultDatabaseConfig() *DatabaseConfig {
 		LogLevel: "warn",
 	}
 }
+
+// CheckDatabaseConfigErrors - Validate the database config on disk, unlike
+// GetDatabaseConfig() this does not write the config back to disk
+func CheckDatabaseConfigErrors() error {
+	configPath := GetDatabaseConfigPath()
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		return nil // Defaults will be used
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	config := getDefaultDatabaseConfig()
+	err = json.Unmarshal(data, config)
+	if err != nil {
+		return err
+	}
+	return checkDatabaseConfig(config)
+}
+
+func checkDatabaseConfig(config *DatabaseConfig) error {
+	switch config.Dialect {
+	case Sqlite:
+	case MySQL, Postgres:
+		if config.Host == "" || config.Port == 0 {
+			return ErrMissingDatabaseHost
+		}
+		if config.Database == "" {
+			return ErrMissingDatabaseName
+		}
+	default:
+		return fmt.Errorf("%w '%s'", ErrInvalidDialect, config.Dialect)
+	}
+	switch strings.ToLower(config.LogLevel) {
+	case "", "silent", "err", "error", "warn", "warning", "info":
+	default:
+		return ErrInvalidDatabaseLogLevel
+	}
+	return nil
+}
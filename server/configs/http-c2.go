@@ -33,9 +33,11 @@ import (
 )
 
 const (
-	httpC2ConfigFileName = "http-c2.json"
-	DefaultChromeBaseVer = 100
-	DefaultMacOSVer      = "10_15_7"
+	httpC2ConfigFileName  = "http-c2.json"
+	httpC2ProfilesDirName = "http-c2"
+	DefaultHTTPC2Profile  = "default"
+	DefaultChromeBaseVer  = 100
+	DefaultMacOSVer       = "10_15_7"
 )
 
 // HTTPC2Config - Parent config file struct for implant/server
@@ -170,6 +172,10 @@ type HTTPC2ImplantConfig struct {
 	CloseFileExt string   `json:"close_file_ext"`
 	CloseFiles   []string `json:"close_files"`
 	ClosePaths   []string `json:"close_paths"`
+
+	// Wrapped around the encoded data in the server's responses, e.g. html
+	ResponsePrefix string `json:"response_prefix"`
+	ResponseSuffix string `json:"response_suffix"`
 }
 
 func (h *HTTPC2ImplantConfig) RandomPollFiles() []string {
@@ -345,6 +351,91 @@ func CheckHTTPC2ConfigErrors() error {
 	return nil
 }
 
+// GetHTTPC2ProfilesDir - Directory named http c2 profiles are saved to
+func GetHTTPC2ProfilesDir() string {
+	appDir := assets.GetRootAppDir()
+	return path.Join(appDir, "configs", httpC2ProfilesDirName)
+}
+
+// GetHTTPC2Profile - Get a named http c2 profile, the default profile is http-c2.json
+func GetHTTPC2Profile(name string) (*HTTPC2Config, error) {
+	if name == "" || name == DefaultHTTPC2Profile {
+		return GetHTTPC2Config(), nil
+	}
+	if !profileNameExp.MatchString(name) {
+		return nil, ErrInvalidHTTPC2ProfileName
+	}
+	data, err := os.ReadFile(path.Join(GetHTTPC2ProfilesDir(), name+".json"))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w '%s'", ErrHTTPC2ProfileNotFound, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseHTTPC2Config(data)
+}
+
+// ListHTTPC2Profiles - Names of the saved http c2 profiles, including the default
+func ListHTTPC2Profiles() ([]string, error) {
+	names := []string{DefaultHTTPC2Profile}
+	entries, err := os.ReadDir(GetHTTPC2ProfilesDir())
+	if os.IsNotExist(err) {
+		return names, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") || !profileNameExp.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// SaveHTTPC2Profile - Validate and save a named http c2 profile, saving the default
+// profile overwrites http-c2.json
+func SaveHTTPC2Profile(name string, data []byte) error {
+	if !profileNameExp.MatchString(name) {
+		return ErrInvalidHTTPC2ProfileName
+	}
+	config, err := ParseHTTPC2Config(data)
+	if err != nil {
+		return err
+	}
+	data, err = json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return err
+	}
+	if name == DefaultHTTPC2Profile {
+		return os.WriteFile(GetHTTPC2ConfigPath(), data, 0600)
+	}
+	err = os.MkdirAll(GetHTTPC2ProfilesDir(), 0700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path.Join(GetHTTPC2ProfilesDir(), name+".json"), data, 0600)
+}
+
+// ParseHTTPC2Config - Parse and validate a http c2 config, coerces common mistakes
+func ParseHTTPC2Config(data []byte) (*HTTPC2Config, error) {
+	config := &HTTPC2Config{}
+	err := json.Unmarshal(data, config)
+	if err != nil {
+		return nil, err
+	}
+	if config.ImplantConfig == nil || config.ServerConfig == nil {
+		return nil, ErrMissingConfig
+	}
+	err = checkHTTPC2Config(config)
+	if err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func generateDefaultConfig(saveTo string) error {
 	data, err := json.MarshalIndent(defaultHTTPC2Config, "", "    ")
 	if err != nil {
@@ -367,8 +458,13 @@ var (
 	ErrTooFewSessionFiles         = errors.New("implant config must specify at least one session_files value")
 	ErrNonuniqueFileExt           = errors.New("implant config must specify unique file extensions")
 	ErrQueryParamNameLen          = errors.New("implant config url query parameter names must be 3 or more characters")
+	ErrInvalidResponseWrapper     = errors.New("implant config response_prefix and response_suffix cannot contain a '`'")
+	ErrMissingConfig              = errors.New("config must specify an implant_config and a server_config")
+	ErrInvalidHTTPC2ProfileName   = errors.New("http c2 profile names may only contain letters, numbers, '_' and '-'")
+	ErrHTTPC2ProfileNotFound      = errors.New("http c2 profile not found")
 
-	fileNameExp = regexp.MustCompile(`[^a-zA-Z0-9\\._-]+`)
+	fileNameExp    = regexp.MustCompile(`[^a-zA-Z0-9\\._-]+`)
+	profileNameExp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 )
 
 // checkHTTPC2Config - Validate the HTTP C2 config, coerces common mistakes
@@ -505,5 +601,10 @@ func checkImplantConfig(config *HTTPC2ImplantConfig) error {
 		}
 	}
 
+	// Response wrappers are rendered into raw string literals
+	if strings.Contains(config.ResponsePrefix, "`") || strings.Contains(config.ResponseSuffix, "`") {
+		return ErrInvalidResponseWrapper
+	}
+
 	return nil
 }
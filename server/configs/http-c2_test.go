@@ -90,3 +90,31 @@ func TestPollConfig(t *testing.T) {
 	config.ImplantConfig.PollFiles = origPollFiles
 
 }
+
+func TestParseHTTPC2Config(t *testing.T) {
+	_, err := ParseHTTPC2Config([]byte(`{"implant_config": {}}`))
+	if err != ErrMissingConfig {
+		t.Fatalf("Expected missing config error, got %v", err)
+	}
+
+	implantConfig := *defaultHTTPC2Config.ImplantConfig
+	config := defaultHTTPC2Config
+	config.ImplantConfig = &implantConfig
+	config.ImplantConfig.ResponsePrefix = "/* "
+	config.ImplantConfig.ResponseSuffix = " */"
+	data, _ := json.Marshal(config)
+	parsed, err := ParseHTTPC2Config(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.ImplantConfig.ResponsePrefix != "/* " || parsed.ImplantConfig.ResponseSuffix != " */" {
+		t.Fatalf("Response wrapper was not parsed %v", parsed.ImplantConfig)
+	}
+
+	config.ImplantConfig.ResponseSuffix = "`"
+	data, _ = json.Marshal(config)
+	_, err = ParseHTTPC2Config(data)
+	if err != ErrInvalidResponseWrapper {
+		t.Fatalf("Expected invalid response wrapper error, got %v", err)
+	}
+}
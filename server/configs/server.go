@@ -21,6 +21,8 @@ package configs
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	insecureRand "math/rand"
 	"os"
 	"path"
@@ -33,10 +35,45 @@ import (
 
 const (
 	serverConfigFileName = "server.json"
+
+	// CheckinAlertsOff - Never alert on source address changes
+	CheckinAlertsOff = "off"
+	// CheckinAlertsLow - Alert when the country changes or the address flips rapidly
+	CheckinAlertsLow = "low"
+	// CheckinAlertsMedium - Alert when the ASN or country changes or the address flips rapidly
+	CheckinAlertsMedium = "medium"
+	// CheckinAlertsHigh - Alert on any source address change
+	CheckinAlertsHigh = "high"
+
+	// StorageLocal - Store loot and implant builds in the root app dir
+	StorageLocal = "local"
+	// StorageS3 - Store loot and implant builds in an S3 compatible object store
+	StorageS3 = "s3"
 )
 
 var (
 	serverConfigLog = log.NamedLogger("config", "server")
+
+	// ErrInvalidLogLevel - The log level is outside of the supported range
+	ErrInvalidLogLevel = errors.New("log level must be between 0 and 6")
+	// ErrInvalidPort - A port is outside of the valid range
+	ErrInvalidPort = errors.New("port must be between 1 and 65535")
+	// ErrDuplicateJobID - Two persistent jobs share the same job id
+	ErrDuplicateJobID = errors.New("persistent jobs must have unique job ids")
+	// ErrMissingDomains - A persistent DNS job has no parent domains
+	ErrMissingDomains = errors.New("persistent dns jobs must specify at least one domain")
+	// ErrMissingCertOrKey - A persistent HTTPS job has only one of a cert or key
+	ErrMissingCertOrKey = errors.New("persistent https jobs must specify both a cert and a key")
+	// ErrInvalidSensitivity - Unknown check-in alert sensitivity
+	ErrInvalidSensitivity = errors.New("checkin alert sensitivity must be one of off, low, medium, or high")
+	// ErrInvalidStorageBackend - Unknown storage backend
+	ErrInvalidStorageBackend = errors.New("storage backend must be one of local or s3")
+	// ErrMissingS3Bucket - The s3 storage backend requires a bucket and region
+	ErrMissingS3Bucket = errors.New("s3 storage requires a bucket and region")
+	// ErrInvalidS3Encryption - Unknown server-side encryption type
+	ErrInvalidS3Encryption = errors.New("s3 server-side encryption must be one of AES256 or aws:kms")
+	// ErrInvalidEngagementEnd - The engagement end isn't RFC3339
+	ErrInvalidEngagementEnd = errors.New("engagement end must be an RFC3339 date (e.g. 2023-12-31T18:00:00Z)")
 )
 
 // GetServerConfigPath - File path to config.json
@@ -59,6 +96,9 @@ type LogConfig struct {
 type DaemonConfig struct {
 	Host string `json:"host"`
 	Port int    `json:"port"`
+
+	// WebSocketPort - Also accept operator connections tunneled over websockets on this port
+	WebSocketPort int `json:"websocket_port,omitempty"`
 }
 
 // JobConfig - Restart Jobs on Load
@@ -68,12 +108,15 @@ type JobConfig struct {
 	WG          []*WGJobConfig          `json:"wg,omitempty"`
 	DNS         []*DNSJobConfig         `json:"dns,omitempty"`
 	HTTP        []*HTTPJobConfig        `json:"http,omitempty"`
+	ICMP        []*ICMPJobConfig        `json:"icmp,omitempty"`
+	UDP         []*UDPJobConfig         `json:"udp,omitempty"`
 }
 
 type MultiplayerJobConfig struct {
-	Host  string `json:"host"`
-	Port  uint16 `json:"port"`
-	JobID string `json:"job_id"`
+	Host      string `json:"host"`
+	Port      uint16 `json:"port"`
+	JobID     string `json:"job_id"`
+	WebSocket bool   `json:"websocket,omitempty"`
 }
 
 // MTLSJobConfig - Per-type job configs
@@ -99,6 +142,8 @@ type DNSJobConfig struct {
 	Port       uint16   `json:"port"`
 	JobID      string   `json:"job_id"`
 	EnforceOTP bool     `json:"enforce_otp"`
+
+	TXTEncodings map[string]string `json:"txt_encodings,omitempty"`
 }
 
 // HTTPJobConfig - Persistent HTTP job config
@@ -116,6 +161,22 @@ type HTTPJobConfig struct {
 	LongPollTimeout int64  `json:"long_poll_timeout"`
 	LongPollJitter  int64  `json:"long_poll_jitter"`
 	RandomizeJARM   bool   `json:"randomize_jarm"`
+	C2Profile       string `json:"c2_profile"`
+	FrontDomain     string `json:"front_domain"`
+	HTTP2           bool   `json:"http2"`
+}
+
+// ICMPJobConfig - Persistent ICMP job config
+type ICMPJobConfig struct {
+	Host  string `json:"host"`
+	JobID string `json:"job_id"`
+}
+
+// UDPJobConfig - Persistent UDP job config
+type UDPJobConfig struct {
+	Host  string `json:"host"`
+	Port  uint16 `json:"port"`
+	JobID string `json:"job_id"`
 }
 
 // WatchTowerConfig - Watch Tower job config
@@ -125,14 +186,69 @@ type WatchTowerConfig struct {
 	XForceApiPassword string `json:"xforce_api_password"`
 }
 
+// CheckinAlertConfig - Alert when the source address of a beacon's check-ins changes
+type CheckinAlertConfig struct {
+	Sensitivity string `json:"sensitivity"`
+	ASNDatabase string `json:"asn_database"`
+	FlipCount   int    `json:"flip_count"`
+	FlipWindow  int64  `json:"flip_window"`
+}
+
+// S3Config - An S3 compatible object store such as AWS S3 or MinIO, objects
+// expire after the configured number of days (0 = never) using lifecycle rules
+type S3Config struct {
+	Endpoint        string `json:"endpoint"`
+	Region          string `json:"region"`
+	Bucket          string `json:"bucket"`
+	Prefix          string `json:"prefix"`
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"secret_access_key"`
+	Insecure        bool   `json:"insecure"`
+	PathStyle       bool   `json:"path_style"`
+
+	ServerSideEncryption string `json:"server_side_encryption"`
+	KMSKeyID             string `json:"kms_key_id"`
+
+	LootExpiration  int `json:"loot_expiration"`
+	BuildExpiration int `json:"build_expiration"`
+}
+
+// StorageConfig - Where loot and implant builds are stored, only the metadata
+// is kept in the database
+type StorageConfig struct {
+	Backend string    `json:"backend"`
+	S3      *S3Config `json:"s3,omitempty"`
+}
+
+// EngagementConfig - When the engagement is over, once the end has passed the server
+// refuses to task implants and kills every implant it can reach
+type EngagementConfig struct {
+	End string `json:"end"`
+}
+
+// EndTime - The end of the engagement, zero if there is none
+func (e *EngagementConfig) EndTime() (time.Time, error) {
+	if e == nil || e.End == "" {
+		return time.Time{}, nil
+	}
+	end, err := time.Parse(time.RFC3339, e.End)
+	if err != nil {
+		return time.Time{}, ErrInvalidEngagementEnd
+	}
+	return end, nil
+}
+
 // ServerConfig - Server config
 type ServerConfig struct {
-	DaemonMode   bool              `json:"daemon_mode"`
-	DaemonConfig *DaemonConfig     `json:"daemon"`
-	Logs         *LogConfig        `json:"logs"`
-	Jobs         *JobConfig        `json:"jobs,omitempty"`
-	Watchtower   *WatchTowerConfig `json:"watch_tower"`
-	GoProxy      string            `json:"go_proxy"`
+	DaemonMode    bool                `json:"daemon_mode"`
+	DaemonConfig  *DaemonConfig       `json:"daemon"`
+	Logs          *LogConfig          `json:"logs"`
+	Jobs          *JobConfig          `json:"jobs,omitempty"`
+	Watchtower    *WatchTowerConfig   `json:"watch_tower"`
+	GoProxy       string              `json:"go_proxy"`
+	CheckinAlerts *CheckinAlertConfig `json:"checkin_alerts"`
+	Storage       *StorageConfig      `json:"storage"`
+	Engagement    *EngagementConfig   `json:"engagement"`
 }
 
 // Save - Save config file to disk
@@ -208,6 +324,26 @@ func (c *ServerConfig) AddHTTPJob(config *HTTPJobConfig) error {
 	return c.Save()
 }
 
+// AddICMPJob - Add a persistent ICMP job
+func (c *ServerConfig) AddICMPJob(config *ICMPJobConfig) error {
+	if c.Jobs == nil {
+		c.Jobs = &JobConfig{}
+	}
+	config.JobID = getRandomID()
+	c.Jobs.ICMP = append(c.Jobs.ICMP, config)
+	return c.Save()
+}
+
+// AddUDPJob - Add a persistent UDP job
+func (c *ServerConfig) AddUDPJob(config *UDPJobConfig) error {
+	if c.Jobs == nil {
+		c.Jobs = &JobConfig{}
+	}
+	config.JobID = getRandomID()
+	c.Jobs.UDP = append(c.Jobs.UDP, config)
+	return c.Save()
+}
+
 // RemoveJob - Remove Job by ID
 func (c *ServerConfig) RemoveJob(jobID string) {
 	if c.Jobs == nil {
@@ -232,6 +368,18 @@ func (c *ServerConfig) RemoveJob(jobID string) {
 			return
 		}
 	}
+	for i, j := range c.Jobs.ICMP {
+		if j.JobID == jobID {
+			c.Jobs.ICMP = append(c.Jobs.ICMP[:i], c.Jobs.ICMP[i+1:]...)
+			return
+		}
+	}
+	for i, j := range c.Jobs.UDP {
+		if j.JobID == jobID {
+			c.Jobs.UDP = append(c.Jobs.UDP[:i], c.Jobs.UDP[i+1:]...)
+			return
+		}
+	}
 }
 
 // GetServerConfig - Get config value
@@ -268,6 +416,119 @@ func GetServerConfig() *ServerConfig {
 	return config
 }
 
+// CheckServerConfigErrors - Validate the server config on disk, unlike GetServerConfig()
+// this does not coerce values or write the config back to disk
+func CheckServerConfigErrors() error {
+	configPath := GetServerConfigPath()
+	if _, err := os.Stat(configPath); os.IsNotExist(err) {
+		return nil // Defaults will be used
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	config := getDefaultServerConfig()
+	err = json.Unmarshal(data, config)
+	if err != nil {
+		return err
+	}
+	return checkServerConfigValues(config)
+}
+
+func checkServerConfigValues(config *ServerConfig) error {
+	if config.Logs != nil && (config.Logs.Level < 0 || 6 < config.Logs.Level) {
+		return ErrInvalidLogLevel
+	}
+	if config.DaemonConfig != nil && (config.DaemonConfig.Port < 1 || 65535 < config.DaemonConfig.Port) {
+		return fmt.Errorf("daemon %w", ErrInvalidPort)
+	}
+	if config.CheckinAlerts != nil {
+		switch config.CheckinAlerts.Sensitivity {
+		case CheckinAlertsOff, CheckinAlertsLow, CheckinAlertsMedium, CheckinAlertsHigh:
+		default:
+			return ErrInvalidSensitivity
+		}
+	}
+	if config.Storage != nil {
+		switch config.Storage.Backend {
+		case StorageLocal:
+		case StorageS3:
+			if config.Storage.S3 == nil || config.Storage.S3.Bucket == "" || config.Storage.S3.Region == "" {
+				return ErrMissingS3Bucket
+			}
+			switch config.Storage.S3.ServerSideEncryption {
+			case "", "AES256", "aws:kms":
+			default:
+				return ErrInvalidS3Encryption
+			}
+		default:
+			return ErrInvalidStorageBackend
+		}
+	}
+	if _, err := config.Engagement.EndTime(); err != nil {
+		return err
+	}
+	if config.Jobs == nil {
+		return nil
+	}
+
+	jobIDs := map[string]bool{}
+	checkJob := func(name string, jobID string, ports ...uint16) error {
+		if jobIDs[jobID] {
+			return fmt.Errorf("%w (%s)", ErrDuplicateJobID, jobID)
+		}
+		jobIDs[jobID] = true
+		for _, port := range ports {
+			if port == 0 {
+				return fmt.Errorf("%s job %s %w", name, jobID, ErrInvalidPort)
+			}
+		}
+		return nil
+	}
+	for _, job := range config.Jobs.Multiplayer {
+		if err := checkJob("multiplayer", job.JobID, job.Port); err != nil {
+			return err
+		}
+	}
+	for _, job := range config.Jobs.MTLS {
+		if err := checkJob("mtls", job.JobID, job.Port); err != nil {
+			return err
+		}
+	}
+	for _, job := range config.Jobs.WG {
+		if err := checkJob("wg", job.JobID, job.Port, job.NPort, job.KeyPort); err != nil {
+			return err
+		}
+	}
+	for _, job := range config.Jobs.DNS {
+		if err := checkJob("dns", job.JobID, job.Port); err != nil {
+			return err
+		}
+		if len(job.Domains) == 0 {
+			return fmt.Errorf("%w (%s)", ErrMissingDomains, job.JobID)
+		}
+	}
+	for _, job := range config.Jobs.HTTP {
+		if err := checkJob("http", job.JobID, job.Port); err != nil {
+			return err
+		}
+		if job.Secure && !job.ACME && (len(job.Cert) == 0) != (len(job.Key) == 0) {
+			return fmt.Errorf("%w (%s)", ErrMissingCertOrKey, job.JobID)
+		}
+	}
+	for _, job := range config.Jobs.ICMP {
+		if err := checkJob("icmp", job.JobID); err != nil {
+			return err
+		}
+	}
+	for _, job := range config.Jobs.UDP {
+		if err := checkJob("udp", job.JobID, job.Port); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getDefaultServerConfig() *ServerConfig {
 	return &ServerConfig{
 		DaemonMode: false,
@@ -281,6 +542,15 @@ func getDefaultServerConfig() *ServerConfig {
 			GRPCStreamPayloads: false,
 		},
 		Jobs: &JobConfig{},
+		CheckinAlerts: &CheckinAlertConfig{
+			Sensitivity: CheckinAlertsMedium,
+			FlipCount:   3,
+			FlipWindow:  3600,
+		},
+		Storage: &StorageConfig{
+			Backend: StorageLocal,
+		},
+		Engagement: &EngagementConfig{},
 	}
 }
 
@@ -0,0 +1,92 @@
+package configs
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckServerConfig(t *testing.T) {
+	config := getDefaultServerConfig()
+	err := checkServerConfigValues(config)
+	if err != nil {
+		t.Fatalf("default config is invalid: %s", err)
+	}
+
+	config.Jobs.MTLS = []*MTLSJobConfig{
+		{Port: 8888, JobID: "a"},
+		{Port: 8889, JobID: "a"},
+	}
+	err = checkServerConfigValues(config)
+	if !errors.Is(err, ErrDuplicateJobID) {
+		t.Fatalf("expected %v, got %v", ErrDuplicateJobID, err)
+	}
+
+	config.Jobs.MTLS = []*MTLSJobConfig{{Port: 0, JobID: "a"}}
+	err = checkServerConfigValues(config)
+	if !errors.Is(err, ErrInvalidPort) {
+		t.Fatalf("expected %v, got %v", ErrInvalidPort, err)
+	}
+
+	config.Jobs.MTLS = nil
+	config.Jobs.HTTP = []*HTTPJobConfig{{Port: 443, Secure: true, Cert: []byte("cert"), JobID: "b"}}
+	err = checkServerConfigValues(config)
+	if !errors.Is(err, ErrMissingCertOrKey) {
+		t.Fatalf("expected %v, got %v", ErrMissingCertOrKey, err)
+	}
+
+	config.Jobs.HTTP = nil
+	config.CheckinAlerts.Sensitivity = "paranoid"
+	err = checkServerConfigValues(config)
+	if !errors.Is(err, ErrInvalidSensitivity) {
+		t.Fatalf("expected %v, got %v", ErrInvalidSensitivity, err)
+	}
+
+	config.CheckinAlerts.Sensitivity = CheckinAlertsMedium
+	config.Engagement.End = "2023-12-31"
+	err = checkServerConfigValues(config)
+	if !errors.Is(err, ErrInvalidEngagementEnd) {
+		t.Fatalf("expected %v, got %v", ErrInvalidEngagementEnd, err)
+	}
+	config.Engagement.End = "2023-12-31T18:00:00Z"
+	if err = checkServerConfigValues(config); err != nil {
+		t.Fatalf("valid engagement end is invalid: %s", err)
+	}
+}
+
+func TestCheckDatabaseConfig(t *testing.T) {
+	config := getDefaultDatabaseConfig()
+	err := checkDatabaseConfig(config)
+	if err != nil {
+		t.Fatalf("default config is invalid: %s", err)
+	}
+
+	config.Dialect = "oracle"
+	err = checkDatabaseConfig(config)
+	if !errors.Is(err, ErrInvalidDialect) {
+		t.Fatalf("expected %v, got %v", ErrInvalidDialect, err)
+	}
+
+	config.Dialect = Postgres
+	err = checkDatabaseConfig(config)
+	if !errors.Is(err, ErrMissingDatabaseHost) {
+		t.Fatalf("expected %v, got %v", ErrMissingDatabaseHost, err)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/server/certs"
@@ -36,6 +37,7 @@ import (
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/secondfactor"
 	"github.com/bishopfox/sliver/server/transport"
 
 	"github.com/desertbit/grumble"
@@ -81,6 +83,7 @@ type ClientConfig struct {
 	CACertificate string `json:"ca_certificate"`
 	PrivateKey    string `json:"private_key"`
 	Certificate   string `json:"certificate"`
+	WebSocketURL  string `json:"websocket_url,omitempty"`
 }
 
 func newOperatorCmd(ctx *grumble.Context) {
@@ -88,17 +91,28 @@ func newOperatorCmd(ctx *grumble.Context) {
 	lhost := ctx.Flags.String("lhost")
 	lport := uint16(ctx.Flags.Int("lport"))
 	save := ctx.Flags.String("save")
+	factorType := ctx.Flags.String("second-factor")
+	fido2Credential := ctx.Flags.String("fido2-credential")
+	websocketURL := ctx.Flags.String("websocket-url")
 
 	if save == "" {
 		save, _ = os.Getwd()
 	}
 
 	fmt.Printf(Info + "Generating new client certificate, please wait ... \n")
-	configJSON, err := NewOperatorConfig(name, lhost, lport)
+	configJSON, err := NewOperatorConfig(name, lhost, lport, websocketURL)
 	if err != nil {
 		fmt.Printf(Warn+"%s\n", err)
 		return
 	}
+	msg, err := EnrollOperatorSecondFactor(name, factorType, fido2Credential)
+	if err != nil {
+		fmt.Printf(Warn+"Failed to enroll second factor: %s\n", err)
+		return
+	}
+	if msg != "" {
+		fmt.Printf(Info+"%s\n", msg)
+	}
 
 	saveTo, _ := filepath.Abs(save)
 	fi, err := os.Stat(saveTo)
@@ -118,8 +132,9 @@ func newOperatorCmd(ctx *grumble.Context) {
 	fmt.Printf(Info+"Saved new client config to: %s \n", saveTo)
 }
 
-// NewOperatorConfig - Generate a new player/client/operator configuration
-func NewOperatorConfig(operatorName string, lhost string, lport uint16) ([]byte, error) {
+// NewOperatorConfig - Generate a new player/client/operator configuration, the client
+// tunnels its connection over a websocket to the websocketURL if it's set
+func NewOperatorConfig(operatorName string, lhost string, lport uint16, websocketURL string) ([]byte, error) {
 	if !namePattern.MatchString(operatorName) {
 		return nil, errors.New("Invalid operator name (alphanumerics only)")
 	}
@@ -129,6 +144,9 @@ func NewOperatorConfig(operatorName string, lhost string, lport uint16) ([]byte,
 	if lhost == "" {
 		return nil, errors.New("Invalid lhost")
 	}
+	if websocketURL != "" && !strings.HasPrefix(websocketURL, "ws://") && !strings.HasPrefix(websocketURL, "wss://") {
+		return nil, errors.New("Invalid websocket url (must start with ws:// or wss://)")
+	}
 
 	rawToken := models.GenerateOperatorToken()
 	digest := sha256.Sum256([]byte(rawToken))
@@ -154,10 +172,36 @@ func NewOperatorConfig(operatorName string, lhost string, lport uint16) ([]byte,
 		CACertificate: string(caCertPEM),
 		PrivateKey:    string(privateKey),
 		Certificate:   string(publicKey),
+		WebSocketURL:  websocketURL,
 	}
 	return json.Marshal(config)
 }
 
+// EnrollOperatorSecondFactor - Require an operator to verify a second factor
+// when connecting, returns a message for the admin to pass on to the operator
+func EnrollOperatorSecondFactor(operatorName string, factorType string, fido2Credential string) (string, error) {
+	switch factorType {
+	case "":
+		return "", nil
+	case secondfactor.TOTP:
+		key, err := secondfactor.EnrollTOTP(operatorName)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Give %s their TOTP secret out of band: %s\n    %s", operatorName, key.Secret(), key.URL()), nil
+	case secondfactor.FIDO2:
+		if fido2Credential == "" {
+			return "", errors.New("FIDO2 requires a credential file from 'sliver-client fido2-enroll'")
+		}
+		credential, err := os.ReadFile(fido2Credential)
+		if err != nil {
+			return "", err
+		}
+		return "", secondfactor.EnrollFIDO2(operatorName, credential)
+	}
+	return "", fmt.Errorf("Invalid second factor '%s' (totp or fido2)", factorType)
+}
+
 func kickOperatorCmd(ctx *grumble.Context) {
 	operator := ctx.Flags.String("name")
 	fmt.Printf(Info+"Removing auth token(s) for %s, please wait ... \n", operator)
@@ -168,6 +212,11 @@ func kickOperatorCmd(ctx *grumble.Context) {
 		return
 	}
 	transport.ClearTokenCache()
+	err = secondfactor.Remove(operator)
+	if err != nil {
+		fmt.Printf(Warn+"Failed to remove the operator's second factor: %v \n", err)
+		return
+	}
 	fmt.Printf(Info+"Removing client certificate(s) for %s, please wait ... \n", operator)
 	err = certs.OperatorClientRemoveCertificate(operator)
 	if err != nil {
@@ -182,7 +231,7 @@ func StartPersistentJobs(cfg *configs.ServerConfig) error {
 		return nil
 	}
 	for _, j := range cfg.Jobs.Multiplayer {
-		jobStartClientListener(j.Host, j.Port)
+		jobStartClientListener(j.Host, j.Port, j.WebSocket)
 	}
 	return nil
 }
@@ -191,14 +240,16 @@ func startMultiplayerModeCmd(ctx *grumble.Context) {
 	lhost := ctx.Flags.String("lhost")
 	lport := uint16(ctx.Flags.Int("lport"))
 	persistent := ctx.Flags.Bool("persistent")
-	_, err := jobStartClientListener(lhost, lport)
+	websocket := ctx.Flags.Bool("websocket")
+	_, err := jobStartClientListener(lhost, lport, websocket)
 	if err == nil {
 		fmt.Printf(Info + "Multiplayer mode enabled!\n")
 		if persistent {
 			serverConfig := configs.GetServerConfig()
 			serverConfig.AddMultiplayerJob(&configs.MultiplayerJobConfig{
-				Host: lhost,
-				Port: lport,
+				Host:      lhost,
+				Port:      lport,
+				WebSocket: websocket,
 			})
 			serverConfig.Save()
 		}
@@ -207,8 +258,14 @@ func startMultiplayerModeCmd(ctx *grumble.Context) {
 	}
 }
 
-func jobStartClientListener(host string, port uint16) (int, error) {
-	_, ln, err := transport.StartClientListener(host, port)
+func jobStartClientListener(host string, port uint16, websocket bool) (int, error) {
+	startListener := transport.StartClientListener
+	description := "client listener"
+	if websocket {
+		startListener = transport.StartWebSocketClientListener
+		description = "websocket client listener"
+	}
+	_, ln, err := startListener(host, port)
 	if err != nil {
 		return -1, err // If we fail to bind don't setup the Job
 	}
@@ -216,7 +273,7 @@ func jobStartClientListener(host string, port uint16) (int, error) {
 	job := &core.Job{
 		ID:          core.NextJobID(),
 		Name:        "grpc",
-		Description: "client listener",
+		Description: description,
 		Protocol:    "tcp",
 		Port:        port,
 		JobCtrl:     make(chan bool),
@@ -58,7 +58,8 @@ func Start() {
 	if err := configs.CheckHTTPC2ConfigErrors(); err != nil {
 		fmt.Printf(Warn+"Error in HTTP C2 config: %s\n", err)
 	}
-	clientconsole.Start(localRPC, command.BindCommands, serverOnlyCmds, true)
+	server := &clientconsole.Server{Name: "server", Rpc: localRPC}
+	clientconsole.Start(server, command.BindCommands, serverOnlyCmds, true)
 }
 
 // ServerOnlyCmds - Server only commands
@@ -74,6 +75,7 @@ func serverOnlyCmds(console *clientconsole.SliverConsoleClient) {
 			f.String("L", "lhost", "", "interface to bind server to")
 			f.Int("l", "lport", 31337, "tcp listen port")
 			f.Bool("p", "persistent", false, "make persistent across restarts")
+			f.Bool("w", "websocket", false, "accept connections tunneled over websockets")
 		},
 		Run: func(ctx *grumble.Context) error {
 			fmt.Println()
@@ -93,6 +95,9 @@ func serverOnlyCmds(console *clientconsole.SliverConsoleClient) {
 			f.Int("p", "lport", 31337, "listen port")
 			f.String("s", "save", "", "directory/file to the binary to")
 			f.String("n", "name", "", "operator name")
+			f.String("", "second-factor", "", "require a second factor when connecting (totp or fido2)")
+			f.String("", "fido2-credential", "", "fido2 credential file from 'sliver-client fido2-enroll'")
+			f.String("w", "websocket-url", "", "tunnel the connection over a websocket to this url (ws:// or wss://)")
 		},
 		Run: func(ctx *grumble.Context) error {
 			fmt.Println()
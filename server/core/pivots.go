@@ -19,6 +19,8 @@ package core
 */
 
 import (
+	"net/url"
+	"sort"
 	"sync"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
@@ -95,6 +97,7 @@ type PivotGraphEntry struct {
 	PeerID    int64
 	SessionID string
 	Name      string
+	Transport string
 
 	// PeerID -> Child
 	Children map[int64]*PivotGraphEntry
@@ -106,11 +109,15 @@ func (e *PivotGraphEntry) ToProtobuf() *clientpb.PivotGraphEntry {
 	for _, child := range e.Children {
 		children = append(children, child.ToProtobuf())
 	}
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
 	return &clientpb.PivotGraphEntry{
-		PeerID:   e.PeerID,
-		Session:  Sessions.Get(e.SessionID).ToProtobuf(),
-		Name:     e.Name,
-		Children: children,
+		PeerID:    e.PeerID,
+		Session:   Sessions.Get(e.SessionID).ToProtobuf(),
+		Name:      e.Name,
+		Children:  children,
+		Transport: e.Transport,
 	}
 }
 
@@ -173,6 +180,7 @@ func PivotGraph() []*PivotGraphEntry {
 			PeerID:    session.PeerID,
 			SessionID: session.ID,
 			Name:      session.Name,
+			Transport: session.Connection.Transport,
 			Children:  make(map[int64]*PivotGraphEntry),
 		})
 	}
@@ -207,6 +215,7 @@ func insertImmediateChildren(entry *PivotGraphEntry, depth int) {
 				PeerID:    pivot.OriginID,
 				SessionID: session.ID,
 				Name:      session.Name,
+				Transport: pivotTransport(session),
 				Children:  make(map[int64]*PivotGraphEntry),
 			}
 			coreLog.Debugf("[graph] entry: %v, found child: %v", entry.Name, child.Name)
@@ -220,3 +229,13 @@ func insertImmediateChildren(entry *PivotGraphEntry, depth int) {
 		insertImmediateChildren(child, depth+1)
 	}
 }
+
+// pivotTransport - The type of pivot an implant connects through, e.g. tcppivot or
+// namedpipe, taken from the c2 url the implant registered with
+func pivotTransport(session *Session) string {
+	uri, err := url.Parse(session.ActiveC2)
+	if err != nil || uri.Scheme == "" {
+		return PivotTransportName
+	}
+	return uri.Scheme
+}
@@ -74,6 +74,9 @@ type Session struct {
 	PeerID            int64
 	Locale            string
 	FirstContact      int64
+	DNSDiagnostics    *sliverpb.DNSDiagnostics
+	Impersonating     string
+	Snapshot          *sliverpb.EnvironmentSnapshot
 }
 
 // LastCheckin - Get the last time a session message was received
@@ -140,6 +143,9 @@ func (s *Session) ToProtobuf() *clientpb.Session {
 		PeerID:            s.PeerID,
 		Locale:            s.Locale,
 		FirstContact:      s.FirstContact,
+		DNSDiagnostics:    s.DNSDiagnostics,
+		Impersonating:     s.Impersonating,
+		Snapshot:          s.Snapshot,
 	}
 }
 
@@ -161,9 +167,10 @@ func (s *Session) Request(msgType uint32, timeout time.Duration, data []byte) ([
 	}
 	select {
 	case s.Connection.Send <- &sliverpb.Envelope{
-		ID:   reqID,
-		Type: msgType,
-		Data: data,
+		ID:      reqID,
+		Type:    msgType,
+		Data:    data,
+		Timeout: int64(timeout), // The implant gives up when we do
 	}:
 	case <-time.After(timeout):
 		return nil, ErrImplantTimeout
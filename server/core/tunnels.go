@@ -45,6 +45,10 @@ const (
 	// delayBeforeClose - delay before closing the tunnel.
 	// I assume 10 seconds may be an overkill for a good connection, but it looks good enough for less stable one.
 	delayBeforeClose = 10 * time.Second
+
+	// reverseTunnelBuffer - number of messages from the implant that are buffered until a
+	// client binds to a reverse tunnel
+	reverseTunnelBuffer = 256
 )
 
 // Tunnel  - Essentially just a mapping between a specific client and sliver
@@ -121,6 +125,20 @@ func (t *tunnels) Create(sessionID string) *Tunnel {
 	return tunnel
 }
 
+// CreateReverse - Register a tunnel the implant opened (i.e. a reverse port forward to a
+// client), the data from the implant is buffered until a client binds to the tunnel
+func (t *tunnels) CreateReverse(tunnelID uint64, sessionID string) *Tunnel {
+	tunnel := NewTunnel(tunnelID, sessionID)
+	tunnel.ToImplant = make(chan []byte, 1) // Close must not block if no client ever binds
+	tunnel.FromImplant = make(chan *sliverpb.TunnelData, reverseTunnelBuffer)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.tunnels[tunnel.ID] = tunnel
+
+	return tunnel
+}
+
 // ScheduleClose - schedules a close for tunnel, must be called as routine.
 // will close it once there is no data for at least delayBeforeClose delay since last message
 // This is _necessary_ since we processing messages asynchronously
@@ -50,6 +50,7 @@ const (
 	TOTPPeriod               = uint(30)
 	TOTPSecretKey            = "server.totp"
 	ServerECCKeyPairKey      = "server.ecc"
+	ImportedServerKeysKey    = "server.imported"
 	serverMinisignPrivateKey = "server.minisign"
 )
 
@@ -250,6 +251,26 @@ func ECCServerKeyPair() *ECCKeyPair {
 
 }
 
+// ECCServerKeyPairFor - Get the server key pair an implant was generated with, this
+// is the server's own key pair unless the implant's beacons were imported from
+// another server
+func ECCServerKeyPairFor(serverPublicKey string) *ECCKeyPair {
+	keyPair := ECCServerKeyPair()
+	if serverPublicKey == "" || keyPair.PublicBase64() == serverPublicKey {
+		return keyPair
+	}
+	imported, err := ImportedServerKeys()
+	if err != nil {
+		return keyPair
+	}
+	for _, importedKeyPair := range imported.ECCKeyPairs {
+		if importedKeyPair.PublicBase64() == serverPublicKey {
+			return importedKeyPair
+		}
+	}
+	return keyPair
+}
+
 func generateServerECCKeyPair() (*ECCKeyPair, error) {
 	keyPair, err := RandomECCKeyPair()
 	if err != nil {
@@ -275,17 +296,88 @@ func TOTPServerSecret() (string, error) {
 	return secret, err
 }
 
-// ValidateTOTP - Validate a TOTP code
+// ValidateTOTP - Validate a TOTP code, codes of servers whose beacons were imported
+// are valid too
 func ValidateTOTP(code string) (bool, error) {
 	secret, err := TOTPServerSecret()
 	if err != nil {
 		return false, err
 	}
-	valid, err := totp.ValidateCustom(code, secret, time.Now().UTC(), TOTPOptions())
+	secrets := []string{secret}
+	if imported, err := ImportedServerKeys(); err == nil {
+		secrets = append(secrets, imported.TOTPSecrets...)
+	}
+	for _, secret := range secrets {
+		valid, err := totp.ValidateCustom(code, secret, time.Now().UTC(), TOTPOptions())
+		if err != nil {
+			return false, err
+		}
+		if valid {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ServerKeys - Keys of other servers, implants they generated can connect to this
+// server once their beacons have been imported
+type ServerKeys struct {
+	ECCKeyPairs []*ECCKeyPair `json:"ecc_key_pairs"`
+	TOTPSecrets []string      `json:"totp_secrets"`
+}
+
+// ImportServerKeys - Accept implants generated by other servers, keys that are
+// already known are skipped
+func ImportServerKeys(keys *ServerKeys) error {
+	imported, err := ImportedServerKeys()
 	if err != nil {
-		return false, err
+		return err
+	}
+	serverPublicKey := ECCServerKeyPair().PublicBase64()
+	for _, keyPair := range keys.ECCKeyPairs {
+		known := keyPair.PublicBase64() == serverPublicKey
+		for _, importedKeyPair := range imported.ECCKeyPairs {
+			known = known || importedKeyPair.PublicBase64() == keyPair.PublicBase64()
+		}
+		if !known {
+			imported.ECCKeyPairs = append(imported.ECCKeyPairs, keyPair)
+		}
+	}
+	serverSecret, err := TOTPServerSecret()
+	if err != nil {
+		return err
+	}
+	for _, secret := range keys.TOTPSecrets {
+		known := secret == serverSecret
+		for _, importedSecret := range imported.TOTPSecrets {
+			known = known || importedSecret == secret
+		}
+		if !known {
+			imported.TOTPSecrets = append(imported.TOTPSecrets, secret)
+		}
+	}
+	data, err := json.Marshal(imported)
+	if err != nil {
+		return err
+	}
+	return db.SetKeyValue(ImportedServerKeysKey, string(data))
+}
+
+// ImportedServerKeys - Get the keys imported from other servers
+func ImportedServerKeys() (*ServerKeys, error) {
+	imported := &ServerKeys{}
+	data, err := db.GetKeyValue(ImportedServerKeysKey)
+	if err == db.ErrRecordNotFound {
+		return imported, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal([]byte(data), imported)
+	if err != nil {
+		return nil, err
 	}
-	return valid, nil
+	return imported, nil
 }
 
 func totpGenerateSecret() (string, error) {
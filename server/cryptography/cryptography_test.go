@@ -25,9 +25,12 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	implantCrypto "github.com/bishopfox/sliver/implant/sliver/cryptography"
 	"github.com/bishopfox/sliver/server/cryptography/minisign"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
 )
 
 var (
@@ -312,3 +315,64 @@ func TestImplantMinisign(t *testing.T) {
 	}
 
 }
+
+func TestImportServerKeys(t *testing.T) {
+	keyPair, err := RandomECCKeyPair()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otpSecret, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      "foo",
+		AccountName: "bar",
+		Digits:      TOTPDigits,
+		Algorithm:   otp.AlgorithmSHA256,
+		Period:      TOTPPeriod,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, _ := totp.GenerateCodeCustom(otpSecret.Secret(), time.Now().UTC(), TOTPOptions())
+	if valid, _ := ValidateTOTP(code); valid {
+		t.Fatal("totp code of another server is valid before importing its keys")
+	}
+	if ECCServerKeyPairFor(keyPair.PublicBase64()).PublicBase64() != ECCServerKeyPair().PublicBase64() {
+		t.Fatal("expected the server's key pair for an unknown public key")
+	}
+
+	keys := &ServerKeys{
+		ECCKeyPairs: []*ECCKeyPair{keyPair, ECCServerKeyPair()},
+		TOTPSecrets: []string{otpSecret.Secret()},
+	}
+	for i := 0; i < 2; i++ {
+		err = ImportServerKeys(keys)
+		if err != nil {
+			t.Fatalf("failed to import keys: %s", err)
+		}
+	}
+	imported, err := ImportedServerKeys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	count := 0
+	for _, importedKeyPair := range imported.ECCKeyPairs {
+		if importedKeyPair.PublicBase64() == ECCServerKeyPair().PublicBase64() {
+			t.Fatal("the server's own key pair was imported")
+		}
+		if importedKeyPair.PublicBase64() == keyPair.PublicBase64() {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Fatalf("expected the key pair to be imported once, got %d", count)
+	}
+
+	if !bytes.Equal(ECCServerKeyPairFor(keyPair.PublicBase64()).Private[:], keyPair.Private[:]) {
+		t.Fatal("expected the imported key pair for its public key")
+	}
+	if !bytes.Equal(ECCServerKeyPairFor("").Private[:], ECCServerKeyPair().Private[:]) {
+		t.Fatal("expected the server's key pair without a public key")
+	}
+	if valid, err := ValidateTOTP(code); !valid || err != nil {
+		t.Fatalf("totp code of an imported server is invalid: %v", err)
+	}
+}
@@ -59,6 +59,17 @@ func Start(host string, port uint16) {
 		daemonLog.Errorf("Error starting client listener %s", err)
 		os.Exit(1)
 	}
+	if serverConfig.DaemonConfig.WebSocketPort != 0 {
+		websocketPort := uint16(serverConfig.DaemonConfig.WebSocketPort)
+		daemonLog.Infof("Starting websocket listener %s:%d ...", host, websocketPort)
+		_, wsLn, err := transport.StartWebSocketClientListener(host, websocketPort)
+		if err != nil {
+			fmt.Printf("[!] Failed to start websocket listener %s", err)
+			daemonLog.Errorf("Error starting websocket client listener %s", err)
+			os.Exit(1)
+		}
+		defer wsLn.Close()
+	}
 
 	done := make(chan bool)
 	signals := make(chan os.Signal, 1)
@@ -25,10 +25,14 @@ package db
 
 import (
 	"encoding/hex"
+	"errors"
 	"time"
 
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
 )
 
@@ -268,7 +272,7 @@ func ListHosts() ([]*models.Host, error) {
 	hosts := []*models.Host{}
 	err := Session().Where(
 		&models.Host{},
-	).Preload("IOCs").Preload("ExtensionData").Find(&hosts).Error
+	).Preload("IOCs").Preload("ExtensionData").Preload("LocalGroupMembers").Preload("Services").Find(&hosts).Error
 	return hosts, err
 }
 
@@ -294,13 +298,218 @@ func HostByHostUUID(id string) (*models.Host, error) {
 	host := models.Host{}
 	err := Session().Where(
 		&models.Host{HostUUID: hostID},
-	).Preload("IOCs").Preload("ExtensionData").First(&host).Error
+	).Preload("IOCs").Preload("ExtensionData").Preload("LocalGroupMembers").Preload("Services").First(&host).Error
 	if err != nil {
 		return nil, err
 	}
 	return &host, nil
 }
 
+// SaveHostLocalGroups - Replace the members previously surveyed from the host for
+// each of the groups, groups that could not be surveyed are left as they were
+func SaveHostLocalGroups(hostUUID string, groups []*sliverpb.LocalGroup) error {
+	host, err := HostByHostUUID(hostUUID)
+	if err != nil {
+		return err
+	}
+	return Session().Transaction(func(tx *gorm.DB) error {
+		for _, group := range groups {
+			if group.Err != "" {
+				continue
+			}
+			// Map conditions so an empty field can't widen the delete
+			err := tx.Where(map[string]interface{}{
+				"host_id":     host.ID,
+				"remote_host": group.Host,
+				"group":       group.Name,
+			}).Delete(&models.LocalGroupMember{}).Error
+			if err != nil {
+				return err
+			}
+			for _, member := range group.Members {
+				err = tx.Create(&models.LocalGroupMember{
+					HostID:     host.ID,
+					RemoteHost: group.Host,
+					Group:      group.Name,
+					GroupSID:   group.SID,
+					Member:     member.Name,
+					MemberSID:  member.SID,
+					MemberType: member.Type,
+				}).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// SaveHostSurvey - Replace the host's survey with a new one
+func SaveHostSurvey(hostUUID string, survey *sliverpb.Survey) error {
+	host, err := HostByHostUUID(hostUUID)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(survey)
+	if err != nil {
+		return err
+	}
+	return Session().Transaction(func(tx *gorm.DB) error {
+		err := tx.Where(map[string]interface{}{"host_id": host.ID}).Delete(&models.HostSurvey{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Create(&models.HostSurvey{HostID: host.ID, Survey: data}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(&models.Host{}).Where(&models.Host{ID: host.ID}).Update("SurveyedAt", time.Now()).Error
+	})
+}
+
+// HostSurveyByHostUUID - The last survey of the host
+func HostSurveyByHostUUID(hostUUID string) (*sliverpb.Survey, error) {
+	host, err := HostByHostUUID(hostUUID)
+	if err != nil {
+		return nil, err
+	}
+	hostSurvey := &models.HostSurvey{}
+	err = Session().Where(&models.HostSurvey{HostID: host.ID}).First(hostSurvey).Error
+	if err != nil {
+		return nil, err
+	}
+	survey := &sliverpb.Survey{}
+	err = proto.Unmarshal(hostSurvey.Survey, survey)
+	return survey, err
+}
+
+// SaveDiscoveredHost - Add a host found by parsing tool output to the hosts we know
+// about, or merge it into the host with the same hostname (or address if it has no
+// hostname). A host without either is the host identified by hostUUID.
+func SaveDiscoveredHost(hostUUID string, discovered *clientpb.Host) error {
+	var host *models.Host
+	var err error
+	if discovered.Hostname == "" && discovered.Address == "" {
+		host, err = HostByHostUUID(hostUUID)
+	} else {
+		host, err = discoveredHost(discovered.Hostname, discovered.Address)
+	}
+	if err != nil {
+		return err
+	}
+	return Session().Transaction(func(tx *gorm.DB) error {
+		if host.Address == "" && discovered.Address != "" && discovered.Hostname != "" {
+			err := tx.Model(host).Update("address", discovered.Address).Error
+			if err != nil {
+				return err
+			}
+		}
+		for _, service := range discovered.Services {
+			existing := &models.HostService{}
+			err := tx.Where(map[string]interface{}{
+				"host_id":  host.ID,
+				"port":     service.Port,
+				"protocol": service.Protocol,
+			}).First(existing).Error
+			if errors.Is(err, ErrRecordNotFound) {
+				err = tx.Create(&models.HostService{
+					HostID:   host.ID,
+					Port:     service.Port,
+					Protocol: service.Protocol,
+					Name:     service.Name,
+					Version:  service.Version,
+				}).Error
+			} else if err == nil && (service.Name != "" || service.Version != "") {
+				err = tx.Model(existing).Updates(&models.HostService{
+					Name:    service.Name,
+					Version: service.Version,
+				}).Error
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func discoveredHost(hostname string, address string) (*models.Host, error) {
+	host := &models.Host{}
+	query := &models.Host{Hostname: hostname}
+	if hostname == "" {
+		query = &models.Host{Address: address}
+	}
+	err := Session().Where(query).First(host).Error
+	if errors.Is(err, ErrRecordNotFound) {
+		host = &models.Host{
+			HostUUID: uuid.Must(uuid.NewV4()),
+			Hostname: hostname,
+			Address:  address,
+		}
+		err = Session().Create(host).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+	return host, nil
+}
+
+// ListPersistence - Everything that's been persisted, oldest first
+func ListPersistence() ([]*models.Persistence, error) {
+	persistence := []*models.Persistence{}
+	err := Session().Order("created_at").Find(&persistence).Error
+	return persistence, err
+}
+
+// PersistenceByID - Select persistence by ID
+func PersistenceByID(id string) (*models.Persistence, error) {
+	persistenceID := uuid.FromStringOrNil(id)
+	if persistenceID == uuid.Nil {
+		return nil, ErrRecordNotFound
+	}
+	persistence := &models.Persistence{}
+	err := Session().Where(&models.Persistence{ID: persistenceID}).First(persistence).Error
+	if err != nil {
+		return nil, err
+	}
+	return persistence, nil
+}
+
+// PersistenceByTarget - Select persistence of a technique on a host by its target
+func PersistenceByTarget(hostUUID string, technique string, target string) (*models.Persistence, error) {
+	persistence := &models.Persistence{}
+	err := Session().Where(&models.Persistence{
+		HostUUID:  uuid.FromStringOrNil(hostUUID),
+		Technique: technique,
+		Target:    target,
+	}).First(persistence).Error
+	if err != nil {
+		return nil, err
+	}
+	return persistence, nil
+}
+
+// ListShellcodeArtifacts - Every saved shellcode artifact, oldest first
+func ListShellcodeArtifacts() ([]*models.ShellcodeArtifact, error) {
+	artifacts := []*models.ShellcodeArtifact{}
+	err := Session().Order("created_at").Find(&artifacts).Error
+	return artifacts, err
+}
+
+// ShellcodeArtifactByName - Select a shellcode artifact by name
+func ShellcodeArtifactByName(name string) (*models.ShellcodeArtifact, error) {
+	if len(name) < 1 {
+		return nil, ErrRecordNotFound
+	}
+	artifact := &models.ShellcodeArtifact{}
+	err := Session().Where(&models.ShellcodeArtifact{Name: name}).First(artifact).Error
+	if err != nil {
+		return nil, err
+	}
+	return artifact, nil
+}
+
 // IOCByID - Select an IOC by ID
 func IOCByID(id string) (*models.IOC, error) {
 	if len(id) < 1 {
@@ -347,7 +556,7 @@ func BeaconTasksByBeaconID(beaconID string) ([]*models.BeaconTask, error) {
 	beaconTasks := []*models.BeaconTask{}
 	err := Session().Select([]string{
 		"ID", "EnvelopeID", "BeaconID", "CreatedAt", "State", "SentAt", "CompletedAt",
-		"Description",
+		"Description", "Priority",
 	}).Where(&models.BeaconTask{BeaconID: id}).Find(&beaconTasks).Error
 	return beaconTasks, err
 }
@@ -409,7 +618,27 @@ func PendingBeaconTasksByBeaconID(id string) ([]*models.BeaconTask, error) {
 			BeaconID: beaconID,
 			State:    models.PENDING,
 		},
-	).Order("created_at").Find(&tasks).Error
+	).Order("priority desc, created_at").Find(&tasks).Error
+	return tasks, err
+}
+
+// CancelPendingBeaconTasksByBeaconID - Select sent BeaconTasks the beacon still
+// needs to be told to cancel
+func CancelPendingBeaconTasksByBeaconID(id string) ([]*models.BeaconTask, error) {
+	if len(id) < 1 {
+		return nil, ErrRecordNotFound
+	}
+	beaconID := uuid.FromStringOrNil(id)
+	if beaconID == uuid.Nil {
+		return nil, ErrRecordNotFound
+	}
+	tasks := []*models.BeaconTask{}
+	err := Session().Where(
+		&models.BeaconTask{
+			BeaconID:      beaconID,
+			CancelPending: true,
+		},
+	).Find(&tasks).Error
 	return tasks, err
 }
 
@@ -431,7 +660,38 @@ func UpdateBeaconCheckinByID(id string, next int64) error {
 	return err
 }
 
-// BeaconTasksByEnvelopeID - Select a (sent) BeaconTask by its envelope ID
+// UpdateBeaconPresenceByID - Update the presence the beacon last reported
+func UpdateBeaconPresenceByID(id string, presence *sliverpb.Presence) error {
+	beaconID := uuid.FromStringOrNil(id)
+	if beaconID == uuid.Nil {
+		return ErrRecordNotFound
+	}
+	data, err := proto.Marshal(presence)
+	if err != nil {
+		return err
+	}
+	err = Session().Where(&models.Beacon{
+		ID: beaconID,
+	}).Updates(models.Beacon{
+		Presence: data,
+	}).Error
+	return err
+}
+
+// UpdateBeaconImpersonatingByID - Update the owner of the token the beacon is impersonating
+func UpdateBeaconImpersonatingByID(id string, owner string) error {
+	beaconID := uuid.FromStringOrNil(id)
+	if beaconID == uuid.Nil {
+		return ErrRecordNotFound
+	}
+	// Update() so that reverting to self clears the column
+	err := Session().Model(&models.Beacon{}).Where(&models.Beacon{
+		ID: beaconID,
+	}).Update("impersonating", owner).Error
+	return err
+}
+
+// BeaconTasksByEnvelopeID - Select a (sent or timed out) BeaconTask by its envelope ID
 func BeaconTaskByEnvelopeID(beaconID string, envelopeID int64) (*models.BeaconTask, error) {
 	if len(beaconID) < 1 {
 		return nil, ErrRecordNotFound
@@ -445,12 +705,20 @@ func BeaconTaskByEnvelopeID(beaconID string, envelopeID int64) (*models.BeaconTa
 		&models.BeaconTask{
 			BeaconID:   beaconUUID,
 			EnvelopeID: envelopeID,
-			State:      models.SENT,
 		},
-	).First(task).Error
+	).Where("state IN ?", []string{models.SENT, models.TIMEOUT, models.CANCELED}).First(task).Error
 	return task, err
 }
 
+// SentBeaconTasksWithTimeout - Select sent BeaconTasks that have a timeout
+func SentBeaconTasksWithTimeout() ([]*models.BeaconTask, error) {
+	tasks := []*models.BeaconTask{}
+	err := Session().Where(
+		&models.BeaconTask{State: models.SENT},
+	).Where("timeout > 0").Find(&tasks).Error
+	return tasks, err
+}
+
 // CountTasksByBeaconID - Select a (sent) BeaconTask by its envelope ID
 func CountTasksByBeaconID(beaconID uuid.UUID) (int64, int64, error) {
 	if beaconID == uuid.Nil {
@@ -475,6 +743,25 @@ func CountTasksByBeaconID(beaconID uuid.UUID) (int64, int64, error) {
 	return allTasks, completedTasks, err
 }
 
+// BeaconAddressLatest - Get the most recent source address of a beacon
+func BeaconAddressLatest(beaconID uuid.UUID) (*models.BeaconAddress, error) {
+	address := &models.BeaconAddress{}
+	err := Session().Where(&models.BeaconAddress{
+		BeaconID: beaconID,
+	}).Order("created_at desc").First(address).Error
+	return address, err
+}
+
+// BeaconAddressChangesSince - Count the source address changes of a beacon since a given
+// time, not counting the record of the change that's being checked
+func BeaconAddressChangesSince(beaconID uuid.UUID, since time.Time, current uuid.UUID) (int64, error) {
+	var count int64
+	err := Session().Model(&models.BeaconAddress{}).Where(&models.BeaconAddress{
+		BeaconID: beaconID,
+	}).Where("created_at > ? AND id != ?", since, current).Count(&count).Error
+	return count, err
+}
+
 // OperatorByToken - Select an operator by token value
 func OperatorByToken(value string) (*models.Operator, error) {
 	if len(value) < 1 {
@@ -487,6 +774,15 @@ func OperatorByToken(value string) (*models.Operator, error) {
 	return operator, err
 }
 
+// OperatorSecondFactorByName - Select an operator's second factor enrollment
+func OperatorSecondFactorByName(name string) (*models.OperatorSecondFactor, error) {
+	secondFactor := &models.OperatorSecondFactor{}
+	err := Session().Where(&models.OperatorSecondFactor{
+		Name: name,
+	}).First(secondFactor).Error
+	return secondFactor, err
+}
+
 // OperatorAll - Select all operators from the database
 func OperatorAll() ([]*models.Operator, error) {
 	operators := []*models.Operator{}
@@ -494,6 +790,41 @@ func OperatorAll() ([]*models.Operator, error) {
 	return operators, err
 }
 
+// ListenerSettingsByPersistentID - Select the saved settings of a persistent listener
+func ListenerSettingsByPersistentID(persistentID string) (*models.ListenerSettings, error) {
+	settings := &models.ListenerSettings{}
+	err := Session().Where(&models.ListenerSettings{
+		PersistentID: persistentID,
+	}).First(settings).Error
+	return settings, err
+}
+
+// SaveListenerSettings - Create or replace the saved settings of a persistent listener
+func SaveListenerSettings(persistentID string, settings *clientpb.ListenerSettings) error {
+	dbSettings, err := ListenerSettingsByPersistentID(persistentID)
+	if err != nil && err != ErrRecordNotFound {
+		return err
+	}
+	dbSettings.PersistentID = persistentID
+	dbSettings.LongPollTimeout = settings.LongPollTimeout
+	dbSettings.LongPollJitter = settings.LongPollJitter
+	dbSettings.C2Profile = settings.C2Profile
+	dbSettings.EnforceOTP = settings.EnforceOTP
+	dbSettings.MaxNOPPadding = settings.MaxNOPPadding
+	dbSettings.TTL = settings.TTL
+	if err == ErrRecordNotFound {
+		return Session().Create(dbSettings).Error
+	}
+	return Session().Save(dbSettings).Error
+}
+
+// DeleteListenerSettings - Delete the saved settings of a persistent listener
+func DeleteListenerSettings(persistentID string) error {
+	return Session().Where(&models.ListenerSettings{
+		PersistentID: persistentID,
+	}).Delete(&models.ListenerSettings{}).Error
+}
+
 // GetKeyValue - Get a value from a key
 func GetKeyValue(key string) (string, error) {
 	keyValue := &models.KeyValue{}
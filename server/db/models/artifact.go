@@ -0,0 +1,71 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// ShellcodeArtifact - Shellcode converted from a .NET assembly, the shellcode
+// itself is kept in the artifacts store
+type ShellcodeArtifact struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Name      string `gorm:"unique;"`
+	FileName  string
+	Arch      string
+	IsDLL     bool
+	Arguments string
+	Runtime   string
+	Size      int
+	SHA256    string
+	Operator  string
+}
+
+// BeforeCreate - GORM hook
+func (a *ShellcodeArtifact) BeforeCreate(tx *gorm.DB) (err error) {
+	a.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	a.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Converts to protobuf object
+func (a *ShellcodeArtifact) ToProtobuf() *clientpb.ShellcodeArtifact {
+	return &clientpb.ShellcodeArtifact{
+		ID:        a.ID.String(),
+		Name:      a.Name,
+		FileName:  a.FileName,
+		Arch:      a.Arch,
+		IsDLL:     a.IsDLL,
+		Arguments: a.Arguments,
+		Runtime:   a.Runtime,
+		Size:      uint64(a.Size),
+		SHA256:    a.SHA256,
+		CreatedAt: a.CreatedAt.Unix(),
+		Operator:  a.Operator,
+	}
+}
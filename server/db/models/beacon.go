@@ -60,6 +60,11 @@ type Beacon struct {
 	Jitter      int64
 	NextCheckin int64
 
+	Presence       []byte // *sliverpb.Presence, last reported with a check-in
+	DNSDiagnostics []byte // *sliverpb.DNSDiagnostics, reported on registration
+	Impersonating  string // Owner of the token the beacon is impersonating
+	Snapshot       []byte // *sliverpb.EnvironmentSnapshot, reported on registration
+
 	Tasks []BeaconTask
 }
 
@@ -70,6 +75,27 @@ func (b *Beacon) BeforeCreate(tx *gorm.DB) (err error) {
 }
 
 func (b *Beacon) ToProtobuf() *clientpb.Beacon {
+	var presence *sliverpb.Presence
+	if 0 < len(b.Presence) {
+		presence = &sliverpb.Presence{}
+		if proto.Unmarshal(b.Presence, presence) != nil {
+			presence = nil
+		}
+	}
+	var dnsDiagnostics *sliverpb.DNSDiagnostics
+	if 0 < len(b.DNSDiagnostics) {
+		dnsDiagnostics = &sliverpb.DNSDiagnostics{}
+		if proto.Unmarshal(b.DNSDiagnostics, dnsDiagnostics) != nil {
+			dnsDiagnostics = nil
+		}
+	}
+	var snapshot *sliverpb.EnvironmentSnapshot
+	if 0 < len(b.Snapshot) {
+		snapshot = &sliverpb.EnvironmentSnapshot{}
+		if proto.Unmarshal(b.Snapshot, snapshot) != nil {
+			snapshot = nil
+		}
+	}
 	return &clientpb.Beacon{
 		ID:                b.ID.String(),
 		Name:              b.Name,
@@ -94,6 +120,10 @@ func (b *Beacon) ToProtobuf() *clientpb.Beacon {
 		NextCheckin:       b.NextCheckin,
 		Locale:            b.Locale,
 		FirstContact:      b.CreatedAt.Unix(),
+		Presence:          presence,
+		DNSDiagnostics:    dnsDiagnostics,
+		Impersonating:     b.Impersonating,
+		Snapshot:          snapshot,
 	}
 }
 
@@ -116,6 +146,7 @@ const (
 	SENT      = "sent"
 	COMPLETED = "completed"
 	CANCELED  = "canceled"
+	TIMEOUT   = "timeout"
 )
 
 type BeaconTask struct {
@@ -127,8 +158,14 @@ type BeaconTask struct {
 	SentAt      time.Time
 	CompletedAt time.Time
 	Description string
+	Timeout     int64  // Nanoseconds, 0 for no timeout
+	Priority    int    // Pending tasks are sent highest priority first
 	Request     []byte // *sliverpb.Envelope
 	Response    []byte // *sliverpb.Envelope
+
+	// CancelPending - The task was canceled after it was sent, the beacon
+	// is told to stop it on its next check in
+	CancelPending bool
 }
 
 // BeforeCreate - GORM hook
@@ -157,6 +194,8 @@ func (b *BeaconTask) ToProtobuf(content bool) *clientpb.BeaconTask {
 		SentAt:      int64(b.SentAt.UTC().Unix()),
 		CompletedAt: int64(b.CompletedAt.UTC().Unix()),
 		Description: b.Description,
+		Timeout:     b.Timeout,
+		Priority:    int32(b.Priority),
 	}
 	if content {
 		task.Request = b.Request
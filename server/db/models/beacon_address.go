@@ -0,0 +1,50 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// BeaconAddress - A source address a beacon has checked in from, consecutive
+// check-ins from the same address share a single record
+type BeaconAddress struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+	BeaconID  uuid.UUID `gorm:"type:uuid;index"`
+
+	RemoteAddress string
+	ASN           uint32
+	Country       string
+	LastSeen      time.Time
+	Checkins      uint32
+}
+
+// BeforeCreate - GORM hook
+func (a *BeaconAddress) BeforeCreate(tx *gorm.DB) (err error) {
+	a.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	a.CreatedAt = time.Now()
+	return nil
+}
@@ -19,6 +19,7 @@ package models
 */
 
 import (
+	"strings"
 	"time"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
@@ -35,9 +36,15 @@ type Host struct {
 	Hostname  string
 	OSVersion string // Verbore OS version
 	Locale    string // Detected language code
+	Address   string // Only set on hosts found by parsing tool output
+	Tags      string // Comma separated
 
-	IOCs          []IOC
-	ExtensionData []ExtensionData
+	SurveyedAt time.Time // Zero if the host has never been surveyed
+
+	IOCs              []IOC
+	ExtensionData     []ExtensionData
+	LocalGroupMembers []LocalGroupMember
+	Services          []HostService
 }
 
 // BeforeCreate - GORM hook
@@ -59,7 +66,41 @@ func (h *Host) ToProtobuf() *clientpb.Host {
 		IOCs:          h.iocsToProtobuf(),
 		ExtensionData: h.extensionDataToProtobuf(),
 		FirstContact:  h.CreatedAt.Unix(),
+
+		LocalGroupMembers: h.localGroupMembersToProtobuf(),
+		Address:           h.Address,
+		Services:          h.servicesToProtobuf(),
+		Tags:              h.TagList(),
+		SurveyedAt:        h.surveyedAt(),
+	}
+}
+
+func (h *Host) surveyedAt() int64 {
+	if h.SurveyedAt.IsZero() {
+		return 0
+	}
+	return h.SurveyedAt.Unix()
+}
+
+// TagList - The host's tags in the order they were added
+func (h *Host) TagList() []string {
+	tags := []string{}
+	for _, tag := range strings.Split(h.Tags, ",") {
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// HasTag - The host has been tagged with tag
+func (h *Host) HasTag(tag string) bool {
+	for _, hostTag := range h.TagList() {
+		if hostTag == tag {
+			return true
+		}
 	}
+	return false
 }
 
 func (h *Host) iocsToProtobuf() []*clientpb.IOC {
@@ -80,6 +121,22 @@ func (h *Host) extensionDataToProtobuf() map[string]*clientpb.ExtensionData {
 	return extData
 }
 
+func (h *Host) localGroupMembersToProtobuf() []*clientpb.HostLocalGroupMember {
+	members := []*clientpb.HostLocalGroupMember{}
+	for _, dbMember := range h.LocalGroupMembers {
+		members = append(members, dbMember.ToProtobuf())
+	}
+	return members
+}
+
+func (h *Host) servicesToProtobuf() []*clientpb.HostService {
+	services := []*clientpb.HostService{}
+	for _, dbService := range h.Services {
+		services = append(services, dbService.ToProtobuf())
+	}
+	return services
+}
+
 // IOC - Represents an indicator of compromise, generally a file we've
 // uploaded to a remote system.
 type IOC struct {
@@ -132,3 +189,91 @@ func (e *ExtensionData) BeforeCreate(tx *gorm.DB) (err error) {
 	e.CreatedAt = time.Now()
 	return nil
 }
+
+// LocalGroupMember - A member of a local group on a remote host, surveyed from the
+// host it belongs to
+type LocalGroupMember struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostID    uuid.UUID `gorm:"type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	RemoteHost string
+	Group      string
+	GroupSID   string
+	Member     string
+	MemberSID  string
+	MemberType string
+}
+
+// BeforeCreate - GORM hook
+func (l *LocalGroupMember) BeforeCreate(tx *gorm.DB) (err error) {
+	l.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	l.CreatedAt = time.Now()
+	return nil
+}
+
+func (l *LocalGroupMember) ToProtobuf() *clientpb.HostLocalGroupMember {
+	return &clientpb.HostLocalGroupMember{
+		RemoteHost: l.RemoteHost,
+		Group:      l.Group,
+		GroupSID:   l.GroupSID,
+		Member:     l.Member,
+		MemberSID:  l.MemberSID,
+		MemberType: l.MemberType,
+		CreatedAt:  l.CreatedAt.Unix(),
+	}
+}
+
+// HostService - A network service found on a host
+type HostService struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostID    uuid.UUID `gorm:"type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Port     uint32
+	Protocol string
+	Name     string
+	Version  string
+}
+
+// BeforeCreate - GORM hook
+func (s *HostService) BeforeCreate(tx *gorm.DB) (err error) {
+	s.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	s.CreatedAt = time.Now()
+	return nil
+}
+
+func (s *HostService) ToProtobuf() *clientpb.HostService {
+	return &clientpb.HostService{
+		Port:     s.Port,
+		Protocol: s.Protocol,
+		Name:     s.Name,
+		Version:  s.Version,
+	}
+}
+
+// HostSurvey - The last survey of a host, kept apart from the host so listing
+// hosts doesn't load it
+type HostSurvey struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostID    uuid.UUID `gorm:"type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Survey []byte // Protobuf encoded sliverpb.Survey
+}
+
+// BeforeCreate - GORM hook
+func (s *HostSurvey) BeforeCreate(tx *gorm.DB) (err error) {
+	s.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	s.CreatedAt = time.Now()
+	return nil
+}
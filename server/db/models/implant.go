@@ -90,10 +90,32 @@ type ImplantConfig struct {
 	DebugFile           string
 	Evasion             bool
 	ObfuscateSymbols    bool
+	QuietCheckin        bool
+	SleepMask           string
 	ReconnectInterval   int64
 	MaxConnectionErrors uint32
 	ConnectionStrategy  string
 
+	// Registration retry policy
+	ConnectBackoff       string
+	ConnectBackoffMax    int64
+	ConnectJitter        int64
+	ConnectMaxAttempts   uint32
+	ConnectGiveUp        int64
+	ConnectFallbackOrder string
+
+	// Named http c2 profile, empty for the default
+	HTTPC2Profile string
+
+	// Failover chain, C2s are used in this protocol order
+	FailoverOrder     string
+	FailoverThreshold uint32
+	FailoverRetry     int64
+
+	// DNS resolver IPs/CIDRs, comma separated
+	DNSResolverAllow string
+	DNSResolverDeny  string
+
 	// WireGuard
 	WGImplantPrivKey  string
 	WGServerPubKey    string
@@ -107,6 +129,8 @@ type ImplantConfig struct {
 	WGc2Enabled   bool
 	HTTPc2Enabled bool
 	DNSc2Enabled  bool
+	ICMPc2Enabled bool
+	UDPc2Enabled  bool
 
 	CanaryDomains     []CanaryDomain
 	NamePipec2Enabled bool
@@ -119,6 +143,21 @@ type ImplantConfig struct {
 	LimitDatetime     string
 	LimitFileExists   string
 	LimitLocale       string
+	WorkingHours      string
+	KillDate          string
+
+	// Environment keying, the c2s are rendered encrypted with a key derived
+	// from the target's environment (see generate.envKeyConfig)
+	KeyDomain      string
+	KeyHostname    string
+	KeyCIDR        string
+	KeyFile        string
+	EnvKeyed       bool
+	EnvKeySalt     string
+	EnvKeyC2s      string
+	EnvKeyCIDRBits int
+	EnvKeyCIDRSize int
+	EnvKeyFileDir  string
 
 	// Output Format
 	Format clientpb.OutputFormat
@@ -130,6 +169,53 @@ type ImplantConfig struct {
 
 	RunAtLoad bool
 
+	// Batch generation
+	BatchName   string
+	BatchTarget string
+
+	IndicatorThreshold uint32
+
+	// Watchdog, the env var carries the process role between the pair
+	Watchdog      bool
+	WatchdogDelay int64
+	WatchdogEnv   string
+
+	// Default process injection technique, empty for create remote thread
+	InjectionTechnique string
+
+	// Local debug harness build, the implant's only c2 is an in-process loopback
+	IsHarness bool
+
+	// Environment snapshot included in the registration
+	StartupSnapshot bool
+
+	// AMSI and ETW are disabled before loading the CLR or a BOF by default
+	AmsiBypass      bool
+	EtwBypass       bool
+	BypassTechnique string
+
+	// The nt syscalls are made by the implant instead of kernel32
+	SyscallBackend string
+
+	// Shellcode is converted with donut, or sRDI and its options
+	ShellcodeLoader      string
+	SRDIFunction         string
+	SRDIKeepHeader       bool
+	SRDIObfuscateImports bool
+	SRDIImportDelay      uint32
+	SRDIHashKey          uint32
+	ShellcodePrepend     []byte
+	ShellcodeAppend      []byte
+
+	// The dll forwards the proxied dll's exports to the target
+	ProxyDLL    []byte
+	ProxyTarget string
+
+	// Saved with the build so rebuilds get the same obfuscated names
+	ObfuscateSkipLiterals bool
+	ObfuscateSkipTiny     bool
+	ObfuscationSeed       string
+
 	FileName string
 }
 
@@ -165,18 +251,40 @@ func (ic *ImplantConfig) ToProtobuf() *clientpb.ImplantConfig {
 		DebugFile:        ic.DebugFile,
 		Evasion:          ic.Evasion,
 		ObfuscateSymbols: ic.ObfuscateSymbols,
+		QuietCheckin:     ic.QuietCheckin,
+		SleepMask:        ic.SleepMask,
 		TemplateName:     ic.TemplateName,
 
 		ReconnectInterval:   ic.ReconnectInterval,
 		MaxConnectionErrors: ic.MaxConnectionErrors,
 		ConnectionStrategy:  ic.ConnectionStrategy,
 
+		ConnectBackoff:       ic.ConnectBackoff,
+		ConnectBackoffMax:    ic.ConnectBackoffMax,
+		ConnectJitter:        ic.ConnectJitter,
+		ConnectMaxAttempts:   ic.ConnectMaxAttempts,
+		ConnectGiveUp:        ic.ConnectGiveUp,
+		ConnectFallbackOrder: ic.ConnectFallbackOrder,
+		HTTPC2Profile:        ic.HTTPC2Profile,
+		FailoverOrder:        ic.FailoverOrder,
+		FailoverThreshold:    ic.FailoverThreshold,
+		FailoverRetry:        ic.FailoverRetry,
+		DNSResolverAllow:     ic.DNSResolverAllow,
+		DNSResolverDeny:      ic.DNSResolverDeny,
+
 		LimitDatetime:     ic.LimitDatetime,
 		LimitDomainJoined: ic.LimitDomainJoined,
 		LimitHostname:     ic.LimitHostname,
 		LimitUsername:     ic.LimitUsername,
 		LimitFileExists:   ic.LimitFileExists,
 		LimitLocale:       ic.LimitLocale,
+		WorkingHours:      ic.WorkingHours,
+		KillDate:          ic.KillDate,
+
+		KeyDomain:   ic.KeyDomain,
+		KeyHostname: ic.KeyHostname,
+		KeyCIDR:     ic.KeyCIDR,
+		KeyFile:     ic.KeyFile,
 
 		IsSharedLib:       ic.IsSharedLib,
 		IsService:         ic.IsService,
@@ -189,6 +297,42 @@ func (ic *ImplantConfig) ToProtobuf() *clientpb.ImplantConfig {
 		WGTcpCommsPort:    ic.WGTcpCommsPort,
 
 		FileName: ic.FileName,
+
+		BatchName:   ic.BatchName,
+		BatchTarget: ic.BatchTarget,
+
+		IndicatorThreshold: ic.IndicatorThreshold,
+
+		Watchdog:      ic.Watchdog,
+		WatchdogDelay: ic.WatchdogDelay,
+
+		InjectionTechnique: ic.InjectionTechnique,
+
+		IsHarness: ic.IsHarness,
+
+		StartupSnapshot: ic.StartupSnapshot,
+
+		AmsiBypass:      ic.AmsiBypass,
+		EtwBypass:       ic.EtwBypass,
+		BypassTechnique: ic.BypassTechnique,
+
+		SyscallBackend: ic.SyscallBackend,
+
+		ShellcodeLoader:      ic.ShellcodeLoader,
+		SRDIFunction:         ic.SRDIFunction,
+		SRDIKeepHeader:       ic.SRDIKeepHeader,
+		SRDIObfuscateImports: ic.SRDIObfuscateImports,
+		SRDIImportDelay:      ic.SRDIImportDelay,
+		SRDIHashKey:          ic.SRDIHashKey,
+		ShellcodePrepend:     ic.ShellcodePrepend,
+		ShellcodeAppend:      ic.ShellcodeAppend,
+
+		ProxyDLL:    ic.ProxyDLL,
+		ProxyTarget: ic.ProxyTarget,
+
+		ObfuscateSkipLiterals: ic.ObfuscateSkipLiterals,
+		ObfuscateSkipTiny:     ic.ObfuscateSkipTiny,
+		ObfuscationSeed:       ic.ObfuscationSeed,
 	}
 	// Copy Canary Domains
 	config.CanaryDomains = []string{}
@@ -0,0 +1,67 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// ListenerSettings - Runtime settings of a persistent listener, these take
+// precedence over the job config the listener was started with
+type ListenerSettings struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+	UpdatedAt time.Time
+
+	PersistentID string `gorm:"unique;"`
+
+	LongPollTimeout int64
+	LongPollJitter  int64
+	C2Profile       string
+	EnforceOTP      bool
+	MaxNOPPadding   uint32
+	TTL             uint32
+}
+
+// BeforeCreate - GORM hook
+func (l *ListenerSettings) BeforeCreate(tx *gorm.DB) (err error) {
+	l.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	l.CreatedAt = time.Now()
+	return nil
+}
+
+// ToProtobuf - Convert to protobuf, the caller fills in the job
+func (l *ListenerSettings) ToProtobuf() *clientpb.ListenerSettings {
+	return &clientpb.ListenerSettings{
+		LongPollTimeout: l.LongPollTimeout,
+		LongPollJitter:  l.LongPollJitter,
+		C2Profile:       l.C2Profile,
+		EnforceOTP:      l.EnforceOTP,
+		MaxNOPPadding:   l.MaxNOPPadding,
+		TTL:             l.TTL,
+		Persisted:       true,
+	}
+}
@@ -34,6 +34,7 @@ type Loot struct {
 	FileType       int
 	CredentialType int
 	Name           string
+	FileName       string
 
 	OriginHost uuid.UUID `gorm:"type:uuid;"`
 }
@@ -46,6 +46,31 @@ func (o *Operator) BeforeCreate(tx *gorm.DB) (err error) {
 	return nil
 }
 
+// OperatorSecondFactor - A second factor an operator must verify after
+// authenticating with their token, the token alone is a bearer credential
+type OperatorSecondFactor struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+	Name      string    `gorm:"uniqueIndex"`
+	Type      string
+
+	TOTPSecret string
+
+	CredentialID []byte
+	PublicKey    string // PEM encoded
+	SignCount    uint32
+}
+
+// BeforeCreate - GORM hook
+func (o *OperatorSecondFactor) BeforeCreate(tx *gorm.DB) (err error) {
+	o.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	o.CreatedAt = time.Now()
+	return nil
+}
+
 // GenerateOperatorToken - Generate a new operator auth token
 func GenerateOperatorToken() string {
 	buf := make([]byte, 32)
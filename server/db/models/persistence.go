@@ -0,0 +1,65 @@
+package models
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+// Persistence - Something an operator changed on a host to keep access
+type Persistence struct {
+	ID        uuid.UUID `gorm:"primaryKey;->;<-:create;type:uuid;"`
+	HostUUID  uuid.UUID `gorm:"type:uuid;"`
+	CreatedAt time.Time `gorm:"->;<-:create;"`
+
+	Hostname  string
+	Technique string
+	Target    string
+	Path      string
+	Restore   string
+	Operator  string
+}
+
+// BeforeCreate - GORM hook
+func (p *Persistence) BeforeCreate(tx *gorm.DB) (err error) {
+	p.ID, err = uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	p.CreatedAt = time.Now()
+	return nil
+}
+
+func (p *Persistence) ToProtobuf() *clientpb.Persistence {
+	return &clientpb.Persistence{
+		ID:        p.ID.String(),
+		HostUUID:  p.HostUUID.String(),
+		Hostname:  p.Hostname,
+		Technique: p.Technique,
+		Target:    p.Target,
+		Path:      p.Path,
+		Restore:   p.Restore,
+		Operator:  p.Operator,
+		CreatedAt: p.CreatedAt.Unix(),
+	}
+}
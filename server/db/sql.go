@@ -32,45 +32,56 @@ import (
 
 var (
 	clientLog = log.NamedLogger("db", "client")
-)
-
-// newDBClient - Initialize the db client
-func newDBClient() *gorm.DB {
-	dbConfig := configs.GetDatabaseConfig()
 
-	var dbClient *gorm.DB
-	switch dbConfig.Dialect {
-	case configs.Sqlite:
-		dbClient = sqliteClient(dbConfig)
-	case configs.Postgres:
-		dbClient = postgresClient(dbConfig)
-	case configs.MySQL:
-		dbClient = mySQLClient(dbConfig)
-	default:
-		panic(fmt.Sprintf("Unknown DB Dialect: '%s'", dbConfig.Dialect))
-	}
-
-	err := dbClient.AutoMigrate(
+	// allModels - Every model that has a table in the database
+	allModels = []interface{}{
 		&models.Beacon{},
+		&models.BeaconAddress{},
 		&models.BeaconTask{},
 		&models.DNSCanary{},
 		&models.Certificate{},
 		&models.Host{},
 		&models.IOC{},
 		&models.ExtensionData{},
+		&models.LocalGroupMember{},
+		&models.HostService{},
+		&models.HostSurvey{},
 		&models.ImplantBuild{},
 		&models.ImplantProfile{},
 		&models.ImplantConfig{},
 		&models.ImplantC2{},
 		&models.KeyValue{},
 		&models.CanaryDomain{},
+		&models.ListenerSettings{},
 		&models.Loot{},
 		&models.Operator{},
+		&models.OperatorSecondFactor{},
+		&models.Persistence{},
+		&models.ShellcodeArtifact{},
 		&models.Website{},
 		&models.WebContent{},
 		&models.WGKeys{},
 		&models.WGPeer{},
-	)
+	}
+)
+
+// newDBClient - Initialize the db client
+func newDBClient() *gorm.DB {
+	dbConfig := configs.GetDatabaseConfig()
+
+	var dbClient *gorm.DB
+	switch dbConfig.Dialect {
+	case configs.Sqlite:
+		dbClient = sqliteClient(dbConfig)
+	case configs.Postgres:
+		dbClient = postgresClient(dbConfig)
+	case configs.MySQL:
+		dbClient = mySQLClient(dbConfig)
+	default:
+		panic(fmt.Sprintf("Unknown DB Dialect: '%s'", dbConfig.Dialect))
+	}
+
+	err := dbClient.AutoMigrate(allModels...)
 	if err != nil {
 		clientLog.Error(err)
 	}
@@ -122,3 +133,32 @@ func mySQLClient(dbConfig *configs.DatabaseConfig) *gorm.DB {
 	}
 	return dbClient
 }
+
+// Migrate - Create or update the tables for all models, this is normally done
+// automatically when the database client is created
+func Migrate() error {
+	return Client.AutoMigrate(allModels...)
+}
+
+// Vacuum - Rebuild the database to reclaim space from deleted records
+func Vacuum() error {
+	dbConfig := configs.GetDatabaseConfig()
+	switch dbConfig.Dialect {
+	case configs.Sqlite, configs.Postgres:
+		return Client.Exec("VACUUM").Error
+	case configs.MySQL:
+		tables, err := Client.Migrator().GetTables()
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			err = Client.Exec(fmt.Sprintf("OPTIMIZE TABLE `%s`", table)).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: '%s'", configs.ErrInvalidDialect, dbConfig.Dialect)
+	}
+}
@@ -0,0 +1,208 @@
+package e2e
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	clientcore "github.com/bishopfox/sliver/client/core"
+	clienttransport "github.com/bishopfox/sliver/client/transport"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/rpcpb"
+	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/transport"
+	"google.golang.org/grpc"
+)
+
+const (
+	// e2eEnv - The e2e tests build and run real implants, which takes a while, so
+	// they only run when this is set
+	e2eEnv = "SLIVER_E2E"
+
+	generateTimeout = 30 * time.Minute
+	sessionTimeout  = 3 * time.Minute
+	requestTimeout  = 5 * time.Minute
+)
+
+var (
+	rpc rpcpb.SliverRPCClient
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv(e2eEnv) == "" {
+		os.Exit(m.Run()) // Every test skips itself
+	}
+	conn, err := setup()
+	if err != nil {
+		fmt.Printf("e2e setup failed: %s\n", err)
+		os.Exit(1)
+	}
+	code := m.Run()
+	conn.Close()
+	os.Exit(code)
+}
+
+// setup - Initialize the server the same way 'sliver-server' does and connect
+// to it over an in-memory listener, just like the server console
+func setup() (*grpc.ClientConn, error) {
+	certs.SetupCAs()
+	certs.SetupWGKeys()
+	cryptography.ECCServerKeyPair()
+	cryptography.TOTPServerSecret()
+	cryptography.MinisignServerPrivateKey()
+
+	_, ln, err := transport.LocalListener()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return ln.Dial()
+		}),
+		grpc.WithInsecure(), // In-memory listener
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(clienttransport.ClientMaxReceiveMessageSize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rpc = rpcpb.NewSliverRPCClient(conn)
+	go clientcore.TunnelLoop(rpc)
+	return conn, nil
+}
+
+func skipUnlessEnabled(t *testing.T) {
+	if os.Getenv(e2eEnv) == "" {
+		t.Skipf("set %s=1 to run the end-to-end tests", e2eEnv)
+	}
+}
+
+// freePort - Ask the OS for a free port, there's a small window where something
+// else could take it but that's good enough for tests
+func freePort(t *testing.T, network string) uint32 {
+	var port int
+	switch network {
+	case "udp":
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Failed to find free udp port: %s", err)
+		}
+		port = conn.LocalAddr().(*net.UDPAddr).Port
+		conn.Close()
+	default:
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("Failed to find free tcp port: %s", err)
+		}
+		port = ln.Addr().(*net.TCPAddr).Port
+		ln.Close()
+	}
+	return uint32(port)
+}
+
+// killJobOnCleanup - Stop a listener when the test is done with it
+func killJobOnCleanup(t *testing.T, jobID uint32) {
+	t.Cleanup(func() {
+		_, err := rpc.KillJob(context.Background(), &clientpb.KillJobReq{ID: jobID})
+		if err != nil {
+			t.Logf("Failed to kill job %d: %s", jobID, err)
+		}
+	})
+}
+
+// generateImplant - Build a session implant for the local platform, the config's
+// GOOS, GOARCH, and format are always overwritten. Returns the implant's name.
+func generateImplant(t *testing.T, config *clientpb.ImplantConfig) (string, []byte) {
+	config.GOOS = runtime.GOOS
+	config.GOARCH = runtime.GOARCH
+	config.Format = clientpb.OutputFormat_EXECUTABLE
+	config.ObfuscateSymbols = false
+
+	t.Logf("Generating implant with C2 %v", config.C2)
+	ctx, cancel := context.WithTimeout(context.Background(), generateTimeout)
+	defer cancel()
+	generated, err := rpc.Generate(ctx, &clientpb.GenerateReq{Config: config})
+	if err != nil {
+		t.Fatalf("Failed to generate implant: %s", err)
+	}
+	name := strings.TrimSuffix(generated.File.Name, filepath.Ext(generated.File.Name))
+	return name, generated.File.Data
+}
+
+// runImplant - Write the implant to disk and execute it, the implant is killed
+// when the test is done. Returns the directory the implant is running in.
+func runImplant(t *testing.T, name string, data []byte) string {
+	dir := t.TempDir()
+	implantPath := filepath.Join(dir, name)
+	if runtime.GOOS == "windows" {
+		implantPath += ".exe"
+	}
+	err := os.WriteFile(implantPath, data, 0700)
+	if err != nil {
+		t.Fatalf("Failed to write implant: %s", err)
+	}
+	cmd := exec.Command(implantPath)
+	cmd.Dir = dir
+	err = cmd.Start()
+	if err != nil {
+		t.Fatalf("Failed to start implant: %s", err)
+	}
+	t.Cleanup(func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	})
+	return dir
+}
+
+// waitForSession - Wait for an implant to register a session with the server
+func waitForSession(t *testing.T, name string) *clientpb.Session {
+	deadline := time.Now().Add(sessionTimeout)
+	for time.Now().Before(deadline) {
+		sessions, err := rpc.GetSessions(context.Background(), &commonpb.Empty{})
+		if err != nil {
+			t.Fatalf("Failed to list sessions: %s", err)
+		}
+		for _, session := range sessions.Sessions {
+			if session.Name == name {
+				t.Logf("Session %s (%s) registered via %s", session.ID, name, session.Transport)
+				return session
+			}
+		}
+		time.Sleep(time.Second)
+	}
+	t.Fatalf("Implant %s did not register a session within %s", name, sessionTimeout)
+	return nil
+}
+
+func request(session *clientpb.Session) *commonpb.Request {
+	return &commonpb.Request{
+		SessionID: session.ID,
+		Timeout:   int64(requestTimeout),
+	}
+}
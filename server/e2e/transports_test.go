@@ -0,0 +1,370 @@
+package e2e
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	clientcore "github.com/bishopfox/sliver/client/core"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/util/encoders"
+)
+
+const (
+	mb = 1024 * 1024
+
+	dnsParent     = "e2e.sliver.test."
+	tunnelTimeout = time.Minute
+)
+
+// transportTest - Start starts a listener and points the implant config's C2 at it
+type transportTest struct {
+	Name string
+	// Bytes to upload and download again, DNS is much slower than everything else
+	TransferSize int
+	Start        func(t *testing.T, config *clientpb.ImplantConfig)
+}
+
+var transportTests = []transportTest{
+	{Name: "mtls", TransferSize: 16 * mb, Start: startMTLS},
+	{Name: "http", TransferSize: 16 * mb, Start: startHTTP(false)},
+	{Name: "https", TransferSize: 16 * mb, Start: startHTTP(true)},
+	{Name: "dns", TransferSize: mb / 4, Start: startDNS},
+	{Name: "wg", TransferSize: 16 * mb, Start: startWG},
+	{Name: "icmp", TransferSize: mb / 4, Start: startICMP},
+	{Name: "udp", TransferSize: mb / 4, Start: startUDP},
+}
+
+// TestTransports - Build an implant for each transport and run it through
+// registration, tasking, a tunnel, and a large transfer
+func TestTransports(t *testing.T) {
+	skipUnlessEnabled(t)
+	for _, test := range transportTests {
+		test := test
+		t.Run(test.Name, func(t *testing.T) {
+			config := &clientpb.ImplantConfig{}
+			test.Start(t, config)
+			name, data := generateImplant(t, config)
+			dir := runImplant(t, name, data)
+			session := waitForSession(t, name)
+			exerciseSession(t, session, dir, test.TransferSize)
+		})
+	}
+}
+
+// TestPivots - Build an mTLS implant and a TCP pivot implant that connects to the
+// server through it
+func TestPivots(t *testing.T) {
+	skipUnlessEnabled(t)
+	config := &clientpb.ImplantConfig{}
+	startMTLS(t, config)
+	name, data := generateImplant(t, config)
+	runImplant(t, name, data)
+	parent := waitForSession(t, name)
+
+	bindAddress := fmt.Sprintf("127.0.0.1:%d", freePort(t, "tcp"))
+	listener, err := rpc.PivotStartListener(context.Background(), &sliverpb.PivotStartListenerReq{
+		Type:        sliverpb.PivotType_TCP,
+		BindAddress: bindAddress,
+		Request:     request(parent),
+	})
+	if err != nil {
+		t.Fatalf("Failed to start pivot listener: %s", err)
+	}
+	if listener.Response != nil && listener.Response.Err != "" {
+		t.Fatalf("Failed to start pivot listener: %s", listener.Response.Err)
+	}
+
+	pivotConfig := &clientpb.ImplantConfig{
+		C2: []*clientpb.ImplantC2{{Priority: 0, URL: "tcppivot://" + bindAddress}},
+	}
+	pivotName, pivotData := generateImplant(t, pivotConfig)
+	dir := runImplant(t, pivotName, pivotData)
+	session := waitForSession(t, pivotName)
+	exerciseSession(t, session, dir, 16*mb)
+}
+
+func startMTLS(t *testing.T, config *clientpb.ImplantConfig) {
+	port := freePort(t, "tcp")
+	listener, err := rpc.StartMTLSListener(context.Background(), &clientpb.MTLSListenerReq{
+		Host: "127.0.0.1",
+		Port: port,
+	})
+	if err != nil {
+		t.Fatalf("Failed to start mtls listener: %s", err)
+	}
+	killJobOnCleanup(t, listener.JobID)
+	config.C2 = []*clientpb.ImplantC2{{URL: fmt.Sprintf("mtls://127.0.0.1:%d", port)}}
+}
+
+func startHTTP(secure bool) func(*testing.T, *clientpb.ImplantConfig) {
+	return func(t *testing.T, config *clientpb.ImplantConfig) {
+		port := freePort(t, "tcp")
+		req := &clientpb.HTTPListenerReq{
+			Host:   "127.0.0.1",
+			Port:   port,
+			Secure: secure,
+		}
+		var listener *clientpb.HTTPListener
+		var err error
+		scheme := "http"
+		if secure {
+			scheme = "https"
+			listener, err = rpc.StartHTTPSListener(context.Background(), req)
+		} else {
+			listener, err = rpc.StartHTTPListener(context.Background(), req)
+		}
+		if err != nil {
+			t.Fatalf("Failed to start %s listener: %s", scheme, err)
+		}
+		killJobOnCleanup(t, listener.JobID)
+		config.C2 = []*clientpb.ImplantC2{{URL: fmt.Sprintf("%s://127.0.0.1:%d", scheme, port)}}
+	}
+}
+
+// startDNS - The server acts as the implant's only resolver, resolvers are always
+// queried on port 53 so this is skipped if we can't bind to it
+func startDNS(t *testing.T, config *clientpb.ImplantConfig) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:53")
+	if err != nil {
+		t.Skipf("DNS requires binding to 127.0.0.1:53: %s", err)
+	}
+	conn.Close()
+	listener, err := rpc.StartDNSListener(context.Background(), &clientpb.DNSListenerReq{
+		Domains: []string{dnsParent},
+		Host:    "127.0.0.1",
+		Port:    53,
+	})
+	if err != nil {
+		t.Fatalf("Failed to start dns listener: %s", err)
+	}
+	killJobOnCleanup(t, listener.JobID)
+	config.C2 = []*clientpb.ImplantC2{{URL: fmt.Sprintf("dns://%s?resolvers=127.0.0.1", strings.TrimSuffix(dnsParent, "."))}}
+}
+
+// startICMP - Both the listener and the implant need raw sockets, so this is
+// skipped unless we're privileged
+func startICMP(t *testing.T, config *clientpb.ImplantConfig) {
+	conn, err := net.ListenPacket("ip4:icmp", "127.0.0.1")
+	if err != nil {
+		t.Skipf("ICMP requires raw sockets: %s", err)
+	}
+	conn.Close()
+	listener, err := rpc.StartICMPListener(context.Background(), &clientpb.ICMPListenerReq{
+		Host: "127.0.0.1",
+	})
+	if err != nil {
+		t.Fatalf("Failed to start icmp listener: %s", err)
+	}
+	killJobOnCleanup(t, listener.JobID)
+	config.C2 = []*clientpb.ImplantC2{{URL: "icmp://127.0.0.1?poll-interval=100ms"}}
+}
+
+func startUDP(t *testing.T, config *clientpb.ImplantConfig) {
+	port := freePort(t, "udp")
+	listener, err := rpc.StartUDPListener(context.Background(), &clientpb.UDPListenerReq{
+		Host: "127.0.0.1",
+		Port: port,
+	})
+	if err != nil {
+		t.Fatalf("Failed to start udp listener: %s", err)
+	}
+	killJobOnCleanup(t, listener.JobID)
+	config.C2 = []*clientpb.ImplantC2{{URL: fmt.Sprintf("udp://127.0.0.1:%d?poll-interval=100ms", port)}}
+}
+
+func startWG(t *testing.T, config *clientpb.ImplantConfig) {
+	port := freePort(t, "udp")
+	nPort := freePort(t, "tcp")
+	keyPort := freePort(t, "tcp")
+	listener, err := rpc.StartWGListener(context.Background(), &clientpb.WGListenerReq{
+		Host:    "127.0.0.1",
+		Port:    port,
+		NPort:   nPort,
+		KeyPort: keyPort,
+	})
+	if err != nil {
+		t.Fatalf("Failed to start wg listener: %s", err)
+	}
+	killJobOnCleanup(t, listener.JobID)
+	tunIP, err := rpc.GenerateUniqueIP(context.Background(), &commonpb.Empty{})
+	if err != nil {
+		t.Fatalf("Failed to generate wg ip: %s", err)
+	}
+	config.C2 = []*clientpb.ImplantC2{{URL: fmt.Sprintf("wg://127.0.0.1:%d", port)}}
+	config.WGPeerTunIP = tunIP.IP
+	config.WGKeyExchangePort = keyPort
+	config.WGTcpCommsPort = nPort
+}
+
+// exerciseSession - Everything an operator is likely to do with a session, dir
+// is the directory the implant is running in
+func exerciseSession(t *testing.T, session *clientpb.Session, dir string, transferSize int) {
+	t.Run("tasking", func(t *testing.T) {
+		ping, err := rpc.Ping(context.Background(), &sliverpb.Ping{Nonce: 31337, Request: request(session)})
+		if err != nil {
+			t.Fatalf("Ping failed: %s", err)
+		}
+		if ping.Nonce != 31337 {
+			t.Fatalf("Expected ping nonce 31337 got %d", ping.Nonce)
+		}
+
+		pwd, err := rpc.Pwd(context.Background(), &sliverpb.PwdReq{Request: request(session)})
+		if err != nil {
+			t.Fatalf("Pwd failed: %s", err)
+		}
+		if !sameDir(pwd.Path, dir) {
+			t.Fatalf("Expected working directory %s got %s", dir, pwd.Path)
+		}
+
+		ls, err := rpc.Ls(context.Background(), &sliverpb.LsReq{Path: dir, Request: request(session)})
+		if err != nil {
+			t.Fatalf("Ls failed: %s", err)
+		}
+		found := false
+		for _, file := range ls.Files {
+			if strings.HasPrefix(file.Name, session.Name) {
+				found = true
+			}
+		}
+		if !found {
+			t.Fatalf("Expected implant binary in %s", dir)
+		}
+	})
+
+	t.Run("tunnel", func(t *testing.T) {
+		exerciseShell(t, session)
+	})
+
+	t.Run("transfer", func(t *testing.T) {
+		data := make([]byte, transferSize)
+		rand.Read(data)
+		remotePath := filepath.Join(dir, "e2e-transfer.bin")
+		upload, err := rpc.Upload(context.Background(), &sliverpb.UploadReq{
+			Path:    remotePath,
+			Encoder: "gzip",
+			Data:    encoders.Gzip{}.Encode(data),
+			Request: request(session),
+		})
+		if err != nil {
+			t.Fatalf("Upload failed: %s", err)
+		}
+		if upload.Response != nil && upload.Response.Err != "" {
+			t.Fatalf("Upload failed: %s", upload.Response.Err)
+		}
+
+		download, err := rpc.Download(context.Background(), &sliverpb.DownloadReq{
+			Path:    remotePath,
+			Request: request(session),
+		})
+		if err != nil {
+			t.Fatalf("Download failed: %s", err)
+		}
+		if download.Response != nil && download.Response.Err != "" {
+			t.Fatalf("Download failed: %s", download.Response.Err)
+		}
+		downloaded := download.Data
+		if download.Encoder == "gzip" {
+			downloaded, err = encoders.Gzip{}.Decode(downloaded)
+			if err != nil {
+				t.Fatalf("Failed to decode download: %s", err)
+			}
+		}
+		if sha256.Sum256(downloaded) != sha256.Sum256(data) {
+			t.Fatalf("Downloaded %d bytes do not match the %d bytes uploaded", len(downloaded), len(data))
+		}
+	})
+}
+
+// exerciseShell - Start a shell bound to a tunnel, and have it do some math so we
+// know the output didn't just come from echoing our input
+func exerciseShell(t *testing.T, session *clientpb.Session) {
+	shellPath := "/bin/sh"
+	command := "echo $((6*7))\n"
+	if runtime.GOOS == "windows" {
+		shellPath = "C:\\Windows\\System32\\cmd.exe"
+		command = "set /a 6*7\r\n"
+	}
+
+	rpcTunnel, err := rpc.CreateTunnel(context.Background(), &sliverpb.Tunnel{SessionID: session.ID})
+	if err != nil {
+		t.Fatalf("Failed to create tunnel: %s", err)
+	}
+	tunnel := clientcore.GetTunnels().Start(rpcTunnel.TunnelID, rpcTunnel.SessionID)
+	defer func() {
+		rpc.CloseTunnel(context.Background(), &sliverpb.Tunnel{
+			TunnelID:  tunnel.ID,
+			SessionID: session.ID,
+		})
+		clientcore.GetTunnels().Close(tunnel.ID)
+	}()
+
+	shell, err := rpc.Shell(context.Background(), &sliverpb.ShellReq{
+		Path:     shellPath,
+		TunnelID: tunnel.ID,
+		Request:  request(session),
+	})
+	if err != nil {
+		t.Fatalf("Shell failed: %s", err)
+	}
+	if shell.Response != nil && shell.Response.Err != "" {
+		t.Fatalf("Shell failed: %s", shell.Response.Err)
+	}
+	_, err = tunnel.Write([]byte(command))
+	if err != nil {
+		t.Fatalf("Failed to write to tunnel: %s", err)
+	}
+
+	output := &bytes.Buffer{}
+	timeout := time.After(tunnelTimeout)
+	for !strings.Contains(output.String(), "42") {
+		select {
+		case data, ok := <-tunnel.Recv:
+			if !ok {
+				t.Fatalf("Tunnel closed before shell output, read %q", output.String())
+			}
+			output.Write(data)
+		case <-timeout:
+			t.Fatalf("Timed out waiting for shell output, read %q", output.String())
+		}
+	}
+}
+
+// sameDir - Compare directories after resolving symlinks, temp dirs are often
+// behind one (e.g. /var -> /private/var on MacOS)
+func sameDir(a string, b string) bool {
+	resolvedA, errA := filepath.EvalSymlinks(a)
+	resolvedB, errB := filepath.EvalSymlinks(b)
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+	return resolvedA == resolvedB
+}
@@ -0,0 +1,228 @@
+package engagement
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	checkInterval = time.Minute
+	killTimeout   = 30 * time.Second
+
+	// killDescription - Matches the description of kill tasks queued by operators
+	killDescription = "KillReq"
+
+	// unreachableGrace - How late a beacon can be to its next check in before it's
+	// reported as unreachable
+	unreachableGrace = 5 * time.Minute
+)
+
+var (
+	engagementLog = log.NamedLogger("engagement", "expiry")
+
+	// ErrEngagementEnded - Implants can't be tasked once the engagement has ended
+	ErrEngagementEnded = errors.New("the engagement has ended, implants can only be killed")
+
+	mutex     = &sync.Mutex{}
+	end       time.Time
+	announced bool
+
+	// Beacons that have already been reported as unreachable
+	unreachable = map[string]bool{}
+)
+
+// Start - Load the engagement end from the server config and enforce it
+func Start() {
+	config := configs.GetServerConfig()
+	engagementEnd, err := config.Engagement.EndTime()
+	if err != nil {
+		engagementLog.Errorf("Ignoring engagement end: %s", err)
+	}
+	mutex.Lock()
+	end = engagementEnd
+	mutex.Unlock()
+	go func() {
+		for now := range time.Tick(checkInterval) {
+			if Ended(now) {
+				enforce(now)
+			}
+		}
+	}()
+}
+
+// End - The end of the engagement, zero if there is none
+func End() time.Time {
+	mutex.Lock()
+	defer mutex.Unlock()
+	return end
+}
+
+// Ended - The engagement has ended
+func Ended(now time.Time) bool {
+	engagementEnd := End()
+	return !engagementEnd.IsZero() && now.After(engagementEnd)
+}
+
+// Check - Returns an error if tasking implants isn't allowed
+func Check() error {
+	if Ended(time.Now()) {
+		return fmt.Errorf("%w (%s)", ErrEngagementEnded, End().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// SetEnd - Change the end of the engagement and save it to the server config,
+// a zero time removes the end
+func SetEnd(engagementEnd time.Time) error {
+	config := configs.GetServerConfig()
+	config.Engagement = &configs.EngagementConfig{}
+	if !engagementEnd.IsZero() {
+		config.Engagement.End = engagementEnd.UTC().Format(time.RFC3339)
+	}
+	err := config.Save()
+	if err != nil {
+		return err
+	}
+	mutex.Lock()
+	end = engagementEnd
+	announced = false
+	unreachable = map[string]bool{}
+	mutex.Unlock()
+	engagementLog.Warnf("Engagement end set to %s", config.Engagement.End)
+	if Ended(time.Now()) {
+		go enforce(time.Now())
+	}
+	return nil
+}
+
+// enforce - Kill every session and queue a kill task for every beacon, beacons
+// that don't check in to pick up their kill task are reported as unreachable
+func enforce(now time.Time) {
+	mutex.Lock()
+	first := !announced
+	announced = true
+	mutex.Unlock()
+	if first {
+		engagementLog.Warnf("Engagement ended at %s, killing all implants", End().Format(time.RFC3339))
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.EngagementEndedEvent,
+			Data:      []byte(End().Format(time.RFC3339)),
+		})
+	}
+
+	for _, session := range core.Sessions.All() {
+		engagementLog.Warnf("Engagement ended, killing session %s (%s)", session.ID, session.Hostname)
+		data, _ := proto.Marshal(&sliverpb.KillReq{Force: true})
+		// Do not block waiting for the msg send, the implant connection may already be dead
+		go session.Request(sliverpb.MsgKillSessionReq, killTimeout, data)
+		core.Sessions.Remove(session.ID)
+	}
+
+	beacons, err := db.ListBeacons()
+	if err != nil {
+		engagementLog.Errorf("Failed to list beacons: %s", err)
+		return
+	}
+	for _, beacon := range beacons {
+		killTask, err := queueKill(beacon)
+		if err != nil {
+			engagementLog.Errorf("Failed to kill beacon %s: %s", beacon.ID, err)
+			continue
+		}
+		if killTask.State == models.PENDING {
+			checkReachable(beacon, now)
+		}
+	}
+}
+
+// queueKill - Cancel the beacon's pending tasks and queue a kill task ahead of
+// everything else, unless one is already queued
+func queueKill(beacon *models.Beacon) (*models.BeaconTask, error) {
+	tasks, err := db.BeaconTasksByBeaconID(beacon.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		if task.Description == killDescription && (task.State == models.PENDING || task.State == models.SENT) {
+			return task, nil
+		}
+	}
+	for _, task := range tasks {
+		if task.State != models.PENDING {
+			continue
+		}
+		err = db.Session().Model(task).Update("state", models.CANCELED).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	engagementLog.Warnf("Engagement ended, killing beacon %s (%s)", beacon.ID, beacon.Hostname)
+	data, err := proto.Marshal(&sliverpb.KillReq{Force: true})
+	if err != nil {
+		return nil, err
+	}
+	task, err := beacon.Task(&sliverpb.Envelope{
+		Type: sliverpb.MsgKillSessionReq,
+		Data: data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	task.Description = killDescription
+	task.Priority = len(tasks) + 1
+	err = db.Session().Create(task).Error
+	return task, err
+}
+
+// checkReachable - Alert once if the beacon is overdue to pick up its kill task
+func checkReachable(beacon *models.Beacon, now time.Time) {
+	nextCheckin := time.Unix(beacon.NextCheckin, 0).Add(time.Duration(beacon.Jitter) + unreachableGrace)
+	if now.Before(nextCheckin) {
+		return
+	}
+	mutex.Lock()
+	alerted := unreachable[beacon.ID.String()]
+	unreachable[beacon.ID.String()] = true
+	mutex.Unlock()
+	if alerted {
+		return
+	}
+	engagementLog.Errorf("Engagement ended, beacon %s (%s) missed its check in at %s and may still be running",
+		beacon.ID, beacon.Hostname, time.Unix(beacon.NextCheckin, 0).Format(time.RFC3339))
+	eventData, _ := proto.Marshal(beacon.ToProtobuf())
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.EngagementUnreachableEvent,
+		Data:      eventData,
+		Beacon:    beacon,
+	})
+}
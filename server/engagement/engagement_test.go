@@ -0,0 +1,105 @@
+package engagement
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+)
+
+func TestEnded(t *testing.T) {
+	mutex.Lock()
+	end = time.Time{}
+	mutex.Unlock()
+	if Ended(time.Now()) || Check() != nil {
+		t.Fatal("engagement without an end has ended")
+	}
+
+	mutex.Lock()
+	end = time.Now().Add(time.Hour)
+	mutex.Unlock()
+	if Ended(time.Now()) || Check() != nil {
+		t.Fatal("engagement ended before its end")
+	}
+	if !Ended(time.Now().Add(2 * time.Hour)) {
+		t.Fatal("engagement did not end after its end")
+	}
+
+	mutex.Lock()
+	end = time.Now().Add(-time.Minute)
+	mutex.Unlock()
+	if Check() == nil {
+		t.Fatal("expected an error tasking implants after the end")
+	}
+	mutex.Lock()
+	end = time.Time{}
+	mutex.Unlock()
+}
+
+func TestQueueKill(t *testing.T) {
+	beacon := &models.Beacon{ID: uuid.Must(uuid.NewV4()), Hostname: "engagement-test"}
+	err := db.Session().Create(beacon).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Session().Delete(beacon)
+	pending, err := beacon.Task(&sliverpb.Envelope{Type: sliverpb.MsgLsReq})
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending.Description = "LsReq"
+	err = db.Session().Create(pending).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	killTask, err := queueKill(beacon)
+	if err != nil {
+		t.Fatalf("failed to queue kill task: %s", err)
+	}
+	if killTask.Description != killDescription || killTask.State != models.PENDING || killTask.Priority <= pending.Priority {
+		t.Fatalf("unexpected kill task %v", killTask)
+	}
+	again, err := queueKill(beacon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != killTask.ID {
+		t.Fatal("queued a second kill task")
+	}
+
+	tasks, err := db.BeaconTasksByBeaconID(beacon.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(tasks))
+	}
+	for _, task := range tasks {
+		if task.ID == pending.ID && task.State != models.CANCELED {
+			t.Fatalf("pending task was not canceled (%s)", task.State)
+		}
+	}
+}
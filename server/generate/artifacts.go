@@ -0,0 +1,162 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"debug/pe"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/storage"
+)
+
+var (
+	artifactNameExp = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+	// ErrInvalidArtifactName - Artifact names are used with other commands' flags
+	ErrInvalidArtifactName = errors.New("invalid artifact name, names may only contain a-z, A-Z, 0-9, '_', '.' and '-'")
+	// ErrArtifactExists - Artifacts are not replaced, remove the old one first
+	ErrArtifactExists = errors.New("an artifact with this name already exists")
+	// ErrArtifactNotFound - No artifact with the name or its shellcode is missing
+	ErrArtifactNotFound = errors.New("artifact not found")
+	// ErrNotDotNetAssembly - The PE doesn't have a CLR header
+	ErrNotDotNetAssembly = errors.New("file is not a .NET assembly")
+)
+
+func getArtifactsStore() (storage.Store, error) {
+	return storage.New(storage.Artifacts)
+}
+
+// ShellcodeArtifactFromAssembly - Convert a .NET assembly to shellcode with donut
+// and save it as an artifact that can be staged or executed later
+func ShellcodeArtifactFromAssembly(req *clientpb.ShellcodeArtifactReq, operator string) (*models.ShellcodeArtifact, error) {
+	if !artifactNameExp.MatchString(req.Name) {
+		return nil, ErrInvalidArtifactName
+	}
+	if _, err := db.ShellcodeArtifactByName(req.Name); err == nil {
+		return nil, ErrArtifactExists
+	}
+	isDLL, err := dotNetAssemblyIsDLL(req.Assembly)
+	if err != nil {
+		return nil, err
+	}
+	arch := strings.ToLower(req.Arch)
+	if arch == "" {
+		arch = "amd64"
+	}
+	runtime := req.Runtime
+	if runtime == "" {
+		runtime = defaultDotNetRuntime
+	}
+	shellcode, err := donutFromAssembly(req.Assembly, isDLL, arch, req.Arguments, req.Method, req.ClassName, req.AppDomain, runtime)
+	if err != nil {
+		return nil, err
+	}
+	_, _, sha256Hash := computeHashes(shellcode)
+	artifact := &models.ShellcodeArtifact{
+		Name:      req.Name,
+		FileName:  req.FileName,
+		Arch:      arch,
+		IsDLL:     isDLL,
+		Arguments: req.Arguments,
+		Runtime:   runtime,
+		Size:      len(shellcode),
+		SHA256:    sha256Hash,
+		Operator:  operator,
+	}
+	store, err := getArtifactsStore()
+	if err != nil {
+		return nil, err
+	}
+	err = db.Session().Create(artifact).Error
+	if err != nil {
+		return nil, err
+	}
+	err = store.Put(artifact.ID.String(), shellcode)
+	if err != nil {
+		db.Session().Delete(artifact)
+		return nil, err
+	}
+	storageLog.Infof("%s -> artifact %s", artifact.ID, artifact.Name)
+	return artifact, nil
+}
+
+// ShellcodeArtifactData - The shellcode of a saved artifact
+func ShellcodeArtifactData(name string) (*models.ShellcodeArtifact, []byte, error) {
+	artifact, err := db.ShellcodeArtifactByName(name)
+	if err != nil {
+		return nil, nil, ErrArtifactNotFound
+	}
+	store, err := getArtifactsStore()
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := store.Get(artifact.ID.String())
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return nil, nil, ErrArtifactNotFound
+	}
+	return artifact, data, err
+}
+
+// ShellcodeArtifactDelete - Remove an artifact and its shellcode
+func ShellcodeArtifactDelete(name string) error {
+	artifact, err := db.ShellcodeArtifactByName(name)
+	if err != nil {
+		return ErrArtifactNotFound
+	}
+	store, err := getArtifactsStore()
+	if err != nil {
+		return err
+	}
+	err = store.Delete(artifact.ID.String())
+	if err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+		return err
+	}
+	return db.Session().Delete(artifact).Error
+}
+
+// dotNetAssemblyIsDLL - Check the PE has a CLR header, donut needs to know if
+// it's loading a library or running an executable's entrypoint
+func dotNetAssemblyIsDLL(assembly []byte) (bool, error) {
+	peFile, err := pe.NewFile(bytes.NewReader(assembly))
+	if err != nil {
+		return false, ErrNotDotNetAssembly
+	}
+	defer peFile.Close()
+	var clrHeader pe.DataDirectory
+	switch header := peFile.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		if pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR < len(header.DataDirectory) {
+			clrHeader = header.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR]
+		}
+	case *pe.OptionalHeader64:
+		if pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR < len(header.DataDirectory) {
+			clrHeader = header.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR]
+		}
+	}
+	if clrHeader.VirtualAddress == 0 || clrHeader.Size == 0 {
+		return false, ErrNotDotNetAssembly
+	}
+	return peFile.Characteristics&pe.IMAGE_FILE_DLL != 0, nil
+}
@@ -0,0 +1,64 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"debug/pe"
+	"encoding/binary"
+	"testing"
+)
+
+// minimalPE - A PE64 header without sections, with or without a CLR header
+func minimalPE(characteristics uint16, clrHeader bool) []byte {
+	buf := &bytes.Buffer{}
+	dosHeader := make([]byte, 0x40)
+	copy(dosHeader, "MZ")
+	binary.LittleEndian.PutUint32(dosHeader[0x3c:], 0x40)
+	buf.Write(dosHeader)
+	buf.WriteString("PE\x00\x00")
+	optionalHeader := pe.OptionalHeader64{Magic: 0x20b, NumberOfRvaAndSizes: 16}
+	if clrHeader {
+		optionalHeader.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR] = pe.DataDirectory{VirtualAddress: 0x2000, Size: 0x48}
+	}
+	binary.Write(buf, binary.LittleEndian, pe.FileHeader{
+		Machine:              pe.IMAGE_FILE_MACHINE_AMD64,
+		SizeOfOptionalHeader: uint16(binary.Size(optionalHeader)),
+		Characteristics:      characteristics,
+	})
+	binary.Write(buf, binary.LittleEndian, optionalHeader)
+	return buf.Bytes()
+}
+
+func TestDotNetAssemblyIsDLL(t *testing.T) {
+	isDLL, err := dotNetAssemblyIsDLL(minimalPE(pe.IMAGE_FILE_EXECUTABLE_IMAGE, true))
+	if err != nil || isDLL {
+		t.Fatalf("expected an executable assembly, got dll=%v (%v)", isDLL, err)
+	}
+	isDLL, err = dotNetAssemblyIsDLL(minimalPE(pe.IMAGE_FILE_EXECUTABLE_IMAGE|pe.IMAGE_FILE_DLL, true))
+	if err != nil || !isDLL {
+		t.Fatalf("expected a dll assembly, got dll=%v (%v)", isDLL, err)
+	}
+	if _, err = dotNetAssemblyIsDLL(minimalPE(pe.IMAGE_FILE_EXECUTABLE_IMAGE, false)); err != ErrNotDotNetAssembly {
+		t.Fatalf("native pe was accepted as an assembly: %v", err)
+	}
+	if _, err = dotNetAssemblyIsDLL([]byte("not a pe")); err != ErrNotDotNetAssembly {
+		t.Fatalf("garbage was accepted as an assembly: %v", err)
+	}
+}
@@ -20,10 +20,15 @@ package generate
 
 import (
 	"bytes"
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io/fs"
+	insecureRand "math/rand"
+	"net"
 	"net/url"
 	"os"
 	"path"
@@ -32,6 +37,7 @@ import (
 	"strings"
 	"text/template"
 
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/implant"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/assets"
@@ -42,17 +48,26 @@ import (
 	"github.com/bishopfox/sliver/server/gogo"
 	"github.com/bishopfox/sliver/server/log"
 	"github.com/bishopfox/sliver/util"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
 	buildLog = log.NamedLogger("generate", "build")
 
+	// ErrObfuscationSeedTooShort - Garble needs at least 8 bytes of seed
+	ErrObfuscationSeedTooShort = errors.New("obfuscation seed must be at least 8 bytes")
+
+	// ErrInvalidResolverNet - A dns resolver allow/deny entry isn't an ip or cidr
+	ErrInvalidResolverNet = errors.New("dns resolver entries must be ip addresses or cidrs")
+
 	// RUNTIME GOOS -> TARGET GOOS -> TARGET ARCH
 	defaultCCPaths = map[string]map[string]map[string]string{
 		"linux": {
 			"windows": {
 				"386":   "/usr/bin/i686-w64-mingw32-gcc",
 				"amd64": "/usr/bin/x86_64-w64-mingw32-gcc",
+				// LLVM MinGW - https://github.com/mstorsjo/llvm-mingw
+				"arm64": "/opt/llvm-mingw/bin/aarch64-w64-mingw32-clang",
 			},
 			"darwin": {
 				// OSX Cross - https://github.com/tpoechtrager/osxcross
@@ -64,6 +79,7 @@ var (
 			"windows": {
 				"386":   "/opt/homebrew/bin/i686-w64-mingw32-gcc",
 				"amd64": "/opt/homebrew/bin/x86_64-w64-mingw32-gcc",
+				"arm64": "/opt/llvm-mingw/bin/aarch64-w64-mingw32-clang",
 			},
 			"linux": {
 				// brew install FiloSottile/musl-cross/musl-cross
@@ -80,12 +96,15 @@ var (
 		"linux/amd64":   true,
 		"windows/386":   true,
 		"windows/amd64": true,
+		"windows/arm64": true,
 	}
 )
 
 const (
 	SliverTemplateName = "sliver"
 
+	obfuscationSeedSize = 16
+
 	// WINDOWS OS
 	WINDOWS = "windows"
 
@@ -107,6 +126,9 @@ const (
 	// DefaultPollInterval - In seconds
 	DefaultPollInterval = 1
 
+	// HarnessC2URL - The c2 of debug harness builds, see implant/sliver/harness
+	HarnessC2URL = "loopback://harness"
+
 	// DefaultSuffix - Indicates a platform independent src file
 	DefaultSuffix = "_default.go"
 
@@ -122,6 +144,11 @@ const (
 	// SliverCXX32EnvVar - Environment variable that can specify the 32 bit mingw path
 	SliverCXX32EnvVar = "SLIVER_CXX_32"
 
+	// SliverCCARM64EnvVar - Environment variable that can specify the arm64 (llvm-)mingw path
+	SliverCCARM64EnvVar = "SLIVER_CC_ARM64"
+	// SliverCXXARM64EnvVar - Environment variable that can specify the arm64 (llvm-)mingw path
+	SliverCXXARM64EnvVar = "SLIVER_CXX_ARM64"
+
 	// *** Platform Specific ***
 
 	// SliverPlatformCC64EnvVar - Environment variable that can specify the 64 bit mingw path
@@ -132,11 +159,42 @@ const (
 	SliverPlatformCXX64EnvVar = "SLIVER_%s_CXX_64"
 	// SliverPlatformCXX32EnvVar - Environment variable that can specify the 32 bit mingw path
 	SliverPlatformCXX32EnvVar = "SLIVER_%s_CXX_32"
+	// SliverPlatformCCARM64EnvVar - Environment variable that can specify the arm64 cc path
+	SliverPlatformCCARM64EnvVar = "SLIVER_%s_CC_ARM64"
+	// SliverPlatformCXXARM64EnvVar - Environment variable that can specify the arm64 cxx path
+	SliverPlatformCXXARM64EnvVar = "SLIVER_%s_CXX_ARM64"
 )
 
+// harnessConfig - Debug harness builds are debug session executables whose only c2
+// is the harness console in the same process
+func harnessConfig(pbConfig *clientpb.ImplantConfig) *clientpb.ImplantConfig {
+	harness := proto.Clone(pbConfig).(*clientpb.ImplantConfig)
+	harness.IsBeacon = false
+	harness.Debug = true
+	harness.ObfuscateSymbols = false
+	harness.Evasion = false
+	harness.C2 = []*clientpb.ImplantC2{{Priority: 0, URL: HarnessC2URL}}
+	harness.ConnectFallbackOrder = ""
+	harness.FailoverOrder = ""
+	harness.Format = clientpb.OutputFormat_EXECUTABLE
+	harness.IsSharedLib = false
+	harness.IsService = false
+	harness.IsShellcode = false
+	harness.Watchdog = false
+	harness.KeyDomain = ""
+	harness.KeyHostname = ""
+	harness.KeyCIDR = ""
+	harness.KeyFile = ""
+	return harness
+}
+
 // ImplantConfigFromProtobuf - Create a native config struct from Protobuf
 func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *models.ImplantConfig) {
+	if pbConfig.IsHarness {
+		pbConfig = harnessConfig(pbConfig)
+	}
 	cfg := &models.ImplantConfig{}
+	cfg.IsHarness = pbConfig.IsHarness
 
 	cfg.IsBeacon = pbConfig.IsBeacon
 	cfg.BeaconInterval = pbConfig.BeaconInterval
@@ -155,6 +213,28 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 	cfg.DebugFile = pbConfig.DebugFile
 	cfg.Evasion = pbConfig.Evasion
 	cfg.ObfuscateSymbols = pbConfig.ObfuscateSymbols
+	if cfg.ObfuscateSymbols {
+		cfg.ObfuscateSkipLiterals = pbConfig.ObfuscateSkipLiterals
+		cfg.ObfuscateSkipTiny = pbConfig.ObfuscateSkipTiny
+		// Random seeds are picked per build, so profiles only keep an explicit one
+		if pbConfig.ObfuscationSeed != "" {
+			seed, err := obfuscationSeed(pbConfig.ObfuscationSeed)
+			if err != nil {
+				buildLog.Warnf("Invalid obfuscation seed '%s' (%s), using a random seed", pbConfig.ObfuscationSeed, err)
+			}
+			cfg.ObfuscationSeed = seed
+		}
+	}
+	cfg.QuietCheckin = pbConfig.QuietCheckin
+	if pbConfig.SleepMask != "" {
+		if !IsSleepMask(pbConfig.SleepMask) {
+			buildLog.Warnf("Unknown sleep mask '%s', ignoring", pbConfig.SleepMask)
+		} else if !pbConfig.IsBeacon || pbConfig.GOOS != "windows" {
+			buildLog.Warnf("Sleep masks are only supported by windows beacons, ignoring")
+		} else {
+			cfg.SleepMask = pbConfig.SleepMask
+		}
+	}
 	cfg.TemplateName = pbConfig.TemplateName
 	if cfg.TemplateName == "" {
 		cfg.TemplateName = SliverTemplateName
@@ -169,12 +249,42 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 	cfg.ReconnectInterval = pbConfig.ReconnectInterval
 	cfg.MaxConnectionErrors = pbConfig.MaxConnectionErrors
 
+	cfg.ConnectBackoff = pbConfig.ConnectBackoff
+	cfg.ConnectBackoffMax = pbConfig.ConnectBackoffMax
+	cfg.ConnectJitter = pbConfig.ConnectJitter
+	cfg.ConnectMaxAttempts = pbConfig.ConnectMaxAttempts
+	cfg.ConnectGiveUp = pbConfig.ConnectGiveUp
+	cfg.ConnectFallbackOrder = pbConfig.ConnectFallbackOrder
+	cfg.HTTPC2Profile = pbConfig.HTTPC2Profile
+	cfg.FailoverOrder = pbConfig.FailoverOrder
+	cfg.FailoverThreshold = pbConfig.FailoverThreshold
+	cfg.FailoverRetry = pbConfig.FailoverRetry
+	cfg.DNSResolverAllow = pbConfig.DNSResolverAllow
+	cfg.DNSResolverDeny = pbConfig.DNSResolverDeny
+
 	cfg.LimitDomainJoined = pbConfig.LimitDomainJoined
 	cfg.LimitDatetime = pbConfig.LimitDatetime
 	cfg.LimitUsername = pbConfig.LimitUsername
 	cfg.LimitHostname = pbConfig.LimitHostname
 	cfg.LimitFileExists = pbConfig.LimitFileExists
 	cfg.LimitLocale = pbConfig.LimitLocale
+	cfg.WorkingHours = pbConfig.WorkingHours
+	cfg.KillDate = pbConfig.KillDate
+
+	cfg.KeyDomain = strings.TrimSpace(pbConfig.KeyDomain)
+	if _, err := keyHostnameRegexp(pbConfig.KeyHostname); err == nil {
+		cfg.KeyHostname = pbConfig.KeyHostname
+	} else {
+		buildLog.Warnf("Invalid hostname key regex '%s', ignoring: %s", pbConfig.KeyHostname, err)
+	}
+	if pbConfig.KeyCIDR != "" {
+		if _, _, err := net.ParseCIDR(pbConfig.KeyCIDR); err == nil {
+			cfg.KeyCIDR = pbConfig.KeyCIDR
+		} else {
+			buildLog.Warnf("Invalid cidr key '%s', ignoring: %s", pbConfig.KeyCIDR, err)
+		}
+	}
+	cfg.KeyFile = strings.TrimSpace(pbConfig.KeyFile)
 
 	cfg.Format = pbConfig.Format
 	cfg.IsSharedLib = pbConfig.IsSharedLib
@@ -183,6 +293,89 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 
 	cfg.RunAtLoad = pbConfig.RunAtLoad
 
+	cfg.BatchName = pbConfig.BatchName
+	cfg.BatchTarget = pbConfig.BatchTarget
+
+	cfg.IndicatorThreshold = pbConfig.IndicatorThreshold
+
+	if pbConfig.Watchdog {
+		if cfg.IsSharedLib || cfg.IsShellcode || cfg.IsService {
+			buildLog.Warnf("Watchdog is only supported by executables, ignoring")
+		} else {
+			cfg.Watchdog = true
+			cfg.WatchdogDelay = pbConfig.WatchdogDelay
+			cfg.WatchdogEnv = watchdogEnvName()
+		}
+	}
+
+	cfg.StartupSnapshot = pbConfig.StartupSnapshot
+
+	if pbConfig.InjectionTechnique != "" {
+		if IsInjectionTechnique(pbConfig.InjectionTechnique) {
+			cfg.InjectionTechnique = pbConfig.InjectionTechnique
+		} else {
+			buildLog.Warnf("Unknown injection technique '%s', ignoring", pbConfig.InjectionTechnique)
+		}
+	}
+
+	cfg.AmsiBypass = pbConfig.AmsiBypass
+	cfg.EtwBypass = pbConfig.EtwBypass
+	if pbConfig.BypassTechnique != "" {
+		if IsBypassTechnique(pbConfig.BypassTechnique) {
+			cfg.BypassTechnique = pbConfig.BypassTechnique
+		} else {
+			buildLog.Warnf("Unknown bypass technique '%s', ignoring", pbConfig.BypassTechnique)
+		}
+	}
+	if pbConfig.SyscallBackend != "" {
+		if !IsSyscallBackend(pbConfig.SyscallBackend) {
+			buildLog.Warnf("Unknown syscall backend '%s', ignoring", pbConfig.SyscallBackend)
+		} else if pbConfig.GOOS != "windows" || pbConfig.GOARCH != "amd64" {
+			buildLog.Warnf("Syscall backends are only supported by windows/amd64 implants, ignoring")
+		} else {
+			cfg.SyscallBackend = pbConfig.SyscallBackend
+		}
+	}
+	if pbConfig.ShellcodeLoader != "" {
+		if IsShellcodeLoader(pbConfig.ShellcodeLoader) {
+			cfg.ShellcodeLoader = pbConfig.ShellcodeLoader
+		} else {
+			buildLog.Warnf("Unknown shellcode loader '%s', ignoring", pbConfig.ShellcodeLoader)
+		}
+	}
+	if cfg.ShellcodeLoader == consts.ShellcodeSRDI {
+		cfg.SRDIFunction = consts.SRDIExports[0]
+		if pbConfig.SRDIFunction != "" {
+			if IsSRDIExport(pbConfig.SRDIFunction) {
+				cfg.SRDIFunction = pbConfig.SRDIFunction
+			} else {
+				buildLog.Warnf("Unknown implant export '%s', using %s", pbConfig.SRDIFunction, cfg.SRDIFunction)
+			}
+		}
+		cfg.SRDIKeepHeader = pbConfig.SRDIKeepHeader
+		cfg.SRDIObfuscateImports = pbConfig.SRDIObfuscateImports
+		cfg.SRDIImportDelay = pbConfig.SRDIImportDelay
+		if pbConfig.SRDIHashKey <= 31 {
+			cfg.SRDIHashKey = pbConfig.SRDIHashKey
+		} else {
+			buildLog.Warnf("Invalid srdi hash key %d, ignoring", pbConfig.SRDIHashKey)
+		}
+	}
+	cfg.ShellcodePrepend = pbConfig.ShellcodePrepend
+	cfg.ShellcodeAppend = pbConfig.ShellcodeAppend
+	if 0 < len(pbConfig.ProxyDLL) {
+		if pbConfig.ProxyTarget == "" {
+			buildLog.Warnf("Export proxy has no target to forward to, ignoring")
+		} else if cfg.IsSharedLib && pbConfig.GOOS == "windows" {
+			cfg.ProxyDLL = pbConfig.ProxyDLL
+			cfg.ProxyTarget = pbConfig.ProxyTarget
+			// The implant's own exports are replaced by the forwarders
+			cfg.RunAtLoad = true
+		} else {
+			buildLog.Warnf("Export proxies are only supported by windows dlls, ignoring")
+		}
+	}
+
 	cfg.CanaryDomains = []models.CanaryDomain{}
 	for _, pbCanary := range pbConfig.CanaryDomains {
 		cfg.CanaryDomains = append(cfg.CanaryDomains, models.CanaryDomain{
@@ -196,6 +389,8 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 	cfg.WGc2Enabled = isC2Enabled([]string{"wg"}, cfg.C2)
 	cfg.HTTPc2Enabled = isC2Enabled([]string{"http", "https"}, cfg.C2)
 	cfg.DNSc2Enabled = isC2Enabled([]string{"dns"}, cfg.C2)
+	cfg.ICMPc2Enabled = isC2Enabled([]string{"icmp"}, cfg.C2)
+	cfg.UDPc2Enabled = isC2Enabled([]string{"udp"}, cfg.C2)
 	cfg.NamePipec2Enabled = isC2Enabled([]string{"namedpipe"}, cfg.C2)
 	cfg.TCPPivotc2Enabled = isC2Enabled([]string{"tcppivot"}, cfg.C2)
 
@@ -212,6 +407,76 @@ func ImplantConfigFromProtobuf(pbConfig *clientpb.ImplantConfig) (string, *model
 	return name, cfg
 }
 
+// watchdogEnvName - A random env var name so the watchdog's role isn't a static indicator
+func watchdogEnvName() string {
+	const charSet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	name := make([]byte, 4+insecureRand.Intn(5))
+	for index := range name {
+		name[index] = charSet[insecureRand.Intn(len(charSet))]
+	}
+	return string(name)
+}
+
+// IsSleepMask - Is the name a sleep mask beacons support
+func IsSleepMask(name string) bool {
+	for _, sleepMask := range consts.SleepMasks {
+		if name == sleepMask {
+			return true
+		}
+	}
+	return false
+}
+
+// IsInjectionTechnique - Is the name a process injection technique implants support
+func IsInjectionTechnique(name string) bool {
+	for _, technique := range consts.InjectionTechniques {
+		if name == technique {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBypassTechnique - Is the name an AMSI and ETW bypass technique implants support
+func IsBypassTechnique(name string) bool {
+	for _, technique := range consts.BypassTechniques {
+		if name == technique {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSyscallBackend - Is the name a syscall backend implants support
+func IsSyscallBackend(name string) bool {
+	for _, backend := range consts.SyscallBackends {
+		if name == backend {
+			return true
+		}
+	}
+	return false
+}
+
+// IsShellcodeLoader - Is the name a loader implants can be converted with
+func IsShellcodeLoader(name string) bool {
+	for _, loader := range consts.ShellcodeLoaders {
+		if loader == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSRDIExport - Is the name an implant export sRDI can call
+func IsSRDIExport(name string) bool {
+	for _, export := range consts.SRDIExports {
+		if export == name {
+			return true
+		}
+	}
+	return false
+}
+
 func copyC2List(src []*clientpb.ImplantC2) []models.ImplantC2 {
 	c2s := []models.ImplantC2{}
 	for _, srcC2 := range src {
@@ -264,11 +529,20 @@ func GetSliversDir() string {
 // Sliver Generation Code
 // -----------------------
 
-// SliverShellcode - Generates a sliver shellcode using Donut
+// SliverShellcode - Generates a sliver shellcode using Donut, or sRDI
 func SliverShellcode(name string, otpSecret string, config *models.ImplantConfig, save bool) (string, error) {
 	if config.GOOS != "windows" {
 		return "", fmt.Errorf("shellcode format is currently only supported on Windows")
 	}
+	if !IsShellcodeArch(config.GOARCH) {
+		return "", ErrUnsupportedShellcodeArch
+	}
+	if err := buildObfuscationSeed(config); err != nil {
+		return "", err
+	}
+	if config.ShellcodeLoader == consts.ShellcodeSRDI {
+		return sliverSRDIShellcode(name, otpSecret, config, save)
+	}
 	appDir := assets.GetRootAppDir()
 	goConfig := &gogo.GoConfig{
 		CGO: "0",
@@ -282,8 +556,11 @@ func SliverShellcode(name string, otpSecret string, config *models.ImplantConfig
 		HTTPPROXY:  getGoHttpProxy(),
 		HTTPSPROXY: getGoHttpsProxy(),
 
-		Obfuscation: config.ObfuscateSymbols,
-		GOGARBLE:    goPrivate(config),
+		Obfuscation:    config.ObfuscateSymbols,
+		GOGARBLE:       goPrivate(config),
+		GarbleLiterals: !config.ObfuscateSkipLiterals,
+		GarbleTiny:     !config.ObfuscateSkipTiny,
+		GarbleSeed:     config.ObfuscationSeed,
 	}
 	pkgPath, err := renderSliverGoCode(name, otpSecret, config, goConfig)
 	if err != nil {
@@ -307,11 +584,16 @@ func SliverShellcode(name string, otpSecret string, config *models.ImplantConfig
 	if err != nil {
 		return "", err
 	}
+	err = buildIndicators(dest, config, goConfig)
+	if err != nil {
+		return "", err
+	}
 	config.FileName = path.Base(dest)
 	shellcode, err := DonutShellcodeFromFile(dest, config.GOARCH, false, "", "", "")
 	if err != nil {
 		return "", err
 	}
+	shellcode = wrapShellcode(shellcode, config.ShellcodePrepend, config.ShellcodeAppend)
 	err = os.WriteFile(dest, shellcode, 0600)
 	if err != nil {
 		return "", err
@@ -328,8 +610,51 @@ func SliverShellcode(name string, otpSecret string, config *models.ImplantConfig
 
 }
 
+// sliverSRDIShellcode - Builds the implant as a dll and converts it with sRDI, which
+// calls the export once the dll is loaded
+func sliverSRDIShellcode(name string, otpSecret string, config *models.ImplantConfig, save bool) (string, error) {
+	config.IsSharedLib = true
+	dll, err := SliverSharedLibrary(name, otpSecret, config, false)
+	config.IsSharedLib = false
+	if err != nil {
+		return "", err
+	}
+	dllData, err := os.ReadFile(dll)
+	if err != nil {
+		return "", err
+	}
+	shellcode, err := ShellcodeRDIWithOptions(dllData, SRDIOptions{
+		FunctionName:     config.SRDIFunction,
+		KeepHeader:       config.SRDIKeepHeader,
+		ObfuscateImports: config.SRDIObfuscateImports,
+		ImportDelay:      config.SRDIImportDelay,
+		HashKey:          config.SRDIHashKey,
+	})
+	if err != nil {
+		return "", err
+	}
+	shellcode = wrapShellcode(shellcode, config.ShellcodePrepend, config.ShellcodeAppend)
+	dest := strings.TrimSuffix(dll, filepath.Ext(dll)) + ".bin"
+	err = os.WriteFile(dest, shellcode, 0600)
+	if err != nil {
+		return "", err
+	}
+	config.FileName = filepath.Base(dest)
+	config.Format = clientpb.OutputFormat_SHELLCODE
+	if save {
+		saveBuildErr := ImplantBuildSave(name, config, dest)
+		if saveBuildErr != nil {
+			buildLog.Errorf("Failed to save build: %s", saveBuildErr)
+		}
+	}
+	return dest, nil
+}
+
 // SliverSharedLibrary - Generates a sliver shared library (DLL/dylib/so) binary
 func SliverSharedLibrary(name string, otpSecret string, config *models.ImplantConfig, save bool) (string, error) {
+	if err := buildObfuscationSeed(config); err != nil {
+		return "", err
+	}
 	// Compile go code
 	var cc string
 	var cxx string
@@ -358,8 +683,11 @@ func SliverSharedLibrary(name string, otpSecret string, config *models.ImplantCo
 		HTTPPROXY:  getGoHttpProxy(),
 		HTTPSPROXY: getGoHttpsProxy(),
 
-		Obfuscation: config.ObfuscateSymbols,
-		GOGARBLE:    goPrivate(config),
+		Obfuscation:    config.ObfuscateSymbols,
+		GOGARBLE:       goPrivate(config),
+		GarbleLiterals: !config.ObfuscateSkipLiterals,
+		GarbleTiny:     !config.ObfuscateSkipTiny,
+		GarbleSeed:     config.ObfuscationSeed,
 	}
 	pkgPath, err := renderSliverGoCode(name, otpSecret, config, goConfig)
 	if err != nil {
@@ -395,6 +723,16 @@ func SliverSharedLibrary(name string, otpSecret string, config *models.ImplantCo
 	if err != nil {
 		return "", err
 	}
+	if 0 < len(config.ProxyDLL) {
+		err = proxyExports(dest, config.ProxyDLL, config.ProxyTarget)
+		if err != nil {
+			return "", err
+		}
+	}
+	err = buildIndicators(dest, config, goConfig)
+	if err != nil {
+		return "", err
+	}
 	config.FileName = filepath.Base(dest)
 
 	if save {
@@ -408,6 +746,9 @@ func SliverSharedLibrary(name string, otpSecret string, config *models.ImplantCo
 
 // SliverExecutable - Generates a sliver executable binary
 func SliverExecutable(name string, otpSecret string, config *models.ImplantConfig, save bool) (string, error) {
+	if err := buildObfuscationSeed(config); err != nil {
+		return "", err
+	}
 	// Compile go code
 	appDir := assets.GetRootAppDir()
 	cgo := "0"
@@ -426,8 +767,11 @@ func SliverExecutable(name string, otpSecret string, config *models.ImplantConfi
 		HTTPPROXY:  getGoHttpProxy(),
 		HTTPSPROXY: getGoHttpsProxy(),
 
-		Obfuscation: config.ObfuscateSymbols,
-		GOGARBLE:    goPrivate(config),
+		Obfuscation:    config.ObfuscateSymbols,
+		GOGARBLE:       goPrivate(config),
+		GarbleLiterals: !config.ObfuscateSkipLiterals,
+		GarbleTiny:     !config.ObfuscateSkipTiny,
+		GarbleSeed:     config.ObfuscationSeed,
 	}
 
 	pkgPath, err := renderSliverGoCode(name, otpSecret, config, goConfig)
@@ -459,6 +803,10 @@ func SliverExecutable(name string, otpSecret string, config *models.ImplantConfi
 	if err != nil {
 		return "", err
 	}
+	err = buildIndicators(dest, config, goConfig)
+	if err != nil {
+		return "", err
+	}
 	config.FileName = filepath.Base(dest)
 	if save {
 		err = ImplantBuildSave(name, config, dest)
@@ -481,6 +829,16 @@ func renderSliverGoCode(name string, otpSecret string, config *models.ImplantCon
 
 	buildLog.Debugf("Generating new sliver binary '%s'", name)
 
+	// Configs from external builders haven't been through GenerateConfig here
+	if err := resolverNetsConfig(config); err != nil {
+		return "", err
+	}
+
+	httpC2Config, err := configs.GetHTTPC2Profile(config.HTTPC2Profile)
+	if err != nil {
+		return "", err
+	}
+
 	sliversDir := GetSliversDir() // ~/.sliver/slivers
 	projectGoPathDir := filepath.Join(sliversDir, config.GOOS, config.GOARCH, filepath.Base(name))
 	if _, err := os.Stat(projectGoPathDir); os.IsNotExist(err) {
@@ -495,7 +853,7 @@ func renderSliverGoCode(name string, otpSecret string, config *models.ImplantCon
 	// srcDir - ~/.sliver/slivers/<os>/<arch>/<name>/src
 	srcDir := filepath.Join(projectGoPathDir, "src")
 	assets.SetupGoPath(srcDir)             // Extract GOPATH dependency files
-	err := util.ChmodR(srcDir, 0600, 0700) // Ensures src code files are writable
+	err = util.ChmodR(srcDir, 0600, 0700) // Ensures src code files are writable
 	if err != nil {
 		buildLog.Errorf("fs perms: %v", err)
 		return "", err
@@ -554,7 +912,7 @@ func renderSliverGoCode(name string, otpSecret string, config *models.ImplantCon
 		sliverCodeTmpl := template.New("sliver")
 		sliverCodeTmpl, err = sliverCodeTmpl.Funcs(template.FuncMap{
 			"GenerateUserAgent": func() string {
-				return configs.GetHTTPC2Config().GenerateUserAgent(config.GOOS, config.GOARCH)
+				return httpC2Config.GenerateUserAgent(config.GOOS, config.GOARCH)
 			},
 		}).Parse(sliverGoCode)
 		if err != nil {
@@ -570,7 +928,7 @@ func renderSliverGoCode(name string, otpSecret string, config *models.ImplantCon
 			name,
 			config,
 			otpSecret,
-			configs.GetHTTPC2Config().RandomImplantConfig(),
+			httpC2Config.RandomImplantConfig(),
 		})
 		if err != nil {
 			buildLog.Errorf("Template execution error %s", err)
@@ -650,9 +1008,20 @@ func GenerateConfig(name string, config *models.ImplantConfig, save bool) error
 	config.WGc2Enabled = isC2Enabled([]string{"wg"}, config.C2)
 	config.HTTPc2Enabled = isC2Enabled([]string{"http", "https"}, config.C2)
 	config.DNSc2Enabled = isC2Enabled([]string{"dns"}, config.C2)
+	config.ICMPc2Enabled = isC2Enabled([]string{"icmp"}, config.C2)
+	config.UDPc2Enabled = isC2Enabled([]string{"udp"}, config.C2)
 	config.NamePipec2Enabled = isC2Enabled([]string{"namedpipe"}, config.C2)
 	config.TCPPivotc2Enabled = isC2Enabled([]string{"tcppivot"}, config.C2)
 
+	err = resolverNetsConfig(config)
+	if err != nil {
+		return err
+	}
+	err = envKeyConfig(config)
+	if err != nil {
+		return err
+	}
+
 	// Cert PEM encoded certificates
 	serverCACert, _, _ := certs.GetCertificateAuthorityPEM(certs.MtlsServerCA)
 	sliverCert, sliverKey, err := certs.MtlsC2ImplantGenerateECCCertificate(name)
@@ -736,6 +1105,16 @@ func getCrossCompilersFromEnv(targetGoos string, targetGoarch string) (string, s
 			cc = os.Getenv(fmt.Sprintf(SliverPlatformCXX32EnvVar, TARGET_GOOS))
 		}
 	}
+	if targetGoarch == "arm64" {
+		cc = os.Getenv(fmt.Sprintf(SliverPlatformCCARM64EnvVar, TARGET_GOOS))
+		if cc == "" {
+			cc = os.Getenv(SliverCCARM64EnvVar)
+		}
+		cxx = os.Getenv(fmt.Sprintf(SliverPlatformCXXARM64EnvVar, TARGET_GOOS))
+		if cxx == "" {
+			cxx = os.Getenv(SliverCXXARM64EnvVar)
+		}
+	}
 	return cc, cxx
 }
 
@@ -836,7 +1215,7 @@ func GetCompilerTargets() []*clientpb.CompilerTarget {
 	// SHELLCODE - Can generate shellcode for Windows targets only
 	for longPlatform := range SupportedCompilerTargets {
 		platform := strings.SplitN(longPlatform, "/", 2)
-		if platform[0] != WINDOWS {
+		if platform[0] != WINDOWS || !IsShellcodeArch(platform[1]) {
 			continue
 		}
 
@@ -952,3 +1331,73 @@ func goPrivate(config *models.ImplantConfig) string {
 	// }
 	return allGoPrivate
 }
+
+// obfuscationSeed - Validate a garble seed (base64, at least 8 bytes), an empty
+// seed is replaced with a random one so it can be saved with the build
+func obfuscationSeed(seed string) (string, error) {
+	if seed == "" {
+		data := make([]byte, obfuscationSeedSize)
+		if _, err := rand.Read(data); err != nil {
+			return "", err
+		}
+		return base64.RawStdEncoding.EncodeToString(data), nil
+	}
+	data, err := base64.RawStdEncoding.DecodeString(strings.TrimRight(seed, "="))
+	if err != nil {
+		return "", err
+	}
+	if len(data) < 8 {
+		return "", ErrObfuscationSeedTooShort
+	}
+	return seed, nil
+}
+
+// resolverNetsConfig - Validate the dns resolver allow/deny lists, they're rendered
+// into the implant as raw strings so anything but ips and cidrs is rejected
+func resolverNetsConfig(config *models.ImplantConfig) error {
+	allow, err := resolverNets(config.DNSResolverAllow)
+	if err != nil {
+		return fmt.Errorf("invalid dns allow resolvers: %w", err)
+	}
+	deny, err := resolverNets(config.DNSResolverDeny)
+	if err != nil {
+		return fmt.Errorf("invalid dns deny resolvers: %w", err)
+	}
+	config.DNSResolverAllow = allow
+	config.DNSResolverDeny = deny
+	return nil
+}
+
+// resolverNets - Normalize a comma separated list of resolver ips and cidrs
+func resolverNets(value string) (string, error) {
+	resolverNets := []string{}
+	for _, resolverNet := range strings.Split(value, ",") {
+		resolverNet = strings.TrimSpace(resolverNet)
+		if resolverNet == "" {
+			continue
+		}
+		if strings.Contains(resolverNet, "/") {
+			if _, _, err := net.ParseCIDR(resolverNet); err != nil {
+				return "", fmt.Errorf("%w (%q)", ErrInvalidResolverNet, resolverNet)
+			}
+		} else if net.ParseIP(resolverNet) == nil {
+			return "", fmt.Errorf("%w (%q)", ErrInvalidResolverNet, resolverNet)
+		}
+		resolverNets = append(resolverNets, resolverNet)
+	}
+	return strings.Join(resolverNets, ","), nil
+}
+
+// buildObfuscationSeed - Pick a random seed for an obfuscated build that doesn't
+// have one, the config (and seed) is saved with the build
+func buildObfuscationSeed(config *models.ImplantConfig) error {
+	if !config.ObfuscateSymbols || config.ObfuscationSeed != "" {
+		return nil
+	}
+	seed, err := obfuscationSeed("")
+	if err != nil {
+		return err
+	}
+	config.ObfuscationSeed = seed
+	return nil
+}
@@ -19,6 +19,7 @@ package generate
 */
 
 import (
+	"errors"
 	"fmt"
 	"runtime"
 	"testing"
@@ -97,6 +98,35 @@ func TestSliverExecutableWindows(t *testing.T) {
 	// Service
 	multiWindowsService(t, "windows", "amd64", false, true)
 	multiWindowsService(t, "windows", "amd64", false, false)
+
+	// ARM64
+	mtlsExe(t, "windows", "arm64", false, false)
+	mtlsExe(t, "windows", "arm64", true, true)
+	multiExe(t, "windows", "arm64", false, false)
+	multiExe(t, "windows", "arm64", true, true)
+	namedPipeExe(t, "windows", "arm64", false)
+	multiWindowsService(t, "windows", "arm64", false, false)
+}
+
+func TestSliverShellcodeARM64(t *testing.T) {
+	config := &models.ImplantConfig{
+		GOOS:   "windows",
+		GOARCH: "arm64",
+		C2: []models.ImplantC2{
+			{URL: "mtls://1.example.com"},
+		},
+		MTLSc2Enabled: true,
+	}
+	nonce++
+	_, err := SliverShellcode(fmt.Sprintf("shellcode_test%d", nonce), otpTestSecret, config, false)
+	if err != ErrUnsupportedShellcodeArch {
+		t.Fatalf("expected unsupported shellcode arch error, got %v", err)
+	}
+	for _, target := range GetCompilerTargets() {
+		if target.GOARCH == "arm64" && target.Format == clientpb.OutputFormat_SHELLCODE {
+			t.Fatalf("arm64 shellcode listed as a compiler target")
+		}
+	}
 }
 
 func TestSliverSharedLibWindows(t *testing.T) {
@@ -416,3 +446,72 @@ func symbolObfuscation(t *testing.T, goos string, goarch string) {
 		t.Fatalf("%v", err)
 	}
 }
+
+func TestObfuscationSeed(t *testing.T) {
+	seed, err := obfuscationSeed("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, _ := obfuscationSeed("")
+	if seed == other {
+		t.Fatalf("random seeds should differ")
+	}
+	if valid, err := obfuscationSeed(seed); err != nil || valid != seed {
+		t.Fatalf("generated seed %q failed to validate: %v", seed, err)
+	}
+	if _, err := obfuscationSeed("7mH2k8X1Zq9b3VwTnO4yQw=="); err != nil {
+		t.Fatalf("padded seed failed to validate: %s", err)
+	}
+	if _, err := obfuscationSeed("c2hvcnQ"); err != ErrObfuscationSeedTooShort {
+		t.Fatalf("expected short seed error, got %v", err)
+	}
+	if _, err := obfuscationSeed("not base64!"); err == nil {
+		t.Fatalf("invalid seed was accepted")
+	}
+}
+
+func TestBuildObfuscationSeed(t *testing.T) {
+	_, config := ImplantConfigFromProtobuf(&clientpb.ImplantConfig{ObfuscateSymbols: true})
+	if config.ObfuscationSeed != "" {
+		t.Fatalf("profile config should not get a random seed")
+	}
+	if err := buildObfuscationSeed(config); err != nil {
+		t.Fatal(err)
+	}
+	first := config.ObfuscationSeed
+	if first == "" {
+		t.Fatalf("build did not get a seed")
+	}
+	if err := buildObfuscationSeed(config); err != nil || config.ObfuscationSeed != first {
+		t.Fatalf("build seed was replaced")
+	}
+	_, config = ImplantConfigFromProtobuf(&clientpb.ImplantConfig{ObfuscateSymbols: true})
+	buildObfuscationSeed(config)
+	if config.ObfuscationSeed == first {
+		t.Fatalf("builds from the same profile should get different seeds")
+	}
+	config = &models.ImplantConfig{}
+	buildObfuscationSeed(config)
+	if config.ObfuscationSeed != "" {
+		t.Fatalf("unobfuscated build got a seed")
+	}
+}
+
+func TestResolverNets(t *testing.T) {
+	value, err := resolverNets(" 1.1.1.1, 10.0.0.0/8,,2001:db8::/32 ")
+	if err != nil || value != "1.1.1.1,10.0.0.0/8,2001:db8::/32" {
+		t.Fatalf("valid resolvers were rejected: %q (%v)", value, err)
+	}
+	for _, invalid := range []string{"1.1.1.1,`+os.Exit(0)+`", "dns.example.com", "10.0.0.0/33"} {
+		if _, err := resolverNets(invalid); !errors.Is(err, ErrInvalidResolverNet) {
+			t.Errorf("expected %q to be rejected, got %v", invalid, err)
+		}
+	}
+	config := &models.ImplantConfig{DNSResolverAllow: "8.8.8.8", DNSResolverDeny: "`"}
+	if err := resolverNetsConfig(config); !errors.Is(err, ErrInvalidResolverNet) {
+		t.Fatalf("deny list with a backtick was accepted: %v", err)
+	}
+	if err := GenerateConfig("resolver-test", &models.ImplantConfig{DNSResolverDeny: "not-an-ip"}, false); !errors.Is(err, ErrInvalidResolverNet) {
+		t.Fatalf("generate accepted an invalid deny list: %v", err)
+	}
+}
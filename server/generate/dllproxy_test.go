@@ -0,0 +1,155 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Binject/debug/pe"
+)
+
+// exportingPE - A 64-bit dll with a .text section and an .rdata section, the
+// names are exported from .rdata followed by one export that's ordinal only
+func exportingPE(dllName string, names []string) []byte {
+	const (
+		fileAlignment = 0x200
+		textRVA       = 0x1000
+		rdataRVA      = 0x2000
+	)
+	rdata := &bytes.Buffer{}
+	if 0 < len(names) {
+		functions := uint32(len(names) + 1)
+		addressTable := uint32(rdataRVA + 40)
+		nameTable := addressTable + 4*functions
+		ordinalTable := nameTable + 4*uint32(len(names))
+		blob := &bytes.Buffer{}
+		stringsRVA := ordinalTable + 2*uint32(len(names))
+		nameRVA := stringsRVA
+		blob.WriteString(dllName + "\x00")
+		nameRVAs := []uint32{}
+		for _, name := range names {
+			nameRVAs = append(nameRVAs, stringsRVA+uint32(blob.Len()))
+			blob.WriteString(name + "\x00")
+		}
+		binary.Write(rdata, binary.LittleEndian, exportDirectory{
+			NameRVA:           nameRVA,
+			OrdinalBase:       1,
+			NumberOfFunctions: functions,
+			NumberOfNames:     uint32(len(names)),
+			AddressTableAddr:  addressTable,
+			NameTableAddr:     nameTable,
+			OrdinalTableAddr:  ordinalTable,
+		})
+		for index := uint32(0); index < functions; index++ {
+			binary.Write(rdata, binary.LittleEndian, textRVA+0x10*index)
+		}
+		binary.Write(rdata, binary.LittleEndian, nameRVAs)
+		for index := range names {
+			binary.Write(rdata, binary.LittleEndian, uint16(index))
+		}
+		rdata.Write(blob.Bytes())
+	}
+	exportSize := uint32(rdata.Len())
+	rdata.Write(make([]byte, fileAlignment-rdata.Len()))
+
+	optionalHeader := pe.OptionalHeader64{
+		Magic:               0x20b,
+		SectionAlignment:    0x1000,
+		FileAlignment:       fileAlignment,
+		SizeOfImage:         0x3000,
+		SizeOfHeaders:       fileAlignment,
+		NumberOfRvaAndSizes: 16,
+	}
+	if 0 < len(names) {
+		optionalHeader.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_EXPORT] = pe.DataDirectory{VirtualAddress: rdataRVA, Size: exportSize}
+	}
+	buf := &bytes.Buffer{}
+	dosHeader := make([]byte, 0x40)
+	copy(dosHeader, "MZ")
+	binary.LittleEndian.PutUint32(dosHeader[0x3c:], 0x40)
+	buf.Write(dosHeader)
+	buf.WriteString("PE\x00\x00")
+	binary.Write(buf, binary.LittleEndian, pe.FileHeader{
+		Machine:              pe.IMAGE_FILE_MACHINE_AMD64,
+		NumberOfSections:     2,
+		SizeOfOptionalHeader: uint16(binary.Size(optionalHeader)),
+		Characteristics:      0x2002, // IMAGE_FILE_EXECUTABLE_IMAGE | IMAGE_FILE_DLL
+	})
+	binary.Write(buf, binary.LittleEndian, optionalHeader)
+	for index, section := range []pe.SectionHeader32{
+		{Name: [8]uint8{'.', 't', 'e', 'x', 't'}, VirtualAddress: textRVA, Characteristics: 0x60000020},
+		{Name: [8]uint8{'.', 'r', 'd', 'a', 't', 'a'}, VirtualAddress: rdataRVA, Characteristics: 0x40000040},
+	} {
+		section.VirtualSize = fileAlignment
+		section.SizeOfRawData = fileAlignment
+		section.PointerToRawData = uint32(fileAlignment * (index + 1))
+		binary.Write(buf, binary.LittleEndian, section)
+	}
+	buf.Write(make([]byte, fileAlignment-buf.Len()))
+	buf.Write(bytes.Repeat([]byte{0xc3}, fileAlignment)) // .text
+	buf.Write(rdata.Bytes())
+	return buf.Bytes()
+}
+
+func TestProxyExports(t *testing.T) {
+	reference := exportingPE("version.dll", []string{"GetFileVersionInfoA", "VerQueryValueW"})
+	dllPath := filepath.Join(t.TempDir(), "implant.dll")
+	err := os.WriteFile(dllPath, exportingPE("implant.dll", nil), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = proxyExports(dllPath, reference, `C:\Legacy.dll.d\VERSION.DLL`)
+	if err != nil {
+		t.Fatalf("failed to proxy exports: %s", err)
+	}
+
+	data, err := os.ReadFile(dllPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proxied, err := pe.NewFile(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("proxied dll doesn't parse: %s", err)
+	}
+	exports, err := proxied.Exports()
+	if err != nil {
+		t.Fatalf("failed to parse the export directory: %s", err)
+	}
+	expected := []pe.Export{
+		{Ordinal: 1, Name: "GetFileVersionInfoA", Forward: `C:\Legacy.dll.d\VERSION.GetFileVersionInfoA`},
+		{Ordinal: 2, Name: "VerQueryValueW", Forward: `C:\Legacy.dll.d\VERSION.VerQueryValueW`},
+		{Ordinal: 3, Forward: `C:\Legacy.dll.d\VERSION.#3`},
+	}
+	if len(exports) != len(expected) {
+		t.Fatalf("expected %d exports, got %v", len(expected), exports)
+	}
+	for index, export := range exports {
+		if export.Ordinal != expected[index].Ordinal || export.Name != expected[index].Name || export.Forward != expected[index].Forward {
+			t.Errorf("export %d: expected %+v, got %+v", index, expected[index], export)
+		}
+	}
+
+	if err := proxyExports(dllPath, exportingPE("empty.dll", nil), "empty"); err == nil {
+		t.Fatal("proxied a dll without exports")
+	}
+}
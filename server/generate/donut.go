@@ -2,6 +2,7 @@ package generate
 
 import (
 	"bytes"
+	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -9,6 +10,27 @@ import (
 	"github.com/Binject/go-donut/donut"
 )
 
+const (
+	defaultDotNetRuntime = "v4.0.30319"
+)
+
+var (
+	// ErrUnsupportedShellcodeArch - Donut and sRDI only have x86/x64 loaders
+	ErrUnsupportedShellcodeArch = errors.New("shellcode is only supported for 386 and amd64 targets")
+)
+
+// wrapShellcode - Adds the operator's shellcode around the implant's, the prepended
+// shellcode has to fall through to the implant and the appended shellcode only runs
+// if the loader returns
+func wrapShellcode(shellcode []byte, prepend []byte, appended []byte) []byte {
+	return bytes.Join([][]byte{prepend, shellcode, appended}, nil)
+}
+
+// IsShellcodeArch - Returns true if we can convert PEs to shellcode for the arch
+func IsShellcodeArch(arch string) bool {
+	return strings.ToLower(arch) != "arm64"
+}
+
 // DonutShellcodeFromFile returns a Donut shellcode for the given PE file
 func DonutShellcodeFromFile(filePath string, arch string, dotnet bool, params string, className string, method string) (data []byte, err error) {
 	pe, err := os.ReadFile(filePath)
@@ -21,6 +43,9 @@ func DonutShellcodeFromFile(filePath string, arch string, dotnet bool, params st
 
 // DonutShellcodeFromPE returns a Donut shellcode for the given PE file
 func DonutShellcodeFromPE(pe []byte, arch string, dotnet bool, params string, className string, method string, isDLL bool, isUnicode bool) (data []byte, err error) {
+	if !IsShellcodeArch(arch) {
+		return nil, ErrUnsupportedShellcodeArch
+	}
 	ext := ".exe"
 	if isDLL {
 		ext = ".dll"
@@ -55,6 +80,13 @@ func DonutShellcodeFromPE(pe []byte, arch string, dotnet bool, params string, cl
 
 // DonutFromAssembly - Generate a donut shellcode from a .NET assembly
 func DonutFromAssembly(assembly []byte, isDLL bool, arch string, params string, method string, className string, appDomain string) ([]byte, error) {
+	return donutFromAssembly(assembly, isDLL, arch, params, method, className, appDomain, defaultDotNetRuntime)
+}
+
+func donutFromAssembly(assembly []byte, isDLL bool, arch string, params string, method string, className string, appDomain string, runtime string) ([]byte, error) {
+	if !IsShellcodeArch(arch) {
+		return nil, ErrUnsupportedShellcodeArch
+	}
 	ext := ".exe"
 	if isDLL {
 		ext = ".dll"
@@ -62,7 +94,7 @@ func DonutFromAssembly(assembly []byte, isDLL bool, arch string, params string,
 	donutArch := getDonutArch(arch)
 	config := donut.DefaultConfig()
 	config.Bypass = 3
-	config.Runtime = "v4.0.30319" // we might want to make this configurable
+	config.Runtime = runtime
 	config.Format = 1
 	config.Arch = donutArch
 	config.Class = className
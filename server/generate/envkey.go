@@ -0,0 +1,138 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db/models"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+const envKeySaltSize = 16
+
+// ErrKeyHostnameBacktick - The hostname regex is rendered into a raw string
+var ErrKeyHostnameBacktick = errors.New("hostname key regex can't contain a backtick, use \\x60 instead")
+
+// envKeyConfig - Encrypt the c2s with a key derived from the environment the
+// implant is keyed to. Only the salt, the cidr's prefix length and the key
+// file's directory are rendered, the implant derives the key from what it finds
+// on the host so the c2s can't be recovered anywhere else
+func envKeyConfig(config *models.ImplantConfig) error {
+	config.EnvKeyed = false
+	config.EnvKeySalt = ""
+	config.EnvKeyC2s = ""
+	config.EnvKeyCIDRBits = 0
+	config.EnvKeyCIDRSize = 0
+	config.EnvKeyFileDir = ""
+
+	materials := []string{}
+	if config.KeyDomain != "" {
+		materials = append(materials, envKeyDomain(config.KeyDomain))
+	}
+	if config.KeyCIDR != "" {
+		_, network, err := net.ParseCIDR(config.KeyCIDR)
+		if err != nil {
+			return err
+		}
+		config.EnvKeyCIDRBits, config.EnvKeyCIDRSize = network.Mask.Size()
+		materials = append(materials, network.String())
+	}
+	if config.KeyFile != "" {
+		dir, name := envKeySplitPath(config.KeyFile)
+		if dir == "" || name == "" {
+			return fmt.Errorf("key file '%s' must be a full path", config.KeyFile)
+		}
+		config.EnvKeyFileDir = dir
+		materials = append(materials, strings.ToLower(name))
+	}
+	if len(materials) == 0 {
+		return nil // The hostname regex alone is only an execution limit
+	}
+	if len(config.C2) == 0 {
+		return fmt.Errorf("environment keying requires at least one c2")
+	}
+
+	salt := make([]byte, envKeySaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	c2s := []string{}
+	for _, c2 := range config.C2 {
+		c2s = append(c2s, c2.URL)
+	}
+	ciphertext, err := cryptography.Encrypt(envKey(salt, materials), []byte(strings.Join(c2s, "\n")))
+	if err != nil {
+		return err
+	}
+	config.EnvKeyed = true
+	config.EnvKeySalt = hex.EncodeToString(salt)
+	config.EnvKeyC2s = base64.StdEncoding.EncodeToString(ciphertext)
+	buildLog.Infof("C2s are keyed to %d environment value(s)", len(materials))
+	return nil
+}
+
+// envKey - Must match the implant's derivation (implant/sliver/envkey)
+func envKey(salt []byte, materials []string) [chacha20poly1305.KeySize]byte {
+	digest := sha256.New()
+	digest.Write(salt)
+	for _, material := range materials {
+		digest.Write([]byte(material))
+		digest.Write([]byte{0})
+	}
+	var key [chacha20poly1305.KeySize]byte
+	copy(key[:], digest.Sum(nil))
+	return key
+}
+
+func envKeyDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSpace(domain), "."))
+}
+
+// keyHostnameRegexp - Compile the hostname key regex, it's rendered into the
+// implant as a raw string so it can't contain a backtick
+func keyHostnameRegexp(expr string) (*regexp.Regexp, error) {
+	if strings.Contains(expr, "`") {
+		return nil, ErrKeyHostnameBacktick
+	}
+	return regexp.Compile(expr)
+}
+
+// envKeySplitPath - Split on either separator since the server may not run on
+// the target's os
+func envKeySplitPath(path string) (string, string) {
+	index := strings.LastIndexAny(path, `/\`)
+	if index < 0 {
+		return "", path
+	}
+	dir := path[:index]
+	if dir == "" || strings.HasSuffix(dir, ":") {
+		dir = path[:index+1] // Keep the separator of a root directory
+	}
+	return dir, path[index+1:]
+}
@@ -0,0 +1,101 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+func TestEnvKeyConfig(t *testing.T) {
+	config := &models.ImplantConfig{
+		C2:        []models.ImplantC2{{URL: "mtls://c2.example.com:8888"}, {URL: "https://c2.example.com"}},
+		KeyDomain: "Corp.Example.com.",
+		KeyCIDR:   "10.20.30.40/16",
+		KeyFile:   `C:\ProgramData\Agent\Agent.cfg`,
+	}
+	err := envKeyConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.EnvKeyed || config.EnvKeyCIDRBits != 16 || config.EnvKeyCIDRSize != 32 || config.EnvKeyFileDir != `C:\ProgramData\Agent` {
+		t.Fatalf("unexpected keyed config %v %d/%d %q", config.EnvKeyed, config.EnvKeyCIDRBits, config.EnvKeyCIDRSize, config.EnvKeyFileDir)
+	}
+	if strings.Contains(config.EnvKeyC2s, "example") {
+		t.Fatal("c2s are not encrypted")
+	}
+	salt, _ := hex.DecodeString(config.EnvKeySalt)
+	ciphertext, _ := base64.StdEncoding.DecodeString(config.EnvKeyC2s)
+	plaintext, err := cryptography.Decrypt(envKey(salt, []string{"corp.example.com", "10.20.0.0/16", "agent.cfg"}), ciphertext)
+	if err != nil {
+		t.Fatalf("failed to decrypt with the environment's key: %s", err)
+	}
+	if string(plaintext) != "mtls://c2.example.com:8888\nhttps://c2.example.com" {
+		t.Fatalf("unexpected c2s %q", plaintext)
+	}
+	if _, err := cryptography.Decrypt(envKey(salt, []string{"corp.example.com", "10.21.0.0/16", "agent.cfg"}), ciphertext); err == nil {
+		t.Fatal("decrypted with the wrong network")
+	}
+
+	config = &models.ImplantConfig{C2: config.C2, KeyHostname: "^ws-", KeyFile: "agent.cfg"}
+	if err := envKeyConfig(config); err == nil {
+		t.Fatal("accepted a key file without a directory")
+	}
+	config.KeyFile = ""
+	if err := envKeyConfig(config); err != nil || config.EnvKeyed {
+		t.Fatalf("hostname regex alone should not key the c2s: %v", err)
+	}
+}
+
+func TestEnvKeySplitPath(t *testing.T) {
+	for path, expected := range map[string][2]string{
+		"/etc/agent.cfg":     {"/etc", "agent.cfg"},
+		"/agent.cfg":         {"/", "agent.cfg"},
+		`C:\agent.cfg`:       {`C:\`, "agent.cfg"},
+		`C:\Agent\agent.cfg`: {`C:\Agent`, "agent.cfg"},
+		"agent.cfg":          {"", "agent.cfg"},
+	} {
+		dir, name := envKeySplitPath(path)
+		if dir != expected[0] || name != expected[1] {
+			t.Fatalf("%s split into %q %q", path, dir, name)
+		}
+	}
+}
+
+func TestKeyHostnameRegexp(t *testing.T) {
+	if _, err := keyHostnameRegexp("^ws-[0-9]+$"); err != nil {
+		t.Fatalf("valid regex was rejected: %s", err)
+	}
+	if _, err := keyHostnameRegexp("ws-`"); err != ErrKeyHostnameBacktick {
+		t.Fatalf("expected a backtick error, got %v", err)
+	}
+	if _, err := keyHostnameRegexp("ws-("); err == nil {
+		t.Fatal("invalid regex was accepted")
+	}
+	_, config := ImplantConfigFromProtobuf(&clientpb.ImplantConfig{KeyHostname: "`,os.Exit(0)//"})
+	if config.KeyHostname != "" {
+		t.Fatalf("hostname key with a backtick was kept: %q", config.KeyHostname)
+	}
+}
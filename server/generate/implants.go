@@ -26,7 +26,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"path"
 	"path/filepath"
 	"strings"
 
@@ -34,6 +33,7 @@ import (
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/storage"
 	"github.com/bishopfox/sliver/server/watchtower"
 	"gorm.io/gorm/clause"
 )
@@ -45,16 +45,8 @@ var (
 	ErrImplantBuildFileNotFound = errors.New("implant build file not found")
 )
 
-func getBuildsDir() (string, error) {
-	buildsDir := filepath.Join(assets.GetRootAppDir(), "builds")
-	storageLog.Debugf("Builds dir: %s", buildsDir)
-	if _, err := os.Stat(buildsDir); os.IsNotExist(err) {
-		err = os.MkdirAll(buildsDir, 0700)
-		if err != nil {
-			return "", err
-		}
-	}
-	return buildsDir, nil
+func getBuildsStore() (storage.Store, error) {
+	return storage.New(storage.Builds)
 }
 
 // ImplantConfigSave - Save only the config to the database
@@ -82,7 +74,7 @@ func ImplantBuildSave(name string, config *models.ImplantConfig, fPath string) e
 		return err
 	}
 	md5Hash, sha1Hash, sha256Hash := computeHashes(data)
-	buildsDir, err := getBuildsDir()
+	buildsStore, err := getBuildsStore()
 	if err != nil {
 		return err
 	}
@@ -100,7 +92,7 @@ func ImplantBuildSave(name string, config *models.ImplantConfig, fPath string) e
 		return result.Error
 	}
 	storageLog.Infof("%s -> %s", implantBuild.ID, implantBuild.Name)
-	return os.WriteFile(filepath.Join(buildsDir, implantBuild.ID.String()), data, 0600)
+	return buildsStore.Put(implantBuild.ID.String(), data)
 }
 
 func computeHashes(data []byte) (string, string, string) {
@@ -115,26 +107,26 @@ func computeHashes(data []byte) (string, string, string) {
 
 // ImplantFileFromBuild - Saves a binary file into the database
 func ImplantFileFromBuild(build *models.ImplantBuild) ([]byte, error) {
-	buildsDir, err := getBuildsDir()
+	buildsStore, err := getBuildsStore()
 	if err != nil {
 		return nil, err
 	}
-	buildFilePath := path.Join(buildsDir, build.ID.String())
-	if _, err := os.Stat(buildFilePath); os.IsNotExist(err) {
+	data, err := buildsStore.Get(build.ID.String())
+	if errors.Is(err, storage.ErrObjectNotFound) {
 		return nil, ErrImplantBuildFileNotFound
 	}
-	return os.ReadFile(buildFilePath)
+	return data, err
 }
 
-// ImplantFileDelete - Delete the implant from the file system
+// ImplantFileDelete - Delete the implant from the builds store
 func ImplantFileDelete(build *models.ImplantBuild) error {
-	buildsDir, err := getBuildsDir()
+	buildsStore, err := getBuildsStore()
 	if err != nil {
 		return err
 	}
-	buildFilePath := filepath.Join(buildsDir, build.ID.String())
-	if _, err := os.Stat(buildFilePath); os.IsNotExist(err) {
+	err = buildsStore.Delete(build.ID.String())
+	if errors.Is(err, storage.ErrObjectNotFound) {
 		return ErrImplantBuildFileNotFound
 	}
-	return os.Remove(buildFilePath)
+	return err
 }
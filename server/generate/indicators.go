@@ -0,0 +1,232 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	insecureRand "math/rand"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/gogo"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// IndicatorPath - Absolute paths from the server's build environment
+	IndicatorPath = "path"
+	// IndicatorProject - Identifiers of the project itself
+	IndicatorProject = "project"
+	// IndicatorLibrary - Import paths of libraries that are rarely seen outside of implants
+	IndicatorLibrary = "library"
+	// IndicatorTemplate - Template actions that were not rendered
+	IndicatorTemplate = "template"
+
+	indicatorReportExt = ".indicators.json"
+)
+
+var (
+	// ErrTooManyIndicators - A build has more residual indicators than its threshold
+	ErrTooManyIndicators = errors.New("too many residual indicators")
+
+	projectIndicators = []string{
+		"bishopfox",
+		"sliverpb",
+		"implant/sliver",
+	}
+	libraryIndicators = []string{
+		"golang.zx2c4.com/wireguard",
+		"gvisor.dev/gvisor",
+		"github.com/miekg/dns",
+		"github.com/things-go/go-socks5",
+		"github.com/kbinani/screenshot",
+		"github.com/lesnuages/go-winio",
+		"github.com/yiya1989/sshkrb5",
+		"github.com/rapid7/go-get-proxied",
+		"github.com/moloch--/memmod",
+		"github.com/Ne0nd0g/go-clr",
+		"github.com/Binject/universal",
+	}
+	templateIndicator = regexp.MustCompile(`\{\{-?\s*(if|else|end|range|with|template|\.)[^}]{0,64}\}\}`)
+)
+
+// ScanIndicators - Find the tell-tale strings in an implant binary, paths are the
+// server's build paths, which are indicators themselves
+func ScanIndicators(data []byte, paths []string) *clientpb.IndicatorReport {
+	report := &clientpb.IndicatorReport{}
+	add := func(category string, value string, count int) {
+		if count == 0 {
+			return
+		}
+		report.Indicators = append(report.Indicators, &clientpb.Indicator{
+			Category: category,
+			Value:    value,
+			Count:    uint32(count),
+		})
+		report.Residual += uint32(count)
+	}
+	for _, path := range paths {
+		add(IndicatorPath, path, bytes.Count(data, []byte(path)))
+	}
+	for _, value := range projectIndicators {
+		add(IndicatorProject, value, bytes.Count(data, []byte(value)))
+	}
+	for _, value := range libraryIndicators {
+		add(IndicatorLibrary, value, bytes.Count(data, []byte(value)))
+	}
+	templates := map[string]int{}
+	for _, match := range templateIndicator.FindAll(data, -1) {
+		templates[string(match)]++
+	}
+	values := []string{}
+	for value := range templates {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	for _, value := range values {
+		add(IndicatorTemplate, value, templates[value])
+	}
+	return report
+}
+
+// StripIndicators - Overwrite the build paths with random characters of the same length,
+// the paths are only used in stack traces so this doesn't change how the binary runs.
+// Everything else is referenced by the code and is only reported, symbol obfuscation
+// renames the packages and encrypts string literals.
+func StripIndicators(data []byte, paths []string) ([]byte, *clientpb.IndicatorReport) {
+	report := ScanIndicators(data, paths)
+	for _, indicator := range report.Indicators {
+		if indicator.Category != IndicatorPath {
+			continue
+		}
+		data = bytes.ReplaceAll(data, []byte(indicator.Value), junkPath(indicator.Value))
+		indicator.Stripped = true
+		report.Residual -= indicator.Count
+	}
+	return data, report
+}
+
+// junkPath - Random letters with the same length and separators as the path
+func junkPath(path string) []byte {
+	junk := []byte(path)
+	for index, char := range junk {
+		switch char {
+		case '/', '\\', ':':
+		default:
+			junk[index] = byte('a' + insecureRand.Intn(26))
+		}
+	}
+	return junk
+}
+
+// buildPaths - The server paths that can end up in a build, longest first so a
+// path is stripped before any of its parent directories
+func buildPaths(goConfig *gogo.GoConfig) []string {
+	paths := []string{}
+	candidates := []string{
+		goConfig.ProjectDir,
+		goConfig.GOROOT,
+		goConfig.GOCACHE,
+		goConfig.GOMODCACHE,
+		assets.GetRootAppDir(),
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, home)
+	}
+	seen := map[string]bool{}
+	for _, candidate := range candidates {
+		candidate = filepath.Clean(candidate)
+		// Go records paths with forward slashes, even on windows
+		for _, path := range []string{candidate, filepath.ToSlash(candidate)} {
+			// Short paths like '/' or '/root' would match all sorts of unrelated strings
+			if len(path) < 6 || seen[path] {
+				continue
+			}
+			seen[path] = true
+			paths = append(paths, path)
+		}
+	}
+	sort.SliceStable(paths, func(i, j int) bool {
+		return len(paths[j]) < len(paths[i])
+	})
+	return paths
+}
+
+// buildIndicators - Strip what we can from a build and save the indicator report next
+// to the binary, fails if more indicators remain than the config's threshold allows.
+// Debug builds are only scanned, stripping the paths would break their stack traces.
+func buildIndicators(dest string, config *models.ImplantConfig, goConfig *gogo.GoConfig) error {
+	data, err := os.ReadFile(dest)
+	if err != nil {
+		return err
+	}
+	var report *clientpb.IndicatorReport
+	if config.Debug {
+		report = ScanIndicators(data, buildPaths(goConfig))
+	} else {
+		data, report = StripIndicators(data, buildPaths(goConfig))
+		info, err := os.Stat(dest)
+		if err != nil {
+			return err
+		}
+		err = os.WriteFile(dest, data, info.Mode())
+		if err != nil {
+			return err
+		}
+	}
+	report.Threshold = config.IndicatorThreshold
+	for _, indicator := range report.Indicators {
+		if !indicator.Stripped {
+			buildLog.Infof("Residual %s indicator '%s' (%d)", indicator.Category, indicator.Value, indicator.Count)
+		}
+	}
+	reportData, err := protojson.Marshal(report)
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(dest+indicatorReportExt, reportData, 0600)
+	if err != nil {
+		return err
+	}
+	if 0 < report.Threshold && report.Threshold < report.Residual {
+		return fmt.Errorf("%w: %d found, the threshold is %d", ErrTooManyIndicators, report.Residual, report.Threshold)
+	}
+	return nil
+}
+
+// ReadIndicatorReport - Read the indicator report of a build
+func ReadIndicatorReport(fPath string) (*clientpb.IndicatorReport, error) {
+	data, err := os.ReadFile(fPath + indicatorReportExt)
+	if err != nil {
+		return nil, err
+	}
+	report := &clientpb.IndicatorReport{}
+	err = protojson.Unmarshal(data, report)
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
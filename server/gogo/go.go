@@ -59,6 +59,11 @@ type GoConfig struct {
 
 	Obfuscation bool
 	GOGARBLE    string
+
+	// Garble modes, an empty seed is random
+	GarbleLiterals bool
+	GarbleTiny     bool
+	GarbleSeed     string
 }
 
 // GetGoRootDir - Get the path to GOROOT
@@ -89,6 +94,21 @@ func getHomeDir() string {
 	return home
 }
 
+func garbleFlags(config GoConfig) []string {
+	seed := config.GarbleSeed
+	if seed == "" {
+		seed = "random"
+	}
+	flags := []string{fmt.Sprintf("-seed=%s", seed)}
+	if config.GarbleLiterals {
+		flags = append(flags, "-literals")
+	}
+	if config.GarbleTiny {
+		flags = append(flags, "-tiny")
+	}
+	return flags
+}
+
 // GarbleCmd - Execute a go command
 func GarbleCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
 	target := fmt.Sprintf("%s/%s", config.GOOS, config.GOARCH)
@@ -96,8 +116,7 @@ func GarbleCmd(config GoConfig, cwd string, command []string) ([]byte, error) {
 		return nil, fmt.Errorf(fmt.Sprintf("Invalid compiler target: %s", target))
 	}
 	garbleBinPath := filepath.Join(config.GOROOT, "bin", "garble")
-	garbleFlags := []string{"-seed=random", "-literals", "-tiny"}
-	command = append(garbleFlags, command...)
+	command = append(garbleFlags(config), command...)
 	cmd := exec.Command(garbleBinPath, command...)
 	cmd.Dir = cwd
 	cmd.Env = []string{
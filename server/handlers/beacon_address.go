@@ -0,0 +1,213 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/asn"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	checkinAlertConfig     *configs.CheckinAlertConfig
+	checkinAlertConfigOnce sync.Once
+
+	// Serialize address tracking per beacon, check-ins are handled concurrently
+	beaconAddressLocks = &sync.Map{}
+)
+
+func getCheckinAlertConfig() *configs.CheckinAlertConfig {
+	checkinAlertConfigOnce.Do(func() {
+		checkinAlertConfig = configs.GetServerConfig().CheckinAlerts
+	})
+	return checkinAlertConfig
+}
+
+// trackBeaconAddress - Record the source address of a beacon check-in and publish
+// an event when the change looks like the host moved networks or is being replayed
+func trackBeaconAddress(beacon *models.Beacon, remoteAddress string) {
+	config := getCheckinAlertConfig()
+	if !checkinAlertsEnabled(config) {
+		return
+	}
+	addr, err := parseRemoteAddress(remoteAddress)
+	if err != nil {
+		return // Some transports (e.g. DNS) do not have a meaningful source address
+	}
+
+	lock, _ := beaconAddressLocks.LoadOrStore(beacon.ID.String(), &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	now := time.Now()
+	previous, err := db.BeaconAddressLatest(beacon.ID)
+	if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+		beaconHandlerLog.Errorf("Database query error %s", err)
+		return
+	}
+	if err == nil && previous.RemoteAddress == addr.String() {
+		previous.LastSeen = now
+		previous.Checkins++
+		err = db.Session().Save(previous).Error
+		if err != nil {
+			beaconHandlerLog.Errorf("Database write %s", err)
+		}
+		return
+	}
+
+	current := &models.BeaconAddress{
+		BeaconID:      beacon.ID,
+		RemoteAddress: addr.String(),
+		LastSeen:      now,
+		Checkins:      1,
+	}
+	if record := asn.Lookup(addr); record != nil {
+		current.ASN = record.ASN
+		current.Country = record.Country
+	}
+	err = db.Session().Create(current).Error
+	if err != nil {
+		beaconHandlerLog.Errorf("Database write %s", err)
+		return
+	}
+	if previous == nil || previous.RemoteAddress == "" {
+		return // First check-in we've seen, nothing to compare against
+	}
+
+	window := time.Duration(config.FlipWindow) * time.Second
+	changes, err := db.BeaconAddressChangesSince(beacon.ID, now.Add(-window), current.ID)
+	if err != nil {
+		beaconHandlerLog.Errorf("Database query error %s", err)
+	}
+	reason := addressChangeReason(config, previous, current, changes)
+	if reason == "" {
+		return
+	}
+	beaconHandlerLog.Warnf("Beacon %s (%s) source address changed %s -> %s: %s",
+		beacon.Name, beacon.ID, previous.RemoteAddress, current.RemoteAddress, reason)
+
+	eventData, _ := proto.Marshal(&clientpb.BeaconAddressChange{
+		Beacon:          beacon.ToProtobuf(),
+		PreviousAddress: previous.RemoteAddress,
+		PreviousASN:     previous.ASN,
+		PreviousCountry: previous.Country,
+		RemoteAddress:   current.RemoteAddress,
+		ASN:             current.ASN,
+		Country:         current.Country,
+		Reason:          reason,
+	})
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.BeaconAddressChangedEvent,
+		Data:      eventData,
+		Beacon:    beacon,
+	})
+}
+
+// addressChangeReason - Decide if a change in source address is worth an alert at
+// the configured sensitivity, returns an empty string if it is not. The ASN and
+// country are only known when an ASN database is configured, without one we fall
+// back to comparing the network portion of the addresses.
+func addressChangeReason(config *configs.CheckinAlertConfig, previous *models.BeaconAddress, current *models.BeaconAddress, changes int64) string {
+	if !checkinAlertsEnabled(config) {
+		return ""
+	}
+	if 0 < config.FlipCount && int64(config.FlipCount) <= changes {
+		window := time.Duration(config.FlipWindow) * time.Second
+		return fmt.Sprintf("address changed %d times in %s", changes, window)
+	}
+	if previous.Country != "" && current.Country != "" && previous.Country != current.Country {
+		return fmt.Sprintf("country changed from %s to %s", previous.Country, current.Country)
+	}
+	if config.Sensitivity == configs.CheckinAlertsLow {
+		return ""
+	}
+	if previous.ASN != 0 && current.ASN != 0 {
+		if previous.ASN != current.ASN {
+			return fmt.Sprintf("ASN changed from AS%d to AS%d", previous.ASN, current.ASN)
+		}
+	} else if !sameNetwork(previous.RemoteAddress, current.RemoteAddress) {
+		return "network changed"
+	}
+	if config.Sensitivity == configs.CheckinAlertsHigh {
+		return "address changed"
+	}
+	return ""
+}
+
+// checkinAlertsEnabled - Only the sensitivities the server config accepts enable
+// alerts, an empty or unknown one is rejected by the config check so it's off too
+func checkinAlertsEnabled(config *configs.CheckinAlertConfig) bool {
+	if config == nil {
+		return false
+	}
+	switch config.Sensitivity {
+	case configs.CheckinAlertsLow, configs.CheckinAlertsMedium, configs.CheckinAlertsHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+// sameNetwork - Compare the /16 of IPv4 addresses or the /32 of IPv6 addresses
+func sameNetwork(a string, b string) bool {
+	addrA, errA := netip.ParseAddr(a)
+	addrB, errB := netip.ParseAddr(b)
+	if errA != nil || errB != nil || addrA.Is4() != addrB.Is4() {
+		return false
+	}
+	bits := 32
+	if addrA.Is4() {
+		bits = 16
+	}
+	prefix, err := addrA.Prefix(bits)
+	if err != nil {
+		return false
+	}
+	return prefix.Contains(addrB)
+}
+
+// parseRemoteAddress - Extract the source IP from an implant connection's remote
+// address, the http(s) c2 reports proxied connections as "tcp(<addr>)-><ip>"
+func parseRemoteAddress(remoteAddress string) (netip.Addr, error) {
+	if index := strings.LastIndex(remoteAddress, "->"); index != -1 {
+		remoteAddress = remoteAddress[index+2:]
+	}
+	host, _, err := net.SplitHostPort(remoteAddress)
+	if err != nil {
+		host = remoteAddress
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap(), nil
+}
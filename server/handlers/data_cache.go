@@ -27,6 +27,9 @@ import (
 var (
 	// TunnelID -> Sequence Number -> Data
 	tunnelDataCache = dataCache{mutex: &sync.RWMutex{}, cache: map[uint64]map[uint64]*sliverpb.TunnelData{}}
+
+	// BeaconID -> Last check-in response
+	beaconCheckinCache = checkinCache{mutex: &sync.RWMutex{}, cache: map[string]*cachedCheckin{}, locks: map[string]*sync.Mutex{}}
 )
 
 type dataCache struct {
@@ -82,3 +85,53 @@ func (c *dataCache) Len(tunnelID uint64) int {
 
 	return len(c.cache[tunnelID])
 }
+
+// checkinCache - The last response sent to each beacon, so a beacon that lost
+// the response to a check-in (and re-polls with the same message id) gets the
+// same tasks again instead of whatever is pending now
+type checkinCache struct {
+	mutex *sync.RWMutex
+	cache map[string]*cachedCheckin
+	locks map[string]*sync.Mutex
+}
+
+type cachedCheckin struct {
+	messageID int64
+	response  *sliverpb.Envelope
+}
+
+func (c *checkinCache) Add(beaconID string, messageID int64, response *sliverpb.Envelope) {
+	if messageID == 0 {
+		return // Older implants don't send message ids
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.cache[beaconID] = &cachedCheckin{messageID: messageID, response: response}
+}
+
+func (c *checkinCache) Get(beaconID string, messageID int64) (*sliverpb.Envelope, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	checkin, ok := c.cache[beaconID]
+	if !ok || messageID == 0 || checkin.messageID != messageID {
+		return nil, false
+	}
+	return checkin.response, true
+}
+
+// Mutex - Serializes check-ins from one beacon, so a check-in that's retransmitted
+// while the original is still being handled waits for its response. Other beacons
+// aren't held up.
+func (c *checkinCache) Mutex(beaconID string) *sync.Mutex {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	lock, ok := c.locks[beaconID]
+	if !ok {
+		lock = &sync.Mutex{}
+		c.locks[beaconID] = lock
+	}
+	return lock
+}
@@ -0,0 +1,72 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+func TestCheckinCache(t *testing.T) {
+	cache := checkinCache{mutex: &sync.RWMutex{}, cache: map[string]*cachedCheckin{}, locks: map[string]*sync.Mutex{}}
+	response := &sliverpb.Envelope{ID: 1}
+	cache.Add("beacon-1", 10, response)
+
+	if cached, ok := cache.Get("beacon-1", 10); !ok || cached != response {
+		t.Fatalf("expected the cached response, got %v", cached)
+	}
+	if _, ok := cache.Get("beacon-1", 11); ok {
+		t.Fatal("a new check-in got the cached response")
+	}
+	if _, ok := cache.Get("beacon-2", 10); ok {
+		t.Fatal("another beacon got the cached response")
+	}
+
+	// Older implants don't send message ids, they always get pending tasks
+	cache.Add("beacon-3", 0, response)
+	if _, ok := cache.Get("beacon-3", 0); ok {
+		t.Fatal("a check-in without a message id got a cached response")
+	}
+
+	next := &sliverpb.Envelope{ID: 2}
+	cache.Add("beacon-1", 11, next)
+	if _, ok := cache.Get("beacon-1", 10); ok {
+		t.Fatal("an old check-in got a response after it was replaced")
+	}
+	if cached, ok := cache.Get("beacon-1", 11); !ok || cached != next {
+		t.Fatalf("expected the replaced response, got %v", cached)
+	}
+}
+
+func TestCheckinCacheMutex(t *testing.T) {
+	cache := checkinCache{mutex: &sync.RWMutex{}, cache: map[string]*cachedCheckin{}, locks: map[string]*sync.Mutex{}}
+	if cache.Mutex("beacon-1") != cache.Mutex("beacon-1") {
+		t.Fatal("check-ins from the same beacon got different locks")
+	}
+	lock := cache.Mutex("beacon-1")
+	lock.Lock()
+	defer lock.Unlock()
+	other := cache.Mutex("beacon-2")
+	if !other.TryLock() {
+		t.Fatal("one beacon's check-in blocked another's")
+	}
+	other.Unlock()
+}
@@ -45,6 +45,10 @@ func GetHandlers() map[uint32]ServerHandler {
 		sliverpb.MsgPing:        pingHandler,
 		sliverpb.MsgSocksData:   socksDataHandler,
 
+		sliverpb.MsgTransferProgress: transferProgressHandler,
+		sliverpb.MsgSearchMatches:    searchMatchesHandler,
+		sliverpb.MsgNTLMCaptures:     ntlmCapturesHandler,
+
 		// Beacons
 		sliverpb.MsgBeaconRegister: beaconRegisterHandler,
 		sliverpb.MsgBeaconTasks:    beaconTasksHandler,
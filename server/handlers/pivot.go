@@ -217,7 +217,7 @@ func serverKeyExchange(implantConn *core.ImplantConnection, peerEnvelope *sliver
 	}
 	var senderPublicKey [32]byte
 	copy(senderPublicKey[:], publicKey)
-	serverKeyPair := cryptography.ECCServerKeyPair()
+	serverKeyPair := cryptography.ECCServerKeyPairFor(implantConfig.ECCServerPublicKey)
 	rawSessionKey, err := cryptography.ECCDecrypt(&senderPublicKey, serverKeyPair.Private, serverKeyEx.SessionKey[32:])
 	if err != nil {
 		pivotLog.Warn("Failed to decrypt session key from origin")
@@ -30,11 +30,13 @@ import (
 	"net"
 	"time"
 
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/core/rtunnels"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
 
 	"google.golang.org/protobuf/proto"
 
@@ -45,6 +47,11 @@ var (
 	sessionHandlerLog = log.NamedLogger("handlers", "sessions")
 )
 
+const (
+	// reverseTunnelBindTimeout - How long a client has to bind to a reverse port forward connection
+	reverseTunnelBindTimeout = 30 * time.Second
+)
+
 func registerSessionHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
 	if implantConn == nil {
 		return nil
@@ -80,11 +87,14 @@ func registerSessionHandler(implantConn *core.ImplantConnection, data []byte) *s
 	session.Locale = register.Locale
 	session.ConfigID = register.ConfigID
 	session.PeerID = register.PeerID
+	session.DNSDiagnostics = register.DNSDiagnostics
+	session.Snapshot = register.Snapshot
 	core.Sessions.Add(session)
 	implantConn.Cleanup = func() {
 		core.Sessions.Remove(session.ID)
 	}
 	go auditLogSession(session, register)
+	go trackWatchdog(register)
 	return nil
 }
 
@@ -131,7 +141,10 @@ func tunnelDataHandler(implantConn *core.ImplantConnection, data []byte) *sliver
 	} else {
 		tunnel := core.Tunnels.Get(tunnelData.TunnelID)
 		if tunnel != nil {
-			if session.ID == tunnel.SessionID {
+			if session.ID == tunnel.SessionID && tunnel.Client == nil && 0 < cap(tunnel.FromImplant) && len(tunnel.FromImplant) == cap(tunnel.FromImplant) {
+				sessionHandlerLog.Warnf("No client bound to reverse tunnel %d, buffer is full", tunnel.ID)
+				closeUnboundTunnel(implantConn, tunnel.ID)
+			} else if session.ID == tunnel.SessionID {
 				tunnel.SendDataFromImplant(tunnelData)
 			} else {
 				sessionHandlerLog.Warnf("Warning: Session %s attempted to send data on tunnel it did not own", session.ID)
@@ -188,6 +201,78 @@ func pingHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Env
 		return nil
 	}
 	sessionHandlerLog.Debugf("ping from session %s", session.ID)
+	ping := &sliverpb.Ping{}
+	err := proto.Unmarshal(data, ping)
+	if err != nil || !ping.Keepalive {
+		return nil
+	}
+	// Implants treat a ping without an envelope id as the reply to a keepalive,
+	// older implants never ask for one since they'd answer it with another ping
+	data, _ = proto.Marshal(&sliverpb.Ping{Nonce: ping.Nonce})
+	return &sliverpb.Envelope{Type: sliverpb.MsgPing, Data: data}
+}
+
+// transferProgressHandler - Forward the progress of large envelopes over slow transports
+// to clients, the progress is not validated beyond coming from a known session
+func transferProgressHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
+	session := core.Sessions.FromImplantConnection(implantConn)
+	if session == nil {
+		sessionHandlerLog.Warnf("Received transfer progress from unknown session: %v", implantConn)
+		return nil
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.TransferProgressEvent,
+		Session:   session,
+		Data:      data,
+	})
+	return nil
+}
+
+// searchMatchesHandler - Forward matches of a running search to clients
+func searchMatchesHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
+	session := core.Sessions.FromImplantConnection(implantConn)
+	if session == nil {
+		sessionHandlerLog.Warnf("Received search matches from unknown session: %v", implantConn)
+		return nil
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.SearchMatchesEvent,
+		Session:   session,
+		Data:      data,
+	})
+	return nil
+}
+
+// ntlmCapturesHandler - Save hashes captured by a session's ntlm listeners as loot
+func ntlmCapturesHandler(implantConn *core.ImplantConnection, data []byte) *sliverpb.Envelope {
+	session := core.Sessions.FromImplantConnection(implantConn)
+	if session == nil {
+		sessionHandlerLog.Warnf("Received ntlm captures from unknown session: %v", implantConn)
+		return nil
+	}
+	captures := &sliverpb.NTLMCaptures{}
+	err := proto.Unmarshal(data, captures)
+	if err != nil {
+		sessionHandlerLog.Errorf("Error decoding ntlm captures: %s", err)
+		return nil
+	}
+	added, err := loot.GetLootStore().SaveNTLMCaptures(captures.Captures)
+	if err != nil {
+		sessionHandlerLog.Errorf("Failed to save ntlm captures: %s", err)
+	}
+	if 0 < len(added) {
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.LootAddedEvent,
+		})
+	}
+	for _, capture := range captures.Captures {
+		captureData, _ := proto.Marshal(capture)
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.NTLMCaptureEvent,
+			Session:   session,
+			Data:      captureData,
+		})
+	}
 	return nil
 }
 
@@ -226,6 +311,11 @@ func createReverseTunnelHandler(implantConn *core.ImplantConnection, data []byte
 	req := &sliverpb.TunnelData{}
 	proto.Unmarshal(data, req)
 
+	if req.Rportfwd.GetLocal() {
+		createClientReverseTunnel(session, implantConn, req)
+		return nil
+	}
+
 	var defaultDialer = new(net.Dialer)
 
 	remoteAddress := fmt.Sprintf("%s:%d", req.Rportfwd.Host, req.Rportfwd.Port)
@@ -329,6 +419,46 @@ func createReverseTunnelHandler(implantConn *core.ImplantConnection, data []byte
 	return nil
 }
 
+// createClientReverseTunnel - The target of the reverse port forward is dialed by the
+// operator's console, so we create a regular tunnel and let the client bind to it
+func createClientReverseTunnel(session *core.Session, implantConn *core.ImplantConnection, req *sliverpb.TunnelData) {
+	tunnel := core.Tunnels.CreateReverse(req.TunnelID, session.ID)
+	tunnel.SendDataFromImplant(req) // Buffered until the client binds
+
+	rportfwd := proto.Clone(req.Rportfwd).(*sliverpb.RPortfwd)
+	rportfwd.TunnelID = tunnel.ID
+	eventData, _ := proto.Marshal(rportfwd)
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.RportFwdConnectionEvent,
+		Session:   session,
+		Data:      eventData,
+	})
+
+	go func() {
+		time.Sleep(reverseTunnelBindTimeout)
+		tunnelHandlerMutex.Lock()
+		defer tunnelHandlerMutex.Unlock()
+		if tunnel := core.Tunnels.Get(req.TunnelID); tunnel != nil && tunnel.Client == nil {
+			sessionHandlerLog.Warnf("No client bound to reverse tunnel %d", tunnel.ID)
+			closeUnboundTunnel(implantConn, tunnel.ID)
+		}
+	}()
+}
+
+// closeUnboundTunnel - Nothing relays a close to the implant until a client binds, so we
+// have to send it ourselves. The caller must hold the tunnelHandlerMutex.
+func closeUnboundTunnel(implantConn *core.ImplantConnection, tunnelID uint64) {
+	core.Tunnels.Close(tunnelID)
+	tunnelClose, _ := proto.Marshal(&sliverpb.TunnelData{
+		Closed:   true,
+		TunnelID: tunnelID,
+	})
+	implantConn.Send <- &sliverpb.Envelope{
+		Type: sliverpb.MsgTunnelClose,
+		Data: tunnelClose,
+	}
+}
+
 func RTunnelDataHandler(tunnelData *sliverpb.TunnelData, tunnel *rtunnels.RTunnel, connection *core.ImplantConnection) {
 
 	// Since we have no guarantees that we will receive tunnel data in the correct order, we need
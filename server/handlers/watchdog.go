@@ -0,0 +1,64 @@
+package handlers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	WARNING: These functions can be invoked by remote implants without user interaction
+
+*/
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/gofrs/uuid"
+)
+
+const watchdogTechnique = "watchdog"
+
+var (
+	watchdogHandlerLog = log.NamedLogger("handlers", "watchdog")
+)
+
+// trackWatchdog - Record an implant's watchdog process in the persistence inventory,
+// a restarted implant reports the same watchdog so it's only recorded once
+func trackWatchdog(register *sliverpb.Register) {
+	if register.WatchdogPID == 0 {
+		return
+	}
+	target := fmt.Sprintf("pid %d", register.WatchdogPID)
+	_, err := db.PersistenceByTarget(register.Uuid, watchdogTechnique, target)
+	if !errors.Is(err, db.ErrRecordNotFound) {
+		return
+	}
+	err = db.Session().Create(&models.Persistence{
+		HostUUID:  uuid.FromStringOrNil(register.Uuid),
+		Hostname:  register.Hostname,
+		Technique: watchdogTechnique,
+		Target:    target,
+		Path:      register.Filename,
+		Restore:   fmt.Sprintf("'kill' the implant (stops the watchdog first), or kill pid %d before the implant", register.WatchdogPID),
+	}).Error
+	if err != nil {
+		watchdogHandlerLog.Errorf("Failed to record watchdog %s: %s", target, err)
+	}
+}
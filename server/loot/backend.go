@@ -20,15 +20,13 @@ package loot
 
 import (
 	"errors"
-	"io/ioutil"
-	"os"
-	"path/filepath"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/storage"
 	"github.com/gofrs/uuid"
 	"google.golang.org/protobuf/proto"
 )
@@ -42,19 +40,29 @@ var (
 	lootLog = log.NamedLogger("loot", "backend")
 )
 
-// LocalBackend - A loot backend that saves files locally to disk
-type LocalBackend struct {
-	LocalFileDir string
-	LocalCredDir string
+// StorageBackend - A loot backend that saves the loot metadata to the database and the
+// content to a storage.Store, files and credentials are saved under separate keys
+type StorageBackend struct {
+	Store storage.Store
+}
+
+func lootFileKey(lootID string) string {
+	return "files/" + lootID
+}
+
+func lootCredentialKey(lootID string) string {
+	return "credentials/" + lootID
 }
 
 // Add - Add a piece of loot
-func (l *LocalBackend) Add(loot *clientpb.Loot) (*clientpb.Loot, error) {
+func (l *StorageBackend) Add(loot *clientpb.Loot) (*clientpb.Loot, error) {
 	dbLoot := &models.Loot{
 		Name:           loot.GetName(),
 		Type:           int(loot.GetType()),
 		CredentialType: int(loot.GetCredentialType()),
 		FileType:       int(loot.GetFileType()),
+		FileName:       loot.GetFile().GetName(),
+		OriginHost:     uuid.FromStringOrNil(loot.GetOriginHostUUID()),
 	}
 	dbSession := db.Session()
 	err := dbSession.Create(dbLoot).Error
@@ -63,24 +71,22 @@ func (l *LocalBackend) Add(loot *clientpb.Loot) (*clientpb.Loot, error) {
 	}
 
 	if loot.File != nil {
-		lootLocalFile := filepath.Join(l.LocalFileDir, dbLoot.ID.String())
 		data, err := proto.Marshal(loot.File)
 		if err != nil {
 			return nil, err
 		}
-		err = ioutil.WriteFile(lootLocalFile, data, 0600)
+		err = l.Store.Put(lootFileKey(dbLoot.ID.String()), data)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	if loot.Credential != nil {
-		lootLocalCred := filepath.Join(l.LocalCredDir, dbLoot.ID.String())
 		data, err := proto.Marshal(loot.Credential)
 		if err != nil {
 			return nil, err
 		}
-		err = ioutil.WriteFile(lootLocalCred, data, 0600)
+		err = l.Store.Put(lootCredentialKey(dbLoot.ID.String()), data)
 		if err != nil {
 			return nil, err
 		}
@@ -94,8 +100,9 @@ func (l *LocalBackend) Add(loot *clientpb.Loot) (*clientpb.Loot, error) {
 	return loot, err
 }
 
-// Update - Update metadata about loot, currently only 'name' can be changed
-func (l *LocalBackend) Update(lootReq *clientpb.Loot) (*clientpb.Loot, error) {
+// Update - Update metadata about loot, currently only 'name' and the credential
+// (i.e. its validations) can be changed
+func (l *StorageBackend) Update(lootReq *clientpb.Loot) (*clientpb.Loot, error) {
 	dbSession := db.Session()
 	lootUUID, err := uuid.FromString(lootReq.LootID)
 	if err != nil {
@@ -114,11 +121,22 @@ func (l *LocalBackend) Update(lootReq *clientpb.Loot) (*clientpb.Loot, error) {
 		}
 	}
 
+	if lootReq.Credential != nil {
+		data, err := proto.Marshal(lootReq.Credential)
+		if err != nil {
+			return nil, err
+		}
+		err = l.Store.Put(lootCredentialKey(dbLoot.ID.String()), data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return l.GetContent(lootReq.LootID, false)
 }
 
 // Rm - Remove a piece of loot
-func (l *LocalBackend) Rm(lootID string) error {
+func (l *StorageBackend) Rm(lootID string) error {
 	dbSession := db.Session()
 	lootUUID, err := uuid.FromString(lootID)
 	if err != nil {
@@ -131,29 +149,24 @@ func (l *LocalBackend) Rm(lootID string) error {
 	}
 
 	// File Loot
-	lootLocalFile := filepath.Join(l.LocalFileDir, dbLoot.ID.String())
-	if _, err := os.Stat(lootLocalFile); !os.IsNotExist(err) {
-		err = os.Remove(lootLocalFile)
-		if err != nil {
-			lootLog.Error(err)
-		}
+	err = l.Store.Delete(lootFileKey(dbLoot.ID.String()))
+	if err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+		lootLog.Error(err)
 	}
 
 	// Credential Loot
-	lootCredFile := filepath.Join(l.LocalCredDir, dbLoot.ID.String())
-	if _, err := os.Stat(lootCredFile); !os.IsNotExist(err) {
-		err = os.Remove(lootCredFile)
-		if err != nil {
-			lootLog.Error(err)
-		}
+	err = l.Store.Delete(lootCredentialKey(dbLoot.ID.String()))
+	if err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+		lootLog.Error(err)
 	}
 
 	result = dbSession.Delete(&dbLoot)
 	return result.Error
 }
 
-// GetContent - Get the content of a piece of loot
-func (l *LocalBackend) GetContent(lootID string, eager bool) (*clientpb.Loot, error) {
+// GetContent - Get the content of a piece of loot, unless eager is set file loot
+// is not fetched from the store if the file name is known from the database
+func (l *StorageBackend) GetContent(lootID string, eager bool) (*clientpb.Loot, error) {
 	dbSession := db.Session()
 	lootUUID, err := uuid.FromString(lootID)
 	if err != nil {
@@ -173,28 +186,39 @@ func (l *LocalBackend) GetContent(lootID string, eager bool) (*clientpb.Loot, er
 		FileType:       clientpb.FileType(dbLoot.FileType),
 		CredentialType: clientpb.CredentialType(dbLoot.CredentialType),
 	}
+	if dbLoot.OriginHost != uuid.Nil {
+		loot.OriginHostUUID = dbLoot.OriginHost.String()
+	}
 
 	// File Loot
-	lootLocalFile := filepath.Join(l.LocalFileDir, dbLoot.ID.String())
-	if _, err := os.Stat(lootLocalFile); !os.IsNotExist(err) {
-		data, err := ioutil.ReadFile(lootLocalFile)
+	if !eager && dbLoot.FileName != "" {
+		exists, err := l.Store.Exists(lootFileKey(dbLoot.ID.String()))
 		if err != nil {
 			return nil, err
 		}
-		loot.File = &commonpb.File{}
-		err = proto.Unmarshal(data, loot.File)
-		if err != nil {
+		if exists {
+			loot.File = &commonpb.File{Name: dbLoot.FileName}
+		}
+	} else {
+		data, err := l.Store.Get(lootFileKey(dbLoot.ID.String()))
+		if err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
 			return nil, err
 		}
+		if err == nil {
+			loot.File = &commonpb.File{}
+			err = proto.Unmarshal(data, loot.File)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Credential Loot
-	lootCredFile := filepath.Join(l.LocalCredDir, dbLoot.ID.String())
-	if _, err := os.Stat(lootCredFile); !os.IsNotExist(err) {
-		data, err := ioutil.ReadFile(lootCredFile)
-		if err != nil {
-			return nil, err
-		}
+	data, err := l.Store.Get(lootCredentialKey(dbLoot.ID.String()))
+	if err != nil && !errors.Is(err, storage.ErrObjectNotFound) {
+		return nil, err
+	}
+	if err == nil {
 		loot.Credential = &clientpb.Credential{}
 		err = proto.Unmarshal(data, loot.Credential)
 		if err != nil {
@@ -206,7 +230,7 @@ func (l *LocalBackend) GetContent(lootID string, eager bool) (*clientpb.Loot, er
 }
 
 // All - Get all loot
-func (l *LocalBackend) All() *clientpb.AllLoot {
+func (l *StorageBackend) All() *clientpb.AllLoot {
 	dbSession := db.Session()
 	allDBLoot := []*models.Loot{}
 	result := dbSession.Where(&models.Loot{}).Find(&allDBLoot)
@@ -230,7 +254,7 @@ func (l *LocalBackend) All() *clientpb.AllLoot {
 }
 
 // AllOf - Get all loot of a particular loot type
-func (l *LocalBackend) AllOf(lootType clientpb.LootType) *clientpb.AllLoot {
+func (l *StorageBackend) AllOf(lootType clientpb.LootType) *clientpb.AllLoot {
 	dbSession := db.Session()
 	allDBLoot := []*models.Loot{}
 	result := dbSession.Where("type == ?", int(lootType)).Find(&allDBLoot)
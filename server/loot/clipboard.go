@@ -0,0 +1,75 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// SaveClipboardEntries - Save each captured clipboard entry as a file, loot is
+// named after the host and the time the entry was captured
+func (l *LootStore) SaveClipboardEntries(hostname string, lootName string, entries []*sliverpb.ClipboardEntry) error {
+	for _, entry := range entries {
+		if len(entry.Data) == 0 {
+			continue
+		}
+		capturedAt := time.Unix(entry.Timestamp, 0).UTC().Format("20060102150405")
+		fileName := fmt.Sprintf("clipboard_%s_%s%s", hostname, capturedAt, clipboardExt(entry))
+		name := fileName
+		if lootName != "" {
+			name = lootName
+			if 1 < len(entries) {
+				name = fmt.Sprintf("%s (%s)", lootName, capturedAt)
+			}
+		}
+		fileType := clientpb.FileType_TEXT
+		if entry.Binary {
+			fileType = clientpb.FileType_BINARY
+		}
+		_, err := l.Add(&clientpb.Loot{
+			Type:     clientpb.LootType_LOOT_FILE,
+			FileType: fileType,
+			Name:     name,
+			File: &commonpb.File{
+				Name: fileName,
+				Data: entry.Data,
+			},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func clipboardExt(entry *sliverpb.ClipboardEntry) string {
+	if strings.HasPrefix(entry.Format, "image/") {
+		return "." + strings.TrimPrefix(entry.Format, "image/")
+	}
+	if entry.Binary {
+		return ".bin"
+	}
+	return ".txt"
+}
@@ -0,0 +1,177 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2021  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+const (
+	// Credential validation statuses reported by implants, expired and
+	// restricted credentials have the right password
+	CredentialValid      = "valid"
+	CredentialInvalid    = "invalid"
+	CredentialExpired    = "expired"
+	CredentialRestricted = "restricted"
+	CredentialSkipped    = "skipped"
+
+	// maxCredentialValidations - Older validations are dropped
+	maxCredentialValidations = 50
+)
+
+func credentialKey(user string, password string) string {
+	return strings.ToLower(user) + "\x00" + password
+}
+
+// CredentialFailures - The failed logins recorded per (lower case) user since a
+// point in time, across all credentials of the user
+func (l *LootStore) CredentialFailures(since time.Time) map[string]uint32 {
+	failures := map[string]uint32{}
+	for _, loot := range l.AllOf(clientpb.LootType_LOOT_CREDENTIAL).GetLoot() {
+		if loot.Credential == nil {
+			continue
+		}
+		for _, validation := range loot.Credential.Validations {
+			if validation.Status == CredentialInvalid && !time.Unix(validation.ValidatedAt, 0).Before(since) {
+				failures[strings.ToLower(loot.Credential.User)]++
+			}
+		}
+	}
+	return failures
+}
+
+// SaveCredentialResults - Record validation results on the credentials they were
+// made with, passwords found by spraying are added as new credential loot. Failed
+// spray attempts aren't saved.
+func (l *LootStore) SaveCredentialResults(results []*sliverpb.CredentialResult) error {
+	existing := map[string]string{}
+	for _, loot := range l.AllOf(clientpb.LootType_LOOT_CREDENTIAL).GetLoot() {
+		if loot.CredentialType == clientpb.CredentialType_USER_PASSWORD && loot.Credential != nil {
+			existing[credentialKey(loot.Credential.User, loot.Credential.Password)] = loot.LootID
+		}
+	}
+
+	lootIDs := []string{}
+	validations := map[string][]*clientpb.CredentialValidation{}
+	for _, result := range results {
+		if result.Status == CredentialSkipped {
+			continue
+		}
+		lootID := result.ID
+		if lootID == "" {
+			lootID = existing[credentialKey(result.User, result.Password)]
+		}
+		if lootID == "" {
+			if !passwordFound(result.Status) {
+				continue
+			}
+			loot, err := l.Add(&clientpb.Loot{
+				Type:           clientpb.LootType_LOOT_CREDENTIAL,
+				CredentialType: clientpb.CredentialType_USER_PASSWORD,
+				Name:           result.User,
+				Credential: &clientpb.Credential{
+					User:     result.User,
+					Password: result.Password,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			lootID = loot.LootID
+			existing[credentialKey(result.User, result.Password)] = lootID
+		}
+		if _, ok := validations[lootID]; !ok {
+			lootIDs = append(lootIDs, lootID)
+		}
+		validations[lootID] = append(validations[lootID], &clientpb.CredentialValidation{
+			Protocol:    result.Protocol,
+			Target:      result.Target,
+			Status:      result.Status,
+			Error:       result.Error,
+			ValidatedAt: result.Timestamp,
+		})
+	}
+
+	for _, lootID := range lootIDs {
+		loot, err := l.GetContent(lootID, false)
+		if err != nil {
+			lootLog.Errorf("Failed to save credential validations: %s", err)
+			continue
+		}
+		if loot.Credential == nil {
+			continue
+		}
+		loot.Credential.Validations = append(loot.Credential.Validations, validations[lootID]...)
+		if maxCredentialValidations < len(loot.Credential.Validations) {
+			loot.Credential.Validations = loot.Credential.Validations[len(loot.Credential.Validations)-maxCredentialValidations:]
+		}
+		loot.File = nil
+		_, err = l.Update(loot)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func passwordFound(status string) bool {
+	return status == CredentialValid || status == CredentialExpired || status == CredentialRestricted
+}
+
+// SaveNTLMCaptures - Add captured ntlm hashes as credential loot, returns the loot
+// that was added. Clients authenticate over and over so only the first hash of
+// each type is kept per user.
+func (l *LootStore) SaveNTLMCaptures(captures []*sliverpb.NTLMCapture) ([]*clientpb.Loot, error) {
+	existing := map[string]bool{}
+	for _, loot := range l.AllOf(clientpb.LootType_LOOT_CREDENTIAL).GetLoot() {
+		if loot.CredentialType == clientpb.CredentialType_HASH && loot.Credential != nil {
+			existing[credentialKey(loot.Credential.User, loot.Credential.HashType)] = true
+		}
+	}
+	added := []*clientpb.Loot{}
+	for _, capture := range captures {
+		user := capture.User
+		if capture.Domain != "" {
+			user = capture.Domain + `\` + capture.User
+		}
+		if existing[credentialKey(user, capture.HashType)] {
+			continue
+		}
+		loot, err := l.Add(&clientpb.Loot{
+			Type:           clientpb.LootType_LOOT_CREDENTIAL,
+			CredentialType: clientpb.CredentialType_HASH,
+			Name:           user + " (" + capture.HashType + ")",
+			Credential: &clientpb.Credential{
+				User:     user,
+				Hash:     capture.Hash,
+				HashType: capture.HashType,
+			},
+		})
+		if err != nil {
+			return added, err
+		}
+		existing[credentialKey(user, capture.HashType)] = true
+		added = append(added, loot)
+	}
+	return added, nil
+}
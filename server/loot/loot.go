@@ -25,6 +25,7 @@ import (
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/storage"
 )
 
 const (
@@ -94,11 +95,12 @@ func (l *LootStore) AllOf(lootType clientpb.LootType) *clientpb.AllLoot {
 
 // GetLootStore - Get an instances of the core LootStore
 func GetLootStore() *LootStore {
+	store, err := storage.New(storage.Loot)
+	if err != nil {
+		panic(err.Error())
+	}
 	return &LootStore{
-		backend: &LocalBackend{
-			LocalFileDir: GetLootFileDir(),
-			LocalCredDir: GetLootCredentialDir(),
-		},
+		backend: &StorageBackend{Store: store},
 	}
 }
 
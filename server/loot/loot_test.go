@@ -2,12 +2,17 @@ package loot
 
 import (
 	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
 	"crypto/rand"
 	insecureRand "math/rand"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/log"
 	"github.com/gofrs/uuid"
 )
@@ -265,3 +270,162 @@ func TestLootErrors(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestSaveCredentialResults(t *testing.T) {
+	lootStore := GetLootStore()
+	cred, err := lootStore.Add(&clientpb.Loot{
+		Type:           clientpb.LootType_LOOT_CREDENTIAL,
+		Name:           "validate-test",
+		CredentialType: clientpb.CredentialType_USER_PASSWORD,
+		Credential: &clientpb.Credential{
+			User:     `CORP\validate-test`,
+			Password: "wrong",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lootStore.Rm(cred.LootID)
+
+	now := time.Now()
+	err = lootStore.SaveCredentialResults([]*sliverpb.CredentialResult{
+		{ID: cred.LootID, User: cred.Credential.User, Password: "wrong", Protocol: "smb", Status: CredentialInvalid, Timestamp: now.Unix()},
+		{ID: cred.LootID, User: cred.Credential.User, Password: "wrong", Protocol: "ldap", Status: CredentialInvalid, Timestamp: now.Add(-time.Hour).Unix()},
+		{ID: cred.LootID, User: cred.Credential.User, Password: "wrong", Protocol: "winrm", Status: CredentialSkipped, Timestamp: now.Unix()},
+		// Spraying
+		{User: `corp\VALIDATE-TEST`, Password: "wrong", Protocol: "kerberos", Status: CredentialInvalid, Timestamp: now.Unix()},
+		{User: "validate-spray", Password: "nope", Protocol: "smb", Status: CredentialInvalid, Timestamp: now.Unix()},
+		{User: "validate-spray", Password: "Summer2023!", Protocol: "smb", Status: CredentialValid, Timestamp: now.Unix()},
+		{User: "validate-spray", Password: "Summer2023!", Protocol: "ldap", Status: CredentialValid, Timestamp: now.Unix()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cred, err = lootStore.GetContent(cred.LootID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cred.Credential.Validations) != 3 {
+		t.Fatalf("expected 3 validations, got %v", cred.Credential.Validations)
+	}
+	if cred.Name != "validate-test" {
+		t.Fatalf("saving validations renamed the loot %s", cred.Name)
+	}
+	failures := lootStore.CredentialFailures(now.Add(-time.Minute))
+	if failures[`corp\validate-test`] != 2 {
+		t.Fatalf("expected 2 recent failures, got %d", failures[`corp\validate-test`])
+	}
+
+	sprayed := 0
+	for _, loot := range lootStore.AllOf(clientpb.LootType_LOOT_CREDENTIAL).Loot {
+		if loot.Credential.GetUser() != "validate-spray" {
+			continue
+		}
+		sprayed++
+		defer lootStore.Rm(loot.LootID)
+		if loot.Credential.Password != "Summer2023!" || len(loot.Credential.Validations) != 2 {
+			t.Fatalf("unexpected sprayed credential %v", loot.Credential)
+		}
+	}
+	if sprayed != 1 {
+		t.Fatalf("expected 1 sprayed credential, got %d", sprayed)
+	}
+}
+
+func TestSaveNTLMCaptures(t *testing.T) {
+	lootStore := GetLootStore()
+	added, err := lootStore.SaveNTLMCaptures([]*sliverpb.NTLMCapture{
+		{User: "capture-test", Domain: "CORP", HashType: "NetNTLMv2", Hash: "capture-test::CORP:01:02:03"},
+		{User: "CAPTURE-TEST", Domain: "corp", HashType: "NetNTLMv2", Hash: "CAPTURE-TEST::corp:04:05:06"},
+		{User: "capture-test", Domain: "CORP", HashType: "NetNTLMv1", Hash: "capture-test::CORP:07:08:09"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, loot := range added {
+		defer lootStore.Rm(loot.LootID)
+	}
+	if len(added) != 2 {
+		t.Fatalf("expected 2 hashes, got %d", len(added))
+	}
+	if added[0].Name != `CORP\capture-test (NetNTLMv2)` || added[0].Credential.Hash != "capture-test::CORP:01:02:03" {
+		t.Fatalf("unexpected hash loot %v", added[0])
+	}
+
+	added, err = lootStore.SaveNTLMCaptures([]*sliverpb.NTLMCapture{
+		{User: "capture-test", Domain: "CORP", HashType: "NetNTLMv2", Hash: "capture-test::CORP:0a:0b:0c"},
+	})
+	if err != nil || len(added) != 0 {
+		t.Fatalf("hash of a known user was added again %v (%v)", added, err)
+	}
+}
+
+func TestSaveClipboardEntries(t *testing.T) {
+	lootStore := GetLootStore()
+	capturedAt := time.Date(2023, 3, 14, 15, 9, 26, 0, time.UTC).Unix()
+	err := lootStore.SaveClipboardEntries("clip-host", "", []*sliverpb.ClipboardEntry{
+		{Timestamp: capturedAt, Data: []byte("hunter2"), Format: "text"},
+		{Timestamp: capturedAt + 1, Data: []byte{0x89, 'P', 'N', 'G'}, Format: "image/png", Binary: true},
+		{Timestamp: capturedAt + 2, Data: []byte{}, Format: "text"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved := map[string]*clientpb.Loot{}
+	for _, loot := range lootStore.AllOf(clientpb.LootType_LOOT_FILE).Loot {
+		if strings.HasPrefix(loot.Name, "clipboard_clip-host_") {
+			saved[loot.Name] = loot
+			defer lootStore.Rm(loot.LootID)
+		}
+	}
+	if len(saved) != 2 {
+		t.Fatalf("expected 2 clipboard loot entries, got %d", len(saved))
+	}
+	text, ok := saved["clipboard_clip-host_20230314150926.txt"]
+	if !ok || text.FileType != clientpb.FileType_TEXT {
+		t.Fatalf("missing text entry %v", saved)
+	}
+	image, ok := saved["clipboard_clip-host_20230314150927.png"]
+	if !ok || image.FileType != clientpb.FileType_BINARY {
+		t.Fatalf("missing image entry %v", saved)
+	}
+	content, err := lootStore.GetContent(text.LootID, true)
+	if err != nil || string(content.File.Data) != "hunter2" {
+		t.Fatalf("unexpected text entry content %v (%v)", content, err)
+	}
+}
+
+func TestSaveLsassDump(t *testing.T) {
+	lootStore := GetLootStore()
+	key := make([]byte, 32)
+	rand.Read(key)
+	minidump := append([]byte("MDMP"), bytes.Repeat([]byte{0x41}, 100)...)
+	block, _ := aes.NewCipher(key)
+	encrypted := make([]byte, len(minidump))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(encrypted, minidump)
+
+	hostUUID := "7f4b0c5e-4a6b-4e1a-9d7c-2b8f4f0c1a2e"
+	req := &sliverpb.LsassDumpReq{Key: key}
+	dump := &sliverpb.LsassDump{Data: encrypted, Pid: 672}
+	wrongKey := &sliverpb.LsassDumpReq{Key: make([]byte, 32)}
+	if _, err := lootStore.SaveLsassDump(hostUUID, "lsass-host", wrongKey, dump); err != ErrInvalidLsassDump {
+		t.Fatalf("expected invalid dump error with the wrong key, got %v", err)
+	}
+	loot, err := lootStore.SaveLsassDump(hostUUID, "lsass-host", req, dump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lootStore.Rm(loot.LootID)
+	if dump.Data != nil || dump.LootID != loot.LootID {
+		t.Fatalf("dump was not updated after it was saved %v", dump)
+	}
+	if !strings.HasPrefix(loot.Name, "lsass_lsass-host_672_") || loot.OriginHostUUID != hostUUID {
+		t.Fatalf("unexpected loot %v", loot)
+	}
+	content, err := lootStore.GetContent(loot.LootID, true)
+	if err != nil || !bytes.Equal(content.File.Data, minidump) {
+		t.Fatalf("unexpected dump content (%v)", err)
+	}
+}
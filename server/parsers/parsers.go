@@ -0,0 +1,170 @@
+package parsers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// Plugins that take longer than this are killed
+	pluginTimeout = 30 * time.Second
+)
+
+var (
+	parsersLog = log.NamedLogger("parsers", "")
+
+	// ErrUnknownParser - No builtin parser or plugin with that name
+	ErrUnknownParser = errors.New("unknown output parser")
+	// ErrInvalidParserName - Plugin names can't be paths
+	ErrInvalidParserName = errors.New("invalid output parser name")
+
+	builtinParsers = map[string]Parser{
+		"user-pass":     parseUserPass,
+		"nmap-grepable": parseNmapGrepable,
+	}
+)
+
+// Parser - Converts the raw output of a tool into credentials and hosts
+type Parser func(output []byte) (*clientpb.ParsedOutput, error)
+
+// GetParsersDir - Get the directory that contains the parser plugins
+func GetParsersDir() string {
+	parsersDir := filepath.Join(assets.GetRootAppDir(), "parsers")
+	if _, err := os.Stat(parsersDir); os.IsNotExist(err) {
+		err = os.MkdirAll(parsersDir, 0700)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+	return parsersDir
+}
+
+// Parse - Parse the output with the builtin parser with this name, or the plugin
+// with this name if there is no such builtin parser
+func Parse(name string, output []byte) (*clientpb.ParsedOutput, error) {
+	if parser, ok := builtinParsers[name]; ok {
+		return parser(output)
+	}
+	return runPlugin(name, output)
+}
+
+// runPlugin - Plugins are executables in the parsers directory, they're given the
+// raw output on stdin and write a ParsedOutput as json to stdout
+func runPlugin(name string, output []byte) (*clientpb.ParsedOutput, error) {
+	if name == "" || name != filepath.Base(name) || strings.HasPrefix(name, ".") {
+		return nil, ErrInvalidParserName
+	}
+	pluginPath := filepath.Join(GetParsersDir(), name)
+	if _, err := os.Stat(pluginPath); os.IsNotExist(err) {
+		return nil, ErrUnknownParser
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), pluginTimeout)
+	defer cancel()
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.CommandContext(ctx, pluginPath)
+	cmd.Stdin = bytes.NewReader(output)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("parser %s failed: %s %s", name, err, strings.TrimSpace(stderr.String()))
+	}
+	parsed := &clientpb.ParsedOutput{}
+	err = protojson.Unmarshal(stdout.Bytes(), parsed)
+	if err != nil {
+		return nil, fmt.Errorf("parser %s returned invalid output: %s", name, err)
+	}
+	return parsed, nil
+}
+
+// Save - Add the parsed credentials to the loot store and the parsed hosts to the
+// hosts database, credentials that are already in the loot store are skipped.
+// Returns the new loot.
+func Save(source string, hostUUID string, parsed *clientpb.ParsedOutput) ([]*clientpb.Loot, error) {
+	added := []*clientpb.Loot{}
+	lootStore := loot.GetLootStore()
+	existing := lootStore.AllOf(clientpb.LootType_LOOT_CREDENTIAL)
+	for _, credential := range parsed.Credentials {
+		if isDuplicateCredential(existing, credential) {
+			continue
+		}
+		credentialType := clientpb.CredentialType_USER_PASSWORD
+		name := credential.User
+		if credential.APIKey != "" {
+			credentialType = clientpb.CredentialType_API_KEY
+			if name == "" {
+				name = "api key"
+			}
+		}
+		lootCredential, err := lootStore.Add(&clientpb.Loot{
+			Type:           clientpb.LootType_LOOT_CREDENTIAL,
+			Name:           fmt.Sprintf("%s (%s)", name, source),
+			CredentialType: credentialType,
+			Credential:     credential,
+		})
+		if err != nil {
+			return added, err
+		}
+		added = append(added, lootCredential)
+		if existing != nil {
+			existing.Loot = append(existing.Loot, &clientpb.Loot{Credential: credential})
+		}
+	}
+	for _, host := range parsed.Hosts {
+		err := db.SaveDiscoveredHost(hostUUID, host)
+		if err != nil {
+			parsersLog.Errorf("Failed to save host %s %s: %s", host.Hostname, host.Address, err)
+			return added, err
+		}
+	}
+	return added, nil
+}
+
+func isDuplicateCredential(existing *clientpb.AllLoot, credential *clientpb.Credential) bool {
+	if existing == nil {
+		return false
+	}
+	for _, lootCredential := range existing.Loot {
+		other := lootCredential.GetCredential()
+		if other == nil {
+			continue
+		}
+		if other.User == credential.User && other.Password == credential.Password && other.APIKey == credential.APIKey {
+			return true
+		}
+	}
+	return false
+}
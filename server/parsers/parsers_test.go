@@ -0,0 +1,130 @@
+package parsers
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"testing"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db"
+)
+
+func TestParseUserPass(t *testing.T) {
+	output := []byte("admin:hunter2\r\nCORP\\svc_sql:P@ss:word\nnot a credential: ok\nempty:\n:nouser\n")
+	parsed, err := Parse("user-pass", output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Credentials) != 2 {
+		t.Fatalf("expected 2 credentials, got %v", parsed.Credentials)
+	}
+	if parsed.Credentials[0].User != "admin" || parsed.Credentials[0].Password != "hunter2" {
+		t.Fatalf("unexpected credential %v", parsed.Credentials[0])
+	}
+	if parsed.Credentials[1].User != "CORP\\svc_sql" || parsed.Credentials[1].Password != "P@ss:word" {
+		t.Fatalf("unexpected credential %v", parsed.Credentials[1])
+	}
+}
+
+func TestParseNmapGrepable(t *testing.T) {
+	output := []byte(`# Nmap 7.94 scan initiated as: nmap -sV -oG - 10.0.0.0/24
+Host: 10.0.0.1 (dc01.corp.local)	Status: Up
+Host: 10.0.0.1 (dc01.corp.local)	Ports: 53/open/tcp//domain//Simple DNS Plus/, 88/filtered/tcp//kerberos-sec///, 389/open/tcp//ldap///	Ignored State: closed (997)
+Host: 10.0.0.2 ()	Status: Up
+# Nmap done at Mon Jan  1 00:00:00 2024 -- 256 IP addresses (2 hosts up) scanned
+`)
+	parsed, err := Parse("nmap-grepable", output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(parsed.Hosts) != 2 {
+		t.Fatalf("expected 2 hosts, got %v", parsed.Hosts)
+	}
+	dc := parsed.Hosts[0]
+	if dc.Address != "10.0.0.1" || dc.Hostname != "dc01.corp.local" {
+		t.Fatalf("unexpected host %v", dc)
+	}
+	if len(dc.Services) != 2 {
+		t.Fatalf("expected only the open ports, got %v", dc.Services)
+	}
+	if dc.Services[0].Port != 53 || dc.Services[0].Protocol != "tcp" || dc.Services[0].Name != "domain" || dc.Services[0].Version != "Simple DNS Plus" {
+		t.Fatalf("unexpected service %v", dc.Services[0])
+	}
+	if parsed.Hosts[1].Address != "10.0.0.2" || parsed.Hosts[1].Hostname != "" {
+		t.Fatalf("unexpected host %v", parsed.Hosts[1])
+	}
+}
+
+func TestParseUnknown(t *testing.T) {
+	if _, err := Parse("does-not-exist", nil); err != ErrUnknownParser {
+		t.Fatalf("expected unknown parser error, got %v", err)
+	}
+	for _, name := range []string{"", "../user-pass", ".hidden", "/bin/sh"} {
+		if _, err := Parse(name, nil); err != ErrInvalidParserName {
+			t.Fatalf("expected invalid parser name error for %q, got %v", name, err)
+		}
+	}
+}
+
+func TestSave(t *testing.T) {
+	parsed := &clientpb.ParsedOutput{
+		Credentials: []*clientpb.Credential{{User: "save-test", Password: "hunter2"}},
+		Hosts: []*clientpb.Host{{
+			Hostname: "save-test.corp.local",
+			Address:  "10.0.0.3",
+			Services: []*clientpb.HostService{{Port: 445, Protocol: "tcp", Name: "microsoft-ds"}},
+		}},
+	}
+	added, err := Save("save-test", "", parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 1 {
+		t.Fatalf("expected 1 new credential, got %d", len(added))
+	}
+	// Saving the same output again shouldn't add anything
+	added, err = Save("save-test", "", parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(added) != 0 {
+		t.Fatalf("duplicate credential was added %v", added)
+	}
+
+	hosts, err := db.ListHosts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, host := range hosts {
+		if host.Hostname != "save-test.corp.local" {
+			continue
+		}
+		if found {
+			t.Fatal("host was added twice")
+		}
+		found = true
+		if host.Address != "10.0.0.3" || len(host.Services) != 1 || host.Services[0].Port != 445 {
+			t.Fatalf("unexpected host %v", host.ToProtobuf())
+		}
+	}
+	if !found {
+		t.Fatal("host was not saved")
+	}
+}
@@ -0,0 +1,233 @@
+package playbooks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	playbooksDirName = "playbooks"
+
+	// maxStepTimeout - Seconds, beacons can take much longer than this to check in
+	// but the implant only has this long to run the task once it has it
+	maxStepTimeout = 24 * 60 * 60
+)
+
+var (
+	playbooksLog = log.NamedLogger("playbooks", "playbooks")
+
+	nameExp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+	// ErrInvalidName - Playbook, step and variable names are used in file names and templates
+	ErrInvalidName = errors.New("invalid name, names may only contain a-z, A-Z, 0-9, '_' and '-'")
+	// ErrPlaybookNotFound - No playbook with the name
+	ErrPlaybookNotFound = errors.New("playbook not found")
+	// ErrInvalidPlaybook - The playbook failed validation
+	ErrInvalidPlaybook = errors.New("invalid playbook")
+	// ErrMissingVariable - A required variable has no value
+	ErrMissingVariable = errors.New("missing required variable")
+)
+
+// GetPlaybooksDir - Playbooks are saved as json files in this directory
+func GetPlaybooksDir() string {
+	return filepath.Join(assets.GetRootAppDir(), playbooksDirName)
+}
+
+// List - All of the saved playbooks, playbooks that fail to load are skipped
+func List() ([]*clientpb.Playbook, error) {
+	playbooks := []*clientpb.Playbook{}
+	entries, err := os.ReadDir(GetPlaybooksDir())
+	if os.IsNotExist(err) {
+		return playbooks, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") || !nameExp.MatchString(name) {
+			continue
+		}
+		playbook, err := Get(name)
+		if err != nil {
+			playbooksLog.Warnf("Failed to load playbook %s: %s", name, err)
+			continue
+		}
+		playbooks = append(playbooks, playbook)
+	}
+	return playbooks, nil
+}
+
+// Get - Load and validate a playbook
+func Get(name string) (*clientpb.Playbook, error) {
+	if !nameExp.MatchString(name) {
+		return nil, ErrInvalidName
+	}
+	data, err := os.ReadFile(filepath.Join(GetPlaybooksDir(), name+".json"))
+	if os.IsNotExist(err) {
+		return nil, fmt.Errorf("%w '%s'", ErrPlaybookNotFound, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	playbook := &clientpb.Playbook{}
+	err = json.Unmarshal(data, playbook)
+	if err != nil {
+		return nil, err
+	}
+	return playbook, Validate(playbook)
+}
+
+// Save - Validate and save a playbook, an existing playbook with the same
+// name is replaced
+func Save(playbook *clientpb.Playbook) error {
+	err := Validate(playbook)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(playbook, "", "    ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(GetPlaybooksDir(), 0700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(GetPlaybooksDir(), playbook.Name+".json"), data, 0600)
+}
+
+// Remove - Delete a saved playbook, runs that already started are not affected
+func Remove(name string) error {
+	if !nameExp.MatchString(name) {
+		return ErrInvalidName
+	}
+	err := os.Remove(filepath.Join(GetPlaybooksDir(), name+".json"))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%w '%s'", ErrPlaybookNotFound, name)
+	}
+	return err
+}
+
+// Validate - Check a playbook's names, tasks, and that every template only
+// references declared variables, builtins, or the results of earlier steps
+func Validate(playbook *clientpb.Playbook) error {
+	if !nameExp.MatchString(playbook.Name) {
+		return ErrInvalidName
+	}
+	if len(playbook.Steps) == 0 {
+		return fmt.Errorf("%w: no steps", ErrInvalidPlaybook)
+	}
+	known := map[string]bool{}
+	for _, builtin := range builtinVariables {
+		known[builtin] = true
+	}
+	for _, variable := range playbook.Variables {
+		if !nameExp.MatchString(variable.Name) {
+			return fmt.Errorf("%w: variable '%s': %s", ErrInvalidPlaybook, variable.Name, ErrInvalidName)
+		}
+		if known[variable.Name] {
+			return fmt.Errorf("%w: variable '%s' is already defined", ErrInvalidPlaybook, variable.Name)
+		}
+		known[variable.Name] = true
+	}
+	for _, step := range playbook.Steps {
+		if err := validateStep(step, known); err != nil {
+			return fmt.Errorf("%w: step '%s': %s", ErrInvalidPlaybook, step.Name, err)
+		}
+		// Later steps can use this step's results
+		for _, field := range resultFields(step) {
+			known[step.Name+"."+field] = true
+		}
+	}
+	return nil
+}
+
+func validateStep(step *clientpb.PlaybookStep, known map[string]bool) error {
+	if !nameExp.MatchString(step.Name) {
+		return ErrInvalidName
+	}
+	if known[step.Name+".ok"] {
+		return errors.New("duplicate step name")
+	}
+	task, ok := tasks[step.Task]
+	if !ok {
+		return fmt.Errorf("%w '%s'", ErrUnknownTask, step.Task)
+	}
+	for name, value := range step.Args {
+		if !task.accepts(name) {
+			return fmt.Errorf("%s does not take a '%s' argument", step.Task, name)
+		}
+		if err := checkReferences(value, known); err != nil {
+			return err
+		}
+	}
+	for _, name := range task.required {
+		if step.Args[name] == "" {
+			return fmt.Errorf("%s requires a '%s' argument", step.Task, name)
+		}
+	}
+	if step.Timeout < 0 || maxStepTimeout < step.Timeout {
+		return fmt.Errorf("timeout must be between 0 and %d seconds", maxStepTimeout)
+	}
+	return checkReferences(step.When, known)
+}
+
+func checkReferences(template string, known map[string]bool) error {
+	for _, name := range references(template) {
+		if !known[name] {
+			return fmt.Errorf("%w '%s'", ErrUnknownVariable, name)
+		}
+	}
+	return nil
+}
+
+// Variables - Resolve the values of a playbook's declared variables, unknown
+// variables and missing required variables are errors
+func Variables(playbook *clientpb.Playbook, values map[string]string) (map[string]string, error) {
+	declared := map[string]bool{}
+	variables := map[string]string{}
+	for _, variable := range playbook.Variables {
+		declared[variable.Name] = true
+		value, ok := values[variable.Name]
+		if !ok {
+			value = variable.Default
+		}
+		if variable.Required && value == "" {
+			return nil, fmt.Errorf("%w '%s'", ErrMissingVariable, variable.Name)
+		}
+		variables[variable.Name] = value
+	}
+	for name := range values {
+		if !declared[name] {
+			return nil, fmt.Errorf("%w '%s'", ErrUnknownVariable, name)
+		}
+	}
+	return variables, nil
+}
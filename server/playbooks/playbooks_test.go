@@ -0,0 +1,232 @@
+package playbooks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func testPlaybook() *clientpb.Playbook {
+	return &clientpb.Playbook{
+		Name: "collect-share",
+		Variables: []*clientpb.PlaybookVariable{
+			{Name: "share", Required: true},
+			{Name: "outfile", Default: "c:\\windows\\temp\\out.txt"},
+		},
+		Steps: []*clientpb.PlaybookStep{
+			{
+				Name: "list",
+				Task: "execute",
+				Args: map[string]string{"path": "cmd.exe", "args": "/c dir {{share}} > {{outfile}}"},
+			},
+			{
+				Name:     "cleanup",
+				Task:     "rm",
+				Args:     map[string]string{"path": "{{outfile}}"},
+				When:     "{{list.status}} == 0",
+				Approval: true,
+			},
+			{
+				Name: "retry",
+				Task: "ls",
+				Args: map[string]string{"path": "{{share}}"},
+				When: "!{{list.ok}}",
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate(testPlaybook()); err != nil {
+		t.Fatalf("valid playbook failed to validate: %s", err)
+	}
+	for name, change := range map[string]func(*clientpb.Playbook){
+		"bad name":         func(p *clientpb.Playbook) { p.Name = "../collect" },
+		"no steps":         func(p *clientpb.Playbook) { p.Steps = nil },
+		"unknown task":     func(p *clientpb.Playbook) { p.Steps[0].Task = "format" },
+		"unknown argument": func(p *clientpb.Playbook) { p.Steps[0].Args["cwd"] = "c:\\" },
+		"missing argument": func(p *clientpb.Playbook) { delete(p.Steps[1].Args, "path") },
+		"unknown variable": func(p *clientpb.Playbook) { p.Steps[0].Args["args"] = "{{sharee}}" },
+		"later step":       func(p *clientpb.Playbook) { p.Steps[0].When = "{{cleanup.ok}}" },
+		"unknown field":    func(p *clientpb.Playbook) { p.Steps[2].When = "{{list.path}}" },
+		"duplicate step":   func(p *clientpb.Playbook) { p.Steps[2].Name = "list" },
+		"builtin variable": func(p *clientpb.Playbook) { p.Variables[0].Name = "host" },
+	} {
+		playbook := testPlaybook()
+		change(playbook)
+		if err := Validate(playbook); !errors.Is(err, ErrInvalidPlaybook) && !errors.Is(err, ErrInvalidName) {
+			t.Errorf("%s: expected a validation error, got %v", name, err)
+		}
+	}
+}
+
+func TestVariables(t *testing.T) {
+	playbook := testPlaybook()
+	variables, err := Variables(playbook, map[string]string{"share": "\\\\fs01\\it"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if variables["outfile"] != "c:\\windows\\temp\\out.txt" {
+		t.Errorf("default was not used: %v", variables)
+	}
+	if _, err := Variables(playbook, map[string]string{}); !errors.Is(err, ErrMissingVariable) {
+		t.Errorf("expected a missing variable error, got %v", err)
+	}
+	if _, err := Variables(playbook, map[string]string{"share": "x", "other": "y"}); !errors.Is(err, ErrUnknownVariable) {
+		t.Errorf("expected an unknown variable error, got %v", err)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	variables := map[string]string{"a.status": "0", "a.ok": "true", "a.stdout": "Access is denied.\r\n", "b.ok": "false"}
+	for condition, expected := range map[string]bool{
+		"":                                  true,
+		"{{a.ok}}":                          true,
+		"{{b.ok}}":                          false,
+		"!{{b.ok}}":                         true,
+		"{{a.status}} == 0":                 true,
+		"{{a.status}} != 0":                 false,
+		"{{a.stdout}} contains 'is denied'": true,
+		"{{a.stdout}} matches ^Access":      true,
+		"'{{a.status}}' == \"0\"":           true,
+	} {
+		result, err := evaluate(condition, variables)
+		if err != nil {
+			t.Errorf("%q: %s", condition, err)
+			continue
+		}
+		if result != expected {
+			t.Errorf("%q: expected %v", condition, expected)
+		}
+	}
+	if _, err := evaluate("{{c.ok}}", variables); !errors.Is(err, ErrUnknownVariable) {
+		t.Errorf("expected an unknown variable error, got %v", err)
+	}
+}
+
+func TestSaveGetRemove(t *testing.T) {
+	playbook := testPlaybook()
+	playbook.Name = "save-test"
+	if err := Save(playbook); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := Get("save-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(saved, playbook) {
+		t.Errorf("saved playbook does not match")
+	}
+	if err := Remove("save-test"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Get("save-test"); !errors.Is(err, ErrPlaybookNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+type testTarget struct {
+	requests []proto.Message
+}
+
+func (t *testTarget) setup(pbRun *clientpb.PlaybookRun, variables map[string]string) {
+	pbRun.Hostname = "ws01"
+	setBuiltins(variables, "ws01", "windows", "amd64", "CORP\\alice", "TEST_IMPLANT", 42)
+}
+
+func (t *testTarget) request(message proto.Message, timeout time.Duration) ([]byte, error) {
+	t.requests = append(t.requests, message)
+	switch req := message.(type) {
+	case *sliverpb.ExecuteReq:
+		return proto.Marshal(&sliverpb.Execute{Status: 0, Stdout: []byte("ok")})
+	case *sliverpb.RmReq:
+		return proto.Marshal(&sliverpb.Rm{Path: req.Path})
+	default:
+		return proto.Marshal(&sliverpb.Ls{Response: &commonpb.Response{Err: "unexpected"}})
+	}
+}
+
+func TestRun(t *testing.T) {
+	playbook := testPlaybook()
+	variables, _ := Variables(playbook, map[string]string{"share": "\\\\fs01\\it"})
+	target := &testTarget{}
+	r := start(playbook, variables, target, "operator")
+
+	waitFor(t, r, func(pbRun *clientpb.PlaybookRun) bool { return pbRun.State == StateAwaitingApproval })
+	if _, err := Approve(r.pbRun.ID[:8], "approver", false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Approve(r.pbRun.ID, "approver", false); err != ErrNotAwaitingApproval {
+		t.Errorf("approved the same step twice: %v", err)
+	}
+	waitFor(t, r, func(pbRun *clientpb.PlaybookRun) bool { return pbRun.CompletedAt != 0 })
+
+	pbRun := r.toProtobuf()
+	if pbRun.State != StateCompleted {
+		t.Fatalf("expected the run to complete, got %s", pbRun.State)
+	}
+	states := []string{StateCompleted, StateCompleted, StateSkipped}
+	for index, step := range pbRun.Steps {
+		if step.State != states[index] {
+			t.Errorf("step %s: expected %s, got %s (%s)", step.Name, states[index], step.State, step.Err)
+		}
+	}
+	if pbRun.Steps[1].ApprovedBy != "approver" {
+		t.Errorf("approver was not recorded: %q", pbRun.Steps[1].ApprovedBy)
+	}
+	if len(target.requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(target.requests))
+	}
+	execute := target.requests[0].(*sliverpb.ExecuteReq)
+	if execute.Args[1] != "dir" || execute.Args[2] != "\\\\fs01\\it" || execute.Args[4] != "c:\\windows\\temp\\out.txt" {
+		t.Errorf("variables were not rendered: %v", execute.Args)
+	}
+}
+
+func TestRunRejected(t *testing.T) {
+	playbook := testPlaybook()
+	variables, _ := Variables(playbook, map[string]string{"share": "x"})
+	r := start(playbook, variables, &testTarget{}, "operator")
+	waitFor(t, r, func(pbRun *clientpb.PlaybookRun) bool { return pbRun.State == StateAwaitingApproval })
+	if _, err := Approve(r.pbRun.ID, "approver", true); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, r, func(pbRun *clientpb.PlaybookRun) bool { return pbRun.CompletedAt != 0 })
+	pbRun := r.toProtobuf()
+	if pbRun.State != StateRejected || pbRun.Steps[1].State != StateRejected || pbRun.Steps[2].State != StatePending {
+		t.Errorf("unexpected states after rejecting: %s %v", pbRun.State, pbRun.Steps)
+	}
+}
+
+func waitFor(t *testing.T, r *run, condition func(*clientpb.PlaybookRun) bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition(r.toProtobuf()) {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting on run, state %s", r.toProtobuf().State)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,531 @@
+package playbooks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/engagement"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/gofrs/uuid"
+	"google.golang.org/protobuf/proto"
+)
+
+// Run and step states
+const (
+	StatePending          = "pending"
+	StateRunning          = "running"
+	StateAwaitingApproval = "awaiting-approval"
+	StateCompleted        = "completed"
+	StateFailed           = "failed"
+	StateRejected         = "rejected"
+	StateSkipped          = "skipped"
+)
+
+const (
+	defaultStepTimeout = 60 * time.Second
+	beaconPollInterval = 2 * time.Second
+
+	// maxOutput - Bytes of each step's output kept with the run, the variables
+	// later steps use are not truncated
+	maxOutput = 4096
+
+	// maxRuns - Finished runs are forgotten once there are more than this
+	maxRuns = 100
+)
+
+var (
+	// ErrRunNotFound - No run with the id
+	ErrRunNotFound = errors.New("playbook run not found")
+	// ErrNotAwaitingApproval - The run isn't waiting on an operator
+	ErrNotAwaitingApproval = errors.New("playbook run is not waiting for approval")
+	// ErrNoTargets - The run request didn't select any sessions or beacons
+	ErrNoTargets = errors.New("no sessions or beacons to run the playbook against")
+	// ErrInvalidTarget - The session or beacon doesn't exist
+	ErrInvalidTarget = errors.New("invalid session or beacon")
+
+	errRejected = errors.New("step rejected")
+
+	runsMutex = &sync.Mutex{}
+	runs      = []*run{} // In the order they were started
+)
+
+// target - A session or beacon that a playbook runs against
+type target interface {
+	setup(pbRun *clientpb.PlaybookRun, variables map[string]string)
+	request(message proto.Message, timeout time.Duration) ([]byte, error)
+}
+
+type sessionTarget struct {
+	session *core.Session
+}
+
+func (s *sessionTarget) setup(pbRun *clientpb.PlaybookRun, variables map[string]string) {
+	pbRun.SessionID = s.session.ID
+	pbRun.Name = s.session.Name
+	pbRun.Hostname = s.session.Hostname
+	setBuiltins(variables, s.session.Hostname, s.session.OS, s.session.Arch, s.session.Username, s.session.Name, s.session.PID)
+}
+
+func (s *sessionTarget) request(message proto.Message, timeout time.Duration) ([]byte, error) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	return s.session.Request(sliverpb.MsgNumber(message), timeout, data)
+}
+
+type beaconTarget struct {
+	beacon *models.Beacon
+}
+
+func (b *beaconTarget) setup(pbRun *clientpb.PlaybookRun, variables map[string]string) {
+	pbRun.BeaconID = b.beacon.ID.String()
+	pbRun.Name = b.beacon.Name
+	pbRun.Hostname = b.beacon.Hostname
+	setBuiltins(variables, b.beacon.Hostname, b.beacon.OS, b.beacon.Arch, b.beacon.Username, b.beacon.Name, b.beacon.PID)
+}
+
+// request - Queue a beacon task and wait for the beacon to complete it
+func (b *beaconTarget) request(message proto.Message, timeout time.Duration) ([]byte, error) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	task, err := b.beacon.Task(&sliverpb.Envelope{
+		Type:    sliverpb.MsgNumber(message),
+		Data:    data,
+		Timeout: int64(timeout),
+	})
+	if err != nil {
+		return nil, err
+	}
+	task.Description = string(message.ProtoReflect().Descriptor().Name())
+	task.Timeout = int64(timeout)
+	err = db.Session().Save(task).Error
+	if err != nil {
+		return nil, err
+	}
+	for {
+		time.Sleep(beaconPollInterval)
+		task, err = db.BeaconTaskByID(task.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		switch task.State {
+		case models.COMPLETED:
+			return task.Response, nil
+		case models.CANCELED, models.TIMEOUT:
+			return nil, fmt.Errorf("beacon task %s", task.State)
+		}
+	}
+}
+
+func setBuiltins(variables map[string]string, hostname, os, arch, username, name string, pid int32) {
+	variables["host"] = hostname
+	variables["os"] = os
+	variables["arch"] = arch
+	variables["username"] = username
+	variables["name"] = name
+	variables["pid"] = strconv.Itoa(int(pid))
+}
+
+// targets - The sessions and beacons a run request selects, a tag selects one
+// implant on each host with the tag and sessions are preferred to beacons
+func targets(req *clientpb.PlaybookRunReq) ([]target, error) {
+	if req.SessionID != "" {
+		session := core.Sessions.Get(req.SessionID)
+		if session == nil {
+			return nil, ErrInvalidTarget
+		}
+		return []target{&sessionTarget{session: session}}, nil
+	}
+	if req.BeaconID != "" {
+		beacon, err := db.BeaconByID(req.BeaconID)
+		if err != nil || beacon == nil {
+			return nil, ErrInvalidTarget
+		}
+		return []target{&beaconTarget{beacon: beacon}}, nil
+	}
+	if req.Tag == "" {
+		return nil, ErrNoTargets
+	}
+	hosts, err := db.ListHosts()
+	if err != nil {
+		return nil, err
+	}
+	tagged := map[string]bool{}
+	for _, host := range hosts {
+		if host.HasTag(req.Tag) {
+			tagged[host.HostUUID.String()] = true
+		}
+	}
+	selected := []target{}
+	for _, session := range core.Sessions.All() {
+		if tagged[session.UUID] {
+			selected = append(selected, &sessionTarget{session: session})
+			delete(tagged, session.UUID)
+		}
+	}
+	beacons, err := db.ListBeacons()
+	if err != nil {
+		return nil, err
+	}
+	for _, beacon := range beacons {
+		if tagged[beacon.UUID.String()] {
+			selected = append(selected, &beaconTarget{beacon: beacon})
+			delete(tagged, beacon.UUID.String())
+		}
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("%w with tag '%s'", ErrNoTargets, req.Tag)
+	}
+	return selected, nil
+}
+
+type approval struct {
+	operator string
+	reject   bool
+}
+
+// run - A playbook running against one target
+type run struct {
+	mutex     *sync.Mutex
+	pbRun     *clientpb.PlaybookRun
+	playbook  *clientpb.Playbook
+	target    target
+	variables map[string]string
+	approvals chan approval
+}
+
+// Run - Start a playbook against each target the request selects
+func Run(req *clientpb.PlaybookRunReq, operator string) (*clientpb.PlaybookRuns, error) {
+	playbook, err := Get(req.Name)
+	if err != nil {
+		return nil, err
+	}
+	variables, err := Variables(playbook, req.Variables)
+	if err != nil {
+		return nil, err
+	}
+	selected, err := targets(req)
+	if err != nil {
+		return nil, err
+	}
+	started := &clientpb.PlaybookRuns{Runs: []*clientpb.PlaybookRun{}}
+	for _, selectedTarget := range selected {
+		r := start(playbook, variables, selectedTarget, operator)
+		started.Runs = append(started.Runs, r.toProtobuf())
+	}
+	return started, nil
+}
+
+func start(playbook *clientpb.Playbook, variables map[string]string, runTarget target, operator string) *run {
+	id, _ := uuid.NewV4()
+	r := &run{
+		mutex: &sync.Mutex{},
+		pbRun: &clientpb.PlaybookRun{
+			ID:        id.String(),
+			Playbook:  playbook.Name,
+			Operator:  operator,
+			State:     StateRunning,
+			Variables: variables,
+			Steps:     []*clientpb.PlaybookStepResult{},
+			StartedAt: time.Now().Unix(),
+		},
+		playbook:  playbook,
+		target:    runTarget,
+		variables: map[string]string{},
+		approvals: make(chan approval, 1),
+	}
+	for name, value := range variables {
+		r.variables[name] = value
+	}
+	runTarget.setup(r.pbRun, r.variables)
+	for _, step := range playbook.Steps {
+		r.pbRun.Steps = append(r.pbRun.Steps, &clientpb.PlaybookStepResult{
+			Name:  step.Name,
+			State: StatePending,
+		})
+	}
+	playbooksLog.Infof("%s started playbook %s on %s (run %s)", operator, playbook.Name, r.pbRun.Hostname, r.pbRun.ID)
+
+	runsMutex.Lock()
+	runs = append(runs, r)
+	forgetFinishedRuns()
+	runsMutex.Unlock()
+	go r.execute()
+	return r
+}
+
+// forgetFinishedRuns - Drop the oldest finished runs, the caller must hold runsMutex
+func forgetFinishedRuns() {
+	kept := []*run{}
+	extra := len(runs) - maxRuns
+	for _, r := range runs {
+		if 0 < extra && r.finished() {
+			extra--
+			continue
+		}
+		kept = append(kept, r)
+	}
+	runs = kept
+}
+
+// Runs - All of the runs we remember
+func Runs() *clientpb.PlaybookRuns {
+	runsMutex.Lock()
+	defer runsMutex.Unlock()
+	pbRuns := &clientpb.PlaybookRuns{Runs: []*clientpb.PlaybookRun{}}
+	for _, r := range runs {
+		pbRuns.Runs = append(pbRuns.Runs, r.toProtobuf())
+	}
+	return pbRuns
+}
+
+// Approve - Approve or reject the step a run is waiting on, runs can be
+// selected by a unique prefix of their id
+func Approve(runID string, operator string, reject bool) (*clientpb.PlaybookRun, error) {
+	runsMutex.Lock()
+	var selected *run
+	for _, r := range runs {
+		if runID != "" && strings.HasPrefix(r.pbRun.ID, runID) {
+			if selected != nil {
+				runsMutex.Unlock()
+				return nil, fmt.Errorf("%w, '%s' matches more than one run", ErrRunNotFound, runID)
+			}
+			selected = r
+		}
+	}
+	runsMutex.Unlock()
+	if selected == nil {
+		return nil, ErrRunNotFound
+	}
+
+	selected.mutex.Lock()
+	if selected.pbRun.State != StateAwaitingApproval {
+		selected.mutex.Unlock()
+		return nil, ErrNotAwaitingApproval
+	}
+	// Change the state now so the step can't be approved twice
+	selected.pbRun.State = StateRunning
+	selected.mutex.Unlock()
+	selected.approvals <- approval{operator: operator, reject: reject}
+	return selected.toProtobuf(), nil
+}
+
+func (r *run) toProtobuf() *clientpb.PlaybookRun {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return proto.Clone(r.pbRun).(*clientpb.PlaybookRun)
+}
+
+func (r *run) finished() bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.pbRun.CompletedAt != 0
+}
+
+// update - Change the run while holding its lock, events are published for
+// the changes operators need to act on or will want to hear about
+func (r *run) update(publish bool, change func(pbRun *clientpb.PlaybookRun)) {
+	r.mutex.Lock()
+	change(r.pbRun)
+	var data []byte
+	if publish {
+		data, _ = proto.Marshal(r.pbRun)
+	}
+	r.mutex.Unlock()
+	if publish {
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.PlaybookEvent,
+			Data:      data,
+		})
+	}
+}
+
+func (r *run) execute() {
+	state := StateCompleted
+	for index, step := range r.playbook.Steps {
+		err := r.executeStep(index, step)
+		if err == errRejected {
+			state = StateRejected
+			break
+		}
+		if err != nil && !step.ContinueOnError {
+			state = StateFailed
+			break
+		}
+	}
+	r.update(true, func(pbRun *clientpb.PlaybookRun) {
+		pbRun.State = state
+		pbRun.CompletedAt = time.Now().Unix()
+	})
+	playbooksLog.Infof("Playbook %s on %s %s (run %s)", r.playbook.Name, r.pbRun.Hostname, state, r.pbRun.ID)
+}
+
+func (r *run) executeStep(index int, step *clientpb.PlaybookStep) error {
+	for _, field := range resultFields(step) {
+		r.variables[step.Name+"."+field] = ""
+	}
+	r.variables[step.Name+".ok"] = "false"
+	ok, err := evaluate(step.When, r.variables)
+	if err != nil {
+		r.finishStep(index, StateFailed, err, "")
+		return err
+	}
+	if !ok {
+		r.variables[step.Name+".skipped"] = "true"
+		r.finishStep(index, StateSkipped, nil, "")
+		return nil
+	}
+
+	approvedBy := ""
+	if step.Approval {
+		r.update(true, func(pbRun *clientpb.PlaybookRun) {
+			pbRun.State = StateAwaitingApproval
+			pbRun.Steps[index].State = StateAwaitingApproval
+		})
+		decision := <-r.approvals
+		approvedBy = decision.operator
+		r.update(false, func(pbRun *clientpb.PlaybookRun) {
+			pbRun.Steps[index].ApprovedBy = decision.operator
+		})
+		if decision.reject {
+			playbooksLog.Infof("%s rejected step %s of run %s", decision.operator, step.Name, r.pbRun.ID)
+			r.finishStep(index, StateRejected, nil, "")
+			return errRejected
+		}
+	}
+
+	r.update(false, func(pbRun *clientpb.PlaybookRun) {
+		pbRun.Steps[index].State = StateRunning
+		pbRun.Steps[index].StartedAt = time.Now().Unix()
+	})
+	results := map[string]string{}
+	err = r.runTask(step, approvedBy, results)
+	for field, value := range results {
+		r.variables[step.Name+"."+field] = value
+	}
+	if err != nil {
+		r.variables[step.Name+".err"] = err.Error()
+		r.finishStep(index, StateFailed, err, results["output"])
+		return err
+	}
+	r.variables[step.Name+".ok"] = "true"
+	r.finishStep(index, StateCompleted, nil, results["output"])
+	return nil
+}
+
+func (r *run) finishStep(index int, state string, err error, output string) {
+	if maxOutput < len(output) {
+		output = output[:maxOutput]
+	}
+	r.update(false, func(pbRun *clientpb.PlaybookRun) {
+		pbRun.Steps[index].State = state
+		pbRun.Steps[index].Output = output
+		pbRun.Steps[index].CompletedAt = time.Now().Unix()
+		if err != nil {
+			pbRun.Steps[index].Err = err.Error()
+		}
+	})
+}
+
+func (r *run) runTask(step *clientpb.PlaybookStep, approvedBy string, results map[string]string) error {
+	if err := engagement.Check(); err != nil {
+		return err
+	}
+	task := tasks[step.Task]
+	args := map[string]string{}
+	for name, value := range step.Args {
+		var err error
+		args[name], err = render(value, r.variables)
+		if err != nil {
+			return err
+		}
+	}
+	req, err := task.request(args)
+	if err != nil {
+		return err
+	}
+	timeout := defaultStepTimeout
+	if 0 < step.Timeout {
+		timeout = time.Duration(step.Timeout) * time.Second
+	}
+	r.audit(step, approvedBy, req)
+	data, err := r.target.request(req, timeout)
+	if err != nil {
+		return err
+	}
+	resp := task.response()
+	err = proto.Unmarshal(data, resp)
+	if err != nil {
+		return err
+	}
+	if resp.GetResponse() != nil && resp.GetResponse().Err != "" {
+		return errors.New(resp.GetResponse().Err)
+	}
+	return task.results(resp, args, r.pbRun.Hostname, results)
+}
+
+type auditPlaybookStepMsg struct {
+	RunID      string
+	Playbook   string
+	Step       string
+	Operator   string
+	ApprovedBy string
+	SessionID  string
+	BeaconID   string
+	Hostname   string
+	Request    string
+}
+
+// audit - Every task a playbook sends is written to the audit log with the
+// operator who started the run and the operator who approved the step
+func (r *run) audit(step *clientpb.PlaybookStep, approvedBy string, req proto.Message) {
+	reqData, _ := json.Marshal(req)
+	msg, err := json.Marshal(auditPlaybookStepMsg{
+		RunID:      r.pbRun.ID,
+		Playbook:   r.playbook.Name,
+		Step:       step.Name,
+		Operator:   r.pbRun.Operator,
+		ApprovedBy: approvedBy,
+		SessionID:  r.pbRun.SessionID,
+		BeaconID:   r.pbRun.BeaconID,
+		Hostname:   r.pbRun.Hostname,
+		Request:    string(reqData),
+	})
+	if err != nil {
+		playbooksLog.Errorf("Failed to log playbook step to audit log: %s", err)
+		return
+	}
+	log.AuditLogger.Info(string(msg))
+}
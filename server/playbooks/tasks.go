@@ -0,0 +1,300 @@
+package playbooks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/loot"
+	"github.com/bishopfox/sliver/util/encoders"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// ErrUnknownTask - The step's task isn't one of the task templates
+	ErrUnknownTask = errors.New("unknown task")
+
+	// commonFields - Results every step has
+	commonFields = []string{"ok", "err", "output", "skipped"}
+
+	tasks = map[string]*task{
+		"execute": {
+			args:     []string{"path", "args", "output"},
+			required: []string{"path"},
+			fields:   []string{"status", "stdout", "stderr"},
+			request: func(args map[string]string) (proto.Message, error) {
+				output, err := boolArg(args, "output", true)
+				if err != nil {
+					return nil, err
+				}
+				return &sliverpb.ExecuteReq{
+					Path:   args["path"],
+					Args:   strings.Fields(args["args"]),
+					Output: output,
+				}, nil
+			},
+			response: func() response { return &sliverpb.Execute{} },
+			results: func(resp response, _ map[string]string, _ string, results map[string]string) error {
+				execute := resp.(*sliverpb.Execute)
+				results["status"] = strconv.Itoa(int(execute.Status))
+				results["stdout"] = string(execute.Stdout)
+				results["stderr"] = string(execute.Stderr)
+				results["output"] = string(execute.Stdout) + string(execute.Stderr)
+				return nil
+			},
+		},
+		"ls": {
+			args:   []string{"path"},
+			fields: []string{"path", "exists", "count"},
+			request: func(args map[string]string) (proto.Message, error) {
+				lsPath := args["path"]
+				if lsPath == "" {
+					lsPath = "."
+				}
+				return &sliverpb.LsReq{Path: lsPath}, nil
+			},
+			response: func() response { return &sliverpb.Ls{} },
+			results: func(resp response, _ map[string]string, _ string, results map[string]string) error {
+				ls := resp.(*sliverpb.Ls)
+				names := []string{}
+				for _, file := range ls.Files {
+					names = append(names, file.Name)
+				}
+				results["path"] = ls.Path
+				results["exists"] = strconv.FormatBool(ls.Exists)
+				results["count"] = strconv.Itoa(len(ls.Files))
+				results["output"] = strings.Join(names, "\n")
+				return nil
+			},
+		},
+		"cd": {
+			args:     []string{"path"},
+			required: []string{"path"},
+			fields:   []string{"path"},
+			request: func(args map[string]string) (proto.Message, error) {
+				return &sliverpb.CdReq{Path: args["path"]}, nil
+			},
+			response: func() response { return &sliverpb.Pwd{} },
+			results:  pwdResults,
+		},
+		"pwd": {
+			fields: []string{"path"},
+			request: func(args map[string]string) (proto.Message, error) {
+				return &sliverpb.PwdReq{}, nil
+			},
+			response: func() response { return &sliverpb.Pwd{} },
+			results:  pwdResults,
+		},
+		"mkdir": {
+			args:     []string{"path"},
+			required: []string{"path"},
+			fields:   []string{"path"},
+			request: func(args map[string]string) (proto.Message, error) {
+				return &sliverpb.MkdirReq{Path: args["path"]}, nil
+			},
+			response: func() response { return &sliverpb.Mkdir{} },
+			results: func(resp response, _ map[string]string, _ string, results map[string]string) error {
+				results["path"] = resp.(*sliverpb.Mkdir).Path
+				results["output"] = results["path"]
+				return nil
+			},
+		},
+		"rm": {
+			args:     []string{"path", "recursive", "force"},
+			required: []string{"path"},
+			fields:   []string{"path"},
+			request: func(args map[string]string) (proto.Message, error) {
+				recursive, err := boolArg(args, "recursive", false)
+				if err != nil {
+					return nil, err
+				}
+				force, err := boolArg(args, "force", false)
+				if err != nil {
+					return nil, err
+				}
+				return &sliverpb.RmReq{Path: args["path"], Recursive: recursive, Force: force}, nil
+			},
+			response: func() response { return &sliverpb.Rm{} },
+			results: func(resp response, _ map[string]string, _ string, results map[string]string) error {
+				results["path"] = resp.(*sliverpb.Rm).Path
+				results["output"] = results["path"]
+				return nil
+			},
+		},
+		"download": {
+			args:     []string{"path", "name"},
+			required: []string{"path"},
+			fields:   []string{"path", "size", "loot"},
+			request: func(args map[string]string) (proto.Message, error) {
+				return &sliverpb.DownloadReq{Path: args["path"]}, nil
+			},
+			response: func() response { return &sliverpb.Download{} },
+			results:  downloadResults,
+		},
+		"ps": {
+			fields: []string{"count"},
+			request: func(args map[string]string) (proto.Message, error) {
+				return &sliverpb.PsReq{}, nil
+			},
+			response: func() response { return &sliverpb.Ps{} },
+			results: func(resp response, _ map[string]string, _ string, results map[string]string) error {
+				lines := []string{}
+				for _, process := range resp.(*sliverpb.Ps).Processes {
+					lines = append(lines, fmt.Sprintf("%d\t%d\t%s\t%s", process.Pid, process.Ppid, process.Owner, process.Executable))
+				}
+				results["count"] = strconv.Itoa(len(lines))
+				results["output"] = strings.Join(lines, "\n")
+				return nil
+			},
+		},
+		"ifconfig": {
+			fields: []string{"addresses"},
+			request: func(args map[string]string) (proto.Message, error) {
+				return &sliverpb.IfconfigReq{}, nil
+			},
+			response: func() response { return &sliverpb.Ifconfig{} },
+			results: func(resp response, _ map[string]string, _ string, results map[string]string) error {
+				lines := []string{}
+				addresses := []string{}
+				for _, iface := range resp.(*sliverpb.Ifconfig).NetInterfaces {
+					lines = append(lines, fmt.Sprintf("%s\t%s", iface.Name, strings.Join(iface.IPAddresses, ", ")))
+					addresses = append(addresses, iface.IPAddresses...)
+				}
+				results["addresses"] = strings.Join(addresses, ",")
+				results["output"] = strings.Join(lines, "\n")
+				return nil
+			},
+		},
+	}
+)
+
+// response - Implant responses to a task
+type response interface {
+	proto.Message
+	GetResponse() *commonpb.Response
+}
+
+// task - A typed task template, the args are rendered before request is called
+// and results sets the step's result variables from the implant's response
+type task struct {
+	args     []string
+	required []string
+	fields   []string
+	request  func(args map[string]string) (proto.Message, error)
+	response func() response
+	results  func(resp response, args map[string]string, hostname string, results map[string]string) error
+}
+
+func (t *task) accepts(name string) bool {
+	for _, arg := range t.args {
+		if arg == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Tasks - Names of the task templates
+func Tasks() []string {
+	names := []string{}
+	for name := range tasks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resultFields - The {{step.field}}s a step sets
+func resultFields(step *clientpb.PlaybookStep) []string {
+	fields := append([]string{}, commonFields...)
+	if task, ok := tasks[step.Task]; ok {
+		fields = append(fields, task.fields...)
+	}
+	return fields
+}
+
+func boolArg(args map[string]string, name string, defaultValue bool) (bool, error) {
+	if args[name] == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseBool(args[name])
+	if err != nil {
+		return false, fmt.Errorf("invalid '%s' argument '%s'", name, args[name])
+	}
+	return value, nil
+}
+
+func pwdResults(resp response, _ map[string]string, _ string, results map[string]string) error {
+	results["path"] = resp.(*sliverpb.Pwd).Path
+	results["output"] = results["path"]
+	return nil
+}
+
+// downloadResults - Downloads are saved as loot, the step's name argument is
+// used as the loot's name
+func downloadResults(resp response, args map[string]string, hostname string, results map[string]string) error {
+	download := resp.(*sliverpb.Download)
+	if !download.Exists {
+		return fmt.Errorf("%s does not exist", download.Path)
+	}
+	data := download.Data
+	if download.Encoder == "gzip" {
+		var err error
+		data, err = new(encoders.Gzip).Decode(data)
+		if err != nil {
+			return err
+		}
+	}
+	fileName := path.Base(strings.ReplaceAll(download.Path, "\\", "/"))
+	if download.IsDir {
+		fileName += ".tar.gz"
+	}
+	name := args["name"]
+	if name == "" {
+		name = fmt.Sprintf("%s:%s", hostname, download.Path)
+	}
+	saved, err := loot.GetLootStore().Add(&clientpb.Loot{
+		Type:     clientpb.LootType_LOOT_FILE,
+		FileType: clientpb.FileType_BINARY,
+		Name:     name,
+		File:     &commonpb.File{Name: fileName, Data: data},
+	})
+	if err != nil {
+		return err
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+		Data:      []byte(saved.LootID),
+	})
+	results["path"] = download.Path
+	results["size"] = strconv.Itoa(len(data))
+	results["loot"] = saved.LootID
+	results["output"] = fmt.Sprintf("saved %d bytes as loot %s", len(data), saved.LootID)
+	return nil
+}
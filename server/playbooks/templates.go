@@ -0,0 +1,125 @@
+package playbooks
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	templateExp = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_.-]+)\s*\}\}`)
+
+	// builtinVariables - Set from the target a playbook runs against
+	builtinVariables = []string{"host", "os", "arch", "username", "name", "pid"}
+
+	// conditionOps - Operators are surrounded by spaces so they can't be
+	// confused with the values they compare
+	conditionOps = []string{" == ", " != ", " contains ", " matches "}
+
+	// ErrUnknownVariable - A template references a variable that isn't defined
+	ErrUnknownVariable = errors.New("unknown variable")
+)
+
+// render - Replace {{variable}}s with their values
+func render(template string, variables map[string]string) (string, error) {
+	var err error
+	rendered := templateExp.ReplaceAllStringFunc(template, func(match string) string {
+		name := templateExp.FindStringSubmatch(match)[1]
+		value, ok := variables[name]
+		if !ok && err == nil {
+			err = fmt.Errorf("%w '%s'", ErrUnknownVariable, name)
+		}
+		return value
+	})
+	return rendered, err
+}
+
+// references - Names of the variables a template uses
+func references(template string) []string {
+	names := []string{}
+	for _, match := range templateExp.FindAllStringSubmatch(template, -1) {
+		names = append(names, match[1])
+	}
+	return names
+}
+
+// evaluate - A condition is either a single value, which is true unless it's
+// empty, "false" or "0" and can be negated with a leading '!', or two values
+// compared with ==, !=, contains or matches (a regular expression). Values can
+// be quoted to keep leading and trailing spaces.
+func evaluate(condition string, variables map[string]string) (bool, error) {
+	condition = strings.TrimSpace(condition)
+	if condition == "" {
+		return true, nil
+	}
+	for _, op := range conditionOps {
+		index := strings.Index(condition, op)
+		if index < 0 {
+			continue
+		}
+		left, err := render(unquote(condition[:index]), variables)
+		if err != nil {
+			return false, err
+		}
+		right, err := render(unquote(condition[index+len(op):]), variables)
+		if err != nil {
+			return false, err
+		}
+		switch strings.TrimSpace(op) {
+		case "==":
+			return left == right, nil
+		case "!=":
+			return left != right, nil
+		case "contains":
+			return strings.Contains(left, right), nil
+		default:
+			exp, err := regexp.Compile(right)
+			if err != nil {
+				return false, err
+			}
+			return exp.MatchString(left), nil
+		}
+	}
+	negate := strings.HasPrefix(condition, "!")
+	value, err := render(unquote(strings.TrimPrefix(condition, "!")), variables)
+	if err != nil {
+		return false, err
+	}
+	return truthy(value) != negate, nil
+}
+
+func truthy(value string) bool {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "false", "0":
+		return false
+	default:
+		return true
+	}
+}
+
+func unquote(value string) string {
+	value = strings.TrimSpace(value)
+	if 2 <= len(value) && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
@@ -21,6 +21,8 @@ package rpc
 import (
 	"fmt"
 
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/db/models"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,5 +49,40 @@ var (
 	// ErrBuildExists
 	ErrBuildExists = status.Error(codes.AlreadyExists, "Build already exists")
 
-	ErrInvalidBeaconTaskCancelState = status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid task state, must be '%s' to cancel", models.PENDING))
+	ErrInvalidBeaconTaskCancelState = status.Error(codes.InvalidArgument, fmt.Sprintf("Invalid task state, must be '%s' or '%s' to cancel", models.PENDING, models.SENT))
+	ErrInvalidBeaconTaskReorder     = status.Error(codes.InvalidArgument, "Invalid task, only pending tasks can be reordered")
+
+	// ErrUnsupportedOnTarget - The implant does not have a handler for the request
+	ErrUnsupportedOnTarget = status.Error(codes.Unimplemented, "Command unsupported on this OS or implant version")
+
+	// ErrNotWGSession - The request only applies to sessions connected over wg
+	ErrNotWGSession = status.Error(codes.InvalidArgument, "Session is not connected over wg")
+
+	// ErrInvalidChunkHash - Upload chunk hashes are hex encoded sha256 sums
+	ErrInvalidChunkHash = status.Error(codes.InvalidArgument, "Invalid upload chunk hash")
+	// ErrChunkMismatch - The upload chunk's data does not match its hash
+	ErrChunkMismatch = status.Error(codes.InvalidArgument, "Upload chunk does not match its hash")
+	// ErrChunkNotCached - An upload referenced a chunk the server does not have
+	ErrChunkNotCached = status.Error(codes.FailedPrecondition, "Upload chunk is not cached")
+
+	// errorCodes - gRPC status codes for implant error codes, so automation can
+	// branch on the type of failure
+	errorCodes = map[commonpb.ErrorCode]codes.Code{
+		commonpb.ErrorCode_ACCESS_DENIED:     codes.PermissionDenied,
+		commonpb.ErrorCode_NOT_FOUND:         codes.NotFound,
+		commonpb.ErrorCode_ALREADY_EXISTS:    codes.AlreadyExists,
+		commonpb.ErrorCode_UNSUPPORTED_OS:    codes.Unimplemented,
+		commonpb.ErrorCode_PAYLOAD_TOO_LARGE: codes.ResourceExhausted,
+		commonpb.ErrorCode_TIMEOUT:           codes.DeadlineExceeded,
+		commonpb.ErrorCode_INVALID_ARGUMENT:  codes.InvalidArgument,
+	}
 )
+
+// responseError - Convert an implant's error response to a gRPC status error
+func responseError(resp *commonpb.Response) error {
+	code, ok := errorCodes[resp.ErrCode]
+	if !ok {
+		code = codes.Unknown
+	}
+	return status.Error(code, sliverpb.ErrorMessage(resp))
+}
@@ -0,0 +1,46 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// AdcsEnum - Enumerate the AD CS certificate authorities and templates of a session's domain
+func (rpc *Server) AdcsEnum(ctx context.Context, req *sliverpb.AdcsEnumReq) (*sliverpb.AdcsEnum, error) {
+	resp := &sliverpb.AdcsEnum{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AdcsRequest - Request a certificate from a CA's web enrollment endpoint
+func (rpc *Server) AdcsRequest(ctx context.Context, req *sliverpb.AdcsRequestReq) (*sliverpb.AdcsRequest, error) {
+	resp := &sliverpb.AdcsRequest{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,89 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/generate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ShellcodeArtifactConvert - Convert a .NET assembly to shellcode and save it as an artifact
+func (rpc *Server) ShellcodeArtifactConvert(ctx context.Context, req *clientpb.ShellcodeArtifactReq) (*clientpb.ShellcodeArtifact, error) {
+	artifact, err := generate.ShellcodeArtifactFromAssembly(req, rpc.getClientCommonName(ctx))
+	if err != nil {
+		return nil, artifactError(err)
+	}
+	rpcLog.Infof("%s converted %s to shellcode artifact %s (%d bytes)", artifact.Operator, req.FileName, artifact.Name, artifact.Size)
+	return artifact.ToProtobuf(), nil
+}
+
+// ShellcodeArtifacts - List the saved shellcode artifacts
+func (rpc *Server) ShellcodeArtifacts(ctx context.Context, _ *commonpb.Empty) (*clientpb.ShellcodeArtifacts, error) {
+	artifacts, err := db.ListShellcodeArtifacts()
+	if err != nil {
+		return nil, ErrDatabaseFailure
+	}
+	resp := &clientpb.ShellcodeArtifacts{Artifacts: []*clientpb.ShellcodeArtifact{}}
+	for _, artifact := range artifacts {
+		resp.Artifacts = append(resp.Artifacts, artifact.ToProtobuf())
+	}
+	return resp, nil
+}
+
+// ShellcodeArtifactContent - Get an artifact with its shellcode
+func (rpc *Server) ShellcodeArtifactContent(ctx context.Context, req *clientpb.ShellcodeArtifact) (*clientpb.ShellcodeArtifact, error) {
+	artifact, data, err := generate.ShellcodeArtifactData(req.Name)
+	if err != nil {
+		return nil, artifactError(err)
+	}
+	resp := artifact.ToProtobuf()
+	resp.Data = data
+	return resp, nil
+}
+
+// ShellcodeArtifactRm - Remove an artifact
+func (rpc *Server) ShellcodeArtifactRm(ctx context.Context, req *clientpb.ShellcodeArtifact) (*commonpb.Empty, error) {
+	err := generate.ShellcodeArtifactDelete(req.Name)
+	if err != nil {
+		return nil, artifactError(err)
+	}
+	rpcLog.Infof("%s removed shellcode artifact %s", rpc.getClientCommonName(ctx), req.Name)
+	return &commonpb.Empty{}, nil
+}
+
+func artifactError(err error) error {
+	switch {
+	case errors.Is(err, generate.ErrArtifactNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, generate.ErrArtifactExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, generate.ErrInvalidArtifactName), errors.Is(err, generate.ErrNotDotNetAssembly),
+		errors.Is(err, generate.ErrUnsupportedShellcodeArch):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
@@ -122,7 +122,7 @@ func (rpc *Server) Backdoor(ctx context.Context, req *sliverpb.BackdoorReq) (*sl
 	}
 
 	if upload.Response != nil && upload.Response.Err != "" {
-		return nil, fmt.Errorf(upload.Response.Err)
+		return nil, responseError(upload.Response)
 	}
 
 	return resp, nil
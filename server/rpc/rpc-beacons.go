@@ -77,6 +77,13 @@ func (rpc *Server) RmBeacon(ctx context.Context, req *clientpb.Beacon) (*commonp
 		beaconRpcLog.Errorf("Database error: %s", err)
 		return nil, ErrDatabaseFailure
 	}
+	err = db.Session().Where(&models.BeaconAddress{
+		BeaconID: beacon.ID},
+	).Delete(&models.BeaconAddress{}).Error
+	if err != nil {
+		beaconRpcLog.Errorf("Database error: %s", err)
+		return nil, ErrDatabaseFailure
+	}
 	err = db.Session().Delete(beacon).Error
 	if err != nil {
 		beaconRpcLog.Errorf("Database error: %s", err)
@@ -108,26 +115,75 @@ func (rpc *Server) GetBeaconTaskContent(ctx context.Context, req *clientpb.Beaco
 	return task.ToProtobuf(true), nil
 }
 
-// CancelBeaconTask - Cancel a beacon task
+// CancelBeaconTask - Cancel a beacon task, tasks that were already sent are
+// canceled by the beacon on its next check in
 func (rpc *Server) CancelBeaconTask(ctx context.Context, req *clientpb.BeaconTask) (*clientpb.BeaconTask, error) {
 	task, err := db.BeaconTaskByID(req.ID)
 	if err != nil {
 		return nil, ErrInvalidBeaconTaskID
 	}
-	if task.State == models.PENDING {
+	switch task.State {
+	case models.PENDING:
 		task.State = models.CANCELED
-		err = db.Session().Save(task).Error
-		if err != nil {
-			beaconRpcLog.Errorf("Database error: %s", err)
-			return nil, ErrDatabaseFailure
-		}
-	} else {
-		// No real point to cancel the task if it's already been sent
+	case models.SENT:
+		task.State = models.CANCELED
+		task.CancelPending = true
+	default:
 		return task.ToProtobuf(false), ErrInvalidBeaconTaskCancelState
 	}
+	err = db.Session().Save(task).Error
+	if err != nil {
+		beaconRpcLog.Errorf("Database error: %s", err)
+		return nil, ErrDatabaseFailure
+	}
 	task, err = db.BeaconTaskByID(req.ID)
 	if err != nil {
 		return nil, ErrInvalidBeaconTaskID
 	}
 	return task.ToProtobuf(false), nil
 }
+
+// ReorderBeaconTasks - Move pending tasks to the front of a beacon's queue, in the
+// order given, the remaining pending tasks keep their order behind them
+func (rpc *Server) ReorderBeaconTasks(ctx context.Context, req *clientpb.BeaconTaskReorderReq) (*clientpb.BeaconTasks, error) {
+	beacon, err := db.BeaconByID(req.BeaconID)
+	if err != nil {
+		return nil, ErrInvalidBeaconID
+	}
+	pending, err := db.PendingBeaconTasksByBeaconID(beacon.ID.String())
+	if err != nil {
+		beaconRpcLog.Errorf("Database error: %s", err)
+		return nil, ErrDatabaseFailure
+	}
+	pendingByID := map[string]*models.BeaconTask{}
+	for _, task := range pending {
+		pendingByID[task.ID.String()] = task
+	}
+	ordered := []*models.BeaconTask{}
+	for _, taskID := range req.TaskIDs {
+		task, ok := pendingByID[taskID]
+		if !ok {
+			return nil, ErrInvalidBeaconTaskReorder
+		}
+		delete(pendingByID, taskID)
+		ordered = append(ordered, task)
+	}
+	for _, task := range pending {
+		if _, ok := pendingByID[task.ID.String()]; ok {
+			ordered = append(ordered, task)
+		}
+	}
+
+	// Priorities are always > 0 so tasks queued later are sent after these
+	tasks := []*clientpb.BeaconTask{}
+	for index, task := range ordered {
+		task.Priority = len(ordered) - index
+		err = db.Session().Model(task).Update("priority", task.Priority).Error
+		if err != nil {
+			beaconRpcLog.Errorf("Database error: %s", err)
+			return nil, ErrDatabaseFailure
+		}
+		tasks = append(tasks, task.ToProtobuf(false))
+	}
+	return &clientpb.BeaconTasks{Tasks: tasks}, nil
+}
@@ -0,0 +1,67 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2019  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcC2ProfilesLog = log.NamedLogger("rpc", "c2profiles")
+)
+
+// GetHTTPC2Profiles - List the saved http c2 profiles
+func (rpc *Server) GetHTTPC2Profiles(ctx context.Context, _ *commonpb.Empty) (*clientpb.HTTPC2Profiles, error) {
+	names, err := configs.ListHTTPC2Profiles()
+	if err != nil {
+		return nil, err
+	}
+	profiles := &clientpb.HTTPC2Profiles{Profiles: []*clientpb.HTTPC2Profile{}}
+	for _, name := range names {
+		config, err := configs.GetHTTPC2Profile(name)
+		if err != nil {
+			rpcC2ProfilesLog.Warnf("Failed to load http c2 profile %s: %s", name, err)
+			continue
+		}
+		data, _ := json.MarshalIndent(config, "", "    ")
+		profiles.Profiles = append(profiles.Profiles, &clientpb.HTTPC2Profile{
+			Name:   name,
+			Config: data,
+		})
+	}
+	return profiles, nil
+}
+
+// SaveHTTPC2Profile - Validate and save a http c2 profile, existing profiles are replaced
+func (rpc *Server) SaveHTTPC2Profile(ctx context.Context, req *clientpb.HTTPC2Profile) (*commonpb.Empty, error) {
+	err := configs.SaveHTTPC2Profile(req.Name, req.Config)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	rpcC2ProfilesLog.Infof("Saved http c2 profile %s", req.Name)
+	return &commonpb.Empty{}, nil
+}
@@ -0,0 +1,128 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	consts "github.com/bishopfox/sliver/client/constants"
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/loot"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	credsLog = log.NamedLogger("rpc", "creds")
+)
+
+// ValidateCreds - Test credentials from the loot store against network services
+// from an implant, the results are saved back to the loot store
+func (rpc *Server) ValidateCreds(ctx context.Context, req *sliverpb.ValidateCredsReq) (*sliverpb.ValidateCreds, error) {
+	if req.Target == "" || len(req.Protocols) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "a target and at least one protocol are required")
+	}
+	lootStore := loot.GetLootStore()
+	err := credentialAttempts(lootStore, req)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Credentials) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no credentials to validate")
+	}
+	if 0 < req.LockoutWindow {
+		since := time.Now().Add(-time.Duration(req.LockoutWindow) * time.Second)
+		req.Failures = lootStore.CredentialFailures(since)
+	}
+
+	resp := &sliverpb.ValidateCreds{Response: &commonpb.Response{}}
+	err = rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Response.Async && resp.Response.Err == "" {
+		err = lootStore.SaveCredentialResults(resp.Results)
+		if err != nil {
+			credsLog.Errorf("Failed to save credential results: %s", err)
+		}
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.LootAddedEvent,
+		})
+	}
+	return resp, nil
+}
+
+// credentialAttempts - Fill in the credentials to try, spraying tries one password
+// against each user and otherwise we try the user/password loot
+func credentialAttempts(lootStore *loot.LootStore, req *sliverpb.ValidateCredsReq) error {
+	userPasswords := []*clientpb.Loot{}
+	for _, cred := range lootStore.AllOf(clientpb.LootType_LOOT_CREDENTIAL).GetLoot() {
+		if cred.CredentialType == clientpb.CredentialType_USER_PASSWORD && cred.Credential != nil {
+			userPasswords = append(userPasswords, cred)
+		}
+	}
+
+	if req.SprayPassword != "" {
+		users := req.SprayUsers
+		if len(users) == 0 {
+			seen := map[string]bool{}
+			for _, cred := range userPasswords {
+				if !seen[strings.ToLower(cred.Credential.User)] {
+					seen[strings.ToLower(cred.Credential.User)] = true
+					users = append(users, cred.Credential.User)
+				}
+			}
+		}
+		req.Credentials = []*sliverpb.CredentialAttempt{}
+		for _, user := range users {
+			req.Credentials = append(req.Credentials, &sliverpb.CredentialAttempt{
+				User:     user,
+				Password: req.SprayPassword,
+			})
+		}
+		req.SprayPassword = ""
+		req.SprayUsers = nil
+		return nil
+	}
+
+	if len(req.Credentials) == 0 {
+		for _, cred := range userPasswords {
+			req.Credentials = append(req.Credentials, &sliverpb.CredentialAttempt{ID: cred.LootID})
+		}
+	}
+	for _, attempt := range req.Credentials {
+		cred, err := lootStore.GetContent(attempt.ID, false)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "%s: %s", attempt.ID, err)
+		}
+		if cred.CredentialType != clientpb.CredentialType_USER_PASSWORD || cred.Credential == nil {
+			return status.Errorf(codes.InvalidArgument, "%s is not a user/password credential", attempt.ID)
+		}
+		attempt.User = cred.Credential.User
+		attempt.Password = cred.Credential.Password
+	}
+	return nil
+}
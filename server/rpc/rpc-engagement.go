@@ -0,0 +1,69 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/engagement"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetEngagement - When the engagement ends
+func (rpc *Server) GetEngagement(ctx context.Context, _ *commonpb.Empty) (*clientpb.Engagement, error) {
+	return engagementProtobuf(), nil
+}
+
+// SetEngagement - Change when the engagement ends, an end in the past kills every implant
+func (rpc *Server) SetEngagement(ctx context.Context, req *clientpb.Engagement) (*clientpb.Engagement, error) {
+	end := time.Time{}
+	if req.End != 0 {
+		end = time.Unix(req.End, 0)
+	}
+	rpcLog.Warnf("%s set the engagement end to %v", operatorName(ctx), end)
+	err := engagement.SetEnd(end)
+	if err != nil {
+		return nil, err
+	}
+	return engagementProtobuf(), nil
+}
+
+// checkEngagement - Tunnels and their streams don't carry a commonpb.Request, so
+// they aren't covered by the engagement interceptor
+func checkEngagement() error {
+	if err := engagement.Check(); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return nil
+}
+
+func engagementProtobuf() *clientpb.Engagement {
+	end := engagement.End()
+	if end.IsZero() {
+		return &clientpb.Engagement{}
+	}
+	return &clientpb.Engagement{
+		End:   end.Unix(),
+		Ended: engagement.Ended(time.Now()),
+	}
+}
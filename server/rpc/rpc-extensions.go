@@ -29,6 +29,9 @@ func (rpc *Server) ListExtensions(ctx context.Context, req *sliverpb.ListExtensi
 
 // CallExtension calls a specific export of the loaded extension
 func (rpc *Server) CallExtension(ctx context.Context, req *sliverpb.CallExtensionReq) (*sliverpb.CallExtension, error) {
+	if err := checkBypass(req.BypassTechnique); err != nil {
+		return nil, err
+	}
 	resp := &sliverpb.CallExtension{Response: &commonpb.Response{}}
 	err := rpc.GenericHandler(req, resp)
 	if err != nil {
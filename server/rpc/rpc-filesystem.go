@@ -29,6 +29,7 @@ import (
 	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/db/models"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/util/encoders"
 )
 
 var (
@@ -45,6 +46,17 @@ func (rpc *Server) Ls(ctx context.Context, req *sliverpb.LsReq) (*sliverpb.Ls, e
 	return resp, nil
 }
 
+// Search - Search the file system of the remote system, sessions stream matches
+// to clients as events while the search is running
+func (rpc *Server) Search(ctx context.Context, req *sliverpb.SearchReq) (*sliverpb.Search, error) {
+	resp := &sliverpb.Search{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Mv - Move or rename a file
 func (rpc *Server) Mv(ctx context.Context, req *sliverpb.MvReq) (*sliverpb.Mv, error) {
 	resp := &sliverpb.Mv{Response: &commonpb.Response{}}
@@ -107,6 +119,15 @@ func (rpc *Server) Download(ctx context.Context, req *sliverpb.DownloadReq) (*sl
 
 // Upload - Upload a file from the remote file system
 func (rpc *Server) Upload(ctx context.Context, req *sliverpb.UploadReq) (*sliverpb.Upload, error) {
+	if 0 < len(req.ChunkHashes) {
+		data, err := readUploadChunks(req.ChunkHashes)
+		if err != nil {
+			return nil, err
+		}
+		req.Data = new(encoders.Gzip).Encode(data)
+		req.Encoder = "gzip"
+		req.ChunkHashes = nil
+	}
 	resp := &sliverpb.Upload{Response: &commonpb.Response{}}
 	err := rpc.GenericHandler(req, resp)
 	if err != nil {
@@ -202,6 +223,10 @@ func trackIOC(req *sliverpb.UploadReq, resp *sliverpb.Upload) {
 		Path:     resp.Path,
 		FileHash: fmt.Sprintf("%x", sum),
 	}
+	if req.Chunked {
+		// Chunked uploads only ask us to track the last chunk
+		ioc.FileHash = req.FileHash
+	}
 	if db.Session().Create(ioc).Error != nil {
 		fsLog.Error("Failed to create IOC")
 	}
@@ -93,6 +93,11 @@ func (rpc *Server) Generate(ctx context.Context, req *clientpb.GenerateReq) (*cl
 		return nil, err
 	}
 
+	indicators, err := generate.ReadIndicatorReport(fPath)
+	if err != nil {
+		rpcLog.Warnf("Failed to read indicator report: %s", err)
+	}
+
 	core.EventBroker.Publish(core.Event{
 		EventType: consts.BuildCompletedEvent,
 		Data:      []byte(fileName),
@@ -103,7 +108,9 @@ func (rpc *Server) Generate(ctx context.Context, req *clientpb.GenerateReq) (*cl
 			Name: fileName,
 			Data: fileData,
 		},
-	}, err
+		Indicators:      indicators,
+		ObfuscationSeed: config.ObfuscationSeed,
+	}, nil
 }
 
 // Regenerate - Regenerate a previously generated implant
@@ -125,6 +132,7 @@ func (rpc *Server) Regenerate(ctx context.Context, req *clientpb.RegenerateReq)
 			Name: build.ImplantConfig.FileName,
 			Data: fileData,
 		},
+		ObfuscationSeed: build.ImplantConfig.ObfuscationSeed,
 	}, nil
 }
 
@@ -20,16 +20,33 @@ package rpc
 
 import (
 	"context"
+	"errors"
+	"regexp"
+	"strings"
 
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/parsers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // var (
 // 	hostsRPCLog = log.NamedLogger("rpc", "hosts")
 // )
 
+var (
+	hostTagExp = regexp.MustCompile(`^[a-z0-9_.-]+$`)
+
+	// ErrInvalidHostTag - Tags are lower case and can't contain commas or spaces
+	ErrInvalidHostTag = errors.New("invalid tag, tags may only contain a-z, 0-9, '_', '.' and '-'")
+)
+
 // Hosts - List all hosts
 func (rpc *Server) Hosts(ctx context.Context, _ *commonpb.Empty) (*clientpb.AllHosts, error) {
 	dbHosts, err := db.ListHosts()
@@ -77,3 +94,74 @@ func (rpc *Server) HostIOCRm(ctx context.Context, req *clientpb.IOC) (*commonpb.
 	}
 	return &commonpb.Empty{}, nil
 }
+
+// HostTags - Add or remove tags from a host
+func (rpc *Server) HostTags(ctx context.Context, req *clientpb.HostTagsReq) (*clientpb.Host, error) {
+	dbHost, err := db.HostByHostUUID(req.HostUUID)
+	if err != nil {
+		return nil, err
+	}
+	tags := dbHost.TagList()
+	for _, tag := range req.Tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !hostTagExp.MatchString(tag) {
+			return nil, status.Error(codes.InvalidArgument, ErrInvalidHostTag.Error())
+		}
+		if req.Remove {
+			tags = removeTag(tags, tag)
+		} else if len(removeTag(tags, tag)) == len(tags) {
+			tags = append(tags, tag)
+		}
+	}
+	dbHost.Tags = strings.Join(tags, ",")
+	err = db.Session().Model(&models.Host{}).Where(&models.Host{ID: dbHost.ID}).Update("Tags", dbHost.Tags).Error
+	if err != nil {
+		return nil, err
+	}
+	return dbHost.ToProtobuf(), nil
+}
+
+// HostSurvey - The last survey of a host
+func (rpc *Server) HostSurvey(ctx context.Context, req *clientpb.Host) (*sliverpb.Survey, error) {
+	survey, err := db.HostSurveyByHostUUID(req.HostUUID)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "host has not been surveyed")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return survey, nil
+}
+
+func removeTag(tags []string, tag string) []string {
+	kept := []string{}
+	for _, hostTag := range tags {
+		if hostTag != tag {
+			kept = append(kept, hostTag)
+		}
+	}
+	return kept
+}
+
+// ParseOutput - Parse the output of an extension or alias, parsed credentials are
+// added to the loot store and parsed hosts to the hosts database
+func (rpc *Server) ParseOutput(ctx context.Context, req *clientpb.ParseOutputReq) (*clientpb.ParsedOutput, error) {
+	parsed, err := parsers.Parse(req.Parser, req.Output)
+	if err == parsers.ErrUnknownParser || err == parsers.ErrInvalidParserName {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	added, err := parsers.Save(req.Name, req.HostUUID, parsed)
+	for _, loot := range added {
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.LootAddedEvent,
+			Data:      []byte(loot.LootID),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
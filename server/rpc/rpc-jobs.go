@@ -29,6 +29,7 @@ import (
 	"github.com/bishopfox/sliver/server/c2"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/db"
 )
 
 const (
@@ -39,6 +40,7 @@ const (
 	defaultDNSPort     = 53
 	defaultHTTPPort    = 80
 	defaultHTTPSPort   = 443
+	defaultUDPPort     = 443
 )
 
 var (
@@ -75,6 +77,7 @@ func (rpc *Server) KillJob(ctx context.Context, kill *clientpb.KillJobReq) (*cli
 		killJob.Success = true
 		if job.PersistentID != "" {
 			configs.GetServerConfig().RemoveJob(job.PersistentID)
+			db.DeleteListenerSettings(job.PersistentID)
 		}
 	} else {
 		killJob.Success = false
@@ -83,6 +86,16 @@ func (rpc *Server) KillJob(ctx context.Context, kill *clientpb.KillJobReq) (*cli
 	return killJob, err
 }
 
+// GetListenerSettings - Get the runtime settings of a listener
+func (rpc *Server) GetListenerSettings(ctx context.Context, req *clientpb.ListenerSettingsReq) (*clientpb.ListenerSettings, error) {
+	return c2.GetListenerSettings(int(req.JobID))
+}
+
+// UpdateListenerSettings - Change the runtime settings of a listener without restarting it
+func (rpc *Server) UpdateListenerSettings(ctx context.Context, req *clientpb.ListenerSettings) (*clientpb.ListenerSettings, error) {
+	return c2.UpdateListenerSettings(req)
+}
+
 // StartMTLSListener - Start an MTLS listener
 func (rpc *Server) StartMTLSListener(ctx context.Context, req *clientpb.MTLSListenerReq) (*clientpb.MTLSListener, error) {
 
@@ -160,7 +173,7 @@ func (rpc *Server) StartDNSListener(ctx context.Context, req *clientpb.DNSListen
 		listenPort = uint16(req.Port)
 	}
 
-	job, err := c2.StartDNSListenerJob(req.Host, listenPort, req.Domains, req.Canaries, req.EnforceOTP)
+	job, err := c2.StartDNSListenerJob(req.Host, listenPort, req.Domains, req.Canaries, req.EnforceOTP, req.TXTEncodings)
 	if err != nil {
 		return nil, err
 	}
@@ -172,6 +185,8 @@ func (rpc *Server) StartDNSListener(ctx context.Context, req *clientpb.DNSListen
 			Port:       listenPort,
 			Canaries:   req.Canaries,
 			EnforceOTP: req.EnforceOTP,
+
+			TXTEncodings: req.TXTEncodings,
 		}
 		configs.GetServerConfig().AddDNSJob(cfg)
 		job.PersistentID = cfg.JobID
@@ -180,6 +195,50 @@ func (rpc *Server) StartDNSListener(ctx context.Context, req *clientpb.DNSListen
 	return &clientpb.DNSListener{JobID: uint32(job.ID)}, nil
 }
 
+// StartICMPListener - Start an ICMP listener
+func (rpc *Server) StartICMPListener(ctx context.Context, req *clientpb.ICMPListenerReq) (*clientpb.ICMPListener, error) {
+	job, err := c2.StartICMPListenerJob(req.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Persistent {
+		cfg := &configs.ICMPJobConfig{
+			Host: req.Host,
+		}
+		configs.GetServerConfig().AddICMPJob(cfg)
+		job.PersistentID = cfg.JobID
+	}
+
+	return &clientpb.ICMPListener{JobID: uint32(job.ID)}, nil
+}
+
+// StartUDPListener - Start a UDP listener
+func (rpc *Server) StartUDPListener(ctx context.Context, req *clientpb.UDPListenerReq) (*clientpb.UDPListener, error) {
+	if 65535 <= req.Port {
+		return nil, ErrInvalidPort
+	}
+	listenPort := uint16(defaultUDPPort)
+	if req.Port != 0 {
+		listenPort = uint16(req.Port)
+	}
+	job, err := c2.StartUDPListenerJob(req.Host, listenPort)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Persistent {
+		cfg := &configs.UDPJobConfig{
+			Host: req.Host,
+			Port: listenPort,
+		}
+		configs.GetServerConfig().AddUDPJob(cfg)
+		job.PersistentID = cfg.JobID
+	}
+
+	return &clientpb.UDPListener{JobID: uint32(job.ID)}, nil
+}
+
 // StartHTTPSListener - Start an HTTPS listener
 func (rpc *Server) StartHTTPSListener(ctx context.Context, req *clientpb.HTTPListenerReq) (*clientpb.HTTPListener, error) {
 
@@ -204,6 +263,9 @@ func (rpc *Server) StartHTTPSListener(ctx context.Context, req *clientpb.HTTPLis
 		LongPollTimeout: time.Duration(req.LongPollTimeout),
 		LongPollJitter:  time.Duration(req.LongPollJitter),
 		RandomizeJARM:   req.RandomizeJARM,
+		C2Profile:       req.C2Profile,
+		FrontDomain:     req.FrontDomain,
+		HTTP2:           req.HTTP2,
 	}
 	job, err := c2.StartHTTPListenerJob(conf)
 	if err != nil {
@@ -224,6 +286,9 @@ func (rpc *Server) StartHTTPSListener(ctx context.Context, req *clientpb.HTTPLis
 			LongPollTimeout: req.LongPollTimeout,
 			LongPollJitter:  req.LongPollJitter,
 			RandomizeJARM:   req.RandomizeJARM,
+			C2Profile:       req.C2Profile,
+			FrontDomain:     req.FrontDomain,
+			HTTP2:           req.HTTP2,
 		}
 		configs.GetServerConfig().AddHTTPJob(cfg)
 		job.PersistentID = cfg.JobID
@@ -252,6 +317,9 @@ func (rpc *Server) StartHTTPListener(ctx context.Context, req *clientpb.HTTPList
 		EnforceOTP:      req.EnforceOTP,
 		LongPollTimeout: time.Duration(req.LongPollTimeout),
 		LongPollJitter:  time.Duration(req.LongPollJitter),
+		C2Profile:       req.C2Profile,
+		FrontDomain:     req.FrontDomain,
+		HTTP2:           req.HTTP2,
 	}
 	job, err := c2.StartHTTPListenerJob(conf)
 	if err != nil {
@@ -268,6 +336,9 @@ func (rpc *Server) StartHTTPListener(ctx context.Context, req *clientpb.HTTPList
 			EnforceOTP:      req.EnforceOTP,
 			LongPollTimeout: req.LongPollTimeout,
 			LongPollJitter:  req.LongPollJitter,
+			C2Profile:       req.C2Profile,
+			FrontDomain:     req.FrontDomain,
+			HTTP2:           req.HTTP2,
 		}
 		configs.GetServerConfig().AddHTTPJob(cfg)
 		job.PersistentID = cfg.JobID
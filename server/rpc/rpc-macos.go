@@ -0,0 +1,66 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// TCC - Survey the TCC permission databases of a macOS session
+func (rpc *Server) TCC(ctx context.Context, req *sliverpb.TCCReq) (*sliverpb.TCC, error) {
+	resp := &sliverpb.TCC{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Keychain - Enumerate, and optionally dump, the keychain items of a macOS session
+func (rpc *Server) Keychain(ctx context.Context, req *sliverpb.KeychainReq) (*sliverpb.Keychain, error) {
+	resp := &sliverpb.Keychain{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Launchd - List the launchd jobs of a macOS session
+func (rpc *Server) Launchd(ctx context.Context, req *sliverpb.LaunchdReq) (*sliverpb.Launchd, error) {
+	resp := &sliverpb.Launchd{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ConfigProfiles - List the configuration profiles installed on a macOS session
+func (rpc *Server) ConfigProfiles(ctx context.Context, req *sliverpb.ConfigProfilesReq) (*sliverpb.ConfigProfiles, error) {
+	resp := &sliverpb.ConfigProfiles{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,56 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/protobuf/sliverpb"
+)
+
+// GetNTLMListeners - Get the ntlm listeners of a session
+func (rpc *Server) GetNTLMListeners(ctx context.Context, req *sliverpb.NTLMListenersReq) (*sliverpb.NTLMListeners, error) {
+	resp := &sliverpb.NTLMListeners{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StartNTLMListener - Instruct the implant to capture (and optionally relay) ntlm authentication
+func (rpc *Server) StartNTLMListener(ctx context.Context, req *sliverpb.NTLMStartListenerReq) (*sliverpb.NTLMListener, error) {
+	resp := &sliverpb.NTLMListener{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// StopNTLMListener - Instruct the implant to stop an ntlm listener
+func (rpc *Server) StopNTLMListener(ctx context.Context, req *sliverpb.NTLMStopListenerReq) (*sliverpb.NTLMListener, error) {
+	resp := &sliverpb.NTLMListener{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -25,6 +25,9 @@ import (
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/secondfactor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetOperators - Get a list of operators
@@ -43,6 +46,32 @@ func (s *Server) GetOperators(ctx context.Context, _ *commonpb.Empty) (*clientpb
 	return operators, nil
 }
 
+// GetSecondFactorChallenge - Get the second factor the operator must verify, if any
+func (s *Server) GetSecondFactorChallenge(ctx context.Context, _ *commonpb.Empty) (*clientpb.SecondFactorChallenge, error) {
+	challenge, err := secondfactor.Challenge(operatorName(ctx))
+	if err != nil {
+		return nil, ErrDatabaseFailure
+	}
+	return challenge, nil
+}
+
+// VerifySecondFactor - Verify the operator's second factor
+func (s *Server) VerifySecondFactor(ctx context.Context, req *clientpb.SecondFactorReq) (*clientpb.SecondFactor, error) {
+	token, err := secondfactor.Verify(operatorName(ctx), req)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return &clientpb.SecondFactor{Token: token}, nil
+}
+
+// operatorName - The operator the request was authenticated as
+func operatorName(ctx context.Context) string {
+	if name, ok := ctx.Value("operator").(string); ok {
+		return name
+	}
+	return ""
+}
+
 func isOperatorOnline(commonName string) bool {
 	for _, operator := range core.Clients.ActiveOperators() {
 		if commonName == operator {
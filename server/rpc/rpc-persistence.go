@@ -0,0 +1,79 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/gofrs/uuid"
+)
+
+// PersistenceAdd - Record persistence installed on a host, so it can be found and
+// cleaned up at the end of an engagement
+func (rpc *Server) PersistenceAdd(ctx context.Context, req *clientpb.Persistence) (*clientpb.Persistence, error) {
+	persistence := &models.Persistence{
+		HostUUID:  uuid.FromStringOrNil(req.HostUUID),
+		Hostname:  req.Hostname,
+		Technique: req.Technique,
+		Target:    req.Target,
+		Path:      req.Path,
+		Restore:   req.Restore,
+		Operator:  operatorName(ctx),
+	}
+	if persistence.Hostname == "" {
+		if host, err := db.HostByHostUUID(req.HostUUID); err == nil {
+			persistence.Hostname = host.Hostname
+		}
+	}
+	err := db.Session().Create(persistence).Error
+	if err != nil {
+		return nil, err
+	}
+	return persistence.ToProtobuf(), nil
+}
+
+// PersistenceRm - Remove a persistence record, this does not undo the persistence
+func (rpc *Server) PersistenceRm(ctx context.Context, req *clientpb.Persistence) (*commonpb.Empty, error) {
+	persistence, err := db.PersistenceByID(req.ID)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Session().Delete(persistence).Error
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.Empty{}, nil
+}
+
+// PersistenceAll - List all recorded persistence
+func (rpc *Server) PersistenceAll(ctx context.Context, _ *commonpb.Empty) (*clientpb.AllPersistence, error) {
+	dbPersistence, err := db.ListPersistence()
+	if err != nil {
+		return nil, err
+	}
+	persistence := []*clientpb.Persistence{}
+	for _, record := range dbPersistence {
+		persistence = append(persistence, record.ToProtobuf())
+	}
+	return &clientpb.AllPersistence{Persistence: persistence}, nil
+}
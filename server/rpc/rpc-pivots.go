@@ -20,11 +20,21 @@ package rpc
 
 import (
 	"context"
+	insecureRand "math/rand"
+	"sync"
+	"time"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/core"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// pivotGraphPingTimeout - How long to wait for each implant in the graph to
+	// answer a ping, implants that don't answer in time have no latency
+	pivotGraphPingTimeout = 5 * time.Second
 )
 
 // PivotGraph - Return the server's pivot graph
@@ -35,9 +45,64 @@ func (rpc *Server) PivotGraph(ctx context.Context, req *commonpb.Empty) (*client
 	for _, topLevel := range core.PivotGraph() {
 		pivotGraph.Children = append(pivotGraph.Children, topLevel.ToProtobuf())
 	}
+	measurePivotLatency(pivotGraph.Children)
 	return pivotGraph, nil
 }
 
+// measurePivotLatency - Ping every implant in the graph at the same time, the hop
+// latency is the difference between an implant's latency and its parent's
+func measurePivotLatency(entries []*clientpb.PivotGraphEntry) {
+	wg := &sync.WaitGroup{}
+	var ping func([]*clientpb.PivotGraphEntry)
+	ping = func(entries []*clientpb.PivotGraphEntry) {
+		for _, entry := range entries {
+			wg.Add(1)
+			go func(entry *clientpb.PivotGraphEntry) {
+				defer wg.Done()
+				entry.Latency = pingLatency(entry.Session)
+			}(entry)
+			ping(entry.Children)
+		}
+	}
+	ping(entries)
+	wg.Wait()
+
+	var hops func([]*clientpb.PivotGraphEntry, *clientpb.PivotGraphEntry)
+	hops = func(entries []*clientpb.PivotGraphEntry, parent *clientpb.PivotGraphEntry) {
+		for _, entry := range entries {
+			if parent == nil {
+				entry.HopLatency = entry.Latency
+			} else if 0 < parent.Latency && parent.Latency <= entry.Latency {
+				entry.HopLatency = entry.Latency - parent.Latency
+			}
+			hops(entry.Children, entry)
+		}
+	}
+	hops(entries, nil)
+}
+
+// pingLatency - Round trip of a ping to the session, 0 if it didn't respond
+func pingLatency(pbSession *clientpb.Session) int64 {
+	if pbSession == nil {
+		return 0
+	}
+	session := core.Sessions.Get(pbSession.ID)
+	if session == nil {
+		return 0
+	}
+	data, err := proto.Marshal(&sliverpb.Ping{Nonce: insecureRand.Int31()})
+	if err != nil {
+		return 0
+	}
+	started := time.Now()
+	_, err = session.Request(sliverpb.MsgPing, pivotGraphPingTimeout, data)
+	if err != nil {
+		rpcLog.Debugf("[graph] session %s did not respond to ping: %s", pbSession.ID, err)
+		return 0
+	}
+	return int64(time.Since(started))
+}
+
 // PivotSessionListeners - Get a list of all pivot listeners from an implant
 func (rpc *Server) PivotSessionListeners(ctx context.Context, req *sliverpb.PivotListenersReq) (*sliverpb.PivotListeners, error) {
 	resp := &sliverpb.PivotListeners{Response: &commonpb.Response{}}
@@ -58,6 +123,16 @@ func (rpc *Server) PivotStartListener(ctx context.Context, req *sliverpb.PivotSt
 	return resp, nil
 }
 
+// PivotAllowPeers - Replace the allowlist of an implant's pivot listener
+func (rpc *Server) PivotAllowPeers(ctx context.Context, req *sliverpb.PivotAllowPeersReq) (*sliverpb.PivotListener, error) {
+	resp := &sliverpb.PivotListener{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // PivotStopListener - Instruct the implant to stop a pivot listener
 func (rpc *Server) PivotStopListener(ctx context.Context, req *sliverpb.PivotStopListenerReq) (*commonpb.Empty, error) {
 	resp := &sliverpb.PivotListener{Response: &commonpb.Response{}}
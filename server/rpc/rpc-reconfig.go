@@ -73,6 +73,26 @@ func (rpc *Server) Reconfigure(ctx context.Context, req *sliverpb.ReconfigureReq
 	return resp, nil
 }
 
+// ProxySet - Override the proxy used by a beacon/session's http(s) transports
+func (rpc *Server) ProxySet(ctx context.Context, req *sliverpb.ProxySetReq) (*sliverpb.ProxySet, error) {
+	resp := &sliverpb.ProxySet{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Redirect - Point a beacon/session's transports at other C2s
+func (rpc *Server) Redirect(ctx context.Context, req *sliverpb.RedirectReq) (*sliverpb.Redirect, error) {
+	resp := &sliverpb.Redirect{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Rename - Rename a beacon/session
 func (rpc *Server) Rename(ctx context.Context, req *clientpb.RenameReq) (*commonpb.Empty, error) {
 	resp := &commonpb.Empty{}
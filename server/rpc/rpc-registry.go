@@ -84,3 +84,23 @@ func (rpc *Server) RegistryListValues(ctx context.Context, req *sliverpb.Registr
 	}
 	return resp, nil
 }
+
+// RegistryExport - gRPC interface to read every key and value under a registry key
+func (rpc *Server) RegistryExport(ctx context.Context, req *sliverpb.RegistryExportReq) (*sliverpb.RegistryExport, error) {
+	resp := &sliverpb.RegistryExport{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RegistrySearch - gRPC interface to search registry keys, values and data
+func (rpc *Server) RegistrySearch(ctx context.Context, req *sliverpb.RegistrySearchReq) (*sliverpb.RegistrySearch, error) {
+	resp := &sliverpb.RegistrySearch{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
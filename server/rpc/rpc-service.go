@@ -36,3 +36,43 @@ func (rpc *Server) RemoveService(ctx context.Context, req *sliverpb.RemoveServic
 	}
 	return resp, nil
 }
+
+// Services lists the services of a host
+func (rpc *Server) Services(ctx context.Context, req *sliverpb.ServicesReq) (*sliverpb.Services, error) {
+	resp := &sliverpb.Services{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ServiceStart starts an installed service
+func (rpc *Server) ServiceStart(ctx context.Context, req *sliverpb.ServiceStartReq) (*sliverpb.ServiceDetail, error) {
+	resp := &sliverpb.ServiceDetail{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CreateService creates a service, it's only started if requested
+func (rpc *Server) CreateService(ctx context.Context, req *sliverpb.CreateServiceReq) (*sliverpb.ServiceDetail, error) {
+	resp := &sliverpb.ServiceDetail{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ServiceConfig reads or changes the configuration of a service
+func (rpc *Server) ServiceConfig(ctx context.Context, req *sliverpb.ServiceConfigReq) (*sliverpb.ServiceDetail, error) {
+	resp := &sliverpb.ServiceDetail{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
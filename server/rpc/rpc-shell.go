@@ -66,3 +66,13 @@ func (rpc *Server) RunSSHCommand(ctx context.Context, req *sliverpb.SSHCommandRe
 	}
 	return resp, nil
 }
+
+// SSHHarvest - Enumerate the ssh keys, known hosts and agent identities of a unix implant
+func (rpc *Server) SSHHarvest(ctx context.Context, req *sliverpb.SSHHarvestReq) (*sliverpb.SSHHarvest, error) {
+	resp := &sliverpb.SSHHarvest{Response: &commonpb.Response{}}
+	err := rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
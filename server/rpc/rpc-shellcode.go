@@ -41,6 +41,7 @@ func (rpc *Server) ShellcodeEncoder(ctx context.Context, req *clientpb.Shellcode
 		}
 	default:
 		resp.Response.Err = "Unknown encoder"
+		resp.Response.ErrCode = commonpb.ErrorCode_INVALID_ARGUMENT
 	}
 
 	rpcLog.Infof("[rpc] Successfully encoded shellcode (%d bytes)", len(resp.Data))
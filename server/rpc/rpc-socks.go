@@ -99,6 +99,9 @@ func (s *Server) SocksProxy(stream rpcpb.SliverRPC_SocksProxyServer) error {
 		}
 		tunnelLog.Debugf("Tunnel %d: From client %d byte(s)",
 			fromClient.TunnelID, len(fromClient.Data))
+		if err := checkEngagement(); err != nil {
+			return err
+		}
 		socks := core.SocksTunnels.Get(fromClient.TunnelID)
 		if socks == nil {
 			return nil
@@ -115,6 +118,7 @@ func (s *Server) SocksProxy(stream rpcpb.SliverRPC_SocksProxyServer) error {
 						rpcLog.Debugf("[socks] agent to (Server To Client)  Data Sequence %d , Data Size %d ,Data %v\n", socks.FromImplantSequence, len(recv.Data), recv.Data)
 						socks.Client.Send(&sliverpb.SocksData{
 							CloseConn: recv.CloseConn,
+							UDP:       recv.UDP,
 							TunnelID:  recv.TunnelID,
 							Data:      recv.Data,
 						})
@@ -152,6 +156,9 @@ func (s *Server) SocksProxy(stream rpcpb.SliverRPC_SocksProxyServer) error {
 
 // CreateSocks5 - Create requests we close a Socks
 func (s *Server) CreateSocks(ctx context.Context, req *sliverpb.Socks) (*sliverpb.Socks, error) {
+	if err := checkEngagement(); err != nil {
+		return nil, err
+	}
 	session := core.Sessions.Get(req.SessionID)
 	if session == nil {
 		return nil, ErrInvalidSessionID
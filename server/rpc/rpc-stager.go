@@ -25,6 +25,8 @@ import (
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/server/c2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // StartTCPStagerListener starts a TCP stager listener
@@ -33,7 +35,31 @@ func (rpc *Server) StartTCPStagerListener(ctx context.Context, req *clientpb.Sta
 	if !checkInterface(req.GetHost()) {
 		host = "0.0.0.0"
 	}
-	job, err := c2.StartTCPStagerListenerJob(host, uint16(req.GetPort()), req.GetData())
+	job, err := c2.StartTCPStagerListenerJob(host, uint16(req.GetPort()), stagerFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.StagerListener{JobID: uint32(job.ID)}, nil
+}
+
+// StartShellUpgradeListener starts a listener that upgrades plain reverse tcp shells
+// to implants, or upgrades the bind shell at req.Connect without starting a job
+func (rpc *Server) StartShellUpgradeListener(ctx context.Context, req *clientpb.ShellUpgradeReq) (*clientpb.StagerListener, error) {
+	if len(req.Implants) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no implants to upgrade shells to")
+	}
+	if req.Connect != "" {
+		err := c2.ConnectBindShell(req.Connect, req.Implants)
+		if err != nil {
+			return nil, err
+		}
+		return &clientpb.StagerListener{}, nil
+	}
+	host := req.GetHost()
+	if !checkInterface(req.GetHost()) {
+		host = "0.0.0.0"
+	}
+	job, err := c2.StartShellUpgradeListenerJob(host, uint16(req.GetPort()), req.Implants)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +84,7 @@ func (rpc *Server) StartHTTPStagerListener(ctx context.Context, req *clientpb.St
 		conf.Cert = req.Cert
 		conf.ACME = req.ACME
 	}
-	job, err := c2.StartHTTPStagerListenerJob(conf, req.Data)
+	job, err := c2.StartHTTPStagerListenerJob(conf, stagerFromReq(req))
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +94,15 @@ func (rpc *Server) StartHTTPStagerListener(ctx context.Context, req *clientpb.St
 	return &clientpb.StagerListener{JobID: uint32(job.ID)}, err
 }
 
+// stagerFromReq - The listener's stages, Data is the default stage
+func stagerFromReq(req *clientpb.StagerListenerReq) *c2.Stager {
+	return &c2.Stager{
+		Default:   req.GetData(),
+		Stages:    req.GetStages(),
+		ChunkSize: req.GetChunkSize(),
+	}
+}
+
 // checkInterface verifies if an IP address
 // is attached to an existing network interface
 func checkInterface(a string) bool {
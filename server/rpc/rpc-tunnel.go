@@ -92,6 +92,9 @@ func (c *dataCache) DeleteSeq(tunnelID uint64, sequence uint64) {
 //                no way to associate the tunnel with the correct client, so the client must send
 //                a zero-byte message over TunnelData to bind itself to the newly created tunnel.
 func (s *Server) CreateTunnel(ctx context.Context, req *sliverpb.Tunnel) (*sliverpb.Tunnel, error) {
+	if err := checkEngagement(); err != nil {
+		return nil, err
+	}
 	session := core.Sessions.Get(req.SessionID)
 	if session == nil {
 		return nil, ErrInvalidSessionID
@@ -128,6 +131,9 @@ func (s *Server) TunnelData(stream rpcpb.SliverRPC_TunnelDataServer) error {
 		}
 		tunnelLog.Debugf("Tunnel %d: From client %d byte(s)",
 			fromClient.TunnelID, len(fromClient.Data))
+		if err := checkEngagement(); err != nil {
+			return err
+		}
 
 		tunnel := core.Tunnels.Get(fromClient.TunnelID)
 		if tunnel == nil {
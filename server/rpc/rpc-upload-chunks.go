@@ -0,0 +1,100 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
+	"github.com/bishopfox/sliver/server/storage"
+	"github.com/bishopfox/sliver/util"
+	"github.com/bishopfox/sliver/util/encoders"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	chunkHashExp = regexp.MustCompile(`^[a-f0-9]{64}$`)
+)
+
+// GetMissingUploadChunks - Filter a list of chunk hashes to the chunks the server doesn't have
+func (rpc *Server) GetMissingUploadChunks(ctx context.Context, req *clientpb.UploadChunks) (*clientpb.UploadChunks, error) {
+	store, err := storage.New(storage.Uploads)
+	if err != nil {
+		return nil, err
+	}
+	missing := &clientpb.UploadChunks{Hashes: []string{}}
+	for _, hash := range req.Hashes {
+		if !chunkHashExp.MatchString(hash) {
+			return nil, ErrInvalidChunkHash
+		}
+		exists, err := store.Exists(hash)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			missing.Hashes = append(missing.Hashes, hash)
+		}
+	}
+	return missing, nil
+}
+
+// SaveUploadChunk - Cache an upload chunk, the chunk must match its hash
+func (rpc *Server) SaveUploadChunk(ctx context.Context, req *clientpb.UploadChunk) (*commonpb.Empty, error) {
+	if !chunkHashExp.MatchString(req.Hash) {
+		return nil, ErrInvalidChunkHash
+	}
+	chunk, err := new(encoders.Gzip).Decode(req.Data)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if util.UploadChunkSize < len(chunk) || util.ChunkHash(chunk) != req.Hash {
+		return nil, ErrChunkMismatch
+	}
+	store, err := storage.New(storage.Uploads)
+	if err != nil {
+		return nil, err
+	}
+	return &commonpb.Empty{}, store.Put(req.Hash, chunk)
+}
+
+// readUploadChunks - Reassemble a file from cached chunks
+func readUploadChunks(hashes []string) ([]byte, error) {
+	store, err := storage.New(storage.Uploads)
+	if err != nil {
+		return nil, err
+	}
+	data := []byte{}
+	for _, hash := range hashes {
+		if !chunkHashExp.MatchString(hash) {
+			return nil, ErrInvalidChunkHash
+		}
+		chunk, err := store.Get(hash)
+		if err == storage.ErrObjectNotFound {
+			return nil, ErrChunkNotCached
+		}
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, chunk...)
+	}
+	return data, nil
+}
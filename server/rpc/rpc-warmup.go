@@ -0,0 +1,44 @@
+package rpc
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"context"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/c2"
+)
+
+// StartWarmup - Start sending warmup traffic to engagement infrastructure
+func (rpc *Server) StartWarmup(ctx context.Context, req *clientpb.WarmupReq) (*clientpb.Warmup, error) {
+	job, err := c2.StartWarmupJob(&c2.WarmupConfig{
+		URLs:            req.URLs,
+		DNSNames:        req.DNSNames,
+		Resolver:        req.Resolver,
+		RequestsPerHour: req.RequestsPerHour,
+		Duration:        time.Duration(req.Duration),
+		ActiveFrom:      int(req.ActiveFrom),
+		ActiveUntil:     int(req.ActiveUntil),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &clientpb.Warmup{JobID: uint32(job.ID)}, nil
+}
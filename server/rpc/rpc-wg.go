@@ -2,11 +2,14 @@ package rpc
 
 import (
 	"context"
+	"net"
 
+	consts "github.com/bishopfox/sliver/client/constants"
 	"github.com/bishopfox/sliver/protobuf/clientpb"
 	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/protobuf/sliverpb"
 	"github.com/bishopfox/sliver/server/certs"
+	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/generate"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -97,3 +100,41 @@ func (rpc *Server) WGListForwarders(ctx context.Context, req *sliverpb.WGTCPForw
 	}
 	return resp, nil
 }
+
+// WGRotateKeys - Switch a wg session to new keys, the implant acknowledges them with
+// the old keys before it switches so the session's tunnel stays up
+func (rpc *Server) WGRotateKeys(ctx context.Context, req *sliverpb.WGRotateKeysReq) (*sliverpb.WGRotateKeys, error) {
+	if req.Request == nil {
+		return nil, ErrMissingRequestField
+	}
+	session := core.Sessions.Get(req.Request.SessionID)
+	if session == nil {
+		return nil, ErrInvalidSessionID
+	}
+	if session.Connection.Transport != consts.WGStr {
+		return nil, ErrNotWGSession
+	}
+	tunIP, _, err := net.SplitHostPort(session.Connection.RemoteAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	privKey, pubKey, err := certs.GenerateWGPeerKeys()
+	if err != nil {
+		rpcLog.Errorf("Could not generate WG keys: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	req.PrivateKey = privKey
+	resp := &sliverpb.WGRotateKeys{Response: &commonpb.Response{}}
+	err = rpc.GenericHandler(req, resp)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Response.Err == "" {
+		err = certs.ReplaceWGPeer(tunIP, privKey, pubKey)
+		if err != nil {
+			rpcLog.Errorf("Could not replace WG peer %s: %v", tunIP, err)
+			return nil, ErrDatabaseFailure
+		}
+	}
+	return resp, nil
+}
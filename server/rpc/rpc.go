@@ -20,7 +20,6 @@ package rpc
 
 import (
 	"context"
-	"errors"
 	"runtime"
 	"strings"
 	"time"
@@ -45,6 +44,40 @@ var (
 
 const (
 	minTimeout = time.Duration(30 * time.Second)
+
+	// DefaultTaskTimeout - Beacon tasks time out after this long unless the operator sets a timeout
+	DefaultTaskTimeout = 5 * time.Minute
+	// DefaultLongTaskTimeout - Default timeout for beacon tasks that run code or transfer files
+	DefaultLongTaskTimeout = 30 * time.Minute
+)
+
+var (
+	longTaskTypes = map[uint32]bool{
+		sliverpb.MsgExecuteReq:                     true,
+		sliverpb.MsgExecuteWindowsReq:              true,
+		sliverpb.MsgExecuteAssemblyReq:             true,
+		sliverpb.MsgInvokeExecuteAssemblyReq:       true,
+		sliverpb.MsgInvokeInProcExecuteAssemblyReq: true,
+		sliverpb.MsgSideloadReq:                    true,
+		sliverpb.MsgInlineExecuteReq:               true,
+		sliverpb.MsgValidateCredsReq:               true,
+		sliverpb.MsgSurveyReq:                      true,
+		sliverpb.MsgSearchReq:                      true,
+		sliverpb.MsgRegistryExportReq:              true,
+		sliverpb.MsgRegistrySearchReq:              true,
+		sliverpb.MsgSpawnDllReq:                    true,
+		sliverpb.MsgTaskReq:                        true,
+		sliverpb.MsgInvokeMigrateReq:               true,
+		sliverpb.MsgCallExtensionReq:               true,
+		sliverpb.MsgSSHCommandReq:                  true,
+		sliverpb.MsgSSHHarvestReq:                  true,
+		sliverpb.MsgProcessDumpReq:                 true,
+		sliverpb.MsgLsassDumpReq:                   true,
+		sliverpb.MsgScreenshotReq:                  true,
+		sliverpb.MsgClipboardReq:                   true,
+		sliverpb.MsgDownloadReq:                    true,
+		sliverpb.MsgUploadReq:                      true,
+	}
 )
 
 // Server - gRPC server
@@ -125,6 +158,9 @@ func (rpc *Server) GenericHandler(req GenericRequest, resp GenericResponse) erro
 	}
 
 	data, err := session.Request(sliverpb.MsgNumber(req), rpc.getTimeout(req), reqData)
+	if err == core.ErrUnknownMessageType {
+		return ErrUnsupportedOnTarget
+	}
 	if err != nil {
 		return err
 	}
@@ -157,12 +193,14 @@ func (rpc *Server) asyncGenericHandler(req GenericRequest, resp GenericResponse)
 	if err != nil {
 		return err
 	}
+	timeout := taskTimeout(sliverpb.MsgNumber(req), request.Timeout)
 	taskResponse := resp.GetResponse()
 	taskResponse.Async = true
 	taskResponse.BeaconID = beacon.ID.String()
 	task, err := beacon.Task(&sliverpb.Envelope{
-		Type: sliverpb.MsgNumber(req),
-		Data: reqData,
+		Type:    sliverpb.MsgNumber(req),
+		Data:    reqData,
+		Timeout: int64(timeout),
 	})
 	if err != nil {
 		rpcLog.Errorf("Database error: %s", err)
@@ -171,6 +209,7 @@ func (rpc *Server) asyncGenericHandler(req GenericRequest, resp GenericResponse)
 	parts := strings.Split(string(req.ProtoReflect().Descriptor().FullName().Name()), ".")
 	name := parts[len(parts)-1]
 	task.Description = name
+	task.Timeout = int64(timeout)
 	err = db.Session().Save(task).Error
 	if err != nil {
 		rpcLog.Errorf("Database error: %s", err)
@@ -208,11 +247,26 @@ func (rpc *Server) getTimeout(req GenericRequest) time.Duration {
 	return time.Duration(timeout)
 }
 
+// taskTimeout - Beacon tasks use the operator's timeout if one was set, otherwise
+// the default for the type of task, a negative timeout means no timeout
+func taskTimeout(msgType uint32, timeout int64) time.Duration {
+	if timeout < 0 {
+		return 0
+	}
+	if 0 < timeout {
+		return time.Duration(timeout)
+	}
+	if longTaskTypes[msgType] {
+		return DefaultLongTaskTimeout
+	}
+	return DefaultTaskTimeout
+}
+
 // getError - Check an implant's response for Err and convert it to an `error` type
 func (rpc *Server) getError(resp GenericResponse) error {
 	respHeader := resp.GetResponse()
 	if respHeader != nil && respHeader.Err != "" {
-		return errors.New(respHeader.Err)
+		return responseError(respHeader)
 	}
 	return nil
 }
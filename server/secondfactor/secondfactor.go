@@ -0,0 +1,468 @@
+package secondfactor
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	Operator config files are bearer credentials, so operators can optionally be
+	required to verify a second factor after authenticating with their token:
+
+	- TOTP codes (RFC 6238) from any authenticator app
+	- FIDO2 hardware keys, the client signs a server challenge with a key that
+	  was enrolled using libfido2's 'fido2-cred' tool
+
+	A successful verification returns a short-lived session token that the client
+	sends along with its operator token on every request.
+
+*/
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/pquerna/otp"
+	"github.com/pquerna/otp/totp"
+)
+
+const (
+	// TOTP - Time-based one time passwords
+	TOTP = "totp"
+	// FIDO2 - Hardware security keys
+	FIDO2 = "fido2"
+
+	// RelyingParty - The FIDO2 relying party id operator credentials are bound to
+	RelyingParty = "sliver"
+
+	// MetadataKey - The gRPC metadata key clients send the session token in
+	MetadataKey = "second-factor"
+
+	totpIssuer   = "Sliver"
+	totpPeriod   = 30
+	challengeTTL = 2 * time.Minute
+	sessionTTL   = 24 * time.Hour
+
+	// Operators are locked out after this many wrong codes or assertions in a row,
+	// so a stolen config can't be used to brute force the second factor
+	maxFailures = 5
+	lockoutTTL  = 15 * time.Minute
+
+	authDataMinLength = 37 // rpIdHash (32) + flags (1) + signCount (4)
+	flagUserPresent   = 0x01
+)
+
+var (
+	// ErrNotEnrolled - The operator is not enrolled in a second factor
+	ErrNotEnrolled = errors.New("operator is not enrolled in a second factor")
+	// ErrInvalidCode - The TOTP code is wrong, expired, or has already been used
+	ErrInvalidCode = errors.New("invalid second factor code")
+	// ErrInvalidAssertion - The FIDO2 assertion did not verify
+	ErrInvalidAssertion = errors.New("invalid second factor assertion")
+	// ErrNoChallenge - There is no outstanding challenge matching the request
+	ErrNoChallenge = errors.New("no matching second factor challenge")
+	// ErrLockedOut - Too many failed verifications, the operator has to wait
+	ErrLockedOut = errors.New("too many failed second factor verifications, try again later")
+
+	secondFactorLog = log.NamedLogger("secondfactor", "verify")
+
+	totpOptions = totp.ValidateOpts{
+		Period:    totpPeriod,
+		Digits:    otp.DigitsSix,
+		Algorithm: otp.AlgorithmSHA1,
+	}
+
+	required   = &sync.Map{} // operator name -> second factor type of enrolled operators
+	challenges = &sync.Map{} // operator name -> *challenge
+	failures   = &sync.Map{} // operator name -> *failure
+	sessions   = &sync.Map{} // hex(sha256(token)) -> *session
+	totpSteps  = &sync.Map{} // operator name -> last accepted time step
+	verifyLock = &sync.Mutex{}
+)
+
+type challenge struct {
+	Value   []byte
+	Expires time.Time
+}
+
+type session struct {
+	Name    string
+	Expires time.Time
+}
+
+type failure struct {
+	Count       int
+	LockedUntil time.Time
+}
+
+// Required - The type of second factor an operator must verify, or an empty
+// string if the operator is not enrolled. Operators can be enrolled by another
+// process (i.e. 'sliver-server operator'), so only enrollments are cached
+func Required(name string) (string, error) {
+	if factorType, ok := required.Load(name); ok {
+		return factorType.(string), nil
+	}
+	secondFactor, err := db.OperatorSecondFactorByName(name)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	required.Store(name, secondFactor.Type)
+	return secondFactor.Type, nil
+}
+
+// Check - Check that a session token was issued to the operator and has not expired
+func Check(name string, token string) bool {
+	if token == "" {
+		return false
+	}
+	value, ok := sessions.Load(tokenDigest(token))
+	if !ok {
+		return false
+	}
+	sess := value.(*session)
+	if time.Now().After(sess.Expires) {
+		sessions.Delete(tokenDigest(token))
+		return false
+	}
+	return sess.Name == name
+}
+
+// Challenge - Start a verification, FIDO2 challenges are single use and short lived
+func Challenge(name string) (*clientpb.SecondFactorChallenge, error) {
+	secondFactor, err := db.OperatorSecondFactorByName(name)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return &clientpb.SecondFactorChallenge{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if secondFactor.Type != FIDO2 {
+		return &clientpb.SecondFactorChallenge{Type: secondFactor.Type}, nil
+	}
+	value := make([]byte, 32)
+	if _, err := rand.Read(value); err != nil {
+		return nil, err
+	}
+	challenges.Store(name, &challenge{Value: value, Expires: time.Now().Add(challengeTTL)})
+	return &clientpb.SecondFactorChallenge{
+		Type:         FIDO2,
+		Challenge:    value,
+		RelyingParty: RelyingParty,
+		CredentialID: secondFactor.CredentialID,
+	}, nil
+}
+
+// Verify - Verify an operator's second factor, returns a session token
+func Verify(name string, req *clientpb.SecondFactorReq) (string, error) {
+	verifyLock.Lock()
+	defer verifyLock.Unlock()
+
+	if lockedOut(name, time.Now()) {
+		secondFactorLog.Warnf("Operator %s is locked out of second factor verification", name)
+		return "", ErrLockedOut
+	}
+	secondFactor, err := db.OperatorSecondFactorByName(name)
+	if errors.Is(err, db.ErrRecordNotFound) {
+		return "", ErrNotEnrolled
+	}
+	if err != nil {
+		return "", err
+	}
+	switch secondFactor.Type {
+	case TOTP:
+		err = verifyTOTP(secondFactor, req.Code, time.Now())
+	case FIDO2:
+		err = verifyFIDO2Challenge(secondFactor, req)
+	default:
+		err = ErrNotEnrolled
+	}
+	if err != nil {
+		secondFactorLog.Warnf("Operator %s failed %s verification: %s", name, secondFactor.Type, err)
+		if err == ErrInvalidCode || err == ErrInvalidAssertion {
+			recordFailure(name, time.Now())
+		}
+		return "", err
+	}
+	failures.Delete(name)
+	secondFactorLog.Infof("Operator %s verified %s", name, secondFactor.Type)
+	return newSession(name)
+}
+
+// lockedOut - Whether the operator failed too many verifications recently, the
+// count starts over once the lockout expires
+func lockedOut(name string, now time.Time) bool {
+	value, ok := failures.Load(name)
+	if !ok {
+		return false
+	}
+	fail := value.(*failure)
+	if fail.LockedUntil.IsZero() {
+		return false
+	}
+	if now.Before(fail.LockedUntil) {
+		return true
+	}
+	failures.Delete(name)
+	return false
+}
+
+// recordFailure - Count a wrong code or assertion, the caller must hold verifyLock
+func recordFailure(name string, now time.Time) {
+	value, _ := failures.LoadOrStore(name, &failure{})
+	fail := value.(*failure)
+	fail.Count++
+	if maxFailures <= fail.Count {
+		fail.LockedUntil = now.Add(lockoutTTL)
+		secondFactorLog.Warnf("Operator %s locked out for %s after %d failed verifications", name, lockoutTTL, fail.Count)
+	}
+}
+
+// EnrollTOTP - Enroll an operator in TOTP, replacing any existing enrollment.
+// The returned key must be given to the operator out of band.
+func EnrollTOTP(name string) (*otp.Key, error) {
+	key, err := totp.Generate(totp.GenerateOpts{
+		Issuer:      totpIssuer,
+		AccountName: name,
+		Period:      totpOptions.Period,
+		Digits:      totpOptions.Digits,
+		Algorithm:   totpOptions.Algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = enroll(&models.OperatorSecondFactor{
+		Name:       name,
+		Type:       TOTP,
+		TOTPSecret: key.Secret(),
+	})
+	return key, err
+}
+
+// EnrollFIDO2 - Enroll an operator's hardware key, replacing any existing
+// enrollment. The credential is the output of 'fido2-cred -V'.
+func EnrollFIDO2(name string, credential []byte) error {
+	credentialID, publicKey, err := ParseFIDO2Credential(credential)
+	if err != nil {
+		return err
+	}
+	return enroll(&models.OperatorSecondFactor{
+		Name:         name,
+		Type:         FIDO2,
+		CredentialID: credentialID,
+		PublicKey:    publicKey,
+	})
+}
+
+// Remove - Remove an operator's second factor enrollment and sessions
+func Remove(name string) error {
+	err := db.Session().Where(&models.OperatorSecondFactor{Name: name}).Delete(&models.OperatorSecondFactor{}).Error
+	if err != nil {
+		return err
+	}
+	required.Delete(name)
+	challenges.Delete(name)
+	totpSteps.Delete(name)
+	failures.Delete(name)
+	sessions.Range(func(key, value interface{}) bool {
+		if value.(*session).Name == name {
+			sessions.Delete(key)
+		}
+		return true
+	})
+	return nil
+}
+
+// ParseFIDO2Credential - Parse the output of 'fido2-cred -V', which is the
+// base64 encoded credential id followed by the PEM encoded public key
+func ParseFIDO2Credential(data []byte) ([]byte, string, error) {
+	lines := strings.SplitN(strings.TrimSpace(string(data)), "\n", 2)
+	if len(lines) != 2 {
+		return nil, "", errors.New("expected a credential id and a public key")
+	}
+	credentialID, err := base64.StdEncoding.DecodeString(strings.TrimSpace(lines[0]))
+	if err != nil || len(credentialID) < 1 {
+		return nil, "", errors.New("invalid credential id")
+	}
+	publicKey := strings.TrimSpace(lines[1]) + "\n"
+	if _, err := parsePublicKey(publicKey); err != nil {
+		return nil, "", err
+	}
+	return credentialID, publicKey, nil
+}
+
+func enroll(secondFactor *models.OperatorSecondFactor) error {
+	if err := Remove(secondFactor.Name); err != nil {
+		return err
+	}
+	return db.Session().Create(secondFactor).Error
+}
+
+func newSession(name string) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(buf)
+	sessions.Store(tokenDigest(token), &session{Name: name, Expires: time.Now().Add(sessionTTL)})
+	return token, nil
+}
+
+func tokenDigest(token string) string {
+	digest := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(digest[:])
+}
+
+// verifyTOTP - Codes from the adjacent time steps are accepted to allow for some
+// clock skew, but a code can't be used twice (or an older one used after it)
+func verifyTOTP(secondFactor *models.OperatorSecondFactor, code string, now time.Time) error {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return ErrInvalidCode
+	}
+	lastStep, _ := totpSteps.Load(secondFactor.Name)
+	for _, offset := range []int64{0, -1, 1} {
+		step := now.Unix()/totpPeriod + offset
+		expected, err := totp.GenerateCodeCustom(secondFactor.TOTPSecret, time.Unix(step*totpPeriod, 0), totpOptions)
+		if err != nil {
+			return err
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) != 1 {
+			continue
+		}
+		if lastStep != nil && step <= lastStep.(int64) {
+			return ErrInvalidCode
+		}
+		totpSteps.Store(secondFactor.Name, step)
+		return nil
+	}
+	return ErrInvalidCode
+}
+
+func verifyFIDO2Challenge(secondFactor *models.OperatorSecondFactor, req *clientpb.SecondFactorReq) error {
+	value, ok := challenges.LoadAndDelete(secondFactor.Name)
+	if !ok {
+		return ErrNoChallenge
+	}
+	chal := value.(*challenge)
+	if time.Now().After(chal.Expires) || !bytes.Equal(chal.Value, req.Challenge) {
+		return ErrNoChallenge
+	}
+	signCount, err := verifyFIDO2(secondFactor, chal.Value, req.AuthenticatorData, req.Signature)
+	if err != nil {
+		return err
+	}
+	return db.Session().Model(secondFactor).Update("sign_count", signCount).Error
+}
+
+// verifyFIDO2 - Verify an assertion's signature over the authenticator data and
+// client data hash (the challenge), returns the authenticator's sign count
+func verifyFIDO2(secondFactor *models.OperatorSecondFactor, clientDataHash []byte, authData []byte, signature []byte) (uint32, error) {
+	authData = unwrapCBORBytes(authData)
+	if len(authData) < authDataMinLength {
+		return 0, ErrInvalidAssertion
+	}
+	rpIDHash := sha256.Sum256([]byte(RelyingParty))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return 0, ErrInvalidAssertion
+	}
+	if authData[32]&flagUserPresent == 0 {
+		return 0, ErrInvalidAssertion
+	}
+	signCount := binary.BigEndian.Uint32(authData[33:37])
+
+	publicKey, err := parsePublicKey(secondFactor.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+	signed := append(append([]byte{}, authData...), clientDataHash...)
+	switch key := publicKey.(type) {
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(signed)
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return 0, ErrInvalidAssertion
+		}
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, signed, signature) {
+			return 0, ErrInvalidAssertion
+		}
+	}
+
+	// Authenticators that don't implement a counter always report zero, otherwise
+	// a counter that didn't increase means the key may have been cloned
+	if (signCount != 0 || secondFactor.SignCount != 0) && signCount <= secondFactor.SignCount {
+		return 0, ErrInvalidAssertion
+	}
+	return signCount, nil
+}
+
+func parsePublicKey(data string) (interface{}, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil {
+		return nil, errors.New("invalid public key pem")
+	}
+	publicKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch publicKey.(type) {
+	case *ecdsa.PublicKey, ed25519.PublicKey:
+		return publicKey, nil
+	}
+	return nil, errors.New("unsupported public key type (expected es256 or eddsa)")
+}
+
+// unwrapCBORBytes - libfido2's tools output the authenticator data as a CBOR
+// byte string, if the data isn't wrapped it's returned as is
+func unwrapCBORBytes(data []byte) []byte {
+	if len(data) < 1 || data[0]>>5 != 2 {
+		return data
+	}
+	info := data[0] & 0x1f
+	length, header := 0, 0
+	switch {
+	case info < 24:
+		length, header = int(info), 1
+	case info == 24 && 2 <= len(data):
+		length, header = int(data[1]), 2
+	case info == 25 && 3 <= len(data):
+		length, header = int(binary.BigEndian.Uint16(data[1:3])), 3
+	default:
+		return data
+	}
+	if header+length != len(data) {
+		return data
+	}
+	return data[header:]
+}
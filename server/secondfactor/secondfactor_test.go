@@ -0,0 +1,229 @@
+package secondfactor
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/db/models"
+	"github.com/pquerna/otp/totp"
+)
+
+func TestVerifyTOTP(t *testing.T) {
+	key, err := totp.Generate(totp.GenerateOpts{Issuer: totpIssuer, AccountName: "totp-test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondFactor := &models.OperatorSecondFactor{Name: "totp-test", Type: TOTP, TOTPSecret: key.Secret()}
+	now := time.Now()
+	code, _ := totp.GenerateCodeCustom(key.Secret(), now, totpOptions)
+
+	if err := verifyTOTP(secondFactor, "000000x", now); err != ErrInvalidCode {
+		t.Fatalf("expected invalid code error, got %v", err)
+	}
+	if err := verifyTOTP(secondFactor, code, now); err != nil {
+		t.Fatalf("valid code failed to verify: %s", err)
+	}
+	if err := verifyTOTP(secondFactor, code, now); err != ErrInvalidCode {
+		t.Fatalf("code was accepted twice: %v", err)
+	}
+	previous, _ := totp.GenerateCodeCustom(key.Secret(), now.Add(-totpPeriod*time.Second), totpOptions)
+	if err := verifyTOTP(secondFactor, previous, now); err != ErrInvalidCode {
+		t.Fatalf("code older than the last accepted code was accepted: %v", err)
+	}
+	next, _ := totp.GenerateCodeCustom(key.Secret(), now.Add(totpPeriod*time.Second), totpOptions)
+	if err := verifyTOTP(secondFactor, next, now); err != nil {
+		t.Fatalf("code within the allowed skew failed to verify: %s", err)
+	}
+}
+
+func TestVerifyFIDO2(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, _ := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	credentialID := []byte("credential-id")
+
+	credential := fmt.Sprintf("%s\n%s", base64.StdEncoding.EncodeToString(credentialID), publicKey)
+	parsedID, parsedKey, err := ParseFIDO2Credential([]byte(credential))
+	if err != nil {
+		t.Fatalf("failed to parse credential: %s", err)
+	}
+	if string(parsedID) != string(credentialID) {
+		t.Fatalf("credential id mismatch %q", parsedID)
+	}
+	secondFactor := &models.OperatorSecondFactor{Type: FIDO2, CredentialID: parsedID, PublicKey: parsedKey, SignCount: 4}
+
+	challenge := make([]byte, 32)
+	rand.Read(challenge)
+	assert := func(rpID string, flags byte, signCount uint32) ([]byte, []byte) {
+		rpIDHash := sha256.Sum256([]byte(rpID))
+		authData := append(rpIDHash[:], flags, 0, 0, 0, 0)
+		binary.BigEndian.PutUint32(authData[33:], signCount)
+		digest := sha256.Sum256(append(append([]byte{}, authData...), challenge...))
+		signature, err := ecdsa.SignASN1(rand.Reader, privateKey, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		return authData, signature
+	}
+
+	authData, signature := assert(RelyingParty, flagUserPresent, 5)
+	signCount, err := verifyFIDO2(secondFactor, challenge, authData, signature)
+	if err != nil || signCount != 5 {
+		t.Fatalf("valid assertion failed to verify: %v (%d)", err, signCount)
+	}
+	// libfido2 wraps the authenticator data in a cbor byte string
+	wrapped := append([]byte{0x58, byte(len(authData))}, authData...)
+	if _, err := verifyFIDO2(secondFactor, challenge, wrapped, signature); err != nil {
+		t.Fatalf("cbor wrapped assertion failed to verify: %s", err)
+	}
+
+	if _, err := verifyFIDO2(secondFactor, challenge[1:], authData, signature); err != ErrInvalidAssertion {
+		t.Fatalf("assertion verified with the wrong challenge: %v", err)
+	}
+	authData, signature = assert("evil", flagUserPresent, 5)
+	if _, err := verifyFIDO2(secondFactor, challenge, authData, signature); err != ErrInvalidAssertion {
+		t.Fatalf("assertion verified with the wrong rp id: %v", err)
+	}
+	authData, signature = assert(RelyingParty, 0, 5)
+	if _, err := verifyFIDO2(secondFactor, challenge, authData, signature); err != ErrInvalidAssertion {
+		t.Fatalf("assertion verified without user presence: %v", err)
+	}
+	authData, signature = assert(RelyingParty, flagUserPresent, 4)
+	if _, err := verifyFIDO2(secondFactor, challenge, authData, signature); err != ErrInvalidAssertion {
+		t.Fatalf("assertion verified with a sign count that did not increase: %v", err)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	token, err := newSession("session-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !Check("session-test", token) {
+		t.Fatal("valid session token rejected")
+	}
+	if Check("someone-else", token) {
+		t.Fatal("session token accepted for the wrong operator")
+	}
+	if Check("session-test", "") || Check("session-test", token[1:]) {
+		t.Fatal("invalid session token accepted")
+	}
+	Remove("session-test")
+	if Check("session-test", token) {
+		t.Fatal("session token accepted after removing the operator")
+	}
+}
+
+func TestEnrollTOTP(t *testing.T) {
+	key, err := EnrollTOTP("enroll-test")
+	if err != nil {
+		t.Fatalf("failed to enroll: %s", err)
+	}
+	defer Remove("enroll-test")
+	factorType, err := Required("enroll-test")
+	if err != nil || factorType != TOTP {
+		t.Fatalf("expected totp to be required, got %q (%v)", factorType, err)
+	}
+	challenge, err := Challenge("enroll-test")
+	if err != nil || challenge.Type != TOTP {
+		t.Fatalf("expected a totp challenge, got %v (%v)", challenge, err)
+	}
+	code, _ := totp.GenerateCodeCustom(key.Secret(), time.Now(), totpOptions)
+	token, err := Verify("enroll-test", &clientpb.SecondFactorReq{Code: code})
+	if err != nil {
+		t.Fatalf("failed to verify: %s", err)
+	}
+	if !Check("enroll-test", token) {
+		t.Fatal("session token rejected")
+	}
+
+	err = Remove("enroll-test")
+	if err != nil {
+		t.Fatalf("failed to remove enrollment: %s", err)
+	}
+	if Check("enroll-test", token) {
+		t.Fatal("session token accepted after removing the enrollment")
+	}
+	factorType, err = Required("enroll-test")
+	if err != nil || factorType != "" {
+		t.Fatalf("expected no second factor, got %q (%v)", factorType, err)
+	}
+}
+
+func TestRequiredNotCached(t *testing.T) {
+	factorType, err := Required("cache-test")
+	if err != nil || factorType != "" {
+		t.Fatalf("expected no second factor, got %q (%v)", factorType, err)
+	}
+	// Enrolled by another process, so nothing here invalidates the cache
+	err = db.Session().Create(&models.OperatorSecondFactor{Name: "cache-test", Type: TOTP}).Error
+	if err != nil {
+		t.Fatalf("failed to create enrollment: %s", err)
+	}
+	defer Remove("cache-test")
+	factorType, err = Required("cache-test")
+	if err != nil || factorType != TOTP {
+		t.Fatalf("expected totp to be required, got %q (%v)", factorType, err)
+	}
+}
+
+func TestVerifyLockout(t *testing.T) {
+	key, err := EnrollTOTP("lockout-test")
+	if err != nil {
+		t.Fatalf("failed to enroll: %s", err)
+	}
+	defer Remove("lockout-test")
+	for i := 0; i < maxFailures; i++ {
+		_, err = Verify("lockout-test", &clientpb.SecondFactorReq{Code: "000000x"})
+		if err != ErrInvalidCode {
+			t.Fatalf("expected an invalid code, got %v", err)
+		}
+	}
+	code, _ := totp.GenerateCodeCustom(key.Secret(), time.Now(), totpOptions)
+	_, err = Verify("lockout-test", &clientpb.SecondFactorReq{Code: code})
+	if err != ErrLockedOut {
+		t.Fatalf("expected a lockout, got %v", err)
+	}
+	if !lockedOut("lockout-test", time.Now().Add(lockoutTTL-time.Second)) {
+		t.Fatal("lockout expired early")
+	}
+	if lockedOut("lockout-test", time.Now().Add(lockoutTTL+time.Second)) {
+		t.Fatal("lockout did not expire")
+	}
+	_, err = Verify("lockout-test", &clientpb.SecondFactorReq{Code: code})
+	if err != nil {
+		t.Fatalf("failed to verify after the lockout: %s", err)
+	}
+}
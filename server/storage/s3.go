@@ -0,0 +1,333 @@
+package storage
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+	------------------------------------------------------------------------
+
+	A minimal S3 client, we only need to put, get, and delete whole objects so
+	this avoids pulling in an SDK. Requests are signed with AWS Signature V4:
+	https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-authenticating-requests.html
+
+*/
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bishopfox/sliver/server/configs"
+)
+
+const (
+	s3Service      = "s3"
+	s3Algorithm    = "AWS4-HMAC-SHA256"
+	s3TimeFormat   = "20060102T150405Z"
+	s3DateFormat   = "20060102"
+	s3Timeout      = 5 * time.Minute
+	maxErrorLength = 512
+)
+
+// S3Store - Stores objects in an S3 compatible bucket under a key prefix
+type S3Store struct {
+	config *configs.S3Config
+	prefix string
+	client *http.Client
+	now    func() time.Time
+}
+
+// LifecycleRule - Expire objects under a prefix after a number of days
+type LifecycleRule struct {
+	ID             string
+	Prefix         string
+	ExpirationDays int
+}
+
+// NewS3Store - Create an S3 store, all keys are prefixed with 'prefix'
+func NewS3Store(config *configs.S3Config, prefix string) *S3Store {
+	return &S3Store{
+		config: config,
+		prefix: prefix,
+		client: &http.Client{Timeout: s3Timeout},
+		now:    time.Now,
+	}
+}
+
+// Put - Upload an object, using server-side encryption if configured
+func (s *S3Store) Put(key string, data []byte) error {
+	headers := http.Header{}
+	switch s.config.ServerSideEncryption {
+	case "":
+	case "aws:kms":
+		headers.Set("X-Amz-Server-Side-Encryption", "aws:kms")
+		if s.config.KMSKeyID != "" {
+			headers.Set("X-Amz-Server-Side-Encryption-Aws-Kms-Key-Id", s.config.KMSKeyID)
+		}
+	default:
+		headers.Set("X-Amz-Server-Side-Encryption", s.config.ServerSideEncryption)
+	}
+	resp, err := s.do(http.MethodPut, s.prefix+key, nil, headers, data)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Get - Download an object
+func (s *S3Store) Get(key string) ([]byte, error) {
+	resp, err := s.do(http.MethodGet, s.prefix+key, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// Exists - Check if an object exists without downloading it
+func (s *S3Store) Exists(key string) (bool, error) {
+	resp, err := s.do(http.MethodHead, s.prefix+key, nil, nil, nil)
+	if err == ErrObjectNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	resp.Body.Close()
+	return true, nil
+}
+
+// Delete - Remove an object, S3 does not report if the object existed
+func (s *S3Store) Delete(key string) error {
+	resp, err := s.do(http.MethodDelete, s.prefix+key, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+type lifecycleConfiguration struct {
+	XMLName xml.Name           `xml:"LifecycleConfiguration"`
+	Rules   []lifecycleRuleXML `xml:"Rule"`
+}
+
+type lifecycleRuleXML struct {
+	ID         string              `xml:"ID"`
+	Filter     lifecycleFilterXML  `xml:"Filter"`
+	Status     string              `xml:"Status"`
+	Expiration lifecycleExpiration `xml:"Expiration"`
+}
+
+type lifecycleFilterXML struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type lifecycleExpiration struct {
+	Days int `xml:"Days"`
+}
+
+// PutLifecycle - Replace the bucket's lifecycle configuration, removes the
+// lifecycle configuration if there are no rules. Note that this replaces any
+// rules that were configured on the bucket outside of Sliver.
+func (s *S3Store) PutLifecycle(rules []LifecycleRule) error {
+	query := url.Values{"lifecycle": []string{""}}
+	if len(rules) < 1 {
+		resp, err := s.do(http.MethodDelete, "", query, nil, nil)
+		if err == ErrObjectNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+	lifecycle := lifecycleConfiguration{}
+	for _, rule := range rules {
+		lifecycle.Rules = append(lifecycle.Rules, lifecycleRuleXML{
+			ID:         rule.ID,
+			Filter:     lifecycleFilterXML{Prefix: rule.Prefix},
+			Status:     "Enabled",
+			Expiration: lifecycleExpiration{Days: rule.ExpirationDays},
+		})
+	}
+	body, err := xml.Marshal(lifecycle)
+	if err != nil {
+		return err
+	}
+	digest := md5.Sum(body)
+	headers := http.Header{}
+	headers.Set("Content-Md5", base64.StdEncoding.EncodeToString(digest[:]))
+	headers.Set("Content-Type", "application/xml")
+	resp, err := s.do(http.MethodPut, "", query, headers, body)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// objectURL - Virtual host style by default, path style for MinIO and friends
+func (s *S3Store) objectURL(key string, query url.Values) *url.URL {
+	scheme := "https"
+	if s.config.Insecure {
+		scheme = "http"
+	}
+	endpoint := s.config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("s3.%s.amazonaws.com", s.config.Region)
+	}
+	objURL := &url.URL{Scheme: scheme, RawQuery: canonicalQuery(query)}
+	if s.config.PathStyle {
+		objURL.Host = endpoint
+		objURL.Path = "/" + s.config.Bucket + "/" + key
+	} else {
+		objURL.Host = s.config.Bucket + "." + endpoint
+		objURL.Path = "/" + key
+	}
+	return objURL
+}
+
+func (s *S3Store) do(method string, key string, query url.Values, headers http.Header, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, s.objectURL(key, query).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range headers {
+		req.Header[name] = values
+	}
+	s.sign(req, body)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, ErrObjectNotFound
+	}
+	if resp.StatusCode < 200 || 300 <= resp.StatusCode {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+		return nil, fmt.Errorf("s3 %s %s failed: %s %s", method, key, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return resp, nil
+}
+
+// sign - Add an AWS Signature V4 'Authorization' header to the request, all of
+// the request's headers are signed
+func (s *S3Store) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	payloadDigest := sha256.Sum256(body)
+	payloadHash := hex.EncodeToString(payloadDigest[:])
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	canonicalHeaders := &strings.Builder{}
+	for _, name := range names {
+		fmt.Fprintf(canonicalHeaders, "%s:%s\n", name, headers[name])
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	requestDigest := sha256.Sum256([]byte(canonicalRequest))
+	scope := strings.Join([]string{now.Format(s3DateFormat), s.config.Region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		now.Format(s3TimeFormat),
+		scope,
+		hex.EncodeToString(requestDigest[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), now.Format(s3DateFormat))
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// canonicalQuery - Sorted and URI encoded query string, keys without a value
+// are encoded as 'key='
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	params := []string{}
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			params = append(params, uriEncode(key, true)+"="+uriEncode(value, true))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// uriEncode - Encode everything except the unreserved characters, and '/'
+// unless encodeSlash is set
+func uriEncode(value string, encodeSlash bool) string {
+	encoded := &strings.Builder{}
+	for _, b := range []byte(value) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9',
+			b == '-', b == '_', b == '.', b == '~':
+			encoded.WriteByte(b)
+		case b == '/' && !encodeSlash:
+			encoded.WriteByte(b)
+		default:
+			fmt.Fprintf(encoded, "%%%02X", b)
+		}
+	}
+	return encoded.String()
+}
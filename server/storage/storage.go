@@ -0,0 +1,175 @@
+package storage
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/server/assets"
+	"github.com/bishopfox/sliver/server/configs"
+	"github.com/bishopfox/sliver/server/log"
+)
+
+const (
+	// Loot - Namespace for loot files and credentials
+	Loot = "loot"
+	// Builds - Namespace for implant builds
+	Builds = "builds"
+	// Uploads - Namespace for cached upload chunks
+	Uploads = "uploads"
+	// Artifacts - Namespace for shellcode converted from assemblies
+	Artifacts = "artifacts"
+)
+
+var (
+	storageLog = log.NamedLogger("storage", "backend")
+
+	// ErrObjectNotFound - The object does not exist in the store
+	ErrObjectNotFound = errors.New("object not found")
+	// ErrInvalidKey - Keys must be relative paths without '..' elements
+	ErrInvalidKey = errors.New("invalid object key")
+
+	lifecycleOnce = &sync.Once{}
+)
+
+// Store - A place to put blobs of data, only metadata is kept in the database
+type Store interface {
+	Put(key string, data []byte) error
+	Get(key string) ([]byte, error)
+	Exists(key string) (bool, error)
+	Delete(key string) error
+}
+
+// New - Get the store for a namespace (e.g. Loot or Builds) using the backend
+// configured in the server config
+func New(namespace string) (Store, error) {
+	config := configs.GetServerConfig()
+	if config.Storage == nil || config.Storage.Backend != configs.StorageS3 {
+		return NewLocalStore(filepath.Join(assets.GetRootAppDir(), namespace))
+	}
+	s3Config := config.Storage.S3
+	store := NewS3Store(s3Config, s3Config.Prefix+namespace+"/")
+	lifecycleOnce.Do(func() {
+		err := store.PutLifecycle(lifecycleRules(s3Config))
+		if err != nil {
+			storageLog.Errorf("Failed to configure bucket lifecycle: %s", err)
+		}
+	})
+	return store, nil
+}
+
+func lifecycleRules(config *configs.S3Config) []LifecycleRule {
+	rules := []LifecycleRule{}
+	if 0 < config.LootExpiration {
+		rules = append(rules, LifecycleRule{
+			ID:             "sliver-loot",
+			Prefix:         config.Prefix + Loot + "/",
+			ExpirationDays: config.LootExpiration,
+		})
+	}
+	if 0 < config.BuildExpiration {
+		rules = append(rules, LifecycleRule{
+			ID:             "sliver-builds",
+			Prefix:         config.Prefix + Builds + "/",
+			ExpirationDays: config.BuildExpiration,
+		})
+	}
+	return rules
+}
+
+// LocalStore - Stores objects as files in a directory, keys may contain '/'
+// to store objects in subdirectories
+type LocalStore struct {
+	Dir string
+}
+
+// NewLocalStore - Create a local store, the directory is created if needed
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		err = os.MkdirAll(dir, 0700)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &LocalStore{Dir: dir}, nil
+}
+
+func (l *LocalStore) path(key string) (string, error) {
+	key = filepath.FromSlash(key)
+	if key == "" || filepath.IsAbs(key) || strings.HasPrefix(filepath.Clean(key), "..") {
+		return "", ErrInvalidKey
+	}
+	return filepath.Join(l.Dir, key), nil
+}
+
+// Put - Write an object, replacing any existing object with the same key
+func (l *LocalStore) Put(key string, data []byte) error {
+	objPath, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(objPath), 0700)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(objPath, data, 0600)
+}
+
+// Get - Read an object
+func (l *LocalStore) Get(key string) ([]byte, error) {
+	objPath, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(objPath)
+	if os.IsNotExist(err) {
+		return nil, ErrObjectNotFound
+	}
+	return data, err
+}
+
+// Exists - Check if an object exists
+func (l *LocalStore) Exists(key string) (bool, error) {
+	objPath, err := l.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(objPath)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Delete - Remove an object
+func (l *LocalStore) Delete(key string) error {
+	objPath, err := l.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(objPath)
+	if os.IsNotExist(err) {
+		return ErrObjectNotFound
+	}
+	return err
+}
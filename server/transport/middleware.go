@@ -26,10 +26,13 @@ import (
 	"sync"
 
 	"github.com/bishopfox/sliver/protobuf/clientpb"
+	"github.com/bishopfox/sliver/protobuf/commonpb"
 	"github.com/bishopfox/sliver/server/configs"
 	"github.com/bishopfox/sliver/server/core"
 	"github.com/bishopfox/sliver/server/db"
+	"github.com/bishopfox/sliver/server/engagement"
 	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/server/secondfactor"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_logrus "github.com/grpc-ecosystem/go-grpc-middleware/logging/logrus"
@@ -37,6 +40,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -44,6 +48,19 @@ var (
 	serverConfig = configs.GetServerConfig()
 
 	middlewareLog = log.NamedLogger("transport", "middleware")
+
+	// secondFactorMethods - The methods an operator can call before verifying
+	// their second factor
+	secondFactorMethods = map[string]bool{
+		"/rpcpb.SliverRPC/GetSecondFactorChallenge": true,
+		"/rpcpb.SliverRPC/VerifySecondFactor":       true,
+	}
+
+	// engagementEndedMethods - The implant methods an operator can call after the
+	// engagement has ended
+	engagementEndedMethods = map[string]bool{
+		"/rpcpb.SliverRPC/Kill": true,
+	}
 )
 
 // initMiddleware - Initialize middleware logger
@@ -58,6 +75,7 @@ func initMiddleware(remoteAuth bool) []grpc.ServerOption {
 			grpc_middleware.WithUnaryServerChain(
 				grpc_auth.UnaryServerInterceptor(tokenAuthFunc),
 				auditLogUnaryServerInterceptor(),
+				engagementUnaryServerInterceptor(),
 				grpc_tags.UnaryServerInterceptor(grpc_tags.WithFieldExtractor(grpc_tags.CodeGenRequestFieldExtractor)),
 				grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
 				grpc_logrus.PayloadUnaryServerInterceptor(logrusEntry, deciderUnary),
@@ -74,6 +92,7 @@ func initMiddleware(remoteAuth bool) []grpc.ServerOption {
 			grpc_middleware.WithUnaryServerChain(
 				grpc_auth.UnaryServerInterceptor(serverAuthFunc),
 				auditLogUnaryServerInterceptor(),
+				engagementUnaryServerInterceptor(),
 				grpc_tags.UnaryServerInterceptor(grpc_tags.WithFieldExtractor(grpc_tags.CodeGenRequestFieldExtractor)),
 				grpc_logrus.UnaryServerInterceptor(logrusEntry, logrusOpts...),
 				grpc_logrus.PayloadUnaryServerInterceptor(logrusEntry, deciderUnary),
@@ -119,7 +138,7 @@ func tokenAuthFunc(ctx context.Context) (context.Context, error) {
 	if name, ok := tokenCache.Load(token); ok {
 		mtlsLog.Debugf("Token in cache!")
 		newCtx = context.WithValue(newCtx, "operator", name.(string))
-		return newCtx, nil
+		return newCtx, checkSecondFactor(newCtx, name.(string))
 	}
 	operator, err := db.OperatorByToken(token)
 	if err != nil || operator == nil {
@@ -130,7 +149,29 @@ func tokenAuthFunc(ctx context.Context) (context.Context, error) {
 	tokenCache.Store(token, operator.Name)
 
 	newCtx = context.WithValue(newCtx, "operator", operator.Name)
-	return newCtx, nil
+	return newCtx, checkSecondFactor(newCtx, operator.Name)
+}
+
+// checkSecondFactor - Operators enrolled in a second factor must send a session
+// token from a successful verification along with their operator token
+func checkSecondFactor(ctx context.Context, name string) error {
+	factorType, err := secondfactor.Required(name)
+	if err != nil {
+		mtlsLog.Errorf("Failed to check second factor for %s: %s", name, err)
+		return status.Error(codes.Unauthenticated, "Authentication failure")
+	}
+	if factorType == "" {
+		return nil
+	}
+	if method, ok := grpc.Method(ctx); ok && secondFactorMethods[method] {
+		return nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if tokens := md.Get(secondfactor.MetadataKey); 0 < len(tokens) && secondfactor.Check(name, tokens[0]) {
+		return nil
+	}
+	mtlsLog.Warnf("Second factor required for %s", name)
+	return status.Error(codes.Unauthenticated, "Second factor verification required")
 }
 
 func deciderUnary(_ context.Context, _ string, _ interface{}) bool {
@@ -225,6 +266,21 @@ func auditLogUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+// engagementUnaryServerInterceptor - Refuse to task implants once the engagement has ended
+func engagementUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if implantReq, ok := req.(interface{ GetRequest() *commonpb.Request }); ok && !engagementEndedMethods[info.FullMethod] {
+			request := implantReq.GetRequest()
+			if request.GetSessionID() != "" || request.GetBeaconID() != "" {
+				if err := engagement.Check(); err != nil {
+					return nil, status.Error(codes.FailedPrecondition, err.Error())
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
 func getActiveTarget(rawRequest []byte) (*clientpb.Session, *clientpb.Beacon, error) {
 
 	var activeBeacon *clientpb.Beacon
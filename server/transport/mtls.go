@@ -50,15 +50,19 @@ var (
 // StartClientListener - Start a mutual TLS listener
 func StartClientListener(host string, port uint16) (*grpc.Server, net.Listener, error) {
 	mtlsLog.Infof("Starting gRPC  listener on %s:%d", host, port)
-
-	tlsConfig := getOperatorServerTLSConfig("multiplayer")
-
-	creds := credentials.NewTLS(tlsConfig)
 	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", host, port))
 	if err != nil {
 		mtlsLog.Error(err)
 		return nil, nil, err
 	}
+	return serveClients(ln), ln, nil
+}
+
+// serveClients - Serve the operator gRPC API with mutual TLS on the listener
+func serveClients(ln net.Listener) *grpc.Server {
+	tlsConfig := getOperatorServerTLSConfig("multiplayer")
+
+	creds := credentials.NewTLS(tlsConfig)
 	options := []grpc.ServerOption{
 		grpc.Creds(creds),
 		grpc.MaxRecvMsgSize(ServerMaxMessageSize),
@@ -80,7 +84,7 @@ func StartClientListener(host string, port uint16) (*grpc.Server, net.Listener,
 			panicked = false
 		}
 	}()
-	return grpcServer, ln, nil
+	return grpcServer
 }
 
 // getOperatorServerTLSConfig - Generate the TLS configuration, we do now allow the end user
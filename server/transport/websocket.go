@@ -0,0 +1,111 @@
+package transport
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/bishopfox/sliver/server/log"
+	"github.com/bishopfox/sliver/util/websocket"
+	"github.com/gobwas/ws"
+	"google.golang.org/grpc"
+)
+
+var (
+	websocketLog = log.NamedLogger("transport", "websocket")
+)
+
+// StartWebSocketClientListener - Start an operator listener for connections tunneled
+// over websockets, so operators can connect through HTTP proxies and reverse proxies.
+// The tunneled connections use the same mutual TLS as the regular listener, any TLS
+// in front of the websocket (e.g. a reverse proxy) is in addition to it.
+func StartWebSocketClientListener(host string, port uint16) (*grpc.Server, net.Listener, error) {
+	websocketLog.Infof("Starting gRPC websocket listener on %s:%d", host, port)
+	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", host, port))
+	if err != nil {
+		websocketLog.Error(err)
+		return nil, nil, err
+	}
+	wsListener := &websocketListener{
+		ln:    ln,
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+	wsListener.server = &http.Server{Handler: wsListener}
+	go func() {
+		err := wsListener.server.Serve(ln)
+		if err != http.ErrServerClosed {
+			websocketLog.Warnf("Websocket server exited with error: %v", err)
+		}
+	}()
+	return serveClients(wsListener), wsListener, nil
+}
+
+// websocketListener - Accepts the tunneled connections of websocket upgrades
+type websocketListener struct {
+	ln     net.Listener
+	server *http.Server
+	conns  chan net.Conn
+	done   chan struct{}
+	once   sync.Once
+}
+
+func (l *websocketListener) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if !strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+		http.NotFound(w, req)
+		return
+	}
+	conn, rw, _, err := ws.UpgradeHTTP(req, w)
+	if err != nil {
+		websocketLog.Warnf("Websocket upgrade from %s failed: %s", req.RemoteAddr, err)
+		return
+	}
+	websocketLog.Infof("Accepted websocket connection from %s", req.RemoteAddr)
+	select {
+	case l.conns <- websocket.NewConn(conn, rw.Reader, ws.StateServerSide):
+	case <-l.done:
+		conn.Close()
+	}
+}
+
+func (l *websocketListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+}
+
+// Close - Stop accepting connections, like closing a tcp listener this does
+// not close the connections that have already been accepted
+func (l *websocketListener) Close() error {
+	l.once.Do(func() {
+		close(l.done)
+	})
+	return l.server.Close()
+}
+
+func (l *websocketListener) Addr() net.Addr {
+	return l.ln.Addr()
+}
@@ -0,0 +1,48 @@
+package util
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+// UploadChunkSize - Size of the content addressed chunks uploads are split into
+const UploadChunkSize = 1024 * 1024
+
+// SplitChunks - Split data into chunks of at most 'size' bytes, returns the hex
+// encoded sha256 of each chunk and the chunks themselves in order
+func SplitChunks(data []byte, size int) ([]string, [][]byte) {
+	hashes := []string{}
+	chunks := [][]byte{}
+	for start := 0; start < len(data); start += size {
+		end := start + size
+		if len(data) < end {
+			end = len(data)
+		}
+		hashes = append(hashes, ChunkHash(data[start:end]))
+		chunks = append(chunks, data[start:end])
+	}
+	return hashes, chunks
+}
+
+// ChunkHash - The content address of a chunk
+func ChunkHash(chunk []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(chunk))
+}
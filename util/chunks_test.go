@@ -0,0 +1,57 @@
+package util
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSplitChunks(t *testing.T) {
+	hashes, chunks := SplitChunks([]byte{}, 4)
+	if len(hashes) != 0 || len(chunks) != 0 {
+		t.Fatalf("Expected no chunks for empty data, got %d", len(chunks))
+	}
+
+	data := randomDataRandomSize(64 * 1024)
+	hashes, chunks = SplitChunks(data, 1000)
+	if len(hashes) != len(chunks) || len(chunks) != (len(data)+999)/1000 {
+		t.Fatalf("Expected %d chunks, got %d (%d hashes)", (len(data)+999)/1000, len(chunks), len(hashes))
+	}
+	joined := []byte{}
+	for index, chunk := range chunks {
+		if 1000 < len(chunk) {
+			t.Fatalf("Chunk %d is too large (%d)", index, len(chunk))
+		}
+		if ChunkHash(chunk) != hashes[index] {
+			t.Fatalf("Chunk %d hash mismatch", index)
+		}
+		joined = append(joined, chunk...)
+	}
+	if !bytes.Equal(data, joined) {
+		t.Fatal("Joined chunks do not match the original data")
+	}
+
+	again, _ := SplitChunks(data, 1000)
+	for index := range hashes {
+		if hashes[index] != again[index] {
+			t.Fatalf("Chunk %d hash is not stable", index)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package websocket
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gobwas/ws"
+	"github.com/gobwas/ws/wsutil"
+)
+
+// Conn - Sends and receives binary websocket messages as a stream, so a stream
+// protocol like TLS can be tunneled over a websocket
+type Conn struct {
+	net.Conn
+
+	state   ws.State
+	reader  *wsutil.Reader
+	control wsutil.FrameHandlerFunc
+	inFrame bool
+
+	writeMutex sync.Mutex
+}
+
+// NewConn - Wrap an upgraded websocket connection, buffered is the reader with
+// any data the handshake read past the end of the upgrade (may be nil)
+func NewConn(conn net.Conn, buffered *bufio.Reader, state ws.State) *Conn {
+	wsConn := &Conn{Conn: conn, state: state}
+	var source io.Reader = conn
+	if buffered != nil {
+		source = buffered
+	}
+	wsConn.control = wsutil.ControlFrameHandler(&controlWriter{wsConn}, state)
+	wsConn.reader = &wsutil.Reader{
+		Source:         source,
+		State:          state,
+		OnIntermediate: wsConn.control,
+	}
+	return wsConn
+}
+
+// Read - Read the payloads of data messages, control messages are handled
+func (c *Conn) Read(p []byte) (int, error) {
+	for {
+		if c.inFrame {
+			n, err := c.reader.Read(p)
+			if err == io.EOF {
+				c.inFrame = false
+				if n == 0 {
+					continue
+				}
+				err = nil
+			}
+			return n, err
+		}
+		header, err := c.reader.NextFrame()
+		if err != nil {
+			return 0, err
+		}
+		if header.OpCode.IsControl() {
+			err = c.control(header, c.reader)
+			if _, ok := err.(wsutil.ClosedError); ok {
+				return 0, io.EOF
+			}
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+		c.inFrame = true
+	}
+}
+
+// Write - Send p as a binary message
+func (c *Conn) Write(p []byte) (int, error) {
+	c.writeMutex.Lock()
+	defer c.writeMutex.Unlock()
+	err := wsutil.WriteMessage(c.Conn, c.state, ws.OpBinary, p)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close - Send a close message and close the connection
+func (c *Conn) Close() error {
+	c.writeMutex.Lock()
+	wsutil.WriteMessage(c.Conn, c.state, ws.OpClose, ws.NewCloseFrameBody(ws.StatusNormalClosure, ""))
+	c.writeMutex.Unlock()
+	return c.Conn.Close()
+}
+
+// controlWriter - Replies to control messages can't be interleaved with data
+type controlWriter struct {
+	conn *Conn
+}
+
+func (w *controlWriter) Write(p []byte) (int, error) {
+	w.conn.writeMutex.Lock()
+	defer w.conn.writeMutex.Unlock()
+	return w.conn.Conn.Write(p)
+}
+
+// Dial - Connect to a websocket server (ws:// or wss://), through the proxy
+// from the HTTP_PROXY/HTTPS_PROXY environment variables if there is one
+func Dial(ctx context.Context, wsURL string) (*Conn, error) {
+	target, err := url.Parse(wsURL)
+	if err != nil {
+		return nil, err
+	}
+	proxyURL, err := proxyFor(target)
+	if err != nil {
+		return nil, err
+	}
+	dialer := ws.Dialer{}
+	if proxyURL != nil {
+		dialer.NetDial = func(ctx context.Context, _ string, addr string) (net.Conn, error) {
+			return dialProxy(ctx, proxyURL, addr)
+		}
+	}
+	conn, buffered, _, err := dialer.Dial(ctx, wsURL)
+	if err != nil {
+		return nil, err
+	}
+	return NewConn(conn, buffered, ws.StateClientSide), nil
+}
+
+// proxyFor - The proxy from the environment for the websocket url, nil if
+// there is none
+func proxyFor(target *url.URL) (*url.URL, error) {
+	scheme := "http"
+	if target.Scheme == "wss" {
+		scheme = "https"
+	}
+	return http.ProxyFromEnvironment(&http.Request{URL: &url.URL{Scheme: scheme, Host: target.Host}})
+}
+
+// dialProxy - Open a tunnel to addr with an HTTP CONNECT request to the proxy
+func dialProxy(ctx context.Context, proxyURL *url.URL, addr string) (net.Conn, error) {
+	proxyAddr := proxyURL.Host
+	if proxyURL.Port() == "" {
+		if proxyURL.Scheme == "https" {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "443")
+		} else {
+			proxyAddr = net.JoinHostPort(proxyURL.Hostname(), "80")
+		}
+	}
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", proxyAddr)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		conn = tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname()})
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	connectReq := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: http.Header{},
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		connectReq.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	err = connectReq.Write(conn)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, connectReq)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused to connect to %s (%s)", proxyURL.Host, addr, resp.Status)
+	}
+	conn.SetDeadline(time.Time{})
+	if 0 < reader.Buffered() {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn - Anything the proxy sent after its response is read first
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
@@ -0,0 +1,130 @@
+package websocket
+
+/*
+	Sliver Implant Framework
+	Copyright (C) 2023  Bishop Fox
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU General Public License for more details.
+
+	You should have received a copy of the GNU General Public License
+	along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gobwas/ws"
+)
+
+func echoServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		conn, rw, _, err := ws.UpgradeHTTP(req, w)
+		if err != nil {
+			t.Errorf("upgrade failed: %s", err)
+			return
+		}
+		wsConn := NewConn(conn, rw.Reader, ws.StateServerSide)
+		defer wsConn.Close()
+		io.Copy(wsConn, wsConn)
+	}))
+}
+
+func roundTrip(t *testing.T, conn net.Conn) {
+	data := bytes.Repeat([]byte("sliver"), 64*1024)
+	go func() {
+		// Multiple writes are read back as one stream
+		conn.Write(data[:len(data)/2])
+		conn.Write(data[len(data)/2:])
+	}()
+	received := make([]byte, len(data))
+	_, err := io.ReadFull(conn, received)
+	if err != nil {
+		t.Fatalf("read failed: %s", err)
+	}
+	if !bytes.Equal(data, received) {
+		t.Fatal("received data does not match sent data")
+	}
+}
+
+func TestDial(t *testing.T) {
+	server := echoServer(t)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := Dial(ctx, strings.Replace(server.URL, "http://", "ws://", 1))
+	if err != nil {
+		t.Fatalf("dial failed: %s", err)
+	}
+	defer conn.Close()
+	roundTrip(t, conn)
+}
+
+func TestDialProxy(t *testing.T) {
+	server := echoServer(t)
+	defer server.Close()
+
+	connected := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodConnect || req.Header.Get("Proxy-Authorization") != "Basic dXNlcjpwYXNz" {
+			w.WriteHeader(http.StatusProxyAuthRequired)
+			return
+		}
+		connected <- req.Host
+		upstream, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		conn, rw, _ := w.(http.Hijacker).Hijack()
+		defer conn.Close()
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		go io.Copy(upstream, rw)
+		io.Copy(conn, upstream)
+	}))
+	defer proxy.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	proxyURL, _ := url.Parse(proxy.URL)
+	proxyURL.User = url.UserPassword("user", "pass")
+	target := strings.TrimPrefix(server.URL, "http://")
+	rawConn, err := dialProxy(ctx, proxyURL, target)
+	if err != nil {
+		t.Fatalf("proxy dial failed: %s", err)
+	}
+	if host := <-connected; host != target {
+		t.Fatalf("proxy connected to %q", host)
+	}
+	buffered, _, err := ws.Dialer{}.Upgrade(rawConn, &url.URL{Scheme: "ws", Host: target, Path: "/"})
+	if err != nil {
+		t.Fatalf("upgrade failed: %s", err)
+	}
+	conn := NewConn(rawConn, buffered, ws.StateClientSide)
+	defer conn.Close()
+	roundTrip(t, conn)
+
+	proxyURL.User = nil
+	_, err = dialProxy(ctx, proxyURL, target)
+	if err == nil {
+		t.Fatal("expected an error when the proxy refuses to connect")
+	}
+}
+